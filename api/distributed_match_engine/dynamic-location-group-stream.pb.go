@@ -0,0 +1,742 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dynamic-location-group-stream.proto
+
+package distributed_match_engine
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicLocGroupStreamRequest identifies the group to receive
+// messages from, the same as the DynamicLocGroupRequest.
+type DynamicLocGroupStreamRequest struct {
+	//
+	// API version
+	//
+	// _(hidden)_ Reserved for future use
+	Ver uint32 `protobuf:"varint,1,opt,name=ver,proto3" json:"ver,omitempty"`
+	// Session Cookie from RegisterClientRequest
+	SessionCookie string `protobuf:"bytes,2,opt,name=session_cookie,json=sessionCookie,proto3" json:"session_cookie,omitempty"`
+	// Dynamic Location Group Id, if not set the AppInst tags are used
+	LgId uint64 `protobuf:"varint,3,opt,name=lg_id,json=lgId,proto3" json:"lg_id,omitempty"`
+	// _(optional)_ Tags to identify the group's AppInst
+	Tags                 map[string]string `protobuf:"bytes,100,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DynamicLocGroupStreamRequest) Reset()         { *m = DynamicLocGroupStreamRequest{} }
+func (m *DynamicLocGroupStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*DynamicLocGroupStreamRequest) ProtoMessage()    {}
+func (*DynamicLocGroupStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f52fa6762d7b57, []int{0}
+}
+func (m *DynamicLocGroupStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicLocGroupStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicLocGroupStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicLocGroupStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicLocGroupStreamRequest.Merge(m, src)
+}
+func (m *DynamicLocGroupStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicLocGroupStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicLocGroupStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicLocGroupStreamRequest proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DynamicLocGroupStreamRequest)(nil), "distributed_match_engine.DynamicLocGroupStreamRequest")
+	proto.RegisterMapType((map[string]string)(nil), "distributed_match_engine.DynamicLocGroupStreamRequest.TagsEntry")
+}
+
+func init() {
+	proto.RegisterFile("dynamic-location-group-stream.proto", fileDescriptor_75f52fa6762d7b57)
+}
+
+var fileDescriptor_75f52fa6762d7b57 = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcb, 0x4a, 0x33, 0x31,
+	0x1c, 0xc5, 0x9b, 0x5e, 0x3e, 0x68, 0x3e, 0x2a, 0x25, 0xba, 0x18, 0x4a, 0x19, 0x86, 0xaa, 0x30,
+	0x9b, 0x0e, 0x52, 0x41, 0xc5, 0x95, 0x77, 0x11, 0x74, 0x13, 0xbb, 0x1f, 0xd2, 0x99, 0x3f, 0x31,
+	0x74, 0x26, 0xa9, 0x49, 0xa6, 0xd0, 0xb7, 0xf0, 0xb1, 0xba, 0xf4, 0x11, 0xb4, 0xaf, 0xe1, 0x46,
+	0x26, 0xa3, 0xae, 0x6a, 0x17, 0xee, 0x4e, 0xce, 0xc9, 0x39, 0xf9, 0x41, 0xf0, 0x6e, 0xba, 0x90,
+	0x2c, 0x17, 0xc9, 0x30, 0x53, 0x09, 0xb3, 0x42, 0xc9, 0x21, 0xd7, 0xaa, 0x98, 0x0d, 0x8d, 0xd5,
+	0xc0, 0xf2, 0x68, 0xa6, 0x95, 0x55, 0xc4, 0x4b, 0x85, 0xb1, 0x5a, 0x4c, 0x0a, 0x0b, 0x69, 0x9c,
+	0x33, 0x9b, 0x3c, 0xc5, 0x20, 0xb9, 0x90, 0xd0, 0xeb, 0xaf, 0xaf, 0x57, 0xbd, 0xc1, 0x07, 0xc2,
+	0xfd, 0xab, 0xea, 0xc2, 0xbd, 0x4a, 0x6e, 0xcb, 0xe4, 0xd1, 0xed, 0x52, 0x78, 0x2e, 0xc0, 0x58,
+	0xd2, 0xc5, 0x8d, 0x39, 0x68, 0x0f, 0x05, 0x28, 0xec, 0xd0, 0x52, 0x92, 0x7d, 0xbc, 0x65, 0xc0,
+	0x18, 0xa1, 0x64, 0x9c, 0x28, 0x35, 0x15, 0xe0, 0xd5, 0x03, 0x14, 0xb6, 0x69, 0xe7, 0xcb, 0xbd,
+	0x74, 0x26, 0xd9, 0xc6, 0xad, 0x8c, 0xc7, 0x22, 0xf5, 0x1a, 0x01, 0x0a, 0x9b, 0xb4, 0x99, 0xf1,
+	0xbb, 0x94, 0x8c, 0x71, 0xd3, 0x32, 0x6e, 0xbc, 0x34, 0x68, 0x84, 0xff, 0x47, 0x67, 0xd1, 0x6f,
+	0xd4, 0xd1, 0x26, 0xa6, 0x68, 0xcc, 0xb8, 0xb9, 0x96, 0x56, 0x2f, 0xa8, 0x5b, 0xeb, 0x1d, 0xe3,
+	0xf6, 0x8f, 0x55, 0x02, 0x4f, 0x61, 0xe1, 0x80, 0xdb, 0xb4, 0x94, 0x64, 0x07, 0xb7, 0xe6, 0x2c,
+	0x2b, 0xbe, 0x39, 0xab, 0xc3, 0x69, 0xfd, 0x04, 0x8d, 0x5e, 0x10, 0xf6, 0xd6, 0xbe, 0x74, 0x3e,
+	0x13, 0xc4, 0xe2, 0x2e, 0x85, 0x04, 0xc4, 0x1c, 0x6e, 0xb4, 0xca, 0x5d, 0x48, 0x8e, 0xfe, 0x46,
+	0xdc, 0xdb, 0xdb, 0xd0, 0xcb, 0xf8, 0x03, 0x18, 0xc3, 0x38, 0x0c, 0x6a, 0x07, 0xe8, 0xa2, 0xbb,
+	0x7c, 0xf7, 0x6b, 0xcb, 0x95, 0x8f, 0x5e, 0x57, 0x3e, 0x7a, 0x5b, 0xf9, 0x68, 0xf2, 0xcf, 0xfd,
+	0xd4, 0xe1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0x8a, 0xf8, 0x31, 0x08, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DynamicLocGroupStreamApiClient is the client API for DynamicLocGroupStreamApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DynamicLocGroupStreamApiClient interface {
+	// Receive messages sent to the dynamic location group. The session
+	// must already have been added to the group.
+	ReceiveFromGroup(ctx context.Context, in *DynamicLocGroupStreamRequest, opts ...grpc.CallOption) (DynamicLocGroupStreamApi_ReceiveFromGroupClient, error)
+}
+
+type dynamicLocGroupStreamApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDynamicLocGroupStreamApiClient(cc *grpc.ClientConn) DynamicLocGroupStreamApiClient {
+	return &dynamicLocGroupStreamApiClient{cc}
+}
+
+func (c *dynamicLocGroupStreamApiClient) ReceiveFromGroup(ctx context.Context, in *DynamicLocGroupStreamRequest, opts ...grpc.CallOption) (DynamicLocGroupStreamApi_ReceiveFromGroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DynamicLocGroupStreamApi_serviceDesc.Streams[0], "/distributed_match_engine.DynamicLocGroupStreamApi/ReceiveFromGroup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dynamicLocGroupStreamApiReceiveFromGroupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DynamicLocGroupStreamApi_ReceiveFromGroupClient interface {
+	Recv() (*DlgMessage, error)
+	grpc.ClientStream
+}
+
+type dynamicLocGroupStreamApiReceiveFromGroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *dynamicLocGroupStreamApiReceiveFromGroupClient) Recv() (*DlgMessage, error) {
+	m := new(DlgMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DynamicLocGroupStreamApiServer is the server API for DynamicLocGroupStreamApi service.
+type DynamicLocGroupStreamApiServer interface {
+	// Receive messages sent to the dynamic location group. The session
+	// must already have been added to the group.
+	ReceiveFromGroup(*DynamicLocGroupStreamRequest, DynamicLocGroupStreamApi_ReceiveFromGroupServer) error
+}
+
+// UnimplementedDynamicLocGroupStreamApiServer can be embedded to have forward compatible implementations.
+type UnimplementedDynamicLocGroupStreamApiServer struct {
+}
+
+func (*UnimplementedDynamicLocGroupStreamApiServer) ReceiveFromGroup(req *DynamicLocGroupStreamRequest, srv DynamicLocGroupStreamApi_ReceiveFromGroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReceiveFromGroup not implemented")
+}
+
+func RegisterDynamicLocGroupStreamApiServer(s *grpc.Server, srv DynamicLocGroupStreamApiServer) {
+	s.RegisterService(&_DynamicLocGroupStreamApi_serviceDesc, srv)
+}
+
+func _DynamicLocGroupStreamApi_ReceiveFromGroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DynamicLocGroupStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DynamicLocGroupStreamApiServer).ReceiveFromGroup(m, &dynamicLocGroupStreamApiReceiveFromGroupServer{stream})
+}
+
+type DynamicLocGroupStreamApi_ReceiveFromGroupServer interface {
+	Send(*DlgMessage) error
+	grpc.ServerStream
+}
+
+type dynamicLocGroupStreamApiReceiveFromGroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *dynamicLocGroupStreamApiReceiveFromGroupServer) Send(m *DlgMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _DynamicLocGroupStreamApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "distributed_match_engine.DynamicLocGroupStreamApi",
+	HandlerType: (*DynamicLocGroupStreamApiServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReceiveFromGroup",
+			Handler:       _DynamicLocGroupStreamApi_ReceiveFromGroup_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dynamic-location-group-stream.proto",
+}
+
+func (m *DynamicLocGroupStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicLocGroupStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicLocGroupStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDynamicLocationGroupStream(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDynamicLocationGroupStream(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDynamicLocationGroupStream(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.LgId != 0 {
+		i = encodeVarintDynamicLocationGroupStream(dAtA, i, uint64(m.LgId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SessionCookie) > 0 {
+		i -= len(m.SessionCookie)
+		copy(dAtA[i:], m.SessionCookie)
+		i = encodeVarintDynamicLocationGroupStream(dAtA, i, uint64(len(m.SessionCookie)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Ver != 0 {
+		i = encodeVarintDynamicLocationGroupStream(dAtA, i, uint64(m.Ver))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicLocationGroupStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicLocationGroupStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicLocGroupStreamRequest) Clone() *DynamicLocGroupStreamRequest {
+	cp := &DynamicLocGroupStreamRequest{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *DynamicLocGroupStreamRequest) CopyInFields(src *DynamicLocGroupStreamRequest) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Ver != src.Ver {
+		m.Ver = src.Ver
+		changed++
+	}
+	if m.SessionCookie != src.SessionCookie {
+		m.SessionCookie = src.SessionCookie
+		changed++
+	}
+	if m.LgId != src.LgId {
+		m.LgId = src.LgId
+		changed++
+	}
+	if src.Tags != nil {
+		if updateListAction == "add" {
+			for k0, v := range src.Tags {
+				m.Tags[k0] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k0, _ := range src.Tags {
+				if _, ok := m.Tags[k0]; ok {
+					delete(m.Tags, k0)
+					changed++
+				}
+			}
+		} else {
+			m.Tags = make(map[string]string)
+			for k0, v := range src.Tags {
+				m.Tags[k0] = v
+			}
+			changed++
+		}
+	} else if m.Tags != nil {
+		m.Tags = nil
+		changed++
+	}
+	return changed
+}
+
+func (m *DynamicLocGroupStreamRequest) DeepCopyIn(src *DynamicLocGroupStreamRequest) {
+	m.Ver = src.Ver
+	m.SessionCookie = src.SessionCookie
+	m.LgId = src.LgId
+	if src.Tags != nil {
+		m.Tags = make(map[string]string)
+		for k, v := range src.Tags {
+			m.Tags[k] = v
+		}
+	} else {
+		m.Tags = nil
+	}
+}
+
+// Helper method to check that enums have valid values
+func (m *DynamicLocGroupStreamRequest) ValidateEnums() error {
+	return nil
+}
+
+func (s *DynamicLocGroupStreamRequest) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *DynamicLocGroupStreamRequest) IsValidArgsForReceiveFromGroup() error {
+	return nil
+}
+
+func (m *DynamicLocGroupStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ver != 0 {
+		n += 1 + sovDynamicLocationGroupStream(uint64(m.Ver))
+	}
+	l = len(m.SessionCookie)
+	if l > 0 {
+		n += 1 + l + sovDynamicLocationGroupStream(uint64(l))
+	}
+	if m.LgId != 0 {
+		n += 1 + sovDynamicLocationGroupStream(uint64(m.LgId))
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDynamicLocationGroupStream(uint64(len(k))) + 1 + len(v) + sovDynamicLocationGroupStream(uint64(len(v)))
+			n += mapEntrySize + 2 + sovDynamicLocationGroupStream(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDynamicLocationGroupStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicLocationGroupStream(x uint64) (n int) {
+	return sovDynamicLocationGroupStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicLocGroupStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicLocationGroupStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicLocGroupStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicLocGroupStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ver", wireType)
+			}
+			m.Ver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicLocationGroupStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ver |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionCookie", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicLocationGroupStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicLocationGroupStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicLocationGroupStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionCookie = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LgId", wireType)
+			}
+			m.LgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicLocationGroupStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicLocationGroupStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicLocationGroupStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicLocationGroupStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDynamicLocationGroupStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDynamicLocationGroupStream
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDynamicLocationGroupStream
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDynamicLocationGroupStream
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDynamicLocationGroupStream
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDynamicLocationGroupStream
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDynamicLocationGroupStream
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDynamicLocationGroupStream(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDynamicLocationGroupStream
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicLocationGroupStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicLocationGroupStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicLocationGroupStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicLocationGroupStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicLocationGroupStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicLocationGroupStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicLocationGroupStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicLocationGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicLocationGroupStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicLocationGroupStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicLocationGroupStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicLocationGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1355,6 +1355,8 @@ func NewOptionalSTM(stm concurrency.STM) *OptionalSTM {
 // represented as strings.
 func EnumDecodeHook(from, to reflect.Type, data interface{}) (interface{}, error) {
 	switch to {
+	case reflect.TypeOf(AlertComparison(0)):
+		return ParseAlertComparison(data)
 	case reflect.TypeOf(OptResNames(0)):
 		return ParseOptResNames(data)
 	case reflect.TypeOf(Liveness(0)):
@@ -1377,6 +1379,14 @@ func EnumDecodeHook(from, to reflect.Type, data interface{}) (interface{}, error
 		return ParseDeleteType(data)
 	case reflect.TypeOf(AccessType(0)):
 		return ParseAccessType(data)
+	case reflect.TypeOf(FindCloudletRanking(0)):
+		return ParseFindCloudletRanking(data)
+	case reflect.TypeOf(UpdateStrategy(0)):
+		return ParseUpdateStrategy(data)
+	case reflect.TypeOf(RolloutBatchBy(0)):
+		return ParseRolloutBatchBy(data)
+	case reflect.TypeOf(RolloutState(0)):
+		return ParseRolloutState(data)
 	case reflect.TypeOf(GpuType(0)):
 		return ParseGpuType(data)
 	case reflect.TypeOf(PowerState(0)):
@@ -1419,6 +1429,8 @@ func EnumDecodeHook(from, to reflect.Type, data interface{}) (interface{}, error
 // valid values, and a bool that indicates if a type was matched.
 func GetEnumParseHelp(t reflect.Type) (string, string, bool) {
 	switch t {
+	case reflect.TypeOf(AlertComparison(0)):
+		return "AlertComparison", ", valid values are one of Above, Below, or 0, 1", true
 	case reflect.TypeOf(OptResNames(0)):
 		return "OptResNames", ", valid values are one of Gpu, Nas, Nic, or 0, 1, 2", true
 	case reflect.TypeOf(Liveness(0)):
@@ -1441,6 +1453,14 @@ func GetEnumParseHelp(t reflect.Type) (string, string, bool) {
 		return "DeleteType", ", valid values are one of NoAutoDelete, AutoDelete, or 0, 1", true
 	case reflect.TypeOf(AccessType(0)):
 		return "AccessType", ", valid values are one of DefaultForDeployment, Direct, LoadBalancer, or 0, 1, 2", true
+	case reflect.TypeOf(FindCloudletRanking(0)):
+		return "FindCloudletRanking", ", valid values are one of Distance, Latency, Load, Weighted, or 0, 1, 2, 3", true
+	case reflect.TypeOf(UpdateStrategy(0)):
+		return "UpdateStrategy", ", valid values are one of InPlace, BlueGreen, Canary, or 0, 1, 2", true
+	case reflect.TypeOf(RolloutBatchBy(0)):
+		return "RolloutBatchBy", ", valid values are one of Cloudlet, Zone, or 0, 1", true
+	case reflect.TypeOf(RolloutState(0)):
+		return "RolloutState", ", valid values are one of None, Pending, InProgress, Paused, Done, Failed, Aborted, or 0, 1, 2, 3, 4, 5, 6", true
 	case reflect.TypeOf(GpuType(0)):
 		return "GpuType", ", valid values are one of None, Any, Vgpu, Pci, or 0, 1, 2, 3", true
 	case reflect.TypeOf(PowerState(0)):
@@ -1627,6 +1647,7 @@ func GetReferencesMap() map[string][]string {
 	refs["AppInstKeyV1"] = []string{"ClusterInst"}
 	refs["AppInstKeyV2"] = []string{"Cloudlet"}
 	refs["AppInstRefs"] = []string{"AppInst"}
+	refs["AppRolloutRequest"] = []string{"App"}
 	refs["AutoProvPolicy"] = []string{"Zone"}
 	refs["AutoProvPolicyZone"] = []string{"AutoProvPolicy", "Zone"}
 	refs["Cloudlet"] = []string{"Flavor", "GPUDriver", "PlatformFeatures", "ResTagTable", "TrustPolicy", "VMPool"}
@@ -5,7 +5,9 @@ package edgeproto
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	"encoding/json"
+	"errors"
 	fmt "fmt"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
@@ -24,6 +26,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	"strconv"
 	strings "strings"
 )
 
@@ -38,6 +41,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AlertComparison specifies how an alert condition value is compared
+// against its threshold
+type AlertComparison int32
+
+const (
+	// Alert when the value is above the threshold
+	AlertComparison_ALERT_COMPARISON_ABOVE AlertComparison = 0
+	// Alert when the value is below the threshold
+	AlertComparison_ALERT_COMPARISON_BELOW AlertComparison = 1
+)
+
+var AlertComparison_name = map[int32]string{
+	0: "ALERT_COMPARISON_ABOVE",
+	1: "ALERT_COMPARISON_BELOW",
+}
+
+var AlertComparison_value = map[string]int32{
+	"ALERT_COMPARISON_ABOVE": 0,
+	"ALERT_COMPARISON_BELOW": 1,
+}
+
+func (x AlertComparison) String() string {
+	return proto.EnumName(AlertComparison_name, int32(x))
+}
+
+func (AlertComparison) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_acb416d0b807474a, []int{0}
+}
+
 type AlertPolicyKey struct {
 	// Name of the organization for the app that this alert can be applied to
 	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
@@ -78,6 +110,51 @@ func (m *AlertPolicyKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AlertPolicyKey proto.InternalMessageInfo
 
+// AlertPolicyCondition is a threshold on a metric expression
+type AlertPolicyCondition struct {
+	// Prometheus expression over the metrics exposed by the App, for example sum(rate(http_requests_total{code=~"5.."}[$window]))by(pod). Aggregations must keep the pod label. $window is replaced by the condition window
+	Expr string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	// Comparison of the expression value against the threshold, one of Above, Below
+	Comparison AlertComparison `protobuf:"varint,2,opt,name=comparison,proto3,enum=edgeproto.AlertComparison" json:"comparison,omitempty"`
+	// Threshold that triggers the condition
+	Threshold float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Window used for range vectors specified as [$window] in the expression
+	Window Duration `protobuf:"varint,4,opt,name=window,proto3,casttype=Duration" json:"window,omitempty"`
+}
+
+func (m *AlertPolicyCondition) Reset()         { *m = AlertPolicyCondition{} }
+func (m *AlertPolicyCondition) String() string { return proto.CompactTextString(m) }
+func (*AlertPolicyCondition) ProtoMessage()    {}
+func (*AlertPolicyCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acb416d0b807474a, []int{1}
+}
+func (m *AlertPolicyCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlertPolicyCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlertPolicyCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlertPolicyCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertPolicyCondition.Merge(m, src)
+}
+func (m *AlertPolicyCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlertPolicyCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertPolicyCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertPolicyCondition proto.InternalMessageInfo
+
 type AlertPolicy struct {
 	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
 	// Unique identifier key
@@ -102,13 +179,15 @@ type AlertPolicy struct {
 	Description string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
 	// Preparing to be deleted
 	DeletePrepare bool `protobuf:"varint,12,opt,name=delete_prepare,json=deletePrepare,proto3" json:"delete_prepare,omitempty"`
+	// Conditions on App metric expressions, all of which must be met to trigger the alert
+	Conditions []AlertPolicyCondition `protobuf:"bytes,13,rep,name=conditions,proto3" json:"conditions"`
 }
 
 func (m *AlertPolicy) Reset()         { *m = AlertPolicy{} }
 func (m *AlertPolicy) String() string { return proto.CompactTextString(m) }
 func (*AlertPolicy) ProtoMessage()    {}
 func (*AlertPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acb416d0b807474a, []int{1}
+	return fileDescriptor_acb416d0b807474a, []int{2}
 }
 func (m *AlertPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,7 +217,9 @@ func (m *AlertPolicy) XXX_DiscardUnknown() {
 var xxx_messageInfo_AlertPolicy proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("edgeproto.AlertComparison", AlertComparison_name, AlertComparison_value)
 	proto.RegisterType((*AlertPolicyKey)(nil), "edgeproto.AlertPolicyKey")
+	proto.RegisterType((*AlertPolicyCondition)(nil), "edgeproto.AlertPolicyCondition")
 	proto.RegisterType((*AlertPolicy)(nil), "edgeproto.AlertPolicy")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.AlertPolicy.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.AlertPolicy.LabelsEntry")
@@ -147,61 +228,70 @@ func init() {
 func init() { proto.RegisterFile("alertpolicy.proto", fileDescriptor_acb416d0b807474a) }
 
 var fileDescriptor_acb416d0b807474a = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x33, 0x9b, 0xcd, 0x36, 0x19, 0x27, 0x6d, 0xe2, 0x2e, 0xab, 0x69, 0xd4, 0x66, 0xa3,
-	0x5c, 0x88, 0x2a, 0x2b, 0x2e, 0x69, 0x91, 0x20, 0x52, 0x90, 0x92, 0x0d, 0x07, 0xd4, 0x16, 0x2a,
-	0x6f, 0x0b, 0xc7, 0xc8, 0x75, 0x1e, 0xee, 0x68, 0x6d, 0x8f, 0x35, 0xb6, 0x13, 0x85, 0x13, 0xe2,
-	0xc8, 0xa9, 0x82, 0x03, 0x85, 0x13, 0x47, 0xc4, 0x09, 0xf5, 0x82, 0xd4, 0xbf, 0x60, 0x0f, 0x1c,
-	0x2a, 0x21, 0x21, 0x4e, 0x15, 0x64, 0x39, 0xa0, 0x9e, 0x90, 0x36, 0x1b, 0x21, 0x4e, 0xc8, 0x63,
-	0x37, 0xeb, 0xfc, 0x28, 0x82, 0xbd, 0x70, 0x9b, 0x79, 0xef, 0xfb, 0x9d, 0xf9, 0x64, 0xf2, 0x7d,
-	0xc6, 0x25, 0xdd, 0x02, 0xee, 0xbb, 0xcc, 0xa2, 0xc6, 0xb8, 0xe1, 0x72, 0xe6, 0x33, 0x39, 0x07,
-	0x03, 0x13, 0xc4, 0xb2, 0x7c, 0xd9, 0x64, 0xcc, 0xb4, 0x40, 0xd5, 0x5d, 0xaa, 0xea, 0x8e, 0xc3,
-	0x7c, 0xdd, 0xa7, 0xcc, 0xf1, 0x22, 0x61, 0x39, 0xcf, 0xc1, 0x0b, 0x2c, 0x3f, 0xde, 0x5d, 0xf1,
-	0x19, 0xb3, 0x3c, 0x55, 0x6c, 0x4c, 0x70, 0xe6, 0x8b, 0xb8, 0xbd, 0x6d, 0x32, 0x93, 0x89, 0xa5,
-	0x1a, 0xae, 0xa2, 0x6a, 0x8d, 0xe1, 0xf3, 0x9d, 0x10, 0xe0, 0x8e, 0x00, 0xb8, 0x09, 0x63, 0xf9,
-	0x1a, 0xce, 0x33, 0x6e, 0xea, 0x0e, 0xfd, 0x48, 0xdc, 0x45, 0x50, 0x15, 0xd5, 0x73, 0xdd, 0xfc,
-	0x93, 0x19, 0xc9, 0x0a, 0x54, 0xc6, 0x4d, 0x6d, 0x41, 0x21, 0x5f, 0xc1, 0x9b, 0x8e, 0x6e, 0x03,
-	0xd9, 0x10, 0xca, 0xdc, 0x93, 0x19, 0xc9, 0x08, 0xa5, 0x26, 0xca, 0xad, 0xfc, 0xef, 0xc7, 0x04,
-	0xfd, 0x79, 0x4c, 0xd0, 0x77, 0x5f, 0xef, 0xa2, 0xda, 0x4f, 0xe7, 0xb0, 0x94, 0xb8, 0x51, 0xde,
-	0xc1, 0x5b, 0x1f, 0x52, 0xb0, 0x06, 0x1e, 0x41, 0xd5, 0x74, 0x3d, 0xa7, 0xc5, 0x3b, 0xf9, 0x35,
-	0x9c, 0x3e, 0x80, 0xb1, 0x38, 0x53, 0x6a, 0x5e, 0x6a, 0xcc, 0x9f, 0xa4, 0xb1, 0x88, 0xdb, 0xdd,
-	0x3c, 0x7c, 0xb6, 0x9b, 0xd2, 0x42, 0xad, 0xdc, 0xc4, 0xaf, 0x18, 0x6e, 0xd0, 0x0f, 0x7c, 0x6a,
-	0xc5, 0x68, 0x7d, 0x8b, 0xda, 0xd4, 0x27, 0xe9, 0x2a, 0xaa, 0x17, 0xb4, 0x8b, 0x86, 0x1b, 0xdc,
-	0x3b, 0xed, 0xdd, 0x0a, 0x5b, 0xa1, 0xc7, 0x06, 0x7b, 0x8d, 0x67, 0x33, 0xf2, 0xd8, 0x60, 0xaf,
-	0x78, 0x6e, 0xe0, 0x9d, 0x01, 0xf5, 0x0e, 0xd6, 0x98, 0x32, 0xc2, 0xb4, 0x1d, 0x76, 0x57, 0x5c,
-	0x57, 0x71, 0x49, 0x37, 0x7c, 0x3a, 0x84, 0xbe, 0xc1, 0x9c, 0x17, 0x86, 0x2d, 0x61, 0xb8, 0x10,
-	0x35, 0xf6, 0x98, 0x13, 0x6b, 0xcb, 0x38, 0xeb, 0xc1, 0x10, 0x38, 0xf5, 0xc7, 0xe4, 0x5c, 0xf8,
-	0xaa, 0xda, 0x7c, 0x2f, 0xab, 0x38, 0xef, 0x73, 0x6a, 0x9a, 0xc0, 0xfb, 0x3e, 0xb5, 0x81, 0x64,
-	0xab, 0xa8, 0x9e, 0xee, 0xe6, 0xff, 0x7a, 0xb6, 0x9b, 0xed, 0x05, 0x5c, 0x5c, 0xa8, 0x49, 0xb1,
-	0xe2, 0x2e, 0xb5, 0x41, 0x6e, 0xe1, 0x2d, 0x4b, 0xbf, 0x0f, 0x96, 0x47, 0x72, 0xd5, 0x74, 0x5d,
-	0x6a, 0xd6, 0xd6, 0x3f, 0x66, 0xe3, 0x96, 0x10, 0xbd, 0xed, 0xf8, 0x7c, 0xac, 0xc5, 0x0e, 0xf9,
-	0x1d, 0x2c, 0x25, 0x62, 0x47, 0xb0, 0x38, 0xe0, 0xd5, 0x97, 0x1c, 0xd0, 0x39, 0x55, 0x46, 0xa7,
-	0x24, 0xbd, 0x72, 0x15, 0x4b, 0x03, 0xf0, 0x0c, 0x4e, 0x5d, 0x11, 0x2b, 0x49, 0xfc, 0xac, 0x64,
-	0x49, 0xbe, 0x81, 0xcf, 0x0f, 0xc0, 0x02, 0x1f, 0xfa, 0x2e, 0x07, 0x57, 0xe7, 0x40, 0xf2, 0x55,
-	0x54, 0xcf, 0x76, 0x0b, 0xdf, 0x4c, 0x09, 0xfa, 0xec, 0xf1, 0xa5, 0x8c, 0xc3, 0x0c, 0xdb, 0xd5,
-	0x0a, 0x91, 0xe8, 0x4e, 0xa4, 0x29, 0xbf, 0x89, 0xa5, 0x04, 0xb9, 0x5c, 0x8c, 0x72, 0x23, 0x52,
-	0x1b, 0xc5, 0x62, 0x1b, 0x67, 0x86, 0xba, 0x15, 0xc4, 0xf9, 0xd4, 0xa2, 0x4d, 0x6b, 0xe3, 0x0d,
-	0x54, 0x7e, 0x0b, 0x17, 0x97, 0x99, 0xff, 0x8b, 0xbf, 0xf5, 0xe9, 0x46, 0x18, 0xed, 0x3f, 0x8e,
-	0x09, 0xfa, 0x78, 0x4a, 0xd0, 0xc3, 0x29, 0x41, 0x8f, 0x42, 0xd2, 0x13, 0x52, 0xe8, 0x25, 0x11,
-	0xbf, 0x3a, 0x21, 0x3f, 0xa0, 0x70, 0x14, 0xda, 0x37, 0x61, 0xdc, 0x78, 0x57, 0xb7, 0x41, 0x79,
-	0x31, 0x49, 0xa2, 0xf2, 0x5e, 0x62, 0x98, 0x14, 0xc3, 0x0d, 0x12, 0xb9, 0x6a, 0xef, 0xad, 0x66,
-	0x56, 0xb1, 0xc1, 0x4e, 0x4a, 0x6e, 0xaf, 0x46, 0x54, 0x09, 0x13, 0x98, 0xd4, 0xf4, 0xd6, 0x24,
-	0x52, 0x89, 0x52, 0x17, 0xa6, 0x11, 0x0c, 0xf1, 0x02, 0xed, 0xce, 0x62, 0x0e, 0x95, 0x38, 0x46,
-	0x61, 0xce, 0xda, 0x77, 0x4f, 0x23, 0xf5, 0x78, 0x46, 0x8a, 0x07, 0x30, 0x6e, 0x27, 0xc1, 0x9b,
-	0x5f, 0x66, 0x16, 0x3e, 0x25, 0x1d, 0x97, 0xca, 0xdf, 0x23, 0x5c, 0xda, 0xe3, 0xa0, 0xfb, 0xb0,
-	0x30, 0xf1, 0xeb, 0xe3, 0x53, 0x2e, 0x25, 0xea, 0x9a, 0xf8, 0xb0, 0xd5, 0x46, 0xcf, 0xa7, 0xe4,
-	0x75, 0x0d, 0x3c, 0x16, 0x70, 0x03, 0x7a, 0x30, 0x04, 0x8b, 0xb9, 0xc0, 0x23, 0xbd, 0xd2, 0x11,
-	0xd0, 0xb7, 0x75, 0x47, 0x37, 0x41, 0x59, 0x7e, 0xc7, 0xc9, 0x09, 0xc9, 0xee, 0xc7, 0x23, 0xf3,
-	0xed, 0x8c, 0x14, 0x97, 0xfb, 0x9f, 0xfc, 0xf8, 0xdb, 0xe7, 0x1b, 0xa4, 0x76, 0x51, 0x35, 0x04,
-	0x9f, 0x9a, 0xf8, 0x0a, 0xb7, 0xd0, 0x55, 0xf9, 0x0b, 0x84, 0x4b, 0xd1, 0x7f, 0x78, 0x46, 0xf2,
-	0x0f, 0xce, 0x4c, 0x3e, 0x27, 0x8b, 0x62, 0xbe, 0x8e, 0xec, 0x9e, 0x3b, 0xd0, 0xff, 0x4f, 0xb2,
-	0x40, 0xdc, 0xbf, 0x4c, 0xf6, 0x08, 0xe1, 0x0b, 0xfb, 0x0f, 0xd8, 0xe8, 0xdf, 0x70, 0xbd, 0xa4,
-	0x5e, 0xdb, 0x7f, 0x3e, 0x25, 0xd7, 0xff, 0x19, 0xee, 0x7d, 0x0a, 0xa3, 0xf5, 0x68, 0x3b, 0xb5,
-	0x92, 0xea, 0x3d, 0x60, 0xa3, 0x25, 0xb0, 0x6b, 0xa8, 0x7b, 0xf9, 0xf0, 0xd7, 0x4a, 0xea, 0x70,
-	0x52, 0x41, 0x4f, 0x27, 0x15, 0xf4, 0xcb, 0xa4, 0x82, 0x1e, 0x1e, 0x55, 0x52, 0x4f, 0x8f, 0x2a,
-	0xa9, 0x9f, 0x8f, 0x2a, 0xa9, 0xfb, 0x5b, 0x82, 0xe3, 0xfa, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x25, 0x5d, 0x4b, 0xbe, 0x8b, 0x07, 0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x3f, 0xb0, 0xc7, 0x76, 0x63, 0x4f, 0x43, 0x34, 0xb5, 0x52, 0xc7, 0xb2, 0x90,
+	0xb0, 0x22, 0xcb, 0x2e, 0x6e, 0x91, 0xc0, 0x92, 0x91, 0x6c, 0xc7, 0x87, 0xa8, 0x49, 0x1d, 0x6d,
+	0xd2, 0xf6, 0x68, 0x6d, 0xd7, 0x8f, 0xcd, 0x28, 0xbb, 0x3b, 0xab, 0xdd, 0x75, 0x8c, 0x39, 0x21,
+	0x8e, 0x9c, 0x2a, 0x38, 0x50, 0x90, 0x90, 0x38, 0xa2, 0x9e, 0x50, 0x2f, 0x48, 0xfd, 0x0b, 0x72,
+	0xe0, 0x50, 0x89, 0x0b, 0xa7, 0x0a, 0x12, 0x0e, 0xa8, 0x27, 0xa4, 0x38, 0x11, 0xe2, 0x84, 0x76,
+	0x76, 0xeb, 0x6c, 0x9c, 0x2d, 0x82, 0x5e, 0xb8, 0xcd, 0xbc, 0xf7, 0x7d, 0x33, 0xdf, 0x7b, 0xfb,
+	0xbd, 0x59, 0x9c, 0x97, 0x35, 0xb0, 0x1c, 0x93, 0x6b, 0x4c, 0x99, 0xd4, 0x4c, 0x8b, 0x3b, 0x9c,
+	0xa4, 0x60, 0xa8, 0x82, 0x58, 0x16, 0x56, 0x54, 0xce, 0x55, 0x0d, 0xea, 0xb2, 0xc9, 0xea, 0xb2,
+	0x61, 0x70, 0x47, 0x76, 0x18, 0x37, 0x6c, 0x0f, 0x58, 0xc8, 0x58, 0x60, 0x8f, 0x34, 0xc7, 0xdf,
+	0x5d, 0x77, 0x38, 0xd7, 0xec, 0xba, 0xd8, 0xa8, 0x60, 0xcc, 0x16, 0x7e, 0x7a, 0x49, 0xe5, 0x2a,
+	0x17, 0xcb, 0xba, 0xbb, 0xf2, 0xa2, 0x65, 0x8e, 0xaf, 0xb4, 0x5d, 0x01, 0xdb, 0x42, 0xc0, 0x6d,
+	0x98, 0x90, 0x1b, 0x38, 0xc3, 0x2d, 0x55, 0x36, 0xd8, 0xc7, 0xe2, 0x2e, 0x8a, 0x4a, 0xa8, 0x92,
+	0xea, 0x64, 0x9e, 0x9e, 0xd1, 0xa4, 0x90, 0xca, 0x2d, 0x55, 0xba, 0x80, 0x20, 0xd7, 0x71, 0xcc,
+	0x90, 0x75, 0xa0, 0x0b, 0x02, 0x99, 0x7a, 0x7a, 0x46, 0xe3, 0x02, 0x29, 0x89, 0x70, 0x33, 0xf3,
+	0xfb, 0x09, 0x45, 0x7f, 0x9e, 0x50, 0xf4, 0xfd, 0xb7, 0xab, 0xa8, 0xfc, 0x18, 0xe1, 0xa5, 0xc0,
+	0x8d, 0x5d, 0x6e, 0x0c, 0x99, 0x38, 0x85, 0xe0, 0x18, 0x7c, 0x64, 0x5a, 0xde, 0x7d, 0x92, 0x58,
+	0x93, 0x26, 0xc6, 0x0a, 0xd7, 0x4d, 0xd9, 0x62, 0x36, 0x37, 0xc4, 0xf9, 0x57, 0x1a, 0x85, 0xda,
+	0xac, 0x3d, 0x35, 0x71, 0x50, 0x77, 0x86, 0x90, 0x02, 0x68, 0xb2, 0x82, 0x53, 0xce, 0x9e, 0x05,
+	0xf6, 0x1e, 0xd7, 0x86, 0x34, 0x5a, 0x42, 0x15, 0x24, 0x9d, 0x07, 0xc8, 0x5b, 0x38, 0x31, 0x66,
+	0xc6, 0x90, 0x8f, 0x69, 0xac, 0x84, 0x2a, 0xd1, 0x4e, 0xe6, 0xaf, 0xe7, 0xab, 0xc9, 0xf5, 0x91,
+	0x25, 0x2a, 0x92, 0xfc, 0x5c, 0xf9, 0x9b, 0x24, 0x4e, 0x07, 0xc4, 0x92, 0x65, 0x9c, 0xf8, 0x90,
+	0x81, 0x36, 0xb4, 0x29, 0x2a, 0x45, 0x2b, 0x29, 0xc9, 0xdf, 0x91, 0x77, 0x70, 0x74, 0x1f, 0x26,
+	0x42, 0x60, 0xba, 0x71, 0x6d, 0x5e, 0xe0, 0xac, 0xb7, 0x9d, 0xd8, 0xe1, 0xf3, 0xd5, 0x88, 0xe4,
+	0x62, 0x49, 0x03, 0xbf, 0xa9, 0x98, 0xa3, 0xc1, 0xc8, 0x61, 0x9a, 0xdf, 0xc7, 0x81, 0xc6, 0x74,
+	0xe6, 0x08, 0xa9, 0x59, 0xe9, 0xaa, 0x62, 0x8e, 0xee, 0x9e, 0xe7, 0x36, 0xdd, 0x94, 0xcb, 0xd1,
+	0x41, 0x0f, 0xe1, 0xc4, 0x3c, 0x8e, 0x0e, 0xfa, 0x25, 0xce, 0x2d, 0xbc, 0x3c, 0x64, 0xf6, 0x7e,
+	0x08, 0x29, 0x2e, 0x48, 0x4b, 0x6e, 0xf6, 0x12, 0x6b, 0x0d, 0xe7, 0x65, 0xc5, 0x61, 0x07, 0x30,
+	0x50, 0xb8, 0xf1, 0x92, 0x90, 0x10, 0x84, 0x45, 0x2f, 0xd1, 0xe5, 0x86, 0x8f, 0x2d, 0xe0, 0xa4,
+	0x0d, 0x07, 0x60, 0x31, 0x67, 0x42, 0xdf, 0x10, 0x1f, 0x6f, 0xb6, 0x27, 0x75, 0x9c, 0x71, 0x2c,
+	0xa6, 0xaa, 0x60, 0x0d, 0x1c, 0xa6, 0x03, 0x4d, 0x86, 0x34, 0x3b, 0xed, 0x23, 0x76, 0x99, 0x0e,
+	0xa4, 0x89, 0x13, 0x9a, 0xfc, 0x00, 0x34, 0x9b, 0xa6, 0x4a, 0xd1, 0x4a, 0xba, 0x51, 0x0e, 0x6f,
+	0x66, 0x6d, 0x53, 0x80, 0x7a, 0x86, 0x63, 0x4d, 0x24, 0x9f, 0x41, 0x36, 0x70, 0x3a, 0x30, 0x23,
+	0x14, 0x8b, 0x03, 0xde, 0x7e, 0xc5, 0x01, 0xed, 0x73, 0xa4, 0x77, 0x4a, 0x90, 0x4b, 0x4a, 0x38,
+	0x3d, 0x04, 0x5b, 0xb1, 0x98, 0x29, 0x66, 0x20, 0x2d, 0xca, 0x0a, 0x86, 0xc8, 0x2d, 0x7c, 0x65,
+	0x08, 0x1a, 0x38, 0x30, 0x30, 0x2d, 0x30, 0x65, 0x0b, 0x68, 0xa6, 0x84, 0x2a, 0xc9, 0x4e, 0xf6,
+	0xbb, 0x29, 0x45, 0x9f, 0x3f, 0xb9, 0x16, 0x37, 0xb8, 0xa2, 0x9b, 0x52, 0xd6, 0x03, 0x6d, 0x7b,
+	0x18, 0xd2, 0x73, 0x0d, 0xed, 0x3b, 0xde, 0xa6, 0x59, 0xa1, 0x70, 0x35, 0x5c, 0xe1, 0x6c, 0x32,
+	0x7c, 0xd7, 0x04, 0x88, 0x85, 0xf7, 0x71, 0x3a, 0xd0, 0x00, 0x92, 0xf3, 0xec, 0xe7, 0x4d, 0x8e,
+	0x70, 0xd7, 0x12, 0x8e, 0x1f, 0xc8, 0xda, 0xc8, 0x9f, 0x49, 0xc9, 0xdb, 0x34, 0x17, 0xde, 0x43,
+	0x85, 0x0f, 0x70, 0x6e, 0xbe, 0xf4, 0xff, 0xc2, 0x6f, 0x7e, 0xb6, 0xe0, 0x8e, 0xf3, 0x1f, 0x27,
+	0x14, 0x7d, 0x32, 0xa5, 0xe8, 0xe1, 0x94, 0xa2, 0x47, 0x6e, 0xc1, 0xa7, 0x34, 0xbb, 0x1e, 0xac,
+	0xf4, 0xeb, 0x53, 0xfa, 0x23, 0x72, 0xc7, 0xbf, 0x75, 0x1b, 0x26, 0xb5, 0x3b, 0xb2, 0x0e, 0xd5,
+	0x97, 0xaf, 0x87, 0x88, 0xf4, 0x03, 0x0f, 0x48, 0x55, 0x31, 0x47, 0x01, 0x7b, 0xb6, 0xba, 0x97,
+	0xad, 0x5f, 0xd5, 0x41, 0x0f, 0x42, 0xb6, 0x2e, 0x3b, 0xbd, 0xea, 0x1a, 0x39, 0x88, 0x59, 0x0f,
+	0x31, 0x76, 0xd5, 0x33, 0xaf, 0x6b, 0x6a, 0x50, 0x44, 0x07, 0x5a, 0xed, 0x8b, 0x76, 0xae, 0xfa,
+	0x6e, 0x74, 0xed, 0xda, 0xda, 0x3d, 0x77, 0xe6, 0x93, 0x33, 0x9a, 0xdb, 0x87, 0x49, 0x2b, 0x28,
+	0x7c, 0x6d, 0x03, 0x2f, 0xce, 0x3d, 0x41, 0xa4, 0x80, 0x97, 0xdb, 0x9b, 0x3d, 0x69, 0x77, 0xd0,
+	0xed, 0x6f, 0x6d, 0xb7, 0xa5, 0x8d, 0x9d, 0xfe, 0x9d, 0x41, 0xbb, 0xd3, 0xbf, 0xd7, 0xcb, 0x45,
+	0x42, 0x73, 0x9d, 0xde, 0x66, 0xff, 0x7e, 0x0e, 0x35, 0xbe, 0x8a, 0x5f, 0x78, 0x89, 0xdb, 0x26,
+	0x23, 0x3f, 0x20, 0x9c, 0xef, 0x5a, 0x20, 0x3b, 0x70, 0xe1, 0x0d, 0x0a, 0xb7, 0x4b, 0x21, 0x1f,
+	0x88, 0x4b, 0xe2, 0xbf, 0x50, 0x1e, 0xbf, 0x98, 0xd2, 0x77, 0x25, 0xb0, 0xf9, 0xc8, 0x52, 0x60,
+	0x1d, 0x0e, 0x40, 0xe3, 0x26, 0x58, 0x1e, 0xbe, 0xda, 0x16, 0xf5, 0x6f, 0xc9, 0x86, 0xac, 0x42,
+	0x75, 0xfe, 0x93, 0x1c, 0x9d, 0xd2, 0xe4, 0x8e, 0x3f, 0xc4, 0x8f, 0xcf, 0x68, 0x6e, 0x3e, 0xff,
+	0xe9, 0x4f, 0xbf, 0x7d, 0xb1, 0x40, 0xcb, 0x57, 0xeb, 0x8a, 0xd0, 0x57, 0x0f, 0xfc, 0xc4, 0x9a,
+	0x68, 0x8d, 0x7c, 0x89, 0x70, 0xde, 0xb3, 0xc3, 0x6b, 0x2a, 0xbf, 0xff, 0xda, 0xca, 0x67, 0xca,
+	0xbc, 0xc1, 0x0b, 0x53, 0x76, 0xd7, 0x1c, 0xca, 0xff, 0xa7, 0xb2, 0x91, 0xb8, 0x7f, 0x5e, 0xd9,
+	0x23, 0x84, 0x17, 0x77, 0xf6, 0xf8, 0xf8, 0xdf, 0xe8, 0x7a, 0x45, 0xbc, 0xbc, 0xf3, 0x62, 0x4a,
+	0x6f, 0xfe, 0xb3, 0xb8, 0x7b, 0x0c, 0xc6, 0xe1, 0xd2, 0x96, 0xcb, 0xf9, 0xba, 0xbd, 0xc7, 0xc7,
+	0x73, 0xc2, 0x6e, 0xa0, 0xce, 0xca, 0xe1, 0xaf, 0xc5, 0xc8, 0xe1, 0x51, 0x11, 0x3d, 0x3b, 0x2a,
+	0xa2, 0x5f, 0x8e, 0x8a, 0xe8, 0xe1, 0x71, 0x31, 0xf2, 0xec, 0xb8, 0x18, 0xf9, 0xf9, 0xb8, 0x18,
+	0x79, 0x90, 0x10, 0x3a, 0x6e, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x45, 0x51, 0xff, 0x3d, 0xca,
+	0x08, 0x00, 0x00,
 }
 
 func (this *AlertPolicyKey) GoString() string {
@@ -485,6 +575,52 @@ func (m *AlertPolicyKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlertPolicyCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlertPolicyCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlertPolicyCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintAlertpolicy(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Threshold))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Comparison != 0 {
+		i = encodeVarintAlertpolicy(dAtA, i, uint64(m.Comparison))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Expr) > 0 {
+		i -= len(m.Expr)
+		copy(dAtA[i:], m.Expr)
+		i = encodeVarintAlertpolicy(dAtA, i, uint64(len(m.Expr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlertPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -505,6 +641,20 @@ func (m *AlertPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAlertpolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.DeletePrepare {
 		i--
 		if m.DeletePrepare {
@@ -725,6 +875,51 @@ func (m *AlertPolicyKey) ValidateEnums() error {
 func (s *AlertPolicyKey) ClearTagged(tags map[string]struct{}) {
 }
 
+func (m *AlertPolicyCondition) Clone() *AlertPolicyCondition {
+	cp := &AlertPolicyCondition{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AlertPolicyCondition) CopyInFields(src *AlertPolicyCondition) int {
+	changed := 0
+	if m.Expr != src.Expr {
+		m.Expr = src.Expr
+		changed++
+	}
+	if m.Comparison != src.Comparison {
+		m.Comparison = src.Comparison
+		changed++
+	}
+	if m.Threshold != src.Threshold {
+		m.Threshold = src.Threshold
+		changed++
+	}
+	if m.Window != src.Window {
+		m.Window = src.Window
+		changed++
+	}
+	return changed
+}
+
+func (m *AlertPolicyCondition) DeepCopyIn(src *AlertPolicyCondition) {
+	m.Expr = src.Expr
+	m.Comparison = src.Comparison
+	m.Threshold = src.Threshold
+	m.Window = src.Window
+}
+
+// Helper method to check that enums have valid values
+func (m *AlertPolicyCondition) ValidateEnums() error {
+	if _, ok := AlertComparison_name[int32(m.Comparison)]; !ok {
+		return errors.New("invalid Comparison")
+	}
+	return nil
+}
+
+func (s *AlertPolicyCondition) ClearTagged(tags map[string]struct{}) {
+}
+
 func (m *AlertPolicy) Matches(o *AlertPolicy, fopts ...MatchOpt) bool {
 	opts := MatchOptions{}
 	applyMatchOptions(&opts, fopts...)
@@ -815,6 +1010,15 @@ func (m *AlertPolicy) Matches(o *AlertPolicy, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.Conditions != nil {
+		if len(m.Conditions) == 0 && len(o.Conditions) > 0 || len(m.Conditions) > 0 && len(o.Conditions) == 0 {
+			return false
+		} else if m.Conditions != nil && o.Conditions != nil {
+			if !opts.Filter && len(m.Conditions) != len(o.Conditions) {
+				return false
+			}
+		}
+	}
 	return true
 }
 
@@ -835,6 +1039,11 @@ const AlertPolicyFieldAnnotationsKey = "10.1"
 const AlertPolicyFieldAnnotationsValue = "10.2"
 const AlertPolicyFieldDescription = "11"
 const AlertPolicyFieldDeletePrepare = "12"
+const AlertPolicyFieldConditions = "13"
+const AlertPolicyFieldConditionsExpr = "13.1"
+const AlertPolicyFieldConditionsComparison = "13.2"
+const AlertPolicyFieldConditionsThreshold = "13.3"
+const AlertPolicyFieldConditionsWindow = "13.4"
 
 var AlertPolicyAllFields = []string{
 	AlertPolicyFieldKeyOrganization,
@@ -851,6 +1060,10 @@ var AlertPolicyAllFields = []string{
 	AlertPolicyFieldAnnotationsValue,
 	AlertPolicyFieldDescription,
 	AlertPolicyFieldDeletePrepare,
+	AlertPolicyFieldConditionsExpr,
+	AlertPolicyFieldConditionsComparison,
+	AlertPolicyFieldConditionsThreshold,
+	AlertPolicyFieldConditionsWindow,
 }
 
 var AlertPolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
@@ -868,6 +1081,10 @@ var AlertPolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AlertPolicyFieldAnnotationsValue:     struct{}{},
 	AlertPolicyFieldDescription:          struct{}{},
 	AlertPolicyFieldDeletePrepare:        struct{}{},
+	AlertPolicyFieldConditionsExpr:       struct{}{},
+	AlertPolicyFieldConditionsComparison: struct{}{},
+	AlertPolicyFieldConditionsThreshold:  struct{}{},
+	AlertPolicyFieldConditionsWindow:     struct{}{},
 })
 
 var AlertPolicyAllFieldsStringMap = map[string]string{
@@ -885,6 +1102,10 @@ var AlertPolicyAllFieldsStringMap = map[string]string{
 	AlertPolicyFieldAnnotationsValue:     "Annotations Value",
 	AlertPolicyFieldDescription:          "Description",
 	AlertPolicyFieldDeletePrepare:        "Delete Prepare",
+	AlertPolicyFieldConditionsExpr:       "Conditions Expr",
+	AlertPolicyFieldConditionsComparison: "Conditions Comparison",
+	AlertPolicyFieldConditionsThreshold:  "Conditions Threshold",
+	AlertPolicyFieldConditionsWindow:     "Conditions Window",
 }
 
 func (m *AlertPolicy) IsKeyField(s string) bool {
@@ -962,6 +1183,28 @@ func (m *AlertPolicy) DiffFields(o *AlertPolicy, fields *FieldMap) {
 	if m.DeletePrepare != o.DeletePrepare {
 		fields.Set(AlertPolicyFieldDeletePrepare)
 	}
+	if len(m.Conditions) != len(o.Conditions) {
+		fields.Set(AlertPolicyFieldConditions)
+	} else {
+		for i0 := 0; i0 < len(m.Conditions); i0++ {
+			if m.Conditions[i0].Expr != o.Conditions[i0].Expr {
+				fields.Set(AlertPolicyFieldConditionsExpr)
+				fields.Set(AlertPolicyFieldConditions)
+			}
+			if m.Conditions[i0].Comparison != o.Conditions[i0].Comparison {
+				fields.Set(AlertPolicyFieldConditionsComparison)
+				fields.Set(AlertPolicyFieldConditions)
+			}
+			if m.Conditions[i0].Threshold != o.Conditions[i0].Threshold {
+				fields.Set(AlertPolicyFieldConditionsThreshold)
+				fields.Set(AlertPolicyFieldConditions)
+			}
+			if m.Conditions[i0].Window != o.Conditions[i0].Window {
+				fields.Set(AlertPolicyFieldConditionsWindow)
+				fields.Set(AlertPolicyFieldConditions)
+			}
+		}
+	}
 }
 
 func (m *AlertPolicy) GetDiffFields(o *AlertPolicy) *FieldMap {
@@ -984,6 +1227,11 @@ var UpdateAlertPolicyFieldsMap = NewFieldMap(map[string]struct{}{
 	AlertPolicyFieldAnnotationsKey:       struct{}{},
 	AlertPolicyFieldAnnotationsValue:     struct{}{},
 	AlertPolicyFieldDescription:          struct{}{},
+	AlertPolicyFieldConditions:           struct{}{},
+	AlertPolicyFieldConditionsExpr:       struct{}{},
+	AlertPolicyFieldConditionsComparison: struct{}{},
+	AlertPolicyFieldConditionsThreshold:  struct{}{},
+	AlertPolicyFieldConditionsWindow:     struct{}{},
 })
 
 func (m *AlertPolicy) ValidateUpdateFields() error {
@@ -1019,6 +1267,37 @@ func (m *AlertPolicy) Clone() *AlertPolicy {
 	return cp
 }
 
+func (m *AlertPolicy) AddConditions(vals ...AlertPolicyCondition) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Conditions {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Conditions = append(m.Conditions, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AlertPolicy) RemoveConditions(vals ...AlertPolicyCondition) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Conditions); i >= 0; i-- {
+		if _, found := remove[m.Conditions[i].String()]; found {
+			m.Conditions = append(m.Conditions[:i], m.Conditions[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
 func (m *AlertPolicy) CopyInFields(src *AlertPolicy) int {
 	updateListAction := "replace"
 	changed := 0
@@ -1137,6 +1416,24 @@ func (m *AlertPolicy) CopyInFields(src *AlertPolicy) int {
 			changed++
 		}
 	}
+	if fmap.HasOrHasChild("13") {
+		if src.Conditions != nil {
+			if updateListAction == "add" {
+				changed += m.AddConditions(src.Conditions...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveConditions(src.Conditions...)
+			} else {
+				m.Conditions = make([]AlertPolicyCondition, 0)
+				for k0, _ := range src.Conditions {
+					m.Conditions = append(m.Conditions, *src.Conditions[k0].Clone())
+				}
+				changed++
+			}
+		} else if m.Conditions != nil {
+			m.Conditions = nil
+			changed++
+		}
+	}
 	return changed
 }
 
@@ -1166,6 +1463,14 @@ func (m *AlertPolicy) DeepCopyIn(src *AlertPolicy) {
 	}
 	m.Description = src.Description
 	m.DeletePrepare = src.DeletePrepare
+	if src.Conditions != nil {
+		m.Conditions = make([]AlertPolicyCondition, len(src.Conditions), len(src.Conditions))
+		for ii, s := range src.Conditions {
+			m.Conditions[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Conditions = nil
+	}
 }
 
 func (s *AlertPolicy) HasFields() bool {
@@ -1833,6 +2138,11 @@ func (m *AlertPolicy) ValidateEnums() error {
 	if err := m.Key.ValidateEnums(); err != nil {
 		return err
 	}
+	for _, e := range m.Conditions {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1841,6 +2151,11 @@ func (s *AlertPolicy) ClearTagged(tags map[string]struct{}) {
 	if _, found := tags["nocmp"]; found {
 		s.DeletePrepare = false
 	}
+	if s.Conditions != nil {
+		for ii := 0; ii < len(s.Conditions); ii++ {
+			s.Conditions[ii].ClearTagged(tags)
+		}
+	}
 }
 
 func IgnoreAlertPolicyFields(taglist string) cmp.Option {
@@ -1855,6 +2170,116 @@ func IgnoreAlertPolicyFields(taglist string) cmp.Option {
 	return cmpopts.IgnoreFields(AlertPolicy{}, names...)
 }
 
+var AlertComparisonStrings = []string{
+	"ALERT_COMPARISON_ABOVE",
+	"ALERT_COMPARISON_BELOW",
+}
+
+const (
+	AlertComparisonALERT_COMPARISON_ABOVE uint64 = 1 << 0
+	AlertComparisonALERT_COMPARISON_BELOW uint64 = 1 << 1
+)
+
+var AlertComparison_CamelName = map[int32]string{
+	// ALERT_COMPARISON_ABOVE -> AlertComparisonAbove
+	0: "AlertComparisonAbove",
+	// ALERT_COMPARISON_BELOW -> AlertComparisonBelow
+	1: "AlertComparisonBelow",
+}
+var AlertComparison_CamelValue = map[string]int32{
+	"AlertComparisonAbove": 0,
+	"AlertComparisonBelow": 1,
+}
+
+func ParseAlertComparison(data interface{}) (AlertComparison, error) {
+	if val, ok := data.(AlertComparison); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := AlertComparison_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = AlertComparison_CamelValue["AlertComparison"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = AlertComparison_CamelName[val]
+			}
+		}
+		if !ok {
+			return AlertComparison(0), fmt.Errorf("Invalid AlertComparison value %q", str)
+		}
+		return AlertComparison(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := AlertComparison_CamelName[ival]; ok {
+			return AlertComparison(ival), nil
+		} else {
+			return AlertComparison(0), fmt.Errorf("Invalid AlertComparison value %d", ival)
+		}
+	}
+	return AlertComparison(0), fmt.Errorf("Invalid AlertComparison value %v", data)
+}
+
+func (e *AlertComparison) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseAlertComparison(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e AlertComparison) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(AlertComparison_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AlertComparison")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *AlertComparison) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseAlertComparison(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(AlertComparison(0)),
+			}
+		}
+		*e = AlertComparison(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseAlertComparison(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(AlertComparison(0)),
+	}
+}
+
+func (e AlertComparison) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(AlertComparison_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "AlertComparison")
+	return json.Marshal(str)
+}
+
+var AlertComparisonCommonPrefix = "AlertComparison"
+
 func (m *AlertPolicy) IsValidArgsForCreateAlertPolicy() error {
 	if m.DeletePrepare != false {
 		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
@@ -1893,6 +2318,28 @@ func (m *AlertPolicyKey) Size() (n int) {
 	return n
 }
 
+func (m *AlertPolicyCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expr)
+	if l > 0 {
+		n += 1 + l + sovAlertpolicy(uint64(l))
+	}
+	if m.Comparison != 0 {
+		n += 1 + sovAlertpolicy(uint64(m.Comparison))
+	}
+	if m.Threshold != 0 {
+		n += 9
+	}
+	if m.Window != 0 {
+		n += 1 + sovAlertpolicy(uint64(m.Window))
+	}
+	return n
+}
+
 func (m *AlertPolicy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1949,6 +2396,12 @@ func (m *AlertPolicy) Size() (n int) {
 	if m.DeletePrepare {
 		n += 2
 	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovAlertpolicy(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2072,6 +2525,137 @@ func (m *AlertPolicyKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlertPolicyCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAlertpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlertPolicyCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlertPolicyCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
+			}
+			m.Comparison = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Comparison |= AlertComparison(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Threshold = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAlertpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlertPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2599,6 +3183,40 @@ func (m *AlertPolicy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DeletePrepare = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAlertpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAlertpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, AlertPolicyCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAlertpolicy(dAtA[iNdEx:])
@@ -1944,6 +1944,9 @@ func IgnoreAllDataFields(taglist string) cmp.Option {
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "ClusterInsts.InfraAnnotations")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "ClusterInsts.DryRun")
+	}
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "Apps.AuthPublicKey")
 	}
@@ -1962,6 +1965,9 @@ func IgnoreAllDataFields(taglist string) cmp.Option {
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "Apps.CompatibilityVersion")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "Apps.RolloutStatus")
+	}
 	if _, found := tags["timestamp"]; found {
 		names = append(names, "AppInstances.CreatedAt")
 	}
@@ -1989,6 +1995,12 @@ func IgnoreAllDataFields(taglist string) cmp.Option {
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "AppInstances.DbModelId")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "AppInstances.RolloutStatus")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "AppInstances.DryRun")
+	}
 	if _, found := tags["timestamp"]; found {
 		names = append(names, "VmPools.Vms.UpdatedAt")
 	}
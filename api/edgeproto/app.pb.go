@@ -5,6 +5,7 @@ package edgeproto
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	"encoding/json"
 	"errors"
 	fmt "fmt"
@@ -240,6 +241,178 @@ func (AccessType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e0f9056a14b86d47, []int{4}
 }
 
+// FindCloudletRanking
+//
+// # FindCloudletRanking specifies how FindCloudlet ranks the AppInsts of an App
+//
+// 0: `RANK_BY_DISTANCE`
+// 1: `RANK_BY_LATENCY`
+// 2: `RANK_BY_LOAD`
+// 3: `RANK_BY_WEIGHTED`
+type FindCloudletRanking int32
+
+const (
+	// Closest AppInst to the client first
+	FindCloudletRanking_RANK_BY_DISTANCE FindCloudletRanking = 0
+	// Lowest average latency reported by clients first, AppInsts without latency samples are estimated by distance
+	FindCloudletRanking_RANK_BY_LATENCY FindCloudletRanking = 1
+	// Fewest active client connections first, ties go to the closest AppInst
+	FindCloudletRanking_RANK_BY_LOAD FindCloudletRanking = 2
+	// Lowest weighted sum of distance, latency, and load first
+	FindCloudletRanking_RANK_BY_WEIGHTED FindCloudletRanking = 3
+)
+
+var FindCloudletRanking_name = map[int32]string{
+	0: "RANK_BY_DISTANCE",
+	1: "RANK_BY_LATENCY",
+	2: "RANK_BY_LOAD",
+	3: "RANK_BY_WEIGHTED",
+}
+
+var FindCloudletRanking_value = map[string]int32{
+	"RANK_BY_DISTANCE": 0,
+	"RANK_BY_LATENCY":  1,
+	"RANK_BY_LOAD":     2,
+	"RANK_BY_WEIGHTED": 3,
+}
+
+func (x FindCloudletRanking) String() string {
+	return proto.EnumName(FindCloudletRanking_name, int32(x))
+}
+
+func (FindCloudletRanking) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{5}
+}
+
+// UpdateStrategy
+//
+// # UpdateStrategy specifies how AppInsts are updated to a new App revision
+//
+// 0: `UPDATE_IN_PLACE`
+// 1: `UPDATE_BLUE_GREEN`
+// 2: `UPDATE_CANARY`
+type UpdateStrategy int32
+
+const (
+	// Replace the running instance in place
+	UpdateStrategy_UPDATE_IN_PLACE UpdateStrategy = 0
+	// Bring up the new version alongside the old, switch traffic once healthy
+	UpdateStrategy_UPDATE_BLUE_GREEN UpdateStrategy = 1
+	// Send a percentage of traffic to the new version, promote once healthy
+	UpdateStrategy_UPDATE_CANARY UpdateStrategy = 2
+)
+
+var UpdateStrategy_name = map[int32]string{
+	0: "UPDATE_IN_PLACE",
+	1: "UPDATE_BLUE_GREEN",
+	2: "UPDATE_CANARY",
+}
+
+var UpdateStrategy_value = map[string]int32{
+	"UPDATE_IN_PLACE":   0,
+	"UPDATE_BLUE_GREEN": 1,
+	"UPDATE_CANARY":     2,
+}
+
+func (x UpdateStrategy) String() string {
+	return proto.EnumName(UpdateStrategy_name, int32(x))
+}
+
+func (UpdateStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{6}
+}
+
+// RolloutBatchBy
+//
+// # RolloutBatchBy specifies how AppInsts are grouped into rollout batches
+//
+// 0: `BATCH_BY_CLOUDLET`
+// 1: `BATCH_BY_ZONE`
+type RolloutBatchBy int32
+
+const (
+	// Group AppInsts by Cloudlet
+	RolloutBatchBy_BATCH_BY_CLOUDLET RolloutBatchBy = 0
+	// Group AppInsts by Zone
+	RolloutBatchBy_BATCH_BY_ZONE RolloutBatchBy = 1
+)
+
+var RolloutBatchBy_name = map[int32]string{
+	0: "BATCH_BY_CLOUDLET",
+	1: "BATCH_BY_ZONE",
+}
+
+var RolloutBatchBy_value = map[string]int32{
+	"BATCH_BY_CLOUDLET": 0,
+	"BATCH_BY_ZONE":     1,
+}
+
+func (x RolloutBatchBy) String() string {
+	return proto.EnumName(RolloutBatchBy_name, int32(x))
+}
+
+func (RolloutBatchBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{7}
+}
+
+// RolloutState
+//
+// # RolloutState is the state of a staged rollout for an App or AppInst
+//
+// 0: `ROLLOUT_NONE`
+// 1: `ROLLOUT_PENDING`
+// 2: `ROLLOUT_IN_PROGRESS`
+// 3: `ROLLOUT_PAUSED`
+// 4: `ROLLOUT_DONE`
+// 5: `ROLLOUT_FAILED`
+// 6: `ROLLOUT_ABORTED`
+type RolloutState int32
+
+const (
+	// No rollout
+	RolloutState_ROLLOUT_NONE RolloutState = 0
+	// Waiting for an earlier batch
+	RolloutState_ROLLOUT_PENDING RolloutState = 1
+	// Rollout in progress
+	RolloutState_ROLLOUT_IN_PROGRESS RolloutState = 2
+	// Rollout paused
+	RolloutState_ROLLOUT_PAUSED RolloutState = 3
+	// Rollout done
+	RolloutState_ROLLOUT_DONE RolloutState = 4
+	// Rollout failed
+	RolloutState_ROLLOUT_FAILED RolloutState = 5
+	// Rollout aborted
+	RolloutState_ROLLOUT_ABORTED RolloutState = 6
+)
+
+var RolloutState_name = map[int32]string{
+	0: "ROLLOUT_NONE",
+	1: "ROLLOUT_PENDING",
+	2: "ROLLOUT_IN_PROGRESS",
+	3: "ROLLOUT_PAUSED",
+	4: "ROLLOUT_DONE",
+	5: "ROLLOUT_FAILED",
+	6: "ROLLOUT_ABORTED",
+}
+
+var RolloutState_value = map[string]int32{
+	"ROLLOUT_NONE":        0,
+	"ROLLOUT_PENDING":     1,
+	"ROLLOUT_IN_PROGRESS": 2,
+	"ROLLOUT_PAUSED":      3,
+	"ROLLOUT_DONE":        4,
+	"ROLLOUT_FAILED":      5,
+	"ROLLOUT_ABORTED":     6,
+}
+
+func (x RolloutState) String() string {
+	return proto.EnumName(RolloutState_name, int32(x))
+}
+
+func (RolloutState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{8}
+}
+
 type GpuType int32
 
 const (
@@ -272,7 +445,7 @@ func (x GpuType) String() string {
 }
 
 func (GpuType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{5}
+	return fileDescriptor_e0f9056a14b86d47, []int{9}
 }
 
 // Application unique key
@@ -320,6 +493,151 @@ func (m *AppKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AppKey proto.InternalMessageInfo
 
+// FindCloudletRankingWeights
+//
+// Weights used to combine AppInst metrics for the weighted FindCloudlet ranking strategy
+type FindCloudletRankingWeights struct {
+	// Weight per km of distance to the client
+	Distance float64 `protobuf:"fixed64,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Weight per ms of average latency
+	Latency float64 `protobuf:"fixed64,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Weight per active client connection
+	Load float64 `protobuf:"fixed64,3,opt,name=load,proto3" json:"load,omitempty"`
+}
+
+func (m *FindCloudletRankingWeights) Reset()         { *m = FindCloudletRankingWeights{} }
+func (m *FindCloudletRankingWeights) String() string { return proto.CompactTextString(m) }
+func (*FindCloudletRankingWeights) ProtoMessage()    {}
+func (*FindCloudletRankingWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{1}
+}
+func (m *FindCloudletRankingWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindCloudletRankingWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindCloudletRankingWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindCloudletRankingWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindCloudletRankingWeights.Merge(m, src)
+}
+func (m *FindCloudletRankingWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindCloudletRankingWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindCloudletRankingWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindCloudletRankingWeights proto.InternalMessageInfo
+
+// RolloutPolicy
+//
+// RolloutPolicy controls how AppInsts are updated to a new App revision
+type RolloutPolicy struct {
+	// Update strategy, blue/green and canary fall back to in place on platforms that cannot switch traffic between versions
+	Strategy UpdateStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=edgeproto.UpdateStrategy" json:"strategy,omitempty"`
+	// Percentage of instance replicas and of AppInsts updated first for the canary strategy
+	CanaryPercent uint32 `protobuf:"varint,2,opt,name=canary_percent,json=canaryPercent,proto3" json:"canary_percent,omitempty"`
+	// Time to wait for the new version to become healthy before rolling back, defaults to 5m
+	HealthTimeout Duration `protobuf:"varint,3,opt,name=health_timeout,json=healthTimeout,proto3,casttype=Duration" json:"health_timeout,omitempty"`
+	// Time the new version must stay healthy while serving traffic before it is promoted, defaults to 1m
+	ObservationTime Duration `protobuf:"varint,6,opt,name=observation_time,json=observationTime,proto3,casttype=Duration" json:"observation_time,omitempty"`
+	// How AppInsts are grouped into batches for a staged rollout
+	BatchBy RolloutBatchBy `protobuf:"varint,4,opt,name=batch_by,json=batchBy,proto3,enum=edgeproto.RolloutBatchBy" json:"batch_by,omitempty"`
+	// Number of Cloudlets or Zones updated per batch, enables a staged rollout if set
+	BatchSize uint32 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (m *RolloutPolicy) Reset()         { *m = RolloutPolicy{} }
+func (m *RolloutPolicy) String() string { return proto.CompactTextString(m) }
+func (*RolloutPolicy) ProtoMessage()    {}
+func (*RolloutPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{2}
+}
+func (m *RolloutPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RolloutPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPolicy.Merge(m, src)
+}
+func (m *RolloutPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPolicy proto.InternalMessageInfo
+
+// RolloutStatus
+//
+// RolloutStatus shows the progress of a staged rollout
+type RolloutStatus struct {
+	// Rollout state
+	State RolloutState `protobuf:"varint,1,opt,name=state,proto3,enum=edgeproto.RolloutState" json:"state,omitempty"`
+	// Current batch of the App rollout, or the batch the AppInst is updated in
+	Batch uint32 `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	// Total number of batches
+	NumBatches uint32 `protobuf:"varint,3,opt,name=num_batches,json=numBatches,proto3" json:"num_batches,omitempty"`
+	// Address of the Controller running the App rollout
+	Controller string `protobuf:"bytes,4,opt,name=controller,proto3" json:"controller,omitempty"`
+	// App rollout updates AppInsts even if they are already at the App's revision
+	ForceUpdate bool `protobuf:"varint,5,opt,name=force_update,json=forceUpdate,proto3" json:"force_update,omitempty"`
+}
+
+func (m *RolloutStatus) Reset()         { *m = RolloutStatus{} }
+func (m *RolloutStatus) String() string { return proto.CompactTextString(m) }
+func (*RolloutStatus) ProtoMessage()    {}
+func (*RolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{3}
+}
+func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStatus.Merge(m, src)
+}
+func (m *RolloutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
+
 // ConfigFile
 type ConfigFile struct {
 	// Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml
@@ -332,7 +650,7 @@ func (m *ConfigFile) Reset()         { *m = ConfigFile{} }
 func (m *ConfigFile) String() string { return proto.CompactTextString(m) }
 func (*ConfigFile) ProtoMessage()    {}
 func (*ConfigFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{1}
+	return fileDescriptor_e0f9056a14b86d47, []int{4}
 }
 func (m *ConfigFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,6 +796,16 @@ type App struct {
 	ManagesOwnNamespaces bool `protobuf:"varint,55,opt,name=manages_own_namespaces,json=managesOwnNamespaces,proto3" json:"manages_own_namespaces,omitempty"`
 	// Internal compatibility version
 	CompatibilityVersion uint32 `protobuf:"varint,56,opt,name=compatibility_version,json=compatibilityVersion,proto3" json:"compatibility_version,omitempty"`
+	// Strategy used by FindCloudlet to rank AppInsts
+	FindCloudletRanking FindCloudletRanking `protobuf:"varint,57,opt,name=find_cloudlet_ranking,json=findCloudletRanking,proto3,enum=edgeproto.FindCloudletRanking" json:"find_cloudlet_ranking,omitempty"`
+	// Weights for the weighted FindCloudlet ranking strategy
+	FindCloudletRankingWeights *FindCloudletRankingWeights `protobuf:"bytes,58,opt,name=find_cloudlet_ranking_weights,json=findCloudletRankingWeights,proto3" json:"find_cloudlet_ranking_weights,omitempty"`
+	// Rollout policy for updating AppInsts
+	RolloutPolicy *RolloutPolicy `protobuf:"bytes,59,opt,name=rollout_policy,json=rolloutPolicy,proto3" json:"rollout_policy,omitempty"`
+	// Status of the current or last staged rollout
+	RolloutStatus *RolloutStatus `protobuf:"bytes,60,opt,name=rollout_status,json=rolloutStatus,proto3" json:"rollout_status,omitempty"`
+	// Default horizontal pod scaling policy for Kubernetes AppInsts
+	HorizontalScalePolicy *HorizontalScalePolicy `protobuf:"bytes,61,opt,name=horizontal_scale_policy,json=horizontalScalePolicy,proto3" json:"horizontal_scale_policy,omitempty"`
 	// Vendor-specific data
 	Tags map[string]string `protobuf:"bytes,100,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -486,7 +814,7 @@ func (m *App) Reset()         { *m = App{} }
 func (m *App) String() string { return proto.CompactTextString(m) }
 func (*App) ProtoMessage()    {}
 func (*App) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{2}
+	return fileDescriptor_e0f9056a14b86d47, []int{5}
 }
 func (m *App) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +858,7 @@ func (m *ServerlessConfig) Reset()         { *m = ServerlessConfig{} }
 func (m *ServerlessConfig) String() string { return proto.CompactTextString(m) }
 func (*ServerlessConfig) ProtoMessage()    {}
 func (*ServerlessConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{3}
+	return fileDescriptor_e0f9056a14b86d47, []int{6}
 }
 func (m *ServerlessConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +902,7 @@ func (m *GpuConfig) Reset()         { *m = GpuConfig{} }
 func (m *GpuConfig) String() string { return proto.CompactTextString(m) }
 func (*GpuConfig) ProtoMessage()    {}
 func (*GpuConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{4}
+	return fileDescriptor_e0f9056a14b86d47, []int{7}
 }
 func (m *GpuConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +943,7 @@ func (m *AppAutoProvPolicy) Reset()         { *m = AppAutoProvPolicy{} }
 func (m *AppAutoProvPolicy) String() string { return proto.CompactTextString(m) }
 func (*AppAutoProvPolicy) ProtoMessage()    {}
 func (*AppAutoProvPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{5}
+	return fileDescriptor_e0f9056a14b86d47, []int{8}
 }
 func (m *AppAutoProvPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +983,7 @@ func (m *AppAlertPolicy) Reset()         { *m = AppAlertPolicy{} }
 func (m *AppAlertPolicy) String() string { return proto.CompactTextString(m) }
 func (*AppAlertPolicy) ProtoMessage()    {}
 func (*AppAlertPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{6}
+	return fileDescriptor_e0f9056a14b86d47, []int{9}
 }
 func (m *AppAlertPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,6 +1012,47 @@ func (m *AppAlertPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AppAlertPolicy proto.InternalMessageInfo
 
+// AppRolloutRequest
+//
+// AppRolloutRequest identifies the App whose staged rollout is controlled
+type AppRolloutRequest struct {
+	// App key
+	AppKey AppKey `protobuf:"bytes,1,opt,name=app_key,json=appKey,proto3" json:"app_key"`
+}
+
+func (m *AppRolloutRequest) Reset()         { *m = AppRolloutRequest{} }
+func (m *AppRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*AppRolloutRequest) ProtoMessage()    {}
+func (*AppRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{10}
+}
+func (m *AppRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppRolloutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppRolloutRequest.Merge(m, src)
+}
+func (m *AppRolloutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppRolloutRequest proto.InternalMessageInfo
+
 type DeploymentZoneRequest struct {
 	// Discover potential App Zones
 	App *App `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
@@ -697,7 +1066,7 @@ func (m *DeploymentZoneRequest) Reset()         { *m = DeploymentZoneRequest{} }
 func (m *DeploymentZoneRequest) String() string { return proto.CompactTextString(m) }
 func (*DeploymentZoneRequest) ProtoMessage()    {}
 func (*DeploymentZoneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{7}
+	return fileDescriptor_e0f9056a14b86d47, []int{11}
 }
 func (m *DeploymentZoneRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,8 +1101,15 @@ func init() {
 	proto.RegisterEnum("edgeproto.VmAppOsType", VmAppOsType_name, VmAppOsType_value)
 	proto.RegisterEnum("edgeproto.DeleteType", DeleteType_name, DeleteType_value)
 	proto.RegisterEnum("edgeproto.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("edgeproto.FindCloudletRanking", FindCloudletRanking_name, FindCloudletRanking_value)
+	proto.RegisterEnum("edgeproto.UpdateStrategy", UpdateStrategy_name, UpdateStrategy_value)
+	proto.RegisterEnum("edgeproto.RolloutBatchBy", RolloutBatchBy_name, RolloutBatchBy_value)
+	proto.RegisterEnum("edgeproto.RolloutState", RolloutState_name, RolloutState_value)
 	proto.RegisterEnum("edgeproto.GpuType", GpuType_name, GpuType_value)
 	proto.RegisterType((*AppKey)(nil), "edgeproto.AppKey")
+	proto.RegisterType((*FindCloudletRankingWeights)(nil), "edgeproto.FindCloudletRankingWeights")
+	proto.RegisterType((*RolloutPolicy)(nil), "edgeproto.RolloutPolicy")
+	proto.RegisterType((*RolloutStatus)(nil), "edgeproto.RolloutStatus")
 	proto.RegisterType((*ConfigFile)(nil), "edgeproto.ConfigFile")
 	proto.RegisterType((*App)(nil), "edgeproto.App")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.App.AppAnnotationsEntry")
@@ -744,190 +1120,236 @@ func init() {
 	proto.RegisterType((*GpuConfig)(nil), "edgeproto.GpuConfig")
 	proto.RegisterType((*AppAutoProvPolicy)(nil), "edgeproto.AppAutoProvPolicy")
 	proto.RegisterType((*AppAlertPolicy)(nil), "edgeproto.AppAlertPolicy")
+	proto.RegisterType((*AppRolloutRequest)(nil), "edgeproto.AppRolloutRequest")
 	proto.RegisterType((*DeploymentZoneRequest)(nil), "edgeproto.DeploymentZoneRequest")
 }
 
 func init() { proto.RegisterFile("app.proto", fileDescriptor_e0f9056a14b86d47) }
 
 var fileDescriptor_e0f9056a14b86d47 = []byte{
-	// 2820 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x4a, 0xd4, 0x07, 0x47, 0x22, 0xb5, 0x1a, 0xcb, 0xf2, 0xe8, 0xc3, 0xb2, 0x4c, 0x3b,
-	0xf9, 0x2b, 0x0a, 0x2d, 0xd9, 0x4a, 0x62, 0x27, 0xfa, 0xff, 0xf3, 0x6f, 0x56, 0x12, 0x25, 0xab,
-	0xa2, 0x49, 0x7a, 0xa9, 0x8f, 0xb8, 0x68, 0xb1, 0x18, 0x71, 0x47, 0xd4, 0x46, 0xfb, 0x31, 0xde,
-	0x0f, 0xaa, 0xcc, 0xa9, 0x28, 0xd0, 0x43, 0x8b, 0xa2, 0x08, 0x52, 0xf4, 0x03, 0x41, 0x81, 0xb6,
-	0x08, 0x8a, 0xe6, 0xd8, 0xe6, 0xd2, 0x22, 0xc7, 0x9e, 0x8c, 0x1c, 0x8a, 0x00, 0xbd, 0x14, 0x3d,
-	0x04, 0x6d, 0xd2, 0x43, 0xa1, 0x53, 0x81, 0x48, 0x42, 0xdb, 0x53, 0x31, 0x33, 0xbb, 0xe4, 0x92,
-	0xa2, 0x81, 0xda, 0x09, 0xd0, 0xdb, 0xce, 0xef, 0xbd, 0x79, 0xf3, 0xe6, 0x37, 0xef, 0xcd, 0x7b,
-	0xb3, 0x20, 0x89, 0x29, 0x9d, 0xa7, 0xae, 0xe3, 0x3b, 0x30, 0x49, 0xf4, 0x2a, 0xe1, 0x9f, 0x13,
-	0x53, 0x55, 0xc7, 0xa9, 0x9a, 0x64, 0x01, 0x53, 0x63, 0x01, 0xdb, 0xb6, 0xe3, 0x63, 0xdf, 0x70,
-	0x6c, 0x4f, 0x28, 0x4e, 0x0c, 0xb9, 0xc4, 0x0b, 0x4c, 0x3f, 0x1c, 0x8d, 0x54, 0x4c, 0x27, 0xd0,
-	0x4d, 0xe2, 0x1f, 0x92, 0x7a, 0x04, 0xf9, 0x6e, 0xe0, 0xf9, 0xd4, 0x31, 0x8d, 0x4a, 0x04, 0x5d,
-	0xf6, 0x1d, 0xc7, 0xf4, 0x16, 0xf8, 0xa0, 0x4a, 0xec, 0xc6, 0x47, 0x64, 0x72, 0xdf, 0xc4, 0x35,
-	0xc7, 0x0d, 0x47, 0xc3, 0x2e, 0xf1, 0x9c, 0xc0, 0xad, 0x90, 0x68, 0xc5, 0x94, 0x4e, 0x2a, 0x86,
-	0x85, 0xcd, 0x70, 0x38, 0x5a, 0x75, 0xaa, 0x0e, 0xff, 0x5c, 0x60, 0x5f, 0x0d, 0x25, 0x8b, 0x2c,
-	0x98, 0x4e, 0x45, 0x0c, 0x33, 0xdf, 0x96, 0x40, 0x9f, 0x42, 0xe9, 0x26, 0xa9, 0xc3, 0x79, 0x30,
-	0xe4, 0xb8, 0x55, 0x6c, 0x1b, 0x6f, 0xf2, 0x7d, 0x20, 0x69, 0x46, 0x9a, 0x4d, 0x2e, 0x83, 0x0f,
-	0xce, 0x50, 0x1f, 0xa6, 0xd4, 0x71, 0xab, 0x6a, 0x8b, 0x1c, 0x4e, 0x82, 0x84, 0x8d, 0x2d, 0x82,
-	0xba, 0xb9, 0x5e, 0xff, 0x07, 0x67, 0xa8, 0x07, 0x53, 0xaa, 0x72, 0x10, 0x5e, 0x07, 0xfd, 0x35,
-	0xe2, 0x7a, 0xcc, 0x4e, 0x4f, 0x8b, 0x9d, 0x1a, 0x71, 0xd5, 0x48, 0xb4, 0x34, 0xf4, 0xb7, 0xcf,
-	0x90, 0xf4, 0x8f, 0xcf, 0x90, 0xf4, 0xab, 0x9f, 0x5d, 0x91, 0x32, 0x2f, 0x03, 0xb0, 0xe2, 0xd8,
-	0xfb, 0x46, 0x75, 0xcd, 0x30, 0x09, 0x84, 0x20, 0x71, 0x68, 0xd8, 0xba, 0x70, 0x43, 0xe5, 0xdf,
-	0x70, 0x0c, 0xf4, 0x55, 0xb8, 0x86, 0x58, 0x54, 0x0d, 0x47, 0x99, 0x1f, 0x20, 0xd0, 0xa3, 0x50,
-	0xca, 0xe4, 0xfb, 0x06, 0x31, 0x75, 0x0f, 0x49, 0x33, 0x3d, 0x4c, 0x2e, 0x46, 0xf0, 0x39, 0xd0,
-	0x73, 0x48, 0xea, 0x7c, 0xd2, 0xe0, 0xe2, 0xc8, 0x7c, 0xe3, 0x08, 0xe7, 0xc5, 0xd6, 0x97, 0x13,
-	0x8f, 0x3e, 0xbe, 0xd2, 0xa5, 0x32, 0x1d, 0x78, 0x0d, 0x00, 0xc3, 0xc2, 0x55, 0xa2, 0x51, 0xec,
-	0x1f, 0xa0, 0x04, 0xf7, 0x3d, 0xf1, 0xde, 0x09, 0x92, 0xd4, 0x24, 0xc7, 0x4b, 0xd8, 0x3f, 0x80,
-	0x2f, 0x44, 0x4a, 0x7e, 0x9d, 0x12, 0xd4, 0x3b, 0x23, 0xcd, 0xa6, 0x17, 0x47, 0x63, 0x66, 0x37,
-	0x98, 0x70, 0xab, 0x4e, 0x49, 0x38, 0x89, 0x7d, 0xc2, 0xab, 0x60, 0x08, 0x57, 0x2a, 0xc4, 0xf3,
-	0x34, 0xea, 0xb8, 0xbe, 0x87, 0xfa, 0xf9, 0x16, 0x06, 0x05, 0x56, 0x62, 0x10, 0xdc, 0x04, 0x69,
-	0x9d, 0xec, 0xe3, 0xc0, 0xf4, 0x35, 0x71, 0xd4, 0x28, 0xc9, 0x5d, 0x8e, 0xdb, 0x5e, 0xe3, 0x02,
-	0xe6, 0x75, 0xfa, 0xf8, 0x0c, 0xf5, 0x89, 0x21, 0xf7, 0x3f, 0x15, 0xce, 0x15, 0x10, 0xbc, 0x05,
-	0x86, 0x71, 0xe0, 0x1f, 0x68, 0x34, 0xd8, 0x33, 0x8d, 0x8a, 0xc6, 0x08, 0x18, 0xe2, 0xdb, 0x49,
-	0xbe, 0xfd, 0xfe, 0x78, 0xaf, 0xed, 0x54, 0x2c, 0xaa, 0xa6, 0x98, 0x46, 0x89, 0x2b, 0xb0, 0x10,
-	0x40, 0xa0, 0xbf, 0xe2, 0x58, 0x16, 0xb6, 0x75, 0x94, 0xe2, 0xde, 0x45, 0x43, 0xe6, 0x7c, 0xf8,
-	0xa9, 0x61, 0xb7, 0xea, 0xa1, 0x79, 0xce, 0xef, 0x60, 0x88, 0x29, 0x6e, 0xd5, 0x83, 0x33, 0x60,
-	0x30, 0x96, 0x05, 0x28, 0x1d, 0x6e, 0xaf, 0x09, 0xc1, 0xeb, 0x00, 0xe8, 0x84, 0x9a, 0x4e, 0xdd,
-	0x22, 0xb6, 0x8f, 0x86, 0x63, 0xdc, 0xc6, 0x70, 0xf8, 0x12, 0xb8, 0xd0, 0x1c, 0x69, 0x16, 0xb6,
-	0x8d, 0x7d, 0xe2, 0xf9, 0x48, 0x8e, 0xa9, 0xc3, 0xa6, 0xc2, 0xbd, 0x50, 0x0e, 0xef, 0x80, 0xd1,
-	0xd8, 0xb4, 0x2a, 0xb1, 0x89, 0x8b, 0x7d, 0xc7, 0x45, 0x23, 0xb1, 0x79, 0x31, 0xc3, 0xeb, 0x91,
-	0x02, 0xbc, 0x09, 0x46, 0xb1, 0xad, 0xbb, 0x8e, 0xa1, 0x6b, 0x14, 0x57, 0x0e, 0xd9, 0xb1, 0xf2,
-	0xb8, 0x86, 0x7c, 0x03, 0x30, 0x94, 0x95, 0x84, 0xa8, 0xc0, 0x82, 0x7b, 0x1e, 0xf4, 0xeb, 0xc4,
-	0xd4, 0x1c, 0xea, 0xa3, 0x51, 0x7e, 0xf6, 0x17, 0x63, 0xe7, 0xb3, 0x4a, 0x4c, 0xe2, 0x8b, 0xc3,
-	0xef, 0xd3, 0x89, 0x59, 0xa4, 0x3e, 0x5c, 0x60, 0xb4, 0xb2, 0x40, 0xf5, 0xd0, 0xc5, 0x99, 0x9e,
-	0xd9, 0xc1, 0x16, 0xfd, 0x66, 0xc8, 0xab, 0x91, 0x16, 0xcc, 0x02, 0xe8, 0x55, 0xb0, 0x49, 0xb4,
-	0x23, 0xc3, 0x3f, 0xd0, 0x2a, 0x66, 0xe0, 0xf9, 0xc4, 0x45, 0x63, 0x33, 0xd2, 0xec, 0x80, 0x2a,
-	0x73, 0xc9, 0xae, 0xe1, 0x1f, 0xac, 0x08, 0x1c, 0x3e, 0x03, 0xd2, 0x86, 0xed, 0x13, 0xd7, 0xc6,
-	0x66, 0x18, 0x5a, 0x97, 0xb8, 0x66, 0x2a, 0x42, 0x45, 0x70, 0x3d, 0x03, 0x06, 0x5c, 0x52, 0x33,
-	0x78, 0x4e, 0xa2, 0xf6, 0x40, 0x68, 0x88, 0xe0, 0x35, 0x90, 0x72, 0xf6, 0xf7, 0x8d, 0x8a, 0x81,
-	0x4d, 0x6d, 0xff, 0xa1, 0x6e, 0xa3, 0x71, 0xce, 0xc3, 0x50, 0x04, 0xae, 0x3d, 0xd4, 0x6d, 0x96,
-	0x68, 0x96, 0xfe, 0x92, 0x17, 0x58, 0x68, 0x42, 0x24, 0xa2, 0x18, 0xc1, 0x59, 0x20, 0xe3, 0xc0,
-	0x77, 0x34, 0xea, 0x3a, 0x35, 0x4d, 0x5c, 0x6d, 0x68, 0x8a, 0x6b, 0xa4, 0x19, 0x5e, 0x72, 0x9d,
-	0x5a, 0x89, 0xa3, 0xf0, 0x36, 0x08, 0x23, 0x5f, 0xe4, 0xd0, 0xe5, 0x73, 0x3c, 0x2a, 0x5c, 0xca,
-	0x79, 0x04, 0xb8, 0xf1, 0x0d, 0x9f, 0x67, 0x29, 0xc2, 0x18, 0xd6, 0xa8, 0x4b, 0x28, 0x76, 0x09,
-	0xba, 0xc2, 0x36, 0x1b, 0x1e, 0x70, 0x4a, 0xc8, 0x4a, 0x42, 0x04, 0x5f, 0x03, 0xb0, 0xcd, 0x1d,
-	0x83, 0x78, 0x68, 0x86, 0xc5, 0xee, 0x32, 0x3c, 0x3e, 0x43, 0x69, 0xa5, 0xc5, 0x29, 0x55, 0x6e,
-	0x71, 0xd2, 0x20, 0x1e, 0xbc, 0x01, 0xa0, 0x4f, 0x2c, 0x6a, 0x62, 0x9f, 0x68, 0x3a, 0x31, 0x0d,
-	0xcb, 0x60, 0x27, 0x71, 0x95, 0x6f, 0x69, 0x24, 0x92, 0xac, 0x46, 0x02, 0x98, 0x01, 0x29, 0xef,
-	0xd0, 0xa0, 0xda, 0x41, 0x25, 0x3c, 0x89, 0x8c, 0xc8, 0x02, 0x06, 0xde, 0xad, 0x88, 0x73, 0x78,
-	0x00, 0x40, 0xc5, 0x25, 0xd8, 0x27, 0xba, 0x86, 0x7d, 0x74, 0x8d, 0x27, 0xf8, 0xb5, 0x79, 0xdd,
-	0xf0, 0x7c, 0xd7, 0xd8, 0x0b, 0x18, 0x6c, 0x61, 0xbf, 0x72, 0xa0, 0x11, 0xbb, 0x6a, 0xd8, 0x64,
-	0x7e, 0xcb, 0xb0, 0x88, 0xe7, 0x63, 0x8b, 0x2e, 0x5f, 0x64, 0x5b, 0x7c, 0xfb, 0xfd, 0xf1, 0xa4,
-	0x1f, 0x41, 0x3c, 0xed, 0x93, 0xa1, 0x35, 0xc5, 0x67, 0xa6, 0x03, 0xaa, 0x47, 0xa6, 0xaf, 0x7f,
-	0x7e, 0xd3, 0xa1, 0x35, 0xc5, 0x67, 0x57, 0x03, 0xaf, 0x57, 0x44, 0x47, 0xcf, 0xf0, 0xe8, 0x8a,
-	0x86, 0x10, 0x83, 0xcb, 0x2e, 0x79, 0x18, 0x18, 0x2e, 0xd1, 0x35, 0x27, 0xf0, 0xf7, 0x9c, 0xc0,
-	0xd6, 0xb5, 0x8a, 0x63, 0xdb, 0xa4, 0x22, 0x6e, 0x82, 0x67, 0x79, 0xcc, 0x5f, 0x8a, 0x9d, 0x6d,
-	0x99, 0x54, 0x02, 0xd7, 0xf0, 0xeb, 0x6a, 0x60, 0x92, 0xf0, 0xf2, 0x9d, 0x8c, 0x6c, 0x14, 0x43,
-	0x13, 0x2b, 0x4d, 0x0b, 0xf0, 0x39, 0x20, 0x63, 0xd3, 0x74, 0x8e, 0x34, 0x8f, 0xb8, 0x35, 0xe2,
-	0x9a, 0xc4, 0xf3, 0xd0, 0xff, 0x70, 0x2f, 0x86, 0x39, 0x5e, 0x6e, 0xc0, 0xf0, 0x2e, 0x18, 0x69,
-	0x2a, 0x69, 0x61, 0xb5, 0x98, 0xe5, 0x4c, 0x4c, 0xb6, 0x78, 0x10, 0xe9, 0x88, 0xfc, 0x53, 0x65,
-	0xaf, 0x0d, 0x81, 0xff, 0x0b, 0xd2, 0x35, 0x4b, 0xc3, 0x94, 0x6a, 0x4e, 0x18, 0xa4, 0xcf, 0xf1,
-	0x20, 0x1d, 0x8b, 0x99, 0xd9, 0xb1, 0x14, 0x4a, 0x8b, 0x22, 0x4a, 0x07, 0x6b, 0xcd, 0x01, 0xbc,
-	0x0d, 0xd2, 0xd8, 0x24, 0xae, 0xdf, 0x8c, 0xba, 0x39, 0x1e, 0x75, 0xc3, 0xc7, 0x67, 0x68, 0x50,
-	0x61, 0x92, 0x30, 0xe4, 0x52, 0xb8, 0x31, 0x60, 0xf1, 0x96, 0x07, 0x17, 0x1e, 0x3a, 0x9e, 0xe6,
-	0x11, 0x8f, 0x25, 0x23, 0x0b, 0xdc, 0x7d, 0xc3, 0x24, 0xe8, 0x79, 0xbe, 0xf2, 0x54, 0x6c, 0xe5,
-	0xfb, 0x8e, 0x57, 0x16, 0x4a, 0x25, 0xa1, 0xa3, 0x8e, 0x3c, 0x6c, 0x87, 0xe0, 0xff, 0x83, 0xd1,
-	0xb8, 0x35, 0x3d, 0x70, 0x45, 0x69, 0xcf, 0xce, 0x48, 0xb3, 0x3d, 0xcb, 0x43, 0xff, 0xfa, 0xf8,
-	0xca, 0xc0, 0x6a, 0x88, 0xa9, 0xb0, 0x39, 0x3d, 0xc2, 0xe0, 0x55, 0x90, 0xac, 0x9a, 0xce, 0x1e,
-	0x36, 0x35, 0x43, 0x47, 0x37, 0x62, 0x17, 0xe9, 0x80, 0x80, 0x37, 0x74, 0x78, 0x1b, 0x0c, 0x10,
-	0xbb, 0xa6, 0xd5, 0xb0, 0xeb, 0xa1, 0x05, 0x7e, 0xd0, 0x93, 0xad, 0xf5, 0x75, 0x3e, 0x67, 0xd7,
-	0x76, 0xb0, 0xeb, 0xe5, 0x6c, 0xdf, 0xad, 0xab, 0xfd, 0x44, 0x8c, 0xe0, 0x06, 0x18, 0xf6, 0x48,
-	0xc5, 0x25, 0xbe, 0xd6, 0x98, 0x7e, 0x93, 0x4f, 0xbf, 0xda, 0x36, 0xbd, 0xcc, 0xb5, 0x5a, 0x8c,
-	0xa4, 0xbc, 0x38, 0xc6, 0x6e, 0x4b, 0x11, 0xa7, 0x9a, 0x69, 0x78, 0xbe, 0x86, 0x79, 0xd0, 0xa0,
-	0x5b, 0x3c, 0xf3, 0x64, 0x21, 0xc9, 0x1b, 0x9e, 0xaf, 0x70, 0x1c, 0xde, 0x07, 0xa3, 0x87, 0xc1,
-	0x1e, 0x71, 0x6d, 0xe2, 0x13, 0x4f, 0x6b, 0xf4, 0x50, 0x68, 0x91, 0xc7, 0xc8, 0x74, 0x6c, 0xf5,
-	0xcd, 0x86, 0x9a, 0x1a, 0x69, 0xa9, 0x17, 0x0e, 0xcf, 0x83, 0xf0, 0x4b, 0x20, 0x6d, 0x3b, 0x3a,
-	0x89, 0x19, 0x7b, 0x81, 0x1b, 0x43, 0x31, 0x63, 0x05, 0x47, 0x27, 0x4d, 0x33, 0x29, 0x3b, 0x3e,
-	0x84, 0xd7, 0x41, 0x9f, 0xb3, 0xf7, 0x06, 0x23, 0xf9, 0x45, 0x4e, 0x72, 0x2a, 0x4c, 0xc7, 0xf0,
-	0x72, 0xee, 0x75, 0xf6, 0xde, 0xd8, 0xd0, 0xe1, 0x26, 0x18, 0x66, 0xd1, 0x18, 0x2f, 0xb2, 0x2f,
-	0x71, 0xca, 0x32, 0x6d, 0x94, 0x29, 0x94, 0x2a, 0x4d, 0x25, 0xc1, 0x59, 0x1a, 0xb7, 0x80, 0xec,
-	0x9a, 0x37, 0x3c, 0xcd, 0xf3, 0xb1, 0xad, 0x63, 0xd3, 0xb1, 0x09, 0xba, 0xcd, 0xf3, 0x69, 0xc8,
-	0xf0, 0xca, 0x0d, 0x0c, 0xbe, 0x08, 0xc6, 0x2c, 0x6c, 0xe3, 0x2a, 0xf1, 0x34, 0xe7, 0xc8, 0xe6,
-	0x65, 0xd1, 0xa3, 0x98, 0x6d, 0xf0, 0x0e, 0xd7, 0x1e, 0x0d, 0xa5, 0xc5, 0x23, 0xbb, 0xd0, 0x90,
-	0xc1, 0x65, 0x70, 0xb1, 0xe2, 0x58, 0x14, 0xfb, 0xc6, 0x9e, 0x61, 0x1a, 0x7e, 0x5d, 0x8b, 0x3a,
-	0xc1, 0x97, 0x67, 0xa4, 0xd9, 0x54, 0xfb, 0xe6, 0x46, 0x5b, 0x74, 0x77, 0x84, 0x2a, 0xcc, 0x82,
-	0x84, 0x8f, 0xab, 0x1e, 0xd2, 0xf9, 0x06, 0x51, 0xdb, 0x06, 0xb7, 0x70, 0x35, 0xdc, 0x16, 0xd7,
-	0x9a, 0x58, 0x02, 0x43, 0xf1, 0x00, 0x81, 0xb2, 0xe8, 0xf7, 0x44, 0xeb, 0xc8, 0xdb, 0xba, 0x51,
-	0xd0, 0x5b, 0xc3, 0x66, 0x10, 0x76, 0xab, 0xaa, 0x18, 0x2c, 0x75, 0xbf, 0x2c, 0x4d, 0xbc, 0x06,
-	0xe0, 0xf9, 0x10, 0x7b, 0x22, 0x0b, 0x0a, 0xb8, 0xd0, 0x81, 0xf1, 0x27, 0x32, 0x71, 0x07, 0x24,
-	0x1b, 0x7b, 0x7a, 0x92, 0x89, 0x4b, 0xff, 0x94, 0x58, 0x0b, 0xfd, 0xf7, 0xcf, 0x90, 0xf4, 0x8d,
-	0x13, 0x24, 0xbd, 0x75, 0x82, 0xa4, 0x1f, 0x9f, 0x20, 0xe9, 0x11, 0x63, 0xf8, 0x14, 0xe5, 0x57,
-	0xe3, 0xd5, 0x30, 0xbb, 0x12, 0xd5, 0x89, 0xec, 0x76, 0x74, 0xad, 0x67, 0x57, 0x79, 0x87, 0x92,
-	0x6d, 0xad, 0x83, 0xd9, 0x95, 0x0e, 0x47, 0xf2, 0xce, 0x29, 0xfa, 0x1a, 0xa6, 0x94, 0xc5, 0xc0,
-	0xab, 0x9b, 0xa4, 0x3e, 0xcf, 0x0e, 0x3c, 0x2b, 0x1a, 0x7a, 0x8f, 0x03, 0xa1, 0x5e, 0x56, 0x3c,
-	0x16, 0x38, 0x54, 0x8c, 0xbd, 0x17, 0xb2, 0x61, 0x77, 0x2a, 0x1a, 0xdb, 0x57, 0x57, 0xe3, 0xbd,
-	0x2a, 0x37, 0xf6, 0xfe, 0x19, 0x92, 0x0f, 0x49, 0xfd, 0xd5, 0xf8, 0xa4, 0xdf, 0x9d, 0x21, 0x24,
-	0x7c, 0xda, 0x24, 0xf5, 0xa5, 0x56, 0x2f, 0xbf, 0x9c, 0x18, 0x98, 0x94, 0xa7, 0xd4, 0x89, 0xa8,
-	0x63, 0xf6, 0x0e, 0x30, 0x2b, 0x41, 0x35, 0xc7, 0x0c, 0x2c, 0xa2, 0x79, 0xc6, 0x9b, 0x24, 0xf3,
-	0x6b, 0x09, 0xc8, 0xed, 0x37, 0x3d, 0xbc, 0x01, 0x7a, 0x6b, 0x15, 0x1a, 0x78, 0x9c, 0xe0, 0xd6,
-	0xe7, 0xc0, 0xb6, 0x4e, 0x2a, 0xb7, 0x5f, 0x0c, 0x2b, 0x92, 0xd0, 0x62, 0xa7, 0xe1, 0x62, 0x8b,
-	0x33, 0x9f, 0x50, 0xd9, 0x27, 0xeb, 0x85, 0x2d, 0xc3, 0xd6, 0x5c, 0x42, 0x4d, 0xa3, 0x82, 0x3d,
-	0xfe, 0xc0, 0x49, 0xa9, 0x83, 0x96, 0x61, 0xab, 0x21, 0x04, 0x5f, 0x01, 0xa0, 0x4a, 0x83, 0xa8,
-	0xfc, 0x24, 0xce, 0x35, 0xf1, 0xeb, 0x34, 0x10, 0xde, 0x84, 0x6b, 0x25, 0xab, 0x11, 0x90, 0xf1,
-	0x41, 0xb2, 0x21, 0x85, 0xcf, 0x82, 0x04, 0xaf, 0x3c, 0x12, 0xbf, 0xff, 0x61, 0xab, 0x05, 0x5e,
-	0x75, 0xb8, 0x9c, 0x05, 0x88, 0xe5, 0xe8, 0xc4, 0x8c, 0x02, 0x84, 0x0f, 0xe0, 0x25, 0xd0, 0x6f,
-	0x07, 0x96, 0x56, 0xa5, 0x01, 0xf7, 0xb1, 0x57, 0xed, 0xb3, 0x03, 0x6b, 0x9d, 0x06, 0xd1, 0x9e,
-	0x12, 0x8d, 0x3d, 0x65, 0x7e, 0xd4, 0x0d, 0x46, 0x58, 0x10, 0xb7, 0x36, 0x69, 0x77, 0x40, 0x3f,
-	0xbb, 0x71, 0xa2, 0x68, 0xec, 0xf8, 0x76, 0x1a, 0x3c, 0x3e, 0x43, 0xec, 0xf1, 0xc5, 0xf7, 0xc1,
-	0x5e, 0x78, 0xec, 0x21, 0xf1, 0x7f, 0x1d, 0xfa, 0x40, 0xf1, 0x4e, 0xec, 0xd4, 0x76, 0xb5, 0xf5,
-	0x86, 0x4b, 0xdf, 0x91, 0xde, 0x39, 0x45, 0xb9, 0x28, 0xd8, 0xc4, 0x3a, 0xad, 0xf1, 0x16, 0x62,
-	0x6d, 0x21, 0x17, 0xa2, 0xf1, 0x00, 0xfa, 0xf0, 0x14, 0xb5, 0x18, 0x68, 0x9b, 0xd8, 0x61, 0x46,
-	0x5b, 0x2e, 0x64, 0xde, 0xed, 0x06, 0x69, 0xc6, 0x4c, 0xb3, 0x66, 0x3f, 0x3d, 0x2d, 0x8b, 0x60,
-	0x28, 0xd6, 0x15, 0x44, 0x94, 0x9c, 0xeb, 0x09, 0x06, 0x9b, 0x3d, 0x41, 0x7d, 0xe9, 0x5d, 0x46,
-	0x06, 0xfe, 0x42, 0xc8, 0xc8, 0x72, 0xbb, 0x62, 0x6d, 0x61, 0xad, 0xb9, 0xce, 0x87, 0xa7, 0x68,
-	0xe9, 0x49, 0x89, 0x6a, 0xce, 0xce, 0xfc, 0xa6, 0x1b, 0x5c, 0x5c, 0x6d, 0x3c, 0xae, 0xbe, 0xe2,
-	0xd8, 0x44, 0x25, 0x0f, 0x03, 0xf6, 0x2e, 0x9b, 0x01, 0x3d, 0x98, 0xd2, 0x90, 0xa8, 0x74, 0x2b,
-	0x51, 0x2a, 0x13, 0xc1, 0xeb, 0x20, 0xad, 0xbb, 0x75, 0xcd, 0x0d, 0x6c, 0x4d, 0xbc, 0xcf, 0x38,
-	0x2f, 0x03, 0xea, 0x90, 0xee, 0xd6, 0xd5, 0xc0, 0x16, 0x66, 0xe1, 0x24, 0x48, 0xb2, 0x60, 0x66,
-	0x85, 0x33, 0x4a, 0xb9, 0x01, 0x3b, 0xb0, 0x58, 0x5d, 0xf5, 0x96, 0x7e, 0xcb, 0xae, 0xbb, 0x4d,
-	0x56, 0x1a, 0x5a, 0xaf, 0x3c, 0x86, 0x34, 0xaf, 0x3d, 0x36, 0x6a, 0x5e, 0x7d, 0xa1, 0x36, 0xbf,
-	0xfe, 0x58, 0xd1, 0x6c, 0x39, 0xf6, 0x77, 0x4e, 0x11, 0x89, 0x71, 0x3e, 0xdf, 0x89, 0xf4, 0xf9,
-	0x2f, 0xe2, 0xd6, 0x9b, 0xfb, 0xae, 0x04, 0x92, 0x8d, 0xff, 0x05, 0x70, 0x0c, 0xc0, 0x8d, 0x7b,
-	0xca, 0x7a, 0x4e, 0xdb, 0x7a, 0x50, 0xca, 0x69, 0xdb, 0x85, 0xcd, 0x42, 0x71, 0xb7, 0x20, 0x77,
-	0xc1, 0x8b, 0x60, 0x24, 0x86, 0xaf, 0x16, 0x57, 0x36, 0x73, 0xaa, 0x2c, 0xc1, 0x0b, 0x60, 0x38,
-	0x06, 0xdf, 0x5f, 0x29, 0xee, 0xca, 0xdd, 0x6d, 0xe0, 0xdd, 0x5c, 0xfe, 0x9e, 0xdc, 0x03, 0x21,
-	0x48, 0xc7, 0xc0, 0xe2, 0xce, 0x9a, 0x9c, 0x38, 0x87, 0x29, 0x72, 0xef, 0xdc, 0xf7, 0x24, 0x30,
-	0x72, 0xae, 0xb7, 0x64, 0x26, 0xef, 0x17, 0xcb, 0x5a, 0xa1, 0xa8, 0x95, 0xd4, 0x8d, 0xa2, 0xba,
-	0xb1, 0xf5, 0x40, 0xee, 0x8a, 0xc0, 0x7c, 0x71, 0x57, 0xcb, 0x2b, 0x5b, 0xb9, 0xc2, 0xca, 0x03,
-	0x59, 0x82, 0xe3, 0xe0, 0x22, 0x03, 0xb7, 0xee, 0xaa, 0xc5, 0xed, 0xf5, 0xbb, 0xa5, 0xed, 0x2d,
-	0x6d, 0xb5, 0xb8, 0x5b, 0xd0, 0xca, 0x72, 0xf7, 0xe3, 0x44, 0xcc, 0xbb, 0xc7, 0x88, 0xf2, 0x72,
-	0x62, 0xee, 0x97, 0x12, 0x18, 0x8c, 0xb5, 0xd9, 0x8c, 0x89, 0x9d, 0x7b, 0x9a, 0x52, 0x2a, 0x69,
-	0xc5, 0x72, 0x8c, 0xa0, 0x0b, 0x60, 0xb8, 0x09, 0xe7, 0x37, 0x0a, 0xdb, 0xaf, 0xcb, 0x12, 0x44,
-	0x60, 0xb4, 0x09, 0xee, 0x6e, 0x14, 0x56, 0x8b, 0xbb, 0x65, 0xed, 0xd6, 0x4d, 0xb9, 0x1b, 0x4e,
-	0x80, 0xb1, 0xf3, 0x92, 0xc5, 0x9b, 0xb7, 0x16, 0xe5, 0x9e, 0xc7, 0xca, 0x6e, 0xcb, 0x89, 0xc7,
-	0xca, 0x5e, 0x91, 0x7b, 0xe7, 0x6e, 0x01, 0xd0, 0x7c, 0xfc, 0x33, 0x72, 0x0b, 0x45, 0x4d, 0xd9,
-	0xde, 0x2a, 0x6a, 0xab, 0xb9, 0x7c, 0x6e, 0x2b, 0x27, 0x77, 0xc1, 0x61, 0x30, 0x18, 0x07, 0xa4,
-	0xb9, 0x43, 0x00, 0x9a, 0xef, 0x5c, 0xf8, 0x2c, 0xc8, 0x28, 0x2b, 0x2b, 0xb9, 0x72, 0x39, 0x3c,
-	0xe5, 0xdc, 0x9a, 0xb2, 0x9d, 0xdf, 0xd2, 0xd6, 0x8a, 0xaa, 0xb6, 0x9a, 0x2b, 0xe5, 0x8b, 0x0f,
-	0xee, 0xe5, 0x0a, 0x5b, 0x72, 0x17, 0x0b, 0x92, 0x16, 0xbd, 0x0d, 0x35, 0xb7, 0xb2, 0x25, 0x4b,
-	0xf0, 0x32, 0x18, 0x8f, 0xe3, 0xf9, 0xa2, 0xb2, 0xaa, 0x2d, 0x2b, 0x79, 0xa5, 0xb0, 0x92, 0x53,
-	0xe5, 0xee, 0xb9, 0x32, 0xe8, 0x0f, 0xab, 0x06, 0x1c, 0x01, 0xa9, 0xf5, 0xd2, 0xb6, 0x50, 0x2b,
-	0x14, 0x0b, 0xcc, 0x37, 0x19, 0x0c, 0x35, 0x20, 0xa5, 0xc0, 0x8e, 0x32, 0xae, 0xb4, 0xb3, 0x5e,
-	0xda, 0x96, 0xbb, 0x5b, 0x94, 0x4a, 0x2b, 0x1b, 0x72, 0xcf, 0xe2, 0xef, 0x01, 0xff, 0x81, 0xa8,
-	0x50, 0x03, 0xb2, 0x48, 0x16, 0xc9, 0xa6, 0x50, 0x0a, 0xdb, 0x52, 0x7d, 0x22, 0x7e, 0x47, 0xaa,
-	0xfc, 0xd7, 0x68, 0xe6, 0xab, 0xc7, 0x27, 0x68, 0x2e, 0xea, 0x82, 0x15, 0x4a, 0xbd, 0xac, 0xe8,
-	0xd1, 0xef, 0xf1, 0xae, 0x32, 0xdb, 0x9e, 0x4b, 0x1f, 0x9d, 0x22, 0xe9, 0x4f, 0xa7, 0x48, 0xde,
-	0x6e, 0x6b, 0xe9, 0xbf, 0xf9, 0x87, 0xbf, 0x7e, 0xbf, 0x5b, 0xce, 0x0c, 0x2e, 0x88, 0x87, 0xf0,
-	0x02, 0xa6, 0x74, 0x49, 0x9a, 0xe3, 0xee, 0x88, 0xf3, 0xf8, 0x2f, 0xb9, 0x23, 0xfe, 0x45, 0x44,
-	0xee, 0x7c, 0x1d, 0x24, 0x85, 0xe6, 0x7f, 0xe8, 0xcd, 0xdd, 0x27, 0xf7, 0xa6, 0xb1, 0xb2, 0x78,
-	0xf4, 0x44, 0x2b, 0x7f, 0x4b, 0x02, 0xfd, 0xe5, 0x03, 0xe7, 0xa8, 0xd3, 0xc2, 0x6d, 0xe3, 0xcc,
-	0xeb, 0xc7, 0x27, 0x68, 0xb6, 0xc3, 0xaa, 0x3b, 0x06, 0x39, 0x7a, 0x32, 0x06, 0xd2, 0x99, 0xe4,
-	0x82, 0x77, 0xe0, 0x1c, 0x85, 0x5e, 0xdc, 0x94, 0xe0, 0x4f, 0x25, 0x30, 0xaa, 0xe8, 0xfa, 0xf9,
-	0x36, 0x63, 0xaa, 0xd5, 0x89, 0x56, 0x69, 0x27, 0x6e, 0x76, 0x8e, 0x4f, 0xd0, 0x8d, 0xc7, 0x73,
-	0xd3, 0xa1, 0x58, 0x3d, 0x8a, 0xe8, 0x99, 0xcc, 0x8c, 0x2d, 0x60, 0x5d, 0x67, 0x5e, 0xb1, 0xae,
-	0x83, 0x35, 0x28, 0xa2, 0x20, 0x32, 0xa6, 0x7e, 0x21, 0x81, 0x4b, 0x2a, 0xb1, 0x9c, 0x1a, 0xf9,
-	0x02, 0x9c, 0x7c, 0xf0, 0xf4, 0x4e, 0x4e, 0x67, 0xc6, 0x17, 0x5c, 0xee, 0x47, 0x67, 0x3f, 0x7f,
-	0x28, 0x81, 0x91, 0x90, 0xc9, 0x58, 0x5b, 0x32, 0xde, 0xe6, 0x61, 0x53, 0xd4, 0xc9, 0xbd, 0xf2,
-	0xd3, 0xbb, 0x87, 0x32, 0x17, 0x1a, 0x1c, 0x36, 0x3b, 0x0a, 0xe6, 0xd8, 0x4f, 0x24, 0x30, 0xda,
-	0x24, 0xf0, 0xa9, 0x7d, 0xfb, 0x9c, 0xe7, 0x1b, 0xa3, 0xae, 0xd5, 0xbd, 0x9f, 0x4b, 0x60, 0x9c,
-	0x65, 0x02, 0xeb, 0x4f, 0xbc, 0x35, 0xc7, 0x55, 0x28, 0x6d, 0x36, 0x2d, 0x70, 0xa6, 0xe5, 0x2f,
-	0x6e, 0x87, 0x5e, 0x66, 0x22, 0xde, 0x80, 0x33, 0x7c, 0x93, 0xd4, 0x33, 0xf9, 0xe3, 0x13, 0x34,
-	0x1e, 0xf9, 0xca, 0x0d, 0xc7, 0x53, 0xe6, 0xbd, 0x53, 0x24, 0x35, 0x52, 0xf3, 0x6a, 0x66, 0x8a,
-	0xa7, 0x84, 0x85, 0x29, 0x35, 0xec, 0xea, 0x42, 0xf3, 0x6f, 0xf4, 0x9b, 0x6c, 0x1e, 0xcf, 0x92,
-	0xe5, 0xa9, 0x47, 0x7f, 0x99, 0xee, 0x7a, 0xf4, 0xc9, 0xb4, 0xf4, 0xd1, 0x27, 0xd3, 0xd2, 0x9f,
-	0x3f, 0x99, 0x96, 0xde, 0xfa, 0x74, 0xba, 0xeb, 0xa3, 0x4f, 0xa7, 0xbb, 0xfe, 0xf8, 0xe9, 0x74,
-	0xd7, 0x5e, 0x1f, 0x5f, 0xfc, 0x85, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x46, 0xd2, 0xc0,
-	0x92, 0x1a, 0x00, 0x00,
+	// 3549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0x49,
+	0x76, 0x56, 0xeb, 0x9f, 0x4f, 0x22, 0xd5, 0x2a, 0x49, 0x76, 0x49, 0xb6, 0x65, 0x9b, 0xe3, 0x99,
+	0x78, 0xb4, 0x1c, 0xc9, 0xf6, 0xcc, 0xd8, 0x33, 0x9a, 0x9d, 0xec, 0xb6, 0x48, 0xea, 0x27, 0xa2,
+	0x49, 0xba, 0x49, 0x5a, 0xa3, 0x41, 0x16, 0x8d, 0x52, 0x77, 0x89, 0xec, 0x55, 0xb3, 0xbb, 0xdd,
+	0x3f, 0x72, 0xe8, 0x5c, 0x82, 0x00, 0x39, 0xe4, 0x07, 0xc1, 0x62, 0x83, 0x64, 0x17, 0x8b, 0x04,
+	0x49, 0xb0, 0x08, 0xb2, 0xc7, 0xcd, 0x9c, 0x82, 0x3d, 0xe6, 0x10, 0x78, 0xe7, 0x34, 0x40, 0x2e,
+	0x41, 0x0e, 0x8b, 0x64, 0x26, 0x87, 0xc0, 0xa7, 0x00, 0x63, 0x3b, 0x41, 0x4e, 0x41, 0x55, 0x75,
+	0x93, 0x4d, 0x8a, 0x0e, 0x62, 0xcf, 0x00, 0x73, 0xeb, 0xfa, 0xde, 0xab, 0x57, 0xef, 0xbd, 0x7a,
+	0xf5, 0xea, 0xbd, 0x6a, 0x48, 0x11, 0xd7, 0x5d, 0x77, 0x3d, 0x27, 0x70, 0x50, 0x8a, 0x1a, 0x4d,
+	0xca, 0x3f, 0x57, 0x2e, 0x36, 0x1d, 0xa7, 0x69, 0xd1, 0x0d, 0xe2, 0x9a, 0x1b, 0xc4, 0xb6, 0x9d,
+	0x80, 0x04, 0xa6, 0x63, 0xfb, 0x82, 0x71, 0x65, 0xd6, 0xa3, 0x7e, 0x68, 0x05, 0xd1, 0x68, 0x5e,
+	0xb7, 0x9c, 0xd0, 0xb0, 0x68, 0x70, 0x42, 0x3b, 0x31, 0x14, 0x78, 0xa1, 0x1f, 0xb8, 0x8e, 0x65,
+	0xea, 0x31, 0x74, 0x29, 0x70, 0x1c, 0xcb, 0xdf, 0xe0, 0x83, 0x26, 0xb5, 0xbb, 0x1f, 0xb1, 0xc8,
+	0x63, 0x8b, 0x9c, 0x3a, 0x5e, 0x34, 0x9a, 0xf3, 0xa8, 0xef, 0x84, 0x9e, 0x4e, 0xe3, 0x15, 0xd3,
+	0x06, 0xd5, 0xcd, 0x36, 0xb1, 0xa2, 0xe1, 0x62, 0xd3, 0x69, 0x3a, 0xfc, 0x73, 0x83, 0x7d, 0x75,
+	0x99, 0xda, 0x74, 0xc3, 0x72, 0x74, 0x31, 0xcc, 0xfe, 0xbe, 0x04, 0x93, 0x8a, 0xeb, 0xee, 0xd3,
+	0x0e, 0x5a, 0x87, 0x59, 0xc7, 0x6b, 0x12, 0xdb, 0x7c, 0xc4, 0xed, 0xc0, 0xd2, 0x15, 0xe9, 0x7a,
+	0x6a, 0x0b, 0x7e, 0xf1, 0x1c, 0x4f, 0x12, 0xd7, 0x75, 0xbc, 0xa6, 0xda, 0x47, 0x47, 0x17, 0x60,
+	0xdc, 0x26, 0x6d, 0x8a, 0x47, 0x39, 0xdf, 0xd4, 0x2f, 0x9e, 0xe3, 0x31, 0xe2, 0xba, 0x2a, 0x07,
+	0xd1, 0x35, 0x98, 0x3a, 0xa5, 0x9e, 0xcf, 0xe4, 0x8c, 0xf5, 0xc9, 0x39, 0xa5, 0x9e, 0x1a, 0x93,
+	0x36, 0x67, 0xff, 0xe3, 0x4b, 0x2c, 0xfd, 0xf7, 0x97, 0x58, 0xfa, 0xf9, 0x5f, 0x5d, 0x96, 0xb2,
+	0xc7, 0xb0, 0xb2, 0x6d, 0xda, 0x46, 0x3e, 0xf2, 0x94, 0x4a, 0xec, 0x13, 0xd3, 0x6e, 0x1e, 0x50,
+	0xb3, 0xd9, 0x0a, 0x7c, 0xb4, 0x02, 0xd3, 0x86, 0xe9, 0x07, 0xc4, 0xd6, 0x29, 0x57, 0x4d, 0x52,
+	0xbb, 0x63, 0x84, 0x61, 0xca, 0x22, 0x01, 0xb5, 0xf5, 0x0e, 0xd7, 0x46, 0x52, 0xe3, 0x21, 0x42,
+	0x30, 0x6e, 0x39, 0xc4, 0xe0, 0x4a, 0x48, 0x2a, 0xff, 0xce, 0xfe, 0x7c, 0x14, 0xd2, 0xaa, 0x63,
+	0x59, 0x4e, 0x18, 0x54, 0xb9, 0xf7, 0xd1, 0xbb, 0x30, 0xed, 0x07, 0x1e, 0x09, 0x68, 0xb3, 0xc3,
+	0x65, 0x67, 0x6e, 0x2d, 0xaf, 0x77, 0xf7, 0x79, 0xbd, 0xe1, 0x1a, 0x24, 0xa0, 0xb5, 0x88, 0x41,
+	0xed, 0xb2, 0xa2, 0xd7, 0x21, 0xa3, 0x13, 0x9b, 0x78, 0x1d, 0xcd, 0xa5, 0x9e, 0x4e, 0xed, 0x80,
+	0xaf, 0x9e, 0x56, 0xd3, 0x02, 0xad, 0x0a, 0x10, 0xbd, 0x0d, 0x99, 0x16, 0x25, 0x56, 0xd0, 0xd2,
+	0x02, 0xb3, 0x4d, 0x9d, 0x30, 0xe0, 0xda, 0x8c, 0x6d, 0xcd, 0xfe, 0xcf, 0xaf, 0x2e, 0x4f, 0x17,
+	0x42, 0x8f, 0xbb, 0x53, 0x4d, 0x0b, 0x9e, 0xba, 0x60, 0x41, 0x77, 0x40, 0x76, 0x8e, 0x7c, 0xea,
+	0x9d, 0x72, 0x2a, 0x9f, 0x89, 0x27, 0x87, 0x4c, 0x9b, 0x4b, 0x70, 0xb1, 0xb9, 0xe8, 0x1d, 0x98,
+	0x3e, 0x22, 0x81, 0xde, 0xd2, 0x8e, 0x3a, 0x78, 0xfc, 0x8c, 0x2d, 0x91, 0xdd, 0x5b, 0x8c, 0x63,
+	0xab, 0xa3, 0x4e, 0x1d, 0x89, 0x0f, 0x74, 0x09, 0x40, 0xcc, 0xf2, 0xcd, 0x47, 0x14, 0x4f, 0x70,
+	0x33, 0x52, 0x1c, 0xa9, 0x99, 0x8f, 0x68, 0xf6, 0xef, 0xa5, 0xae, 0xcb, 0x6a, 0x01, 0x09, 0x42,
+	0x1f, 0xbd, 0x05, 0x13, 0x7e, 0x40, 0x02, 0x1a, 0xf9, 0xeb, 0xfc, 0xd9, 0x35, 0x18, 0x23, 0x55,
+	0x05, 0x17, 0x5a, 0x84, 0x09, 0x2e, 0x2d, 0xf2, 0x90, 0x18, 0xa0, 0xcb, 0x30, 0x63, 0x87, 0x6d,
+	0x8d, 0x0f, 0xa8, 0xcf, 0xdd, 0x92, 0x56, 0xc1, 0x0e, 0xdb, 0x5b, 0x02, 0x41, 0xab, 0x00, 0xba,
+	0x63, 0x07, 0x9e, 0x63, 0x59, 0xd4, 0xe3, 0xe6, 0xa4, 0xd4, 0x04, 0x82, 0xae, 0xc2, 0xec, 0xb1,
+	0xe3, 0xe9, 0x54, 0x0b, 0xf9, 0x1e, 0x71, 0xc5, 0xa7, 0xd5, 0x19, 0x8e, 0x89, 0x6d, 0xcb, 0xbe,
+	0x07, 0x90, 0x77, 0xec, 0x63, 0xb3, 0xb9, 0x6d, 0x5a, 0x94, 0xc5, 0xc3, 0x89, 0x69, 0x1b, 0x22,
+	0xb8, 0x55, 0xfe, 0x8d, 0xce, 0xc1, 0xa4, 0xce, 0x39, 0x44, 0x28, 0xab, 0xd1, 0x28, 0xfb, 0x87,
+	0x17, 0x61, 0x4c, 0x71, 0x5d, 0x46, 0x3f, 0x36, 0xa9, 0x65, 0xf8, 0x58, 0xba, 0x32, 0xc6, 0xe8,
+	0x62, 0x84, 0xde, 0x84, 0xb1, 0x13, 0x2a, 0x22, 0x6e, 0xe6, 0xd6, 0x7c, 0xc2, 0x01, 0xe2, 0x40,
+	0x6d, 0x8d, 0x3f, 0xfe, 0xd5, 0xe5, 0x11, 0x95, 0xf1, 0xa0, 0xd7, 0x00, 0xcc, 0x36, 0x69, 0x52,
+	0xcd, 0x25, 0x41, 0x4b, 0xd8, 0xb1, 0x35, 0xfe, 0xb3, 0xa7, 0x58, 0x52, 0x53, 0x1c, 0xaf, 0x92,
+	0xa0, 0x85, 0xde, 0x8e, 0x99, 0x82, 0x8e, 0x2b, 0x4c, 0xc9, 0xdc, 0x5a, 0x4c, 0x88, 0xdd, 0x63,
+	0xc4, 0x7a, 0xc7, 0xa5, 0xd1, 0x24, 0xf6, 0xc9, 0x3c, 0x40, 0x74, 0x9d, 0xfa, 0xbe, 0xe6, 0x3a,
+	0x5e, 0xe0, 0xe3, 0x29, 0x6e, 0xc2, 0x8c, 0xc0, 0xaa, 0x0c, 0x42, 0xfb, 0x90, 0x31, 0xe8, 0x31,
+	0x09, 0xad, 0x40, 0x13, 0x09, 0x04, 0xa7, 0xb8, 0xca, 0x49, 0xd9, 0xdb, 0x9c, 0xc0, 0xb4, 0xce,
+	0x3c, 0x79, 0x8e, 0x27, 0xc5, 0x90, 0xeb, 0x9f, 0x8e, 0xe6, 0x0a, 0x08, 0xdd, 0x84, 0x39, 0x12,
+	0x06, 0x2d, 0xcd, 0x0d, 0x8f, 0x2c, 0x53, 0xd7, 0x98, 0x03, 0x66, 0xb9, 0x39, 0xa9, 0x1f, 0x7e,
+	0xb2, 0x3c, 0x61, 0x3b, 0x7a, 0xdb, 0x55, 0xd3, 0x8c, 0xa3, 0xca, 0x19, 0x58, 0x62, 0xc1, 0x30,
+	0xa5, 0x3b, 0xed, 0x36, 0xb1, 0x0d, 0x9c, 0xe6, 0xda, 0xc5, 0x43, 0xa6, 0x7c, 0xf4, 0xa9, 0x11,
+	0xaf, 0xe9, 0xe3, 0x75, 0xee, 0xdf, 0x99, 0x08, 0x53, 0xbc, 0xa6, 0x8f, 0xae, 0xc0, 0x4c, 0x22,
+	0xb7, 0xe2, 0x4c, 0x64, 0x5e, 0x0f, 0x42, 0xd7, 0x00, 0x0c, 0xea, 0x5a, 0x4e, 0xa7, 0xcd, 0x4e,
+	0xe0, 0x5c, 0xc2, 0xb7, 0x09, 0x1c, 0xbd, 0x0b, 0x0b, 0xbd, 0x91, 0xd6, 0x26, 0xb6, 0x79, 0x4c,
+	0xfd, 0x00, 0xcb, 0x09, 0x76, 0xd4, 0x63, 0xb8, 0x1b, 0xd1, 0xd1, 0x1d, 0x58, 0x4c, 0x4c, 0x6b,
+	0x52, 0x9b, 0x7a, 0x24, 0x70, 0x3c, 0x3c, 0x9f, 0x98, 0x97, 0x10, 0xbc, 0x13, 0x33, 0xa0, 0x1b,
+	0xb0, 0x48, 0x6c, 0xc3, 0x73, 0x4c, 0x43, 0x73, 0x89, 0x7e, 0xc2, 0xb6, 0x95, 0x67, 0x4b, 0xc4,
+	0x0d, 0x40, 0x11, 0xad, 0x2a, 0x48, 0x65, 0x96, 0x32, 0xd7, 0x61, 0xca, 0xa0, 0x96, 0xe6, 0xb8,
+	0x01, 0x5e, 0xe4, 0x7b, 0xbf, 0x94, 0xd8, 0x9f, 0x02, 0xb5, 0x68, 0x20, 0x36, 0x7f, 0xd2, 0xa0,
+	0x56, 0xc5, 0x0d, 0xd0, 0x06, 0x73, 0x2b, 0x0b, 0x54, 0x1f, 0x2f, 0x5d, 0x19, 0xbb, 0x3e, 0xd3,
+	0xc7, 0xdf, 0x0b, 0x79, 0x35, 0xe6, 0x42, 0x39, 0x40, 0xbe, 0x4e, 0x2c, 0xaa, 0x3d, 0x34, 0x83,
+	0x96, 0xa6, 0x5b, 0xa1, 0x1f, 0x50, 0x0f, 0x9f, 0xe3, 0x47, 0x46, 0xe6, 0x94, 0x03, 0x33, 0x68,
+	0xe5, 0x05, 0xce, 0x92, 0x9b, 0x69, 0x07, 0xd4, 0xb3, 0x89, 0x15, 0x85, 0xd6, 0x79, 0xce, 0x99,
+	0x8e, 0x51, 0x11, 0x5c, 0xaf, 0xc3, 0xb4, 0x47, 0x4f, 0x4d, 0x9e, 0xe9, 0xf1, 0x60, 0x20, 0x74,
+	0x49, 0xe8, 0x35, 0x48, 0x3b, 0xc7, 0xc7, 0xa6, 0x6e, 0x12, 0x4b, 0x3b, 0x7e, 0x60, 0xd8, 0x78,
+	0x99, 0xfb, 0x61, 0x36, 0x06, 0xb7, 0x1f, 0x18, 0x36, 0x3b, 0x68, 0x6d, 0xe3, 0x5d, 0x3f, 0x6c,
+	0xe3, 0x15, 0x71, 0x10, 0xc5, 0x08, 0x5d, 0x07, 0x99, 0x84, 0x81, 0xa3, 0xb9, 0x9e, 0x73, 0xaa,
+	0x89, 0x0b, 0x13, 0x5f, 0xe4, 0x1c, 0x19, 0x86, 0x57, 0x3d, 0xe7, 0x34, 0x4a, 0xe4, 0xb7, 0x21,
+	0x8a, 0x7c, 0x71, 0x86, 0x2e, 0x9d, 0xf1, 0xa3, 0xc2, 0xa9, 0xdc, 0x8f, 0x40, 0xba, 0xdf, 0xe8,
+	0x5b, 0xec, 0x88, 0x30, 0x0f, 0x6b, 0xae, 0x47, 0x5d, 0xe2, 0x51, 0x7c, 0x99, 0x19, 0x1b, 0x6d,
+	0x70, 0x5a, 0xd0, 0xaa, 0x82, 0x84, 0xbe, 0x0b, 0x68, 0x40, 0x1d, 0x93, 0xfa, 0xf8, 0x0a, 0x8b,
+	0xdd, 0x2d, 0xf4, 0xe4, 0x39, 0xce, 0x28, 0x7d, 0x4a, 0xa9, 0x72, 0x9f, 0x92, 0x26, 0x65, 0xc9,
+	0x13, 0x05, 0xb4, 0xed, 0xb2, 0x4b, 0x4a, 0x33, 0xa8, 0x65, 0xb6, 0x4d, 0xb6, 0x13, 0x57, 0xb9,
+	0x49, 0xf3, 0x31, 0xa5, 0x10, 0x13, 0x50, 0x16, 0xd2, 0xfe, 0x89, 0xe9, 0x6a, 0x2d, 0x3d, 0xda,
+	0x89, 0xac, 0x38, 0x05, 0x0c, 0xdc, 0xd5, 0xc5, 0x3e, 0x1c, 0x02, 0xe8, 0x1e, 0x25, 0x01, 0x35,
+	0x34, 0x12, 0xe0, 0xd7, 0xf8, 0x01, 0x7f, 0x6d, 0x9d, 0xdd, 0x90, 0x9e, 0x79, 0x14, 0x32, 0xb8,
+	0xcd, 0xf3, 0x3b, 0xb5, 0x9b, 0xa6, 0x4d, 0xd7, 0xd9, 0x55, 0xe1, 0x07, 0xa4, 0xed, 0x6e, 0x2d,
+	0x31, 0x13, 0x7f, 0xf8, 0xc9, 0x72, 0x2a, 0x88, 0x21, 0x7e, 0xec, 0x53, 0x91, 0x34, 0x25, 0x60,
+	0xa2, 0x45, 0x7a, 0xe5, 0xa2, 0xaf, 0x7d, 0x75, 0xd1, 0x91, 0x34, 0x25, 0x60, 0xa9, 0x81, 0x57,
+	0x41, 0xd4, 0xc0, 0xaf, 0xf3, 0xe8, 0x8a, 0x87, 0x88, 0xc0, 0x25, 0x8f, 0x3e, 0x08, 0x4d, 0x8f,
+	0x1a, 0x9a, 0x13, 0x06, 0x47, 0x4e, 0x68, 0x1b, 0x9a, 0xee, 0xd8, 0x36, 0xd5, 0x45, 0x26, 0x78,
+	0x83, 0xc7, 0x7c, 0xf2, 0xde, 0xa9, 0x51, 0x3d, 0xf4, 0xcc, 0xa0, 0xa3, 0x86, 0x16, 0x8d, 0x92,
+	0xef, 0x85, 0x58, 0x46, 0x25, 0x12, 0x91, 0xef, 0x49, 0x40, 0x6f, 0x82, 0x4c, 0x2c, 0xcb, 0x79,
+	0xa8, 0xb1, 0x0b, 0x94, 0x7a, 0x16, 0xf5, 0x7d, 0xfc, 0x6b, 0x5c, 0x8b, 0x39, 0x8e, 0xd7, 0xba,
+	0x30, 0xda, 0x85, 0xf9, 0x1e, 0x93, 0x16, 0xdd, 0x16, 0xd7, 0xb9, 0x27, 0x2e, 0xf4, 0x69, 0x10,
+	0xf3, 0x88, 0xf3, 0xa7, 0xca, 0xfe, 0x00, 0x82, 0x3e, 0x80, 0xcc, 0x69, 0x5b, 0x23, 0xae, 0xab,
+	0x39, 0x51, 0x90, 0xbe, 0xc9, 0x83, 0xf4, 0x5c, 0x42, 0xcc, 0xfd, 0xb6, 0xe2, 0xba, 0x15, 0x11,
+	0xa5, 0x33, 0xa7, 0xbd, 0x01, 0xba, 0x0d, 0x19, 0x62, 0x51, 0x2f, 0xe8, 0x45, 0xdd, 0x1a, 0x8f,
+	0xba, 0xb9, 0x27, 0xcf, 0xf1, 0x8c, 0xc2, 0x28, 0x51, 0xc8, 0xa5, 0x49, 0x77, 0xc0, 0xe2, 0xad,
+	0x04, 0x0b, 0x0f, 0x1c, 0x5f, 0xf3, 0xa9, 0xcf, 0x0e, 0x23, 0x0b, 0xdc, 0x63, 0xd3, 0xa2, 0xf8,
+	0x5b, 0x7c, 0xe5, 0x8b, 0x89, 0x95, 0xef, 0x39, 0x7e, 0x4d, 0x30, 0x55, 0x05, 0x8f, 0x3a, 0xff,
+	0x60, 0x10, 0x42, 0xbf, 0x0e, 0x8b, 0x49, 0x69, 0x46, 0x54, 0x8a, 0xe0, 0xdc, 0x90, 0xf2, 0x04,
+	0xf5, 0xa6, 0xc7, 0x18, 0xba, 0x0a, 0xa9, 0xa6, 0xe5, 0x1c, 0x11, 0x4b, 0x33, 0x0d, 0xfc, 0x56,
+	0x22, 0x91, 0x4e, 0x0b, 0x78, 0xcf, 0x40, 0xb7, 0x61, 0x9a, 0xda, 0xa7, 0xda, 0x29, 0xf1, 0x7c,
+	0xbc, 0xc1, 0x37, 0xfa, 0x42, 0xff, 0xfd, 0xba, 0x5e, 0xb4, 0x4f, 0xef, 0x13, 0xcf, 0x2f, 0xda,
+	0x81, 0xd7, 0x51, 0xa7, 0xa8, 0x18, 0xa1, 0x3d, 0x98, 0xf3, 0xa9, 0xee, 0xd1, 0x40, 0xeb, 0x4e,
+	0xbf, 0xc1, 0xa7, 0x5f, 0x1d, 0x98, 0x5e, 0xe3, 0x5c, 0x7d, 0x42, 0xd2, 0x7e, 0x12, 0x63, 0xd9,
+	0x52, 0xc4, 0xa9, 0x66, 0x99, 0x7e, 0xa0, 0x11, 0x1e, 0x34, 0xf8, 0x26, 0x3f, 0x79, 0xb2, 0xa0,
+	0x94, 0x4c, 0x3f, 0x50, 0x38, 0x8e, 0xee, 0xc1, 0xe2, 0x49, 0x78, 0x44, 0x3d, 0x9b, 0x06, 0xd4,
+	0xd7, 0xba, 0x95, 0x39, 0xbe, 0xc5, 0x63, 0x64, 0x35, 0xb1, 0xfa, 0x7e, 0x97, 0x4d, 0x8d, 0xb9,
+	0xd4, 0x85, 0x93, 0xb3, 0x20, 0xfa, 0x0e, 0x64, 0x6c, 0xc7, 0xa0, 0x09, 0x61, 0x6f, 0x73, 0x61,
+	0x38, 0x21, 0xac, 0xec, 0x18, 0xb4, 0x27, 0x26, 0x6d, 0x27, 0x87, 0xe8, 0x1a, 0x4c, 0x3a, 0x47,
+	0xdf, 0x67, 0x4e, 0x7e, 0x87, 0x3b, 0x39, 0x1d, 0x1d, 0xc7, 0x28, 0x39, 0x4f, 0x38, 0x47, 0xdf,
+	0xdf, 0x33, 0xd0, 0x3e, 0xcc, 0xb1, 0x68, 0x4c, 0x5e, 0xb2, 0xef, 0x72, 0x97, 0x65, 0x07, 0x5c,
+	0xa6, 0xb8, 0xae, 0xd2, 0x63, 0x12, 0x3e, 0xcb, 0x90, 0x3e, 0x90, 0xa5, 0x79, 0xd3, 0xd7, 0x58,
+	0x55, 0x6e, 0x10, 0xcb, 0xb1, 0x29, 0xbe, 0xcd, 0xcf, 0xd3, 0xac, 0xe9, 0xd7, 0xba, 0x18, 0x7a,
+	0x07, 0xce, 0xb5, 0x89, 0x4d, 0x9a, 0xd4, 0xd7, 0x9c, 0x87, 0x36, 0xbf, 0x16, 0x7d, 0x97, 0x30,
+	0x03, 0xef, 0x70, 0xee, 0xc5, 0x88, 0x5a, 0x79, 0x68, 0x97, 0xbb, 0x34, 0xb4, 0x05, 0x4b, 0xba,
+	0xd3, 0x76, 0x49, 0x60, 0x1e, 0x99, 0x96, 0x19, 0x74, 0xb4, 0xb8, 0xbf, 0x78, 0x8f, 0x55, 0x8d,
+	0x83, 0xc6, 0x2d, 0xf6, 0xf1, 0xde, 0x17, 0xac, 0x48, 0x85, 0xa5, 0x63, 0x93, 0xe5, 0x91, 0xa8,
+	0xc5, 0xd0, 0x3c, 0xd1, 0x63, 0xe0, 0xf7, 0xf9, 0x49, 0x48, 0x6e, 0xd3, 0x90, 0x4e, 0x44, 0x5d,
+	0x38, 0x3e, 0x0b, 0xa2, 0x16, 0x5c, 0x1a, 0x2a, 0x53, 0x7b, 0x28, 0x1a, 0x17, 0xbc, 0xc9, 0x77,
+	0xed, 0xf5, 0xff, 0x5b, 0x76, 0xd4, 0xe5, 0xa8, 0x2b, 0xc7, 0x2f, 0xee, 0x80, 0xbe, 0x03, 0x19,
+	0x4f, 0x94, 0xd6, 0xf1, 0x25, 0xf8, 0xc1, 0x99, 0x80, 0xe8, 0xeb, 0x6b, 0xd4, 0xb4, 0xd7, 0xd7,
+	0xe6, 0x94, 0x7b, 0x02, 0x7c, 0x5e, 0xc5, 0xe3, 0x6f, 0xbf, 0x48, 0x80, 0xa8, 0xf2, 0x07, 0xbd,
+	0x1a, 0xcb, 0x8b, 0x7a, 0x80, 0x8f, 0xe0, 0x7c, 0xcb, 0xf1, 0xcc, 0x47, 0x8e, 0x1d, 0x10, 0x4b,
+	0x13, 0xb5, 0x45, 0xa4, 0xd9, 0x87, 0x5c, 0xf0, 0x95, 0x84, 0xe0, 0xdd, 0x2e, 0x67, 0x8d, 0x31,
+	0x46, 0x1a, 0x2e, 0xb5, 0x86, 0xc1, 0x28, 0x07, 0xe3, 0x01, 0x69, 0xfa, 0xd8, 0xe0, 0x91, 0x88,
+	0x07, 0x22, 0xb1, 0x4e, 0x9a, 0x51, 0xfc, 0x71, 0xae, 0x95, 0x4d, 0x98, 0x4d, 0x9e, 0x64, 0x24,
+	0x8b, 0xc2, 0x5c, 0xd4, 0xf8, 0xbc, 0xfe, 0x5e, 0x84, 0x89, 0x53, 0x62, 0x85, 0x51, 0xb3, 0xaa,
+	0x8a, 0xc1, 0xe6, 0xe8, 0x7b, 0xd2, 0xca, 0x77, 0x01, 0x9d, 0xcd, 0x05, 0x2f, 0x25, 0x41, 0x81,
+	0x85, 0x21, 0x47, 0xe3, 0xa5, 0x44, 0xdc, 0x81, 0x54, 0xd7, 0xa6, 0x97, 0x99, 0xb8, 0xf9, 0x8f,
+	0xa3, 0xac, 0x83, 0xfe, 0xcf, 0x2f, 0xb1, 0xf4, 0x3b, 0x4f, 0xb1, 0xf4, 0x83, 0xa7, 0x58, 0xfa,
+	0xf1, 0x53, 0x2c, 0x3d, 0x66, 0x9b, 0xf6, 0x0c, 0x7f, 0x5c, 0x48, 0x96, 0x2d, 0xb9, 0x7c, 0x7c,
+	0xa1, 0xe7, 0x1a, 0xf1, 0xfd, 0x9b, 0x2b, 0xf0, 0x52, 0x32, 0xd7, 0x5f, 0xb0, 0xe4, 0xf2, 0x43,
+	0xce, 0x4e, 0xae, 0x2f, 0x30, 0x7e, 0xf2, 0x0c, 0x7f, 0x8f, 0xb8, 0x2e, 0x3b, 0xba, 0x1f, 0xee,
+	0xd3, 0xce, 0x3a, 0x3b, 0xa7, 0x39, 0xd1, 0xdd, 0xfb, 0x1c, 0x88, 0x67, 0x89, 0x97, 0x03, 0x0e,
+	0x55, 0x12, 0x8f, 0x07, 0xb9, 0xa8, 0xa9, 0x10, 0xfd, 0xc8, 0x87, 0x85, 0x64, 0x8b, 0xc1, 0x85,
+	0x7d, 0xf2, 0x1c, 0xcb, 0x27, 0xb4, 0xf3, 0x61, 0x72, 0xd2, 0x3f, 0x3c, 0xc7, 0x58, 0x68, 0xb8,
+	0x4f, 0x3b, 0x9b, 0xfd, 0x3a, 0x7f, 0xfe, 0x5f, 0xf8, 0x83, 0x13, 0xda, 0x59, 0x4f, 0xbe, 0x50,
+	0xe4, 0x18, 0xc0, 0x54, 0xe4, 0x1f, 0x51, 0xba, 0xc8, 0xf5, 0x8a, 0xf5, 0x5c, 0xaf, 0x25, 0xfb,
+	0x8d, 0xf1, 0xe9, 0x0b, 0xf2, 0x45, 0x75, 0x25, 0xee, 0x93, 0xfc, 0x16, 0x61, 0x85, 0xc7, 0xa9,
+	0x63, 0x85, 0x6d, 0xca, 0x7b, 0xe2, 0xec, 0xdf, 0x49, 0x20, 0x0f, 0xde, 0xef, 0xac, 0x0b, 0x3e,
+	0xd5, 0xdd, 0xd0, 0xe7, 0xbb, 0xd5, 0xdf, 0x04, 0x36, 0x0c, 0xaa, 0xdf, 0x7e, 0x27, 0xaa, 0x43,
+	0x04, 0x17, 0xdb, 0x5a, 0x8f, 0xb4, 0xf9, 0x36, 0x8e, 0xab, 0xec, 0x93, 0x75, 0x40, 0x6d, 0xd3,
+	0xd6, 0x3c, 0xea, 0x5a, 0xa6, 0x4e, 0xe2, 0x16, 0x78, 0xa6, 0x6d, 0xda, 0x6a, 0x04, 0xa1, 0xf7,
+	0x01, 0x9a, 0x6e, 0x18, 0x17, 0x1d, 0xe3, 0x67, 0x5a, 0xb7, 0x1d, 0x37, 0x14, 0xda, 0x44, 0x6b,
+	0xa5, 0x9a, 0x31, 0x90, 0x0d, 0x20, 0xd5, 0xa5, 0xa2, 0x37, 0x60, 0x9c, 0xd7, 0x1b, 0xa2, 0x61,
+	0x47, 0xfd, 0x12, 0x78, 0xad, 0xc1, 0xe9, 0x2c, 0xda, 0xda, 0x8e, 0x41, 0xad, 0x38, 0xda, 0xf8,
+	0x00, 0x9d, 0x87, 0x29, 0xd6, 0xaa, 0x37, 0xdd, 0x90, 0xeb, 0x38, 0xa1, 0x4e, 0xda, 0x61, 0x7b,
+	0xc7, 0x0d, 0x63, 0x9b, 0xc6, 0xbb, 0x36, 0x65, 0x7f, 0x34, 0x0a, 0xf3, 0xec, 0x44, 0xf4, 0x97,
+	0xe6, 0x77, 0x60, 0x8a, 0xdd, 0x33, 0x71, 0x68, 0x0f, 0xed, 0x98, 0x67, 0x9e, 0x3c, 0xc7, 0xac,
+	0xe5, 0xe6, 0x76, 0x4c, 0x12, 0xf1, 0x2e, 0xf5, 0xed, 0x21, 0xd5, 0xbf, 0x78, 0x73, 0x1a, 0x56,
+	0x6c, 0x0f, 0x74, 0x04, 0x9b, 0x7f, 0x20, 0xfd, 0xe4, 0x19, 0x2e, 0xc6, 0xb1, 0x2a, 0xd6, 0xe9,
+	0x0f, 0xd7, 0x08, 0x1b, 0x88, 0xd8, 0x08, 0x4d, 0xc6, 0xdf, 0xa7, 0xcf, 0x70, 0x9f, 0x80, 0x81,
+	0x89, 0x43, 0x66, 0x0c, 0x1c, 0xac, 0xec, 0x4f, 0x47, 0x21, 0xc3, 0x3c, 0xd3, 0xab, 0xd4, 0x5e,
+	0xdd, 0x2d, 0xb7, 0x60, 0x36, 0x51, 0x0b, 0xc6, 0x2e, 0x39, 0x53, 0x09, 0xce, 0xf4, 0x2a, 0xc1,
+	0xce, 0xe6, 0x4f, 0x99, 0x33, 0xc8, 0xd7, 0xe2, 0x8c, 0x1c, 0x97, 0x2b, 0xd6, 0x16, 0xd2, 0x7a,
+	0xeb, 0x7c, 0xfa, 0x0c, 0x6f, 0xbe, 0xac, 0xa3, 0x7a, 0xb3, 0xb3, 0xbf, 0x94, 0x78, 0xfc, 0x44,
+	0x09, 0x47, 0xa5, 0x0f, 0x42, 0xd1, 0x89, 0xbf, 0x9a, 0xa3, 0x36, 0x7f, 0xfb, 0xeb, 0x0c, 0x80,
+	0xf5, 0x97, 0xb3, 0x2b, 0xfb, 0xcb, 0x51, 0x58, 0x2a, 0x74, 0x33, 0xce, 0xc7, 0x8e, 0x4d, 0x63,
+	0x7b, 0xae, 0xc0, 0x18, 0x71, 0xdd, 0xc8, 0x96, 0x4c, 0xbf, 0x2d, 0x2a, 0x23, 0xa1, 0x6b, 0x90,
+	0x31, 0xbc, 0x8e, 0xe6, 0x85, 0xb6, 0x26, 0x92, 0x16, 0xdf, 0xe3, 0x69, 0x75, 0xd6, 0xf0, 0x3a,
+	0x6a, 0x68, 0x0b, 0xb1, 0xe8, 0x02, 0xa4, 0xd8, 0xc1, 0x64, 0xa5, 0x5f, 0x9c, 0x3e, 0xa6, 0xed,
+	0xb0, 0xcd, 0x2a, 0x43, 0x7f, 0xf3, 0x53, 0x76, 0x0f, 0x7c, 0x8f, 0xdd, 0x99, 0xfd, 0x77, 0x01,
+	0x43, 0x7a, 0xf7, 0x01, 0x1b, 0xf5, 0xee, 0x84, 0x88, 0x9b, 0xdf, 0x0b, 0xac, 0xec, 0xeb, 0xbf,
+	0x1b, 0x18, 0x34, 0x78, 0x15, 0xd0, 0x84, 0x77, 0xd7, 0x87, 0xb9, 0x77, 0xfd, 0xeb, 0xb8, 0x12,
+	0xd6, 0xfe, 0x48, 0x82, 0x54, 0xf7, 0x0d, 0x0c, 0x9d, 0x03, 0xb4, 0x77, 0x57, 0xd9, 0x29, 0x6a,
+	0xf5, 0xc3, 0x6a, 0x51, 0x6b, 0x94, 0xf7, 0xcb, 0x95, 0x83, 0xb2, 0x3c, 0x82, 0x96, 0x60, 0x3e,
+	0x81, 0x17, 0x2a, 0xf9, 0xfd, 0xa2, 0x2a, 0x4b, 0x68, 0x01, 0xe6, 0x12, 0xf0, 0xbd, 0x7c, 0xe5,
+	0x40, 0x1e, 0x1d, 0x00, 0x77, 0x8b, 0xa5, 0xbb, 0xf2, 0x18, 0x42, 0x90, 0x49, 0x80, 0x95, 0xfb,
+	0xdb, 0xf2, 0xf8, 0x19, 0x4c, 0x91, 0x27, 0xd6, 0xfe, 0x58, 0x82, 0xf9, 0x33, 0xfd, 0x12, 0x13,
+	0x79, 0xaf, 0x52, 0xd3, 0xca, 0x15, 0xad, 0xaa, 0xee, 0x55, 0xd4, 0xbd, 0xfa, 0xa1, 0x3c, 0x12,
+	0x83, 0xa5, 0xca, 0x81, 0x56, 0x52, 0xea, 0xc5, 0x72, 0xfe, 0x50, 0x96, 0xd0, 0x32, 0x2c, 0x31,
+	0xb0, 0xbe, 0xab, 0x56, 0x1a, 0x3b, 0xbb, 0xd5, 0x46, 0x5d, 0x2b, 0x54, 0x0e, 0xca, 0x5a, 0x4d,
+	0x1e, 0x7d, 0x11, 0x89, 0x69, 0xf7, 0x02, 0x52, 0x49, 0x1e, 0x5f, 0xfb, 0x5b, 0x09, 0x66, 0x12,
+	0xad, 0x23, 0xf3, 0xc4, 0xfd, 0xbb, 0x9a, 0x52, 0xad, 0x6a, 0x95, 0x5a, 0xc2, 0x41, 0x0b, 0x30,
+	0xd7, 0x83, 0x4b, 0x7b, 0xe5, 0xc6, 0x47, 0xb2, 0x84, 0x30, 0x2c, 0xf6, 0xc0, 0x83, 0xbd, 0x72,
+	0xa1, 0x72, 0x50, 0xd3, 0x6e, 0xde, 0x90, 0x47, 0xd1, 0x0a, 0x9c, 0x3b, 0x4b, 0xb9, 0x75, 0xe3,
+	0xe6, 0x2d, 0x79, 0xec, 0x85, 0xb4, 0xdb, 0xf2, 0xf8, 0x0b, 0x69, 0xef, 0xcb, 0x13, 0x6b, 0x37,
+	0x01, 0x7a, 0x0f, 0x5a, 0xcc, 0xb9, 0xe5, 0x8a, 0xa6, 0x34, 0xea, 0x15, 0xad, 0x50, 0x2c, 0x15,
+	0xeb, 0x45, 0x79, 0x04, 0xcd, 0xc1, 0x4c, 0x12, 0x90, 0xd6, 0x4e, 0x00, 0x7a, 0x6f, 0x37, 0xe8,
+	0x0d, 0xc8, 0x2a, 0xf9, 0x7c, 0xb1, 0x56, 0x8b, 0x76, 0xb9, 0xb8, 0xad, 0x34, 0x4a, 0x75, 0x6d,
+	0xbb, 0xa2, 0x6a, 0x85, 0x62, 0xb5, 0x54, 0x39, 0xbc, 0x5b, 0x2c, 0xd7, 0xe5, 0x11, 0x16, 0x24,
+	0x7d, 0x7c, 0x7b, 0x6a, 0x31, 0x5f, 0x97, 0x25, 0x74, 0x09, 0x96, 0x93, 0x78, 0xa9, 0xa2, 0x14,
+	0xb4, 0x2d, 0xa5, 0xa4, 0x94, 0xf3, 0x45, 0x55, 0x1e, 0x5d, 0x6b, 0xc1, 0xc2, 0x90, 0x1a, 0x1d,
+	0x2d, 0x82, 0xac, 0x2a, 0xe5, 0x7d, 0x6d, 0xeb, 0x50, 0x2b, 0xec, 0xd5, 0xea, 0x8c, 0x5b, 0xf8,
+	0x33, 0x46, 0x7b, 0x9b, 0x2b, 0xc3, 0x6c, 0x17, 0xac, 0x28, 0x05, 0x79, 0x34, 0x39, 0xf9, 0xa0,
+	0xb8, 0xb7, 0xb3, 0x5b, 0x2f, 0x16, 0xe4, 0xb1, 0xb5, 0x0a, 0x64, 0xfa, 0x7f, 0x2f, 0x30, 0x71,
+	0x8d, 0x6a, 0x41, 0xa9, 0x17, 0xb5, 0xbd, 0xb2, 0x56, 0x2d, 0x29, 0x7c, 0x8d, 0x25, 0x98, 0x8f,
+	0xc0, 0xad, 0x52, 0xa3, 0xa8, 0xed, 0xa8, 0xc5, 0x62, 0x59, 0x96, 0xd0, 0x3c, 0xa4, 0x23, 0x38,
+	0xaf, 0x94, 0x15, 0xf5, 0x50, 0x1e, 0x5d, 0xdb, 0x84, 0x4c, 0xff, 0x1b, 0x3f, 0x9b, 0xbb, 0xa5,
+	0xd4, 0xf3, 0xbb, 0x6c, 0xe5, 0x7c, 0xa9, 0xd2, 0x28, 0x94, 0x8a, 0xcc, 0x35, 0xf3, 0x90, 0xee,
+	0xc2, 0x1f, 0x57, 0xca, 0xcc, 0xc7, 0x7f, 0x21, 0xc1, 0x6c, 0xf2, 0xf1, 0x9e, 0x5b, 0x51, 0x29,
+	0x95, 0x2a, 0x8d, 0xba, 0x56, 0x66, 0x2c, 0xc2, 0xd8, 0x08, 0xa9, 0x16, 0xcb, 0x85, 0xbd, 0xf2,
+	0x8e, 0x2c, 0xa1, 0xf3, 0xb0, 0x10, 0x83, 0x4c, 0x67, 0xb5, 0xb2, 0xa3, 0x16, 0x6b, 0x2c, 0x8e,
+	0x11, 0x64, 0xba, 0xdc, 0x4a, 0xa3, 0xc6, 0x2c, 0x4e, 0xca, 0x2c, 0x30, 0x99, 0xe3, 0x49, 0xae,
+	0x6d, 0x65, 0xaf, 0x54, 0x2c, 0xc8, 0x13, 0xc9, 0x75, 0x94, 0xad, 0x8a, 0xca, 0x9c, 0x35, 0xb9,
+	0x56, 0x83, 0xa9, 0xa8, 0x54, 0x61, 0xda, 0xef, 0x54, 0x1b, 0x62, 0xf7, 0x22, 0xd5, 0x64, 0x98,
+	0xed, 0x42, 0x4a, 0xf9, 0x50, 0xb8, 0xa7, 0x8b, 0xdc, 0xdf, 0xa9, 0x36, 0xe4, 0xd1, 0x3e, 0xa6,
+	0x6a, 0x7e, 0x4f, 0x1e, 0xbb, 0xf5, 0xe3, 0x0c, 0xff, 0x03, 0xa6, 0xb8, 0x26, 0x62, 0x09, 0x46,
+	0x64, 0x45, 0xc5, 0x75, 0xd1, 0x40, 0x4e, 0x5e, 0x49, 0xde, 0x37, 0x2a, 0xff, 0xb7, 0x97, 0xfd,
+	0xcd, 0x27, 0x4f, 0xf1, 0x5a, 0xdc, 0x70, 0x2b, 0xae, 0xeb, 0xe7, 0xc4, 0x73, 0xc0, 0x5d, 0xde,
+	0xc0, 0xe6, 0x06, 0x53, 0xdc, 0x67, 0xcf, 0xb0, 0xf4, 0x2f, 0xcf, 0xb0, 0xdc, 0x18, 0x78, 0x3d,
+	0xf8, 0xdd, 0x7f, 0xfa, 0xf7, 0x3f, 0x19, 0x95, 0xb3, 0x33, 0x1b, 0xe2, 0xcd, 0x6d, 0x83, 0xb8,
+	0xee, 0xa6, 0xb4, 0xc6, 0xd5, 0x11, 0xc7, 0xe4, 0x1b, 0x52, 0x47, 0x3c, 0x7b, 0xc6, 0xea, 0xfc,
+	0x16, 0xa4, 0x04, 0xe7, 0xff, 0x53, 0x9b, 0xdd, 0x97, 0xd7, 0xa6, 0xbb, 0xb2, 0x78, 0x5f, 0x89,
+	0x57, 0xfe, 0x3d, 0x09, 0xa6, 0x6a, 0x2d, 0xe7, 0xe1, 0xb0, 0x85, 0x07, 0xc6, 0xd9, 0x8f, 0x9e,
+	0x3c, 0xc5, 0xd7, 0x87, 0xac, 0x7a, 0xdf, 0xa4, 0x0f, 0x5f, 0xce, 0x03, 0x99, 0x6c, 0x6a, 0xc3,
+	0x6f, 0x39, 0x0f, 0x23, 0x2d, 0x6e, 0x48, 0xe8, 0x2f, 0x25, 0x58, 0x54, 0x0c, 0xe3, 0x6c, 0x6d,
+	0x7b, 0xb1, 0x5f, 0x89, 0x7e, 0xea, 0x30, 0xdf, 0xdc, 0x7f, 0xf2, 0x14, 0xbf, 0xf5, 0x62, 0xdf,
+	0x0c, 0xa9, 0x24, 0x1e, 0xc7, 0xee, 0xb9, 0x90, 0x3d, 0xb7, 0x41, 0x0c, 0x83, 0x69, 0xc5, 0x4a,
+	0x5d, 0x56, 0x15, 0x8b, 0x2a, 0x8c, 0x79, 0xea, 0x6f, 0x24, 0x38, 0xaf, 0xd2, 0xb6, 0x73, 0x4a,
+	0xbf, 0x06, 0x25, 0x0f, 0x5f, 0x5d, 0xc9, 0xd5, 0xec, 0xf2, 0x86, 0xc7, 0xf5, 0x18, 0xae, 0xe7,
+	0x9f, 0xb1, 0x12, 0x4f, 0x78, 0x32, 0x51, 0x0b, 0x2f, 0x0f, 0x68, 0xd8, 0x23, 0x0d, 0x53, 0xaf,
+	0xf6, 0xea, 0xea, 0xe1, 0xec, 0x42, 0xd7, 0x87, 0xbd, 0x32, 0x96, 0x29, 0xf6, 0xe7, 0x12, 0x2c,
+	0xf6, 0x1c, 0xf8, 0xca, 0xba, 0x7d, 0xc5, 0xfd, 0x4d, 0xb8, 0xae, 0x5f, 0xbd, 0x3f, 0x95, 0x60,
+	0xae, 0x4a, 0x42, 0x9f, 0xf6, 0xea, 0xe3, 0xc1, 0x7d, 0xed, 0x2f, 0x9b, 0x87, 0x29, 0x77, 0xef,
+	0xd5, 0x95, 0x3b, 0x97, 0x9d, 0xdf, 0x70, 0xd9, 0xfa, 0x4c, 0xb7, 0xe8, 0x31, 0x88, 0xe9, 0xf5,
+	0x23, 0x09, 0x64, 0x26, 0xbd, 0xfd, 0x95, 0x14, 0x53, 0x5f, 0x5d, 0xb1, 0xf3, 0x59, 0xb4, 0xe1,
+	0x71, 0x05, 0x06, 0x34, 0x63, 0x1e, 0x53, 0x8e, 0x1c, 0x2f, 0xf8, 0x06, 0x3d, 0x46, 0xd8, 0xfa,
+	0x03, 0x7a, 0xfd, 0xb5, 0x04, 0xcb, 0x2c, 0xa7, 0xb1, 0x96, 0xc0, 0xdf, 0x76, 0x3c, 0xc5, 0x75,
+	0x7b, 0x7d, 0x02, 0xba, 0xd2, 0xf7, 0xeb, 0x6f, 0x48, 0xfb, 0xb0, 0x92, 0xec, 0xdf, 0x19, 0xbe,
+	0x4f, 0x3b, 0xd9, 0xd2, 0x93, 0xa7, 0x78, 0x39, 0x56, 0x93, 0x0b, 0x4e, 0x26, 0xbf, 0x9f, 0x3d,
+	0xc3, 0x52, 0x37, 0xc9, 0x5e, 0xcd, 0x5e, 0xe4, 0xc9, 0xad, 0x4d, 0x5c, 0xd7, 0xb4, 0x9b, 0x1b,
+	0xbd, 0x57, 0x91, 0x47, 0x6c, 0x1e, 0xcf, 0x77, 0x5b, 0x17, 0x1f, 0xff, 0xdb, 0xea, 0xc8, 0xe3,
+	0xcf, 0x57, 0xa5, 0xcf, 0x3e, 0x5f, 0x95, 0xfe, 0xf5, 0xf3, 0x55, 0xe9, 0x07, 0x5f, 0xac, 0x8e,
+	0x7c, 0xf6, 0xc5, 0xea, 0xc8, 0x3f, 0x7f, 0xb1, 0x3a, 0x72, 0x34, 0xc9, 0x17, 0x7f, 0xfb, 0x7f,
+	0x03, 0x00, 0x00, 0xff, 0xff, 0x21, 0xad, 0x9f, 0xc3, 0x1d, 0x23, 0x00, 0x00,
 }
 
 func (this *AppKey) GoString() string {
@@ -979,6 +1401,12 @@ type AppApiClient interface {
 	AddAppAlertPolicy(ctx context.Context, in *AppAlertPolicy, opts ...grpc.CallOption) (*Result, error)
 	// Remove an AlertPolicy from the application definition
 	RemoveAppAlertPolicy(ctx context.Context, in *AppAlertPolicy, opts ...grpc.CallOption) (*Result, error)
+	// Pause the staged rollout of an App after the current batch
+	PauseAppRollout(ctx context.Context, in *AppRolloutRequest, opts ...grpc.CallOption) (*Result, error)
+	// Resume a paused staged rollout of an App
+	ResumeAppRollout(ctx context.Context, in *AppRolloutRequest, opts ...grpc.CallOption) (*Result, error)
+	// Abort the staged rollout of an App, AppInsts in remaining batches are not updated
+	AbortAppRollout(ctx context.Context, in *AppRolloutRequest, opts ...grpc.CallOption) (*Result, error)
 	// Discover zones supporting deployments of App.DefaultFlavor
 	ShowZonesForAppDeployment(ctx context.Context, in *DeploymentZoneRequest, opts ...grpc.CallOption) (AppApi_ShowZonesForAppDeploymentClient, error)
 }
@@ -1086,6 +1514,33 @@ func (c *appApiClient) RemoveAppAlertPolicy(ctx context.Context, in *AppAlertPol
 	return out, nil
 }
 
+func (c *appApiClient) PauseAppRollout(ctx context.Context, in *AppRolloutRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppApi/PauseAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appApiClient) ResumeAppRollout(ctx context.Context, in *AppRolloutRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppApi/ResumeAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appApiClient) AbortAppRollout(ctx context.Context, in *AppRolloutRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.AppApi/AbortAppRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appApiClient) ShowZonesForAppDeployment(ctx context.Context, in *DeploymentZoneRequest, opts ...grpc.CallOption) (AppApi_ShowZonesForAppDeploymentClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_AppApi_serviceDesc.Streams[1], "/edgeproto.AppApi/ShowZonesForAppDeployment", opts...)
 	if err != nil {
@@ -1136,6 +1591,12 @@ type AppApiServer interface {
 	AddAppAlertPolicy(context.Context, *AppAlertPolicy) (*Result, error)
 	// Remove an AlertPolicy from the application definition
 	RemoveAppAlertPolicy(context.Context, *AppAlertPolicy) (*Result, error)
+	// Pause the staged rollout of an App after the current batch
+	PauseAppRollout(context.Context, *AppRolloutRequest) (*Result, error)
+	// Resume a paused staged rollout of an App
+	ResumeAppRollout(context.Context, *AppRolloutRequest) (*Result, error)
+	// Abort the staged rollout of an App, AppInsts in remaining batches are not updated
+	AbortAppRollout(context.Context, *AppRolloutRequest) (*Result, error)
 	// Discover zones supporting deployments of App.DefaultFlavor
 	ShowZonesForAppDeployment(*DeploymentZoneRequest, AppApi_ShowZonesForAppDeploymentServer) error
 }
@@ -1168,6 +1629,15 @@ func (*UnimplementedAppApiServer) AddAppAlertPolicy(ctx context.Context, req *Ap
 func (*UnimplementedAppApiServer) RemoveAppAlertPolicy(ctx context.Context, req *AppAlertPolicy) (*Result, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppAlertPolicy not implemented")
 }
+func (*UnimplementedAppApiServer) PauseAppRollout(ctx context.Context, req *AppRolloutRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAppRollout not implemented")
+}
+func (*UnimplementedAppApiServer) ResumeAppRollout(ctx context.Context, req *AppRolloutRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAppRollout not implemented")
+}
+func (*UnimplementedAppApiServer) AbortAppRollout(ctx context.Context, req *AppRolloutRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortAppRollout not implemented")
+}
 func (*UnimplementedAppApiServer) ShowZonesForAppDeployment(req *DeploymentZoneRequest, srv AppApi_ShowZonesForAppDeploymentServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowZonesForAppDeployment not implemented")
 }
@@ -1323,6 +1793,60 @@ func _AppApi_RemoveAppAlertPolicy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppApi_PauseAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppApiServer).PauseAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppApi/PauseAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppApiServer).PauseAppRollout(ctx, req.(*AppRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppApi_ResumeAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppApiServer).ResumeAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppApi/ResumeAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppApiServer).ResumeAppRollout(ctx, req.(*AppRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppApi_AbortAppRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppApiServer).AbortAppRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.AppApi/AbortAppRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppApiServer).AbortAppRollout(ctx, req.(*AppRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppApi_ShowZonesForAppDeployment_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(DeploymentZoneRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1376,6 +1900,18 @@ var _AppApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAppAlertPolicy",
 			Handler:    _AppApi_RemoveAppAlertPolicy_Handler,
 		},
+		{
+			MethodName: "PauseAppRollout",
+			Handler:    _AppApi_PauseAppRollout_Handler,
+		},
+		{
+			MethodName: "ResumeAppRollout",
+			Handler:    _AppApi_ResumeAppRollout_Handler,
+		},
+		{
+			MethodName: "AbortAppRollout",
+			Handler:    _AppApi_AbortAppRollout_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1436,7 +1972,7 @@ func (m *AppKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConfigFile) Marshal() (dAtA []byte, err error) {
+func (m *FindCloudletRankingWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1446,34 +1982,38 @@ func (m *ConfigFile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConfigFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *FindCloudletRankingWeights) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConfigFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FindCloudletRankingWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintApp(dAtA, i, uint64(len(m.Config)))
+	if m.Load != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Load))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x19
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintApp(dAtA, i, uint64(len(m.Kind)))
+	if m.Latency != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Latency))))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x11
+	}
+	if m.Distance != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Distance))))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *App) Marshal() (dAtA []byte, err error) {
+func (m *RolloutPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1483,27 +2023,172 @@ func (m *App) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *App) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *App) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for k := range m.Tags {
-			v := m.Tags[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApp(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
+	if m.ObservationTime != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.ObservationTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchBy != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.BatchBy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HealthTimeout != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.HealthTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CanaryPercent != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.CanaryPercent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForceUpdate {
+		i--
+		if m.ForceUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumBatches != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.NumBatches))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Batch != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.Batch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.State != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *App) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *App) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *App) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApp(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
 			i = encodeVarintApp(dAtA, i, uint64(len(k)))
 			i--
 			dAtA[i] = 0xa
@@ -1514,6 +2199,69 @@ func (m *App) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa2
 		}
 	}
+	if m.HorizontalScalePolicy != nil {
+		{
+			size, err := m.HorizontalScalePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.RolloutStatus != nil {
+		{
+			size, err := m.RolloutStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.RolloutPolicy != nil {
+		{
+			size, err := m.RolloutPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.FindCloudletRankingWeights != nil {
+		{
+			size, err := m.FindCloudletRankingWeights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.FindCloudletRanking != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.FindCloudletRanking))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
 	if m.CompatibilityVersion != 0 {
 		i = encodeVarintApp(dAtA, i, uint64(m.CompatibilityVersion))
 		i--
@@ -2199,6 +2947,39 @@ func (m *AppAlertPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AppRolloutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AppKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DeploymentZoneRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2372,65 +3153,210 @@ func (m *AppKey) ValidateEnums() error {
 func (s *AppKey) ClearTagged(tags map[string]struct{}) {
 }
 
-func (m *ConfigFile) Clone() *ConfigFile {
-	cp := &ConfigFile{}
+func (m *FindCloudletRankingWeights) Clone() *FindCloudletRankingWeights {
+	cp := &FindCloudletRankingWeights{}
 	cp.DeepCopyIn(m)
 	return cp
 }
 
-func (m *ConfigFile) CopyInFields(src *ConfigFile) int {
+func (m *FindCloudletRankingWeights) CopyInFields(src *FindCloudletRankingWeights) int {
 	changed := 0
-	if m.Kind != src.Kind {
-		m.Kind = src.Kind
+	if m.Distance != src.Distance {
+		m.Distance = src.Distance
 		changed++
 	}
-	if m.Config != src.Config {
-		m.Config = src.Config
+	if m.Latency != src.Latency {
+		m.Latency = src.Latency
+		changed++
+	}
+	if m.Load != src.Load {
+		m.Load = src.Load
 		changed++
 	}
 	return changed
 }
 
-func (m *ConfigFile) DeepCopyIn(src *ConfigFile) {
-	m.Kind = src.Kind
-	m.Config = src.Config
+func (m *FindCloudletRankingWeights) DeepCopyIn(src *FindCloudletRankingWeights) {
+	m.Distance = src.Distance
+	m.Latency = src.Latency
+	m.Load = src.Load
 }
 
 // Helper method to check that enums have valid values
-func (m *ConfigFile) ValidateEnums() error {
+func (m *FindCloudletRankingWeights) ValidateEnums() error {
 	return nil
 }
 
-func (s *ConfigFile) ClearTagged(tags map[string]struct{}) {
+func (s *FindCloudletRankingWeights) ClearTagged(tags map[string]struct{}) {
 }
 
-func (m *App) Matches(o *App, fopts ...MatchOpt) bool {
-	opts := MatchOptions{}
-	applyMatchOptions(&opts, fopts...)
-	if o == nil {
-		if opts.Filter {
-			return true
-		}
-		return false
+func (m *RolloutPolicy) Clone() *RolloutPolicy {
+	cp := &RolloutPolicy{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *RolloutPolicy) CopyInFields(src *RolloutPolicy) int {
+	changed := 0
+	if m.Strategy != src.Strategy {
+		m.Strategy = src.Strategy
+		changed++
 	}
-	if !m.Key.Matches(&o.Key, fopts...) {
-		return false
+	if m.CanaryPercent != src.CanaryPercent {
+		m.CanaryPercent = src.CanaryPercent
+		changed++
 	}
-	if !opts.IgnoreBackend {
-		if !opts.Filter || o.ImagePath != "" {
-			if o.ImagePath != m.ImagePath {
-				return false
-			}
-		}
+	if m.HealthTimeout != src.HealthTimeout {
+		m.HealthTimeout = src.HealthTimeout
+		changed++
 	}
-	if !opts.Filter || o.ImageType != 0 {
-		if o.ImageType != m.ImageType {
-			return false
-		}
+	if m.BatchBy != src.BatchBy {
+		m.BatchBy = src.BatchBy
+		changed++
 	}
-	if !opts.Filter || o.AccessPorts != "" {
-		if o.AccessPorts != m.AccessPorts {
-			return false
+	if m.BatchSize != src.BatchSize {
+		m.BatchSize = src.BatchSize
+		changed++
+	}
+	if m.ObservationTime != src.ObservationTime {
+		m.ObservationTime = src.ObservationTime
+		changed++
+	}
+	return changed
+}
+
+func (m *RolloutPolicy) DeepCopyIn(src *RolloutPolicy) {
+	m.Strategy = src.Strategy
+	m.CanaryPercent = src.CanaryPercent
+	m.HealthTimeout = src.HealthTimeout
+	m.BatchBy = src.BatchBy
+	m.BatchSize = src.BatchSize
+	m.ObservationTime = src.ObservationTime
+}
+
+// Helper method to check that enums have valid values
+func (m *RolloutPolicy) ValidateEnums() error {
+	if _, ok := UpdateStrategy_name[int32(m.Strategy)]; !ok {
+		return errors.New("invalid Strategy")
+	}
+	if _, ok := RolloutBatchBy_name[int32(m.BatchBy)]; !ok {
+		return errors.New("invalid BatchBy")
+	}
+	return nil
+}
+
+func (s *RolloutPolicy) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *RolloutStatus) Clone() *RolloutStatus {
+	cp := &RolloutStatus{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *RolloutStatus) CopyInFields(src *RolloutStatus) int {
+	changed := 0
+	if m.State != src.State {
+		m.State = src.State
+		changed++
+	}
+	if m.Batch != src.Batch {
+		m.Batch = src.Batch
+		changed++
+	}
+	if m.NumBatches != src.NumBatches {
+		m.NumBatches = src.NumBatches
+		changed++
+	}
+	if m.Controller != src.Controller {
+		m.Controller = src.Controller
+		changed++
+	}
+	if m.ForceUpdate != src.ForceUpdate {
+		m.ForceUpdate = src.ForceUpdate
+		changed++
+	}
+	return changed
+}
+
+func (m *RolloutStatus) DeepCopyIn(src *RolloutStatus) {
+	m.State = src.State
+	m.Batch = src.Batch
+	m.NumBatches = src.NumBatches
+	m.Controller = src.Controller
+	m.ForceUpdate = src.ForceUpdate
+}
+
+// Helper method to check that enums have valid values
+func (m *RolloutStatus) ValidateEnums() error {
+	if _, ok := RolloutState_name[int32(m.State)]; !ok {
+		return errors.New("invalid State")
+	}
+	return nil
+}
+
+func (s *RolloutStatus) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *ConfigFile) Clone() *ConfigFile {
+	cp := &ConfigFile{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *ConfigFile) CopyInFields(src *ConfigFile) int {
+	changed := 0
+	if m.Kind != src.Kind {
+		m.Kind = src.Kind
+		changed++
+	}
+	if m.Config != src.Config {
+		m.Config = src.Config
+		changed++
+	}
+	return changed
+}
+
+func (m *ConfigFile) DeepCopyIn(src *ConfigFile) {
+	m.Kind = src.Kind
+	m.Config = src.Config
+}
+
+// Helper method to check that enums have valid values
+func (m *ConfigFile) ValidateEnums() error {
+	return nil
+}
+
+func (s *ConfigFile) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *App) Matches(o *App, fopts ...MatchOpt) bool {
+	opts := MatchOptions{}
+	applyMatchOptions(&opts, fopts...)
+	if o == nil {
+		if opts.Filter {
+			return true
+		}
+		return false
+	}
+	if !m.Key.Matches(&o.Key, fopts...) {
+		return false
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.ImagePath != "" {
+			if o.ImagePath != m.ImagePath {
+				return false
+			}
+		}
+	}
+	if !opts.Filter || o.ImageType != 0 {
+		if o.ImageType != m.ImageType {
+			return false
+		}
+	}
+	if !opts.Filter || o.AccessPorts != "" {
+		if o.AccessPorts != m.AccessPorts {
+			return false
 		}
 	}
 	if !m.DefaultFlavor.Matches(&o.DefaultFlavor, fopts...) {
@@ -2752,6 +3678,37 @@ func (m *App) Matches(o *App, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.FindCloudletRanking != 0 {
+		if o.FindCloudletRanking != m.FindCloudletRanking {
+			return false
+		}
+	}
+	if !opts.Filter || o.FindCloudletRankingWeights != nil {
+		if m.FindCloudletRankingWeights == nil && o.FindCloudletRankingWeights != nil || m.FindCloudletRankingWeights != nil && o.FindCloudletRankingWeights == nil {
+			return false
+		} else if m.FindCloudletRankingWeights != nil && o.FindCloudletRankingWeights != nil {
+		}
+	}
+	if !opts.Filter || o.RolloutPolicy != nil {
+		if m.RolloutPolicy == nil && o.RolloutPolicy != nil || m.RolloutPolicy != nil && o.RolloutPolicy == nil {
+			return false
+		} else if m.RolloutPolicy != nil && o.RolloutPolicy != nil {
+		}
+	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.RolloutStatus != nil {
+			if m.RolloutStatus == nil && o.RolloutStatus != nil || m.RolloutStatus != nil && o.RolloutStatus == nil {
+				return false
+			} else if m.RolloutStatus != nil && o.RolloutStatus != nil {
+			}
+		}
+	}
+	if !opts.Filter || o.HorizontalScalePolicy != nil {
+		if m.HorizontalScalePolicy == nil && o.HorizontalScalePolicy != nil || m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy == nil {
+			return false
+		} else if m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy != nil {
+		}
+	}
 	if !opts.Filter || o.Tags != nil {
 		if len(m.Tags) == 0 && len(o.Tags) > 0 || len(m.Tags) > 0 && len(o.Tags) == 0 {
 			return false
@@ -2816,6 +3773,7 @@ const AppFieldRequiredOutboundConnectionsProtocol = "38.1"
 const AppFieldRequiredOutboundConnectionsPortRangeMin = "38.2"
 const AppFieldRequiredOutboundConnectionsPortRangeMax = "38.3"
 const AppFieldRequiredOutboundConnectionsRemoteCidr = "38.4"
+const AppFieldRequiredOutboundConnectionsRemoteFqdn = "38.5"
 const AppFieldAllowServerless = "39"
 const AppFieldServerlessConfig = "40"
 const AppFieldServerlessConfigVcpus = "40.1"
@@ -2893,6 +3851,31 @@ const AppFieldAppAnnotationsValue = "53.2"
 const AppFieldIsStandalone = "54"
 const AppFieldManagesOwnNamespaces = "55"
 const AppFieldCompatibilityVersion = "56"
+const AppFieldFindCloudletRanking = "57"
+const AppFieldFindCloudletRankingWeights = "58"
+const AppFieldFindCloudletRankingWeightsDistance = "58.1"
+const AppFieldFindCloudletRankingWeightsLatency = "58.2"
+const AppFieldFindCloudletRankingWeightsLoad = "58.3"
+const AppFieldRolloutPolicy = "59"
+const AppFieldRolloutPolicyStrategy = "59.1"
+const AppFieldRolloutPolicyCanaryPercent = "59.2"
+const AppFieldRolloutPolicyHealthTimeout = "59.3"
+const AppFieldRolloutPolicyBatchBy = "59.4"
+const AppFieldRolloutPolicyBatchSize = "59.5"
+const AppFieldRolloutPolicyObservationTime = "59.6"
+const AppFieldRolloutStatus = "60"
+const AppFieldRolloutStatusState = "60.1"
+const AppFieldRolloutStatusBatch = "60.2"
+const AppFieldRolloutStatusNumBatches = "60.3"
+const AppFieldRolloutStatusController = "60.4"
+const AppFieldRolloutStatusForceUpdate = "60.5"
+const AppFieldHorizontalScalePolicy = "61"
+const AppFieldHorizontalScalePolicyMinReplicas = "61.1"
+const AppFieldHorizontalScalePolicyMaxReplicas = "61.2"
+const AppFieldHorizontalScalePolicyTargetCpu = "61.3"
+const AppFieldHorizontalScalePolicyTargetMem = "61.4"
+const AppFieldHorizontalScalePolicyTargetActiveConnections = "61.5"
+const AppFieldHorizontalScalePolicyStabilizationWindowSec = "61.6"
 const AppFieldTags = "100"
 const AppFieldTagsKey = "100.1"
 const AppFieldTagsValue = "100.2"
@@ -2935,6 +3918,7 @@ var AppAllFields = []string{
 	AppFieldRequiredOutboundConnectionsPortRangeMin,
 	AppFieldRequiredOutboundConnectionsPortRangeMax,
 	AppFieldRequiredOutboundConnectionsRemoteCidr,
+	AppFieldRequiredOutboundConnectionsRemoteFqdn,
 	AppFieldAllowServerless,
 	AppFieldServerlessConfigVcpusWhole,
 	AppFieldServerlessConfigVcpusNanos,
@@ -2993,6 +3977,27 @@ var AppAllFields = []string{
 	AppFieldIsStandalone,
 	AppFieldManagesOwnNamespaces,
 	AppFieldCompatibilityVersion,
+	AppFieldFindCloudletRanking,
+	AppFieldFindCloudletRankingWeightsDistance,
+	AppFieldFindCloudletRankingWeightsLatency,
+	AppFieldFindCloudletRankingWeightsLoad,
+	AppFieldRolloutPolicyStrategy,
+	AppFieldRolloutPolicyCanaryPercent,
+	AppFieldRolloutPolicyHealthTimeout,
+	AppFieldRolloutPolicyBatchBy,
+	AppFieldRolloutPolicyBatchSize,
+	AppFieldRolloutPolicyObservationTime,
+	AppFieldRolloutStatusState,
+	AppFieldRolloutStatusBatch,
+	AppFieldRolloutStatusNumBatches,
+	AppFieldRolloutStatusController,
+	AppFieldRolloutStatusForceUpdate,
+	AppFieldHorizontalScalePolicyMinReplicas,
+	AppFieldHorizontalScalePolicyMaxReplicas,
+	AppFieldHorizontalScalePolicyTargetCpu,
+	AppFieldHorizontalScalePolicyTargetMem,
+	AppFieldHorizontalScalePolicyTargetActiveConnections,
+	AppFieldHorizontalScalePolicyStabilizationWindowSec,
 	AppFieldTagsKey,
 	AppFieldTagsValue,
 }
@@ -3035,6 +4040,7 @@ var AppAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppFieldRequiredOutboundConnectionsPortRangeMin:              struct{}{},
 	AppFieldRequiredOutboundConnectionsPortRangeMax:              struct{}{},
 	AppFieldRequiredOutboundConnectionsRemoteCidr:                struct{}{},
+	AppFieldRequiredOutboundConnectionsRemoteFqdn:                struct{}{},
 	AppFieldAllowServerless:                                      struct{}{},
 	AppFieldServerlessConfigVcpusWhole:                           struct{}{},
 	AppFieldServerlessConfigVcpusNanos:                           struct{}{},
@@ -3093,6 +4099,27 @@ var AppAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppFieldIsStandalone:                                         struct{}{},
 	AppFieldManagesOwnNamespaces:                                 struct{}{},
 	AppFieldCompatibilityVersion:                                 struct{}{},
+	AppFieldFindCloudletRanking:                                  struct{}{},
+	AppFieldFindCloudletRankingWeightsDistance:                   struct{}{},
+	AppFieldFindCloudletRankingWeightsLatency:                    struct{}{},
+	AppFieldFindCloudletRankingWeightsLoad:                       struct{}{},
+	AppFieldRolloutPolicyStrategy:                                struct{}{},
+	AppFieldRolloutPolicyCanaryPercent:                           struct{}{},
+	AppFieldRolloutPolicyHealthTimeout:                           struct{}{},
+	AppFieldRolloutPolicyBatchBy:                                 struct{}{},
+	AppFieldRolloutPolicyBatchSize:                               struct{}{},
+	AppFieldRolloutPolicyObservationTime:                         struct{}{},
+	AppFieldRolloutStatusState:                                   struct{}{},
+	AppFieldRolloutStatusBatch:                                   struct{}{},
+	AppFieldRolloutStatusNumBatches:                              struct{}{},
+	AppFieldRolloutStatusController:                              struct{}{},
+	AppFieldRolloutStatusForceUpdate:                             struct{}{},
+	AppFieldHorizontalScalePolicyMinReplicas:                     struct{}{},
+	AppFieldHorizontalScalePolicyMaxReplicas:                     struct{}{},
+	AppFieldHorizontalScalePolicyTargetCpu:                       struct{}{},
+	AppFieldHorizontalScalePolicyTargetMem:                       struct{}{},
+	AppFieldHorizontalScalePolicyTargetActiveConnections:         struct{}{},
+	AppFieldHorizontalScalePolicyStabilizationWindowSec:          struct{}{},
 	AppFieldTagsKey:                                              struct{}{},
 	AppFieldTagsValue:                                            struct{}{},
 })
@@ -3135,6 +4162,7 @@ var AppAllFieldsStringMap = map[string]string{
 	AppFieldRequiredOutboundConnectionsPortRangeMin:              "Required Outbound Connections Port Range Min",
 	AppFieldRequiredOutboundConnectionsPortRangeMax:              "Required Outbound Connections Port Range Max",
 	AppFieldRequiredOutboundConnectionsRemoteCidr:                "Required Outbound Connections Remote Cidr",
+	AppFieldRequiredOutboundConnectionsRemoteFqdn:                "Required Outbound Connections Remote Fqdn",
 	AppFieldAllowServerless:                                      "Allow Serverless",
 	AppFieldServerlessConfigVcpusWhole:                           "Serverless Config Vcpus Whole",
 	AppFieldServerlessConfigVcpusNanos:                           "Serverless Config Vcpus Nanos",
@@ -3193,6 +4221,27 @@ var AppAllFieldsStringMap = map[string]string{
 	AppFieldIsStandalone:                                         "Is Standalone",
 	AppFieldManagesOwnNamespaces:                                 "Manages Own Namespaces",
 	AppFieldCompatibilityVersion:                                 "Compatibility Version",
+	AppFieldFindCloudletRanking:                                  "Find Cloudlet Ranking",
+	AppFieldFindCloudletRankingWeightsDistance:                   "Find Cloudlet Ranking Weights Distance",
+	AppFieldFindCloudletRankingWeightsLatency:                    "Find Cloudlet Ranking Weights Latency",
+	AppFieldFindCloudletRankingWeightsLoad:                       "Find Cloudlet Ranking Weights Load",
+	AppFieldRolloutPolicyStrategy:                                "Rollout Policy Strategy",
+	AppFieldRolloutPolicyCanaryPercent:                           "Rollout Policy Canary Percent",
+	AppFieldRolloutPolicyHealthTimeout:                           "Rollout Policy Health Timeout",
+	AppFieldRolloutPolicyBatchBy:                                 "Rollout Policy Batch By",
+	AppFieldRolloutPolicyBatchSize:                               "Rollout Policy Batch Size",
+	AppFieldRolloutPolicyObservationTime:                         "Rollout Policy Observation Time",
+	AppFieldRolloutStatusState:                                   "Rollout Status State",
+	AppFieldRolloutStatusBatch:                                   "Rollout Status Batch",
+	AppFieldRolloutStatusNumBatches:                              "Rollout Status Num Batches",
+	AppFieldRolloutStatusController:                              "Rollout Status Controller",
+	AppFieldRolloutStatusForceUpdate:                             "Rollout Status Force Update",
+	AppFieldHorizontalScalePolicyMinReplicas:                     "Horizontal Scale Policy Min Replicas",
+	AppFieldHorizontalScalePolicyMaxReplicas:                     "Horizontal Scale Policy Max Replicas",
+	AppFieldHorizontalScalePolicyTargetCpu:                       "Horizontal Scale Policy Target Cpu",
+	AppFieldHorizontalScalePolicyTargetMem:                       "Horizontal Scale Policy Target Mem",
+	AppFieldHorizontalScalePolicyTargetActiveConnections:         "Horizontal Scale Policy Target Active Connections",
+	AppFieldHorizontalScalePolicyStabilizationWindowSec:          "Horizontal Scale Policy Stabilization Window Sec",
 	AppFieldTagsKey:                                              "Tags Key",
 	AppFieldTagsValue:                                            "Tags Value",
 }
@@ -3348,6 +4397,10 @@ func (m *App) DiffFields(o *App, fields *FieldMap) {
 				fields.Set(AppFieldRequiredOutboundConnectionsRemoteCidr)
 				fields.Set(AppFieldRequiredOutboundConnections)
 			}
+			if m.RequiredOutboundConnections[i0].RemoteFqdn != o.RequiredOutboundConnections[i0].RemoteFqdn {
+				fields.Set(AppFieldRequiredOutboundConnectionsRemoteFqdn)
+				fields.Set(AppFieldRequiredOutboundConnections)
+			}
 		}
 	}
 	if m.AllowServerless != o.AllowServerless {
@@ -3772,6 +4825,105 @@ func (m *App) DiffFields(o *App, fields *FieldMap) {
 	if m.CompatibilityVersion != o.CompatibilityVersion {
 		fields.Set(AppFieldCompatibilityVersion)
 	}
+	if m.FindCloudletRanking != o.FindCloudletRanking {
+		fields.Set(AppFieldFindCloudletRanking)
+	}
+	if m.FindCloudletRankingWeights != nil && o.FindCloudletRankingWeights != nil {
+		if m.FindCloudletRankingWeights.Distance != o.FindCloudletRankingWeights.Distance {
+			fields.Set(AppFieldFindCloudletRankingWeightsDistance)
+			fields.Set(AppFieldFindCloudletRankingWeights)
+		}
+		if m.FindCloudletRankingWeights.Latency != o.FindCloudletRankingWeights.Latency {
+			fields.Set(AppFieldFindCloudletRankingWeightsLatency)
+			fields.Set(AppFieldFindCloudletRankingWeights)
+		}
+		if m.FindCloudletRankingWeights.Load != o.FindCloudletRankingWeights.Load {
+			fields.Set(AppFieldFindCloudletRankingWeightsLoad)
+			fields.Set(AppFieldFindCloudletRankingWeights)
+		}
+	} else if (m.FindCloudletRankingWeights != nil && o.FindCloudletRankingWeights == nil) || (m.FindCloudletRankingWeights == nil && o.FindCloudletRankingWeights != nil) {
+		fields.Set(AppFieldFindCloudletRankingWeights)
+	}
+	if m.RolloutPolicy != nil && o.RolloutPolicy != nil {
+		if m.RolloutPolicy.Strategy != o.RolloutPolicy.Strategy {
+			fields.Set(AppFieldRolloutPolicyStrategy)
+			fields.Set(AppFieldRolloutPolicy)
+		}
+		if m.RolloutPolicy.CanaryPercent != o.RolloutPolicy.CanaryPercent {
+			fields.Set(AppFieldRolloutPolicyCanaryPercent)
+			fields.Set(AppFieldRolloutPolicy)
+		}
+		if m.RolloutPolicy.HealthTimeout != o.RolloutPolicy.HealthTimeout {
+			fields.Set(AppFieldRolloutPolicyHealthTimeout)
+			fields.Set(AppFieldRolloutPolicy)
+		}
+		if m.RolloutPolicy.BatchBy != o.RolloutPolicy.BatchBy {
+			fields.Set(AppFieldRolloutPolicyBatchBy)
+			fields.Set(AppFieldRolloutPolicy)
+		}
+		if m.RolloutPolicy.BatchSize != o.RolloutPolicy.BatchSize {
+			fields.Set(AppFieldRolloutPolicyBatchSize)
+			fields.Set(AppFieldRolloutPolicy)
+		}
+		if m.RolloutPolicy.ObservationTime != o.RolloutPolicy.ObservationTime {
+			fields.Set(AppFieldRolloutPolicyObservationTime)
+			fields.Set(AppFieldRolloutPolicy)
+		}
+	} else if (m.RolloutPolicy != nil && o.RolloutPolicy == nil) || (m.RolloutPolicy == nil && o.RolloutPolicy != nil) {
+		fields.Set(AppFieldRolloutPolicy)
+	}
+	if m.RolloutStatus != nil && o.RolloutStatus != nil {
+		if m.RolloutStatus.State != o.RolloutStatus.State {
+			fields.Set(AppFieldRolloutStatusState)
+			fields.Set(AppFieldRolloutStatus)
+		}
+		if m.RolloutStatus.Batch != o.RolloutStatus.Batch {
+			fields.Set(AppFieldRolloutStatusBatch)
+			fields.Set(AppFieldRolloutStatus)
+		}
+		if m.RolloutStatus.NumBatches != o.RolloutStatus.NumBatches {
+			fields.Set(AppFieldRolloutStatusNumBatches)
+			fields.Set(AppFieldRolloutStatus)
+		}
+		if m.RolloutStatus.Controller != o.RolloutStatus.Controller {
+			fields.Set(AppFieldRolloutStatusController)
+			fields.Set(AppFieldRolloutStatus)
+		}
+		if m.RolloutStatus.ForceUpdate != o.RolloutStatus.ForceUpdate {
+			fields.Set(AppFieldRolloutStatusForceUpdate)
+			fields.Set(AppFieldRolloutStatus)
+		}
+	} else if (m.RolloutStatus != nil && o.RolloutStatus == nil) || (m.RolloutStatus == nil && o.RolloutStatus != nil) {
+		fields.Set(AppFieldRolloutStatus)
+	}
+	if m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy != nil {
+		if m.HorizontalScalePolicy.MinReplicas != o.HorizontalScalePolicy.MinReplicas {
+			fields.Set(AppFieldHorizontalScalePolicyMinReplicas)
+			fields.Set(AppFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.MaxReplicas != o.HorizontalScalePolicy.MaxReplicas {
+			fields.Set(AppFieldHorizontalScalePolicyMaxReplicas)
+			fields.Set(AppFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.TargetCpu != o.HorizontalScalePolicy.TargetCpu {
+			fields.Set(AppFieldHorizontalScalePolicyTargetCpu)
+			fields.Set(AppFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.TargetMem != o.HorizontalScalePolicy.TargetMem {
+			fields.Set(AppFieldHorizontalScalePolicyTargetMem)
+			fields.Set(AppFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.TargetActiveConnections != o.HorizontalScalePolicy.TargetActiveConnections {
+			fields.Set(AppFieldHorizontalScalePolicyTargetActiveConnections)
+			fields.Set(AppFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.StabilizationWindowSec != o.HorizontalScalePolicy.StabilizationWindowSec {
+			fields.Set(AppFieldHorizontalScalePolicyStabilizationWindowSec)
+			fields.Set(AppFieldHorizontalScalePolicy)
+		}
+	} else if (m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy == nil) || (m.HorizontalScalePolicy == nil && o.HorizontalScalePolicy != nil) {
+		fields.Set(AppFieldHorizontalScalePolicy)
+	}
 	if m.Tags != nil && o.Tags != nil {
 		if len(m.Tags) != len(o.Tags) {
 			fields.Set(AppFieldTags)
@@ -3830,6 +4982,7 @@ var UpdateAppFieldsMap = NewFieldMap(map[string]struct{}{
 	AppFieldRequiredOutboundConnectionsPortRangeMin:              struct{}{},
 	AppFieldRequiredOutboundConnectionsPortRangeMax:              struct{}{},
 	AppFieldRequiredOutboundConnectionsRemoteCidr:                struct{}{},
+	AppFieldRequiredOutboundConnectionsRemoteFqdn:                struct{}{},
 	AppFieldAllowServerless:                                      struct{}{},
 	AppFieldServerlessConfig:                                     struct{}{},
 	AppFieldServerlessConfigVcpus:                                struct{}{},
@@ -3906,9 +5059,28 @@ var UpdateAppFieldsMap = NewFieldMap(map[string]struct{}{
 	AppFieldAppAnnotationsValue:                                  struct{}{},
 	AppFieldIsStandalone:                                         struct{}{},
 	AppFieldManagesOwnNamespaces:                                 struct{}{},
-	AppFieldTags:                                                 struct{}{},
-	AppFieldTagsKey:                                              struct{}{},
-	AppFieldTagsValue:                                            struct{}{},
+	AppFieldFindCloudletRanking:                                  struct{}{},
+	AppFieldFindCloudletRankingWeights:                           struct{}{},
+	AppFieldFindCloudletRankingWeightsDistance:                   struct{}{},
+	AppFieldFindCloudletRankingWeightsLatency:                    struct{}{},
+	AppFieldFindCloudletRankingWeightsLoad:                       struct{}{},
+	AppFieldRolloutPolicy:                                        struct{}{},
+	AppFieldRolloutPolicyStrategy:                                struct{}{},
+	AppFieldRolloutPolicyCanaryPercent:                           struct{}{},
+	AppFieldRolloutPolicyHealthTimeout:                           struct{}{},
+	AppFieldRolloutPolicyBatchBy:                                 struct{}{},
+	AppFieldRolloutPolicyBatchSize:                               struct{}{},
+	AppFieldRolloutPolicyObservationTime:                         struct{}{},
+	AppFieldHorizontalScalePolicy:                                struct{}{},
+	AppFieldHorizontalScalePolicyMinReplicas:                     struct{}{},
+	AppFieldHorizontalScalePolicyMaxReplicas:                     struct{}{},
+	AppFieldHorizontalScalePolicyTargetCpu:                       struct{}{},
+	AppFieldHorizontalScalePolicyTargetMem:                       struct{}{},
+	AppFieldHorizontalScalePolicyTargetActiveConnections:         struct{}{},
+	AppFieldHorizontalScalePolicyStabilizationWindowSec:          struct{}{},
+	AppFieldTags:      struct{}{},
+	AppFieldTagsKey:   struct{}{},
+	AppFieldTagsValue: struct{}{},
 })
 
 func (m *App) ValidateUpdateFields() error {
@@ -3938,6 +5110,11 @@ func (m *App) ValidateUpdateFieldsCustom(allowedFields *FieldMap) error {
 	return nil
 }
 
+// TableColumns are the default columns for table output
+func (m *App) TableColumns() []string {
+	return []string{"key.organization", "key.name", "key.version", "deployment", "image_path"}
+}
+
 func (m *App) Clone() *App {
 	cp := &App{}
 	cp.DeepCopyIn(m)
@@ -4873,6 +6050,172 @@ func (m *App) CopyInFields(src *App) int {
 			changed++
 		}
 	}
+	if fmap.Has("57") {
+		if m.FindCloudletRanking != src.FindCloudletRanking {
+			m.FindCloudletRanking = src.FindCloudletRanking
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("58") {
+		if src.FindCloudletRankingWeights != nil {
+			if m.FindCloudletRankingWeights == nil {
+				m.FindCloudletRankingWeights = &FindCloudletRankingWeights{}
+			}
+			if fmap.Has("58.1") {
+				if m.FindCloudletRankingWeights.Distance != src.FindCloudletRankingWeights.Distance {
+					m.FindCloudletRankingWeights.Distance = src.FindCloudletRankingWeights.Distance
+					changed++
+				}
+			}
+			if fmap.Has("58.2") {
+				if m.FindCloudletRankingWeights.Latency != src.FindCloudletRankingWeights.Latency {
+					m.FindCloudletRankingWeights.Latency = src.FindCloudletRankingWeights.Latency
+					changed++
+				}
+			}
+			if fmap.Has("58.3") {
+				if m.FindCloudletRankingWeights.Load != src.FindCloudletRankingWeights.Load {
+					m.FindCloudletRankingWeights.Load = src.FindCloudletRankingWeights.Load
+					changed++
+				}
+			}
+		} else if m.FindCloudletRankingWeights != nil {
+			m.FindCloudletRankingWeights = nil
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("59") {
+		if src.RolloutPolicy != nil {
+			if m.RolloutPolicy == nil {
+				m.RolloutPolicy = &RolloutPolicy{}
+			}
+			if fmap.Has("59.1") {
+				if m.RolloutPolicy.Strategy != src.RolloutPolicy.Strategy {
+					m.RolloutPolicy.Strategy = src.RolloutPolicy.Strategy
+					changed++
+				}
+			}
+			if fmap.Has("59.2") {
+				if m.RolloutPolicy.CanaryPercent != src.RolloutPolicy.CanaryPercent {
+					m.RolloutPolicy.CanaryPercent = src.RolloutPolicy.CanaryPercent
+					changed++
+				}
+			}
+			if fmap.Has("59.3") {
+				if m.RolloutPolicy.HealthTimeout != src.RolloutPolicy.HealthTimeout {
+					m.RolloutPolicy.HealthTimeout = src.RolloutPolicy.HealthTimeout
+					changed++
+				}
+			}
+			if fmap.Has("59.4") {
+				if m.RolloutPolicy.BatchBy != src.RolloutPolicy.BatchBy {
+					m.RolloutPolicy.BatchBy = src.RolloutPolicy.BatchBy
+					changed++
+				}
+			}
+			if fmap.Has("59.5") {
+				if m.RolloutPolicy.BatchSize != src.RolloutPolicy.BatchSize {
+					m.RolloutPolicy.BatchSize = src.RolloutPolicy.BatchSize
+					changed++
+				}
+			}
+			if fmap.Has("59.6") {
+				if m.RolloutPolicy.ObservationTime != src.RolloutPolicy.ObservationTime {
+					m.RolloutPolicy.ObservationTime = src.RolloutPolicy.ObservationTime
+					changed++
+				}
+			}
+		} else if m.RolloutPolicy != nil {
+			m.RolloutPolicy = nil
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("60") {
+		if src.RolloutStatus != nil {
+			if m.RolloutStatus == nil {
+				m.RolloutStatus = &RolloutStatus{}
+			}
+			if fmap.Has("60.1") {
+				if m.RolloutStatus.State != src.RolloutStatus.State {
+					m.RolloutStatus.State = src.RolloutStatus.State
+					changed++
+				}
+			}
+			if fmap.Has("60.2") {
+				if m.RolloutStatus.Batch != src.RolloutStatus.Batch {
+					m.RolloutStatus.Batch = src.RolloutStatus.Batch
+					changed++
+				}
+			}
+			if fmap.Has("60.3") {
+				if m.RolloutStatus.NumBatches != src.RolloutStatus.NumBatches {
+					m.RolloutStatus.NumBatches = src.RolloutStatus.NumBatches
+					changed++
+				}
+			}
+			if fmap.Has("60.4") {
+				if m.RolloutStatus.Controller != src.RolloutStatus.Controller {
+					m.RolloutStatus.Controller = src.RolloutStatus.Controller
+					changed++
+				}
+			}
+			if fmap.Has("60.5") {
+				if m.RolloutStatus.ForceUpdate != src.RolloutStatus.ForceUpdate {
+					m.RolloutStatus.ForceUpdate = src.RolloutStatus.ForceUpdate
+					changed++
+				}
+			}
+		} else if m.RolloutStatus != nil {
+			m.RolloutStatus = nil
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("61") {
+		if src.HorizontalScalePolicy != nil {
+			if m.HorizontalScalePolicy == nil {
+				m.HorizontalScalePolicy = &HorizontalScalePolicy{}
+			}
+			if fmap.Has("61.1") {
+				if m.HorizontalScalePolicy.MinReplicas != src.HorizontalScalePolicy.MinReplicas {
+					m.HorizontalScalePolicy.MinReplicas = src.HorizontalScalePolicy.MinReplicas
+					changed++
+				}
+			}
+			if fmap.Has("61.2") {
+				if m.HorizontalScalePolicy.MaxReplicas != src.HorizontalScalePolicy.MaxReplicas {
+					m.HorizontalScalePolicy.MaxReplicas = src.HorizontalScalePolicy.MaxReplicas
+					changed++
+				}
+			}
+			if fmap.Has("61.3") {
+				if m.HorizontalScalePolicy.TargetCpu != src.HorizontalScalePolicy.TargetCpu {
+					m.HorizontalScalePolicy.TargetCpu = src.HorizontalScalePolicy.TargetCpu
+					changed++
+				}
+			}
+			if fmap.Has("61.4") {
+				if m.HorizontalScalePolicy.TargetMem != src.HorizontalScalePolicy.TargetMem {
+					m.HorizontalScalePolicy.TargetMem = src.HorizontalScalePolicy.TargetMem
+					changed++
+				}
+			}
+			if fmap.Has("61.5") {
+				if m.HorizontalScalePolicy.TargetActiveConnections != src.HorizontalScalePolicy.TargetActiveConnections {
+					m.HorizontalScalePolicy.TargetActiveConnections = src.HorizontalScalePolicy.TargetActiveConnections
+					changed++
+				}
+			}
+			if fmap.Has("61.6") {
+				if m.HorizontalScalePolicy.StabilizationWindowSec != src.HorizontalScalePolicy.StabilizationWindowSec {
+					m.HorizontalScalePolicy.StabilizationWindowSec = src.HorizontalScalePolicy.StabilizationWindowSec
+					changed++
+				}
+			}
+		} else if m.HorizontalScalePolicy != nil {
+			m.HorizontalScalePolicy = nil
+			changed++
+		}
+	}
 	if fmap.HasOrHasChild("100") {
 		if src.Tags != nil {
 			if updateListAction == "add" {
@@ -5026,6 +6369,35 @@ func (m *App) DeepCopyIn(src *App) {
 	m.IsStandalone = src.IsStandalone
 	m.ManagesOwnNamespaces = src.ManagesOwnNamespaces
 	m.CompatibilityVersion = src.CompatibilityVersion
+	m.FindCloudletRanking = src.FindCloudletRanking
+	if src.FindCloudletRankingWeights != nil {
+		var tmp_FindCloudletRankingWeights FindCloudletRankingWeights
+		tmp_FindCloudletRankingWeights.DeepCopyIn(src.FindCloudletRankingWeights)
+		m.FindCloudletRankingWeights = &tmp_FindCloudletRankingWeights
+	} else {
+		m.FindCloudletRankingWeights = nil
+	}
+	if src.RolloutPolicy != nil {
+		var tmp_RolloutPolicy RolloutPolicy
+		tmp_RolloutPolicy.DeepCopyIn(src.RolloutPolicy)
+		m.RolloutPolicy = &tmp_RolloutPolicy
+	} else {
+		m.RolloutPolicy = nil
+	}
+	if src.RolloutStatus != nil {
+		var tmp_RolloutStatus RolloutStatus
+		tmp_RolloutStatus.DeepCopyIn(src.RolloutStatus)
+		m.RolloutStatus = &tmp_RolloutStatus
+	} else {
+		m.RolloutStatus = nil
+	}
+	if src.HorizontalScalePolicy != nil {
+		var tmp_HorizontalScalePolicy HorizontalScalePolicy
+		tmp_HorizontalScalePolicy.DeepCopyIn(src.HorizontalScalePolicy)
+		m.HorizontalScalePolicy = &tmp_HorizontalScalePolicy
+	} else {
+		m.HorizontalScalePolicy = nil
+	}
 	if src.Tags != nil {
 		m.Tags = make(map[string]string)
 		for k, v := range src.Tags {
@@ -5851,6 +7223,29 @@ func (m *App) ValidateEnums() error {
 			return err
 		}
 	}
+	if _, ok := FindCloudletRanking_name[int32(m.FindCloudletRanking)]; !ok {
+		return errors.New("invalid FindCloudletRanking")
+	}
+	if m.FindCloudletRankingWeights != nil {
+		if err := m.FindCloudletRankingWeights.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if m.RolloutPolicy != nil {
+		if err := m.RolloutPolicy.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if m.RolloutStatus != nil {
+		if err := m.RolloutStatus.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if m.HorizontalScalePolicy != nil {
+		if err := m.HorizontalScalePolicy.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -5894,6 +7289,18 @@ func (s *App) ClearTagged(tags map[string]struct{}) {
 	if _, found := tags["nocmp"]; found {
 		s.CompatibilityVersion = 0
 	}
+	if s.FindCloudletRankingWeights != nil {
+		s.FindCloudletRankingWeights.ClearTagged(tags)
+	}
+	if s.RolloutPolicy != nil {
+		s.RolloutPolicy.ClearTagged(tags)
+	}
+	if _, found := tags["nocmp"]; found {
+		s.RolloutStatus = nil
+	}
+	if s.HorizontalScalePolicy != nil {
+		s.HorizontalScalePolicy.ClearTagged(tags)
+	}
 }
 
 func IgnoreAppFields(taglist string) cmp.Option {
@@ -5920,6 +7327,9 @@ func IgnoreAppFields(taglist string) cmp.Option {
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "CompatibilityVersion")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "RolloutStatus")
+	}
 	return cmpopts.IgnoreFields(App{}, names...)
 }
 
@@ -6122,6 +7532,45 @@ func (s *AppAlertPolicy) ClearTagged(tags map[string]struct{}) {
 	s.AppKey.ClearTagged(tags)
 }
 
+func (m *AppRolloutRequest) Clone() *AppRolloutRequest {
+	cp := &AppRolloutRequest{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AppRolloutRequest) CopyInFields(src *AppRolloutRequest) int {
+	changed := 0
+	if m.AppKey.Organization != src.AppKey.Organization {
+		m.AppKey.Organization = src.AppKey.Organization
+		changed++
+	}
+	if m.AppKey.Name != src.AppKey.Name {
+		m.AppKey.Name = src.AppKey.Name
+		changed++
+	}
+	if m.AppKey.Version != src.AppKey.Version {
+		m.AppKey.Version = src.AppKey.Version
+		changed++
+	}
+	return changed
+}
+
+func (m *AppRolloutRequest) DeepCopyIn(src *AppRolloutRequest) {
+	m.AppKey.DeepCopyIn(&src.AppKey)
+}
+
+// Helper method to check that enums have valid values
+func (m *AppRolloutRequest) ValidateEnums() error {
+	if err := m.AppKey.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AppRolloutRequest) ClearTagged(tags map[string]struct{}) {
+	s.AppKey.ClearTagged(tags)
+}
+
 func (m *DeploymentZoneRequest) Clone() *DeploymentZoneRequest {
 	cp := &DeploymentZoneRequest{}
 	cp.DeepCopyIn(m)
@@ -6876,6 +8325,122 @@ func (m *DeploymentZoneRequest) CopyInFields(src *DeploymentZoneRequest) int {
 			m.App.CompatibilityVersion = src.App.CompatibilityVersion
 			changed++
 		}
+		if m.App.FindCloudletRanking != src.App.FindCloudletRanking {
+			m.App.FindCloudletRanking = src.App.FindCloudletRanking
+			changed++
+		}
+		if src.App.FindCloudletRankingWeights != nil {
+			if m.App.FindCloudletRankingWeights == nil {
+				m.App.FindCloudletRankingWeights = &FindCloudletRankingWeights{}
+			}
+			if m.App.FindCloudletRankingWeights.Distance != src.App.FindCloudletRankingWeights.Distance {
+				m.App.FindCloudletRankingWeights.Distance = src.App.FindCloudletRankingWeights.Distance
+				changed++
+			}
+			if m.App.FindCloudletRankingWeights.Latency != src.App.FindCloudletRankingWeights.Latency {
+				m.App.FindCloudletRankingWeights.Latency = src.App.FindCloudletRankingWeights.Latency
+				changed++
+			}
+			if m.App.FindCloudletRankingWeights.Load != src.App.FindCloudletRankingWeights.Load {
+				m.App.FindCloudletRankingWeights.Load = src.App.FindCloudletRankingWeights.Load
+				changed++
+			}
+		} else if m.App.FindCloudletRankingWeights != nil {
+			m.App.FindCloudletRankingWeights = nil
+			changed++
+		}
+		if src.App.RolloutPolicy != nil {
+			if m.App.RolloutPolicy == nil {
+				m.App.RolloutPolicy = &RolloutPolicy{}
+			}
+			if m.App.RolloutPolicy.Strategy != src.App.RolloutPolicy.Strategy {
+				m.App.RolloutPolicy.Strategy = src.App.RolloutPolicy.Strategy
+				changed++
+			}
+			if m.App.RolloutPolicy.CanaryPercent != src.App.RolloutPolicy.CanaryPercent {
+				m.App.RolloutPolicy.CanaryPercent = src.App.RolloutPolicy.CanaryPercent
+				changed++
+			}
+			if m.App.RolloutPolicy.HealthTimeout != src.App.RolloutPolicy.HealthTimeout {
+				m.App.RolloutPolicy.HealthTimeout = src.App.RolloutPolicy.HealthTimeout
+				changed++
+			}
+			if m.App.RolloutPolicy.BatchBy != src.App.RolloutPolicy.BatchBy {
+				m.App.RolloutPolicy.BatchBy = src.App.RolloutPolicy.BatchBy
+				changed++
+			}
+			if m.App.RolloutPolicy.BatchSize != src.App.RolloutPolicy.BatchSize {
+				m.App.RolloutPolicy.BatchSize = src.App.RolloutPolicy.BatchSize
+				changed++
+			}
+			if m.App.RolloutPolicy.ObservationTime != src.App.RolloutPolicy.ObservationTime {
+				m.App.RolloutPolicy.ObservationTime = src.App.RolloutPolicy.ObservationTime
+				changed++
+			}
+		} else if m.App.RolloutPolicy != nil {
+			m.App.RolloutPolicy = nil
+			changed++
+		}
+		if src.App.RolloutStatus != nil {
+			if m.App.RolloutStatus == nil {
+				m.App.RolloutStatus = &RolloutStatus{}
+			}
+			if m.App.RolloutStatus.State != src.App.RolloutStatus.State {
+				m.App.RolloutStatus.State = src.App.RolloutStatus.State
+				changed++
+			}
+			if m.App.RolloutStatus.Batch != src.App.RolloutStatus.Batch {
+				m.App.RolloutStatus.Batch = src.App.RolloutStatus.Batch
+				changed++
+			}
+			if m.App.RolloutStatus.NumBatches != src.App.RolloutStatus.NumBatches {
+				m.App.RolloutStatus.NumBatches = src.App.RolloutStatus.NumBatches
+				changed++
+			}
+			if m.App.RolloutStatus.Controller != src.App.RolloutStatus.Controller {
+				m.App.RolloutStatus.Controller = src.App.RolloutStatus.Controller
+				changed++
+			}
+			if m.App.RolloutStatus.ForceUpdate != src.App.RolloutStatus.ForceUpdate {
+				m.App.RolloutStatus.ForceUpdate = src.App.RolloutStatus.ForceUpdate
+				changed++
+			}
+		} else if m.App.RolloutStatus != nil {
+			m.App.RolloutStatus = nil
+			changed++
+		}
+		if src.App.HorizontalScalePolicy != nil {
+			if m.App.HorizontalScalePolicy == nil {
+				m.App.HorizontalScalePolicy = &HorizontalScalePolicy{}
+			}
+			if m.App.HorizontalScalePolicy.MinReplicas != src.App.HorizontalScalePolicy.MinReplicas {
+				m.App.HorizontalScalePolicy.MinReplicas = src.App.HorizontalScalePolicy.MinReplicas
+				changed++
+			}
+			if m.App.HorizontalScalePolicy.MaxReplicas != src.App.HorizontalScalePolicy.MaxReplicas {
+				m.App.HorizontalScalePolicy.MaxReplicas = src.App.HorizontalScalePolicy.MaxReplicas
+				changed++
+			}
+			if m.App.HorizontalScalePolicy.TargetCpu != src.App.HorizontalScalePolicy.TargetCpu {
+				m.App.HorizontalScalePolicy.TargetCpu = src.App.HorizontalScalePolicy.TargetCpu
+				changed++
+			}
+			if m.App.HorizontalScalePolicy.TargetMem != src.App.HorizontalScalePolicy.TargetMem {
+				m.App.HorizontalScalePolicy.TargetMem = src.App.HorizontalScalePolicy.TargetMem
+				changed++
+			}
+			if m.App.HorizontalScalePolicy.TargetActiveConnections != src.App.HorizontalScalePolicy.TargetActiveConnections {
+				m.App.HorizontalScalePolicy.TargetActiveConnections = src.App.HorizontalScalePolicy.TargetActiveConnections
+				changed++
+			}
+			if m.App.HorizontalScalePolicy.StabilizationWindowSec != src.App.HorizontalScalePolicy.StabilizationWindowSec {
+				m.App.HorizontalScalePolicy.StabilizationWindowSec = src.App.HorizontalScalePolicy.StabilizationWindowSec
+				changed++
+			}
+		} else if m.App.HorizontalScalePolicy != nil {
+			m.App.HorizontalScalePolicy = nil
+			changed++
+		}
 		if src.App.Tags != nil {
 			if updateListAction == "add" {
 				for k1, v := range src.App.Tags {
@@ -6967,6 +8532,9 @@ func IgnoreDeploymentZoneRequestFields(taglist string) cmp.Option {
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "App.CompatibilityVersion")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "App.RolloutStatus")
+	}
 	return cmpopts.IgnoreFields(DeploymentZoneRequest{}, names...)
 }
 
@@ -7572,75 +9140,75 @@ func (e AccessType) MarshalJSON() ([]byte, error) {
 
 var AccessTypeCommonPrefix = "AccessType"
 
-var GpuTypeStrings = []string{
-	"GPU_TYPE_NONE",
-	"GPU_TYPE_ANY",
-	"GPU_TYPE_VGPU",
-	"GPU_TYPE_PCI",
+var FindCloudletRankingStrings = []string{
+	"RANK_BY_DISTANCE",
+	"RANK_BY_LATENCY",
+	"RANK_BY_LOAD",
+	"RANK_BY_WEIGHTED",
 }
 
 const (
-	GpuTypeGPU_TYPE_NONE uint64 = 1 << 0
-	GpuTypeGPU_TYPE_ANY  uint64 = 1 << 1
-	GpuTypeGPU_TYPE_VGPU uint64 = 1 << 2
-	GpuTypeGPU_TYPE_PCI  uint64 = 1 << 3
+	FindCloudletRankingRANK_BY_DISTANCE uint64 = 1 << 0
+	FindCloudletRankingRANK_BY_LATENCY  uint64 = 1 << 1
+	FindCloudletRankingRANK_BY_LOAD     uint64 = 1 << 2
+	FindCloudletRankingRANK_BY_WEIGHTED uint64 = 1 << 3
 )
 
-var GpuType_CamelName = map[int32]string{
-	// GPU_TYPE_NONE -> GpuTypeNone
-	0: "GpuTypeNone",
-	// GPU_TYPE_ANY -> GpuTypeAny
-	1: "GpuTypeAny",
-	// GPU_TYPE_VGPU -> GpuTypeVgpu
-	2: "GpuTypeVgpu",
-	// GPU_TYPE_PCI -> GpuTypePci
-	3: "GpuTypePci",
-}
-var GpuType_CamelValue = map[string]int32{
-	"GpuTypeNone": 0,
-	"GpuTypeAny":  1,
-	"GpuTypeVgpu": 2,
-	"GpuTypePci":  3,
-}
-
-func ParseGpuType(data interface{}) (GpuType, error) {
-	if val, ok := data.(GpuType); ok {
+var FindCloudletRanking_CamelName = map[int32]string{
+	// RANK_BY_DISTANCE -> RankByDistance
+	0: "RankByDistance",
+	// RANK_BY_LATENCY -> RankByLatency
+	1: "RankByLatency",
+	// RANK_BY_LOAD -> RankByLoad
+	2: "RankByLoad",
+	// RANK_BY_WEIGHTED -> RankByWeighted
+	3: "RankByWeighted",
+}
+var FindCloudletRanking_CamelValue = map[string]int32{
+	"RankByDistance": 0,
+	"RankByLatency":  1,
+	"RankByLoad":     2,
+	"RankByWeighted": 3,
+}
+
+func ParseFindCloudletRanking(data interface{}) (FindCloudletRanking, error) {
+	if val, ok := data.(FindCloudletRanking); ok {
 		return val, nil
 	} else if str, ok := data.(string); ok {
-		val, ok := GpuType_CamelValue[util.CamelCase(str)]
+		val, ok := FindCloudletRanking_CamelValue[util.CamelCase(str)]
 		if !ok {
 			// may have omitted common prefix
-			val, ok = GpuType_CamelValue["GpuType"+util.CamelCase(str)]
+			val, ok = FindCloudletRanking_CamelValue["RankBy"+util.CamelCase(str)]
 		}
 		if !ok {
 			// may be int value instead of enum name
 			ival, err := strconv.Atoi(str)
 			val = int32(ival)
 			if err == nil {
-				_, ok = GpuType_CamelName[val]
+				_, ok = FindCloudletRanking_CamelName[val]
 			}
 		}
 		if !ok {
-			return GpuType(0), fmt.Errorf("Invalid GpuType value %q", str)
+			return FindCloudletRanking(0), fmt.Errorf("Invalid FindCloudletRanking value %q", str)
 		}
-		return GpuType(val), nil
+		return FindCloudletRanking(val), nil
 	} else if ival, ok := data.(int32); ok {
-		if _, ok := GpuType_CamelName[ival]; ok {
-			return GpuType(ival), nil
+		if _, ok := FindCloudletRanking_CamelName[ival]; ok {
+			return FindCloudletRanking(ival), nil
 		} else {
-			return GpuType(0), fmt.Errorf("Invalid GpuType value %d", ival)
+			return FindCloudletRanking(0), fmt.Errorf("Invalid FindCloudletRanking value %d", ival)
 		}
 	}
-	return GpuType(0), fmt.Errorf("Invalid GpuType value %v", data)
+	return FindCloudletRanking(0), fmt.Errorf("Invalid FindCloudletRanking value %v", data)
 }
 
-func (e *GpuType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (e *FindCloudletRanking) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var str string
 	err := unmarshal(&str)
 	if err != nil {
 		return err
 	}
-	val, err := ParseGpuType(str)
+	val, err := ParseFindCloudletRanking(str)
 	if err != nil {
 		return err
 	}
@@ -7648,31 +9216,31 @@ func (e *GpuType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
-func (e GpuType) MarshalYAML() (interface{}, error) {
-	str := proto.EnumName(GpuType_CamelName, int32(e))
-	str = strings.TrimPrefix(str, "GpuType")
+func (e FindCloudletRanking) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(FindCloudletRanking_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "RankBy")
 	return str, nil
 }
 
 // custom JSON encoding/decoding
-func (e *GpuType) UnmarshalJSON(b []byte) error {
+func (e *FindCloudletRanking) UnmarshalJSON(b []byte) error {
 	var str string
 	err := json.Unmarshal(b, &str)
 	if err == nil {
-		val, err := ParseGpuType(str)
+		val, err := ParseFindCloudletRanking(str)
 		if err != nil {
 			return &json.UnmarshalTypeError{
 				Value: "string " + str,
-				Type:  reflect.TypeOf(GpuType(0)),
+				Type:  reflect.TypeOf(FindCloudletRanking(0)),
 			}
 		}
-		*e = GpuType(val)
+		*e = FindCloudletRanking(val)
 		return nil
 	}
 	var ival int32
 	err = json.Unmarshal(b, &ival)
 	if err == nil {
-		val, err := ParseGpuType(ival)
+		val, err := ParseFindCloudletRanking(ival)
 		if err == nil {
 			*e = val
 			return nil
@@ -7680,110 +9248,599 @@ func (e *GpuType) UnmarshalJSON(b []byte) error {
 	}
 	return &json.UnmarshalTypeError{
 		Value: "value " + string(b),
-		Type:  reflect.TypeOf(GpuType(0)),
+		Type:  reflect.TypeOf(FindCloudletRanking(0)),
 	}
 }
 
-func (e GpuType) MarshalJSON() ([]byte, error) {
-	str := proto.EnumName(GpuType_CamelName, int32(e))
-	str = strings.TrimPrefix(str, "GpuType")
+func (e FindCloudletRanking) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(FindCloudletRanking_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "RankBy")
 	return json.Marshal(str)
 }
 
-var GpuTypeCommonPrefix = "GpuType"
+var FindCloudletRankingCommonPrefix = "RankBy"
 
-func (m *App) IsValidArgsForCreateApp() error {
-	if m.DelOpt != 0 {
-		return fmt.Errorf("Invalid field specified: DelOpt, this field is only for internal use")
-	}
-	if m.AutoProvPolicy != "" {
-		return fmt.Errorf("Invalid field specified: AutoProvPolicy, this field is only for internal use")
-	}
-	if m.DeletePrepare != false {
-		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
-	}
-	if m.CreatedAt.Seconds != 0 {
-		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
-	}
-	if m.CreatedAt.Nanos != 0 {
-		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
-	}
-	if m.UpdatedAt.Seconds != 0 {
-		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
-	}
-	if m.UpdatedAt.Nanos != 0 {
-		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
-	}
-	if m.UpdateListAction != "" {
-		return fmt.Errorf("Invalid field specified: UpdateListAction, this field is only for internal use")
-	}
-	if m.CompatibilityVersion != 0 {
-		return fmt.Errorf("Invalid field specified: CompatibilityVersion, this field is only for internal use")
-	}
-	return nil
+var UpdateStrategyStrings = []string{
+	"UPDATE_IN_PLACE",
+	"UPDATE_BLUE_GREEN",
+	"UPDATE_CANARY",
 }
 
-func (m *App) IsValidArgsForDeleteApp() error {
-	if m.DelOpt != 0 {
-		return fmt.Errorf("Invalid field specified: DelOpt, this field is only for internal use")
-	}
-	if m.AutoProvPolicy != "" {
-		return fmt.Errorf("Invalid field specified: AutoProvPolicy, this field is only for internal use")
-	}
-	if m.DeletePrepare != false {
-		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
-	}
-	if m.CreatedAt.Seconds != 0 {
-		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
-	}
-	if m.CreatedAt.Nanos != 0 {
-		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
-	}
-	if m.UpdatedAt.Seconds != 0 {
-		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
-	}
-	if m.UpdatedAt.Nanos != 0 {
-		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
-	}
-	if m.UpdateListAction != "" {
-		return fmt.Errorf("Invalid field specified: UpdateListAction, this field is only for internal use")
-	}
-	if m.CompatibilityVersion != 0 {
-		return fmt.Errorf("Invalid field specified: CompatibilityVersion, this field is only for internal use")
-	}
-	return nil
+const (
+	UpdateStrategyUPDATE_IN_PLACE   uint64 = 1 << 0
+	UpdateStrategyUPDATE_BLUE_GREEN uint64 = 1 << 1
+	UpdateStrategyUPDATE_CANARY     uint64 = 1 << 2
+)
+
+var UpdateStrategy_CamelName = map[int32]string{
+	// UPDATE_IN_PLACE -> UpdateInPlace
+	0: "UpdateInPlace",
+	// UPDATE_BLUE_GREEN -> UpdateBlueGreen
+	1: "UpdateBlueGreen",
+	// UPDATE_CANARY -> UpdateCanary
+	2: "UpdateCanary",
+}
+var UpdateStrategy_CamelValue = map[string]int32{
+	"UpdateInPlace":   0,
+	"UpdateBlueGreen": 1,
+	"UpdateCanary":    2,
 }
 
-func (m *App) IsValidArgsForUpdateApp() error {
-	if m.DelOpt != 0 {
-		return fmt.Errorf("Invalid field specified: DelOpt, this field is only for internal use")
-	}
-	if m.AutoProvPolicy != "" {
-		return fmt.Errorf("Invalid field specified: AutoProvPolicy, this field is only for internal use")
-	}
-	if m.DeletePrepare != false {
-		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
-	}
-	if m.CreatedAt.Seconds != 0 {
-		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
-	}
-	if m.CreatedAt.Nanos != 0 {
-		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
-	}
-	if m.UpdatedAt.Seconds != 0 {
-		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+func ParseUpdateStrategy(data interface{}) (UpdateStrategy, error) {
+	if val, ok := data.(UpdateStrategy); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := UpdateStrategy_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = UpdateStrategy_CamelValue["Update"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = UpdateStrategy_CamelName[val]
+			}
+		}
+		if !ok {
+			return UpdateStrategy(0), fmt.Errorf("Invalid UpdateStrategy value %q", str)
+		}
+		return UpdateStrategy(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := UpdateStrategy_CamelName[ival]; ok {
+			return UpdateStrategy(ival), nil
+		} else {
+			return UpdateStrategy(0), fmt.Errorf("Invalid UpdateStrategy value %d", ival)
+		}
 	}
-	if m.UpdatedAt.Nanos != 0 {
-		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	return UpdateStrategy(0), fmt.Errorf("Invalid UpdateStrategy value %v", data)
+}
+
+func (e *UpdateStrategy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
 	}
-	if m.CompatibilityVersion != 0 {
-		return fmt.Errorf("Invalid field specified: CompatibilityVersion, this field is only for internal use")
+	val, err := ParseUpdateStrategy(str)
+	if err != nil {
+		return err
 	}
+	*e = val
 	return nil
 }
 
-func (m *AppAutoProvPolicy) IsValidArgsForAddAppAutoProvPolicy() error {
-	return nil
+func (e UpdateStrategy) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(UpdateStrategy_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "Update")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *UpdateStrategy) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseUpdateStrategy(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(UpdateStrategy(0)),
+			}
+		}
+		*e = UpdateStrategy(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseUpdateStrategy(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(UpdateStrategy(0)),
+	}
+}
+
+func (e UpdateStrategy) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(UpdateStrategy_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "Update")
+	return json.Marshal(str)
+}
+
+var UpdateStrategyCommonPrefix = "Update"
+
+var RolloutBatchByStrings = []string{
+	"BATCH_BY_CLOUDLET",
+	"BATCH_BY_ZONE",
+}
+
+const (
+	RolloutBatchByBATCH_BY_CLOUDLET uint64 = 1 << 0
+	RolloutBatchByBATCH_BY_ZONE     uint64 = 1 << 1
+)
+
+var RolloutBatchBy_CamelName = map[int32]string{
+	// BATCH_BY_CLOUDLET -> BatchByCloudlet
+	0: "BatchByCloudlet",
+	// BATCH_BY_ZONE -> BatchByZone
+	1: "BatchByZone",
+}
+var RolloutBatchBy_CamelValue = map[string]int32{
+	"BatchByCloudlet": 0,
+	"BatchByZone":     1,
+}
+
+func ParseRolloutBatchBy(data interface{}) (RolloutBatchBy, error) {
+	if val, ok := data.(RolloutBatchBy); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := RolloutBatchBy_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = RolloutBatchBy_CamelValue["BatchBy"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = RolloutBatchBy_CamelName[val]
+			}
+		}
+		if !ok {
+			return RolloutBatchBy(0), fmt.Errorf("Invalid RolloutBatchBy value %q", str)
+		}
+		return RolloutBatchBy(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := RolloutBatchBy_CamelName[ival]; ok {
+			return RolloutBatchBy(ival), nil
+		} else {
+			return RolloutBatchBy(0), fmt.Errorf("Invalid RolloutBatchBy value %d", ival)
+		}
+	}
+	return RolloutBatchBy(0), fmt.Errorf("Invalid RolloutBatchBy value %v", data)
+}
+
+func (e *RolloutBatchBy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseRolloutBatchBy(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e RolloutBatchBy) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(RolloutBatchBy_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "BatchBy")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *RolloutBatchBy) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseRolloutBatchBy(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(RolloutBatchBy(0)),
+			}
+		}
+		*e = RolloutBatchBy(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseRolloutBatchBy(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(RolloutBatchBy(0)),
+	}
+}
+
+func (e RolloutBatchBy) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(RolloutBatchBy_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "BatchBy")
+	return json.Marshal(str)
+}
+
+var RolloutBatchByCommonPrefix = "BatchBy"
+
+var RolloutStateStrings = []string{
+	"ROLLOUT_NONE",
+	"ROLLOUT_PENDING",
+	"ROLLOUT_IN_PROGRESS",
+	"ROLLOUT_PAUSED",
+	"ROLLOUT_DONE",
+	"ROLLOUT_FAILED",
+	"ROLLOUT_ABORTED",
+}
+
+const (
+	RolloutStateROLLOUT_NONE        uint64 = 1 << 0
+	RolloutStateROLLOUT_PENDING     uint64 = 1 << 1
+	RolloutStateROLLOUT_IN_PROGRESS uint64 = 1 << 2
+	RolloutStateROLLOUT_PAUSED      uint64 = 1 << 3
+	RolloutStateROLLOUT_DONE        uint64 = 1 << 4
+	RolloutStateROLLOUT_FAILED      uint64 = 1 << 5
+	RolloutStateROLLOUT_ABORTED     uint64 = 1 << 6
+)
+
+var RolloutState_CamelName = map[int32]string{
+	// ROLLOUT_NONE -> RolloutNone
+	0: "RolloutNone",
+	// ROLLOUT_PENDING -> RolloutPending
+	1: "RolloutPending",
+	// ROLLOUT_IN_PROGRESS -> RolloutInProgress
+	2: "RolloutInProgress",
+	// ROLLOUT_PAUSED -> RolloutPaused
+	3: "RolloutPaused",
+	// ROLLOUT_DONE -> RolloutDone
+	4: "RolloutDone",
+	// ROLLOUT_FAILED -> RolloutFailed
+	5: "RolloutFailed",
+	// ROLLOUT_ABORTED -> RolloutAborted
+	6: "RolloutAborted",
+}
+var RolloutState_CamelValue = map[string]int32{
+	"RolloutNone":       0,
+	"RolloutPending":    1,
+	"RolloutInProgress": 2,
+	"RolloutPaused":     3,
+	"RolloutDone":       4,
+	"RolloutFailed":     5,
+	"RolloutAborted":    6,
+}
+
+func ParseRolloutState(data interface{}) (RolloutState, error) {
+	if val, ok := data.(RolloutState); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := RolloutState_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = RolloutState_CamelValue["Rollout"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = RolloutState_CamelName[val]
+			}
+		}
+		if !ok {
+			return RolloutState(0), fmt.Errorf("Invalid RolloutState value %q", str)
+		}
+		return RolloutState(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := RolloutState_CamelName[ival]; ok {
+			return RolloutState(ival), nil
+		} else {
+			return RolloutState(0), fmt.Errorf("Invalid RolloutState value %d", ival)
+		}
+	}
+	return RolloutState(0), fmt.Errorf("Invalid RolloutState value %v", data)
+}
+
+func (e *RolloutState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseRolloutState(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e RolloutState) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(RolloutState_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "Rollout")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *RolloutState) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseRolloutState(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(RolloutState(0)),
+			}
+		}
+		*e = RolloutState(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseRolloutState(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(RolloutState(0)),
+	}
+}
+
+func (e RolloutState) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(RolloutState_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "Rollout")
+	return json.Marshal(str)
+}
+
+var RolloutStateCommonPrefix = "Rollout"
+
+var GpuTypeStrings = []string{
+	"GPU_TYPE_NONE",
+	"GPU_TYPE_ANY",
+	"GPU_TYPE_VGPU",
+	"GPU_TYPE_PCI",
+}
+
+const (
+	GpuTypeGPU_TYPE_NONE uint64 = 1 << 0
+	GpuTypeGPU_TYPE_ANY  uint64 = 1 << 1
+	GpuTypeGPU_TYPE_VGPU uint64 = 1 << 2
+	GpuTypeGPU_TYPE_PCI  uint64 = 1 << 3
+)
+
+var GpuType_CamelName = map[int32]string{
+	// GPU_TYPE_NONE -> GpuTypeNone
+	0: "GpuTypeNone",
+	// GPU_TYPE_ANY -> GpuTypeAny
+	1: "GpuTypeAny",
+	// GPU_TYPE_VGPU -> GpuTypeVgpu
+	2: "GpuTypeVgpu",
+	// GPU_TYPE_PCI -> GpuTypePci
+	3: "GpuTypePci",
+}
+var GpuType_CamelValue = map[string]int32{
+	"GpuTypeNone": 0,
+	"GpuTypeAny":  1,
+	"GpuTypeVgpu": 2,
+	"GpuTypePci":  3,
+}
+
+func ParseGpuType(data interface{}) (GpuType, error) {
+	if val, ok := data.(GpuType); ok {
+		return val, nil
+	} else if str, ok := data.(string); ok {
+		val, ok := GpuType_CamelValue[util.CamelCase(str)]
+		if !ok {
+			// may have omitted common prefix
+			val, ok = GpuType_CamelValue["GpuType"+util.CamelCase(str)]
+		}
+		if !ok {
+			// may be int value instead of enum name
+			ival, err := strconv.Atoi(str)
+			val = int32(ival)
+			if err == nil {
+				_, ok = GpuType_CamelName[val]
+			}
+		}
+		if !ok {
+			return GpuType(0), fmt.Errorf("Invalid GpuType value %q", str)
+		}
+		return GpuType(val), nil
+	} else if ival, ok := data.(int32); ok {
+		if _, ok := GpuType_CamelName[ival]; ok {
+			return GpuType(ival), nil
+		} else {
+			return GpuType(0), fmt.Errorf("Invalid GpuType value %d", ival)
+		}
+	}
+	return GpuType(0), fmt.Errorf("Invalid GpuType value %v", data)
+}
+
+func (e *GpuType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	err := unmarshal(&str)
+	if err != nil {
+		return err
+	}
+	val, err := ParseGpuType(str)
+	if err != nil {
+		return err
+	}
+	*e = val
+	return nil
+}
+
+func (e GpuType) MarshalYAML() (interface{}, error) {
+	str := proto.EnumName(GpuType_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "GpuType")
+	return str, nil
+}
+
+// custom JSON encoding/decoding
+func (e *GpuType) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err == nil {
+		val, err := ParseGpuType(str)
+		if err != nil {
+			return &json.UnmarshalTypeError{
+				Value: "string " + str,
+				Type:  reflect.TypeOf(GpuType(0)),
+			}
+		}
+		*e = GpuType(val)
+		return nil
+	}
+	var ival int32
+	err = json.Unmarshal(b, &ival)
+	if err == nil {
+		val, err := ParseGpuType(ival)
+		if err == nil {
+			*e = val
+			return nil
+		}
+	}
+	return &json.UnmarshalTypeError{
+		Value: "value " + string(b),
+		Type:  reflect.TypeOf(GpuType(0)),
+	}
+}
+
+func (e GpuType) MarshalJSON() ([]byte, error) {
+	str := proto.EnumName(GpuType_CamelName, int32(e))
+	str = strings.TrimPrefix(str, "GpuType")
+	return json.Marshal(str)
+}
+
+var GpuTypeCommonPrefix = "GpuType"
+
+func (m *App) IsValidArgsForCreateApp() error {
+	if m.DelOpt != 0 {
+		return fmt.Errorf("Invalid field specified: DelOpt, this field is only for internal use")
+	}
+	if m.AutoProvPolicy != "" {
+		return fmt.Errorf("Invalid field specified: AutoProvPolicy, this field is only for internal use")
+	}
+	if m.DeletePrepare != false {
+		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdateListAction != "" {
+		return fmt.Errorf("Invalid field specified: UpdateListAction, this field is only for internal use")
+	}
+	if m.CompatibilityVersion != 0 {
+		return fmt.Errorf("Invalid field specified: CompatibilityVersion, this field is only for internal use")
+	}
+	if m.RolloutStatus != nil {
+		return fmt.Errorf("Invalid field specified: RolloutStatus, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *App) IsValidArgsForDeleteApp() error {
+	if m.DelOpt != 0 {
+		return fmt.Errorf("Invalid field specified: DelOpt, this field is only for internal use")
+	}
+	if m.AutoProvPolicy != "" {
+		return fmt.Errorf("Invalid field specified: AutoProvPolicy, this field is only for internal use")
+	}
+	if m.DeletePrepare != false {
+		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdateListAction != "" {
+		return fmt.Errorf("Invalid field specified: UpdateListAction, this field is only for internal use")
+	}
+	if m.CompatibilityVersion != 0 {
+		return fmt.Errorf("Invalid field specified: CompatibilityVersion, this field is only for internal use")
+	}
+	if m.RolloutStatus != nil {
+		return fmt.Errorf("Invalid field specified: RolloutStatus, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *App) IsValidArgsForUpdateApp() error {
+	if m.DelOpt != 0 {
+		return fmt.Errorf("Invalid field specified: DelOpt, this field is only for internal use")
+	}
+	if m.AutoProvPolicy != "" {
+		return fmt.Errorf("Invalid field specified: AutoProvPolicy, this field is only for internal use")
+	}
+	if m.DeletePrepare != false {
+		return fmt.Errorf("Invalid field specified: DeletePrepare, this field is only for internal use")
+	}
+	if m.CreatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
+	}
+	if m.CreatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: CreatedAt.Nanos, this field is only for internal use")
+	}
+	if m.UpdatedAt.Seconds != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Seconds, this field is only for internal use")
+	}
+	if m.UpdatedAt.Nanos != 0 {
+		return fmt.Errorf("Invalid field specified: UpdatedAt.Nanos, this field is only for internal use")
+	}
+	if m.CompatibilityVersion != 0 {
+		return fmt.Errorf("Invalid field specified: CompatibilityVersion, this field is only for internal use")
+	}
+	if m.RolloutStatus != nil {
+		return fmt.Errorf("Invalid field specified: RolloutStatus, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *AppAutoProvPolicy) IsValidArgsForAddAppAutoProvPolicy() error {
+	return nil
 }
 
 func (m *AppAutoProvPolicy) IsValidArgsForRemoveAppAutoProvPolicy() error {
@@ -7798,6 +9855,18 @@ func (m *AppAlertPolicy) IsValidArgsForRemoveAppAlertPolicy() error {
 	return nil
 }
 
+func (m *AppRolloutRequest) IsValidArgsForPauseAppRollout() error {
+	return nil
+}
+
+func (m *AppRolloutRequest) IsValidArgsForResumeAppRollout() error {
+	return nil
+}
+
+func (m *AppRolloutRequest) IsValidArgsForAbortAppRollout() error {
+	return nil
+}
+
 func (m *AppKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7808,13 +9877,83 @@ func (m *AppKey) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApp(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *FindCloudletRankingWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Distance != 0 {
+		n += 9
+	}
+	if m.Latency != 0 {
+		n += 9
+	}
+	if m.Load != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *RolloutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovApp(uint64(m.Strategy))
+	}
+	if m.CanaryPercent != 0 {
+		n += 1 + sovApp(uint64(m.CanaryPercent))
+	}
+	if m.HealthTimeout != 0 {
+		n += 1 + sovApp(uint64(m.HealthTimeout))
+	}
+	if m.BatchBy != 0 {
+		n += 1 + sovApp(uint64(m.BatchBy))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovApp(uint64(m.BatchSize))
+	}
+	if m.ObservationTime != 0 {
+		n += 1 + sovApp(uint64(m.ObservationTime))
+	}
+	return n
+}
+
+func (m *RolloutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovApp(uint64(m.State))
+	}
+	if m.Batch != 0 {
+		n += 1 + sovApp(uint64(m.Batch))
+	}
+	if m.NumBatches != 0 {
+		n += 1 + sovApp(uint64(m.NumBatches))
+	}
+	l = len(m.Controller)
 	if l > 0 {
 		n += 1 + l + sovApp(uint64(l))
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovApp(uint64(l))
+	if m.ForceUpdate {
+		n += 2
 	}
 	return n
 }
@@ -8036,6 +10175,25 @@ func (m *App) Size() (n int) {
 	if m.CompatibilityVersion != 0 {
 		n += 2 + sovApp(uint64(m.CompatibilityVersion))
 	}
+	if m.FindCloudletRanking != 0 {
+		n += 2 + sovApp(uint64(m.FindCloudletRanking))
+	}
+	if m.FindCloudletRankingWeights != nil {
+		l = m.FindCloudletRankingWeights.Size()
+		n += 2 + l + sovApp(uint64(l))
+	}
+	if m.RolloutPolicy != nil {
+		l = m.RolloutPolicy.Size()
+		n += 2 + l + sovApp(uint64(l))
+	}
+	if m.RolloutStatus != nil {
+		l = m.RolloutStatus.Size()
+		n += 2 + l + sovApp(uint64(l))
+	}
+	if m.HorizontalScalePolicy != nil {
+		l = m.HorizontalScalePolicy.Size()
+		n += 2 + l + sovApp(uint64(l))
+	}
 	if len(m.Tags) > 0 {
 		for k, v := range m.Tags {
 			_ = k
@@ -8085,65 +10243,469 @@ func (m *GpuConfig) Size() (n int) {
 	if m.Ram != 0 {
 		n += 1 + sovApp(uint64(m.Ram))
 	}
-	return n
-}
+	return n
+}
+
+func (m *AppAutoProvPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AppKey.Size()
+	n += 1 + l + sovApp(uint64(l))
+	l = len(m.AutoProvPolicy)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *AppAlertPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AppKey.Size()
+	n += 1 + l + sovApp(uint64(l))
+	l = len(m.AlertPolicy)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *AppRolloutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AppKey.Size()
+	n += 1 + l + sovApp(uint64(l))
+	return n
+}
+
+func (m *DeploymentZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.DryRunDeploy {
+		n += 2
+	}
+	if m.NumNodes != 0 {
+		n += 1 + sovApp(uint64(m.NumNodes))
+	}
+	return n
+}
+
+func sovApp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApp(x uint64) (n int) {
+	return sovApp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AppKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindCloudletRankingWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindCloudletRankingWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindCloudletRankingWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Distance = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Latency = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Load = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AppAutoProvPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AppKey.Size()
-	n += 1 + l + sovApp(uint64(l))
-	l = len(m.AutoProvPolicy)
-	if l > 0 {
-		n += 1 + l + sovApp(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *AppAlertPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AppKey.Size()
-	n += 1 + l + sovApp(uint64(l))
-	l = len(m.AlertPolicy)
-	if l > 0 {
-		n += 1 + l + sovApp(uint64(l))
+func (m *RolloutPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= UpdateStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryPercent", wireType)
+			}
+			m.CanaryPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanaryPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthTimeout", wireType)
+			}
+			m.HealthTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthTimeout |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchBy", wireType)
+			}
+			m.BatchBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchBy |= RolloutBatchBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationTime", wireType)
+			}
+			m.ObservationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationTime |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *DeploymentZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.App != nil {
-		l = m.App.Size()
-		n += 1 + l + sovApp(uint64(l))
-	}
-	if m.DryRunDeploy {
-		n += 2
-	}
-	if m.NumNodes != 0 {
-		n += 1 + sovApp(uint64(m.NumNodes))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovApp(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApp(x uint64) (n int) {
-	return sovApp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AppKey) Unmarshal(dAtA []byte) error {
+func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8166,17 +10728,17 @@ func (m *AppKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApp
@@ -8186,27 +10748,52 @@ func (m *AppKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= RolloutState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApp
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApp
+			m.Batch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Batch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBatches", wireType)
+			}
+			m.NumBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBatches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8234,13 +10821,13 @@ func (m *AppKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUpdate", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApp
@@ -8250,24 +10837,12 @@ func (m *AppKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ForceUpdate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApp(dAtA[iNdEx:])
@@ -10098,6 +12673,169 @@ func (m *App) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindCloudletRanking", wireType)
+			}
+			m.FindCloudletRanking = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FindCloudletRanking |= FindCloudletRanking(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindCloudletRankingWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FindCloudletRankingWeights == nil {
+				m.FindCloudletRankingWeights = &FindCloudletRankingWeights{}
+			}
+			if err := m.FindCloudletRankingWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutPolicy == nil {
+				m.RolloutPolicy = &RolloutPolicy{}
+			}
+			if err := m.RolloutPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutStatus == nil {
+				m.RolloutStatus = &RolloutStatus{}
+			}
+			if err := m.RolloutStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizontalScalePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HorizontalScalePolicy == nil {
+				m.HorizontalScalePolicy = &HorizontalScalePolicy{}
+			}
+			if err := m.HorizontalScalePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
@@ -10769,6 +13507,89 @@ func (m *AppAlertPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AppRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AppKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeploymentZoneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
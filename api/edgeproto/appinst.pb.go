@@ -393,6 +393,12 @@ type AppInst struct {
 	NodeResources *NodeResources `protobuf:"bytes,56,opt,name=node_resources,json=nodeResources,proto3" json:"node_resources,omitempty"`
 	// A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster
 	IsStandalone bool `protobuf:"varint,57,opt,name=is_standalone,json=isStandalone,proto3" json:"is_standalone,omitempty"`
+	// Progress of the App's staged rollout for this AppInst, tracked in the App's AppInstRefs
+	RolloutStatus *RolloutStatus `protobuf:"bytes,58,opt,name=rollout_status,json=rolloutStatus,proto3" json:"rollout_status,omitempty"`
+	// Run validation and placement without creating the instance, and show the placement decisions
+	DryRun bool `protobuf:"varint,59,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Horizontal pod scaling policy for Kubernetes deployments, defaults to the App's policy
+	HorizontalScalePolicy *HorizontalScalePolicy `protobuf:"bytes,60,opt,name=horizontal_scale_policy,json=horizontalScalePolicy,proto3" json:"horizontal_scale_policy,omitempty"`
 	// Vendor-specific data
 	Tags map[string]string `protobuf:"bytes,100,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -436,6 +442,10 @@ var xxx_messageInfo_AppInst proto.InternalMessageInfo
 type AppInstRuntime struct {
 	// List of container names
 	ContainerIds []string `protobuf:"bytes,1,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	// Current number of replicas, for horizontally scaled instances
+	Replicas uint32 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Number of replicas desired by the horizontal pod autoscaler
+	DesiredReplicas uint32 `protobuf:"varint,3,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
 }
 
 func (m *AppInstRuntime) Reset()         { *m = AppInstRuntime{} }
@@ -909,207 +919,217 @@ func init() {
 func init() { proto.RegisterFile("appinst.proto", fileDescriptor_94c89dd623ab567d) }
 
 var fileDescriptor_94c89dd623ab567d = []byte{
-	// 3186 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xf7, 0x4a, 0x14, 0x45, 0x0e, 0x49, 0x89, 0x1a, 0x7d, 0x78, 0xac, 0xc8, 0xb2, 0x4c, 0xc7,
-	0x89, 0xe2, 0xff, 0x5a, 0xb2, 0xe5, 0x44, 0x4e, 0x14, 0x28, 0xfe, 0x4b, 0x8e, 0x94, 0x28, 0xb6,
-	0x25, 0x67, 0xf5, 0x91, 0xb6, 0x97, 0xc5, 0x6a, 0x77, 0x48, 0x6d, 0xb4, 0xdc, 0xd9, 0xec, 0x2e,
-	0x69, 0xcb, 0x40, 0x81, 0x36, 0x40, 0x81, 0xa2, 0x87, 0x20, 0x4d, 0x0f, 0x2d, 0xd2, 0x1e, 0x02,
-	0xb4, 0x87, 0x1c, 0x7a, 0x48, 0x0c, 0x14, 0x05, 0x7c, 0x28, 0x8a, 0x1e, 0x82, 0x20, 0x27, 0x03,
-	0xbd, 0x04, 0x39, 0x14, 0x6e, 0xd2, 0x43, 0xe1, 0x53, 0x00, 0x4b, 0x4a, 0xd1, 0x53, 0x31, 0x1f,
-	0xbb, 0x9c, 0x25, 0x29, 0xd7, 0xb2, 0x7b, 0xe3, 0xbe, 0xf7, 0x7b, 0x6f, 0xde, 0x7b, 0xf3, 0xe6,
-	0xcd, 0x7b, 0x43, 0x50, 0x30, 0x3c, 0xcf, 0x76, 0x83, 0x70, 0xc2, 0xf3, 0x49, 0x48, 0x60, 0x16,
-	0x5b, 0x15, 0xcc, 0x7e, 0x0e, 0x8f, 0x54, 0x08, 0xa9, 0x38, 0x78, 0xd2, 0xf0, 0xec, 0x49, 0xc3,
-	0x75, 0x49, 0x68, 0x84, 0x36, 0x71, 0x03, 0x0e, 0x1c, 0xce, 0xfb, 0x38, 0xa8, 0x39, 0x42, 0x6c,
-	0xf8, 0x78, 0x48, 0x88, 0x13, 0x4c, 0xb2, 0x8f, 0x0a, 0x76, 0xe3, 0x1f, 0x82, 0x9d, 0x35, 0x3c,
-	0x2f, 0x92, 0x2b, 0x3b, 0x46, 0x9d, 0xf8, 0xe2, 0xab, 0xd7, 0xc7, 0x01, 0xa9, 0xf9, 0x26, 0x8e,
-	0xd5, 0x9a, 0xa4, 0x5a, 0x25, 0x91, 0x5c, 0x9f, 0xe9, 0x90, 0x9a, 0xe5, 0xe0, 0x70, 0x1b, 0xef,
-	0x08, 0xd2, 0xa0, 0x51, 0x0b, 0x49, 0x60, 0x1a, 0x0e, 0xf6, 0x88, 0x63, 0x9b, 0x11, 0xb9, 0x60,
-	0x3a, 0xb5, 0x20, 0xc4, 0x91, 0xde, 0x82, 0x55, 0xc5, 0x93, 0x0e, 0x31, 0xc5, 0x67, 0x3f, 0xfd,
-	0x34, 0x3c, 0x2f, 0xa1, 0x7c, 0xa0, 0x42, 0x2a, 0x84, 0xfd, 0x9c, 0xa4, 0xbf, 0x04, 0x15, 0xc6,
-	0x01, 0x88, 0xcd, 0x2f, 0xdd, 0x53, 0xc0, 0xd1, 0x0d, 0xdb, 0x0f, 0x6b, 0x86, 0x73, 0x99, 0x2f,
-	0xb3, 0xe4, 0x06, 0xe1, 0x15, 0xbc, 0xb3, 0x71, 0x1e, 0xbe, 0x02, 0x72, 0x62, 0x69, 0x7d, 0x1b,
-	0xef, 0x20, 0x65, 0x4c, 0x19, 0xcf, 0x4d, 0x1d, 0x9d, 0x88, 0xb5, 0x4c, 0x08, 0x09, 0x86, 0x9e,
-	0x4f, 0x7d, 0xfe, 0xb7, 0x13, 0x47, 0x34, 0x60, 0xc6, 0x34, 0x78, 0x09, 0xe4, 0x23, 0x27, 0x99,
-	0x82, 0x0e, 0xa6, 0x60, 0x28, 0xa1, 0x80, 0xb3, 0xaf, 0xe0, 0x1d, 0x21, 0x9f, 0x33, 0x1b, 0x24,
-	0x38, 0x0d, 0xf2, 0xc4, 0xaf, 0x18, 0xae, 0x7d, 0x8b, 0xed, 0x0f, 0xea, 0x1c, 0x53, 0xc6, 0xb3,
-	0xf3, 0xf0, 0xce, 0x3e, 0x8a, 0x96, 0x21, 0x7e, 0xe5, 0xee, 0x3e, 0x52, 0xb4, 0x04, 0x6e, 0x26,
-	0xff, 0xcf, 0x07, 0x48, 0xf9, 0xd7, 0x03, 0xa4, 0x7c, 0xf2, 0xd1, 0x09, 0xa5, 0xf4, 0x07, 0x05,
-	0xe4, 0xe7, 0x3c, 0xaf, 0xe1, 0xd7, 0x39, 0xd0, 0x6d, 0x78, 0x9e, 0xe4, 0x53, 0x9f, 0x64, 0xd2,
-	0x9c, 0xe7, 0x35, 0xac, 0x49, 0x1b, 0xec, 0x0b, 0x62, 0x50, 0x8c, 0x22, 0x41, 0x13, 0x8a, 0x89,
-	0xa6, 0x98, 0x68, 0x49, 0x12, 0x3d, 0x20, 0x8e, 0xf3, 0x47, 0x3f, 0xde, 0x45, 0xca, 0xfd, 0x7d,
-	0x94, 0x93, 0x38, 0x4c, 0x7d, 0x8f, 0x99, 0x80, 0x36, 0xd9, 0xfd, 0x97, 0xa4, 0xdd, 0x53, 0xf0,
-	0x24, 0x48, 0xb9, 0x46, 0x15, 0x33, 0xa3, 0xb3, 0xf3, 0x85, 0x3b, 0xfb, 0x28, 0x2b, 0x32, 0xbc,
-	0x3e, 0xa5, 0x31, 0x16, 0x7c, 0xbe, 0x29, 0x62, 0x1d, 0x0c, 0x5a, 0xbc, 0xb3, 0x8f, 0xf2, 0x31,
-	0x94, 0xf8, 0x95, 0x64, 0xbc, 0xe0, 0x95, 0xa6, 0x8d, 0xea, 0x7c, 0xe8, 0x46, 0x15, 0xef, 0xef,
-	0xa3, 0x4c, 0x44, 0x68, 0xd9, 0xb4, 0x26, 0x27, 0x08, 0x00, 0x0d, 0x1f, 0xe0, 0x89, 0x84, 0x07,
-	0xb9, 0x3b, 0xfb, 0xa8, 0x5b, 0x98, 0x25, 0xec, 0x9f, 0x6a, 0x6b, 0x7f, 0x0f, 0xdd, 0x71, 0x01,
-	0x6c, 0xb1, 0xbe, 0x69, 0xc1, 0xdf, 0x8e, 0x80, 0x6e, 0xb1, 0x22, 0x1c, 0x02, 0xe9, 0xb2, 0x8d,
-	0x1d, 0x2b, 0x40, 0xca, 0x58, 0xe7, 0x78, 0x56, 0x13, 0x5f, 0xf0, 0x2c, 0xe8, 0x6c, 0xe4, 0xe3,
-	0x60, 0x72, 0xf3, 0x85, 0xa9, 0x22, 0x01, 0x28, 0x0e, 0x5e, 0x6c, 0xe4, 0x8b, 0x7a, 0x50, 0xbe,
-	0xe4, 0xee, 0xef, 0xa3, 0xce, 0x39, 0xcf, 0x4b, 0xa4, 0xcd, 0x95, 0xe4, 0x01, 0x3a, 0xdb, 0xb2,
-	0x5e, 0xe3, 0x00, 0xcd, 0xf7, 0xb7, 0x4b, 0x10, 0xf9, 0x34, 0x35, 0x6f, 0xd2, 0x85, 0x27, 0xd8,
-	0x24, 0x78, 0x01, 0x64, 0x6e, 0x11, 0x17, 0x33, 0x45, 0x2f, 0x30, 0x45, 0x50, 0x52, 0xf4, 0x03,
-	0xe2, 0xe2, 0x46, 0x0c, 0xba, 0x6f, 0xf1, 0x4f, 0xb8, 0x28, 0x59, 0xe0, 0x10, 0x53, 0xa4, 0xc9,
-	0xf1, 0x09, 0xcb, 0x0e, 0x42, 0xdf, 0xde, 0xac, 0x85, 0xd8, 0xd2, 0xab, 0x46, 0x68, 0x6e, 0xe9,
-	0xd8, 0xad, 0xd8, 0x2e, 0x9e, 0xb8, 0x4a, 0xcc, 0xe6, 0x63, 0x7d, 0x95, 0x98, 0x70, 0x08, 0x74,
-	0xd6, 0x7c, 0x9b, 0x1d, 0xa0, 0xec, 0x7c, 0x8a, 0x1e, 0x0e, 0x8d, 0x12, 0xe0, 0x29, 0x00, 0x02,
-	0x5a, 0x89, 0x4d, 0x9d, 0xb2, 0xa7, 0x24, 0x76, 0x96, 0xd3, 0xd7, 0x7d, 0x1b, 0xbe, 0x00, 0x32,
-	0x8e, 0x5d, 0xc7, 0x2e, 0x0e, 0x02, 0x94, 0x1e, 0x53, 0xc6, 0x7b, 0xa6, 0xfa, 0x25, 0xcb, 0xaf,
-	0x0a, 0x96, 0x90, 0x8b, 0xa1, 0xf0, 0xff, 0x41, 0xbe, 0x6a, 0x78, 0x1e, 0xb6, 0x74, 0x8f, 0xf8,
-	0x61, 0x80, 0xb2, 0x63, 0x9d, 0xe3, 0xb9, 0x84, 0x28, 0x0d, 0xfa, 0x75, 0xe2, 0x87, 0xf3, 0x19,
-	0x2a, 0xca, 0xad, 0xe6, 0x22, 0x94, 0x4a, 0x35, 0xa4, 0x79, 0x7d, 0x47, 0x79, 0xe6, 0xf7, 0x80,
-	0x24, 0xbb, 0xc8, 0x18, 0x34, 0x64, 0x50, 0x9c, 0xf5, 0x34, 0x27, 0xf1, 0x74, 0xe0, 0x72, 0xf0,
-	0x59, 0xd0, 0x1b, 0xc7, 0x4f, 0xa8, 0x3a, 0x43, 0x9d, 0xa4, 0x75, 0x80, 0x93, 0xb9, 0x10, 0xbc,
-	0x00, 0xba, 0xa8, 0xc3, 0x18, 0xf5, 0x30, 0x07, 0xe5, 0x92, 0xbb, 0xe6, 0x1b, 0xe6, 0x36, 0xb6,
-	0x56, 0x29, 0x5b, 0x38, 0xc9, 0xb1, 0x70, 0x04, 0xa4, 0xb1, 0xef, 0x13, 0x3f, 0x40, 0xbd, 0x34,
-	0xd9, 0x05, 0x53, 0xd0, 0xe0, 0x4b, 0x20, 0x6f, 0xfa, 0x55, 0x9d, 0xd4, 0xb1, 0xef, 0xdb, 0x16,
-	0x46, 0x45, 0xa6, 0x39, 0x91, 0x3d, 0xda, 0xb5, 0x15, 0xc1, 0xd5, 0x72, 0xa6, 0x5f, 0x8d, 0x3e,
-	0xe0, 0x3c, 0xc8, 0xfb, 0x35, 0x37, 0xb4, 0xab, 0x58, 0xb7, 0xdd, 0x32, 0x41, 0x7d, 0xcc, 0xfd,
-	0x63, 0xad, 0xc7, 0x46, 0xe3, 0xa8, 0x68, 0xcb, 0x85, 0xd0, 0x92, 0x5b, 0x26, 0xf0, 0xfb, 0x00,
-	0x98, 0x3e, 0x36, 0x68, 0x86, 0x18, 0x21, 0x1a, 0x64, 0x1a, 0x4e, 0x1d, 0x9c, 0x38, 0x6b, 0x76,
-	0x15, 0x07, 0xa1, 0x51, 0xf5, 0xe6, 0x07, 0xa9, 0x17, 0x1f, 0xdc, 0x3e, 0x96, 0x0d, 0x23, 0x12,
-	0x53, 0x9e, 0x15, 0xda, 0xe6, 0x42, 0xb8, 0x0c, 0x86, 0xe8, 0xbd, 0xa9, 0xc7, 0x05, 0xda, 0xd3,
-	0x0d, 0xd3, 0xa4, 0xe9, 0x31, 0xd4, 0x92, 0x1e, 0x4b, 0xde, 0x1c, 0x63, 0x89, 0xe0, 0xf4, 0x53,
-	0xc1, 0xe8, 0xcc, 0x09, 0x16, 0x3c, 0x0d, 0x32, 0x3e, 0xae, 0xdb, 0x01, 0x2d, 0x3f, 0x88, 0xe5,
-	0x60, 0xf6, 0x83, 0xdb, 0xc7, 0xba, 0x5c, 0x62, 0x56, 0x3d, 0x2d, 0x66, 0x41, 0x15, 0xe4, 0xcb,
-	0xc4, 0x37, 0xb1, 0x5e, 0xf3, 0x2c, 0xba, 0x55, 0xc7, 0xc6, 0x94, 0xf1, 0x8c, 0x0c, 0xcd, 0x31,
-	0xf6, 0x3a, 0xe3, 0xc2, 0x29, 0xd0, 0xcb, 0x71, 0x7a, 0xb5, 0xe6, 0x84, 0xb6, 0xe7, 0x60, 0x34,
-	0xdc, 0x2c, 0xd0, 0xc3, 0x11, 0xd7, 0x04, 0x00, 0x4e, 0x82, 0x6e, 0x93, 0xb8, 0x65, 0xbb, 0x12,
-	0xa0, 0xa7, 0x58, 0xb6, 0x26, 0x2a, 0x07, 0xe3, 0x2c, 0xda, 0x0e, 0xd6, 0x22, 0x14, 0x5c, 0x06,
-	0xf9, 0x2d, 0x6c, 0x38, 0xe1, 0x96, 0x6e, 0x6e, 0x61, 0x73, 0x1b, 0x1d, 0x67, 0xfe, 0x9f, 0x3e,
-	0x38, 0xcc, 0xaf, 0x33, 0xf4, 0x65, 0x0a, 0x16, 0x11, 0xc9, 0x6d, 0x35, 0x48, 0x70, 0x1a, 0xe4,
-	0x3c, 0x72, 0x03, 0xfb, 0x3a, 0x4f, 0xc6, 0x13, 0x4c, 0x9d, 0x6c, 0xc4, 0x75, 0xca, 0x65, 0xa9,
-	0xa8, 0x01, 0x2f, 0xfe, 0x0d, 0xa7, 0xc1, 0x00, 0xbe, 0x19, 0x62, 0xdf, 0x35, 0x1c, 0xbd, 0x4e,
-	0x9c, 0x5a, 0x15, 0xeb, 0x81, 0x7d, 0x0b, 0xa3, 0xb1, 0x31, 0x65, 0x3c, 0x25, 0x16, 0x82, 0x11,
-	0x62, 0x83, 0x01, 0x56, 0xed, 0x5b, 0x18, 0x9e, 0x07, 0x7d, 0x46, 0xdd, 0xb0, 0x1d, 0x63, 0xd3,
-	0x76, 0xec, 0x70, 0x47, 0xa7, 0x75, 0x07, 0x9d, 0x94, 0xca, 0x40, 0x51, 0x66, 0xd3, 0x22, 0x05,
-	0x4f, 0x82, 0x6c, 0xbd, 0x1a, 0x1d, 0xa6, 0x92, 0x04, 0xcd, 0xd4, 0xab, 0xe2, 0x30, 0x1d, 0x07,
-	0xdd, 0xc4, 0x0b, 0x75, 0x1f, 0x07, 0xe8, 0x94, 0x04, 0x48, 0x13, 0x2f, 0xd4, 0x70, 0x40, 0x33,
-	0x93, 0xc7, 0x9d, 0x65, 0xe6, 0xd3, 0x4f, 0x9e, 0x99, 0x42, 0xdb, 0x5c, 0x08, 0xcf, 0x81, 0x3e,
-	0x1f, 0x1b, 0x4e, 0x9c, 0x99, 0xec, 0xea, 0x3b, 0x2d, 0xd9, 0xd0, 0x4b, 0xd9, 0x22, 0xff, 0x96,
-	0xe9, 0xf5, 0x67, 0x81, 0x21, 0xdb, 0x15, 0x91, 0xa3, 0x75, 0x4a, 0x0f, 0x89, 0xee, 0x6c, 0xea,
-	0xb6, 0x87, 0x9e, 0x61, 0x19, 0x70, 0xa6, 0xf5, 0xd0, 0x4d, 0x2c, 0x09, 0x01, 0x5a, 0xa5, 0xd6,
-	0xc8, 0xd5, 0xcd, 0x25, 0x6f, 0xc1, 0x0d, 0xfd, 0x9d, 0x28, 0xce, 0x76, 0x0b, 0x1b, 0x9e, 0x04,
-	0x79, 0x0b, 0x5b, 0xb6, 0xc9, 0x9c, 0xb6, 0x3d, 0xf4, 0x2c, 0xcd, 0x44, 0x2d, 0x17, 0xd3, 0x18,
-	0x24, 0x5b, 0x73, 0xed, 0x77, 0x6a, 0x58, 0xb7, 0x2d, 0x34, 0x2e, 0xc7, 0x95, 0x93, 0x97, 0x2c,
-	0x0a, 0xb1, 0xdc, 0x40, 0x77, 0x8c, 0x4d, 0xec, 0xa0, 0xe7, 0x64, 0x88, 0xe5, 0x06, 0x57, 0x29,
-	0x15, 0xbe, 0x0c, 0xba, 0xcb, 0xd8, 0x62, 0x97, 0xcc, 0xff, 0xb1, 0xc0, 0x22, 0xb9, 0x66, 0x62,
-	0x4b, 0xba, 0x6e, 0x1b, 0x45, 0x37, 0x5d, 0xc6, 0x16, 0xbd, 0x6d, 0xe6, 0xc1, 0xa0, 0x49, 0xaa,
-	0x9e, 0x11, 0xda, 0x22, 0x1d, 0xea, 0xd8, 0x67, 0x87, 0x72, 0x62, 0x4c, 0x19, 0x2f, 0xcc, 0x17,
-	0x44, 0xf8, 0xc5, 0xe1, 0x19, 0x48, 0x60, 0x37, 0x38, 0x14, 0x7e, 0x0f, 0xf4, 0xd7, 0x79, 0x53,
-	0xa6, 0xcb, 0x17, 0xf1, 0xe4, 0xc3, 0x2e, 0xe2, 0xbe, 0x84, 0x62, 0x66, 0x52, 0x5f, 0x3d, 0xd1,
-	0xd9, 0xf1, 0x4e, 0x26, 0x87, 0x5d, 0x63, 0xd3, 0xc1, 0xba, 0xed, 0xd5, 0xa7, 0xd1, 0x39, 0x16,
-	0x42, 0xc0, 0x49, 0x4b, 0x5e, 0x7d, 0x1a, 0x3e, 0x0d, 0xd2, 0x64, 0xf3, 0x6d, 0x1a, 0xbe, 0xf3,
-	0xbc, 0x5d, 0x4b, 0xda, 0xdb, 0x45, 0x36, 0xdf, 0x5e, 0xb2, 0xe0, 0x02, 0xc8, 0x49, 0xf3, 0x07,
-	0x7a, 0x9e, 0xed, 0xf2, 0xa9, 0x36, 0xbb, 0x3c, 0xd7, 0x40, 0xb1, 0xed, 0xd5, 0x64, 0x39, 0x78,
-	0x16, 0xe4, 0xac, 0x4d, 0xbd, 0x4a, 0x2c, 0xec, 0xd0, 0x15, 0xa7, 0xc7, 0x94, 0xf1, 0xae, 0xe6,
-	0x15, 0xb3, 0xd6, 0xe6, 0x35, 0x0a, 0x58, 0xb2, 0xe0, 0x9b, 0x60, 0x60, 0xbb, 0xb6, 0x89, 0x7d,
-	0x17, 0x87, 0x38, 0xd0, 0xe3, 0x39, 0x05, 0x5d, 0x64, 0x71, 0x19, 0x95, 0x96, 0xbf, 0x12, 0xc3,
-	0xb4, 0x08, 0xa5, 0xf5, 0x6f, 0xb7, 0x12, 0xe1, 0x25, 0xd0, 0xe3, 0x12, 0x0b, 0x4b, 0xca, 0x5e,
-	0x6c, 0xd9, 0xf1, 0x65, 0x62, 0xe1, 0x86, 0x9a, 0x82, 0x2b, 0x7f, 0xc2, 0x53, 0xa0, 0x60, 0x07,
-	0xb4, 0xd2, 0xb8, 0x96, 0xe1, 0xd0, 0x83, 0xff, 0x12, 0x0b, 0x69, 0xde, 0x0e, 0x56, 0x63, 0x1a,
-	0x3c, 0x07, 0x52, 0xa1, 0x51, 0x09, 0x90, 0xc5, 0xe2, 0x34, 0xd2, 0x26, 0x4e, 0x6b, 0x46, 0x45,
-	0x04, 0x88, 0x21, 0x87, 0x17, 0xc0, 0xd1, 0x03, 0x0e, 0x08, 0x2c, 0xf2, 0x2e, 0x90, 0xf5, 0xa2,
-	0xbc, 0xd1, 0x1b, 0x00, 0x5d, 0x75, 0xc3, 0xa9, 0x61, 0xde, 0x76, 0x6a, 0xfc, 0x63, 0xa6, 0xe3,
-	0x45, 0x65, 0xf8, 0x15, 0x50, 0x6c, 0xde, 0x81, 0x43, 0xc9, 0x5f, 0x04, 0xd9, 0xd8, 0xb2, 0xc3,
-	0x08, 0xce, 0xfc, 0x2e, 0x4d, 0xbb, 0xdb, 0x6f, 0x1f, 0x20, 0xe5, 0x47, 0xbb, 0x48, 0x79, 0x7f,
-	0x17, 0x29, 0xbf, 0xda, 0x45, 0xca, 0x27, 0xf4, 0xb0, 0xec, 0x22, 0xe5, 0x4b, 0xba, 0xc3, 0x7b,
-	0xe8, 0x93, 0x8e, 0xcb, 0x8d, 0xf6, 0x4a, 0x5d, 0xf7, 0x6d, 0x75, 0x35, 0xea, 0x97, 0xd4, 0x6b,
-	0x8d, 0x16, 0x46, 0x8d, 0xba, 0x23, 0xf5, 0x72, 0x74, 0x7b, 0xaa, 0x9a, 0xb8, 0xcf, 0xd4, 0x05,
-	0xd6, 0x27, 0xa8, 0x5a, 0xe3, 0xd2, 0x56, 0x37, 0x44, 0x09, 0x55, 0x17, 0x5a, 0x6a, 0xb5, 0x3a,
-	0xd7, 0x54, 0x89, 0xd9, 0x8a, 0x58, 0x5d, 0x8f, 0x8a, 0x9f, 0xba, 0xc2, 0xca, 0xab, 0xba, 0xba,
-	0x65, 0xf8, 0xd8, 0x92, 0x05, 0x5b, 0xaf, 0x5c, 0xb5, 0x75, 0x87, 0xd4, 0x75, 0x51, 0x66, 0xd4,
-	0x57, 0x45, 0x31, 0x51, 0x17, 0x59, 0x59, 0x50, 0x79, 0xbf, 0x3d, 0xb1, 0x22, 0x4d, 0x00, 0xea,
-	0xe5, 0x36, 0x67, 0x5f, 0xdd, 0x68, 0x3e, 0xb3, 0xaa, 0xd4, 0x1f, 0x7f, 0xb8, 0x87, 0x7e, 0xd6,
-	0x29, 0x26, 0x0a, 0x5a, 0x96, 0x67, 0xa9, 0x4e, 0x5a, 0x82, 0xd5, 0xc6, 0x98, 0x31, 0xdb, 0xb2,
-	0x8e, 0xe1, 0x79, 0x0c, 0x2c, 0x6c, 0x88, 0xf0, 0xb4, 0x30, 0x45, 0xb4, 0x68, 0x75, 0xc3, 0xf3,
-	0xa8, 0x8a, 0x76, 0xd6, 0xd2, 0x6b, 0x6d, 0x56, 0xb4, 0xd8, 0x5c, 0x07, 0xa5, 0x50, 0x74, 0x44,
-	0x6c, 0x81, 0x97, 0xb1, 0x25, 0xf3, 0x17, 0xb1, 0x85, 0x7d, 0x1a, 0xe7, 0x04, 0x30, 0x6a, 0x22,
-	0x67, 0x25, 0x3f, 0xb9, 0xfe, 0x88, 0x43, 0x75, 0xc8, 0xcc, 0x84, 0x78, 0x39, 0x52, 0xda, 0x8c,
-	0x3a, 0x68, 0x35, 0x16, 0xd7, 0xd9, 0x46, 0x7c, 0xa3, 0xb5, 0xa2, 0xc1, 0x5c, 0x66, 0x25, 0x57,
-	0x62, 0x59, 0x35, 0xcb, 0x93, 0x8b, 0x49, 0x7d, 0xb5, 0x87, 0xba, 0x85, 0x73, 0xb7, 0xf7, 0xd1,
-	0xd9, 0x6d, 0xbc, 0x33, 0x9b, 0x90, 0xa8, 0x1b, 0xce, 0x81, 0x86, 0x7f, 0xf4, 0x1d, 0x52, 0xde,
-	0x48, 0x65, 0x46, 0x8a, 0xc7, 0xdf, 0x48, 0x65, 0x46, 0x8b, 0x27, 0x34, 0x18, 0xb0, 0x9c, 0x93,
-	0x5b, 0x0f, 0xad, 0xc7, 0xf3, 0xed, 0xba, 0x61, 0xee, 0xe8, 0xfc, 0x65, 0xa5, 0xf4, 0x32, 0xe8,
-	0x49, 0x36, 0xad, 0xf0, 0x39, 0x50, 0x30, 0x89, 0x1b, 0x1a, 0xb6, 0x4b, 0x7b, 0xc8, 0x68, 0x64,
-	0x14, 0x57, 0x5a, 0x3e, 0x66, 0x2d, 0x59, 0x41, 0xe9, 0xbd, 0x4e, 0x90, 0x89, 0xa6, 0x05, 0x38,
-	0x0d, 0xba, 0x58, 0x05, 0x62, 0xc7, 0xb9, 0x67, 0x6a, 0xec, 0x21, 0xd3, 0xd0, 0x75, 0x8a, 0xd3,
-	0x38, 0x9c, 0xd5, 0x3b, 0xf9, 0xaa, 0x67, 0x47, 0xbf, 0x4b, 0xcb, 0xcb, 0xf7, 0x35, 0xbd, 0x65,
-	0xbc, 0xda, 0xa6, 0x63, 0x9b, 0x1c, 0xd2, 0xc9, 0x20, 0x80, 0x93, 0x62, 0x80, 0x11, 0x6e, 0xe9,
-	0x9e, 0x8f, 0xcb, 0xf6, 0x4d, 0x3e, 0x52, 0x69, 0x80, 0x92, 0xae, 0x33, 0x0a, 0x05, 0x94, 0xdf,
-	0xb1, 0xdc, 0x08, 0xd0, 0xc5, 0x01, 0x94, 0x24, 0x00, 0xc7, 0x40, 0x06, 0xbb, 0x7c, 0x2a, 0x62,
-	0xf3, 0x54, 0x97, 0xd6, 0x8d, 0x5d, 0x56, 0x2f, 0x68, 0x9d, 0x0a, 0x9d, 0x00, 0x75, 0xb3, 0x42,
-	0x4c, 0x7f, 0xd2, 0x3a, 0x45, 0x7d, 0xb9, 0x89, 0x32, 0x8c, 0xc6, 0x3f, 0xe0, 0x18, 0x9d, 0xad,
-	0x6e, 0xea, 0xde, 0x76, 0xc8, 0xfb, 0xbc, 0xec, 0x98, 0x32, 0xde, 0xa9, 0x81, 0xaa, 0x71, 0xf3,
-	0xfa, 0x76, 0xc8, 0x3a, 0xbb, 0x33, 0xa0, 0x2f, 0x76, 0xb6, 0x6e, 0x07, 0x3a, 0x71, 0x9d, 0x1d,
-	0x04, 0x98, 0x8e, 0xde, 0x88, 0xb1, 0x61, 0x07, 0x2b, 0xae, 0xb3, 0x03, 0x7b, 0x40, 0x87, 0x6d,
-	0xa1, 0x1c, 0x33, 0xb4, 0xc3, 0xa6, 0x7d, 0x46, 0x3e, 0xc0, 0x7e, 0xdd, 0x36, 0x31, 0x6f, 0xa0,
-	0xf2, 0x8c, 0x93, 0x13, 0x34, 0x9a, 0x3d, 0xa5, 0xcf, 0x52, 0x20, 0x27, 0xb6, 0x93, 0x4d, 0x1b,
-	0xff, 0xa3, 0xb9, 0xff, 0x19, 0x90, 0x75, 0x49, 0x68, 0x97, 0x77, 0xe8, 0x9d, 0x4a, 0x63, 0xdf,
-	0x99, 0x18, 0x05, 0x38, 0x6f, 0xc9, 0x82, 0x67, 0xa3, 0x71, 0x2d, 0xf5, 0xd0, 0x71, 0x2d, 0x1a,
-	0xd4, 0x86, 0xe2, 0x41, 0xad, 0x8b, 0x5b, 0x27, 0x46, 0xb4, 0xe6, 0x39, 0x2b, 0xfd, 0x18, 0x73,
-	0xd6, 0x45, 0x90, 0xa6, 0x8b, 0xd4, 0xf8, 0xae, 0x25, 0x9d, 0x5c, 0x65, 0x0c, 0x0a, 0x93, 0xbb,
-	0x2d, 0x0e, 0x6f, 0xee, 0xf5, 0x33, 0x8f, 0xda, 0xeb, 0x8b, 0x59, 0x3e, 0xdb, 0x3c, 0xcb, 0x4b,
-	0xad, 0x1f, 0x38, 0x74, 0xeb, 0x37, 0x0d, 0xb2, 0xe5, 0x78, 0x52, 0xcf, 0x1d, 0x3c, 0xa9, 0xf3,
-	0x00, 0x64, 0xca, 0xe2, 0x7e, 0x9b, 0xb9, 0xd4, 0x7c, 0x57, 0x7e, 0xb4, 0x8b, 0x94, 0x3b, 0xbb,
-	0x28, 0x2f, 0x6f, 0xc3, 0xbd, 0x5d, 0xa4, 0xdc, 0xde, 0x47, 0x29, 0x97, 0xb8, 0xf8, 0xdb, 0x7d,
-	0xa4, 0xdc, 0xfe, 0x0e, 0x45, 0x0f, 0x46, 0xa5, 0x89, 0xb8, 0x2c, 0x5c, 0xc3, 0xa1, 0x6f, 0x9b,
-	0x01, 0x1c, 0x01, 0xd9, 0x80, 0x54, 0x71, 0xb8, 0x65, 0xbb, 0x15, 0x76, 0x7a, 0x52, 0x5a, 0x83,
-	0x50, 0xfa, 0xa9, 0x02, 0x0a, 0x42, 0xe0, 0x2a, 0x21, 0xdb, 0x35, 0x2f, 0x4a, 0x31, 0xe5, 0x11,
-	0x53, 0xec, 0x25, 0x00, 0x78, 0x45, 0x92, 0x1e, 0x48, 0x07, 0x12, 0x51, 0xa7, 0xcc, 0x86, 0x50,
-	0xd6, 0x8b, 0x08, 0x33, 0x85, 0x2f, 0xf6, 0x51, 0x36, 0xe6, 0x97, 0x7e, 0xae, 0xc4, 0xb6, 0x73,
-	0x53, 0xa6, 0x0e, 0x6b, 0xcb, 0x93, 0x3e, 0xd7, 0xce, 0xf4, 0x7e, 0xc1, 0x9e, 0xb0, 0x62, 0x42,
-	0xe9, 0x81, 0x64, 0x93, 0x11, 0x62, 0xd7, 0xdc, 0x39, 0xa4, 0x4d, 0x33, 0x9f, 0x2a, 0x1f, 0xee,
-	0xa1, 0xdf, 0x2b, 0x87, 0xbe, 0xa9, 0xe3, 0xbb, 0x90, 0x72, 0x1e, 0x7a, 0x1f, 0x36, 0x03, 0xda,
-	0xaa, 0x11, 0xf7, 0x6f, 0x33, 0xb6, 0xed, 0xcd, 0x48, 0x77, 0xa2, 0x90, 0xc8, 0x70, 0x78, 0x09,
-	0xf4, 0x8a, 0xdb, 0xd5, 0x26, 0xae, 0x2e, 0xbd, 0x80, 0x0e, 0xdd, 0xd9, 0x47, 0x3d, 0x0d, 0x16,
-	0xe5, 0xb0, 0xe7, 0x6c, 0x89, 0xc6, 0xe6, 0xc2, 0xf3, 0x20, 0x67, 0x78, 0x1e, 0x7f, 0x7b, 0xb6,
-	0x2d, 0xf1, 0x2a, 0xda, 0x27, 0x3d, 0x00, 0xdb, 0x16, 0x93, 0xa3, 0x9f, 0xac, 0x0a, 0x5a, 0x4d,
-	0xaf, 0xa2, 0xbf, 0x54, 0x00, 0x68, 0xd8, 0x04, 0xcf, 0xc9, 0xbb, 0x70, 0xf0, 0xc9, 0x94, 0x92,
-	0x63, 0x16, 0xe4, 0x63, 0x0b, 0x1e, 0xb1, 0x86, 0x02, 0x23, 0xa6, 0xcc, 0x20, 0xf9, 0x64, 0xca,
-	0xa7, 0xaf, 0x74, 0x4f, 0x01, 0xbd, 0x8d, 0x55, 0x17, 0xea, 0xd8, 0x7d, 0x1c, 0xf3, 0xe2, 0x12,
-	0xdc, 0xf1, 0x48, 0x25, 0x18, 0x81, 0xee, 0x2a, 0x0e, 0x02, 0xa3, 0x82, 0xf9, 0x7f, 0x0a, 0x5a,
-	0xf4, 0x09, 0x27, 0x41, 0x17, 0x2f, 0x3b, 0xa9, 0xff, 0x56, 0x76, 0x38, 0x0e, 0x3e, 0x25, 0x4f,
-	0xca, 0xfc, 0x7a, 0x8d, 0x67, 0xe4, 0x99, 0xd4, 0x9f, 0x77, 0x91, 0x72, 0xe6, 0xbd, 0x0e, 0x00,
-	0x1a, 0xe5, 0x13, 0x1e, 0x07, 0xfd, 0xd7, 0x57, 0xde, 0x5a, 0xd0, 0xf4, 0xd5, 0xb5, 0xb9, 0xb5,
-	0x05, 0x7d, 0x7d, 0xf9, 0xca, 0xf2, 0xca, 0x5b, 0xcb, 0xc5, 0x23, 0xc3, 0xa9, 0xf7, 0xf7, 0x91,
-	0x02, 0x47, 0x00, 0xe4, 0xec, 0x95, 0x65, 0x5d, 0x5b, 0x78, 0x73, 0x7d, 0x61, 0x75, 0x6d, 0xe1,
-	0xd5, 0xa2, 0x22, 0xb8, 0x83, 0x20, 0xc7, 0xb8, 0x4b, 0xcb, 0xaf, 0xe9, 0x2b, 0xcb, 0xc5, 0x0e,
-	0x41, 0xce, 0x83, 0x4c, 0x24, 0x54, 0xec, 0x6c, 0xac, 0xb0, 0xb2, 0xb8, 0x28, 0xe9, 0x48, 0x09,
-	0xf0, 0x10, 0xc8, 0x37, 0x74, 0x2c, 0x2e, 0x16, 0xbb, 0x04, 0xbd, 0x00, 0xb2, 0xb1, 0x58, 0x31,
-	0x0d, 0x87, 0x41, 0x51, 0x5b, 0x98, 0x5f, 0x59, 0x59, 0x93, 0x54, 0x74, 0x0b, 0x68, 0x3f, 0xc8,
-	0x72, 0xde, 0xd2, 0xf2, 0x6b, 0xc5, 0x8c, 0x20, 0x02, 0x90, 0xe6, 0xc4, 0x62, 0x16, 0x3e, 0x05,
-	0xfa, 0x64, 0x27, 0x17, 0x34, 0x6d, 0x45, 0x2b, 0x02, 0x0e, 0x9c, 0xfa, 0x32, 0x13, 0xff, 0x2b,
-	0x30, 0xe7, 0xd9, 0xf0, 0x8f, 0x0a, 0x28, 0xf0, 0x89, 0x24, 0xca, 0x4f, 0xd8, 0x9a, 0x57, 0xc3,
-	0xf2, 0xa3, 0xbb, 0xc6, 0xfe, 0xa0, 0x2b, 0xfd, 0xf0, 0xfe, 0x2e, 0x9a, 0x88, 0x46, 0x48, 0x81,
-	0x0b, 0xd4, 0x39, 0x93, 0x9e, 0x9b, 0x6b, 0x86, 0x6b, 0x54, 0xb0, 0xda, 0x7c, 0xa4, 0x3f, 0xde,
-	0x43, 0xca, 0xdd, 0x3d, 0xa4, 0x7c, 0xb5, 0x87, 0x4e, 0xaf, 0x27, 0xde, 0xdb, 0xd4, 0xc5, 0xc6,
-	0x7b, 0x9d, 0xda, 0xd8, 0xae, 0x77, 0xff, 0xfa, 0x8f, 0x5f, 0x74, 0x0c, 0x94, 0x7a, 0x27, 0xf9,
-	0x8b, 0xe3, 0xa4, 0x38, 0x70, 0x33, 0xca, 0x99, 0x73, 0x0a, 0xfc, 0x8d, 0x02, 0x0a, 0xaf, 0x62,
-	0x07, 0x1f, 0xda, 0xf2, 0xcd, 0x27, 0xb2, 0x1c, 0xb4, 0x31, 0xcf, 0x62, 0x66, 0x24, 0xcd, 0xfb,
-	0xb7, 0x02, 0x7a, 0x34, 0x5c, 0xf6, 0x71, 0xb0, 0x75, 0x48, 0xfb, 0xfe, 0xa4, 0x3c, 0x9e, 0x81,
-	0x5f, 0xed, 0xa1, 0x55, 0x31, 0x34, 0xb6, 0x1b, 0xf4, 0xf8, 0xab, 0x65, 0x20, 0x85, 0x57, 0x95,
-	0xde, 0x20, 0x5b, 0x87, 0xc5, 0x68, 0x02, 0xbd, 0xbf, 0x87, 0x20, 0x2f, 0xbe, 0xf2, 0xff, 0x65,
-	0xcc, 0xf7, 0xc1, 0x52, 0x71, 0xd2, 0xe7, 0x3e, 0x26, 0x9d, 0xff, 0x71, 0x07, 0x28, 0xf0, 0xdd,
-	0x3c, 0xa4, 0xef, 0x9f, 0x3d, 0xbe, 0xef, 0x7e, 0x3b, 0xa7, 0x1f, 0x92, 0x66, 0x8f, 0xe4, 0xbc,
-	0x18, 0x79, 0xd5, 0xf6, 0x93, 0x6c, 0x9c, 0x00, 0xfc, 0xdd, 0x31, 0x19, 0x83, 0x9f, 0x28, 0x20,
-	0xb7, 0xba, 0x45, 0x6e, 0x3c, 0x2c, 0x02, 0x6d, 0x68, 0xa5, 0xab, 0xf7, 0x77, 0x91, 0x7a, 0x40,
-	0x04, 0x36, 0x6c, 0x7c, 0xa3, 0xc5, 0x7f, 0x9a, 0x98, 0xcc, 0x12, 0x58, 0x2a, 0x4c, 0x06, 0x5b,
-	0xe4, 0x46, 0xd2, 0x8e, 0x2d, 0x30, 0xf8, 0xba, 0xe1, 0x5a, 0x0e, 0x6e, 0xae, 0xf4, 0xc3, 0x6d,
-	0x8b, 0x3b, 0xe3, 0xb5, 0xdb, 0x9a, 0x31, 0xb6, 0xc6, 0x70, 0x69, 0x70, 0x92, 0x5e, 0x90, 0x14,
-	0x15, 0xad, 0x43, 0x3b, 0xe6, 0x19, 0xe5, 0xcc, 0x54, 0x10, 0x77, 0x1c, 0xb4, 0xd1, 0xa5, 0xd5,
-	0xc5, 0x00, 0xbd, 0x52, 0x08, 0xf8, 0x7c, 0xd0, 0xea, 0x32, 0xa5, 0x0f, 0x1f, 0x40, 0x2f, 0x8d,
-	0xb0, 0x65, 0x87, 0x4a, 0x7d, 0x09, 0xd7, 0xc4, 0x92, 0xe7, 0x94, 0xa9, 0x77, 0x15, 0xd0, 0x97,
-	0xec, 0x1b, 0xe9, 0xc2, 0x55, 0x00, 0xa5, 0x85, 0xa3, 0x86, 0xb2, 0x4d, 0x3f, 0x2f, 0x58, 0xc3,
-	0x07, 0xb3, 0x4a, 0x27, 0x98, 0x05, 0xc7, 0x4a, 0x03, 0x09, 0x0b, 0xaa, 0x9c, 0xcb, 0x8d, 0xf8,
-	0xb4, 0x61, 0x84, 0x68, 0xb6, 0xa8, 0x11, 0xbf, 0x56, 0xc0, 0xa0, 0x86, 0xdf, 0xa9, 0x61, 0x5a,
-	0x6a, 0x13, 0x9d, 0x58, 0x9b, 0xd5, 0x04, 0xab, 0x5d, 0xe4, 0xd7, 0x0e, 0x7f, 0x26, 0x98, 0xc9,
-	0x23, 0xa5, 0xa3, 0x93, 0x3e, 0x5f, 0x3f, 0xb2, 0xda, 0xe1, 0xab, 0xcc, 0x28, 0x67, 0xe6, 0x47,
-	0x3e, 0xff, 0xfb, 0xe8, 0x91, 0xcf, 0xbf, 0x1e, 0x55, 0xee, 0x7e, 0x3d, 0xaa, 0xdc, 0xfb, 0x7a,
-	0x54, 0x79, 0xff, 0x9b, 0xd1, 0x23, 0x77, 0xbf, 0x19, 0x3d, 0xf2, 0xe5, 0x37, 0xa3, 0x47, 0x36,
-	0xd3, 0xcc, 0x82, 0x0b, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x79, 0x13, 0xc6, 0xc8, 0x21,
-	0x00, 0x00,
+	// 3355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5d, 0x68, 0x1c, 0xd7,
+	0xf5, 0xf7, 0x48, 0x2b, 0x69, 0xf7, 0xee, 0xae, 0xb4, 0xba, 0xfa, 0xf0, 0xb5, 0x22, 0xcb, 0xeb,
+	0x75, 0x9c, 0x28, 0xfe, 0x8f, 0x25, 0x5b, 0x4e, 0xe4, 0x44, 0xf9, 0x2b, 0xae, 0x64, 0x4b, 0x89,
+	0x62, 0x5b, 0x72, 0x46, 0x1f, 0x69, 0xf3, 0x32, 0x8c, 0x66, 0xee, 0xae, 0x26, 0x9a, 0x9d, 0x99,
+	0xcc, 0xcc, 0xae, 0xbd, 0x86, 0x42, 0x1b, 0x28, 0x84, 0x3e, 0x84, 0x34, 0x7d, 0x68, 0x49, 0x5f,
+	0x02, 0x7d, 0xc9, 0x43, 0x29, 0x89, 0xa1, 0x14, 0xfc, 0x50, 0x4a, 0xa1, 0x25, 0x04, 0x0a, 0x86,
+	0xbe, 0x84, 0x3c, 0x14, 0xd7, 0xe9, 0x43, 0x31, 0x14, 0x02, 0x96, 0x94, 0x42, 0x5f, 0xca, 0xfd,
+	0x98, 0x99, 0x3b, 0xbb, 0x2b, 0xd7, 0xb2, 0xfb, 0xb6, 0x73, 0xce, 0xef, 0x7c, 0xdc, 0x73, 0xcf,
+	0x3d, 0xf7, 0x9c, 0xbb, 0x20, 0xaf, 0xb9, 0xae, 0x69, 0xfb, 0xc1, 0x84, 0xeb, 0x39, 0x81, 0x03,
+	0x33, 0xd8, 0xa8, 0x60, 0xfa, 0x73, 0x64, 0xb4, 0xe2, 0x38, 0x15, 0x0b, 0x4f, 0x6a, 0xae, 0x39,
+	0xa9, 0xd9, 0xb6, 0x13, 0x68, 0x81, 0xe9, 0xd8, 0x3e, 0x03, 0x8e, 0xe4, 0x3c, 0xec, 0xd7, 0x2c,
+	0x2e, 0x36, 0x72, 0x34, 0x70, 0x1c, 0xcb, 0x9f, 0xa4, 0x1f, 0x15, 0x6c, 0x47, 0x3f, 0x38, 0x3b,
+	0xa3, 0xb9, 0x6e, 0x28, 0x57, 0xb6, 0xb4, 0xba, 0xe3, 0xf1, 0xaf, 0x3e, 0x0f, 0xfb, 0x4e, 0xcd,
+	0xd3, 0x71, 0xa4, 0x56, 0x77, 0xaa, 0x55, 0x27, 0x94, 0xeb, 0xd7, 0x2d, 0xa7, 0x66, 0x58, 0x38,
+	0xd8, 0xc6, 0x0d, 0x4e, 0x1a, 0xd2, 0x6a, 0x81, 0xe3, 0xeb, 0x9a, 0x85, 0x5d, 0xc7, 0x32, 0xf5,
+	0x90, 0x9c, 0xd7, 0xad, 0x9a, 0x1f, 0xe0, 0x50, 0x6f, 0xde, 0xa8, 0xe2, 0x49, 0xcb, 0xd1, 0xf9,
+	0xe7, 0x00, 0xf9, 0xd4, 0x5c, 0x37, 0xa1, 0x7c, 0xb0, 0xe2, 0x54, 0x1c, 0xfa, 0x73, 0x92, 0xfc,
+	0xe2, 0x54, 0x18, 0x05, 0x20, 0x72, 0xbf, 0x74, 0x57, 0x02, 0x87, 0x37, 0x4c, 0x2f, 0xa8, 0x69,
+	0xd6, 0x45, 0x66, 0x66, 0xc9, 0xf6, 0x83, 0xcb, 0xb8, 0xb1, 0x71, 0x16, 0xbe, 0x02, 0xb2, 0xdc,
+	0xb4, 0xba, 0x8d, 0x1b, 0x48, 0x2a, 0x4a, 0xe3, 0xd9, 0xa9, 0xc3, 0x13, 0x91, 0x96, 0x09, 0x2e,
+	0x41, 0xd1, 0xf3, 0xa9, 0xcf, 0xff, 0x7a, 0xec, 0x90, 0x02, 0xf4, 0x88, 0x06, 0x2f, 0x80, 0x5c,
+	0xb8, 0x48, 0xaa, 0xa0, 0x83, 0x2a, 0x18, 0x4e, 0x28, 0x60, 0xec, 0xcb, 0xb8, 0xc1, 0xe5, 0xb3,
+	0x7a, 0x4c, 0x82, 0xd3, 0x20, 0xe7, 0x78, 0x15, 0xcd, 0x36, 0x6f, 0xd2, 0xfd, 0x41, 0x9d, 0x45,
+	0x69, 0x3c, 0x33, 0x0f, 0x6f, 0xef, 0xa1, 0xd0, 0x8c, 0xe3, 0x55, 0xee, 0xec, 0x21, 0x49, 0x49,
+	0xe0, 0x66, 0x72, 0xff, 0x78, 0x80, 0xa4, 0x7f, 0x3d, 0x40, 0xd2, 0xa7, 0x1f, 0x1f, 0x93, 0x4a,
+	0xbf, 0x91, 0x40, 0x6e, 0xce, 0x75, 0xe3, 0x75, 0x9d, 0x01, 0x3d, 0x9a, 0xeb, 0x0a, 0x6b, 0xea,
+	0x17, 0x5c, 0x9a, 0x73, 0xdd, 0xd8, 0x9b, 0x6e, 0x8d, 0x7e, 0x41, 0x0c, 0x0a, 0x61, 0x24, 0x48,
+	0x42, 0x51, 0xd1, 0x14, 0x15, 0x2d, 0x09, 0xa2, 0xfb, 0xc4, 0x71, 0xfe, 0xf0, 0x27, 0x3b, 0x48,
+	0xba, 0xbf, 0x87, 0xb2, 0x02, 0x87, 0xaa, 0xef, 0xd5, 0x13, 0xd0, 0x26, 0xbf, 0xff, 0x90, 0xf4,
+	0x7b, 0x0a, 0x1e, 0x07, 0x29, 0x5b, 0xab, 0x62, 0xea, 0x74, 0x66, 0x3e, 0x7f, 0x7b, 0x0f, 0x65,
+	0x78, 0x86, 0xd7, 0xa7, 0x14, 0xca, 0x82, 0xcf, 0x37, 0x45, 0xac, 0x83, 0x42, 0x0b, 0xb7, 0xf7,
+	0x50, 0x2e, 0x82, 0x3a, 0x5e, 0x25, 0x19, 0x2f, 0x78, 0xb9, 0x69, 0xa3, 0x3a, 0x1f, 0xba, 0x51,
+	0x85, 0xfb, 0x7b, 0x28, 0x1d, 0x12, 0x5a, 0x36, 0xad, 0x69, 0x11, 0x0e, 0x00, 0xf1, 0x1a, 0xe0,
+	0xb1, 0xc4, 0x0a, 0xb2, 0xb7, 0xf7, 0x50, 0x0f, 0x77, 0x8b, 0xfb, 0x3f, 0xd5, 0xd6, 0xff, 0x5e,
+	0xb2, 0xe3, 0x1c, 0xd8, 0xe2, 0x7d, 0x93, 0xc1, 0x7f, 0x8f, 0x81, 0x1e, 0x6e, 0x11, 0x0e, 0x83,
+	0xee, 0xb2, 0x89, 0x2d, 0xc3, 0x47, 0x52, 0xb1, 0x73, 0x3c, 0xa3, 0xf0, 0x2f, 0x78, 0x1a, 0x74,
+	0xc6, 0xf9, 0x38, 0x94, 0xdc, 0x7c, 0xee, 0x2a, 0x4f, 0x00, 0x82, 0x83, 0xe7, 0xe3, 0x7c, 0x91,
+	0xf7, 0xcb, 0x97, 0xec, 0xfd, 0x3d, 0xd4, 0x39, 0xe7, 0xba, 0x89, 0xb4, 0xb9, 0x9c, 0x3c, 0x40,
+	0xa7, 0x5b, 0xec, 0xc5, 0x07, 0x68, 0x7e, 0xa0, 0x5d, 0x82, 0x88, 0xa7, 0xa9, 0x79, 0x93, 0xce,
+	0x3d, 0xc1, 0x26, 0xc1, 0x73, 0x20, 0x7d, 0xd3, 0xb1, 0x31, 0x55, 0xf4, 0x02, 0x55, 0x04, 0x05,
+	0x45, 0x6f, 0x39, 0x36, 0x8e, 0x63, 0xd0, 0x73, 0x93, 0x7d, 0xc2, 0x45, 0xc1, 0x03, 0xcb, 0xd1,
+	0x79, 0x9a, 0x1c, 0x9d, 0x30, 0x4c, 0x3f, 0xf0, 0xcc, 0xcd, 0x5a, 0x80, 0x0d, 0xb5, 0xaa, 0x05,
+	0xfa, 0x96, 0x8a, 0xed, 0x8a, 0x69, 0xe3, 0x89, 0x2b, 0x8e, 0xde, 0x7c, 0xac, 0xaf, 0x38, 0x3a,
+	0x1c, 0x06, 0x9d, 0x35, 0xcf, 0xa4, 0x07, 0x28, 0x33, 0x9f, 0x22, 0x87, 0x43, 0x21, 0x04, 0x78,
+	0x02, 0x00, 0x9f, 0x54, 0x62, 0x5d, 0x25, 0xec, 0x29, 0x81, 0x9d, 0x61, 0xf4, 0x75, 0xcf, 0x84,
+	0x2f, 0x80, 0xb4, 0x65, 0xd6, 0xb1, 0x8d, 0x7d, 0x1f, 0x75, 0x17, 0xa5, 0xf1, 0xde, 0xa9, 0x01,
+	0xc1, 0xf3, 0x2b, 0x9c, 0xc5, 0xe5, 0x22, 0x28, 0xfc, 0x0e, 0xc8, 0x55, 0x35, 0xd7, 0xc5, 0x86,
+	0xea, 0x3a, 0x5e, 0xe0, 0xa3, 0x4c, 0xb1, 0x73, 0x3c, 0x9b, 0x10, 0x25, 0x41, 0xbf, 0xe6, 0x78,
+	0xc1, 0x7c, 0x9a, 0x88, 0x32, 0xaf, 0x99, 0x08, 0xa1, 0x12, 0x0d, 0xdd, 0xac, 0xbe, 0xa3, 0x1c,
+	0x5d, 0xf7, 0xa0, 0x20, 0xbb, 0x48, 0x19, 0x24, 0x64, 0x90, 0x9f, 0xf5, 0x6e, 0x46, 0x62, 0xe9,
+	0xc0, 0xe4, 0xe0, 0xb3, 0xa0, 0x2f, 0x8a, 0x1f, 0x57, 0x75, 0x8a, 0x2c, 0x92, 0xd4, 0x01, 0x46,
+	0x66, 0x42, 0xf0, 0x1c, 0xe8, 0x22, 0x0b, 0xc6, 0xa8, 0x97, 0x2e, 0x50, 0x2c, 0xb9, 0x6b, 0x9e,
+	0xa6, 0x6f, 0x63, 0x63, 0x95, 0xb0, 0xf9, 0x22, 0x19, 0x16, 0x8e, 0x82, 0x6e, 0xec, 0x79, 0x8e,
+	0xe7, 0xa3, 0x3e, 0x92, 0xec, 0x9c, 0xc9, 0x69, 0xf0, 0x25, 0x90, 0xd3, 0xbd, 0xaa, 0xea, 0xd4,
+	0xb1, 0xe7, 0x99, 0x06, 0x46, 0x05, 0xaa, 0x39, 0x91, 0x3d, 0xca, 0xd5, 0x15, 0xce, 0x55, 0xb2,
+	0xba, 0x57, 0x0d, 0x3f, 0xe0, 0x3c, 0xc8, 0x79, 0x35, 0x3b, 0x30, 0xab, 0x58, 0x35, 0xed, 0xb2,
+	0x83, 0xfa, 0xe9, 0xf2, 0x8f, 0xb4, 0x1e, 0x1b, 0x85, 0xa1, 0xc2, 0x2d, 0xe7, 0x42, 0x4b, 0x76,
+	0xd9, 0x81, 0xdf, 0x03, 0x40, 0xf7, 0xb0, 0x46, 0x32, 0x44, 0x0b, 0xd0, 0x10, 0xd5, 0x70, 0x62,
+	0xff, 0xc4, 0x59, 0x33, 0xab, 0xd8, 0x0f, 0xb4, 0xaa, 0x3b, 0x3f, 0x44, 0x56, 0xf1, 0xe1, 0xad,
+	0x23, 0x99, 0x20, 0x24, 0x51, 0xe5, 0x19, 0xae, 0x6d, 0x2e, 0x80, 0xcb, 0x60, 0x98, 0xdc, 0x9b,
+	0x6a, 0x54, 0xa0, 0x5d, 0x55, 0xd3, 0x75, 0x92, 0x1e, 0xc3, 0x2d, 0xe9, 0xb1, 0xe4, 0xce, 0x51,
+	0x16, 0x0f, 0xce, 0x00, 0x11, 0x0c, 0xcf, 0x1c, 0x67, 0xc1, 0x93, 0x20, 0xed, 0xe1, 0xba, 0xe9,
+	0x93, 0xf2, 0x83, 0x68, 0x0e, 0x66, 0x3e, 0xbc, 0x75, 0xa4, 0xcb, 0x76, 0xf4, 0xaa, 0xab, 0x44,
+	0x2c, 0x28, 0x83, 0x5c, 0xd9, 0xf1, 0x74, 0xac, 0xd6, 0x5c, 0x83, 0x6c, 0xd5, 0x91, 0xa2, 0x34,
+	0x9e, 0x16, 0xa1, 0x59, 0xca, 0x5e, 0xa7, 0x5c, 0x38, 0x05, 0xfa, 0x18, 0x4e, 0xad, 0xd6, 0xac,
+	0xc0, 0x74, 0x2d, 0x8c, 0x46, 0x9a, 0x05, 0x7a, 0x19, 0xe2, 0x2a, 0x07, 0xc0, 0x49, 0xd0, 0xa3,
+	0x3b, 0x76, 0xd9, 0xac, 0xf8, 0xe8, 0x29, 0x9a, 0xad, 0x89, 0xca, 0x41, 0x39, 0x8b, 0xa6, 0x85,
+	0x95, 0x10, 0x05, 0x97, 0x41, 0x6e, 0x0b, 0x6b, 0x56, 0xb0, 0xa5, 0xea, 0x5b, 0x58, 0xdf, 0x46,
+	0x47, 0xe9, 0xfa, 0x4f, 0xee, 0x1f, 0xe6, 0xd7, 0x28, 0xfa, 0x22, 0x01, 0xf3, 0x88, 0x64, 0xb7,
+	0x62, 0x12, 0x9c, 0x06, 0x59, 0xd7, 0xb9, 0x8e, 0x3d, 0x95, 0x25, 0xe3, 0x31, 0xaa, 0x4e, 0x74,
+	0xe2, 0x1a, 0xe1, 0xd2, 0x54, 0x54, 0x80, 0x1b, 0xfd, 0x86, 0xd3, 0x60, 0x10, 0xdf, 0x08, 0xb0,
+	0x67, 0x6b, 0x96, 0x5a, 0x77, 0xac, 0x5a, 0x15, 0xab, 0xbe, 0x79, 0x13, 0xa3, 0x62, 0x51, 0x1a,
+	0x4f, 0x71, 0x43, 0x30, 0x44, 0x6c, 0x50, 0xc0, 0xaa, 0x79, 0x13, 0xc3, 0xb3, 0xa0, 0x5f, 0xab,
+	0x6b, 0xa6, 0xa5, 0x6d, 0x9a, 0x96, 0x19, 0x34, 0x54, 0x52, 0x77, 0xd0, 0x71, 0xa1, 0x0c, 0x14,
+	0x44, 0x36, 0x29, 0x52, 0xf0, 0x38, 0xc8, 0xd4, 0xab, 0xe1, 0x61, 0x2a, 0x09, 0xd0, 0x74, 0xbd,
+	0xca, 0x0f, 0xd3, 0x51, 0xd0, 0xe3, 0xb8, 0x81, 0xea, 0x61, 0x1f, 0x9d, 0x10, 0x00, 0xdd, 0x8e,
+	0x1b, 0x28, 0xd8, 0x27, 0x99, 0xc9, 0xe2, 0x4e, 0x33, 0xf3, 0xe9, 0x27, 0xcf, 0x4c, 0xae, 0x6d,
+	0x2e, 0x80, 0x67, 0x40, 0xbf, 0x87, 0x35, 0x2b, 0xca, 0x4c, 0x7a, 0xf5, 0x9d, 0x14, 0x7c, 0xe8,
+	0x23, 0x6c, 0x9e, 0x7f, 0xcb, 0xe4, 0xfa, 0x33, 0xc0, 0xb0, 0x69, 0xf3, 0xc8, 0x91, 0x3a, 0xa5,
+	0x06, 0x8e, 0x6a, 0x6d, 0xaa, 0xa6, 0x8b, 0x9e, 0xa1, 0x19, 0x70, 0xaa, 0xf5, 0xd0, 0x4d, 0x2c,
+	0x71, 0x01, 0x52, 0xa5, 0xd6, 0x9c, 0x2b, 0x9b, 0x4b, 0xee, 0x82, 0x1d, 0x78, 0x8d, 0x30, 0xce,
+	0x66, 0x0b, 0x1b, 0x1e, 0x07, 0x39, 0x03, 0x1b, 0xa6, 0x4e, 0x17, 0x6d, 0xba, 0xe8, 0x59, 0x92,
+	0x89, 0x4a, 0x36, 0xa2, 0x51, 0x48, 0xa6, 0x66, 0x9b, 0xef, 0xd4, 0xb0, 0x6a, 0x1a, 0x68, 0x5c,
+	0x8c, 0x2b, 0x23, 0x2f, 0x19, 0x04, 0x62, 0xd8, 0xbe, 0x6a, 0x69, 0x9b, 0xd8, 0x42, 0xcf, 0x89,
+	0x10, 0xc3, 0xf6, 0xaf, 0x10, 0x2a, 0x7c, 0x19, 0xf4, 0x94, 0xb1, 0x41, 0x2f, 0x99, 0xff, 0xa3,
+	0x81, 0x45, 0x62, 0xcd, 0xc4, 0x86, 0x70, 0xdd, 0xc6, 0x45, 0xb7, 0xbb, 0x8c, 0x0d, 0x72, 0xdb,
+	0xcc, 0x83, 0x21, 0xdd, 0xa9, 0xba, 0x5a, 0x60, 0xf2, 0x74, 0xa8, 0x63, 0x8f, 0x1e, 0xca, 0x89,
+	0xa2, 0x34, 0x9e, 0x9f, 0xcf, 0xf3, 0xf0, 0xf3, 0xc3, 0x33, 0x98, 0xc0, 0x6e, 0x30, 0x28, 0xfc,
+	0x2e, 0x18, 0xa8, 0xb3, 0xa6, 0x4c, 0x15, 0x2f, 0xe2, 0xc9, 0x87, 0x5d, 0xc4, 0xfd, 0x09, 0xc5,
+	0xd4, 0xa5, 0xfe, 0x7a, 0xa2, 0xb3, 0x63, 0x9d, 0x4c, 0x16, 0xdb, 0xda, 0xa6, 0x85, 0x55, 0xd3,
+	0xad, 0x4f, 0xa3, 0x33, 0x34, 0x84, 0x80, 0x91, 0x96, 0xdc, 0xfa, 0x34, 0x7c, 0x1a, 0x74, 0x3b,
+	0x9b, 0x6f, 0x93, 0xf0, 0x9d, 0x65, 0xed, 0x5a, 0xd2, 0xdf, 0x2e, 0x67, 0xf3, 0xed, 0x25, 0x03,
+	0x2e, 0x80, 0xac, 0x30, 0x7f, 0xa0, 0xe7, 0xe9, 0x2e, 0x9f, 0x68, 0xb3, 0xcb, 0x73, 0x31, 0x8a,
+	0x6e, 0xaf, 0x22, 0xca, 0xc1, 0xd3, 0x20, 0x6b, 0x6c, 0xaa, 0x55, 0xc7, 0xc0, 0x16, 0xb1, 0x38,
+	0x5d, 0x94, 0xc6, 0xbb, 0x9a, 0x2d, 0x66, 0x8c, 0xcd, 0xab, 0x04, 0xb0, 0x64, 0xc0, 0x37, 0xc0,
+	0xe0, 0x76, 0x6d, 0x13, 0x7b, 0x36, 0x0e, 0xb0, 0xaf, 0x46, 0x73, 0x0a, 0x3a, 0x4f, 0xe3, 0x32,
+	0x26, 0x98, 0xbf, 0x1c, 0xc1, 0x94, 0x10, 0xa5, 0x0c, 0x6c, 0xb7, 0x12, 0xe1, 0x05, 0xd0, 0x6b,
+	0x3b, 0x06, 0x16, 0x94, 0xbd, 0xd8, 0xb2, 0xe3, 0xcb, 0x8e, 0x81, 0x63, 0x35, 0x79, 0x5b, 0xfc,
+	0x84, 0x27, 0x40, 0xde, 0xf4, 0x49, 0xa5, 0xb1, 0x0d, 0xcd, 0x22, 0x07, 0xff, 0x25, 0x1a, 0xd2,
+	0x9c, 0xe9, 0xaf, 0x46, 0x34, 0xb8, 0x0c, 0x7a, 0x3d, 0xc7, 0xb2, 0x9c, 0x5a, 0x40, 0x6b, 0x52,
+	0xcd, 0x47, 0x33, 0x2d, 0x56, 0x14, 0x06, 0x58, 0xa5, 0xfc, 0xe6, 0x20, 0xe4, 0x3d, 0x91, 0x0b,
+	0x4b, 0xa0, 0xc7, 0xf0, 0x1a, 0xaa, 0x57, 0xb3, 0xd1, 0xcb, 0xcd, 0xe5, 0xb8, 0xdb, 0xf0, 0x1a,
+	0x4a, 0x8d, 0xe4, 0xd0, 0xe1, 0x2d, 0xc7, 0x33, 0x6f, 0x3a, 0x76, 0xa0, 0x59, 0x2a, 0x1d, 0xcf,
+	0x54, 0x36, 0x9f, 0xa1, 0xff, 0xa7, 0xc6, 0x8b, 0x82, 0xf1, 0xd7, 0x22, 0xe4, 0x2a, 0x01, 0x5e,
+	0xa3, 0x38, 0x65, 0x68, 0xab, 0x1d, 0x19, 0x9e, 0x01, 0xa9, 0x40, 0xab, 0xf8, 0xc8, 0xa0, 0xbb,
+	0x3e, 0xda, 0x66, 0xd7, 0xd7, 0xb4, 0x0a, 0xdf, 0x6e, 0x8a, 0x1c, 0x59, 0x00, 0x87, 0xf7, 0x39,
+	0xee, 0xb0, 0xc0, 0x7a, 0x5a, 0xda, 0x59, 0xb3, 0xb6, 0x75, 0x10, 0x74, 0xd5, 0x35, 0xab, 0x86,
+	0x59, 0x13, 0xad, 0xb0, 0x8f, 0x99, 0x8e, 0x17, 0xa5, 0x91, 0x57, 0x40, 0xa1, 0x39, 0x9f, 0x0e,
+	0x24, 0x7f, 0x1e, 0x64, 0x22, 0xcf, 0x0e, 0x22, 0x38, 0xf3, 0xeb, 0x1e, 0xd2, 0xab, 0x7f, 0xf3,
+	0x00, 0x49, 0x3f, 0xd8, 0x41, 0xd2, 0x07, 0x3b, 0x48, 0xfa, 0xf9, 0x0e, 0x92, 0x3e, 0x25, 0x47,
+	0x7f, 0x07, 0x49, 0x5f, 0x92, 0xad, 0xda, 0x45, 0xbf, 0xeb, 0xb8, 0x18, 0x37, 0x8b, 0xf2, 0xba,
+	0x67, 0xca, 0xab, 0x61, 0xf7, 0x27, 0x5f, 0x8d, 0x1b, 0x32, 0x39, 0xec, 0xf5, 0xe4, 0x8b, 0x61,
+	0x2f, 0x20, 0x2b, 0xfc, 0x76, 0x96, 0x17, 0x68, 0xd7, 0x23, 0x2b, 0x71, 0x0b, 0x22, 0x6f, 0xf0,
+	0x0b, 0x41, 0x5e, 0x68, 0xb9, 0x79, 0xe4, 0xb9, 0xa6, 0x7b, 0x85, 0x5a, 0xc4, 0xf2, 0x7a, 0x58,
+	0xca, 0xe5, 0x15, 0x7a, 0x59, 0xc8, 0xab, 0x5b, 0x9a, 0x87, 0x0d, 0x51, 0xb0, 0xb5, 0x81, 0x90,
+	0x5b, 0x77, 0x48, 0x5e, 0xe7, 0x45, 0x53, 0xbe, 0xc4, 0x4b, 0xa3, 0xbc, 0x48, 0x8b, 0x9c, 0xcc,
+	0xa6, 0x87, 0x89, 0x15, 0x61, 0x9e, 0x91, 0x2f, 0xb6, 0xa9, 0x64, 0xf2, 0x46, 0x73, 0x05, 0x92,
+	0x85, 0x6e, 0x5f, 0x4e, 0xe4, 0xfc, 0x47, 0xbb, 0xe8, 0xc7, 0x9d, 0x7c, 0x5a, 0x22, 0x57, 0xce,
+	0x2c, 0xb1, 0x40, 0xae, 0x17, 0x39, 0x1e, 0xa1, 0x66, 0x5b, 0xac, 0x6a, 0xae, 0x4b, 0xc1, 0xdc,
+	0xa3, 0x10, 0x4f, 0x8a, 0x6e, 0x48, 0x0b, 0x7d, 0xd1, 0x5c, 0x97, 0xa8, 0x68, 0xe7, 0x3b, 0xb9,
+	0xb2, 0x67, 0xf9, 0xf8, 0xc0, 0x74, 0x10, 0x0a, 0x41, 0x87, 0xc4, 0x16, 0x78, 0x19, 0x1b, 0x22,
+	0x7f, 0x11, 0x1b, 0xd8, 0x23, 0x51, 0x4f, 0x00, 0xc3, 0x06, 0x79, 0x56, 0x58, 0x35, 0xd3, 0x1f,
+	0x72, 0x88, 0x0e, 0x91, 0x99, 0x10, 0x2f, 0x87, 0x4a, 0x9b, 0x51, 0xfb, 0x59, 0xa3, 0x51, 0x9e,
+	0x8d, 0xa3, 0x1d, 0xda, 0x0a, 0x1f, 0x1d, 0x44, 0x56, 0xd2, 0x12, 0xcd, 0xb1, 0x59, 0x96, 0x6a,
+	0x54, 0xea, 0xab, 0x5d, 0xd4, 0xc3, 0x17, 0x77, 0x6b, 0x0f, 0x9d, 0xde, 0xc6, 0x8d, 0xd9, 0x84,
+	0x44, 0x5d, 0xb3, 0xf6, 0x75, 0xfc, 0xe3, 0x6f, 0x91, 0x74, 0xef, 0x5b, 0x74, 0x63, 0x1b, 0x37,
+	0x26, 0xc4, 0x99, 0x57, 0x26, 0x04, 0x9b, 0x6f, 0x91, 0xda, 0xf2, 0xc1, 0x6f, 0x4a, 0x59, 0xb8,
+	0xf3, 0x18, 0x40, 0x1c, 0x20, 0x19, 0x85, 0xb6, 0x76, 0xb2, 0xd8, 0x36, 0xca, 0x42, 0xcf, 0xf7,
+	0x7a, 0x2a, 0x3d, 0x5a, 0x38, 0xfa, 0x7a, 0x2a, 0x3d, 0x56, 0x38, 0xa6, 0x40, 0x9f, 0x66, 0xbf,
+	0xd8, 0xd2, 0x29, 0xbd, 0xae, 0x67, 0xd6, 0x35, 0xbd, 0xc1, 0x2b, 0x62, 0xe9, 0x7d, 0x09, 0xf4,
+	0x26, 0xa7, 0x01, 0xf8, 0x1c, 0xc8, 0xeb, 0xa4, 0xf4, 0x99, 0x36, 0x69, 0xce, 0xc3, 0x59, 0x9c,
+	0xf7, 0x0a, 0xb9, 0x88, 0xb5, 0x64, 0xf8, 0xb0, 0x48, 0x5a, 0x6f, 0xd7, 0x32, 0x75, 0xcd, 0xa7,
+	0xb5, 0x23, 0x1f, 0x76, 0x14, 0x21, 0x15, 0x4e, 0x82, 0x82, 0x81, 0x7d, 0x93, 0xb8, 0x11, 0x21,
+	0x3b, 0x05, 0x64, 0x1f, 0xe7, 0x2a, 0x9c, 0x59, 0x7a, 0xbf, 0x13, 0xa4, 0xc3, 0xc9, 0x0e, 0x4e,
+	0x83, 0x2e, 0x5a, 0x5f, 0x69, 0xb1, 0xea, 0x9d, 0x2a, 0x3e, 0x64, 0x72, 0xbd, 0x46, 0x70, 0x0a,
+	0x83, 0xd3, 0xbb, 0x49, 0x6c, 0xcb, 0xa8, 0x73, 0x5d, 0x4a, 0x4e, 0xec, 0xad, 0x48, 0x47, 0xe0,
+	0xd6, 0x36, 0x2d, 0x53, 0x67, 0x90, 0x4e, 0x0a, 0x01, 0x8c, 0x14, 0x01, 0xb4, 0x60, 0x4b, 0x75,
+	0x3d, 0x5c, 0x36, 0x6f, 0xb0, 0xf1, 0x57, 0x01, 0x84, 0x74, 0x8d, 0x52, 0x08, 0xa0, 0xfc, 0x8e,
+	0x61, 0x87, 0x80, 0x2e, 0x06, 0x20, 0x24, 0x0e, 0x38, 0x02, 0xd2, 0xd8, 0x66, 0x13, 0x2c, 0x9d,
+	0x7d, 0xbb, 0x94, 0x1e, 0x6c, 0xd3, 0x6a, 0x48, 0xaa, 0x70, 0x60, 0xf9, 0xa8, 0x87, 0x5e, 0x9a,
+	0xe4, 0x27, 0xa9, 0xc2, 0x64, 0x2d, 0x37, 0x50, 0x9a, 0xd2, 0xd8, 0x07, 0x2c, 0x92, 0x39, 0xf8,
+	0x86, 0xea, 0x6e, 0x07, 0xac, 0x27, 0xcf, 0x14, 0xa5, 0xf1, 0x4e, 0x05, 0x54, 0xb5, 0x1b, 0xd7,
+	0xb6, 0x03, 0xda, 0x85, 0x9f, 0x02, 0xfd, 0xd1, 0x62, 0xeb, 0xa6, 0xaf, 0x3a, 0xb6, 0xd5, 0x40,
+	0x80, 0xea, 0xe8, 0x0b, 0x19, 0x1b, 0xa6, 0xbf, 0x62, 0x5b, 0x0d, 0xd8, 0x0b, 0x3a, 0x4c, 0x03,
+	0x65, 0xa9, 0xa3, 0x1d, 0x26, 0xe9, 0x09, 0x73, 0x3e, 0xf6, 0xea, 0xa6, 0x8e, 0x59, 0xb3, 0x9b,
+	0xa3, 0x9c, 0x2c, 0xa7, 0x91, 0xd3, 0x50, 0xfa, 0x53, 0x0a, 0x64, 0x79, 0x86, 0xd0, 0xc9, 0xf0,
+	0x7f, 0xf4, 0x46, 0xf3, 0x0c, 0xc8, 0xd8, 0x4e, 0x60, 0x96, 0x1b, 0xa4, 0xff, 0x21, 0xb1, 0xef,
+	0x4c, 0x8c, 0x6d, 0x8c, 0xb7, 0x64, 0xc0, 0xd3, 0xe1, 0x68, 0x9d, 0x7a, 0xe8, 0x68, 0x1d, 0x0e,
+	0xd5, 0xc3, 0xd1, 0x50, 0xdd, 0xc5, 0xbc, 0xe3, 0xe3, 0x74, 0xf3, 0x4c, 0xdc, 0xfd, 0x18, 0x33,
+	0xf1, 0x79, 0xd0, 0xcd, 0x9b, 0x98, 0x9e, 0x96, 0x45, 0xb2, 0x4a, 0x4e, 0x60, 0x62, 0x67, 0xcc,
+	0xe0, 0xcd, 0x73, 0x59, 0xfa, 0x51, 0xe7, 0x32, 0xfe, 0xee, 0x92, 0x69, 0x7e, 0x77, 0x11, 0xda,
+	0x74, 0x70, 0xe0, 0x36, 0x7d, 0x1a, 0x64, 0xca, 0xd1, 0xab, 0x4a, 0x76, 0xff, 0x57, 0x15, 0x16,
+	0x80, 0x74, 0x99, 0xdf, 0xde, 0x33, 0x17, 0x9a, 0x3b, 0x81, 0x8f, 0x77, 0x90, 0x74, 0x7b, 0x07,
+	0xe5, 0xc4, 0x6d, 0xb8, 0xbb, 0x83, 0xa4, 0x5b, 0x7b, 0x28, 0x65, 0x3b, 0x36, 0xfe, 0x66, 0x0f,
+	0x49, 0xb7, 0xbe, 0x45, 0xe1, 0xe3, 0x5e, 0x69, 0x22, 0xaa, 0x34, 0x57, 0x71, 0xe0, 0x99, 0xba,
+	0x0f, 0x47, 0x41, 0xc6, 0x77, 0xaa, 0x38, 0xd8, 0x32, 0xed, 0x0a, 0x3d, 0x3d, 0x29, 0x25, 0x26,
+	0x94, 0xde, 0x93, 0x40, 0x9e, 0x0b, 0x5c, 0x71, 0x9c, 0xed, 0x9a, 0x1b, 0xa6, 0x98, 0xf4, 0x88,
+	0x29, 0xf6, 0x12, 0x00, 0xac, 0xca, 0x09, 0x8f, 0xd9, 0x83, 0x89, 0xa8, 0x13, 0x66, 0x2c, 0x94,
+	0x71, 0x43, 0xc2, 0x4c, 0xfe, 0x8b, 0x3d, 0x94, 0x89, 0xf8, 0xa5, 0x9f, 0xc4, 0x55, 0x92, 0xb9,
+	0x32, 0x75, 0x50, 0x5f, 0x9e, 0xf4, 0x69, 0x7d, 0xa6, 0xef, 0x0b, 0xfa, 0xdc, 0x18, 0x11, 0x4a,
+	0x0f, 0x04, 0x9f, 0xb4, 0x00, 0xdb, 0x7a, 0xe3, 0x80, 0x3e, 0xcd, 0x7c, 0x26, 0x7d, 0xb4, 0x8b,
+	0x7e, 0x25, 0x1d, 0xb8, 0xf3, 0x88, 0xee, 0x76, 0xc2, 0x79, 0xe8, 0xfd, 0xde, 0x0c, 0x68, 0xab,
+	0x86, 0xf7, 0x13, 0xcd, 0xd8, 0xb6, 0x37, 0x3d, 0xd9, 0x89, 0x7c, 0x22, 0xc3, 0xe1, 0x05, 0xd0,
+	0xc7, 0xbb, 0x05, 0xd3, 0xb1, 0x55, 0xe1, 0xb5, 0x7a, 0xf8, 0xf6, 0x1e, 0xea, 0x8d, 0x59, 0x84,
+	0x43, 0xff, 0x7a, 0x10, 0x68, 0x74, 0x86, 0x3f, 0x0b, 0xb2, 0xe4, 0x1e, 0xa6, 0xff, 0x13, 0x98,
+	0x06, 0x7f, 0xc1, 0xee, 0x17, 0x1e, 0xeb, 0x4d, 0x83, 0xca, 0x91, 0x4f, 0x5a, 0x05, 0x8d, 0xa6,
+	0x17, 0xec, 0x9f, 0x49, 0x00, 0xc4, 0x3e, 0xc1, 0x33, 0xe2, 0x2e, 0xec, 0x7f, 0x32, 0x85, 0xe4,
+	0x98, 0x05, 0xb9, 0xc8, 0x83, 0x47, 0xac, 0xa1, 0x40, 0x8b, 0x28, 0x33, 0x48, 0x3c, 0x99, 0xe2,
+	0xe9, 0x2b, 0xdd, 0x95, 0x40, 0x5f, 0x6c, 0x75, 0xa1, 0x8e, 0xed, 0xc7, 0x71, 0x2f, 0x2a, 0xc1,
+	0x1d, 0x8f, 0x54, 0x82, 0x11, 0xe8, 0xa9, 0x62, 0xdf, 0xd7, 0x2a, 0x98, 0xfd, 0xff, 0xa3, 0x84,
+	0x9f, 0x70, 0x12, 0x74, 0xb1, 0xb2, 0x93, 0xfa, 0x6f, 0x65, 0x87, 0xe1, 0xe0, 0x53, 0xe2, 0xab,
+	0x06, 0xbb, 0x5e, 0xa3, 0xf7, 0x8c, 0x99, 0xd4, 0xef, 0x77, 0x90, 0x74, 0xea, 0xfd, 0x0e, 0x00,
+	0xe2, 0xf2, 0x09, 0x8f, 0x82, 0x81, 0x6b, 0x2b, 0x6f, 0x2e, 0x28, 0xea, 0xea, 0xda, 0xdc, 0xda,
+	0x82, 0xba, 0xbe, 0x7c, 0x79, 0x79, 0xe5, 0xcd, 0xe5, 0xc2, 0xa1, 0x91, 0xd4, 0x07, 0x7b, 0x48,
+	0x82, 0xa3, 0x00, 0x32, 0xf6, 0xca, 0xb2, 0xaa, 0x2c, 0xbc, 0xb1, 0xbe, 0xb0, 0xba, 0xb6, 0x70,
+	0xa9, 0x20, 0x71, 0xee, 0x10, 0xc8, 0x52, 0xee, 0xd2, 0xf2, 0xab, 0xea, 0xca, 0x72, 0xa1, 0x83,
+	0x93, 0x73, 0x20, 0x1d, 0x0a, 0x15, 0x3a, 0x63, 0x0b, 0x2b, 0x8b, 0x8b, 0x82, 0x8e, 0x14, 0x07,
+	0x0f, 0x83, 0x5c, 0xac, 0x63, 0x71, 0xb1, 0xd0, 0xc5, 0xe9, 0x79, 0x90, 0x89, 0xc4, 0x0a, 0xdd,
+	0x70, 0x04, 0x14, 0x94, 0x85, 0xf9, 0x95, 0x95, 0x35, 0x41, 0x45, 0x0f, 0x87, 0x0e, 0x80, 0x0c,
+	0xe3, 0x2d, 0x2d, 0xbf, 0x5a, 0x48, 0x73, 0x22, 0x00, 0xdd, 0x8c, 0x58, 0xc8, 0xc0, 0xa7, 0x40,
+	0xbf, 0xb8, 0xc8, 0x05, 0x45, 0x59, 0x51, 0x0a, 0x80, 0x01, 0xa7, 0xfe, 0x99, 0x8e, 0xfe, 0xc1,
+	0x99, 0x73, 0x4d, 0xf8, 0x5b, 0x09, 0xe4, 0xd9, 0xbc, 0x15, 0xe6, 0x27, 0x6c, 0xcd, 0xab, 0x11,
+	0xf1, 0x0f, 0x12, 0x85, 0xfe, 0x99, 0x5a, 0xfa, 0xfe, 0xfd, 0x1d, 0x34, 0x11, 0x8e, 0xfb, 0x1c,
+	0xe7, 0xcb, 0x73, 0x3a, 0x39, 0x37, 0x57, 0x35, 0x5b, 0xab, 0x60, 0xb9, 0xf9, 0x48, 0x7f, 0xb2,
+	0x8b, 0xa4, 0x3b, 0xbb, 0x48, 0xfa, 0x6a, 0x17, 0x9d, 0x5c, 0x4f, 0xbc, 0x8d, 0xca, 0x8b, 0xf1,
+	0xdb, 0xaa, 0x1c, 0x6f, 0xd7, 0xbb, 0x7f, 0xf9, 0xfb, 0x4f, 0x3b, 0x06, 0x4b, 0x7d, 0x93, 0xec,
+	0x75, 0x78, 0x92, 0x1f, 0xb8, 0x19, 0xe9, 0xd4, 0x19, 0x09, 0xfe, 0x52, 0x02, 0xf9, 0x4b, 0xd8,
+	0xc2, 0x07, 0xf6, 0xdc, 0x7c, 0x22, 0xcf, 0xfb, 0x63, 0xf7, 0xe4, 0x4b, 0xf4, 0x45, 0x21, 0xf2,
+	0xd2, 0xa0, 0xde, 0x24, 0xbd, 0xfc, 0x61, 0x07, 0xe8, 0x55, 0x70, 0xd9, 0xc3, 0xfe, 0xd6, 0x01,
+	0xdd, 0xfc, 0xa3, 0xf4, 0x78, 0x7e, 0x7e, 0xb5, 0x8b, 0xde, 0xe2, 0x93, 0x71, 0xbb, 0x69, 0x96,
+	0x3d, 0x34, 0xfb, 0x42, 0x94, 0x65, 0xe1, 0xd9, 0xb8, 0x75, 0x22, 0x8e, 0xc6, 0x6c, 0xb6, 0xd8,
+	0xfb, 0xbb, 0x08, 0xb2, 0x52, 0x2c, 0xfe, 0xd3, 0x49, 0x43, 0x30, 0x54, 0x2a, 0x4c, 0x7a, 0x6c,
+	0xa9, 0xc9, 0x18, 0xbc, 0xd7, 0x01, 0xf2, 0x6c, 0x6f, 0x0f, 0x18, 0x82, 0x3f, 0x3f, 0x7e, 0x08,
+	0x1a, 0xed, 0xd6, 0xfe, 0x90, 0xa4, 0x7b, 0xb4, 0x18, 0xb0, 0x11, 0x59, 0xde, 0x67, 0x6a, 0x6f,
+	0x4a, 0x07, 0xf6, 0x70, 0x9c, 0x0c, 0xc5, 0x8f, 0x24, 0x90, 0x5d, 0xdd, 0x72, 0xae, 0x3f, 0x2c,
+	0x10, 0x6d, 0x68, 0xa5, 0x2b, 0xf7, 0x77, 0x90, 0xbc, 0x4f, 0x20, 0x36, 0x4c, 0x7c, 0xbd, 0x25,
+	0x0c, 0x24, 0x5b, 0xa9, 0x27, 0xb0, 0x94, 0x9f, 0xf4, 0xb7, 0x9c, 0xeb, 0x49, 0x3f, 0xb6, 0xc0,
+	0xd0, 0x6b, 0x9a, 0x6d, 0x58, 0xb8, 0xb9, 0xfc, 0x8f, 0xb4, 0xad, 0xf8, 0x94, 0xd7, 0x6e, 0x87,
+	0x8a, 0xd4, 0xc6, 0x48, 0x69, 0x68, 0x92, 0xdc, 0x9a, 0x04, 0x15, 0xda, 0x21, 0x6d, 0xf4, 0x8c,
+	0x74, 0x6a, 0xca, 0x8f, 0xda, 0x10, 0xd2, 0xfd, 0x92, 0x92, 0xa3, 0x81, 0x3e, 0x21, 0x04, 0x6c,
+	0x68, 0x68, 0x5d, 0x32, 0xa1, 0x8f, 0xec, 0x43, 0x2f, 0x8d, 0x52, 0xb3, 0xc3, 0xa5, 0xfe, 0xc4,
+	0xd2, 0xb8, 0xc9, 0x33, 0xd2, 0xd4, 0xbb, 0x12, 0xe8, 0x4f, 0x36, 0x93, 0xc4, 0x70, 0x15, 0x40,
+	0xc1, 0x70, 0xd8, 0x65, 0xb6, 0x69, 0xf2, 0x39, 0x6b, 0x64, 0x7f, 0x56, 0xe9, 0x18, 0xf5, 0xe0,
+	0x48, 0x69, 0x30, 0xe1, 0x41, 0x95, 0x71, 0x99, 0x13, 0x9f, 0xc5, 0x4e, 0xf0, 0x0e, 0x8c, 0x38,
+	0xf1, 0x0b, 0x09, 0x0c, 0x29, 0xf8, 0x9d, 0x1a, 0x26, 0xf5, 0x37, 0xd1, 0x9e, 0xb5, 0xb1, 0xc6,
+	0x59, 0xed, 0x22, 0xbf, 0x76, 0xf0, 0xa3, 0x41, 0x5d, 0x1e, 0x2d, 0x1d, 0x9e, 0xf4, 0x98, 0xfd,
+	0xd0, 0x6b, 0x8b, 0x59, 0x99, 0x91, 0x4e, 0xcd, 0x8f, 0x7e, 0xfe, 0xb7, 0xb1, 0x43, 0x9f, 0xdf,
+	0x1b, 0x93, 0xee, 0xdc, 0x1b, 0x93, 0xee, 0xde, 0x1b, 0x93, 0x3e, 0xf8, 0x7a, 0xec, 0xd0, 0x9d,
+	0xaf, 0xc7, 0x0e, 0x7d, 0xf9, 0xf5, 0xd8, 0xa1, 0xcd, 0x6e, 0xea, 0xc1, 0xb9, 0xff, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0x69, 0xcb, 0xb9, 0x94, 0x89, 0x23, 0x00, 0x00,
 }
 
 func (this *VirtualClusterInstKeyV1) GoString() string {
@@ -2084,6 +2104,46 @@ func (m *AppInst) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa2
 		}
 	}
+	if m.HorizontalScalePolicy != nil {
+		{
+			size, err := m.HorizontalScalePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAppinst(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.RolloutStatus != nil {
+		{
+			size, err := m.RolloutStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAppinst(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
 	if m.IsStandalone {
 		i--
 		if m.IsStandalone {
@@ -2579,6 +2639,16 @@ func (m *AppInstRuntime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DesiredReplicas != 0 {
+		i = encodeVarintAppinst(dAtA, i, uint64(m.DesiredReplicas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Replicas != 0 {
+		i = encodeVarintAppinst(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ContainerIds) > 0 {
 		for iNdEx := len(m.ContainerIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ContainerIds[iNdEx])
@@ -3891,6 +3961,25 @@ func (m *AppInst) Matches(o *AppInst, fopts ...MatchOpt) bool {
 			return false
 		}
 	}
+	if !opts.IgnoreBackend {
+		if !opts.Filter || o.RolloutStatus != nil {
+			if m.RolloutStatus == nil && o.RolloutStatus != nil || m.RolloutStatus != nil && o.RolloutStatus == nil {
+				return false
+			} else if m.RolloutStatus != nil && o.RolloutStatus != nil {
+			}
+		}
+	}
+	if !opts.Filter || o.DryRun != false {
+		if o.DryRun != m.DryRun {
+			return false
+		}
+	}
+	if !opts.Filter || o.HorizontalScalePolicy != nil {
+		if m.HorizontalScalePolicy == nil && o.HorizontalScalePolicy != nil || m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy == nil {
+			return false
+		} else if m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy != nil {
+		}
+	}
 	if !opts.Filter || o.Tags != nil {
 		if len(m.Tags) == 0 && len(o.Tags) > 0 || len(m.Tags) > 0 && len(o.Tags) == 0 {
 			return false
@@ -3948,6 +4037,8 @@ const AppInstFieldErrors = "15"
 const AppInstFieldCrmOverride = "16"
 const AppInstFieldRuntimeInfo = "17"
 const AppInstFieldRuntimeInfoContainerIds = "17.1"
+const AppInstFieldRuntimeInfoReplicas = "17.2"
+const AppInstFieldRuntimeInfoDesiredReplicas = "17.3"
 const AppInstFieldCreatedAt = "21"
 const AppInstFieldCreatedAtSeconds = "21.1"
 const AppInstFieldCreatedAtNanos = "21.2"
@@ -4050,6 +4141,20 @@ const AppInstFieldNodeResourcesOptResMapValue = "56.4.2"
 const AppInstFieldNodeResourcesInfraNodeFlavor = "56.5"
 const AppInstFieldNodeResourcesExternalVolumeSize = "56.6"
 const AppInstFieldIsStandalone = "57"
+const AppInstFieldRolloutStatus = "58"
+const AppInstFieldRolloutStatusState = "58.1"
+const AppInstFieldRolloutStatusBatch = "58.2"
+const AppInstFieldRolloutStatusNumBatches = "58.3"
+const AppInstFieldRolloutStatusController = "58.4"
+const AppInstFieldRolloutStatusForceUpdate = "58.5"
+const AppInstFieldDryRun = "59"
+const AppInstFieldHorizontalScalePolicy = "60"
+const AppInstFieldHorizontalScalePolicyMinReplicas = "60.1"
+const AppInstFieldHorizontalScalePolicyMaxReplicas = "60.2"
+const AppInstFieldHorizontalScalePolicyTargetCpu = "60.3"
+const AppInstFieldHorizontalScalePolicyTargetMem = "60.4"
+const AppInstFieldHorizontalScalePolicyTargetActiveConnections = "60.5"
+const AppInstFieldHorizontalScalePolicyStabilizationWindowSec = "60.6"
 const AppInstFieldTags = "100"
 const AppInstFieldTagsKey = "100.1"
 const AppInstFieldTagsValue = "100.2"
@@ -4085,6 +4190,8 @@ var AppInstAllFields = []string{
 	AppInstFieldErrors,
 	AppInstFieldCrmOverride,
 	AppInstFieldRuntimeInfoContainerIds,
+	AppInstFieldRuntimeInfoReplicas,
+	AppInstFieldRuntimeInfoDesiredReplicas,
 	AppInstFieldCreatedAtSeconds,
 	AppInstFieldCreatedAtNanos,
 	AppInstFieldAutoClusterIpAccess,
@@ -4163,6 +4270,18 @@ var AppInstAllFields = []string{
 	AppInstFieldNodeResourcesInfraNodeFlavor,
 	AppInstFieldNodeResourcesExternalVolumeSize,
 	AppInstFieldIsStandalone,
+	AppInstFieldRolloutStatusState,
+	AppInstFieldRolloutStatusBatch,
+	AppInstFieldRolloutStatusNumBatches,
+	AppInstFieldRolloutStatusController,
+	AppInstFieldRolloutStatusForceUpdate,
+	AppInstFieldDryRun,
+	AppInstFieldHorizontalScalePolicyMinReplicas,
+	AppInstFieldHorizontalScalePolicyMaxReplicas,
+	AppInstFieldHorizontalScalePolicyTargetCpu,
+	AppInstFieldHorizontalScalePolicyTargetMem,
+	AppInstFieldHorizontalScalePolicyTargetActiveConnections,
+	AppInstFieldHorizontalScalePolicyStabilizationWindowSec,
 	AppInstFieldTagsKey,
 	AppInstFieldTagsValue,
 }
@@ -4198,6 +4317,8 @@ var AppInstAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppInstFieldErrors:                                               struct{}{},
 	AppInstFieldCrmOverride:                                          struct{}{},
 	AppInstFieldRuntimeInfoContainerIds:                              struct{}{},
+	AppInstFieldRuntimeInfoReplicas:                                  struct{}{},
+	AppInstFieldRuntimeInfoDesiredReplicas:                           struct{}{},
 	AppInstFieldCreatedAtSeconds:                                     struct{}{},
 	AppInstFieldCreatedAtNanos:                                       struct{}{},
 	AppInstFieldAutoClusterIpAccess:                                  struct{}{},
@@ -4276,6 +4397,18 @@ var AppInstAllFieldsMap = NewFieldMap(map[string]struct{}{
 	AppInstFieldNodeResourcesInfraNodeFlavor:                         struct{}{},
 	AppInstFieldNodeResourcesExternalVolumeSize:                      struct{}{},
 	AppInstFieldIsStandalone:                                         struct{}{},
+	AppInstFieldRolloutStatusState:                                   struct{}{},
+	AppInstFieldRolloutStatusBatch:                                   struct{}{},
+	AppInstFieldRolloutStatusNumBatches:                              struct{}{},
+	AppInstFieldRolloutStatusController:                              struct{}{},
+	AppInstFieldRolloutStatusForceUpdate:                             struct{}{},
+	AppInstFieldDryRun:                                               struct{}{},
+	AppInstFieldHorizontalScalePolicyMinReplicas:                     struct{}{},
+	AppInstFieldHorizontalScalePolicyMaxReplicas:                     struct{}{},
+	AppInstFieldHorizontalScalePolicyTargetCpu:                       struct{}{},
+	AppInstFieldHorizontalScalePolicyTargetMem:                       struct{}{},
+	AppInstFieldHorizontalScalePolicyTargetActiveConnections:         struct{}{},
+	AppInstFieldHorizontalScalePolicyStabilizationWindowSec:          struct{}{},
 	AppInstFieldTagsKey:                                              struct{}{},
 	AppInstFieldTagsValue:                                            struct{}{},
 })
@@ -4311,6 +4444,8 @@ var AppInstAllFieldsStringMap = map[string]string{
 	AppInstFieldErrors:                                               "Errors",
 	AppInstFieldCrmOverride:                                          "Crm Override",
 	AppInstFieldRuntimeInfoContainerIds:                              "Runtime Info Container Ids",
+	AppInstFieldRuntimeInfoReplicas:                                  "Runtime Info Replicas",
+	AppInstFieldRuntimeInfoDesiredReplicas:                           "Runtime Info Desired Replicas",
 	AppInstFieldCreatedAtSeconds:                                     "Created At Seconds",
 	AppInstFieldCreatedAtNanos:                                       "Created At Nanos",
 	AppInstFieldAutoClusterIpAccess:                                  "Auto Cluster Ip Access",
@@ -4389,6 +4524,18 @@ var AppInstAllFieldsStringMap = map[string]string{
 	AppInstFieldNodeResourcesInfraNodeFlavor:                         "Node Resources Infra Node Flavor",
 	AppInstFieldNodeResourcesExternalVolumeSize:                      "Node Resources External Volume Size",
 	AppInstFieldIsStandalone:                                         "Is Standalone",
+	AppInstFieldRolloutStatusState:                                   "Rollout Status State",
+	AppInstFieldRolloutStatusBatch:                                   "Rollout Status Batch",
+	AppInstFieldRolloutStatusNumBatches:                              "Rollout Status Num Batches",
+	AppInstFieldRolloutStatusController:                              "Rollout Status Controller",
+	AppInstFieldRolloutStatusForceUpdate:                             "Rollout Status Force Update",
+	AppInstFieldDryRun:                                               "Dry Run",
+	AppInstFieldHorizontalScalePolicyMinReplicas:                     "Horizontal Scale Policy Min Replicas",
+	AppInstFieldHorizontalScalePolicyMaxReplicas:                     "Horizontal Scale Policy Max Replicas",
+	AppInstFieldHorizontalScalePolicyTargetCpu:                       "Horizontal Scale Policy Target Cpu",
+	AppInstFieldHorizontalScalePolicyTargetMem:                       "Horizontal Scale Policy Target Mem",
+	AppInstFieldHorizontalScalePolicyTargetActiveConnections:         "Horizontal Scale Policy Target Active Connections",
+	AppInstFieldHorizontalScalePolicyStabilizationWindowSec:          "Horizontal Scale Policy Stabilization Window Sec",
 	AppInstFieldTagsKey:                                              "Tags Key",
 	AppInstFieldTagsValue:                                            "Tags Value",
 }
@@ -4541,6 +4688,14 @@ func (m *AppInst) DiffFields(o *AppInst, fields *FieldMap) {
 			}
 		}
 	}
+	if m.RuntimeInfo.Replicas != o.RuntimeInfo.Replicas {
+		fields.Set(AppInstFieldRuntimeInfoReplicas)
+		fields.Set(AppInstFieldRuntimeInfo)
+	}
+	if m.RuntimeInfo.DesiredReplicas != o.RuntimeInfo.DesiredReplicas {
+		fields.Set(AppInstFieldRuntimeInfoDesiredReplicas)
+		fields.Set(AppInstFieldRuntimeInfo)
+	}
 	if m.CreatedAt.Seconds != o.CreatedAt.Seconds {
 		fields.Set(AppInstFieldCreatedAtSeconds)
 		fields.Set(AppInstFieldCreatedAt)
@@ -5009,6 +5164,61 @@ func (m *AppInst) DiffFields(o *AppInst, fields *FieldMap) {
 	if m.IsStandalone != o.IsStandalone {
 		fields.Set(AppInstFieldIsStandalone)
 	}
+	if m.RolloutStatus != nil && o.RolloutStatus != nil {
+		if m.RolloutStatus.State != o.RolloutStatus.State {
+			fields.Set(AppInstFieldRolloutStatusState)
+			fields.Set(AppInstFieldRolloutStatus)
+		}
+		if m.RolloutStatus.Batch != o.RolloutStatus.Batch {
+			fields.Set(AppInstFieldRolloutStatusBatch)
+			fields.Set(AppInstFieldRolloutStatus)
+		}
+		if m.RolloutStatus.NumBatches != o.RolloutStatus.NumBatches {
+			fields.Set(AppInstFieldRolloutStatusNumBatches)
+			fields.Set(AppInstFieldRolloutStatus)
+		}
+		if m.RolloutStatus.Controller != o.RolloutStatus.Controller {
+			fields.Set(AppInstFieldRolloutStatusController)
+			fields.Set(AppInstFieldRolloutStatus)
+		}
+		if m.RolloutStatus.ForceUpdate != o.RolloutStatus.ForceUpdate {
+			fields.Set(AppInstFieldRolloutStatusForceUpdate)
+			fields.Set(AppInstFieldRolloutStatus)
+		}
+	} else if (m.RolloutStatus != nil && o.RolloutStatus == nil) || (m.RolloutStatus == nil && o.RolloutStatus != nil) {
+		fields.Set(AppInstFieldRolloutStatus)
+	}
+	if m.DryRun != o.DryRun {
+		fields.Set(AppInstFieldDryRun)
+	}
+	if m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy != nil {
+		if m.HorizontalScalePolicy.MinReplicas != o.HorizontalScalePolicy.MinReplicas {
+			fields.Set(AppInstFieldHorizontalScalePolicyMinReplicas)
+			fields.Set(AppInstFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.MaxReplicas != o.HorizontalScalePolicy.MaxReplicas {
+			fields.Set(AppInstFieldHorizontalScalePolicyMaxReplicas)
+			fields.Set(AppInstFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.TargetCpu != o.HorizontalScalePolicy.TargetCpu {
+			fields.Set(AppInstFieldHorizontalScalePolicyTargetCpu)
+			fields.Set(AppInstFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.TargetMem != o.HorizontalScalePolicy.TargetMem {
+			fields.Set(AppInstFieldHorizontalScalePolicyTargetMem)
+			fields.Set(AppInstFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.TargetActiveConnections != o.HorizontalScalePolicy.TargetActiveConnections {
+			fields.Set(AppInstFieldHorizontalScalePolicyTargetActiveConnections)
+			fields.Set(AppInstFieldHorizontalScalePolicy)
+		}
+		if m.HorizontalScalePolicy.StabilizationWindowSec != o.HorizontalScalePolicy.StabilizationWindowSec {
+			fields.Set(AppInstFieldHorizontalScalePolicyStabilizationWindowSec)
+			fields.Set(AppInstFieldHorizontalScalePolicy)
+		}
+	} else if (m.HorizontalScalePolicy != nil && o.HorizontalScalePolicy == nil) || (m.HorizontalScalePolicy == nil && o.HorizontalScalePolicy != nil) {
+		fields.Set(AppInstFieldHorizontalScalePolicy)
+	}
 	if m.Tags != nil && o.Tags != nil {
 		if len(m.Tags) != len(o.Tags) {
 			fields.Set(AppInstFieldTags)
@@ -5103,6 +5313,13 @@ var UpdateAppInstFieldsMap = NewFieldMap(map[string]struct{}{
 	AppInstFieldNodeResourcesInfraNodeFlavor:                         struct{}{},
 	AppInstFieldNodeResourcesExternalVolumeSize:                      struct{}{},
 	AppInstFieldIsStandalone:                                         struct{}{},
+	AppInstFieldHorizontalScalePolicy:                                struct{}{},
+	AppInstFieldHorizontalScalePolicyMinReplicas:                     struct{}{},
+	AppInstFieldHorizontalScalePolicyMaxReplicas:                     struct{}{},
+	AppInstFieldHorizontalScalePolicyTargetCpu:                       struct{}{},
+	AppInstFieldHorizontalScalePolicyTargetMem:                       struct{}{},
+	AppInstFieldHorizontalScalePolicyTargetActiveConnections:         struct{}{},
+	AppInstFieldHorizontalScalePolicyStabilizationWindowSec:          struct{}{},
 	AppInstFieldTags:                                                 struct{}{},
 	AppInstFieldTagsKey:                                              struct{}{},
 	AppInstFieldTagsValue:                                            struct{}{},
@@ -5135,6 +5352,11 @@ func (m *AppInst) ValidateUpdateFieldsCustom(allowedFields *FieldMap) error {
 	return nil
 }
 
+// TableColumns are the default columns for table output
+func (m *AppInst) TableColumns() []string {
+	return []string{"key.organization", "key.name", "app_key.name", "app_key.version", "cluster_key.name", "cloudlet_key.name", "state", "health_check", "power_state"}
+}
+
 func (m *AppInst) Clone() *AppInst {
 	cp := &AppInst{}
 	cp.DeepCopyIn(m)
@@ -5432,6 +5654,18 @@ func (m *AppInst) CopyInFields(src *AppInst) int {
 				changed++
 			}
 		}
+		if fmap.Has("17.2") {
+			if m.RuntimeInfo.Replicas != src.RuntimeInfo.Replicas {
+				m.RuntimeInfo.Replicas = src.RuntimeInfo.Replicas
+				changed++
+			}
+		}
+		if fmap.Has("17.3") {
+			if m.RuntimeInfo.DesiredReplicas != src.RuntimeInfo.DesiredReplicas {
+				m.RuntimeInfo.DesiredReplicas = src.RuntimeInfo.DesiredReplicas
+				changed++
+			}
+		}
 	}
 	if fmap.HasOrHasChild("21") {
 		if fmap.Has("21.1") {
@@ -6061,6 +6295,98 @@ func (m *AppInst) CopyInFields(src *AppInst) int {
 			changed++
 		}
 	}
+	if fmap.HasOrHasChild("58") {
+		if src.RolloutStatus != nil {
+			if m.RolloutStatus == nil {
+				m.RolloutStatus = &RolloutStatus{}
+			}
+			if fmap.Has("58.1") {
+				if m.RolloutStatus.State != src.RolloutStatus.State {
+					m.RolloutStatus.State = src.RolloutStatus.State
+					changed++
+				}
+			}
+			if fmap.Has("58.2") {
+				if m.RolloutStatus.Batch != src.RolloutStatus.Batch {
+					m.RolloutStatus.Batch = src.RolloutStatus.Batch
+					changed++
+				}
+			}
+			if fmap.Has("58.3") {
+				if m.RolloutStatus.NumBatches != src.RolloutStatus.NumBatches {
+					m.RolloutStatus.NumBatches = src.RolloutStatus.NumBatches
+					changed++
+				}
+			}
+			if fmap.Has("58.4") {
+				if m.RolloutStatus.Controller != src.RolloutStatus.Controller {
+					m.RolloutStatus.Controller = src.RolloutStatus.Controller
+					changed++
+				}
+			}
+			if fmap.Has("58.5") {
+				if m.RolloutStatus.ForceUpdate != src.RolloutStatus.ForceUpdate {
+					m.RolloutStatus.ForceUpdate = src.RolloutStatus.ForceUpdate
+					changed++
+				}
+			}
+		} else if m.RolloutStatus != nil {
+			m.RolloutStatus = nil
+			changed++
+		}
+	}
+	if fmap.Has("59") {
+		if m.DryRun != src.DryRun {
+			m.DryRun = src.DryRun
+			changed++
+		}
+	}
+	if fmap.HasOrHasChild("60") {
+		if src.HorizontalScalePolicy != nil {
+			if m.HorizontalScalePolicy == nil {
+				m.HorizontalScalePolicy = &HorizontalScalePolicy{}
+			}
+			if fmap.Has("60.1") {
+				if m.HorizontalScalePolicy.MinReplicas != src.HorizontalScalePolicy.MinReplicas {
+					m.HorizontalScalePolicy.MinReplicas = src.HorizontalScalePolicy.MinReplicas
+					changed++
+				}
+			}
+			if fmap.Has("60.2") {
+				if m.HorizontalScalePolicy.MaxReplicas != src.HorizontalScalePolicy.MaxReplicas {
+					m.HorizontalScalePolicy.MaxReplicas = src.HorizontalScalePolicy.MaxReplicas
+					changed++
+				}
+			}
+			if fmap.Has("60.3") {
+				if m.HorizontalScalePolicy.TargetCpu != src.HorizontalScalePolicy.TargetCpu {
+					m.HorizontalScalePolicy.TargetCpu = src.HorizontalScalePolicy.TargetCpu
+					changed++
+				}
+			}
+			if fmap.Has("60.4") {
+				if m.HorizontalScalePolicy.TargetMem != src.HorizontalScalePolicy.TargetMem {
+					m.HorizontalScalePolicy.TargetMem = src.HorizontalScalePolicy.TargetMem
+					changed++
+				}
+			}
+			if fmap.Has("60.5") {
+				if m.HorizontalScalePolicy.TargetActiveConnections != src.HorizontalScalePolicy.TargetActiveConnections {
+					m.HorizontalScalePolicy.TargetActiveConnections = src.HorizontalScalePolicy.TargetActiveConnections
+					changed++
+				}
+			}
+			if fmap.Has("60.6") {
+				if m.HorizontalScalePolicy.StabilizationWindowSec != src.HorizontalScalePolicy.StabilizationWindowSec {
+					m.HorizontalScalePolicy.StabilizationWindowSec = src.HorizontalScalePolicy.StabilizationWindowSec
+					changed++
+				}
+			}
+		} else if m.HorizontalScalePolicy != nil {
+			m.HorizontalScalePolicy = nil
+			changed++
+		}
+	}
 	if fmap.HasOrHasChild("100") {
 		if src.Tags != nil {
 			if updateListAction == "add" {
@@ -6184,6 +6510,21 @@ func (m *AppInst) DeepCopyIn(src *AppInst) {
 		m.NodeResources = nil
 	}
 	m.IsStandalone = src.IsStandalone
+	if src.RolloutStatus != nil {
+		var tmp_RolloutStatus RolloutStatus
+		tmp_RolloutStatus.DeepCopyIn(src.RolloutStatus)
+		m.RolloutStatus = &tmp_RolloutStatus
+	} else {
+		m.RolloutStatus = nil
+	}
+	m.DryRun = src.DryRun
+	if src.HorizontalScalePolicy != nil {
+		var tmp_HorizontalScalePolicy HorizontalScalePolicy
+		tmp_HorizontalScalePolicy.DeepCopyIn(src.HorizontalScalePolicy)
+		m.HorizontalScalePolicy = &tmp_HorizontalScalePolicy
+	} else {
+		m.HorizontalScalePolicy = nil
+	}
 	if src.Tags != nil {
 		m.Tags = make(map[string]string)
 		for k, v := range src.Tags {
@@ -6927,6 +7268,16 @@ func (m *AppInst) ValidateEnums() error {
 			return err
 		}
 	}
+	if m.RolloutStatus != nil {
+		if err := m.RolloutStatus.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if m.HorizontalScalePolicy != nil {
+		if err := m.HorizontalScalePolicy.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -6982,6 +7333,15 @@ func (s *AppInst) ClearTagged(tags map[string]struct{}) {
 	if s.NodeResources != nil {
 		s.NodeResources.ClearTagged(tags)
 	}
+	if _, found := tags["nocmp"]; found {
+		s.RolloutStatus = nil
+	}
+	if _, found := tags["nocmp"]; found {
+		s.DryRun = false
+	}
+	if s.HorizontalScalePolicy != nil {
+		s.HorizontalScalePolicy.ClearTagged(tags)
+	}
 }
 
 func IgnoreAppInstFields(taglist string) cmp.Option {
@@ -7017,6 +7377,12 @@ func IgnoreAppInstFields(taglist string) cmp.Option {
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "DbModelId")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "RolloutStatus")
+	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "DryRun")
+	}
 	return cmpopts.IgnoreFields(AppInst{}, names...)
 }
 
@@ -7074,6 +7440,14 @@ func (m *AppInstRuntime) CopyInFields(src *AppInstRuntime) int {
 		m.ContainerIds = nil
 		changed++
 	}
+	if m.Replicas != src.Replicas {
+		m.Replicas = src.Replicas
+		changed++
+	}
+	if m.DesiredReplicas != src.DesiredReplicas {
+		m.DesiredReplicas = src.DesiredReplicas
+		changed++
+	}
 	return changed
 }
 
@@ -7086,6 +7460,8 @@ func (m *AppInstRuntime) DeepCopyIn(src *AppInstRuntime) {
 	} else {
 		m.ContainerIds = nil
 	}
+	m.Replicas = src.Replicas
+	m.DesiredReplicas = src.DesiredReplicas
 }
 
 // Helper method to check that enums have valid values
@@ -7263,6 +7639,8 @@ const AppInstInfoFieldState = "4"
 const AppInstInfoFieldErrors = "5"
 const AppInstInfoFieldRuntimeInfo = "6"
 const AppInstInfoFieldRuntimeInfoContainerIds = "6.1"
+const AppInstInfoFieldRuntimeInfoReplicas = "6.2"
+const AppInstInfoFieldRuntimeInfoDesiredReplicas = "6.3"
 const AppInstInfoFieldStatus = "7"
 const AppInstInfoFieldStatusTaskNumber = "7.1"
 const AppInstInfoFieldStatusMaxTasks = "7.2"
@@ -7296,6 +7674,8 @@ var AppInstInfoAllFields = []string{
 	AppInstInfoFieldState,
 	AppInstInfoFieldErrors,
 	AppInstInfoFieldRuntimeInfoContainerIds,
+	AppInstInfoFieldRuntimeInfoReplicas,
+	AppInstInfoFieldRuntimeInfoDesiredReplicas,
 	AppInstInfoFieldStatusTaskNumber,
 	AppInstInfoFieldStatusMaxTasks,
 	AppInstInfoFieldStatusTaskName,
@@ -7321,65 +7701,69 @@ var AppInstInfoAllFields = []string{
 }
 
 var AppInstInfoAllFieldsMap = NewFieldMap(map[string]struct{}{
-	AppInstInfoFieldKeyName:                 struct{}{},
-	AppInstInfoFieldKeyOrganization:         struct{}{},
-	AppInstInfoFieldNotifyId:                struct{}{},
-	AppInstInfoFieldState:                   struct{}{},
-	AppInstInfoFieldErrors:                  struct{}{},
-	AppInstInfoFieldRuntimeInfoContainerIds: struct{}{},
-	AppInstInfoFieldStatusTaskNumber:        struct{}{},
-	AppInstInfoFieldStatusMaxTasks:          struct{}{},
-	AppInstInfoFieldStatusTaskName:          struct{}{},
-	AppInstInfoFieldStatusStepName:          struct{}{},
-	AppInstInfoFieldStatusMsgCount:          struct{}{},
-	AppInstInfoFieldStatusMsgs:              struct{}{},
-	AppInstInfoFieldPowerState:              struct{}{},
-	AppInstInfoFieldUri:                     struct{}{},
-	AppInstInfoFieldFedKeyFederationName:    struct{}{},
-	AppInstInfoFieldFedKeyAppInstId:         struct{}{},
-	AppInstInfoFieldFedPortsProto:           struct{}{},
-	AppInstInfoFieldFedPortsInternalPort:    struct{}{},
-	AppInstInfoFieldFedPortsPublicPort:      struct{}{},
-	AppInstInfoFieldFedPortsPathPrefix:      struct{}{},
-	AppInstInfoFieldFedPortsFqdnPrefix:      struct{}{},
-	AppInstInfoFieldFedPortsEndPort:         struct{}{},
-	AppInstInfoFieldFedPortsTls:             struct{}{},
-	AppInstInfoFieldFedPortsNginx:           struct{}{},
-	AppInstInfoFieldFedPortsMaxPktSize:      struct{}{},
-	AppInstInfoFieldFedPortsInternalVisOnly: struct{}{},
-	AppInstInfoFieldFedPortsId:              struct{}{},
-	AppInstInfoFieldFedPortsServiceName:     struct{}{},
+	AppInstInfoFieldKeyName:                    struct{}{},
+	AppInstInfoFieldKeyOrganization:            struct{}{},
+	AppInstInfoFieldNotifyId:                   struct{}{},
+	AppInstInfoFieldState:                      struct{}{},
+	AppInstInfoFieldErrors:                     struct{}{},
+	AppInstInfoFieldRuntimeInfoContainerIds:    struct{}{},
+	AppInstInfoFieldRuntimeInfoReplicas:        struct{}{},
+	AppInstInfoFieldRuntimeInfoDesiredReplicas: struct{}{},
+	AppInstInfoFieldStatusTaskNumber:           struct{}{},
+	AppInstInfoFieldStatusMaxTasks:             struct{}{},
+	AppInstInfoFieldStatusTaskName:             struct{}{},
+	AppInstInfoFieldStatusStepName:             struct{}{},
+	AppInstInfoFieldStatusMsgCount:             struct{}{},
+	AppInstInfoFieldStatusMsgs:                 struct{}{},
+	AppInstInfoFieldPowerState:                 struct{}{},
+	AppInstInfoFieldUri:                        struct{}{},
+	AppInstInfoFieldFedKeyFederationName:       struct{}{},
+	AppInstInfoFieldFedKeyAppInstId:            struct{}{},
+	AppInstInfoFieldFedPortsProto:              struct{}{},
+	AppInstInfoFieldFedPortsInternalPort:       struct{}{},
+	AppInstInfoFieldFedPortsPublicPort:         struct{}{},
+	AppInstInfoFieldFedPortsPathPrefix:         struct{}{},
+	AppInstInfoFieldFedPortsFqdnPrefix:         struct{}{},
+	AppInstInfoFieldFedPortsEndPort:            struct{}{},
+	AppInstInfoFieldFedPortsTls:                struct{}{},
+	AppInstInfoFieldFedPortsNginx:              struct{}{},
+	AppInstInfoFieldFedPortsMaxPktSize:         struct{}{},
+	AppInstInfoFieldFedPortsInternalVisOnly:    struct{}{},
+	AppInstInfoFieldFedPortsId:                 struct{}{},
+	AppInstInfoFieldFedPortsServiceName:        struct{}{},
 })
 
 var AppInstInfoAllFieldsStringMap = map[string]string{
-	AppInstInfoFieldKeyName:                 "Key Name",
-	AppInstInfoFieldKeyOrganization:         "Key Organization",
-	AppInstInfoFieldNotifyId:                "Notify Id",
-	AppInstInfoFieldState:                   "State",
-	AppInstInfoFieldErrors:                  "Errors",
-	AppInstInfoFieldRuntimeInfoContainerIds: "Runtime Info Container Ids",
-	AppInstInfoFieldStatusTaskNumber:        "Status Task Number",
-	AppInstInfoFieldStatusMaxTasks:          "Status Max Tasks",
-	AppInstInfoFieldStatusTaskName:          "Status Task Name",
-	AppInstInfoFieldStatusStepName:          "Status Step Name",
-	AppInstInfoFieldStatusMsgCount:          "Status Msg Count",
-	AppInstInfoFieldStatusMsgs:              "Status Msgs",
-	AppInstInfoFieldPowerState:              "Power State",
-	AppInstInfoFieldUri:                     "Uri",
-	AppInstInfoFieldFedKeyFederationName:    "Fed Key Federation Name",
-	AppInstInfoFieldFedKeyAppInstId:         "Fed Key App Inst Id",
-	AppInstInfoFieldFedPortsProto:           "Fed Ports Proto",
-	AppInstInfoFieldFedPortsInternalPort:    "Fed Ports Internal Port",
-	AppInstInfoFieldFedPortsPublicPort:      "Fed Ports Public Port",
-	AppInstInfoFieldFedPortsPathPrefix:      "Fed Ports Path Prefix",
-	AppInstInfoFieldFedPortsFqdnPrefix:      "Fed Ports Fqdn Prefix",
-	AppInstInfoFieldFedPortsEndPort:         "Fed Ports End Port",
-	AppInstInfoFieldFedPortsTls:             "Fed Ports Tls",
-	AppInstInfoFieldFedPortsNginx:           "Fed Ports Nginx",
-	AppInstInfoFieldFedPortsMaxPktSize:      "Fed Ports Max Pkt Size",
-	AppInstInfoFieldFedPortsInternalVisOnly: "Fed Ports Internal Vis Only",
-	AppInstInfoFieldFedPortsId:              "Fed Ports Id",
-	AppInstInfoFieldFedPortsServiceName:     "Fed Ports Service Name",
+	AppInstInfoFieldKeyName:                    "Key Name",
+	AppInstInfoFieldKeyOrganization:            "Key Organization",
+	AppInstInfoFieldNotifyId:                   "Notify Id",
+	AppInstInfoFieldState:                      "State",
+	AppInstInfoFieldErrors:                     "Errors",
+	AppInstInfoFieldRuntimeInfoContainerIds:    "Runtime Info Container Ids",
+	AppInstInfoFieldRuntimeInfoReplicas:        "Runtime Info Replicas",
+	AppInstInfoFieldRuntimeInfoDesiredReplicas: "Runtime Info Desired Replicas",
+	AppInstInfoFieldStatusTaskNumber:           "Status Task Number",
+	AppInstInfoFieldStatusMaxTasks:             "Status Max Tasks",
+	AppInstInfoFieldStatusTaskName:             "Status Task Name",
+	AppInstInfoFieldStatusStepName:             "Status Step Name",
+	AppInstInfoFieldStatusMsgCount:             "Status Msg Count",
+	AppInstInfoFieldStatusMsgs:                 "Status Msgs",
+	AppInstInfoFieldPowerState:                 "Power State",
+	AppInstInfoFieldUri:                        "Uri",
+	AppInstInfoFieldFedKeyFederationName:       "Fed Key Federation Name",
+	AppInstInfoFieldFedKeyAppInstId:            "Fed Key App Inst Id",
+	AppInstInfoFieldFedPortsProto:              "Fed Ports Proto",
+	AppInstInfoFieldFedPortsInternalPort:       "Fed Ports Internal Port",
+	AppInstInfoFieldFedPortsPublicPort:         "Fed Ports Public Port",
+	AppInstInfoFieldFedPortsPathPrefix:         "Fed Ports Path Prefix",
+	AppInstInfoFieldFedPortsFqdnPrefix:         "Fed Ports Fqdn Prefix",
+	AppInstInfoFieldFedPortsEndPort:            "Fed Ports End Port",
+	AppInstInfoFieldFedPortsTls:                "Fed Ports Tls",
+	AppInstInfoFieldFedPortsNginx:              "Fed Ports Nginx",
+	AppInstInfoFieldFedPortsMaxPktSize:         "Fed Ports Max Pkt Size",
+	AppInstInfoFieldFedPortsInternalVisOnly:    "Fed Ports Internal Vis Only",
+	AppInstInfoFieldFedPortsId:                 "Fed Ports Id",
+	AppInstInfoFieldFedPortsServiceName:        "Fed Ports Service Name",
 }
 
 func (m *AppInstInfo) IsKeyField(s string) bool {
@@ -7423,6 +7807,14 @@ func (m *AppInstInfo) DiffFields(o *AppInstInfo, fields *FieldMap) {
 			}
 		}
 	}
+	if m.RuntimeInfo.Replicas != o.RuntimeInfo.Replicas {
+		fields.Set(AppInstInfoFieldRuntimeInfoReplicas)
+		fields.Set(AppInstInfoFieldRuntimeInfo)
+	}
+	if m.RuntimeInfo.DesiredReplicas != o.RuntimeInfo.DesiredReplicas {
+		fields.Set(AppInstInfoFieldRuntimeInfoDesiredReplicas)
+		fields.Set(AppInstInfoFieldRuntimeInfo)
+	}
 	if m.Status.TaskNumber != o.Status.TaskNumber {
 		fields.Set(AppInstInfoFieldStatusTaskNumber)
 		fields.Set(AppInstInfoFieldStatus)
@@ -7724,6 +8116,18 @@ func (m *AppInstInfo) CopyInFields(src *AppInstInfo) int {
 				changed++
 			}
 		}
+		if fmap.Has("6.2") {
+			if m.RuntimeInfo.Replicas != src.RuntimeInfo.Replicas {
+				m.RuntimeInfo.Replicas = src.RuntimeInfo.Replicas
+				changed++
+			}
+		}
+		if fmap.Has("6.3") {
+			if m.RuntimeInfo.DesiredReplicas != src.RuntimeInfo.DesiredReplicas {
+				m.RuntimeInfo.DesiredReplicas = src.RuntimeInfo.DesiredReplicas
+				changed++
+			}
+		}
 	}
 	if fmap.HasOrHasChild("7") {
 		if fmap.Has("7.1") {
@@ -10331,6 +10735,12 @@ func (m *AppInst) IsValidArgsForCreateAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
+	if m.RuntimeInfo.DesiredReplicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.DesiredReplicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -10406,6 +10816,9 @@ func (m *AppInst) IsValidArgsForCreateAppInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.RolloutStatus != nil {
+		return fmt.Errorf("Invalid field specified: RolloutStatus, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -10452,6 +10865,12 @@ func (m *AppInst) IsValidArgsForDeleteAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
+	if m.RuntimeInfo.DesiredReplicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.DesiredReplicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -10521,6 +10940,12 @@ func (m *AppInst) IsValidArgsForDeleteAppInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.RolloutStatus != nil {
+		return fmt.Errorf("Invalid field specified: RolloutStatus, this field is only for internal use")
+	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -10570,6 +10995,12 @@ func (m *AppInst) IsValidArgsForRefreshAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
+	if m.RuntimeInfo.DesiredReplicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.DesiredReplicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -10645,6 +11076,12 @@ func (m *AppInst) IsValidArgsForRefreshAppInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.RolloutStatus != nil {
+		return fmt.Errorf("Invalid field specified: RolloutStatus, this field is only for internal use")
+	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -10691,6 +11128,12 @@ func (m *AppInst) IsValidArgsForUpdateAppInst() error {
 	if m.RuntimeInfo.ContainerIds != nil {
 		return fmt.Errorf("Invalid field specified: RuntimeInfo.ContainerIds, this field is only for internal use")
 	}
+	if m.RuntimeInfo.Replicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.Replicas, this field is only for internal use")
+	}
+	if m.RuntimeInfo.DesiredReplicas != 0 {
+		return fmt.Errorf("Invalid field specified: RuntimeInfo.DesiredReplicas, this field is only for internal use")
+	}
 	if m.CreatedAt.Seconds != 0 {
 		return fmt.Errorf("Invalid field specified: CreatedAt.Seconds, this field is only for internal use")
 	}
@@ -10781,6 +11224,12 @@ func (m *AppInst) IsValidArgsForUpdateAppInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.RolloutStatus != nil {
+		return fmt.Errorf("Invalid field specified: RolloutStatus, this field is only for internal use")
+	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -11022,6 +11471,17 @@ func (m *AppInst) Size() (n int) {
 	if m.IsStandalone {
 		n += 3
 	}
+	if m.RolloutStatus != nil {
+		l = m.RolloutStatus.Size()
+		n += 2 + l + sovAppinst(uint64(l))
+	}
+	if m.DryRun {
+		n += 3
+	}
+	if m.HorizontalScalePolicy != nil {
+		l = m.HorizontalScalePolicy.Size()
+		n += 2 + l + sovAppinst(uint64(l))
+	}
 	if len(m.Tags) > 0 {
 		for k, v := range m.Tags {
 			_ = k
@@ -11045,6 +11505,12 @@ func (m *AppInstRuntime) Size() (n int) {
 			n += 1 + l + sovAppinst(uint64(l))
 		}
 	}
+	if m.Replicas != 0 {
+		n += 1 + sovAppinst(uint64(m.Replicas))
+	}
+	if m.DesiredReplicas != 0 {
+		n += 1 + sovAppinst(uint64(m.DesiredReplicas))
+	}
 	return n
 }
 
@@ -13289,6 +13755,98 @@ func (m *AppInst) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsStandalone = bool(v != 0)
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutStatus == nil {
+				m.RolloutStatus = &RolloutStatus{}
+			}
+			if err := m.RolloutStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizontalScalePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppinst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HorizontalScalePolicy == nil {
+				m.HorizontalScalePolicy = &HorizontalScalePolicy{}
+			}
+			if err := m.HorizontalScalePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
@@ -13498,6 +14056,44 @@ func (m *AppInstRuntime) Unmarshal(dAtA []byte) error {
 			}
 			m.ContainerIds = append(m.ContainerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
+			}
+			m.DesiredReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAppinst(dAtA[iNdEx:])
@@ -63,6 +63,18 @@ type AutoProvPolicy struct {
 	UndeployIntervalCount uint32 `protobuf:"varint,9,opt,name=undeploy_interval_count,json=undeployIntervalCount,proto3" json:"undeploy_interval_count,omitempty"`
 	// Preparing to be deleted
 	DeletePrepare bool `protobuf:"varint,10,opt,name=delete_prepare,json=deletePrepare,proto3" json:"delete_prepare,omitempty"`
+	// Time-of-day schedules for pre-provisioning instances ahead of expected demand
+	Schedules []AutoProvSchedule `protobuf:"bytes,11,rep,name=schedules,proto3" json:"schedules"`
+	// How far ahead of a scheduled window to deploy instances, i.e. 10m, 1h
+	ScheduleLeadTime Duration `protobuf:"varint,12,opt,name=schedule_lead_time,json=scheduleLeadTime,proto3,casttype=Duration" json:"schedule_lead_time,omitempty"`
+	// Minimum time an auto-provisioned instance is kept before it can be undeployed due to low demand, i.e. 30m
+	MinInstanceLifetime Duration `protobuf:"varint,13,opt,name=min_instance_lifetime,json=minInstanceLifetime,proto3,casttype=Duration" json:"min_instance_lifetime,omitempty"`
+	// Time after undeploying from a zone before deploying to it again due to demand, i.e. 15m
+	RedeployCooldown Duration `protobuf:"varint,14,opt,name=redeploy_cooldown,json=redeployCooldown,proto3,casttype=Duration" json:"redeploy_cooldown,omitempty"`
+	// Number of demand-driven undeploys of an App within the flap window above which demand-driven deploys of the App are suspended, undeploys are still allowed, 0 (default) disables
+	MaxFlaps uint32 `protobuf:"varint,15,opt,name=max_flaps,json=maxFlaps,proto3" json:"max_flaps,omitempty"`
+	// Window over which undeploys are counted against max flaps, also the time for which demand-driven deploys are suspended, i.e. 1h
+	FlapWindow Duration `protobuf:"varint,16,opt,name=flap_window,json=flapWindow,proto3,casttype=Duration" json:"flap_window,omitempty"`
 }
 
 func (m *AutoProvPolicy) Reset()         { *m = AutoProvPolicy{} }
@@ -98,6 +110,58 @@ func (m *AutoProvPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AutoProvPolicy proto.InternalMessageInfo
 
+// AutoProvSchedule defines a recurring time window during which
+// a minimum number of instances are kept deployed. The minimum is
+// a total across the schedule's zones, not per zone. Auto-provisioning
+// deploys at most one instance per zone, so to keep an instance in
+// each zone either set the minimum to the number of zones or
+// define a schedule per zone.
+type AutoProvSchedule struct {
+	// Schedule name, unique within the policy
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Window start time in 5-field cron format (minute hour day-of-month month day-of-week), evaluated in each zone's time zone
+	Cron string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Length of the window, i.e. 2h, 30m
+	Duration Duration `protobuf:"varint,3,opt,name=duration,proto3,casttype=Duration" json:"duration,omitempty"`
+	// Zones the schedule applies to, defaults to all zones in the policy
+	Zones []ZoneKey `protobuf:"bytes,4,rep,name=zones,proto3" json:"zones"`
+	// Minimum number of active instances in total across the schedule's zones whose window is active, not per zone
+	MinActiveInstances uint32 `protobuf:"varint,5,opt,name=min_active_instances,json=minActiveInstances,proto3" json:"min_active_instances,omitempty"`
+}
+
+func (m *AutoProvSchedule) Reset()         { *m = AutoProvSchedule{} }
+func (m *AutoProvSchedule) String() string { return proto.CompactTextString(m) }
+func (*AutoProvSchedule) ProtoMessage()    {}
+func (*AutoProvSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199b84e2b69e837c, []int{1}
+}
+func (m *AutoProvSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoProvSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoProvSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoProvSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoProvSchedule.Merge(m, src)
+}
+func (m *AutoProvSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoProvSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoProvSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoProvSchedule proto.InternalMessageInfo
+
 // AutoProvCount is used to send potential zone and location counts from DME to Controller
 type AutoProvCount struct {
 	// Target app
@@ -114,7 +178,7 @@ func (m *AutoProvCount) Reset()         { *m = AutoProvCount{} }
 func (m *AutoProvCount) String() string { return proto.CompactTextString(m) }
 func (*AutoProvCount) ProtoMessage()    {}
 func (*AutoProvCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_199b84e2b69e837c, []int{1}
+	return fileDescriptor_199b84e2b69e837c, []int{2}
 }
 func (m *AutoProvCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +221,7 @@ func (m *AutoProvCounts) Reset()         { *m = AutoProvCounts{} }
 func (m *AutoProvCounts) String() string { return proto.CompactTextString(m) }
 func (*AutoProvCounts) ProtoMessage()    {}
 func (*AutoProvCounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_199b84e2b69e837c, []int{2}
+	return fileDescriptor_199b84e2b69e837c, []int{3}
 }
 func (m *AutoProvCounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,7 +262,7 @@ func (m *AutoProvPolicyZone) Reset()         { *m = AutoProvPolicyZone{} }
 func (m *AutoProvPolicyZone) String() string { return proto.CompactTextString(m) }
 func (*AutoProvPolicyZone) ProtoMessage()    {}
 func (*AutoProvPolicyZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_199b84e2b69e837c, []int{3}
+	return fileDescriptor_199b84e2b69e837c, []int{4}
 }
 func (m *AutoProvPolicyZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +311,7 @@ func (m *AutoProvInfo) Reset()         { *m = AutoProvInfo{} }
 func (m *AutoProvInfo) String() string { return proto.CompactTextString(m) }
 func (*AutoProvInfo) ProtoMessage()    {}
 func (*AutoProvInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_199b84e2b69e837c, []int{4}
+	return fileDescriptor_199b84e2b69e837c, []int{5}
 }
 func (m *AutoProvInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,6 +342,7 @@ var xxx_messageInfo_AutoProvInfo proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*AutoProvPolicy)(nil), "edgeproto.AutoProvPolicy")
+	proto.RegisterType((*AutoProvSchedule)(nil), "edgeproto.AutoProvSchedule")
 	proto.RegisterType((*AutoProvCount)(nil), "edgeproto.AutoProvCount")
 	proto.RegisterType((*AutoProvCounts)(nil), "edgeproto.AutoProvCounts")
 	proto.RegisterType((*AutoProvPolicyZone)(nil), "edgeproto.AutoProvPolicyZone")
@@ -287,83 +352,96 @@ func init() {
 func init() { proto.RegisterFile("autoprovpolicy.proto", fileDescriptor_199b84e2b69e837c) }
 
 var fileDescriptor_199b84e2b69e837c = []byte{
-	// 1206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x68, 0x1b, 0x47,
-	0x14, 0xd6, 0x58, 0xb2, 0x2d, 0x8d, 0xad, 0x10, 0x6d, 0x64, 0x67, 0xe2, 0x3a, 0x8a, 0x51, 0x69,
-	0x31, 0xa9, 0x90, 0x8c, 0x42, 0x4b, 0x71, 0x49, 0xc0, 0x76, 0x28, 0x98, 0xe0, 0x34, 0x6c, 0xda,
-	0x14, 0x72, 0xc8, 0x32, 0xd9, 0x7d, 0x56, 0x96, 0xec, 0xce, 0x2c, 0xbb, 0x23, 0x39, 0xca, 0xa5,
-	0xa5, 0x3d, 0xb4, 0xc7, 0x90, 0x1e, 0x5a, 0x72, 0xea, 0xa1, 0x87, 0xd2, 0x3f, 0x4a, 0xa0, 0xf7,
-	0xd2, 0x93, 0x4f, 0x25, 0x90, 0x4b, 0x4f, 0x25, 0x75, 0x7a, 0x28, 0x3e, 0x05, 0x22, 0xe9, 0x5c,
-	0x66, 0x76, 0x57, 0x5a, 0xa9, 0x72, 0x30, 0x71, 0x6f, 0xfb, 0xde, 0xfb, 0xde, 0xea, 0x7b, 0xdf,
-	0xbe, 0xef, 0x09, 0x17, 0x69, 0x53, 0x70, 0xcf, 0xe7, 0x2d, 0x8f, 0x3b, 0xb6, 0xd9, 0xae, 0x7a,
-	0x3e, 0x17, 0x5c, 0xcb, 0x81, 0xd5, 0x00, 0xf5, 0xb8, 0xb0, 0xd8, 0xe0, 0xbc, 0xe1, 0x40, 0x8d,
-	0x7a, 0x76, 0x8d, 0x32, 0xc6, 0x05, 0x15, 0x36, 0x67, 0x41, 0x08, 0x5c, 0x98, 0xf5, 0x21, 0x68,
-	0x3a, 0x22, 0x8a, 0x4e, 0x0b, 0xce, 0x9d, 0xa0, 0xa6, 0x82, 0x06, 0xb0, 0xfe, 0x43, 0x54, 0x2e,
-	0x36, 0x78, 0x83, 0xab, 0xc7, 0x9a, 0x7c, 0x8a, 0xb2, 0x73, 0x92, 0x41, 0x60, 0x52, 0x07, 0x92,
-	0x14, 0x16, 0x0a, 0xa6, 0xc3, 0x9b, 0x96, 0x03, 0xe2, 0x36, 0xc4, 0xa9, 0x1c, 0xf5, 0xbc, 0x41,
-	0xb5, 0x19, 0x08, 0xf0, 0x6d, 0x16, 0xc4, 0x3f, 0x9e, 0xb7, 0x5c, 0xa8, 0x39, 0xdc, 0x8c, 0xc2,
-	0x13, 0x32, 0xa4, 0x9e, 0x67, 0x72, 0xd7, 0xe5, 0x31, 0x83, 0x33, 0xd1, 0x30, 0x2a, 0xba, 0xd9,
-	0xdc, 0xae, 0x09, 0xdb, 0x85, 0x40, 0x50, 0x37, 0x7a, 0x6f, 0xf9, 0x71, 0x06, 0x1f, 0x5b, 0x6b,
-	0x0a, 0x7e, 0xc5, 0xe7, 0xad, 0x2b, 0x8a, 0x8e, 0x36, 0x8f, 0xa7, 0xb6, 0x6d, 0x70, 0xac, 0x80,
-	0xa0, 0xa5, 0xf4, 0x72, 0x4e, 0x8f, 0x22, 0xad, 0x82, 0xd3, 0xb7, 0xa1, 0x4d, 0x26, 0x96, 0xd0,
-	0xf2, 0x4c, 0xbd, 0x58, 0xed, 0x2b, 0x56, 0x0d, 0xfb, 0x2e, 0x41, 0x7b, 0x3d, 0xb3, 0xfb, 0xe7,
-	0x99, 0x94, 0x2e, 0x61, 0x5a, 0x15, 0x9f, 0xb0, 0xc0, 0x73, 0x78, 0xdb, 0x30, 0x1d, 0x1b, 0x98,
-	0x30, 0x4c, 0xde, 0x64, 0x82, 0xa4, 0x97, 0xd0, 0x72, 0x5e, 0x2f, 0x84, 0xa5, 0x0d, 0x55, 0xd9,
-	0x90, 0x05, 0xed, 0x6d, 0x3c, 0x17, 0xe1, 0x6d, 0x26, 0xc0, 0x6f, 0x51, 0x27, 0xea, 0xc8, 0xc8,
-	0x8e, 0xf5, 0xcc, 0xe7, 0x1d, 0x82, 0xf4, 0xe8, 0x95, 0x9b, 0x11, 0x22, 0xec, 0xac, 0xe3, 0xc9,
-	0xbb, 0x9c, 0x41, 0x40, 0x26, 0x97, 0xd2, 0xcb, 0x33, 0x75, 0x2d, 0xc1, 0xec, 0x3a, 0x67, 0x20,
-	0x79, 0x65, 0xf7, 0x7b, 0x24, 0x23, 0x03, 0x3d, 0x84, 0x6a, 0x2b, 0xb8, 0xe8, 0xda, 0xcc, 0xa0,
-	0xa6, 0xb0, 0x5b, 0x60, 0x48, 0x51, 0x29, 0x33, 0x21, 0x20, 0x53, 0x8a, 0x9e, 0xe6, 0xda, 0x6c,
-	0x4d, 0x95, 0x36, 0xe3, 0x8a, 0xf6, 0x2a, 0xce, 0xbb, 0xf4, 0x4e, 0x02, 0x3a, 0xad, 0xa0, 0xb3,
-	0x2e, 0xbd, 0x33, 0x00, 0xd5, 0xf1, 0x5c, 0x93, 0x8d, 0x1b, 0x3b, 0xab, 0xc0, 0x27, 0xe2, 0x62,
-	0x72, 0xf0, 0xb7, 0xf0, 0xc9, 0x7e, 0xcf, 0xc8, 0xe8, 0x39, 0xd5, 0xd5, 0x7f, 0xe5, 0xf0, 0xd8,
-	0x6f, 0xe0, 0x63, 0x16, 0x38, 0x20, 0xc0, 0xf0, 0x7c, 0xf0, 0xa8, 0x0f, 0x04, 0x2f, 0xa1, 0xe5,
-	0xec, 0x7a, 0xe6, 0x5b, 0xa9, 0x54, 0x3e, 0xac, 0x5d, 0x09, 0x4b, 0xab, 0xee, 0x3f, 0xcf, 0x09,
-	0x7a, 0xf6, 0x9c, 0xa0, 0x8f, 0x3b, 0x04, 0xdd, 0xeb, 0x10, 0xf4, 0x55, 0x87, 0xa0, 0xfb, 0x5d,
-	0x92, 0xbf, 0x98, 0x84, 0x3d, 0xe8, 0x92, 0xd7, 0x18, 0x75, 0xe1, 0xfc, 0x25, 0x68, 0x57, 0x2f,
-	0x53, 0x17, 0x2a, 0xd4, 0xf3, 0xb8, 0xdf, 0x50, 0xf1, 0x7b, 0x7e, 0x83, 0x32, 0xfb, 0xae, 0x72,
-	0xc3, 0xc3, 0x1e, 0x39, 0x7e, 0x1b, 0xda, 0xe7, 0x93, 0xb9, 0xdf, 0x7a, 0x64, 0x3a, 0xd2, 0xbb,
-	0xfc, 0x23, 0xc2, 0xf9, 0x78, 0xab, 0x42, 0xb6, 0x2b, 0x78, 0x9a, 0x7a, 0x9e, 0x21, 0x17, 0x08,
-	0xa9, 0x05, 0x2a, 0x24, 0x3e, 0xd3, 0x9a, 0xe7, 0x0d, 0xb6, 0x67, 0x8a, 0xaa, 0x48, 0x3b, 0x87,
-	0xb3, 0xf2, 0x5b, 0x19, 0x83, 0x9d, 0x1b, 0xf7, 0x65, 0xc3, 0x9e, 0xe9, 0xbb, 0x61, 0xa8, 0x15,
-	0xf1, 0xe4, 0x60, 0xcf, 0x32, 0x7a, 0x18, 0x68, 0x67, 0xf0, 0x8c, 0xe7, 0x73, 0x13, 0x82, 0xc0,
-	0x60, 0x7c, 0x47, 0x6d, 0x54, 0x56, 0xc7, 0x51, 0xea, 0x32, 0xdf, 0x29, 0xff, 0x8c, 0x06, 0x2e,
-	0x50, 0x7c, 0x03, 0xad, 0x8c, 0xa5, 0xbf, 0x0c, 0xc6, 0x2d, 0x30, 0xa4, 0x26, 0x8a, 0x76, 0x4e,
-	0x9f, 0xb1, 0x5c, 0xb8, 0xcc, 0x2d, 0x90, 0xea, 0x68, 0x17, 0x70, 0xae, 0xef, 0xa7, 0x88, 0xe3,
-	0x42, 0x35, 0x74, 0x5c, 0x35, 0x76, 0x5c, 0xf5, 0xfd, 0x18, 0x11, 0x71, 0x1d, 0xb4, 0x68, 0x2b,
-	0x78, 0x4a, 0x11, 0x0c, 0x48, 0x5a, 0xad, 0x2e, 0x49, 0x6a, 0x92, 0xa4, 0xa3, 0x47, 0xb8, 0xd5,
-	0xec, 0xaf, 0x1d, 0x82, 0x9e, 0x75, 0x48, 0xaa, 0xfc, 0xe5, 0x04, 0xd6, 0x86, 0x8d, 0x2b, 0x25,
-	0xd1, 0x2e, 0x84, 0x26, 0x45, 0x2f, 0x30, 0xe9, 0xfc, 0x7e, 0x8f, 0x8c, 0x78, 0x7e, 0x60, 0xdb,
-	0x77, 0x0e, 0xa5, 0xfa, 0x6c, 0xec, 0xa7, 0x21, 0xf5, 0x57, 0x3f, 0x45, 0x0f, 0xba, 0xe4, 0xa3,
-	0x43, 0x6d, 0x50, 0x45, 0xf6, 0x9c, 0x8f, 0xde, 0x17, 0x22, 0x65, 0x46, 0x42, 0xe3, 0xe4, 0x10,
-	0x7c, 0x1b, 0x2c, 0xf0, 0xa9, 0x00, 0x2b, 0x89, 0x78, 0x37, 0x4e, 0x26, 0xa1, 0xe5, 0xef, 0x27,
-	0xf0, 0x6c, 0x3c, 0xde, 0x26, 0xdb, 0xe6, 0x07, 0x1e, 0xb4, 0x6a, 0xf2, 0xa0, 0xcd, 0x27, 0xc6,
-	0xdc, 0x88, 0x2e, 0xf1, 0xc8, 0x49, 0x7b, 0x1d, 0xe7, 0x18, 0x17, 0xf6, 0x76, 0xdb, 0xb0, 0x2d,
-	0xb5, 0x60, 0xe9, 0xf5, 0xdc, 0xfd, 0x87, 0xa7, 0x26, 0x19, 0x37, 0x5d, 0x4f, 0xcf, 0x86, 0xb5,
-	0x4d, 0x4b, 0xfb, 0x10, 0x17, 0x5c, 0x2a, 0xad, 0xcc, 0xe4, 0x55, 0x30, 0x02, 0x41, 0x05, 0xa8,
-	0xa5, 0x3b, 0x56, 0x3f, 0x5b, 0xb5, 0xec, 0x40, 0xf8, 0xf6, 0xcd, 0xa6, 0x00, 0xcb, 0x70, 0xa9,
-	0x30, 0x6f, 0x19, 0xc0, 0x1a, 0x36, 0x83, 0xea, 0xd6, 0xa0, 0xe5, 0xaa, 0xec, 0xd0, 0x8f, 0xbb,
-	0x23, 0x19, 0x6d, 0x11, 0xe7, 0x4c, 0xee, 0x7a, 0xd2, 0xb1, 0x96, 0xba, 0x76, 0x39, 0x7d, 0x90,
-	0x90, 0x63, 0x82, 0xef, 0x73, 0x5f, 0x5e, 0x31, 0x35, 0x66, 0x18, 0xad, 0x2e, 0x8e, 0x7a, 0xff,
-	0x49, 0x87, 0xa0, 0x87, 0x3d, 0x92, 0x61, 0x9c, 0x41, 0xfd, 0x97, 0x2c, 0x2e, 0x0c, 0x2f, 0xc3,
-	0x9a, 0x67, 0x6b, 0xbf, 0x23, 0x5c, 0xdc, 0xf0, 0x81, 0x0a, 0x18, 0xf9, 0x73, 0x38, 0x35, 0x66,
-	0x45, 0xc3, 0xd2, 0x42, 0xd2, 0xd1, 0xba, 0xfa, 0x97, 0x2c, 0x7f, 0x86, 0xf6, 0x3b, 0xe4, 0x4d,
-	0x1d, 0x02, 0xde, 0xf4, 0x4d, 0xb8, 0x08, 0x2d, 0x70, 0xb8, 0x07, 0x7e, 0xd8, 0x50, 0x91, 0x27,
-	0x96, 0xb3, 0x2d, 0xca, 0x68, 0x03, 0x2a, 0xa3, 0x5f, 0xfc, 0x51, 0x97, 0xa0, 0xbd, 0x2e, 0x29,
-	0x6c, 0xd9, 0x4c, 0xfa, 0x2d, 0xa8, 0x6c, 0xd1, 0x3b, 0xea, 0xe1, 0xbb, 0x1e, 0x39, 0x3e, 0x0a,
-	0xfe, 0xe4, 0xf1, 0xdf, 0x5f, 0x4c, 0xbc, 0x52, 0x9e, 0xaf, 0x99, 0x8a, 0x73, 0x6d, 0xf8, 0x3f,
-	0x7e, 0x15, 0x9d, 0xd5, 0xbe, 0x46, 0xb8, 0x18, 0xde, 0xba, 0x23, 0x0d, 0x74, 0xfd, 0xa5, 0xe7,
-	0xe9, 0x53, 0x0c, 0x0f, 0xf3, 0x18, 0x8a, 0xdf, 0x20, 0x5c, 0xfc, 0xc0, 0xb3, 0x8e, 0xaa, 0xf9,
-	0x8d, 0x23, 0x49, 0xde, 0xa7, 0xd9, 0x54, 0x4c, 0xc6, 0xd3, 0xd4, 0xae, 0xde, 0xe2, 0x3b, 0x87,
-	0x27, 0x79, 0x70, 0xa9, 0x7c, 0x6d, 0xbf, 0x43, 0xce, 0xbd, 0x98, 0xec, 0x35, 0x1b, 0x76, 0xc6,
-	0xab, 0x79, 0xaa, 0x5c, 0xac, 0x05, 0xb7, 0xf8, 0xce, 0x7f, 0x49, 0xae, 0x20, 0xed, 0x27, 0x84,
-	0xe7, 0xd6, 0x2c, 0x6b, 0xcc, 0x89, 0x3c, 0x7d, 0x20, 0x1d, 0x59, 0x1e, 0x27, 0xa9, 0xf9, 0xd2,
-	0x92, 0xee, 0x76, 0x09, 0xea, 0xcb, 0xba, 0x58, 0x3e, 0x59, 0xa3, 0x96, 0x35, 0x42, 0x57, 0x9e,
-	0x3d, 0xa9, 0xeb, 0x0f, 0x08, 0x13, 0x1d, 0x5c, 0xde, 0x82, 0xff, 0x85, 0xf3, 0x8d, 0x23, 0x71,
-	0xee, 0xaf, 0x81, 0xef, 0x8e, 0xa7, 0xbb, 0xbe, 0xb8, 0xfb, 0x57, 0x29, 0xb5, 0xbb, 0x57, 0x42,
-	0x8f, 0xf6, 0x4a, 0xe8, 0xc9, 0x5e, 0x09, 0xdd, 0x7b, 0x5a, 0x4a, 0x3d, 0x7a, 0x5a, 0x4a, 0xfd,
-	0xf1, 0xb4, 0x94, 0xba, 0x39, 0xa5, 0xb8, 0x9c, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xe2,
-	0x1b, 0x85, 0x6e, 0x0b, 0x00, 0x00,
+	// 1410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6c, 0x1b, 0xc5,
+	0x17, 0xce, 0x24, 0x4e, 0x62, 0x4f, 0xfe, 0xfc, 0x92, 0x89, 0x93, 0x4e, 0xd3, 0xd4, 0xb1, 0xfc,
+	0x13, 0xc8, 0x2a, 0xc1, 0x8e, 0x52, 0x81, 0x20, 0xa8, 0x85, 0x24, 0x55, 0xa5, 0xa8, 0x4d, 0xa9,
+	0xb6, 0xa5, 0x95, 0x7a, 0xe8, 0x6a, 0xba, 0xfb, 0xec, 0xac, 0xba, 0x3b, 0xb3, 0xda, 0x5d, 0xc7,
+	0x71, 0x2f, 0x20, 0x38, 0xc0, 0xb1, 0x2a, 0x07, 0xa0, 0x27, 0x0e, 0x1c, 0x10, 0xff, 0x84, 0x2a,
+	0x71, 0x47, 0x1c, 0x50, 0x4e, 0xa8, 0x12, 0x17, 0x4e, 0x55, 0x49, 0x39, 0xa0, 0x9c, 0x2a, 0xd5,
+	0xc9, 0x81, 0x13, 0x9a, 0xd9, 0x5d, 0x7b, 0x63, 0x9c, 0x52, 0x35, 0xdc, 0xe6, 0xcd, 0xfb, 0xde,
+	0xec, 0xf7, 0xde, 0xbc, 0xf7, 0xcd, 0xe2, 0x2c, 0xab, 0x05, 0xc2, 0xf5, 0xc4, 0x86, 0x2b, 0x6c,
+	0xcb, 0x68, 0x94, 0x5c, 0x4f, 0x04, 0x82, 0x64, 0xc0, 0xac, 0x82, 0x5a, 0x4e, 0xcf, 0x54, 0x85,
+	0xa8, 0xda, 0x50, 0x66, 0xae, 0x55, 0x66, 0x9c, 0x8b, 0x80, 0x05, 0x96, 0xe0, 0x7e, 0x08, 0x9c,
+	0x1e, 0xf6, 0xc0, 0xaf, 0xd9, 0x41, 0x64, 0x1d, 0x0f, 0x84, 0xb0, 0xfd, 0xb2, 0x32, 0xaa, 0xc0,
+	0x5b, 0x8b, 0xc8, 0x9d, 0xad, 0x8a, 0xaa, 0x50, 0xcb, 0xb2, 0x5c, 0x45, 0xbb, 0x93, 0x92, 0x81,
+	0x6f, 0x30, 0x1b, 0x92, 0x14, 0xa6, 0xc7, 0x0d, 0x5b, 0xd4, 0x4c, 0x1b, 0x82, 0x9b, 0x10, 0x6f,
+	0x65, 0x98, 0xeb, 0xb6, 0xbd, 0x35, 0x3f, 0x00, 0xcf, 0xe2, 0x7e, 0xfc, 0xf1, 0x11, 0xd3, 0x81,
+	0xb2, 0x2d, 0x8c, 0xc8, 0x9c, 0x90, 0x26, 0x73, 0x5d, 0x43, 0x38, 0x8e, 0x88, 0x19, 0xcc, 0x46,
+	0xc9, 0x28, 0xeb, 0x46, 0xad, 0x52, 0x0e, 0x2c, 0x07, 0xfc, 0x80, 0x39, 0xd1, 0xb9, 0x85, 0xcf,
+	0x06, 0xf1, 0xe8, 0x52, 0x2d, 0x10, 0x17, 0x3d, 0xb1, 0x71, 0x51, 0xd1, 0x21, 0x53, 0x78, 0xa0,
+	0x62, 0x81, 0x6d, 0xfa, 0x14, 0xe5, 0xfb, 0x8a, 0x19, 0x2d, 0xb2, 0xc8, 0x1c, 0xee, 0xbb, 0x09,
+	0x0d, 0xda, 0x9b, 0x47, 0xc5, 0xa1, 0x85, 0x6c, 0xa9, 0x55, 0xb1, 0x52, 0x18, 0x77, 0x0e, 0x1a,
+	0xcb, 0xa9, 0xad, 0x07, 0xb3, 0x3d, 0x9a, 0x84, 0x91, 0x12, 0x9e, 0x30, 0xc1, 0xb5, 0x45, 0x43,
+	0x37, 0x6c, 0x0b, 0x78, 0xa0, 0x1b, 0xa2, 0xc6, 0x03, 0xda, 0x97, 0x47, 0xc5, 0x11, 0x6d, 0x3c,
+	0x74, 0xad, 0x28, 0xcf, 0x8a, 0x74, 0x90, 0xd7, 0xf0, 0x64, 0x84, 0xb7, 0x78, 0x00, 0xde, 0x06,
+	0xb3, 0xa3, 0x88, 0x94, 0x8c, 0x58, 0x4e, 0x7d, 0xd4, 0xa4, 0x48, 0x8b, 0x8e, 0x5c, 0x8d, 0x10,
+	0x61, 0xe4, 0x02, 0xee, 0xbf, 0x25, 0x38, 0xf8, 0xb4, 0x3f, 0xdf, 0x57, 0x1c, 0x5a, 0x20, 0x09,
+	0x66, 0xd7, 0x04, 0x07, 0xc9, 0x2b, 0xbd, 0xb3, 0x47, 0x53, 0xd2, 0xd0, 0x42, 0x28, 0x99, 0xc7,
+	0x59, 0xc7, 0xe2, 0x3a, 0x33, 0x02, 0x6b, 0x03, 0x74, 0x59, 0x54, 0xc6, 0x0d, 0xf0, 0xe9, 0x80,
+	0xa2, 0x47, 0x1c, 0x8b, 0x2f, 0x29, 0xd7, 0x6a, 0xec, 0x21, 0xff, 0xc7, 0x23, 0x0e, 0xdb, 0x4c,
+	0x40, 0x07, 0x15, 0x74, 0xd8, 0x61, 0x9b, 0x6d, 0xd0, 0x02, 0x9e, 0xac, 0xf1, 0x6e, 0x69, 0xa7,
+	0x15, 0x78, 0x22, 0x76, 0x26, 0x13, 0x7f, 0x15, 0x1f, 0x69, 0xc5, 0x74, 0xa4, 0x9e, 0x51, 0x51,
+	0xad, 0x23, 0xf7, 0xa7, 0xfd, 0x12, 0x1e, 0x35, 0xc1, 0x86, 0x00, 0x74, 0xd7, 0x03, 0x97, 0x79,
+	0x40, 0x71, 0x1e, 0x15, 0xd3, 0xcb, 0xa9, 0x2f, 0x65, 0xa5, 0x46, 0x42, 0xdf, 0xc5, 0xd0, 0x45,
+	0xde, 0xc4, 0x19, 0xdf, 0x58, 0x07, 0xb3, 0x66, 0x83, 0x4f, 0x87, 0x54, 0x9d, 0x8e, 0x25, 0xea,
+	0x14, 0x77, 0xc0, 0xa5, 0x08, 0x13, 0x5d, 0x64, 0x3b, 0x86, 0x2c, 0x62, 0x12, 0x1b, 0xba, 0x0d,
+	0xcc, 0xd4, 0x65, 0x23, 0xd1, 0xe1, 0x3c, 0x2a, 0xf6, 0x2d, 0x0f, 0xff, 0xf5, 0x60, 0x36, 0x7d,
+	0xa6, 0xe6, 0xa9, 0x41, 0xd1, 0xc6, 0x62, 0xdc, 0x79, 0x60, 0xe6, 0x65, 0xcb, 0x01, 0xf2, 0x16,
+	0x9e, 0x94, 0xc5, 0x8e, 0x4b, 0xa7, 0xdb, 0x56, 0x05, 0x54, 0xf8, 0x48, 0x97, 0xf0, 0x09, 0xc7,
+	0xe2, 0x71, 0x41, 0xcf, 0x47, 0x40, 0xf2, 0x3a, 0x1e, 0xf7, 0x20, 0xae, 0xab, 0x10, 0xb6, 0x29,
+	0xea, 0x9c, 0x8e, 0x76, 0xfb, 0x78, 0x0c, 0x5b, 0x89, 0x50, 0xe4, 0x18, 0xce, 0xc8, 0x7b, 0xab,
+	0xd8, 0xcc, 0xf5, 0xe9, 0xff, 0x54, 0x41, 0xd3, 0x0e, 0xdb, 0x3c, 0x2b, 0x6d, 0xf2, 0x32, 0x1e,
+	0x92, 0x0e, 0xbd, 0x6e, 0x71, 0x53, 0xd4, 0xe9, 0x58, 0x97, 0x13, 0xb1, 0x04, 0x5c, 0x55, 0xfe,
+	0x45, 0xe7, 0xcf, 0x27, 0x14, 0x3d, 0x7e, 0x42, 0xd1, 0x7b, 0x4d, 0x8a, 0x6e, 0x37, 0x29, 0xfa,
+	0xb4, 0x49, 0xd1, 0x9d, 0x5d, 0x3a, 0x72, 0x26, 0x59, 0xec, 0xbb, 0xbb, 0xf4, 0x05, 0xce, 0x1c,
+	0x38, 0x75, 0x0e, 0x1a, 0xa5, 0x0b, 0xcc, 0x81, 0x39, 0xe6, 0xba, 0xc2, 0xab, 0x2a, 0xfb, 0x6d,
+	0xaf, 0xca, 0xb8, 0x75, 0x4b, 0x9d, 0x7d, 0x6f, 0x8f, 0x8e, 0xdd, 0x84, 0xc6, 0xa9, 0xe4, 0xde,
+	0x4f, 0x7b, 0x74, 0x30, 0xea, 0xda, 0xc2, 0xcf, 0x08, 0x8f, 0x75, 0xde, 0x0c, 0x21, 0x38, 0x25,
+	0x0f, 0xa7, 0x28, 0x8f, 0x8a, 0x19, 0x4d, 0xad, 0xe5, 0x9e, 0xe1, 0x09, 0xae, 0x46, 0x33, 0xa3,
+	0xa9, 0x35, 0x29, 0xe2, 0xb4, 0x19, 0xe5, 0xa0, 0x86, 0xae, 0x33, 0xaf, 0x96, 0x97, 0x94, 0xe2,
+	0xf9, 0x49, 0x1d, 0x38, 0x3f, 0x61, 0x3b, 0xfc, 0xcb, 0xec, 0xf4, 0x1f, 0x34, 0x3b, 0x85, 0x6f,
+	0x11, 0x1e, 0x89, 0x13, 0x09, 0x9b, 0x77, 0x1e, 0x0f, 0x32, 0xd7, 0xd5, 0xa5, 0x9e, 0x20, 0xa5,
+	0x27, 0xe3, 0xc9, 0x6e, 0x74, 0xdd, 0xf6, 0x47, 0x07, 0x98, 0xb2, 0xc8, 0x49, 0x9c, 0x96, 0x9f,
+	0xd7, 0xdb, 0x12, 0x74, 0x30, 0xd1, 0xc1, 0x5b, 0xa1, 0x49, 0xb2, 0xb8, 0xbf, 0x2d, 0x3b, 0x29,
+	0x2d, 0x34, 0xc8, 0x2c, 0x1e, 0x72, 0x3d, 0x61, 0x80, 0xef, 0xeb, 0x5c, 0xd4, 0x95, 0xc0, 0xa4,
+	0x35, 0x1c, 0x6d, 0x5d, 0x10, 0xf5, 0xc2, 0xf7, 0xa8, 0x2d, 0x8a, 0x8a, 0xaf, 0x4f, 0x0a, 0x58,
+	0xca, 0xad, 0xce, 0x85, 0x09, 0x7a, 0xa2, 0xfe, 0x43, 0xa6, 0x03, 0x17, 0x84, 0x09, 0xf2, 0x9a,
+	0xc9, 0x69, 0x9c, 0x69, 0xc9, 0x6b, 0xc4, 0x71, 0xba, 0x14, 0x0a, 0x70, 0x29, 0x16, 0xe0, 0xd2,
+	0xe5, 0x18, 0x11, 0xcf, 0x58, 0x2b, 0x84, 0xcc, 0xe3, 0x01, 0x45, 0xd0, 0xa7, 0x7d, 0xea, 0x26,
+	0x68, 0x97, 0x09, 0x55, 0x74, 0xb4, 0x08, 0xb7, 0x98, 0xfe, 0xb1, 0x49, 0xd1, 0xe3, 0x26, 0xed,
+	0x29, 0x7c, 0xd2, 0x8b, 0xc9, 0x7e, 0x1d, 0x97, 0x25, 0x21, 0xa7, 0x43, 0xcd, 0x46, 0x4f, 0xd1,
+	0xec, 0xa9, 0x9d, 0x3d, 0xda, 0xf1, 0x04, 0xb4, 0x55, 0xfc, 0x8d, 0x67, 0xaa, 0xfa, 0x70, 0x2c,
+	0xaf, 0xfb, 0xaa, 0xbf, 0xf8, 0x01, 0xba, 0xbb, 0x4b, 0xdf, 0x7d, 0xa6, 0x51, 0x98, 0x93, 0x31,
+	0xa7, 0xa2, 0xf3, 0x42, 0xa4, 0xdc, 0x91, 0xd0, 0x78, 0x73, 0x1f, 0xbc, 0x02, 0x26, 0x78, 0x2c,
+	0x00, 0x33, 0x89, 0x38, 0x1b, 0x6f, 0x26, 0xa1, 0x85, 0xaf, 0x7b, 0xf1, 0x70, 0x9c, 0xde, 0x2a,
+	0xaf, 0x88, 0x03, 0xdf, 0xb7, 0x52, 0xf2, 0x7d, 0x9b, 0x4a, 0xa4, 0xb9, 0x12, 0x3d, 0xcc, 0x1d,
+	0x2f, 0xdc, 0x8b, 0x38, 0xc3, 0x45, 0x60, 0x55, 0x1a, 0xba, 0x65, 0x46, 0x23, 0x96, 0xb9, 0x73,
+	0xef, 0x68, 0x3f, 0x17, 0x86, 0xe3, 0x6a, 0xe9, 0xd0, 0xb7, 0x6a, 0x92, 0xab, 0x78, 0xdc, 0x61,
+	0x52, 0xd9, 0xb9, 0x52, 0x3f, 0x3f, 0x60, 0x01, 0xa8, 0xa6, 0x1b, 0x5d, 0x38, 0x51, 0x32, 0x2d,
+	0x3f, 0xf0, 0xac, 0x1b, 0xb5, 0x00, 0x4c, 0xdd, 0x61, 0x81, 0xb1, 0xae, 0x03, 0xaf, 0x5a, 0x1c,
+	0x4a, 0x6b, 0xed, 0x90, 0x4b, 0x32, 0x42, 0x1b, 0x73, 0x3a, 0x76, 0xc8, 0x0c, 0xce, 0x18, 0xc2,
+	0x71, 0xa5, 0xf4, 0x98, 0xea, 0xf1, 0xcb, 0x68, 0xed, 0x0d, 0x99, 0x26, 0x78, 0x9e, 0xf0, 0xe4,
+	0xa3, 0xa6, 0xd2, 0x0c, 0xad, 0xc5, 0x99, 0x4e, 0x11, 0x7b, 0xd8, 0xa4, 0xe8, 0xde, 0x1e, 0x4d,
+	0x71, 0xc1, 0x61, 0xe1, 0x87, 0x34, 0x1e, 0xdf, 0xdf, 0x0c, 0x4b, 0xae, 0x45, 0x7e, 0x41, 0x38,
+	0xbb, 0xe2, 0x01, 0x0b, 0xa0, 0xe3, 0x5f, 0xe1, 0x68, 0x97, 0x16, 0x0d, 0x5d, 0xd3, 0xc9, 0x89,
+	0xd6, 0xd4, 0x4f, 0x53, 0xe1, 0x43, 0xb4, 0xd3, 0xa4, 0xaf, 0x68, 0xe0, 0x8b, 0x9a, 0x67, 0xc0,
+	0x19, 0xd8, 0x00, 0x5b, 0xb8, 0xe0, 0x85, 0x01, 0x73, 0x52, 0x35, 0x04, 0x5f, 0x63, 0x9c, 0x55,
+	0x61, 0xae, 0xf3, 0xc6, 0xef, 0xef, 0x52, 0xb4, 0xbd, 0x4b, 0xc7, 0xd7, 0x2c, 0x2e, 0xe7, 0xcd,
+	0x9f, 0x5b, 0x63, 0x9b, 0x6a, 0xf1, 0xd5, 0x1e, 0x1d, 0xeb, 0x04, 0xbf, 0xff, 0xeb, 0x1f, 0x1f,
+	0xf7, 0x1e, 0x2b, 0x4c, 0x95, 0x0d, 0xc5, 0xb9, 0xbc, 0xff, 0x97, 0x6f, 0x11, 0x9d, 0x20, 0x9f,
+	0x23, 0x9c, 0x0d, 0x45, 0xfb, 0x50, 0x09, 0x5d, 0x7b, 0xee, 0x7c, 0x5a, 0x14, 0xc3, 0x77, 0xba,
+	0x0b, 0xc5, 0x2f, 0x10, 0xce, 0xbe, 0xe3, 0x9a, 0x87, 0xad, 0xf9, 0xf5, 0x43, 0x95, 0xbc, 0x45,
+	0xb3, 0xa6, 0x98, 0x74, 0xa7, 0x49, 0x2e, 0xad, 0x8b, 0xfa, 0xb3, 0x93, 0x3c, 0xd8, 0x55, 0xb8,
+	0xb2, 0xd3, 0xa4, 0x27, 0x9f, 0x4e, 0xf6, 0x8a, 0x05, 0xf5, 0xee, 0xd5, 0x3c, 0x5a, 0xc8, 0x96,
+	0xfd, 0x75, 0x51, 0xff, 0x27, 0xc9, 0x79, 0x44, 0xbe, 0x43, 0x78, 0x72, 0xc9, 0x34, 0xbb, 0x48,
+	0xe4, 0xf1, 0x03, 0xe9, 0x48, 0x77, 0xb7, 0x92, 0x1a, 0xcf, 0x5d, 0xd2, 0xad, 0x5d, 0x8a, 0x5a,
+	0x65, 0x9d, 0x29, 0x1c, 0x29, 0x33, 0xd3, 0xec, 0xa0, 0x2b, 0x65, 0x4f, 0xd6, 0xf5, 0x1b, 0x84,
+	0xa9, 0x06, 0x8e, 0xd8, 0x80, 0xff, 0x84, 0xf3, 0xf5, 0x43, 0x71, 0x6e, 0xb5, 0x81, 0xe7, 0x74,
+	0xa7, 0xbb, 0x3c, 0xb3, 0xf5, 0x7b, 0xae, 0x67, 0x6b, 0x3b, 0x87, 0xee, 0x6f, 0xe7, 0xd0, 0xc3,
+	0xed, 0x1c, 0xba, 0xfd, 0x28, 0xd7, 0x73, 0xff, 0x51, 0xae, 0xe7, 0xb7, 0x47, 0xb9, 0x9e, 0x1b,
+	0x03, 0x8a, 0xcb, 0xc9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x7d, 0x08, 0xf6, 0x7d, 0x0d,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -686,6 +764,47 @@ func (m *AutoProvPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FlapWindow != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.FlapWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxFlaps != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.MaxFlaps))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RedeployCooldown != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.RedeployCooldown))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinInstanceLifetime != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.MinInstanceLifetime))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ScheduleLeadTime != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.ScheduleLeadTime))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAutoprovpolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.DeletePrepare {
 		i--
 		if m.DeletePrepare {
@@ -762,6 +881,67 @@ func (m *AutoProvPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoProvSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoProvSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoProvSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinActiveInstances != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.MinActiveInstances))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAutoprovpolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Duration != 0 {
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAutoprovpolicy(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AutoProvCount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1076,6 +1256,40 @@ func (m *AutoProvPolicy) Matches(o *AutoProvPolicy, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.Schedules != nil {
+		if len(m.Schedules) == 0 && len(o.Schedules) > 0 || len(m.Schedules) > 0 && len(o.Schedules) == 0 {
+			return false
+		} else if m.Schedules != nil && o.Schedules != nil {
+			if !opts.Filter && len(m.Schedules) != len(o.Schedules) {
+				return false
+			}
+		}
+	}
+	if !opts.Filter || o.ScheduleLeadTime != 0 {
+		if o.ScheduleLeadTime != m.ScheduleLeadTime {
+			return false
+		}
+	}
+	if !opts.Filter || o.MinInstanceLifetime != 0 {
+		if o.MinInstanceLifetime != m.MinInstanceLifetime {
+			return false
+		}
+	}
+	if !opts.Filter || o.RedeployCooldown != 0 {
+		if o.RedeployCooldown != m.RedeployCooldown {
+			return false
+		}
+	}
+	if !opts.Filter || o.MaxFlaps != 0 {
+		if o.MaxFlaps != m.MaxFlaps {
+			return false
+		}
+	}
+	if !opts.Filter || o.FlapWindow != 0 {
+		if o.FlapWindow != m.FlapWindow {
+			return false
+		}
+	}
 	return true
 }
 
@@ -1093,6 +1307,20 @@ const AutoProvPolicyFieldMaxInstances = "7"
 const AutoProvPolicyFieldUndeployClientCount = "8"
 const AutoProvPolicyFieldUndeployIntervalCount = "9"
 const AutoProvPolicyFieldDeletePrepare = "10"
+const AutoProvPolicyFieldSchedules = "11"
+const AutoProvPolicyFieldSchedulesName = "11.1"
+const AutoProvPolicyFieldSchedulesCron = "11.2"
+const AutoProvPolicyFieldSchedulesDuration = "11.3"
+const AutoProvPolicyFieldSchedulesZones = "11.4"
+const AutoProvPolicyFieldSchedulesZonesOrganization = "11.4.1"
+const AutoProvPolicyFieldSchedulesZonesName = "11.4.2"
+const AutoProvPolicyFieldSchedulesZonesFederatedOrganization = "11.4.3"
+const AutoProvPolicyFieldSchedulesMinActiveInstances = "11.5"
+const AutoProvPolicyFieldScheduleLeadTime = "12"
+const AutoProvPolicyFieldMinInstanceLifetime = "13"
+const AutoProvPolicyFieldRedeployCooldown = "14"
+const AutoProvPolicyFieldMaxFlaps = "15"
+const AutoProvPolicyFieldFlapWindow = "16"
 
 var AutoProvPolicyAllFields = []string{
 	AutoProvPolicyFieldKeyOrganization,
@@ -1107,36 +1335,72 @@ var AutoProvPolicyAllFields = []string{
 	AutoProvPolicyFieldUndeployClientCount,
 	AutoProvPolicyFieldUndeployIntervalCount,
 	AutoProvPolicyFieldDeletePrepare,
+	AutoProvPolicyFieldSchedulesName,
+	AutoProvPolicyFieldSchedulesCron,
+	AutoProvPolicyFieldSchedulesDuration,
+	AutoProvPolicyFieldSchedulesZonesOrganization,
+	AutoProvPolicyFieldSchedulesZonesName,
+	AutoProvPolicyFieldSchedulesZonesFederatedOrganization,
+	AutoProvPolicyFieldSchedulesMinActiveInstances,
+	AutoProvPolicyFieldScheduleLeadTime,
+	AutoProvPolicyFieldMinInstanceLifetime,
+	AutoProvPolicyFieldRedeployCooldown,
+	AutoProvPolicyFieldMaxFlaps,
+	AutoProvPolicyFieldFlapWindow,
 }
 
 var AutoProvPolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
-	AutoProvPolicyFieldKeyOrganization:            struct{}{},
-	AutoProvPolicyFieldKeyName:                    struct{}{},
-	AutoProvPolicyFieldDeployClientCount:          struct{}{},
-	AutoProvPolicyFieldDeployIntervalCount:        struct{}{},
-	AutoProvPolicyFieldZonesOrganization:          struct{}{},
-	AutoProvPolicyFieldZonesName:                  struct{}{},
-	AutoProvPolicyFieldZonesFederatedOrganization: struct{}{},
-	AutoProvPolicyFieldMinActiveInstances:         struct{}{},
-	AutoProvPolicyFieldMaxInstances:               struct{}{},
-	AutoProvPolicyFieldUndeployClientCount:        struct{}{},
-	AutoProvPolicyFieldUndeployIntervalCount:      struct{}{},
-	AutoProvPolicyFieldDeletePrepare:              struct{}{},
+	AutoProvPolicyFieldKeyOrganization:                     struct{}{},
+	AutoProvPolicyFieldKeyName:                             struct{}{},
+	AutoProvPolicyFieldDeployClientCount:                   struct{}{},
+	AutoProvPolicyFieldDeployIntervalCount:                 struct{}{},
+	AutoProvPolicyFieldZonesOrganization:                   struct{}{},
+	AutoProvPolicyFieldZonesName:                           struct{}{},
+	AutoProvPolicyFieldZonesFederatedOrganization:          struct{}{},
+	AutoProvPolicyFieldMinActiveInstances:                  struct{}{},
+	AutoProvPolicyFieldMaxInstances:                        struct{}{},
+	AutoProvPolicyFieldUndeployClientCount:                 struct{}{},
+	AutoProvPolicyFieldUndeployIntervalCount:               struct{}{},
+	AutoProvPolicyFieldDeletePrepare:                       struct{}{},
+	AutoProvPolicyFieldSchedulesName:                       struct{}{},
+	AutoProvPolicyFieldSchedulesCron:                       struct{}{},
+	AutoProvPolicyFieldSchedulesDuration:                   struct{}{},
+	AutoProvPolicyFieldSchedulesZonesOrganization:          struct{}{},
+	AutoProvPolicyFieldSchedulesZonesName:                  struct{}{},
+	AutoProvPolicyFieldSchedulesZonesFederatedOrganization: struct{}{},
+	AutoProvPolicyFieldSchedulesMinActiveInstances:         struct{}{},
+	AutoProvPolicyFieldScheduleLeadTime:                    struct{}{},
+	AutoProvPolicyFieldMinInstanceLifetime:                 struct{}{},
+	AutoProvPolicyFieldRedeployCooldown:                    struct{}{},
+	AutoProvPolicyFieldMaxFlaps:                            struct{}{},
+	AutoProvPolicyFieldFlapWindow:                          struct{}{},
 })
 
 var AutoProvPolicyAllFieldsStringMap = map[string]string{
-	AutoProvPolicyFieldKeyOrganization:            "Key Organization",
-	AutoProvPolicyFieldKeyName:                    "Key Name",
-	AutoProvPolicyFieldDeployClientCount:          "Deploy Client Count",
-	AutoProvPolicyFieldDeployIntervalCount:        "Deploy Interval Count",
-	AutoProvPolicyFieldZonesOrganization:          "Zones Organization",
-	AutoProvPolicyFieldZonesName:                  "Zones Name",
-	AutoProvPolicyFieldZonesFederatedOrganization: "Zones Federated Organization",
-	AutoProvPolicyFieldMinActiveInstances:         "Min Active Instances",
-	AutoProvPolicyFieldMaxInstances:               "Max Instances",
-	AutoProvPolicyFieldUndeployClientCount:        "Undeploy Client Count",
-	AutoProvPolicyFieldUndeployIntervalCount:      "Undeploy Interval Count",
-	AutoProvPolicyFieldDeletePrepare:              "Delete Prepare",
+	AutoProvPolicyFieldKeyOrganization:                     "Key Organization",
+	AutoProvPolicyFieldKeyName:                             "Key Name",
+	AutoProvPolicyFieldDeployClientCount:                   "Deploy Client Count",
+	AutoProvPolicyFieldDeployIntervalCount:                 "Deploy Interval Count",
+	AutoProvPolicyFieldZonesOrganization:                   "Zones Organization",
+	AutoProvPolicyFieldZonesName:                           "Zones Name",
+	AutoProvPolicyFieldZonesFederatedOrganization:          "Zones Federated Organization",
+	AutoProvPolicyFieldMinActiveInstances:                  "Min Active Instances",
+	AutoProvPolicyFieldMaxInstances:                        "Max Instances",
+	AutoProvPolicyFieldUndeployClientCount:                 "Undeploy Client Count",
+	AutoProvPolicyFieldUndeployIntervalCount:               "Undeploy Interval Count",
+	AutoProvPolicyFieldDeletePrepare:                       "Delete Prepare",
+	AutoProvPolicyFieldSchedulesName:                       "Schedules Name",
+	AutoProvPolicyFieldSchedulesCron:                       "Schedules Cron",
+	AutoProvPolicyFieldSchedulesDuration:                   "Schedules Duration",
+	AutoProvPolicyFieldSchedulesZonesOrganization:          "Schedules Zones Organization",
+	AutoProvPolicyFieldSchedulesZonesName:                  "Schedules Zones Name",
+	AutoProvPolicyFieldSchedulesZonesFederatedOrganization: "Schedules Zones Federated Organization",
+	AutoProvPolicyFieldSchedulesMinActiveInstances:         "Schedules Min Active Instances",
+	AutoProvPolicyFieldScheduleLeadTime:                    "Schedule Lead Time",
+	AutoProvPolicyFieldMinInstanceLifetime:                 "Min Instance Lifetime",
+	AutoProvPolicyFieldRedeployCooldown:                    "Redeploy Cooldown",
+	AutoProvPolicyFieldMaxFlaps:                            "Max Flaps",
+	AutoProvPolicyFieldFlapWindow:                          "Flap Window",
 }
 
 func (m *AutoProvPolicy) IsKeyField(s string) bool {
@@ -1195,6 +1459,65 @@ func (m *AutoProvPolicy) DiffFields(o *AutoProvPolicy, fields *FieldMap) {
 	if m.DeletePrepare != o.DeletePrepare {
 		fields.Set(AutoProvPolicyFieldDeletePrepare)
 	}
+	if len(m.Schedules) != len(o.Schedules) {
+		fields.Set(AutoProvPolicyFieldSchedules)
+	} else {
+		for i0 := 0; i0 < len(m.Schedules); i0++ {
+			if m.Schedules[i0].Name != o.Schedules[i0].Name {
+				fields.Set(AutoProvPolicyFieldSchedulesName)
+				fields.Set(AutoProvPolicyFieldSchedules)
+			}
+			if m.Schedules[i0].Cron != o.Schedules[i0].Cron {
+				fields.Set(AutoProvPolicyFieldSchedulesCron)
+				fields.Set(AutoProvPolicyFieldSchedules)
+			}
+			if m.Schedules[i0].Duration != o.Schedules[i0].Duration {
+				fields.Set(AutoProvPolicyFieldSchedulesDuration)
+				fields.Set(AutoProvPolicyFieldSchedules)
+			}
+			if len(m.Schedules[i0].Zones) != len(o.Schedules[i0].Zones) {
+				fields.Set(AutoProvPolicyFieldSchedulesZones)
+				fields.Set(AutoProvPolicyFieldSchedules)
+			} else {
+				for i1 := 0; i1 < len(m.Schedules[i0].Zones); i1++ {
+					if m.Schedules[i0].Zones[i1].Organization != o.Schedules[i0].Zones[i1].Organization {
+						fields.Set(AutoProvPolicyFieldSchedulesZonesOrganization)
+						fields.Set(AutoProvPolicyFieldSchedulesZones)
+						fields.Set(AutoProvPolicyFieldSchedules)
+					}
+					if m.Schedules[i0].Zones[i1].Name != o.Schedules[i0].Zones[i1].Name {
+						fields.Set(AutoProvPolicyFieldSchedulesZonesName)
+						fields.Set(AutoProvPolicyFieldSchedulesZones)
+						fields.Set(AutoProvPolicyFieldSchedules)
+					}
+					if m.Schedules[i0].Zones[i1].FederatedOrganization != o.Schedules[i0].Zones[i1].FederatedOrganization {
+						fields.Set(AutoProvPolicyFieldSchedulesZonesFederatedOrganization)
+						fields.Set(AutoProvPolicyFieldSchedulesZones)
+						fields.Set(AutoProvPolicyFieldSchedules)
+					}
+				}
+			}
+			if m.Schedules[i0].MinActiveInstances != o.Schedules[i0].MinActiveInstances {
+				fields.Set(AutoProvPolicyFieldSchedulesMinActiveInstances)
+				fields.Set(AutoProvPolicyFieldSchedules)
+			}
+		}
+	}
+	if m.ScheduleLeadTime != o.ScheduleLeadTime {
+		fields.Set(AutoProvPolicyFieldScheduleLeadTime)
+	}
+	if m.MinInstanceLifetime != o.MinInstanceLifetime {
+		fields.Set(AutoProvPolicyFieldMinInstanceLifetime)
+	}
+	if m.RedeployCooldown != o.RedeployCooldown {
+		fields.Set(AutoProvPolicyFieldRedeployCooldown)
+	}
+	if m.MaxFlaps != o.MaxFlaps {
+		fields.Set(AutoProvPolicyFieldMaxFlaps)
+	}
+	if m.FlapWindow != o.FlapWindow {
+		fields.Set(AutoProvPolicyFieldFlapWindow)
+	}
 }
 
 func (m *AutoProvPolicy) GetDiffFields(o *AutoProvPolicy) *FieldMap {
@@ -1204,16 +1527,30 @@ func (m *AutoProvPolicy) GetDiffFields(o *AutoProvPolicy) *FieldMap {
 }
 
 var UpdateAutoProvPolicyFieldsMap = NewFieldMap(map[string]struct{}{
-	AutoProvPolicyFieldDeployClientCount:          struct{}{},
-	AutoProvPolicyFieldDeployIntervalCount:        struct{}{},
-	AutoProvPolicyFieldZones:                      struct{}{},
-	AutoProvPolicyFieldZonesOrganization:          struct{}{},
-	AutoProvPolicyFieldZonesName:                  struct{}{},
-	AutoProvPolicyFieldZonesFederatedOrganization: struct{}{},
-	AutoProvPolicyFieldMinActiveInstances:         struct{}{},
-	AutoProvPolicyFieldMaxInstances:               struct{}{},
-	AutoProvPolicyFieldUndeployClientCount:        struct{}{},
-	AutoProvPolicyFieldUndeployIntervalCount:      struct{}{},
+	AutoProvPolicyFieldDeployClientCount:                   struct{}{},
+	AutoProvPolicyFieldDeployIntervalCount:                 struct{}{},
+	AutoProvPolicyFieldZones:                               struct{}{},
+	AutoProvPolicyFieldZonesOrganization:                   struct{}{},
+	AutoProvPolicyFieldZonesName:                           struct{}{},
+	AutoProvPolicyFieldZonesFederatedOrganization:          struct{}{},
+	AutoProvPolicyFieldMinActiveInstances:                  struct{}{},
+	AutoProvPolicyFieldMaxInstances:                        struct{}{},
+	AutoProvPolicyFieldUndeployClientCount:                 struct{}{},
+	AutoProvPolicyFieldUndeployIntervalCount:               struct{}{},
+	AutoProvPolicyFieldSchedules:                           struct{}{},
+	AutoProvPolicyFieldSchedulesName:                       struct{}{},
+	AutoProvPolicyFieldSchedulesCron:                       struct{}{},
+	AutoProvPolicyFieldSchedulesDuration:                   struct{}{},
+	AutoProvPolicyFieldSchedulesZones:                      struct{}{},
+	AutoProvPolicyFieldSchedulesZonesOrganization:          struct{}{},
+	AutoProvPolicyFieldSchedulesZonesName:                  struct{}{},
+	AutoProvPolicyFieldSchedulesZonesFederatedOrganization: struct{}{},
+	AutoProvPolicyFieldSchedulesMinActiveInstances:         struct{}{},
+	AutoProvPolicyFieldScheduleLeadTime:                    struct{}{},
+	AutoProvPolicyFieldMinInstanceLifetime:                 struct{}{},
+	AutoProvPolicyFieldRedeployCooldown:                    struct{}{},
+	AutoProvPolicyFieldMaxFlaps:                            struct{}{},
+	AutoProvPolicyFieldFlapWindow:                          struct{}{},
 })
 
 func (m *AutoProvPolicy) ValidateUpdateFields() error {
@@ -1280,6 +1617,37 @@ func (m *AutoProvPolicy) RemoveZones(vals ...*ZoneKey) int {
 	return changes
 }
 
+func (m *AutoProvPolicy) AddSchedules(vals ...AutoProvSchedule) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Schedules {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.Schedules = append(m.Schedules, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *AutoProvPolicy) RemoveSchedules(vals ...AutoProvSchedule) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.Schedules); i >= 0; i-- {
+		if _, found := remove[m.Schedules[i].String()]; found {
+			m.Schedules = append(m.Schedules[:i], m.Schedules[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
 func (m *AutoProvPolicy) CopyInFields(src *AutoProvPolicy) int {
 	updateListAction := "replace"
 	changed := 0
@@ -1358,6 +1726,54 @@ func (m *AutoProvPolicy) CopyInFields(src *AutoProvPolicy) int {
 			changed++
 		}
 	}
+	if fmap.HasOrHasChild("11") {
+		if src.Schedules != nil {
+			if updateListAction == "add" {
+				changed += m.AddSchedules(src.Schedules...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveSchedules(src.Schedules...)
+			} else {
+				m.Schedules = make([]AutoProvSchedule, 0)
+				for k0, _ := range src.Schedules {
+					m.Schedules = append(m.Schedules, *src.Schedules[k0].Clone())
+				}
+				changed++
+			}
+		} else if m.Schedules != nil {
+			m.Schedules = nil
+			changed++
+		}
+	}
+	if fmap.Has("12") {
+		if m.ScheduleLeadTime != src.ScheduleLeadTime {
+			m.ScheduleLeadTime = src.ScheduleLeadTime
+			changed++
+		}
+	}
+	if fmap.Has("13") {
+		if m.MinInstanceLifetime != src.MinInstanceLifetime {
+			m.MinInstanceLifetime = src.MinInstanceLifetime
+			changed++
+		}
+	}
+	if fmap.Has("14") {
+		if m.RedeployCooldown != src.RedeployCooldown {
+			m.RedeployCooldown = src.RedeployCooldown
+			changed++
+		}
+	}
+	if fmap.Has("15") {
+		if m.MaxFlaps != src.MaxFlaps {
+			m.MaxFlaps = src.MaxFlaps
+			changed++
+		}
+	}
+	if fmap.Has("16") {
+		if m.FlapWindow != src.FlapWindow {
+			m.FlapWindow = src.FlapWindow
+			changed++
+		}
+	}
 	return changed
 }
 
@@ -1380,6 +1796,19 @@ func (m *AutoProvPolicy) DeepCopyIn(src *AutoProvPolicy) {
 	m.UndeployClientCount = src.UndeployClientCount
 	m.UndeployIntervalCount = src.UndeployIntervalCount
 	m.DeletePrepare = src.DeletePrepare
+	if src.Schedules != nil {
+		m.Schedules = make([]AutoProvSchedule, len(src.Schedules), len(src.Schedules))
+		for ii, s := range src.Schedules {
+			m.Schedules[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Schedules = nil
+	}
+	m.ScheduleLeadTime = src.ScheduleLeadTime
+	m.MinInstanceLifetime = src.MinInstanceLifetime
+	m.RedeployCooldown = src.RedeployCooldown
+	m.MaxFlaps = src.MaxFlaps
+	m.FlapWindow = src.FlapWindow
 }
 
 func (s *AutoProvPolicy) HasFields() bool {
@@ -2159,6 +2588,11 @@ func (m *AutoProvPolicy) ValidateEnums() error {
 			return err
 		}
 	}
+	for _, e := range m.Schedules {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -2169,56 +2603,169 @@ func (s *AutoProvPolicy) ClearTagged(tags map[string]struct{}) {
 			s.Zones[ii].ClearTagged(tags)
 		}
 	}
+	if s.Schedules != nil {
+		for ii := 0; ii < len(s.Schedules); ii++ {
+			s.Schedules[ii].ClearTagged(tags)
+		}
+	}
 }
 
-func (m *AutoProvCount) Clone() *AutoProvCount {
-	cp := &AutoProvCount{}
+func (m *AutoProvSchedule) Clone() *AutoProvSchedule {
+	cp := &AutoProvSchedule{}
 	cp.DeepCopyIn(m)
 	return cp
 }
 
-func (m *AutoProvCount) CopyInFields(src *AutoProvCount) int {
-	changed := 0
-	if m.AppKey.Organization != src.AppKey.Organization {
-		m.AppKey.Organization = src.AppKey.Organization
-		changed++
+func (m *AutoProvSchedule) AddZones(vals ...ZoneKey) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.Zones {
+		cur[v.GetKeyString()] = struct{}{}
 	}
-	if m.AppKey.Name != src.AppKey.Name {
-		m.AppKey.Name = src.AppKey.Name
-		changed++
+	for _, v := range vals {
+		if _, found := cur[v.GetKeyString()]; found {
+			continue // duplicate
+		}
+		m.Zones = append(m.Zones, v)
+		changes++
 	}
-	if m.AppKey.Version != src.AppKey.Version {
-		m.AppKey.Version = src.AppKey.Version
-		changed++
+	return changes
+}
+
+func (m *AutoProvSchedule) RemoveZones(vals ...ZoneKey) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.GetKeyString()] = struct{}{}
 	}
-	if m.ZoneKey.Organization != src.ZoneKey.Organization {
-		m.ZoneKey.Organization = src.ZoneKey.Organization
+	for i := len(m.Zones); i >= 0; i-- {
+		if _, found := remove[m.Zones[i].GetKeyString()]; found {
+			m.Zones = append(m.Zones[:i], m.Zones[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
+func (m *AutoProvSchedule) CopyInFields(src *AutoProvSchedule) int {
+	updateListAction := "replace"
+	changed := 0
+	if m.Name != src.Name {
+		m.Name = src.Name
 		changed++
 	}
-	if m.ZoneKey.Name != src.ZoneKey.Name {
-		m.ZoneKey.Name = src.ZoneKey.Name
+	if m.Cron != src.Cron {
+		m.Cron = src.Cron
 		changed++
 	}
-	if m.ZoneKey.FederatedOrganization != src.ZoneKey.FederatedOrganization {
-		m.ZoneKey.FederatedOrganization = src.ZoneKey.FederatedOrganization
+	if m.Duration != src.Duration {
+		m.Duration = src.Duration
 		changed++
 	}
-	if m.Count != src.Count {
-		m.Count = src.Count
+	if src.Zones != nil {
+		if updateListAction == "add" {
+			changed += m.AddZones(src.Zones...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveZones(src.Zones...)
+		} else {
+			m.Zones = make([]ZoneKey, 0)
+			for k0, _ := range src.Zones {
+				m.Zones = append(m.Zones, *src.Zones[k0].Clone())
+			}
+			changed++
+		}
+	} else if m.Zones != nil {
+		m.Zones = nil
 		changed++
 	}
-	if m.ProcessNow != src.ProcessNow {
-		m.ProcessNow = src.ProcessNow
+	if m.MinActiveInstances != src.MinActiveInstances {
+		m.MinActiveInstances = src.MinActiveInstances
 		changed++
 	}
 	return changed
 }
 
-func (m *AutoProvCount) DeepCopyIn(src *AutoProvCount) {
-	m.AppKey.DeepCopyIn(&src.AppKey)
-	m.ZoneKey.DeepCopyIn(&src.ZoneKey)
-	m.Count = src.Count
-	m.ProcessNow = src.ProcessNow
+func (m *AutoProvSchedule) DeepCopyIn(src *AutoProvSchedule) {
+	m.Name = src.Name
+	m.Cron = src.Cron
+	m.Duration = src.Duration
+	if src.Zones != nil {
+		m.Zones = make([]ZoneKey, len(src.Zones), len(src.Zones))
+		for ii, s := range src.Zones {
+			m.Zones[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.Zones = nil
+	}
+	m.MinActiveInstances = src.MinActiveInstances
+}
+
+// Helper method to check that enums have valid values
+func (m *AutoProvSchedule) ValidateEnums() error {
+	for _, e := range m.Zones {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AutoProvSchedule) ClearTagged(tags map[string]struct{}) {
+	if s.Zones != nil {
+		for ii := 0; ii < len(s.Zones); ii++ {
+			s.Zones[ii].ClearTagged(tags)
+		}
+	}
+}
+
+func (m *AutoProvCount) Clone() *AutoProvCount {
+	cp := &AutoProvCount{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *AutoProvCount) CopyInFields(src *AutoProvCount) int {
+	changed := 0
+	if m.AppKey.Organization != src.AppKey.Organization {
+		m.AppKey.Organization = src.AppKey.Organization
+		changed++
+	}
+	if m.AppKey.Name != src.AppKey.Name {
+		m.AppKey.Name = src.AppKey.Name
+		changed++
+	}
+	if m.AppKey.Version != src.AppKey.Version {
+		m.AppKey.Version = src.AppKey.Version
+		changed++
+	}
+	if m.ZoneKey.Organization != src.ZoneKey.Organization {
+		m.ZoneKey.Organization = src.ZoneKey.Organization
+		changed++
+	}
+	if m.ZoneKey.Name != src.ZoneKey.Name {
+		m.ZoneKey.Name = src.ZoneKey.Name
+		changed++
+	}
+	if m.ZoneKey.FederatedOrganization != src.ZoneKey.FederatedOrganization {
+		m.ZoneKey.FederatedOrganization = src.ZoneKey.FederatedOrganization
+		changed++
+	}
+	if m.Count != src.Count {
+		m.Count = src.Count
+		changed++
+	}
+	if m.ProcessNow != src.ProcessNow {
+		m.ProcessNow = src.ProcessNow
+		changed++
+	}
+	return changed
+}
+
+func (m *AutoProvCount) DeepCopyIn(src *AutoProvCount) {
+	m.AppKey.DeepCopyIn(&src.AppKey)
+	m.ZoneKey.DeepCopyIn(&src.ZoneKey)
+	m.Count = src.Count
+	m.ProcessNow = src.ProcessNow
 }
 
 // Helper method to check that enums have valid values
@@ -3523,6 +4070,56 @@ func (m *AutoProvPolicy) Size() (n int) {
 	if m.DeletePrepare {
 		n += 2
 	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovAutoprovpolicy(uint64(l))
+		}
+	}
+	if m.ScheduleLeadTime != 0 {
+		n += 1 + sovAutoprovpolicy(uint64(m.ScheduleLeadTime))
+	}
+	if m.MinInstanceLifetime != 0 {
+		n += 1 + sovAutoprovpolicy(uint64(m.MinInstanceLifetime))
+	}
+	if m.RedeployCooldown != 0 {
+		n += 1 + sovAutoprovpolicy(uint64(m.RedeployCooldown))
+	}
+	if m.MaxFlaps != 0 {
+		n += 1 + sovAutoprovpolicy(uint64(m.MaxFlaps))
+	}
+	if m.FlapWindow != 0 {
+		n += 2 + sovAutoprovpolicy(uint64(m.FlapWindow))
+	}
+	return n
+}
+
+func (m *AutoProvSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAutoprovpolicy(uint64(l))
+	}
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovAutoprovpolicy(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovAutoprovpolicy(uint64(m.Duration))
+	}
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovAutoprovpolicy(uint64(l))
+		}
+	}
+	if m.MinActiveInstances != 0 {
+		n += 1 + sovAutoprovpolicy(uint64(m.MinActiveInstances))
+	}
 	return n
 }
 
@@ -3882,6 +4479,321 @@ func (m *AutoProvPolicy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DeletePrepare = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, AutoProvSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleLeadTime", wireType)
+			}
+			m.ScheduleLeadTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleLeadTime |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInstanceLifetime", wireType)
+			}
+			m.MinInstanceLifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinInstanceLifetime |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeployCooldown", wireType)
+			}
+			m.RedeployCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedeployCooldown |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlaps", wireType)
+			}
+			m.MaxFlaps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlaps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlapWindow", wireType)
+			}
+			m.FlapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlapWindow |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoprovpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoProvSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoprovpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoProvSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoProvSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoprovpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, ZoneKey{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinActiveInstances", wireType)
+			}
+			m.MinActiveInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoprovpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinActiveInstances |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAutoprovpolicy(dAtA[iNdEx:])
@@ -2101,6 +2101,18 @@ func (m *CloudletExecReq) CopyInFields(src *CloudletExecReq) int {
 				m.ExecReq.Log.Follow = src.ExecReq.Log.Follow
 				changed++
 			}
+			if m.ExecReq.Log.AllContainers != src.ExecReq.Log.AllContainers {
+				m.ExecReq.Log.AllContainers = src.ExecReq.Log.AllContainers
+				changed++
+			}
+			if m.ExecReq.Log.Filter != src.ExecReq.Log.Filter {
+				m.ExecReq.Log.Filter = src.ExecReq.Log.Filter
+				changed++
+			}
+			if m.ExecReq.Log.FilterRegex != src.ExecReq.Log.FilterRegex {
+				m.ExecReq.Log.FilterRegex = src.ExecReq.Log.FilterRegex
+				changed++
+			}
 		} else if m.ExecReq.Log != nil {
 			m.ExecReq.Log = nil
 			changed++
@@ -2145,6 +2157,46 @@ func (m *CloudletExecReq) CopyInFields(src *CloudletExecReq) int {
 			m.ExecReq.CloudletKey.FederatedOrganization = src.ExecReq.CloudletKey.FederatedOrganization
 			changed++
 		}
+		if src.ExecReq.CopyFile != nil {
+			if m.ExecReq.CopyFile == nil {
+				m.ExecReq.CopyFile = &CopyFile{}
+			}
+			if m.ExecReq.CopyFile.Path != src.ExecReq.CopyFile.Path {
+				m.ExecReq.CopyFile.Path = src.ExecReq.CopyFile.Path
+				changed++
+			}
+			if m.ExecReq.CopyFile.Upload != src.ExecReq.CopyFile.Upload {
+				m.ExecReq.CopyFile.Upload = src.ExecReq.CopyFile.Upload
+				changed++
+			}
+			if m.ExecReq.CopyFile.FileSize != src.ExecReq.CopyFile.FileSize {
+				m.ExecReq.CopyFile.FileSize = src.ExecReq.CopyFile.FileSize
+				changed++
+			}
+		} else if m.ExecReq.CopyFile != nil {
+			m.ExecReq.CopyFile = nil
+			changed++
+		}
+		if src.ExecReq.PortForward != nil {
+			if m.ExecReq.PortForward == nil {
+				m.ExecReq.PortForward = &PortForward{}
+			}
+			if m.ExecReq.PortForward.Port != src.ExecReq.PortForward.Port {
+				m.ExecReq.PortForward.Port = src.ExecReq.PortForward.Port
+				changed++
+			}
+			if m.ExecReq.PortForward.Ttl != src.ExecReq.PortForward.Ttl {
+				m.ExecReq.PortForward.Ttl = src.ExecReq.PortForward.Ttl
+				changed++
+			}
+			if m.ExecReq.PortForward.User != src.ExecReq.PortForward.User {
+				m.ExecReq.PortForward.User = src.ExecReq.PortForward.User
+				changed++
+			}
+		} else if m.ExecReq.PortForward != nil {
+			m.ExecReq.PortForward = nil
+			changed++
+		}
 	} else if m.ExecReq != nil {
 		m.ExecReq = nil
 		changed++
@@ -1656,429 +1656,431 @@ func init() {
 func init() { proto.RegisterFile("cloudlet.proto", fileDescriptor_3aea31a648a25d86) }
 
 var fileDescriptor_3aea31a648a25d86 = []byte{
-	// 6748 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x5b, 0x6c, 0x1c, 0xd7,
-	0x75, 0x1a, 0x92, 0xa2, 0x76, 0xcf, 0xf2, 0xb1, 0x1c, 0x3e, 0x34, 0xa4, 0x48, 0x8a, 0x1a, 0xbf,
-	0x64, 0x79, 0x4d, 0xc6, 0x94, 0xe5, 0xc8, 0x8c, 0x65, 0x9b, 0x0f, 0x49, 0xa6, 0x25, 0x8a, 0xf4,
-	0x2c, 0x25, 0x35, 0x2e, 0xda, 0xc1, 0x70, 0xe6, 0xee, 0x72, 0xc2, 0xd9, 0x99, 0xf1, 0xbd, 0xb3,
-	0x2b, 0xaf, 0xbf, 0x92, 0xfc, 0xb4, 0x45, 0x81, 0x20, 0x75, 0xd2, 0x26, 0x75, 0x0b, 0xc4, 0x4d,
-	0x63, 0x24, 0x28, 0x5a, 0x20, 0x30, 0xfa, 0x93, 0xc7, 0x4f, 0xfb, 0x53, 0xa3, 0x45, 0x0b, 0x07,
-	0x2d, 0xd0, 0xc0, 0x40, 0x93, 0xd4, 0xee, 0x47, 0xcb, 0x7e, 0xb4, 0x40, 0x48, 0x39, 0xc8, 0x57,
-	0x71, 0x1f, 0xf3, 0xda, 0x9d, 0xa5, 0x44, 0x5a, 0x69, 0xfe, 0x76, 0xce, 0x3d, 0xf7, 0xcc, 0xb9,
-	0xe7, 0x9e, 0x7b, 0x9e, 0x77, 0x16, 0x06, 0x4c, 0xc7, 0xab, 0x5b, 0x0e, 0x0a, 0x66, 0x7d, 0xec,
-	0x05, 0x9e, 0x9c, 0x47, 0x56, 0x15, 0xb1, 0x9f, 0x13, 0x93, 0x55, 0xcf, 0xab, 0x3a, 0x68, 0xce,
-	0xf0, 0xed, 0x39, 0xc3, 0x75, 0xbd, 0xc0, 0x08, 0x6c, 0xcf, 0x25, 0x1c, 0x71, 0x62, 0x2a, 0xf0,
-	0x3c, 0x87, 0xcc, 0xb1, 0x87, 0x2a, 0x72, 0xa3, 0x1f, 0x62, 0x78, 0x28, 0xa4, 0xbb, 0x83, 0x9a,
-	0x02, 0xd4, 0x57, 0x71, 0x8c, 0x86, 0x87, 0xc3, 0x27, 0x8c, 0x48, 0xdd, 0x09, 0x42, 0x74, 0x8c,
-	0x48, 0x60, 0x54, 0x03, 0x63, 0xcb, 0x41, 0x21, 0x82, 0xe9, 0xd5, 0x6a, 0x5e, 0x48, 0x6f, 0xc4,
-	0x76, 0x2b, 0xd8, 0xc0, 0x88, 0x78, 0x75, 0x6c, 0xa2, 0x90, 0x89, 0xbc, 0x87, 0xab, 0xe2, 0x67,
-	0xbf, 0x55, 0x43, 0x73, 0x8e, 0x67, 0x86, 0xf8, 0x55, 0xaf, 0xea, 0xb1, 0x9f, 0x73, 0xf4, 0x97,
-	0x80, 0x0e, 0x53, 0x24, 0xc3, 0xf7, 0x53, 0xa4, 0x07, 0x5b, 0xa8, 0xaa, 0xff, 0xd0, 0x0d, 0xc3,
-	0xeb, 0x3e, 0xc2, 0x6c, 0xbd, 0x9b, 0x76, 0x0d, 0x5d, 0xb7, 0x6b, 0x76, 0x40, 0xe4, 0x6b, 0x70,
-	0xca, 0xc4, 0xc8, 0x08, 0x90, 0x6e, 0x3a, 0x75, 0x12, 0x20, 0xac, 0xdb, 0x2e, 0x09, 0xf4, 0xc0,
-	0xae, 0x21, 0xaf, 0x1e, 0x28, 0xd2, 0x8c, 0x74, 0xb6, 0x7b, 0xa9, 0xef, 0x97, 0x3f, 0x39, 0x9d,
-	0x5b, 0xa9, 0xf3, 0xc9, 0x9a, 0xc2, 0x27, 0x2c, 0x73, 0xfc, 0x55, 0x97, 0x04, 0x9b, 0x1c, 0x9b,
-	0x12, 0xab, 0xfb, 0x56, 0x47, 0x62, 0x5d, 0x59, 0xc4, 0xf8, 0x84, 0x6c, 0x62, 0x16, 0x72, 0x50,
-	0x27, 0x62, 0xdd, 0x59, 0xc4, 0xf8, 0x84, 0x0c, 0x62, 0xcb, 0x70, 0x52, 0x2c, 0xd3, 0xf0, 0xfd,
-	0x34, 0xa1, 0x9e, 0x0c, 0x42, 0x23, 0x1c, 0x79, 0xd1, 0xf7, 0x5b, 0x88, 0x88, 0xe5, 0xb5, 0x11,
-	0x39, 0x9e, 0x45, 0x84, 0x23, 0xb7, 0x13, 0x11, 0xcb, 0x6a, 0x23, 0xd2, 0x9b, 0x45, 0x84, 0x23,
-	0xa7, 0x89, 0xa8, 0xbf, 0x90, 0xa0, 0xb8, 0x2c, 0x94, 0x71, 0xd5, 0x0d, 0x10, 0x76, 0x0d, 0x47,
-	0x1e, 0x83, 0xde, 0x8a, 0x8d, 0x1c, 0x8b, 0x28, 0xd2, 0x4c, 0xf7, 0xd9, 0xbc, 0x26, 0x9e, 0xe4,
-	0x59, 0xe8, 0xde, 0x41, 0x4d, 0x26, 0xfd, 0xc2, 0xfc, 0xd8, 0x6c, 0x74, 0x18, 0x66, 0x43, 0x0a,
-	0xd7, 0x50, 0x73, 0xa9, 0xe7, 0xbd, 0x9f, 0x9c, 0x3e, 0xa6, 0x51, 0x44, 0xf9, 0x39, 0x38, 0xee,
-	0x63, 0xcf, 0x27, 0x4a, 0xf7, 0x4c, 0xf7, 0xd9, 0xc2, 0xfc, 0xa3, 0x19, 0x33, 0xc2, 0x77, 0xce,
-	0x6e, 0x50, 0xc4, 0xcb, 0x6e, 0x80, 0x9b, 0x1a, 0x9f, 0x34, 0x71, 0x11, 0x20, 0x06, 0xca, 0x45,
-	0xfe, 0x6e, 0xaa, 0x46, 0x79, 0x4e, 0x7d, 0x04, 0x8e, 0x37, 0x0c, 0xa7, 0x8e, 0x18, 0x3f, 0x79,
-	0x8d, 0x3f, 0x2c, 0x74, 0x5d, 0x94, 0x16, 0x1e, 0xfe, 0xcf, 0x9f, 0x2b, 0xd2, 0xff, 0xfe, 0x5c,
-	0x91, 0x3e, 0xbf, 0xa7, 0x48, 0x5f, 0xde, 0x53, 0xa4, 0x77, 0xef, 0x2a, 0xc5, 0x1d, 0xd4, 0xbc,
-	0xb4, 0x8e, 0xab, 0x86, 0x6b, 0xbf, 0xc1, 0x04, 0xa2, 0x7e, 0x21, 0x0f, 0x03, 0x1b, 0x8e, 0x11,
-	0x54, 0x3c, 0x5c, 0x5b, 0xf6, 0xdc, 0x8a, 0x5d, 0x95, 0x9f, 0x81, 0x93, 0xa6, 0xe7, 0x06, 0x86,
-	0xed, 0x22, 0xac, 0x63, 0x54, 0xb5, 0x49, 0x80, 0x9b, 0xba, 0x6f, 0x04, 0xdb, 0xe2, 0xc5, 0xa3,
-	0xd1, 0xb0, 0x26, 0x46, 0x37, 0x8c, 0x60, 0x5b, 0x3e, 0x0f, 0x63, 0xe1, 0x89, 0xd6, 0x1b, 0x35,
-	0xdd, 0xae, 0x19, 0x55, 0xc4, 0xa7, 0x71, 0xde, 0x86, 0xc3, 0xd1, 0x5b, 0xb5, 0x55, 0x3a, 0xc6,
-	0x26, 0x5d, 0x80, 0x21, 0xd7, 0x0b, 0xec, 0x4a, 0x53, 0x37, 0x03, 0xec, 0xe8, 0x86, 0x65, 0x61,
-	0xc2, 0x94, 0x31, 0xbf, 0x94, 0x7f, 0xf3, 0xdd, 0xf1, 0xe3, 0xae, 0x67, 0xd6, 0x7c, 0x6d, 0x90,
-	0xe3, 0x2c, 0x07, 0xd8, 0x59, 0xa4, 0x18, 0xb2, 0x0a, 0xfd, 0x81, 0x43, 0x74, 0x13, 0xe1, 0x40,
-	0xaf, 0xd8, 0x0e, 0x62, 0x1a, 0x93, 0xd7, 0x0a, 0x81, 0x43, 0x96, 0x11, 0x0e, 0xae, 0xd8, 0x0e,
-	0x92, 0x67, 0xa0, 0x8f, 0xe2, 0xec, 0xa0, 0x26, 0x47, 0x19, 0x61, 0x28, 0x10, 0x38, 0xe4, 0x1a,
-	0x6a, 0x32, 0x8c, 0x69, 0x28, 0x30, 0x2a, 0x06, 0x47, 0x18, 0x65, 0x08, 0x79, 0x4a, 0xc3, 0x60,
-	0xe3, 0xcf, 0xc3, 0x09, 0xe4, 0x36, 0xf4, 0x86, 0x81, 0x95, 0x5e, 0xb6, 0x79, 0x8f, 0x24, 0x36,
-	0x2f, 0x2d, 0xb5, 0xd9, 0xcb, 0x6e, 0xe3, 0x96, 0x81, 0xf9, 0xde, 0xf5, 0x22, 0xf6, 0x20, 0x97,
-	0xa0, 0xcf, 0x17, 0x58, 0x7a, 0x60, 0x54, 0x95, 0x5c, 0xeb, 0xba, 0x0a, 0xe1, 0xf0, 0xa6, 0x51,
-	0x95, 0x4f, 0x41, 0x3e, 0x40, 0x24, 0xd0, 0x6b, 0x9e, 0x85, 0x94, 0xfc, 0x8c, 0x74, 0x36, 0xa7,
-	0xe5, 0x28, 0x60, 0xcd, 0xb3, 0x90, 0x3c, 0x05, 0x3d, 0xc4, 0x37, 0x5c, 0x05, 0x5a, 0x49, 0x30,
-	0xb0, 0x7c, 0x06, 0xfa, 0x4c, 0x07, 0x19, 0x6e, 0xdd, 0xe7, 0xd3, 0x0b, 0x6c, 0x7a, 0x41, 0xc0,
-	0x18, 0x85, 0x31, 0xe8, 0xa5, 0x9b, 0xe9, 0xb9, 0x4a, 0x1f, 0x5b, 0xa7, 0x78, 0x92, 0x1f, 0x87,
-	0x22, 0xb5, 0x75, 0x08, 0x9b, 0xb6, 0xe1, 0x30, 0x89, 0x12, 0xa5, 0x9f, 0x4d, 0x1f, 0x8c, 0xe1,
-	0x54, 0xa8, 0x4c, 0xea, 0x75, 0x82, 0xf4, 0x86, 0x51, 0x77, 0x02, 0xdd, 0xdf, 0xb1, 0x95, 0x01,
-	0xfe, 0x9a, 0x3a, 0x41, 0xb7, 0x28, 0x6c, 0x63, 0xc7, 0xa6, 0x52, 0xa7, 0x27, 0xd1, 0x72, 0x89,
-	0x8e, 0x3d, 0x2f, 0x50, 0x8a, 0x5c, 0xea, 0x86, 0xef, 0xaf, 0xb8, 0x44, 0xf3, 0xbc, 0x40, 0x7e,
-	0x04, 0x06, 0x2c, 0xe4, 0x3b, 0x5e, 0xb3, 0x86, 0xdc, 0x80, 0xc9, 0x65, 0x98, 0xe1, 0xf4, 0xc7,
-	0x50, 0x2a, 0x8e, 0xe7, 0x61, 0xcc, 0xc4, 0x35, 0xdd, 0x30, 0x4d, 0x44, 0x88, 0xee, 0x63, 0xbb,
-	0x41, 0x4d, 0x05, 0x55, 0xff, 0xb1, 0x56, 0x19, 0x0c, 0x9b, 0xb8, 0xb6, 0xc8, 0xf0, 0x36, 0x38,
-	0xda, 0x35, 0xd4, 0x94, 0x9f, 0x82, 0x41, 0x31, 0xd7, 0xf0, 0x6d, 0xa6, 0x58, 0xca, 0xc9, 0xd6,
-	0x89, 0xfd, 0x1c, 0x63, 0xd1, 0xb7, 0xa9, 0x5a, 0xd1, 0x1d, 0x30, 0x0d, 0x73, 0x1b, 0xe9, 0x96,
-	0x8d, 0x15, 0x85, 0x31, 0x95, 0x63, 0x80, 0x15, 0x1b, 0xcb, 0xaf, 0xc0, 0x0c, 0x41, 0xa6, 0xe7,
-	0x5a, 0x06, 0x6e, 0xea, 0x1d, 0x38, 0x1b, 0x6f, 0x7d, 0xc1, 0x64, 0x34, 0x65, 0x39, 0x83, 0xc5,
-	0xb3, 0x50, 0x0c, 0xb6, 0x0d, 0xd7, 0x23, 0x3a, 0x46, 0x66, 0x83, 0xf3, 0x38, 0xc1, 0x5e, 0x3b,
-	0xc0, 0xe1, 0x1a, 0x32, 0x1b, 0x8c, 0xb3, 0x59, 0x18, 0x36, 0x5c, 0x62, 0x6f, 0x39, 0x48, 0xf7,
-	0xeb, 0x5b, 0x8e, 0x6d, 0x72, 0xe4, 0x53, 0x0c, 0x79, 0x48, 0x0c, 0x6d, 0xb0, 0x11, 0x86, 0xff,
-	0x14, 0x8c, 0x22, 0xb7, 0xe1, 0x35, 0xf5, 0x3b, 0x76, 0xb0, 0xad, 0x9b, 0x75, 0xec, 0xf0, 0xf3,
-	0xa8, 0x4c, 0xb1, 0x19, 0x32, 0x1b, 0xbc, 0x6d, 0x07, 0xdb, 0xcb, 0x75, 0xec, 0xb0, 0xd3, 0x48,
-	0xa7, 0xb8, 0x55, 0xdb, 0x7d, 0xbd, 0x6d, 0xca, 0x34, 0x9f, 0xc2, 0x06, 0x53, 0x53, 0x26, 0x9e,
-	0x85, 0x42, 0x42, 0xed, 0x0f, 0x63, 0x9d, 0x5e, 0xee, 0xc9, 0xf5, 0x14, 0x8f, 0xbf, 0xdc, 0x93,
-	0x9b, 0x2c, 0x4e, 0xa9, 0x3f, 0x92, 0xa0, 0x78, 0x05, 0x59, 0xc2, 0x9b, 0x0a, 0x2b, 0x34, 0x0f,
-	0xa3, 0x95, 0x08, 0xa6, 0x53, 0x8b, 0x83, 0x5e, 0x0f, 0x74, 0xdb, 0x12, 0xe4, 0x87, 0x2b, 0xc9,
-	0x09, 0x74, 0x6c, 0xd5, 0xa2, 0x96, 0xcb, 0x37, 0x70, 0x40, 0xed, 0x56, 0x62, 0x2e, 0x93, 0x14,
-	0x67, 0x60, 0x54, 0x0c, 0xc7, 0x6f, 0x63, 0xd2, 0x3a, 0x0b, 0xc5, 0x04, 0xbe, 0xb5, 0x45, 0x5f,
-	0x43, 0x6d, 0x50, 0x8f, 0x36, 0x10, 0xc3, 0x57, 0xb6, 0x56, 0x2d, 0xf9, 0x31, 0x18, 0x4c, 0x60,
-	0xba, 0x46, 0x0d, 0x31, 0x87, 0x97, 0x4f, 0x22, 0xde, 0x30, 0x6a, 0x48, 0xdd, 0x2d, 0x42, 0x31,
-	0xb4, 0x10, 0x57, 0x90, 0x11, 0xd4, 0x31, 0x22, 0xf2, 0x43, 0xd0, 0x1f, 0xdb, 0x83, 0xa6, 0x8f,
-	0xc4, 0x5a, 0x22, 0x23, 0xb1, 0xd9, 0xf4, 0x11, 0x55, 0x42, 0xd7, 0xb3, 0x10, 0x47, 0x18, 0xe7,
-	0x4a, 0x48, 0x01, 0x6c, 0x70, 0x11, 0xa6, 0x48, 0xdd, 0xf7, 0x3d, 0x1c, 0x10, 0xbd, 0x56, 0x77,
-	0x02, 0x5b, 0x0f, 0x90, 0x6b, 0xb8, 0x41, 0xe8, 0xd4, 0xd9, 0x3a, 0x73, 0xda, 0x44, 0x88, 0xb4,
-	0x46, 0x71, 0x36, 0x19, 0x8a, 0x70, 0xe3, 0xf2, 0xd3, 0x30, 0x16, 0x91, 0x20, 0xdb, 0x06, 0x46,
-	0x96, 0xde, 0xf0, 0x9c, 0x7a, 0x0d, 0xb1, 0x25, 0xe7, 0xb4, 0x91, 0x70, 0xb4, 0xcc, 0x06, 0x6f,
-	0xb1, 0x31, 0xba, 0x1d, 0xd1, 0xac, 0x00, 0xd7, 0x49, 0xa0, 0xfb, 0x9e, 0x63, 0x9b, 0x4d, 0xb6,
-	0xfc, 0x9c, 0x36, 0x1c, 0x0e, 0x6e, 0xd2, 0xb1, 0x0d, 0x36, 0x24, 0x5f, 0x04, 0x25, 0x9a, 0xb3,
-	0x53, 0xdf, 0x42, 0xd8, 0x45, 0x01, 0x22, 0xba, 0xe7, 0x3a, 0x4d, 0x66, 0xaf, 0x73, 0x5a, 0xc4,
-	0xc9, 0xb5, 0x68, 0x78, 0xdd, 0x75, 0x9a, 0xf2, 0x55, 0x98, 0x49, 0x4c, 0xc0, 0xe8, 0xb5, 0xba,
-	0x8d, 0x11, 0xd1, 0xef, 0x78, 0x78, 0x07, 0x61, 0x9d, 0x4a, 0x83, 0x30, 0xf7, 0x9e, 0xd3, 0xa6,
-	0x62, 0x3c, 0x4d, 0xa0, 0xdd, 0x66, 0x58, 0x37, 0x28, 0x12, 0xf3, 0x65, 0xa1, 0x4f, 0x22, 0x08,
-	0x37, 0x6c, 0x13, 0x11, 0xdd, 0xf1, 0x4c, 0xc3, 0x51, 0x4e, 0xb0, 0xf9, 0xa3, 0xe1, 0x70, 0x59,
-	0x8c, 0x5e, 0xa7, 0x83, 0xf2, 0xa7, 0x41, 0xb1, 0x7d, 0xdd, 0x70, 0x28, 0x6a, 0x80, 0x2c, 0xdd,
-	0x47, 0x38, 0x9c, 0xcf, 0xac, 0x78, 0x4e, 0x1b, 0xb5, 0xfd, 0xc5, 0x70, 0x78, 0x03, 0x61, 0x31,
-	0x5d, 0xbe, 0x00, 0x27, 0xa3, 0x35, 0x73, 0x0f, 0x48, 0xf7, 0x51, 0xf7, 0x1a, 0x15, 0x61, 0xd2,
-	0x23, 0xf1, 0xb2, 0x23, 0x44, 0x37, 0x75, 0xbd, 0x51, 0xe9, 0x3c, 0xcd, 0x60, 0xc6, 0x31, 0x7b,
-	0x9a, 0x21, 0x4f, 0x02, 0xd8, 0x84, 0x3a, 0x5b, 0xdf, 0xf3, 0x1c, 0xe6, 0x1b, 0x72, 0x5a, 0xce,
-	0x26, 0xb7, 0x6a, 0x1b, 0x9e, 0xe7, 0xc8, 0x27, 0xe1, 0x84, 0x4d, 0xf4, 0x8a, 0xb1, 0x13, 0xfa,
-	0x83, 0x5e, 0x9b, 0x5c, 0x31, 0x76, 0x90, 0x18, 0xa8, 0x79, 0xe6, 0x0e, 0x33, 0x37, 0x6c, 0x60,
-	0xcd, 0x33, 0x77, 0xe4, 0x17, 0x61, 0x32, 0x62, 0xc3, 0xb0, 0x2c, 0x9b, 0xaa, 0xb3, 0xe1, 0xe8,
-	0x2e, 0x0a, 0xa8, 0xe8, 0x09, 0xf3, 0x1c, 0x09, 0xed, 0x5a, 0x8c, 0x50, 0x6e, 0x08, 0x0c, 0xf9,
-	0x05, 0x98, 0xb4, 0x89, 0x4e, 0x6c, 0xb7, 0xea, 0xa0, 0xe4, 0xa6, 0x87, 0xfa, 0xc9, 0x3d, 0xcb,
-	0xb8, 0x4d, 0xca, 0x0c, 0x25, 0xde, 0xf7, 0x50, 0x3d, 0x97, 0x60, 0x3a, 0x66, 0x21, 0x0c, 0xe9,
-	0x2c, 0x64, 0xd9, 0x7c, 0x23, 0x6c, 0x5f, 0x38, 0x9d, 0x98, 0x09, 0x1e, 0xcb, 0xad, 0x84, 0x28,
-	0xab, 0xbe, 0xfc, 0x59, 0x38, 0x17, 0xd1, 0x88, 0x0e, 0xdc, 0xb6, 0x5d, 0xdd, 0xd6, 0x8d, 0x86,
-	0x61, 0x3b, 0xc6, 0x96, 0xed, 0xd8, 0x41, 0x53, 0xf7, 0x5c, 0x7d, 0xe7, 0x22, 0x51, 0x06, 0x19,
-	0xbd, 0x47, 0xc2, 0x19, 0xe1, 0xa9, 0x7d, 0xc9, 0xae, 0x6e, 0x2f, 0x26, 0xd0, 0xd7, 0xdd, 0x6b,
-	0x17, 0x89, 0xac, 0xc3, 0x93, 0xf7, 0x49, 0xda, 0xf2, 0xcc, 0x1d, 0x84, 0x99, 0xff, 0xcb, 0x69,
-	0x67, 0xef, 0x4d, 0x7d, 0x85, 0xe1, 0xcb, 0x57, 0x60, 0xc6, 0xf5, 0x32, 0x24, 0xa7, 0x1b, 0xf5,
-	0xc0, 0xd3, 0x89, 0x69, 0x38, 0x48, 0x19, 0x62, 0x34, 0x27, 0x5d, 0xaf, 0x4d, 0x7c, 0x8b, 0xf5,
-	0xc0, 0x2b, 0x53, 0x1c, 0x79, 0x19, 0xa6, 0x6d, 0xea, 0x9c, 0xd0, 0x56, 0xdd, 0x76, 0x82, 0xac,
-	0xad, 0x90, 0x19, 0x95, 0x53, 0x36, 0xd9, 0x10, 0x48, 0xed, 0x9b, 0x51, 0x02, 0xd9, 0xf5, 0x22,
-	0x0e, 0xc4, 0x1a, 0x58, 0x20, 0x95, 0xd3, 0x8a, 0xae, 0x27, 0xd0, 0xca, 0x1c, 0x2e, 0x4f, 0x31,
-	0x6d, 0xa4, 0x11, 0xd2, 0x96, 0xf7, 0x3a, 0x8b, 0xa6, 0x72, 0x5a, 0xde, 0x26, 0x97, 0x39, 0x80,
-	0x5a, 0xbf, 0x58, 0xc7, 0xfd, 0xc6, 0x33, 0xcc, 0x7b, 0xe5, 0xb4, 0xbe, 0x48, 0xb3, 0xfd, 0xc6,
-	0x33, 0xf2, 0x1c, 0x8c, 0x44, 0xc7, 0x9d, 0x3a, 0x59, 0xcf, 0x65, 0x04, 0x95, 0x49, 0x86, 0x3b,
-	0x14, 0x8e, 0x2d, 0xe3, 0xda, 0xba, 0x4b, 0x09, 0x53, 0xb7, 0x95, 0x9e, 0x50, 0xa9, 0xf0, 0x19,
-	0x53, 0x6c, 0x86, 0x9c, 0x9c, 0x51, 0xa9, 0xb0, 0x29, 0xf3, 0xc9, 0x29, 0x34, 0x82, 0xc4, 0xa8,
-	0x82, 0x11, 0xd9, 0x66, 0x9e, 0x2e, 0xa7, 0x0d, 0x47, 0x53, 0x10, 0x0e, 0x34, 0x3e, 0x44, 0xf5,
-	0x3a, 0x6d, 0x78, 0x7d, 0x07, 0x31, 0x43, 0xc4, 0x8e, 0x1e, 0x51, 0x4e, 0x73, 0xbd, 0x4e, 0xd9,
-	0x5d, 0xdf, 0x41, 0xd4, 0x0a, 0xd1, 0xb3, 0x48, 0xe4, 0x67, 0x61, 0xbc, 0x66, 0xb8, 0x46, 0x15,
-	0x11, 0xaa, 0x74, 0xcc, 0xa1, 0x61, 0xcf, 0x11, 0xb6, 0x6c, 0x86, 0x5b, 0x43, 0x81, 0x70, 0xed,
-	0x22, 0x59, 0xe6, 0xc3, 0xdc, 0x88, 0x9d, 0x81, 0xbe, 0x3a, 0x41, 0x44, 0xb7, 0xdd, 0x2a, 0x46,
-	0x84, 0x28, 0x67, 0xa2, 0xa8, 0x8b, 0xac, 0x72, 0x90, 0x7c, 0x1d, 0x0a, 0x22, 0x1c, 0x69, 0x18,
-	0x98, 0x28, 0x63, 0x2c, 0x5a, 0x7d, 0x22, 0x23, 0x5a, 0x0d, 0x7d, 0xd1, 0x2c, 0x0f, 0x46, 0x6e,
-	0x19, 0x58, 0xe4, 0x1b, 0x60, 0x44, 0x00, 0xf9, 0x1a, 0x00, 0xcd, 0x3e, 0x10, 0x0e, 0x6c, 0x44,
-	0x94, 0x93, 0xf7, 0x26, 0xb6, 0x11, 0x61, 0x0b, 0x62, 0xf1, 0x74, 0xf9, 0x55, 0x18, 0x0f, 0xb3,
-	0x67, 0xfd, 0xb5, 0xba, 0x17, 0x18, 0x7a, 0x82, 0xb6, 0xc2, 0x68, 0x2b, 0x09, 0xda, 0xab, 0x34,
-	0x89, 0xd7, 0xc4, 0x04, 0x91, 0x47, 0x9d, 0x0c, 0x09, 0xbc, 0x42, 0xe7, 0xc7, 0x2f, 0x93, 0x9f,
-	0xa0, 0xa1, 0x24, 0xcb, 0xfe, 0x7c, 0x8c, 0x7c, 0x03, 0x23, 0xc5, 0xa4, 0xb2, 0x59, 0xea, 0xf9,
-	0xce, 0x9e, 0x22, 0xd1, 0x80, 0x92, 0x8e, 0x6d, 0xf0, 0xa1, 0x89, 0x5b, 0x30, 0xd8, 0xb2, 0xe8,
-	0x8c, 0x88, 0xe5, 0xc9, 0x64, 0xc4, 0x52, 0x98, 0x3f, 0x99, 0x5c, 0x35, 0x7f, 0x6f, 0x73, 0xd5,
-	0xad, 0x78, 0x89, 0x50, 0x86, 0xd2, 0x6d, 0x59, 0xff, 0x03, 0xa1, 0xbb, 0x70, 0x36, 0x23, 0x81,
-	0xeb, 0x71, 0x3d, 0x17, 0xfd, 0xed, 0xc7, 0x4a, 0xdf, 0x46, 0x22, 0x64, 0x50, 0x7f, 0xda, 0x05,
-	0x03, 0x61, 0x2e, 0xa9, 0x21, 0xb2, 0x66, 0xf8, 0xf2, 0x42, 0xcc, 0x41, 0xe7, 0x2c, 0xb5, 0xb8,
-	0x7b, 0x57, 0xc9, 0x85, 0x80, 0x38, 0x63, 0x7d, 0x05, 0x4e, 0xd4, 0x0c, 0xdf, 0xb7, 0xdd, 0xaa,
-	0xd2, 0xd5, 0x31, 0x67, 0xe5, 0xef, 0x99, 0x5d, 0xe3, 0x88, 0x6c, 0xd9, 0x4b, 0x83, 0xbb, 0x77,
-	0x95, 0x82, 0x86, 0xc8, 0xa6, 0x51, 0xdd, 0x34, 0xb6, 0x1c, 0xa4, 0x85, 0x74, 0x26, 0x16, 0xa0,
-	0x2f, 0x89, 0x79, 0xa8, 0x44, 0xf6, 0x0b, 0xd2, 0x5b, 0xfb, 0xca, 0xcd, 0xd0, 0x4f, 0x5f, 0xba,
-	0x86, 0x9a, 0xb3, 0x34, 0xc4, 0x2a, 0x85, 0x10, 0x0f, 0x57, 0x19, 0x30, 0x99, 0xd7, 0x96, 0x44,
-	0x38, 0x86, 0xac, 0x70, 0xf4, 0x4a, 0x08, 0x48, 0xa2, 0x7d, 0x73, 0x5f, 0x19, 0xef, 0x38, 0xf8,
-	0xf7, 0xfb, 0xca, 0x09, 0xc1, 0xb4, 0xba, 0x05, 0x05, 0xa6, 0x98, 0x71, 0x70, 0x8a, 0x5e, 0xe7,
-	0x39, 0x7b, 0xe8, 0x1c, 0x79, 0x30, 0x28, 0x82, 0xd3, 0x70, 0x50, 0xb8, 0x45, 0xca, 0xae, 0x7c,
-	0x1a, 0x0a, 0xbc, 0xba, 0xc5, 0x31, 0xf9, 0x32, 0x81, 0x83, 0x58, 0xc8, 0xb8, 0x05, 0xfd, 0x5a,
-	0x52, 0xcf, 0x65, 0x19, 0x7a, 0x12, 0x44, 0xd9, 0xef, 0xb4, 0x98, 0x7a, 0x84, 0x98, 0x68, 0x58,
-	0x6a, 0x38, 0xd4, 0x92, 0x05, 0xdb, 0xd4, 0x5a, 0x79, 0x0e, 0x8f, 0x5f, 0x8f, 0x6b, 0x03, 0x0c,
-	0xbc, 0x19, 0x42, 0xd5, 0x3a, 0xf4, 0x5d, 0xdd, 0xb8, 0xb9, 0x82, 0xed, 0x06, 0xc2, 0x34, 0x03,
-	0x39, 0x93, 0x7c, 0xc5, 0x52, 0xff, 0xf7, 0xef, 0x2a, 0xf9, 0xaa, 0x5f, 0xb7, 0xd8, 0xb8, 0x78,
-	0xe3, 0xd3, 0xd0, 0xe7, 0x25, 0xa4, 0xc2, 0x19, 0x5f, 0x2a, 0x7e, 0xff, 0xae, 0xd2, 0x17, 0xa1,
-	0x7a, 0xb8, 0xaa, 0xa5, 0xb0, 0x16, 0xfa, 0xa8, 0xf2, 0xfe, 0xe2, 0xe7, 0x8a, 0xf4, 0xdd, 0xb7,
-	0x4f, 0x4b, 0xea, 0x8f, 0xba, 0x60, 0x20, 0x7a, 0xef, 0x52, 0xdd, 0x76, 0xac, 0xcc, 0xc5, 0x9d,
-	0x86, 0x02, 0xa7, 0x97, 0x2c, 0x1b, 0x00, 0x07, 0xb1, 0x6a, 0xc1, 0x39, 0x18, 0x4a, 0x20, 0xe8,
-	0x26, 0x46, 0x96, 0xa8, 0x16, 0x68, 0x83, 0x31, 0xda, 0x32, 0x05, 0xcb, 0xcf, 0x41, 0xd1, 0xe3,
-	0x15, 0x3a, 0xb7, 0xaa, 0x93, 0x26, 0x09, 0x50, 0x8d, 0x05, 0xab, 0x03, 0xf3, 0x43, 0x09, 0x75,
-	0x5e, 0x2f, 0xd3, 0x13, 0xa4, 0x0d, 0x46, 0xa8, 0x65, 0x86, 0x49, 0x93, 0xd4, 0x1d, 0xea, 0x0c,
-	0x1d, 0xbd, 0x81, 0x30, 0xa1, 0xeb, 0xe6, 0x15, 0x86, 0x7e, 0x0e, 0xbd, 0xc5, 0x81, 0x54, 0xf0,
-	0xdb, 0x4d, 0x9f, 0xc6, 0x7e, 0xc4, 0xc3, 0xba, 0xed, 0x56, 0x3c, 0x16, 0x97, 0xe6, 0xb5, 0x81,
-	0x18, 0x4c, 0xcf, 0x35, 0xcd, 0xbe, 0x6b, 0xd6, 0x05, 0x52, 0xaf, 0xb1, 0xb8, 0x33, 0xaf, 0x89,
-	0x27, 0xf9, 0x31, 0xe8, 0x23, 0x81, 0x87, 0xa3, 0x52, 0x09, 0x2f, 0x11, 0x70, 0xfb, 0x55, 0x10,
-	0x23, 0x74, 0x4d, 0x0b, 0x83, 0x6f, 0xee, 0x2b, 0x85, 0x72, 0x0c, 0x50, 0xff, 0x47, 0x82, 0x91,
-	0xb4, 0x4c, 0xd7, 0x50, 0x6d, 0x0b, 0x61, 0x79, 0x2e, 0x79, 0xf4, 0x93, 0x86, 0x26, 0xb9, 0xf3,
-	0xc9, 0x0a, 0xd5, 0x05, 0x38, 0x4e, 0xfd, 0xbf, 0x25, 0x6c, 0xd3, 0x78, 0xd6, 0x14, 0xf6, 0x02,
-	0x31, 0x89, 0x63, 0x53, 0xb7, 0x64, 0x57, 0x5d, 0x0f, 0x23, 0x9d, 0x04, 0x46, 0x10, 0xa6, 0x0f,
-	0x05, 0x0e, 0x2b, 0x53, 0xd0, 0xc2, 0xf5, 0xb7, 0xf6, 0x95, 0xa7, 0x23, 0x2d, 0xa1, 0x7b, 0x1c,
-	0x1f, 0xdf, 0xa4, 0xf2, 0xb4, 0x9d, 0xdf, 0xcc, 0x5a, 0x95, 0x09, 0x43, 0x69, 0x7e, 0x6e, 0x6a,
-	0xd7, 0xe5, 0x87, 0x61, 0x80, 0xb1, 0xa3, 0xd3, 0x84, 0x35, 0x51, 0xa4, 0xea, 0x63, 0xd0, 0x9b,
-	0xd8, 0x61, 0x8a, 0x73, 0x16, 0x72, 0x0d, 0xc3, 0xb1, 0x2d, 0x3b, 0x68, 0x66, 0xd6, 0x4d, 0xa3,
-	0x51, 0xf5, 0x07, 0xbd, 0x90, 0x8f, 0xde, 0xd2, 0xb1, 0x08, 0x38, 0x97, 0x2c, 0x02, 0xde, 0x8f,
-	0x8c, 0x3f, 0x0d, 0xbd, 0x8c, 0xa1, 0xb0, 0x0c, 0x78, 0x4f, 0x21, 0x0b, 0x74, 0xaa, 0x88, 0x8e,
-	0x6d, 0x22, 0x97, 0x20, 0x1a, 0x33, 0x54, 0xec, 0xaa, 0x48, 0x38, 0xfb, 0x05, 0x34, 0xb6, 0x48,
-	0x69, 0x34, 0x5d, 0xa8, 0x1b, 0x57, 0xdb, 0xe1, 0x14, 0xf6, 0x1a, 0xd7, 0xbd, 0x95, 0x94, 0x9b,
-	0xe7, 0x15, 0xae, 0x87, 0xb3, 0xf8, 0x3a, 0xd0, 0xbf, 0x8f, 0xc0, 0x71, 0xbe, 0xff, 0x5c, 0xb1,
-	0xf9, 0x43, 0x9b, 0x72, 0xe4, 0xda, 0x94, 0x23, 0xc3, 0x79, 0xe7, 0x3b, 0x3a, 0x6f, 0xf9, 0x69,
-	0x18, 0x0e, 0xcf, 0xc9, 0x56, 0xdd, 0xdc, 0x41, 0x01, 0xb7, 0xa2, 0x90, 0x38, 0x2e, 0x43, 0x02,
-	0x61, 0x89, 0x8d, 0x33, 0x9b, 0xbb, 0x0c, 0xa7, 0x5a, 0xa4, 0x92, 0x3a, 0x6c, 0x85, 0xc4, 0x6c,
-	0x25, 0x25, 0xa1, 0xc4, 0x41, 0x9b, 0xb8, 0x74, 0x3f, 0xfe, 0xbd, 0xb3, 0xfb, 0xda, 0x95, 0x5a,
-	0xfd, 0xf8, 0xd7, 0xf7, 0x14, 0xe9, 0xbb, 0x7b, 0x8a, 0xf4, 0xde, 0x9e, 0x22, 0xfd, 0x78, 0x4f,
-	0x91, 0xde, 0xdc, 0x57, 0x34, 0x26, 0x92, 0xd2, 0xf5, 0x96, 0x5d, 0x2a, 0xd7, 0x6b, 0xa5, 0x95,
-	0xa4, 0x1c, 0x4a, 0xe5, 0xd6, 0x35, 0xa6, 0xe7, 0x24, 0xf8, 0x3e, 0xea, 0xd1, 0xfb, 0xe6, 0xbe,
-	0x52, 0xbc, 0x9f, 0xe3, 0xf8, 0xc5, 0x8f, 0x99, 0x03, 0xe0, 0x1a, 0xb2, 0xe8, 0xdb, 0x6f, 0x7f,
-	0xac, 0x48, 0xea, 0x7b, 0x5d, 0xec, 0xf4, 0x08, 0xa5, 0x5c, 0x82, 0x5e, 0xfe, 0x9e, 0x7b, 0x19,
-	0xa3, 0xa1, 0xdd, 0xbb, 0x4a, 0x7c, 0xea, 0xb8, 0xfe, 0xf3, 0x99, 0x2d, 0x4a, 0xda, 0x95, 0xa5,
-	0xa4, 0xa2, 0x02, 0x7b, 0x90, 0x92, 0xb6, 0x9f, 0xa2, 0xee, 0x43, 0x9d, 0xa2, 0x9e, 0x8e, 0xa7,
-	0xe8, 0x93, 0xaa, 0xc7, 0xc9, 0x37, 0xf7, 0x95, 0xe1, 0x8c, 0x7d, 0x57, 0x7f, 0x7a, 0x06, 0xa2,
-	0xd8, 0xec, 0x81, 0x35, 0x23, 0x5e, 0x80, 0x1c, 0xab, 0x59, 0x84, 0x0e, 0xad, 0x30, 0x3f, 0x35,
-	0x6b, 0xd9, 0x24, 0xc0, 0xf6, 0x56, 0x9d, 0xa6, 0xd7, 0x35, 0x23, 0x30, 0xb7, 0x75, 0xe4, 0x56,
-	0x6d, 0x17, 0xcd, 0x5e, 0xf7, 0x4c, 0x31, 0x37, 0x9a, 0x44, 0xdd, 0xf6, 0x1b, 0x9e, 0x8b, 0x94,
-	0x45, 0xee, 0xb6, 0xe9, 0x6f, 0xf9, 0x3c, 0x80, 0xed, 0x47, 0xd9, 0x61, 0x2f, 0xf3, 0xb1, 0x23,
-	0xc9, 0x90, 0xde, 0x17, 0x19, 0xa2, 0x96, 0xb7, 0xfd, 0x44, 0xb2, 0x48, 0x2d, 0x83, 0x6d, 0xea,
-	0xb6, 0x4f, 0x84, 0xed, 0xc8, 0x73, 0xc8, 0xaa, 0x4f, 0xe4, 0x47, 0x61, 0xd0, 0xad, 0xd7, 0x74,
-	0xab, 0xe9, 0x1a, 0x35, 0x81, 0x93, 0x63, 0x11, 0x4d, 0xbf, 0x5b, 0xaf, 0xad, 0x70, 0x28, 0xc5,
-	0xbb, 0x0c, 0x85, 0xc0, 0xae, 0x21, 0xdd, 0x61, 0xfd, 0x37, 0x66, 0x41, 0x0a, 0xf3, 0xd3, 0x49,
-	0x07, 0xdf, 0xde, 0xa5, 0x13, 0x8b, 0x82, 0x20, 0xee, 0xdb, 0x3d, 0x02, 0xbd, 0x08, 0x63, 0x0f,
-	0x13, 0x05, 0xa8, 0x7c, 0x97, 0xfa, 0xa9, 0x4d, 0x88, 0xcb, 0xb8, 0x62, 0x50, 0x3e, 0x1f, 0xda,
-	0xba, 0x3e, 0xb6, 0xc8, 0xa4, 0x3e, 0x6f, 0x62, 0xc3, 0xdc, 0x41, 0x16, 0x3b, 0xc7, 0xc2, 0xa4,
-	0x08, 0x53, 0xf8, 0x02, 0xf4, 0xb1, 0xc4, 0xb4, 0x81, 0x30, 0xb6, 0x2d, 0xc4, 0x4a, 0x20, 0x03,
-	0xe9, 0xcd, 0xd2, 0xd6, 0xd6, 0xc5, 0x68, 0xe8, 0xfa, 0x4d, 0x5c, 0x0b, 0x41, 0xf2, 0x1c, 0x14,
-	0x13, 0x05, 0x73, 0x5e, 0xbd, 0x1a, 0x48, 0x98, 0xca, 0xc1, 0x78, 0x94, 0x57, 0xaf, 0x9e, 0x6d,
-	0xad, 0x33, 0x0e, 0x32, 0x43, 0x37, 0xb2, 0x7b, 0x57, 0x69, 0x2b, 0x4a, 0xb6, 0x54, 0x1f, 0x2f,
-	0x80, 0xe8, 0xb5, 0xe8, 0x04, 0x8b, 0x8a, 0x74, 0x91, 0xc7, 0x86, 0x69, 0x89, 0xf4, 0x73, 0xac,
-	0x32, 0xe6, 0xf5, 0xe9, 0xe7, 0xa0, 0x97, 0x47, 0xb2, 0xac, 0x36, 0x51, 0x48, 0x6d, 0xff, 0x15,
-	0x36, 0x40, 0x15, 0x71, 0x60, 0xf7, 0xae, 0xd2, 0xcb, 0x1f, 0xf9, 0x19, 0xe7, 0x73, 0x58, 0x5d,
-	0x74, 0xbb, 0x49, 0x6c, 0x93, 0x86, 0xd3, 0xd4, 0xac, 0xcb, 0xa2, 0x2e, 0x2a, 0x80, 0xcc, 0x96,
-	0x5f, 0x8c, 0x9b, 0x31, 0xc3, 0xcc, 0x0a, 0x9c, 0xce, 0x50, 0xf7, 0xcc, 0x36, 0xcc, 0x13, 0x30,
-	0x14, 0x37, 0xb4, 0xc2, 0x70, 0x8e, 0x77, 0x83, 0x8a, 0xd1, 0x40, 0x18, 0xd1, 0x7d, 0x06, 0x7a,
-	0x85, 0x85, 0x18, 0x6d, 0x8b, 0x86, 0xd2, 0x2d, 0x9f, 0xa5, 0x1c, 0x15, 0x09, 0x5f, 0x08, 0x9f,
-	0x22, 0xdf, 0x86, 0x02, 0x46, 0x44, 0x0f, 0x8c, 0xaa, 0x5e, 0x33, 0x7c, 0x91, 0x86, 0xab, 0x59,
-	0x7c, 0xf2, 0x2c, 0x69, 0xcd, 0xf0, 0x79, 0xe6, 0x34, 0x4c, 0x49, 0xb5, 0x66, 0x4f, 0x79, 0x1c,
-	0x22, 0xc9, 0xe5, 0x74, 0x7e, 0xcf, 0x53, 0xf2, 0x87, 0xb2, 0x08, 0xb7, 0xa4, 0xb8, 0xad, 0xfb,
-	0x96, 0x4c, 0xf3, 0xcf, 0x42, 0x31, 0xea, 0xd3, 0x85, 0x62, 0xe1, 0x5d, 0x8f, 0x81, 0x06, 0x6f,
-	0xd1, 0x85, 0x42, 0x99, 0x06, 0x88, 0x75, 0x4c, 0xb4, 0x28, 0x12, 0x10, 0x79, 0x19, 0x8a, 0xac,
-	0xf9, 0xce, 0x5b, 0x2d, 0xec, 0x0d, 0xac, 0xba, 0x33, 0x90, 0x12, 0x1f, 0xcb, 0xa0, 0x16, 0x7d,
-	0x9b, 0xb3, 0xa8, 0x0d, 0xd8, 0xa9, 0x67, 0x7a, 0x4e, 0x38, 0x11, 0x21, 0xff, 0xc9, 0x36, 0xa3,
-	0x96, 0x48, 0xc1, 0xc4, 0x19, 0x2e, 0xd8, 0x89, 0xac, 0xec, 0x36, 0x0c, 0xd5, 0x0c, 0xdb, 0x65,
-	0x15, 0x71, 0x33, 0x0c, 0x3c, 0xa6, 0x19, 0x1b, 0xe7, 0x3a, 0x5b, 0xb9, 0xb5, 0x78, 0x0a, 0x3b,
-	0xbc, 0x5a, 0xb1, 0xd6, 0x02, 0x91, 0x57, 0xe1, 0x4c, 0x78, 0x7a, 0x45, 0xd9, 0x5b, 0x6f, 0x57,
-	0x28, 0x5e, 0x01, 0x9a, 0x0e, 0x11, 0x79, 0x0d, 0x7c, 0xb9, 0x55, 0xbd, 0x1e, 0x82, 0x13, 0x61,
-	0xb9, 0x76, 0x86, 0x9d, 0x2b, 0xa0, 0x67, 0xe2, 0xd6, 0xda, 0x86, 0xe7, 0x39, 0x5a, 0x6f, 0x83,
-	0x17, 0x6e, 0x5f, 0x84, 0xd1, 0x64, 0x83, 0x89, 0x37, 0x7c, 0xa8, 0x9d, 0x3f, 0x93, 0x75, 0x14,
-	0xe5, 0xb8, 0xfb, 0xc5, 0x30, 0x69, 0x5e, 0xf7, 0x32, 0x9c, 0x4e, 0x50, 0xd8, 0x41, 0x4d, 0xbd,
-	0xee, 0x57, 0xb1, 0x61, 0xa1, 0xb0, 0x98, 0x6e, 0x29, 0x6a, 0xc2, 0x82, 0x9c, 0x8a, 0x48, 0x5c,
-	0x43, 0xcd, 0x9b, 0x1c, 0x53, 0x94, 0xd3, 0x2d, 0xf9, 0xb3, 0x00, 0xbc, 0x7f, 0x6f, 0xe9, 0x46,
-	0xa0, 0x3c, 0xc4, 0x76, 0xe5, 0xa1, 0xce, 0xf2, 0xa4, 0x76, 0x96, 0x04, 0x46, 0xcd, 0x5f, 0x1a,
-	0x15, 0x7c, 0xe6, 0x83, 0x10, 0xc4, 0xf6, 0x2c, 0x2f, 0xa8, 0x2d, 0x06, 0x94, 0x34, 0xef, 0xea,
-	0x33, 0xd2, 0x0f, 0x7f, 0x72, 0xd2, 0x82, 0xda, 0x62, 0x20, 0xcf, 0x43, 0x5f, 0xaa, 0x4f, 0xf1,
-	0x08, 0x13, 0x1d, 0xab, 0x50, 0x24, 0x7a, 0x14, 0x5a, 0x21, 0x48, 0x34, 0x2c, 0xae, 0x81, 0x9c,
-	0x9c, 0x23, 0x34, 0xe8, 0xd1, 0xfb, 0xb1, 0xf5, 0xc5, 0x04, 0x1d, 0xae, 0x34, 0x57, 0x61, 0x30,
-	0x5d, 0xf7, 0x22, 0xca, 0x63, 0x6d, 0xd5, 0xae, 0x54, 0xc2, 0x2f, 0x74, 0x7a, 0x20, 0x55, 0xed,
-	0x22, 0xf2, 0x55, 0x98, 0xb1, 0x50, 0x85, 0xf5, 0x5c, 0x23, 0x82, 0xad, 0xd9, 0xfe, 0x59, 0xe6,
-	0x1b, 0xa7, 0x04, 0x5e, 0x48, 0x75, 0x31, 0x95, 0xfc, 0xcb, 0x17, 0x60, 0xe0, 0x25, 0x8f, 0x04,
-	0xa2, 0xb6, 0xe8, 0x20, 0xac, 0x3c, 0x9e, 0xa5, 0x4f, 0x2d, 0x48, 0xd4, 0x3a, 0xef, 0x18, 0x95,
-	0x1d, 0x23, 0x2a, 0x1c, 0x9f, 0xe3, 0xd6, 0x99, 0x01, 0xc3, 0x4a, 0xf1, 0x14, 0x00, 0x47, 0xaa,
-	0x13, 0x84, 0x95, 0x27, 0xb8, 0x3b, 0x67, 0x90, 0x9b, 0x04, 0x61, 0x96, 0x4e, 0xb3, 0x61, 0xdf,
-	0x20, 0xe4, 0x8e, 0x87, 0x2d, 0xa5, 0x24, 0xd2, 0x69, 0x0a, 0xdd, 0x10, 0x40, 0xf9, 0x59, 0x80,
-	0xaa, 0x5f, 0x0f, 0x0d, 0xc0, 0x93, 0x6d, 0xae, 0x24, 0x0a, 0xf6, 0x84, 0xa8, 0xf2, 0x55, 0xbf,
-	0x2e, 0x0e, 0xff, 0x2a, 0x9c, 0x41, 0x2e, 0x35, 0x9b, 0x7a, 0x28, 0x2c, 0x82, 0x70, 0x03, 0x61,
-	0x87, 0x1e, 0x80, 0x90, 0xf3, 0x59, 0x7e, 0x46, 0x39, 0xe2, 0x0a, 0xc7, 0x2b, 0x47, 0x68, 0xe1,
-	0x5a, 0x1e, 0x82, 0x7e, 0xc3, 0x71, 0x6c, 0x66, 0x44, 0x3c, 0x5c, 0x25, 0xca, 0x1c, 0x8b, 0xb9,
-	0xfa, 0x42, 0xe0, 0x3a, 0xae, 0xd2, 0xc0, 0xe3, 0x74, 0xc7, 0x2e, 0x87, 0xee, 0xdd, 0x71, 0x11,
-	0x56, 0x3e, 0xc5, 0x96, 0x38, 0x49, 0xb2, 0x3b, 0x1d, 0xeb, 0x14, 0x27, 0x23, 0x09, 0x7a, 0xaa,
-	0x73, 0x12, 0xf4, 0x1c, 0x4c, 0x74, 0xee, 0x39, 0x28, 0xf3, 0x6c, 0x71, 0x8a, 0xdf, 0xa1, 0xc3,
-	0x20, 0x97, 0xe1, 0x74, 0x76, 0x03, 0x3b, 0xb6, 0x2f, 0xe7, 0xb3, 0xf4, 0xe1, 0x54, 0x46, 0x0f,
-	0x3b, 0x32, 0x34, 0xbf, 0x05, 0x8f, 0x67, 0x12, 0xcd, 0x34, 0x39, 0x4f, 0x27, 0x96, 0xf6, 0x70,
-	0x3b, 0xd5, 0x0c, 0xdb, 0xf3, 0x12, 0x8c, 0xc7, 0xe4, 0x5b, 0x03, 0x93, 0x0b, 0x59, 0xdc, 0x8e,
-	0x45, 0xf8, 0x37, 0x52, 0x11, 0xca, 0x19, 0xc8, 0x5b, 0x2e, 0xd1, 0x1d, 0x63, 0x0b, 0x39, 0xca,
-	0x33, 0x89, 0xc4, 0x2f, 0x67, 0xb9, 0xe4, 0x3a, 0x85, 0xca, 0x8f, 0x42, 0x1f, 0xf6, 0xbc, 0x40,
-	0x77, 0xb6, 0xf4, 0xca, 0x6b, 0x96, 0xab, 0x7c, 0x3a, 0x81, 0x05, 0x74, 0xe4, 0xfa, 0xd6, 0x95,
-	0xd7, 0x2c, 0x57, 0x3e, 0x4f, 0x73, 0x51, 0x16, 0xba, 0xa6, 0xd0, 0x5f, 0x48, 0xa0, 0x17, 0x39,
-	0x82, 0x16, 0x4f, 0xd2, 0x60, 0x28, 0xdd, 0xcf, 0xa6, 0x1a, 0x7e, 0x91, 0x69, 0xf8, 0xa9, 0x64,
-	0xb0, 0xd4, 0xd2, 0x07, 0x4f, 0x04, 0x19, 0xc5, 0x4a, 0x6b, 0x8f, 0xfc, 0x1e, 0xe9, 0xed, 0xb3,
-	0xf7, 0x93, 0xde, 0xca, 0x2f, 0x42, 0x3f, 0x77, 0xbb, 0x3c, 0x18, 0x23, 0xca, 0x02, 0xb3, 0x52,
-	0xa3, 0x6d, 0x11, 0xdc, 0xaa, 0x5b, 0xf1, 0x04, 0x35, 0xee, 0xa8, 0x39, 0x98, 0xf5, 0x27, 0x44,
-	0xd3, 0x87, 0xf7, 0x76, 0x3f, 0xc3, 0x73, 0x7d, 0x01, 0x63, 0x0d, 0xdd, 0x69, 0x28, 0x24, 0xbb,
-	0x39, 0x97, 0x78, 0x6f, 0xc8, 0x8c, 0xba, 0x38, 0x0f, 0x43, 0xaf, 0xb7, 0xf5, 0x39, 0xdd, 0xb6,
-	0x94, 0xe7, 0xb3, 0x36, 0xf5, 0xb8, 0xb7, 0xf5, 0xb9, 0x55, 0x4b, 0xbe, 0x02, 0x85, 0xc4, 0x2d,
-	0x43, 0xe5, 0xc5, 0xb6, 0x64, 0x30, 0x8e, 0x82, 0x62, 0x34, 0x1e, 0x0b, 0x26, 0x27, 0xca, 0x4f,
-	0x42, 0xc1, 0xda, 0x62, 0x17, 0x65, 0x1c, 0xfa, 0xca, 0x25, 0x6a, 0x3c, 0x5b, 0x5f, 0x99, 0xb7,
-	0xb6, 0xd6, 0x28, 0xc2, 0xaa, 0xf5, 0x09, 0xae, 0x39, 0x4c, 0xdc, 0x86, 0x81, 0x74, 0xa4, 0x97,
-	0x31, 0x7b, 0x2e, 0xdd, 0x1a, 0x18, 0x4f, 0xbb, 0x87, 0x30, 0x1a, 0xbc, 0x86, 0x9a, 0x49, 0xc2,
-	0x97, 0xee, 0xa7, 0x99, 0xd1, 0x99, 0xaf, 0xe7, 0xa1, 0xd8, 0x2a, 0xa2, 0x43, 0x65, 0xad, 0x3f,
-	0xec, 0x39, 0xa8, 0xa8, 0xf1, 0x3e, 0x2f, 0x6a, 0xfc, 0x5e, 0xf7, 0x75, 0x91, 0x36, 0xce, 0xbe,
-	0xe4, 0x61, 0xfb, 0x0d, 0x1a, 0x0b, 0x39, 0x8b, 0xa6, 0x59, 0xc7, 0x86, 0xd9, 0x2c, 0x45, 0x63,
-	0xb7, 0x68, 0xea, 0x6c, 0x66, 0x8d, 0x2c, 0x7b, 0x75, 0x4c, 0x50, 0xfc, 0x5c, 0xf6, 0x11, 0xb2,
-	0xe2, 0xc7, 0x28, 0x1c, 0x28, 0x71, 0xad, 0x2e, 0xf1, 0x22, 0xca, 0x65, 0x96, 0xab, 0x95, 0xda,
-	0x8d, 0x55, 0xe9, 0x00, 0x4b, 0x53, 0x2a, 0x77, 0x36, 0x72, 0x19, 0x63, 0x19, 0x04, 0x96, 0xc3,
-	0xa8, 0xa6, 0x74, 0x33, 0x0c, 0x42, 0x4a, 0x9b, 0x2d, 0x41, 0x41, 0x29, 0xed, 0x5a, 0x5b, 0x6a,
-	0x3b, 0x57, 0x43, 0x67, 0x36, 0x9b, 0x59, 0x07, 0x12, 0x66, 0xaa, 0x14, 0x1b, 0x15, 0xb6, 0xe0,
-	0xa4, 0x95, 0x39, 0xa8, 0x18, 0xf4, 0x6b, 0xe8, 0xa0, 0x64, 0x95, 0x8d, 0xde, 0xfe, 0x58, 0x91,
-	0x5e, 0xee, 0xc9, 0x3d, 0x57, 0xbc, 0xa4, 0x7e, 0xab, 0x0b, 0x0a, 0xdc, 0x86, 0xac, 0xd1, 0x28,
-	0x2f, 0x2c, 0x66, 0x48, 0xf7, 0x5b, 0xcc, 0x68, 0xe9, 0xa8, 0x74, 0xb7, 0x76, 0x54, 0x68, 0xe2,
-	0x97, 0xea, 0xca, 0xb3, 0xca, 0x05, 0x2f, 0xe5, 0x14, 0x93, 0x03, 0xaf, 0x7a, 0x2e, 0x5a, 0xf8,
-	0x23, 0xe9, 0xad, 0x7d, 0xa5, 0x7a, 0x58, 0x21, 0xb1, 0x97, 0x5d, 0xba, 0x12, 0xbd, 0xf3, 0x01,
-	0x35, 0x9e, 0x20, 0xa6, 0xa8, 0xce, 0xc6, 0x97, 0x53, 0xd7, 0x0c, 0xd7, 0xae, 0x20, 0x12, 0xc8,
-	0x13, 0x90, 0xab, 0x89, 0xdf, 0xe2, 0x70, 0x46, 0xcf, 0xea, 0x3f, 0x4a, 0xd0, 0x97, 0xec, 0x29,
-	0x66, 0xb6, 0x5a, 0x66, 0xa0, 0x60, 0x21, 0x62, 0x62, 0xdb, 0x8f, 0x9b, 0x3a, 0x5a, 0x12, 0x14,
-	0x1f, 0xfe, 0xee, 0xc4, 0xe1, 0x97, 0xc7, 0xa0, 0x97, 0x20, 0x13, 0xa3, 0x40, 0x5c, 0xfc, 0x11,
-	0x4f, 0xf2, 0x24, 0xe4, 0x6b, 0x86, 0x6b, 0x19, 0x81, 0x87, 0xc3, 0xcb, 0x3d, 0x31, 0x80, 0xb2,
-	0x6b, 0x8b, 0x3b, 0xae, 0xe2, 0xde, 0x4e, 0xf4, 0x4c, 0x77, 0x31, 0xf0, 0x02, 0x5f, 0x17, 0x64,
-	0xf9, 0xb5, 0x1c, 0xa0, 0xa0, 0x32, 0x83, 0xa8, 0xbf, 0x94, 0xa0, 0x3f, 0x14, 0x00, 0xbb, 0x0b,
-	0x7b, 0x7f, 0xf7, 0xa8, 0x5e, 0xca, 0x28, 0x1c, 0x9e, 0xcd, 0x50, 0x2a, 0x46, 0xf2, 0xc0, 0xe2,
-	0xa1, 0xda, 0xd2, 0x01, 0xe3, 0x02, 0x49, 0xc1, 0x7e, 0x55, 0x4d, 0x60, 0xf5, 0x1d, 0x09, 0x26,
-	0x12, 0x2d, 0xd7, 0x74, 0x17, 0xfc, 0x3e, 0x25, 0xf1, 0x7c, 0x86, 0x24, 0xee, 0xd5, 0x72, 0x3f,
-	0xe4, 0xfa, 0xd5, 0x1f, 0x74, 0xc1, 0x68, 0x2b, 0x9f, 0x37, 0x89, 0x51, 0x45, 0x47, 0x39, 0xd5,
-	0x3c, 0x1e, 0xa9, 0xd3, 0xe9, 0xe2, 0x42, 0x1b, 0x30, 0x10, 0x27, 0x38, 0x0f, 0x3d, 0xac, 0xd1,
-	0xd6, 0x7d, 0x5f, 0x0b, 0x61, 0xb8, 0x34, 0xff, 0x88, 0x72, 0x27, 0x62, 0x7a, 0x98, 0x9b, 0x81,
-	0x1e, 0xad, 0x3f, 0x84, 0x96, 0x29, 0x70, 0xa1, 0xf1, 0xeb, 0xb1, 0x93, 0xea, 0xef, 0x77, 0xc1,
-	0x70, 0x28, 0x8e, 0xc5, 0x38, 0xcb, 0x38, 0xb4, 0xec, 0xd4, 0xac, 0x5e, 0x6d, 0x4b, 0x67, 0xf6,
-	0x1b, 0xd4, 0xce, 0xb9, 0x87, 0x5c, 0x64, 0x98, 0xfa, 0xd0, 0x41, 0xef, 0xc1, 0xf7, 0xd9, 0xfb,
-	0x52, 0xd2, 0xf8, 0x37, 0x09, 0x20, 0x0e, 0x39, 0x3b, 0xb6, 0xc1, 0x4d, 0xbf, 0x4e, 0xa2, 0x36,
-	0x38, 0x7d, 0xa0, 0x27, 0x0e, 0x1b, 0x35, 0x71, 0x75, 0x93, 0xfe, 0xa4, 0x73, 0x2d, 0x9b, 0xec,
-	0x88, 0xdd, 0x66, 0xbf, 0xe5, 0x65, 0xc8, 0x51, 0xe5, 0x66, 0x15, 0xba, 0xe3, 0x6d, 0x15, 0xba,
-	0xf8, 0xc5, 0xec, 0x4c, 0x46, 0x15, 0x3a, 0x1e, 0xf8, 0x9e, 0xf0, 0x39, 0x6c, 0x62, 0x81, 0xdb,
-	0xd8, 0x03, 0xc2, 0xba, 0x8e, 0xc1, 0x93, 0x7a, 0x01, 0x4e, 0xac, 0x97, 0x17, 0xa9, 0xd3, 0xc9,
-	0x5c, 0x1b, 0x35, 0xb1, 0x81, 0x11, 0x88, 0xc5, 0xe5, 0x35, 0xf1, 0xa4, 0x62, 0x3a, 0x8d, 0xdf,
-	0xd5, 0xcd, 0x9a, 0x26, 0x43, 0x4f, 0x60, 0x54, 0xc3, 0x49, 0xec, 0xb7, 0x3c, 0x9d, 0x3a, 0xf9,
-	0xc2, 0x41, 0x26, 0x4e, 0xf6, 0x69, 0x28, 0x50, 0x91, 0xe8, 0xd4, 0x54, 0x18, 0x81, 0x70, 0x8d,
-	0x40, 0x41, 0x57, 0x18, 0x44, 0xfd, 0x46, 0x1f, 0xf4, 0xc5, 0x5f, 0x29, 0xf0, 0x3e, 0xf6, 0x03,
-	0x69, 0x44, 0x5c, 0x0a, 0x2b, 0xe9, 0xdd, 0xac, 0xba, 0xf2, 0x58, 0xe7, 0xa2, 0x4f, 0x48, 0x80,
-	0x17, 0xe7, 0x44, 0x4d, 0xfd, 0x51, 0xc8, 0x8b, 0x6c, 0xd0, 0xb6, 0xc4, 0x27, 0x27, 0x89, 0x5b,
-	0xd7, 0x39, 0x3e, 0xb6, 0x6a, 0xc9, 0x8f, 0x03, 0x98, 0x71, 0xb9, 0xe3, 0x78, 0xeb, 0xf5, 0xec,
-	0xc4, 0xa0, 0x3c, 0x09, 0xe0, 0x11, 0xbd, 0x66, 0xbc, 0xae, 0x53, 0x1d, 0xea, 0x65, 0x0a, 0x93,
-	0xf3, 0xc8, 0x9a, 0xf1, 0xba, 0x66, 0xd4, 0x64, 0x15, 0xfa, 0xc5, 0x68, 0x83, 0x5a, 0x0a, 0xde,
-	0xb1, 0xe8, 0xd1, 0x0a, 0x0c, 0xe1, 0x16, 0x03, 0xc9, 0x67, 0x62, 0x1c, 0xcf, 0xd1, 0xab, 0x5b,
-	0xac, 0x63, 0xd1, 0xa3, 0x01, 0xc7, 0xf1, 0x9c, 0xab, 0x5b, 0x54, 0x7c, 0xa2, 0xcf, 0x90, 0xe7,
-	0xe2, 0x13, 0x8d, 0x85, 0x39, 0x38, 0x11, 0xa6, 0x5f, 0x70, 0x40, 0xfa, 0xa5, 0x85, 0x58, 0xf2,
-	0x8b, 0x91, 0x92, 0x14, 0x98, 0xc8, 0x93, 0xf8, 0x65, 0x36, 0xc0, 0xd2, 0xb5, 0xa1, 0xaf, 0x7f,
-	0x9c, 0x48, 0x6a, 0x78, 0xad, 0x9a, 0xcf, 0xcb, 0xae, 0x8a, 0xf7, 0x75, 0xa8, 0x8a, 0x2f, 0x82,
-	0xdc, 0x16, 0x49, 0x11, 0xa5, 0x9f, 0xb1, 0x2a, 0xa7, 0xae, 0x53, 0x30, 0xbd, 0xd6, 0x86, 0x5a,
-	0xc3, 0x2b, 0xba, 0xc4, 0xbc, 0x27, 0x2e, 0xb7, 0x12, 0x65, 0x20, 0x63, 0x26, 0x53, 0x6d, 0x2a,
-	0x72, 0xf6, 0x83, 0xc8, 0x0b, 0x30, 0x1e, 0x6f, 0x8f, 0xce, 0x2f, 0xe6, 0x63, 0x64, 0x22, 0xbb,
-	0x81, 0x2c, 0x71, 0x69, 0xf3, 0x64, 0x8c, 0xb0, 0x4c, 0xc7, 0x35, 0x31, 0x9c, 0x5d, 0x0a, 0x2e,
-	0x3e, 0x80, 0x52, 0xf0, 0xab, 0x20, 0x47, 0xdf, 0x82, 0xe9, 0xc4, 0x35, 0x7c, 0xb2, 0xed, 0x05,
-	0xa2, 0xe9, 0x71, 0xa6, 0x93, 0x2b, 0x22, 0x65, 0x81, 0x98, 0xc8, 0xe6, 0x87, 0x70, 0xeb, 0xa0,
-	0x7c, 0x39, 0xb3, 0xfc, 0x28, 0x1f, 0x58, 0x7e, 0xcc, 0x28, 0x3c, 0x3e, 0x0f, 0xa3, 0xa6, 0x57,
-	0xf3, 0x8d, 0xc0, 0x16, 0x9b, 0x15, 0x6e, 0xee, 0xf0, 0x8c, 0x74, 0xb6, 0x3f, 0xa9, 0xfe, 0x23,
-	0x29, 0xbc, 0x70, 0xaf, 0xaf, 0xa6, 0x8c, 0xc6, 0x08, 0xdb, 0xa9, 0xc7, 0x32, 0xbf, 0x5a, 0xaa,
-	0x78, 0x07, 0xc6, 0x4d, 0xf3, 0x00, 0xec, 0x86, 0x24, 0xf5, 0xc0, 0x44, 0x19, 0x65, 0x84, 0x86,
-	0x13, 0x84, 0x6e, 0x78, 0x16, 0x62, 0x5a, 0xcd, 0x2e, 0xbc, 0xd3, 0x5f, 0x84, 0x7d, 0xe8, 0x60,
-	0x06, 0x76, 0x03, 0xb1, 0x62, 0x92, 0xed, 0x92, 0x80, 0xca, 0x9e, 0x7f, 0xf2, 0xa1, 0x0d, 0xf1,
-	0xa1, 0x65, 0x5c, 0x5b, 0x15, 0x03, 0xd4, 0x82, 0xd1, 0x5f, 0xd6, 0x16, 0xab, 0x3e, 0xb1, 0x2f,
-	0x3c, 0x72, 0x1a, 0x08, 0xd0, 0x32, 0xae, 0xc9, 0x8f, 0xc1, 0x20, 0x46, 0x0e, 0x32, 0x48, 0x5b,
-	0x8f, 0x43, 0x80, 0xc3, 0x65, 0x87, 0xdc, 0xf2, 0xfb, 0x9c, 0xb3, 0x99, 0xdc, 0xb2, 0x2a, 0x3d,
-	0xe3, 0x96, 0x5d, 0xea, 0xfc, 0xa4, 0xbd, 0xdf, 0xbf, 0x69, 0xbb, 0x1a, 0xf0, 0xf6, 0x9e, 0x22,
-	0x7d, 0x7f, 0x4f, 0xe9, 0x4f, 0x19, 0x3d, 0x9a, 0x4e, 0xff, 0x8c, 0xa7, 0xd4, 0xbd, 0xfc, 0x6c,
-	0xff, 0xda, 0xd2, 0x39, 0x76, 0xff, 0xf0, 0xdd, 0x8f, 0xe3, 0x5b, 0x83, 0xea, 0x43, 0x30, 0x18,
-	0x65, 0x27, 0x28, 0xc0, 0xb6, 0xc9, 0xdc, 0x70, 0xc5, 0xf3, 0x98, 0xb5, 0xed, 0xd1, 0xe8, 0xcf,
-	0x73, 0x0b, 0x30, 0x90, 0x6e, 0xfe, 0xc8, 0x43, 0xd0, 0xbf, 0xb2, 0xaa, 0x5d, 0x5e, 0xde, 0xd4,
-	0x17, 0x97, 0x97, 0x2f, 0x97, 0xcb, 0xc5, 0x63, 0xf2, 0x28, 0x0c, 0x69, 0x97, 0xcb, 0x9b, 0xda,
-	0xea, 0xf2, 0xe6, 0xe5, 0x95, 0x10, 0x2c, 0x9d, 0x2b, 0x41, 0x2f, 0xbf, 0xa4, 0x25, 0xe7, 0xe1,
-	0xf8, 0xf5, 0xd5, 0x1b, 0x37, 0x7f, 0xa3, 0x78, 0x4c, 0x2e, 0xc0, 0x89, 0xdb, 0xab, 0x37, 0x56,
-	0xd6, 0x6f, 0x97, 0x8b, 0x92, 0x0c, 0xd0, 0xbb, 0xbe, 0xf9, 0xd2, 0x65, 0xad, 0x5c, 0x1c, 0x39,
-	0x77, 0x05, 0x06, 0x34, 0xe4, 0x7b, 0x38, 0x28, 0x9b, 0xdb, 0xc8, 0xaa, 0x3b, 0x48, 0xee, 0x87,
-	0xfc, 0xe5, 0x06, 0xc2, 0xcd, 0xdb, 0x08, 0xed, 0x14, 0x8f, 0xc9, 0x83, 0x50, 0x60, 0x8f, 0x4f,
-	0x5d, 0x58, 0x31, 0x9a, 0xa4, 0x28, 0xc9, 0x03, 0x00, 0x0c, 0xb0, 0xe6, 0xb9, 0xc1, 0x76, 0xb1,
-	0x7b, 0xa2, 0xe7, 0x83, 0xbb, 0xca, 0xb1, 0xf9, 0xaf, 0xf6, 0xc0, 0x70, 0x6b, 0xab, 0x74, 0xd1,
-	0xb7, 0xe5, 0x3f, 0x93, 0x60, 0xa4, 0xbc, 0xed, 0xdd, 0x69, 0xfb, 0xb6, 0xe3, 0xd4, 0x01, 0xf7,
-	0x63, 0x27, 0x0e, 0x1a, 0x54, 0xd7, 0x76, 0xf7, 0x94, 0xb3, 0xa1, 0xa9, 0x08, 0x65, 0x49, 0x4a,
-	0x8b, 0x26, 0x95, 0xf9, 0x2d, 0x1b, 0xdd, 0x29, 0x91, 0x1d, 0xdb, 0x47, 0x6e, 0xc5, 0xc3, 0x26,
-	0xfa, 0xe2, 0x3f, 0xff, 0xc7, 0x57, 0xba, 0x4e, 0xa9, 0x63, 0x73, 0x64, 0xdb, 0xbb, 0x33, 0x17,
-	0x86, 0xf9, 0x15, 0x41, 0x6b, 0x41, 0x3a, 0xf7, 0x29, 0x49, 0xfe, 0x92, 0x04, 0x63, 0xa2, 0xb2,
-	0x70, 0x28, 0x2e, 0x87, 0xd2, 0x95, 0xa7, 0xba, 0x13, 0xa8, 0x2b, 0xbb, 0x7b, 0xca, 0xd4, 0x81,
-	0xbc, 0x31, 0x86, 0xa6, 0x54, 0x65, 0x8e, 0x97, 0xaa, 0xb3, 0x58, 0x92, 0xff, 0x4e, 0x82, 0x53,
-	0x59, 0x42, 0xbb, 0xe2, 0x61, 0x1e, 0x05, 0x25, 0x5e, 0x4c, 0x01, 0xd7, 0x50, 0xf3, 0x60, 0x91,
-	0xd5, 0x77, 0xf7, 0x94, 0xf1, 0x90, 0x2d, 0xe6, 0x5e, 0x92, 0x2c, 0x7d, 0x67, 0x5f, 0x91, 0xde,
-	0xdf, 0x57, 0xa4, 0xdd, 0x7d, 0xe5, 0xb1, 0xd4, 0x19, 0x60, 0xa7, 0x24, 0x53, 0xb5, 0x3f, 0x7f,
-	0x57, 0x91, 0x22, 0xd1, 0x52, 0xe7, 0x96, 0x2d, 0xda, 0xf9, 0x7f, 0xed, 0x4b, 0x5c, 0xa0, 0xa4,
-	0xfa, 0xf0, 0x8e, 0x04, 0x83, 0xbc, 0xf2, 0x13, 0x5f, 0x1a, 0x1b, 0xc9, 0xba, 0xe6, 0x92, 0x25,
-	0xdd, 0xea, 0xee, 0x9e, 0x32, 0xd7, 0x49, 0xba, 0x6b, 0xec, 0x32, 0x78, 0xa9, 0xf5, 0x20, 0xd3,
-	0xc5, 0xfd, 0xc5, 0xdd, 0xf6, 0x2b, 0x3a, 0x8c, 0xfb, 0x31, 0x75, 0x68, 0x8e, 0x77, 0xd7, 0xe6,
-	0xa2, 0x3b, 0x3e, 0x5c, 0x27, 0xfe, 0x40, 0x82, 0x41, 0xae, 0x13, 0x47, 0xe0, 0xb3, 0x7c, 0x44,
-	0x3e, 0x23, 0x9e, 0x84, 0x6e, 0xb4, 0xf0, 0xf4, 0x97, 0x12, 0x0c, 0xf2, 0x5a, 0xd9, 0x11, 0x78,
-	0x72, 0x8f, 0xc8, 0xd3, 0x07, 0xfb, 0xca, 0x49, 0x76, 0xcf, 0x8e, 0x94, 0xa8, 0x51, 0x29, 0xad,
-	0xc6, 0x37, 0xd2, 0x22, 0x76, 0x79, 0x17, 0xb1, 0x95, 0xdd, 0x2f, 0x49, 0xd0, 0x4f, 0xb5, 0xf8,
-	0x5e, 0xcc, 0x66, 0x42, 0xd5, 0xf5, 0xdd, 0x3d, 0xe5, 0xf1, 0x8e, 0x2a, 0x9b, 0xc5, 0xe9, 0xfb,
-	0xa1, 0x04, 0x47, 0xd4, 0x41, 0x7e, 0xdc, 0x5b, 0x18, 0xfa, 0x48, 0x82, 0xa1, 0x45, 0xcb, 0x6a,
-	0xb9, 0x58, 0x7b, 0xba, 0xe3, 0xcd, 0x42, 0x7e, 0x3f, 0x34, 0x4b, 0x98, 0x5f, 0x93, 0x8e, 0x28,
-	0xcd, 0x0f, 0xf7, 0x95, 0xe7, 0x19, 0x6d, 0xee, 0x81, 0xf8, 0xcf, 0x95, 0xe8, 0x26, 0xae, 0x00,
-	0x88, 0x0a, 0x26, 0x7f, 0x58, 0x4f, 0xdf, 0xb4, 0x65, 0x2b, 0x54, 0xd4, 0xe1, 0x39, 0xc3, 0xb2,
-	0xe2, 0x05, 0xb2, 0xcb, 0x8f, 0x7c, 0x95, 0xbf, 0xdb, 0x05, 0x23, 0x1a, 0xaa, 0x79, 0x0d, 0xf4,
-	0x00, 0x16, 0xfa, 0x3d, 0xe9, 0xe8, 0x6a, 0xb3, 0xde, 0x61, 0x75, 0x2d, 0x0b, 0x12, 0xd0, 0x6b,
-	0xc9, 0x7b, 0xc2, 0x02, 0xf6, 0x52, 0xea, 0x4e, 0xf0, 0x87, 0xfb, 0x0a, 0xc4, 0xb2, 0x8b, 0xac,
-	0x0f, 0x66, 0x6b, 0xcd, 0x14, 0xc5, 0xbb, 0x5d, 0x30, 0x72, 0x15, 0x05, 0xed, 0x97, 0x60, 0xef,
-	0x29, 0x8a, 0xc9, 0x8e, 0x08, 0x37, 0xb5, 0xeb, 0xea, 0x07, 0x54, 0x2a, 0x4f, 0x1e, 0x68, 0xe6,
-	0x5b, 0x65, 0xf2, 0x3e, 0x97, 0x09, 0x7e, 0xc0, 0x32, 0x69, 0xd3, 0x20, 0x76, 0x97, 0x3b, 0xa5,
-	0x46, 0x1d, 0xc4, 0x56, 0x45, 0x41, 0x8b, 0xcc, 0xea, 0xd8, 0xa1, 0xce, 0xe7, 0x5b, 0x12, 0x8c,
-	0x27, 0x85, 0x96, 0x2a, 0x91, 0xcb, 0x9d, 0xae, 0x24, 0x66, 0x29, 0xcf, 0x6f, 0xee, 0xee, 0x29,
-	0x4f, 0xb5, 0x4a, 0x69, 0xd1, 0x35, 0x9c, 0x66, 0x60, 0x9b, 0x29, 0x69, 0xb5, 0x19, 0xe6, 0x19,
-	0xf5, 0x54, 0x9a, 0x43, 0xd1, 0x8e, 0xe3, 0x6d, 0xbb, 0x05, 0xe9, 0xdc, 0xfc, 0xb7, 0xa7, 0xa1,
-	0x10, 0xd1, 0xf4, 0x6d, 0x79, 0x57, 0x82, 0x81, 0x65, 0xf1, 0xcf, 0x10, 0xe2, 0x12, 0xe0, 0x70,
-	0x46, 0x10, 0x9e, 0xc5, 0xe7, 0x5f, 0x1d, 0x55, 0xc9, 0xc5, 0xa6, 0xe6, 0xa3, 0x96, 0xd5, 0x87,
-	0xfb, 0xca, 0xfc, 0x8d, 0xe4, 0x7d, 0xbb, 0xb8, 0xf9, 0x72, 0xdd, 0x08, 0xec, 0xa0, 0x6e, 0x25,
-	0xba, 0x33, 0xd7, 0x3d, 0xb7, 0xca, 0x40, 0x1d, 0xdd, 0xd3, 0xa8, 0x5a, 0x0c, 0xdd, 0x53, 0x18,
-	0xab, 0x72, 0xc5, 0x7e, 0x4b, 0x82, 0x81, 0x15, 0xf1, 0x67, 0x13, 0x87, 0x5c, 0xec, 0x6f, 0x1f,
-	0xfd, 0x40, 0xc7, 0xeb, 0x8c, 0xcc, 0xac, 0x70, 0x54, 0x8c, 0xbb, 0x90, 0xb9, 0x7f, 0xe9, 0x82,
-	0x81, 0x9b, 0xe2, 0x6f, 0x35, 0x0e, 0xc9, 0xdc, 0xd7, 0xba, 0x3e, 0xd9, 0x4e, 0xfc, 0x50, 0x4a,
-	0x7e, 0xe7, 0x53, 0x5a, 0x49, 0xdf, 0xf3, 0x2b, 0xf1, 0xc2, 0x40, 0x69, 0x23, 0x71, 0x4d, 0xae,
-	0xd4, 0x7a, 0xe3, 0xa8, 0x14, 0x2d, 0xb2, 0x74, 0x2b, 0x75, 0xa9, 0x2b, 0x41, 0xad, 0x94, 0x0e,
-	0xce, 0x4b, 0x89, 0x7b, 0x56, 0xa5, 0xf5, 0x03, 0xef, 0x33, 0x95, 0xf8, 0xa7, 0xa6, 0x89, 0x97,
-	0x5c, 0x8e, 0xbb, 0xbe, 0xd1, 0x9e, 0x0b, 0x7f, 0x9a, 0xde, 0xf3, 0xaf, 0x48, 0xd0, 0x47, 0xdd,
-	0xe9, 0xc1, 0x42, 0xcd, 0x02, 0xaa, 0xb7, 0x0f, 0x6d, 0xae, 0xda, 0x77, 0x7b, 0x58, 0x1d, 0xe0,
-	0x4e, 0x35, 0xcd, 0xd5, 0x9f, 0x4a, 0x30, 0x7c, 0x15, 0x05, 0x6d, 0x0d, 0x97, 0x0e, 0x25, 0xad,
-	0x54, 0x98, 0xda, 0x3a, 0x49, 0xdd, 0xd8, 0xdd, 0x53, 0x9e, 0xb8, 0xc7, 0xee, 0xb7, 0x1d, 0x92,
-	0xd0, 0x98, 0x85, 0x7c, 0xcd, 0x85, 0x8d, 0x1d, 0x6a, 0xcc, 0xbe, 0x27, 0x41, 0x31, 0xc1, 0x1e,
-	0x6f, 0x02, 0x28, 0x9d, 0xba, 0x1a, 0x13, 0x1d, 0x47, 0xd4, 0xd7, 0x8e, 0x64, 0xcb, 0x3e, 0xd8,
-	0x57, 0x20, 0x4e, 0x78, 0x3f, 0xdc, 0x4f, 0x7f, 0x87, 0x16, 0xb9, 0xf2, 0x14, 0xfb, 0xec, 0x7f,
-	0x4c, 0x28, 0xef, 0xff, 0x25, 0xc1, 0x54, 0x82, 0xf7, 0x8c, 0x6e, 0xc6, 0x23, 0xd9, 0xdf, 0x99,
-	0xb5, 0xa0, 0x4d, 0xdc, 0x1f, 0x9a, 0xfa, 0xc6, 0xaf, 0x6a, 0x89, 0x67, 0xd4, 0xc9, 0xf4, 0x12,
-	0xc3, 0x52, 0x4e, 0xbc, 0xd6, 0x7f, 0x92, 0x40, 0xc9, 0x58, 0x2b, 0x6f, 0x60, 0xcc, 0x1c, 0xc0,
-	0x3f, 0xc3, 0x98, 0xb8, 0x27, 0x06, 0x0b, 0x7f, 0x0f, 0x7d, 0x04, 0x64, 0x2d, 0xd9, 0xed, 0xa0,
-	0xc7, 0xdc, 0xbb, 0xc7, 0x82, 0x58, 0x0f, 0x86, 0x2e, 0xe8, 0xbf, 0x25, 0x18, 0x4f, 0x9e, 0xd6,
-	0xf4, 0x8a, 0x32, 0x8f, 0xee, 0xbd, 0x17, 0xf1, 0xd5, 0xc3, 0xc7, 0x1d, 0xbb, 0xfb, 0xca, 0xf9,
-	0xb6, 0xda, 0x46, 0x54, 0x01, 0xe9, 0x58, 0xba, 0x88, 0xf2, 0x3b, 0x55, 0x9d, 0x4a, 0x1f, 0xfb,
-	0xf6, 0xb5, 0x7e, 0x4a, 0x92, 0xff, 0x58, 0x82, 0xd1, 0x45, 0xcb, 0x4a, 0x7f, 0xeb, 0xe8, 0xdb,
-	0x6e, 0x55, 0x1e, 0xef, 0xf8, 0x29, 0x64, 0x96, 0xfd, 0xd7, 0x8e, 0x60, 0xfe, 0x19, 0x9b, 0xe3,
-	0xea, 0x08, 0x0d, 0x88, 0xc5, 0xe7, 0x93, 0x49, 0x1b, 0x25, 0xff, 0x89, 0x04, 0x0a, 0x8f, 0x87,
-	0x3f, 0x31, 0x7b, 0xaf, 0x1c, 0x95, 0x3d, 0x7a, 0xc8, 0x71, 0x2d, 0x8b, 0xbb, 0x6f, 0x4b, 0x30,
-	0x96, 0x90, 0x5c, 0xb2, 0x99, 0x35, 0x9d, 0xc1, 0x5b, 0x62, 0x3c, 0x8b, 0xc1, 0x57, 0x8f, 0xc0,
-	0x60, 0x94, 0x36, 0x4d, 0xa9, 0x0a, 0x95, 0x61, 0xa2, 0x75, 0x95, 0xe2, 0xf4, 0x1d, 0x09, 0xc6,
-	0xd3, 0x72, 0xfc, 0x84, 0xcc, 0xde, 0x3c, 0xaa, 0x34, 0x27, 0xd5, 0x93, 0x73, 0xb8, 0xd6, 0x89,
-	0xcf, 0xaf, 0x4a, 0x30, 0x78, 0xc5, 0x76, 0xad, 0xe4, 0x4d, 0x89, 0xb1, 0xb6, 0xee, 0x00, 0x83,
-	0x4f, 0x74, 0x80, 0xb3, 0x8d, 0x3e, 0xdc, 0x39, 0x63, 0x8c, 0x4d, 0xa8, 0xa3, 0x73, 0x15, 0xdb,
-	0xcd, 0x54, 0xc3, 0xef, 0x49, 0x20, 0x53, 0x83, 0x20, 0x2e, 0x81, 0x1d, 0x54, 0xca, 0xc9, 0xfc,
-	0x18, 0x40, 0xbd, 0xf3, 0x2b, 0xab, 0xe1, 0xd0, 0x8d, 0xa7, 0x67, 0x3c, 0x64, 0xfb, 0x0d, 0xcf,
-	0x45, 0xa2, 0x6f, 0x12, 0x1d, 0xef, 0xb1, 0xab, 0x28, 0x48, 0x4e, 0x26, 0xeb, 0x6e, 0x47, 0xfe,
-	0x93, 0x39, 0x42, 0xaa, 0x51, 0x49, 0xfd, 0xfb, 0x23, 0x1d, 0x97, 0x90, 0x59, 0x0d, 0xa1, 0xbc,
-	0x51, 0x53, 0xcb, 0x1a, 0x28, 0x73, 0xc9, 0x56, 0x2a, 0x89, 0x0b, 0x35, 0x1a, 0x6a, 0x78, 0x3b,
-	0x28, 0xba, 0x69, 0xd4, 0x31, 0xf8, 0xe8, 0x50, 0xaa, 0x39, 0x74, 0xc8, 0x31, 0xad, 0x8e, 0xcf,
-	0x61, 0xf6, 0xce, 0x68, 0x8b, 0xf9, 0x35, 0xce, 0x1d, 0xd4, 0xa4, 0x7b, 0xfd, 0x87, 0x12, 0x0c,
-	0x5d, 0x45, 0x2e, 0x13, 0xf9, 0x91, 0xb8, 0xba, 0x79, 0x14, 0xae, 0x78, 0xce, 0xc4, 0xdf, 0x9a,
-	0xcd, 0xd7, 0x5f, 0x4b, 0x70, 0x26, 0xe1, 0x65, 0x3b, 0xa4, 0x78, 0x87, 0xe0, 0xd3, 0x3a, 0x7a,
-	0x86, 0xf7, 0xb8, 0xfa, 0x70, 0xda, 0x87, 0x76, 0x4c, 0xf5, 0xe8, 0x89, 0x1e, 0x5a, 0xde, 0x36,
-	0xdc, 0x6a, 0xf4, 0x8a, 0x95, 0x1b, 0xe5, 0xc3, 0xb0, 0xa9, 0x1d, 0x52, 0x9c, 0x91, 0xf6, 0x51,
-	0xb7, 0x62, 0xb2, 0x37, 0xc7, 0x7c, 0x5a, 0xa1, 0xe6, 0xbd, 0x23, 0x41, 0x9f, 0xf8, 0xbb, 0x0b,
-	0xfe, 0x77, 0x5f, 0x87, 0xe0, 0x68, 0xfb, 0x08, 0x1c, 0xed, 0xee, 0x2b, 0x43, 0xec, 0x34, 0x67,
-	0xda, 0x9d, 0x84, 0x7f, 0x66, 0x2c, 0x99, 0x08, 0xf3, 0x10, 0x7d, 0xfe, 0xcf, 0xbb, 0xe3, 0x96,
-	0x03, 0x0d, 0x61, 0x68, 0xb6, 0xfc, 0x4d, 0x09, 0x8a, 0xc9, 0xf0, 0x84, 0xf5, 0xaa, 0x4f, 0x76,
-	0x68, 0x5a, 0x4d, 0x74, 0x1a, 0x60, 0xfe, 0xe6, 0xc2, 0x7d, 0xed, 0x7f, 0x47, 0xaf, 0x73, 0x52,
-	0x95, 0xd3, 0x01, 0x86, 0xed, 0x56, 0x3c, 0x2e, 0xe0, 0x3a, 0xc8, 0xab, 0xee, 0xe7, 0x90, 0x19,
-	0xdc, 0x1f, 0x97, 0x19, 0x62, 0x3e, 0x7f, 0x04, 0x17, 0x23, 0x07, 0x30, 0x74, 0xb9, 0x61, 0xff,
-	0x3f, 0xbf, 0x75, 0xfe, 0x77, 0x24, 0x90, 0x5b, 0x1a, 0x43, 0x74, 0xa3, 0x5e, 0x83, 0xe1, 0xe4,
-	0x3e, 0x85, 0x2d, 0xa3, 0x89, 0xac, 0x34, 0x8a, 0x8f, 0x4d, 0x1c, 0x30, 0xa6, 0xce, 0x44, 0xfa,
-	0x92, 0x92, 0x79, 0x8d, 0x0f, 0x33, 0xb1, 0x2f, 0x4d, 0xbe, 0xf7, 0xef, 0xd3, 0xc7, 0xde, 0xfb,
-	0x70, 0x5a, 0x7a, 0xff, 0xc3, 0x69, 0xe9, 0x67, 0x1f, 0x4e, 0x4b, 0x5f, 0xfe, 0x68, 0xfa, 0xd8,
-	0xfb, 0x1f, 0x4d, 0x1f, 0xfb, 0xf1, 0x47, 0xd3, 0xc7, 0xb6, 0x7a, 0x19, 0xe1, 0xf3, 0xff, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0xd7, 0xba, 0xd2, 0xe6, 0x54, 0x00, 0x00,
+	// 6779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0x5d, 0x6c, 0x1c, 0xd7,
+	0x79, 0xa8, 0x86, 0xa4, 0xa8, 0xdd, 0x6f, 0xf9, 0xb3, 0x1c, 0xfe, 0x68, 0x48, 0x91, 0x14, 0x35,
+	0xfe, 0x93, 0xe5, 0x35, 0x19, 0x53, 0x96, 0x23, 0x33, 0x96, 0x6d, 0xfe, 0x48, 0x32, 0x2d, 0x51,
+	0xa4, 0x67, 0x29, 0xe9, 0xc6, 0x17, 0xf7, 0x0e, 0x86, 0x33, 0x67, 0x97, 0x13, 0xce, 0xce, 0x8c,
+	0xcf, 0x99, 0x5d, 0x79, 0xfd, 0x94, 0xe4, 0xe5, 0xde, 0x8b, 0x0b, 0x04, 0xa9, 0x93, 0x34, 0xa9,
+	0x5b, 0x20, 0x6e, 0x1a, 0x23, 0x41, 0xd1, 0x02, 0x81, 0xd1, 0x97, 0x24, 0x7d, 0x69, 0x5f, 0x6a,
+	0xb4, 0x68, 0xe1, 0xa0, 0x05, 0x1a, 0x18, 0x68, 0x9a, 0xca, 0x7d, 0x68, 0xd9, 0x87, 0x16, 0x0d,
+	0x29, 0x07, 0x79, 0x2a, 0xce, 0xcf, 0xfc, 0xed, 0xce, 0x52, 0x22, 0xad, 0x34, 0x6f, 0x3b, 0xdf,
+	0xf9, 0xce, 0x37, 0xdf, 0xf9, 0xce, 0x77, 0xbe, 0xdf, 0x33, 0x0b, 0x03, 0xa6, 0xe3, 0xd5, 0x2d,
+	0x07, 0x05, 0xb3, 0x3e, 0xf6, 0x02, 0x4f, 0xce, 0x23, 0xab, 0x8a, 0xd8, 0xcf, 0x89, 0xc9, 0xaa,
+	0xe7, 0x55, 0x1d, 0x34, 0x67, 0xf8, 0xf6, 0x9c, 0xe1, 0xba, 0x5e, 0x60, 0x04, 0xb6, 0xe7, 0x12,
+	0x8e, 0x38, 0x31, 0x15, 0x78, 0x9e, 0x43, 0xe6, 0xd8, 0x43, 0x15, 0xb9, 0xd1, 0x0f, 0x31, 0x3c,
+	0x14, 0xd2, 0xdd, 0x41, 0x4d, 0x01, 0xea, 0xab, 0x38, 0x46, 0xc3, 0xc3, 0xe1, 0x13, 0x46, 0xa4,
+	0xee, 0x04, 0x21, 0x3a, 0x46, 0x24, 0x30, 0xaa, 0x81, 0xb1, 0xe5, 0xa0, 0x10, 0xc1, 0xf4, 0x6a,
+	0x35, 0x2f, 0xa4, 0x37, 0x62, 0xbb, 0x15, 0x6c, 0x60, 0x44, 0xbc, 0x3a, 0x36, 0x51, 0xc8, 0x44,
+	0xde, 0xc3, 0x55, 0xf1, 0xb3, 0xdf, 0xaa, 0xa1, 0x39, 0xc7, 0x33, 0x43, 0xfc, 0xaa, 0x57, 0xf5,
+	0xd8, 0xcf, 0x39, 0xfa, 0x4b, 0x40, 0x87, 0x29, 0x92, 0xe1, 0xfb, 0x29, 0xd2, 0x83, 0x2d, 0x54,
+	0xd5, 0xbf, 0xea, 0x86, 0xe1, 0x75, 0x1f, 0x61, 0xb6, 0xde, 0x4d, 0xbb, 0x86, 0xae, 0xdb, 0x35,
+	0x3b, 0x20, 0xf2, 0x35, 0x38, 0x65, 0x62, 0x64, 0x04, 0x48, 0x37, 0x9d, 0x3a, 0x09, 0x10, 0xd6,
+	0x6d, 0x97, 0x04, 0x7a, 0x60, 0xd7, 0x90, 0x57, 0x0f, 0x14, 0x69, 0x46, 0x3a, 0xdb, 0xbd, 0xd4,
+	0xf7, 0xab, 0x9f, 0x9d, 0xce, 0xad, 0xd4, 0xf9, 0x64, 0x4d, 0xe1, 0x13, 0x96, 0x39, 0xfe, 0xaa,
+	0x4b, 0x82, 0x4d, 0x8e, 0x4d, 0x89, 0xd5, 0x7d, 0xab, 0x23, 0xb1, 0xae, 0x2c, 0x62, 0x7c, 0x42,
+	0x36, 0x31, 0x0b, 0x39, 0xa8, 0x13, 0xb1, 0xee, 0x2c, 0x62, 0x7c, 0x42, 0x06, 0xb1, 0x65, 0x38,
+	0x29, 0x96, 0x69, 0xf8, 0x7e, 0x9a, 0x50, 0x4f, 0x06, 0xa1, 0x11, 0x8e, 0xbc, 0xe8, 0xfb, 0x2d,
+	0x44, 0xc4, 0xf2, 0xda, 0x88, 0x1c, 0xcf, 0x22, 0xc2, 0x91, 0xdb, 0x89, 0x88, 0x65, 0xb5, 0x11,
+	0xe9, 0xcd, 0x22, 0xc2, 0x91, 0xd3, 0x44, 0xd4, 0x5f, 0x4a, 0x50, 0x5c, 0x16, 0xca, 0xb8, 0xea,
+	0x06, 0x08, 0xbb, 0x86, 0x23, 0x8f, 0x41, 0x6f, 0xc5, 0x46, 0x8e, 0x45, 0x14, 0x69, 0xa6, 0xfb,
+	0x6c, 0x5e, 0x13, 0x4f, 0xf2, 0x2c, 0x74, 0xef, 0xa0, 0x26, 0x93, 0x7e, 0x61, 0x7e, 0x6c, 0x36,
+	0x3a, 0x0c, 0xb3, 0x21, 0x85, 0x6b, 0xa8, 0xb9, 0xd4, 0xf3, 0xc1, 0xcf, 0x4e, 0x1f, 0xd3, 0x28,
+	0xa2, 0xfc, 0x02, 0x1c, 0xf7, 0xb1, 0xe7, 0x13, 0xa5, 0x7b, 0xa6, 0xfb, 0x6c, 0x61, 0xfe, 0xf1,
+	0x8c, 0x19, 0xe1, 0x3b, 0x67, 0x37, 0x28, 0xe2, 0x65, 0x37, 0xc0, 0x4d, 0x8d, 0x4f, 0x9a, 0xb8,
+	0x08, 0x10, 0x03, 0xe5, 0x22, 0x7f, 0x37, 0x55, 0xa3, 0x3c, 0xa7, 0x3e, 0x02, 0xc7, 0x1b, 0x86,
+	0x53, 0x47, 0x8c, 0x9f, 0xbc, 0xc6, 0x1f, 0x16, 0xba, 0x2e, 0x4a, 0x0b, 0x8f, 0xfe, 0xcb, 0x2f,
+	0x14, 0xe9, 0x3f, 0x7e, 0xa1, 0x48, 0x5f, 0xdc, 0x53, 0xa4, 0xaf, 0xee, 0x29, 0xd2, 0xfb, 0xf7,
+	0x94, 0xe2, 0x0e, 0x6a, 0x5e, 0x5a, 0xc7, 0x55, 0xc3, 0xb5, 0xdf, 0x62, 0x02, 0x51, 0xbf, 0x94,
+	0x87, 0x81, 0x0d, 0xc7, 0x08, 0x2a, 0x1e, 0xae, 0x2d, 0x7b, 0x6e, 0xc5, 0xae, 0xca, 0xcf, 0xc1,
+	0x49, 0xd3, 0x73, 0x03, 0xc3, 0x76, 0x11, 0xd6, 0x31, 0xaa, 0xda, 0x24, 0xc0, 0x4d, 0xdd, 0x37,
+	0x82, 0x6d, 0xf1, 0xe2, 0xd1, 0x68, 0x58, 0x13, 0xa3, 0x1b, 0x46, 0xb0, 0x2d, 0x9f, 0x87, 0xb1,
+	0xf0, 0x44, 0xeb, 0x8d, 0x9a, 0x6e, 0xd7, 0x8c, 0x2a, 0xe2, 0xd3, 0x38, 0x6f, 0xc3, 0xe1, 0xe8,
+	0xad, 0xda, 0x2a, 0x1d, 0x63, 0x93, 0x2e, 0xc0, 0x90, 0xeb, 0x05, 0x76, 0xa5, 0xa9, 0x9b, 0x01,
+	0x76, 0x74, 0xc3, 0xb2, 0x30, 0x61, 0xca, 0x98, 0x5f, 0xca, 0xbf, 0xfd, 0xfe, 0xf8, 0x71, 0xd7,
+	0x33, 0x6b, 0xbe, 0x36, 0xc8, 0x71, 0x96, 0x03, 0xec, 0x2c, 0x52, 0x0c, 0x59, 0x85, 0xfe, 0xc0,
+	0x21, 0xba, 0x89, 0x70, 0xa0, 0x57, 0x6c, 0x07, 0x31, 0x8d, 0xc9, 0x6b, 0x85, 0xc0, 0x21, 0xcb,
+	0x08, 0x07, 0x57, 0x6c, 0x07, 0xc9, 0x33, 0xd0, 0x47, 0x71, 0x76, 0x50, 0x93, 0xa3, 0x8c, 0x30,
+	0x14, 0x08, 0x1c, 0x72, 0x0d, 0x35, 0x19, 0xc6, 0x34, 0x14, 0x18, 0x15, 0x83, 0x23, 0x8c, 0x32,
+	0x84, 0x3c, 0xa5, 0x61, 0xb0, 0xf1, 0x17, 0xe1, 0x04, 0x72, 0x1b, 0x7a, 0xc3, 0xc0, 0x4a, 0x2f,
+	0xdb, 0xbc, 0xc7, 0x12, 0x9b, 0x97, 0x96, 0xda, 0xec, 0x65, 0xb7, 0x71, 0xcb, 0xc0, 0x7c, 0xef,
+	0x7a, 0x11, 0x7b, 0x90, 0x4b, 0xd0, 0xe7, 0x0b, 0x2c, 0x3d, 0x30, 0xaa, 0x4a, 0xae, 0x75, 0x5d,
+	0x85, 0x70, 0x78, 0xd3, 0xa8, 0xca, 0xa7, 0x20, 0x1f, 0x20, 0x12, 0xe8, 0x35, 0xcf, 0x42, 0x4a,
+	0x7e, 0x46, 0x3a, 0x9b, 0xd3, 0x72, 0x14, 0xb0, 0xe6, 0x59, 0x48, 0x9e, 0x82, 0x1e, 0xe2, 0x1b,
+	0xae, 0x02, 0xad, 0x24, 0x18, 0x58, 0x3e, 0x03, 0x7d, 0xa6, 0x83, 0x0c, 0xb7, 0xee, 0xf3, 0xe9,
+	0x05, 0x36, 0xbd, 0x20, 0x60, 0x8c, 0xc2, 0x18, 0xf4, 0xd2, 0xcd, 0xf4, 0x5c, 0xa5, 0x8f, 0xad,
+	0x53, 0x3c, 0xc9, 0x4f, 0x42, 0x91, 0xda, 0x3a, 0x84, 0x4d, 0xdb, 0x70, 0x98, 0x44, 0x89, 0xd2,
+	0xcf, 0xa6, 0x0f, 0xc6, 0x70, 0x2a, 0x54, 0x26, 0xf5, 0x3a, 0x41, 0x7a, 0xc3, 0xa8, 0x3b, 0x81,
+	0xee, 0xef, 0xd8, 0xca, 0x00, 0x7f, 0x4d, 0x9d, 0xa0, 0x5b, 0x14, 0xb6, 0xb1, 0x63, 0x53, 0xa9,
+	0xd3, 0x93, 0x68, 0xb9, 0x44, 0xc7, 0x9e, 0x17, 0x28, 0x45, 0x2e, 0x75, 0xc3, 0xf7, 0x57, 0x5c,
+	0xa2, 0x79, 0x5e, 0x20, 0x3f, 0x06, 0x03, 0x16, 0xf2, 0x1d, 0xaf, 0x59, 0x43, 0x6e, 0xc0, 0xe4,
+	0x32, 0xcc, 0x70, 0xfa, 0x63, 0x28, 0x15, 0xc7, 0x8b, 0x30, 0x66, 0xe2, 0x9a, 0x6e, 0x98, 0x26,
+	0x22, 0x44, 0xf7, 0xb1, 0xdd, 0xa0, 0xa6, 0x82, 0xaa, 0xff, 0x58, 0xab, 0x0c, 0x86, 0x4d, 0x5c,
+	0x5b, 0x64, 0x78, 0x1b, 0x1c, 0xed, 0x1a, 0x6a, 0xca, 0xcf, 0xc0, 0xa0, 0x98, 0x6b, 0xf8, 0x36,
+	0x53, 0x2c, 0xe5, 0x64, 0xeb, 0xc4, 0x7e, 0x8e, 0xb1, 0xe8, 0xdb, 0x54, 0xad, 0xe8, 0x0e, 0x98,
+	0x86, 0xb9, 0x8d, 0x74, 0xcb, 0xc6, 0x8a, 0xc2, 0x98, 0xca, 0x31, 0xc0, 0x8a, 0x8d, 0xe5, 0xd7,
+	0x60, 0x86, 0x20, 0xd3, 0x73, 0x2d, 0x03, 0x37, 0xf5, 0x0e, 0x9c, 0x8d, 0xb7, 0xbe, 0x60, 0x32,
+	0x9a, 0xb2, 0x9c, 0xc1, 0xe2, 0x59, 0x28, 0x06, 0xdb, 0x86, 0xeb, 0x11, 0x1d, 0x23, 0xb3, 0xc1,
+	0x79, 0x9c, 0x60, 0xaf, 0x1d, 0xe0, 0x70, 0x0d, 0x99, 0x0d, 0xc6, 0xd9, 0x2c, 0x0c, 0x1b, 0x2e,
+	0xb1, 0xb7, 0x1c, 0xa4, 0xfb, 0xf5, 0x2d, 0xc7, 0x36, 0x39, 0xf2, 0x29, 0x86, 0x3c, 0x24, 0x86,
+	0x36, 0xd8, 0x08, 0xc3, 0x7f, 0x06, 0x46, 0x91, 0xdb, 0xf0, 0x9a, 0xfa, 0x1d, 0x3b, 0xd8, 0xd6,
+	0xcd, 0x3a, 0x76, 0xf8, 0x79, 0x54, 0xa6, 0xd8, 0x0c, 0x99, 0x0d, 0xde, 0xb6, 0x83, 0xed, 0xe5,
+	0x3a, 0x76, 0xd8, 0x69, 0xa4, 0x53, 0xdc, 0xaa, 0xed, 0xbe, 0xd9, 0x36, 0x65, 0x9a, 0x4f, 0x61,
+	0x83, 0xa9, 0x29, 0x13, 0xcf, 0x43, 0x21, 0xa1, 0xf6, 0x87, 0xb1, 0x4e, 0xaf, 0xf6, 0xe4, 0x7a,
+	0x8a, 0xc7, 0x5f, 0xed, 0xc9, 0x4d, 0x16, 0xa7, 0xd4, 0x9f, 0x48, 0x50, 0xbc, 0x82, 0x2c, 0xe1,
+	0x4d, 0x85, 0x15, 0x9a, 0x87, 0xd1, 0x4a, 0x04, 0xd3, 0xa9, 0xc5, 0x41, 0x6f, 0x06, 0xba, 0x6d,
+	0x09, 0xf2, 0xc3, 0x95, 0xe4, 0x04, 0x3a, 0xb6, 0x6a, 0x51, 0xcb, 0xe5, 0x1b, 0x38, 0xa0, 0x76,
+	0x2b, 0x31, 0x97, 0x49, 0x8a, 0x33, 0x30, 0x2a, 0x86, 0xe3, 0xb7, 0x31, 0x69, 0x9d, 0x85, 0x62,
+	0x02, 0xdf, 0xda, 0xa2, 0xaf, 0xa1, 0x36, 0xa8, 0x47, 0x1b, 0x88, 0xe1, 0x2b, 0x5b, 0xab, 0x96,
+	0xfc, 0x04, 0x0c, 0x26, 0x30, 0x5d, 0xa3, 0x86, 0x98, 0xc3, 0xcb, 0x27, 0x11, 0x6f, 0x18, 0x35,
+	0xa4, 0xee, 0x16, 0xa1, 0x18, 0x5a, 0x88, 0x2b, 0xc8, 0x08, 0xea, 0x18, 0x11, 0xf9, 0x11, 0xe8,
+	0x8f, 0xed, 0x41, 0xd3, 0x47, 0x62, 0x2d, 0x91, 0x91, 0xd8, 0x6c, 0xfa, 0x88, 0x2a, 0xa1, 0xeb,
+	0x59, 0x88, 0x23, 0x8c, 0x73, 0x25, 0xa4, 0x00, 0x36, 0xb8, 0x08, 0x53, 0xa4, 0xee, 0xfb, 0x1e,
+	0x0e, 0x88, 0x5e, 0xab, 0x3b, 0x81, 0xad, 0x07, 0xc8, 0x35, 0xdc, 0x20, 0x74, 0xea, 0x6c, 0x9d,
+	0x39, 0x6d, 0x22, 0x44, 0x5a, 0xa3, 0x38, 0x9b, 0x0c, 0x45, 0xb8, 0x71, 0xf9, 0x59, 0x18, 0x8b,
+	0x48, 0x90, 0x6d, 0x03, 0x23, 0x4b, 0x6f, 0x78, 0x4e, 0xbd, 0x86, 0xd8, 0x92, 0x73, 0xda, 0x48,
+	0x38, 0x5a, 0x66, 0x83, 0xb7, 0xd8, 0x18, 0xdd, 0x8e, 0x68, 0x56, 0x80, 0xeb, 0x24, 0xd0, 0x7d,
+	0xcf, 0xb1, 0xcd, 0x26, 0x5b, 0x7e, 0x4e, 0x1b, 0x0e, 0x07, 0x37, 0xe9, 0xd8, 0x06, 0x1b, 0x92,
+	0x2f, 0x82, 0x12, 0xcd, 0xd9, 0xa9, 0x6f, 0x21, 0xec, 0xa2, 0x00, 0x11, 0xdd, 0x73, 0x9d, 0x26,
+	0xb3, 0xd7, 0x39, 0x2d, 0xe2, 0xe4, 0x5a, 0x34, 0xbc, 0xee, 0x3a, 0x4d, 0xf9, 0x2a, 0xcc, 0x24,
+	0x26, 0x60, 0xf4, 0x46, 0xdd, 0xc6, 0x88, 0xe8, 0x77, 0x3c, 0xbc, 0x83, 0xb0, 0x4e, 0xa5, 0x41,
+	0x98, 0x7b, 0xcf, 0x69, 0x53, 0x31, 0x9e, 0x26, 0xd0, 0x6e, 0x33, 0xac, 0x1b, 0x14, 0x89, 0xf9,
+	0xb2, 0xd0, 0x27, 0x11, 0x84, 0x1b, 0xb6, 0x89, 0x88, 0xee, 0x78, 0xa6, 0xe1, 0x28, 0x27, 0xd8,
+	0xfc, 0xd1, 0x70, 0xb8, 0x2c, 0x46, 0xaf, 0xd3, 0x41, 0xf9, 0xb3, 0xa0, 0xd8, 0xbe, 0x6e, 0x38,
+	0x14, 0x35, 0x40, 0x96, 0xee, 0x23, 0x1c, 0xce, 0x67, 0x56, 0x3c, 0xa7, 0x8d, 0xda, 0xfe, 0x62,
+	0x38, 0xbc, 0x81, 0xb0, 0x98, 0x2e, 0x5f, 0x80, 0x93, 0xd1, 0x9a, 0xb9, 0x07, 0xa4, 0xfb, 0xa8,
+	0x7b, 0x8d, 0x8a, 0x30, 0xe9, 0x91, 0x78, 0xd9, 0x11, 0xa2, 0x9b, 0xba, 0xde, 0xa8, 0x74, 0x9e,
+	0x66, 0x30, 0xe3, 0x98, 0x3d, 0xcd, 0x90, 0x27, 0x01, 0x6c, 0x42, 0x9d, 0xad, 0xef, 0x79, 0x0e,
+	0xf3, 0x0d, 0x39, 0x2d, 0x67, 0x93, 0x5b, 0xb5, 0x0d, 0xcf, 0x73, 0xe4, 0x93, 0x70, 0xc2, 0x26,
+	0x7a, 0xc5, 0xd8, 0x09, 0xfd, 0x41, 0xaf, 0x4d, 0xae, 0x18, 0x3b, 0x48, 0x0c, 0xd4, 0x3c, 0x73,
+	0x87, 0x99, 0x1b, 0x36, 0xb0, 0xe6, 0x99, 0x3b, 0xf2, 0xcb, 0x30, 0x19, 0xb1, 0x61, 0x58, 0x96,
+	0x4d, 0xd5, 0xd9, 0x70, 0x74, 0x17, 0x05, 0x54, 0xf4, 0x84, 0x79, 0x8e, 0x84, 0x76, 0x2d, 0x46,
+	0x28, 0x37, 0x04, 0x86, 0xfc, 0x12, 0x4c, 0xda, 0x44, 0x27, 0xb6, 0x5b, 0x75, 0x50, 0x72, 0xd3,
+	0x43, 0xfd, 0xe4, 0x9e, 0x65, 0xdc, 0x26, 0x65, 0x86, 0x12, 0xef, 0x7b, 0xa8, 0x9e, 0x4b, 0x30,
+	0x1d, 0xb3, 0x10, 0x86, 0x74, 0x16, 0xb2, 0x6c, 0xbe, 0x11, 0xb6, 0x2f, 0x9c, 0x4e, 0xcc, 0x04,
+	0x8f, 0xe5, 0x56, 0x42, 0x94, 0x55, 0x5f, 0xfe, 0x3c, 0x9c, 0x8b, 0x68, 0x44, 0x07, 0x6e, 0xdb,
+	0xae, 0x6e, 0xeb, 0x46, 0xc3, 0xb0, 0x1d, 0x63, 0xcb, 0x76, 0xec, 0xa0, 0xa9, 0x7b, 0xae, 0xbe,
+	0x73, 0x91, 0x28, 0x83, 0x8c, 0xde, 0x63, 0xe1, 0x8c, 0xf0, 0xd4, 0xbe, 0x62, 0x57, 0xb7, 0x17,
+	0x13, 0xe8, 0xeb, 0xee, 0xb5, 0x8b, 0x44, 0xd6, 0xe1, 0xe9, 0x07, 0x24, 0x6d, 0x79, 0xe6, 0x0e,
+	0xc2, 0xcc, 0xff, 0xe5, 0xb4, 0xb3, 0xf7, 0xa7, 0xbe, 0xc2, 0xf0, 0xe5, 0x2b, 0x30, 0xe3, 0x7a,
+	0x19, 0x92, 0xd3, 0x8d, 0x7a, 0xe0, 0xe9, 0xc4, 0x34, 0x1c, 0xa4, 0x0c, 0x31, 0x9a, 0x93, 0xae,
+	0xd7, 0x26, 0xbe, 0xc5, 0x7a, 0xe0, 0x95, 0x29, 0x8e, 0xbc, 0x0c, 0xd3, 0x36, 0x75, 0x4e, 0x68,
+	0xab, 0x6e, 0x3b, 0x41, 0xd6, 0x56, 0xc8, 0x8c, 0xca, 0x29, 0x9b, 0x6c, 0x08, 0xa4, 0xf6, 0xcd,
+	0x28, 0x81, 0xec, 0x7a, 0x11, 0x07, 0x62, 0x0d, 0x2c, 0x90, 0xca, 0x69, 0x45, 0xd7, 0x13, 0x68,
+	0x65, 0x0e, 0x97, 0xa7, 0x98, 0x36, 0xd2, 0x08, 0x69, 0xcb, 0x7b, 0x93, 0x45, 0x53, 0x39, 0x2d,
+	0x6f, 0x93, 0xcb, 0x1c, 0x40, 0xad, 0x5f, 0xac, 0xe3, 0x7e, 0xe3, 0x39, 0xe6, 0xbd, 0x72, 0x5a,
+	0x5f, 0xa4, 0xd9, 0x7e, 0xe3, 0x39, 0x79, 0x0e, 0x46, 0xa2, 0xe3, 0x4e, 0x9d, 0xac, 0xe7, 0x32,
+	0x82, 0xca, 0x24, 0xc3, 0x1d, 0x0a, 0xc7, 0x96, 0x71, 0x6d, 0xdd, 0xa5, 0x84, 0xa9, 0xdb, 0x4a,
+	0x4f, 0xa8, 0x54, 0xf8, 0x8c, 0x29, 0x36, 0x43, 0x4e, 0xce, 0xa8, 0x54, 0xd8, 0x94, 0xf9, 0xe4,
+	0x14, 0x1a, 0x41, 0x62, 0x54, 0xc1, 0x88, 0x6c, 0x33, 0x4f, 0x97, 0xd3, 0x86, 0xa3, 0x29, 0x08,
+	0x07, 0x1a, 0x1f, 0xa2, 0x7a, 0x9d, 0x36, 0xbc, 0xbe, 0x83, 0x98, 0x21, 0x62, 0x47, 0x8f, 0x28,
+	0xa7, 0xb9, 0x5e, 0xa7, 0xec, 0xae, 0xef, 0x20, 0x6a, 0x85, 0xe8, 0x59, 0x24, 0xf2, 0xf3, 0x30,
+	0x5e, 0x33, 0x5c, 0xa3, 0x8a, 0x08, 0x55, 0x3a, 0xe6, 0xd0, 0xb0, 0xe7, 0x08, 0x5b, 0x36, 0xc3,
+	0xad, 0xa1, 0x40, 0xb8, 0x76, 0x91, 0x2c, 0xf3, 0x61, 0x6e, 0xc4, 0xce, 0x40, 0x5f, 0x9d, 0x20,
+	0xa2, 0xdb, 0x6e, 0x15, 0x23, 0x42, 0x94, 0x33, 0x51, 0xd4, 0x45, 0x56, 0x39, 0x48, 0xbe, 0x0e,
+	0x05, 0x11, 0x8e, 0x34, 0x0c, 0x4c, 0x94, 0x31, 0x16, 0xad, 0x3e, 0x95, 0x11, 0xad, 0x86, 0xbe,
+	0x68, 0x96, 0x07, 0x23, 0xb7, 0x0c, 0x2c, 0xf2, 0x0d, 0x30, 0x22, 0x80, 0x7c, 0x0d, 0x80, 0x66,
+	0x1f, 0x08, 0x07, 0x36, 0x22, 0xca, 0xc9, 0xfb, 0x13, 0xdb, 0x88, 0xb0, 0x05, 0xb1, 0x78, 0xba,
+	0xfc, 0x3a, 0x8c, 0x87, 0xd9, 0xb3, 0xfe, 0x46, 0xdd, 0x0b, 0x0c, 0x3d, 0x41, 0x5b, 0x61, 0xb4,
+	0x95, 0x04, 0xed, 0x55, 0x9a, 0xc4, 0x6b, 0x62, 0x82, 0xc8, 0xa3, 0x4e, 0x86, 0x04, 0x5e, 0xa3,
+	0xf3, 0xe3, 0x97, 0xc9, 0x4f, 0xd1, 0x50, 0x92, 0x65, 0x7f, 0x3e, 0x46, 0xbe, 0x81, 0x91, 0x62,
+	0x52, 0xd9, 0x2c, 0xf5, 0x7c, 0x7f, 0x4f, 0x91, 0x68, 0x40, 0x49, 0xc7, 0x36, 0xf8, 0xd0, 0xc4,
+	0x2d, 0x18, 0x6c, 0x59, 0x74, 0x46, 0xc4, 0xf2, 0x74, 0x32, 0x62, 0x29, 0xcc, 0x9f, 0x4c, 0xae,
+	0x9a, 0xbf, 0xb7, 0xb9, 0xea, 0x56, 0xbc, 0x44, 0x28, 0x43, 0xe9, 0xb6, 0xac, 0xff, 0xa1, 0xd0,
+	0x5d, 0x38, 0x9b, 0x91, 0xc0, 0xf5, 0xb8, 0x9e, 0x8b, 0xfe, 0xfc, 0x13, 0xa5, 0x6f, 0x23, 0x11,
+	0x32, 0xa8, 0xff, 0xd8, 0x05, 0x03, 0x61, 0x2e, 0xa9, 0x21, 0xb2, 0x66, 0xf8, 0xf2, 0x42, 0xcc,
+	0x41, 0xe7, 0x2c, 0xb5, 0xb8, 0x7b, 0x4f, 0xc9, 0x85, 0x80, 0x38, 0x63, 0x7d, 0x0d, 0x4e, 0xd4,
+	0x0c, 0xdf, 0xb7, 0xdd, 0xaa, 0xd2, 0xd5, 0x31, 0x67, 0xe5, 0xef, 0x99, 0x5d, 0xe3, 0x88, 0x6c,
+	0xd9, 0x4b, 0x83, 0xbb, 0xf7, 0x94, 0x82, 0x86, 0xc8, 0xa6, 0x51, 0xdd, 0x34, 0xb6, 0x1c, 0xa4,
+	0x85, 0x74, 0x26, 0x16, 0xa0, 0x2f, 0x89, 0x79, 0xa8, 0x44, 0xf6, 0x4b, 0xd2, 0x3b, 0xfb, 0xca,
+	0xcd, 0xd0, 0x4f, 0x5f, 0xba, 0x86, 0x9a, 0xb3, 0x34, 0xc4, 0x2a, 0x85, 0x10, 0x0f, 0x57, 0x19,
+	0x30, 0x99, 0xd7, 0x96, 0x44, 0x38, 0x86, 0xac, 0x70, 0xf4, 0x4a, 0x08, 0x48, 0xa2, 0x7d, 0x67,
+	0x5f, 0x19, 0xef, 0x38, 0xf8, 0x97, 0xfb, 0xca, 0x09, 0xc1, 0xb4, 0xba, 0x05, 0x05, 0xa6, 0x98,
+	0x71, 0x70, 0x8a, 0xde, 0xe4, 0x39, 0x7b, 0xe8, 0x1c, 0x79, 0x30, 0x28, 0x82, 0xd3, 0x70, 0x50,
+	0xb8, 0x45, 0xca, 0xae, 0x7c, 0x1a, 0x0a, 0xbc, 0xba, 0xc5, 0x31, 0xf9, 0x32, 0x81, 0x83, 0x58,
+	0xc8, 0xb8, 0x05, 0xfd, 0x5a, 0x52, 0xcf, 0x65, 0x19, 0x7a, 0x12, 0x44, 0xd9, 0xef, 0xb4, 0x98,
+	0x7a, 0x84, 0x98, 0x68, 0x58, 0x6a, 0x38, 0xd4, 0x92, 0x05, 0xdb, 0xd4, 0x5a, 0x79, 0x0e, 0x8f,
+	0x5f, 0x8f, 0x6b, 0x03, 0x0c, 0xbc, 0x19, 0x42, 0xd5, 0x3a, 0xf4, 0x5d, 0xdd, 0xb8, 0xb9, 0x82,
+	0xed, 0x06, 0xc2, 0x34, 0x03, 0x39, 0x93, 0x7c, 0xc5, 0x52, 0xff, 0x8f, 0xee, 0x29, 0xf9, 0xaa,
+	0x5f, 0xb7, 0xd8, 0xb8, 0x78, 0xe3, 0xb3, 0xd0, 0xe7, 0x25, 0xa4, 0xc2, 0x19, 0x5f, 0x2a, 0xfe,
+	0xe8, 0x9e, 0xd2, 0x17, 0xa1, 0x7a, 0xb8, 0xaa, 0xa5, 0xb0, 0x16, 0xfa, 0xa8, 0xf2, 0xfe, 0xf2,
+	0x17, 0x8a, 0xf4, 0x83, 0x77, 0x4f, 0x4b, 0xea, 0x4f, 0xba, 0x60, 0x20, 0x7a, 0xef, 0x52, 0xdd,
+	0x76, 0xac, 0xcc, 0xc5, 0x9d, 0x86, 0x02, 0xa7, 0x97, 0x2c, 0x1b, 0x00, 0x07, 0xb1, 0x6a, 0xc1,
+	0x39, 0x18, 0x4a, 0x20, 0xe8, 0x26, 0x46, 0x96, 0xa8, 0x16, 0x68, 0x83, 0x31, 0xda, 0x32, 0x05,
+	0xcb, 0x2f, 0x40, 0xd1, 0xe3, 0x15, 0x3a, 0xb7, 0xaa, 0x93, 0x26, 0x09, 0x50, 0x8d, 0x05, 0xab,
+	0x03, 0xf3, 0x43, 0x09, 0x75, 0x5e, 0x2f, 0xd3, 0x13, 0xa4, 0x0d, 0x46, 0xa8, 0x65, 0x86, 0x49,
+	0x93, 0xd4, 0x1d, 0xea, 0x0c, 0x1d, 0xbd, 0x81, 0x30, 0xa1, 0xeb, 0xe6, 0x15, 0x86, 0x7e, 0x0e,
+	0xbd, 0xc5, 0x81, 0x54, 0xf0, 0xdb, 0x4d, 0x9f, 0xc6, 0x7e, 0xc4, 0xc3, 0xba, 0xed, 0x56, 0x3c,
+	0x16, 0x97, 0xe6, 0xb5, 0x81, 0x18, 0x4c, 0xcf, 0x35, 0xcd, 0xbe, 0x6b, 0xd6, 0x05, 0x52, 0xaf,
+	0xb1, 0xb8, 0x33, 0xaf, 0x89, 0x27, 0xf9, 0x09, 0xe8, 0x23, 0x81, 0x87, 0xa3, 0x52, 0x09, 0x2f,
+	0x11, 0x70, 0xfb, 0x55, 0x10, 0x23, 0x74, 0x4d, 0x0b, 0x83, 0x6f, 0xef, 0x2b, 0x85, 0x72, 0x0c,
+	0x50, 0xff, 0x5d, 0x82, 0x91, 0xb4, 0x4c, 0xd7, 0x50, 0x6d, 0x0b, 0x61, 0x79, 0x2e, 0x79, 0xf4,
+	0x93, 0x86, 0x26, 0xb9, 0xf3, 0xc9, 0x0a, 0xd5, 0x05, 0x38, 0x4e, 0xfd, 0xbf, 0x25, 0x6c, 0xd3,
+	0x78, 0xd6, 0x14, 0xf6, 0x02, 0x31, 0x89, 0x63, 0x53, 0xb7, 0x64, 0x57, 0x5d, 0x0f, 0x23, 0x9d,
+	0x04, 0x46, 0x10, 0xa6, 0x0f, 0x05, 0x0e, 0x2b, 0x53, 0xd0, 0xc2, 0xf5, 0x77, 0xf6, 0x95, 0x67,
+	0x23, 0x2d, 0xa1, 0x7b, 0x1c, 0x1f, 0xdf, 0xa4, 0xf2, 0xb4, 0x9d, 0xdf, 0xcc, 0x5a, 0x95, 0x09,
+	0x43, 0x69, 0x7e, 0x6e, 0x6a, 0xd7, 0xe5, 0x47, 0x61, 0x80, 0xb1, 0xa3, 0xd3, 0x84, 0x35, 0x51,
+	0xa4, 0xea, 0x63, 0xd0, 0x9b, 0xd8, 0x61, 0x8a, 0x73, 0x16, 0x72, 0x0d, 0xc3, 0xb1, 0x2d, 0x3b,
+	0x68, 0x66, 0xd6, 0x4d, 0xa3, 0x51, 0xf5, 0xc7, 0xbd, 0x90, 0x8f, 0xde, 0xd2, 0xb1, 0x08, 0x38,
+	0x97, 0x2c, 0x02, 0x3e, 0x88, 0x8c, 0x3f, 0x0b, 0xbd, 0x8c, 0xa1, 0xb0, 0x0c, 0x78, 0x5f, 0x21,
+	0x0b, 0x74, 0xaa, 0x88, 0x8e, 0x6d, 0x22, 0x97, 0x20, 0x1a, 0x33, 0x54, 0xec, 0xaa, 0x48, 0x38,
+	0xfb, 0x05, 0x34, 0xb6, 0x48, 0x69, 0x34, 0x5d, 0xa8, 0x1b, 0x57, 0xdb, 0xe1, 0x14, 0xf6, 0x1a,
+	0xd7, 0xbd, 0x95, 0x94, 0x9b, 0xe7, 0x15, 0xae, 0x47, 0xb3, 0xf8, 0x3a, 0xd0, 0xbf, 0x8f, 0xc0,
+	0x71, 0xbe, 0xff, 0x5c, 0xb1, 0xf9, 0x43, 0x9b, 0x72, 0xe4, 0xda, 0x94, 0x23, 0xc3, 0x79, 0xe7,
+	0x3b, 0x3a, 0x6f, 0xf9, 0x59, 0x18, 0x0e, 0xcf, 0xc9, 0x56, 0xdd, 0xdc, 0x41, 0x01, 0xb7, 0xa2,
+	0x90, 0x38, 0x2e, 0x43, 0x02, 0x61, 0x89, 0x8d, 0x33, 0x9b, 0xbb, 0x0c, 0xa7, 0x5a, 0xa4, 0x92,
+	0x3a, 0x6c, 0x85, 0xc4, 0x6c, 0x25, 0x25, 0xa1, 0xc4, 0x41, 0x9b, 0xb8, 0xf4, 0x20, 0xfe, 0xbd,
+	0xb3, 0xfb, 0xda, 0x95, 0x5a, 0xfd, 0xf8, 0xb7, 0xf6, 0x14, 0xe9, 0x07, 0x7b, 0x8a, 0xf4, 0xc1,
+	0x9e, 0x22, 0xfd, 0x74, 0x4f, 0x91, 0xde, 0xde, 0x57, 0x34, 0x26, 0x92, 0xd2, 0xf5, 0x96, 0x5d,
+	0x2a, 0xd7, 0x6b, 0xa5, 0x95, 0xa4, 0x1c, 0x4a, 0xe5, 0xd6, 0x35, 0xa6, 0xe7, 0x24, 0xf8, 0x3e,
+	0xea, 0xd1, 0xfb, 0xce, 0xbe, 0x52, 0x7c, 0x90, 0xe3, 0xf8, 0xe5, 0x4f, 0x98, 0x03, 0xe0, 0x1a,
+	0xb2, 0xe8, 0xdb, 0xef, 0x7e, 0xa2, 0x48, 0xea, 0x07, 0x5d, 0xec, 0xf4, 0x08, 0xa5, 0x5c, 0x82,
+	0x5e, 0xfe, 0x9e, 0xfb, 0x19, 0xa3, 0xa1, 0xdd, 0x7b, 0x4a, 0x7c, 0xea, 0xb8, 0xfe, 0xf3, 0x99,
+	0x2d, 0x4a, 0xda, 0x95, 0xa5, 0xa4, 0xa2, 0x02, 0x7b, 0x90, 0x92, 0xb6, 0x9f, 0xa2, 0xee, 0x43,
+	0x9d, 0xa2, 0x9e, 0x8e, 0xa7, 0xe8, 0xd3, 0xaa, 0xc7, 0xc9, 0xb7, 0xf7, 0x95, 0xe1, 0x8c, 0x7d,
+	0x57, 0xbf, 0xa1, 0x42, 0x14, 0x9b, 0x3d, 0xb4, 0x66, 0xc4, 0x4b, 0x90, 0x63, 0x35, 0x8b, 0xd0,
+	0xa1, 0x15, 0xe6, 0xa7, 0x66, 0x2d, 0x9b, 0x04, 0xd8, 0xde, 0xaa, 0xd3, 0xf4, 0xba, 0x66, 0x04,
+	0xe6, 0xb6, 0x8e, 0xdc, 0xaa, 0xed, 0xa2, 0xd9, 0xeb, 0x9e, 0x29, 0xe6, 0x46, 0x93, 0xa8, 0xdb,
+	0x7e, 0xcb, 0x73, 0x91, 0xb2, 0xc8, 0xdd, 0x36, 0xfd, 0x2d, 0x9f, 0x07, 0xb0, 0xfd, 0x28, 0x3b,
+	0xec, 0x65, 0x3e, 0x76, 0x24, 0x19, 0xd2, 0xfb, 0x22, 0x43, 0xd4, 0xf2, 0xb6, 0x9f, 0x48, 0x16,
+	0xa9, 0x65, 0xb0, 0x4d, 0xdd, 0xf6, 0x89, 0xb0, 0x1d, 0x79, 0x0e, 0x59, 0xf5, 0x89, 0xfc, 0x38,
+	0x0c, 0xba, 0xf5, 0x9a, 0x6e, 0x35, 0x5d, 0xa3, 0x26, 0x70, 0x72, 0x2c, 0xa2, 0xe9, 0x77, 0xeb,
+	0xb5, 0x15, 0x0e, 0xa5, 0x78, 0x97, 0xa1, 0x10, 0xd8, 0x35, 0xa4, 0x3b, 0xac, 0xff, 0xc6, 0x2c,
+	0x48, 0x61, 0x7e, 0x3a, 0xe9, 0xe0, 0xdb, 0xbb, 0x74, 0x62, 0x51, 0x10, 0xc4, 0x7d, 0xbb, 0xc7,
+	0xa0, 0x17, 0x61, 0xec, 0x61, 0xa2, 0x00, 0x95, 0xef, 0x52, 0x3f, 0xb5, 0x09, 0x71, 0x19, 0x57,
+	0x0c, 0xca, 0xe7, 0x43, 0x5b, 0xd7, 0xc7, 0x16, 0x99, 0xd4, 0xe7, 0x4d, 0x6c, 0x98, 0x3b, 0xc8,
+	0x62, 0xe7, 0x58, 0x98, 0x14, 0x61, 0x0a, 0x5f, 0x82, 0x3e, 0x96, 0x98, 0x36, 0x10, 0xc6, 0xb6,
+	0x85, 0x58, 0x09, 0x64, 0x20, 0xbd, 0x59, 0xda, 0xda, 0xba, 0x18, 0x0d, 0x5d, 0xbf, 0x89, 0x6b,
+	0x21, 0x48, 0x9e, 0x83, 0x62, 0xa2, 0x60, 0xce, 0xab, 0x57, 0x03, 0x09, 0x53, 0x39, 0x18, 0x8f,
+	0xf2, 0xea, 0xd5, 0xf3, 0xad, 0x75, 0xc6, 0x41, 0x66, 0xe8, 0x46, 0x76, 0xef, 0x29, 0x6d, 0x45,
+	0xc9, 0x96, 0xea, 0xe3, 0x05, 0x10, 0xbd, 0x16, 0x9d, 0x60, 0x51, 0x91, 0x2e, 0xf2, 0xd8, 0x30,
+	0x2d, 0x91, 0x7e, 0x8e, 0x55, 0xc6, 0xbc, 0x3e, 0xfd, 0x02, 0xf4, 0xf2, 0x48, 0x96, 0xd5, 0x26,
+	0x0a, 0xa9, 0xed, 0xbf, 0xc2, 0x06, 0xa8, 0x22, 0x0e, 0xec, 0xde, 0x53, 0x7a, 0xf9, 0x23, 0x3f,
+	0xe3, 0x7c, 0x0e, 0xab, 0x8b, 0x6e, 0x37, 0x89, 0x6d, 0xd2, 0x70, 0x9a, 0x9a, 0x75, 0x59, 0xd4,
+	0x45, 0x05, 0x90, 0xd9, 0xf2, 0x8b, 0x71, 0x33, 0x66, 0x98, 0x59, 0x81, 0xd3, 0x19, 0xea, 0x9e,
+	0xd9, 0x86, 0x79, 0x0a, 0x86, 0xe2, 0x86, 0x56, 0x18, 0xce, 0xf1, 0x6e, 0x50, 0x31, 0x1a, 0x08,
+	0x23, 0xba, 0xcf, 0x41, 0xaf, 0xb0, 0x10, 0xa3, 0x6d, 0xd1, 0x50, 0xba, 0xe5, 0xb3, 0x94, 0xa3,
+	0x22, 0xe1, 0x0b, 0xe1, 0x53, 0xe4, 0xdb, 0x50, 0xc0, 0x88, 0xe8, 0x81, 0x51, 0xd5, 0x6b, 0x86,
+	0x2f, 0xd2, 0x70, 0x35, 0x8b, 0x4f, 0x9e, 0x25, 0xad, 0x19, 0x3e, 0xcf, 0x9c, 0x86, 0x29, 0xa9,
+	0xd6, 0xec, 0x29, 0x8f, 0x43, 0x24, 0xb9, 0x9c, 0xce, 0xef, 0x79, 0x4a, 0xfe, 0x48, 0x16, 0xe1,
+	0x96, 0x14, 0xb7, 0x75, 0xdf, 0x92, 0x69, 0xfe, 0x59, 0x28, 0x46, 0x7d, 0xba, 0x50, 0x2c, 0xbc,
+	0xeb, 0x31, 0xd0, 0xe0, 0x2d, 0xba, 0x50, 0x28, 0xd3, 0x00, 0xb1, 0x8e, 0x89, 0x16, 0x45, 0x02,
+	0x22, 0x2f, 0x43, 0x91, 0x35, 0xdf, 0x79, 0xab, 0x85, 0xbd, 0x81, 0x55, 0x77, 0x06, 0x52, 0xe2,
+	0x63, 0x19, 0xd4, 0xa2, 0x6f, 0x73, 0x16, 0xb5, 0x01, 0x3b, 0xf5, 0x4c, 0xcf, 0x09, 0x27, 0x22,
+	0xe4, 0x3f, 0xd9, 0x66, 0xd4, 0x12, 0x29, 0x98, 0x38, 0xc3, 0x05, 0x3b, 0x91, 0x95, 0xdd, 0x86,
+	0xa1, 0x9a, 0x61, 0xbb, 0xac, 0x22, 0x6e, 0x86, 0x81, 0xc7, 0x34, 0x63, 0xe3, 0x5c, 0x67, 0x2b,
+	0xb7, 0x16, 0x4f, 0x61, 0x87, 0x57, 0x2b, 0xd6, 0x5a, 0x20, 0xf2, 0x2a, 0x9c, 0x09, 0x4f, 0xaf,
+	0x28, 0x7b, 0xeb, 0xed, 0x0a, 0xc5, 0x2b, 0x40, 0xd3, 0x21, 0x22, 0xaf, 0x81, 0x2f, 0xb7, 0xaa,
+	0xd7, 0x23, 0x70, 0x22, 0x2c, 0xd7, 0xce, 0xb0, 0x73, 0x05, 0xf4, 0x4c, 0xdc, 0x5a, 0xdb, 0xf0,
+	0x3c, 0x47, 0xeb, 0x6d, 0xf0, 0xc2, 0xed, 0xcb, 0x30, 0x9a, 0x6c, 0x30, 0xf1, 0x86, 0x0f, 0xb5,
+	0xf3, 0x67, 0xb2, 0x8e, 0xa2, 0x1c, 0x77, 0xbf, 0x18, 0x26, 0xcd, 0xeb, 0x5e, 0x85, 0xd3, 0x09,
+	0x0a, 0x3b, 0xa8, 0xa9, 0xd7, 0xfd, 0x2a, 0x36, 0x2c, 0x14, 0x16, 0xd3, 0x2d, 0x45, 0x4d, 0x58,
+	0x90, 0x53, 0x11, 0x89, 0x6b, 0xa8, 0x79, 0x93, 0x63, 0x8a, 0x72, 0xba, 0x25, 0x7f, 0x1e, 0x80,
+	0xf7, 0xef, 0x2d, 0xdd, 0x08, 0x94, 0x47, 0xd8, 0xae, 0x3c, 0xd2, 0x59, 0x9e, 0xd4, 0xce, 0x92,
+	0xc0, 0xa8, 0xf9, 0x4b, 0xa3, 0x82, 0xcf, 0x7c, 0x10, 0x82, 0xd8, 0x9e, 0xe5, 0x05, 0xb5, 0xc5,
+	0x80, 0x92, 0xe6, 0x5d, 0x7d, 0x46, 0xfa, 0xd1, 0x4f, 0x4f, 0x5a, 0x50, 0x5b, 0x0c, 0xe4, 0x79,
+	0xe8, 0x4b, 0xf5, 0x29, 0x1e, 0x63, 0xa2, 0x63, 0x15, 0x8a, 0x44, 0x8f, 0x42, 0x2b, 0x04, 0x89,
+	0x86, 0xc5, 0x35, 0x90, 0x93, 0x73, 0x84, 0x06, 0x3d, 0xfe, 0x20, 0xb6, 0xbe, 0x98, 0xa0, 0xc3,
+	0x95, 0xe6, 0x2a, 0x0c, 0xa6, 0xeb, 0x5e, 0x44, 0x79, 0xa2, 0xad, 0xda, 0x95, 0x4a, 0xf8, 0x85,
+	0x4e, 0x0f, 0xa4, 0xaa, 0x5d, 0x44, 0xbe, 0x0a, 0x33, 0x16, 0xaa, 0xb0, 0x9e, 0x6b, 0x44, 0xb0,
+	0x35, 0xdb, 0x3f, 0xcb, 0x7c, 0xe3, 0x94, 0xc0, 0x0b, 0xa9, 0x2e, 0xa6, 0x92, 0x7f, 0xf9, 0x02,
+	0x0c, 0xbc, 0xe2, 0x91, 0x40, 0xd4, 0x16, 0x1d, 0x84, 0x95, 0x27, 0xb3, 0xf4, 0xa9, 0x05, 0x89,
+	0x5a, 0xe7, 0x1d, 0xa3, 0xb2, 0x63, 0x44, 0x85, 0xe3, 0x73, 0xdc, 0x3a, 0x33, 0x60, 0x58, 0x29,
+	0x9e, 0x02, 0xe0, 0x48, 0x75, 0x82, 0xb0, 0xf2, 0x14, 0x77, 0xe7, 0x0c, 0x72, 0x93, 0x20, 0xcc,
+	0xd2, 0x69, 0x36, 0xec, 0x1b, 0x84, 0xdc, 0xf1, 0xb0, 0xa5, 0x94, 0x44, 0x3a, 0x4d, 0xa1, 0x1b,
+	0x02, 0x28, 0x3f, 0x0f, 0x50, 0xf5, 0xeb, 0xa1, 0x01, 0x78, 0xba, 0xcd, 0x95, 0x44, 0xc1, 0x9e,
+	0x10, 0x55, 0xbe, 0xea, 0xd7, 0xc5, 0xe1, 0x5f, 0x85, 0x33, 0xc8, 0xa5, 0x66, 0x53, 0x0f, 0x85,
+	0x45, 0x10, 0x6e, 0x20, 0xec, 0xd0, 0x03, 0x10, 0x72, 0x3e, 0xcb, 0xcf, 0x28, 0x47, 0x5c, 0xe1,
+	0x78, 0xe5, 0x08, 0x2d, 0x5c, 0xcb, 0x23, 0xd0, 0x6f, 0x38, 0x8e, 0xcd, 0x8c, 0x88, 0x87, 0xab,
+	0x44, 0x99, 0x63, 0x31, 0x57, 0x5f, 0x08, 0x5c, 0xc7, 0x55, 0x1a, 0x78, 0x9c, 0xee, 0xd8, 0xe5,
+	0xd0, 0xbd, 0x3b, 0x2e, 0xc2, 0xca, 0x67, 0xd8, 0x12, 0x27, 0x49, 0x76, 0xa7, 0x63, 0x9d, 0xe2,
+	0x64, 0x24, 0x41, 0xcf, 0x74, 0x4e, 0x82, 0x5e, 0x80, 0x89, 0xce, 0x3d, 0x07, 0x65, 0x9e, 0x2d,
+	0x4e, 0xf1, 0x3b, 0x74, 0x18, 0xe4, 0x32, 0x9c, 0xce, 0x6e, 0x60, 0xc7, 0xf6, 0xe5, 0x7c, 0x96,
+	0x3e, 0x9c, 0xca, 0xe8, 0x61, 0x47, 0x86, 0xe6, 0x7f, 0xc1, 0x93, 0x99, 0x44, 0x33, 0x4d, 0xce,
+	0xb3, 0x89, 0xa5, 0x3d, 0xda, 0x4e, 0x35, 0xc3, 0xf6, 0xbc, 0x02, 0xe3, 0x31, 0xf9, 0xd6, 0xc0,
+	0xe4, 0x42, 0x16, 0xb7, 0x63, 0x11, 0xfe, 0x8d, 0x54, 0x84, 0x72, 0x06, 0xf2, 0x96, 0x4b, 0x74,
+	0xc7, 0xd8, 0x42, 0x8e, 0xf2, 0x5c, 0x22, 0xf1, 0xcb, 0x59, 0x2e, 0xb9, 0x4e, 0xa1, 0xf2, 0xe3,
+	0xd0, 0x87, 0x3d, 0x2f, 0xd0, 0x9d, 0x2d, 0xbd, 0xf2, 0x86, 0xe5, 0x2a, 0x9f, 0x4d, 0x60, 0x01,
+	0x1d, 0xb9, 0xbe, 0x75, 0xe5, 0x0d, 0xcb, 0x95, 0xcf, 0xd3, 0x5c, 0x94, 0x85, 0xae, 0x29, 0xf4,
+	0x97, 0x12, 0xe8, 0x45, 0x8e, 0xa0, 0xc5, 0x93, 0x34, 0x18, 0x4a, 0xf7, 0xb3, 0xa9, 0x86, 0x5f,
+	0x64, 0x1a, 0x7e, 0x2a, 0x19, 0x2c, 0xb5, 0xf4, 0xc1, 0x13, 0x41, 0x46, 0xb1, 0xd2, 0xda, 0x23,
+	0xbf, 0x4f, 0x7a, 0xfb, 0xfc, 0x83, 0xa4, 0xb7, 0xf2, 0xcb, 0xd0, 0xcf, 0xdd, 0x2e, 0x0f, 0xc6,
+	0x88, 0xb2, 0xc0, 0xac, 0xd4, 0x68, 0x5b, 0x04, 0xb7, 0xea, 0x56, 0x3c, 0x41, 0x8d, 0x3b, 0x6a,
+	0x0e, 0x66, 0xfd, 0x09, 0xd1, 0xf4, 0xe1, 0xbd, 0xdd, 0xcf, 0xf1, 0x5c, 0x5f, 0xc0, 0x58, 0x43,
+	0x77, 0x1a, 0x0a, 0xc9, 0x6e, 0xce, 0x25, 0xde, 0x1b, 0x32, 0xa3, 0x2e, 0xce, 0xa3, 0xd0, 0xeb,
+	0x6d, 0x7d, 0x41, 0xb7, 0x2d, 0xe5, 0xc5, 0xac, 0x4d, 0x3d, 0xee, 0x6d, 0x7d, 0x61, 0xd5, 0x92,
+	0xaf, 0x40, 0x21, 0x71, 0xcb, 0x50, 0x79, 0xb9, 0x2d, 0x19, 0x8c, 0xa3, 0xa0, 0x18, 0x8d, 0xc7,
+	0x82, 0xc9, 0x89, 0xf2, 0xd3, 0x50, 0xb0, 0xb6, 0xd8, 0x45, 0x19, 0x87, 0xbe, 0x72, 0x89, 0x1a,
+	0xcf, 0xd6, 0x57, 0xe6, 0xad, 0xad, 0x35, 0x8a, 0xb0, 0x6a, 0x7d, 0x8a, 0x6b, 0x0e, 0x13, 0xb7,
+	0x61, 0x20, 0x1d, 0xe9, 0x65, 0xcc, 0x9e, 0x4b, 0xb7, 0x06, 0xc6, 0xd3, 0xee, 0x21, 0x8c, 0x06,
+	0xaf, 0xa1, 0x66, 0x92, 0xf0, 0xa5, 0x07, 0x69, 0x66, 0x74, 0xe6, 0xeb, 0x45, 0x28, 0xb6, 0x8a,
+	0xe8, 0x50, 0x59, 0xeb, 0x7f, 0xf6, 0x1c, 0x54, 0xd4, 0xf8, 0x90, 0x17, 0x35, 0xfe, 0x5f, 0xf7,
+	0x75, 0x91, 0x36, 0xce, 0xbe, 0xe2, 0x61, 0xfb, 0x2d, 0x1a, 0x0b, 0x39, 0x8b, 0xa6, 0x59, 0xc7,
+	0x86, 0xd9, 0x2c, 0x45, 0x63, 0xb7, 0x68, 0xea, 0x6c, 0x66, 0x8d, 0x2c, 0x7b, 0x75, 0x4c, 0x50,
+	0xfc, 0x5c, 0xf6, 0x11, 0xb2, 0xe2, 0xc7, 0x28, 0x1c, 0x28, 0x71, 0xad, 0x2e, 0xf1, 0x22, 0xca,
+	0x65, 0x96, 0xab, 0x95, 0xda, 0x8d, 0x55, 0xe9, 0x00, 0x4b, 0x53, 0x2a, 0x77, 0x36, 0x72, 0x19,
+	0x63, 0x19, 0x04, 0x96, 0xc3, 0xa8, 0xa6, 0x74, 0x33, 0x0c, 0x42, 0x4a, 0x9b, 0x2d, 0x41, 0x41,
+	0x29, 0xed, 0x5a, 0x5b, 0x6a, 0x3b, 0x57, 0x43, 0x67, 0x36, 0x9b, 0x59, 0x07, 0x12, 0x66, 0xaa,
+	0x14, 0x1b, 0x15, 0xb6, 0xe0, 0xa4, 0x95, 0x39, 0xa8, 0x18, 0xf4, 0x1b, 0xe8, 0xa0, 0x64, 0x95,
+	0x8d, 0xde, 0xfd, 0x44, 0x91, 0xee, 0x7e, 0xa2, 0xcc, 0xef, 0xa0, 0xe6, 0x6c, 0xb2, 0x63, 0x50,
+	0xa2, 0x00, 0x9a, 0xfc, 0x95, 0xde, 0xf2, 0x5c, 0x54, 0x4a, 0xe5, 0xaf, 0x25, 0x16, 0x88, 0xbd,
+	0xda, 0x93, 0x7b, 0xa1, 0x78, 0x49, 0xfd, 0x6e, 0x17, 0x14, 0xb8, 0xe5, 0x59, 0xa3, 0xb1, 0x61,
+	0x58, 0x02, 0x91, 0x1e, 0xb4, 0x04, 0xd2, 0xd2, 0x87, 0xe9, 0x6e, 0xed, 0xc3, 0xd0, 0x74, 0x31,
+	0xd5, 0xcb, 0x67, 0xf5, 0x0e, 0x5e, 0x00, 0x2a, 0x26, 0x07, 0x5e, 0xf7, 0x5c, 0xb4, 0xf0, 0xdb,
+	0xd2, 0x3b, 0xfb, 0x4a, 0xf5, 0xb0, 0xa2, 0x65, 0x2f, 0xbb, 0x74, 0x25, 0x7a, 0xe7, 0x43, 0x6a,
+	0x57, 0x41, 0x4c, 0x51, 0x9d, 0x8d, 0xaf, 0xb4, 0xae, 0x19, 0xae, 0x5d, 0x41, 0x24, 0x90, 0x27,
+	0x20, 0x57, 0x13, 0xbf, 0xc5, 0x91, 0x8e, 0x9e, 0xd5, 0xbf, 0x96, 0xa0, 0x2f, 0xd9, 0x89, 0xcc,
+	0x6c, 0xd0, 0xcc, 0x40, 0xc1, 0x42, 0xc4, 0xc4, 0xb6, 0x1f, 0xb7, 0x82, 0xb4, 0x24, 0x28, 0x36,
+	0x19, 0xdd, 0x09, 0x93, 0x21, 0x8f, 0x41, 0x2f, 0x41, 0x26, 0x46, 0x81, 0xb8, 0x2e, 0x24, 0x9e,
+	0xe4, 0x49, 0xc8, 0xd7, 0x0c, 0xd7, 0x32, 0x02, 0x0f, 0x87, 0x57, 0x82, 0x62, 0x00, 0x65, 0xd7,
+	0x16, 0x37, 0x63, 0xc5, 0x6d, 0x9f, 0xe8, 0x99, 0xee, 0x62, 0xe0, 0x05, 0xbe, 0x2e, 0xc8, 0xf2,
+	0xcb, 0x3c, 0x40, 0x41, 0x65, 0x06, 0x51, 0x7f, 0x25, 0x41, 0x7f, 0x28, 0x00, 0x76, 0x83, 0xf6,
+	0xc1, 0x6e, 0x5f, 0xbd, 0x92, 0x51, 0x6e, 0x3c, 0x9b, 0xa1, 0x54, 0x8c, 0xe4, 0x81, 0x25, 0x47,
+	0xb5, 0xa5, 0x6f, 0xc6, 0x05, 0x92, 0x82, 0xfd, 0xba, 0x5a, 0xc7, 0xea, 0x7b, 0x12, 0x4c, 0x24,
+	0x1a, 0xb5, 0xe9, 0xde, 0xf9, 0x03, 0x4a, 0xe2, 0xc5, 0x0c, 0x49, 0xdc, 0xaf, 0x51, 0x7f, 0xc8,
+	0xf5, 0xab, 0x3f, 0xee, 0x82, 0xd1, 0x56, 0x3e, 0x6f, 0x12, 0xa3, 0x8a, 0x8e, 0x72, 0xaa, 0x79,
+	0x14, 0x53, 0xa7, 0xd3, 0xc5, 0x35, 0x38, 0x60, 0x20, 0x4e, 0x70, 0x1e, 0x7a, 0x58, 0x7b, 0xae,
+	0xfb, 0x81, 0x16, 0xc2, 0x70, 0x69, 0xd6, 0x12, 0x65, 0x5c, 0xc4, 0xf4, 0x30, 0x37, 0x03, 0x3d,
+	0x5a, 0x7f, 0x08, 0x2d, 0x53, 0xe0, 0x42, 0xe3, 0x37, 0x63, 0x5d, 0xd5, 0xff, 0xdf, 0x05, 0xc3,
+	0xa1, 0x38, 0x16, 0xe3, 0xdc, 0xe4, 0xd0, 0xb2, 0x53, 0xb3, 0x3a, 0xbc, 0x2d, 0xfd, 0xdc, 0x6f,
+	0x53, 0x3b, 0xe7, 0x1e, 0x72, 0x91, 0x61, 0xc2, 0x44, 0x07, 0xbd, 0x87, 0xdf, 0x9d, 0xef, 0x4b,
+	0x49, 0xe3, 0x1f, 0x24, 0x80, 0x38, 0x50, 0xed, 0xd8, 0x3c, 0x37, 0xfd, 0x3a, 0x89, 0x9a, 0xe7,
+	0xf4, 0x81, 0x9e, 0x38, 0x6c, 0xd4, 0xc4, 0x85, 0x4f, 0xfa, 0x93, 0xce, 0xb5, 0x6c, 0xb2, 0x23,
+	0x76, 0x9b, 0xfd, 0x96, 0x97, 0x21, 0x47, 0x95, 0x9b, 0xd5, 0xf5, 0x8e, 0xb7, 0xd5, 0xf5, 0xe2,
+	0x17, 0xb3, 0x33, 0x19, 0xd5, 0xf5, 0x78, 0xb8, 0x7c, 0xc2, 0xe7, 0xb0, 0x89, 0x05, 0x6e, 0x63,
+	0x0f, 0x08, 0x06, 0x3b, 0x86, 0x5c, 0xea, 0x05, 0x38, 0xb1, 0x5e, 0x5e, 0xa4, 0x4e, 0x27, 0x73,
+	0x6d, 0xd4, 0xc4, 0x06, 0x46, 0x20, 0x16, 0x97, 0xd7, 0xc4, 0x93, 0x8a, 0xe9, 0x34, 0x7e, 0xc3,
+	0x37, 0x6b, 0x9a, 0x0c, 0x3d, 0x81, 0x51, 0x0d, 0x27, 0xb1, 0xdf, 0xf2, 0x74, 0xea, 0xe4, 0x0b,
+	0x07, 0x99, 0x38, 0xd9, 0xa7, 0xa1, 0x40, 0x45, 0xa2, 0x53, 0x53, 0x61, 0x04, 0xc2, 0x35, 0x02,
+	0x05, 0x5d, 0x61, 0x10, 0xf5, 0xdb, 0x7d, 0xd0, 0x17, 0x7f, 0xdb, 0xc0, 0xbb, 0xdf, 0x0f, 0xa5,
+	0x7d, 0x71, 0x29, 0xac, 0xbf, 0x77, 0xb3, 0x9a, 0xcc, 0x13, 0x9d, 0x4b, 0x45, 0x21, 0x01, 0x5e,
+	0xd2, 0x13, 0x95, 0xf8, 0xc7, 0x21, 0x2f, 0x72, 0x48, 0xdb, 0x12, 0x1f, 0xaa, 0x24, 0xee, 0x6a,
+	0xe7, 0xf8, 0xd8, 0xaa, 0x25, 0x3f, 0x09, 0x60, 0xc6, 0x45, 0x92, 0xe3, 0xad, 0x97, 0xba, 0x13,
+	0x83, 0xf2, 0x24, 0x80, 0x47, 0xf4, 0x9a, 0xf1, 0xa6, 0x4e, 0x75, 0xa8, 0x97, 0x29, 0x4c, 0xce,
+	0x23, 0x6b, 0xc6, 0x9b, 0x9a, 0x51, 0x93, 0x55, 0xe8, 0x17, 0xa3, 0x0d, 0x6a, 0x29, 0x78, 0x9f,
+	0xa3, 0x47, 0x2b, 0x30, 0x84, 0x5b, 0x0c, 0x24, 0x9f, 0x89, 0x71, 0x3c, 0x47, 0xaf, 0x6e, 0xb1,
+	0x3e, 0x47, 0x8f, 0x06, 0x1c, 0xc7, 0x73, 0xae, 0x6e, 0x51, 0xf1, 0x89, 0xee, 0x44, 0x9e, 0x8b,
+	0x4f, 0xb4, 0x23, 0xe6, 0xe0, 0x44, 0x98, 0xb4, 0xc1, 0x01, 0x49, 0x9b, 0x16, 0x62, 0xc9, 0x2f,
+	0x47, 0x4a, 0x52, 0x60, 0x22, 0x4f, 0xe2, 0x97, 0xd9, 0x00, 0x4b, 0xf2, 0x86, 0xbe, 0xf5, 0x49,
+	0x22, 0x15, 0xe2, 0x15, 0x6e, 0x3e, 0x2f, 0xbb, 0x96, 0xde, 0xd7, 0xa1, 0x96, 0xbe, 0x08, 0x72,
+	0x5b, 0x24, 0x45, 0x94, 0x7e, 0xc6, 0xaa, 0x9c, 0xba, 0x84, 0xc1, 0xf4, 0x5a, 0x1b, 0x6a, 0x0d,
+	0xaf, 0xe8, 0x12, 0xf3, 0x9e, 0xb8, 0x12, 0x4b, 0x94, 0x81, 0x8c, 0x99, 0x4c, 0xb5, 0xa9, 0xc8,
+	0xd9, 0x0f, 0x22, 0x2f, 0xc0, 0x78, 0xbc, 0x3d, 0x3a, 0xbf, 0xce, 0x8f, 0x91, 0x89, 0xec, 0x06,
+	0xb2, 0xc4, 0x55, 0xcf, 0x93, 0x31, 0xc2, 0x32, 0x1d, 0xd7, 0xc4, 0x70, 0x76, 0x01, 0xb9, 0xf8,
+	0x10, 0x0a, 0xc8, 0xaf, 0x83, 0x1c, 0x7d, 0x41, 0xa6, 0x13, 0xd7, 0xf0, 0xc9, 0xb6, 0x17, 0x88,
+	0x56, 0xc9, 0x99, 0x4e, 0xae, 0x88, 0x94, 0x05, 0x62, 0xa2, 0x06, 0x30, 0x84, 0x5b, 0x07, 0xe5,
+	0xcb, 0x99, 0x45, 0x4b, 0xf9, 0xc0, 0xa2, 0x65, 0x46, 0xb9, 0xf2, 0x45, 0x18, 0x35, 0xbd, 0x9a,
+	0x6f, 0x04, 0xb6, 0xd8, 0xac, 0x70, 0x73, 0x87, 0x67, 0xa4, 0xb3, 0xfd, 0x49, 0xf5, 0x1f, 0x49,
+	0xe1, 0x85, 0x7b, 0x7d, 0x35, 0x65, 0x34, 0x46, 0xd8, 0x4e, 0x3d, 0x91, 0xf9, 0xad, 0x53, 0xc5,
+	0x3b, 0x30, 0x6e, 0x9a, 0x07, 0x60, 0xf7, 0x2a, 0xa9, 0x07, 0x26, 0xca, 0x28, 0x23, 0x34, 0x9c,
+	0x20, 0x74, 0xc3, 0xb3, 0x10, 0xd3, 0x6a, 0x76, 0x4d, 0x9e, 0xfe, 0x22, 0xec, 0xf3, 0x08, 0x33,
+	0xb0, 0x1b, 0x88, 0x95, 0xa0, 0x6c, 0x97, 0x04, 0x54, 0xf6, 0xfc, 0x43, 0x11, 0x6d, 0x88, 0x0f,
+	0x2d, 0xe3, 0xda, 0xaa, 0x18, 0xa0, 0x16, 0x8c, 0xfe, 0xb2, 0xb6, 0x58, 0xcd, 0x8a, 0x7d, 0x17,
+	0x92, 0xd3, 0x40, 0x80, 0x96, 0x71, 0x4d, 0x7e, 0x02, 0x06, 0x31, 0x72, 0x90, 0x41, 0xda, 0x3a,
+	0x23, 0x02, 0x1c, 0x2e, 0x3b, 0xe4, 0x96, 0xdf, 0x02, 0x9d, 0xcd, 0xe4, 0x96, 0xd5, 0xf6, 0x19,
+	0xb7, 0xec, 0x2a, 0xe8, 0xa7, 0xed, 0x18, 0xff, 0x59, 0xdb, 0x85, 0x82, 0x77, 0xf7, 0x14, 0xe9,
+	0x47, 0x7b, 0x4a, 0x7f, 0xca, 0xe8, 0xd1, 0x24, 0xfc, 0xe7, 0x3c, 0x11, 0xef, 0xe5, 0x67, 0xfb,
+	0x37, 0x96, 0x04, 0xb2, 0x5b, 0x8b, 0xef, 0x7f, 0x12, 0xdf, 0x35, 0x54, 0x1f, 0x81, 0xc1, 0x28,
+	0x3b, 0x41, 0x01, 0xb6, 0x4d, 0xe6, 0x86, 0x2b, 0x9e, 0xc7, 0xac, 0x6d, 0x8f, 0x46, 0x7f, 0x9e,
+	0x5b, 0x80, 0x81, 0x74, 0xcb, 0x48, 0x1e, 0x82, 0xfe, 0x95, 0x55, 0xed, 0xf2, 0xf2, 0xa6, 0xbe,
+	0xb8, 0xbc, 0x7c, 0xb9, 0x5c, 0x2e, 0x1e, 0x93, 0x47, 0x61, 0x48, 0xbb, 0x5c, 0xde, 0xd4, 0x56,
+	0x97, 0x37, 0x2f, 0xaf, 0x84, 0x60, 0xe9, 0x5c, 0x09, 0x7a, 0xf9, 0xd5, 0x2e, 0x39, 0x0f, 0xc7,
+	0xaf, 0xaf, 0xde, 0xb8, 0xf9, 0x3f, 0x8a, 0xc7, 0xe4, 0x02, 0x9c, 0xb8, 0xbd, 0x7a, 0x63, 0x65,
+	0xfd, 0x76, 0xb9, 0x28, 0xc9, 0x00, 0xbd, 0xeb, 0x9b, 0xaf, 0x5c, 0xd6, 0xca, 0xc5, 0x91, 0x73,
+	0x57, 0x60, 0x40, 0x43, 0xbe, 0x87, 0x83, 0xb2, 0xb9, 0x8d, 0xac, 0xba, 0x83, 0xe4, 0x7e, 0xc8,
+	0x5f, 0x6e, 0x20, 0xdc, 0xbc, 0x8d, 0xd0, 0x4e, 0xf1, 0x98, 0x3c, 0x08, 0x05, 0xf6, 0xf8, 0xcc,
+	0x85, 0x15, 0xa3, 0x49, 0x8a, 0x92, 0x3c, 0x00, 0xc0, 0x00, 0x6b, 0x9e, 0x1b, 0x6c, 0x17, 0xbb,
+	0x27, 0x7a, 0x3e, 0xba, 0xa7, 0x1c, 0x9b, 0xff, 0x7a, 0x0f, 0x0c, 0xb7, 0x36, 0x58, 0x17, 0x7d,
+	0x5b, 0xfe, 0x7d, 0x09, 0x46, 0xca, 0xdb, 0xde, 0x9d, 0xb6, 0x2f, 0x42, 0x4e, 0x1d, 0x70, 0xab,
+	0x76, 0xe2, 0xa0, 0x41, 0x75, 0x6d, 0x77, 0x4f, 0x39, 0x1b, 0x9a, 0x8a, 0x50, 0x96, 0xa4, 0xb4,
+	0x68, 0x52, 0x99, 0xdf, 0xb2, 0xd1, 0x9d, 0x12, 0xd9, 0xb1, 0x7d, 0xe4, 0x56, 0x3c, 0x6c, 0xa2,
+	0x2f, 0xff, 0xed, 0x3f, 0x7f, 0xad, 0xeb, 0x94, 0x3a, 0x36, 0x47, 0xb6, 0xbd, 0x3b, 0x73, 0x61,
+	0x98, 0x5f, 0x11, 0xb4, 0x16, 0xa4, 0x73, 0x9f, 0x91, 0xe4, 0xaf, 0x48, 0x30, 0x26, 0xea, 0x11,
+	0x87, 0xe2, 0x72, 0x28, 0x5d, 0xaf, 0xaa, 0x3b, 0x81, 0xba, 0xb2, 0xbb, 0xa7, 0x4c, 0x1d, 0xc8,
+	0x1b, 0x63, 0x68, 0x4a, 0x55, 0xe6, 0x78, 0x81, 0x3b, 0x8b, 0x25, 0xf9, 0x2f, 0x24, 0x38, 0x95,
+	0x25, 0xb4, 0x2b, 0x1e, 0xe6, 0x51, 0x50, 0xe2, 0xc5, 0x14, 0x70, 0x0d, 0x35, 0x0f, 0x16, 0x59,
+	0x7d, 0x77, 0x4f, 0x19, 0x0f, 0xd9, 0x62, 0xee, 0x25, 0xc9, 0xd2, 0xf7, 0xf7, 0x15, 0xe9, 0xc3,
+	0x7d, 0x45, 0xda, 0xdd, 0x57, 0x9e, 0x48, 0x9d, 0x01, 0x76, 0x4a, 0x32, 0x55, 0xfb, 0x8b, 0xf7,
+	0x14, 0x29, 0x12, 0x2d, 0x75, 0x6e, 0xd9, 0xa2, 0x9d, 0xff, 0xfb, 0xbe, 0xc4, 0xb5, 0x4b, 0xaa,
+	0x0f, 0xef, 0x49, 0x30, 0xc8, 0xeb, 0x45, 0xf1, 0x55, 0xb3, 0x91, 0xac, 0xcb, 0x31, 0x59, 0xd2,
+	0xad, 0xee, 0xee, 0x29, 0x73, 0x9d, 0xa4, 0xbb, 0xc6, 0xae, 0x90, 0x97, 0x5a, 0x0f, 0x32, 0x5d,
+	0xdc, 0x1f, 0xde, 0x6b, 0xbf, 0xd8, 0xc3, 0xb8, 0x1f, 0x53, 0x87, 0xe6, 0x78, 0x4f, 0x6e, 0x2e,
+	0xba, 0x19, 0xc4, 0x75, 0xe2, 0xb7, 0x24, 0x18, 0xe4, 0x3a, 0x71, 0x04, 0x3e, 0xcb, 0x47, 0xe4,
+	0x33, 0xe2, 0x49, 0xe8, 0x46, 0x0b, 0x4f, 0x7f, 0x24, 0xc1, 0x20, 0xaf, 0xb0, 0x1d, 0x81, 0x27,
+	0xf7, 0x88, 0x3c, 0x7d, 0xb4, 0xaf, 0x9c, 0x64, 0xb7, 0xf3, 0x48, 0x89, 0x1a, 0x95, 0xd2, 0x6a,
+	0x7c, 0x8f, 0x2d, 0x62, 0x97, 0xf7, 0x1e, 0x5b, 0xd9, 0xfd, 0x8a, 0x04, 0xfd, 0x54, 0x8b, 0xef,
+	0xc7, 0x6c, 0x26, 0x54, 0x5d, 0xdf, 0xdd, 0x53, 0x9e, 0xec, 0xa8, 0xb2, 0x59, 0x9c, 0x7e, 0x18,
+	0x4a, 0x70, 0x44, 0x1d, 0xe4, 0xc7, 0xbd, 0x85, 0xa1, 0x8f, 0x25, 0x18, 0x5a, 0xb4, 0xac, 0x96,
+	0xeb, 0xb8, 0xa7, 0x3b, 0xde, 0x47, 0xe4, 0xb7, 0x4a, 0xb3, 0x84, 0xf9, 0x4d, 0xe9, 0x88, 0xd2,
+	0xbc, 0xbb, 0xaf, 0xbc, 0xc8, 0x68, 0x73, 0x0f, 0xc4, 0x7f, 0xae, 0x44, 0xf7, 0x77, 0x05, 0x40,
+	0xd4, 0x3d, 0xf9, 0xc3, 0x7a, 0xfa, 0x7e, 0x2e, 0x5b, 0xa1, 0xa2, 0x0e, 0xcf, 0x19, 0x96, 0x15,
+	0x2f, 0x90, 0x5d, 0x99, 0xe4, 0xab, 0xfc, 0xbf, 0x5d, 0x30, 0xa2, 0xa1, 0x9a, 0xd7, 0x40, 0x0f,
+	0x61, 0xa1, 0x3f, 0x94, 0x8e, 0xae, 0x36, 0xeb, 0x1d, 0x56, 0xd7, 0xb2, 0x20, 0x01, 0xbd, 0x96,
+	0xbc, 0x5d, 0x2c, 0x60, 0xaf, 0xa4, 0x6e, 0x12, 0xdf, 0xdd, 0x57, 0x20, 0x96, 0x5d, 0x64, 0x7d,
+	0x30, 0x5b, 0x6b, 0xa6, 0x28, 0xde, 0xef, 0x82, 0x91, 0xab, 0x28, 0x68, 0xbf, 0x3a, 0x7b, 0x5f,
+	0x51, 0x4c, 0x76, 0x44, 0xb8, 0xa9, 0x5d, 0x57, 0x3f, 0xa2, 0x52, 0x79, 0xfa, 0x40, 0x33, 0xdf,
+	0x2a, 0x93, 0x0f, 0xb9, 0x4c, 0xf0, 0x43, 0x96, 0x49, 0x9b, 0x06, 0xb1, 0x1b, 0xe0, 0x29, 0x35,
+	0xea, 0x20, 0xb6, 0x2a, 0x0a, 0x5a, 0x64, 0x56, 0xc7, 0x0e, 0x75, 0x3e, 0xdf, 0x95, 0x60, 0x3c,
+	0x29, 0xb4, 0x54, 0x61, 0x5d, 0xee, 0x74, 0x91, 0x31, 0x4b, 0x79, 0xfe, 0xe7, 0xee, 0x9e, 0xf2,
+	0x4c, 0xab, 0x94, 0x16, 0x5d, 0xc3, 0x69, 0x06, 0xb6, 0x99, 0x92, 0x56, 0x9b, 0x61, 0x9e, 0x51,
+	0x4f, 0xa5, 0x39, 0x14, 0x4d, 0x3c, 0xde, 0xec, 0x5b, 0x90, 0xce, 0xcd, 0x7f, 0x6f, 0x1a, 0x0a,
+	0x11, 0x4d, 0xdf, 0x96, 0x77, 0x25, 0x18, 0x58, 0x16, 0xff, 0x27, 0x21, 0xae, 0x0e, 0x0e, 0x67,
+	0x04, 0xe1, 0x59, 0x7c, 0xfe, 0xf1, 0x51, 0x95, 0x5c, 0x6c, 0x6a, 0x3e, 0x6a, 0x74, 0xdd, 0xdd,
+	0x57, 0xe6, 0x6f, 0x24, 0x6f, 0xe9, 0xc5, 0x2d, 0x9b, 0xeb, 0x46, 0x60, 0x07, 0x75, 0x2b, 0xd1,
+	0xd3, 0xb9, 0xee, 0xb9, 0x55, 0x06, 0xea, 0xe8, 0x9e, 0x46, 0xd5, 0x62, 0xe8, 0x9e, 0xc2, 0x58,
+	0x95, 0x2b, 0xf6, 0x3b, 0x12, 0x0c, 0xac, 0x88, 0xbf, 0xa8, 0x38, 0xe4, 0x62, 0xff, 0xf7, 0xd1,
+	0x0f, 0x74, 0xbc, 0xce, 0xc8, 0xcc, 0x0a, 0x47, 0xc5, 0xb8, 0x0b, 0x99, 0xfb, 0xbb, 0x2e, 0x18,
+	0xb8, 0x29, 0xfe, 0x8c, 0xe3, 0x90, 0xcc, 0x7d, 0xb3, 0xeb, 0xd3, 0xed, 0xc4, 0x9f, 0x4a, 0xc9,
+	0xaf, 0x83, 0x4a, 0x2b, 0xe9, 0xdb, 0x81, 0x25, 0x5e, 0x18, 0x28, 0x6d, 0x24, 0x2e, 0xd7, 0x95,
+	0x5a, 0xef, 0x29, 0x95, 0xa2, 0x45, 0x96, 0x6e, 0xa5, 0xae, 0x82, 0x25, 0xa8, 0x95, 0xd2, 0xc1,
+	0x79, 0x29, 0x71, 0x3b, 0xab, 0xb4, 0x7e, 0xe0, 0x2d, 0xa8, 0x12, 0xff, 0x40, 0x35, 0xf1, 0x92,
+	0xcb, 0x71, 0xaf, 0x38, 0xda, 0x73, 0xe1, 0x4f, 0xd3, 0x7b, 0xfe, 0x35, 0x09, 0xfa, 0xa8, 0x3b,
+	0x3d, 0x58, 0xa8, 0x59, 0x40, 0xf5, 0xf6, 0xa1, 0xcd, 0x55, 0xfb, 0x6e, 0x0f, 0xab, 0x03, 0xdc,
+	0xa9, 0xa6, 0xb9, 0xfa, 0x3d, 0x09, 0x86, 0xaf, 0xa2, 0xa0, 0xad, 0xe1, 0xd2, 0xa1, 0xa4, 0x95,
+	0x0a, 0x53, 0x5b, 0x27, 0xa9, 0x1b, 0xbb, 0x7b, 0xca, 0x53, 0xf7, 0xd9, 0xfd, 0xb6, 0x43, 0x12,
+	0x1a, 0xb3, 0x90, 0xaf, 0xb9, 0xb0, 0xb1, 0x43, 0x8d, 0xd9, 0x0f, 0x25, 0x28, 0x26, 0xd8, 0xe3,
+	0x4d, 0x00, 0xa5, 0x53, 0x57, 0x63, 0xa2, 0xe3, 0x88, 0xfa, 0xc6, 0x91, 0x6c, 0xd9, 0x47, 0xfb,
+	0x0a, 0xc4, 0x09, 0xef, 0xdd, 0xfd, 0xf4, 0xd7, 0x6b, 0x91, 0x2b, 0x4f, 0xb1, 0xcf, 0xfe, 0xfd,
+	0x84, 0xf2, 0xfe, 0xaf, 0x12, 0x4c, 0x25, 0x78, 0xcf, 0xe8, 0x66, 0x3c, 0x96, 0xfd, 0x75, 0x5a,
+	0x0b, 0xda, 0xc4, 0x83, 0xa1, 0xa9, 0x6f, 0xfd, 0xba, 0x96, 0x78, 0x46, 0x9d, 0x4c, 0x2f, 0x31,
+	0x2c, 0xe5, 0xc4, 0x6b, 0xfd, 0x1b, 0x09, 0x94, 0x8c, 0xb5, 0xf2, 0x06, 0xc6, 0xcc, 0x01, 0xfc,
+	0x33, 0x8c, 0x89, 0xfb, 0x62, 0xb0, 0xf0, 0xf7, 0xd0, 0x47, 0x40, 0xd6, 0x92, 0xdd, 0x0e, 0x7a,
+	0xcc, 0xbd, 0xfb, 0x2c, 0x88, 0xf5, 0x60, 0xe8, 0x82, 0xfe, 0x4d, 0x82, 0xf1, 0xe4, 0x69, 0x4d,
+	0xaf, 0x28, 0xf3, 0xe8, 0xde, 0x7f, 0x11, 0x5f, 0x3f, 0x7c, 0xdc, 0xb1, 0xbb, 0xaf, 0x9c, 0x6f,
+	0xab, 0x6d, 0x44, 0x15, 0x90, 0x8e, 0xa5, 0x8b, 0x28, 0xbf, 0x53, 0xd5, 0xa9, 0xf4, 0xb1, 0x6f,
+	0x5f, 0xeb, 0x67, 0x24, 0xf9, 0x77, 0x24, 0x18, 0x5d, 0xb4, 0xac, 0xf4, 0x17, 0x92, 0xbe, 0xed,
+	0x56, 0xe5, 0xf1, 0x8e, 0x1f, 0x50, 0x66, 0xd9, 0x7f, 0xed, 0x08, 0xe6, 0x9f, 0xb1, 0x39, 0xae,
+	0x8e, 0xd0, 0x80, 0x58, 0x7c, 0x74, 0x99, 0xb4, 0x51, 0xf2, 0xef, 0x4a, 0xa0, 0xf0, 0x78, 0xf8,
+	0x53, 0xb3, 0xf7, 0xda, 0x51, 0xd9, 0xa3, 0x87, 0x1c, 0xd7, 0xb2, 0xb8, 0xfb, 0x9e, 0x04, 0x63,
+	0x09, 0xc9, 0x25, 0x9b, 0x59, 0xd3, 0x19, 0xbc, 0x25, 0xc6, 0xb3, 0x18, 0x7c, 0xfd, 0x08, 0x0c,
+	0x46, 0x69, 0xd3, 0x94, 0xaa, 0x50, 0x19, 0x26, 0x5a, 0x57, 0x29, 0x4e, 0xdf, 0x93, 0x60, 0x3c,
+	0x2d, 0xc7, 0x4f, 0xc9, 0xec, 0xcd, 0xa3, 0x4a, 0x73, 0x52, 0x3d, 0x39, 0x87, 0x6b, 0x9d, 0xf8,
+	0xfc, 0xba, 0x04, 0x83, 0x57, 0x6c, 0xd7, 0x4a, 0xde, 0x94, 0x18, 0x6b, 0xeb, 0x0e, 0x30, 0xf8,
+	0x44, 0x07, 0x38, 0xdb, 0xe8, 0xc3, 0x9d, 0x33, 0xc6, 0xd8, 0x84, 0x3a, 0x3a, 0x57, 0xb1, 0xdd,
+	0x4c, 0x35, 0xfc, 0xa1, 0x04, 0x32, 0x35, 0x08, 0xe2, 0xea, 0xd8, 0x41, 0xa5, 0x9c, 0xcc, 0x4f,
+	0x08, 0xd4, 0x3b, 0xbf, 0xb6, 0x1a, 0x0e, 0xdd, 0x78, 0x7a, 0xc6, 0x43, 0xb6, 0xdf, 0xf2, 0x5c,
+	0x24, 0xfa, 0x26, 0xd1, 0xf1, 0x1e, 0xbb, 0x8a, 0x82, 0xe4, 0x64, 0xb2, 0xee, 0x76, 0xe4, 0x3f,
+	0x99, 0x23, 0xa4, 0x1a, 0x95, 0xd4, 0xbf, 0x3f, 0xd6, 0x71, 0x09, 0x99, 0xd5, 0x10, 0xca, 0x1b,
+	0x35, 0xb5, 0xac, 0x81, 0x32, 0x97, 0x6c, 0xa5, 0x92, 0xb8, 0x50, 0xa3, 0xa1, 0x86, 0xb7, 0x83,
+	0xa2, 0xfb, 0x49, 0x1d, 0x83, 0x8f, 0x0e, 0xa5, 0x9a, 0x43, 0x87, 0x1c, 0xd3, 0xea, 0xf8, 0x1c,
+	0x66, 0xef, 0x8c, 0xb6, 0x98, 0x5f, 0xfe, 0xdc, 0x41, 0x4d, 0xba, 0xd7, 0xdf, 0x90, 0x60, 0xe8,
+	0x2a, 0x72, 0x99, 0xc8, 0x8f, 0xc4, 0xd5, 0xcd, 0xa3, 0x70, 0xc5, 0x73, 0x26, 0xfe, 0xd6, 0x6c,
+	0xbe, 0xfe, 0x44, 0x82, 0x33, 0x09, 0x2f, 0xdb, 0x21, 0xc5, 0x3b, 0x04, 0x9f, 0xd6, 0xd1, 0x33,
+	0xbc, 0x27, 0xd5, 0x47, 0xd3, 0x3e, 0xb4, 0x63, 0xaa, 0x47, 0x4f, 0xf4, 0xd0, 0xf2, 0xb6, 0xe1,
+	0x56, 0xa3, 0x57, 0xac, 0xdc, 0x28, 0x1f, 0x86, 0x4d, 0xed, 0x90, 0xe2, 0x8c, 0xb4, 0x8f, 0xba,
+	0x15, 0x93, 0xbd, 0x39, 0xe6, 0xd3, 0x0a, 0x35, 0xef, 0x3d, 0x09, 0xfa, 0xc4, 0x9f, 0x64, 0xf0,
+	0x3f, 0x09, 0x3b, 0x04, 0x47, 0xdb, 0x47, 0xe0, 0x68, 0x77, 0x5f, 0x19, 0x62, 0xa7, 0x39, 0xd3,
+	0xee, 0x24, 0xfc, 0x33, 0x63, 0xc9, 0x44, 0x98, 0x87, 0xe8, 0xf3, 0x7f, 0xd0, 0x1d, 0xb7, 0x1c,
+	0x68, 0x08, 0x43, 0xb3, 0xe5, 0xef, 0x48, 0x50, 0x4c, 0x86, 0x27, 0xac, 0x57, 0x7d, 0xb2, 0x43,
+	0xd3, 0x6a, 0xa2, 0xd3, 0x00, 0xf3, 0x37, 0x17, 0x1e, 0x68, 0xff, 0x3b, 0x7a, 0x9d, 0x93, 0xaa,
+	0x9c, 0x0e, 0x30, 0x6c, 0xb7, 0xe2, 0x71, 0x01, 0xd7, 0x41, 0x5e, 0x75, 0xbf, 0x80, 0xcc, 0xe0,
+	0xc1, 0xb8, 0xcc, 0x10, 0xf3, 0xf9, 0x23, 0xb8, 0x18, 0x39, 0x80, 0xa1, 0xcb, 0x0d, 0xfb, 0xbf,
+	0xf9, 0xad, 0xf3, 0xff, 0x47, 0x02, 0xb9, 0xa5, 0x31, 0x44, 0x37, 0xea, 0x0d, 0x18, 0x4e, 0xee,
+	0x53, 0xd8, 0x32, 0x9a, 0xc8, 0x4a, 0xa3, 0xf8, 0xd8, 0xc4, 0x01, 0x63, 0xea, 0x4c, 0xa4, 0x2f,
+	0x29, 0x99, 0xd7, 0xf8, 0x30, 0x13, 0xfb, 0xd2, 0xe4, 0x07, 0xff, 0x34, 0x7d, 0xec, 0x83, 0xbb,
+	0xd3, 0xd2, 0x87, 0x77, 0xa7, 0xa5, 0x9f, 0xdf, 0x9d, 0x96, 0xbe, 0xfa, 0xf1, 0xf4, 0xb1, 0x0f,
+	0x3f, 0x9e, 0x3e, 0xf6, 0xd3, 0x8f, 0xa7, 0x8f, 0x6d, 0xf5, 0x32, 0xc2, 0xe7, 0xff, 0x2b, 0x00,
+	0x00, 0xff, 0xff, 0x34, 0xac, 0xa6, 0xb8, 0x1c, 0x55, 0x00, 0x00,
 }
 
 func (this *GPUDriverKey) GoString() string {
@@ -12691,6 +12693,11 @@ func (m *Cloudlet) ValidateUpdateFieldsCustom(allowedFields *FieldMap) error {
 	return nil
 }
 
+// TableColumns are the default columns for table output
+func (m *Cloudlet) TableColumns() []string {
+	return []string{"key.organization", "key.name", "zone", "platform_type", "state"}
+}
+
 func (m *Cloudlet) Clone() *Cloudlet {
 	cp := &Cloudlet{}
 	cp.DeepCopyIn(m)
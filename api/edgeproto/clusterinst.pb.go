@@ -228,6 +228,8 @@ type ClusterInst struct {
 	KubernetesVersion string `protobuf:"bytes,48,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetes_version,omitempty"`
 	// Disables dynamic placement of AppInsts on this cluster
 	DisableDynamicAppinstPlacement bool `protobuf:"varint,49,opt,name=disable_dynamic_appinst_placement,json=disableDynamicAppinstPlacement,proto3" json:"disable_dynamic_appinst_placement,omitempty"`
+	// Run validation and resource checks without creating or updating the instance, and show the placement decisions
+	DryRun bool `protobuf:"varint,50,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	// Vendor-specific data
 	Tags map[string]string `protobuf:"bytes,100,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -429,158 +431,161 @@ func init() {
 func init() { proto.RegisterFile("clusterinst.proto", fileDescriptor_2d2ba73d39f00460) }
 
 var fileDescriptor_2d2ba73d39f00460 = []byte{
-	// 2402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6f, 0x14, 0xc9,
-	0x19, 0xa7, 0xec, 0xb1, 0x3d, 0x53, 0xe3, 0xd7, 0x94, 0x6d, 0x28, 0x0c, 0xd8, 0xc3, 0x80, 0x17,
-	0x2f, 0xb4, 0x1f, 0x6b, 0x12, 0x93, 0x58, 0xcb, 0xa2, 0xb1, 0x01, 0xc5, 0x61, 0x0d, 0xa8, 0x0d,
-	0x28, 0x89, 0x22, 0xb5, 0x7a, 0xba, 0xcb, 0x43, 0xaf, 0xbb, 0xbb, 0x7a, 0xfb, 0x61, 0x32, 0x9c,
-	0x56, 0x51, 0xa4, 0x5c, 0x51, 0x72, 0x49, 0x38, 0x44, 0x5c, 0x22, 0xad, 0x94, 0xcb, 0x86, 0xe3,
-	0xfe, 0x05, 0x1c, 0x91, 0x72, 0x41, 0xab, 0xd5, 0x6a, 0x03, 0x89, 0x84, 0x9c, 0xcb, 0x46, 0x8c,
-	0xcd, 0x2a, 0x52, 0xa4, 0xa8, 0xaa, 0xba, 0x67, 0x6a, 0x7a, 0xc6, 0xc0, 0x9a, 0xcd, 0xad, 0xeb,
-	0x7b, 0xfc, 0xea, 0xab, 0xaf, 0xbe, 0xfa, 0x1e, 0x0d, 0x0b, 0x86, 0x1d, 0x05, 0x21, 0xf1, 0x2d,
-	0x37, 0x08, 0x67, 0x3d, 0x9f, 0x86, 0x14, 0xe5, 0x88, 0x59, 0x25, 0xfc, 0x73, 0xfc, 0x68, 0x95,
-	0xd2, 0xaa, 0x4d, 0xe6, 0x74, 0xcf, 0x9a, 0xd3, 0x5d, 0x97, 0x86, 0x7a, 0x68, 0x51, 0x37, 0x10,
-	0x82, 0xe3, 0xc7, 0x42, 0x4a, 0xed, 0x60, 0x8e, 0x2f, 0xaa, 0xc4, 0x6d, 0x7c, 0xc4, 0xec, 0x7e,
-	0x9f, 0x04, 0x91, 0x1d, 0x26, 0xab, 0x0d, 0x5b, 0xdf, 0xa2, 0x7e, 0xbc, 0x1a, 0xf2, 0x49, 0x40,
-	0x23, 0xdf, 0x20, 0x09, 0xd6, 0x40, 0x6c, 0x47, 0xbc, 0x2c, 0x18, 0x36, 0x8d, 0x4c, 0x9b, 0x84,
-	0x9b, 0xa4, 0x96, 0x00, 0x18, 0xd4, 0x71, 0x68, 0x02, 0x3e, 0x6a, 0xb9, 0x1b, 0xbe, 0x9e, 0x46,
-	0x19, 0xad, 0xd2, 0x2a, 0xe5, 0x9f, 0x73, 0xec, 0x2b, 0xc1, 0x36, 0x1d, 0x32, 0x67, 0x53, 0x43,
-	0x2c, 0x4b, 0xff, 0x04, 0x70, 0x78, 0x45, 0xec, 0xb6, 0xea, 0x06, 0xe1, 0x15, 0x52, 0xbb, 0xf5,
-	0x1e, 0xfa, 0x00, 0xe6, 0x63, 0x0b, 0xb4, 0x4d, 0x52, 0xc3, 0xa0, 0x08, 0xa6, 0xf3, 0x0b, 0x87,
-	0x66, 0x1b, 0xae, 0x98, 0x8d, 0x35, 0xb8, 0xf4, 0x72, 0xe6, 0xd1, 0x57, 0x93, 0x07, 0x54, 0x68,
-	0x34, 0x68, 0xe8, 0x0a, 0xec, 0x4f, 0x4c, 0xe6, 0x00, 0x5d, 0x1c, 0xe0, 0x60, 0x0b, 0x80, 0x60,
-	0x5f, 0x21, 0xb5, 0xe5, 0xe1, 0xed, 0x5d, 0x9c, 0x4d, 0x08, 0x1c, 0x2b, 0x6f, 0x34, 0xd9, 0x68,
-	0x11, 0xf6, 0x53, 0xbf, 0xaa, 0xbb, 0xd6, 0x5d, 0xee, 0x6f, 0xdc, 0x5d, 0x04, 0xd3, 0xb9, 0x65,
-	0xf4, 0xf9, 0x2e, 0x4e, 0xb6, 0xa4, 0x7e, 0xf5, 0xf1, 0x2e, 0x06, 0x6a, 0x8b, 0xdc, 0x52, 0xff,
-	0xf3, 0x17, 0x18, 0x7c, 0xfb, 0x02, 0x83, 0xcf, 0x1e, 0x4c, 0x82, 0xd2, 0x5f, 0xda, 0xcf, 0xb9,
-	0x80, 0xde, 0xef, 0x74, 0xce, 0xb1, 0x8e, 0xe7, 0xfc, 0x3f, 0x9f, 0x32, 0x65, 0xed, 0xbf, 0x30,
-	0xcc, 0x4b, 0xd6, 0xa2, 0x83, 0xb0, 0x77, 0xc3, 0x22, 0xb6, 0x19, 0x60, 0x50, 0xec, 0x9e, 0xce,
-	0xa9, 0xf1, 0x0a, 0xcd, 0xc0, 0xee, 0xe6, 0xce, 0xaf, 0x34, 0x9c, 0xc9, 0xb5, 0x59, 0xfc, 0xee,
-	0xdb, 0xdc, 0xcb, 0x59, 0x98, 0xbd, 0x4b, 0x5d, 0xc2, 0x81, 0xce, 0x70, 0x20, 0x24, 0x01, 0xfd,
-	0x82, 0xba, 0xa4, 0xb9, 0x7b, 0xdf, 0x5d, 0xb1, 0x44, 0x8b, 0xb0, 0x57, 0x84, 0x3e, 0xbf, 0xc6,
-	0xfc, 0xc2, 0xa8, 0xa4, 0x72, 0x99, 0x33, 0x98, 0x52, 0xf6, 0xd3, 0x3a, 0x06, 0x5c, 0x31, 0x96,
-	0x46, 0x3f, 0x84, 0x59, 0xdb, 0xda, 0x22, 0x2e, 0x09, 0x02, 0x9c, 0x2b, 0x82, 0xe9, 0xc1, 0x85,
-	0x11, 0x49, 0xf3, 0xc3, 0x98, 0xb5, 0x9c, 0x61, 0x8a, 0x6a, 0x43, 0x14, 0x61, 0x98, 0xd1, 0xa3,
-	0x90, 0x62, 0x58, 0x04, 0xd3, 0xd9, 0x98, 0xcb, 0x29, 0xe8, 0x2c, 0xec, 0x09, 0x42, 0x3d, 0x24,
-	0x38, 0xc3, 0xd1, 0xe4, 0xe0, 0xbe, 0xe1, 0xeb, 0xc6, 0x26, 0x31, 0xd7, 0x19, 0x3b, 0xd6, 0x11,
-	0xb2, 0x68, 0x0a, 0xf6, 0x12, 0xdf, 0xa7, 0x7e, 0x80, 0x7b, 0xd8, 0x35, 0x2c, 0x0f, 0x30, 0xe6,
-	0xef, 0x1e, 0x1e, 0xee, 0x71, 0xa9, 0xe1, 0x78, 0x6a, 0xcc, 0x44, 0x3f, 0x86, 0xfd, 0x86, 0xef,
-	0x68, 0x74, 0x8b, 0xf8, 0xbe, 0x65, 0x12, 0xdc, 0xcb, 0xb7, 0x68, 0x71, 0xb3, 0xba, 0x76, 0x2d,
-	0xe6, 0xaa, 0x79, 0xc3, 0x77, 0x92, 0x05, 0x5a, 0x84, 0x39, 0xcb, 0xd3, 0x74, 0xc3, 0x60, 0x07,
-	0xed, 0x6b, 0x3b, 0xe8, 0xaa, 0x57, 0xe6, 0xac, 0xe4, 0xa0, 0x56, 0xbc, 0x46, 0xf3, 0xb0, 0x5f,
-	0xb7, 0x6d, 0x6a, 0xe8, 0x21, 0x31, 0x35, 0xcb, 0xc3, 0x59, 0xfe, 0x48, 0x52, 0xf6, 0xe5, 0x1b,
-	0x22, 0xab, 0x1e, 0x9a, 0x82, 0x79, 0x97, 0x9a, 0x44, 0x8b, 0xaf, 0x23, 0xcf, 0x15, 0x04, 0x2c,
-	0x64, 0x0c, 0x71, 0x1b, 0xe8, 0x24, 0x84, 0x26, 0xf1, 0x6c, 0x5a, 0x73, 0x88, 0x1b, 0xe2, 0x21,
-	0x59, 0xaa, 0x49, 0x47, 0x93, 0x30, 0xef, 0x46, 0x8e, 0xe6, 0xe8, 0x2c, 0xe8, 0x02, 0x3c, 0x50,
-	0x04, 0xd3, 0x03, 0x2a, 0x74, 0x23, 0x67, 0x4d, 0x50, 0xd0, 0x11, 0x98, 0x63, 0x02, 0x0c, 0x38,
-	0xc0, 0x83, 0x9c, 0x9d, 0x75, 0x23, 0xe7, 0x2a, 0x5b, 0xa3, 0x45, 0x38, 0x4a, 0x7e, 0x15, 0x12,
-	0xdf, 0xd5, 0x6d, 0x6d, 0x8b, 0xda, 0x91, 0x43, 0xb4, 0xc0, 0xba, 0x4b, 0x70, 0xa1, 0x08, 0xa6,
-	0x33, 0xf1, 0x6e, 0x28, 0x91, 0xb8, 0xc5, 0x05, 0xd6, 0xad, 0xbb, 0x04, 0x5d, 0x80, 0x05, 0x76,
-	0x97, 0x5a, 0x60, 0xe8, 0x36, 0xd1, 0x3c, 0x6a, 0x5b, 0x46, 0x0d, 0x23, 0x6e, 0xe2, 0xc8, 0xf6,
-	0x2e, 0x1e, 0x2a, 0x47, 0x21, 0x5d, 0x67, 0xbc, 0xeb, 0x9c, 0xa5, 0x0e, 0xe9, 0xad, 0x04, 0x74,
-	0x06, 0x16, 0xf4, 0x2d, 0xdd, 0xb2, 0xf5, 0x8a, 0x65, 0x5b, 0x61, 0x4d, 0x63, 0x51, 0x8a, 0x47,
-	0x18, 0x80, 0x3a, 0x2c, 0x33, 0x58, 0x30, 0xa3, 0x13, 0x10, 0x5a, 0x8e, 0x5e, 0x25, 0x9a, 0xab,
-	0x3b, 0x04, 0x8f, 0x4a, 0x9e, 0xc8, 0x71, 0xfa, 0x55, 0xdd, 0x21, 0x68, 0x02, 0x42, 0x9f, 0x04,
-	0xc4, 0xdf, 0xd2, 0x2b, 0x36, 0xc1, 0x63, 0x2c, 0xec, 0x54, 0x89, 0xc2, 0xbc, 0x2e, 0x56, 0xc4,
-	0xd4, 0x2a, 0x35, 0x7c, 0x50, 0xf6, 0x67, 0xc2, 0x58, 0xae, 0x21, 0x05, 0xa2, 0xe0, 0xb6, 0xee,
-	0x13, 0xb3, 0xc5, 0x1f, 0x87, 0x98, 0x3f, 0xd4, 0x61, 0xc1, 0x91, 0xfc, 0xb0, 0x00, 0x91, 0xf0,
-	0xbc, 0x26, 0xdf, 0xe8, 0x61, 0x09, 0x7b, 0x58, 0xf0, 0xaf, 0x36, 0xef, 0xf5, 0x7d, 0x78, 0x24,
-	0xd8, 0xb4, 0x3c, 0x8d, 0x05, 0xaa, 0x61, 0x13, 0xdd, 0x8d, 0x3c, 0x8d, 0xba, 0xda, 0x86, 0x6e,
-	0xd9, 0x91, 0x4f, 0xf0, 0x38, 0xb7, 0xfc, 0x10, 0x13, 0x59, 0xf1, 0x9d, 0x15, 0x21, 0x70, 0xcd,
-	0xbd, 0x2c, 0xd8, 0xe8, 0x18, 0xec, 0xa3, 0x5e, 0xa8, 0xf9, 0x24, 0xc0, 0x47, 0xa4, 0x6d, 0x7a,
-	0xa9, 0x17, 0xaa, 0x24, 0x40, 0x65, 0x98, 0x6b, 0x14, 0x23, 0x7c, 0x94, 0x3f, 0xf4, 0xc3, 0x72,
-	0x14, 0xb3, 0x6a, 0xa5, 0x26, 0x02, 0xd2, 0x6b, 0x6f, 0x6a, 0xa1, 0x9f, 0x43, 0x68, 0xf8, 0x84,
-	0x87, 0xb3, 0x1e, 0xe2, 0x63, 0x1c, 0xe3, 0xc4, 0xac, 0x69, 0x05, 0xa1, 0x6f, 0x55, 0x22, 0x46,
-	0x76, 0xf4, 0xd0, 0xb8, 0xad, 0x11, 0xb7, 0x6a, 0xb9, 0x64, 0xf6, 0x86, 0xe5, 0x90, 0x20, 0xd4,
-	0x1d, 0x6f, 0x79, 0x2c, 0x8e, 0xf9, 0x5c, 0x98, 0x90, 0x04, 0x74, 0x8c, 0x56, 0x0e, 0x19, 0x74,
-	0xe4, 0x99, 0x09, 0xf4, 0xc4, 0xdb, 0x43, 0xc7, 0x68, 0xe5, 0x10, 0xd9, 0x70, 0x34, 0xbe, 0x6c,
-	0x56, 0x82, 0x34, 0xe2, 0x9a, 0x62, 0x93, 0xc9, 0xb7, 0xde, 0x04, 0x49, 0xb8, 0x97, 0x18, 0x6c,
-	0x39, 0x44, 0xc7, 0x61, 0xbf, 0x13, 0xd9, 0xa1, 0xa5, 0x85, 0xc4, 0xd5, 0xdd, 0x10, 0x17, 0xf9,
-	0xa5, 0xe5, 0x39, 0xed, 0x06, 0x27, 0xa1, 0x53, 0x30, 0xeb, 0x92, 0xf0, 0x0e, 0xf5, 0x37, 0x03,
-	0x7c, 0x9c, 0xe7, 0xac, 0xfc, 0xf6, 0x2e, 0xee, 0xbb, 0x2a, 0x68, 0x6a, 0x83, 0x89, 0xce, 0xc0,
-	0x41, 0x93, 0xd8, 0x24, 0x24, 0x9a, 0xe7, 0x13, 0x4f, 0xf7, 0x09, 0x2e, 0x49, 0x39, 0x73, 0x40,
-	0xf0, 0xae, 0x0b, 0x16, 0x3a, 0x0e, 0x73, 0xa6, 0x1b, 0x68, 0xb6, 0x5e, 0x21, 0x36, 0x3e, 0x21,
-	0x05, 0x40, 0xd6, 0x74, 0x83, 0x0f, 0x19, 0x95, 0x65, 0xde, 0x8d, 0x8f, 0x4d, 0x17, 0x9f, 0x94,
-	0xb8, 0x9c, 0xc2, 0x9e, 0x00, 0xcb, 0xa6, 0x96, 0xa1, 0x71, 0x81, 0x53, 0xf2, 0x13, 0x10, 0x8c,
-	0xcb, 0x4c, 0x6c, 0x12, 0xe6, 0x89, 0xcb, 0xde, 0x8c, 0x66, 0x79, 0x5b, 0x8b, 0x78, 0x4a, 0x3c,
-	0x25, 0x41, 0x5a, 0xf5, 0xb6, 0x16, 0xd1, 0x49, 0xd8, 0x4b, 0x2b, 0x1f, 0x69, 0x96, 0x89, 0xdf,
-	0xe9, 0x94, 0xec, 0x7a, 0x68, 0xe5, 0xa3, 0x55, 0x13, 0x2d, 0xc3, 0x31, 0x83, 0x3a, 0x9e, 0x1e,
-	0x5a, 0xf1, 0x1b, 0xdf, 0x22, 0x7e, 0xc0, 0xda, 0x88, 0x69, 0x96, 0x84, 0xd2, 0x4a, 0xa3, 0x2d,
-	0xb2, 0xb7, 0x84, 0x28, 0x5a, 0x85, 0x79, 0xa9, 0xdf, 0xc3, 0xa7, 0x8b, 0xdd, 0xd3, 0xf9, 0x85,
-	0x53, 0xed, 0xd5, 0x96, 0x95, 0xea, 0xd9, 0x72, 0x53, 0xf2, 0x92, 0x1b, 0xfa, 0x35, 0x55, 0xd6,
-	0x45, 0x33, 0x30, 0x6f, 0x56, 0x34, 0x87, 0x9a, 0xc4, 0x66, 0x96, 0x2b, 0x45, 0x30, 0xdd, 0x93,
-	0x36, 0x22, 0x67, 0x56, 0xd6, 0x98, 0xc0, 0xaa, 0x89, 0x2e, 0xc0, 0x41, 0xfe, 0xa4, 0x9b, 0xaf,
-	0x69, 0x86, 0x47, 0x12, 0x96, 0x36, 0x67, 0x8f, 0xba, 0xf1, 0x98, 0xd4, 0x01, 0x57, 0x5e, 0xa2,
-	0x05, 0xc8, 0x93, 0xb9, 0xe6, 0xb1, 0xe6, 0x14, 0xcf, 0x72, 0xcb, 0x47, 0x52, 0xca, 0xd7, 0x29,
-	0xb5, 0xd5, 0x9c, 0x1b, 0x7f, 0x05, 0xe8, 0x36, 0x2c, 0xf0, 0x7e, 0x52, 0x93, 0x0f, 0x3d, 0xc7,
-	0x55, 0x95, 0x3d, 0x0e, 0xcd, 0x5f, 0x74, 0xfa, 0xe4, 0xe9, 0x73, 0x0d, 0x5b, 0x29, 0x29, 0x34,
-	0x03, 0xd1, 0x66, 0x54, 0x21, 0xbe, 0x4b, 0x42, 0x12, 0x34, 0x6e, 0x66, 0x9e, 0x27, 0xe0, 0x42,
-	0x93, 0xd3, 0xbc, 0x87, 0xe3, 0xa6, 0x15, 0xf0, 0x98, 0x30, 0x6b, 0xae, 0xee, 0x58, 0x86, 0xa6,
-	0x7b, 0x1e, 0x6b, 0xd6, 0x35, 0xcf, 0xd6, 0x0d, 0xc2, 0x4b, 0xd4, 0x7b, 0x3c, 0x50, 0x26, 0x62,
-	0xc1, 0x8b, 0x42, 0xae, 0x2c, 0xc4, 0xae, 0x27, 0x52, 0xe8, 0x07, 0x30, 0x13, 0xea, 0xd5, 0x00,
-	0x9b, 0xfc, 0x58, 0xc5, 0x3d, 0x8e, 0x75, 0x43, 0xaf, 0xc6, 0x97, 0xc8, 0xa5, 0xc7, 0x3f, 0x80,
-	0xc3, 0xe9, 0x43, 0xa2, 0x61, 0xd1, 0x82, 0x01, 0x6e, 0x34, 0xef, 0xb2, 0x46, 0x61, 0xcf, 0x96,
-	0x6e, 0x47, 0x84, 0xb7, 0x65, 0x39, 0x55, 0x2c, 0x96, 0xba, 0x7e, 0x04, 0xc6, 0x57, 0xe0, 0x58,
-	0x47, 0x4f, 0x7d, 0x27, 0x90, 0x73, 0x30, 0xd7, 0xb0, 0xeb, 0xbb, 0x28, 0x2e, 0xdd, 0xcb, 0xb0,
-	0x1e, 0xf3, 0x9b, 0x17, 0x18, 0x7c, 0x52, 0xc7, 0xe0, 0x5e, 0x1d, 0x83, 0x3f, 0xd4, 0x31, 0xf8,
-	0x8c, 0x25, 0xdf, 0x3a, 0x06, 0x4f, 0xd8, 0x5d, 0xed, 0xe0, 0x7f, 0x83, 0xa4, 0x99, 0x52, 0x58,
-	0xf9, 0x54, 0xd6, 0x52, 0x85, 0x43, 0x91, 0x3e, 0x2f, 0xb5, 0x95, 0x64, 0xa5, 0xdc, 0xec, 0x30,
-	0x14, 0xb5, 0x51, 0xcf, 0x14, 0xde, 0x4b, 0x29, 0x97, 0x78, 0x7b, 0xa4, 0x34, 0xa2, 0x53, 0x29,
-	0xa7, 0x6a, 0xac, 0xb2, 0x92, 0x24, 0x69, 0xe5, 0x66, 0x92, 0x53, 0x95, 0x6b, 0xbc, 0xa6, 0xc4,
-	0x68, 0x72, 0xfe, 0x53, 0x2e, 0xca, 0x49, 0x49, 0xb9, 0x18, 0xa7, 0x1e, 0x85, 0xa5, 0x0f, 0xbe,
-	0xa3, 0xc8, 0x24, 0xca, 0x4a, 0x87, 0x37, 0xad, 0x48, 0x8d, 0xee, 0xfd, 0x1d, 0xfc, 0x25, 0x88,
-	0xfb, 0xfa, 0xf3, 0x57, 0x48, 0x6d, 0x96, 0x55, 0x72, 0x25, 0x69, 0x75, 0xcf, 0x4b, 0x92, 0xad,
-	0x1c, 0xea, 0x57, 0x5b, 0x98, 0xd7, 0xa4, 0xe9, 0x43, 0xd9, 0x20, 0x26, 0xf1, 0xd9, 0x09, 0xd2,
-	0x52, 0x97, 0x13, 0x46, 0x8b, 0x78, 0x73, 0x92, 0x39, 0xdf, 0x0e, 0xc5, 0xbd, 0x7d, 0x5e, 0x38,
-	0x5d, 0x98, 0xc0, 0x1a, 0x95, 0xf3, 0x71, 0x8b, 0xdd, 0xa4, 0x30, 0xed, 0x84, 0x28, 0x23, 0x7c,
-	0xb1, 0x83, 0xfb, 0x62, 0xfa, 0xc3, 0x5d, 0xdc, 0x6b, 0x44, 0x41, 0x48, 0x9d, 0x07, 0x2f, 0x31,
-	0xf8, 0x69, 0x26, 0x3b, 0x3c, 0x5c, 0x28, 0xdd, 0x84, 0xe3, 0xab, 0xa6, 0x4d, 0xd4, 0x46, 0x9b,
-	0x22, 0xbd, 0x81, 0x00, 0xbd, 0x0b, 0x73, 0x96, 0x69, 0x13, 0x8d, 0xd5, 0x23, 0x1e, 0x68, 0xdd,
-	0xcb, 0xfd, 0xff, 0xf9, 0x6a, 0x32, 0x7b, 0x31, 0xf2, 0x39, 0xba, 0x9a, 0x65, 0x6c, 0x56, 0xc0,
-	0x96, 0xfa, 0xbf, 0xdd, 0xc1, 0xe0, 0xe1, 0x2e, 0xce, 0xb8, 0xd4, 0x25, 0xa5, 0xff, 0x66, 0xe0,
-	0x68, 0x8c, 0x94, 0x5c, 0xf9, 0xcd, 0x40, 0xaf, 0x92, 0x64, 0x6a, 0x01, 0x6f, 0x38, 0xb5, 0xc8,
-	0x83, 0x46, 0xd7, 0x9b, 0x0e, 0x1a, 0x17, 0x52, 0xa3, 0x4e, 0xf7, 0x2b, 0x47, 0x9d, 0x4c, 0xfb,
-	0x78, 0x53, 0x86, 0x43, 0x21, 0x0d, 0x75, 0x5b, 0xca, 0xbd, 0x19, 0x9e, 0x2c, 0xf0, 0x5e, 0x9d,
-	0x8c, 0x3a, 0xc8, 0x15, 0x9a, 0xc9, 0xf7, 0x27, 0x70, 0xc4, 0xf0, 0x22, 0x91, 0x7b, 0x25, 0x98,
-	0x9e, 0xd7, 0xc0, 0x14, 0x0c, 0x2f, 0xe2, 0x99, 0xb8, 0x05, 0xa9, 0xda, 0x01, 0xa9, 0xf7, 0x75,
-	0x48, 0xd5, 0x36, 0xa4, 0x29, 0x38, 0x98, 0xe8, 0x6b, 0x81, 0x41, 0x7d, 0xc2, 0xa7, 0x8c, 0x8c,
-	0x3a, 0x90, 0x50, 0xd7, 0x19, 0x11, 0x9d, 0x83, 0xb8, 0xdd, 0xf4, 0x58, 0x21, 0xcb, 0x15, 0xc6,
-	0xd2, 0x56, 0x36, 0x14, 0xab, 0x7b, 0x29, 0xe6, 0x84, 0x62, 0xb5, 0xa3, 0xe2, 0x04, 0x84, 0xcd,
-	0x84, 0xc1, 0x07, 0xb6, 0x9c, 0x2a, 0x51, 0x96, 0xe6, 0xef, 0xef, 0x60, 0xa5, 0xc3, 0x2b, 0xdd,
-	0xf3, 0xdd, 0x94, 0x1e, 0x66, 0xe0, 0x90, 0x14, 0xc9, 0xab, 0xee, 0x06, 0xfd, 0xbe, 0x06, 0xe9,
-	0x77, 0x60, 0xce, 0xa5, 0xa1, 0xb5, 0x51, 0x63, 0x45, 0xbc, 0x9b, 0xbf, 0x89, 0x5c, 0xb3, 0xd0,
-	0x65, 0x05, 0x6f, 0xd5, 0x44, 0x33, 0x6f, 0x36, 0x65, 0x26, 0xf3, 0xe5, 0xc1, 0xd6, 0xf9, 0xb2,
-	0x31, 0x50, 0x9e, 0x83, 0xbd, 0x4c, 0x20, 0x0a, 0xf8, 0x28, 0xd9, 0x6a, 0xe0, 0x3a, 0x67, 0xb0,
-	0xc3, 0xc9, 0x63, 0xb3, 0x10, 0x6f, 0x6d, 0xc4, 0xfb, 0xf6, 0xd5, 0x88, 0x1b, 0x9d, 0xba, 0x81,
-	0x19, 0x1e, 0x78, 0xf3, 0x9d, 0xcb, 0x26, 0xb3, 0x65, 0x8f, 0x8e, 0x20, 0x9e, 0x46, 0xd2, 0x8d,
-	0xc0, 0xf7, 0x52, 0x18, 0x97, 0x56, 0xd2, 0xe5, 0xed, 0x41, 0x1d, 0x83, 0xcf, 0xeb, 0xb8, 0x5f,
-	0x76, 0xf5, 0xd7, 0xf5, 0x66, 0x86, 0xfa, 0x66, 0x17, 0x83, 0x87, 0x2f, 0xb1, 0xfc, 0xa7, 0x65,
-	0xe1, 0x79, 0x0e, 0x0e, 0x4a, 0xeb, 0xb2, 0x67, 0xa1, 0x3f, 0x01, 0x58, 0x10, 0x85, 0xa9, 0xe5,
-	0x97, 0x4c, 0xe7, 0xc3, 0x8f, 0x17, 0x24, 0xba, 0xca, 0xff, 0xf9, 0x95, 0x7e, 0xb9, 0x5d, 0xc7,
-	0x0b, 0x89, 0x9b, 0xe5, 0xdc, 0xaa, 0x94, 0x0d, 0x76, 0xd6, 0x35, 0xdd, 0xd5, 0xab, 0x44, 0x49,
-	0x07, 0xf0, 0xa7, 0x3b, 0x18, 0x3c, 0xde, 0xc1, 0xe0, 0xd7, 0x7f, 0xfb, 0xc7, 0xef, 0xbb, 0x70,
-	0x69, 0x64, 0x4e, 0x8c, 0x30, 0x73, 0xd2, 0x4f, 0xca, 0x25, 0x70, 0x7a, 0x1e, 0xa0, 0xfb, 0x00,
-	0x16, 0x44, 0x2d, 0xdc, 0xa7, 0x81, 0x3f, 0xdb, 0xbf, 0x81, 0x0d, 0xe3, 0xc4, 0x84, 0xd0, 0x6e,
-	0xdc, 0xf3, 0x2e, 0x58, 0x10, 0xc5, 0x7c, 0x9f, 0xc6, 0xfd, 0xb9, 0x6b, 0xff, 0xd6, 0x7d, 0xb1,
-	0x83, 0x1f, 0x81, 0xa4, 0x71, 0x69, 0xfc, 0x8d, 0x68, 0xef, 0x3b, 0x9a, 0xa5, 0x4f, 0x59, 0x4f,
-	0x4d, 0xd7, 0x4a, 0xf2, 0xcf, 0x45, 0xb9, 0xd8, 0xf8, 0xdf, 0xa1, 0xac, 0x26, 0x03, 0xbf, 0x12,
-	0x4f, 0x52, 0x81, 0xb2, 0xd6, 0x9c, 0xba, 0xe4, 0xe6, 0x42, 0x69, 0x69, 0xcf, 0x95, 0xa4, 0xdf,
-	0x0e, 0x96, 0x4e, 0x88, 0x04, 0xd6, 0x42, 0x68, 0x11, 0xbd, 0x92, 0xee, 0x7f, 0x1b, 0xae, 0x16,
-	0xf3, 0x66, 0xbb, 0xab, 0xff, 0x08, 0xe0, 0xd0, 0xfa, 0x6d, 0x7a, 0xe7, 0x4d, 0x1c, 0xbd, 0x07,
-	0xbd, 0x74, 0x63, 0xbb, 0x8e, 0xe7, 0x5f, 0xe1, 0xec, 0x5b, 0x16, 0xb9, 0xd3, 0xe6, 0xea, 0x46,
-	0x94, 0x1e, 0x2c, 0x15, 0xe6, 0x82, 0xdb, 0xf4, 0x4e, 0xbb, 0x6d, 0x7f, 0x05, 0xb0, 0x28, 0x62,
-	0xf4, 0x15, 0xad, 0xc6, 0x94, 0x9c, 0x9b, 0xf6, 0x14, 0xeb, 0x14, 0x24, 0xeb, 0xdb, 0x75, 0x5c,
-	0x7a, 0x7d, 0x8c, 0x70, 0x23, 0x4f, 0x95, 0x4a, 0x49, 0xb4, 0xb2, 0xce, 0xa5, 0xf9, 0x77, 0x46,
-	0x32, 0x3a, 0x58, 0x02, 0xa7, 0xd1, 0x97, 0x00, 0x62, 0xc9, 0x9f, 0xad, 0x4d, 0xcc, 0x5e, 0x8e,
-	0x9d, 0x6c, 0xa7, 0xb7, 0x28, 0x96, 0x7e, 0x03, 0xf6, 0xe3, 0xe2, 0xed, 0x1d, 0x7c, 0xb8, 0xad,
-	0x33, 0x4c, 0xca, 0xe0, 0x27, 0xbb, 0x18, 0x3c, 0x79, 0x19, 0xdf, 0xc1, 0x54, 0xa9, 0xd8, 0x76,
-	0x07, 0x73, 0x49, 0x3e, 0x9f, 0x8b, 0x98, 0x0d, 0xfc, 0x4a, 0x16, 0x7e, 0x0b, 0x20, 0x4a, 0xa5,
-	0x6d, 0x96, 0xee, 0x3e, 0x86, 0x23, 0xa9, 0x20, 0xe2, 0x95, 0x73, 0x7c, 0xef, 0x64, 0x3f, 0xfe,
-	0x0a, 0x5e, 0xa9, 0xc8, 0x8d, 0x1a, 0x2f, 0x8d, 0xb5, 0x19, 0x65, 0xb9, 0x1b, 0x94, 0x5b, 0xb2,
-	0x7c, 0xf4, 0xd1, 0xdf, 0x27, 0x0e, 0x3c, 0x7a, 0x3a, 0x01, 0x1e, 0x3f, 0x9d, 0x00, 0x5f, 0x3f,
-	0x9d, 0x00, 0xf7, 0x9e, 0x4d, 0x1c, 0x78, 0xfc, 0x6c, 0xe2, 0xc0, 0x93, 0x67, 0x13, 0x07, 0x2a,
-	0xbd, 0x1c, 0xf8, 0xec, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7c, 0xca, 0x27, 0x3a, 0x9e, 0x19,
-	0x00, 0x00,
+	// 2453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x14, 0xc9,
+	0xd9, 0xa7, 0xec, 0xb1, 0x3d, 0x53, 0xe3, 0xaf, 0x29, 0xdb, 0x6c, 0x61, 0xc0, 0x1e, 0x06, 0xbc,
+	0x78, 0xa1, 0xfd, 0xb1, 0xe6, 0x7d, 0x4d, 0x62, 0x2d, 0x8b, 0xc6, 0x36, 0x28, 0x0e, 0x6b, 0x40,
+	0x6d, 0x40, 0x4a, 0x14, 0xa9, 0xd5, 0xd3, 0x5d, 0x1e, 0x7a, 0xdd, 0x5d, 0xd5, 0xdb, 0x1f, 0x26,
+	0xc3, 0x69, 0x15, 0x45, 0xca, 0x75, 0x95, 0x5c, 0x92, 0x3d, 0x44, 0x1c, 0x12, 0x69, 0xa5, 0x5c,
+	0x36, 0x1c, 0xf7, 0x2f, 0xe0, 0x88, 0x94, 0x0b, 0x5a, 0xad, 0x56, 0x1b, 0x48, 0xa4, 0x8d, 0x4f,
+	0x9b, 0x60, 0x9b, 0x55, 0xa4, 0x48, 0x51, 0x55, 0x75, 0xcf, 0xd4, 0x7c, 0x18, 0x58, 0xd8, 0xdc,
+	0xba, 0x9e, 0x8f, 0x5f, 0x3d, 0xf5, 0xd4, 0x53, 0xcf, 0x47, 0xc3, 0x82, 0xe5, 0xc6, 0x61, 0x44,
+	0x02, 0x87, 0x86, 0xd1, 0xac, 0x1f, 0xb0, 0x88, 0xa1, 0x1c, 0xb1, 0xab, 0x44, 0x7c, 0x8e, 0x1f,
+	0xab, 0x32, 0x56, 0x75, 0xc9, 0x9c, 0xe9, 0x3b, 0x73, 0x26, 0xa5, 0x2c, 0x32, 0x23, 0x87, 0xd1,
+	0x50, 0x0a, 0x8e, 0x1f, 0x8f, 0x18, 0x73, 0xc3, 0x39, 0xb1, 0xa8, 0x12, 0x5a, 0xff, 0x48, 0xd8,
+	0xfd, 0x01, 0x09, 0x63, 0x37, 0x4a, 0x57, 0x9b, 0xae, 0xb9, 0xcd, 0x82, 0x64, 0x35, 0x14, 0x90,
+	0x90, 0xc5, 0x81, 0x45, 0x52, 0xac, 0x81, 0xc4, 0x8e, 0x64, 0x59, 0xb0, 0x5c, 0x16, 0xdb, 0x2e,
+	0x89, 0xb6, 0x48, 0x2d, 0x05, 0xb0, 0x98, 0xe7, 0xb1, 0x14, 0x7c, 0xd4, 0xa1, 0x9b, 0x81, 0xd9,
+	0x8a, 0x32, 0x5a, 0x65, 0x55, 0x26, 0x3e, 0xe7, 0xf8, 0x57, 0x8a, 0x6d, 0x7b, 0x64, 0xce, 0x65,
+	0x96, 0x5c, 0x96, 0xfe, 0x0e, 0xe0, 0xf0, 0x8a, 0xdc, 0x6d, 0x8d, 0x86, 0xd1, 0x15, 0x52, 0xbb,
+	0xf5, 0x36, 0x7a, 0x17, 0xe6, 0x13, 0x0b, 0x8c, 0x2d, 0x52, 0xc3, 0xa0, 0x08, 0xa6, 0xf3, 0x0b,
+	0x6f, 0xcc, 0xd6, 0x5d, 0x31, 0x9b, 0x68, 0x08, 0xe9, 0xe5, 0xcc, 0x83, 0x2f, 0x27, 0x0f, 0xe9,
+	0xd0, 0xaa, 0xd3, 0xd0, 0x15, 0xd8, 0x9f, 0x9a, 0x2c, 0x00, 0xba, 0x04, 0xc0, 0xe1, 0x26, 0x00,
+	0xc9, 0xbe, 0x42, 0x6a, 0xcb, 0xc3, 0x3b, 0xfb, 0x38, 0x9b, 0x12, 0x04, 0x56, 0xde, 0x6a, 0xb0,
+	0xd1, 0x22, 0xec, 0x67, 0x41, 0xd5, 0xa4, 0xce, 0x5d, 0xe1, 0x6f, 0xdc, 0x5d, 0x04, 0xd3, 0xb9,
+	0x65, 0xf4, 0xd9, 0x3e, 0x4e, 0xb7, 0x64, 0x41, 0xf5, 0xe1, 0x3e, 0x06, 0x7a, 0x93, 0xdc, 0x52,
+	0xff, 0xd7, 0x4f, 0x31, 0xf8, 0xf6, 0x29, 0x06, 0x9f, 0xde, 0x9b, 0x04, 0xa5, 0x3f, 0xb5, 0x9f,
+	0x73, 0x01, 0xbd, 0xd3, 0xe9, 0x9c, 0x63, 0x1d, 0xcf, 0xf9, 0x3f, 0x3e, 0x65, 0x8b, 0xb5, 0xff,
+	0x38, 0x02, 0xf3, 0x8a, 0xb5, 0xe8, 0x30, 0xec, 0xdd, 0x74, 0x88, 0x6b, 0x87, 0x18, 0x14, 0xbb,
+	0xa7, 0x73, 0x7a, 0xb2, 0x42, 0x33, 0xb0, 0xbb, 0xb1, 0xf3, 0x73, 0x0d, 0xe7, 0x72, 0x6d, 0x16,
+	0xbf, 0xf5, 0x3a, 0xf7, 0x72, 0x0e, 0x66, 0xef, 0x32, 0x4a, 0x04, 0xd0, 0x59, 0x01, 0x84, 0x14,
+	0xa0, 0x9f, 0x32, 0x4a, 0x1a, 0xbb, 0xf7, 0xdd, 0x95, 0x4b, 0xb4, 0x08, 0x7b, 0x65, 0xe8, 0x8b,
+	0x6b, 0xcc, 0x2f, 0x8c, 0x2a, 0x2a, 0x97, 0x05, 0x83, 0x2b, 0x65, 0x3f, 0xd9, 0xc5, 0x40, 0x28,
+	0x26, 0xd2, 0xe8, 0xff, 0x61, 0xd6, 0x75, 0xb6, 0x09, 0x25, 0x61, 0x88, 0x73, 0x45, 0x30, 0x3d,
+	0xb8, 0x30, 0xa2, 0x68, 0xbe, 0x97, 0xb0, 0x96, 0x33, 0x5c, 0x51, 0xaf, 0x8b, 0x22, 0x0c, 0x33,
+	0x66, 0x1c, 0x31, 0x0c, 0x8b, 0x60, 0x3a, 0x9b, 0x70, 0x05, 0x05, 0x9d, 0x83, 0x3d, 0x61, 0x64,
+	0x46, 0x04, 0x67, 0x04, 0x9a, 0x1a, 0xdc, 0x37, 0x02, 0xd3, 0xda, 0x22, 0xf6, 0x06, 0x67, 0x27,
+	0x3a, 0x52, 0x16, 0x4d, 0xc1, 0x5e, 0x12, 0x04, 0x2c, 0x08, 0x71, 0x0f, 0xbf, 0x86, 0xe5, 0x01,
+	0xce, 0xfc, 0xf5, 0xfd, 0x23, 0x3d, 0x94, 0x59, 0x9e, 0xaf, 0x27, 0x4c, 0xf4, 0x43, 0xd8, 0x6f,
+	0x05, 0x9e, 0xc1, 0xb6, 0x49, 0x10, 0x38, 0x36, 0xc1, 0xbd, 0x62, 0x8b, 0x26, 0x37, 0xeb, 0xeb,
+	0xd7, 0x12, 0xae, 0x9e, 0xb7, 0x02, 0x2f, 0x5d, 0xa0, 0x45, 0x98, 0x73, 0x7c, 0xc3, 0xb4, 0x2c,
+	0x7e, 0xd0, 0xbe, 0xb6, 0x83, 0xae, 0xf9, 0x65, 0xc1, 0x4a, 0x0f, 0xea, 0x24, 0x6b, 0x34, 0x0f,
+	0xfb, 0x4d, 0xd7, 0x65, 0x96, 0x19, 0x11, 0xdb, 0x70, 0x7c, 0x9c, 0x15, 0x8f, 0xa4, 0xc5, 0xbe,
+	0x7c, 0x5d, 0x64, 0xcd, 0x47, 0x53, 0x30, 0x4f, 0x99, 0x4d, 0x8c, 0xe4, 0x3a, 0xf2, 0x42, 0x41,
+	0xc2, 0x42, 0xce, 0x90, 0xb7, 0x81, 0x4e, 0x41, 0x68, 0x13, 0xdf, 0x65, 0x35, 0x8f, 0xd0, 0x08,
+	0x0f, 0xa9, 0x52, 0x0d, 0x3a, 0x9a, 0x84, 0x79, 0x1a, 0x7b, 0x86, 0x67, 0xf2, 0xa0, 0x0b, 0xf1,
+	0x40, 0x11, 0x4c, 0x0f, 0xe8, 0x90, 0xc6, 0xde, 0xba, 0xa4, 0xa0, 0xa3, 0x30, 0xc7, 0x05, 0x38,
+	0x70, 0x88, 0x07, 0x05, 0x3b, 0x4b, 0x63, 0xef, 0x2a, 0x5f, 0xa3, 0x45, 0x38, 0x4a, 0x7e, 0x1e,
+	0x91, 0x80, 0x9a, 0xae, 0xb1, 0xcd, 0xdc, 0xd8, 0x23, 0x46, 0xe8, 0xdc, 0x25, 0xb8, 0x50, 0x04,
+	0xd3, 0x99, 0x64, 0x37, 0x94, 0x4a, 0xdc, 0x12, 0x02, 0x1b, 0xce, 0x5d, 0x82, 0x2e, 0xc2, 0x02,
+	0xbf, 0x4b, 0x23, 0xb4, 0x4c, 0x97, 0x18, 0x3e, 0x73, 0x1d, 0xab, 0x86, 0x91, 0x30, 0x71, 0x64,
+	0x67, 0x1f, 0x0f, 0x95, 0xe3, 0x88, 0x6d, 0x70, 0xde, 0x75, 0xc1, 0xd2, 0x87, 0xcc, 0x66, 0x02,
+	0x3a, 0x0b, 0x0b, 0xe6, 0xb6, 0xe9, 0xb8, 0x66, 0xc5, 0x71, 0x9d, 0xa8, 0x66, 0xf0, 0x28, 0xc5,
+	0x23, 0x1c, 0x40, 0x1f, 0x56, 0x19, 0x3c, 0x98, 0xd1, 0x49, 0x08, 0x1d, 0xcf, 0xac, 0x12, 0x83,
+	0x9a, 0x1e, 0xc1, 0xa3, 0x8a, 0x27, 0x72, 0x82, 0x7e, 0xd5, 0xf4, 0x08, 0x9a, 0x80, 0x30, 0x20,
+	0x21, 0x09, 0xb6, 0xcd, 0x8a, 0x4b, 0xf0, 0x18, 0x0f, 0x3b, 0x5d, 0xa1, 0x70, 0xaf, 0xcb, 0x15,
+	0xb1, 0x8d, 0x4a, 0x0d, 0x1f, 0x56, 0xfd, 0x99, 0x32, 0x96, 0x6b, 0x48, 0x83, 0x28, 0xbc, 0x6d,
+	0x06, 0xc4, 0x6e, 0xf2, 0xc7, 0x1b, 0xdc, 0x1f, 0xfa, 0xb0, 0xe4, 0x28, 0x7e, 0x58, 0x80, 0x48,
+	0x7a, 0xde, 0x50, 0x6f, 0xf4, 0x88, 0x82, 0x3d, 0x2c, 0xf9, 0x57, 0x1b, 0xf7, 0xfa, 0x0e, 0x3c,
+	0x1a, 0x6e, 0x39, 0xbe, 0xc1, 0x03, 0xd5, 0x72, 0x89, 0x49, 0x63, 0xdf, 0x60, 0xd4, 0xd8, 0x34,
+	0x1d, 0x37, 0x0e, 0x08, 0x1e, 0x17, 0x96, 0xbf, 0xc1, 0x45, 0x56, 0x02, 0x6f, 0x45, 0x0a, 0x5c,
+	0xa3, 0x97, 0x25, 0x1b, 0x1d, 0x87, 0x7d, 0xcc, 0x8f, 0x8c, 0x80, 0x84, 0xf8, 0xa8, 0xb2, 0x4d,
+	0x2f, 0xf3, 0x23, 0x9d, 0x84, 0xa8, 0x0c, 0x73, 0xf5, 0x62, 0x84, 0x8f, 0x89, 0x87, 0x7e, 0x44,
+	0x8d, 0x62, 0x5e, 0xad, 0xf4, 0x54, 0x40, 0x79, 0xed, 0x0d, 0x2d, 0xf4, 0x13, 0x08, 0xad, 0x80,
+	0x88, 0x70, 0x36, 0x23, 0x7c, 0x5c, 0x60, 0x9c, 0x9c, 0xb5, 0x9d, 0x30, 0x0a, 0x9c, 0x4a, 0xcc,
+	0xc9, 0x9e, 0x19, 0x59, 0xb7, 0x0d, 0x42, 0xab, 0x0e, 0x25, 0xb3, 0x37, 0x1c, 0x8f, 0x84, 0x91,
+	0xe9, 0xf9, 0xcb, 0x63, 0x49, 0xcc, 0xe7, 0xa2, 0x94, 0x24, 0xa1, 0x13, 0xb4, 0x72, 0xc4, 0xa1,
+	0x63, 0xdf, 0x4e, 0xa1, 0x27, 0x5e, 0x1f, 0x3a, 0x41, 0x2b, 0x47, 0xc8, 0x85, 0xa3, 0xc9, 0x65,
+	0xf3, 0x12, 0x64, 0x10, 0x6a, 0xcb, 0x4d, 0x26, 0x5f, 0x7b, 0x13, 0xa4, 0xe0, 0x5e, 0xe2, 0xb0,
+	0xe5, 0x08, 0x9d, 0x80, 0xfd, 0x5e, 0xec, 0x46, 0x8e, 0x11, 0x11, 0x6a, 0xd2, 0x08, 0x17, 0xc5,
+	0xa5, 0xe5, 0x05, 0xed, 0x86, 0x20, 0xa1, 0xd3, 0x30, 0x4b, 0x49, 0x74, 0x87, 0x05, 0x5b, 0x21,
+	0x3e, 0x21, 0x72, 0x56, 0x7e, 0x67, 0x1f, 0xf7, 0x5d, 0x95, 0x34, 0xbd, 0xce, 0x44, 0x67, 0xe1,
+	0xa0, 0x4d, 0x5c, 0x12, 0x11, 0xc3, 0x0f, 0x88, 0x6f, 0x06, 0x04, 0x97, 0x94, 0x9c, 0x39, 0x20,
+	0x79, 0xd7, 0x25, 0x0b, 0x9d, 0x80, 0x39, 0x9b, 0x86, 0x86, 0x6b, 0x56, 0x88, 0x8b, 0x4f, 0x2a,
+	0x01, 0x90, 0xb5, 0x69, 0xf8, 0x1e, 0xa7, 0xf2, 0xcc, 0xbb, 0xf9, 0x81, 0x4d, 0xf1, 0x29, 0x85,
+	0x2b, 0x28, 0xfc, 0x09, 0xf0, 0x6c, 0xea, 0x58, 0x86, 0x10, 0x38, 0xad, 0x3e, 0x01, 0xc9, 0xb8,
+	0xcc, 0xc5, 0x26, 0x61, 0x9e, 0x50, 0xfe, 0x66, 0x0c, 0xc7, 0xdf, 0x5e, 0xc4, 0x53, 0xf2, 0x29,
+	0x49, 0xd2, 0x9a, 0xbf, 0xbd, 0x88, 0x4e, 0xc1, 0x5e, 0x56, 0x79, 0xdf, 0x70, 0x6c, 0xfc, 0x66,
+	0xa7, 0x64, 0xd7, 0xc3, 0x2a, 0xef, 0xaf, 0xd9, 0x68, 0x19, 0x8e, 0x59, 0xcc, 0xf3, 0xcd, 0xc8,
+	0x49, 0xde, 0xf8, 0x36, 0x09, 0x42, 0xde, 0x46, 0x4c, 0xf3, 0x24, 0xd4, 0xaa, 0x34, 0xda, 0x24,
+	0x7b, 0x4b, 0x8a, 0xa2, 0x35, 0x98, 0x57, 0xfa, 0x3d, 0x7c, 0xa6, 0xd8, 0x3d, 0x9d, 0x5f, 0x38,
+	0xdd, 0x5e, 0x6d, 0x79, 0xa9, 0x9e, 0x2d, 0x37, 0x24, 0x2f, 0xd1, 0x28, 0xa8, 0xe9, 0xaa, 0x2e,
+	0x9a, 0x81, 0x79, 0xbb, 0x62, 0x78, 0xcc, 0x26, 0x2e, 0xb7, 0x5c, 0x2b, 0x82, 0xe9, 0x9e, 0x56,
+	0x23, 0x72, 0x76, 0x65, 0x9d, 0x0b, 0xac, 0xd9, 0xe8, 0x22, 0x1c, 0x14, 0x4f, 0xba, 0xf1, 0x9a,
+	0x66, 0x44, 0x24, 0x61, 0x65, 0x73, 0xfe, 0xa8, 0xeb, 0x8f, 0x49, 0x1f, 0xa0, 0xea, 0x12, 0x2d,
+	0x40, 0x91, 0xcc, 0x0d, 0x9f, 0x37, 0xa7, 0x78, 0x56, 0x58, 0x3e, 0xd2, 0xa2, 0x7c, 0x9d, 0x31,
+	0x57, 0xcf, 0xd1, 0xe4, 0x2b, 0x44, 0xb7, 0x61, 0x41, 0xf4, 0x93, 0x86, 0x7a, 0xe8, 0x39, 0xa1,
+	0xaa, 0x1d, 0x70, 0x68, 0xf1, 0xa2, 0x5b, 0x4f, 0xde, 0x7a, 0xae, 0x61, 0xa7, 0x45, 0x0a, 0xcd,
+	0x40, 0xb4, 0x15, 0x57, 0x48, 0x40, 0x49, 0x44, 0xc2, 0xfa, 0xcd, 0xcc, 0x8b, 0x04, 0x5c, 0x68,
+	0x70, 0x1a, 0xf7, 0x70, 0xc2, 0x76, 0x42, 0x11, 0x13, 0x76, 0x8d, 0x9a, 0x9e, 0x63, 0x19, 0xa6,
+	0xef, 0xf3, 0x66, 0xdd, 0xf0, 0x5d, 0xd3, 0x22, 0xa2, 0x44, 0xbd, 0x2d, 0x02, 0x65, 0x22, 0x11,
+	0x5c, 0x95, 0x72, 0x65, 0x29, 0x76, 0x3d, 0x95, 0x42, 0x25, 0xd8, 0x67, 0x07, 0x35, 0x23, 0x88,
+	0x29, 0x5e, 0x10, 0x71, 0x9e, 0x53, 0xca, 0xb8, 0x1d, 0xd4, 0xf4, 0x98, 0xa2, 0xff, 0x83, 0x99,
+	0xc8, 0xac, 0x86, 0xd8, 0x16, 0x47, 0x2f, 0x1e, 0x70, 0xf4, 0x1b, 0x66, 0x35, 0xb9, 0x68, 0x21,
+	0x3d, 0xfe, 0x2e, 0x1c, 0x6e, 0x75, 0x04, 0x1a, 0x96, 0x6d, 0x1a, 0x10, 0x07, 0x13, 0x9d, 0xd8,
+	0x28, 0xec, 0xd9, 0x36, 0xdd, 0x98, 0x88, 0xd6, 0x2d, 0xa7, 0xcb, 0xc5, 0x52, 0xd7, 0x0f, 0xc0,
+	0xf8, 0x0a, 0x1c, 0xeb, 0xe8, 0xcd, 0xef, 0x04, 0x72, 0x1e, 0xe6, 0xea, 0x76, 0x7d, 0x17, 0xc5,
+	0xa5, 0x9d, 0x0c, 0xef, 0x43, 0xbf, 0x79, 0x8a, 0xc1, 0x87, 0xbb, 0x18, 0x7c, 0xb4, 0x8b, 0xc1,
+	0x6f, 0x77, 0x31, 0xf8, 0x94, 0x27, 0xe8, 0x5d, 0x0c, 0x1e, 0xf1, 0xfb, 0xdc, 0xc3, 0xff, 0x04,
+	0x69, 0xc3, 0xa5, 0xf1, 0x12, 0xab, 0xad, 0xb7, 0x14, 0x17, 0x4d, 0xf9, 0xbc, 0xd4, 0x56, 0xb6,
+	0xb5, 0x72, 0xa3, 0x0b, 0xd1, 0xf4, 0x7a, 0xcd, 0xd3, 0x44, 0xbf, 0xa5, 0x5d, 0x12, 0x2d, 0x94,
+	0x56, 0x8f, 0x60, 0xad, 0xdc, 0x52, 0x87, 0xb5, 0x95, 0x34, 0x91, 0x6b, 0x37, 0xd3, 0xbc, 0xab,
+	0x5d, 0x13, 0x75, 0x27, 0x41, 0x53, 0x73, 0xa4, 0xb6, 0xaa, 0x26, 0x2e, 0x6d, 0x35, 0x49, 0x4f,
+	0x1a, 0x4f, 0x31, 0x62, 0x47, 0x99, 0x6d, 0xb4, 0x95, 0x0e, 0xef, 0x5e, 0x53, 0x9a, 0xe1, 0x8f,
+	0xf7, 0xf0, 0x17, 0x20, 0xe9, 0xfd, 0x2f, 0x5c, 0x21, 0xb5, 0x59, 0x5e, 0xed, 0xb5, 0xb4, 0x1d,
+	0xbe, 0xa0, 0x48, 0x36, 0x73, 0x58, 0x50, 0x6d, 0x62, 0x5e, 0x53, 0x26, 0x14, 0x6d, 0x93, 0xd8,
+	0x24, 0xe0, 0x27, 0x68, 0x95, 0xba, 0x9c, 0x32, 0x9a, 0xc4, 0x1b, 0xd3, 0xce, 0x85, 0x76, 0x28,
+	0xe1, 0xed, 0x0b, 0xd2, 0xe9, 0xd2, 0x04, 0xde, 0xcc, 0x5c, 0x48, 0xda, 0xf0, 0x06, 0x85, 0x6b,
+	0xa7, 0x44, 0x15, 0xe1, 0xf3, 0x3d, 0xdc, 0x97, 0xd0, 0xef, 0xef, 0xe3, 0x5e, 0x2b, 0x0e, 0x23,
+	0xe6, 0xdd, 0x7b, 0x86, 0xc1, 0xe3, 0x67, 0xf8, 0x16, 0x9f, 0x3b, 0xd5, 0xd9, 0x4a, 0xe3, 0x04,
+	0xaa, 0x9e, 0xd3, 0x68, 0x13, 0x69, 0xe2, 0x08, 0xd9, 0x46, 0xbf, 0xa8, 0x89, 0x76, 0xfa, 0xc7,
+	0x99, 0xec, 0xf0, 0x70, 0xa1, 0x74, 0x13, 0x8e, 0xaf, 0xd9, 0x2e, 0xd1, 0xeb, 0x4d, 0x92, 0xf2,
+	0xba, 0x42, 0xf4, 0x16, 0xcc, 0x39, 0xb6, 0x4b, 0x0c, 0x5e, 0x0d, 0x45, 0x08, 0x77, 0x2f, 0xf7,
+	0xff, 0xfb, 0xcb, 0xc9, 0xec, 0x6a, 0x1c, 0x88, 0x7d, 0xf4, 0x2c, 0x67, 0xf3, 0xf2, 0xb9, 0xd4,
+	0xff, 0xed, 0x1e, 0x06, 0xf7, 0xf7, 0x71, 0x86, 0x32, 0x4a, 0x4a, 0xff, 0xc9, 0xc0, 0xd1, 0x04,
+	0x29, 0x0d, 0xa6, 0x9b, 0xa1, 0x59, 0x25, 0xe9, 0xcc, 0x04, 0x5e, 0x72, 0x66, 0x52, 0xc7, 0x9c,
+	0xae, 0x97, 0x1d, 0x73, 0x2e, 0xb6, 0x0c, 0x5a, 0xdd, 0xcf, 0x1d, 0xb4, 0x32, 0xed, 0xc3, 0x55,
+	0x19, 0x0e, 0x45, 0x2c, 0x32, 0x5d, 0x25, 0xf3, 0x67, 0x44, 0x1a, 0xc2, 0x07, 0xf5, 0x51, 0xfa,
+	0xa0, 0x50, 0x68, 0xa4, 0xfe, 0x1f, 0xc1, 0x11, 0xcb, 0x8f, 0x65, 0xe6, 0x57, 0x60, 0x7a, 0x5e,
+	0x00, 0x53, 0xb0, 0xfc, 0x58, 0xd4, 0x81, 0x26, 0xa4, 0x6a, 0x07, 0xa4, 0xde, 0x17, 0x21, 0x55,
+	0xdb, 0x90, 0xa6, 0xe0, 0x60, 0xaa, 0x6f, 0x84, 0x16, 0x0b, 0x88, 0x98, 0x71, 0x32, 0xfa, 0x40,
+	0x4a, 0xdd, 0xe0, 0x44, 0x74, 0x1e, 0xe2, 0x76, 0xd3, 0x13, 0x85, 0xac, 0x50, 0x18, 0x6b, 0xb5,
+	0xb2, 0xae, 0x58, 0x3d, 0x48, 0x31, 0x27, 0x15, 0xab, 0x1d, 0x15, 0x27, 0x20, 0x6c, 0xa4, 0x22,
+	0x31, 0x2e, 0xe6, 0x74, 0x85, 0xb2, 0x34, 0xff, 0xf1, 0x1e, 0xd6, 0x3a, 0xbc, 0xff, 0x03, 0x5f,
+	0x64, 0xe9, 0x7e, 0x06, 0x0e, 0x29, 0x91, 0xbc, 0x46, 0x37, 0xd9, 0xf7, 0x35, 0xc6, 0xbf, 0x09,
+	0x73, 0x94, 0x45, 0xce, 0x66, 0x8d, 0xb7, 0x10, 0xdd, 0xe2, 0x4d, 0x28, 0xe5, 0x2b, 0x2b, 0x79,
+	0x6b, 0x36, 0x9a, 0x79, 0xb9, 0x19, 0x37, 0x9d, 0x6e, 0x0f, 0x37, 0x4f, 0xb7, 0xf5, 0x71, 0xf6,
+	0x3c, 0xec, 0xe5, 0x02, 0x71, 0x28, 0x06, 0xd9, 0x66, 0x03, 0x37, 0x04, 0x83, 0x1f, 0x4e, 0x1d,
+	0xda, 0xa5, 0x78, 0xf3, 0x18, 0xd0, 0xf7, 0x4a, 0x63, 0x80, 0xd5, 0xa9, 0x17, 0x99, 0x11, 0x81,
+	0x37, 0xdf, 0xb9, 0x20, 0x73, 0x5b, 0x0e, 0xe8, 0x47, 0x92, 0x59, 0xa8, 0xb5, 0x0d, 0xf9, 0x5e,
+	0x4a, 0xee, 0xd2, 0x4a, 0x6b, 0xe1, 0xbc, 0xb7, 0x8b, 0xc1, 0x67, 0xbb, 0xb8, 0x5f, 0x75, 0xf5,
+	0x57, 0xbb, 0x8d, 0x0c, 0xf5, 0xcd, 0x3e, 0x06, 0xf7, 0x9f, 0x61, 0xf5, 0x3f, 0xcf, 0xc2, 0xbf,
+	0x72, 0x70, 0x50, 0x59, 0x97, 0x7d, 0x07, 0xfd, 0x1e, 0xc0, 0x82, 0x2c, 0x79, 0x4d, 0x3f, 0x84,
+	0x3a, 0x1f, 0x7e, 0xbc, 0xa0, 0xd0, 0x75, 0xf1, 0xc7, 0xb1, 0xf4, 0xb3, 0x9d, 0x5d, 0xbc, 0x90,
+	0xba, 0x59, 0xcd, 0xad, 0x5a, 0xd9, 0xe2, 0x67, 0x5d, 0x37, 0xa9, 0x59, 0x25, 0x5a, 0x6b, 0x00,
+	0x7f, 0xb2, 0x87, 0xc1, 0xc3, 0x3d, 0x0c, 0x7e, 0xf1, 0x97, 0xbf, 0xfd, 0xa6, 0x0b, 0x97, 0x46,
+	0xe6, 0xe4, 0x00, 0x35, 0xa7, 0xfc, 0x22, 0x5d, 0x02, 0x67, 0xe6, 0x01, 0xfa, 0x03, 0x80, 0x05,
+	0x59, 0x65, 0x5f, 0xd1, 0xc0, 0xca, 0xab, 0x1b, 0xf8, 0xf9, 0x1e, 0xee, 0x5d, 0x15, 0xdd, 0x5a,
+	0xdd, 0x4c, 0x39, 0xa9, 0xb4, 0x9b, 0xf9, 0x75, 0x17, 0x2c, 0xc8, 0x86, 0xe1, 0x15, 0xcd, 0xfc,
+	0x63, 0xd7, 0x6b, 0xd9, 0xf9, 0x00, 0xa4, 0xcd, 0x51, 0xfd, 0xaf, 0x48, 0x7b, 0x6f, 0xd3, 0x28,
+	0x82, 0xda, 0x46, 0xcb, 0x94, 0xaf, 0xa5, 0xff, 0x7e, 0xb4, 0xd5, 0x46, 0x1d, 0x5d, 0x4b, 0x7f,
+	0x3c, 0x68, 0xc9, 0x44, 0x17, 0x6a, 0xeb, 0x8d, 0xe9, 0x4f, 0x6d, 0x60, 0xb4, 0xa6, 0x31, 0x41,
+	0x4b, 0xfb, 0xfe, 0x70, 0xe9, 0xa4, 0x4c, 0x65, 0x4d, 0x84, 0x26, 0xd1, 0x2b, 0xad, 0x7d, 0x78,
+	0xdd, 0xd5, 0x72, 0xee, 0x6d, 0x77, 0xf5, 0xef, 0x00, 0x1c, 0xda, 0xb8, 0xcd, 0xee, 0xbc, 0x8c,
+	0xa3, 0x0f, 0xa0, 0x97, 0x6e, 0xec, 0xec, 0xe2, 0xf9, 0xe7, 0x38, 0xfb, 0x96, 0x43, 0xee, 0xb4,
+	0xb9, 0xba, 0x1e, 0xaf, 0x87, 0x4b, 0x85, 0xb9, 0xf0, 0x36, 0xbb, 0xd3, 0x6e, 0xdb, 0x9f, 0x01,
+	0x2c, 0xca, 0x68, 0x7d, 0x4e, 0xd3, 0x31, 0xa5, 0x66, 0xa9, 0x03, 0xc5, 0x3a, 0x05, 0xc9, 0xc6,
+	0xce, 0x2e, 0x2e, 0xbd, 0x38, 0x46, 0x84, 0x91, 0xa7, 0x4b, 0xa5, 0x34, 0x5a, 0x79, 0x0f, 0xd3,
+	0xf8, 0x4b, 0xa4, 0x18, 0x1d, 0x2e, 0x81, 0x33, 0xe8, 0x0b, 0x00, 0xb1, 0xe2, 0xcf, 0xe6, 0x76,
+	0xe6, 0x20, 0xc7, 0x4e, 0xb6, 0xd3, 0x9b, 0x14, 0x4b, 0xbf, 0x04, 0xaf, 0xe2, 0xe2, 0x9d, 0x3d,
+	0x7c, 0xa4, 0xad, 0xfb, 0x4c, 0x0b, 0xe2, 0x87, 0xfb, 0x18, 0x3c, 0x7a, 0x96, 0xdc, 0xc1, 0x54,
+	0xa9, 0xd8, 0x76, 0x07, 0x73, 0x69, 0x66, 0x9f, 0x8b, 0xb9, 0x0d, 0xe2, 0x4a, 0x16, 0x7e, 0x05,
+	0x20, 0x6a, 0x49, 0xe0, 0x3c, 0xf1, 0x7d, 0x00, 0x47, 0x5a, 0x82, 0x48, 0xd4, 0xd0, 0xf1, 0x83,
+	0xd3, 0xfe, 0xf8, 0x73, 0x78, 0xa5, 0xa2, 0x30, 0x6a, 0xbc, 0x34, 0xd6, 0x66, 0x94, 0x43, 0x37,
+	0x99, 0xb0, 0x64, 0xf9, 0xd8, 0x83, 0xbf, 0x4e, 0x1c, 0x7a, 0xf0, 0x78, 0x02, 0x3c, 0x7c, 0x3c,
+	0x01, 0xbe, 0x7a, 0x3c, 0x01, 0x3e, 0x7a, 0x32, 0x71, 0xe8, 0xe1, 0x93, 0x89, 0x43, 0x8f, 0x9e,
+	0x4c, 0x1c, 0xaa, 0xf4, 0x0a, 0xe0, 0x73, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x60, 0x18, 0xc6,
+	0xaf, 0x26, 0x1a, 0x00, 0x00,
 }
 
 func (this *ClusterInstKeyV1) GoString() string {
@@ -1262,6 +1267,18 @@ func (m *ClusterInst) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa2
 		}
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
 	if m.DisableDynamicAppinstPlacement {
 		i--
 		if m.DisableDynamicAppinstPlacement {
@@ -2537,6 +2554,11 @@ func (m *ClusterInst) Matches(o *ClusterInst, fopts ...MatchOpt) bool {
 			return false
 		}
 	}
+	if !opts.Filter || o.DryRun != false {
+		if o.DryRun != m.DryRun {
+			return false
+		}
+	}
 	if !opts.Filter || o.Tags != nil {
 		if len(m.Tags) == 0 && len(o.Tags) > 0 || len(m.Tags) > 0 && len(o.Tags) == 0 {
 			return false
@@ -2656,6 +2678,7 @@ const ClusterInstFieldInfraAnnotationsKey = "47.1"
 const ClusterInstFieldInfraAnnotationsValue = "47.2"
 const ClusterInstFieldKubernetesVersion = "48"
 const ClusterInstFieldDisableDynamicAppinstPlacement = "49"
+const ClusterInstFieldDryRun = "50"
 const ClusterInstFieldTags = "100"
 const ClusterInstFieldTagsKey = "100.1"
 const ClusterInstFieldTagsValue = "100.2"
@@ -2741,6 +2764,7 @@ var ClusterInstAllFields = []string{
 	ClusterInstFieldInfraAnnotationsValue,
 	ClusterInstFieldKubernetesVersion,
 	ClusterInstFieldDisableDynamicAppinstPlacement,
+	ClusterInstFieldDryRun,
 	ClusterInstFieldTagsKey,
 	ClusterInstFieldTagsValue,
 }
@@ -2826,6 +2850,7 @@ var ClusterInstAllFieldsMap = NewFieldMap(map[string]struct{}{
 	ClusterInstFieldInfraAnnotationsValue:                    struct{}{},
 	ClusterInstFieldKubernetesVersion:                        struct{}{},
 	ClusterInstFieldDisableDynamicAppinstPlacement:           struct{}{},
+	ClusterInstFieldDryRun:                                   struct{}{},
 	ClusterInstFieldTagsKey:                                  struct{}{},
 	ClusterInstFieldTagsValue:                                struct{}{},
 })
@@ -2911,6 +2936,7 @@ var ClusterInstAllFieldsStringMap = map[string]string{
 	ClusterInstFieldInfraAnnotationsValue:                    "Infra Annotations Value",
 	ClusterInstFieldKubernetesVersion:                        "Kubernetes Version",
 	ClusterInstFieldDisableDynamicAppinstPlacement:           "Disable Dynamic Appinst Placement",
+	ClusterInstFieldDryRun:                                   "Dry Run",
 	ClusterInstFieldTagsKey:                                  "Tags Key",
 	ClusterInstFieldTagsValue:                                "Tags Value",
 }
@@ -3348,6 +3374,9 @@ func (m *ClusterInst) DiffFields(o *ClusterInst, fields *FieldMap) {
 	if m.DisableDynamicAppinstPlacement != o.DisableDynamicAppinstPlacement {
 		fields.Set(ClusterInstFieldDisableDynamicAppinstPlacement)
 	}
+	if m.DryRun != o.DryRun {
+		fields.Set(ClusterInstFieldDryRun)
+	}
 	if m.Tags != nil && o.Tags != nil {
 		if len(m.Tags) != len(o.Tags) {
 			fields.Set(ClusterInstFieldTags)
@@ -3407,6 +3436,7 @@ var UpdateClusterInstFieldsMap = NewFieldMap(map[string]struct{}{
 	ClusterInstFieldInfraAnnotationsKey:                      struct{}{},
 	ClusterInstFieldInfraAnnotationsValue:                    struct{}{},
 	ClusterInstFieldDisableDynamicAppinstPlacement:           struct{}{},
+	ClusterInstFieldDryRun:                                   struct{}{},
 	ClusterInstFieldTags:                                     struct{}{},
 	ClusterInstFieldTagsKey:                                  struct{}{},
 	ClusterInstFieldTagsValue:                                struct{}{},
@@ -3439,6 +3469,11 @@ func (m *ClusterInst) ValidateUpdateFieldsCustom(allowedFields *FieldMap) error
 	return nil
 }
 
+// TableColumns are the default columns for table output
+func (m *ClusterInst) TableColumns() []string {
+	return []string{"key.organization", "key.name", "cloudlet_key.organization", "cloudlet_key.name", "deployment", "state"}
+}
+
 func (m *ClusterInst) Clone() *ClusterInst {
 	cp := &ClusterInst{}
 	cp.DeepCopyIn(m)
@@ -4051,6 +4086,12 @@ func (m *ClusterInst) CopyInFields(src *ClusterInst) int {
 			changed++
 		}
 	}
+	if fmap.Has("50") {
+		if m.DryRun != src.DryRun {
+			m.DryRun = src.DryRun
+			changed++
+		}
+	}
 	if fmap.HasOrHasChild("100") {
 		if src.Tags != nil {
 			if updateListAction == "add" {
@@ -4169,6 +4210,7 @@ func (m *ClusterInst) DeepCopyIn(src *ClusterInst) {
 	}
 	m.KubernetesVersion = src.KubernetesVersion
 	m.DisableDynamicAppinstPlacement = src.DisableDynamicAppinstPlacement
+	m.DryRun = src.DryRun
 	if src.Tags != nil {
 		m.Tags = make(map[string]string)
 		for k, v := range src.Tags {
@@ -4914,6 +4956,9 @@ func (s *ClusterInst) ClearTagged(tags map[string]struct{}) {
 	if _, found := tags["nocmp"]; found {
 		s.InfraAnnotations = nil
 	}
+	if _, found := tags["nocmp"]; found {
+		s.DryRun = false
+	}
 }
 
 func IgnoreClusterInstFields(taglist string) cmp.Option {
@@ -4949,6 +4994,9 @@ func IgnoreClusterInstFields(taglist string) cmp.Option {
 	if _, found := tags["nocmp"]; found {
 		names = append(names, "InfraAnnotations")
 	}
+	if _, found := tags["nocmp"]; found {
+		names = append(names, "DryRun")
+	}
 	return cmpopts.IgnoreFields(ClusterInst{}, names...)
 }
 
@@ -7083,6 +7131,9 @@ func (m *ClusterInst) IsValidArgsForDeleteClusterInst() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.DryRun != false {
+		return fmt.Errorf("Invalid field specified: DryRun, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -7492,6 +7543,9 @@ func (m *ClusterInst) Size() (n int) {
 	if m.DisableDynamicAppinstPlacement {
 		n += 3
 	}
+	if m.DryRun {
+		n += 3
+	}
 	if len(m.Tags) > 0 {
 		for k, v := range m.Tags {
 			_ = k
@@ -9363,6 +9417,26 @@ func (m *ClusterInst) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DisableDynamicAppinstPlacement = bool(v != 0)
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterinst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
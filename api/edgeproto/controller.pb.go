@@ -126,41 +126,203 @@ func (m *Controller) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Controller proto.InternalMessageInfo
 
+// ControllerBackupRequest
+//
+// ControllerBackupRequest specifies how to export a backup of the region's controller data
+type ControllerBackupRequest struct {
+	// Passphrase used to encrypt the backup, required to restore it
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Include secret data such as cloudlet node password hashes in the backup
+	IncludeSecrets bool `protobuf:"varint,2,opt,name=include_secrets,json=includeSecrets,proto3" json:"include_secrets,omitempty"`
+}
+
+func (m *ControllerBackupRequest) Reset()         { *m = ControllerBackupRequest{} }
+func (m *ControllerBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*ControllerBackupRequest) ProtoMessage()    {}
+func (*ControllerBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed7f10298fa1d90f, []int{2}
+}
+func (m *ControllerBackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerBackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerBackupRequest.Merge(m, src)
+}
+func (m *ControllerBackupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerBackupRequest proto.InternalMessageInfo
+
+// ControllerBackup
+//
+// ControllerBackup is an encrypted point-in-time snapshot of the region's controller data
+type ControllerBackup struct {
+	// Region the backup was taken from
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Etcd revision at which the snapshot was taken
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Data model version hash of the snapshot
+	DataModelVersionHash string `protobuf:"bytes,3,opt,name=data_model_version_hash,json=dataModelVersionHash,proto3" json:"data_model_version_hash,omitempty"`
+	// Data model version ID of the snapshot
+	DataModelVersionId int32 `protobuf:"varint,4,opt,name=data_model_version_id,json=dataModelVersionId,proto3" json:"data_model_version_id,omitempty"`
+	// Number of objects in the snapshot
+	NumObjects int32 `protobuf:"varint,5,opt,name=num_objects,json=numObjects,proto3" json:"num_objects,omitempty"`
+	// Whether secret data is included in the snapshot
+	IncludeSecrets bool `protobuf:"varint,6,opt,name=include_secrets,json=includeSecrets,proto3" json:"include_secrets,omitempty"`
+	// Encrypted snapshot data, base64 encoded
+	Data string `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ControllerBackup) Reset()         { *m = ControllerBackup{} }
+func (m *ControllerBackup) String() string { return proto.CompactTextString(m) }
+func (*ControllerBackup) ProtoMessage()    {}
+func (*ControllerBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed7f10298fa1d90f, []int{3}
+}
+func (m *ControllerBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerBackup.Merge(m, src)
+}
+func (m *ControllerBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerBackup proto.InternalMessageInfo
+
+// ControllerRestoreRequest
+//
+// ControllerRestoreRequest restores a backup into an empty region
+type ControllerRestoreRequest struct {
+	// Passphrase used to encrypt the backup
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Encrypted snapshot data from ControllerBackup
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ControllerRestoreRequest) Reset()         { *m = ControllerRestoreRequest{} }
+func (m *ControllerRestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*ControllerRestoreRequest) ProtoMessage()    {}
+func (*ControllerRestoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed7f10298fa1d90f, []int{4}
+}
+func (m *ControllerRestoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerRestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerRestoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerRestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerRestoreRequest.Merge(m, src)
+}
+func (m *ControllerRestoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerRestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerRestoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerRestoreRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ControllerKey)(nil), "edgeproto.ControllerKey")
 	proto.RegisterType((*Controller)(nil), "edgeproto.Controller")
+	proto.RegisterType((*ControllerBackupRequest)(nil), "edgeproto.ControllerBackupRequest")
+	proto.RegisterType((*ControllerBackup)(nil), "edgeproto.ControllerBackup")
+	proto.RegisterType((*ControllerRestoreRequest)(nil), "edgeproto.ControllerRestoreRequest")
 }
 
 func init() { proto.RegisterFile("controller.proto", fileDescriptor_ed7f10298fa1d90f) }
 
 var fileDescriptor_ed7f10298fa1d90f = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0xab, 0xd3, 0x40,
-	0x14, 0xc5, 0x33, 0x7d, 0x79, 0xd5, 0xcc, 0xfb, 0xc3, 0x63, 0x78, 0x95, 0xb1, 0xd6, 0xb4, 0x64,
-	0x21, 0x55, 0xa4, 0x29, 0x75, 0xd7, 0x5d, 0x2b, 0x82, 0x20, 0x6e, 0xe2, 0x5a, 0x64, 0xda, 0x8c,
-	0x49, 0x30, 0x9d, 0x1b, 0x92, 0x29, 0xa5, 0x3b, 0xf1, 0x13, 0x88, 0x7e, 0x01, 0x97, 0xba, 0x93,
-	0x2e, 0xfd, 0x04, 0x5d, 0x16, 0xdc, 0xb8, 0x12, 0x6d, 0x5d, 0xb8, 0x14, 0x5a, 0x8a, 0x4b, 0xc9,
-	0xb4, 0x24, 0x15, 0xf2, 0x76, 0xe7, 0xde, 0xf3, 0xcb, 0xc9, 0xcd, 0x09, 0xbe, 0x18, 0x82, 0x90,
-	0x31, 0x84, 0x21, 0x8f, 0x5b, 0x51, 0x0c, 0x12, 0x88, 0xc1, 0x5d, 0x8f, 0x2b, 0x59, 0xad, 0x79,
-	0x00, 0x5e, 0xc8, 0x6d, 0x16, 0x05, 0x36, 0x13, 0x02, 0x24, 0x93, 0x01, 0x88, 0x64, 0x07, 0x56,
-	0x2f, 0x3d, 0xf0, 0x40, 0x49, 0x3b, 0x55, 0xfb, 0xed, 0x6d, 0x09, 0x10, 0x26, 0xb6, 0x1a, 0x3c,
-	0x2e, 0x32, 0xb1, 0xb3, 0xad, 0x47, 0xf8, 0xec, 0x61, 0xf6, 0xc6, 0x27, 0x7c, 0x4a, 0xee, 0x60,
-	0x9d, 0xb9, 0x6e, 0x4c, 0x51, 0x03, 0x35, 0x8d, 0x3e, 0xf9, 0xb2, 0xa5, 0xe7, 0xf9, 0x49, 0xa9,
-	0xe3, 0x28, 0xbf, 0x7b, 0xfa, 0x7b, 0x4d, 0xd1, 0xdf, 0x35, 0x45, 0x9f, 0x3f, 0xd4, 0x91, 0xf5,
-	0xa9, 0x84, 0x71, 0x9e, 0x43, 0x6e, 0xe0, 0xf2, 0xcb, 0x80, 0x87, 0x6e, 0x42, 0x51, 0xe3, 0xa8,
-	0x69, 0x38, 0xfb, 0x89, 0xb4, 0xf1, 0xd1, 0x2b, 0x3e, 0xa5, 0xa5, 0x06, 0x6a, 0x9e, 0x74, 0x68,
-	0x2b, 0xfb, 0xb2, 0xd6, 0x7f, 0x37, 0xf4, 0xf5, 0xf9, 0xf7, 0xba, 0xe6, 0xa4, 0x28, 0x69, 0xe3,
-	0xd3, 0xc1, 0x38, 0x08, 0xdd, 0x17, 0x23, 0x96, 0x48, 0x1e, 0x53, 0x5d, 0x9d, 0x75, 0xf6, 0x71,
-	0x43, 0xd1, 0xbb, 0xd9, 0xcd, 0x63, 0x01, 0xc3, 0x51, 0xe4, 0x9c, 0x28, 0xe4, 0xa9, 0x22, 0xc8,
-	0x7d, 0x8c, 0x77, 0x4f, 0xf8, 0x9c, 0xb9, 0xf4, 0xb8, 0x88, 0x37, 0x14, 0xf0, 0x98, 0x33, 0x37,
-	0xcf, 0x67, 0x63, 0xe9, 0x43, 0x4c, 0xcb, 0x57, 0xe7, 0xf7, 0x14, 0x41, 0xee, 0xe2, 0xeb, 0x3e,
-	0x24, 0x52, 0xb0, 0x11, 0xa7, 0xd7, 0x8a, 0xe8, 0xcc, 0xee, 0x5e, 0xa6, 0x1d, 0xfd, 0x59, 0x53,
-	0xf4, 0x7a, 0x43, 0xd1, 0x6c, 0x4b, 0x75, 0x01, 0x82, 0x77, 0xc4, 0x61, 0xe5, 0xbd, 0x28, 0x20,
-	0xcf, 0xf1, 0xf9, 0x33, 0x1f, 0x26, 0x07, 0xfd, 0x55, 0x0a, 0xab, 0xa9, 0x16, 0xaf, 0xad, 0x5b,
-	0x6f, 0xbe, 0xfe, 0x7a, 0x5f, 0xaa, 0x58, 0x17, 0x76, 0xe2, 0xc3, 0xc4, 0xce, 0x7f, 0x57, 0x17,
-	0xdd, 0x6b, 0xa3, 0x7e, 0x6d, 0xfe, 0xd3, 0xd4, 0xe6, 0x4b, 0x13, 0x2d, 0x96, 0x26, 0xfa, 0xb1,
-	0x34, 0xd1, 0xdb, 0x95, 0xa9, 0x2d, 0x56, 0xa6, 0xf6, 0x6d, 0x65, 0x6a, 0x83, 0xb2, 0x8a, 0x7b,
-	0xf0, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xde, 0x92, 0x87, 0x42, 0x79, 0x02, 0x00, 0x00,
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0xd4, 0x5c,
+	0x14, 0x9e, 0x3b, 0x5f, 0x2f, 0x73, 0xf9, 0x1a, 0xee, 0x0b, 0x2f, 0xf7, 0x1d, 0xb0, 0x90, 0x9a,
+	0x28, 0x9a, 0x09, 0x45, 0x8c, 0x1b, 0x76, 0x0c, 0x9a, 0x60, 0x08, 0x7e, 0x94, 0xc4, 0x9d, 0x99,
+	0xdc, 0x69, 0x0f, 0x6d, 0xa5, 0xd3, 0x3b, 0xf6, 0xb6, 0x10, 0x76, 0xc6, 0x1f, 0x60, 0x8c, 0xee,
+	0x0d, 0x4b, 0xdd, 0x19, 0x96, 0xfe, 0x02, 0x96, 0x24, 0x6e, 0x5c, 0x19, 0x1d, 0x5c, 0x18, 0x57,
+	0x26, 0x20, 0x71, 0x69, 0x7a, 0x3b, 0x4e, 0x67, 0x48, 0x35, 0xec, 0xce, 0x39, 0xcf, 0x73, 0x9f,
+	0xf3, 0xdc, 0x73, 0x7a, 0x8b, 0xcb, 0x06, 0xf7, 0x02, 0x9f, 0xbb, 0x2e, 0xf8, 0xf3, 0x2d, 0x9f,
+	0x07, 0x9c, 0x94, 0xc0, 0xb4, 0x40, 0x86, 0x95, 0x69, 0x8b, 0x73, 0xcb, 0x05, 0x8d, 0xb5, 0x1c,
+	0x8d, 0x79, 0x1e, 0x0f, 0x58, 0xe0, 0x70, 0x4f, 0xc4, 0xc4, 0xca, 0xb8, 0xc5, 0x2d, 0x2e, 0x43,
+	0x2d, 0x8a, 0x3a, 0xd5, 0x0b, 0x01, 0xe7, 0xae, 0xd0, 0x64, 0x62, 0x81, 0xd7, 0x0d, 0x3a, 0xf0,
+	0x90, 0x0f, 0x22, 0x74, 0x83, 0x38, 0x53, 0x6f, 0xe1, 0xe1, 0x95, 0x6e, 0xff, 0x35, 0xd8, 0x25,
+	0x97, 0x70, 0x9e, 0x99, 0xa6, 0x4f, 0xd1, 0x2c, 0x9a, 0x2b, 0xd5, 0xc8, 0xbb, 0x53, 0x3a, 0x92,
+	0x18, 0x8c, 0x10, 0x5d, 0xe2, 0x4b, 0x43, 0x5f, 0x8f, 0x29, 0xfa, 0x79, 0x4c, 0xd1, 0xdb, 0xbd,
+	0x19, 0xa4, 0xbe, 0xc9, 0x62, 0x9c, 0xe8, 0x90, 0xff, 0x70, 0x71, 0xd3, 0x01, 0xd7, 0x14, 0x14,
+	0xcd, 0xe6, 0xe6, 0x4a, 0x7a, 0x27, 0x23, 0x0b, 0x38, 0xb7, 0x05, 0xbb, 0x34, 0x3b, 0x8b, 0xe6,
+	0x06, 0x17, 0xe9, 0x7c, 0xf7, 0x9e, 0xf3, 0x7d, 0x1e, 0x6a, 0xf9, 0x83, 0x8f, 0x33, 0x19, 0x3d,
+	0xa2, 0x92, 0x05, 0x3c, 0xd4, 0x08, 0x1d, 0xd7, 0xac, 0x37, 0x99, 0x08, 0xc0, 0xa7, 0x79, 0x69,
+	0x6b, 0xf8, 0xf5, 0x09, 0x45, 0x2f, 0xf6, 0xff, 0x2f, 0x78, 0xdc, 0x68, 0xb6, 0xf4, 0x41, 0x49,
+	0x59, 0x97, 0x0c, 0x52, 0xc5, 0x38, 0x3e, 0x61, 0x03, 0x33, 0x69, 0x21, 0x8d, 0x5f, 0x92, 0x84,
+	0x55, 0x60, 0x66, 0xa2, 0xcf, 0xc2, 0xc0, 0xe6, 0x3e, 0x2d, 0xfe, 0x59, 0x7f, 0x59, 0x32, 0xc8,
+	0x15, 0x3c, 0x60, 0x73, 0x11, 0x78, 0xac, 0x09, 0xf4, 0x9f, 0x34, 0x76, 0x17, 0x5e, 0x1a, 0x8f,
+	0x66, 0xf4, 0xfd, 0x98, 0xa2, 0x27, 0x27, 0x14, 0xed, 0x9f, 0xd2, 0xbc, 0xc7, 0x3d, 0x50, 0x1b,
+	0x78, 0x32, 0xb9, 0x6e, 0x8d, 0x19, 0x5b, 0x61, 0x4b, 0x87, 0xc7, 0x21, 0x88, 0x80, 0x28, 0x18,
+	0xb7, 0x98, 0x10, 0x2d, 0xdb, 0x67, 0x02, 0xe2, 0x15, 0xe8, 0x3d, 0x15, 0x72, 0x19, 0x8f, 0x3a,
+	0x9e, 0xe1, 0x86, 0x26, 0xd4, 0x05, 0x18, 0x3e, 0x04, 0x42, 0xce, 0x72, 0x40, 0x1f, 0xe9, 0x94,
+	0x37, 0xe2, 0xaa, 0xfa, 0x2c, 0x8b, 0xcb, 0x67, 0x9b, 0x44, 0x5b, 0xf1, 0xc1, 0x72, 0xb8, 0xd7,
+	0x51, 0xee, 0x64, 0xa4, 0x82, 0x07, 0x7c, 0xd8, 0x76, 0x44, 0x84, 0x44, 0x72, 0x39, 0xbd, 0x9b,
+	0x93, 0x1b, 0x78, 0xd2, 0x64, 0x01, 0xab, 0x37, 0xb9, 0x09, 0x6e, 0x7d, 0x1b, 0xfc, 0xa8, 0x5a,
+	0xb7, 0x99, 0xb0, 0x69, 0x4e, 0x8a, 0x8c, 0x47, 0xf0, 0x7a, 0x84, 0x3e, 0x88, 0xc1, 0x55, 0x26,
+	0x6c, 0x72, 0x0d, 0x4f, 0xa4, 0x1c, 0x73, 0x4c, 0xb9, 0xbf, 0x82, 0x4e, 0xce, 0x1e, 0xba, 0x6d,
+	0x92, 0x19, 0x3c, 0xe8, 0x85, 0xcd, 0x3a, 0x6f, 0x3c, 0x02, 0x23, 0x10, 0x72, 0x71, 0x05, 0x1d,
+	0x7b, 0x61, 0xf3, 0x6e, 0x5c, 0x49, 0xbb, 0x7c, 0x31, 0xed, 0xf2, 0x84, 0xe0, 0x7c, 0xa4, 0x1f,
+	0x6f, 0x47, 0x97, 0xb1, 0x7a, 0x07, 0xd3, 0x64, 0x1e, 0x3a, 0x88, 0x80, 0xfb, 0x70, 0xde, 0xa9,
+	0xff, 0xd6, 0xcb, 0x26, 0x7a, 0x8b, 0xfb, 0xb9, 0xde, 0x87, 0xb3, 0xdc, 0x72, 0xc8, 0x43, 0x3c,
+	0xb2, 0x61, 0xf3, 0x9d, 0x9e, 0x57, 0x30, 0x91, 0xfa, 0x81, 0x57, 0xd2, 0xcb, 0xea, 0xd4, 0xd3,
+	0xf7, 0x5f, 0x5e, 0x66, 0x27, 0xd4, 0xb2, 0x26, 0x6c, 0xbe, 0xa3, 0x25, 0x8f, 0x6e, 0x09, 0x5d,
+	0x5d, 0x40, 0x64, 0x0f, 0xe1, 0x72, 0xbc, 0xc7, 0x9e, 0x0e, 0x6a, 0xaa, 0x54, 0xdf, 0x37, 0x55,
+	0x99, 0xfa, 0x0b, 0x47, 0x5d, 0xfb, 0x76, 0x42, 0xa7, 0x75, 0x10, 0x3c, 0xf4, 0x0d, 0x58, 0xe1,
+	0xde, 0xa6, 0x63, 0x55, 0x97, 0x8d, 0xe8, 0x1f, 0xb3, 0xce, 0x3c, 0x66, 0x41, 0xb5, 0xfd, 0x83,
+	0xe2, 0x7b, 0xdd, 0x51, 0x48, 0x8b, 0x93, 0x2a, 0xd1, 0x1a, 0xf2, 0x78, 0xbf, 0x49, 0xf2, 0x0a,
+	0xe1, 0xb1, 0xce, 0x68, 0x7b, 0x3c, 0x5e, 0x4c, 0xed, 0xdf, 0xbf, 0x82, 0xca, 0x58, 0x0f, 0x49,
+	0x97, 0xff, 0x27, 0xf5, 0xfe, 0x39, 0xac, 0x8d, 0x26, 0xd6, 0xaa, 0x37, 0x59, 0xc0, 0xa4, 0x3f,
+	0xaa, 0xfe, 0xab, 0xf9, 0xb1, 0x7c, 0xbf, 0xc1, 0xda, 0xf4, 0xc1, 0x67, 0x25, 0x73, 0xd0, 0x56,
+	0xd0, 0x61, 0x5b, 0x41, 0x9f, 0xda, 0x0a, 0x7a, 0x7e, 0xa4, 0x64, 0x0e, 0x8f, 0x94, 0xcc, 0x87,
+	0x23, 0x25, 0xd3, 0x28, 0xca, 0xf6, 0xd7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xc5, 0x99,
+	0xda, 0x91, 0x05, 0x00, 0x00,
 }
 
 func (this *ControllerKey) GoString() string {
@@ -196,6 +358,10 @@ const _ = grpc.SupportPackageIsVersion4
 type ControllerApiClient interface {
 	// Show Controllers
 	ShowController(ctx context.Context, in *Controller, opts ...grpc.CallOption) (ControllerApi_ShowControllerClient, error)
+	// Export an encrypted backup of all controller data in the region
+	BackupController(ctx context.Context, in *ControllerBackupRequest, opts ...grpc.CallOption) (*ControllerBackup, error)
+	// Restore a controller backup into an empty region
+	RestoreController(ctx context.Context, in *ControllerRestoreRequest, opts ...grpc.CallOption) (*Result, error)
 }
 
 type controllerApiClient struct {
@@ -238,10 +404,32 @@ func (x *controllerApiShowControllerClient) Recv() (*Controller, error) {
 	return m, nil
 }
 
+func (c *controllerApiClient) BackupController(ctx context.Context, in *ControllerBackupRequest, opts ...grpc.CallOption) (*ControllerBackup, error) {
+	out := new(ControllerBackup)
+	err := c.cc.Invoke(ctx, "/edgeproto.ControllerApi/BackupController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerApiClient) RestoreController(ctx context.Context, in *ControllerRestoreRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/edgeproto.ControllerApi/RestoreController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControllerApiServer is the server API for ControllerApi service.
 type ControllerApiServer interface {
 	// Show Controllers
 	ShowController(*Controller, ControllerApi_ShowControllerServer) error
+	// Export an encrypted backup of all controller data in the region
+	BackupController(context.Context, *ControllerBackupRequest) (*ControllerBackup, error)
+	// Restore a controller backup into an empty region
+	RestoreController(context.Context, *ControllerRestoreRequest) (*Result, error)
 }
 
 // UnimplementedControllerApiServer can be embedded to have forward compatible implementations.
@@ -251,6 +439,12 @@ type UnimplementedControllerApiServer struct {
 func (*UnimplementedControllerApiServer) ShowController(req *Controller, srv ControllerApi_ShowControllerServer) error {
 	return status.Errorf(codes.Unimplemented, "method ShowController not implemented")
 }
+func (*UnimplementedControllerApiServer) BackupController(ctx context.Context, req *ControllerBackupRequest) (*ControllerBackup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupController not implemented")
+}
+func (*UnimplementedControllerApiServer) RestoreController(ctx context.Context, req *ControllerRestoreRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreController not implemented")
+}
 
 func RegisterControllerApiServer(s *grpc.Server, srv ControllerApiServer) {
 	s.RegisterService(&_ControllerApi_serviceDesc, srv)
@@ -277,10 +471,55 @@ func (x *controllerApiShowControllerServer) Send(m *Controller) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ControllerApi_BackupController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControllerBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerApiServer).BackupController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.ControllerApi/BackupController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerApiServer).BackupController(ctx, req.(*ControllerBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerApi_RestoreController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControllerRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerApiServer).RestoreController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.ControllerApi/RestoreController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerApiServer).RestoreController(ctx, req.(*ControllerRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ControllerApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "edgeproto.ControllerApi",
 	HandlerType: (*ControllerApiServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BackupController",
+			Handler:    _ControllerApi_BackupController_Handler,
+		},
+		{
+			MethodName: "RestoreController",
+			Handler:    _ControllerApi_RestoreController_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "ShowController",
@@ -391,6 +630,152 @@ func (m *Controller) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ControllerBackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerBackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerBackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeSecrets {
+		i--
+		if m.IncludeSecrets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Passphrase) > 0 {
+		i -= len(m.Passphrase)
+		copy(dAtA[i:], m.Passphrase)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Passphrase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.IncludeSecrets {
+		i--
+		if m.IncludeSecrets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumObjects != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.NumObjects))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DataModelVersionId != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.DataModelVersionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DataModelVersionHash) > 0 {
+		i -= len(m.DataModelVersionHash)
+		copy(dAtA[i:], m.DataModelVersionHash)
+		i = encodeVarintController(dAtA, i, uint64(len(m.DataModelVersionHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerRestoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerRestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerRestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Passphrase) > 0 {
+		i -= len(m.Passphrase)
+		copy(dAtA[i:], m.Passphrase)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Passphrase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintController(dAtA []byte, offset int, v uint64) int {
 	offset -= sovController(v)
 	base := offset
@@ -1343,59 +1728,254 @@ func IgnoreControllerFields(taglist string) cmp.Option {
 	return cmpopts.IgnoreFields(Controller{}, names...)
 }
 
-func (m *ControllerKey) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ControllerBackupRequest) Clone() *ControllerBackupRequest {
+	cp := &ControllerBackupRequest{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *ControllerBackupRequest) CopyInFields(src *ControllerBackupRequest) int {
+	changed := 0
+	if m.Passphrase != src.Passphrase {
+		m.Passphrase = src.Passphrase
+		changed++
 	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovController(uint64(l))
+	if m.IncludeSecrets != src.IncludeSecrets {
+		m.IncludeSecrets = src.IncludeSecrets
+		changed++
 	}
-	return n
+	return changed
 }
 
-func (m *Controller) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ControllerBackupRequest) DeepCopyIn(src *ControllerBackupRequest) {
+	m.Passphrase = src.Passphrase
+	m.IncludeSecrets = src.IncludeSecrets
+}
+
+// Helper method to check that enums have valid values
+func (m *ControllerBackupRequest) ValidateEnums() error {
+	return nil
+}
+
+func (s *ControllerBackupRequest) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *ControllerBackup) Clone() *ControllerBackup {
+	cp := &ControllerBackup{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *ControllerBackup) CopyInFields(src *ControllerBackup) int {
+	changed := 0
+	if m.Region != src.Region {
+		m.Region = src.Region
+		changed++
 	}
-	var l int
-	_ = l
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
-			n += 1 + l + sovController(uint64(l))
-		}
+	if m.Revision != src.Revision {
+		m.Revision = src.Revision
+		changed++
 	}
-	l = m.Key.Size()
-	n += 1 + l + sovController(uint64(l))
-	l = len(m.BuildMaster)
-	if l > 0 {
-		n += 1 + l + sovController(uint64(l))
+	if m.DataModelVersionHash != src.DataModelVersionHash {
+		m.DataModelVersionHash = src.DataModelVersionHash
+		changed++
 	}
-	l = len(m.BuildHead)
-	if l > 0 {
-		n += 1 + l + sovController(uint64(l))
+	if m.DataModelVersionId != src.DataModelVersionId {
+		m.DataModelVersionId = src.DataModelVersionId
+		changed++
 	}
-	l = len(m.BuildAuthor)
-	if l > 0 {
-		n += 1 + l + sovController(uint64(l))
+	if m.NumObjects != src.NumObjects {
+		m.NumObjects = src.NumObjects
+		changed++
 	}
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovController(uint64(l))
+	if m.IncludeSecrets != src.IncludeSecrets {
+		m.IncludeSecrets = src.IncludeSecrets
+		changed++
 	}
-	return n
+	if m.Data != src.Data {
+		m.Data = src.Data
+		changed++
+	}
+	return changed
 }
 
-func sovController(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ControllerBackup) DeepCopyIn(src *ControllerBackup) {
+	m.Region = src.Region
+	m.Revision = src.Revision
+	m.DataModelVersionHash = src.DataModelVersionHash
+	m.DataModelVersionId = src.DataModelVersionId
+	m.NumObjects = src.NumObjects
+	m.IncludeSecrets = src.IncludeSecrets
+	m.Data = src.Data
 }
-func sozController(x uint64) (n int) {
-	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// Helper method to check that enums have valid values
+func (m *ControllerBackup) ValidateEnums() error {
+	return nil
 }
-func (m *ControllerKey) Unmarshal(dAtA []byte) error {
+
+func (s *ControllerBackup) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *ControllerRestoreRequest) Clone() *ControllerRestoreRequest {
+	cp := &ControllerRestoreRequest{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *ControllerRestoreRequest) CopyInFields(src *ControllerRestoreRequest) int {
+	changed := 0
+	if m.Passphrase != src.Passphrase {
+		m.Passphrase = src.Passphrase
+		changed++
+	}
+	if m.Data != src.Data {
+		m.Data = src.Data
+		changed++
+	}
+	return changed
+}
+
+func (m *ControllerRestoreRequest) DeepCopyIn(src *ControllerRestoreRequest) {
+	m.Passphrase = src.Passphrase
+	m.Data = src.Data
+}
+
+// Helper method to check that enums have valid values
+func (m *ControllerRestoreRequest) ValidateEnums() error {
+	return nil
+}
+
+func (s *ControllerRestoreRequest) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *ControllerBackupRequest) IsValidArgsForBackupController() error {
+	return nil
+}
+
+func (m *ControllerRestoreRequest) IsValidArgsForRestoreController() error {
+	return nil
+}
+
+func (m *ControllerKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *Controller) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovController(uint64(l))
+	l = len(m.BuildMaster)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.BuildHead)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.BuildAuthor)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *ControllerBackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.IncludeSecrets {
+		n += 2
+	}
+	return n
+}
+
+func (m *ControllerBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovController(uint64(m.Revision))
+	}
+	l = len(m.DataModelVersionHash)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.DataModelVersionId != 0 {
+		n += 1 + sovController(uint64(m.DataModelVersionId))
+	}
+	if m.NumObjects != 0 {
+		n += 1 + sovController(uint64(m.NumObjects))
+	}
+	if m.IncludeSecrets {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *ControllerRestoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func sovController(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozController(x uint64) (n int) {
+	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ControllerKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1720,6 +2300,445 @@ func (m *Controller) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ControllerBackupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerBackupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSecrets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSecrets = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerBackup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerBackup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataModelVersionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataModelVersionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataModelVersionId", wireType)
+			}
+			m.DataModelVersionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataModelVersionId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumObjects", wireType)
+			}
+			m.NumObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumObjects |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSecrets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSecrets = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerRestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerRestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerRestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipController(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -158,6 +158,12 @@ type ShowLog struct {
 	Timestamps bool `protobuf:"varint,3,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
 	// Stream data
 	Follow bool `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Show logs from all containers of the AppInst, merged in timestamp order and prefixed by container
+	AllContainers bool `protobuf:"varint,5,opt,name=all_containers,json=allContainers,proto3" json:"all_containers,omitempty"`
+	// Only show log lines containing the filter string
+	Filter string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Treat the filter as a regular expression
+	FilterRegex bool `protobuf:"varint,7,opt,name=filter_regex,json=filterRegex,proto3" json:"filter_regex,omitempty"`
 }
 
 func (m *ShowLog) Reset()         { *m = ShowLog{} }
@@ -193,6 +199,91 @@ func (m *ShowLog) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShowLog proto.InternalMessageInfo
 
+type CopyFile struct {
+	// Absolute path of the file in the container
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Upload the file to the container, otherwise download the file from the container
+	Upload bool `protobuf:"varint,2,opt,name=upload,proto3" json:"upload,omitempty"`
+	// Size in bytes of the file to upload
+	FileSize int64 `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+}
+
+func (m *CopyFile) Reset()         { *m = CopyFile{} }
+func (m *CopyFile) String() string { return proto.CompactTextString(m) }
+func (*CopyFile) ProtoMessage()    {}
+func (*CopyFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{4}
+}
+func (m *CopyFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CopyFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CopyFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CopyFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyFile.Merge(m, src)
+}
+func (m *CopyFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *CopyFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyFile proto.InternalMessageInfo
+
+type PortForward struct {
+	// AppInst TCP port to forward to
+	Port int32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// Session time to live, limited by the EdgeTurn server maximum
+	Ttl Duration `protobuf:"varint,2,opt,name=ttl,proto3,casttype=Duration" json:"ttl,omitempty"`
+	// User requesting the session, for session limits and auditing.
+	// Set by the Controller from the authenticated caller.
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *PortForward) Reset()         { *m = PortForward{} }
+func (m *PortForward) String() string { return proto.CompactTextString(m) }
+func (*PortForward) ProtoMessage()    {}
+func (*PortForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{5}
+}
+func (m *PortForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PortForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortForward.Merge(m, src)
+}
+func (m *PortForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortForward proto.InternalMessageInfo
+
 // ExecRequest is a common struct for enabling a connection to execute some work on a container
 type ExecRequest struct {
 	// Target AppInst
@@ -211,6 +302,10 @@ type ExecRequest struct {
 	Log *ShowLog `protobuf:"bytes,10,opt,name=log,proto3" json:"log,omitempty"`
 	// Console (one of)
 	Console *RunVMConsole `protobuf:"bytes,11,opt,name=console,proto3" json:"console,omitempty"`
+	// Copy file (one of)
+	CopyFile *CopyFile `protobuf:"bytes,18,opt,name=copy_file,json=copyFile,proto3" json:"copy_file,omitempty"`
+	// Port forward (one of)
+	PortForward *PortForward `protobuf:"bytes,19,opt,name=port_forward,json=portForward,proto3" json:"port_forward,omitempty"`
 	// Timeout
 	Timeout Duration `protobuf:"varint,12,opt,name=timeout,proto3,casttype=Duration" json:"timeout,omitempty"`
 	// Access URL
@@ -227,7 +322,7 @@ func (m *ExecRequest) Reset()         { *m = ExecRequest{} }
 func (m *ExecRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecRequest) ProtoMessage()    {}
 func (*ExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{4}
+	return fileDescriptor_4d737c7315c25422, []int{6}
 }
 func (m *ExecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,78 +356,100 @@ func init() {
 	proto.RegisterType((*RunCmd)(nil), "edgeproto.RunCmd")
 	proto.RegisterType((*RunVMConsole)(nil), "edgeproto.RunVMConsole")
 	proto.RegisterType((*ShowLog)(nil), "edgeproto.ShowLog")
+	proto.RegisterType((*CopyFile)(nil), "edgeproto.CopyFile")
+	proto.RegisterType((*PortForward)(nil), "edgeproto.PortForward")
 	proto.RegisterType((*ExecRequest)(nil), "edgeproto.ExecRequest")
 }
 
 func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
 
 var fileDescriptor_4d737c7315c25422 = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xc0, 0xe3, 0x4d, 0x93, 0x34, 0x2f, 0xd9, 0x92, 0x8e, 0x4a, 0x19, 0x0a, 0xa4, 0x25, 0x05,
-	0x54, 0x90, 0x95, 0x8a, 0xae, 0xf6, 0xb2, 0x52, 0x0e, 0x69, 0xb6, 0x8b, 0x0a, 0xed, 0x2e, 0x72,
-	0xb3, 0xcb, 0x05, 0x29, 0x1a, 0xec, 0xa9, 0xb1, 0x6a, 0xcf, 0x98, 0xb1, 0xad, 0x36, 0x9c, 0x38,
-	0x71, 0x46, 0x88, 0x13, 0x1f, 0x00, 0xed, 0x99, 0x0b, 0xe2, 0x86, 0xc4, 0xa5, 0xe2, 0x54, 0x89,
-	0x0b, 0xda, 0xc3, 0x0a, 0xd2, 0x13, 0x9c, 0x37, 0x8b, 0x84, 0x38, 0xa0, 0x19, 0xdb, 0x89, 0x9b,
-	0x36, 0x4b, 0xab, 0xbd, 0xbd, 0x79, 0x7f, 0xfc, 0xe6, 0xf7, 0xde, 0x9b, 0x67, 0x00, 0x7a, 0x44,
-	0xcd, 0xa6, 0x2f, 0x78, 0xc8, 0x51, 0x99, 0x5a, 0x36, 0x55, 0xe2, 0xd2, 0x6b, 0x21, 0xe7, 0x6e,
-	0xb0, 0xae, 0x0e, 0x36, 0x65, 0x23, 0x21, 0xf6, 0x5c, 0x5a, 0xb0, 0xb9, 0xcd, 0x95, 0xb8, 0x2e,
-	0xa5, 0x44, 0x7b, 0x9d, 0xf8, 0xbe, 0xc3, 0x82, 0x30, 0x39, 0xce, 0x9b, 0x2e, 0x8f, 0x2c, 0x97,
-	0x86, 0x07, 0xb4, 0x1f, 0xab, 0x1a, 0xb7, 0xa0, 0xd6, 0x49, 0x94, 0xbb, 0xb6, 0x17, 0xde, 0xe5,
-	0x16, 0x45, 0x08, 0x66, 0xc2, 0xbe, 0x4f, 0xb1, 0xb6, 0xa2, 0xad, 0x95, 0x0d, 0x25, 0x4b, 0x1d,
-	0x23, 0x1e, 0xc5, 0xd7, 0x62, 0x9d, 0x94, 0x1b, 0x1e, 0x14, 0x8d, 0x88, 0x75, 0x3c, 0x0b, 0x61,
-	0x28, 0x99, 0xdc, 0xf3, 0x08, 0xb3, 0x92, 0xa0, 0xf4, 0x88, 0xb6, 0x01, 0xa5, 0x49, 0x7b, 0x9e,
-	0xed, 0x85, 0x3d, 0xc6, 0xad, 0xf8, 0x2b, 0x95, 0x8d, 0x57, 0x9a, 0x23, 0xbc, 0xe6, 0xe4, 0x25,
-	0x8c, 0x9a, 0x39, 0xa1, 0x69, 0xac, 0x43, 0xd5, 0x88, 0xd8, 0x83, 0xdd, 0x0e, 0x67, 0x01, 0x77,
-	0x29, 0x5a, 0x86, 0x7c, 0x24, 0xdc, 0x38, 0xe1, 0xe6, 0xf5, 0x87, 0x4f, 0xb0, 0xf6, 0xf5, 0xf7,
-	0x2f, 0x17, 0x18, 0x37, 0x3d, 0xdf, 0x90, 0x96, 0xc6, 0x01, 0x94, 0xf6, 0x3e, 0xe5, 0x87, 0x3b,
-	0xdc, 0x46, 0x0b, 0x50, 0x08, 0x1c, 0x66, 0xa6, 0x4c, 0xf1, 0x41, 0x81, 0x12, 0xc7, 0x55, 0xd7,
-	0x29, 0x18, 0x4a, 0x46, 0x75, 0x80, 0xd0, 0xf1, 0x68, 0x10, 0x12, 0xcf, 0x0f, 0x70, 0x7e, 0x45,
-	0x5b, 0x9b, 0x35, 0x32, 0x1a, 0xb4, 0x08, 0xc5, 0x7d, 0xee, 0xba, 0xfc, 0x10, 0xcf, 0x28, 0x5b,
-	0x72, 0x6a, 0xfc, 0x5b, 0x82, 0xca, 0xd6, 0x11, 0x35, 0x0d, 0xfa, 0x59, 0x44, 0x83, 0x10, 0xb5,
-	0xa0, 0x4a, 0x7c, 0xbf, 0x27, 0xab, 0xdf, 0x3b, 0xa0, 0x7d, 0x95, 0xb8, 0xb2, 0xf1, 0x62, 0x06,
-	0xb9, 0xed, 0xfb, 0xdb, 0x2c, 0x08, 0x3f, 0xa0, 0xfd, 0xcd, 0x99, 0xe3, 0xc7, 0xcb, 0x39, 0x03,
-	0xc8, 0x48, 0x83, 0x5e, 0x87, 0xaa, 0xc9, 0x59, 0x48, 0x1c, 0x46, 0x45, 0xcf, 0xb1, 0xd4, 0x45,
-	0xca, 0x46, 0x65, 0xa4, 0xdb, 0xb6, 0xd0, 0x2a, 0x14, 0xf8, 0xfe, 0x3e, 0x15, 0xea, 0x22, 0xe7,
-	0x2a, 0x10, 0xdb, 0xd0, 0x9b, 0x50, 0x24, 0x2c, 0x38, 0xa4, 0x02, 0x17, 0x2e, 0xf2, 0x4a, 0x8c,
-	0xa8, 0x06, 0x79, 0x2a, 0x04, 0x2e, 0xaa, 0x2c, 0x52, 0x44, 0xab, 0x90, 0x37, 0x3d, 0x0b, 0x97,
-	0xd5, 0xb5, 0xe7, 0x33, 0xd7, 0x8e, 0x5b, 0x6e, 0x48, 0x2b, 0x7a, 0x03, 0xf2, 0x2e, 0xb7, 0x31,
-	0x28, 0x27, 0x94, 0x71, 0x4a, 0xea, 0x6e, 0x48, 0x33, 0x7a, 0x57, 0x4e, 0x87, 0xea, 0x19, 0xae,
-	0x28, 0xcf, 0x97, 0xce, 0x7e, 0x6e, 0xd4, 0x52, 0x23, 0xf5, 0x43, 0x6f, 0x41, 0x49, 0xd6, 0x9c,
-	0x47, 0x21, 0xae, 0xae, 0x68, 0x6b, 0xf9, 0xcd, 0xea, 0x3f, 0x8f, 0x97, 0x67, 0x6f, 0x47, 0x82,
-	0x84, 0x0e, 0x67, 0x46, 0x6a, 0x44, 0xab, 0x00, 0xc4, 0x34, 0x69, 0x10, 0xf4, 0xe4, 0x28, 0xcc,
-	0x29, 0xc4, 0x19, 0x89, 0x68, 0x94, 0x63, 0xfd, 0x7d, 0xe1, 0xa2, 0x77, 0x60, 0x4e, 0xe6, 0xeb,
-	0x85, 0x91, 0x60, 0x3d, 0x62, 0x59, 0x02, 0xbf, 0x90, 0x71, 0xac, 0x4a, 0x5b, 0x37, 0x12, 0xac,
-	0x6d, 0x59, 0x02, 0xdd, 0x84, 0x85, 0xb1, 0xaf, 0x2f, 0xf8, 0x51, 0x3f, 0x8e, 0xa8, 0x65, 0x22,
-	0xe6, 0xd3, 0x88, 0x0f, 0xa5, 0x5d, 0x85, 0x75, 0xa0, 0x3a, 0x1a, 0x73, 0xd9, 0xed, 0x79, 0xc5,
-	0xb9, 0x78, 0xc1, 0x80, 0xcb, 0x76, 0xcf, 0xca, 0xcf, 0xa8, 0x96, 0x57, 0xcc, 0xb1, 0xfa, 0xd6,
-	0x8f, 0xf9, 0x9f, 0xa4, 0x5e, 0x36, 0x68, 0x88, 0xdf, 0xbf, 0x27, 0xfb, 0xa7, 0xb7, 0x55, 0x7f,
-	0xf4, 0x2d, 0x21, 0xf4, 0xa4, 0x48, 0xcd, 0xfb, 0xc2, 0xd5, 0xbb, 0x31, 0xbf, 0xde, 0x4e, 0x21,
-	0xf5, 0xad, 0x0c, 0x85, 0xde, 0x25, 0xc2, 0xa6, 0x61, 0x9a, 0xf2, 0xdb, 0x21, 0x3e, 0xb9, 0x96,
-	0x6c, 0x02, 0xf9, 0x70, 0x5b, 0xe3, 0xc9, 0x6b, 0xde, 0x25, 0x1e, 0xd5, 0x13, 0x1b, 0x17, 0x76,
-	0xd6, 0x74, 0x4f, 0xd8, 0x84, 0x39, 0x9f, 0xab, 0x8a, 0xeb, 0xe9, 0x3d, 0x5b, 0x19, 0x8e, 0x38,
-	0x38, 0xb5, 0xc8, 0xe8, 0xac, 0xf1, 0x4c, 0xf8, 0x3e, 0xb5, 0xa8, 0x20, 0x21, 0xb5, 0x26, 0xbd,
-	0xee, 0xa4, 0x86, 0xb3, 0xd9, 0xe2, 0xed, 0xd1, 0xea, 0x78, 0x56, 0xb3, 0x13, 0xcb, 0xba, 0x7a,
-	0xb2, 0xad, 0x1d, 0x6e, 0x37, 0xf7, 0xa4, 0xa4, 0xcb, 0xd7, 0xaa, 0x8e, 0x5d, 0xe2, 0xb8, 0xfa,
-	0xf8, 0x85, 0xc6, 0xba, 0xd1, 0x51, 0x8f, 0xdf, 0xa7, 0xd2, 0xde, 0x51, 0xa2, 0x2e, 0x57, 0x90,
-	0x5c, 0x6a, 0xf1, 0xe7, 0x27, 0xd6, 0x4c, 0xb3, 0xdb, 0xf7, 0xa9, 0x72, 0x51, 0xa5, 0xba, 0xd0,
-	0x45, 0x72, 0xff, 0x32, 0xc4, 0x30, 0x2e, 0xd6, 0xc6, 0x49, 0x09, 0x4a, 0xf2, 0xf9, 0xb7, 0x7d,
-	0x07, 0x3d, 0xd5, 0x00, 0xe4, 0x2b, 0x49, 0x56, 0x60, 0x76, 0x0a, 0x32, 0x1b, 0x62, 0x69, 0x8a,
-	0xbe, 0xf1, 0x83, 0xf6, 0xd7, 0x13, 0x7c, 0xd3, 0xa0, 0x01, 0x8f, 0x84, 0x49, 0x93, 0x1c, 0x81,
-	0xde, 0x36, 0x65, 0x69, 0x76, 0x09, 0x23, 0x36, 0xd5, 0xa7, 0xb4, 0xe9, 0xd1, 0x10, 0x7f, 0x7c,
-	0x6e, 0x6e, 0xd2, 0x59, 0xd9, 0xe1, 0x76, 0x3a, 0x43, 0xd3, 0xe6, 0xe6, 0x22, 0x5a, 0x3d, 0xd3,
-	0xae, 0xc1, 0x10, 0x2f, 0x8e, 0x53, 0xeb, 0x99, 0xf6, 0x3c, 0xfc, 0x1b, 0x6b, 0xe8, 0xd7, 0x14,
-	0x3c, 0x7e, 0xc4, 0x57, 0x05, 0xff, 0xe6, 0xb9, 0xc0, 0x3f, 0x7a, 0x36, 0xb8, 0x67, 0x8d, 0xe0,
-	0x3b, 0xe3, 0x25, 0x3a, 0xb5, 0x10, 0x63, 0x66, 0xf4, 0xb3, 0x06, 0xb3, 0xc9, 0x3e, 0x0b, 0xae,
-	0xcc, 0xf4, 0xa5, 0x64, 0xba, 0x31, 0x85, 0xe9, 0x81, 0x43, 0x0f, 0x9f, 0x41, 0xf4, 0xde, 0x54,
-	0xa2, 0x2c, 0xcd, 0xff, 0x13, 0x7c, 0xf1, 0x14, 0x6b, 0xe8, 0x4f, 0x0d, 0xe6, 0x62, 0xd7, 0xd4,
-	0x72, 0x65, 0x96, 0xef, 0x24, 0xcb, 0x72, 0xca, 0x92, 0x7e, 0x66, 0xa2, 0x41, 0x8f, 0x86, 0xd8,
-	0xb8, 0xd4, 0x08, 0x5e, 0xa2, 0x0b, 0xe3, 0xd2, 0x0c, 0x86, 0xf8, 0xed, 0x73, 0x9b, 0x67, 0xda,
-	0xb6, 0x41, 0xb7, 0xa1, 0xb6, 0x47, 0x99, 0xb5, 0xc3, 0x4d, 0xe2, 0xa6, 0xff, 0xe3, 0xab, 0xc2,
-	0xe6, 0x36, 0x5f, 0x3d, 0xfe, 0xa3, 0x9e, 0x3b, 0x1e, 0xd4, 0xb5, 0x93, 0x41, 0x5d, 0xfb, 0x7d,
-	0x50, 0xd7, 0xbe, 0x3a, 0xad, 0xe7, 0x4e, 0x4e, 0xeb, 0xb9, 0xdf, 0x4e, 0xeb, 0xb9, 0x4f, 0x8a,
-	0x2a, 0xe4, 0xc6, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x37, 0x41, 0xd6, 0xd7, 0xaf, 0x09, 0x00,
-	0x00,
+	// 1349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x3d, 0x6c, 0x1c, 0x45,
+	0x14, 0xf6, 0xe6, 0xfc, 0x73, 0x9e, 0xbb, 0x18, 0x7b, 0x12, 0xc2, 0x90, 0xc0, 0x39, 0x5c, 0x08,
+	0x0a, 0x68, 0x74, 0x81, 0x44, 0x29, 0x88, 0x74, 0xc5, 0xf9, 0x12, 0x4b, 0x01, 0xe7, 0x47, 0x6b,
+	0x3b, 0x34, 0x48, 0xab, 0x61, 0x77, 0xbc, 0x59, 0x65, 0x76, 0x67, 0x33, 0x3b, 0x2b, 0xfb, 0x52,
+	0x51, 0xd0, 0x20, 0x1a, 0x44, 0x09, 0x12, 0x05, 0x55, 0xe8, 0x10, 0x2d, 0x0d, 0x74, 0x11, 0x02,
+	0x29, 0x65, 0x94, 0x22, 0x02, 0xa7, 0x41, 0x54, 0x08, 0xe5, 0x40, 0xa2, 0x40, 0x68, 0x66, 0x76,
+	0xef, 0xc6, 0x76, 0x2e, 0xe4, 0x92, 0xee, 0xcd, 0x7b, 0xf3, 0xde, 0xce, 0xfb, 0xde, 0x37, 0xdf,
+	0x2c, 0x00, 0x74, 0x8b, 0xfa, 0xad, 0x54, 0x70, 0xc9, 0xe1, 0x2c, 0x0d, 0x42, 0xaa, 0xcd, 0xc3,
+	0x2f, 0x4b, 0xce, 0x59, 0x76, 0x52, 0x2f, 0x42, 0x9a, 0x0c, 0x0c, 0xb3, 0xf3, 0xf0, 0xc1, 0x90,
+	0x87, 0x5c, 0x9b, 0x27, 0x95, 0x55, 0x78, 0xf7, 0x93, 0x34, 0x8d, 0x92, 0x4c, 0x16, 0xcb, 0x05,
+	0x9f, 0xf1, 0x3c, 0x60, 0x54, 0x5e, 0xa7, 0x3d, 0xe3, 0x6a, 0x9e, 0x05, 0xf3, 0xdd, 0xc2, 0x79,
+	0x31, 0x8c, 0xe5, 0x25, 0x1e, 0x50, 0x08, 0xc1, 0xa4, 0xec, 0xa5, 0x14, 0x39, 0x47, 0x9d, 0x13,
+	0xb3, 0xae, 0xb6, 0x95, 0x2f, 0x21, 0x31, 0x45, 0xfb, 0x8c, 0x4f, 0xd9, 0xcd, 0x18, 0x4c, 0xbb,
+	0x79, 0xd2, 0x8d, 0x03, 0x88, 0xc0, 0x8c, 0xcf, 0xe3, 0x98, 0x24, 0x41, 0x91, 0x54, 0x2e, 0xe1,
+	0x05, 0x00, 0xcb, 0x8f, 0x7a, 0x71, 0x18, 0x4b, 0x2f, 0xe1, 0x81, 0xa9, 0x52, 0x3b, 0x75, 0xa4,
+	0x35, 0x68, 0xaf, 0xb5, 0xfb, 0x10, 0xee, 0xbc, 0xbf, 0xcb, 0xd3, 0x3c, 0x09, 0xea, 0x6e, 0x9e,
+	0x5c, 0xbd, 0xd8, 0xe5, 0x49, 0xc6, 0x19, 0x85, 0x8b, 0xa0, 0x92, 0x0b, 0x66, 0x3e, 0xb8, 0xb4,
+	0xff, 0xd6, 0x43, 0xe4, 0x7c, 0xf6, 0xed, 0x8b, 0x53, 0x09, 0xf7, 0xe3, 0xd4, 0x55, 0x91, 0xe6,
+	0xcf, 0x0e, 0x98, 0x59, 0xbd, 0xc6, 0x37, 0x57, 0x78, 0x08, 0x0f, 0x82, 0xa9, 0x2c, 0x4a, 0xfc,
+	0xb2, 0x29, 0xb3, 0xd0, 0x9d, 0x92, 0x88, 0xe9, 0xf3, 0x4c, 0xb9, 0xda, 0x86, 0x0d, 0x00, 0x64,
+	0x14, 0xd3, 0x4c, 0x92, 0x38, 0xcd, 0x50, 0xe5, 0xa8, 0x73, 0xa2, 0xea, 0x5a, 0x1e, 0x78, 0x08,
+	0x4c, 0x6f, 0x70, 0xc6, 0xf8, 0x26, 0x9a, 0xd4, 0xb1, 0x62, 0x05, 0x8f, 0x83, 0x39, 0xc2, 0x98,
+	0xe7, 0xf3, 0x44, 0x92, 0x28, 0xa1, 0x22, 0x43, 0x53, 0x3a, 0xbe, 0x9f, 0x30, 0xd6, 0x1d, 0x38,
+	0x75, 0x7a, 0xc4, 0x24, 0x15, 0x68, 0x5a, 0x9f, 0xa4, 0x58, 0xc1, 0x57, 0x40, 0xdd, 0x58, 0x9e,
+	0xa0, 0x21, 0xdd, 0x42, 0x33, 0x3a, 0xb9, 0x66, 0x7c, 0xae, 0x72, 0x35, 0x57, 0x41, 0xb5, 0xcb,
+	0xd3, 0xde, 0x72, 0xc4, 0xf4, 0xc9, 0x53, 0x22, 0xaf, 0x95, 0x33, 0x52, 0xb6, 0x2a, 0x9d, 0xa7,
+	0x8c, 0x93, 0x40, 0xf7, 0x53, 0x75, 0x8b, 0x15, 0x3c, 0x02, 0x66, 0x37, 0x22, 0x46, 0xbd, 0x2c,
+	0xba, 0x49, 0x75, 0x43, 0x15, 0xb7, 0xaa, 0x1c, 0xab, 0xd1, 0x4d, 0xda, 0x5c, 0x07, 0xb5, 0x2b,
+	0x5c, 0xc8, 0x65, 0x2e, 0x36, 0x89, 0x08, 0x74, 0x5d, 0x2e, 0xa4, 0xae, 0x3b, 0xe5, 0x6a, 0x1b,
+	0x36, 0x40, 0x45, 0x4a, 0x03, 0x52, 0x65, 0xa9, 0xfe, 0xcf, 0xfd, 0xc5, 0xea, 0xb9, 0x5c, 0x10,
+	0x19, 0xf1, 0xc4, 0x55, 0x01, 0x95, 0x93, 0x67, 0x54, 0xe8, 0xd2, 0xb3, 0xae, 0xb6, 0x9b, 0x5f,
+	0x01, 0x50, 0x3b, 0xbf, 0x45, 0x7d, 0x97, 0xde, 0xc8, 0x69, 0x26, 0x61, 0x1b, 0xd4, 0x49, 0x9a,
+	0x7a, 0x8a, 0x8c, 0xde, 0x75, 0xda, 0xd3, 0xf5, 0x6b, 0xa7, 0x9e, 0xb7, 0x18, 0xd0, 0x49, 0xd3,
+	0x0b, 0x49, 0x26, 0xdf, 0xa5, 0xbd, 0xa5, 0xc9, 0xdb, 0xf7, 0x17, 0x27, 0x5c, 0x40, 0x06, 0x1e,
+	0x85, 0xce, 0x00, 0x58, 0x2f, 0x0a, 0x8a, 0x4f, 0xd5, 0x06, 0xbe, 0x0b, 0x01, 0x3c, 0x06, 0xa6,
+	0xf8, 0xc6, 0x06, 0x15, 0x7a, 0x2c, 0x7b, 0x08, 0x61, 0x62, 0xf0, 0x38, 0x98, 0x26, 0x49, 0xb6,
+	0x49, 0x85, 0x1e, 0xce, 0x9e, 0x5d, 0x45, 0x10, 0xce, 0x83, 0x0a, 0x15, 0xe5, 0x84, 0x94, 0x09,
+	0x8f, 0x81, 0x8a, 0x1f, 0x07, 0x68, 0x56, 0x1f, 0x7b, 0xc1, 0x3a, 0xb6, 0xb9, 0x01, 0xae, 0x8a,
+	0xc2, 0x57, 0x41, 0x85, 0xf1, 0x10, 0x01, 0xbd, 0x09, 0x5a, 0x9b, 0x0a, 0x16, 0xba, 0x2a, 0x0c,
+	0xdf, 0x52, 0x97, 0x45, 0x53, 0x18, 0xd5, 0xf4, 0xce, 0x17, 0x76, 0x96, 0x1b, 0x30, 0xdc, 0x2d,
+	0xf7, 0xc1, 0x37, 0xc1, 0xac, 0xcf, 0xd3, 0x9e, 0xa7, 0xa6, 0x86, 0xa0, 0x4e, 0x3a, 0x60, 0x5f,
+	0x9e, 0x82, 0x15, 0x6e, 0xd5, 0x2f, 0xf9, 0xf1, 0x36, 0xa8, 0xab, 0xd9, 0x79, 0x1b, 0x66, 0xae,
+	0xe8, 0x80, 0x4e, 0x3a, 0x64, 0x25, 0x59, 0x53, 0x77, 0x6b, 0xa9, 0x45, 0x81, 0xd7, 0xc0, 0x8c,
+	0xa2, 0x3b, 0xcf, 0x25, 0xaa, 0x3f, 0x62, 0xe4, 0x65, 0x10, 0x1e, 0x03, 0x80, 0xf8, 0x3e, 0xcd,
+	0x32, 0x4f, 0x5d, 0xc3, 0x39, 0x8d, 0xe7, 0xa4, 0xc2, 0xd3, 0x9d, 0x35, 0xfe, 0x75, 0xc1, 0xe0,
+	0x1b, 0x60, 0x4e, 0x7d, 0xd2, 0x93, 0xb9, 0x48, 0x3c, 0x12, 0x04, 0x02, 0x3d, 0x67, 0x6d, 0xac,
+	0xab, 0xd8, 0x5a, 0x2e, 0x92, 0x4e, 0x10, 0x08, 0x78, 0x06, 0x1c, 0x1c, 0xee, 0x4d, 0x05, 0xdf,
+	0xea, 0x99, 0x8c, 0x79, 0x2b, 0x63, 0xa1, 0xcc, 0xb8, 0xa2, 0xe2, 0x3a, 0xad, 0x0b, 0xea, 0x03,
+	0x89, 0x51, 0xd4, 0x5a, 0xd8, 0xd3, 0x6a, 0x29, 0x2e, 0x8a, 0x5b, 0x55, 0x55, 0x46, 0xf3, 0xab,
+	0xe6, 0x0f, 0xdd, 0x67, 0xff, 0x9c, 0xfc, 0x5e, 0xf9, 0x15, 0x1b, 0xfa, 0xe8, 0x9d, 0xcb, 0x8a,
+	0x2c, 0xb8, 0xa3, 0xc9, 0x80, 0xcf, 0x0b, 0x81, 0x8b, 0x89, 0xb4, 0xd6, 0x05, 0xc3, 0x6b, 0xa6,
+	0x7f, 0xdc, 0x29, 0x9b, 0xc4, 0xe7, 0xad, 0x2e, 0xf0, 0x1a, 0x11, 0x21, 0x95, 0xe5, 0x27, 0x3f,
+	0xef, 0xa3, 0x8f, 0x27, 0x0b, 0x15, 0x56, 0xa2, 0xd9, 0x1e, 0xd2, 0xbc, 0x75, 0x89, 0xc4, 0x14,
+	0x17, 0x31, 0x2e, 0x42, 0x3b, 0x74, 0x59, 0x84, 0x24, 0x89, 0x6e, 0x6a, 0xc4, 0x71, 0x79, 0xce,
+	0xb6, 0xd5, 0x87, 0x49, 0x2e, 0x23, 0x2a, 0xdb, 0x0e, 0xee, 0x48, 0xdf, 0xa0, 0x01, 0x15, 0x44,
+	0xd2, 0x60, 0xf7, 0xae, 0xe5, 0x32, 0xb0, 0xf3, 0x6b, 0x46, 0xb9, 0xdb, 0xdd, 0x38, 0x68, 0x75,
+	0x8d, 0x8d, 0xb5, 0x5a, 0xb6, 0x57, 0x78, 0xd8, 0x5a, 0x55, 0x16, 0x56, 0x42, 0xa9, 0x97, 0x6b,
+	0x24, 0x62, 0x78, 0x28, 0x8e, 0xc6, 0x37, 0x58, 0x62, 0x23, 0x8d, 0xda, 0xbb, 0xac, 0x4d, 0x4c,
+	0x18, 0x1b, 0x2a, 0xa4, 0x0e, 0x74, 0x6c, 0x79, 0xc4, 0x46, 0xef, 0x4c, 0x86, 0x36, 0x0b, 0x8f,
+	0x16, 0x45, 0xcb, 0xad, 0x15, 0x11, 0xab, 0xc7, 0x44, 0x3d, 0x4f, 0xe6, 0xb0, 0xbb, 0x1e, 0x8c,
+	0xd6, 0x5a, 0x2f, 0xa5, 0x7a, 0x8b, 0x06, 0xfe, 0x91, 0x5b, 0x34, 0x8a, 0x4a, 0x3c, 0xdb, 0xe5,
+	0x1d, 0x6a, 0x5d, 0x21, 0xf2, 0x1a, 0x36, 0xca, 0x39, 0x74, 0xae, 0xeb, 0x35, 0x56, 0x0a, 0x3a,
+	0x74, 0x2e, 0x17, 0x1a, 0x8a, 0xd5, 0xed, 0x69, 0x5b, 0xf7, 0x49, 0xdf, 0x2d, 0x2c, 0x25, 0xdb,
+	0xe1, 0x5c, 0x93, 0xec, 0xc7, 0x3e, 0x02, 0xc3, 0x29, 0x9f, 0xfa, 0xb2, 0x0e, 0x66, 0x94, 0x48,
+	0x76, 0xd2, 0x08, 0x7e, 0xb2, 0x0f, 0x00, 0xa5, 0x25, 0xc5, 0xbb, 0x69, 0xd3, 0xd7, 0xd2, 0xd1,
+	0xc3, 0x23, 0xfc, 0xcd, 0x9f, 0x9c, 0xdf, 0x1f, 0xa2, 0x33, 0x2e, 0xcd, 0x78, 0x2e, 0x7c, 0x5a,
+	0x7c, 0x23, 0xc3, 0x1d, 0x5f, 0xcd, 0xf4, 0x22, 0x49, 0x48, 0x48, 0xf1, 0x08, 0x7e, 0xdd, 0xeb,
+	0xa3, 0x1b, 0x7b, 0x08, 0x5f, 0x92, 0x7c, 0x85, 0x87, 0x25, 0xf9, 0x47, 0x11, 0xfe, 0x51, 0xc0,
+	0xe2, 0x12, 0x24, 0x6c, 0x21, 0x80, 0x2d, 0xf2, 0x6d, 0xf7, 0xd1, 0xa1, 0xe1, 0x79, 0xb0, 0x45,
+	0xb6, 0x5b, 0x7f, 0x23, 0x07, 0xfe, 0xe6, 0x14, 0x68, 0x18, 0xfd, 0x1b, 0x17, 0x8d, 0xaf, 0x9f,
+	0x09, 0x0d, 0xf6, 0x78, 0x34, 0xe2, 0x60, 0x80, 0x48, 0x77, 0xf8, 0xfe, 0x8c, 0x44, 0xe7, 0x7f,
+	0x80, 0x80, 0x77, 0x1d, 0x50, 0x2d, 0xde, 0x87, 0x6c, 0xec, 0x46, 0xbf, 0x50, 0x8d, 0x9e, 0x1e,
+	0xd1, 0xe8, 0xd5, 0x88, 0x6e, 0x3e, 0xa6, 0xcd, 0xf7, 0x47, 0xb6, 0x69, 0xb7, 0xf8, 0x94, 0x6d,
+	0x7d, 0xf8, 0x17, 0x72, 0xe0, 0x1f, 0x0e, 0xa8, 0xe9, 0x29, 0x16, 0x8f, 0xd2, 0xb8, 0xdd, 0x7d,
+	0xf3, 0x4c, 0x63, 0x7c, 0xef, 0x89, 0xc7, 0x38, 0xa2, 0xc7, 0xd1, 0xd4, 0x45, 0x36, 0x75, 0x6d,
+	0xe1, 0x80, 0x1f, 0xed, 0x03, 0x73, 0x6e, 0x9e, 0xd8, 0xbf, 0x54, 0xe3, 0x76, 0xfd, 0xc3, 0x33,
+	0x75, 0x4d, 0xc7, 0x20, 0x6f, 0x31, 0xc2, 0x51, 0x23, 0x1e, 0xb6, 0x6c, 0x43, 0xd1, 0x5a, 0xcf,
+	0xa8, 0xd8, 0xee, 0xa3, 0x23, 0x16, 0x06, 0xbb, 0xb5, 0x0f, 0xfe, 0xeb, 0x80, 0x39, 0x53, 0xb6,
+	0xac, 0x32, 0x36, 0x0c, 0xdf, 0x29, 0x18, 0x16, 0x4b, 0x18, 0xca, 0x32, 0xbb, 0x70, 0xb8, 0xd7,
+	0x47, 0xe1, 0x13, 0x69, 0xd7, 0xd3, 0xde, 0xd4, 0x61, 0x93, 0xdb, 0x7d, 0xf4, 0xfa, 0x9e, 0x07,
+	0x78, 0xd4, 0xa3, 0x0b, 0xcf, 0x81, 0xf9, 0x55, 0x9a, 0x04, 0x2b, 0xdc, 0x27, 0xac, 0xfc, 0x07,
+	0x1e, 0x17, 0x81, 0x89, 0xa5, 0x97, 0x6e, 0xff, 0xda, 0x98, 0xb8, 0xbd, 0xdd, 0x70, 0xee, 0x6c,
+	0x37, 0x9c, 0x5f, 0xb6, 0x1b, 0xce, 0xa7, 0x0f, 0x1a, 0x13, 0x77, 0x1e, 0x34, 0x26, 0xee, 0x3e,
+	0x68, 0x4c, 0x7c, 0x30, 0xad, 0x53, 0x4e, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xfa, 0x1b,
+	0x34, 0x32, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -353,6 +470,10 @@ type ExecApiClient interface {
 	RunConsole(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error)
 	// View logs for AppInst
 	ShowLogs(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error)
+	// Upload or download a file to or from a container
+	RunCopyFile(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error)
+	// Forward a local port to an AppInst port
+	RunPortForward(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error)
 	// Access Cloudlet VM
 	AccessCloudlet(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error)
 	// This is used internally to forward requests to other Controllers.e
@@ -394,6 +515,24 @@ func (c *execApiClient) ShowLogs(ctx context.Context, in *ExecRequest, opts ...g
 	return out, nil
 }
 
+func (c *execApiClient) RunCopyFile(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error) {
+	out := new(ExecRequest)
+	err := c.cc.Invoke(ctx, "/edgeproto.ExecApi/RunCopyFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execApiClient) RunPortForward(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error) {
+	out := new(ExecRequest)
+	err := c.cc.Invoke(ctx, "/edgeproto.ExecApi/RunPortForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *execApiClient) AccessCloudlet(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecRequest, error) {
 	out := new(ExecRequest)
 	err := c.cc.Invoke(ctx, "/edgeproto.ExecApi/AccessCloudlet", in, out, opts...)
@@ -420,6 +559,10 @@ type ExecApiServer interface {
 	RunConsole(context.Context, *ExecRequest) (*ExecRequest, error)
 	// View logs for AppInst
 	ShowLogs(context.Context, *ExecRequest) (*ExecRequest, error)
+	// Upload or download a file to or from a container
+	RunCopyFile(context.Context, *ExecRequest) (*ExecRequest, error)
+	// Forward a local port to an AppInst port
+	RunPortForward(context.Context, *ExecRequest) (*ExecRequest, error)
 	// Access Cloudlet VM
 	AccessCloudlet(context.Context, *ExecRequest) (*ExecRequest, error)
 	// This is used internally to forward requests to other Controllers.e
@@ -439,6 +582,12 @@ func (*UnimplementedExecApiServer) RunConsole(ctx context.Context, req *ExecRequ
 func (*UnimplementedExecApiServer) ShowLogs(ctx context.Context, req *ExecRequest) (*ExecRequest, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowLogs not implemented")
 }
+func (*UnimplementedExecApiServer) RunCopyFile(ctx context.Context, req *ExecRequest) (*ExecRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCopyFile not implemented")
+}
+func (*UnimplementedExecApiServer) RunPortForward(ctx context.Context, req *ExecRequest) (*ExecRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunPortForward not implemented")
+}
 func (*UnimplementedExecApiServer) AccessCloudlet(ctx context.Context, req *ExecRequest) (*ExecRequest, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccessCloudlet not implemented")
 }
@@ -504,6 +653,42 @@ func _ExecApi_ShowLogs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecApi_RunCopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecApiServer).RunCopyFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.ExecApi/RunCopyFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecApiServer).RunCopyFile(ctx, req.(*ExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecApi_RunPortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecApiServer).RunPortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgeproto.ExecApi/RunPortForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecApiServer).RunPortForward(ctx, req.(*ExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecApi_AccessCloudlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecRequest)
 	if err := dec(in); err != nil {
@@ -556,6 +741,14 @@ var _ExecApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowLogs",
 			Handler:    _ExecApi_ShowLogs_Handler,
 		},
+		{
+			MethodName: "RunCopyFile",
+			Handler:    _ExecApi_RunCopyFile_Handler,
+		},
+		{
+			MethodName: "RunPortForward",
+			Handler:    _ExecApi_RunPortForward_Handler,
+		},
 		{
 			MethodName: "AccessCloudlet",
 			Handler:    _ExecApi_AccessCloudlet_Handler,
@@ -698,6 +891,33 @@ func (m *ShowLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FilterRegex {
+		i--
+		if m.FilterRegex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AllContainers {
+		i--
+		if m.AllContainers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Follow {
 		i--
 		if m.Follow {
@@ -733,6 +953,91 @@ func (m *ShowLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CopyFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FileSize != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Upload {
+		i--
+		if m.Upload {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PortForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Port != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -753,6 +1058,34 @@ func (m *ExecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PortForward != nil {
+		{
+			size, err := m.PortForward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.CopyFile != nil {
+		{
+			size, err := m.CopyFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	{
 		size, err := m.CloudletKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1037,6 +1370,18 @@ func (m *ShowLog) CopyInFields(src *ShowLog) int {
 		m.Follow = src.Follow
 		changed++
 	}
+	if m.AllContainers != src.AllContainers {
+		m.AllContainers = src.AllContainers
+		changed++
+	}
+	if m.Filter != src.Filter {
+		m.Filter = src.Filter
+		changed++
+	}
+	if m.FilterRegex != src.FilterRegex {
+		m.FilterRegex = src.FilterRegex
+		changed++
+	}
 	return changed
 }
 
@@ -1045,6 +1390,9 @@ func (m *ShowLog) DeepCopyIn(src *ShowLog) {
 	m.Tail = src.Tail
 	m.Timestamps = src.Timestamps
 	m.Follow = src.Follow
+	m.AllContainers = src.AllContainers
+	m.Filter = src.Filter
+	m.FilterRegex = src.FilterRegex
 }
 
 // Helper method to check that enums have valid values
@@ -1055,6 +1403,80 @@ func (m *ShowLog) ValidateEnums() error {
 func (s *ShowLog) ClearTagged(tags map[string]struct{}) {
 }
 
+func (m *CopyFile) Clone() *CopyFile {
+	cp := &CopyFile{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *CopyFile) CopyInFields(src *CopyFile) int {
+	changed := 0
+	if m.Path != src.Path {
+		m.Path = src.Path
+		changed++
+	}
+	if m.Upload != src.Upload {
+		m.Upload = src.Upload
+		changed++
+	}
+	if m.FileSize != src.FileSize {
+		m.FileSize = src.FileSize
+		changed++
+	}
+	return changed
+}
+
+func (m *CopyFile) DeepCopyIn(src *CopyFile) {
+	m.Path = src.Path
+	m.Upload = src.Upload
+	m.FileSize = src.FileSize
+}
+
+// Helper method to check that enums have valid values
+func (m *CopyFile) ValidateEnums() error {
+	return nil
+}
+
+func (s *CopyFile) ClearTagged(tags map[string]struct{}) {
+}
+
+func (m *PortForward) Clone() *PortForward {
+	cp := &PortForward{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *PortForward) CopyInFields(src *PortForward) int {
+	changed := 0
+	if m.Port != src.Port {
+		m.Port = src.Port
+		changed++
+	}
+	if m.Ttl != src.Ttl {
+		m.Ttl = src.Ttl
+		changed++
+	}
+	if m.User != src.User {
+		m.User = src.User
+		changed++
+	}
+	return changed
+}
+
+func (m *PortForward) DeepCopyIn(src *PortForward) {
+	m.Port = src.Port
+	m.Ttl = src.Ttl
+	m.User = src.User
+}
+
+// Helper method to check that enums have valid values
+func (m *PortForward) ValidateEnums() error {
+	return nil
+}
+
+func (s *PortForward) ClearTagged(tags map[string]struct{}) {
+}
+
 func (m *ExecRequest) Clone() *ExecRequest {
 	cp := &ExecRequest{}
 	cp.DeepCopyIn(m)
@@ -1135,6 +1557,18 @@ func (m *ExecRequest) CopyInFields(src *ExecRequest) int {
 			m.Log.Follow = src.Log.Follow
 			changed++
 		}
+		if m.Log.AllContainers != src.Log.AllContainers {
+			m.Log.AllContainers = src.Log.AllContainers
+			changed++
+		}
+		if m.Log.Filter != src.Log.Filter {
+			m.Log.Filter = src.Log.Filter
+			changed++
+		}
+		if m.Log.FilterRegex != src.Log.FilterRegex {
+			m.Log.FilterRegex = src.Log.FilterRegex
+			changed++
+		}
 	} else if m.Log != nil {
 		m.Log = nil
 		changed++
@@ -1179,13 +1613,53 @@ func (m *ExecRequest) CopyInFields(src *ExecRequest) int {
 		m.CloudletKey.FederatedOrganization = src.CloudletKey.FederatedOrganization
 		changed++
 	}
-	return changed
-}
-
-func (m *ExecRequest) DeepCopyIn(src *ExecRequest) {
-	m.AppInstKey.DeepCopyIn(&src.AppInstKey)
-	m.ContainerId = src.ContainerId
-	m.Offer = src.Offer
+	if src.CopyFile != nil {
+		if m.CopyFile == nil {
+			m.CopyFile = &CopyFile{}
+		}
+		if m.CopyFile.Path != src.CopyFile.Path {
+			m.CopyFile.Path = src.CopyFile.Path
+			changed++
+		}
+		if m.CopyFile.Upload != src.CopyFile.Upload {
+			m.CopyFile.Upload = src.CopyFile.Upload
+			changed++
+		}
+		if m.CopyFile.FileSize != src.CopyFile.FileSize {
+			m.CopyFile.FileSize = src.CopyFile.FileSize
+			changed++
+		}
+	} else if m.CopyFile != nil {
+		m.CopyFile = nil
+		changed++
+	}
+	if src.PortForward != nil {
+		if m.PortForward == nil {
+			m.PortForward = &PortForward{}
+		}
+		if m.PortForward.Port != src.PortForward.Port {
+			m.PortForward.Port = src.PortForward.Port
+			changed++
+		}
+		if m.PortForward.Ttl != src.PortForward.Ttl {
+			m.PortForward.Ttl = src.PortForward.Ttl
+			changed++
+		}
+		if m.PortForward.User != src.PortForward.User {
+			m.PortForward.User = src.PortForward.User
+			changed++
+		}
+	} else if m.PortForward != nil {
+		m.PortForward = nil
+		changed++
+	}
+	return changed
+}
+
+func (m *ExecRequest) DeepCopyIn(src *ExecRequest) {
+	m.AppInstKey.DeepCopyIn(&src.AppInstKey)
+	m.ContainerId = src.ContainerId
+	m.Offer = src.Offer
 	m.Answer = src.Answer
 	m.Err = src.Err
 	if src.Cmd != nil {
@@ -1214,6 +1688,20 @@ func (m *ExecRequest) DeepCopyIn(src *ExecRequest) {
 	m.EdgeTurnAddr = src.EdgeTurnAddr
 	m.EdgeTurnProxyAddr = src.EdgeTurnProxyAddr
 	m.CloudletKey.DeepCopyIn(&src.CloudletKey)
+	if src.CopyFile != nil {
+		var tmp_CopyFile CopyFile
+		tmp_CopyFile.DeepCopyIn(src.CopyFile)
+		m.CopyFile = &tmp_CopyFile
+	} else {
+		m.CopyFile = nil
+	}
+	if src.PortForward != nil {
+		var tmp_PortForward PortForward
+		tmp_PortForward.DeepCopyIn(src.PortForward)
+		m.PortForward = &tmp_PortForward
+	} else {
+		m.PortForward = nil
+	}
 }
 
 func (m *ExecRequest) MessageTypeKey() string {
@@ -1243,6 +1731,16 @@ func (m *ExecRequest) ValidateEnums() error {
 	if err := m.CloudletKey.ValidateEnums(); err != nil {
 		return err
 	}
+	if m.CopyFile != nil {
+		if err := m.CopyFile.ValidateEnums(); err != nil {
+			return err
+		}
+	}
+	if m.PortForward != nil {
+		if err := m.PortForward.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1264,6 +1762,12 @@ func (s *ExecRequest) ClearTagged(tags map[string]struct{}) {
 		s.Console.ClearTagged(tags)
 	}
 	s.CloudletKey.ClearTagged(tags)
+	if s.CopyFile != nil {
+		s.CopyFile.ClearTagged(tags)
+	}
+	if s.PortForward != nil {
+		s.PortForward.ClearTagged(tags)
+	}
 }
 
 func IgnoreExecRequestFields(taglist string) cmp.Option {
@@ -1323,6 +1827,12 @@ func (m *ExecRequest) IsValidArgsForRunCommand() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.CopyFile != nil {
+		return fmt.Errorf("Invalid field specified: CopyFile, this field is only for internal use")
+	}
+	if m.PortForward != nil {
+		return fmt.Errorf("Invalid field specified: PortForward, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -1366,6 +1876,12 @@ func (m *ExecRequest) IsValidArgsForRunConsole() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.CopyFile != nil {
+		return fmt.Errorf("Invalid field specified: CopyFile, this field is only for internal use")
+	}
+	if m.PortForward != nil {
+		return fmt.Errorf("Invalid field specified: PortForward, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -1403,6 +1919,103 @@ func (m *ExecRequest) IsValidArgsForShowLogs() error {
 	if m.CloudletKey.FederatedOrganization != "" {
 		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
 	}
+	if m.CopyFile != nil {
+		return fmt.Errorf("Invalid field specified: CopyFile, this field is only for internal use")
+	}
+	if m.PortForward != nil {
+		return fmt.Errorf("Invalid field specified: PortForward, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *ExecRequest) IsValidArgsForRunCopyFile() error {
+	if m.Offer != "" {
+		return fmt.Errorf("Invalid field specified: Offer, this field is only for internal use")
+	}
+	if m.Answer != "" {
+		return fmt.Errorf("Invalid field specified: Answer, this field is only for internal use")
+	}
+	if m.Err != "" {
+		return fmt.Errorf("Invalid field specified: Err, this field is only for internal use")
+	}
+	if m.Cmd != nil {
+		return fmt.Errorf("Invalid field specified: Cmd, this field is only for internal use")
+	}
+	if m.Log != nil {
+		return fmt.Errorf("Invalid field specified: Log, this field is only for internal use")
+	}
+	if m.Console != nil {
+		return fmt.Errorf("Invalid field specified: Console, this field is only for internal use")
+	}
+	if m.Timeout != 0 {
+		return fmt.Errorf("Invalid field specified: Timeout, this field is only for internal use")
+	}
+	if m.AccessUrl != "" {
+		return fmt.Errorf("Invalid field specified: AccessUrl, this field is only for internal use")
+	}
+	if m.EdgeTurnAddr != "" {
+		return fmt.Errorf("Invalid field specified: EdgeTurnAddr, this field is only for internal use")
+	}
+	if m.CloudletKey.Organization != "" {
+		return fmt.Errorf("Invalid field specified: CloudletKey.Organization, this field is only for internal use")
+	}
+	if m.CloudletKey.Name != "" {
+		return fmt.Errorf("Invalid field specified: CloudletKey.Name, this field is only for internal use")
+	}
+	if m.CloudletKey.FederatedOrganization != "" {
+		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
+	}
+	if m.PortForward != nil {
+		return fmt.Errorf("Invalid field specified: PortForward, this field is only for internal use")
+	}
+	return nil
+}
+
+func (m *ExecRequest) IsValidArgsForRunPortForward() error {
+	if m.Offer != "" {
+		return fmt.Errorf("Invalid field specified: Offer, this field is only for internal use")
+	}
+	if m.Answer != "" {
+		return fmt.Errorf("Invalid field specified: Answer, this field is only for internal use")
+	}
+	if m.Err != "" {
+		return fmt.Errorf("Invalid field specified: Err, this field is only for internal use")
+	}
+	if m.Cmd != nil {
+		return fmt.Errorf("Invalid field specified: Cmd, this field is only for internal use")
+	}
+	if m.Log != nil {
+		return fmt.Errorf("Invalid field specified: Log, this field is only for internal use")
+	}
+	if m.Console != nil {
+		return fmt.Errorf("Invalid field specified: Console, this field is only for internal use")
+	}
+	if m.Timeout != 0 {
+		return fmt.Errorf("Invalid field specified: Timeout, this field is only for internal use")
+	}
+	if m.AccessUrl != "" {
+		return fmt.Errorf("Invalid field specified: AccessUrl, this field is only for internal use")
+	}
+	if m.EdgeTurnAddr != "" {
+		return fmt.Errorf("Invalid field specified: EdgeTurnAddr, this field is only for internal use")
+	}
+	if m.CloudletKey.Organization != "" {
+		return fmt.Errorf("Invalid field specified: CloudletKey.Organization, this field is only for internal use")
+	}
+	if m.CloudletKey.Name != "" {
+		return fmt.Errorf("Invalid field specified: CloudletKey.Name, this field is only for internal use")
+	}
+	if m.CloudletKey.FederatedOrganization != "" {
+		return fmt.Errorf("Invalid field specified: CloudletKey.FederatedOrganization, this field is only for internal use")
+	}
+	if m.CopyFile != nil {
+		return fmt.Errorf("Invalid field specified: CopyFile, this field is only for internal use")
+	}
+	if m.PortForward != nil {
+		if m.PortForward.User != "" {
+			return fmt.Errorf("Invalid field specified: PortForward.User, this field is only for internal use")
+		}
+	}
 	return nil
 }
 
@@ -1440,6 +2053,12 @@ func (m *ExecRequest) IsValidArgsForAccessCloudlet() error {
 	if m.EdgeTurnAddr != "" {
 		return fmt.Errorf("Invalid field specified: EdgeTurnAddr, this field is only for internal use")
 	}
+	if m.CopyFile != nil {
+		return fmt.Errorf("Invalid field specified: CopyFile, this field is only for internal use")
+	}
+	if m.PortForward != nil {
+		return fmt.Errorf("Invalid field specified: PortForward, this field is only for internal use")
+	}
 	return nil
 }
 
@@ -1533,6 +2152,54 @@ func (m *ShowLog) Size() (n int) {
 	if m.Follow {
 		n += 2
 	}
+	if m.AllContainers {
+		n += 2
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.FilterRegex {
+		n += 2
+	}
+	return n
+}
+
+func (m *CopyFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Upload {
+		n += 2
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovExec(uint64(m.FileSize))
+	}
+	return n
+}
+
+func (m *PortForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovExec(uint64(m.Port))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovExec(uint64(m.Ttl))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
 	return n
 }
 
@@ -1589,6 +2256,14 @@ func (m *ExecRequest) Size() (n int) {
 	}
 	l = m.CloudletKey.Size()
 	n += 2 + l + sovExec(uint64(l))
+	if m.CopyFile != nil {
+		l = m.CopyFile.Size()
+		n += 2 + l + sovExec(uint64(l))
+	}
+	if m.PortForward != nil {
+		l = m.PortForward.Size()
+		n += 2 + l + sovExec(uint64(l))
+	}
 	return n
 }
 
@@ -2032,6 +2707,319 @@ func (m *ShowLog) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Follow = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllContainers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllContainers = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterRegex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FilterRegex = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upload = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -2499,6 +3487,78 @@ func (m *ExecRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CopyFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CopyFile == nil {
+				m.CopyFile = &CopyFile{}
+			}
+			if err := m.CopyFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortForward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PortForward == nil {
+				m.PortForward = &PortForward{}
+			}
+			if err := m.PortForward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
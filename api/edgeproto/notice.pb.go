@@ -92,6 +92,12 @@ type Notice struct {
 	Tags map[string]string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Filter by federated cloudlet
 	FilterFederatedCloudlet bool `protobuf:"varint,9,opt,name=filter_federated_cloudlet,json=filterFederatedCloudlet,proto3" json:"filter_federated_cloudlet,omitempty"`
+	// Last synced database revision by object type, for incremental sync
+	SyncRevs map[string]int64 `protobuf:"bytes,10,rep,name=sync_revs,json=syncRevs,proto3" json:"sync_revs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Object types that will be sent incrementally instead of sending all
+	IncrementalObjs []string `protobuf:"bytes,11,rep,name=incremental_objs,json=incrementalObjs,proto3" json:"incremental_objs,omitempty"`
+	// Database revision up to which all changes to the object type have been sent
+	SyncRev int64 `protobuf:"varint,12,opt,name=sync_rev,json=syncRev,proto3" json:"sync_rev,omitempty"`
 }
 
 func (m *Notice) Reset()         { *m = Notice{} }
@@ -130,43 +136,49 @@ var xxx_messageInfo_Notice proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("edgeproto.NoticeAction", NoticeAction_name, NoticeAction_value)
 	proto.RegisterType((*Notice)(nil), "edgeproto.Notice")
+	proto.RegisterMapType((map[string]int64)(nil), "edgeproto.Notice.SyncRevsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.Notice.TagsEntry")
 }
 
 func init() { proto.RegisterFile("notice.proto", fileDescriptor_642492014393dbdb) }
 
 var fileDescriptor_642492014393dbdb = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x8f, 0xd2, 0x40,
-	0x14, 0xc7, 0x3b, 0xdb, 0x6e, 0xa1, 0x0f, 0xd4, 0xee, 0x48, 0x42, 0x97, 0x35, 0xb5, 0xd9, 0x53,
-	0x63, 0x4c, 0x31, 0x78, 0xd0, 0x70, 0x63, 0xa5, 0x1a, 0x23, 0x29, 0x66, 0x40, 0xaf, 0x64, 0xa0,
-	0x43, 0xc3, 0x5a, 0x3a, 0xa4, 0x1d, 0x30, 0xfd, 0x16, 0x7e, 0x05, 0xbf, 0x0d, 0xc7, 0x3d, 0x7a,
-	0x32, 0x0a, 0x5f, 0xc4, 0x74, 0x0a, 0xc4, 0x84, 0xdb, 0xff, 0xbd, 0xf7, 0x9f, 0x7f, 0x7e, 0xef,
-	0x65, 0xa0, 0x9e, 0x70, 0xb1, 0x98, 0x31, 0x6f, 0x95, 0x72, 0xc1, 0xb1, 0xc1, 0xc2, 0x88, 0x49,
-	0xd9, 0xba, 0x8e, 0x38, 0x8f, 0x62, 0xd6, 0x96, 0xd5, 0x74, 0x3d, 0x6f, 0xd3, 0x24, 0x2f, 0x5d,
-	0xad, 0x46, 0xc4, 0x23, 0x2e, 0x65, 0xbb, 0x50, 0x65, 0xf7, 0xf6, 0xa7, 0x0a, 0x7a, 0x20, 0xc3,
-	0x70, 0x1b, 0x74, 0x3a, 0x13, 0x0b, 0x9e, 0x58, 0xc8, 0x41, 0xee, 0xe3, 0x4e, 0xd3, 0x3b, 0xe5,
-	0x7a, 0xa5, 0xa5, 0x27, 0xc7, 0xe4, 0x60, 0xc3, 0x16, 0x54, 0x36, 0x2c, 0xcd, 0x8a, 0x17, 0x17,
-	0x0e, 0x72, 0x1f, 0x91, 0x63, 0x89, 0x5f, 0x82, 0x4a, 0x93, 0xdc, 0x52, 0x1d, 0xe4, 0xd6, 0x3a,
-	0x0d, 0xaf, 0x84, 0xf2, 0x8e, 0x50, 0x5e, 0x2f, 0xc9, 0xef, 0xb4, 0xed, 0xef, 0xe7, 0x0a, 0x29,
-	0x6c, 0xf8, 0x06, 0x8c, 0xef, 0x34, 0x11, 0x13, 0x3e, 0xbd, 0xcf, 0x2c, 0xcd, 0x51, 0x5d, 0x83,
-	0x54, 0x8b, 0xc6, 0x70, 0x7a, 0x9f, 0x61, 0x0f, 0x9e, 0xce, 0x17, 0xb1, 0x60, 0xe9, 0x64, 0x16,
-	0xf3, 0x75, 0x18, 0x33, 0x31, 0xf9, 0xc6, 0x72, 0xeb, 0xd2, 0x41, 0x6e, 0x95, 0x5c, 0x95, 0xa3,
-	0x77, 0x87, 0xc9, 0x27, 0x96, 0x63, 0x0c, 0x5a, 0xb6, 0xa2, 0x89, 0xa5, 0x3b, 0xc8, 0x35, 0x88,
-	0xd4, 0xb8, 0x09, 0x95, 0x25, 0x0f, 0x27, 0x29, 0xdb, 0x58, 0x15, 0x07, 0xb9, 0x2a, 0xd1, 0x97,
-	0x3c, 0x24, 0x6c, 0x83, 0xdb, 0xa0, 0x09, 0x1a, 0x65, 0x56, 0xd5, 0x51, 0xdd, 0x5a, 0xe7, 0xe6,
-	0x6c, 0x61, 0x6f, 0x4c, 0xa3, 0xcc, 0x4f, 0x44, 0x9a, 0x13, 0x69, 0xc4, 0x5d, 0xb8, 0x3e, 0xd0,
-	0xcc, 0x59, 0xc8, 0x52, 0x2a, 0x58, 0x78, 0xe2, 0xb2, 0x0c, 0xc9, 0xd4, 0x2c, 0x0d, 0xef, 0x8f,
-	0xf3, 0x23, 0x5c, 0xeb, 0x0d, 0x18, 0xa7, 0x38, 0x6c, 0x82, 0x5a, 0xac, 0x81, 0x24, 0x65, 0x21,
-	0x71, 0x03, 0x2e, 0x37, 0x34, 0x5e, 0x33, 0x79, 0x4b, 0x83, 0x94, 0x45, 0xf7, 0xe2, 0x2d, 0x7a,
-	0x11, 0x40, 0xfd, 0xff, 0xfb, 0xe3, 0x2a, 0x68, 0xc1, 0x30, 0xf0, 0x4d, 0x05, 0x03, 0xe8, 0x5f,
-	0x3e, 0xf7, 0x7b, 0x63, 0xdf, 0x44, 0x85, 0xee, 0xfb, 0x03, 0x7f, 0xec, 0x9b, 0x17, 0xb8, 0x06,
-	0x95, 0xaf, 0x3e, 0x19, 0x7d, 0x1c, 0x06, 0xa6, 0x8a, 0x9f, 0x40, 0x6d, 0xe4, 0x07, 0xfd, 0xde,
-	0x60, 0x30, 0xf1, 0x83, 0xbe, 0xa9, 0x75, 0x3e, 0x80, 0x51, 0xe4, 0xcd, 0xf3, 0xde, 0x6a, 0x81,
-	0xbb, 0x50, 0x1f, 0x89, 0x94, 0xd1, 0xe5, 0xe1, 0x17, 0x5c, 0x9d, 0x1d, 0xa1, 0x75, 0xde, 0xba,
-	0x55, 0x5c, 0xf4, 0x0a, 0xdd, 0x3d, 0xdb, 0xfe, 0xb5, 0x95, 0xed, 0xce, 0x46, 0x0f, 0x3b, 0x1b,
-	0xfd, 0xd9, 0xd9, 0xe8, 0xc7, 0xde, 0x56, 0x1e, 0xf6, 0xb6, 0xf2, 0x6b, 0x6f, 0x2b, 0x53, 0x5d,
-	0xbe, 0x78, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x48, 0xfc, 0x58, 0xad, 0x02, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0xd6, 0xae, 0x63, 0x4f, 0x52, 0xea, 0x2e, 0x95, 0xea, 0xa6, 0xc8, 0xb5, 0x7a, 0x32,
+	0x08, 0x39, 0x28, 0x1c, 0x40, 0x85, 0x4b, 0x4a, 0x0c, 0x42, 0x54, 0x2e, 0xda, 0x14, 0xae, 0x91,
+	0x63, 0x6f, 0xac, 0x14, 0x67, 0xb7, 0xb2, 0x9d, 0x20, 0xff, 0x05, 0x9f, 0x95, 0x63, 0x8f, 0x9c,
+	0x10, 0x24, 0x3f, 0x82, 0xbc, 0x76, 0xa2, 0xa0, 0x1c, 0xb8, 0xbd, 0x99, 0x79, 0x33, 0xf3, 0xe6,
+	0xed, 0x42, 0x8b, 0xf1, 0x7c, 0x12, 0x52, 0xf7, 0x3e, 0xe5, 0x39, 0xc7, 0x3a, 0x8d, 0x62, 0x2a,
+	0x60, 0xfb, 0x34, 0xe6, 0x3c, 0x4e, 0x68, 0x47, 0x44, 0xa3, 0xd9, 0xb8, 0x13, 0xb0, 0xa2, 0x62,
+	0xb5, 0x8f, 0x63, 0x1e, 0x73, 0x01, 0x3b, 0x25, 0xaa, 0xb2, 0x17, 0x4b, 0x05, 0x54, 0x5f, 0x0c,
+	0xc3, 0x1d, 0x50, 0x83, 0x30, 0x9f, 0x70, 0x66, 0x22, 0x1b, 0x39, 0x8f, 0xba, 0x27, 0xee, 0x66,
+	0xae, 0x5b, 0x51, 0x7a, 0xa2, 0x4c, 0x6a, 0x1a, 0x36, 0xa1, 0x31, 0xa7, 0x69, 0x56, 0x76, 0xec,
+	0xd9, 0xc8, 0x39, 0x20, 0xeb, 0x10, 0x3f, 0x07, 0x39, 0x60, 0x85, 0x29, 0xdb, 0xc8, 0x69, 0x76,
+	0x8f, 0xdd, 0x4a, 0x94, 0xbb, 0x16, 0xe5, 0xf6, 0x58, 0x71, 0xa5, 0x2c, 0x7e, 0x9d, 0x4b, 0xa4,
+	0xa4, 0xe1, 0x33, 0xd0, 0xbf, 0x07, 0x2c, 0x1f, 0xf2, 0xd1, 0x5d, 0x66, 0x2a, 0xb6, 0xec, 0xe8,
+	0x44, 0x2b, 0x13, 0x37, 0xa3, 0xbb, 0x0c, 0xbb, 0xf0, 0x78, 0x3c, 0x49, 0x72, 0x9a, 0x0e, 0xc3,
+	0x84, 0xcf, 0xa2, 0x84, 0xe6, 0xc3, 0x6f, 0xb4, 0x30, 0xf7, 0x6d, 0xe4, 0x68, 0xe4, 0xa8, 0x2a,
+	0xbd, 0xab, 0x2b, 0x9f, 0x68, 0x81, 0x31, 0x28, 0xd9, 0x7d, 0xc0, 0x4c, 0xd5, 0x46, 0x8e, 0x4e,
+	0x04, 0xc6, 0x27, 0xd0, 0x98, 0xf2, 0x68, 0x98, 0xd2, 0xb9, 0xd9, 0xb0, 0x91, 0x23, 0x13, 0x75,
+	0xca, 0x23, 0x42, 0xe7, 0xb8, 0x03, 0x4a, 0x1e, 0xc4, 0x99, 0xa9, 0xd9, 0xb2, 0xd3, 0xec, 0x9e,
+	0xed, 0x1c, 0xec, 0xde, 0x06, 0x71, 0xe6, 0xb1, 0x3c, 0x2d, 0x88, 0x20, 0xe2, 0x4b, 0x38, 0xad,
+	0xd5, 0x8c, 0x69, 0x44, 0xd3, 0x20, 0xa7, 0xd1, 0x46, 0x97, 0xa9, 0x0b, 0x4d, 0x27, 0x15, 0xe1,
+	0xfd, 0xba, 0xbe, 0x16, 0x87, 0xdf, 0x82, 0x9e, 0x15, 0x2c, 0x2c, 0x65, 0x64, 0x26, 0x88, 0x8d,
+	0xe7, 0xbb, 0x1b, 0x07, 0x05, 0x0b, 0x09, 0x9d, 0xd7, 0x5b, 0xb5, 0xac, 0x0e, 0xf1, 0x53, 0x30,
+	0x26, 0x2c, 0x4c, 0xe9, 0x94, 0xb2, 0x3c, 0x48, 0x2a, 0xaf, 0x9a, 0xc2, 0xab, 0xc3, 0xad, 0xbc,
+	0xb0, 0xec, 0x14, 0xb4, 0xf5, 0x22, 0xb3, 0x25, 0xee, 0x6d, 0xd4, 0x63, 0xda, 0xaf, 0x40, 0xdf,
+	0x9c, 0x84, 0x0d, 0x90, 0x4b, 0x2b, 0x91, 0x70, 0xaa, 0x84, 0xf8, 0x18, 0xf6, 0xe7, 0x41, 0x32,
+	0xa3, 0xe2, 0x3d, 0x75, 0x52, 0x05, 0x97, 0x7b, 0xaf, 0x51, 0xfb, 0x0d, 0x1c, 0xfc, 0xa3, 0xec,
+	0x7f, 0xcd, 0xf2, 0x56, 0xf3, 0x33, 0x1f, 0x5a, 0xdb, 0x1f, 0x08, 0x6b, 0xa0, 0xf8, 0x37, 0xbe,
+	0x67, 0x48, 0x18, 0x40, 0xfd, 0xf2, 0xb9, 0xdf, 0xbb, 0xf5, 0x0c, 0x54, 0xe2, 0xbe, 0x77, 0xed,
+	0xdd, 0x7a, 0xc6, 0x1e, 0x6e, 0x42, 0xe3, 0xab, 0x47, 0x06, 0x1f, 0x6f, 0x7c, 0x43, 0xc6, 0x87,
+	0xd0, 0x1c, 0x78, 0x7e, 0xbf, 0x77, 0x7d, 0x3d, 0xf4, 0xfc, 0xbe, 0xa1, 0x74, 0x3f, 0x80, 0x5e,
+	0xce, 0x1b, 0x17, 0xbd, 0xfb, 0x09, 0xbe, 0x84, 0xd6, 0x20, 0x4f, 0x69, 0x30, 0xad, 0xbf, 0xf1,
+	0xd1, 0x8e, 0xa7, 0xed, 0xdd, 0xd4, 0x85, 0xe4, 0xa0, 0x17, 0xe8, 0xea, 0xc9, 0xe2, 0x8f, 0x25,
+	0x2d, 0x96, 0x16, 0x7a, 0x58, 0x5a, 0xe8, 0xf7, 0xd2, 0x42, 0x3f, 0x56, 0x96, 0xf4, 0xb0, 0xb2,
+	0xa4, 0x9f, 0x2b, 0x4b, 0x1a, 0xa9, 0xa2, 0xe3, 0xe5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e,
+	0xce, 0xc3, 0x29, 0x6e, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -303,6 +315,37 @@ func (m *Notice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncRev != 0 {
+		i = encodeVarintNotice(dAtA, i, uint64(m.SyncRev))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.IncrementalObjs) > 0 {
+		for iNdEx := len(m.IncrementalObjs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IncrementalObjs[iNdEx])
+			copy(dAtA[i:], m.IncrementalObjs[iNdEx])
+			i = encodeVarintNotice(dAtA, i, uint64(len(m.IncrementalObjs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SyncRevs) > 0 {
+		for k := range m.SyncRevs {
+			v := m.SyncRevs[k]
+			baseI := i
+			i = encodeVarintNotice(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNotice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNotice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.FilterFederatedCloudlet {
 		i--
 		if m.FilterFederatedCloudlet {
@@ -434,6 +477,37 @@ func (m *Notice) RemoveWantObjs(vals ...string) int {
 	return changes
 }
 
+func (m *Notice) AddIncrementalObjs(vals ...string) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.IncrementalObjs {
+		cur[v] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v]; found {
+			continue // duplicate
+		}
+		m.IncrementalObjs = append(m.IncrementalObjs, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *Notice) RemoveIncrementalObjs(vals ...string) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v] = struct{}{}
+	}
+	for i := len(m.IncrementalObjs); i >= 0; i-- {
+		if _, found := remove[m.IncrementalObjs[i]]; found {
+			m.IncrementalObjs = append(m.IncrementalObjs[:i], m.IncrementalObjs[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
 func (m *Notice) CopyInFields(src *Notice) int {
 	updateListAction := "replace"
 	changed := 0
@@ -507,6 +581,48 @@ func (m *Notice) CopyInFields(src *Notice) int {
 		m.FilterFederatedCloudlet = src.FilterFederatedCloudlet
 		changed++
 	}
+	if src.SyncRevs != nil {
+		if updateListAction == "add" {
+			for k0, v := range src.SyncRevs {
+				m.SyncRevs[k0] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k0, _ := range src.SyncRevs {
+				if _, ok := m.SyncRevs[k0]; ok {
+					delete(m.SyncRevs, k0)
+					changed++
+				}
+			}
+		} else {
+			m.SyncRevs = make(map[string]int64)
+			for k0, v := range src.SyncRevs {
+				m.SyncRevs[k0] = v
+			}
+			changed++
+		}
+	} else if m.SyncRevs != nil {
+		m.SyncRevs = nil
+		changed++
+	}
+	if src.IncrementalObjs != nil {
+		if updateListAction == "add" {
+			changed += m.AddIncrementalObjs(src.IncrementalObjs...)
+		} else if updateListAction == "remove" {
+			changed += m.RemoveIncrementalObjs(src.IncrementalObjs...)
+		} else {
+			m.IncrementalObjs = make([]string, 0)
+			m.IncrementalObjs = append(m.IncrementalObjs, src.IncrementalObjs...)
+			changed++
+		}
+	} else if m.IncrementalObjs != nil {
+		m.IncrementalObjs = nil
+		changed++
+	}
+	if m.SyncRev != src.SyncRev {
+		m.SyncRev = src.SyncRev
+		changed++
+	}
 	return changed
 }
 
@@ -534,6 +650,23 @@ func (m *Notice) DeepCopyIn(src *Notice) {
 		m.Tags = nil
 	}
 	m.FilterFederatedCloudlet = src.FilterFederatedCloudlet
+	if src.SyncRevs != nil {
+		m.SyncRevs = make(map[string]int64)
+		for k, v := range src.SyncRevs {
+			m.SyncRevs[k] = v
+		}
+	} else {
+		m.SyncRevs = nil
+	}
+	if src.IncrementalObjs != nil {
+		m.IncrementalObjs = make([]string, len(src.IncrementalObjs), len(src.IncrementalObjs))
+		for ii, s := range src.IncrementalObjs {
+			m.IncrementalObjs[ii] = s
+		}
+	} else {
+		m.IncrementalObjs = nil
+	}
+	m.SyncRev = src.SyncRev
 }
 
 // Helper method to check that enums have valid values
@@ -708,6 +841,23 @@ func (m *Notice) Size() (n int) {
 	if m.FilterFederatedCloudlet {
 		n += 2
 	}
+	if len(m.SyncRevs) > 0 {
+		for k, v := range m.SyncRevs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovNotice(uint64(len(k))) + 1 + sovNotice(uint64(v))
+			n += mapEntrySize + 1 + sovNotice(uint64(mapEntrySize))
+		}
+	}
+	if len(m.IncrementalObjs) > 0 {
+		for _, s := range m.IncrementalObjs {
+			l = len(s)
+			n += 1 + l + sovNotice(uint64(l))
+		}
+	}
+	if m.SyncRev != 0 {
+		n += 1 + sovNotice(uint64(m.SyncRev))
+	}
 	return n
 }
 
@@ -1067,6 +1217,170 @@ func (m *Notice) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.FilterFederatedCloudlet = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncRevs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncRevs == nil {
+				m.SyncRevs = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNotice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNotice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNotice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNotice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNotice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNotice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthNotice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SyncRevs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementalObjs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncrementalObjs = append(m.IncrementalObjs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncRev", wireType)
+			}
+			m.SyncRev = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncRev |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNotice(dAtA[iNdEx:])
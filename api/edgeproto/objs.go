@@ -458,9 +458,25 @@ func (s *Zone) Validate(fmap objstore.FieldMap) error {
 	if err := s.GetKey().ValidateKey(); err != nil {
 		return err
 	}
+	if _, err := s.GetLocation(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// GetLocation gets the time zone location of the Zone,
+// defaulting to UTC.
+func (s *Zone) GetLocation() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid timezone %q, %v", s.Timezone, err)
+	}
+	return loc, nil
+}
+
 func (s *CloudletInfo) Validate(fmap objstore.FieldMap) error {
 	return nil
 }
@@ -786,17 +802,122 @@ func (s *AutoProvPolicy) Validate(fmap objstore.FieldMap) error {
 	if s.MinActiveInstances > s.MaxInstances && s.MaxInstances != 0 {
 		return fmt.Errorf("Minimum active instances cannot be larger than Maximum Instances")
 	}
-	if s.MinActiveInstances == 0 && s.DeployClientCount == 0 {
-		return fmt.Errorf("One of deploy client count and minimum active instances must be specified")
+	if s.MinActiveInstances == 0 && s.DeployClientCount == 0 && len(s.Schedules) == 0 {
+		return fmt.Errorf("One of deploy client count, minimum active instances, or schedules must be specified")
+	}
+	if s.ScheduleLeadTime < 0 {
+		return fmt.Errorf("Schedule lead time cannot be negative")
+	}
+	if s.ScheduleLeadTime > Duration(24*time.Hour) {
+		return fmt.Errorf("Schedule lead time should not exceed 24 hours")
+	}
+	if s.MinInstanceLifetime < 0 {
+		return fmt.Errorf("Min instance lifetime cannot be negative")
+	}
+	if s.RedeployCooldown < 0 {
+		return fmt.Errorf("Redeploy cooldown cannot be negative")
+	}
+	if s.FlapWindow < 0 {
+		return fmt.Errorf("Flap window cannot be negative")
+	}
+	if s.MaxFlaps > 0 && s.FlapWindow == 0 {
+		return fmt.Errorf("Flap window must be specified with max flaps")
+	}
+	names := map[string]struct{}{}
+	for ii := range s.Schedules {
+		sched := &s.Schedules[ii]
+		if sched.Name == "" {
+			return fmt.Errorf("Schedule %d name must be specified", ii+1)
+		}
+		if _, found := names[sched.Name]; found {
+			return fmt.Errorf("Duplicate schedule name %s", sched.Name)
+		}
+		names[sched.Name] = struct{}{}
+		if _, err := util.ParseCron(sched.Cron); err != nil {
+			return fmt.Errorf("Schedule %s invalid cron, %v", sched.Name, err)
+		}
+		if sched.Duration <= 0 {
+			return fmt.Errorf("Schedule %s duration must be greater than 0", sched.Name)
+		}
+		if sched.Duration > Duration(MaxAutoProvScheduleDuration) {
+			return fmt.Errorf("Schedule %s duration should not exceed %s", sched.Name, MaxAutoProvScheduleDuration)
+		}
+		if sched.MinActiveInstances == 0 {
+			return fmt.Errorf("Schedule %s minimum active instances must be greater than 0", sched.Name)
+		}
+		if s.MaxInstances != 0 && sched.MinActiveInstances > s.MaxInstances {
+			return fmt.Errorf("Schedule %s minimum active instances cannot be larger than Maximum Instances", sched.Name)
+		}
+		numZones := len(s.Zones)
+		if len(sched.Zones) > 0 {
+			numZones = len(sched.Zones)
+		}
+		for jj := range sched.Zones {
+			if !s.HasZone(&sched.Zones[jj]) {
+				return fmt.Errorf("Schedule %s zone %s is not part of the policy", sched.Name, sched.Zones[jj].GetKeyString())
+			}
+		}
+		if int(sched.MinActiveInstances) > numZones {
+			return fmt.Errorf("Schedule %s minimum active instances cannot be larger than the number of its Zones", sched.Name)
+		}
 	}
 	return nil
 }
 
+// MaxAutoProvScheduleDuration is the max length of a schedule window
+var MaxAutoProvScheduleDuration = 7 * 24 * time.Hour
+
+// HasZone checks if the zone is part of the policy
+func (s *AutoProvPolicy) HasZone(zoneKey *ZoneKey) bool {
+	for _, zk := range s.Zones {
+		if zk.Matches(zoneKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetZones gets the zones the schedule applies to, which
+// defaults to all zones of the policy.
+func (s *AutoProvSchedule) GetZones(policy *AutoProvPolicy) []ZoneKey {
+	if len(s.Zones) > 0 {
+		return s.Zones
+	}
+	zones := []ZoneKey{}
+	for _, zk := range policy.Zones {
+		zones = append(zones, *zk)
+	}
+	return zones
+}
+
+// HasZone checks if the schedule applies to the zone
+func (s *AutoProvSchedule) HasZone(policy *AutoProvPolicy, zoneKey *ZoneKey) bool {
+	if len(s.Zones) == 0 {
+		return policy.HasZone(zoneKey)
+	}
+	for ii := range s.Zones {
+		if s.Zones[ii].Matches(zoneKey) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *AutoProvInfo) Validate(fmap objstore.FieldMap) error {
 	return nil
 }
 
 func ValidateSecurityRules(rules []SecurityRule) error {
+	return validateSecurityRules(rules, false)
+}
+
+// ValidateFqdnSecurityRules validates rules that may specify a
+// remote FQDN instead of a remote CIDR.
+func ValidateFqdnSecurityRules(rules []SecurityRule) error {
+	return validateSecurityRules(rules, true)
+}
+
+func validateSecurityRules(rules []SecurityRule, allowFqdn bool) error {
 	for _, r := range rules {
 		if r.Protocol != "TCP" && r.Protocol != "UDP" && r.Protocol != "ICMP" {
 			return fmt.Errorf("Protocol must be one of: (TCP,UDP,ICMP)")
@@ -817,6 +938,18 @@ func ValidateSecurityRules(rules []SecurityRule) error {
 				return fmt.Errorf("Min port range: %d cannot be higher than max: %d", r.PortRangeMin, r.PortRangeMax)
 			}
 		}
+		if r.RemoteFqdn != "" {
+			if !allowFqdn {
+				return fmt.Errorf("Remote FQDN not allowed, please specify remote CIDR")
+			}
+			if r.RemoteCidr != "" {
+				return fmt.Errorf("Only one of remote CIDR or remote FQDN may be specified")
+			}
+			if err := util.ValidFQDN(r.RemoteFqdn); err != nil {
+				return err
+			}
+			continue
+		}
 		_, _, err := net.ParseCIDR(r.RemoteCidr)
 		if err != nil {
 			return err
@@ -1391,13 +1524,14 @@ func (a *AlertPolicy) Validate(fmap objstore.FieldMap) error {
 	// Since active connections and other metrics are part
 	// of different instances of Prometheus, disallow mixing them
 	if a.ActiveConnLimit != 0 {
-		if a.CpuUtilizationLimit != 0 || a.MemUtilizationLimit != 0 || a.DiskUtilizationLimit != 0 {
+		if a.CpuUtilizationLimit != 0 || a.MemUtilizationLimit != 0 || a.DiskUtilizationLimit != 0 || len(a.Conditions) > 0 {
 			return errors.New("Active Connection Alerts should not include any other triggers")
 		}
 	}
 	// at least one of the values for alert should be set
 	if a.ActiveConnLimit == 0 && a.CpuUtilizationLimit == 0 &&
-		a.MemUtilizationLimit == 0 && a.DiskUtilizationLimit == 0 {
+		a.MemUtilizationLimit == 0 && a.DiskUtilizationLimit == 0 &&
+		len(a.Conditions) == 0 {
 		return errors.New("At least one of the measurements for alert should be set")
 	}
 	// check CPU to be within 0-100 percent
@@ -1416,9 +1550,29 @@ func (a *AlertPolicy) Validate(fmap objstore.FieldMap) error {
 	if a.TriggerTime > Duration(72*time.Hour) {
 		return errors.New("Trigger duration should not exceed 72 hours")
 	}
+	if len(a.Conditions) > MaxAlertPolicyConditions {
+		return fmt.Errorf("Alert policy cannot have more than %d conditions", MaxAlertPolicyConditions)
+	}
+	for ii, cond := range a.Conditions {
+		if cond.Expr == "" {
+			return fmt.Errorf("Condition %d expression must be specified", ii+1)
+		}
+		if _, found := AlertComparison_CamelName[int32(cond.Comparison)]; !found {
+			return fmt.Errorf("Condition %d has invalid comparison %d", ii+1, cond.Comparison)
+		}
+		if cond.Window < 0 {
+			return fmt.Errorf("Condition %d window cannot be negative", ii+1)
+		}
+		if cond.Window > Duration(24*time.Hour) {
+			return fmt.Errorf("Condition %d window should not exceed 24 hours", ii+1)
+		}
+	}
 	return nil
 }
 
+// MaxAlertPolicyConditions is the max number of conditions per alert policy
+var MaxAlertPolicyConditions = 10
+
 // Check if AlertPolicies are different between two apps
 func (app *App) AppAlertPoliciesDifferent(other *App) bool {
 	alertsDiff := false
@@ -1444,7 +1598,13 @@ func (s *TrustPolicy) Validate(fmap objstore.FieldMap) error {
 		return err
 	}
 	log.DebugLog(log.DebugLevelInfra, "ValidateSecurityRules()", "TrustPolicy:", s.GetKey().Name)
-	return ValidateSecurityRules(s.OutboundSecurityRules)
+	if err := ValidateFqdnSecurityRules(s.OutboundSecurityRules); err != nil {
+		return err
+	}
+	if err := ValidateSecurityRules(s.InboundSecurityRules); err != nil {
+		return fmt.Errorf("Invalid inbound security rule, %s", err)
+	}
+	return nil
 }
 
 func (key *TrustPolicyExceptionKey) ValidateKey() error {
@@ -1489,10 +1649,12 @@ func fixupSecurityRules(ctx context.Context, rules []SecurityRule) {
 			rules[i].PortRangeMax = o.PortRangeMin
 		}
 		rules[i].Protocol = strings.ToUpper(o.Protocol)
+		rules[i].RemoteFqdn = strings.TrimSuffix(strings.ToLower(o.RemoteFqdn), ".")
 	}
 }
 func (s *TrustPolicy) FixupSecurityRules(ctx context.Context) {
 	fixupSecurityRules(ctx, s.OutboundSecurityRules)
+	fixupSecurityRules(ctx, s.InboundSecurityRules)
 }
 
 func (s *TrustPolicyException) FixupSecurityRules(ctx context.Context) {
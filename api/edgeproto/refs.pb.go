@@ -186,6 +186,8 @@ type AppInstRefs struct {
 	Insts map[string]uint32 `protobuf:"bytes,2,rep,name=insts,proto3" json:"insts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	// AppInsts being deleted (key is JSON of AppInst Key)
 	DeleteRequestedInsts map[string]uint32 `protobuf:"bytes,3,rep,name=delete_requested_insts,json=deleteRequestedInsts,proto3" json:"delete_requested_insts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Staged rollout progress of AppInsts (key is JSON of AppInst Key)
+	RolloutInsts map[string]RolloutStatus `protobuf:"bytes,4,rep,name=rollout_insts,json=rolloutInsts,proto3" json:"rollout_insts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *AppInstRefs) Reset()         { *m = AppInstRefs{} }
@@ -230,69 +232,73 @@ func init() {
 	proto.RegisterType((*AppInstRefs)(nil), "edgeproto.AppInstRefs")
 	proto.RegisterMapType((map[string]uint32)(nil), "edgeproto.AppInstRefs.DeleteRequestedInstsEntry")
 	proto.RegisterMapType((map[string]uint32)(nil), "edgeproto.AppInstRefs.InstsEntry")
+	proto.RegisterMapType((map[string]RolloutStatus)(nil), "edgeproto.AppInstRefs.RolloutInstsEntry")
 }
 
 func init() { proto.RegisterFile("refs.proto", fileDescriptor_6435a763ece979c6) }
 
 var fileDescriptor_6435a763ece979c6 = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x18, 0xf5, 0xc4, 0xde, 0x12, 0x7f, 0xce, 0xda, 0xee, 0xd6, 0x98, 0xa9, 0x29, 0xc6, 0xf8, 0x80,
-	0x2c, 0x94, 0xba, 0x26, 0x70, 0x08, 0x16, 0x02, 0xdc, 0x16, 0x68, 0x69, 0xab, 0xa0, 0x8d, 0xe8,
-	0x75, 0xb5, 0xf1, 0x4e, 0xcc, 0xca, 0xf6, 0xce, 0xb0, 0x33, 0xeb, 0xca, 0x9c, 0xb8, 0x81, 0x0a,
-	0x87, 0xfe, 0x00, 0x0e, 0x5c, 0xb9, 0xf2, 0x2b, 0x72, 0xec, 0x91, 0x13, 0x82, 0xe4, 0x82, 0x7c,
-	0x42, 0xaa, 0xe5, 0x72, 0x44, 0x3b, 0xb3, 0x9b, 0xdd, 0x60, 0xbb, 0x69, 0x6e, 0xf3, 0xbd, 0x7d,
-	0xf3, 0xbe, 0x37, 0xf3, 0x3d, 0x8f, 0x01, 0x7c, 0x72, 0xc8, 0xdb, 0xcc, 0xa7, 0x82, 0x1a, 0x79,
-	0xe2, 0x0c, 0x88, 0x5c, 0xd6, 0xde, 0x10, 0x94, 0x8e, 0xf8, 0x0d, 0x59, 0x0c, 0x88, 0x77, 0xba,
-	0x50, 0xcc, 0x5a, 0x65, 0x40, 0x07, 0x54, 0x2e, 0x6f, 0x84, 0xab, 0x08, 0xbd, 0xdc, 0x1f, 0xd1,
-	0xc0, 0x19, 0x11, 0x31, 0x24, 0xd3, 0x08, 0x2a, 0xc6, 0x50, 0x42, 0x09, 0xb8, 0x20, 0xbe, 0xeb,
-	0xf1, 0x18, 0xd2, 0x23, 0x28, 0x2a, 0xf3, 0x36, 0x63, 0xf1, 0x17, 0x9b, 0xb1, 0x14, 0xb1, 0xe2,
-	0x7a, 0x87, 0xbe, 0xed, 0x13, 0x4e, 0x03, 0xbf, 0x4f, 0x22, 0xd3, 0xcd, 0x1f, 0x10, 0xc0, 0xc3,
-	0x07, 0x66, 0x84, 0x1a, 0xd7, 0x21, 0x3b, 0x24, 0x53, 0x8c, 0x1a, 0xa8, 0x55, 0xd8, 0x79, 0xb5,
-	0x7d, 0x7a, 0xa2, 0xf6, 0x2d, 0xd5, 0xe5, 0x1e, 0x99, 0xde, 0xcc, 0x1d, 0xfd, 0xf1, 0x66, 0xc6,
-	0x0c, 0x79, 0xc6, 0xeb, 0x90, 0x9f, 0x8c, 0xad, 0xc3, 0x91, 0x3d, 0xa1, 0x3e, 0xde, 0x68, 0xa0,
-	0x56, 0xde, 0xdc, 0x9c, 0x8c, 0x3f, 0x93, 0xb5, 0x61, 0x40, 0x4e, 0x4c, 0x19, 0xc1, 0x59, 0x89,
-	0xcb, 0xb5, 0x51, 0x01, 0xad, 0x4f, 0x03, 0x4f, 0x60, 0xad, 0x81, 0x5a, 0xba, 0xa9, 0x8a, 0x2f,
-	0x72, 0x9b, 0xb9, 0xb2, 0xd6, 0x5c, 0x68, 0xb0, 0x75, 0x2b, 0x3a, 0xaf, 0x49, 0x0e, 0xb9, 0xd1,
-	0x4d, 0x9b, 0xa9, 0x9e, 0x31, 0xa3, 0x58, 0xa1, 0x9b, 0xf2, 0x6c, 0x81, 0x37, 0x63, 0x20, 0x71,
-	0x76, 0x1f, 0x74, 0x9f, 0x52, 0x61, 0x8d, 0x0e, 0x2c, 0x46, 0x7d, 0xc1, 0xf1, 0x66, 0x23, 0xdb,
-	0x2a, 0xec, 0xb4, 0x56, 0xa8, 0x84, 0xbd, 0xda, 0x26, 0xa5, 0xe2, 0xfe, 0xc1, 0x97, 0x21, 0xf5,
-	0x53, 0x4f, 0xf8, 0x53, 0xb3, 0xe0, 0x27, 0x88, 0xd1, 0x82, 0x72, 0xc0, 0x89, 0x63, 0x39, 0x53,
-	0xcf, 0x1e, 0xbb, 0x7d, 0xcb, 0x65, 0x1c, 0xe7, 0x1b, 0xa8, 0xa5, 0x99, 0xc5, 0x10, 0xbf, 0xad,
-	0xe0, 0xbb, 0x8c, 0x1b, 0x6f, 0x43, 0x49, 0x32, 0xb9, 0xb0, 0x45, 0x44, 0x04, 0x79, 0x7e, 0x3d,
-	0x84, 0xf7, 0x25, 0x1a, 0xf2, 0xf6, 0xa1, 0x4c, 0x99, 0xb0, 0x7c, 0xc2, 0x2d, 0xc9, 0x1f, 0xdb,
-	0x0c, 0x17, 0xa4, 0xc5, 0x77, 0xd6, 0x59, 0xdc, 0x63, 0xc2, 0x24, 0xfc, 0x2b, 0x4e, 0x9c, 0x07,
-	0x36, 0x53, 0x26, 0x75, 0x9a, 0xc6, 0x8c, 0x0f, 0xe0, 0xaa, 0x4f, 0x38, 0xf1, 0x27, 0xc4, 0xb1,
-	0xec, 0x40, 0x50, 0x2b, 0xca, 0x86, 0xe5, 0x3a, 0x1c, 0x6f, 0x35, 0x50, 0xeb, 0x92, 0x59, 0x8d,
-	0x09, 0xbd, 0x40, 0xd0, 0x68, 0xa8, 0x77, 0x1d, 0x6e, 0xec, 0x81, 0x7e, 0x4a, 0xf6, 0xb8, 0xe0,
-	0x58, 0x97, 0x66, 0xd6, 0x44, 0xe0, 0xca, 0xe3, 0xe7, 0xb8, 0x10, 0xef, 0xf6, 0xb8, 0xba, 0xf7,
-	0xad, 0x7e, 0x02, 0x70, 0xe3, 0x0e, 0x6c, 0x4d, 0xc6, 0x96, 0xcd, 0x58, 0xa4, 0x57, 0x5c, 0xd2,
-	0xeb, 0x31, 0x16, 0x52, 0x43, 0xbd, 0xd2, 0xe3, 0xe7, 0xf8, 0x95, 0xa8, 0x96, 0x5a, 0x30, 0x19,
-	0x47, 0x25, 0x37, 0x3e, 0x06, 0x7d, 0xb8, 0xcb, 0x53, 0x52, 0xa5, 0x17, 0x49, 0xa9, 0x74, 0x16,
-	0x86, 0xbb, 0x3c, 0x16, 0xa8, 0x7d, 0x04, 0xe5, 0xff, 0x8f, 0xd7, 0x28, 0x27, 0xd9, 0xd2, 0x54,
-	0x62, 0x2a, 0xa0, 0x4d, 0xec, 0x51, 0x40, 0x64, 0x8e, 0x35, 0x53, 0x15, 0xdd, 0x8d, 0x5d, 0x54,
-	0xfb, 0x04, 0x8c, 0xe5, 0xbb, 0x4f, 0x2b, 0xe4, 0x57, 0x28, 0xe8, 0x29, 0x85, 0xee, 0xb5, 0xbf,
-	0x9f, 0x61, 0xf4, 0xcf, 0x33, 0x8c, 0xbe, 0x9b, 0x63, 0xf4, 0xcb, 0x1c, 0xa3, 0xdf, 0x16, 0x38,
-	0xe7, 0x51, 0x8f, 0xfc, 0xbb, 0xc0, 0xa8, 0xf9, 0x2b, 0x82, 0xf8, 0x32, 0x65, 0xee, 0x3f, 0x7c,
-	0x89, 0x1f, 0xe1, 0x95, 0xd9, 0x62, 0x79, 0x02, 0xd2, 0x45, 0x17, 0x72, 0x36, 0x63, 0x1c, 0x6f,
-	0x5c, 0xe8, 0xc2, 0xe5, 0x9e, 0x6e, 0x23, 0xed, 0xf3, 0xc9, 0x2a, 0xaf, 0x3f, 0x67, 0xa1, 0x10,
-	0xed, 0x93, 0x5e, 0x3b, 0x69, 0xaf, 0x97, 0xcf, 0x36, 0x0b, 0x1b, 0x15, 0x66, 0x0b, 0x9c, 0xed,
-	0x31, 0x96, 0xf8, 0xbb, 0x03, 0x9a, 0x1a, 0xa3, 0x32, 0xf8, 0xd6, 0xb2, 0x41, 0x99, 0x76, 0x39,
-	0x3a, 0x79, 0xd3, 0xcb, 0x66, 0x95, 0x80, 0x31, 0x82, 0xaa, 0x43, 0x46, 0x44, 0x10, 0xcb, 0x27,
-	0xdf, 0x04, 0x84, 0x0b, 0xe2, 0x44, 0x09, 0xc9, 0x4a, 0xe9, 0xce, 0x1a, 0xe9, 0xdb, 0x72, 0x93,
-	0x19, 0xef, 0x49, 0x75, 0x52, 0xe1, 0xa9, 0x38, 0x2b, 0x08, 0xb5, 0x5d, 0x80, 0x84, 0x79, 0x91,
-	0xe9, 0xd7, 0x3e, 0x87, 0xab, 0x6b, 0x5b, 0x5e, 0x28, 0x46, 0xe7, 0x8e, 0x67, 0xe7, 0x27, 0x04,
-	0xa5, 0xf4, 0xa3, 0xd1, 0x63, 0xae, 0x31, 0x85, 0xf2, 0xfe, 0xd7, 0xf4, 0xd1, 0x99, 0xa7, 0xf5,
-	0xb5, 0x35, 0x8f, 0x4c, 0x6d, 0xdd, 0x87, 0xe6, 0xbb, 0xb3, 0x39, 0xbe, 0x1e, 0xff, 0x4f, 0xc4,
-	0x5f, 0xf8, 0x76, 0xaf, 0x2f, 0x5c, 0xea, 0x3d, 0x74, 0xc9, 0xa3, 0xed, 0x7b, 0x64, 0xda, 0xde,
-	0xf3, 0x07, 0xb6, 0xe7, 0x7e, 0x6b, 0x87, 0x60, 0x07, 0xed, 0xfc, 0x88, 0xa0, 0x98, 0x4a, 0xb6,
-	0x72, 0x53, 0x52, 0x6e, 0x92, 0xbc, 0x57, 0x97, 0x23, 0x2e, 0xbd, 0xac, 0xc1, 0x9b, 0xef, 0xcf,
-	0xe6, 0xb8, 0x93, 0x58, 0x49, 0x1e, 0x9d, 0x73, 0xdc, 0x7c, 0x8f, 0xa0, 0x98, 0xca, 0x41, 0xe8,
-	0x26, 0x50, 0x6e, 0xd2, 0x89, 0xae, 0xae, 0x4e, 0x4d, 0x6d, 0x0d, 0xde, 0xec, 0xcc, 0xe6, 0x78,
-	0x3b, 0x76, 0x13, 0xbf, 0x39, 0x2f, 0x76, 0x72, 0xf3, 0xda, 0xd1, 0x5f, 0xf5, 0xcc, 0xd1, 0x71,
-	0x1d, 0x3d, 0x3d, 0xae, 0xa3, 0x3f, 0x8f, 0xeb, 0xe8, 0xc9, 0x49, 0x3d, 0xf3, 0xf4, 0xa4, 0x9e,
-	0xf9, 0xfd, 0xa4, 0x9e, 0x39, 0xb8, 0x24, 0x9b, 0xbc, 0xf7, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x01, 0x45, 0x7b, 0xb9, 0x5d, 0x08, 0x00, 0x00,
+	// 950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x8f, 0x1b, 0x35,
+	0x1c, 0x5d, 0x6f, 0x92, 0xb2, 0x71, 0xfe, 0xae, 0x1b, 0x82, 0x1b, 0x4a, 0x08, 0x39, 0xa0, 0x08,
+	0x6d, 0xd3, 0x10, 0x38, 0x2c, 0x11, 0x02, 0xd2, 0x16, 0x68, 0x69, 0xab, 0x45, 0xb3, 0xa2, 0x12,
+	0xa7, 0xd1, 0x6c, 0xc6, 0x1b, 0x46, 0x3b, 0x19, 0x9b, 0xb1, 0x27, 0x55, 0x38, 0x71, 0x03, 0x15,
+	0x0e, 0xfd, 0x08, 0x5c, 0xb9, 0xf2, 0x29, 0xf6, 0xc0, 0xa1, 0x47, 0x4e, 0x08, 0x76, 0x2f, 0x28,
+	0x27, 0xa4, 0x46, 0x29, 0x47, 0x34, 0xf6, 0xcc, 0x8e, 0x97, 0x49, 0x5a, 0xf6, 0x66, 0xbf, 0x79,
+	0x7e, 0xbf, 0x67, 0xfb, 0xf9, 0x37, 0x10, 0xfa, 0xe4, 0x90, 0x77, 0x99, 0x4f, 0x05, 0x45, 0x79,
+	0x62, 0x8f, 0x89, 0x1c, 0x36, 0x5e, 0x13, 0x94, 0xba, 0xfc, 0xba, 0x9c, 0x8c, 0x89, 0x77, 0x36,
+	0x50, 0xcc, 0x46, 0x6d, 0x4c, 0xc7, 0x54, 0x0e, 0xaf, 0x87, 0xa3, 0x08, 0xdd, 0x1e, 0xb9, 0x34,
+	0xb0, 0x5d, 0x22, 0x8e, 0xc8, 0x2c, 0x82, 0xca, 0x31, 0x94, 0x50, 0x02, 0x2e, 0x88, 0xef, 0x78,
+	0x3c, 0x86, 0x4a, 0x11, 0x14, 0x4d, 0xf3, 0x16, 0x63, 0xf1, 0x17, 0x8b, 0x31, 0x8d, 0x58, 0x73,
+	0xbc, 0x43, 0xdf, 0xf2, 0x09, 0xa7, 0x81, 0x3f, 0x22, 0x91, 0xe9, 0xf6, 0xf7, 0x00, 0xc2, 0x07,
+	0xf7, 0x8d, 0x08, 0x45, 0xd7, 0x60, 0xe6, 0x88, 0xcc, 0x30, 0x68, 0x81, 0x4e, 0xa1, 0xff, 0x72,
+	0xf7, 0x6c, 0x47, 0xdd, 0x9b, 0xaa, 0xca, 0x5d, 0x32, 0xbb, 0x91, 0x3d, 0xfe, 0xfd, 0xf5, 0x0d,
+	0x23, 0xe4, 0xa1, 0x57, 0x61, 0x7e, 0x3a, 0x31, 0x0f, 0x5d, 0x6b, 0x4a, 0x7d, 0xbc, 0xd9, 0x02,
+	0x9d, 0xbc, 0xb1, 0x35, 0x9d, 0x7c, 0x22, 0xe7, 0x08, 0xc1, 0xac, 0x98, 0x31, 0x82, 0x33, 0x12,
+	0x97, 0x63, 0x54, 0x83, 0xb9, 0x11, 0x0d, 0x3c, 0x81, 0x73, 0x2d, 0xd0, 0x29, 0x19, 0x6a, 0xf2,
+	0x59, 0x76, 0x2b, 0x5b, 0xcd, 0xb5, 0x97, 0x39, 0x58, 0xbc, 0x19, 0xed, 0xd7, 0x20, 0x87, 0x1c,
+	0x0d, 0x74, 0x33, 0xf5, 0x73, 0x66, 0x14, 0x2b, 0x74, 0x53, 0x9d, 0x2f, 0xf1, 0x56, 0x0c, 0x24,
+	0xce, 0xee, 0xc1, 0x92, 0x4f, 0xa9, 0x30, 0xdd, 0x03, 0x93, 0x51, 0x5f, 0x70, 0xbc, 0xd5, 0xca,
+	0x74, 0x0a, 0xfd, 0xce, 0x0a, 0x95, 0xb0, 0x56, 0xd7, 0xa0, 0x54, 0xdc, 0x3b, 0xf8, 0x3c, 0xa4,
+	0x7e, 0xec, 0x09, 0x7f, 0x66, 0x14, 0xfc, 0x04, 0x41, 0x1d, 0x58, 0x0d, 0x38, 0xb1, 0x4d, 0x7b,
+	0xe6, 0x59, 0x13, 0x67, 0x64, 0x3a, 0x8c, 0xe3, 0x7c, 0x0b, 0x74, 0x72, 0x46, 0x39, 0xc4, 0x6f,
+	0x29, 0xf8, 0x0e, 0xe3, 0xe8, 0x4d, 0x58, 0x91, 0x4c, 0x2e, 0x2c, 0x11, 0x11, 0xa1, 0xdc, 0x7f,
+	0x29, 0x84, 0xf7, 0x25, 0x1a, 0xf2, 0xf6, 0x61, 0x95, 0x32, 0x61, 0xfa, 0x84, 0x9b, 0x92, 0x3f,
+	0xb1, 0x18, 0x2e, 0x48, 0x8b, 0x6f, 0xad, 0xb3, 0xb8, 0xc7, 0x84, 0x41, 0xf8, 0x17, 0x9c, 0xd8,
+	0xf7, 0x2d, 0xa6, 0x4c, 0x96, 0xa8, 0x8e, 0xa1, 0xf7, 0xe0, 0x15, 0x9f, 0x70, 0xe2, 0x4f, 0x89,
+	0x6d, 0x5a, 0x81, 0xa0, 0x66, 0x94, 0x0d, 0xd3, 0xb1, 0x39, 0x2e, 0xb6, 0x40, 0xe7, 0x92, 0x51,
+	0x8f, 0x09, 0xc3, 0x40, 0xd0, 0xe8, 0x52, 0xef, 0xd8, 0x1c, 0xed, 0xc1, 0xd2, 0x19, 0xd9, 0xe3,
+	0x82, 0xe3, 0x92, 0x34, 0xb3, 0x26, 0x02, 0x97, 0x1f, 0x3d, 0xc3, 0x85, 0x78, 0xb5, 0xc7, 0xd5,
+	0xb9, 0x17, 0x47, 0x09, 0xc0, 0xd1, 0x6d, 0x58, 0x9c, 0x4e, 0x4c, 0x8b, 0xb1, 0x48, 0xaf, 0x9c,
+	0xd2, 0x1b, 0x32, 0x16, 0x52, 0x43, 0xbd, 0xca, 0xa3, 0x67, 0xf8, 0xa5, 0x68, 0x2e, 0xb5, 0xe0,
+	0x74, 0x12, 0x4d, 0x39, 0xfa, 0x10, 0x96, 0x8e, 0x76, 0xb9, 0x26, 0x55, 0x79, 0x9e, 0x94, 0x4a,
+	0x67, 0xe1, 0x68, 0x97, 0xc7, 0x02, 0x8d, 0x0f, 0x60, 0xf5, 0xbf, 0xd7, 0x8b, 0xaa, 0x49, 0xb6,
+	0x72, 0x2a, 0x31, 0x35, 0x98, 0x9b, 0x5a, 0x6e, 0x40, 0x64, 0x8e, 0x73, 0x86, 0x9a, 0x0c, 0x36,
+	0x77, 0x41, 0xe3, 0x23, 0x88, 0xd2, 0x67, 0xaf, 0x2b, 0xe4, 0x57, 0x28, 0x94, 0x34, 0x85, 0xc1,
+	0xd5, 0xbf, 0x9e, 0x62, 0xf0, 0xf7, 0x53, 0x0c, 0xbe, 0x5d, 0x60, 0xf0, 0xd3, 0x02, 0x83, 0x5f,
+	0x96, 0x38, 0xeb, 0x51, 0x8f, 0xfc, 0xb3, 0xc4, 0xa0, 0xfd, 0x33, 0x80, 0xf1, 0x61, 0xca, 0xdc,
+	0xbf, 0xff, 0x3f, 0x1e, 0xe1, 0xe5, 0xf9, 0x32, 0x7d, 0x03, 0xd2, 0xc5, 0x00, 0x66, 0x2d, 0xc6,
+	0x38, 0xde, 0xbc, 0xd0, 0x81, 0xcb, 0x35, 0x83, 0x96, 0xee, 0xf3, 0xf1, 0x2a, 0xaf, 0xbf, 0x66,
+	0x61, 0x21, 0x5a, 0x27, 0xbd, 0xf6, 0x74, 0xaf, 0xdb, 0xe7, 0x8b, 0x85, 0x85, 0x0a, 0xf3, 0x25,
+	0xce, 0x0c, 0x19, 0x4b, 0xfc, 0xdd, 0x86, 0x39, 0x75, 0x8d, 0xca, 0xe0, 0x1b, 0x69, 0x83, 0x32,
+	0xed, 0xf2, 0xea, 0xe4, 0x49, 0xa7, 0xcd, 0x2a, 0x01, 0xe4, 0xc2, 0xba, 0x4d, 0x5c, 0x22, 0x88,
+	0xe9, 0x93, 0xaf, 0x03, 0xc2, 0x05, 0xb1, 0xa3, 0x84, 0x64, 0xa4, 0x74, 0x6f, 0x8d, 0xf4, 0x2d,
+	0xb9, 0xc8, 0x88, 0xd7, 0x68, 0x95, 0x54, 0x78, 0x6a, 0xf6, 0x0a, 0x02, 0xda, 0x0f, 0x3b, 0x8a,
+	0xeb, 0xd2, 0x40, 0x44, 0x45, 0xb2, 0xa9, 0x8e, 0xa2, 0x17, 0x31, 0x14, 0x37, 0x25, 0x5e, 0xf4,
+	0xb5, 0x0f, 0x8d, 0x5d, 0x08, 0x13, 0xc6, 0x45, 0x22, 0xd5, 0xf8, 0x14, 0x5e, 0x59, 0xbb, 0x8f,
+	0x0b, 0x09, 0x7d, 0x09, 0xb7, 0x53, 0x5e, 0x57, 0x08, 0x74, 0x75, 0x81, 0x42, 0x1f, 0x6b, 0xdb,
+	0x8e, 0x96, 0x87, 0xcd, 0x2d, 0xe0, 0x7a, 0xec, 0x5f, 0x18, 0xa7, 0xfe, 0x8f, 0x00, 0x56, 0xf4,
+	0x26, 0x37, 0x64, 0x0e, 0x9a, 0xc1, 0xea, 0xfe, 0x57, 0xf4, 0xe1, 0xb9, 0x5f, 0xc1, 0x2b, 0x6b,
+	0x9a, 0x62, 0x63, 0xdd, 0x87, 0xf6, 0xdb, 0xf3, 0x05, 0xbe, 0x16, 0xff, 0xd7, 0xe2, 0x2f, 0x7c,
+	0x67, 0x38, 0x12, 0x0e, 0xf5, 0x1e, 0x38, 0xe4, 0xe1, 0xce, 0x5d, 0x32, 0xeb, 0xee, 0xf9, 0x63,
+	0xcb, 0x73, 0xbe, 0xb1, 0x42, 0xb0, 0x07, 0xfa, 0x3f, 0x00, 0x58, 0xd6, 0x5e, 0xa2, 0x72, 0x53,
+	0x51, 0x6e, 0x92, 0xf7, 0x59, 0x4f, 0x3f, 0x49, 0xe9, 0x65, 0x0d, 0xde, 0x7e, 0x77, 0xbe, 0xc0,
+	0xbd, 0xc4, 0x4a, 0xd2, 0x24, 0x5f, 0xe0, 0xe6, 0x3b, 0x00, 0xcb, 0x5a, 0xa4, 0x42, 0x37, 0x81,
+	0x72, 0xa3, 0xbf, 0xc0, 0xfa, 0xea, 0x00, 0x36, 0xd6, 0xe0, 0xed, 0xde, 0x7c, 0x81, 0x77, 0x62,
+	0x37, 0x71, 0x8f, 0x7c, 0xbe, 0x93, 0x1b, 0x57, 0x8f, 0xff, 0x6c, 0x6e, 0x1c, 0x9f, 0x34, 0xc1,
+	0x93, 0x93, 0x26, 0xf8, 0xe3, 0xa4, 0x09, 0x1e, 0x9f, 0x36, 0x37, 0x9e, 0x9c, 0x36, 0x37, 0x7e,
+	0x3b, 0x6d, 0x6e, 0x1c, 0x5c, 0x92, 0x45, 0xde, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x4f, 0xa7,
+	0x09, 0x6d, 0x0d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -850,6 +856,30 @@ func (m *AppInstRefs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RolloutInsts) > 0 {
+		for k := range m.RolloutInsts {
+			v := m.RolloutInsts[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRefs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRefs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRefs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.DeleteRequestedInsts) > 0 {
 		for k := range m.DeleteRequestedInsts {
 			v := m.DeleteRequestedInsts[k]
@@ -2914,6 +2944,21 @@ func (m *AppInstRefs) Matches(o *AppInstRefs, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.RolloutInsts != nil {
+		if len(m.RolloutInsts) == 0 && len(o.RolloutInsts) > 0 || len(m.RolloutInsts) > 0 && len(o.RolloutInsts) == 0 {
+			return false
+		} else if m.RolloutInsts != nil && o.RolloutInsts != nil {
+			if !opts.Filter && len(m.RolloutInsts) != len(o.RolloutInsts) {
+				return false
+			}
+			for k, _ := range o.RolloutInsts {
+				_, ok := m.RolloutInsts[k]
+				if !ok {
+					return false
+				}
+			}
+		}
+	}
 	return true
 }
 
@@ -2986,6 +3031,31 @@ func (m *AppInstRefs) CopyInFields(src *AppInstRefs) int {
 		m.DeleteRequestedInsts = nil
 		changed++
 	}
+	if src.RolloutInsts != nil {
+		if updateListAction == "add" {
+			for k0, v := range src.RolloutInsts {
+				v = *v.Clone()
+				m.RolloutInsts[k0] = v
+				changed++
+			}
+		} else if updateListAction == "remove" {
+			for k0, _ := range src.RolloutInsts {
+				if _, ok := m.RolloutInsts[k0]; ok {
+					delete(m.RolloutInsts, k0)
+					changed++
+				}
+			}
+		} else {
+			m.RolloutInsts = make(map[string]RolloutStatus)
+			for k0, v := range src.RolloutInsts {
+				m.RolloutInsts[k0] = *v.Clone()
+			}
+			changed++
+		}
+	} else if m.RolloutInsts != nil {
+		m.RolloutInsts = nil
+		changed++
+	}
 	return changed
 }
 
@@ -3007,6 +3077,16 @@ func (m *AppInstRefs) DeepCopyIn(src *AppInstRefs) {
 	} else {
 		m.DeleteRequestedInsts = nil
 	}
+	if src.RolloutInsts != nil {
+		m.RolloutInsts = make(map[string]RolloutStatus)
+		for k, v := range src.RolloutInsts {
+			var tmp_v RolloutStatus
+			tmp_v.DeepCopyIn(&v)
+			m.RolloutInsts[k] = tmp_v
+		}
+	} else {
+		m.RolloutInsts = nil
+	}
 }
 
 func (s *AppInstRefs) HasFields() bool {
@@ -3777,6 +3857,15 @@ func (m *AppInstRefs) Size() (n int) {
 			n += mapEntrySize + 1 + sovRefs(uint64(mapEntrySize))
 		}
 	}
+	if len(m.RolloutInsts) > 0 {
+		for k, v := range m.RolloutInsts {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovRefs(uint64(len(k))) + 1 + l + sovRefs(uint64(l))
+			n += mapEntrySize + 1 + sovRefs(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -4815,6 +4904,135 @@ func (m *AppInstRefs) Unmarshal(dAtA []byte) error {
 			}
 			m.DeleteRequestedInsts[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutInsts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutInsts == nil {
+				m.RolloutInsts = make(map[string]RolloutStatus)
+			}
+			var mapkey string
+			mapvalue := &RolloutStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRefs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRefs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRefs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRefs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRefs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRefs
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRefs
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RolloutStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRefs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRefs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RolloutInsts[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRefs(dAtA[iNdEx:])
@@ -21,6 +21,9 @@ import (
 
 const DefaultNodePoolName = "defaultpool"
 
+// Kubernetes limits the HPA stabilization window to one hour
+const MaxHorizontalScaleStabilizationWindowSec = 3600
+
 func (s *NodeResources) Validate() error {
 	if s == nil {
 		return errors.New("missing node resources")
@@ -87,6 +90,28 @@ func (s *KubernetesResources) Validate() error {
 	return nil
 }
 
+func (s *HorizontalScalePolicy) Validate() error {
+	if s.MinReplicas == 0 {
+		return errors.New("min replicas must be greater than 0")
+	}
+	if s.MaxReplicas < s.MinReplicas {
+		return errors.New("max replicas must be greater than or equal to min replicas")
+	}
+	if s.TargetCpu > 100 {
+		return errors.New("target cpu must be between 1 and 100")
+	}
+	if s.TargetMem > 100 {
+		return errors.New("target mem must be between 1 and 100")
+	}
+	if s.StabilizationWindowSec > MaxHorizontalScaleStabilizationWindowSec {
+		return fmt.Errorf("stabilization window cannot exceed %d seconds", MaxHorizontalScaleStabilizationWindowSec)
+	}
+	if s.TargetCpu == 0 && s.TargetMem == 0 && s.TargetActiveConnections == 0 {
+		return errors.New("one of target cpu, target mem, or target active connections must be specified")
+	}
+	return nil
+}
+
 func (s *Flavor) ToNodeResources() *NodeResources {
 	return &NodeResources{
 		Vcpus:     s.Vcpus,
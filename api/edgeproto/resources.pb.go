@@ -117,6 +117,59 @@ func (m *KubernetesResources) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KubernetesResources proto.InternalMessageInfo
 
+// HorizontalScalePolicy defines how the pods of a Kubernetes
+// application are scaled horizontally. When set, the Kubernetes
+// resources of the instance specify the resources for a single
+// replica, and resources for the maximum number of replicas
+// are reserved in the cluster.
+type HorizontalScalePolicy struct {
+	// Minimum number of replicas
+	MinReplicas uint32 `protobuf:"varint,1,opt,name=min_replicas,json=minReplicas,proto3" json:"min_replicas,omitempty"`
+	// Maximum number of replicas
+	MaxReplicas uint32 `protobuf:"varint,2,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`
+	// Target average cpu utilization of pod requests (percentage 1 to 100), 0 means disabled
+	TargetCpu uint32 `protobuf:"varint,3,opt,name=target_cpu,json=targetCpu,proto3" json:"target_cpu,omitempty"`
+	// Target average memory utilization of pod requests (percentage 1 to 100), 0 means disabled
+	TargetMem uint32 `protobuf:"varint,4,opt,name=target_mem,json=targetMem,proto3" json:"target_mem,omitempty"`
+	// Target average number of active connections per pod, 0 means disabled. This is an external metric, and requires an external metrics adapter in the cluster that serves the load balancer's envoy_cluster_upstream_cx_active metric, which is not deployed by the platform. Without the adapter, the autoscaler cannot scale on this target.
+	TargetActiveConnections uint64 `protobuf:"varint,5,opt,name=target_active_connections,json=targetActiveConnections,proto3" json:"target_active_connections,omitempty"`
+	// Scale down stabilization window in seconds, 0 uses the Kubernetes default
+	StabilizationWindowSec uint32 `protobuf:"varint,6,opt,name=stabilization_window_sec,json=stabilizationWindowSec,proto3" json:"stabilization_window_sec,omitempty"`
+}
+
+func (m *HorizontalScalePolicy) Reset()         { *m = HorizontalScalePolicy{} }
+func (m *HorizontalScalePolicy) String() string { return proto.CompactTextString(m) }
+func (*HorizontalScalePolicy) ProtoMessage()    {}
+func (*HorizontalScalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{2}
+}
+func (m *HorizontalScalePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HorizontalScalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HorizontalScalePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HorizontalScalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HorizontalScalePolicy.Merge(m, src)
+}
+func (m *HorizontalScalePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *HorizontalScalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HorizontalScalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HorizontalScalePolicy proto.InternalMessageInfo
+
 // NodePoolResources defines the total resource and topology requirements for a Kubernetes node pool.
 type NodePoolResources struct {
 	// Total Vcpus to be allocated in the pool, in increments of 0.001
@@ -136,7 +189,7 @@ func (m *NodePoolResources) Reset()         { *m = NodePoolResources{} }
 func (m *NodePoolResources) String() string { return proto.CompactTextString(m) }
 func (*NodePoolResources) ProtoMessage()    {}
 func (*NodePoolResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{2}
+	return fileDescriptor_cf1b13971fe4c19d, []int{3}
 }
 func (m *NodePoolResources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +236,7 @@ func (m *NodePoolTopology) Reset()         { *m = NodePoolTopology{} }
 func (m *NodePoolTopology) String() string { return proto.CompactTextString(m) }
 func (*NodePoolTopology) ProtoMessage()    {}
 func (*NodePoolTopology) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{3}
+	return fileDescriptor_cf1b13971fe4c19d, []int{4}
 }
 func (m *NodePoolTopology) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +281,7 @@ func (m *NodePool) Reset()         { *m = NodePool{} }
 func (m *NodePool) String() string { return proto.CompactTextString(m) }
 func (*NodePool) ProtoMessage()    {}
 func (*NodePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{4}
+	return fileDescriptor_cf1b13971fe4c19d, []int{5}
 }
 func (m *NodePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,6 +314,7 @@ func init() {
 	proto.RegisterType((*NodeResources)(nil), "edgeproto.NodeResources")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.NodeResources.OptResMapEntry")
 	proto.RegisterType((*KubernetesResources)(nil), "edgeproto.KubernetesResources")
+	proto.RegisterType((*HorizontalScalePolicy)(nil), "edgeproto.HorizontalScalePolicy")
 	proto.RegisterType((*NodePoolResources)(nil), "edgeproto.NodePoolResources")
 	proto.RegisterMapType((map[string]string)(nil), "edgeproto.NodePoolResources.TotalOptResEntry")
 	proto.RegisterType((*NodePoolTopology)(nil), "edgeproto.NodePoolTopology")
@@ -271,52 +325,61 @@ func init() {
 func init() { proto.RegisterFile("resources.proto", fileDescriptor_cf1b13971fe4c19d) }
 
 var fileDescriptor_cf1b13971fe4c19d = []byte{
-	// 713 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
-	0x14, 0x8d, 0x43, 0xe0, 0x4b, 0xae, 0x31, 0x84, 0x21, 0xfa, 0x64, 0x05, 0x70, 0x69, 0x54, 0xb5,
-	0x6c, 0x30, 0x55, 0x8a, 0x28, 0xaa, 0xfa, 0x27, 0xd4, 0x9f, 0x45, 0x05, 0xb4, 0x2e, 0xa5, 0x4b,
-	0xcb, 0x71, 0x26, 0x96, 0x85, 0xed, 0xb1, 0xfc, 0x13, 0x35, 0x3c, 0x45, 0x97, 0x7d, 0x84, 0x3e,
-	0x44, 0xbb, 0x67, 0x55, 0xb1, 0xec, 0xaa, 0x2a, 0xf0, 0x0a, 0x7d, 0x80, 0x6a, 0xee, 0x4c, 0x4c,
-	0x02, 0x2d, 0x2a, 0xbb, 0x3b, 0xf7, 0x9e, 0x73, 0xef, 0x9d, 0xe3, 0xe3, 0x81, 0xd9, 0x84, 0xa6,
-	0x2c, 0x4f, 0x5c, 0x9a, 0x9a, 0x71, 0xc2, 0x32, 0x46, 0x6a, 0xb4, 0xeb, 0x51, 0x0c, 0x9b, 0x0d,
-	0x8f, 0x79, 0x0c, 0xc3, 0x35, 0x1e, 0x09, 0x40, 0x53, 0xeb, 0x52, 0xd7, 0x0f, 0x9d, 0x40, 0x1e,
-	0x97, 0x32, 0xc6, 0x82, 0x74, 0x0d, 0x0f, 0x1e, 0x8d, 0x8a, 0x40, 0x94, 0x5b, 0x5f, 0xca, 0xa0,
-	0xed, 0xb0, 0x2e, 0xb5, 0x86, 0x63, 0x48, 0x03, 0x26, 0xfb, 0x6e, 0x9c, 0xa7, 0xba, 0xb2, 0xac,
-	0xac, 0x54, 0x2c, 0x71, 0x20, 0x75, 0x98, 0x48, 0x9c, 0x50, 0x2f, 0x63, 0x8e, 0x87, 0x84, 0x40,
-	0xa5, 0xeb, 0xa7, 0x07, 0xfa, 0x04, 0xa6, 0x30, 0x26, 0x2f, 0x41, 0x65, 0x71, 0x66, 0x27, 0x34,
-	0xb5, 0x43, 0x27, 0xd6, 0x2b, 0xcb, 0x13, 0x2b, 0x6a, 0xfb, 0x8e, 0x59, 0xac, 0x6c, 0x8e, 0x8d,
-	0x32, 0x77, 0xe3, 0xcc, 0xa2, 0xe9, 0xb6, 0x13, 0x3f, 0x8f, 0xb2, 0x64, 0x60, 0xd5, 0xd8, 0xf0,
-	0x4c, 0xee, 0xc2, 0x9c, 0x1f, 0xf5, 0x12, 0xc7, 0x8e, 0x58, 0x97, 0xda, 0xbd, 0xc0, 0xe9, 0xb3,
-	0x44, 0x9f, 0x5c, 0x56, 0x56, 0x6a, 0x5b, 0x95, 0xcf, 0xbf, 0x74, 0xc5, 0x9a, 0xc5, 0x32, 0x6f,
-	0xf7, 0x02, 0x8b, 0x64, 0x03, 0x1a, 0xf4, 0x43, 0x46, 0x93, 0xc8, 0x09, 0xec, 0x3e, 0x0b, 0xf2,
-	0x90, 0xda, 0xa9, 0x7f, 0x48, 0xf5, 0x29, 0xbe, 0x9e, 0x24, 0x91, 0x21, 0x62, 0x1f, 0x01, 0x6f,
-	0xfd, 0x43, 0xda, 0x7c, 0x08, 0x33, 0xe3, 0x6b, 0xf0, 0xab, 0x1e, 0xd0, 0x01, 0x5e, 0xbf, 0x66,
-	0xf1, 0x10, 0x25, 0x71, 0x82, 0x9c, 0xe2, 0xf5, 0x6b, 0x96, 0x38, 0x3c, 0x28, 0x6f, 0x2a, 0xad,
-	0xaf, 0x0a, 0xcc, 0xbf, 0xca, 0x3b, 0x34, 0x89, 0x68, 0x46, 0xd3, 0x73, 0x11, 0xef, 0x43, 0xd5,
-	0x8d, 0x73, 0x3b, 0x66, 0x2c, 0xc0, 0x46, 0x6a, 0x7b, 0xf1, 0x82, 0x0a, 0xaf, 0x19, 0x0b, 0x0a,
-	0xbc, 0xf5, 0x9f, 0x1b, 0xe7, 0x3c, 0xc3, 0x89, 0xde, 0x90, 0x58, 0xfe, 0x17, 0xa2, 0x27, 0x89,
-	0xeb, 0xf0, 0x7f, 0xe8, 0x47, 0xf6, 0x41, 0xb1, 0x8c, 0xdd, 0xa7, 0x49, 0xea, 0xb3, 0x48, 0xaf,
-	0xe0, 0xd2, 0x8d, 0xd0, 0x8f, 0xce, 0x37, 0xdd, 0x17, 0xb5, 0xd6, 0x49, 0x19, 0xe6, 0x2e, 0x35,
-	0x25, 0x9b, 0xa0, 0x66, 0x2c, 0xe3, 0x42, 0x16, 0x46, 0x50, 0xdb, 0x73, 0x23, 0x7b, 0xbc, 0xeb,
-	0x52, 0x77, 0x63, 0x7d, 0xab, 0x72, 0xf4, 0xe3, 0x46, 0xc9, 0x02, 0xc4, 0xee, 0xa3, 0x4d, 0x6e,
-	0xc2, 0xb4, 0x60, 0x86, 0x34, 0x64, 0xc9, 0x40, 0xfa, 0x45, 0x74, 0xdb, 0xc6, 0x14, 0x59, 0x02,
-	0x41, 0xb0, 0x47, 0xdc, 0x53, 0xc3, 0xcc, 0x33, 0x6e, 0xa1, 0x37, 0xa0, 0x89, 0xb2, 0x34, 0x92,
-	0x34, 0xd1, 0xea, 0x55, 0x2a, 0x98, 0x7b, 0x9c, 0x21, 0x3e, 0xa3, 0xb0, 0x92, 0x98, 0x28, 0x32,
-	0xe4, 0x11, 0x54, 0x33, 0x16, 0xb3, 0x80, 0x79, 0x03, 0xf4, 0x90, 0xda, 0x5e, 0xf8, 0x43, 0xb7,
-	0x3d, 0x09, 0x91, 0xb7, 0x2a, 0x28, 0xcd, 0xc7, 0x50, 0xbf, 0xd8, 0xff, 0x5a, 0x1e, 0xf9, 0x56,
-	0x86, 0xfa, 0xc5, 0x21, 0xe4, 0x16, 0xcc, 0xf0, 0xcf, 0x85, 0xf6, 0x1e, 0xfd, 0xdd, 0xa6, 0x43,
-	0x3f, 0xe2, 0x60, 0x21, 0xe7, 0x6d, 0x98, 0x2d, 0x50, 0x63, 0x8a, 0x6a, 0x12, 0x26, 0x35, 0x6d,
-	0x81, 0x56, 0xe0, 0x46, 0x64, 0x55, 0x25, 0x0a, 0x85, 0x7d, 0x0f, 0xf5, 0x02, 0x33, 0xae, 0xad,
-	0x79, 0x85, 0x1a, 0xe6, 0xb6, 0x68, 0x31, 0x2a, 0xee, 0x70, 0xb8, 0x94, 0x77, 0x15, 0xe6, 0xb1,
-	0x71, 0x1e, 0x76, 0x68, 0x62, 0xb3, 0x1e, 0x8e, 0x48, 0x51, 0xe9, 0x49, 0x8b, 0xcf, 0xdc, 0xc1,
-	0xca, 0x6e, 0x8f, 0x73, 0xd2, 0xe6, 0x53, 0x20, 0x97, 0x7b, 0x5e, 0x4b, 0xd0, 0x4f, 0x0a, 0x54,
-	0x87, 0x7b, 0xf2, 0x67, 0x68, 0xc7, 0x09, 0xa9, 0x64, 0x62, 0x4c, 0x16, 0xa0, 0x16, 0xe5, 0xa1,
-	0xdc, 0x83, 0xd3, 0x35, 0xab, 0x1a, 0xe5, 0x21, 0xce, 0x27, 0x4f, 0x60, 0x06, 0x35, 0x28, 0x1e,
-	0x56, 0x14, 0x4b, 0x6d, 0xeb, 0x7f, 0x7b, 0xa6, 0x2c, 0x2d, 0x1a, 0x7b, 0x20, 0x9b, 0x50, 0x4d,
-	0x5d, 0x27, 0x70, 0x3a, 0x01, 0xc5, 0x7f, 0xab, 0x6a, 0x15, 0xe7, 0xad, 0xc5, 0xa3, 0x13, 0xa3,
-	0x74, 0x74, 0x6a, 0x28, 0xc7, 0xa7, 0x86, 0xf2, 0xf3, 0xd4, 0x50, 0x3e, 0x9e, 0x19, 0xa5, 0xe3,
-	0x33, 0xa3, 0xf4, 0xfd, 0xcc, 0x28, 0x75, 0xa6, 0xb0, 0xfb, 0xbd, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0xd5, 0x94, 0x3b, 0xd5, 0x05, 0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x4e, 0xb1, 0x8f, 0xbb, 0xf9, 0x99, 0x86, 0xb2, 0xb8, 0xad, 0x49, 0x2d, 0x04,
+	0xb9, 0xa9, 0x8b, 0x4c, 0x55, 0xa2, 0x8a, 0xdf, 0x94, 0x3f, 0x09, 0x25, 0x2d, 0x93, 0x92, 0x5e,
+	0xae, 0xc6, 0xeb, 0x13, 0x6b, 0x94, 0xdd, 0x99, 0xd5, 0xfe, 0xb8, 0x71, 0x9e, 0x02, 0xee, 0x78,
+	0x04, 0x1e, 0x02, 0xee, 0x73, 0x85, 0x7a, 0xc9, 0x15, 0xa2, 0xc9, 0x2b, 0xf0, 0x00, 0x68, 0xce,
+	0x8c, 0x37, 0x76, 0x0a, 0x15, 0xbd, 0x3b, 0xe7, 0x7c, 0xdf, 0xf9, 0x99, 0xcf, 0xe7, 0xac, 0x61,
+	0x35, 0xc3, 0x5c, 0x97, 0x59, 0x84, 0x79, 0x3f, 0xcd, 0x74, 0xa1, 0x59, 0x0b, 0x47, 0x63, 0x24,
+	0xb3, 0xb3, 0x31, 0xd6, 0x63, 0x4d, 0xe6, 0x5d, 0x63, 0x59, 0x42, 0xc7, 0x1f, 0x61, 0x24, 0x13,
+	0x11, 0x3b, 0xf7, 0x56, 0xa1, 0x75, 0x9c, 0xdf, 0x25, 0x67, 0x8c, 0xaa, 0x32, 0x2c, 0xdc, 0xfb,
+	0xb5, 0x0e, 0xfe, 0x9e, 0x1e, 0x21, 0x9f, 0xb5, 0x61, 0x1b, 0xb0, 0x3c, 0x89, 0xd2, 0x32, 0x0f,
+	0xbc, 0x4d, 0x6f, 0xab, 0xc1, 0xad, 0xc3, 0xd6, 0x60, 0x29, 0x13, 0x49, 0x50, 0xa7, 0x98, 0x31,
+	0x19, 0x83, 0xc6, 0x48, 0xe6, 0x47, 0xc1, 0x12, 0x85, 0xc8, 0x66, 0xdf, 0x40, 0x5b, 0xa7, 0x45,
+	0x98, 0x61, 0x1e, 0x26, 0x22, 0x0d, 0x1a, 0x9b, 0x4b, 0x5b, 0xed, 0xc1, 0xfb, 0xfd, 0x6a, 0xe4,
+	0xfe, 0x42, 0xab, 0xfe, 0xa3, 0xb4, 0xe0, 0x98, 0xef, 0x8a, 0xf4, 0x2b, 0x55, 0x64, 0x53, 0xde,
+	0xd2, 0x33, 0x9f, 0x7d, 0x00, 0xeb, 0x52, 0x1d, 0x66, 0x22, 0x54, 0x7a, 0x84, 0xe1, 0x61, 0x2c,
+	0x26, 0x3a, 0x0b, 0x96, 0x37, 0xbd, 0xad, 0xd6, 0x4e, 0xe3, 0x97, 0xbf, 0x03, 0x8f, 0xaf, 0x12,
+	0x6c, 0xca, 0x7d, 0x4d, 0x20, 0xbb, 0x0f, 0x1b, 0x78, 0x5c, 0x60, 0xa6, 0x44, 0x1c, 0x4e, 0x74,
+	0x5c, 0x26, 0x18, 0xe6, 0xf2, 0x04, 0x83, 0x2b, 0x66, 0x3c, 0x97, 0xc4, 0x66, 0x8c, 0x03, 0x22,
+	0xec, 0xcb, 0x13, 0xec, 0x7c, 0x0c, 0x2b, 0x8b, 0x63, 0x98, 0xa7, 0x1e, 0xe1, 0x94, 0x9e, 0xdf,
+	0xe2, 0xc6, 0x24, 0x49, 0x44, 0x5c, 0x22, 0x3d, 0xbf, 0xc5, 0xad, 0xf3, 0xa0, 0xbe, 0xed, 0xf5,
+	0x7e, 0xf3, 0xe0, 0xda, 0x77, 0xe5, 0x10, 0x33, 0x85, 0x05, 0xe6, 0x17, 0x22, 0x7e, 0x04, 0xcd,
+	0x28, 0x2d, 0xc3, 0x54, 0xeb, 0x98, 0x0a, 0xb5, 0x07, 0x37, 0x2f, 0xa9, 0xf0, 0x58, 0xeb, 0xb8,
+	0xe2, 0xf3, 0x37, 0xa2, 0xb4, 0x34, 0x11, 0x93, 0x38, 0x9e, 0x25, 0xd6, 0xff, 0x4f, 0xe2, 0xd8,
+	0x25, 0xde, 0x83, 0xeb, 0x89, 0x54, 0xe1, 0x51, 0x35, 0x4c, 0x38, 0xc1, 0x2c, 0x97, 0x5a, 0x05,
+	0x0d, 0x1a, 0x7a, 0x23, 0x91, 0xea, 0x62, 0xd2, 0x03, 0x8b, 0xf5, 0x7e, 0xaa, 0xc3, 0x9b, 0xdf,
+	0xea, 0x4c, 0x9e, 0x68, 0x55, 0x88, 0x78, 0x3f, 0x12, 0x31, 0x3e, 0xd6, 0xb1, 0x8c, 0xa6, 0xec,
+	0x36, 0x5c, 0x35, 0xf5, 0x32, 0x4c, 0x63, 0x19, 0x09, 0xbb, 0x0d, 0x3e, 0x6f, 0x27, 0x52, 0x71,
+	0x17, 0x22, 0x8a, 0x38, 0xbe, 0xa0, 0xd4, 0x1d, 0x45, 0x1c, 0x57, 0x94, 0x5b, 0x00, 0x85, 0xc8,
+	0xc6, 0x58, 0x84, 0x51, 0x5a, 0xd2, 0xaa, 0xf8, 0xbc, 0x65, 0x23, 0x0f, 0xd3, 0x72, 0x0e, 0x4e,
+	0x30, 0xa1, 0x41, 0x2b, 0x78, 0x17, 0x13, 0xf6, 0x00, 0xde, 0x76, 0xb0, 0x88, 0x0a, 0x39, 0xc1,
+	0x30, 0xd2, 0x4a, 0x61, 0x54, 0x48, 0xad, 0x72, 0xda, 0x86, 0x06, 0x7f, 0xcb, 0x12, 0xbe, 0x20,
+	0xfc, 0xe1, 0x05, 0xcc, 0xb6, 0x21, 0xc8, 0x0b, 0x31, 0x94, 0xb1, 0x3c, 0x11, 0x26, 0x12, 0x3e,
+	0x93, 0x6a, 0xa4, 0x9f, 0x85, 0x39, 0x46, 0xb4, 0x13, 0x3e, 0xbf, 0xbe, 0x80, 0x3f, 0x25, 0x78,
+	0x1f, 0xa3, 0xde, 0x8b, 0x3a, 0xac, 0xbf, 0x24, 0x34, 0xdb, 0x86, 0x76, 0xa1, 0x0b, 0xb3, 0x5c,
+	0xd5, 0x71, 0xb4, 0x07, 0xeb, 0x73, 0xbf, 0xcd, 0x0f, 0x23, 0x8c, 0xee, 0xdf, 0xdb, 0x69, 0x9c,
+	0xfe, 0xf9, 0x4e, 0x8d, 0x03, 0x71, 0x0f, 0xe8, 0x74, 0x6e, 0xc3, 0x55, 0x9b, 0x99, 0x60, 0xa2,
+	0xb3, 0xa9, 0xbb, 0x21, 0x5b, 0x6d, 0x97, 0x42, 0xa4, 0x03, 0x51, 0xe6, 0x2e, 0xaa, 0x45, 0x91,
+	0x2f, 0xcd, 0x59, 0x7d, 0x0f, 0xbe, 0x85, 0xdd, 0x71, 0xb9, 0xc3, 0xba, 0xf3, 0xaa, 0xcd, 0xe8,
+	0x3f, 0x31, 0x19, 0x76, 0xb5, 0xed, 0x79, 0xd9, 0x8e, 0x36, 0xc2, 0x3e, 0x81, 0x66, 0xa1, 0x53,
+	0x1d, 0xeb, 0xf1, 0x94, 0x94, 0x6c, 0x0f, 0x6e, 0xfc, 0x4b, 0xb5, 0x27, 0x8e, 0xe2, 0x5e, 0x55,
+	0xa5, 0x74, 0x3e, 0x85, 0xb5, 0xcb, 0xf5, 0x5f, 0xeb, 0x6e, 0x7e, 0xaf, 0xc3, 0xda, 0xe5, 0x26,
+	0xec, 0x5d, 0x58, 0x31, 0x2b, 0x47, 0x27, 0x3f, 0xff, 0x09, 0x32, 0x8b, 0x68, 0xc8, 0x56, 0xce,
+	0xf7, 0x60, 0xb5, 0x62, 0x2d, 0x28, 0xea, 0x3b, 0x9a, 0xd3, 0xb4, 0x07, 0x7e, 0xc5, 0x9b, 0x93,
+	0xb5, 0xed, 0x58, 0x24, 0xec, 0x53, 0x58, 0xab, 0x38, 0x8b, 0xda, 0xf6, 0x5f, 0xa1, 0x46, 0x7f,
+	0xd7, 0x96, 0x98, 0x17, 0x77, 0xd6, 0xdc, 0xc9, 0x7b, 0x07, 0xae, 0x51, 0xe1, 0x32, 0x19, 0x62,
+	0x16, 0xea, 0x43, 0x6a, 0x61, 0x77, 0x76, 0x99, 0x9b, 0x9e, 0x7b, 0x84, 0x3c, 0x3a, 0x34, 0x39,
+	0x79, 0xe7, 0x73, 0x60, 0x2f, 0xd7, 0x7c, 0x2d, 0x41, 0x7f, 0xf6, 0xa0, 0x39, 0x9b, 0xd3, 0x7c,
+	0x9a, 0xf7, 0x44, 0x82, 0x2e, 0x93, 0x6c, 0x76, 0x03, 0x5a, 0xaa, 0x4c, 0xdc, 0x1c, 0xf6, 0x52,
+	0x9b, 0xaa, 0x4c, 0xa8, 0x3f, 0xfb, 0x0c, 0x56, 0x48, 0x83, 0xea, 0xcf, 0x86, 0xc4, 0x6a, 0x0f,
+	0x82, 0xff, 0xfa, 0x74, 0x73, 0x5f, 0x2d, 0xfc, 0x69, 0x74, 0xa0, 0x99, 0x47, 0x22, 0x16, 0xc3,
+	0x18, 0xe9, 0x8c, 0x9b, 0xbc, 0xf2, 0x77, 0x6e, 0x9e, 0xbe, 0xe8, 0xd6, 0x4e, 0xcf, 0xba, 0xde,
+	0xf3, 0xb3, 0xae, 0xf7, 0xd7, 0x59, 0xd7, 0xfb, 0xf1, 0xbc, 0x5b, 0x7b, 0x7e, 0xde, 0xad, 0xfd,
+	0x71, 0xde, 0xad, 0x0d, 0xaf, 0x50, 0xf5, 0x0f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x66, 0xe3,
+	0xfa, 0x2c, 0xe9, 0x06, 0x00, 0x00,
 }
 
 func (m *NodeResources) Marshal() (dAtA []byte, err error) {
@@ -442,6 +505,59 @@ func (m *KubernetesResources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HorizontalScalePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HorizontalScalePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HorizontalScalePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StabilizationWindowSec != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.StabilizationWindowSec))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetActiveConnections != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.TargetActiveConnections))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetMem != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.TargetMem))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetCpu != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.TargetCpu))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxReplicas != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.MaxReplicas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinReplicas != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.MinReplicas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NodePoolResources) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -958,6 +1074,58 @@ func (s *KubernetesResources) ClearTagged(tags map[string]struct{}) {
 	}
 }
 
+func (m *HorizontalScalePolicy) Clone() *HorizontalScalePolicy {
+	cp := &HorizontalScalePolicy{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *HorizontalScalePolicy) CopyInFields(src *HorizontalScalePolicy) int {
+	changed := 0
+	if m.MinReplicas != src.MinReplicas {
+		m.MinReplicas = src.MinReplicas
+		changed++
+	}
+	if m.MaxReplicas != src.MaxReplicas {
+		m.MaxReplicas = src.MaxReplicas
+		changed++
+	}
+	if m.TargetCpu != src.TargetCpu {
+		m.TargetCpu = src.TargetCpu
+		changed++
+	}
+	if m.TargetMem != src.TargetMem {
+		m.TargetMem = src.TargetMem
+		changed++
+	}
+	if m.TargetActiveConnections != src.TargetActiveConnections {
+		m.TargetActiveConnections = src.TargetActiveConnections
+		changed++
+	}
+	if m.StabilizationWindowSec != src.StabilizationWindowSec {
+		m.StabilizationWindowSec = src.StabilizationWindowSec
+		changed++
+	}
+	return changed
+}
+
+func (m *HorizontalScalePolicy) DeepCopyIn(src *HorizontalScalePolicy) {
+	m.MinReplicas = src.MinReplicas
+	m.MaxReplicas = src.MaxReplicas
+	m.TargetCpu = src.TargetCpu
+	m.TargetMem = src.TargetMem
+	m.TargetActiveConnections = src.TargetActiveConnections
+	m.StabilizationWindowSec = src.StabilizationWindowSec
+}
+
+// Helper method to check that enums have valid values
+func (m *HorizontalScalePolicy) ValidateEnums() error {
+	return nil
+}
+
+func (s *HorizontalScalePolicy) ClearTagged(tags map[string]struct{}) {
+}
+
 func (m *NodePoolResources) Clone() *NodePoolResources {
 	cp := &NodePoolResources{}
 	cp.DeepCopyIn(m)
@@ -1315,6 +1483,33 @@ func (m *KubernetesResources) Size() (n int) {
 	return n
 }
 
+func (m *HorizontalScalePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinReplicas != 0 {
+		n += 1 + sovResources(uint64(m.MinReplicas))
+	}
+	if m.MaxReplicas != 0 {
+		n += 1 + sovResources(uint64(m.MaxReplicas))
+	}
+	if m.TargetCpu != 0 {
+		n += 1 + sovResources(uint64(m.TargetCpu))
+	}
+	if m.TargetMem != 0 {
+		n += 1 + sovResources(uint64(m.TargetMem))
+	}
+	if m.TargetActiveConnections != 0 {
+		n += 1 + sovResources(uint64(m.TargetActiveConnections))
+	}
+	if m.StabilizationWindowSec != 0 {
+		n += 1 + sovResources(uint64(m.StabilizationWindowSec))
+	}
+	return n
+}
+
 func (m *NodePoolResources) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1839,6 +2034,170 @@ func (m *KubernetesResources) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HorizontalScalePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HorizontalScalePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HorizontalScalePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReplicas", wireType)
+			}
+			m.MinReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			m.MaxReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCpu", wireType)
+			}
+			m.TargetCpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetCpu |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetMem", wireType)
+			}
+			m.TargetMem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetMem |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetActiveConnections", wireType)
+			}
+			m.TargetActiveConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetActiveConnections |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilizationWindowSec", wireType)
+			}
+			m.StabilizationWindowSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StabilizationWindowSec |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NodePoolResources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
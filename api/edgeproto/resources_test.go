@@ -77,3 +77,43 @@ func TestNodePoolResourcesValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestHorizontalScalePolicyValidate(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   HorizontalScalePolicy
+		err  string
+	}{{
+		desc: "min replicas gt 0",
+		in:   HorizontalScalePolicy{MaxReplicas: 2, TargetCpu: 50},
+		err:  "min replicas must be greater than 0",
+	}, {
+		desc: "max lt min",
+		in:   HorizontalScalePolicy{MinReplicas: 3, MaxReplicas: 2, TargetCpu: 50},
+		err:  "max replicas must be greater than or equal to min replicas",
+	}, {
+		desc: "target cpu range",
+		in:   HorizontalScalePolicy{MinReplicas: 1, MaxReplicas: 2, TargetCpu: 101},
+		err:  "target cpu must be between 1 and 100",
+	}, {
+		desc: "stabilization window limit",
+		in:   HorizontalScalePolicy{MinReplicas: 1, MaxReplicas: 2, TargetMem: 50, StabilizationWindowSec: 3601},
+		err:  "stabilization window cannot exceed 3600 seconds",
+	}, {
+		desc: "no targets",
+		in:   HorizontalScalePolicy{MinReplicas: 1, MaxReplicas: 2},
+		err:  "one of target cpu, target mem, or target active connections must be specified",
+	}, {
+		desc: "valid",
+		in:   HorizontalScalePolicy{MinReplicas: 1, MaxReplicas: 1, TargetActiveConnections: 100},
+	}}
+	for _, test := range tests {
+		err := test.in.Validate()
+		if test.err == "" {
+			require.Nil(t, err, test.desc)
+		} else {
+			require.NotNil(t, err, test.desc)
+			require.Equal(t, test.err, err.Error(), test.desc)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package edgeproto
 
 import (
 	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +30,8 @@ type Result struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	// Error code, 0 indicates success, non-zero indicates failure (not implemented)
 	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Placement decisions for dry run requests
+	PlacementCandidates []PlacementCandidate `protobuf:"bytes,3,rep,name=placement_candidates,json=placementCandidates,proto3" json:"placement_candidates"`
 }
 
 func (m *Result) Reset()         { *m = Result{} }
@@ -64,23 +67,89 @@ func (m *Result) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Result proto.InternalMessageInfo
 
+// PlacementCandidate
+//
+// PlacementCandidate explains why a cloudlet or cluster was chosen or skipped for deployment
+type PlacementCandidate struct {
+	// Candidate cloudlet
+	CloudletKey CloudletKey `protobuf:"bytes,1,opt,name=cloudlet_key,json=cloudletKey,proto3" json:"cloudlet_key"`
+	// Candidate existing cluster, blank if the candidate is the cloudlet itself
+	ClusterKey ClusterKey `protobuf:"bytes,2,opt,name=cluster_key,json=clusterKey,proto3" json:"cluster_key"`
+	// Candidate was chosen for deployment
+	Chosen bool `protobuf:"varint,3,opt,name=chosen,proto3" json:"chosen,omitempty"`
+	// Reason the candidate was skipped, blank if the candidate is usable
+	SkipReason string `protobuf:"bytes,4,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	// Details of the placement decision
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	// Resource score, higher score means more available resources
+	ResourceScore uint64 `protobuf:"varint,6,opt,name=resource_score,json=resourceScore,proto3" json:"resource_score,omitempty"`
+}
+
+func (m *PlacementCandidate) Reset()         { *m = PlacementCandidate{} }
+func (m *PlacementCandidate) String() string { return proto.CompactTextString(m) }
+func (*PlacementCandidate) ProtoMessage()    {}
+func (*PlacementCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feee897733d2100, []int{1}
+}
+func (m *PlacementCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlacementCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementCandidate.Merge(m, src)
+}
+func (m *PlacementCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementCandidate proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Result)(nil), "edgeproto.Result")
+	proto.RegisterType((*PlacementCandidate)(nil), "edgeproto.PlacementCandidate")
 }
 
 func init() { proto.RegisterFile("result.proto", fileDescriptor_4feee897733d2100) }
 
 var fileDescriptor_4feee897733d2100 = []byte{
-	// 139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x4a, 0x2d, 0x2e,
-	0xcd, 0x29, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4c, 0x4d, 0x49, 0x4f, 0x05, 0x33,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0xa2, 0x40, 0xc9, 0x8c, 0x8b,
-	0x2d, 0x08, 0xac, 0x41, 0x48, 0x82, 0x8b, 0x3d, 0x37, 0xb5, 0xb8, 0x38, 0x31, 0x3d, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0x15, 0x12, 0xe2, 0x62, 0x49, 0xce, 0x4f, 0x49, 0x95,
-	0x60, 0x52, 0x60, 0xd4, 0x60, 0x0d, 0x02, 0xb3, 0x9d, 0x64, 0x4e, 0x3c, 0x94, 0x63, 0x38, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x24, 0x36, 0xb0, 0xe1, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xac, 0x4f, 0xde, 0x49, 0x8d, 0x00, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0x3f, 0x6b, 0xf2, 0x40,
+	0x1c, 0xce, 0x69, 0xcc, 0xfb, 0x7a, 0xd1, 0x42, 0xaf, 0x56, 0x0e, 0xa9, 0x31, 0x08, 0x85, 0x4c,
+	0x0a, 0x76, 0x2b, 0x85, 0x82, 0x8e, 0x5d, 0x4a, 0x0a, 0x5d, 0x43, 0x7a, 0xf9, 0x91, 0x8a, 0x31,
+	0x17, 0x72, 0x97, 0xc1, 0x0f, 0x51, 0xe8, 0xde, 0x2f, 0xe4, 0xe8, 0xd8, 0xa9, 0xb4, 0xf1, 0x43,
+	0x74, 0x2d, 0x39, 0x13, 0x6d, 0x71, 0x7b, 0xfe, 0xdc, 0xc3, 0xf3, 0xbb, 0x07, 0xb7, 0x52, 0x10,
+	0x59, 0x24, 0x47, 0x49, 0xca, 0x25, 0x27, 0x4d, 0x08, 0x42, 0x50, 0xb0, 0xd7, 0x09, 0x79, 0xc8,
+	0x15, 0x1c, 0x17, 0x68, 0xf7, 0xa0, 0x77, 0xca, 0x22, 0x9e, 0x05, 0x11, 0xc8, 0x05, 0xac, 0x4a,
+	0xa9, 0xcd, 0xa2, 0x4c, 0x48, 0x48, 0x4b, 0xda, 0x97, 0x9c, 0x47, 0x62, 0xac, 0x48, 0x08, 0xf1,
+	0x1e, 0xec, 0xec, 0xe1, 0x1b, 0xc2, 0x86, 0xab, 0x2a, 0x09, 0xc5, 0xff, 0x96, 0x20, 0x84, 0x1f,
+	0x02, 0x45, 0x36, 0x72, 0x9a, 0x6e, 0x45, 0x09, 0xc1, 0x3a, 0xe3, 0x01, 0xd0, 0x9a, 0x8d, 0x9c,
+	0x86, 0xab, 0x30, 0x79, 0xc4, 0x9d, 0x24, 0xf2, 0x19, 0x2c, 0x21, 0x96, 0x1e, 0xf3, 0xe3, 0x60,
+	0x1e, 0xf8, 0x12, 0x04, 0xad, 0xdb, 0x75, 0xc7, 0x9c, 0xf4, 0x47, 0xfb, 0xcb, 0x47, 0xf7, 0xd5,
+	0xb3, 0x59, 0xf5, 0x6a, 0xaa, 0xaf, 0x3f, 0x06, 0x9a, 0x7b, 0x96, 0x1c, 0x39, 0xe2, 0xda, 0xcc,
+	0xbf, 0x69, 0x55, 0x3c, 0x7c, 0xa9, 0x61, 0x72, 0x1c, 0x27, 0xb7, 0xb8, 0x55, 0xfd, 0xdb, 0x5b,
+	0xc0, 0x4a, 0x9d, 0x6b, 0x4e, 0xba, 0xbf, 0x3a, 0x67, 0xa5, 0x7d, 0x07, 0xab, 0xb2, 0xcc, 0x64,
+	0x07, 0x89, 0xdc, 0x60, 0xb3, 0x5c, 0x49, 0xe5, 0x6b, 0x2a, 0x7f, 0xfe, 0x27, 0xaf, 0xdc, 0x43,
+	0x1c, 0xb3, 0xbd, 0x42, 0xba, 0xd8, 0x60, 0xcf, 0x5c, 0x40, 0x4c, 0xeb, 0x36, 0x72, 0xfe, 0xbb,
+	0x25, 0x23, 0x03, 0x6c, 0x8a, 0xc5, 0x3c, 0xf1, 0x52, 0xf0, 0x05, 0x8f, 0xa9, 0xae, 0x46, 0xc4,
+	0x85, 0xe4, 0x2a, 0xa5, 0x58, 0x38, 0x00, 0xe9, 0xcf, 0x23, 0x41, 0x1b, 0xbb, 0x85, 0x4b, 0x4a,
+	0x2e, 0xf1, 0x49, 0x0a, 0x82, 0x67, 0x29, 0x03, 0x4f, 0x30, 0x9e, 0x02, 0x35, 0x6c, 0xe4, 0xe8,
+	0x6e, 0xbb, 0x52, 0x1f, 0x0a, 0x71, 0x7a, 0xb1, 0xfe, 0xb2, 0xb4, 0x75, 0x6e, 0xa1, 0x4d, 0x6e,
+	0xa1, 0xcf, 0xdc, 0x42, 0xaf, 0x5b, 0x4b, 0xdb, 0x6c, 0x2d, 0xed, 0x7d, 0x6b, 0x69, 0x4f, 0x86,
+	0xba, 0xfd, 0xea, 0x27, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x3a, 0xe5, 0xf8, 0x44, 0x02, 0x00, 0x00,
 }
 
 func (m *Result) Marshal() (dAtA []byte, err error) {
@@ -103,6 +172,20 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PlacementCandidates) > 0 {
+		for iNdEx := len(m.PlacementCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlacementCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Code != 0 {
 		i = encodeVarintResult(dAtA, i, uint64(m.Code))
 		i--
@@ -118,6 +201,78 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PlacementCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlacementCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceScore != 0 {
+		i = encodeVarintResult(dAtA, i, uint64(m.ResourceScore))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintResult(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SkipReason) > 0 {
+		i -= len(m.SkipReason)
+		copy(dAtA[i:], m.SkipReason)
+		i = encodeVarintResult(dAtA, i, uint64(len(m.SkipReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Chosen {
+		i--
+		if m.Chosen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ClusterKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CloudletKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintResult(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintResult(dAtA []byte, offset int, v uint64) int {
 	offset -= sovResult(v)
 	base := offset
@@ -129,13 +284,51 @@ func encodeVarintResult(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+
+// TableColumns are the default columns for table output
+func (m *Result) TableColumns() []string {
+	return []string{"message"}
+}
+
 func (m *Result) Clone() *Result {
 	cp := &Result{}
 	cp.DeepCopyIn(m)
 	return cp
 }
 
+func (m *Result) AddPlacementCandidates(vals ...PlacementCandidate) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.PlacementCandidates {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.PlacementCandidates = append(m.PlacementCandidates, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *Result) RemovePlacementCandidates(vals ...PlacementCandidate) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.PlacementCandidates); i >= 0; i-- {
+		if _, found := remove[m.PlacementCandidates[i].String()]; found {
+			m.PlacementCandidates = append(m.PlacementCandidates[:i], m.PlacementCandidates[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
 func (m *Result) CopyInFields(src *Result) int {
+	updateListAction := "replace"
 	changed := 0
 	if m.Message != src.Message {
 		m.Message = src.Message
@@ -145,20 +338,126 @@ func (m *Result) CopyInFields(src *Result) int {
 		m.Code = src.Code
 		changed++
 	}
+	if src.PlacementCandidates != nil {
+		if updateListAction == "add" {
+			changed += m.AddPlacementCandidates(src.PlacementCandidates...)
+		} else if updateListAction == "remove" {
+			changed += m.RemovePlacementCandidates(src.PlacementCandidates...)
+		} else {
+			m.PlacementCandidates = make([]PlacementCandidate, 0)
+			for k0, _ := range src.PlacementCandidates {
+				m.PlacementCandidates = append(m.PlacementCandidates, *src.PlacementCandidates[k0].Clone())
+			}
+			changed++
+		}
+	} else if m.PlacementCandidates != nil {
+		m.PlacementCandidates = nil
+		changed++
+	}
 	return changed
 }
 
 func (m *Result) DeepCopyIn(src *Result) {
 	m.Message = src.Message
 	m.Code = src.Code
+	if src.PlacementCandidates != nil {
+		m.PlacementCandidates = make([]PlacementCandidate, len(src.PlacementCandidates), len(src.PlacementCandidates))
+		for ii, s := range src.PlacementCandidates {
+			m.PlacementCandidates[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.PlacementCandidates = nil
+	}
 }
 
 // Helper method to check that enums have valid values
 func (m *Result) ValidateEnums() error {
+	for _, e := range m.PlacementCandidates {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (s *Result) ClearTagged(tags map[string]struct{}) {
+	if s.PlacementCandidates != nil {
+		for ii := 0; ii < len(s.PlacementCandidates); ii++ {
+			s.PlacementCandidates[ii].ClearTagged(tags)
+		}
+	}
+}
+
+func (m *PlacementCandidate) Clone() *PlacementCandidate {
+	cp := &PlacementCandidate{}
+	cp.DeepCopyIn(m)
+	return cp
+}
+
+func (m *PlacementCandidate) CopyInFields(src *PlacementCandidate) int {
+	changed := 0
+	if m.CloudletKey.Organization != src.CloudletKey.Organization {
+		m.CloudletKey.Organization = src.CloudletKey.Organization
+		changed++
+	}
+	if m.CloudletKey.Name != src.CloudletKey.Name {
+		m.CloudletKey.Name = src.CloudletKey.Name
+		changed++
+	}
+	if m.CloudletKey.FederatedOrganization != src.CloudletKey.FederatedOrganization {
+		m.CloudletKey.FederatedOrganization = src.CloudletKey.FederatedOrganization
+		changed++
+	}
+	if m.ClusterKey.Name != src.ClusterKey.Name {
+		m.ClusterKey.Name = src.ClusterKey.Name
+		changed++
+	}
+	if m.ClusterKey.Organization != src.ClusterKey.Organization {
+		m.ClusterKey.Organization = src.ClusterKey.Organization
+		changed++
+	}
+	if m.Chosen != src.Chosen {
+		m.Chosen = src.Chosen
+		changed++
+	}
+	if m.SkipReason != src.SkipReason {
+		m.SkipReason = src.SkipReason
+		changed++
+	}
+	if m.Details != src.Details {
+		m.Details = src.Details
+		changed++
+	}
+	if m.ResourceScore != src.ResourceScore {
+		m.ResourceScore = src.ResourceScore
+		changed++
+	}
+	return changed
+}
+
+func (m *PlacementCandidate) DeepCopyIn(src *PlacementCandidate) {
+	m.CloudletKey.DeepCopyIn(&src.CloudletKey)
+	m.ClusterKey.DeepCopyIn(&src.ClusterKey)
+	m.Chosen = src.Chosen
+	m.SkipReason = src.SkipReason
+	m.Details = src.Details
+	m.ResourceScore = src.ResourceScore
+}
+
+// Helper method to check that enums have valid values
+func (m *PlacementCandidate) ValidateEnums() error {
+	if err := m.CloudletKey.ValidateEnums(); err != nil {
+		return err
+	}
+	if err := m.ClusterKey.ValidateEnums(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *PlacementCandidate) ClearTagged(tags map[string]struct{}) {
+	s.CloudletKey.ClearTagged(tags)
+	s.ClusterKey.ClearTagged(tags)
 }
 
 func (m *Result) Size() (n int) {
@@ -174,6 +473,39 @@ func (m *Result) Size() (n int) {
 	if m.Code != 0 {
 		n += 1 + sovResult(uint64(m.Code))
 	}
+	if len(m.PlacementCandidates) > 0 {
+		for _, e := range m.PlacementCandidates {
+			l = e.Size()
+			n += 1 + l + sovResult(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlacementCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CloudletKey.Size()
+	n += 1 + l + sovResult(uint64(l))
+	l = m.ClusterKey.Size()
+	n += 1 + l + sovResult(uint64(l))
+	if m.Chosen {
+		n += 2
+	}
+	l = len(m.SkipReason)
+	if l > 0 {
+		n += 1 + l + sovResult(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovResult(uint64(l))
+	}
+	if m.ResourceScore != 0 {
+		n += 1 + sovResult(uint64(m.ResourceScore))
+	}
 	return n
 }
 
@@ -263,6 +595,259 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementCandidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementCandidates = append(m.PlacementCandidates, PlacementCandidate{})
+			if err := m.PlacementCandidates[len(m.PlacementCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResult(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResult
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlacementCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResult
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudletKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloudletKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClusterKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chosen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Chosen = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResult
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkipReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResult
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceScore", wireType)
+			}
+			m.ResourceScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResult(dAtA[iNdEx:])
@@ -163,6 +163,8 @@ func (s *Settings) Validate(fmap objstore.FieldMap) error {
 			v.CheckGT(f, s.PlatformHaInstancePollInterval, Duration(10*time.Millisecond))
 		case SettingsFieldCcrmApiTimeout:
 			v.CheckGT(f, s.CcrmApiTimeout, dur0)
+		case SettingsFieldTrustPolicyFqdnRefreshInterval:
+			v.CheckGTE(f, s.TrustPolicyFqdnRefreshInterval, Duration(10*time.Second))
 		default:
 			// If this is a setting field (and not "fields"), ensure there is an entry in the switch
 			// above.  If no validation is to be done for a field, make an empty case entry
@@ -231,6 +233,7 @@ func GetDefaultSettings() *Settings {
 	s.PlatformHaInstanceActiveExpireTime = Duration(1 * time.Second)
 	s.PlatformHaInstancePollInterval = Duration(300 * time.Millisecond)
 	s.CcrmApiTimeout = Duration(30 * time.Second)
+	s.TrustPolicyFqdnRefreshInterval = Duration(5 * time.Minute)
 
 	return &s
 }
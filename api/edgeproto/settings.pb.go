@@ -120,6 +120,8 @@ type Settings struct {
 	PlatformHaInstanceActiveExpireTime Duration `protobuf:"varint,43,opt,name=platform_ha_instance_active_expire_time,json=platformHaInstanceActiveExpireTime,proto3,casttype=Duration" json:"platform_ha_instance_active_expire_time,omitempty"`
 	// Timeout for controller platform-specific API calls to CCRM
 	CcrmApiTimeout Duration `protobuf:"varint,44,opt,name=ccrm_api_timeout,json=ccrmApiTimeout,proto3,casttype=Duration" json:"ccrm_api_timeout,omitempty"`
+	// Trust policy remote FQDN resolution refresh interval
+	TrustPolicyFqdnRefreshInterval Duration `protobuf:"varint,45,opt,name=trust_policy_fqdn_refresh_interval,json=trustPolicyFqdnRefreshInterval,proto3,casttype=Duration" json:"trust_policy_fqdn_refresh_interval,omitempty"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -204,102 +206,104 @@ func init() {
 func init() { proto.RegisterFile("settings.proto", fileDescriptor_6c7cab62fa432213) }
 
 var fileDescriptor_6c7cab62fa432213 = []byte{
-	// 1509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcf, 0x6f, 0x14, 0xc9,
-	0x15, 0xc7, 0xdd, 0x18, 0x88, 0x5d, 0x18, 0xe3, 0xb4, 0x7f, 0xd0, 0x1e, 0xc6, 0xe3, 0x61, 0x30,
-	0x62, 0x30, 0x16, 0x23, 0x81, 0x08, 0x8a, 0x91, 0x22, 0x0d, 0x33, 0x8e, 0x70, 0xc0, 0xc4, 0xf4,
-	0x18, 0x48, 0x22, 0x45, 0xa5, 0x72, 0xf7, 0x73, 0x4f, 0x85, 0xea, 0xae, 0xa6, 0xab, 0xda, 0x3f,
-	0x6e, 0x51, 0xfe, 0x02, 0xa4, 0x9c, 0xf2, 0x6f, 0xe4, 0xaf, 0xe0, 0x48, 0x94, 0x4b, 0x4e, 0xd1,
-	0x2e, 0xec, 0x61, 0x85, 0xf6, 0xb0, 0x5a, 0xcc, 0x6a, 0xb5, 0xa7, 0x55, 0x55, 0xff, 0x18, 0xdb,
-	0x53, 0xa0, 0xdd, 0xdb, 0x4c, 0xd5, 0xfb, 0x7e, 0xde, 0xab, 0x7e, 0xaf, 0xde, 0xeb, 0x46, 0x93,
-	0x02, 0xa4, 0xa4, 0x51, 0x20, 0x6e, 0xc6, 0x09, 0x97, 0xdc, 0x1e, 0x07, 0x3f, 0x00, 0xfd, 0xb3,
-	0x32, 0x91, 0x80, 0x48, 0x99, 0xcc, 0x36, 0x2a, 0xd5, 0x80, 0xf3, 0x80, 0x41, 0x8b, 0xc4, 0xb4,
-	0x45, 0xa2, 0x88, 0x4b, 0x22, 0x29, 0x8f, 0x72, 0x59, 0x65, 0x41, 0x72, 0xce, 0x44, 0x4b, 0xff,
-	0x09, 0x20, 0x2a, 0x7f, 0xe4, 0xdb, 0x33, 0x01, 0x0f, 0xb8, 0xfe, 0xd9, 0x52, 0xbf, 0xb2, 0xd5,
-	0xc6, 0x7f, 0xe6, 0xd1, 0x58, 0x2f, 0x77, 0x6f, 0xcf, 0xa1, 0xb3, 0x3b, 0x14, 0x98, 0x2f, 0x1c,
-	0xab, 0x3e, 0xda, 0x1c, 0x77, 0xf3, 0x7f, 0xf6, 0x5f, 0xd1, 0x92, 0xe8, 0x43, 0xdc, 0x87, 0xc4,
-	0xc7, 0x21, 0xc8, 0x84, 0x7a, 0x02, 0x7b, 0x9c, 0x31, 0xf0, 0x94, 0x7f, 0x4c, 0x23, 0x09, 0xc9,
-	0x2e, 0x61, 0xce, 0xa9, 0xba, 0xd5, 0x1c, 0xbd, 0x3f, 0xf1, 0xe3, 0xff, 0x17, 0xc7, 0xba, 0x69,
-	0xa2, 0x83, 0x73, 0x2f, 0x17, 0xca, 0x8d, 0x4c, 0xd8, 0x29, 0x75, 0xeb, 0xb9, 0xcc, 0xfe, 0x33,
-	0x6a, 0x94, 0x78, 0xc2, 0x20, 0x91, 0x18, 0x76, 0x09, 0x4b, 0xc9, 0x71, 0xf8, 0x8c, 0x01, 0xbe,
-	0x58, 0xe8, 0xda, 0x4a, 0xb6, 0x56, 0xaa, 0x4a, 0xf4, 0x53, 0x54, 0x1f, 0x8a, 0x5c, 0x78, 0x09,
-	0x89, 0x61, 0x00, 0x6e, 0x1a, 0xc0, 0x0b, 0x27, 0xa2, 0xee, 0x69, 0x4d, 0x89, 0x6d, 0xa3, 0xd2,
-	0x00, 0xf7, 0x81, 0x30, 0xd9, 0xc7, 0x5e, 0x1f, 0xbc, 0x17, 0x38, 0x51, 0xe6, 0x20, 0x9c, 0xd1,
-	0xba, 0xd5, 0x3c, 0xe3, 0x56, 0x0a, 0xa3, 0x07, 0xda, 0xa6, 0xa3, 0x4c, 0xdc, 0xcc, 0xc2, 0x7e,
-	0x82, 0x6a, 0x66, 0x44, 0x19, 0xd7, 0x69, 0x43, 0x5c, 0x97, 0x0c, 0xc4, 0x32, 0xaa, 0xbb, 0xc8,
-	0x21, 0xa9, 0xe4, 0xd8, 0x87, 0x98, 0xf1, 0x83, 0x12, 0x84, 0x05, 0x78, 0xce, 0x99, 0xba, 0xd5,
-	0xb4, 0xdc, 0x59, 0xb5, 0xdf, 0xd5, 0xdb, 0x85, 0xaa, 0x07, 0x9e, 0x7d, 0x1b, 0xcd, 0x1d, 0x15,
-	0xf2, 0x9d, 0x1d, 0x01, 0x52, 0xcb, 0xce, 0x6a, 0xd9, 0xf4, 0x40, 0xf6, 0x47, 0xbd, 0xa7, 0x44,
-	0xbf, 0x45, 0xf3, 0x47, 0x45, 0x21, 0xd9, 0x2f, 0x3d, 0x0a, 0xe7, 0x57, 0x75, 0xab, 0x79, 0xde,
-	0x9d, 0x1b, 0xe8, 0x36, 0xc8, 0x7e, 0xe1, 0x51, 0xd8, 0x1d, 0x74, 0xd1, 0x4b, 0x80, 0x48, 0xc0,
-	0x24, 0x8e, 0x31, 0x8d, 0x84, 0xc4, 0x92, 0x86, 0xc0, 0x53, 0xe9, 0x8c, 0x19, 0x0e, 0x3d, 0x93,
-	0x19, 0xb7, 0xe3, 0x78, 0x3d, 0x12, 0x72, 0x2b, 0xb3, 0x54, 0x90, 0x34, 0xf6, 0x8d, 0x90, 0x71,
-	0x13, 0x24, 0x33, 0x1e, 0x86, 0xf8, 0xc0, 0xc0, 0x04, 0x41, 0x26, 0x48, 0x66, 0x7c, 0x02, 0xf2,
-	0x10, 0x5d, 0xca, 0x8f, 0xe3, 0xb1, 0x54, 0x48, 0x48, 0x8e, 0x83, 0xce, 0x19, 0x40, 0x4e, 0x26,
-	0xe8, 0x64, 0xf6, 0x27, 0x60, 0xf9, 0xb1, 0x8c, 0xb0, 0x09, 0x13, 0x2c, 0x13, 0x98, 0x61, 0xf9,
-	0xf1, 0x8c, 0xb0, 0xf3, 0x26, 0x58, 0x26, 0x30, 0xc0, 0x56, 0x90, 0x1d, 0x12, 0x0d, 0x89, 0xb8,
-	0x0f, 0x78, 0x87, 0x91, 0x5d, 0x9e, 0x38, 0x93, 0x75, 0xab, 0x39, 0xee, 0x4e, 0x65, 0x3b, 0x8f,
-	0xb9, 0x0f, 0xbf, 0xd7, 0xeb, 0xf6, 0x1d, 0x74, 0x51, 0x95, 0x84, 0x4c, 0x88, 0xf7, 0x02, 0x7c,
-	0xec, 0x87, 0x2a, 0x06, 0x0a, 0x91, 0x14, 0xce, 0x94, 0xbe, 0x1c, 0x33, 0x21, 0xd9, 0xdf, 0xca,
-	0x76, 0xbb, 0x21, 0x74, 0xb2, 0x3d, 0x15, 0x31, 0x8d, 0x76, 0x58, 0xba, 0x8f, 0xfd, 0xed, 0xf2,
-	0xc6, 0x26, 0x20, 0x21, 0x52, 0xd1, 0x39, 0xb6, 0x29, 0xe2, 0x4c, 0xd0, 0xdd, 0xce, 0xef, 0xaa,
-	0x5b, 0x58, 0xdb, 0x8f, 0x51, 0xd5, 0x63, 0x3c, 0xf5, 0x19, 0x48, 0x1c, 0x12, 0x55, 0x9d, 0x11,
-	0x89, 0x3c, 0x28, 0xcf, 0x3f, 0xad, 0x02, 0x39, 0x41, 0xab, 0x14, 0x8a, 0x8d, 0x81, 0xa0, 0x78,
-	0x02, 0x6d, 0x34, 0x97, 0xe7, 0x66, 0x37, 0xc4, 0x31, 0xe7, 0xac, 0x24, 0xcd, 0x1a, 0xe2, 0x9a,
-	0xce, 0x6c, 0x9f, 0x85, 0x9b, 0x9c, 0xb3, 0xe1, 0xf4, 0xca, 0x24, 0x15, 0x12, 0xc7, 0x9c, 0x51,
-	0xef, 0xa0, 0xe4, 0xcc, 0x7d, 0x3a, 0xbd, 0x5b, 0xca, 0x7e, 0x53, 0x9b, 0x17, 0xb0, 0xbf, 0xa0,
-	0x2b, 0xea, 0xb9, 0x92, 0x98, 0x7e, 0xb6, 0x2d, 0x5f, 0x34, 0x75, 0x4e, 0x3f, 0x84, 0x76, 0x4c,
-	0x3f, 0xdd, 0x94, 0xb7, 0xd1, 0x35, 0x35, 0x86, 0x30, 0xec, 0xaa, 0xbc, 0x7c, 0x96, 0xef, 0x18,
-	0xf8, 0x57, 0x94, 0x78, 0x4d, 0x6b, 0x3f, 0xed, 0xc3, 0x47, 0x4d, 0x8f, 0x01, 0x89, 0xd2, 0x18,
-	0x27, 0x20, 0xd4, 0xda, 0x36, 0x03, 0xac, 0xbb, 0x4a, 0x59, 0xaf, 0x2a, 0x15, 0x34, 0x04, 0x67,
-	0xde, 0xe0, 0x64, 0x29, 0x57, 0xbb, 0xa5, 0xb8, 0x9d, 0x4a, 0x5e, 0x94, 0x6e, 0xae, 0xb4, 0x03,
-	0xb4, 0x3c, 0x28, 0xa9, 0xb2, 0x1e, 0x52, 0x41, 0x02, 0x30, 0x54, 0x58, 0xc5, 0xe0, 0xe7, 0x6a,
-	0x51, 0x61, 0x9d, 0x5c, 0xfd, 0x54, 0x89, 0x87, 0xca, 0xad, 0x5b, 0xb6, 0xb5, 0xd2, 0x4b, 0x91,
-	0xd7, 0x4b, 0x06, 0xea, 0x6c, 0xd1, 0x03, 0x32, 0xdb, 0x22, 0xa9, 0xdd, 0xb2, 0xaf, 0x0d, 0x51,
-	0xaa, 0x26, 0x4a, 0x71, 0xf9, 0x8f, 0x53, 0x7e, 0x87, 0xaa, 0x8c, 0x7b, 0xd9, 0x08, 0x95, 0x94,
-	0x01, 0x16, 0xd4, 0x07, 0xcc, 0x20, 0x0a, 0x64, 0x1f, 0xbf, 0x08, 0x9d, 0x05, 0x85, 0x72, 0x9d,
-	0xc2, 0x66, 0x8b, 0x32, 0xe8, 0x51, 0x1f, 0x1e, 0x69, 0x83, 0x87, 0xa1, 0xfd, 0x2f, 0x0b, 0xdd,
-	0x33, 0xe7, 0x3f, 0x92, 0x34, 0x4a, 0x79, 0x2a, 0xf0, 0xcb, 0x14, 0xd4, 0x24, 0x33, 0x95, 0x84,
-	0x70, 0x6a, 0xf5, 0xd1, 0xe6, 0xb9, 0x5b, 0x0b, 0x37, 0xcb, 0x57, 0x99, 0x9b, 0xc3, 0xf9, 0x77,
-	0xef, 0x18, 0x8a, 0xa4, 0xc0, 0x3f, 0xc9, 0xe8, 0xc3, 0x2a, 0xa1, 0x4a, 0x73, 0x90, 0x50, 0x9f,
-	0xef, 0x45, 0x82, 0x84, 0x31, 0x03, 0xdf, 0x90, 0xcd, 0x45, 0x53, 0x69, 0x16, 0xd9, 0xec, 0x0e,
-	0xa4, 0x43, 0xb9, 0x24, 0x47, 0x7d, 0x98, 0x1e, 0xc4, 0xc0, 0x47, 0xdd, 0xe0, 0xa3, 0x51, 0xf8,
-	0x58, 0x3b, 0x79, 0xc2, 0x81, 0x8b, 0x1e, 0x5a, 0x24, 0x71, 0xac, 0x1b, 0x72, 0xd6, 0x19, 0x71,
-	0x71, 0x19, 0xca, 0x9b, 0x75, 0xd9, 0x80, 0xae, 0xe6, 0xa2, 0xac, 0x63, 0x76, 0x32, 0x49, 0x79,
-	0xa5, 0x9e, 0xa3, 0xeb, 0xc5, 0xd5, 0xd1, 0xf7, 0x48, 0x78, 0x44, 0x5d, 0xa9, 0x5d, 0x48, 0x48,
-	0x40, 0xa3, 0x00, 0xfb, 0x39, 0x46, 0x4f, 0xf7, 0x86, 0x2e, 0x82, 0xa5, 0x5c, 0xa0, 0xee, 0x4e,
-	0x4f, 0x99, 0xb7, 0x0b, 0xeb, 0xc2, 0xa7, 0x1a, 0xf7, 0x9b, 0xa8, 0x66, 0x00, 0xab, 0xf7, 0x9d,
-	0x03, 0xec, 0x03, 0x23, 0x07, 0xce, 0x15, 0x43, 0xb0, 0x95, 0x93, 0x6c, 0xf5, 0xfa, 0x73, 0xd0,
-	0x55, 0xf6, 0xf6, 0x63, 0xb4, 0x90, 0xbd, 0xed, 0xe5, 0x3d, 0x30, 0xa4, 0x11, 0x96, 0x09, 0x0d,
-	0x02, 0x48, 0x74, 0xc5, 0x3b, 0x4b, 0x06, 0xe0, 0xbc, 0x96, 0x64, 0x6d, 0x70, 0x83, 0x46, 0x5b,
-	0x99, 0xbd, 0xaa, 0x7a, 0x35, 0x9f, 0x7c, 0x2a, 0x74, 0x0b, 0x49, 0xd4, 0xf5, 0x61, 0x34, 0xa4,
-	0xd2, 0xb9, 0x5a, 0xb7, 0x9a, 0x63, 0xee, 0x54, 0xbe, 0xe3, 0x12, 0x09, 0x8f, 0xd4, 0xba, 0xbd,
-	0x8a, 0x2a, 0x03, 0x2b, 0x7c, 0x74, 0x54, 0xd1, 0x58, 0x38, 0xd7, 0xf4, 0x93, 0x99, 0x4b, 0x0a,
-	0xf3, 0x8d, 0x72, 0x56, 0xad, 0xc7, 0xc2, 0x7e, 0x8e, 0x2e, 0x27, 0x20, 0x78, 0x9a, 0x78, 0x80,
-	0x45, 0x44, 0x62, 0xd1, 0xe7, 0x12, 0xcb, 0x7e, 0x02, 0xc4, 0x1f, 0xe4, 0xee, 0xba, 0x21, 0xfa,
-	0x5a, 0x21, 0xeb, 0xe5, 0xaa, 0x2d, 0x2d, 0x2a, 0xb3, 0xf7, 0x27, 0xd4, 0x88, 0x19, 0x91, 0x3b,
-	0x3c, 0x09, 0x71, 0x9f, 0xe8, 0x61, 0xad, 0x07, 0x56, 0xcc, 0x19, 0x1b, 0x90, 0x97, 0x4d, 0xe4,
-	0x42, 0xf7, 0x80, 0xac, 0xe7, 0xaa, 0x4d, 0xce, 0x58, 0x49, 0x26, 0xe8, 0x9a, 0x91, 0x4c, 0x3c,
-	0x49, 0x77, 0x01, 0xc3, 0x7e, 0x4c, 0x93, 0x6c, 0x30, 0x3a, 0x37, 0x4c, 0xf5, 0x3c, 0x8c, 0x6f,
-	0x6b, 0xe5, 0x9a, 0x16, 0xea, 0xe7, 0xff, 0x1b, 0x34, 0xe5, 0x79, 0x49, 0xa8, 0xc7, 0x51, 0xd1,
-	0xb1, 0x56, 0x0c, 0xac, 0x49, 0x65, 0xd5, 0x8e, 0x69, 0xde, 0xaa, 0x56, 0x6f, 0x7c, 0xfd, 0xc1,
-	0xb1, 0xbe, 0xfd, 0xe0, 0x58, 0x7f, 0x3f, 0x74, 0xac, 0x57, 0x87, 0x8e, 0xf5, 0xdd, 0x47, 0xe7,
-	0x5c, 0xf1, 0x4d, 0xf2, 0x10, 0x0e, 0x7e, 0xf8, 0xe8, 0x58, 0xff, 0xfe, 0xde, 0x39, 0x1d, 0xf1,
-	0x08, 0xfe, 0x70, 0x7a, 0xec, 0xc2, 0xd4, 0x94, 0x5b, 0x65, 0x9c, 0xf8, 0x78, 0x9b, 0x30, 0x15,
-	0x48, 0xa2, 0xb3, 0x17, 0xf3, 0x44, 0xe2, 0x84, 0x44, 0x01, 0x34, 0xfe, 0x86, 0x6c, 0xc3, 0xb0,
-	0x69, 0xa2, 0xb1, 0xf2, 0x09, 0x5a, 0x86, 0xb0, 0xca, 0x5d, 0x7b, 0x19, 0x8d, 0x0f, 0x6e, 0xb7,
-	0xe9, 0x9b, 0x66, 0xb0, 0x7d, 0xeb, 0x9b, 0x53, 0xa8, 0x8c, 0xb5, 0x1d, 0x53, 0x3b, 0x45, 0x93,
-	0x4f, 0x75, 0x43, 0x2e, 0x3f, 0xaa, 0xa6, 0x8f, 0xf4, 0xc0, 0x62, 0xb1, 0xf2, 0xeb, 0x23, 0x8b,
-	0xae, 0xfe, 0xc4, 0x6b, 0xdc, 0x7b, 0x7f, 0xe8, 0x54, 0xdd, 0xbc, 0x3e, 0x3a, 0x3c, 0xda, 0xa1,
-	0xc1, 0x4a, 0x5b, 0x1f, 0x61, 0x83, 0x44, 0x24, 0x80, 0x95, 0x7f, 0xfc, 0xf7, 0xab, 0x7f, 0x9e,
-	0x9a, 0x6d, 0x4c, 0xb5, 0xb2, 0x8e, 0xdf, 0x2a, 0xbe, 0x1a, 0x57, 0xad, 0x65, 0x5b, 0xa0, 0xf3,
-	0x6a, 0x08, 0xca, 0x5f, 0xec, 0x75, 0xf5, 0x67, 0x79, 0x9d, 0x69, 0x5c, 0x68, 0xa9, 0x09, 0x2d,
-	0x8f, 0x39, 0x7d, 0x89, 0x26, 0x7a, 0x7d, 0xbe, 0xf7, 0x79, 0x9f, 0xa6, 0xc5, 0xc6, 0xdd, 0xf7,
-	0x87, 0x4e, 0xc5, 0xe8, 0xf5, 0x19, 0x85, 0xbd, 0xcc, 0xe7, 0x74, 0x63, 0xb2, 0x25, 0xfa, 0x7c,
-	0xef, 0xa8, 0xcb, 0xfb, 0xd5, 0xd7, 0x5f, 0xd6, 0x46, 0x5e, 0xbf, 0xad, 0x59, 0x6f, 0xde, 0xd6,
-	0xac, 0x2f, 0xde, 0xd6, 0xac, 0x57, 0xef, 0x6a, 0x23, 0x6f, 0xde, 0xd5, 0x46, 0xfe, 0xf7, 0xae,
-	0x36, 0xb2, 0x7d, 0x56, 0xbb, 0xb9, 0xfd, 0x53, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0xba, 0xcc,
-	0xe1, 0x51, 0x0f, 0x00, 0x00,
+	// 1543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcf, 0x6f, 0xdc, 0xc6,
+	0x15, 0xc7, 0x4d, 0xdb, 0x71, 0xa5, 0xb1, 0xad, 0xa8, 0x94, 0x2c, 0xd3, 0xeb, 0xd5, 0x7a, 0xbd,
+	0x76, 0xe0, 0x8d, 0xe3, 0x7a, 0x81, 0x04, 0x69, 0x50, 0x07, 0x28, 0xb0, 0xd9, 0x55, 0x10, 0xd5,
+	0x91, 0xab, 0x70, 0xe5, 0x24, 0x2d, 0x50, 0x0c, 0x46, 0xe4, 0x5b, 0xee, 0xd4, 0x43, 0x0e, 0x35,
+	0x33, 0xd4, 0x8f, 0x5b, 0xd1, 0xbf, 0x20, 0x40, 0x4f, 0xfd, 0x1b, 0x7a, 0xeb, 0x5f, 0x91, 0x63,
+	0x80, 0x5e, 0x7a, 0x2a, 0x5a, 0xbb, 0x87, 0x22, 0xe8, 0xa1, 0x68, 0xe4, 0xa2, 0xe8, 0xa9, 0x98,
+	0x21, 0x87, 0x94, 0xb4, 0x63, 0xa3, 0xbd, 0xed, 0xce, 0xbc, 0xef, 0xe7, 0xbd, 0xe1, 0x7b, 0xf3,
+	0x1e, 0x89, 0x96, 0x24, 0x28, 0x45, 0xb3, 0x44, 0x3e, 0xcc, 0x05, 0x57, 0xdc, 0x5f, 0x84, 0x38,
+	0x01, 0xf3, 0xb3, 0x75, 0x45, 0x80, 0x2c, 0x98, 0x2a, 0x37, 0x5a, 0xed, 0x84, 0xf3, 0x84, 0xc1,
+	0x80, 0xe4, 0x74, 0x40, 0xb2, 0x8c, 0x2b, 0xa2, 0x28, 0xcf, 0x2a, 0x59, 0x6b, 0x5d, 0x71, 0xce,
+	0xe4, 0xc0, 0xfc, 0x49, 0x20, 0xab, 0x7f, 0x54, 0xdb, 0xab, 0x09, 0x4f, 0xb8, 0xf9, 0x39, 0xd0,
+	0xbf, 0xca, 0xd5, 0xde, 0xef, 0x5a, 0x68, 0x61, 0x52, 0xb9, 0xf7, 0xd7, 0xd0, 0xa5, 0x29, 0x05,
+	0x16, 0xcb, 0xc0, 0xeb, 0x5e, 0xe8, 0x2f, 0x86, 0xd5, 0x3f, 0xff, 0x17, 0xe8, 0xae, 0x9c, 0x41,
+	0x3e, 0x03, 0x11, 0xe3, 0x14, 0x94, 0xa0, 0x91, 0xc4, 0x11, 0x67, 0x0c, 0x22, 0xed, 0x1f, 0xd3,
+	0x4c, 0x81, 0xd8, 0x27, 0x2c, 0x38, 0xdf, 0xf5, 0xfa, 0x17, 0x3e, 0xba, 0xf2, 0x9f, 0x3f, 0xdd,
+	0x5a, 0x18, 0x17, 0xc2, 0x04, 0x17, 0xde, 0xb6, 0xca, 0xad, 0x52, 0x38, 0xaa, 0x75, 0x9b, 0x95,
+	0xcc, 0xff, 0x19, 0xea, 0xd5, 0x78, 0xc2, 0x40, 0x28, 0x0c, 0xfb, 0x84, 0x15, 0xe4, 0x34, 0x7c,
+	0xd5, 0x01, 0xbf, 0x65, 0x75, 0x43, 0x2d, 0xdb, 0xa8, 0x55, 0x35, 0xfa, 0x29, 0xea, 0xce, 0x45,
+	0x2e, 0x23, 0x41, 0x72, 0x68, 0xc0, 0x7d, 0x07, 0x78, 0xfd, 0x4c, 0xd4, 0x13, 0xa3, 0xa9, 0xb1,
+	0x43, 0x54, 0x1b, 0xe0, 0x19, 0x10, 0xa6, 0x66, 0x38, 0x9a, 0x41, 0xf4, 0x0c, 0x0b, 0x6d, 0x0e,
+	0x32, 0xb8, 0xd0, 0xf5, 0xfa, 0x6f, 0x84, 0x2d, 0x6b, 0xf4, 0x89, 0xb1, 0x19, 0x69, 0x93, 0xb0,
+	0xb4, 0xf0, 0x3f, 0x43, 0x1d, 0x37, 0xa2, 0x8e, 0xeb, 0xa2, 0x23, 0xae, 0x9b, 0x0e, 0x62, 0x1d,
+	0xd5, 0x07, 0x28, 0x20, 0x85, 0xe2, 0x38, 0x86, 0x9c, 0xf1, 0xa3, 0x1a, 0x84, 0x25, 0x44, 0xc1,
+	0x1b, 0x5d, 0xaf, 0xef, 0x85, 0xd7, 0xf4, 0xfe, 0xd8, 0x6c, 0x5b, 0xd5, 0x04, 0x22, 0xff, 0x3d,
+	0xb4, 0x76, 0x52, 0xc8, 0xa7, 0x53, 0x09, 0xca, 0xc8, 0x2e, 0x19, 0xd9, 0x4a, 0x23, 0xfb, 0xa9,
+	0xd9, 0xd3, 0xa2, 0x1f, 0xa1, 0x1b, 0x27, 0x45, 0x29, 0x39, 0xac, 0x3d, 0xca, 0xe0, 0x7b, 0x5d,
+	0xaf, 0x7f, 0x35, 0x5c, 0x6b, 0x74, 0x5b, 0xe4, 0xd0, 0x7a, 0x94, 0xfe, 0x08, 0x5d, 0x8f, 0x04,
+	0x10, 0x05, 0x98, 0xe4, 0x39, 0xa6, 0x99, 0x54, 0x58, 0xd1, 0x14, 0x78, 0xa1, 0x82, 0x05, 0xc7,
+	0xa1, 0x57, 0x4b, 0xe3, 0x61, 0x9e, 0x6f, 0x66, 0x52, 0xed, 0x94, 0x96, 0x1a, 0x52, 0xe4, 0xb1,
+	0x13, 0xb2, 0xe8, 0x82, 0x94, 0xc6, 0xf3, 0x90, 0x18, 0x18, 0xb8, 0x20, 0xc8, 0x05, 0x29, 0x8d,
+	0xcf, 0x40, 0x1e, 0xa3, 0x9b, 0xd5, 0x71, 0x22, 0x56, 0x48, 0x05, 0xe2, 0x34, 0xe8, 0xb2, 0x03,
+	0x14, 0x94, 0x82, 0x51, 0x69, 0x7f, 0x06, 0x56, 0x1d, 0xcb, 0x09, 0xbb, 0xe2, 0x82, 0x95, 0x02,
+	0x37, 0xac, 0x3a, 0x9e, 0x13, 0x76, 0xd5, 0x05, 0x2b, 0x05, 0x0e, 0xd8, 0x03, 0xe4, 0xa7, 0xc4,
+	0x40, 0x32, 0x1e, 0x03, 0x9e, 0x32, 0xb2, 0xcf, 0x45, 0xb0, 0xd4, 0xf5, 0xfa, 0x8b, 0xe1, 0x72,
+	0xb9, 0xf3, 0x84, 0xc7, 0xf0, 0xb1, 0x59, 0xf7, 0xdf, 0x47, 0xd7, 0x75, 0x49, 0x28, 0x41, 0xa2,
+	0x67, 0x10, 0xe3, 0x38, 0xd5, 0x31, 0x50, 0xc8, 0x94, 0x0c, 0x96, 0xcd, 0xe5, 0x58, 0x4d, 0xc9,
+	0xe1, 0x4e, 0xb9, 0x3b, 0x4e, 0x61, 0x54, 0xee, 0xe9, 0x88, 0x69, 0x36, 0x65, 0xc5, 0x21, 0x8e,
+	0x77, 0xeb, 0x1b, 0x2b, 0x40, 0x41, 0xa6, 0xa3, 0x0b, 0x7c, 0x57, 0xc4, 0xa5, 0x60, 0xbc, 0x5b,
+	0xdd, 0xd5, 0xd0, 0x5a, 0xfb, 0x4f, 0x50, 0x3b, 0x62, 0xbc, 0x88, 0x19, 0x28, 0x9c, 0x12, 0x5d,
+	0x9d, 0x19, 0xc9, 0x22, 0xa8, 0xcf, 0xbf, 0xa2, 0x03, 0x39, 0x43, 0x6b, 0x59, 0xc5, 0x56, 0x23,
+	0xb0, 0x4f, 0x60, 0x88, 0xd6, 0xaa, 0xdc, 0xec, 0xa7, 0x38, 0xe7, 0x9c, 0xd5, 0xa4, 0x6b, 0x8e,
+	0xb8, 0x56, 0x4a, 0xdb, 0xcf, 0xd3, 0x6d, 0xce, 0xd9, 0x7c, 0x7a, 0x95, 0x28, 0xa4, 0xc2, 0x39,
+	0x67, 0x34, 0x3a, 0xaa, 0x39, 0x6b, 0xaf, 0x4e, 0xef, 0x8e, 0xb6, 0xdf, 0x36, 0xe6, 0x16, 0xf6,
+	0x73, 0x74, 0x47, 0x3f, 0x57, 0x92, 0xd3, 0xd7, 0xb6, 0xe5, 0xeb, 0xae, 0xce, 0x19, 0xa7, 0x30,
+	0xcc, 0xe9, 0xab, 0x9b, 0xf2, 0x2e, 0xba, 0xa7, 0xc7, 0x10, 0x86, 0x7d, 0x9d, 0x97, 0xd7, 0xf2,
+	0x03, 0x07, 0xff, 0x8e, 0x16, 0x6f, 0x18, 0xed, 0xab, 0x7d, 0xc4, 0xa8, 0x1f, 0x31, 0x20, 0x59,
+	0x91, 0x63, 0x01, 0x52, 0xaf, 0xed, 0x32, 0xc0, 0xa6, 0xab, 0xd4, 0xf5, 0xaa, 0x53, 0x41, 0x53,
+	0x08, 0x6e, 0x38, 0x9c, 0xdc, 0xad, 0xd4, 0x61, 0x2d, 0x1e, 0x16, 0x8a, 0xdb, 0xd2, 0xad, 0x94,
+	0x7e, 0x82, 0xee, 0x37, 0x25, 0x55, 0xd7, 0x43, 0x21, 0x49, 0x02, 0x8e, 0x0a, 0x6b, 0x39, 0xfc,
+	0xbc, 0x65, 0x2b, 0x6c, 0x54, 0xa9, 0x9f, 0x6a, 0xf1, 0x5c, 0xb9, 0x8d, 0xeb, 0xb6, 0x56, 0x7b,
+	0xb1, 0x79, 0xbd, 0xe9, 0xa0, 0x5e, 0xb3, 0x3d, 0xa0, 0xb4, 0xb5, 0x49, 0x1d, 0xd7, 0x7d, 0x6d,
+	0x8e, 0xd2, 0x76, 0x51, 0xec, 0xe5, 0x3f, 0x4d, 0xf9, 0x31, 0x6a, 0x33, 0x1e, 0x95, 0x23, 0x54,
+	0x51, 0x06, 0x58, 0xd2, 0x18, 0x30, 0x83, 0x2c, 0x51, 0x33, 0xfc, 0x2c, 0x0d, 0xd6, 0x35, 0x2a,
+	0x0c, 0xac, 0xcd, 0x0e, 0x65, 0x30, 0xa1, 0x31, 0x7c, 0x6a, 0x0c, 0x1e, 0xa7, 0xfe, 0x6f, 0x3d,
+	0xf4, 0xa1, 0x3b, 0xff, 0x99, 0xa2, 0x59, 0xc1, 0x0b, 0x89, 0xf7, 0x0a, 0xd0, 0x93, 0xcc, 0x55,
+	0x12, 0x32, 0xe8, 0x74, 0x2f, 0xf4, 0x2f, 0xbf, 0xbb, 0xfe, 0xb0, 0x7e, 0x95, 0x79, 0x38, 0x9f,
+	0xff, 0xf0, 0x7d, 0x47, 0x91, 0x58, 0xfc, 0x67, 0x25, 0x7d, 0x5e, 0x25, 0x75, 0x69, 0x36, 0x09,
+	0x8d, 0xf9, 0x41, 0x26, 0x49, 0x9a, 0x33, 0x88, 0x1d, 0xd9, 0xbc, 0xe5, 0x2a, 0x4d, 0x9b, 0xcd,
+	0x71, 0x23, 0x9d, 0xcb, 0x25, 0x39, 0xe9, 0xc3, 0xf5, 0x20, 0x1a, 0x1f, 0x5d, 0x87, 0x8f, 0x9e,
+	0xf5, 0xb1, 0x71, 0xf6, 0x84, 0x8d, 0x8b, 0x09, 0xba, 0x45, 0xf2, 0xdc, 0x34, 0xe4, 0xb2, 0x33,
+	0x62, 0x7b, 0x19, 0xea, 0x9b, 0x75, 0xdb, 0x81, 0x6e, 0x57, 0xa2, 0xb2, 0x63, 0x8e, 0x4a, 0x49,
+	0x7d, 0xa5, 0xbe, 0x40, 0x6f, 0xdb, 0xab, 0x63, 0xee, 0x91, 0x8c, 0x88, 0xbe, 0x52, 0xfb, 0x20,
+	0x48, 0x42, 0xb3, 0x04, 0xc7, 0x15, 0xc6, 0x4c, 0xf7, 0x9e, 0x29, 0x82, 0xbb, 0x95, 0x40, 0xdf,
+	0x9d, 0x89, 0x36, 0x1f, 0x5a, 0x6b, 0xeb, 0x53, 0x8f, 0xfb, 0x6d, 0xd4, 0x71, 0x80, 0xf5, 0xfb,
+	0xce, 0x11, 0x8e, 0x81, 0x91, 0xa3, 0xe0, 0x8e, 0x23, 0xd8, 0xd6, 0x59, 0xb6, 0x7e, 0xfd, 0x39,
+	0x1a, 0x6b, 0x7b, 0xff, 0x09, 0x5a, 0x2f, 0xdf, 0xf6, 0xaa, 0x1e, 0x98, 0xd2, 0x0c, 0x2b, 0x41,
+	0x93, 0x04, 0x84, 0xa9, 0xf8, 0xe0, 0xae, 0x03, 0x78, 0xc3, 0x48, 0xca, 0x36, 0xb8, 0x45, 0xb3,
+	0x9d, 0xd2, 0x5e, 0x57, 0xbd, 0x9e, 0x4f, 0x31, 0x95, 0xa6, 0x85, 0x08, 0x7d, 0x7d, 0x18, 0x4d,
+	0xa9, 0x0a, 0xde, 0xea, 0x7a, 0xfd, 0x85, 0x70, 0xb9, 0xda, 0x09, 0x89, 0x82, 0x4f, 0xf5, 0xba,
+	0xff, 0x08, 0xb5, 0x1a, 0x2b, 0x7c, 0x72, 0x54, 0xd1, 0x5c, 0x06, 0xf7, 0xcc, 0x93, 0x59, 0x13,
+	0xd6, 0x7c, 0xab, 0x9e, 0x55, 0x9b, 0xb9, 0xf4, 0xbf, 0x40, 0xb7, 0x05, 0x48, 0x5e, 0x88, 0x08,
+	0xb0, 0xcc, 0x48, 0x2e, 0x67, 0x5c, 0x61, 0x35, 0x13, 0x40, 0xe2, 0x26, 0x77, 0x6f, 0x3b, 0xa2,
+	0xef, 0x58, 0xd9, 0xa4, 0x52, 0xed, 0x18, 0x51, 0x9d, 0xbd, 0x2f, 0x51, 0x2f, 0x67, 0x44, 0x4d,
+	0xb9, 0x48, 0xf1, 0x8c, 0x98, 0x61, 0x6d, 0x06, 0x56, 0xce, 0x19, 0x6b, 0xc8, 0xf7, 0x5d, 0x64,
+	0xab, 0xfb, 0x84, 0x6c, 0x56, 0xaa, 0x6d, 0xce, 0x58, 0x4d, 0x26, 0xe8, 0x9e, 0x93, 0x4c, 0x22,
+	0x45, 0xf7, 0x01, 0xc3, 0x61, 0x4e, 0x45, 0x39, 0x18, 0x83, 0x77, 0x5c, 0xf5, 0x3c, 0x8f, 0x1f,
+	0x1a, 0xe5, 0x86, 0x11, 0x9a, 0xe7, 0xff, 0x43, 0xb4, 0x1c, 0x45, 0x22, 0x35, 0xe3, 0xc8, 0x76,
+	0xac, 0x07, 0x0e, 0xd6, 0x92, 0xb6, 0x1a, 0xe6, 0xd4, 0xb6, 0xaa, 0x2f, 0x51, 0xef, 0xd4, 0x2c,
+	0x9c, 0xee, 0xc5, 0x19, 0x16, 0x30, 0x15, 0x20, 0x67, 0xcd, 0xa1, 0x7f, 0xe0, 0x3a, 0xb4, 0x6a,
+	0x66, 0xe2, 0xc7, 0x7b, 0x71, 0x16, 0x96, 0x22, 0x7b, 0xe8, 0x47, 0xef, 0xfc, 0xed, 0xbb, 0xc0,
+	0xfb, 0xc7, 0x77, 0x81, 0xf7, 0xab, 0xe3, 0xc0, 0xfb, 0xea, 0x38, 0xf0, 0xfe, 0xf9, 0x32, 0xb8,
+	0x6c, 0xbf, 0x76, 0x1e, 0xc3, 0xd1, 0xbf, 0x5f, 0x06, 0xde, 0xef, 0xff, 0x15, 0x5c, 0xcc, 0x78,
+	0x06, 0x3f, 0xb9, 0xb8, 0xf0, 0xe6, 0xf2, 0x72, 0xd8, 0x66, 0x9c, 0xc4, 0x78, 0x97, 0x30, 0x7d,
+	0x44, 0x61, 0xea, 0x22, 0xe7, 0x42, 0x61, 0x41, 0xb2, 0x04, 0x7a, 0xbf, 0x44, 0xbe, 0x63, 0x8c,
+	0xf5, 0xd1, 0x42, 0x1d, 0xa6, 0xe7, 0x08, 0xb3, 0xde, 0xf5, 0xef, 0xa3, 0xc5, 0xa6, 0x6f, 0xb8,
+	0xbe, 0x96, 0x9a, 0xed, 0x77, 0xff, 0x7e, 0x1e, 0xd5, 0xb1, 0x0e, 0x73, 0xea, 0x17, 0x68, 0xe9,
+	0xa9, 0x69, 0xf5, 0xf5, 0xe7, 0xda, 0xca, 0x89, 0xee, 0x6a, 0x17, 0x5b, 0xdf, 0x3f, 0xb1, 0x18,
+	0x9a, 0x8f, 0xc7, 0xde, 0x87, 0xdf, 0x1e, 0x07, 0xed, 0xb0, 0xaa, 0xbc, 0x11, 0xcf, 0xa6, 0x34,
+	0x79, 0x30, 0x34, 0x47, 0xd8, 0x22, 0x19, 0x49, 0xe0, 0xc1, 0xaf, 0xff, 0xf0, 0xd7, 0xdf, 0x9c,
+	0xbf, 0xd6, 0x5b, 0x1e, 0x94, 0xb3, 0x64, 0x60, 0xbf, 0x47, 0x1f, 0x79, 0xf7, 0x7d, 0x89, 0xae,
+	0xea, 0xf1, 0xaa, 0xfe, 0x6f, 0xaf, 0x8f, 0xfe, 0x27, 0xaf, 0xab, 0xbd, 0x37, 0x07, 0x7a, 0xf6,
+	0xab, 0x53, 0x4e, 0xf7, 0xd0, 0x95, 0xc9, 0x8c, 0x1f, 0xbc, 0xde, 0xa7, 0x6b, 0xb1, 0xf7, 0xc1,
+	0xb7, 0xc7, 0x41, 0xcb, 0xe9, 0xf5, 0x73, 0x0a, 0x07, 0xa5, 0xcf, 0x95, 0xde, 0xd2, 0x40, 0xce,
+	0xf8, 0xc1, 0x49, 0x97, 0x1f, 0xb5, 0xbf, 0xfe, 0x4b, 0xe7, 0xdc, 0xd7, 0xcf, 0x3b, 0xde, 0x37,
+	0xcf, 0x3b, 0xde, 0x9f, 0x9f, 0x77, 0xbc, 0xaf, 0x5e, 0x74, 0xce, 0x7d, 0xf3, 0xa2, 0x73, 0xee,
+	0x8f, 0x2f, 0x3a, 0xe7, 0x76, 0x2f, 0x19, 0x37, 0xef, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0x19,
+	0x42, 0x82, 0x2d, 0xab, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -480,6 +484,13 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrustPolicyFqdnRefreshInterval != 0 {
+		i = encodeVarintSettings(dAtA, i, uint64(m.TrustPolicyFqdnRefreshInterval))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
 	if m.CcrmApiTimeout != 0 {
 		i = encodeVarintSettings(dAtA, i, uint64(m.CcrmApiTimeout))
 		i--
@@ -1021,6 +1032,11 @@ func (m *Settings) Matches(o *Settings, fopts ...MatchOpt) bool {
 			return false
 		}
 	}
+	if !opts.Filter || o.TrustPolicyFqdnRefreshInterval != 0 {
+		if o.TrustPolicyFqdnRefreshInterval != m.TrustPolicyFqdnRefreshInterval {
+			return false
+		}
+	}
 	return true
 }
 
@@ -1066,6 +1082,7 @@ const SettingsFieldResourceSnapshotThreadInterval = "41"
 const SettingsFieldPlatformHaInstancePollInterval = "42"
 const SettingsFieldPlatformHaInstanceActiveExpireTime = "43"
 const SettingsFieldCcrmApiTimeout = "44"
+const SettingsFieldTrustPolicyFqdnRefreshInterval = "45"
 
 var SettingsAllFields = []string{
 	SettingsFieldShepherdMetricsCollectionInterval,
@@ -1109,6 +1126,7 @@ var SettingsAllFields = []string{
 	SettingsFieldPlatformHaInstancePollInterval,
 	SettingsFieldPlatformHaInstanceActiveExpireTime,
 	SettingsFieldCcrmApiTimeout,
+	SettingsFieldTrustPolicyFqdnRefreshInterval,
 }
 
 var SettingsAllFieldsMap = NewFieldMap(map[string]struct{}{
@@ -1153,6 +1171,7 @@ var SettingsAllFieldsMap = NewFieldMap(map[string]struct{}{
 	SettingsFieldPlatformHaInstancePollInterval:                                 struct{}{},
 	SettingsFieldPlatformHaInstanceActiveExpireTime:                             struct{}{},
 	SettingsFieldCcrmApiTimeout:                                                 struct{}{},
+	SettingsFieldTrustPolicyFqdnRefreshInterval:                                 struct{}{},
 })
 
 var SettingsAllFieldsStringMap = map[string]string{
@@ -1197,6 +1216,7 @@ var SettingsAllFieldsStringMap = map[string]string{
 	SettingsFieldPlatformHaInstancePollInterval:                                 "Platform Ha Instance Poll Interval",
 	SettingsFieldPlatformHaInstanceActiveExpireTime:                             "Platform Ha Instance Active Expire Time",
 	SettingsFieldCcrmApiTimeout:                                                 "Ccrm Api Timeout",
+	SettingsFieldTrustPolicyFqdnRefreshInterval:                                 "Trust Policy Fqdn Refresh Interval",
 }
 
 func (m *Settings) IsKeyField(s string) bool {
@@ -1339,6 +1359,9 @@ func (m *Settings) DiffFields(o *Settings, fields *FieldMap) {
 	if m.CcrmApiTimeout != o.CcrmApiTimeout {
 		fields.Set(SettingsFieldCcrmApiTimeout)
 	}
+	if m.TrustPolicyFqdnRefreshInterval != o.TrustPolicyFqdnRefreshInterval {
+		fields.Set(SettingsFieldTrustPolicyFqdnRefreshInterval)
+	}
 }
 
 func (m *Settings) GetDiffFields(o *Settings) *FieldMap {
@@ -1390,6 +1413,7 @@ var UpdateSettingsFieldsMap = NewFieldMap(map[string]struct{}{
 	SettingsFieldPlatformHaInstancePollInterval:                                 struct{}{},
 	SettingsFieldPlatformHaInstanceActiveExpireTime:                             struct{}{},
 	SettingsFieldCcrmApiTimeout:                                                 struct{}{},
+	SettingsFieldTrustPolicyFqdnRefreshInterval:                                 struct{}{},
 })
 
 func (m *Settings) ValidateUpdateFields() error {
@@ -1712,6 +1736,12 @@ func (m *Settings) CopyInFields(src *Settings) int {
 			changed++
 		}
 	}
+	if fmap.Has("45") {
+		if m.TrustPolicyFqdnRefreshInterval != src.TrustPolicyFqdnRefreshInterval {
+			m.TrustPolicyFqdnRefreshInterval = src.TrustPolicyFqdnRefreshInterval
+			changed++
+		}
+	}
 	return changed
 }
 
@@ -1765,6 +1795,7 @@ func (m *Settings) DeepCopyIn(src *Settings) {
 	m.PlatformHaInstancePollInterval = src.PlatformHaInstancePollInterval
 	m.PlatformHaInstanceActiveExpireTime = src.PlatformHaInstanceActiveExpireTime
 	m.CcrmApiTimeout = src.CcrmApiTimeout
+	m.TrustPolicyFqdnRefreshInterval = src.TrustPolicyFqdnRefreshInterval
 }
 
 func (s *Settings) HasFields() bool {
@@ -2594,6 +2625,9 @@ func (m *Settings) Size() (n int) {
 	if m.CcrmApiTimeout != 0 {
 		n += 2 + sovSettings(uint64(m.CcrmApiTimeout))
 	}
+	if m.TrustPolicyFqdnRefreshInterval != 0 {
+		n += 2 + sovSettings(uint64(m.TrustPolicyFqdnRefreshInterval))
+	}
 	return n
 }
 
@@ -3452,6 +3486,25 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustPolicyFqdnRefreshInterval", wireType)
+			}
+			m.TrustPolicyFqdnRefreshInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustPolicyFqdnRefreshInterval |= Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -44,6 +44,8 @@ type SecurityRule struct {
 	PortRangeMax uint32 `protobuf:"varint,3,opt,name=port_range_max,json=portRangeMax,proto3" json:"port_range_max,omitempty"`
 	// Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6
 	RemoteCidr string `protobuf:"bytes,4,opt,name=remote_cidr,json=remoteCidr,proto3" json:"remote_cidr,omitempty"`
+	// Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.
+	RemoteFqdn string `protobuf:"bytes,5,opt,name=remote_fqdn,json=remoteFqdn,proto3" json:"remote_fqdn,omitempty"`
 }
 
 func (m *SecurityRule) Reset()         { *m = SecurityRule{} }
@@ -90,6 +92,8 @@ type TrustPolicy struct {
 	OutboundSecurityRules []SecurityRule `protobuf:"bytes,3,rep,name=outbound_security_rules,json=outboundSecurityRules,proto3" json:"outbound_security_rules"`
 	// Preparing to be deleted
 	DeletePrepare bool `protobuf:"varint,4,opt,name=delete_prepare,json=deletePrepare,proto3" json:"delete_prepare,omitempty"`
+	// List of inbound security rules for whitelisting traffic to application instances
+	InboundSecurityRules []SecurityRule `protobuf:"bytes,5,rep,name=inbound_security_rules,json=inboundSecurityRules,proto3" json:"inbound_security_rules"`
 }
 
 func (m *TrustPolicy) Reset()         { *m = TrustPolicy{} }
@@ -133,47 +137,49 @@ func init() {
 func init() { proto.RegisterFile("trustpolicy.proto", fileDescriptor_0ac2a49c998f3261) }
 
 var fileDescriptor_0ac2a49c998f3261 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xc7, 0x6f, 0x38, 0x7e, 0x04, 0xe6, 0x80, 0x1f, 0xb7, 0xf2, 0x67, 0x72, 0xc1, 0xe5, 0x72,
-	0xb1, 0xb8, 0xe0, 0x79, 0x6b, 0xb0, 0x23, 0xa1, 0x00, 0xec, 0x08, 0x8a, 0x8b, 0xd8, 0x5e, 0x86,
-	0xdd, 0xc7, 0x65, 0xc2, 0xde, 0xce, 0x66, 0x66, 0x36, 0x70, 0x56, 0xc6, 0xd6, 0x86, 0x68, 0x22,
-	0xc6, 0x57, 0x40, 0xb4, 0x31, 0xbe, 0x06, 0x0b, 0x4a, 0x12, 0x1b, 0x2b, 0xa3, 0x60, 0x61, 0xa8,
-	0x4c, 0x58, 0xa8, 0xcd, 0xcd, 0x2e, 0x97, 0xf5, 0x90, 0xc4, 0xd0, 0xd8, 0x3d, 0xcf, 0xf7, 0x79,
-	0x66, 0x9e, 0xcf, 0xf3, 0xdd, 0x59, 0x5c, 0x54, 0x22, 0x92, 0x2a, 0xe4, 0x3e, 0x73, 0x5a, 0xf5,
-	0x50, 0x70, 0xc5, 0x8d, 0x01, 0x70, 0x3d, 0xd0, 0x61, 0x69, 0xd2, 0xe3, 0xdc, 0xf3, 0xc1, 0xa2,
-	0x21, 0xb3, 0x68, 0x10, 0x70, 0x45, 0x15, 0xe3, 0x81, 0x4c, 0x1a, 0x4b, 0x83, 0x02, 0x64, 0xe4,
-	0xab, 0x34, 0xbb, 0xae, 0x38, 0xf7, 0xa5, 0xa5, 0x13, 0x0f, 0x82, 0x4e, 0x90, 0x96, 0x47, 0x3d,
-	0xee, 0x71, 0x1d, 0x5a, 0xed, 0x28, 0x55, 0xc7, 0x68, 0xa4, 0xb8, 0x74, 0xa8, 0x0f, 0x59, 0x84,
-	0xca, 0x2e, 0xc2, 0x83, 0xab, 0xe0, 0x44, 0x82, 0xa9, 0x96, 0x1d, 0xf9, 0x60, 0x94, 0x70, 0xbf,
-	0xae, 0x38, 0xdc, 0x27, 0xa8, 0x8c, 0xaa, 0x03, 0x76, 0x27, 0x37, 0x6e, 0xe0, 0xe1, 0x90, 0x0b,
-	0xd5, 0x10, 0x34, 0xf0, 0xa0, 0xd1, 0x64, 0x01, 0xe9, 0x29, 0xa3, 0xea, 0x90, 0x3d, 0xd8, 0x56,
-	0xed, 0xb6, 0xb8, 0xcc, 0x82, 0xee, 0x2e, 0xba, 0x4d, 0xf2, 0xdd, 0x5d, 0x74, 0xdb, 0x98, 0xc2,
-	0x05, 0x01, 0x4d, 0xae, 0xa0, 0xe1, 0x30, 0x57, 0x90, 0x5e, 0x3d, 0x0a, 0x27, 0xd2, 0x22, 0x73,
-	0x45, 0xe5, 0x63, 0x0f, 0x2e, 0x3c, 0x6c, 0x5b, 0xb6, 0xa2, 0x79, 0x8d, 0x71, 0xdc, 0xf7, 0x98,
-	0x81, 0xef, 0x4a, 0x82, 0xca, 0xf9, 0xea, 0x80, 0x9d, 0x66, 0x46, 0x0d, 0xe7, 0x37, 0xa1, 0xa5,
-	0x49, 0x0a, 0x33, 0xa3, 0xf5, 0x8e, 0xa5, 0xf5, 0xe4, 0xdc, 0x12, 0xb4, 0x16, 0x7a, 0xf7, 0xbf,
-	0x4c, 0xe5, 0xec, 0x76, 0x9b, 0xb1, 0x86, 0x27, 0x78, 0xa4, 0xd6, 0x79, 0x14, 0xb8, 0x0d, 0x99,
-	0xee, 0xdd, 0x10, 0x91, 0x0f, 0x92, 0xe4, 0xcb, 0xf9, 0x6a, 0x61, 0x66, 0x22, 0x73, 0x43, 0xd6,
-	0x98, 0xf4, 0x92, 0xb1, 0xf3, 0xd3, 0xd9, 0x9a, 0x34, 0x6e, 0xe2, 0x61, 0x17, 0x7c, 0x50, 0xd0,
-	0x08, 0x05, 0x84, 0x54, 0x80, 0x5e, 0xa8, 0x7f, 0xa1, 0x77, 0x2f, 0x26, 0xc8, 0x1e, 0x4a, 0x6a,
-	0x2b, 0x49, 0x69, 0x76, 0xf3, 0xc7, 0x09, 0x41, 0x3f, 0x4f, 0x08, 0x7a, 0x1a, 0x13, 0xb4, 0x13,
-	0x13, 0xf4, 0x3a, 0x26, 0xe8, 0x7d, 0x4c, 0xd0, 0x8b, 0x53, 0x32, 0x74, 0x37, 0xdb, 0xfa, 0xe6,
-	0x94, 0x4c, 0x07, 0xb4, 0x09, 0x73, 0x4b, 0xd0, 0xaa, 0xdf, 0xa3, 0x4d, 0xa8, 0x39, 0x3e, 0x8f,
-	0x5c, 0x1f, 0x14, 0x17, 0x9e, 0x16, 0xef, 0x0b, 0x8f, 0x06, 0xec, 0x89, 0x7e, 0x2d, 0x1f, 0xce,
-	0xc8, 0xc8, 0x26, 0xb4, 0xe6, 0xb2, 0xda, 0xcc, 0xf3, 0xff, 0xf0, 0x70, 0xc6, 0xc6, 0xf9, 0x90,
-	0x19, 0xef, 0x10, 0x2e, 0x2e, 0x0a, 0xa0, 0x0a, 0x7e, 0xf3, 0x37, 0xb3, 0x78, 0x46, 0x2f, 0x15,
-	0x33, 0xba, 0xad, 0x9f, 0x61, 0x85, 0x1d, 0xc7, 0xc4, 0xb2, 0x41, 0xf2, 0x48, 0x38, 0xb0, 0x98,
-	0x12, 0xc9, 0xda, 0xbc, 0xd3, 0x9e, 0xb8, 0x4c, 0x03, 0xea, 0x41, 0xad, 0x1b, 0x6e, 0xef, 0x94,
-	0xa0, 0xb7, 0x67, 0x64, 0xa4, 0x5b, 0x7f, 0xf6, 0xe9, 0xfb, 0xcb, 0x1e, 0x52, 0xb9, 0x66, 0x39,
-	0x9a, 0xc8, 0xca, 0xfc, 0x25, 0xb3, 0x68, 0xfa, 0x36, 0x32, 0x76, 0x11, 0x2e, 0x26, 0xa6, 0x5c,
-	0x91, 0x76, 0xed, 0x8a, 0xb4, 0x1d, 0xb2, 0xe4, 0xeb, 0xfd, 0x99, 0x6c, 0x2d, 0x74, 0xe9, 0xbf,
-	0x23, 0x8b, 0xf4, 0xf4, 0x8b, 0x64, 0xaf, 0x10, 0xfe, 0x7f, 0x75, 0x83, 0x6f, 0xfd, 0x0d, 0xd7,
-	0x25, 0x7a, 0xe5, 0xc1, 0x71, 0x4c, 0x6e, 0x5d, 0x06, 0xf7, 0x88, 0xc1, 0xd6, 0x05, 0xb4, 0x83,
-	0x73, 0xb4, 0xf1, 0x4a, 0xd1, 0x92, 0x1b, 0x7c, 0xeb, 0x02, 0xd8, 0xc2, 0xe4, 0xfe, 0x37, 0x33,
-	0xb7, 0x7f, 0x68, 0xa2, 0x83, 0x43, 0x13, 0x7d, 0x3d, 0x34, 0xd1, 0xce, 0x91, 0x99, 0x3b, 0x38,
-	0x32, 0x73, 0x9f, 0x8f, 0xcc, 0xdc, 0x7a, 0x9f, 0xa6, 0xb8, 0xf3, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0xfa, 0x4b, 0xcd, 0x2b, 0x05, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0x3d, 0x4f, 0x1b, 0x4d,
+	0x10, 0xc7, 0xbd, 0x18, 0x10, 0xac, 0x81, 0x07, 0xdf, 0xc3, 0xcb, 0xca, 0x22, 0xc6, 0xb2, 0x52,
+	0x58, 0xc4, 0xf1, 0x45, 0xa4, 0x43, 0xa2, 0x00, 0xa2, 0x34, 0x88, 0x84, 0x1c, 0x21, 0xad, 0xb5,
+	0xdc, 0x0d, 0xc7, 0x8a, 0xf3, 0xee, 0xb1, 0xb7, 0x27, 0x70, 0xaa, 0x28, 0x6d, 0x1a, 0x94, 0x48,
+	0x79, 0xfb, 0x04, 0x28, 0x69, 0xa2, 0x54, 0xf9, 0x08, 0x94, 0x48, 0x69, 0x52, 0x45, 0x09, 0xa4,
+	0x88, 0xa8, 0x22, 0x61, 0xa8, 0x23, 0xef, 0x1d, 0xd6, 0x61, 0x40, 0x42, 0x34, 0xe9, 0x66, 0xfe,
+	0xf3, 0xdf, 0x9b, 0xdf, 0xce, 0xce, 0xe1, 0xac, 0x92, 0x61, 0xa0, 0x7c, 0xe1, 0x31, 0xbb, 0x5e,
+	0xf1, 0xa5, 0x50, 0xc2, 0xe8, 0x05, 0xc7, 0x05, 0x1d, 0xe6, 0xc6, 0x5c, 0x21, 0x5c, 0x0f, 0x4c,
+	0xea, 0x33, 0x93, 0x72, 0x2e, 0x14, 0x55, 0x4c, 0xf0, 0x20, 0x32, 0xe6, 0xfa, 0x24, 0x04, 0xa1,
+	0xa7, 0xe2, 0xec, 0x86, 0x12, 0xc2, 0x0b, 0x4c, 0x9d, 0xb8, 0xc0, 0x5b, 0x41, 0x5c, 0x1e, 0x72,
+	0x85, 0x2b, 0x74, 0x68, 0x36, 0xa3, 0x58, 0x1d, 0xa6, 0xa1, 0x12, 0x81, 0x4d, 0x3d, 0x48, 0x22,
+	0x14, 0xbf, 0x20, 0xdc, 0xb7, 0x04, 0x76, 0x28, 0x99, 0xaa, 0x5b, 0xa1, 0x07, 0x46, 0x0e, 0xf7,
+	0xe8, 0x8a, 0x2d, 0x3c, 0x82, 0x0a, 0xa8, 0xd4, 0x6b, 0xb5, 0x72, 0xe3, 0x26, 0x1e, 0xf0, 0x85,
+	0x54, 0x55, 0x49, 0xb9, 0x0b, 0xd5, 0x1a, 0xe3, 0xa4, 0xa3, 0x80, 0x4a, 0xfd, 0x56, 0x5f, 0x53,
+	0xb5, 0x9a, 0xe2, 0x02, 0xe3, 0xed, 0x2e, 0xba, 0x45, 0xd2, 0xed, 0x2e, 0xba, 0x65, 0x8c, 0xe3,
+	0x8c, 0x84, 0x9a, 0x50, 0x50, 0xb5, 0x99, 0x23, 0x49, 0xa7, 0x6e, 0x85, 0x23, 0x69, 0x8e, 0x39,
+	0x32, 0x61, 0x58, 0xdd, 0x70, 0x38, 0xe9, 0x4a, 0x1a, 0xee, 0x6f, 0x38, 0xbc, 0xf8, 0x2e, 0x8d,
+	0x33, 0x8f, 0x9b, 0x33, 0x5d, 0xd4, 0x17, 0x32, 0x46, 0x70, 0xf7, 0x2a, 0x03, 0xcf, 0x09, 0x08,
+	0x2a, 0xa4, 0x4b, 0xbd, 0x56, 0x9c, 0x19, 0x65, 0x9c, 0x5e, 0x87, 0xba, 0x46, 0xcd, 0x4c, 0x0e,
+	0x55, 0x5a, 0x33, 0xaf, 0x44, 0xe7, 0xe6, 0xa1, 0x3e, 0xdb, 0xb9, 0xfb, 0x7d, 0x3c, 0x65, 0x35,
+	0x6d, 0xc6, 0x32, 0x1e, 0x15, 0xa1, 0x5a, 0x11, 0x21, 0x77, 0xaa, 0x41, 0x3c, 0x98, 0xaa, 0x0c,
+	0x3d, 0x08, 0x48, 0xba, 0x90, 0x2e, 0x65, 0x26, 0x47, 0x13, 0x5f, 0x48, 0x4e, 0x2e, 0xfe, 0xc8,
+	0xf0, 0xe9, 0xe9, 0x64, 0x2d, 0x30, 0x6e, 0xe1, 0x01, 0x07, 0x3c, 0x50, 0x50, 0xf5, 0x25, 0xf8,
+	0x54, 0x82, 0xbe, 0x71, 0xcf, 0x6c, 0xe7, 0x4e, 0x83, 0x20, 0xab, 0x3f, 0xaa, 0x2d, 0x46, 0x25,
+	0x63, 0x09, 0x8f, 0x30, 0x7e, 0x21, 0x42, 0xd7, 0x55, 0x10, 0x86, 0xe2, 0xc3, 0x67, 0x08, 0xa6,
+	0xd6, 0x7f, 0x1f, 0x11, 0xf4, 0xe7, 0x88, 0xa0, 0x67, 0x0d, 0x82, 0xb6, 0x1b, 0x04, 0xbd, 0x6d,
+	0x10, 0xf4, 0xa9, 0x41, 0xd0, 0xcb, 0x63, 0xd2, 0x7f, 0x2f, 0xd9, 0xff, 0xfd, 0x31, 0x99, 0xe0,
+	0xb4, 0x06, 0xd3, 0xf3, 0x50, 0xaf, 0x3c, 0xa0, 0x35, 0x28, 0xdb, 0x9e, 0x08, 0x1d, 0x0f, 0x94,
+	0x90, 0xae, 0x16, 0x1f, 0x4a, 0x97, 0x72, 0xf6, 0x54, 0xef, 0xe8, 0xe7, 0x13, 0x32, 0xb8, 0x0e,
+	0xf5, 0xe9, 0xa4, 0x36, 0xf9, 0xa2, 0x0b, 0x0f, 0x24, 0xde, 0x66, 0xc6, 0x67, 0xc6, 0x47, 0x84,
+	0xb3, 0x73, 0x12, 0xa8, 0x82, 0x33, 0x8f, 0x96, 0xb8, 0x4a, 0x42, 0xcf, 0x65, 0x13, 0xba, 0xa5,
+	0x97, 0xbf, 0xc8, 0x0e, 0x1b, 0xc4, 0xb4, 0x20, 0x10, 0xa1, 0xb4, 0x61, 0x2e, 0x26, 0x0a, 0xca,
+	0x33, 0x76, 0xb3, 0xe3, 0x02, 0xe5, 0xd4, 0x85, 0x72, 0x3b, 0xdc, 0xce, 0x31, 0x41, 0x1f, 0x4e,
+	0xc8, 0x60, 0xbb, 0xfe, 0xfc, 0xeb, 0xaf, 0x57, 0x1d, 0xa4, 0xf8, 0xbf, 0x69, 0x6b, 0x22, 0x33,
+	0xf1, 0x6f, 0x4e, 0xa1, 0x89, 0x3b, 0xc8, 0x78, 0x83, 0x70, 0x36, 0x1a, 0xca, 0x35, 0x69, 0x97,
+	0xaf, 0x49, 0xdb, 0x22, 0x8b, 0x56, 0xe2, 0x62, 0xb2, 0x65, 0xdf, 0xa1, 0xff, 0x8e, 0x2c, 0xd4,
+	0xdd, 0xcf, 0x93, 0xbd, 0x46, 0xf8, 0xbf, 0xa5, 0x35, 0xb1, 0x79, 0x15, 0xae, 0x4b, 0xf4, 0xe2,
+	0xa3, 0xc3, 0x06, 0xb9, 0x7d, 0x19, 0xdc, 0x13, 0x06, 0x9b, 0xe7, 0xd0, 0xf6, 0x4e, 0xd1, 0x46,
+	0x8a, 0x59, 0x33, 0x58, 0x13, 0x9b, 0xe7, 0xc0, 0x66, 0xc7, 0x76, 0x7f, 0xe6, 0x53, 0xbb, 0xfb,
+	0x79, 0xb4, 0xb7, 0x9f, 0x47, 0x3f, 0xf6, 0xf3, 0x68, 0xfb, 0x20, 0x9f, 0xda, 0x3b, 0xc8, 0xa7,
+	0xbe, 0x1d, 0xe4, 0x53, 0x2b, 0xdd, 0x9a, 0xe2, 0xee, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10,
+	0x5e, 0x1c, 0xf7, 0xa1, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -500,6 +506,13 @@ func (m *SecurityRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemoteFqdn) > 0 {
+		i -= len(m.RemoteFqdn)
+		copy(dAtA[i:], m.RemoteFqdn)
+		i = encodeVarintTrustpolicy(dAtA, i, uint64(len(m.RemoteFqdn)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.RemoteCidr) > 0 {
 		i -= len(m.RemoteCidr)
 		copy(dAtA[i:], m.RemoteCidr)
@@ -547,6 +560,20 @@ func (m *TrustPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InboundSecurityRules) > 0 {
+		for iNdEx := len(m.InboundSecurityRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundSecurityRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrustpolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.DeletePrepare {
 		i--
 		if m.DeletePrepare {
@@ -628,6 +655,10 @@ func (m *SecurityRule) CopyInFields(src *SecurityRule) int {
 		m.RemoteCidr = src.RemoteCidr
 		changed++
 	}
+	if m.RemoteFqdn != src.RemoteFqdn {
+		m.RemoteFqdn = src.RemoteFqdn
+		changed++
+	}
 	return changed
 }
 
@@ -636,6 +667,7 @@ func (m *SecurityRule) DeepCopyIn(src *SecurityRule) {
 	m.PortRangeMin = src.PortRangeMin
 	m.PortRangeMax = src.PortRangeMax
 	m.RemoteCidr = src.RemoteCidr
+	m.RemoteFqdn = src.RemoteFqdn
 }
 
 // Helper method to check that enums have valid values
@@ -674,6 +706,15 @@ func (m *TrustPolicy) Matches(o *TrustPolicy, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.InboundSecurityRules != nil {
+		if len(m.InboundSecurityRules) == 0 && len(o.InboundSecurityRules) > 0 || len(m.InboundSecurityRules) > 0 && len(o.InboundSecurityRules) == 0 {
+			return false
+		} else if m.InboundSecurityRules != nil && o.InboundSecurityRules != nil {
+			if !opts.Filter && len(m.InboundSecurityRules) != len(o.InboundSecurityRules) {
+				return false
+			}
+		}
+	}
 	return true
 }
 
@@ -685,7 +726,14 @@ const TrustPolicyFieldOutboundSecurityRulesProtocol = "3.1"
 const TrustPolicyFieldOutboundSecurityRulesPortRangeMin = "3.2"
 const TrustPolicyFieldOutboundSecurityRulesPortRangeMax = "3.3"
 const TrustPolicyFieldOutboundSecurityRulesRemoteCidr = "3.4"
+const TrustPolicyFieldOutboundSecurityRulesRemoteFqdn = "3.5"
 const TrustPolicyFieldDeletePrepare = "4"
+const TrustPolicyFieldInboundSecurityRules = "5"
+const TrustPolicyFieldInboundSecurityRulesProtocol = "5.1"
+const TrustPolicyFieldInboundSecurityRulesPortRangeMin = "5.2"
+const TrustPolicyFieldInboundSecurityRulesPortRangeMax = "5.3"
+const TrustPolicyFieldInboundSecurityRulesRemoteCidr = "5.4"
+const TrustPolicyFieldInboundSecurityRulesRemoteFqdn = "5.5"
 
 var TrustPolicyAllFields = []string{
 	TrustPolicyFieldKeyOrganization,
@@ -694,7 +742,13 @@ var TrustPolicyAllFields = []string{
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMin,
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMax,
 	TrustPolicyFieldOutboundSecurityRulesRemoteCidr,
+	TrustPolicyFieldOutboundSecurityRulesRemoteFqdn,
 	TrustPolicyFieldDeletePrepare,
+	TrustPolicyFieldInboundSecurityRulesProtocol,
+	TrustPolicyFieldInboundSecurityRulesPortRangeMin,
+	TrustPolicyFieldInboundSecurityRulesPortRangeMax,
+	TrustPolicyFieldInboundSecurityRulesRemoteCidr,
+	TrustPolicyFieldInboundSecurityRulesRemoteFqdn,
 }
 
 var TrustPolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
@@ -704,7 +758,13 @@ var TrustPolicyAllFieldsMap = NewFieldMap(map[string]struct{}{
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMin: struct{}{},
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMax: struct{}{},
 	TrustPolicyFieldOutboundSecurityRulesRemoteCidr:   struct{}{},
+	TrustPolicyFieldOutboundSecurityRulesRemoteFqdn:   struct{}{},
 	TrustPolicyFieldDeletePrepare:                     struct{}{},
+	TrustPolicyFieldInboundSecurityRulesProtocol:      struct{}{},
+	TrustPolicyFieldInboundSecurityRulesPortRangeMin:  struct{}{},
+	TrustPolicyFieldInboundSecurityRulesPortRangeMax:  struct{}{},
+	TrustPolicyFieldInboundSecurityRulesRemoteCidr:    struct{}{},
+	TrustPolicyFieldInboundSecurityRulesRemoteFqdn:    struct{}{},
 })
 
 var TrustPolicyAllFieldsStringMap = map[string]string{
@@ -714,7 +774,13 @@ var TrustPolicyAllFieldsStringMap = map[string]string{
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMin: "Outbound Security Rules Port Range Min",
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMax: "Outbound Security Rules Port Range Max",
 	TrustPolicyFieldOutboundSecurityRulesRemoteCidr:   "Outbound Security Rules Remote Cidr",
+	TrustPolicyFieldOutboundSecurityRulesRemoteFqdn:   "Outbound Security Rules Remote Fqdn",
 	TrustPolicyFieldDeletePrepare:                     "Delete Prepare",
+	TrustPolicyFieldInboundSecurityRulesProtocol:      "Inbound Security Rules Protocol",
+	TrustPolicyFieldInboundSecurityRulesPortRangeMin:  "Inbound Security Rules Port Range Min",
+	TrustPolicyFieldInboundSecurityRulesPortRangeMax:  "Inbound Security Rules Port Range Max",
+	TrustPolicyFieldInboundSecurityRulesRemoteCidr:    "Inbound Security Rules Remote Cidr",
+	TrustPolicyFieldInboundSecurityRulesRemoteFqdn:    "Inbound Security Rules Remote Fqdn",
 }
 
 func (m *TrustPolicy) IsKeyField(s string) bool {
@@ -750,11 +816,41 @@ func (m *TrustPolicy) DiffFields(o *TrustPolicy, fields *FieldMap) {
 				fields.Set(TrustPolicyFieldOutboundSecurityRulesRemoteCidr)
 				fields.Set(TrustPolicyFieldOutboundSecurityRules)
 			}
+			if m.OutboundSecurityRules[i0].RemoteFqdn != o.OutboundSecurityRules[i0].RemoteFqdn {
+				fields.Set(TrustPolicyFieldOutboundSecurityRulesRemoteFqdn)
+				fields.Set(TrustPolicyFieldOutboundSecurityRules)
+			}
 		}
 	}
 	if m.DeletePrepare != o.DeletePrepare {
 		fields.Set(TrustPolicyFieldDeletePrepare)
 	}
+	if len(m.InboundSecurityRules) != len(o.InboundSecurityRules) {
+		fields.Set(TrustPolicyFieldInboundSecurityRules)
+	} else {
+		for i0 := 0; i0 < len(m.InboundSecurityRules); i0++ {
+			if m.InboundSecurityRules[i0].Protocol != o.InboundSecurityRules[i0].Protocol {
+				fields.Set(TrustPolicyFieldInboundSecurityRulesProtocol)
+				fields.Set(TrustPolicyFieldInboundSecurityRules)
+			}
+			if m.InboundSecurityRules[i0].PortRangeMin != o.InboundSecurityRules[i0].PortRangeMin {
+				fields.Set(TrustPolicyFieldInboundSecurityRulesPortRangeMin)
+				fields.Set(TrustPolicyFieldInboundSecurityRules)
+			}
+			if m.InboundSecurityRules[i0].PortRangeMax != o.InboundSecurityRules[i0].PortRangeMax {
+				fields.Set(TrustPolicyFieldInboundSecurityRulesPortRangeMax)
+				fields.Set(TrustPolicyFieldInboundSecurityRules)
+			}
+			if m.InboundSecurityRules[i0].RemoteCidr != o.InboundSecurityRules[i0].RemoteCidr {
+				fields.Set(TrustPolicyFieldInboundSecurityRulesRemoteCidr)
+				fields.Set(TrustPolicyFieldInboundSecurityRules)
+			}
+			if m.InboundSecurityRules[i0].RemoteFqdn != o.InboundSecurityRules[i0].RemoteFqdn {
+				fields.Set(TrustPolicyFieldInboundSecurityRulesRemoteFqdn)
+				fields.Set(TrustPolicyFieldInboundSecurityRules)
+			}
+		}
+	}
 }
 
 func (m *TrustPolicy) GetDiffFields(o *TrustPolicy) *FieldMap {
@@ -769,6 +865,13 @@ var UpdateTrustPolicyFieldsMap = NewFieldMap(map[string]struct{}{
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMin: struct{}{},
 	TrustPolicyFieldOutboundSecurityRulesPortRangeMax: struct{}{},
 	TrustPolicyFieldOutboundSecurityRulesRemoteCidr:   struct{}{},
+	TrustPolicyFieldOutboundSecurityRulesRemoteFqdn:   struct{}{},
+	TrustPolicyFieldInboundSecurityRules:              struct{}{},
+	TrustPolicyFieldInboundSecurityRulesProtocol:      struct{}{},
+	TrustPolicyFieldInboundSecurityRulesPortRangeMin:  struct{}{},
+	TrustPolicyFieldInboundSecurityRulesPortRangeMax:  struct{}{},
+	TrustPolicyFieldInboundSecurityRulesRemoteCidr:    struct{}{},
+	TrustPolicyFieldInboundSecurityRulesRemoteFqdn:    struct{}{},
 })
 
 func (m *TrustPolicy) ValidateUpdateFields() error {
@@ -835,6 +938,37 @@ func (m *TrustPolicy) RemoveOutboundSecurityRules(vals ...SecurityRule) int {
 	return changes
 }
 
+func (m *TrustPolicy) AddInboundSecurityRules(vals ...SecurityRule) int {
+	changes := 0
+	cur := make(map[string]struct{})
+	for _, v := range m.InboundSecurityRules {
+		cur[v.String()] = struct{}{}
+	}
+	for _, v := range vals {
+		if _, found := cur[v.String()]; found {
+			continue // duplicate
+		}
+		m.InboundSecurityRules = append(m.InboundSecurityRules, v)
+		changes++
+	}
+	return changes
+}
+
+func (m *TrustPolicy) RemoveInboundSecurityRules(vals ...SecurityRule) int {
+	changes := 0
+	remove := make(map[string]struct{})
+	for _, v := range vals {
+		remove[v.String()] = struct{}{}
+	}
+	for i := len(m.InboundSecurityRules); i >= 0; i-- {
+		if _, found := remove[m.InboundSecurityRules[i].String()]; found {
+			m.InboundSecurityRules = append(m.InboundSecurityRules[:i], m.InboundSecurityRules[i+1:]...)
+			changes++
+		}
+	}
+	return changes
+}
+
 func (m *TrustPolicy) CopyInFields(src *TrustPolicy) int {
 	updateListAction := "replace"
 	changed := 0
@@ -877,6 +1011,24 @@ func (m *TrustPolicy) CopyInFields(src *TrustPolicy) int {
 			changed++
 		}
 	}
+	if fmap.HasOrHasChild("5") {
+		if src.InboundSecurityRules != nil {
+			if updateListAction == "add" {
+				changed += m.AddInboundSecurityRules(src.InboundSecurityRules...)
+			} else if updateListAction == "remove" {
+				changed += m.RemoveInboundSecurityRules(src.InboundSecurityRules...)
+			} else {
+				m.InboundSecurityRules = make([]SecurityRule, 0)
+				for k0, _ := range src.InboundSecurityRules {
+					m.InboundSecurityRules = append(m.InboundSecurityRules, *src.InboundSecurityRules[k0].Clone())
+				}
+				changed++
+			}
+		} else if m.InboundSecurityRules != nil {
+			m.InboundSecurityRules = nil
+			changed++
+		}
+	}
 	return changed
 }
 
@@ -891,6 +1043,14 @@ func (m *TrustPolicy) DeepCopyIn(src *TrustPolicy) {
 		m.OutboundSecurityRules = nil
 	}
 	m.DeletePrepare = src.DeletePrepare
+	if src.InboundSecurityRules != nil {
+		m.InboundSecurityRules = make([]SecurityRule, len(src.InboundSecurityRules), len(src.InboundSecurityRules))
+		for ii, s := range src.InboundSecurityRules {
+			m.InboundSecurityRules[ii].DeepCopyIn(&s)
+		}
+	} else {
+		m.InboundSecurityRules = nil
+	}
 }
 
 func (s *TrustPolicy) HasFields() bool {
@@ -1563,6 +1723,11 @@ func (m *TrustPolicy) ValidateEnums() error {
 			return err
 		}
 	}
+	for _, e := range m.InboundSecurityRules {
+		if err := e.ValidateEnums(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1573,6 +1738,11 @@ func (s *TrustPolicy) ClearTagged(tags map[string]struct{}) {
 			s.OutboundSecurityRules[ii].ClearTagged(tags)
 		}
 	}
+	if s.InboundSecurityRules != nil {
+		for ii := 0; ii < len(s.InboundSecurityRules); ii++ {
+			s.InboundSecurityRules[ii].ClearTagged(tags)
+		}
+	}
 }
 
 func (m *TrustPolicy) IsValidArgsForCreateTrustPolicy() error {
@@ -1616,6 +1786,10 @@ func (m *SecurityRule) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTrustpolicy(uint64(l))
 	}
+	l = len(m.RemoteFqdn)
+	if l > 0 {
+		n += 1 + l + sovTrustpolicy(uint64(l))
+	}
 	return n
 }
 
@@ -1642,6 +1816,12 @@ func (m *TrustPolicy) Size() (n int) {
 	if m.DeletePrepare {
 		n += 2
 	}
+	if len(m.InboundSecurityRules) > 0 {
+		for _, e := range m.InboundSecurityRules {
+			l = e.Size()
+			n += 1 + l + sovTrustpolicy(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1782,6 +1962,38 @@ func (m *SecurityRule) Unmarshal(dAtA []byte) error {
 			}
 			m.RemoteCidr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteFqdn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrustpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrustpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrustpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteFqdn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrustpolicy(dAtA[iNdEx:])
@@ -1951,6 +2163,40 @@ func (m *TrustPolicy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DeletePrepare = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSecurityRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrustpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrustpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrustpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundSecurityRules = append(m.InboundSecurityRules, SecurityRule{})
+			if err := m.InboundSecurityRules[len(m.InboundSecurityRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrustpolicy(dAtA[iNdEx:])
@@ -1042,6 +1042,7 @@ const TrustPolicyExceptionFieldOutboundSecurityRulesProtocol = "4.1"
 const TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMin = "4.2"
 const TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMax = "4.3"
 const TrustPolicyExceptionFieldOutboundSecurityRulesRemoteCidr = "4.4"
+const TrustPolicyExceptionFieldOutboundSecurityRulesRemoteFqdn = "4.5"
 
 var TrustPolicyExceptionAllFields = []string{
 	TrustPolicyExceptionFieldKeyAppKeyOrganization,
@@ -1055,6 +1056,7 @@ var TrustPolicyExceptionAllFields = []string{
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMin,
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMax,
 	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteCidr,
+	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteFqdn,
 }
 
 var TrustPolicyExceptionAllFieldsMap = NewFieldMap(map[string]struct{}{
@@ -1069,6 +1071,7 @@ var TrustPolicyExceptionAllFieldsMap = NewFieldMap(map[string]struct{}{
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMin: struct{}{},
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMax: struct{}{},
 	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteCidr:   struct{}{},
+	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteFqdn:   struct{}{},
 })
 
 var TrustPolicyExceptionAllFieldsStringMap = map[string]string{
@@ -1083,6 +1086,7 @@ var TrustPolicyExceptionAllFieldsStringMap = map[string]string{
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMin: "Outbound Security Rules Port Range Min",
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMax: "Outbound Security Rules Port Range Max",
 	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteCidr:   "Outbound Security Rules Remote Cidr",
+	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteFqdn:   "Outbound Security Rules Remote Fqdn",
 }
 
 func (m *TrustPolicyException) IsKeyField(s string) bool {
@@ -1142,6 +1146,10 @@ func (m *TrustPolicyException) DiffFields(o *TrustPolicyException, fields *Field
 				fields.Set(TrustPolicyExceptionFieldOutboundSecurityRulesRemoteCidr)
 				fields.Set(TrustPolicyExceptionFieldOutboundSecurityRules)
 			}
+			if m.OutboundSecurityRules[i0].RemoteFqdn != o.OutboundSecurityRules[i0].RemoteFqdn {
+				fields.Set(TrustPolicyExceptionFieldOutboundSecurityRulesRemoteFqdn)
+				fields.Set(TrustPolicyExceptionFieldOutboundSecurityRules)
+			}
 		}
 	}
 }
@@ -1159,6 +1167,7 @@ var UpdateTrustPolicyExceptionFieldsMap = NewFieldMap(map[string]struct{}{
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMin: struct{}{},
 	TrustPolicyExceptionFieldOutboundSecurityRulesPortRangeMax: struct{}{},
 	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteCidr:   struct{}{},
+	TrustPolicyExceptionFieldOutboundSecurityRulesRemoteFqdn:   struct{}{},
 })
 
 func (m *TrustPolicyException) ValidateUpdateFields() error {
@@ -53,6 +53,8 @@ type Zone struct {
 	InfraFlavors []*FlavorInfo `protobuf:"bytes,4,rep,name=infra_flavors,json=infraFlavors,proto3" json:"infra_flavors,omitempty"`
 	// Geo location, used for Federation and UI display
 	Location distributed_match_engine.Loc `protobuf:"bytes,5,opt,name=location,proto3" json:"location"`
+	// IANA time zone name (e.g. America/Los_Angeles) used to evaluate time-of-day schedules, defaults to UTC
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	// Universally unique object ID
 	ObjId string `protobuf:"bytes,89,opt,name=obj_id,json=objId,proto3" json:"obj_id,omitempty"`
 	// Preparing to be deleted
@@ -103,52 +105,53 @@ func init() {
 func init() { proto.RegisterFile("zone.proto", fileDescriptor_b71619a26b0a679a) }
 
 var fileDescriptor_b71619a26b0a679a = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xdf, 0x4b, 0x1b, 0x59,
-	0x14, 0xce, 0x35, 0x89, 0x6b, 0xae, 0xc6, 0x1f, 0xc3, 0xba, 0x5c, 0x45, 0xb3, 0x21, 0xbb, 0x0f,
-	0x41, 0x87, 0xcc, 0xe2, 0xc2, 0x3e, 0x08, 0xb2, 0x9b, 0xb8, 0x08, 0xa2, 0xbb, 0x2d, 0x69, 0x2d,
-	0x68, 0x0b, 0xe1, 0x66, 0xe6, 0x64, 0xbc, 0x3a, 0xb9, 0x77, 0x98, 0xb9, 0xa9, 0x8d, 0x4f, 0xa5,
-	0xcf, 0x7d, 0x90, 0xf6, 0xa5, 0xf4, 0x2f, 0x28, 0x85, 0x42, 0xf1, 0xaf, 0xf0, 0x51, 0xe8, 0x4b,
-	0xe9, 0x43, 0x69, 0xb5, 0x0f, 0xc5, 0xa7, 0x82, 0x89, 0xcf, 0x65, 0xee, 0x4c, 0xc2, 0x20, 0x16,
-	0x5a, 0x4a, 0xdf, 0xce, 0xf9, 0xce, 0x8f, 0xef, 0x3b, 0xe7, 0x9e, 0x8b, 0xf1, 0xbe, 0xe0, 0x50,
-	0x72, 0x3d, 0x21, 0x85, 0x96, 0x01, 0xcb, 0x06, 0x65, 0x4e, 0xcf, 0xd8, 0x42, 0xd8, 0x0e, 0x18,
-	0xd4, 0x65, 0x06, 0xe5, 0x5c, 0x48, 0x2a, 0x99, 0xe0, 0x7e, 0x98, 0x38, 0x3d, 0x2b, 0x85, 0x70,
-	0x7c, 0x43, 0x39, 0x36, 0xf0, 0xbe, 0x11, 0x85, 0x27, 0x4c, 0x47, 0xb4, 0x2c, 0x07, 0xe4, 0x2e,
-	0xb4, 0x23, 0x68, 0xc4, 0x03, 0xbf, 0xe5, 0xc8, 0xc8, 0x1b, 0xed, 0x25, 0x44, 0xfe, 0xcf, 0xb6,
-	0xb0, 0x85, 0x32, 0x8d, 0xc0, 0x8a, 0xd0, 0xac, 0xd5, 0x04, 0xc3, 0x11, 0x66, 0xe8, 0x16, 0x5e,
-	0xa4, 0x71, 0x6a, 0x4b, 0x70, 0xd0, 0x7e, 0xc1, 0x83, 0x0d, 0x06, 0x8e, 0xe5, 0x13, 0x94, 0x4f,
-	0x16, 0x33, 0xd5, 0xc8, 0xd3, 0xe6, 0x70, 0x72, 0x17, 0xda, 0x64, 0x20, 0x8f, 0x8a, 0xc3, 0x0b,
-	0x5a, 0xa9, 0x3f, 0x4c, 0x29, 0xa8, 0x5a, 0x83, 0x76, 0x25, 0x75, 0xf4, 0xf6, 0xd7, 0x44, 0x35,
-	0x48, 0xd2, 0xf2, 0x78, 0xd8, 0x02, 0xdf, 0xf4, 0x98, 0x1b, 0xcc, 0x45, 0x92, 0x79, 0x54, 0xcc,
-	0x54, 0xe3, 0x90, 0xf6, 0x0f, 0xce, 0x32, 0xde, 0xf0, 0x68, 0xad, 0xe1, 0xd0, 0xbb, 0xc2, 0xf3,
-	0x49, 0x2a, 0x9f, 0x2c, 0x0e, 0x2f, 0x4c, 0xc6, 0xfa, 0xae, 0xa8, 0xc8, 0x2a, 0x6f, 0x88, 0x4a,
-	0xea, 0x59, 0x87, 0xa0, 0xea, 0x88, 0xaa, 0x08, 0x61, 0x5f, 0xfb, 0x1b, 0x0f, 0x39, 0xc2, 0x54,
-	0x8b, 0x23, 0x69, 0x25, 0x6a, 0xb6, 0x64, 0x31, 0x5f, 0x7a, 0xac, 0xde, 0x92, 0x60, 0xd5, 0x9a,
-	0x54, 0x9a, 0xdb, 0x35, 0xe0, 0x36, 0xe3, 0x50, 0x5a, 0x17, 0x66, 0xa4, 0xaf, 0x5f, 0xa4, 0xfd,
-	0x8e, 0x07, 0x45, 0x7d, 0xa7, 0xc6, 0x2c, 0xb2, 0x19, 0xe8, 0xab, 0x64, 0x03, 0x92, 0x47, 0x87,
-	0x53, 0x69, 0x2e, 0xcc, 0xa6, 0x5b, 0x4d, 0x8b, 0xfa, 0xce, 0xaa, 0xa5, 0xcd, 0xe3, 0x51, 0x0b,
-	0x1c, 0x90, 0x50, 0x73, 0x3d, 0x70, 0xa9, 0x07, 0x64, 0x2b, 0x8f, 0x8a, 0x43, 0x91, 0xa4, 0x6c,
-	0x18, 0xbb, 0x1e, 0x86, 0xb4, 0x4d, 0x8c, 0x4d, 0x0f, 0x68, 0x40, 0x4f, 0x25, 0xb9, 0xad, 0x54,
-	0xfd, 0xf6, 0x65, 0x55, 0x37, 0x59, 0x13, 0x7c, 0x49, 0x9b, 0x6e, 0x65, 0x32, 0xe2, 0xce, 0xc8,
-	0x1e, 0xa4, 0xc4, 0x66, 0xa2, 0x6e, 0x65, 0x19, 0xb4, 0x6e, 0xb9, 0x56, 0xaf, 0xf5, 0x9d, 0xef,
-	0x6f, 0x1d, 0x75, 0x2b, 0xcb, 0xc5, 0x43, 0xf4, 0xf1, 0x9c, 0xa0, 0x4f, 0xe7, 0x04, 0xdd, 0xef,
-	0x10, 0x74, 0xd0, 0x21, 0xe8, 0x49, 0x50, 0xd2, 0x25, 0x1b, 0xcb, 0x3d, 0x7a, 0x7d, 0xa3, 0x97,
-	0xad, 0xff, 0x1b, 0x9f, 0x58, 0x5f, 0x83, 0x76, 0x69, 0x05, 0x2c, 0xf0, 0x82, 0xe8, 0x35, 0xcf,
-	0xa6, 0x9c, 0xed, 0xab, 0xf5, 0xea, 0xeb, 0xd1, 0x9e, 0xf5, 0x28, 0xcc, 0x04, 0x5f, 0x16, 0xbc,
-	0xc1, 0xec, 0xa7, 0x5d, 0x52, 0xe0, 0xb4, 0x09, 0x4b, 0x41, 0xed, 0xff, 0xb4, 0x09, 0xba, 0xf0,
-	0x6c, 0xe5, 0xc4, 0xeb, 0x5f, 0x76, 0x49, 0xe2, 0x4d, 0x97, 0x24, 0xd7, 0xa0, 0x7d, 0x78, 0x41,
-	0xc6, 0x77, 0xa1, 0xbd, 0x14, 0x0f, 0x2f, 0x74, 0x92, 0xf8, 0xa7, 0xe0, 0xf2, 0xca, 0x2e, 0xd3,
-	0x1e, 0x22, 0x8c, 0x43, 0xa9, 0xea, 0x82, 0xc7, 0x2e, 0x1d, 0xe7, 0xf4, 0x44, 0x0c, 0xa8, 0xaa,
-	0x9f, 0x52, 0xd8, 0x3a, 0xeb, 0x90, 0xf9, 0x2a, 0xf8, 0xa2, 0xe5, 0x99, 0xaa, 0xca, 0xd7, 0xcb,
-	0x66, 0xd0, 0xf8, 0x3f, 0xca, 0xa9, 0x1d, 0xce, 0x15, 0xe7, 0x7b, 0x7e, 0x41, 0xc6, 0x2f, 0x63,
-	0x0f, 0x5e, 0x7d, 0x78, 0x3c, 0x30, 0x51, 0x18, 0x31, 0xc2, 0x57, 0x32, 0x82, 0xbf, 0xbe, 0x88,
-	0xe6, 0xb4, 0x7b, 0x18, 0x87, 0x4b, 0xfa, 0x6a, 0x35, 0x2b, 0xdf, 0xa8, 0xa6, 0xcf, 0x1c, 0x9e,
-	0x5f, 0x9c, 0x39, 0x7c, 0xa8, 0x1f, 0xcf, 0x1c, 0x9e, 0x4f, 0x9f, 0xb9, 0x81, 0x87, 0x6e, 0x6c,
-	0x8b, 0xbd, 0xab, 0x79, 0x2f, 0x03, 0x85, 0xbf, 0xce, 0x3a, 0x64, 0xea, 0x2a, 0xd6, 0x5b, 0x0c,
-	0xf6, 0xf4, 0xe3, 0x2e, 0x41, 0x8a, 0x67, 0xac, 0x80, 0x0d, 0x7f, 0x5b, 0xec, 0xf5, 0x58, 0xfe,
-	0x40, 0x95, 0x99, 0xa3, 0xf7, 0xb9, 0xc4, 0xd1, 0x49, 0x0e, 0x1d, 0x9f, 0xe4, 0xd0, 0xbb, 0x93,
-	0x1c, 0x3a, 0x38, 0xcd, 0x25, 0x8e, 0x4f, 0x73, 0x89, 0xd7, 0xa7, 0xb9, 0x44, 0x7d, 0x50, 0x71,
-	0xfc, 0xf9, 0x39, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x2f, 0x56, 0xe1, 0x77, 0x05, 0x00, 0x00,
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcf, 0x6b, 0xdb, 0x48,
+	0x14, 0xf6, 0xc4, 0x3f, 0xd6, 0x9e, 0xc4, 0xf9, 0x21, 0x36, 0xcb, 0xc4, 0x24, 0x5e, 0xe3, 0xdd,
+	0x83, 0x49, 0x84, 0xb5, 0x64, 0x61, 0x0f, 0x81, 0xb0, 0x6b, 0x67, 0x09, 0x84, 0x64, 0x7f, 0xe0,
+	0xdd, 0x14, 0x92, 0x16, 0x8c, 0x2c, 0x3d, 0x2b, 0x93, 0x48, 0x33, 0x42, 0x1a, 0x37, 0x75, 0x4e,
+	0xa5, 0xe7, 0x1e, 0x42, 0x7b, 0x29, 0xfd, 0x0b, 0x4a, 0x4f, 0x25, 0x7f, 0x45, 0x7a, 0x0b, 0xf4,
+	0x52, 0x7a, 0x28, 0x6d, 0xd2, 0x43, 0xc9, 0xa9, 0x10, 0x3b, 0xe7, 0xa2, 0x91, 0x6c, 0x44, 0x48,
+	0xa1, 0xa5, 0xf4, 0x36, 0xef, 0xfb, 0xde, 0x7b, 0xdf, 0xf7, 0xf4, 0x9e, 0x30, 0x3e, 0xe0, 0x0c,
+	0xaa, 0xae, 0xc7, 0x05, 0x57, 0x72, 0x60, 0x5a, 0x20, 0x9f, 0x85, 0x59, 0x8b, 0x73, 0xcb, 0x06,
+	0x4d, 0x77, 0xa9, 0xa6, 0x33, 0xc6, 0x85, 0x2e, 0x28, 0x67, 0x7e, 0x98, 0x58, 0x98, 0x13, 0x9c,
+	0xdb, 0xbe, 0x26, 0x03, 0x0b, 0xd8, 0xf0, 0x11, 0xd1, 0x53, 0x86, 0xcd, 0x3b, 0xa6, 0x0d, 0x62,
+	0x0f, 0xba, 0x11, 0x34, 0xe6, 0x81, 0xdf, 0xb1, 0x45, 0x14, 0x8d, 0x0f, 0x12, 0xa2, 0xf8, 0x7b,
+	0x8b, 0x5b, 0x5c, 0x3e, 0xb5, 0xe0, 0x15, 0xa1, 0x79, 0xd3, 0x01, 0xcd, 0xe6, 0x46, 0x18, 0x96,
+	0x9f, 0xa7, 0x71, 0x6a, 0x9b, 0x33, 0x50, 0x7e, 0xc0, 0x99, 0x36, 0x05, 0xdb, 0xf4, 0x09, 0x2a,
+	0x25, 0x2b, 0xb9, 0x46, 0x14, 0x29, 0xf3, 0x38, 0xb9, 0x07, 0x5d, 0x32, 0x52, 0x42, 0x95, 0xd1,
+	0x45, 0xa5, 0x3a, 0x1c, 0xa6, 0x1a, 0x54, 0xad, 0x43, 0xb7, 0x9e, 0x3a, 0x7e, 0xfd, 0x63, 0xa2,
+	0x11, 0x24, 0x29, 0x25, 0x3c, 0x6a, 0x82, 0x6f, 0x78, 0xd4, 0x0d, 0xe6, 0x22, 0xc9, 0x12, 0xaa,
+	0xe4, 0x1a, 0x71, 0x48, 0xf9, 0x03, 0xe7, 0x29, 0x6b, 0x7b, 0x7a, 0xb3, 0x6d, 0xeb, 0xb7, 0xb9,
+	0xe7, 0x93, 0x54, 0x29, 0x59, 0x19, 0x5d, 0x9c, 0x8e, 0xf5, 0x5d, 0x95, 0xcc, 0x1a, 0x6b, 0xf3,
+	0x7a, 0xea, 0x49, 0x8f, 0xa0, 0xc6, 0x98, 0xac, 0x08, 0x61, 0x5f, 0xf9, 0x1d, 0x67, 0x6d, 0x6e,
+	0xc8, 0x0f, 0x47, 0xd2, 0xd2, 0xd4, 0x5c, 0xd5, 0xa4, 0xbe, 0xf0, 0x68, 0xab, 0x23, 0xc0, 0x6c,
+	0x3a, 0xba, 0x30, 0x76, 0x9a, 0xc0, 0x2c, 0xca, 0xa0, 0xba, 0xc1, 0x8d, 0xc8, 0xdf, 0xb0, 0x48,
+	0x29, 0xe0, 0xac, 0xa0, 0x0e, 0x04, 0x1b, 0x22, 0x19, 0xe9, 0x70, 0x18, 0x2b, 0x3f, 0xe3, 0x0c,
+	0x6f, 0xed, 0x36, 0xa9, 0x49, 0xb6, 0x02, 0xa6, 0x9e, 0x0f, 0x0c, 0x3c, 0x38, 0x9a, 0x49, 0x33,
+	0x6e, 0x38, 0x6e, 0x23, 0xcd, 0x5b, 0xbb, 0x6b, 0xa6, 0xb2, 0x80, 0xc7, 0x4d, 0xb0, 0x41, 0x40,
+	0xd3, 0xf5, 0xc0, 0xd5, 0x3d, 0x20, 0xdb, 0x25, 0x54, 0xc9, 0x46, 0x76, 0xf3, 0x21, 0xf7, 0x6f,
+	0x48, 0x29, 0x5b, 0x18, 0x1b, 0x1e, 0xe8, 0x81, 0x35, 0x5d, 0x90, 0x9b, 0xd2, 0xf1, 0x4f, 0x9f,
+	0x76, 0xfc, 0x3f, 0x75, 0xc0, 0x17, 0xba, 0xe3, 0xd6, 0xa7, 0x23, 0xed, 0x9c, 0x18, 0x40, 0x72,
+	0x90, 0x5c, 0xd4, 0xad, 0x26, 0x82, 0xd6, 0x1d, 0xd7, 0x1c, 0xb4, 0xbe, 0xf5, 0xf5, 0xad, 0xa3,
+	0x6e, 0x35, 0xb1, 0x74, 0x84, 0xde, 0x5f, 0x10, 0xf4, 0xe1, 0x82, 0xa0, 0xbb, 0x3d, 0x82, 0x0e,
+	0x7b, 0x04, 0x3d, 0x0a, 0x4a, 0xfa, 0x64, 0x73, 0x65, 0x20, 0xaf, 0x6e, 0x0e, 0xb2, 0xd5, 0x3f,
+	0xe3, 0x13, 0xab, 0xeb, 0xd0, 0xad, 0xae, 0x82, 0x09, 0x5e, 0xc0, 0xfe, 0xe3, 0x59, 0x3a, 0xa3,
+	0x07, 0xf2, 0xd3, 0xab, 0x1b, 0xd1, 0x0e, 0xd4, 0x88, 0xa6, 0x9c, 0xad, 0x70, 0xd6, 0xa6, 0xd6,
+	0xe3, 0x3e, 0x29, 0x33, 0xdd, 0x81, 0xe5, 0xa0, 0xf6, 0x6f, 0xdd, 0x01, 0x95, 0x7b, 0x96, 0x0c,
+	0xe2, 0xf5, 0xcf, 0xfa, 0x24, 0xf1, 0xaa, 0x4f, 0x92, 0xeb, 0xd0, 0x3d, 0xba, 0x24, 0x93, 0x7b,
+	0xd0, 0x5d, 0x8e, 0xd3, 0x8b, 0xbd, 0x24, 0xfe, 0x2e, 0xb8, 0xca, 0x9a, 0x4b, 0x95, 0xfb, 0x08,
+	0xe3, 0xd0, 0xaa, 0xbc, 0xee, 0x89, 0x2b, 0x87, 0x5b, 0x98, 0x8a, 0x01, 0x0d, 0xf9, 0x17, 0x95,
+	0xb7, 0xcf, 0x7b, 0x64, 0xa1, 0x01, 0x3e, 0xef, 0x78, 0x86, 0xac, 0xf2, 0xd5, 0x9a, 0x11, 0x34,
+	0xfe, 0x4b, 0x67, 0xba, 0x15, 0xce, 0x15, 0xd7, 0x7b, 0x7a, 0x49, 0x26, 0xaf, 0x62, 0xf7, 0x5e,
+	0xbc, 0x7b, 0x38, 0x32, 0x55, 0x1e, 0xd3, 0xc2, 0x2d, 0x69, 0xc1, 0x55, 0x2d, 0xa1, 0x79, 0xe5,
+	0x0e, 0xc6, 0xe1, 0x47, 0xfa, 0x6c, 0x37, 0xab, 0x5f, 0xe8, 0x66, 0xa8, 0x1c, 0x9e, 0x5f, 0x5c,
+	0x39, 0x5c, 0xd4, 0xb7, 0x57, 0x0e, 0xcf, 0x67, 0xa8, 0xdc, 0xc6, 0xd9, 0xff, 0x76, 0xf8, 0xfe,
+	0xf5, 0xba, 0x57, 0x81, 0xf2, 0x6f, 0xe7, 0x3d, 0x32, 0x73, 0x9d, 0xea, 0x0d, 0x0a, 0xfb, 0xea,
+	0x49, 0x9f, 0x20, 0xa9, 0x33, 0x51, 0xc6, 0x9a, 0xbf, 0xc3, 0xf7, 0x07, 0x2a, 0xbf, 0xa0, 0xfa,
+	0xec, 0xf1, 0xdb, 0x62, 0xe2, 0xf8, 0xb4, 0x88, 0x4e, 0x4e, 0x8b, 0xe8, 0xcd, 0x69, 0x11, 0x1d,
+	0x9e, 0x15, 0x13, 0x27, 0x67, 0xc5, 0xc4, 0xcb, 0xb3, 0x62, 0xa2, 0x95, 0x91, 0x1a, 0xbf, 0x7e,
+	0x0c, 0x00, 0x00, 0xff, 0xff, 0x85, 0x37, 0xa6, 0xc0, 0x93, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -440,6 +443,13 @@ func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xca
 	}
+	if len(m.Timezone) > 0 {
+		i -= len(m.Timezone)
+		copy(dAtA[i:], m.Timezone)
+		i = encodeVarintZone(dAtA, i, uint64(len(m.Timezone)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -532,6 +542,11 @@ func (m *Zone) Matches(o *Zone, fopts ...MatchOpt) bool {
 			}
 		}
 	}
+	if !opts.Filter || o.Timezone != "" {
+		if o.Timezone != m.Timezone {
+			return false
+		}
+	}
 	if !opts.IgnoreBackend {
 		if !opts.Filter || o.ObjId != "" {
 			if o.ObjId != m.ObjId {
@@ -577,6 +592,7 @@ const ZoneFieldLocationSpeed = "5.7"
 const ZoneFieldLocationTimestamp = "5.8"
 const ZoneFieldLocationTimestampSeconds = "5.8.1"
 const ZoneFieldLocationTimestampNanos = "5.8.2"
+const ZoneFieldTimezone = "6"
 const ZoneFieldObjId = "89"
 const ZoneFieldDeletePrepare = "90"
 const ZoneFieldCreatedAt = "91"
@@ -606,6 +622,7 @@ var ZoneAllFields = []string{
 	ZoneFieldLocationSpeed,
 	ZoneFieldLocationTimestampSeconds,
 	ZoneFieldLocationTimestampNanos,
+	ZoneFieldTimezone,
 	ZoneFieldObjId,
 	ZoneFieldDeletePrepare,
 	ZoneFieldCreatedAtSeconds,
@@ -634,6 +651,7 @@ var ZoneAllFieldsMap = NewFieldMap(map[string]struct{}{
 	ZoneFieldLocationSpeed:              struct{}{},
 	ZoneFieldLocationTimestampSeconds:   struct{}{},
 	ZoneFieldLocationTimestampNanos:     struct{}{},
+	ZoneFieldTimezone:                   struct{}{},
 	ZoneFieldObjId:                      struct{}{},
 	ZoneFieldDeletePrepare:              struct{}{},
 	ZoneFieldCreatedAtSeconds:           struct{}{},
@@ -662,6 +680,7 @@ var ZoneAllFieldsStringMap = map[string]string{
 	ZoneFieldLocationSpeed:              "Location Speed",
 	ZoneFieldLocationTimestampSeconds:   "Location Timestamp Seconds",
 	ZoneFieldLocationTimestampNanos:     "Location Timestamp Nanos",
+	ZoneFieldTimezone:                   "Timezone",
 	ZoneFieldObjId:                      "Obj Id",
 	ZoneFieldDeletePrepare:              "Delete Prepare",
 	ZoneFieldCreatedAtSeconds:           "Created At Seconds",
@@ -782,6 +801,9 @@ func (m *Zone) DiffFields(o *Zone, fields *FieldMap) {
 		fields.Set(ZoneFieldLocationTimestamp)
 		fields.Set(ZoneFieldLocation)
 	}
+	if m.Timezone != o.Timezone {
+		fields.Set(ZoneFieldTimezone)
+	}
 	if m.ObjId != o.ObjId {
 		fields.Set(ZoneFieldObjId)
 	}
@@ -822,6 +844,7 @@ var UpdateZoneFieldsMap = NewFieldMap(map[string]struct{}{
 	ZoneFieldInfraFlavorsPropMap:      struct{}{},
 	ZoneFieldInfraFlavorsPropMapKey:   struct{}{},
 	ZoneFieldInfraFlavorsPropMapValue: struct{}{},
+	ZoneFieldTimezone:                 struct{}{},
 	ZoneFieldObjId:                    struct{}{},
 })
 
@@ -1003,6 +1026,12 @@ func (m *Zone) CopyInFields(src *Zone) int {
 			}
 		}
 	}
+	if fmap.Has("6") {
+		if m.Timezone != src.Timezone {
+			m.Timezone = src.Timezone
+			changed++
+		}
+	}
 	if fmap.Has("89") {
 		if m.ObjId != src.ObjId {
 			m.ObjId = src.ObjId
@@ -1060,6 +1089,7 @@ func (m *Zone) DeepCopyIn(src *Zone) {
 		m.InfraFlavors = nil
 	}
 	m.Location = src.Location
+	m.Timezone = src.Timezone
 	m.ObjId = src.ObjId
 	m.DeletePrepare = src.DeletePrepare
 	m.CreatedAt = src.CreatedAt
@@ -1930,6 +1960,10 @@ func (m *Zone) Size() (n int) {
 	}
 	l = m.Location.Size()
 	n += 1 + l + sovZone(uint64(l))
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovZone(uint64(l))
+	}
 	l = len(m.ObjId)
 	if l > 0 {
 		n += 2 + l + sovZone(uint64(l))
@@ -2143,6 +2177,38 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 89:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjId", wireType)
@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -235,6 +236,54 @@ type AppInstanceInfoStatus string
 // AppInstanceName Name of the App instance, scoped to the AppProvider
 type AppInstanceName = string
 
+// AppInstanceStatusEvent Notification sent to a subscription sink when the status of an
+// Application Instance changes, in CloudEvents format.
+type AppInstanceStatusEvent struct {
+	// Data Status change of an Application Instance
+	Data AppInstanceStatusEventData `json:"data"`
+
+	// Id Unique identifier of the event
+	Id string `json:"id"`
+
+	// Source Identifies the context in which the event happened
+	Source string `json:"source"`
+
+	// Specversion Version of the CloudEvents specification
+	Specversion string `json:"specversion"`
+
+	// Time Time at which the status changed
+	Time time.Time `json:"time"`
+
+	// Type Type of the event
+	Type string `json:"type"`
+}
+
+// AppInstanceStatusEventData Status change of an Application Instance
+type AppInstanceStatusEventData struct {
+	// AppId A globally unique identifier associated with the application.
+	// Edge Cloud Platform generates this identifier when the
+	// Application is submitted.
+	AppId AppId `json:"appId"`
+
+	// AppInstanceId A globally unique identifier associated with a running
+	// instance of an application.
+	// Edge Cloud Platform generates this identifier when the
+	// instantiation in the Edge Cloud Zone is successful.
+	AppInstanceId AppInstanceId `json:"appInstanceId"`
+
+	// Message Additional information, such as error details
+	Message *string `json:"message,omitempty"`
+
+	// Status Status of the application instance, one of the
+	// AppInstanceInfo status values, or 'deleted' once the
+	// instance has been removed.
+	Status string `json:"status"`
+
+	// SubscriptionId A globally unique identifier for a subscription, generated by
+	// the Edge Cloud Platform when the subscription is created.
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
 // AppManifest Application information and requirements provided by the
 // Application Provider
 type AppManifest struct {
@@ -782,6 +831,67 @@ type SubmittedApp struct {
 	AppId *AppId `json:"appId,omitempty"`
 }
 
+// Subscription Subscription to Application Instance status changes
+type Subscription struct {
+	// AppId A globally unique identifier associated with the application.
+	// Edge Cloud Platform generates this identifier when the
+	// Application is submitted.
+	AppId *AppId `json:"appId,omitempty"`
+
+	// AppInstanceId A globally unique identifier associated with a running
+	// instance of an application.
+	// Edge Cloud Platform generates this identifier when the
+	// instantiation in the Edge Cloud Zone is successful.
+	AppInstanceId *AppInstanceId `json:"appInstanceId,omitempty"`
+
+	// AppProvider Human readable name of the Application Provider.
+	AppProvider AppProvider `json:"appProvider"`
+
+	// ExpiresAt Time at which the subscription expires. If not present
+	// the subscription does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Sink The address to which notifications are sent
+	Sink string `json:"sink"`
+
+	// StartsAt Time at which the subscription was created
+	StartsAt time.Time `json:"startsAt"`
+
+	// SubscriptionId A globally unique identifier for a subscription, generated by
+	// the Edge Cloud Platform when the subscription is created.
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
+// SubscriptionId A globally unique identifier for a subscription, generated by
+// the Edge Cloud Platform when the subscription is created.
+type SubscriptionId = string
+
+// SubscriptionRequest Request to subscribe to Application Instance status changes
+type SubscriptionRequest struct {
+	// AppId A globally unique identifier associated with the application.
+	// Edge Cloud Platform generates this identifier when the
+	// Application is submitted.
+	AppId *AppId `json:"appId,omitempty"`
+
+	// AppInstanceId A globally unique identifier associated with a running
+	// instance of an application.
+	// Edge Cloud Platform generates this identifier when the
+	// instantiation in the Edge Cloud Zone is successful.
+	AppInstanceId *AppInstanceId `json:"appInstanceId,omitempty"`
+
+	// AppProvider Human readable name of the Application Provider.
+	AppProvider AppProvider `json:"appProvider"`
+
+	// Sink The http or https address to which notifications are sent.
+	// Addresses that resolve to private, loopback, or link-local
+	// networks are not allowed.
+	Sink string `json:"sink"`
+
+	// SubscriptionExpireTime Time at which the subscription expires. If not specified
+	// the subscription remains until it is deleted.
+	SubscriptionExpireTime *time.Time `json:"subscriptionExpireTime,omitempty"`
+}
+
 // Uri A Uniform Resource Identifier (URI) as per RFC 3986,
 // identifies the endpoint within an Edge Cloud Zone where the user
 // equipment may connect to the selected application instance
@@ -888,6 +998,18 @@ type DeleteAppInstanceParams struct {
 	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
 }
 
+// UpdateAppInstanceJSONBody defines parameters for UpdateAppInstance.
+type UpdateAppInstanceJSONBody struct {
+	// KubernetesResources Definition of Kubernetes Cluster Infrastructure.
+	KubernetesResources *KubernetesResources `json:"kubernetesResources,omitempty"`
+}
+
+// UpdateAppInstanceParams defines parameters for UpdateAppInstance.
+type UpdateAppInstanceParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
 // GetAppsParams defines parameters for GetApps.
 type GetAppsParams struct {
 	// XCorrelator Correlation id for the different services
@@ -912,6 +1034,12 @@ type GetAppParams struct {
 	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
 }
 
+// UpdateAppParams defines parameters for UpdateApp.
+type UpdateAppParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
 // GetClustersParams defines parameters for GetClusters.
 type GetClustersParams struct {
 	// Region Human readable name of the geographical Edge Cloud Region of
@@ -938,12 +1066,47 @@ type GetEdgeCloudZonesParams struct {
 	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
 }
 
+// GetSubscriptionsParams defines parameters for GetSubscriptions.
+type GetSubscriptionsParams struct {
+	// AppProvider Application Provider that owns the subscriptions. Only
+	// subscriptions of this Application Provider are visible.
+	AppProvider AppProvider `form:"appProvider" json:"appProvider"`
+
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// CreateSubscriptionParams defines parameters for CreateSubscription.
+type CreateSubscriptionParams struct {
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
+// DeleteSubscriptionParams defines parameters for DeleteSubscription.
+type DeleteSubscriptionParams struct {
+	// AppProvider Application Provider that owns the subscriptions. Only
+	// subscriptions of this Application Provider are visible.
+	AppProvider AppProvider `form:"appProvider" json:"appProvider"`
+
+	// XCorrelator Correlation id for the different services
+	XCorrelator *XCorrelator `json:"x-correlator,omitempty"`
+}
+
 // CreateAppInstanceJSONRequestBody defines body for CreateAppInstance for application/json ContentType.
 type CreateAppInstanceJSONRequestBody CreateAppInstanceJSONBody
 
+// UpdateAppInstanceJSONRequestBody defines body for UpdateAppInstance for application/json ContentType.
+type UpdateAppInstanceJSONRequestBody UpdateAppInstanceJSONBody
+
 // SubmitAppJSONRequestBody defines body for SubmitApp for application/json ContentType.
 type SubmitAppJSONRequestBody = AppManifest
 
+// UpdateAppJSONRequestBody defines body for UpdateApp for application/json ContentType.
+type UpdateAppJSONRequestBody = AppManifest
+
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = SubscriptionRequest
+
 // AsAccessEndpoint0 returns the union data inside the AccessEndpoint as a AccessEndpoint0
 func (t AccessEndpoint) AsAccessEndpoint0() (AccessEndpoint0, error) {
 	var body AccessEndpoint0
@@ -1340,6 +1503,11 @@ type ClientInterface interface {
 	// DeleteAppInstance request
 	DeleteAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *DeleteAppInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateAppInstanceWithBody request with any body
+	UpdateAppInstanceWithBody(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApps request
 	GetApps(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1354,11 +1522,27 @@ type ClientInterface interface {
 	// GetApp request
 	GetApp(ctx context.Context, appId AppId, params *GetAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateAppWithBody request with any body
+	UpdateAppWithBody(ctx context.Context, appId AppId, params *UpdateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateApp(ctx context.Context, appId AppId, params *UpdateAppParams, body UpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetClusters request
 	GetClusters(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetEdgeCloudZones request
 	GetEdgeCloudZones(ctx context.Context, params *GetEdgeCloudZonesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSubscriptions request
+	GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSubscriptionWithBody request with any body
+	CreateSubscriptionWithBody(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSubscription(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSubscription request
+	DeleteSubscription(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetAppInstance(ctx context.Context, params *GetAppInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -1409,6 +1593,30 @@ func (c *Client) DeleteAppInstance(ctx context.Context, appInstanceId AppInstanc
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateAppInstanceWithBody(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppInstanceRequestWithBody(c.Server, appInstanceId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAppInstance(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppInstanceRequest(c.Server, appInstanceId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetApps(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAppsRequest(c.Server, params)
 	if err != nil {
@@ -1469,6 +1677,30 @@ func (c *Client) GetApp(ctx context.Context, appId AppId, params *GetAppParams,
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateAppWithBody(ctx context.Context, appId AppId, params *UpdateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppRequestWithBody(c.Server, appId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateApp(ctx context.Context, appId AppId, params *UpdateAppParams, body UpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppRequest(c.Server, appId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetClusters(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetClustersRequest(c.Server, params)
 	if err != nil {
@@ -1493,6 +1725,54 @@ func (c *Client) GetEdgeCloudZones(ctx context.Context, params *GetEdgeCloudZone
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubscriptionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSubscriptionWithBody(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSubscription(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSubscription(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSubscriptionRequest(c.Server, subscriptionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetAppInstanceRequest generates requests for GetAppInstance
 func NewGetAppInstanceRequest(server string, params *GetAppInstanceParams) (*http.Request, error) {
 	var err error
@@ -1693,6 +1973,68 @@ func NewDeleteAppInstanceRequest(server string, appInstanceId AppInstanceId, par
 	return req, nil
 }
 
+// NewUpdateAppInstanceRequest calls the generic UpdateAppInstance builder with application/json body
+func NewUpdateAppInstanceRequest(server string, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAppInstanceRequestWithBody(server, appInstanceId, params, "application/json", bodyReader)
+}
+
+// NewUpdateAppInstanceRequestWithBody generates requests for UpdateAppInstance with any type of body
+func NewUpdateAppInstanceRequestWithBody(server string, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appInstanceId", runtime.ParamLocationPath, appInstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/appinstances/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
 // NewGetAppsRequest generates requests for GetApps
 func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, error) {
 	var err error
@@ -1888,16 +2230,34 @@ func NewGetAppRequest(server string, appId AppId, params *GetAppParams) (*http.R
 	return req, nil
 }
 
-// NewGetClustersRequest generates requests for GetClusters
-func NewGetClustersRequest(server string, params *GetClustersParams) (*http.Request, error) {
+// NewUpdateAppRequest calls the generic UpdateApp builder with application/json body
+func NewUpdateAppRequest(server string, appId AppId, params *UpdateAppParams, body UpdateAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAppRequestWithBody(server, appId, params, "application/json", bodyReader)
+}
+
+// NewUpdateAppRequestWithBody generates requests for UpdateApp with any type of body
+func NewUpdateAppRequestWithBody(server string, appId AppId, params *UpdateAppParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appId", runtime.ParamLocationPath, appId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clusters")
+	operationPath := fmt.Sprintf("/apps/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1907,13 +2267,57 @@ func NewGetClustersRequest(server string, params *GetClustersParams) (*http.Requ
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Region != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, *params.Region); err != nil {
-				return nil, err
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetClustersRequest generates requests for GetClusters
+func NewGetClustersRequest(server string, params *GetClustersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Region != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, *params.Region); err != nil {
+				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
 			} else {
@@ -2048,6 +2452,188 @@ func NewGetEdgeCloudZonesRequest(server string, params *GetEdgeCloudZonesParams)
 	return req, nil
 }
 
+// NewGetSubscriptionsRequest generates requests for GetSubscriptions
+func NewGetSubscriptionsRequest(server string, params *GetSubscriptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "appProvider", runtime.ParamLocationQuery, params.AppProvider); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateSubscriptionRequest calls the generic CreateSubscription builder with application/json body
+func NewCreateSubscriptionRequest(server string, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSubscriptionRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateSubscriptionRequestWithBody generates requests for CreateSubscription with any type of body
+func NewCreateSubscriptionRequestWithBody(server string, params *CreateSubscriptionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteSubscriptionRequest generates requests for DeleteSubscription
+func NewDeleteSubscriptionRequest(server string, subscriptionId SubscriptionId, params *DeleteSubscriptionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "appProvider", runtime.ParamLocationQuery, params.AppProvider); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCorrelator != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "x-correlator", runtime.ParamLocationHeader, *params.XCorrelator)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("x-correlator", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -2102,6 +2688,11 @@ type ClientWithResponsesInterface interface {
 	// DeleteAppInstanceWithResponse request
 	DeleteAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *DeleteAppInstanceParams, reqEditors ...RequestEditorFn) (*DeleteAppInstanceResponse, error)
 
+	// UpdateAppInstanceWithBodyWithResponse request with any body
+	UpdateAppInstanceWithBodyWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error)
+
+	UpdateAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error)
+
 	// GetAppsWithResponse request
 	GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResponse, error)
 
@@ -2116,11 +2707,27 @@ type ClientWithResponsesInterface interface {
 	// GetAppWithResponse request
 	GetAppWithResponse(ctx context.Context, appId AppId, params *GetAppParams, reqEditors ...RequestEditorFn) (*GetAppResponse, error)
 
+	// UpdateAppWithBodyWithResponse request with any body
+	UpdateAppWithBodyWithResponse(ctx context.Context, appId AppId, params *UpdateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppResponse, error)
+
+	UpdateAppWithResponse(ctx context.Context, appId AppId, params *UpdateAppParams, body UpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppResponse, error)
+
 	// GetClustersWithResponse request
 	GetClustersWithResponse(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*GetClustersResponse, error)
 
 	// GetEdgeCloudZonesWithResponse request
 	GetEdgeCloudZonesWithResponse(ctx context.Context, params *GetEdgeCloudZonesParams, reqEditors ...RequestEditorFn) (*GetEdgeCloudZonesResponse, error)
+
+	// GetSubscriptionsWithResponse request
+	GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error)
+
+	// CreateSubscriptionWithBodyWithResponse request with any body
+	CreateSubscriptionWithBodyWithResponse(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	CreateSubscriptionWithResponse(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	// DeleteSubscriptionWithResponse request
+	DeleteSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*DeleteSubscriptionResponse, error)
 }
 
 type GetAppInstanceResponse struct {
@@ -2221,6 +2828,39 @@ func (r DeleteAppInstanceResponse) GetBody() []byte {
 	return r.Body
 }
 
+type UpdateAppInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *AppInstanceInfo
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r UpdateAppInstanceResponse) GetBody() []byte {
+	return r.Body
+}
+
 type GetAppsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2356,19 +2996,21 @@ func (r GetAppResponse) GetBody() []byte {
 	return r.Body
 }
 
-type GetClustersResponse struct {
+type UpdateAppResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ClusterInfo
+	JSON200      *AppManifest
+	JSON400      *N400
 	JSON401      *N401
 	JSON403      *N403
 	JSON404      *N404
+	JSON409      *ErrorInfo
 	JSON500      *N500
 	JSON503      *N503
 }
 
 // Status returns HTTPResponse.Status
-func (r GetClustersResponse) Status() string {
+func (r UpdateAppResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2376,7 +3018,7 @@ func (r GetClustersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetClustersResponse) StatusCode() int {
+func (r UpdateAppResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -2384,14 +3026,14 @@ func (r GetClustersResponse) StatusCode() int {
 }
 
 // GetBody returns the HTTP response's body
-func (r GetClustersResponse) GetBody() []byte {
+func (r UpdateAppResponse) GetBody() []byte {
 	return r.Body
 }
 
-type GetEdgeCloudZonesResponse struct {
+type GetClustersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EdgeCloudZones
+	JSON200      *[]ClusterInfo
 	JSON401      *N401
 	JSON403      *N403
 	JSON404      *N404
@@ -2400,7 +3042,7 @@ type GetEdgeCloudZonesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEdgeCloudZonesResponse) Status() string {
+func (r GetClustersResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2408,7 +3050,7 @@ func (r GetEdgeCloudZonesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEdgeCloudZonesResponse) StatusCode() int {
+func (r GetClustersResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -2416,11 +3058,138 @@ func (r GetEdgeCloudZonesResponse) StatusCode() int {
 }
 
 // GetBody returns the HTTP response's body
-func (r GetEdgeCloudZonesResponse) GetBody() []byte {
+func (r GetClustersResponse) GetBody() []byte {
 	return r.Body
 }
 
-// GetAppInstanceWithResponse request returning *GetAppInstanceResponse
+type GetEdgeCloudZonesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EdgeCloudZones
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEdgeCloudZonesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEdgeCloudZonesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetEdgeCloudZonesResponse) GetBody() []byte {
+	return r.Body
+}
+
+type GetSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Subscription
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r GetSubscriptionsResponse) GetBody() []byte {
+	return r.Body
+}
+
+type CreateSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Subscription
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r CreateSubscriptionResponse) GetBody() []byte {
+	return r.Body
+}
+
+type DeleteSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *N400
+	JSON401      *N401
+	JSON403      *N403
+	JSON404      *N404
+	JSON500      *N500
+	JSON503      *N503
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetBody returns the HTTP response's body
+func (r DeleteSubscriptionResponse) GetBody() []byte {
+	return r.Body
+}
+
+// GetAppInstanceWithResponse request returning *GetAppInstanceResponse
 func (c *ClientWithResponses) GetAppInstanceWithResponse(ctx context.Context, params *GetAppInstanceParams, reqEditors ...RequestEditorFn) (*GetAppInstanceResponse, error) {
 	rsp, err := c.GetAppInstance(ctx, params, reqEditors...)
 	if err != nil {
@@ -2455,6 +3224,23 @@ func (c *ClientWithResponses) DeleteAppInstanceWithResponse(ctx context.Context,
 	return ParseDeleteAppInstanceResponse(rsp)
 }
 
+// UpdateAppInstanceWithBodyWithResponse request with arbitrary body returning *UpdateAppInstanceResponse
+func (c *ClientWithResponses) UpdateAppInstanceWithBodyWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error) {
+	rsp, err := c.UpdateAppInstanceWithBody(ctx, appInstanceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppInstanceWithResponse(ctx context.Context, appInstanceId AppInstanceId, params *UpdateAppInstanceParams, body UpdateAppInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppInstanceResponse, error) {
+	rsp, err := c.UpdateAppInstance(ctx, appInstanceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppInstanceResponse(rsp)
+}
+
 // GetAppsWithResponse request returning *GetAppsResponse
 func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResponse, error) {
 	rsp, err := c.GetApps(ctx, params, reqEditors...)
@@ -2499,6 +3285,23 @@ func (c *ClientWithResponses) GetAppWithResponse(ctx context.Context, appId AppI
 	return ParseGetAppResponse(rsp)
 }
 
+// UpdateAppWithBodyWithResponse request with arbitrary body returning *UpdateAppResponse
+func (c *ClientWithResponses) UpdateAppWithBodyWithResponse(ctx context.Context, appId AppId, params *UpdateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppResponse, error) {
+	rsp, err := c.UpdateAppWithBody(ctx, appId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppWithResponse(ctx context.Context, appId AppId, params *UpdateAppParams, body UpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppResponse, error) {
+	rsp, err := c.UpdateApp(ctx, appId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppResponse(rsp)
+}
+
 // GetClustersWithResponse request returning *GetClustersResponse
 func (c *ClientWithResponses) GetClustersWithResponse(ctx context.Context, params *GetClustersParams, reqEditors ...RequestEditorFn) (*GetClustersResponse, error) {
 	rsp, err := c.GetClusters(ctx, params, reqEditors...)
@@ -2517,6 +3320,41 @@ func (c *ClientWithResponses) GetEdgeCloudZonesWithResponse(ctx context.Context,
 	return ParseGetEdgeCloudZonesResponse(rsp)
 }
 
+// GetSubscriptionsWithResponse request returning *GetSubscriptionsResponse
+func (c *ClientWithResponses) GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error) {
+	rsp, err := c.GetSubscriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionsResponse(rsp)
+}
+
+// CreateSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateSubscriptionResponse
+func (c *ClientWithResponses) CreateSubscriptionWithBodyWithResponse(ctx context.Context, params *CreateSubscriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscriptionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSubscriptionWithResponse(ctx context.Context, params *CreateSubscriptionParams, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscription(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+// DeleteSubscriptionWithResponse request returning *DeleteSubscriptionResponse
+func (c *ClientWithResponses) DeleteSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *DeleteSubscriptionParams, reqEditors ...RequestEditorFn) (*DeleteSubscriptionResponse, error) {
+	rsp, err := c.DeleteSubscription(ctx, subscriptionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSubscriptionResponse(rsp)
+}
+
 // ParseGetAppInstanceResponse parses an HTTP response from a GetAppInstanceWithResponse call
 func ParseGetAppInstanceResponse(rsp *http.Response) (*GetAppInstanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -2714,6 +3552,74 @@ func ParseDeleteAppInstanceResponse(rsp *http.Response) (*DeleteAppInstanceRespo
 	return response, nil
 }
 
+// ParseUpdateAppInstanceResponse parses an HTTP response from a UpdateAppInstanceWithResponse call
+func ParseUpdateAppInstanceResponse(rsp *http.Response) (*UpdateAppInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAppInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest AppInstanceInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetAppsResponse parses an HTTP response from a GetAppsWithResponse call
 func ParseGetAppsResponse(rsp *http.Response) (*GetAppsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -2983,27 +3889,34 @@ func ParseGetAppResponse(rsp *http.Response) (*GetAppResponse, error) {
 	return response, nil
 }
 
-// ParseGetClustersResponse parses an HTTP response from a GetClustersWithResponse call
-func ParseGetClustersResponse(rsp *http.Response) (*GetClustersResponse, error) {
+// ParseUpdateAppResponse parses an HTTP response from a UpdateAppWithResponse call
+func ParseUpdateAppResponse(rsp *http.Response) (*UpdateAppResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClustersResponse{
+	response := &UpdateAppResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ClusterInfo
+		var dest AppManifest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest N401
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3025,6 +3938,13 @@ func ParseGetClustersResponse(rsp *http.Response) (*GetClustersResponse, error)
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest N500
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3044,22 +3964,22 @@ func ParseGetClustersResponse(rsp *http.Response) (*GetClustersResponse, error)
 	return response, nil
 }
 
-// ParseGetEdgeCloudZonesResponse parses an HTTP response from a GetEdgeCloudZonesWithResponse call
-func ParseGetEdgeCloudZonesResponse(rsp *http.Response) (*GetEdgeCloudZonesResponse, error) {
+// ParseGetClustersResponse parses an HTTP response from a GetClustersWithResponse call
+func ParseGetClustersResponse(rsp *http.Response) (*GetClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEdgeCloudZonesResponse{
+	response := &GetClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EdgeCloudZones
+		var dest []ClusterInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3105,17 +4025,257 @@ func ParseGetEdgeCloudZonesResponse(rsp *http.Response) (*GetEdgeCloudZonesRespo
 	return response, nil
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Retrieve the information of Application Instances for a given App
-	// (GET /appinstances)
-	GetAppInstance(ctx echo.Context, params GetAppInstanceParams) error
-	// Instantiation of an Application
-	// (POST /appinstances)
-	CreateAppInstance(ctx echo.Context, params CreateAppInstanceParams) error
-	// Terminate an Application Instance
-	// (DELETE /appinstances/{appInstanceId})
-	DeleteAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params DeleteAppInstanceParams) error
+// ParseGetEdgeCloudZonesResponse parses an HTTP response from a GetEdgeCloudZonesWithResponse call
+func ParseGetEdgeCloudZonesResponse(rsp *http.Response) (*GetEdgeCloudZonesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEdgeCloudZonesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EdgeCloudZones
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubscriptionsResponse parses an HTTP response from a GetSubscriptionsWithResponse call
+func ParseGetSubscriptionsResponse(rsp *http.Response) (*GetSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSubscriptionResponse parses an HTTP response from a CreateSubscriptionWithResponse call
+func ParseCreateSubscriptionResponse(rsp *http.Response) (*CreateSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSubscriptionResponse parses an HTTP response from a DeleteSubscriptionWithResponse call
+func ParseDeleteSubscriptionResponse(rsp *http.Response) (*DeleteSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Retrieve the information of Application Instances for a given App
+	// (GET /appinstances)
+	GetAppInstance(ctx echo.Context, params GetAppInstanceParams) error
+	// Instantiation of an Application
+	// (POST /appinstances)
+	CreateAppInstance(ctx echo.Context, params CreateAppInstanceParams) error
+	// Terminate an Application Instance
+	// (DELETE /appinstances/{appInstanceId})
+	DeleteAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params DeleteAppInstanceParams) error
+	// Update an Application Instance
+	// (PATCH /appinstances/{appInstanceId})
+	UpdateAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params UpdateAppInstanceParams) error
 	// Retrieve a list of existing Applications
 	// (GET /apps)
 	GetApps(ctx echo.Context, params GetAppsParams) error
@@ -3128,12 +4288,24 @@ type ServerInterface interface {
 	// Retrieve the information of an Application
 	// (GET /apps/{appId})
 	GetApp(ctx echo.Context, appId AppId, params GetAppParams) error
+	// Update an Application
+	// (PUT /apps/{appId})
+	UpdateApp(ctx echo.Context, appId AppId, params UpdateAppParams) error
 	// Retrieve a list of the available clusters
 	// (GET /clusters)
 	GetClusters(ctx echo.Context, params GetClustersParams) error
 	// Retrieve a list of the operators Edge Cloud Zones and their status
 	// (GET /edge-cloud-zones)
 	GetEdgeCloudZones(ctx echo.Context, params GetEdgeCloudZonesParams) error
+	// Retrieve the list of subscriptions
+	// (GET /subscriptions)
+	GetSubscriptions(ctx echo.Context, params GetSubscriptionsParams) error
+	// Subscribe to Application Instance status changes
+	// (POST /subscriptions)
+	CreateSubscription(ctx echo.Context, params CreateSubscriptionParams) error
+	// Delete a subscription
+	// (DELETE /subscriptions/{subscriptionId})
+	DeleteSubscription(ctx echo.Context, subscriptionId SubscriptionId, params DeleteSubscriptionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -3261,14 +4433,21 @@ func (w *ServerInterfaceWrapper) DeleteAppInstance(ctx echo.Context) error {
 	return err
 }
 
-// GetApps converts echo context to params.
-func (w *ServerInterfaceWrapper) GetApps(ctx echo.Context) error {
+// UpdateAppInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateAppInstance(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "appInstanceId" -------------
+	var appInstanceId AppInstanceId
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:read"})
+	err = runtime.BindStyledParameterWithOptions("simple", "appInstanceId", ctx.Param("appInstanceId"), &appInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appInstanceId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:instances:write"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAppsParams
+	var params UpdateAppInstanceParams
 
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "x-correlator" -------------
@@ -3288,15 +4467,46 @@ func (w *ServerInterfaceWrapper) GetApps(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetApps(ctx, params)
+	err = w.Handler.UpdateAppInstance(ctx, appInstanceId, params)
 	return err
 }
 
-// SubmitApp converts echo context to params.
-func (w *ServerInterfaceWrapper) SubmitApp(ctx echo.Context) error {
+// GetApps converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApps(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:write"})
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAppsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApps(ctx, params)
+	return err
+}
+
+// SubmitApp converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitApp(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:write"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params SubmitAppParams
@@ -3399,6 +4609,44 @@ func (w *ServerInterfaceWrapper) GetApp(ctx echo.Context) error {
 	return err
 }
 
+// UpdateApp converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateApp(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appId" -------------
+	var appId AppId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appId", ctx.Param("appId"), &appId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:apps:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateAppParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateApp(ctx, appId, params)
+	return err
+}
+
 // GetClusters converts echo context to params.
 func (w *ServerInterfaceWrapper) GetClusters(ctx echo.Context) error {
 	var err error
@@ -3487,6 +4735,118 @@ func (w *ServerInterfaceWrapper) GetEdgeCloudZones(ctx echo.Context) error {
 	return err
 }
 
+// GetSubscriptions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSubscriptions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsParams
+	// ------------- Required query parameter "appProvider" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "appProvider", ctx.QueryParams(), &params.AppProvider)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appProvider: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSubscriptions(ctx, params)
+	return err
+}
+
+// CreateSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSubscription(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSubscriptionParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSubscription(ctx, params)
+	return err
+}
+
+// DeleteSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSubscription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", ctx.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subscriptionId: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"edge-application-management:subscriptions:delete"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSubscriptionParams
+	// ------------- Required query parameter "appProvider" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "appProvider", ctx.QueryParams(), &params.AppProvider)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appProvider: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "x-correlator" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-correlator")]; found {
+		var XCorrelator XCorrelator
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for x-correlator, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-correlator", valueList[0], &XCorrelator, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x-correlator: %s", err))
+		}
+
+		params.XCorrelator = &XCorrelator
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSubscription(ctx, subscriptionId, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -3518,12 +4878,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/appinstances", wrapper.GetAppInstance)
 	router.POST(baseURL+"/appinstances", wrapper.CreateAppInstance)
 	router.DELETE(baseURL+"/appinstances/:appInstanceId", wrapper.DeleteAppInstance)
+	router.PATCH(baseURL+"/appinstances/:appInstanceId", wrapper.UpdateAppInstance)
 	router.GET(baseURL+"/apps", wrapper.GetApps)
 	router.POST(baseURL+"/apps", wrapper.SubmitApp)
 	router.DELETE(baseURL+"/apps/:appId", wrapper.DeleteApp)
 	router.GET(baseURL+"/apps/:appId", wrapper.GetApp)
+	router.PUT(baseURL+"/apps/:appId", wrapper.UpdateApp)
 	router.GET(baseURL+"/clusters", wrapper.GetClusters)
 	router.GET(baseURL+"/edge-cloud-zones", wrapper.GetEdgeCloudZones)
+	router.GET(baseURL+"/subscriptions", wrapper.GetSubscriptions)
+	router.POST(baseURL+"/subscriptions", wrapper.CreateSubscription)
+	router.DELETE(baseURL+"/subscriptions/:subscriptionId", wrapper.DeleteSubscription)
 
 }
 
@@ -3657,7 +5022,357 @@ func (response GetAppInstance500JSONResponse) VisitGetAppInstanceResponse(w http
 
 type GetAppInstance503JSONResponse struct{ N503JSONResponse }
 
-func (response GetAppInstance503JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+func (response GetAppInstance503JSONResponse) VisitGetAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAppInstanceRequestObject struct {
+	Params CreateAppInstanceParams
+	Body   *CreateAppInstanceJSONRequestBody
+}
+
+type CreateAppInstanceResponseObject interface {
+	VisitCreateAppInstanceResponse(w http.ResponseWriter) error
+}
+
+type CreateAppInstance202ResponseHeaders struct {
+	Location    string
+	XCorrelator openapi_types.UUID
+}
+
+type CreateAppInstance202JSONResponse struct {
+	Body    AppInstanceInfo
+	Headers CreateAppInstance202ResponseHeaders
+}
+
+func (response CreateAppInstance202JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAppInstance400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAppInstance400JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAppInstance401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateAppInstance401JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAppInstance403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateAppInstance403JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAppInstance409JSONResponse ErrorInfo
+
+func (response CreateAppInstance409JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAppInstance500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAppInstance500JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAppInstance501JSONResponse struct{ N501JSONResponse }
+
+func (response CreateAppInstance501JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAppInstance503JSONResponse struct{ N503JSONResponse }
+
+func (response CreateAppInstance503JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAppInstanceRequestObject struct {
+	AppInstanceId AppInstanceId `json:"appInstanceId"`
+	Params        DeleteAppInstanceParams
+}
+
+type DeleteAppInstanceResponseObject interface {
+	VisitDeleteAppInstanceResponse(w http.ResponseWriter) error
+}
+
+type DeleteAppInstance202ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+
+type DeleteAppInstance202Response struct {
+	Headers DeleteAppInstance202ResponseHeaders
+}
+
+func (response DeleteAppInstance202Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
+	return nil
+}
+
+type DeleteAppInstance204Response struct {
+}
+
+func (response DeleteAppInstance204Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAppInstance400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteAppInstance400JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAppInstance401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteAppInstance401JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAppInstance403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteAppInstance403JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAppInstance404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteAppInstance404JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAppInstance500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteAppInstance500JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAppInstance503JSONResponse struct{ N503JSONResponse }
+
+func (response DeleteAppInstance503JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAppInstanceRequestObject struct {
+	AppInstanceId AppInstanceId `json:"appInstanceId"`
+	Params        UpdateAppInstanceParams
+	Body          *UpdateAppInstanceJSONRequestBody
+}
+
+type UpdateAppInstanceResponseObject interface {
+	VisitUpdateAppInstanceResponse(w http.ResponseWriter) error
+}
+
+type UpdateAppInstance202ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+
+type UpdateAppInstance202JSONResponse struct {
+	Body    AppInstanceInfo
+	Headers UpdateAppInstance202ResponseHeaders
+}
+
+func (response UpdateAppInstance202JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAppInstance400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateAppInstance400JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAppInstance401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateAppInstance401JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAppInstance403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateAppInstance403JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAppInstance404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateAppInstance404JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAppInstance500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateAppInstance500JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAppInstance503JSONResponse struct{ N503JSONResponse }
+
+func (response UpdateAppInstance503JSONResponse) VisitUpdateAppInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAppsRequestObject struct {
+	Params GetAppsParams
+}
+
+type GetAppsResponseObject interface {
+	VisitGetAppsResponse(w http.ResponseWriter) error
+}
+
+type GetApps200ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+
+type GetApps200JSONResponse struct {
+	Body    []AppManifest
+	Headers GetApps200ResponseHeaders
+}
+
+func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApps401JSONResponse struct{ N401JSONResponse }
+
+func (response GetApps401JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApps403JSONResponse struct{ N403JSONResponse }
+
+func (response GetApps403JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApps404JSONResponse struct{ N404JSONResponse }
+
+func (response GetApps404JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApps500JSONResponse struct{ N500JSONResponse }
+
+func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApps503JSONResponse struct{ N503JSONResponse }
+
+func (response GetApps503JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3665,37 +5380,35 @@ func (response GetAppInstance503JSONResponse) VisitGetAppInstanceResponse(w http
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstanceRequestObject struct {
-	Params CreateAppInstanceParams
-	Body   *CreateAppInstanceJSONRequestBody
+type SubmitAppRequestObject struct {
+	Params SubmitAppParams
+	Body   *SubmitAppJSONRequestBody
 }
 
-type CreateAppInstanceResponseObject interface {
-	VisitCreateAppInstanceResponse(w http.ResponseWriter) error
+type SubmitAppResponseObject interface {
+	VisitSubmitAppResponse(w http.ResponseWriter) error
 }
 
-type CreateAppInstance202ResponseHeaders struct {
-	Location    string
+type SubmitApp201ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type CreateAppInstance202JSONResponse struct {
-	Body    AppInstanceInfo
-	Headers CreateAppInstance202ResponseHeaders
+type SubmitApp201JSONResponse struct {
+	Body    SubmittedApp
+	Headers SubmitApp201ResponseHeaders
 }
 
-func (response CreateAppInstance202JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp201JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(202)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance400JSONResponse struct{ N400JSONResponse }
+type SubmitApp400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateAppInstance400JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp400JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(400)
@@ -3703,9 +5416,9 @@ func (response CreateAppInstance400JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance401JSONResponse struct{ N401JSONResponse }
+type SubmitApp401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateAppInstance401JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp401JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3713,9 +5426,9 @@ func (response CreateAppInstance401JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance403JSONResponse struct{ N403JSONResponse }
+type SubmitApp403JSONResponse struct{ N403JSONResponse }
 
-func (response CreateAppInstance403JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp403JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3723,18 +5436,18 @@ func (response CreateAppInstance403JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance409JSONResponse ErrorInfo
+type SubmitApp409JSONResponse ErrorInfo
 
-func (response CreateAppInstance409JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp409JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateAppInstance500JSONResponse struct{ N500JSONResponse }
+type SubmitApp500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateAppInstance500JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp500JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -3742,9 +5455,9 @@ func (response CreateAppInstance500JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance501JSONResponse struct{ N501JSONResponse }
+type SubmitApp501JSONResponse struct{ N501JSONResponse }
 
-func (response CreateAppInstance501JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp501JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(501)
@@ -3752,9 +5465,9 @@ func (response CreateAppInstance501JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateAppInstance503JSONResponse struct{ N503JSONResponse }
+type SubmitApp503JSONResponse struct{ N503JSONResponse }
 
-func (response CreateAppInstance503JSONResponse) VisitCreateAppInstanceResponse(w http.ResponseWriter) error {
+func (response SubmitApp503JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3762,40 +5475,40 @@ func (response CreateAppInstance503JSONResponse) VisitCreateAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstanceRequestObject struct {
-	AppInstanceId AppInstanceId `json:"appInstanceId"`
-	Params        DeleteAppInstanceParams
+type DeleteAppRequestObject struct {
+	AppId  AppId `json:"appId"`
+	Params DeleteAppParams
 }
 
-type DeleteAppInstanceResponseObject interface {
-	VisitDeleteAppInstanceResponse(w http.ResponseWriter) error
+type DeleteAppResponseObject interface {
+	VisitDeleteAppResponse(w http.ResponseWriter) error
 }
 
-type DeleteAppInstance202ResponseHeaders struct {
+type DeleteApp202ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type DeleteAppInstance202Response struct {
-	Headers DeleteAppInstance202ResponseHeaders
+type DeleteApp202Response struct {
+	Headers DeleteApp202ResponseHeaders
 }
 
-func (response DeleteAppInstance202Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp202Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(202)
 	return nil
 }
 
-type DeleteAppInstance204Response struct {
+type DeleteApp204Response struct {
 }
 
-func (response DeleteAppInstance204Response) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp204Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type DeleteAppInstance400JSONResponse struct{ N400JSONResponse }
+type DeleteApp400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteAppInstance400JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp400JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(400)
@@ -3803,9 +5516,9 @@ func (response DeleteAppInstance400JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance401JSONResponse struct{ N401JSONResponse }
+type DeleteApp401JSONResponse struct{ N401JSONResponse }
 
-func (response DeleteAppInstance401JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp401JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3813,9 +5526,9 @@ func (response DeleteAppInstance401JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance403JSONResponse struct{ N403JSONResponse }
+type DeleteApp403JSONResponse struct{ N403JSONResponse }
 
-func (response DeleteAppInstance403JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp403JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3823,9 +5536,9 @@ func (response DeleteAppInstance403JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance404JSONResponse struct{ N404JSONResponse }
+type DeleteApp404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteAppInstance404JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp404JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -3833,9 +5546,18 @@ func (response DeleteAppInstance404JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance500JSONResponse struct{ N500JSONResponse }
+type DeleteApp409JSONResponse ErrorInfo
 
-func (response DeleteAppInstance500JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp409JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApp500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -3843,9 +5565,9 @@ func (response DeleteAppInstance500JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppInstance503JSONResponse struct{ N503JSONResponse }
+type DeleteApp503JSONResponse struct{ N503JSONResponse }
 
-func (response DeleteAppInstance503JSONResponse) VisitDeleteAppInstanceResponse(w http.ResponseWriter) error {
+func (response DeleteApp503JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3853,24 +5575,29 @@ func (response DeleteAppInstance503JSONResponse) VisitDeleteAppInstanceResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppsRequestObject struct {
-	Params GetAppsParams
+type GetAppRequestObject struct {
+	AppId  AppId `json:"appId"`
+	Params GetAppParams
 }
 
-type GetAppsResponseObject interface {
-	VisitGetAppsResponse(w http.ResponseWriter) error
+type GetAppResponseObject interface {
+	VisitGetAppResponse(w http.ResponseWriter) error
 }
 
-type GetApps200ResponseHeaders struct {
+type GetApp200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type GetApps200JSONResponse struct {
-	Body    []AppManifest
-	Headers GetApps200ResponseHeaders
+type GetApp200JSONResponse struct {
+	Body struct {
+		// AppManifest Application information and requirements provided by the
+		// Application Provider
+		AppManifest *AppManifest `json:"appManifest,omitempty"`
+	}
+	Headers GetApp200ResponseHeaders
 }
 
-func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetApp200JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(200)
@@ -3878,9 +5605,9 @@ func (response GetApps200JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps401JSONResponse struct{ N401JSONResponse }
+type GetApp401JSONResponse struct{ N401JSONResponse }
 
-func (response GetApps401JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetApp401JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3888,9 +5615,9 @@ func (response GetApps401JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps403JSONResponse struct{ N403JSONResponse }
+type GetApp403JSONResponse struct{ N403JSONResponse }
 
-func (response GetApps403JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetApp403JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3898,9 +5625,9 @@ func (response GetApps403JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps404JSONResponse struct{ N404JSONResponse }
+type GetApp404JSONResponse struct{ N404JSONResponse }
 
-func (response GetApps404JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetApp404JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -3908,9 +5635,9 @@ func (response GetApps404JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps500JSONResponse struct{ N500JSONResponse }
+type GetApp500JSONResponse struct{ N500JSONResponse }
 
-func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -3918,9 +5645,9 @@ func (response GetApps500JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApps503JSONResponse struct{ N503JSONResponse }
+type GetApp503JSONResponse struct{ N503JSONResponse }
 
-func (response GetApps503JSONResponse) VisitGetAppsResponse(w http.ResponseWriter) error {
+func (response GetApp503JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -3928,35 +5655,36 @@ func (response GetApps503JSONResponse) VisitGetAppsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitAppRequestObject struct {
-	Params SubmitAppParams
-	Body   *SubmitAppJSONRequestBody
+type UpdateAppRequestObject struct {
+	AppId  AppId `json:"appId"`
+	Params UpdateAppParams
+	Body   *UpdateAppJSONRequestBody
 }
 
-type SubmitAppResponseObject interface {
-	VisitSubmitAppResponse(w http.ResponseWriter) error
+type UpdateAppResponseObject interface {
+	VisitUpdateAppResponse(w http.ResponseWriter) error
 }
 
-type SubmitApp201ResponseHeaders struct {
+type UpdateApp200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type SubmitApp201JSONResponse struct {
-	Body    SubmittedApp
-	Headers SubmitApp201ResponseHeaders
+type UpdateApp200JSONResponse struct {
+	Body    AppManifest
+	Headers UpdateApp200ResponseHeaders
 }
 
-func (response SubmitApp201JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response UpdateApp200JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp400JSONResponse struct{ N400JSONResponse }
+type UpdateApp400JSONResponse struct{ N400JSONResponse }
 
-func (response SubmitApp400JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response UpdateApp400JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(400)
@@ -3964,9 +5692,9 @@ func (response SubmitApp400JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp401JSONResponse struct{ N401JSONResponse }
+type UpdateApp401JSONResponse struct{ N401JSONResponse }
 
-func (response SubmitApp401JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response UpdateApp401JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -3974,9 +5702,9 @@ func (response SubmitApp401JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp403JSONResponse struct{ N403JSONResponse }
+type UpdateApp403JSONResponse struct{ N403JSONResponse }
 
-func (response SubmitApp403JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response UpdateApp403JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -3984,18 +5712,28 @@ func (response SubmitApp403JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp409JSONResponse ErrorInfo
+type UpdateApp404JSONResponse struct{ N404JSONResponse }
 
-func (response SubmitApp409JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response UpdateApp404JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateApp409JSONResponse ErrorInfo
+
+func (response UpdateApp409JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitApp500JSONResponse struct{ N500JSONResponse }
+type UpdateApp500JSONResponse struct{ N500JSONResponse }
 
-func (response SubmitApp500JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response UpdateApp500JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4003,19 +5741,84 @@ func (response SubmitApp500JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp501JSONResponse struct{ N501JSONResponse }
+type UpdateApp503JSONResponse struct{ N503JSONResponse }
 
-func (response SubmitApp501JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+func (response UpdateApp503JSONResponse) VisitUpdateAppResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(501)
+	w.WriteHeader(503)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubmitApp503JSONResponse struct{ N503JSONResponse }
+type GetClustersRequestObject struct {
+	Params GetClustersParams
+}
 
-func (response SubmitApp503JSONResponse) VisitSubmitAppResponse(w http.ResponseWriter) error {
+type GetClustersResponseObject interface {
+	VisitGetClustersResponse(w http.ResponseWriter) error
+}
+
+type GetClusters200ResponseHeaders struct {
+	XCorrelator openapi_types.UUID
+}
+
+type GetClusters200JSONResponse struct {
+	Body    []ClusterInfo
+	Headers GetClusters200ResponseHeaders
+}
+
+func (response GetClusters200JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetClusters401JSONResponse struct{ N401JSONResponse }
+
+func (response GetClusters401JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetClusters403JSONResponse struct{ N403JSONResponse }
+
+func (response GetClusters403JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetClusters404JSONResponse struct{ N404JSONResponse }
+
+func (response GetClusters404JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetClusters500JSONResponse struct{ N500JSONResponse }
+
+func (response GetClusters500JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetClusters503JSONResponse struct{ N503JSONResponse }
+
+func (response GetClusters503JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4023,50 +5826,34 @@ func (response SubmitApp503JSONResponse) VisitSubmitAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteAppRequestObject struct {
-	AppId  AppId `json:"appId"`
-	Params DeleteAppParams
+type GetEdgeCloudZonesRequestObject struct {
+	Params GetEdgeCloudZonesParams
 }
 
-type DeleteAppResponseObject interface {
-	VisitDeleteAppResponse(w http.ResponseWriter) error
+type GetEdgeCloudZonesResponseObject interface {
+	VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error
 }
 
-type DeleteApp202ResponseHeaders struct {
+type GetEdgeCloudZones200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type DeleteApp202Response struct {
-	Headers DeleteApp202ResponseHeaders
-}
-
-func (response DeleteApp202Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(202)
-	return nil
-}
-
-type DeleteApp204Response struct {
-}
-
-func (response DeleteApp204Response) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetEdgeCloudZones200JSONResponse struct {
+	Body    EdgeCloudZones
+	Headers GetEdgeCloudZones200ResponseHeaders
 }
 
-type DeleteApp400JSONResponse struct{ N400JSONResponse }
-
-func (response DeleteApp400JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones200JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp401JSONResponse struct{ N401JSONResponse }
+type GetEdgeCloudZones401JSONResponse struct{ N401JSONResponse }
 
-func (response DeleteApp401JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones401JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -4074,9 +5861,9 @@ func (response DeleteApp401JSONResponse) VisitDeleteAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp403JSONResponse struct{ N403JSONResponse }
+type GetEdgeCloudZones403JSONResponse struct{ N403JSONResponse }
 
-func (response DeleteApp403JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones403JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -4084,9 +5871,9 @@ func (response DeleteApp403JSONResponse) VisitDeleteAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp404JSONResponse struct{ N404JSONResponse }
+type GetEdgeCloudZones404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteApp404JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones404JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -4094,18 +5881,9 @@ func (response DeleteApp404JSONResponse) VisitDeleteAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp409JSONResponse ErrorInfo
-
-func (response DeleteApp409JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type DeleteApp500JSONResponse struct{ N500JSONResponse }
+type GetEdgeCloudZones500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones500JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4113,9 +5891,9 @@ func (response DeleteApp500JSONResponse) VisitDeleteAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteApp503JSONResponse struct{ N503JSONResponse }
+type GetEdgeCloudZones503JSONResponse struct{ N503JSONResponse }
 
-func (response DeleteApp503JSONResponse) VisitDeleteAppResponse(w http.ResponseWriter) error {
+func (response GetEdgeCloudZones503JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4123,29 +5901,24 @@ func (response DeleteApp503JSONResponse) VisitDeleteAppResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetAppRequestObject struct {
-	AppId  AppId `json:"appId"`
-	Params GetAppParams
+type GetSubscriptionsRequestObject struct {
+	Params GetSubscriptionsParams
 }
 
-type GetAppResponseObject interface {
-	VisitGetAppResponse(w http.ResponseWriter) error
+type GetSubscriptionsResponseObject interface {
+	VisitGetSubscriptionsResponse(w http.ResponseWriter) error
 }
 
-type GetApp200ResponseHeaders struct {
+type GetSubscriptions200ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type GetApp200JSONResponse struct {
-	Body struct {
-		// AppManifest Application information and requirements provided by the
-		// Application Provider
-		AppManifest *AppManifest `json:"appManifest,omitempty"`
-	}
-	Headers GetApp200ResponseHeaders
+type GetSubscriptions200JSONResponse struct {
+	Body    []Subscription
+	Headers GetSubscriptions200ResponseHeaders
 }
 
-func (response GetApp200JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions200JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(200)
@@ -4153,9 +5926,9 @@ func (response GetApp200JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp401JSONResponse struct{ N401JSONResponse }
+type GetSubscriptions401JSONResponse struct{ N401JSONResponse }
 
-func (response GetApp401JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions401JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -4163,9 +5936,9 @@ func (response GetApp401JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp403JSONResponse struct{ N403JSONResponse }
+type GetSubscriptions403JSONResponse struct{ N403JSONResponse }
 
-func (response GetApp403JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions403JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -4173,19 +5946,9 @@ func (response GetApp403JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp404JSONResponse struct{ N404JSONResponse }
-
-func (response GetApp404JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response.Body)
-}
-
-type GetApp500JSONResponse struct{ N500JSONResponse }
+type GetSubscriptions500JSONResponse struct{ N500JSONResponse }
 
-func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions500JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4193,9 +5956,9 @@ func (response GetApp500JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetApp503JSONResponse struct{ N503JSONResponse }
+type GetSubscriptions503JSONResponse struct{ N503JSONResponse }
 
-func (response GetApp503JSONResponse) VisitGetAppResponse(w http.ResponseWriter) error {
+func (response GetSubscriptions503JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4203,64 +5966,65 @@ func (response GetApp503JSONResponse) VisitGetAppResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClustersRequestObject struct {
-	Params GetClustersParams
+type CreateSubscriptionRequestObject struct {
+	Params CreateSubscriptionParams
+	Body   *CreateSubscriptionJSONRequestBody
 }
 
-type GetClustersResponseObject interface {
-	VisitGetClustersResponse(w http.ResponseWriter) error
+type CreateSubscriptionResponseObject interface {
+	VisitCreateSubscriptionResponse(w http.ResponseWriter) error
 }
 
-type GetClusters200ResponseHeaders struct {
+type CreateSubscription201ResponseHeaders struct {
 	XCorrelator openapi_types.UUID
 }
 
-type GetClusters200JSONResponse struct {
-	Body    []ClusterInfo
-	Headers GetClusters200ResponseHeaders
+type CreateSubscription201JSONResponse struct {
+	Body    Subscription
+	Headers CreateSubscription201ResponseHeaders
 }
 
-func (response GetClusters200JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response CreateSubscription201JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters401JSONResponse struct{ N401JSONResponse }
+type CreateSubscription400JSONResponse struct{ N400JSONResponse }
 
-func (response GetClusters401JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response CreateSubscription400JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters403JSONResponse struct{ N403JSONResponse }
+type CreateSubscription401JSONResponse struct{ N401JSONResponse }
 
-func (response GetClusters403JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response CreateSubscription401JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters404JSONResponse struct{ N404JSONResponse }
+type CreateSubscription403JSONResponse struct{ N403JSONResponse }
 
-func (response GetClusters404JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response CreateSubscription403JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters500JSONResponse struct{ N500JSONResponse }
+type CreateSubscription500JSONResponse struct{ N500JSONResponse }
 
-func (response GetClusters500JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response CreateSubscription500JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4268,9 +6032,9 @@ func (response GetClusters500JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetClusters503JSONResponse struct{ N503JSONResponse }
+type CreateSubscription503JSONResponse struct{ N503JSONResponse }
 
-func (response GetClusters503JSONResponse) VisitGetClustersResponse(w http.ResponseWriter) error {
+func (response CreateSubscription503JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4278,34 +6042,36 @@ func (response GetClusters503JSONResponse) VisitGetClustersResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZonesRequestObject struct {
-	Params GetEdgeCloudZonesParams
+type DeleteSubscriptionRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+	Params         DeleteSubscriptionParams
 }
 
-type GetEdgeCloudZonesResponseObject interface {
-	VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error
+type DeleteSubscriptionResponseObject interface {
+	VisitDeleteSubscriptionResponse(w http.ResponseWriter) error
 }
 
-type GetEdgeCloudZones200ResponseHeaders struct {
-	XCorrelator openapi_types.UUID
+type DeleteSubscription204Response struct {
 }
 
-type GetEdgeCloudZones200JSONResponse struct {
-	Body    EdgeCloudZones
-	Headers GetEdgeCloudZones200ResponseHeaders
+func (response DeleteSubscription204Response) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-func (response GetEdgeCloudZones200JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+type DeleteSubscription400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteSubscription400JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones401JSONResponse struct{ N401JSONResponse }
+type DeleteSubscription401JSONResponse struct{ N401JSONResponse }
 
-func (response GetEdgeCloudZones401JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription401JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(401)
@@ -4313,9 +6079,9 @@ func (response GetEdgeCloudZones401JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones403JSONResponse struct{ N403JSONResponse }
+type DeleteSubscription403JSONResponse struct{ N403JSONResponse }
 
-func (response GetEdgeCloudZones403JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription403JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(403)
@@ -4323,9 +6089,9 @@ func (response GetEdgeCloudZones403JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones404JSONResponse struct{ N404JSONResponse }
+type DeleteSubscription404JSONResponse struct{ N404JSONResponse }
 
-func (response GetEdgeCloudZones404JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription404JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(404)
@@ -4333,9 +6099,9 @@ func (response GetEdgeCloudZones404JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones500JSONResponse struct{ N500JSONResponse }
+type DeleteSubscription500JSONResponse struct{ N500JSONResponse }
 
-func (response GetEdgeCloudZones500JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription500JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(500)
@@ -4343,9 +6109,9 @@ func (response GetEdgeCloudZones500JSONResponse) VisitGetEdgeCloudZonesResponse(
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetEdgeCloudZones503JSONResponse struct{ N503JSONResponse }
+type DeleteSubscription503JSONResponse struct{ N503JSONResponse }
 
-func (response GetEdgeCloudZones503JSONResponse) VisitGetEdgeCloudZonesResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription503JSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("x-correlator", fmt.Sprint(response.Headers.XCorrelator))
 	w.WriteHeader(503)
@@ -4364,6 +6130,9 @@ type StrictServerInterface interface {
 	// Terminate an Application Instance
 	// (DELETE /appinstances/{appInstanceId})
 	DeleteAppInstance(ctx context.Context, request DeleteAppInstanceRequestObject) (DeleteAppInstanceResponseObject, error)
+	// Update an Application Instance
+	// (PATCH /appinstances/{appInstanceId})
+	UpdateAppInstance(ctx context.Context, request UpdateAppInstanceRequestObject) (UpdateAppInstanceResponseObject, error)
 	// Retrieve a list of existing Applications
 	// (GET /apps)
 	GetApps(ctx context.Context, request GetAppsRequestObject) (GetAppsResponseObject, error)
@@ -4376,12 +6145,24 @@ type StrictServerInterface interface {
 	// Retrieve the information of an Application
 	// (GET /apps/{appId})
 	GetApp(ctx context.Context, request GetAppRequestObject) (GetAppResponseObject, error)
+	// Update an Application
+	// (PUT /apps/{appId})
+	UpdateApp(ctx context.Context, request UpdateAppRequestObject) (UpdateAppResponseObject, error)
 	// Retrieve a list of the available clusters
 	// (GET /clusters)
 	GetClusters(ctx context.Context, request GetClustersRequestObject) (GetClustersResponseObject, error)
 	// Retrieve a list of the operators Edge Cloud Zones and their status
 	// (GET /edge-cloud-zones)
 	GetEdgeCloudZones(ctx context.Context, request GetEdgeCloudZonesRequestObject) (GetEdgeCloudZonesResponseObject, error)
+	// Retrieve the list of subscriptions
+	// (GET /subscriptions)
+	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
+	// Subscribe to Application Instance status changes
+	// (POST /subscriptions)
+	CreateSubscription(ctx context.Context, request CreateSubscriptionRequestObject) (CreateSubscriptionResponseObject, error)
+	// Delete a subscription
+	// (DELETE /subscriptions/{subscriptionId})
+	DeleteSubscription(ctx context.Context, request DeleteSubscriptionRequestObject) (DeleteSubscriptionResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -4478,6 +6259,38 @@ func (sh *strictHandler) DeleteAppInstance(ctx echo.Context, appInstanceId AppIn
 	return nil
 }
 
+// UpdateAppInstance operation middleware
+func (sh *strictHandler) UpdateAppInstance(ctx echo.Context, appInstanceId AppInstanceId, params UpdateAppInstanceParams) error {
+	var request UpdateAppInstanceRequestObject
+
+	request.AppInstanceId = appInstanceId
+	request.Params = params
+
+	var body UpdateAppInstanceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAppInstance(ctx.Request().Context(), request.(UpdateAppInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAppInstance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateAppInstanceResponseObject); ok {
+		return validResponse.VisitUpdateAppInstanceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetApps operation middleware
 func (sh *strictHandler) GetApps(ctx echo.Context, params GetAppsParams) error {
 	var request GetAppsRequestObject
@@ -4586,6 +6399,38 @@ func (sh *strictHandler) GetApp(ctx echo.Context, appId AppId, params GetAppPara
 	return nil
 }
 
+// UpdateApp operation middleware
+func (sh *strictHandler) UpdateApp(ctx echo.Context, appId AppId, params UpdateAppParams) error {
+	var request UpdateAppRequestObject
+
+	request.AppId = appId
+	request.Params = params
+
+	var body UpdateAppJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateApp(ctx.Request().Context(), request.(UpdateAppRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateApp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateAppResponseObject); ok {
+		return validResponse.VisitUpdateAppResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetClusters operation middleware
 func (sh *strictHandler) GetClusters(ctx echo.Context, params GetClustersParams) error {
 	var request GetClustersRequestObject
@@ -4635,3 +6480,85 @@ func (sh *strictHandler) GetEdgeCloudZones(ctx echo.Context, params GetEdgeCloud
 	}
 	return nil
 }
+
+// GetSubscriptions operation middleware
+func (sh *strictHandler) GetSubscriptions(ctx echo.Context, params GetSubscriptionsParams) error {
+	var request GetSubscriptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptions(ctx.Request().Context(), request.(GetSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSubscriptionsResponseObject); ok {
+		return validResponse.VisitGetSubscriptionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateSubscription operation middleware
+func (sh *strictHandler) CreateSubscription(ctx echo.Context, params CreateSubscriptionParams) error {
+	var request CreateSubscriptionRequestObject
+
+	request.Params = params
+
+	var body CreateSubscriptionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSubscription(ctx.Request().Context(), request.(CreateSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSubscription")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateSubscriptionResponseObject); ok {
+		return validResponse.VisitCreateSubscriptionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteSubscription operation middleware
+func (sh *strictHandler) DeleteSubscription(ctx echo.Context, subscriptionId SubscriptionId, params DeleteSubscriptionParams) error {
+	var request DeleteSubscriptionRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscription(ctx.Request().Context(), request.(DeleteSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscription")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteSubscriptionResponseObject); ok {
+		return validResponse.VisitDeleteSubscriptionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
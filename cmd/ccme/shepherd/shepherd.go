@@ -38,6 +38,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
 	k8sbm "github.com/edgexr/edge-cloud-platform/pkg/platform/k8s-baremetal"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/libvirt"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/openstack"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/platforms"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/vcd"
@@ -409,6 +410,15 @@ func getPlatform() (platform.Platform, error) {
 		plat = &shepherd_vmprovider.ShepherdPlatform{
 			VMPlatform: &vmPlatform,
 		}
+	case pf.PlatformTypeLibvirt:
+		libvirtProvider := libvirt.LibvirtPlatform{}
+		vmPlatform := vmlayer.VMPlatform{
+			Type:       pfType,
+			VMProvider: &libvirtProvider,
+		}
+		plat = &shepherd_vmprovider.ShepherdPlatform{
+			VMPlatform: &vmPlatform,
+		}
 	case pf.PlatformTypeVCD:
 		vcdProvider := vcd.VcdPlatform{}
 		vmPlatform := vmlayer.VMPlatform{
@@ -30,13 +30,15 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
-
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
+	"github.com/edgexr/edge-cloud-platform/pkg/edgeturnclient"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	edgetls "github.com/edgexr/edge-cloud-platform/pkg/tls"
 	"github.com/gorilla/websocket"
@@ -50,22 +52,32 @@ var region = flag.String("region", "local", "region name")
 var debugLevels = flag.String("d", "", fmt.Sprintf("comma separated list of %v", log.DebugLevelStrings))
 var testMode = flag.Bool("testMode", false, "Run EdgeTurn in test mode")
 var consoleAddr = flag.String("consoleAddr", "", "Address of the UI console using EdgeTurn, required for origin check")
+var portForwardMaxTTL = flag.Duration("portForwardMaxTTL", time.Hour, "Maximum time to live for port forward sessions")
+var portForwardMaxPerUser = flag.Int("portForwardMaxPerUser", 5, "Maximum number of concurrent port forward sessions per user")
 
 const (
-	ShellConnTimeout   = 5 * time.Minute
-	ConsoleConnTimeout = 20 * time.Minute
+	ShellConnTimeout      = 5 * time.Minute
+	ConsoleConnTimeout    = 20 * time.Minute
+	PortForwardDefaultTTL = 15 * time.Minute
 )
 
+var PortForwardCheckInterval = 5 * time.Second
+
 type ProxyValue struct {
+	Type      cloudcommon.ExecReqType
 	InitURL   *url.URL
 	CrmConn   net.Conn
 	ProxySess *smux.Session
 	Connected chan bool
+	CopyFile  *edgeproto.CopyFile
+	// Done is closed when a port forward session ends
+	Done chan struct{}
 }
 
 type TurnProxyObj struct {
-	mux      sync.Mutex
-	proxyMap map[string]*ProxyValue
+	mux          sync.Mutex
+	proxyMap     map[string]*ProxyValue
+	userSessions map[string]int
 }
 
 func (cp *TurnProxyObj) Add(token string, proxyVal *ProxyValue) {
@@ -87,6 +99,19 @@ func (cp *TurnProxyObj) Remove(token string) {
 	delete(cp.proxyMap, token)
 }
 
+// Take removes the token and returns its proxy value, so that
+// the token can only be used once.
+func (cp *TurnProxyObj) Take(token string) *ProxyValue {
+	cp.mux.Lock()
+	defer cp.mux.Unlock()
+	out, ok := cp.proxyMap[token]
+	if !ok {
+		return nil
+	}
+	delete(cp.proxyMap, token)
+	return out
+}
+
 func (cp *TurnProxyObj) Get(token string) *ProxyValue {
 	cp.mux.Lock()
 	defer cp.mux.Unlock()
@@ -96,6 +121,36 @@ func (cp *TurnProxyObj) Get(token string) *ProxyValue {
 	return nil
 }
 
+// AddUserSession tracks a port forward session for the user. It fails
+// if the user already has the maximum number of sessions.
+func (cp *TurnProxyObj) AddUserSession(user string, max int) error {
+	cp.mux.Lock()
+	defer cp.mux.Unlock()
+	if cp.userSessions == nil {
+		cp.userSessions = make(map[string]int)
+	}
+	if cp.userSessions[user] >= max {
+		return fmt.Errorf("user %q already has the maximum of %d port forward sessions", user, max)
+	}
+	cp.userSessions[user]++
+	return nil
+}
+
+func (cp *TurnProxyObj) RemoveUserSession(user string) {
+	cp.mux.Lock()
+	defer cp.mux.Unlock()
+	cp.userSessions[user]--
+	if cp.userSessions[user] <= 0 {
+		delete(cp.userSessions, user)
+	}
+}
+
+func (cp *TurnProxyObj) NumUserSessions(user string) int {
+	cp.mux.Lock()
+	defer cp.mux.Unlock()
+	return cp.userSessions[user]
+}
+
 var (
 	sigChan   chan os.Signal
 	TurnProxy = &TurnProxyObj{}
@@ -197,13 +252,29 @@ func handleConnection(crmConn net.Conn) {
 	}
 	log.SpanLog(ctx, log.DebugLevelInfo, "received execreq info", "info", execReqInfo)
 
+	if execReqInfo.Type == cloudcommon.ExecReqPortForward {
+		handlePortForward(ctx, crmConn, execReqInfo.PortForward)
+		return
+	}
+
+	if execReqInfo.Type == cloudcommon.ExecReqCopyFile && execReqInfo.CopyFile == nil {
+		log.SpanLog(ctx, log.DebugLevelInfo, "missing copy file info")
+		writeSessionInfo(ctx, crmConn, &cloudcommon.SessionInfo{
+			Err: "missing copy file info",
+		})
+		crmConn.Close()
+		return
+	}
+
 	// Generate session token
 	tokObj := ksuid.New()
 	token := tokObj.String()
 	proxyVal := &ProxyValue{
+		Type:      execReqInfo.Type,
 		InitURL:   execReqInfo.InitURL,
 		CrmConn:   crmConn,
 		Connected: make(chan bool),
+		CopyFile:  execReqInfo.CopyFile,
 	}
 
 	// Send Initial Information about the connection
@@ -232,7 +303,7 @@ func handleConnection(crmConn net.Conn) {
 	crmConn.Write(out)
 
 	switch execReqInfo.Type {
-	case cloudcommon.ExecReqShell:
+	case cloudcommon.ExecReqShell, cloudcommon.ExecReqCopyFile:
 		select {
 		case <-proxyVal.Connected:
 			// Once client connects, proxy server will handle closing this
@@ -259,6 +330,120 @@ func handleConnection(crmConn net.Conn) {
 	}
 }
 
+func getPortForwardTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		ttl = PortForwardDefaultTTL
+	}
+	if ttl > *portForwardMaxTTL {
+		ttl = *portForwardMaxTTL
+	}
+	return ttl
+}
+
+// handlePortForward sets up a port forward session from the CRM.
+// Client connections are multiplexed over the CRM connection, which
+// is closed once the session TTL expires.
+func handlePortForward(ctx context.Context, crmConn net.Conn, info *cloudcommon.PortForwardInfo) {
+	defer crmConn.Close()
+	if info == nil {
+		log.SpanLog(ctx, log.DebugLevelInfo, "missing port forward info")
+		return
+	}
+	if info.User == "" {
+		// user is required for session limits and auditing
+		log.SpanLog(ctx, log.DebugLevelInfo, "missing port forward user")
+		writeSessionInfo(ctx, crmConn, &cloudcommon.SessionInfo{
+			Err: "missing port forward user",
+		})
+		return
+	}
+	org := info.AppInstKey.Organization
+	keyTags := info.AppInstKey.GetTags()
+	eventKVs := []string{"user", info.User, "port", strconv.Itoa(int(info.Port))}
+	ttl := getPortForwardTTL(info.TTL)
+
+	sessInfo := cloudcommon.SessionInfo{}
+	err := TurnProxy.AddUserSession(info.User, *portForwardMaxPerUser)
+	if err != nil {
+		nodeMgr.Event(ctx, "Port forward session rejected", org, keyTags, err, eventKVs...)
+		sessInfo.Err = err.Error()
+		writeSessionInfo(ctx, crmConn, &sessInfo)
+		return
+	}
+	defer TurnProxy.RemoveUserSession(info.User)
+
+	sess, err := smux.Client(crmConn, nil)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfo, "failed to setup smux client", "err", err)
+		return
+	}
+	defer sess.Close()
+
+	token := ksuid.New().String()
+	done := make(chan struct{})
+	defer close(done)
+	TurnProxy.Add(token, &ProxyValue{
+		Type:      cloudcommon.ExecReqPortForward,
+		CrmConn:   crmConn,
+		ProxySess: sess,
+		Done:      done,
+	})
+	defer TurnProxy.Remove(token)
+
+	sessInfo.Token = token
+	if err := writeSessionInfo(ctx, crmConn, &sessInfo); err != nil {
+		return
+	}
+	start := time.Now()
+	nodeMgr.Event(ctx, "Port forward session started", org, keyTags, nil, append(eventKVs, "ttl", ttl.String())...)
+
+	// smux keepalive closes the session if the CRM goes away
+	reason := "ttl expired"
+	expire := time.After(ttl)
+	ticker := time.NewTicker(PortForwardCheckInterval)
+	defer ticker.Stop()
+	for done := false; !done; {
+		select {
+		case <-expire:
+			done = true
+		case <-ticker.C:
+			if sess.IsClosed() {
+				reason = "connection closed"
+				done = true
+			}
+		}
+	}
+	nodeMgr.TimedEvent(ctx, "Port forward session ended", org, node.EventType, keyTags, nil, start, time.Now(), append(eventKVs, "reason", reason)...)
+}
+
+// proxyStreams copies data between the streams until either
+// side is closed.
+func proxyStreams(a, b io.ReadWriteCloser) {
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(a, b)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(b, a)
+		done <- struct{}{}
+	}()
+	<-done
+	a.Close()
+	b.Close()
+}
+
+func writeSessionInfo(ctx context.Context, crmConn net.Conn, sessInfo *cloudcommon.SessionInfo) error {
+	out, err := json.Marshal(sessInfo)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfo, "failed to marshal session info", "info", sessInfo, "err", err)
+		return err
+	}
+	log.SpanLog(ctx, log.DebugLevelInfo, "send session info", "info", string(out))
+	_, err = crmConn.Write(out)
+	return err
+}
+
 // Below code from "Start" to "End" is copied from:
 //   https://github.com/golang/go/blob/master/src/net/http/transport.go
 // It is required for Websockets to work
@@ -545,6 +730,132 @@ func setupProxyServer(ctx context.Context, serveMux *http.ServeMux) (*http.Serve
 		TurnProxy.Remove(token)
 		log.SpanLog(ctx, log.DebugLevelInfo, "client exited", "token", token)
 	})
+	serveMux.HandleFunc("/edgeforward", func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("edgetoken")
+		if token == "" {
+			log.SpanLog(ctx, log.DebugLevelInfo, "no token found")
+			http.Error(w, "no token specified", http.StatusBadRequest)
+			return
+		}
+		proxyVal := TurnProxy.Get(token)
+		if proxyVal == nil || proxyVal.ProxySess == nil || proxyVal.Type != cloudcommon.ExecReqPortForward {
+			log.SpanLog(ctx, log.DebugLevelInfo, "unable to find proxy connection", "token", token)
+			http.Error(w, "invalid token", http.StatusNotFound)
+			return
+		}
+		// token is single use, the client multiplexes all of its
+		// connections over this websocket connection.
+		if TurnProxy.Take(token) != proxyVal {
+			log.SpanLog(ctx, log.DebugLevelInfo, "token already used", "token", token)
+			http.Error(w, "invalid token", http.StatusNotFound)
+			return
+		}
+		// the port forward session ends when the client disconnects
+		defer proxyVal.ProxySess.Close()
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfo, "failed to upgrade to websocket", "err", err)
+			return
+		}
+		defer c.Close()
+		clientSess, err := smux.Server(edgeturnclient.NewWebsocketConn(c), nil)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfo, "failed to setup smux server", "err", err)
+			return
+		}
+		defer clientSess.Close()
+		log.SpanLog(ctx, log.DebugLevelInfo, "client connected to edgeforward", "token", token)
+
+		go func() {
+			// disconnect the client once the session expires
+			<-proxyVal.Done
+			clientSess.Close()
+		}()
+		for {
+			clientStream, err := clientSess.AcceptStream()
+			if err != nil {
+				break
+			}
+			crmStream, err := proxyVal.ProxySess.OpenStream()
+			if err != nil {
+				log.SpanLog(ctx, log.DebugLevelInfo, "failed to open port forward stream", "token", token, "err", err)
+				clientStream.Close()
+				break
+			}
+			go proxyStreams(clientStream, crmStream)
+		}
+		log.SpanLog(ctx, log.DebugLevelInfo, "client exited edgeforward", "token", token)
+	})
+	serveMux.HandleFunc("/edgecopy", func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("edgetoken")
+		if token == "" {
+			log.SpanLog(ctx, log.DebugLevelInfo, "no token found")
+			http.Error(w, "no token specified", http.StatusBadRequest)
+			return
+		}
+		proxyVal := TurnProxy.Get(token)
+		if proxyVal == nil || proxyVal.CrmConn == nil || proxyVal.Type != cloudcommon.ExecReqCopyFile {
+			log.SpanLog(ctx, log.DebugLevelInfo, "unable to find proxy connection", "token", token)
+			http.Error(w, "invalid token", http.StatusNotFound)
+			return
+		}
+		// the method must match the direction of the copy requested
+		// via the ExecRequest
+		upload := proxyVal.CopyFile != nil && proxyVal.CopyFile.Upload
+		if upload && r.Method != http.MethodPut && r.Method != http.MethodPost {
+			http.Error(w, "upload requires PUT or POST, not "+r.Method, http.StatusMethodNotAllowed)
+			return
+		}
+		if !upload && r.Method != http.MethodGet {
+			http.Error(w, "download requires GET, not "+r.Method, http.StatusMethodNotAllowed)
+			return
+		}
+		// token is single use
+		if TurnProxy.Take(token) != proxyVal {
+			log.SpanLog(ctx, log.DebugLevelInfo, "token already used", "token", token)
+			http.Error(w, "invalid token", http.StatusNotFound)
+			return
+		}
+		crmConn := proxyVal.CrmConn
+		proxyVal.Connected <- true
+		defer crmConn.Close()
+		log.SpanLog(ctx, log.DebugLevelInfo, "client connected to edgecopy", "token", token, "method", r.Method)
+
+		if !upload {
+			// download, CRM streams the file contents and closes
+			// the connection. The content length allows the client
+			// to detect if the stream was cut short.
+			size := proxyVal.CopyFile.FileSize
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+			_, err := io.CopyN(w, crmConn, size)
+			if err != nil && !errors.Is(err, net.ErrClosed) {
+				log.SpanLog(ctx, log.DebugLevelInfo, "failed to copy file to client", "token", token, "err", err)
+			}
+			return
+		}
+		// upload, CRM reads the file contents, and
+		// writes back any error before closing the connection.
+		body := http.MaxBytesReader(w, r.Body, cloudcommon.ExecCopyFileMaxSize)
+		_, err := io.Copy(crmConn, body)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfo, "failed to copy file to CRM", "token", token, "err", err)
+			http.Error(w, fmt.Sprintf("failed to upload file, %v", err), http.StatusBadRequest)
+			return
+		}
+		if cw, ok := crmConn.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		}
+		reply, err := io.ReadAll(crmConn)
+		if err != nil && !errors.Is(err, net.ErrClosed) {
+			log.SpanLog(ctx, log.DebugLevelInfo, "failed to read upload result from CRM", "token", token, "err", err)
+		}
+		if len(reply) > 0 {
+			http.Error(w, string(reply), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
 
 	server := &http.Server{
 		Addr:    *proxyAddr,
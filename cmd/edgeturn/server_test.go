@@ -25,9 +25,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/edgeturnclient"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
@@ -164,6 +166,280 @@ func TestEdgeTurnServer(t *testing.T) {
 	isTLS := true
 	testEdgeTurnConsole(t, isTLS)
 	testEdgeTurnConsole(t, !isTLS)
+
+	// Test edge copy file
+	// ===================
+	testEdgeTurnCopyFile(t)
+
+	// Test port forward
+	// =================
+	testEdgeTurnPortForward(t)
+}
+
+func startPortForwardSession(t *testing.T, user string, ttl time.Duration) (net.Conn, cloudcommon.SessionInfo) {
+	tlsConfig, err := edgetls.GetLocalTLSConfig()
+	require.Nil(t, err, "get local tls config")
+	turnConn, err := tls.Dial("tcp", "127.0.0.1:6080", tlsConfig)
+	require.Nil(t, err, "connect to EdgeTurn server")
+
+	execReqInfo := cloudcommon.ExecReqInfo{
+		Type: cloudcommon.ExecReqPortForward,
+		PortForward: &cloudcommon.PortForwardInfo{
+			AppInstKey: edgeproto.AppInstKey{
+				Name:         "inst1",
+				Organization: "devorg",
+			},
+			Port: 5432,
+			User: user,
+			TTL:  ttl,
+		},
+	}
+	out, err := json.Marshal(&execReqInfo)
+	require.Nil(t, err, "marshal ExecReqInfo")
+	_, err = turnConn.Write(out)
+	require.Nil(t, err, "send ExecReqInfo to EdgeTurn server")
+
+	var sessInfo cloudcommon.SessionInfo
+	d := json.NewDecoder(turnConn)
+	err = d.Decode(&sessInfo)
+	require.Nil(t, err, "decode session info from EdgeTurn server")
+	return turnConn, sessInfo
+}
+
+func testEdgeTurnPortForward(t *testing.T) {
+	*portForwardMaxPerUser = 1
+	*portForwardMaxTTL = 2 * time.Second
+	PortForwardCheckInterval = 100 * time.Millisecond
+
+	turnConn, sessInfo := startPortForwardSession(t, "user1", 0)
+	defer turnConn.Close()
+	require.Equal(t, "", sessInfo.Err)
+	require.NotEqual(t, "", sessInfo.Token, "token is not empty")
+	require.Equal(t, 1, TurnProxy.NumUserSessions("user1"))
+
+	// CRM side echoes data on each forwarded stream
+	sess, err := smux.Server(turnConn, nil)
+	require.Nil(t, err)
+	go func() {
+		for {
+			stream, err := sess.AcceptStream()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(stream, stream)
+				stream.Close()
+			}()
+		}
+	}()
+
+	// per-user session limit
+	turnConn2, sessInfo2 := startPortForwardSession(t, "user1", 0)
+	turnConn2.Close()
+	require.Contains(t, sessInfo2.Err, "maximum of 1 port forward sessions")
+	require.Equal(t, "", sessInfo2.Token)
+	require.Equal(t, 1, TurnProxy.NumUserSessions("user1"))
+
+	// multiple client connections are multiplexed over the
+	// client websocket connection
+	dialer := websocket.Dialer{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	ws, _, err := dialer.Dial("wss://127.0.0.1:8443/edgeforward?edgetoken="+sessInfo.Token, nil)
+	require.Nil(t, err, "client websocket connection to EdgeTurn server")
+	defer ws.Close()
+	clientSess, err := smux.Client(edgeturnclient.NewWebsocketConn(ws), nil)
+	require.Nil(t, err)
+	for _, msg := range []string{"conn1 data", "conn2 data"} {
+		stream, err := clientSess.OpenStream()
+		require.Nil(t, err)
+		_, err = stream.Write([]byte(msg))
+		require.Nil(t, err)
+		reply := make([]byte, len(msg))
+		_, err = io.ReadFull(stream, reply)
+		require.Nil(t, err)
+		require.Equal(t, msg, string(reply))
+		stream.Close()
+	}
+
+	// token is single use
+	require.Nil(t, TurnProxy.Get(sessInfo.Token), "token consumed")
+	_, _, err = dialer.Dial("wss://127.0.0.1:8443/edgeforward?edgetoken="+sessInfo.Token, nil)
+	require.NotNil(t, err)
+
+	// session is closed after ttl, which is limited by the max ttl
+	waitSessionClosed(t, clientSess, 5*time.Second)
+	require.Equal(t, 0, TurnProxy.NumUserSessions("user1"))
+
+	// session is closed when the client disconnects
+	turnConn3, sessInfo3 := startPortForwardSession(t, "user1", 0)
+	defer turnConn3.Close()
+	require.Equal(t, "", sessInfo3.Err)
+	sess3, err := smux.Server(turnConn3, nil)
+	require.Nil(t, err)
+	ws3, _, err := dialer.Dial("wss://127.0.0.1:8443/edgeforward?edgetoken="+sessInfo3.Token, nil)
+	require.Nil(t, err)
+	ws3.Close()
+	waitSessionClosed(t, sess3, time.Second)
+	for ii := 0; ii < 10; ii++ {
+		if TurnProxy.NumUserSessions("user1") == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.Equal(t, 0, TurnProxy.NumUserSessions("user1"))
+
+	// user is required
+	turnConn4, sessInfo4 := startPortForwardSession(t, "", 0)
+	turnConn4.Close()
+	require.Equal(t, "missing port forward user", sessInfo4.Err)
+	require.Equal(t, "", sessInfo4.Token)
+}
+
+// waitSessionClosed waits for the remote end to close the session.
+func waitSessionClosed(t *testing.T, sess *smux.Session, timeout time.Duration) {
+	closed := make(chan struct{})
+	go func() {
+		for {
+			stream, err := sess.AcceptStream()
+			if err != nil {
+				break
+			}
+			stream.Close()
+		}
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(timeout):
+		require.Fail(t, "timed out waiting for session to close")
+	}
+}
+
+func startCopyFileSession(t *testing.T, copyFile *edgeproto.CopyFile) (net.Conn, string) {
+	tlsConfig, err := edgetls.GetLocalTLSConfig()
+	require.Nil(t, err, "get local tls config")
+	turnConn, err := tls.Dial("tcp", "127.0.0.1:6080", tlsConfig)
+	require.Nil(t, err, "connect to EdgeTurn server")
+
+	execReqInfo := cloudcommon.ExecReqInfo{
+		Type:     cloudcommon.ExecReqCopyFile,
+		CopyFile: copyFile,
+	}
+	out, err := json.Marshal(&execReqInfo)
+	require.Nil(t, err, "marshal ExecReqInfo")
+	_, err = turnConn.Write(out)
+	require.Nil(t, err, "send ExecReqInfo to EdgeTurn server")
+
+	var sessInfo cloudcommon.SessionInfo
+	d := json.NewDecoder(turnConn)
+	err = d.Decode(&sessInfo)
+	require.Nil(t, err, "decode session info from EdgeTurn server")
+	require.NotEqual(t, "", sessInfo.Token, "token is not empty")
+	return turnConn, sessInfo.Token
+}
+
+func testEdgeTurnCopyFile(t *testing.T) {
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	copyUrl := "https://127.0.0.1:8443/edgecopy?edgetoken="
+
+	download := &edgeproto.CopyFile{
+		Path:     "/tmp/file",
+		FileSize: 13,
+	}
+	upload := &edgeproto.CopyFile{
+		Path:     "/tmp/file",
+		Upload:   true,
+		FileSize: 11,
+	}
+
+	// download
+	turnConn, token := startCopyFileSession(t, download)
+	go func() {
+		turnConn.Write([]byte("file contents"))
+		turnConn.Close()
+	}()
+	resp, err := client.Get(copyUrl + token)
+	require.Nil(t, err)
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int64(13), resp.ContentLength)
+	require.Equal(t, "file contents", string(data))
+	require.Nil(t, TurnProxy.Get(token), "token is single use")
+
+	// token cannot be reused
+	resp, err = client.Get(copyUrl + token)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// download cut short is detected by the client
+	turnConn, token = startCopyFileSession(t, download)
+	go func() {
+		turnConn.Write([]byte("file"))
+		turnConn.Close()
+	}()
+	resp, err = client.Get(copyUrl + token)
+	require.Nil(t, err)
+	_, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NotNil(t, err)
+
+	// method must match the copy direction, and a
+	// rejected request does not use up the token
+	turnConn, token = startCopyFileSession(t, download)
+	req, err := http.NewRequest(http.MethodPut, copyUrl+token, strings.NewReader("upload data"))
+	require.Nil(t, err)
+	resp, err = client.Do(req)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	require.NotNil(t, TurnProxy.Get(token))
+	turnConn.Close()
+	turnConn, token = startCopyFileSession(t, upload)
+	resp, err = client.Get(copyUrl + token)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	require.NotNil(t, TurnProxy.Get(token))
+	turnConn.Close()
+
+	// upload
+	turnConn, token = startCopyFileSession(t, upload)
+	recvData := make(chan string, 1)
+	go func() {
+		data, _ := io.ReadAll(io.LimitReader(turnConn, 11))
+		recvData <- string(data)
+		turnConn.Close()
+	}()
+	req, err = http.NewRequest(http.MethodPut, copyUrl+token, strings.NewReader("upload data"))
+	require.Nil(t, err)
+	resp, err = client.Do(req)
+	require.Nil(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "upload data", <-recvData)
+
+	// upload failure is reported back to the client
+	turnConn, token = startCopyFileSession(t, upload)
+	go func() {
+		io.ReadAll(io.LimitReader(turnConn, 11))
+		turnConn.Write([]byte("permission denied"))
+		turnConn.Close()
+	}()
+	req, err = http.NewRequest(http.MethodPut, copyUrl+token, strings.NewReader("upload data"))
+	require.Nil(t, err)
+	resp, err = client.Do(req)
+	require.Nil(t, err)
+	data, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Nil(t, err)
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	require.Contains(t, string(data), "permission denied")
 }
 
 func testEdgeTurnConsole(t *testing.T, isTLS bool) {
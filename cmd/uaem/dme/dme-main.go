@@ -43,6 +43,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/platform"
 	"github.com/edgexr/edge-cloud-platform/pkg/plugin/edgeevents"
 	pplat "github.com/edgexr/edge-cloud-platform/pkg/plugin/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/rediscache"
 	"github.com/edgexr/edge-cloud-platform/pkg/tls"
 	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
@@ -85,6 +86,9 @@ var carrier = flag.String("carrier", "standalone", "carrier name for API connect
 
 var operatorApiGw op.OperatorApiGw
 
+// Optional redis used to share rate limits between DMEs in the region
+var redisCfg rediscache.RedisConfig
+
 // server is used to implement helloworld.GreeterServer.
 type server struct{}
 
@@ -601,17 +605,28 @@ func getDmeApiRateLimitSettings(apiName string, target edgeproto.RateLimitTarget
 }
 
 // Initialize API RateLimitManager
-func initRateLimitMgr() {
+func initRateLimitMgr(ctx context.Context) error {
 	disableRateLimit := uaemcommon.Settings.DisableRateLimit
 	if *testMode {
 		disableRateLimit = true
 	}
 	uaemcommon.RateLimitMgr = ratelimit.NewRateLimitManager(disableRateLimit, int(uaemcommon.Settings.RateLimitMaxTrackedIps), 0)
+	if redisCfg.AddrSpecified() {
+		// Redis is not required to be up, limiters fall back to
+		// local limits if redis is unreachable.
+		redisClient, err := rediscache.NewClient(ctx, &redisCfg)
+		if err != nil {
+			return err
+		}
+		uaemcommon.RateLimitMgr.SetRedisClient(redisClient)
+	}
+	return nil
 }
 
 func main() {
 	nodeMgr.InitFlags()
 	nodeMgr.AccessKeyClient.InitFlags()
+	redisCfg.InitFlags(rediscache.DefaultCfgRedisOptional)
 	flag.Parse()
 	log.SetDebugLevelStrs(*debugLevels)
 	done := make(chan struct{})
@@ -720,7 +735,11 @@ func main() {
 	uaemcommon.InitAppInstClients(time.Duration(uaemcommon.Settings.AppinstClientCleanupInterval))
 	defer uaemcommon.StopAppInstClients()
 
-	initRateLimitMgr()
+	err = initRateLimitMgr(ctx)
+	if err != nil {
+		span.Finish()
+		log.FatalLog("Failed to init rate limit manager", "err", err)
+	}
 	grpcOpts = append(grpcOpts,
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(ratelimit.GetDmeUnaryRateLimiterInterceptor(uaemcommon.RateLimitMgr), uaemcommon.UnaryAuthInterceptor, uaemcommon.Stats.UnaryStatsInterceptor)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(ratelimit.GetDmeStreamRateLimiterInterceptor(uaemcommon.RateLimitMgr), uaemcommon.GetStreamAuthInterceptor(), uaemcommon.Stats.GetStreamStatsInterceptor())))
@@ -45,7 +45,8 @@ func TestNotify(t *testing.T) {
 	eehandler, err := initEdgeEventsPlugin(ctx, "standalone")
 	require.Nil(t, err, "init edge events plugin")
 	uaemcommon.SetupMatchEngine(eehandler)
-	initRateLimitMgr()
+	err = initRateLimitMgr(ctx)
+	require.Nil(t, err, "init rate limit mgr")
 	uaemcommon.InitAppInstClients(time.Minute)
 	defer uaemcommon.StopAppInstClients()
 	apps := uaemtest.GenerateApps()
@@ -41,12 +41,12 @@ var MEXPrometheusUserAlertsT = `additionalPrometheusRules:
       for: [[ .TriggerTimeString ]]
       labels:
         [[- range $key, $value := .Rule.Labels ]]
-        [[ $key ]]: "[[ $value ]]"
+        [[ $key ]]: [[ printf "%q" $value ]]
         [[- end ]]
       [[- if gt (len .Rule.Annotations) 0 ]]
       annotations:
         [[- range $key, $value := .Rule.Annotations ]]
-        [[ $key ]]: "[[ $value ]]"
+        [[ $key ]]: [[ printf "%q" $value ]]
         [[- end ]]
       [[- end ]]
     [[- end ]]
@@ -63,7 +63,7 @@ type AlertArgs struct {
 }
 
 // Walk the alerts and create a prometheus alert structure from them
-func getAlertRulesArgs(ctx context.Context, appInst *edgeproto.AppInst, alerts []edgeproto.AlertPolicy) AlertArgs {
+func getAlertRulesArgs(ctx context.Context, appInst *edgeproto.AppInst, alerts []edgeproto.AlertPolicy) (AlertArgs, error) {
 	alertArgs := AlertArgs{ClusterAlerts: []*PrometheusClusterAlert{}}
 
 	// filter the prom query to only include mexAppName
@@ -98,6 +98,13 @@ func getAlertRulesArgs(ctx context.Context, appInst *edgeproto.AppInst, alerts [
 			exp := fmt.Sprintf("%s > %d", diskQuery, alerts[ii].DiskUtilizationLimit)
 			expressions = append(expressions, exp)
 		}
+		for jj := range alerts[ii].Conditions {
+			exp, err := getConditionPromQuery(labelFilter, &alerts[ii].Conditions[jj])
+			if err != nil {
+				return alertArgs, fmt.Errorf("alert policy %s condition %d, %s", alerts[ii].Key.Name, jj+1, err)
+			}
+			expressions = append(expressions, exp)
+		}
 		promAlert.Rule.Expr = strings.Join(expressions, " and ")
 
 		log.SpanLog(ctx, log.DebugLevelInfo, "Adding Prometheus user alert rule", "appInst", appInst,
@@ -105,7 +112,7 @@ func getAlertRulesArgs(ctx context.Context, appInst *edgeproto.AppInst, alerts [
 
 		alertArgs.ClusterAlerts = append(alertArgs.ClusterAlerts, &promAlert)
 	}
-	return alertArgs
+	return alertArgs, nil
 }
 
 func GetAlertRules(ctx context.Context, appInst *edgeproto.AppInst, alerts []edgeproto.AlertPolicy) (string, error) {
@@ -115,9 +122,12 @@ func GetAlertRules(ctx context.Context, appInst *edgeproto.AppInst, alerts []edg
 	}
 	// change delims because Prometheus triggers off of golang delims
 	t := template.Must(template.New("policy").Delims("[[", "]]").Parse(MEXPrometheusUserAlertsT))
-	args := getAlertRulesArgs(ctx, appInst, alerts)
+	args, err := getAlertRulesArgs(ctx, appInst, alerts)
+	if err != nil {
+		return "", err
+	}
 	buf := bytes.Buffer{}
-	err := t.Execute(&buf, &args)
+	err = t.Execute(&buf, &args)
 	if err != nil {
 		return "", err
 	}
@@ -196,5 +206,8 @@ func getAlertPolicyDescription(in *edgeproto.AlertPolicy) string {
 		connStr := fmt.Sprintf("Number of active connections > %d", in.ActiveConnLimit)
 		defaultDescription = append(defaultDescription, connStr)
 	}
+	for ii := range in.Conditions {
+		defaultDescription = append(defaultDescription, getConditionDescription(&in.Conditions[ii]))
+	}
 	return strings.Join(defaultDescription, " and ")
 }
@@ -0,0 +1,609 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/promutils"
+	"github.com/prometheus/common/model"
+)
+
+// User defined alert condition expressions are a restricted subset
+// of PromQL. The expressions are evaluated by the cluster's prometheus
+// which is shared by all the Apps on the cluster, so the result of the
+// expression must be per pod, which allows it to be joined against the
+// kube pod labels of the AppInst. Anything that would allow the
+// expression to combine series across pods, such as aggregations that
+// drop the pod label or vector matching modifiers, is not allowed.
+
+const (
+	// ConditionWindowVar is replaced by the condition's window
+	ConditionWindowVar = "$window"
+	// MaxConditionExprLen is the max length of a condition expression
+	MaxConditionExprLen = 1024
+	// PodLabel is the label the condition expressions must preserve
+	PodLabel = "pod"
+)
+
+// range vector functions, first args are scalars, last is a range vector
+var conditionRangeFuncs = map[string]int{
+	"rate":               0,
+	"irate":              0,
+	"increase":           0,
+	"delta":              0,
+	"idelta":             0,
+	"deriv":              0,
+	"changes":            0,
+	"resets":             0,
+	"avg_over_time":      0,
+	"min_over_time":      0,
+	"max_over_time":      0,
+	"sum_over_time":      0,
+	"count_over_time":    0,
+	"last_over_time":     0,
+	"stddev_over_time":   0,
+	"quantile_over_time": 1,
+}
+
+// instant vector functions, first args are scalars, last is a vector,
+// the number of scalars is fixed unless optional.
+var conditionVectorFuncs = map[string]int{
+	"abs":                0,
+	"ceil":               0,
+	"floor":              0,
+	"round":              0,
+	"sqrt":               0,
+	"exp":                0,
+	"ln":                 0,
+	"log2":               0,
+	"log10":              0,
+	"histogram_quantile": 1,
+}
+
+// functions with trailing scalar args
+var conditionClampFuncs = map[string]struct{}{
+	"clamp_min": {},
+	"clamp_max": {},
+}
+
+var conditionAggregations = map[string]int{
+	"sum":      0,
+	"avg":      0,
+	"min":      0,
+	"max":      0,
+	"count":    0,
+	"stddev":   0,
+	"stdvar":   0,
+	"quantile": 1,
+}
+
+var conditionBinaryOps = map[string]struct{}{
+	"+": {},
+	"-": {},
+	"*": {},
+	"/": {},
+	"%": {},
+	"^": {},
+}
+
+type condTokenType int
+
+const (
+	condTokEOF condTokenType = iota
+	condTokIdent
+	condTokNumber
+	condTokString
+	condTokRange
+	condTokOp
+)
+
+type condToken struct {
+	typ condTokenType
+	val string
+	pos int
+}
+
+type condValueType int
+
+const (
+	condScalar condValueType = iota
+	condVector
+	condRangeVector
+)
+
+var condIdentRE = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*`)
+var condLabelNameRE = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+var condNumberRE = regexp.MustCompile(`^([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][-+]?[0-9]+)?`)
+
+func lexConditionExpr(expr string) ([]condToken, error) {
+	tokens := []condToken{}
+	ii := 0
+	for ii < len(expr) {
+		c := expr[ii]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			ii++
+		case c == '"' || c == '\'':
+			// quoted string, keep quotes for output
+			end := ii + 1
+			for ; end < len(expr); end++ {
+				if expr[end] == '\\' {
+					end++
+					continue
+				}
+				if expr[end] == c {
+					break
+				}
+			}
+			if end >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at position %d", ii)
+			}
+			tokens = append(tokens, condToken{condTokString, expr[ii : end+1], ii})
+			ii = end + 1
+		case c == '[':
+			end := strings.IndexByte(expr[ii:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated range at position %d", ii)
+			}
+			tokens = append(tokens, condToken{condTokRange, strings.TrimSpace(expr[ii+1 : ii+end]), ii})
+			ii += end + 1
+		case condNumberRE.MatchString(expr[ii:]):
+			num := condNumberRE.FindString(expr[ii:])
+			tokens = append(tokens, condToken{condTokNumber, num, ii})
+			ii += len(num)
+		case condIdentRE.MatchString(expr[ii:]):
+			id := condIdentRE.FindString(expr[ii:])
+			tokens = append(tokens, condToken{condTokIdent, id, ii})
+			ii += len(id)
+		default:
+			op := string(c)
+			if ii+1 < len(expr) {
+				switch expr[ii : ii+2] {
+				case "!=", "=~", "!~", "==", ">=", "<=":
+					op = expr[ii : ii+2]
+				}
+			}
+			switch op {
+			case "(", ")", "{", "}", ",", "=", "!=", "=~", "!~", "+", "-", "*", "/", "%", "^":
+			case "==", ">", "<", ">=", "<=":
+				return nil, fmt.Errorf("comparison operator %q not allowed, use the condition comparison and threshold instead", op)
+			default:
+				return nil, fmt.Errorf("unexpected character %q at position %d", op, ii)
+			}
+			tokens = append(tokens, condToken{condTokOp, op, ii})
+			ii += len(op)
+		}
+	}
+	tokens = append(tokens, condToken{condTokEOF, "", len(expr)})
+	return tokens, nil
+}
+
+// conditionParser validates a condition expression and renders
+// it with the window variable replaced.
+type conditionParser struct {
+	tokens []condToken
+	pos    int
+	window string
+}
+
+func (s *conditionParser) peek() condToken {
+	return s.tokens[s.pos]
+}
+
+func (s *conditionParser) next() condToken {
+	tok := s.tokens[s.pos]
+	if tok.typ != condTokEOF {
+		s.pos++
+	}
+	return tok
+}
+
+func (s *conditionParser) isOp(op string) bool {
+	tok := s.peek()
+	return tok.typ == condTokOp && tok.val == op
+}
+
+func (s *conditionParser) expectOp(op string) error {
+	tok := s.next()
+	if tok.typ != condTokOp || tok.val != op {
+		return s.unexpected(tok, op)
+	}
+	return nil
+}
+
+func (s *conditionParser) unexpected(tok condToken, expected string) error {
+	if tok.typ == condTokEOF {
+		return fmt.Errorf("unexpected end of expression, expected %s", expected)
+	}
+	return fmt.Errorf("unexpected %q at position %d, expected %s", tok.val, tok.pos, expected)
+}
+
+func (s *conditionParser) parseExpr() (string, condValueType, error) {
+	out, typ, err := s.parseUnary()
+	if err != nil {
+		return "", 0, err
+	}
+	for {
+		tok := s.peek()
+		if tok.typ == condTokIdent {
+			switch tok.val {
+			case "and", "or", "unless":
+				return "", 0, fmt.Errorf("set operator %q not allowed, use multiple conditions instead", tok.val)
+			case "on", "ignoring", "group_left", "group_right", "bool":
+				return "", 0, fmt.Errorf("vector matching modifier %q not allowed", tok.val)
+			case "offset":
+				return "", 0, errors.New("offset modifier not allowed")
+			}
+		}
+		if tok.typ != condTokOp {
+			break
+		}
+		if _, found := conditionBinaryOps[tok.val]; !found {
+			break
+		}
+		s.next()
+		if next := s.peek(); next.typ == condTokIdent && (next.val == "on" || next.val == "ignoring" || next.val == "bool") {
+			return "", 0, fmt.Errorf("vector matching modifier %q not allowed", next.val)
+		}
+		rhs, rtyp, err := s.parseUnary()
+		if err != nil {
+			return "", 0, err
+		}
+		if typ == condRangeVector || rtyp == condRangeVector {
+			return "", 0, fmt.Errorf("range vector not allowed as operand of %q, use a function like rate", tok.val)
+		}
+		out = out + " " + tok.val + " " + rhs
+		if rtyp == condVector {
+			typ = condVector
+		}
+	}
+	return out, typ, nil
+}
+
+func (s *conditionParser) parseUnary() (string, condValueType, error) {
+	if s.isOp("-") || s.isOp("+") {
+		op := s.next().val
+		out, typ, err := s.parseUnary()
+		if err != nil {
+			return "", 0, err
+		}
+		if typ == condRangeVector {
+			return "", 0, errors.New("range vector not allowed as operand of unary operator")
+		}
+		return op + out, typ, nil
+	}
+	return s.parsePrimary()
+}
+
+func (s *conditionParser) parsePrimary() (string, condValueType, error) {
+	tok := s.next()
+	switch tok.typ {
+	case condTokNumber:
+		return tok.val, condScalar, nil
+	case condTokOp:
+		if tok.val == "(" {
+			out, typ, err := s.parseExpr()
+			if err != nil {
+				return "", 0, err
+			}
+			if err := s.expectOp(")"); err != nil {
+				return "", 0, err
+			}
+			return "(" + out + ")", typ, nil
+		}
+		if tok.val == "{" {
+			return "", 0, fmt.Errorf("metric name required for selector at position %d", tok.pos)
+		}
+	case condTokIdent:
+		if _, found := conditionAggregations[tok.val]; found {
+			return s.parseAggregation(tok)
+		}
+		if s.isOp("(") {
+			return s.parseFunction(tok)
+		}
+		return s.parseSelector(tok)
+	}
+	return "", 0, s.unexpected(tok, "number, metric, function or aggregation")
+}
+
+// parseArgs parses a function or aggregation argument list, where the
+// leading args must be number literals and the last arg must be of the
+// given type.
+func (s *conditionParser) parseArgs(name string, numScalars int, lastType condValueType) ([]string, error) {
+	if err := s.expectOp("("); err != nil {
+		return nil, err
+	}
+	args := []string{}
+	for ii := 0; ii < numScalars; ii++ {
+		tok := s.next()
+		if tok.typ != condTokNumber {
+			return nil, fmt.Errorf("%s argument %d must be a number", name, ii+1)
+		}
+		args = append(args, tok.val)
+		if err := s.expectOp(","); err != nil {
+			return nil, err
+		}
+	}
+	out, typ, err := s.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if typ != lastType {
+		switch lastType {
+		case condRangeVector:
+			return nil, fmt.Errorf("%s requires a range vector argument like metric[$window]", name)
+		default:
+			return nil, fmt.Errorf("%s requires an instant vector argument", name)
+		}
+	}
+	args = append(args, out)
+	return args, nil
+}
+
+func (s *conditionParser) parseFunction(name condToken) (string, condValueType, error) {
+	var args []string
+	var err error
+	if numScalars, found := conditionRangeFuncs[name.val]; found {
+		args, err = s.parseArgs(name.val, numScalars, condRangeVector)
+	} else if numScalars, found := conditionVectorFuncs[name.val]; found {
+		args, err = s.parseArgs(name.val, numScalars, condVector)
+	} else if _, found := conditionClampFuncs[name.val]; found {
+		args, err = s.parseArgs(name.val, 0, condVector)
+		if err == nil {
+			if err = s.expectOp(","); err == nil {
+				tok := s.next()
+				if tok.typ != condTokNumber {
+					err = fmt.Errorf("%s argument 2 must be a number", name.val)
+				}
+				args = append(args, tok.val)
+			}
+		}
+	} else {
+		return "", 0, fmt.Errorf("function %q not allowed", name.val)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	if err := s.expectOp(")"); err != nil {
+		return "", 0, err
+	}
+	return name.val + "(" + strings.Join(args, ", ") + ")", condVector, nil
+}
+
+func (s *conditionParser) parseGrouping() (string, bool, error) {
+	tok := s.peek()
+	if tok.typ != condTokIdent || (tok.val != "by" && tok.val != "without") {
+		return "", false, nil
+	}
+	s.next()
+	if err := s.expectOp("("); err != nil {
+		return "", false, err
+	}
+	labels := []string{}
+	hasPod := false
+	for !s.isOp(")") {
+		if len(labels) > 0 {
+			if err := s.expectOp(","); err != nil {
+				return "", false, err
+			}
+		}
+		label := s.next()
+		if label.typ != condTokIdent || !condLabelNameRE.MatchString(label.val) {
+			return "", false, s.unexpected(label, "label name")
+		}
+		if label.val == PodLabel {
+			hasPod = true
+		}
+		labels = append(labels, label.val)
+	}
+	s.next()
+	if tok.val == "by" && !hasPod || tok.val == "without" && hasPod {
+		return "", false, fmt.Errorf("aggregation must keep the %s label", PodLabel)
+	}
+	return " " + tok.val + " (" + strings.Join(labels, ", ") + ")", true, nil
+}
+
+func (s *conditionParser) parseAggregation(name condToken) (string, condValueType, error) {
+	grouping, found, err := s.parseGrouping()
+	if err != nil {
+		return "", 0, err
+	}
+	args, err := s.parseArgs(name.val, conditionAggregations[name.val], condVector)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := s.expectOp(")"); err != nil {
+		return "", 0, err
+	}
+	if !found {
+		grouping, found, err = s.parseGrouping()
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	if !found {
+		return "", 0, fmt.Errorf("aggregation %s must specify by (%s)", name.val, PodLabel)
+	}
+	return name.val + "(" + strings.Join(args, ", ") + ")" + grouping, condVector, nil
+}
+
+func (s *conditionParser) parseSelector(name condToken) (string, condValueType, error) {
+	switch name.val {
+	case "by", "without", "on", "ignoring", "group_left", "group_right", "bool", "offset", "and", "or", "unless":
+		return "", 0, s.unexpected(name, "metric name")
+	}
+	out := name.val
+	if s.isOp("{") {
+		s.next()
+		matchers := []string{}
+		for !s.isOp("}") {
+			if len(matchers) > 0 {
+				if err := s.expectOp(","); err != nil {
+					return "", 0, err
+				}
+				// allow trailing comma
+				if s.isOp("}") {
+					break
+				}
+			}
+			matcher, err := s.parseMatcher()
+			if err != nil {
+				return "", 0, err
+			}
+			matchers = append(matchers, matcher)
+		}
+		s.next()
+		out += "{" + strings.Join(matchers, ",") + "}"
+	}
+	if s.peek().typ != condTokRange {
+		return out, condVector, nil
+	}
+	rng := s.next()
+	dur := rng.val
+	if dur == ConditionWindowVar {
+		if s.window == "" {
+			return "", 0, fmt.Errorf("window must be specified to use %s", ConditionWindowVar)
+		}
+		dur = s.window
+	} else {
+		d, err := model.ParseDuration(dur)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid range %q for %s, %s", rng.val, name.val, err)
+		}
+		if d <= 0 {
+			return "", 0, fmt.Errorf("invalid range %q for %s, must be positive", rng.val, name.val)
+		}
+	}
+	return out + "[" + dur + "]", condRangeVector, nil
+}
+
+func (s *conditionParser) parseMatcher() (string, error) {
+	label := s.next()
+	if label.typ != condTokIdent || !condLabelNameRE.MatchString(label.val) {
+		return "", s.unexpected(label, "label name")
+	}
+	op := s.next()
+	if op.typ != condTokOp || (op.val != "=" && op.val != "!=" && op.val != "=~" && op.val != "!~") {
+		return "", s.unexpected(op, "label match operator")
+	}
+	val := s.next()
+	if val.typ != condTokString {
+		return "", s.unexpected(val, "quoted label value")
+	}
+	// rules are rendered as plain yaml scalars
+	if strings.Contains(val.val, " #") || strings.Contains(val.val, ": ") || strings.ContainsAny(val.val, "\r\n\t") {
+		return "", fmt.Errorf("label value %s for %s cannot contain \" #\", \": \" or control characters", val.val, label.val)
+	}
+	if op.val == "=~" || op.val == "!~" {
+		str, err := strconv.Unquote(val.val)
+		if err != nil && val.val[0] == '\'' {
+			str, err = strconv.Unquote(`"` + strings.ReplaceAll(val.val[1:len(val.val)-1], `"`, `\"`) + `"`)
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid label value %s, %s", val.val, err)
+		}
+		if _, err := regexp.Compile("^(?:" + str + ")$"); err != nil {
+			return "", fmt.Errorf("invalid regex for label %s, %s", label.val, err)
+		}
+	}
+	return label.val + op.val + val.val, nil
+}
+
+// parseConditionExpr validates the condition expression and returns it
+// with the window variable replaced.
+func parseConditionExpr(cond *edgeproto.AlertPolicyCondition) (string, error) {
+	if strings.TrimSpace(cond.Expr) == "" {
+		return "", errors.New("expression must be specified")
+	}
+	if len(cond.Expr) > MaxConditionExprLen {
+		return "", fmt.Errorf("expression exceeds max length of %d", MaxConditionExprLen)
+	}
+	tokens, err := lexConditionExpr(cond.Expr)
+	if err != nil {
+		return "", err
+	}
+	parser := conditionParser{
+		tokens: tokens,
+	}
+	if cond.Window != 0 {
+		parser.window = model.Duration(cond.Window.TimeDuration()).String()
+	}
+	out, typ, err := parser.parseExpr()
+	if err != nil {
+		return "", err
+	}
+	if tok := parser.peek(); tok.typ != condTokEOF {
+		return "", parser.unexpected(tok, "operator")
+	}
+	switch typ {
+	case condScalar:
+		return "", errors.New("expression must include a metric")
+	case condRangeVector:
+		return "", errors.New("expression must not be a range vector, use a function like rate")
+	}
+	return out, nil
+}
+
+// ValidateAlertPolicyConditions checks that the condition expressions
+// can be rendered into alert rules.
+func ValidateAlertPolicyConditions(conditions []edgeproto.AlertPolicyCondition) error {
+	for ii := range conditions {
+		if _, err := parseConditionExpr(&conditions[ii]); err != nil {
+			return fmt.Errorf("Invalid condition %d expression, %s", ii+1, err)
+		}
+	}
+	return nil
+}
+
+// getConditionPromQuery gets the prometheus query for the condition,
+// restricted to the pods of the AppInst specified by the label filter.
+// The comparison is applied to the max (or min) value for each pod,
+// so the condition is met if any of the series for the pod crosses
+// the threshold.
+func getConditionPromQuery(labelFilter string, cond *edgeproto.AlertPolicyCondition) (string, error) {
+	expr, err := parseConditionExpr(cond)
+	if err != nil {
+		return "", err
+	}
+	agg, op := "max", ">"
+	if cond.Comparison == edgeproto.AlertComparison_ALERT_COMPARISON_BELOW {
+		agg, op = "min", "<"
+	}
+	podQuery := agg + "(" + expr + ")by(" + PodLabel + ")"
+	query := promutils.GetPromQueryWithK8sLabels(labelFilter, podQuery)
+	return query + " " + op + " " + formatThreshold(cond.Threshold), nil
+}
+
+func formatThreshold(val float64) string {
+	return strconv.FormatFloat(val, 'g', -1, 64)
+}
+
+func getConditionDescription(cond *edgeproto.AlertPolicyCondition) string {
+	op := ">"
+	if cond.Comparison == edgeproto.AlertComparison_ALERT_COMPARISON_BELOW {
+		op = "<"
+	}
+	desc := cond.Expr + " " + op + " " + formatThreshold(cond.Threshold)
+	if cond.Window != 0 && strings.Contains(cond.Expr, ConditionWindowVar) {
+		desc += " over " + cond.Window.TimeDuration().String()
+	}
+	return desc
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerts
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	yaml "github.com/mobiledgex/yaml/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConditionExpr(t *testing.T) {
+	window := edgeproto.Duration(5 * time.Minute)
+	tests := []struct {
+		expr   string
+		window edgeproto.Duration
+		exp    string
+		expErr string
+	}{{
+		expr: `http_requests_in_flight`,
+		exp:  `http_requests_in_flight`,
+	}, {
+		expr:   `sum(rate(http_requests_total{code=~"5.."}[$window])) by (pod) / sum(rate(http_requests_total[$window])) by (pod) * 100`,
+		window: window,
+		exp:    `sum(rate(http_requests_total{code=~"5.."}[5m])) by (pod) / sum(rate(http_requests_total[5m])) by (pod) * 100`,
+	}, {
+		expr: `sum by (pod, code) (increase(http_requests_total{code!="200",path='/api'}[1h]))`,
+		exp:  `sum(increase(http_requests_total{code!="200",path='/api'}[1h])) by (pod, code)`,
+	}, {
+		expr: `histogram_quantile(0.99, sum(rate(request_duration_seconds_bucket[10m])) by (pod, le))`,
+		exp:  `histogram_quantile(0.99, sum(rate(request_duration_seconds_bucket[10m])) by (pod, le))`,
+	}, {
+		expr: `clamp_max(avg_over_time(queue_depth[30s]), 100) - -1`,
+		exp:  `clamp_max(avg_over_time(queue_depth[30s]), 100) - -1`,
+	}, {
+		expr: `max without (instance) (up)`,
+		exp:  `max(up) without (instance)`,
+	}, {
+		expr:   `rate(http_requests_total[$window])`,
+		expErr: "window must be specified to use $window",
+	}, {
+		expr:   `sum(rate(http_requests_total[5m]))`,
+		expErr: "aggregation sum must specify by (pod)",
+	}, {
+		expr:   `sum(rate(http_requests_total[5m])) by (code)`,
+		expErr: "aggregation must keep the pod label",
+	}, {
+		expr:   `sum without (pod) (up)`,
+		expErr: "aggregation must keep the pod label",
+	}, {
+		expr:   `up / on(pod) up`,
+		expErr: `vector matching modifier "on" not allowed`,
+	}, {
+		expr:   `up and down`,
+		expErr: `set operator "and" not allowed`,
+	}, {
+		expr:   `up > 1`,
+		expErr: `comparison operator ">" not allowed`,
+	}, {
+		expr:   `label_replace(up, "pod", "x", "", "")`,
+		expErr: `function "label_replace" not allowed`,
+	}, {
+		expr:   `rate(http_requests_total)`,
+		expErr: "rate requires a range vector argument",
+	}, {
+		expr:   `http_requests_total[5m]`,
+		expErr: "expression must not be a range vector",
+	}, {
+		expr:   `http_requests_total[5x]`,
+		expErr: `invalid range "5x"`,
+	}, {
+		expr:   `1 + 2`,
+		expErr: "expression must include a metric",
+	}, {
+		expr:   `{__name__="up"}`,
+		expErr: "metric name required",
+	}, {
+		expr:   `up{code=~"5(.."}`,
+		expErr: "invalid regex for label code",
+	}, {
+		expr:   `up{path="/a #b"}`,
+		expErr: "cannot contain",
+	}, {
+		expr:   `sum(up`,
+		expErr: "unexpected end of expression",
+	}, {
+		expr:   `up up`,
+		expErr: `unexpected "up" at position 3, expected operator`,
+	}, {
+		expr:   `up{code="500}`,
+		expErr: "unterminated string",
+	}, {
+		expr:   "",
+		expErr: "expression must be specified",
+	}, {
+		expr:   strings.Repeat("a", MaxConditionExprLen+1),
+		expErr: "expression exceeds max length",
+	}}
+	for _, test := range tests {
+		cond := edgeproto.AlertPolicyCondition{
+			Expr:   test.expr,
+			Window: test.window,
+		}
+		out, err := parseConditionExpr(&cond)
+		if test.expErr != "" {
+			require.NotNil(t, err, test.expr)
+			require.Contains(t, err.Error(), test.expErr, test.expr)
+		} else {
+			require.Nil(t, err, test.expr)
+			require.Equal(t, test.exp, out, test.expr)
+		}
+	}
+}
+
+func TestConditionAlertRules(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfo)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	appInst := testutil.AppInstData()[0]
+	alert := testutil.AlertPolicyData()[3]
+	alert.Description = ""
+	alert.MemUtilizationLimit = 0
+	alert.Conditions = []edgeproto.AlertPolicyCondition{{
+		Expr:      `sum(rate(http_requests_total{code=~"5.."}[$window]))by(pod)`,
+		Threshold: 0.5,
+		Window:    edgeproto.Duration(5 * time.Minute),
+	}, {
+		Expr:       `sum(rate(http_requests_total[$window]))by(pod)`,
+		Comparison: edgeproto.AlertComparison_ALERT_COMPARISON_BELOW,
+		Threshold:  2,
+		Window:     edgeproto.Duration(time.Hour),
+	}}
+	args, err := getAlertRulesArgs(ctx, &appInst, []edgeproto.AlertPolicy{alert})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(args.ClusterAlerts))
+	rule := args.ClusterAlerts[0].Rule
+	exprs := strings.Split(rule.Expr, " and ")
+	require.Equal(t, 3, len(exprs))
+	wrapper := `max(kube_pod_labels{label_mexAppName="` + util.DNSSanitize(appInst.AppKey.Name) + `",label_mexAppVersion="` + util.DNSSanitize(appInst.AppKey.Version) + `"})by(label_mexAppName,label_mexAppVersion,label_mexAppInstName,label_mexAppInstOrg,pod)*on(pod)group_right(label_mexAppName,label_mexAppVersion,label_mexAppInstName,label_mexAppInstOrg)`
+	require.Equal(t, wrapper+`(max(sum(rate(http_requests_total{code=~"5.."}[5m])) by (pod))by(pod)) > 0.5`, exprs[1])
+	require.Equal(t, wrapper+`(min(sum(rate(http_requests_total[1h])) by (pod))by(pod)) < 2`, exprs[2])
+	require.Equal(t, `CPU Utilization > 80% and sum(rate(http_requests_total{code=~"5.."}[$window]))by(pod) > 0.5 over 5m0s and sum(rate(http_requests_total[$window]))by(pod) < 2 over 1h0m0s`, getAlertPolicyDescription(&alert))
+
+	// quotes in the description are escaped in the rules yaml
+	file, err := GetAlertRules(ctx, &appInst, []edgeproto.AlertPolicy{alert})
+	require.Nil(t, err)
+	require.Contains(t, file, `description: "CPU Utilization > 80% and sum(rate(http_requests_total{code=~\"5..\"}[$window]))by(pod) > 0.5`)
+	out := map[string]interface{}{}
+	require.Nil(t, yaml.Unmarshal([]byte(file), &out))
+
+	// invalid conditions fail to render
+	alert.Conditions[0].Window = 0
+	_, err = GetAlertRules(ctx, &appInst, []edgeproto.AlertPolicy{alert})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "condition 1, window must be specified")
+}
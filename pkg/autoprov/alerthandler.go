@@ -87,6 +87,17 @@ func autoUndeploy(ctx context.Context, name string, alert *edgeproto.Alert) erro
 	inst.ClusterKey.Name = alert.Labels[edgeproto.ClusterKeyTagName]
 	inst.ClusterKey.Organization = alert.Labels[edgeproto.ClusterKeyTagOrganization]
 
+	cur := edgeproto.AppInst{}
+	if cacheData.appInstCache.Get(&inst.Key, &cur) {
+		inst.ZoneKey = cur.ZoneKey
+		// defer the undeploy until the min instance lifetime expires,
+		// the Controller would otherwise just reject it.
+		if until := flapTracker.minLifetimeEnd(&cur); timeNow().Before(until) {
+			flapTracker.deferUndeploy(ctx, alert.GetKeyVal(), until)
+			return nil
+		}
+	}
+
 	// we're already in a separate go thread so don't need another one here
 	goAppInstApi(ctx, &inst, cloudcommon.Delete, cloudcommon.AutoProvReasonDemand, "")
 	return nil
@@ -48,6 +48,7 @@ var vaultConfig *vault.Config
 var autoProvAggr *AutoProvAggr
 var minMaxChecker *MinMaxChecker
 var retryTracker *RetryTracker
+var flapTracker *FlapTracker
 var settings edgeproto.Settings
 var nodeMgr node.NodeMgr
 
@@ -92,11 +93,14 @@ func start() error {
 
 	cacheData.init(&nodeMgr)
 	retryTracker = newRetryTracker()
+	flapTracker = newFlapTracker(&cacheData)
 	autoProvAggr = NewAutoProvAggr(settings.AutoDeployIntervalSec, settings.AutoDeployOffsetSec, &cacheData)
 	minMaxChecker = newMinMaxChecker(&cacheData)
 	cacheData.alertCache.AddUpdatedCb(alertChanged)
+	initDebug(&nodeMgr)
 
 	autoProvAggr.Start()
+	minMaxChecker.Start()
 
 	addrs := strings.Split(*notifyAddrs, ",")
 	notifyClient = notify.NewClient(nodeMgr.Name(), addrs, dialOpts)
@@ -112,6 +116,9 @@ func stop() {
 	if autoProvAggr != nil {
 		autoProvAggr.Stop()
 	}
+	if minMaxChecker != nil {
+		minMaxChecker.Stop()
+	}
 	if notifyClient != nil {
 		notifyClient.Stop()
 	}
@@ -146,6 +146,8 @@ func (s *AutoProvAggr) Run() {
 				log.SpanLog(ctx, log.DebugLevelMetrics, "runIter failed", "err", err)
 			}
 			retryTracker.doRetry(ctx, minMaxChecker)
+			flapTracker.checkExpired(ctx)
+			flapTracker.runDeferredUndeploys(ctx)
 			span.Finish()
 		case <-s.stop:
 			done = true
@@ -293,6 +295,9 @@ func (s *AutoProvAggr) runIter(ctx context.Context, init bool) error {
 
 func (s *AutoProvAggr) deploy(ctx context.Context, app *edgeproto.App, zoneKey *edgeproto.ZoneKey) {
 	log.SpanLog(ctx, log.DebugLevelApi, "auto-prov deploy App", "app", app.Key, "zone", *zoneKey)
+	if !flapTracker.canDeploy(ctx, &app.Key, zoneKey) {
+		return
+	}
 
 	inst := edgeproto.AppInst{}
 	inst.Key = cloudcommon.GetAutoProvAppInstKey(&app.Key, zoneKey)
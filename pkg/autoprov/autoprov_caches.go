@@ -31,6 +31,7 @@ type CacheData struct {
 	frClusterInsts      edgeproto.FreeReservableClusterInstCache
 	alertCache          edgeproto.AlertCache
 	autoProvInfoCache   edgeproto.AutoProvInfoCache
+	autoProvAlertCache  edgeproto.AlertCache
 }
 
 func (s *CacheData) init(nodeMgr *node.NodeMgr) {
@@ -49,6 +50,7 @@ func (s *CacheData) init(nodeMgr *node.NodeMgr) {
 	s.frClusterInsts.Init()
 	edgeproto.InitAlertCache(&s.alertCache)
 	edgeproto.InitAutoProvInfoCache(&s.autoProvInfoCache)
+	edgeproto.InitAlertCache(&s.autoProvAlertCache)
 }
 
 func (s *CacheData) initNotifyClient(client *notify.Client) {
@@ -62,4 +64,5 @@ func (s *CacheData) initNotifyClient(client *notify.Client) {
 	notifyClient.RegisterRecv(notify.NewClusterInstRecv(&s.frClusterInsts))
 	notifyClient.RegisterRecvAlertCache(&s.alertCache)
 	notifyClient.RegisterSendAutoProvInfoCache(&s.autoProvInfoCache)
+	notifyClient.RegisterSendAlertCache(&s.autoProvAlertCache)
 }
@@ -0,0 +1,49 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprov
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
+)
+
+const (
+	DumpAutoProvStateCmd = "dump-autoprov-state"
+)
+
+type autoProvStateDump struct {
+	RetryFailures []string    `json:"retryfailures"`
+	Flapping      interface{} `json:"flapping"`
+}
+
+func initDebug(nodeMgr *node.NodeMgr) {
+	nodeMgr.Debug.AddDebugFunc(DumpAutoProvStateCmd, dumpAutoProvState)
+}
+
+func dumpAutoProvState(ctx context.Context, req *edgeproto.DebugRequest) string {
+	dump := autoProvStateDump{
+		RetryFailures: retryTracker.Dumpable(),
+		Flapping:      flapTracker.Dumpable(),
+	}
+	out, err := json.Marshal(&dump)
+	if err != nil {
+		return fmt.Sprintf("failed to marshal autoprov state, %v", err)
+	}
+	return string(out)
+}
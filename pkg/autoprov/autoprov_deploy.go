@@ -57,9 +57,12 @@ func goAppInstApi(ctx context.Context, inst *edgeproto.AppInst, action cloudcomm
 		// was successful.
 		nodeMgr.TimedEvent(ctx, eventName, inst.Key.Organization, node.EventType, inst.GetTags(), err, eventStart, time.Now(), "reason", reason, "autoprovpolicy", policyName)
 	}
-	if reason == cloudcommon.AutoProvReasonMinMax {
+	if reason == cloudcommon.AutoProvReasonMinMax || reason == cloudcommon.AutoProvReasonSchedule {
 		retryTracker.registerDeployResult(ctx, inst, err)
 	}
+	if reason == cloudcommon.AutoProvReasonDemand && action == cloudcommon.Delete && err == nil {
+		flapTracker.registerUndeploy(ctx, inst)
+	}
 	return err
 }
 
@@ -0,0 +1,335 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprov
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+)
+
+// FlapTracker adds hysteresis to demand-driven auto-provisioning,
+// to avoid repeatedly deploying and undeploying the same App when
+// client counts hover around the policy thresholds. It enforces a
+// cooldown before redeploying to a zone that was undeployed from,
+// and suspends demand-driven auto-provisioning of an App that is
+// undeployed too many times within the policy's flap window.
+// Suspensions only block deploys; undeploys are still allowed so
+// that unused instances are not left running. Demand undeploys of
+// instances younger than the policy's minimum instance lifetime are
+// deferred until the lifetime expires.
+// All state is kept in memory only, so it is lost if auto-prov
+// restarts, which resets any cooldowns, suspensions, and deferred
+// undeploys.
+type FlapTracker struct {
+	caches     *CacheData
+	alertCache *edgeproto.AlertCache
+	undeploys  map[edgeproto.AppZoneKeyPair]time.Time
+	apps       map[edgeproto.AppKey]*appFlapState
+	deferred   map[edgeproto.AlertKey]time.Time
+	mux        sync.Mutex
+}
+
+type appFlapState struct {
+	Policy         string      `json:"policy"`
+	Undeploys      []time.Time `json:"undeploys,omitempty"`
+	SuspendedUntil *time.Time  `json:"suspendeduntil,omitempty"`
+}
+
+func newFlapTracker(caches *CacheData) *FlapTracker {
+	s := FlapTracker{}
+	s.caches = caches
+	s.alertCache = &caches.autoProvAlertCache
+	s.undeploys = make(map[edgeproto.AppZoneKeyPair]time.Time)
+	s.apps = make(map[edgeproto.AppKey]*appFlapState)
+	s.deferred = make(map[edgeproto.AlertKey]time.Time)
+	return &s
+}
+
+// getZonePolicy gets the App's policy that contains the zone.
+func (s *FlapTracker) getZonePolicy(appKey *edgeproto.AppKey, zoneKey *edgeproto.ZoneKey) *edgeproto.AutoProvPolicy {
+	app := edgeproto.App{}
+	if !s.caches.appCache.Get(appKey, &app) {
+		return nil
+	}
+	names := []string{}
+	for pname := range app.GetAutoProvPolicies() {
+		names = append(names, pname)
+	}
+	sort.Strings(names)
+	for _, pname := range names {
+		policy := edgeproto.AutoProvPolicy{}
+		policyKey := edgeproto.PolicyKey{
+			Name:         pname,
+			Organization: appKey.Organization,
+		}
+		if !s.caches.autoProvPolicyCache.Get(&policyKey, &policy) {
+			continue
+		}
+		if policy.HasZone(zoneKey) {
+			return &policy
+		}
+	}
+	return nil
+}
+
+// Caller must hold FlapTracker.mux
+func (s *FlapTracker) isSuspendedLocked(appKey *edgeproto.AppKey, now time.Time) bool {
+	st, found := s.apps[*appKey]
+	return found && st.SuspendedUntil != nil && now.Before(*st.SuspendedUntil)
+}
+
+// isSuspended checks if demand-driven auto-provisioning of the App
+// has been suspended due to flapping.
+func (s *FlapTracker) isSuspended(appKey *edgeproto.AppKey) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.isSuspendedLocked(appKey, timeNow())
+}
+
+// inCooldown checks if the App was undeployed from the zone
+// within the policy's redeploy cooldown.
+func (s *FlapTracker) inCooldown(appKey *edgeproto.AppKey, zoneKey *edgeproto.ZoneKey) bool {
+	policy := s.getZonePolicy(appKey, zoneKey)
+	if policy == nil || policy.RedeployCooldown <= 0 {
+		return false
+	}
+	lookup := edgeproto.AppZoneKeyPair{
+		AppKey:  *appKey,
+		ZoneKey: *zoneKey,
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	last, found := s.undeploys[lookup]
+	return found && timeNow().Sub(last) < policy.RedeployCooldown.TimeDuration()
+}
+
+// canDeploy checks if a demand-driven deployment is allowed.
+func (s *FlapTracker) canDeploy(ctx context.Context, appKey *edgeproto.AppKey, zoneKey *edgeproto.ZoneKey) bool {
+	if s.isSuspended(appKey) {
+		log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov suspended for app due to flapping", "app", *appKey)
+		return false
+	}
+	if s.inCooldown(appKey, zoneKey) {
+		log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov zone in redeploy cooldown", "app", *appKey, "zone", *zoneKey)
+		return false
+	}
+	return true
+}
+
+// minLifetimeEnd returns the time when the AppInst's min instance
+// lifetime expires, or the zero time if its policy has none.
+func (s *FlapTracker) minLifetimeEnd(inst *edgeproto.AppInst) time.Time {
+	policy := s.getZonePolicy(&inst.AppKey, &inst.ZoneKey)
+	if policy == nil || policy.MinInstanceLifetime <= 0 {
+		return time.Time{}
+	}
+	created := dme.TimestampToTime(inst.CreatedAt)
+	return created.Add(policy.MinInstanceLifetime.TimeDuration())
+}
+
+// deferUndeploy records an undeploy alert to be handled again
+// once the min instance lifetime expires.
+func (s *FlapTracker) deferUndeploy(ctx context.Context, alertKey edgeproto.AlertKey, until time.Time) {
+	log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov deferring undeploy for min instance lifetime", "alert", alertKey, "until", until)
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.deferred[alertKey] = until
+}
+
+// runDeferredUndeploys handles deferred undeploy alerts whose
+// min instance lifetime has expired, if the alert is still firing.
+func (s *FlapTracker) runDeferredUndeploys(ctx context.Context) {
+	now := timeNow()
+	due := []edgeproto.AlertKey{}
+
+	s.mux.Lock()
+	for alertKey, until := range s.deferred {
+		if now.Before(until) {
+			continue
+		}
+		due = append(due, alertKey)
+		delete(s.deferred, alertKey)
+	}
+	s.mux.Unlock()
+
+	for _, alertKey := range due {
+		alert := edgeproto.Alert{}
+		if !s.caches.alertCache.Get(&alertKey, &alert) {
+			log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov deferred undeploy alert no longer present", "alert", alertKey)
+			continue
+		}
+		err := autoUndeploy(ctx, alert.Labels["alertname"], &alert)
+		log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov ran deferred undeploy", "alert", alertKey, "err", err)
+	}
+}
+
+// registerUndeploy records a demand-driven undeploy. If the number
+// of undeploys within the policy's flap window exceeds the max,
+// demand-driven auto-provisioning of the App is suspended for the
+// flap window and an alert is raised.
+func (s *FlapTracker) registerUndeploy(ctx context.Context, inst *edgeproto.AppInst) {
+	policy := s.getZonePolicy(&inst.AppKey, &inst.ZoneKey)
+	lookup := edgeproto.AppZoneKeyPair{
+		AppKey:  inst.AppKey,
+		ZoneKey: inst.ZoneKey,
+	}
+	now := timeNow()
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.undeploys[lookup] = now
+	if policy == nil || policy.MaxFlaps == 0 || policy.FlapWindow <= 0 {
+		return
+	}
+	st, found := s.apps[inst.AppKey]
+	if !found {
+		st = &appFlapState{}
+		s.apps[inst.AppKey] = st
+	}
+	st.Policy = policy.Key.Name
+	st.Undeploys = pruneFlaps(st.Undeploys, now.Add(-policy.FlapWindow.TimeDuration()))
+	st.Undeploys = append(st.Undeploys, now)
+	log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov register undeploy", "app", inst.AppKey, "zone", inst.ZoneKey, "policy", policy.Key.Name, "flaps", len(st.Undeploys), "maxFlaps", policy.MaxFlaps)
+
+	if len(st.Undeploys) <= int(policy.MaxFlaps) || s.isSuspendedLocked(&inst.AppKey, now) {
+		return
+	}
+	until := now.Add(policy.FlapWindow.TimeDuration())
+	st.SuspendedUntil = &until
+	log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov suspending app due to flapping", "app", inst.AppKey, "policy", policy.Key.Name, "until", until)
+	alert := getFlapAlert(&inst.AppKey, policy.Key.Name, now)
+	s.alertCache.Update(ctx, alert, 0)
+}
+
+// checkExpired resumes Apps whose suspensions have expired, and
+// cleans up stale tracking data.
+func (s *FlapTracker) checkExpired(ctx context.Context) {
+	now := timeNow()
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for appKey, st := range s.apps {
+		if st.SuspendedUntil != nil {
+			if now.Before(*st.SuspendedUntil) {
+				continue
+			}
+			log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov resuming app after flapping suspension", "app", appKey, "policy", st.Policy)
+			alert := getFlapAlert(&appKey, st.Policy, now)
+			s.alertCache.Delete(ctx, alert, 0)
+			delete(s.apps, appKey)
+			continue
+		}
+		policyKey := edgeproto.PolicyKey{
+			Name:         st.Policy,
+			Organization: appKey.Organization,
+		}
+		policy := edgeproto.AutoProvPolicy{}
+		if s.caches.autoProvPolicyCache.Get(&policyKey, &policy) {
+			st.Undeploys = pruneFlaps(st.Undeploys, now.Add(-policy.FlapWindow.TimeDuration()))
+		} else {
+			st.Undeploys = nil
+		}
+		if len(st.Undeploys) == 0 {
+			delete(s.apps, appKey)
+		}
+	}
+	for lookup, last := range s.undeploys {
+		policy := s.getZonePolicy(&lookup.AppKey, &lookup.ZoneKey)
+		if policy == nil || now.Sub(last) >= policy.RedeployCooldown.TimeDuration() {
+			delete(s.undeploys, lookup)
+		}
+	}
+}
+
+// sortCooldownLast moves sites that are in cooldown to the end
+// of the list, so they are only used if needed to meet a min.
+func (s *FlapTracker) sortCooldownLast(appKey *edgeproto.AppKey, sites []*potentialCreateSite) []*potentialCreateSite {
+	sorted := make([]*potentialCreateSite, 0, len(sites))
+	cooling := []*potentialCreateSite{}
+	for _, site := range sites {
+		if s.inCooldown(appKey, &site.zoneKey) {
+			cooling = append(cooling, site)
+		} else {
+			sorted = append(sorted, site)
+		}
+	}
+	return append(sorted, cooling...)
+}
+
+func pruneFlaps(times []time.Time, cutoff time.Time) []time.Time {
+	pruned := []time.Time{}
+	for _, t := range times {
+		if t.After(cutoff) {
+			pruned = append(pruned, t)
+		}
+	}
+	return pruned
+}
+
+func getFlapAlert(appKey *edgeproto.AppKey, policyName string, activeAt time.Time) *edgeproto.Alert {
+	alert := edgeproto.Alert{}
+	alert.State = "firing"
+	alert.ActiveAt = dme.TimeToTimestamp(activeAt)
+	alert.Labels = make(map[string]string)
+	alert.Labels["alertname"] = cloudcommon.AlertAutoProvFlapping
+	alert.Labels[cloudcommon.AlertScopeTypeTag] = cloudcommon.AlertScopeApp
+	alert.Labels[cloudcommon.AlertSeverityLabel] = cloudcommon.AlertSeverityWarn
+	alert.Labels[edgeproto.AppKeyTagName] = appKey.Name
+	alert.Labels[edgeproto.AppKeyTagVersion] = appKey.Version
+	alert.Labels[edgeproto.AppKeyTagOrganization] = appKey.Organization
+	alert.Labels[cloudcommon.AutoProvPolicyName] = policyName
+	alert.Annotations = make(map[string]string)
+	alert.Annotations[cloudcommon.AlertAnnotationTitle] = cloudcommon.AlertAutoProvFlapping
+	alert.Annotations[cloudcommon.AlertAnnotationDescription] = cloudcommon.AlertAutoProvFlappingDescription
+	return &alert
+}
+
+type flapTrackerDump struct {
+	Apps      map[string]*appFlapState `json:"apps"`
+	Cooldowns map[string]time.Time     `json:"cooldowns"`
+	Deferred  map[string]time.Time     `json:"deferred"`
+}
+
+// Dumpable returns the tracker state for debugging.
+func (s *FlapTracker) Dumpable() interface{} {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	dump := flapTrackerDump{
+		Apps:      make(map[string]*appFlapState),
+		Cooldowns: make(map[string]time.Time),
+		Deferred:  make(map[string]time.Time),
+	}
+	for appKey, st := range s.apps {
+		cp := *st
+		dump.Apps[appKey.GetKeyString()] = &cp
+	}
+	for lookup, last := range s.undeploys {
+		dump.Cooldowns[lookup.AppKey.GetKeyString()+" "+lookup.ZoneKey.GetKeyString()] = last
+	}
+	for alertKey, until := range s.deferred {
+		dump.Deferred[alertKey.GetKeyString()] = until
+	}
+	return &dump
+}
@@ -0,0 +1,214 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprov
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestFlapTracker(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify | log.DebugLevelApi | log.DebugLevelMetrics)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	cacheData.init(nil)
+	retryTracker = newRetryTracker()
+	flapTracker = newFlapTracker(&cacheData)
+
+	start := time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)
+	now := start
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	pt := makePolicyTest("flappolicy", 2, &cacheData)
+	pt.policy.RedeployCooldown = edgeproto.Duration(10 * time.Minute)
+	pt.policy.MaxFlaps = 2
+	pt.policy.FlapWindow = edgeproto.Duration(time.Hour)
+	pt.updatePolicy(ctx)
+
+	app := edgeproto.App{}
+	app.Key.Name = "flapapp"
+	app.AutoProvPolicies = []string{pt.policy.Key.Name}
+	cacheData.appCache.Update(ctx, &app, 0)
+
+	inst := edgeproto.AppInst{}
+	inst.Key.Name = "flapinst"
+	inst.AppKey = app.Key
+	inst.ZoneKey = pt.zones[0].Key
+
+	hasAlert := func() bool {
+		alert := getFlapAlert(&app.Key, pt.policy.Key.Name, now)
+		return cacheData.autoProvAlertCache.HasKey(alert.GetKey())
+	}
+
+	// undeploy puts zone in cooldown, other zones are unaffected
+	require.True(t, flapTracker.canDeploy(ctx, &app.Key, &pt.zones[0].Key))
+	flapTracker.registerUndeploy(ctx, &inst)
+	require.False(t, flapTracker.canDeploy(ctx, &app.Key, &pt.zones[0].Key))
+	require.True(t, flapTracker.canDeploy(ctx, &app.Key, &pt.zones[1].Key))
+	require.False(t, flapTracker.isSuspended(&app.Key))
+
+	// cooldown sites are sorted last
+	sites := []*potentialCreateSite{
+		{zoneKey: pt.zones[0].Key},
+		{zoneKey: pt.zones[1].Key},
+	}
+	sites = flapTracker.sortCooldownLast(&app.Key, sites)
+	require.Equal(t, pt.zones[1].Key, sites[0].zoneKey)
+	require.Equal(t, pt.zones[0].Key, sites[1].zoneKey)
+
+	// cooldown expires
+	now = start.Add(11 * time.Minute)
+	require.True(t, flapTracker.canDeploy(ctx, &app.Key, &pt.zones[0].Key))
+
+	// second undeploy is within max flaps
+	flapTracker.registerUndeploy(ctx, &inst)
+	require.False(t, flapTracker.isSuspended(&app.Key))
+	require.False(t, hasAlert())
+
+	// third undeploy within window exceeds max flaps
+	now = start.Add(30 * time.Minute)
+	inst.ZoneKey = pt.zones[1].Key
+	flapTracker.registerUndeploy(ctx, &inst)
+	require.True(t, flapTracker.isSuspended(&app.Key))
+	require.True(t, hasAlert())
+	now = start.Add(50 * time.Minute)
+	require.False(t, flapTracker.canDeploy(ctx, &app.Key, &pt.zones[0].Key))
+
+	// debug dump shows state
+	dump := dumpAutoProvState(ctx, &edgeproto.DebugRequest{})
+	state := autoProvStateDump{}
+	err := json.Unmarshal([]byte(dump), &state)
+	require.Nil(t, err)
+	flapping, ok := state.Flapping.(map[string]interface{})
+	require.True(t, ok)
+	apps, ok := flapping["apps"].(map[string]interface{})
+	require.True(t, ok)
+	require.Contains(t, apps, app.Key.GetKeyString())
+
+	// suspension still active, nothing expires
+	flapTracker.checkExpired(ctx)
+	require.True(t, flapTracker.isSuspended(&app.Key))
+	require.True(t, hasAlert())
+
+	// suspension expires, alert is cleared and state is cleaned up
+	now = start.Add(91 * time.Minute)
+	require.False(t, flapTracker.isSuspended(&app.Key))
+	flapTracker.checkExpired(ctx)
+	require.False(t, hasAlert())
+	require.Equal(t, 0, len(flapTracker.apps))
+	require.Equal(t, 0, len(flapTracker.undeploys))
+	require.True(t, flapTracker.canDeploy(ctx, &app.Key, &pt.zones[1].Key))
+
+	// alert name matches
+	alert := getFlapAlert(&app.Key, pt.policy.Key.Name, now)
+	require.Equal(t, cloudcommon.AlertAutoProvFlapping, alert.Labels["alertname"])
+}
+
+func TestFlapDeferredUndeploy(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify | log.DebugLevelApi | log.DebugLevelMetrics)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	cacheData.init(nil)
+	retryTracker = newRetryTracker()
+	flapTracker = newFlapTracker(&cacheData)
+	dc := newDummyController(&cacheData.appInstCache, nil)
+	dc.start()
+	defer dc.stop()
+	dialOpts = grpc.WithContextDialer(dc.getBufDialer())
+	testDialOpt = grpc.WithInsecure()
+
+	start := time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)
+	now := start
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	pt := makePolicyTest("lifetimepolicy", 2, &cacheData)
+	pt.policy.MinInstanceLifetime = edgeproto.Duration(10 * time.Minute)
+	pt.policy.MaxFlaps = 1
+	pt.policy.FlapWindow = edgeproto.Duration(time.Hour)
+	pt.updatePolicy(ctx)
+
+	app := edgeproto.App{}
+	app.Key.Name = "lifetimeapp"
+	app.AutoProvPolicies = []string{pt.policy.Key.Name}
+	cacheData.appCache.Update(ctx, &app, 0)
+
+	inst := edgeproto.AppInst{}
+	inst.Key.Name = "lifetimeinst"
+	inst.AppKey = app.Key
+	inst.ZoneKey = pt.zones[0].Key
+	inst.CreatedAt = dme.TimeToTimestamp(start.Add(-time.Minute))
+	cacheData.appInstCache.Update(ctx, &inst, 0)
+
+	// suspend the app due to flapping in another zone
+	other := inst
+	other.ZoneKey = pt.zones[1].Key
+	flapTracker.registerUndeploy(ctx, &other)
+	flapTracker.registerUndeploy(ctx, &other)
+	require.True(t, flapTracker.isSuspended(&app.Key))
+
+	alert := edgeproto.Alert{}
+	alert.State = "firing"
+	alert.Labels = map[string]string{
+		"alertname":                 cloudcommon.AlertAutoUndeploy,
+		edgeproto.AppInstKeyTagName: inst.Key.Name,
+		edgeproto.AppKeyTagName:     app.Key.Name,
+	}
+	cacheData.alertCache.Update(ctx, &alert, 0)
+
+	// undeploy is deferred until the min instance lifetime expires
+	err := autoUndeploy(ctx, cloudcommon.AlertAutoUndeploy, &alert)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(flapTracker.deferred))
+	require.Equal(t, 1, cacheData.appInstCache.GetCount())
+
+	now = start.Add(5 * time.Minute)
+	flapTracker.runDeferredUndeploys(ctx)
+	require.Equal(t, 1, len(flapTracker.deferred))
+	require.Equal(t, 1, cacheData.appInstCache.GetCount())
+
+	// lifetime expires, undeploy runs even though the app is suspended
+	now = start.Add(10 * time.Minute)
+	flapTracker.runDeferredUndeploys(ctx)
+	require.Equal(t, 0, len(flapTracker.deferred))
+	err = dc.waitForAppInsts(ctx, 0)
+	require.Nil(t, err)
+
+	// deferred undeploy is dropped if the alert is gone
+	inst.CreatedAt = dme.TimeToTimestamp(now)
+	cacheData.appInstCache.Update(ctx, &inst, 0)
+	err = autoUndeploy(ctx, cloudcommon.AlertAutoUndeploy, &alert)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(flapTracker.deferred))
+	cacheData.alertCache.Delete(ctx, &alert, 0)
+	now = now.Add(10 * time.Minute)
+	flapTracker.runDeferredUndeploys(ctx)
+	require.Equal(t, 0, len(flapTracker.deferred))
+	require.Equal(t, 1, cacheData.appInstCache.GetCount())
+}
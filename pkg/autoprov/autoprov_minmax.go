@@ -39,12 +39,17 @@ type MinMaxChecker struct {
 	appsByPolicy            edgeproto.AppByAutoProvPolicy
 	autoprovInstsByCloudlet edgeproto.AppInstLookup2ByCloudletKey
 	workers                 tasks.KeyWorkers
+	// last evaluated active schedules per policy
+	scheduleStates map[edgeproto.PolicyKey]string
+	stop           chan struct{}
+	waitGroup      sync.WaitGroup
 }
 
 func newMinMaxChecker(caches *CacheData) *MinMaxChecker {
 	s := MinMaxChecker{}
 	s.caches = caches
 	s.failoverRequests = make(map[edgeproto.CloudletKey]*failoverReq)
+	s.scheduleStates = make(map[edgeproto.PolicyKey]string)
 	s.workers.Init("autoprov-minmax", s.CheckApp)
 	s.policiesByZone.Init()
 	s.appsByPolicy.Init()
@@ -471,6 +476,7 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 	// get counts
 	potentialDelete := []edgeproto.AppInstKey{}
 	potentialCreate := []*potentialCreateSite{}
+	onlineInsts := make(map[edgeproto.AppInstKey]edgeproto.ZoneKey)
 	onlineCount := 0
 	totalCount := 0
 	// check AppInsts on the policy's zones
@@ -508,6 +514,7 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 				// stuck in a going-online transitional state,
 				// however.
 				if s.appInstOnlineOrGoingOnline(ctx, &appInstKey) {
+					onlineInsts[appInstKey] = *zkey
 					onlineCount++
 				}
 				if s.isAutoProvInst(&appInstKey) {
@@ -518,6 +525,34 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 	}
 	log.SpanLog(ctx, log.DebugLevelMetrics, "checkPolicy stats", "policy", policyKey, "onlineCount", onlineCount, "min", policy.MinActiveInstances, "totalCount", totalCount, "max", policy.MaxInstances, "potentialCreate", potentialCreate, "potentialDelete", potentialDelete)
 
+	// Remove instances deployed for schedules whose windows
+	// have passed, so they are not counted against the max or
+	// the schedules that are still active.
+	activeScheds := s.getActiveSchedules(ctx, &policy)
+	schedDeletes := s.chooseScheduleDelete(ctx, &policy, activeScheds, onlineInsts, onlineCount)
+	if len(schedDeletes) > 0 {
+		remaining := []edgeproto.AppInstKey{}
+		for _, key := range potentialDelete {
+			if _, found := schedDeletes[key]; found {
+				continue
+			}
+			remaining = append(remaining, key)
+		}
+		potentialDelete = remaining
+		for key, _ := range schedDeletes {
+			inst := edgeproto.AppInst{
+				Key:    key,
+				AppKey: app.Key,
+			}
+			go goAppInstApi(ctx, &inst, cloudcommon.Delete, cloudcommon.AutoProvReasonSchedule, pname)
+			totalCount--
+			if _, found := onlineInsts[key]; found {
+				delete(onlineInsts, key)
+				onlineCount--
+			}
+		}
+	}
+
 	// Check max first. If we meet or exceed max,
 	// we cannot deploy to try to meet min.
 	if policy.MaxInstances > 0 {
@@ -538,6 +573,7 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 	// Check min
 	needCreateCount := int(policy.MinActiveInstances) - onlineCount
 	potentialCreate = s.sortPotentialCreate(ctx, potentialCreate)
+	potentialCreate = flapTracker.sortCooldownLast(&s.appKey, potentialCreate)
 	if len(potentialCreate) < needCreateCount {
 		log.SpanLog(ctx, log.DebugLevelMetrics, "Not enough potential Cloudlets to meet min constraint", "App", s.appKey, "policy", pname, "min", policy.MinActiveInstances)
 		str := fmt.Sprintf("Not enough potential cloudlets to deploy to for App %s to meet policy %s min constraint %d", s.appKey.GetKeyString(), pname, policy.MinActiveInstances)
@@ -545,8 +581,35 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 			req.addError(str)
 		}
 	}
+
+	// Check active schedules. Each schedule and the policy min
+	// take sites from their own disjoint lists so that workers
+	// retrying a failed create never race for the same site.
+	if needCreateCount < 0 {
+		needCreateCount = 0
+	}
+	if needCreateCount > len(potentialCreate) {
+		needCreateCount = len(potentialCreate)
+	}
+	minSites := append([]*potentialCreateSite{}, potentialCreate[:needCreateCount]...)
+	schedCreates, remainingSites := s.chooseScheduleCreate(ctx, &policy, activeScheds, onlineInsts, potentialCreate[needCreateCount:], totalCount+needCreateCount)
+
+	// Sites not claimed by a schedule are left for the policy
+	// min workers to retry with.
+	minSites = append(minSites, remainingSites...)
+	constraint := fmt.Sprintf("policy %s min constraint %d", pname, policy.MinActiveInstances)
+	s.goCreateForMin(ctx, app, pname, minSites, needCreateCount, cloudcommon.AutoProvReasonMinMax, "", constraint)
+	for _, sc := range schedCreates {
+		constraint := fmt.Sprintf("policy %s schedule %s min constraint %d", pname, sc.sched.Name, sc.sched.MinActiveInstances)
+		s.goCreateForMin(ctx, app, pname, sc.sites, len(sc.sites), cloudcommon.AutoProvReasonSchedule, sc.sched.Name, constraint)
+	}
+}
+
+// goCreateForMin creates AppInsts on the given sites, in order, to
+// meet a min constraint.
+func (s *AppChecker) goCreateForMin(ctx context.Context, app *edgeproto.App, pname string, sites []*potentialCreateSite, needCreateCount int, reason, schedName, constraint string) {
 	createSites := potentialCreateSites{
-		sites: potentialCreate,
+		sites: sites,
 	}
 	// Spawn the same number of threads as the number of AppInsts we need
 	// to create. Each thread will try to create a single AppInst.
@@ -556,8 +619,8 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 	// These could potentially overlap with a retry of this App,
 	// but that's ok for create since the Controller will prevent
 	// extra creates to meet the min.
-	log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov create min workers", "needCreateCount", needCreateCount, "numPotential", len(potentialCreate))
-	for ii := 0; ii < needCreateCount && ii < len(potentialCreate); ii++ {
+	log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov create min workers", "needCreateCount", needCreateCount, "numPotential", len(sites), "reason", reason, "schedule", schedName)
+	for ii := 0; ii < needCreateCount && ii < len(sites); ii++ {
 		for _, req := range s.failoverReqs {
 			req.waitApiCalls.Add(1)
 		}
@@ -575,10 +638,15 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 				inst.Key = cloudcommon.GetAutoProvAppInstKey(&app.Key, &site.zoneKey)
 				inst.AppKey = app.Key
 				inst.ZoneKey = site.zoneKey
+				if schedName != "" {
+					inst.Annotations = map[string]string{
+						cloudcommon.AnnotationAutoProvSchedule: schedName,
+					}
+				}
 
-				err := goAppInstApi(ctx, &inst, cloudcommon.Create, cloudcommon.AutoProvReasonMinMax, pname)
+				err := goAppInstApi(ctx, &inst, cloudcommon.Create, reason, pname)
 				if err == nil {
-					str := fmt.Sprintf("Created AppInst %s to meet policy %s min constraint %d", inst.Key.GetKeyString(), pname, policy.MinActiveInstances)
+					str := fmt.Sprintf("Created AppInst %s to meet %s", inst.Key.GetKeyString(), constraint)
 					for _, req := range s.failoverReqs {
 						req.addCompleted(str)
 					}
@@ -586,7 +654,7 @@ func (s *AppChecker) checkPolicy(ctx context.Context, app *edgeproto.App, pname
 					log.SpanLog(ctx, log.DebugLevelMetrics, "auto-prov ignore deploy error", "workerNum", workerNum, "attempt", attempt, "err", err)
 					err = nil
 				} else {
-					str := fmt.Sprintf("Failed to create AppInst %s to meet policy %s min constraint %d: %s", inst.Key.GetKeyString(), pname, policy.MinActiveInstances, err)
+					str := fmt.Sprintf("Failed to create AppInst %s to meet %s: %s", inst.Key.GetKeyString(), constraint, err)
 					for _, req := range s.failoverReqs {
 						req.addError(str)
 					}
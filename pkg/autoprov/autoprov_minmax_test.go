@@ -164,6 +164,7 @@ func TestAppChecker(t *testing.T) {
 
 	minmax := newMinMaxChecker(&cacheData)
 	retryTracker = newRetryTracker()
+	flapTracker = newFlapTracker(&cacheData)
 	// run iterations manually, otherwise the cache update loop causes
 	// checkApp to be run multiple times, and without the Controller code
 	// to block invalid creates/deletes, we end up with incorrect states.
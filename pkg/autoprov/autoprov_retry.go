@@ -16,6 +16,7 @@ package autoprov
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 
@@ -102,3 +103,16 @@ func ignoreDeployError(inst *edgeproto.AppInst, err error) bool {
 	}
 	return false
 }
+
+// Dumpable returns the tracked failures for debugging.
+func (s *RetryTracker) Dumpable() []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	failures := []string{}
+	for lookup := range s.allFailures {
+		failures = append(failures, lookup.AppKey.GetKeyString()+" "+lookup.ZoneKey.GetKeyString())
+	}
+	sort.Strings(failures)
+	return failures
+}
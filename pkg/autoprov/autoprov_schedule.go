@@ -0,0 +1,280 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprov
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+)
+
+// timeNow can be replaced by unit tests
+var timeNow = time.Now
+
+// scheduleCheckIntervalSec is how often schedules are evaluated.
+// Schedules have minute granularity.
+var scheduleCheckIntervalSec = float64(60)
+
+// activeSchedule is a policy schedule and the zones it is
+// currently active for.
+type activeSchedule struct {
+	sched *edgeproto.AutoProvSchedule
+	zones map[edgeproto.ZoneKey]struct{}
+}
+
+func getZoneLocation(ctx context.Context, caches *CacheData, key *edgeproto.ZoneKey) *time.Location {
+	zone := edgeproto.Zone{}
+	if !caches.zoneCache.Get(key, &zone) {
+		return time.UTC
+	}
+	loc, err := zone.GetLocation()
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelMetrics, "failed to get zone location, using UTC", "zone", key, "err", err)
+		return time.UTC
+	}
+	return loc
+}
+
+func getActiveSchedules(ctx context.Context, caches *CacheData, policy *edgeproto.AutoProvPolicy, now time.Time) []activeSchedule {
+	getLoc := func(key *edgeproto.ZoneKey) *time.Location {
+		return getZoneLocation(ctx, caches, key)
+	}
+	active := []activeSchedule{}
+	for ii := range policy.Schedules {
+		sched := &policy.Schedules[ii]
+		zones := cloudcommon.GetAutoProvScheduleActiveZones(policy, sched, now, getLoc)
+		if len(zones) == 0 {
+			continue
+		}
+		as := activeSchedule{
+			sched: sched,
+			zones: make(map[edgeproto.ZoneKey]struct{}),
+		}
+		for _, zkey := range zones {
+			as.zones[zkey] = struct{}{}
+		}
+		active = append(active, as)
+	}
+	return active
+}
+
+func (s *AppChecker) getActiveSchedules(ctx context.Context, policy *edgeproto.AutoProvPolicy) []activeSchedule {
+	return getActiveSchedules(ctx, s.caches, policy, timeNow())
+}
+
+// scheduleCreate is the set of sites chosen to meet an active
+// schedule's min.
+type scheduleCreate struct {
+	sched *edgeproto.AutoProvSchedule
+	sites []*potentialCreateSite
+}
+
+// chooseScheduleCreate chooses sites from the potential sites to
+// meet each active schedule's min. Each schedule gets its own
+// disjoint set of sites, and the unclaimed sites are returned.
+// The schedule min is the total across the schedule's Zones whose
+// window is active, not per Zone. The policy's max constraint is
+// still honored, counting the plannedCount instances.
+func (s *AppChecker) chooseScheduleCreate(ctx context.Context, policy *edgeproto.AutoProvPolicy, activeScheds []activeSchedule, onlineInsts map[edgeproto.AppInstKey]edgeproto.ZoneKey, sites []*potentialCreateSite, plannedCount int) ([]scheduleCreate, []*potentialCreateSite) {
+	creates := []scheduleCreate{}
+	remainingSites := sites
+	for _, as := range activeScheds {
+		schedOnline := 0
+		for _, zkey := range onlineInsts {
+			if _, found := as.zones[zkey]; found {
+				schedOnline++
+			}
+		}
+		needCount := int(as.sched.MinActiveInstances) - schedOnline
+		if needCount <= 0 {
+			continue
+		}
+		if policy.MaxInstances > 0 && plannedCount+needCount > int(policy.MaxInstances) {
+			needCount = int(policy.MaxInstances) - plannedCount
+			if needCount <= 0 {
+				log.SpanLog(ctx, log.DebugLevelMetrics, "Max instances reached, cannot meet schedule min", "App", s.appKey, "policy", policy.Key.Name, "schedule", as.sched.Name, "max", policy.MaxInstances)
+				break
+			}
+		}
+		schedSites := []*potentialCreateSite{}
+		unusedSites := []*potentialCreateSite{}
+		for _, site := range remainingSites {
+			if _, found := as.zones[site.zoneKey]; found && len(schedSites) < needCount {
+				schedSites = append(schedSites, site)
+			} else {
+				unusedSites = append(unusedSites, site)
+			}
+		}
+		remainingSites = unusedSites
+		if len(schedSites) < needCount {
+			log.SpanLog(ctx, log.DebugLevelMetrics, "Not enough potential Cloudlets to meet schedule min constraint", "App", s.appKey, "policy", policy.Key.Name, "schedule", as.sched.Name, "min", as.sched.MinActiveInstances)
+		}
+		plannedCount += len(schedSites)
+		creates = append(creates, scheduleCreate{
+			sched: as.sched,
+			sites: schedSites,
+		})
+	}
+	return creates, remainingSites
+}
+
+// chooseScheduleDelete chooses AppInsts deployed for a schedule that
+// are no longer needed because no schedule is active for their Zone.
+// The policy's min constraint is still honored.
+func (s *AppChecker) chooseScheduleDelete(ctx context.Context, policy *edgeproto.AutoProvPolicy, activeScheds []activeSchedule, onlineInsts map[edgeproto.AppInstKey]edgeproto.ZoneKey, onlineCount int) map[edgeproto.AppInstKey]struct{} {
+	deletes := make(map[edgeproto.AppInstKey]struct{})
+	for _, zkey := range policy.Zones {
+		inActiveSchedule := false
+		for _, as := range activeScheds {
+			if _, found := as.zones[*zkey]; found {
+				inActiveSchedule = true
+				break
+			}
+		}
+		if inActiveSchedule {
+			continue
+		}
+		for appInstKey, _ := range s.zoneInsts[*zkey] {
+			if s.getAutoProvSchedule(&appInstKey) == "" {
+				continue
+			}
+			if _, found := onlineInsts[appInstKey]; found {
+				if policy.MinActiveInstances > 0 && onlineCount <= int(policy.MinActiveInstances) {
+					// still needed for min
+					continue
+				}
+				onlineCount--
+			}
+			log.SpanLog(ctx, log.DebugLevelMetrics, "schedule no longer active for AppInst", "AppInst", appInstKey, "zone", zkey)
+			deletes[appInstKey] = struct{}{}
+		}
+	}
+	return deletes
+}
+
+// getAutoProvSchedule gets the name of the schedule the AppInst
+// was auto-provisioned for, if any.
+func (s *AppChecker) getAutoProvSchedule(key *edgeproto.AppInstKey) string {
+	// direct lookup to avoid copy
+	s.caches.appInstCache.Mux.Lock()
+	defer s.caches.appInstCache.Mux.Unlock()
+
+	data, found := s.caches.appInstCache.Objs[*key]
+	if !found || data.Obj.Liveness != edgeproto.Liveness_LIVENESS_AUTOPROV {
+		return ""
+	}
+	return data.Obj.Annotations[cloudcommon.AnnotationAutoProvSchedule]
+}
+
+// Start starts the periodic schedule checks.
+func (s *MinMaxChecker) Start() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.stop != nil {
+		// already started
+		return
+	}
+	s.stop = make(chan struct{})
+	s.waitGroup.Add(1)
+	go s.runSchedules()
+}
+
+// Stop stops the periodic schedule checks.
+func (s *MinMaxChecker) Stop() {
+	s.mux.Lock()
+	if s.stop == nil {
+		s.mux.Unlock()
+		return
+	}
+	close(s.stop)
+	s.mux.Unlock()
+	s.waitGroup.Wait()
+	s.mux.Lock()
+	s.stop = nil
+	s.mux.Unlock()
+}
+
+func (s *MinMaxChecker) runSchedules() {
+	done := false
+	for !done {
+		waitTime := util.GetWaitTime(time.Now(), scheduleCheckIntervalSec, 0)
+		select {
+		case <-time.After(waitTime):
+			span := log.StartSpan(log.DebugLevelMetrics, "auto-prov-schedules")
+			ctx := log.ContextWithSpan(context.Background(), span)
+			s.checkSchedules(ctx)
+			span.Finish()
+		case <-s.stop:
+			done = true
+		}
+	}
+	s.waitGroup.Done()
+}
+
+// checkSchedules rechecks the Apps of any policies for which the
+// set of active schedules has changed since the last check. This
+// deploys instances ahead of a schedule's window and removes them
+// once the window has passed.
+func (s *MinMaxChecker) checkSchedules(ctx context.Context) {
+	now := timeNow()
+	policies := []edgeproto.AutoProvPolicy{}
+	s.caches.autoProvPolicyCache.Show(&edgeproto.AutoProvPolicy{}, func(obj *edgeproto.AutoProvPolicy) error {
+		policies = append(policies, *obj)
+		return nil
+	})
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	seen := make(map[edgeproto.PolicyKey]struct{})
+	for ii := range policies {
+		policy := &policies[ii]
+		seen[policy.Key] = struct{}{}
+		state := getActiveSchedulesState(getActiveSchedules(ctx, s.caches, policy, now))
+		if s.scheduleStates[policy.Key] == state {
+			continue
+		}
+		log.SpanLog(ctx, log.DebugLevelMetrics, "active schedules changed", "policy", policy.Key, "old", s.scheduleStates[policy.Key], "new", state)
+		s.scheduleStates[policy.Key] = state
+		for _, appKey := range s.appsByPolicy.Find(policy.Key) {
+			s.workers.NeedsWork(ctx, appKey)
+		}
+	}
+	for key := range s.scheduleStates {
+		if _, found := seen[key]; !found {
+			delete(s.scheduleStates, key)
+		}
+	}
+}
+
+func getActiveSchedulesState(active []activeSchedule) string {
+	strs := []string{}
+	for _, as := range active {
+		zones := []string{}
+		for zkey := range as.zones {
+			zones = append(zones, zkey.GetKeyString())
+		}
+		sort.Strings(zones)
+		strs = append(strs, as.sched.Name+"="+strings.Join(zones, ","))
+	}
+	sort.Strings(strs)
+	return strings.Join(strs, ";")
+}
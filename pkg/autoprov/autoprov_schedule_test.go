@@ -0,0 +1,224 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprov
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestAppCheckerSchedule(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify | log.DebugLevelApi | log.DebugLevelMetrics)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	// init with null nodeMgr
+	cacheData.init(nil)
+	autoProvAggr = NewAutoProvAggr(300, 0, &cacheData)
+	autoProvAggr.allStats = make(map[edgeproto.AppKey]*apAppStats)
+	// forward AppInsts created by the test to cacheData
+	dc := newDummyController(&cacheData.appInstCache, &cacheData.appInstRefsCache)
+	dc.start()
+	defer dc.stop()
+	dialOpts = grpc.WithContextDialer(dc.getBufDialer())
+	testDialOpt = grpc.WithInsecure()
+
+	minmax := newMinMaxChecker(&cacheData)
+	retryTracker = newRetryTracker()
+	flapTracker = newFlapTracker(&cacheData)
+	dummyCheckApp := newDummyCheckApp()
+	minmax.workers.Init("autoprov-minmax-test", dummyCheckApp.CheckApp)
+
+	day := time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)
+	setTime := func(hour, min int) {
+		now := day.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+		timeNow = func() time.Time { return now }
+	}
+	defer func() { timeNow = time.Now }()
+
+	// The last zone is in a different time zone, 8am in
+	// Tokyo is 23:00 UTC the previous day.
+	pt := makePolicyTest("schedpolicy", 4, &cacheData)
+	pt.zones[3].Timezone = "Asia/Tokyo"
+	pt.policy.MinActiveInstances = 1
+	pt.policy.ScheduleLeadTime = edgeproto.Duration(10 * time.Minute)
+	pt.policy.Schedules = []edgeproto.AutoProvSchedule{{
+		Name:               "morning",
+		Cron:               "0 8 * * *",
+		Duration:           edgeproto.Duration(2 * time.Hour),
+		MinActiveInstances: 2,
+	}}
+	pt.updatePolicy(ctx)
+	pt.updateClusterInsts(ctx)
+
+	app := edgeproto.App{}
+	app.Key.Name = "schedapp"
+	app.AutoProvPolicies = []string{pt.policy.Key.Name}
+	cacheData.appCache.Update(ctx, &app, 0)
+
+	refs := edgeproto.AppInstRefs{}
+	refs.Key = app.Key
+	refs.Insts = make(map[string]uint32)
+	cacheData.appInstRefsCache.Update(ctx, &refs, 0)
+
+	// outside of window, only policy min is deployed
+	setTime(7, 0)
+	minmax.CheckApp(ctx, app.Key)
+	err := dc.waitForAppInsts(ctx, 1)
+	require.Nil(t, err)
+	require.True(t, pt.hasAppInst(&app.Key, dc, 0))
+
+	// no change in schedules, so no App check triggered
+	minmax.workers.WaitIdle()
+	dummyCheckApp.Clear()
+	minmax.checkSchedules(ctx)
+	minmax.workers.WaitIdle()
+	require.False(t, dummyCheckApp.HasApp(app.Key))
+
+	// within lead time of window, schedule min is deployed.
+	// The existing instance counts towards the schedule min.
+	setTime(7, 55)
+	minmax.workers.WaitIdle()
+	dummyCheckApp.Clear()
+	minmax.checkSchedules(ctx)
+	minmax.workers.WaitIdle()
+	require.True(t, dummyCheckApp.HasApp(app.Key))
+	minmax.CheckApp(ctx, app.Key)
+	err = dc.waitForAppInsts(ctx, 2)
+	require.Nil(t, err)
+	require.True(t, pt.hasAppInst(&app.Key, dc, 1))
+	for _, inst := range pt.getAppInsts(&app.Key, dc) {
+		if inst.ZoneKey.Matches(&pt.zones[1].Key) {
+			require.Equal(t, "morning", inst.Annotations[cloudcommon.AnnotationAutoProvSchedule])
+		} else {
+			require.Empty(t, inst.Annotations[cloudcommon.AnnotationAutoProvSchedule])
+		}
+	}
+
+	// still within window, no change
+	setTime(9, 30)
+	minmax.workers.WaitIdle()
+	dummyCheckApp.Clear()
+	minmax.checkSchedules(ctx)
+	minmax.workers.WaitIdle()
+	require.False(t, dummyCheckApp.HasApp(app.Key))
+	minmax.CheckApp(ctx, app.Key)
+	err = dc.waitForAppInsts(ctx, 2)
+	require.Nil(t, err)
+
+	// after window, scheduled instance is removed
+	setTime(10, 0)
+	minmax.workers.WaitIdle()
+	dummyCheckApp.Clear()
+	minmax.checkSchedules(ctx)
+	minmax.workers.WaitIdle()
+	require.True(t, dummyCheckApp.HasApp(app.Key))
+	minmax.CheckApp(ctx, app.Key)
+	err = dc.waitForAppInsts(ctx, 1)
+	require.Nil(t, err)
+	require.True(t, pt.hasAppInst(&app.Key, dc, 0))
+
+	// window in Tokyo only applies to the Tokyo zone
+	setTime(22, 55)
+	minmax.CheckApp(ctx, app.Key)
+	err = dc.waitForAppInsts(ctx, 2)
+	require.Nil(t, err)
+	require.True(t, pt.hasAppInst(&app.Key, dc, 3))
+
+	// after window in Tokyo
+	setTime(25, 0)
+	minmax.CheckApp(ctx, app.Key)
+	err = dc.waitForAppInsts(ctx, 1)
+	require.Nil(t, err)
+	require.True(t, pt.hasAppInst(&app.Key, dc, 0))
+
+	// clean up
+	pt.policy.MinActiveInstances = 0
+	pt.policy.Schedules = nil
+	pt.updatePolicy(ctx)
+	pt.deleteAppInsts(ctx, dc, &app.Key)
+	err = dc.waitForAppInsts(ctx, 0)
+	require.Nil(t, err)
+}
+
+func TestChooseScheduleCreate(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelMetrics)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	zones := []edgeproto.ZoneKey{}
+	sites := []*potentialCreateSite{}
+	for ii := 0; ii < 5; ii++ {
+		zkey := edgeproto.ZoneKey{
+			Name:         fmt.Sprintf("zone%d", ii),
+			Organization: "op",
+		}
+		zones = append(zones, zkey)
+		sites = append(sites, &potentialCreateSite{zoneKey: zkey})
+	}
+	schedZones := func(idx ...int) map[edgeproto.ZoneKey]struct{} {
+		zs := map[edgeproto.ZoneKey]struct{}{}
+		for _, ii := range idx {
+			zs[zones[ii]] = struct{}{}
+		}
+		return zs
+	}
+	siteZones := func(sites []*potentialCreateSite) []edgeproto.ZoneKey {
+		zs := []edgeproto.ZoneKey{}
+		for _, site := range sites {
+			zs = append(zs, site.zoneKey)
+		}
+		return zs
+	}
+	// zone0 already has an instance
+	onlineInsts := map[edgeproto.AppInstKey]edgeproto.ZoneKey{
+		{Name: "inst0", Organization: "dev"}: zones[0],
+	}
+	activeScheds := []activeSchedule{{
+		sched: &edgeproto.AutoProvSchedule{Name: "s1", MinActiveInstances: 2},
+		zones: schedZones(0, 1, 2),
+	}, {
+		sched: &edgeproto.AutoProvSchedule{Name: "s2", MinActiveInstances: 2},
+		zones: schedZones(1, 2, 3),
+	}}
+	checker := &AppChecker{}
+	policy := &edgeproto.AutoProvPolicy{}
+
+	// the schedule min is the total across its zones, and
+	// schedules do not share sites
+	creates, remaining := checker.chooseScheduleCreate(ctx, policy, activeScheds, onlineInsts, sites[1:], 1)
+	require.Equal(t, 2, len(creates))
+	require.Equal(t, []edgeproto.ZoneKey{zones[1]}, siteZones(creates[0].sites))
+	require.Equal(t, []edgeproto.ZoneKey{zones[2], zones[3]}, siteZones(creates[1].sites))
+	require.Equal(t, []edgeproto.ZoneKey{zones[4]}, siteZones(remaining))
+
+	// max limits the schedule creates
+	policy.MaxInstances = 3
+	creates, remaining = checker.chooseScheduleCreate(ctx, policy, activeScheds, onlineInsts, sites[1:], 1)
+	require.Equal(t, 2, len(creates))
+	require.Equal(t, []edgeproto.ZoneKey{zones[1]}, siteZones(creates[0].sites))
+	require.Equal(t, []edgeproto.ZoneKey{zones[2]}, siteZones(creates[1].sites))
+	require.Equal(t, []edgeproto.ZoneKey{zones[3], zones[4]}, siteZones(remaining))
+}
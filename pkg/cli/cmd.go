@@ -39,6 +39,7 @@ var SilenceUsage bool
 var OutputFormat = OutputFormatYaml
 var Interactive bool
 var Tty bool
+var LocalAddr string
 
 func AddInputFlags(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&Data, "data", "", "json formatted input data, alternative to name=val args list")
@@ -46,7 +47,9 @@ func AddInputFlags(flagSet *pflag.FlagSet) {
 }
 
 func AddOutputFlags(flagSet *pflag.FlagSet) {
-	flagSet.StringVar(&OutputFormat, "output-format", OutputFormatYaml, fmt.Sprintf("output format: %s, %s, or %s", OutputFormatYaml, OutputFormatJson, OutputFormatJsonCompact))
+	flagSet.StringVar(&OutputFormat, "output-format", OutputFormatYaml, fmt.Sprintf("output format: %s, %s, %s, or %s", OutputFormatYaml, OutputFormatJson, OutputFormatJsonCompact, OutputFormatTable))
+	flagSet.StringVar(&Columns, "columns", "", "comma separated list of hierarchical field names for table output, i.e. key.name,state")
+	flagSet.StringVar(&Query, "query", "", "JSONPath-style query to select output values, i.e. $[*].key.name")
 	flagSet.BoolVar(&Parsable, "parsable", false, "generate parsable output")
 	flagSet.BoolVar(&OutputStream, "output-stream", true, "stream output incrementally if supported by command")
 }
@@ -61,6 +64,10 @@ func AddTtyFlags(flagSet *pflag.FlagSet) {
 	flagSet.BoolVarP(&Tty, "tty", "t", false, "treat stdin and stout as a tty")
 }
 
+func AddPortForwardFlags(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(&LocalAddr, "local-addr", "127.0.0.1:0", "local address to listen on for forwarded connections")
+}
+
 var NoFlags func(flagSet *pflag.FlagSet) = nil
 
 // HideTags is a comma separated list of tag names that are matched
@@ -98,6 +105,7 @@ type Command struct {
 	Annotations          map[string]string
 	AddFlagsFunc         func(*pflag.FlagSet)
 	unalias              map[string]string
+	table                *tableWriter
 }
 
 func (c *Command) GenCmd() *cobra.Command {
@@ -237,7 +245,12 @@ func (c *Command) argsHelp(pad int, args []string) string {
 }
 
 func (c *Command) runE(cmd *cobra.Command, args []string) error {
-	return c.Run(c, args)
+	c.table = nil
+	err := c.Run(c, args)
+	if ferr := c.FlushOutput(); ferr != nil && err == nil {
+		err = ferr
+	}
+	return err
 }
 
 // ParseInput converts args to generic map.
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	yaml "github.com/mobiledgex/yaml/v2"
 )
@@ -39,6 +40,9 @@ var OutputFormats = []string{
 // note slightly different function in cmdsup.WriteOutputGeneric,
 // perhaps we can consolidate them.
 func (c *Command) WriteOutput(out io.Writer, objs interface{}, format string) error {
+	if Query != "" {
+		return WriteQuery(out, objs, Query)
+	}
 	switch format {
 	case OutputFormatYaml:
 		output, err := yaml.Marshal(objs)
@@ -59,9 +63,36 @@ func (c *Command) WriteOutput(out io.Writer, objs interface{}, format string) er
 		}
 		fmt.Fprintln(out, string(output))
 	case OutputFormatTable:
-		return fmt.Errorf("table output format not supported yet")
+		// streamed objects are written one at a time, so keep
+		// the table state to only write the header once and to
+		// keep the columns aligned
+		if c.table == nil {
+			c.table = newTableWriter(GetColumns())
+		}
+		return c.table.write(out, objs)
 	default:
 		return fmt.Errorf("invalid output format %s", format)
 	}
 	return nil
 }
+
+// FlushOutput writes out any table rows buffered to size the
+// columns of streamed objects.
+func (c *Command) FlushOutput() error {
+	if c.table == nil {
+		return nil
+	}
+	return c.table.flush()
+}
+
+// GetColumns gets the table columns specified by the user.
+func GetColumns() []string {
+	columns := []string{}
+	for _, col := range strings.Split(Columns, ",") {
+		col = strings.TrimSpace(col)
+		if col != "" {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
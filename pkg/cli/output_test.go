@@ -0,0 +1,191 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	edgeproto "github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/stretchr/testify/require"
+)
+
+func testOutputAppInsts() []*edgeproto.AppInst {
+	return []*edgeproto.AppInst{{
+		Key: edgeproto.AppInstKey{
+			Name:         "inst1",
+			Organization: "devorg",
+		},
+		AppKey: edgeproto.AppKey{
+			Name:         "app1",
+			Organization: "devorg",
+			Version:      "1.0",
+		},
+		CloudletKey: edgeproto.CloudletKey{
+			Name:         "cloudlet1",
+			Organization: "oporg",
+		},
+		State: edgeproto.TrackedState_READY,
+		MappedPorts: []edgeproto.InstPort{{
+			Proto:      dme.LProto_L_PROTO_TCP,
+			PublicPort: 443,
+		}, {
+			Proto:      dme.LProto_L_PROTO_UDP,
+			PublicPort: 10002,
+		}},
+	}, {
+		Key: edgeproto.AppInstKey{
+			Name:         "inst2",
+			Organization: "devorg",
+		},
+		AppKey: edgeproto.AppKey{
+			Name:         "app2",
+			Organization: "devorg",
+			Version:      "2.0",
+		},
+		CloudletKey: edgeproto.CloudletKey{
+			Name:         "cloudlet2",
+			Organization: "oporg",
+		},
+		State: edgeproto.TrackedState_CREATING,
+	}}
+}
+
+func TestWriteTable(t *testing.T) {
+	objs := testOutputAppInsts()
+
+	// specified columns, names are matched loosely
+	buf := bytes.Buffer{}
+	err := WriteTable(&buf, objs, []string{"key.name", "cloudletkey.name", "state", "mapped_ports"})
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 3, len(lines))
+	require.Equal(t, []string{"KEY.NAME", "CLOUDLETKEY.NAME", "STATE", "MAPPED_PORTS"}, strings.Fields(lines[0]))
+	require.Equal(t, []string{"inst1", "cloudlet1", "Ready", `[{"proto":"Tcp","public_port":443},{"proto":"Udp","public_port":10002}]`}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"inst2", "cloudlet2", "Creating"}, strings.Fields(lines[2]))
+
+	// single object is a single row
+	buf.Reset()
+	err = WriteTable(&buf, objs[0], []string{"key.name", "app_key.version"})
+	require.Nil(t, err)
+	require.Equal(t, "KEY.NAME  APP_KEY.VERSION\ninst1     1.0\n", buf.String())
+
+	// default columns from the generated table columns
+	require.Equal(t, (&edgeproto.AppInst{}).TableColumns(), GetDefaultColumns(objs))
+	require.Equal(t, []string{"message"}, GetDefaultColumns(&edgeproto.Result{}))
+	// default columns from the key and state
+	require.Equal(t, []string{"key.name"}, GetDefaultColumns([]edgeproto.Flavor{}))
+	require.Equal(t, []string{"key.organization", "key.name"}, GetDefaultColumns(&edgeproto.TrustPolicy{}))
+	require.Equal(t, []string{"key.organization", "key.name", "state"}, GetDefaultColumns(&edgeproto.VMPool{}))
+	// default columns from top-level fields
+	require.Equal(t, []string{"chosen", "skip_reason", "details", "resource_score"}, GetDefaultColumns([]edgeproto.PlacementCandidate{}))
+
+	// table output through the command
+	cmd := &Command{}
+	buf.Reset()
+	err = cmd.WriteOutput(&buf, objs, OutputFormatTable)
+	require.Nil(t, err)
+	err = cmd.FlushOutput()
+	require.Nil(t, err)
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 3, len(lines))
+	require.True(t, strings.HasPrefix(lines[0], "KEY.ORGANIZATION"))
+
+	// streamed objects share a single header
+	Columns = "key.name,state"
+	defer func() { Columns = "" }()
+	cmd = &Command{}
+	buf.Reset()
+	for _, obj := range objs {
+		err = cmd.WriteOutput(&buf, obj, OutputFormatTable)
+		require.Nil(t, err)
+	}
+	require.Equal(t, "", buf.String())
+	err = cmd.FlushOutput()
+	require.Nil(t, err)
+	require.Equal(t, "KEY.NAME  STATE\ninst1     Ready\ninst2     Creating\n", buf.String())
+
+	// streamed rows are aligned using the widths from the
+	// buffered window of rows
+	origWindow := TableStreamWindow
+	TableStreamWindow = 2
+	defer func() { TableStreamWindow = origWindow }()
+	objs[0].Key.Name = "i1"
+	objs[1].Key.Name = "instance2"
+	cmd = &Command{}
+	buf.Reset()
+	err = cmd.WriteOutput(&buf, objs[0], OutputFormatTable)
+	require.Nil(t, err)
+	require.Equal(t, "KEY.NAME  STATE\ni1        Ready\n", buf.String())
+	buf.Reset()
+	err = cmd.WriteOutput(&buf, objs[0], OutputFormatTable)
+	require.Nil(t, err)
+	require.Equal(t, "i1        Ready\n", buf.String())
+	buf.Reset()
+	err = cmd.WriteOutput(&buf, objs[1], OutputFormatTable)
+	require.Nil(t, err)
+	require.Equal(t, "instance2  Creating\n", buf.String())
+	err = cmd.FlushOutput()
+	require.Nil(t, err)
+	require.Equal(t, "instance2  Creating\n", buf.String())
+}
+
+func TestRunQuery(t *testing.T) {
+	objs := testOutputAppInsts()
+
+	var tests = []struct {
+		query  string
+		expect []interface{}
+	}{
+		{"$[*].key.name", []interface{}{"inst1", "inst2"}},
+		{"[*].key.name", []interface{}{"inst1", "inst2"}},
+		{"[1].app_key.version", []interface{}{"2.0"}},
+		{"[-1].appkey.name", []interface{}{"app2"}},
+		{"$[0].mapped_ports[*].public_port", []interface{}{float64(443), float64(10002)}},
+		{"[0].key['name']", []interface{}{"inst1"}},
+		{"[5].key.name", []interface{}{}},
+		{"[*].nosuchfield", []interface{}{}},
+		{"[0].key.*", []interface{}{"inst1", "devorg"}},
+		{"[0].key[*]", []interface{}{"inst1", "devorg"}},
+	}
+	for _, test := range tests {
+		vals, err := RunQuery(objs, test.query)
+		require.Nil(t, err, test.query)
+		require.Equal(t, test.expect, vals, test.query)
+	}
+
+	// single object does not require an index
+	vals, err := RunQuery(objs[0], "key.organization")
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"devorg"}, vals)
+
+	_, err = RunQuery(objs, "[*.key")
+	require.NotNil(t, err)
+	_, err = RunQuery(objs, "[x].key")
+	require.NotNil(t, err)
+	_, err = RunQuery(objs, "[0]..key")
+	require.NotNil(t, err)
+
+	// query output through the command
+	Query = "[*].key.name"
+	defer func() { Query = "" }()
+	cmd := &Command{}
+	buf := bytes.Buffer{}
+	err = cmd.WriteOutput(&buf, objs, OutputFormatYaml)
+	require.Nil(t, err)
+	require.Equal(t, "inst1\ninst2\n", buf.String())
+}
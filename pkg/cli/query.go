@@ -0,0 +1,153 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Query is a JSONPath-style expression applied to output data.
+// Supported syntax is a subset of JSONPath: an optional "$" root,
+// ".name" child fields, "[n]" list indices, and "[*]" or ".*"
+// wildcards, i.e. "$[*].key.name" or "[0].mapped_ports[*].public_port".
+// Wildcards over objects return the values ordered by field name.
+var Query string
+
+type queryStep struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+func parseQuery(query string) ([]queryStep, error) {
+	steps := []queryStep{}
+	q := strings.TrimSpace(query)
+	q = strings.TrimPrefix(q, "$")
+	for len(q) > 0 {
+		switch q[0] {
+		case '.':
+			q = q[1:]
+			end := strings.IndexAny(q, ".[")
+			if end == -1 {
+				end = len(q)
+			}
+			name := q[:end]
+			q = q[end:]
+			if name == "" {
+				return nil, fmt.Errorf("invalid query %q, empty field name", query)
+			}
+			if name == "*" {
+				steps = append(steps, queryStep{wildcard: true})
+			} else {
+				steps = append(steps, queryStep{name: name})
+			}
+		case '[':
+			end := strings.Index(q, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid query %q, missing ]", query)
+			}
+			idx := strings.TrimSpace(q[1:end])
+			q = q[end+1:]
+			if idx == "*" {
+				steps = append(steps, queryStep{wildcard: true})
+				continue
+			}
+			if len(idx) >= 2 && (idx[0] == '\'' || idx[0] == '"') && idx[len(idx)-1] == idx[0] {
+				steps = append(steps, queryStep{name: idx[1 : len(idx)-1]})
+				continue
+			}
+			ii, err := strconv.Atoi(idx)
+			if err != nil {
+				return nil, fmt.Errorf("invalid query %q, bad index %q", query, idx)
+			}
+			steps = append(steps, queryStep{index: ii, isIndex: true})
+		default:
+			if len(steps) > 0 {
+				return nil, fmt.Errorf("invalid query %q, unexpected %q", query, q)
+			}
+			// allow leading field name without "."
+			q = "." + q
+		}
+	}
+	return steps, nil
+}
+
+// RunQuery applies the JSONPath-style query to the objects and
+// returns the matching values.
+func RunQuery(objs interface{}, query string) ([]interface{}, error) {
+	steps, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	data, err := toGenericData(objs)
+	if err != nil {
+		return nil, err
+	}
+	vals := []interface{}{data}
+	for _, step := range steps {
+		next := []interface{}{}
+		for _, val := range vals {
+			switch v := val.(type) {
+			case map[string]interface{}:
+				if step.wildcard {
+					// sort keys for deterministic output
+					keys := []string{}
+					for key := range v {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					for _, key := range keys {
+						next = append(next, v[key])
+					}
+				} else if !step.isIndex {
+					if sub, ok := lookupKey(v, step.name); ok {
+						next = append(next, sub)
+					}
+				}
+			case []interface{}:
+				if step.wildcard {
+					next = append(next, v...)
+				} else if step.isIndex {
+					ii := step.index
+					if ii < 0 {
+						ii += len(v)
+					}
+					if ii >= 0 && ii < len(v) {
+						next = append(next, v[ii])
+					}
+				}
+			}
+		}
+		vals = next
+	}
+	return vals, nil
+}
+
+// WriteQuery writes the query results, one per line.
+func WriteQuery(out io.Writer, objs interface{}, query string) error {
+	vals, err := RunQuery(objs, query)
+	if err != nil {
+		return err
+	}
+	for _, val := range vals {
+		fmt.Fprintln(out, valueString(val))
+	}
+	return nil
+}
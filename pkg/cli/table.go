@@ -0,0 +1,278 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// Columns is a comma separated list of hierarchical json field names,
+// i.e. "key.name,state", used for table output.
+var Columns string
+
+// TableColumnsGetter is implemented by generated objects that
+// specify their default table columns via the protogen.table_columns
+// message option.
+type TableColumnsGetter interface {
+	TableColumns() []string
+}
+
+// defaultColumnFields are non-key fields shown by default if the
+// object has them.
+var defaultColumnFields = []string{"state"}
+
+// WriteTable writes the object or list of objects as a table.
+func WriteTable(out io.Writer, objs interface{}, columns []string) error {
+	tw := newTableWriter(columns)
+	if err := tw.write(out, objs); err != nil {
+		return err
+	}
+	return tw.flush()
+}
+
+// TableStreamWindow is the number of rows buffered to size the
+// columns for objects that are streamed one at a time.
+var TableStreamWindow = 50
+
+// tableWriter writes objects as table rows. The header is written
+// once, so objects that are streamed one at a time share the same
+// header. Rows are buffered until the stream window fills, and
+// column widths are then fixed from the buffered rows so that all
+// rows stay aligned. A later value wider than its column is written
+// in full, shifting only the rest of its own row.
+type tableWriter struct {
+	columns    []string
+	widths     []int
+	headerDone bool
+	pending    [][]string
+	out        io.Writer
+}
+
+func newTableWriter(columns []string) *tableWriter {
+	return &tableWriter{
+		columns: columns,
+	}
+}
+
+func (s *tableWriter) write(out io.Writer, objs interface{}) error {
+	if len(s.columns) == 0 {
+		s.columns = GetDefaultColumns(objs)
+	}
+	if len(s.columns) == 0 {
+		return fmt.Errorf("no columns to show")
+	}
+	data, err := toGenericData(objs)
+	if err != nil {
+		return err
+	}
+	rows, ok := data.([]interface{})
+	if !ok {
+		rows = []interface{}{data}
+	}
+	s.out = out
+
+	if !s.headerDone && !Parsable {
+		headers := []string{}
+		for _, col := range s.columns {
+			headers = append(headers, strings.ToUpper(col))
+		}
+		s.pending = append(s.pending, headers)
+	}
+	s.headerDone = true
+	for _, row := range rows {
+		vals := []string{}
+		for _, col := range s.columns {
+			val, _ := lookupPath(row, strings.Split(col, "."))
+			vals = append(vals, valueString(val))
+		}
+		s.pending = append(s.pending, vals)
+	}
+	if s.widths == nil && len(s.pending) < TableStreamWindow {
+		return nil
+	}
+	return s.flush()
+}
+
+// flush writes out any buffered rows, fixing the column widths
+// if they have not been set yet.
+func (s *tableWriter) flush() error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	if s.widths == nil {
+		s.widths = make([]int, len(s.columns))
+		for _, vals := range s.pending {
+			for ii, val := range vals {
+				if width := utf8.RuneCountInString(val); width > s.widths[ii] {
+					s.widths[ii] = width
+				}
+			}
+		}
+	}
+	buf := bytes.Buffer{}
+	for _, vals := range s.pending {
+		for ii, val := range vals {
+			buf.WriteString(val)
+			if ii < len(vals)-1 {
+				pad := s.widths[ii] - utf8.RuneCountInString(val)
+				if pad < 0 {
+					pad = 0
+				}
+				buf.WriteString(strings.Repeat(" ", pad+tablePadding))
+			}
+		}
+		buf.WriteString("\n")
+	}
+	s.pending = nil
+	_, err := s.out.Write(buf.Bytes())
+	return err
+}
+
+// tablePadding is the number of spaces between columns
+const tablePadding = 2
+
+// GetDefaultColumns gets the default table columns for the
+// object or list of objects.
+func GetDefaultColumns(objs interface{}) []string {
+	t := reflect.TypeOf(objs)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	if getter, ok := reflect.New(t).Interface().(TableColumnsGetter); ok {
+		return getter.TableColumns()
+	}
+	cols := []string{}
+	if sf, ok := t.FieldByName("Key"); ok {
+		cols = getLeafColumns(sf.Type, []string{GetFieldTaggedName(sf, JsonNamespace)})
+	}
+	for _, name := range defaultColumnFields {
+		if sf, ok := FindField(t, name, JsonNamespace); ok {
+			cols = append(cols, GetFieldTaggedName(sf, JsonNamespace))
+		}
+	}
+	if len(cols) > 0 {
+		return cols
+	}
+	// fall back to all top-level protobuf fields that are not structs
+	for ii := 0; ii < t.NumField(); ii++ {
+		sf := t.Field(ii)
+		if _, ok := getProtoTag(sf); !ok {
+			continue
+		}
+		kind := sf.Type.Kind()
+		if kind == reflect.Struct || kind == reflect.Ptr || kind == reflect.Slice || kind == reflect.Map {
+			continue
+		}
+		cols = append(cols, GetFieldTaggedName(sf, JsonNamespace))
+	}
+	return cols
+}
+
+// getLeafColumns gets the hierarchical json names of the protobuf
+// leaf fields of the type.
+func getLeafColumns(t reflect.Type, parents []string) []string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return []string{strings.Join(parents, ".")}
+	}
+	cols := []string{}
+	for ii := 0; ii < t.NumField(); ii++ {
+		sf := t.Field(ii)
+		if _, ok := getProtoTag(sf); !ok {
+			continue
+		}
+		name := GetFieldTaggedName(sf, JsonNamespace)
+		cols = append(cols, getLeafColumns(sf.Type, append(parents, name))...)
+	}
+	return cols
+}
+
+// toGenericData converts the objects to generic json data, so that
+// values are formatted the same as json output.
+func toGenericData(objs interface{}) (interface{}, error) {
+	out, err := json.Marshal(objs)
+	if err != nil {
+		return nil, fmt.Errorf("json failed to marshal: %v", err)
+	}
+	var data interface{}
+	if err := json.Unmarshal(out, &data); err != nil {
+		return nil, fmt.Errorf("json failed to unmarshal: %v", err)
+	}
+	return data, nil
+}
+
+// lookupPath looks up the value for the hierarchical field names.
+// Names are matched against json names, falling back to case
+// insensitive matching with underscores removed, so that "cloudletkey"
+// matches "cloudlet_key".
+func lookupPath(data interface{}, path []string) (interface{}, bool) {
+	for _, name := range path {
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		data, ok = lookupKey(m, name)
+		if !ok {
+			return nil, false
+		}
+	}
+	return data, true
+}
+
+func lookupKey(m map[string]interface{}, name string) (interface{}, bool) {
+	if val, ok := m[name]; ok {
+		return val, true
+	}
+	normName := normalizeName(name)
+	for key, val := range m {
+		if normalizeName(key) == normName {
+			return val, true
+		}
+	}
+	return nil, false
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
+
+// valueString formats generic json data for table and query output.
+func valueString(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(out)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
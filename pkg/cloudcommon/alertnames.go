@@ -27,6 +27,8 @@ const (
 	AlertClusterSvcAppInstFailureDescription = "Cluster-svc create AppInst failed"
 	AlertCloudletResourceUsage               = "CloudletResourceUsage"
 	AlertTypeUserDefined                     = "UserDefined"
+	AlertAutoProvFlapping                    = "AutoProvFlapping"
+	AlertAutoProvFlappingDescription         = "Auto-provisioning suspended due to repeated deploys and undeploys"
 )
 
 // Alert types
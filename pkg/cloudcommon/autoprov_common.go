@@ -31,6 +31,7 @@ const (
 	AutoProvReasonDemand   = "demand"
 	AutoProvReasonMinMax   = "minmax"
 	AutoProvReasonOrphaned = "orphaned"
+	AutoProvReasonSchedule = "schedule"
 	AutoProvPolicyName     = "auto-prov-policy-name"
 	AccessKeyData          = "access-key-data"
 	AccessKeySig           = "access-key-sig"
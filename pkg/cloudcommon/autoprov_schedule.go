@@ -0,0 +1,66 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcommon
+
+import (
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+)
+
+// AutoProvScheduleActive checks if the schedule's window, started
+// early by the lead time, covers the given time. The cron expression
+// is evaluated in the given location, which is the Zone's time zone.
+func AutoProvScheduleActive(sched *edgeproto.AutoProvSchedule, leadTime time.Duration, now time.Time, loc *time.Location) bool {
+	cs, err := util.ParseCron(sched.Cron)
+	if err != nil {
+		// schedules are validated on create/update
+		return false
+	}
+	// A window starting at time t is active for
+	// t - leadTime <= now < t + duration, so look for
+	// any start time t in (now - duration, now + leadTime].
+	return cs.MatchesWithin(now.Add(-sched.Duration.TimeDuration()), now.Add(leadTime), loc)
+}
+
+// GetActiveAutoProvSchedules returns the policy's schedules that
+// are active for the Zone at the given time.
+func GetActiveAutoProvSchedules(policy *edgeproto.AutoProvPolicy, zoneKey *edgeproto.ZoneKey, loc *time.Location, now time.Time) []*edgeproto.AutoProvSchedule {
+	active := []*edgeproto.AutoProvSchedule{}
+	for ii := range policy.Schedules {
+		sched := &policy.Schedules[ii]
+		if !sched.HasZone(policy, zoneKey) {
+			continue
+		}
+		if AutoProvScheduleActive(sched, policy.ScheduleLeadTime.TimeDuration(), now, loc) {
+			active = append(active, sched)
+		}
+	}
+	return active
+}
+
+// GetAutoProvScheduleActiveZones returns the schedule's zones for
+// which the schedule is active at the given time. Each zone is
+// evaluated in its own time zone, as returned by getLoc.
+func GetAutoProvScheduleActiveZones(policy *edgeproto.AutoProvPolicy, sched *edgeproto.AutoProvSchedule, now time.Time, getLoc func(zoneKey *edgeproto.ZoneKey) *time.Location) []edgeproto.ZoneKey {
+	active := []edgeproto.ZoneKey{}
+	for _, zoneKey := range sched.GetZones(policy) {
+		if AutoProvScheduleActive(sched, policy.ScheduleLeadTime.TimeDuration(), now, getLoc(&zoneKey)) {
+			active = append(active, zoneKey)
+		}
+	}
+	return active
+}
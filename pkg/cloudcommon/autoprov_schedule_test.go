@@ -0,0 +1,96 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcommon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoProvScheduleActive(t *testing.T) {
+	zone1 := edgeproto.ZoneKey{Name: "zone1", Organization: "op"}
+	zone2 := edgeproto.ZoneKey{Name: "zone2", Organization: "op"}
+	policy := edgeproto.AutoProvPolicy{
+		Zones:            []*edgeproto.ZoneKey{&zone1, &zone2},
+		ScheduleLeadTime: edgeproto.Duration(15 * time.Minute),
+		Schedules: []edgeproto.AutoProvSchedule{{
+			// weekday mornings, all zones
+			Name:               "morning",
+			Cron:               "0 8 * * 1-5",
+			Duration:           edgeproto.Duration(2 * time.Hour),
+			MinActiveInstances: 2,
+		}, {
+			// evenings, zone2 only
+			Name:               "evening",
+			Cron:               "0 18 * * *",
+			Duration:           edgeproto.Duration(time.Hour),
+			Zones:              []edgeproto.ZoneKey{zone2},
+			MinActiveInstances: 1,
+		}},
+	}
+	names := func(scheds []*edgeproto.AutoProvSchedule) []string {
+		out := []string{}
+		for _, s := range scheds {
+			out = append(out, s.Name)
+		}
+		return out
+	}
+
+	// Wednesday Jan 15 2025
+	at := func(hour, min int) time.Time {
+		return time.Date(2025, time.January, 15, hour, min, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		desc   string
+		zone   edgeproto.ZoneKey
+		now    time.Time
+		active []string
+	}{
+		{"before lead", zone1, at(7, 44), []string{}},
+		{"within lead", zone1, at(7, 45), []string{"morning"}},
+		{"start", zone1, at(8, 0), []string{"morning"}},
+		{"end", zone1, at(9, 59), []string{"morning"}},
+		{"after end", zone1, at(10, 0), []string{}},
+		{"zone1 not in evening", zone1, at(18, 30), []string{}},
+		{"zone2 evening", zone2, at(18, 30), []string{"evening"}},
+		{"weekend", zone1, at(8, 30).AddDate(0, 0, 3), []string{}},
+	}
+	for _, test := range tests {
+		active := GetActiveAutoProvSchedules(&policy, &test.zone, time.UTC, test.now)
+		require.Equal(t, test.active, names(active), test.desc)
+	}
+
+	// schedules are evaluated in the zone's time zone,
+	// 8am in New York is 13:00 UTC in January.
+	ny, err := time.LoadLocation("America/New_York")
+	require.Nil(t, err)
+	require.Empty(t, GetActiveAutoProvSchedules(&policy, &zone1, ny, at(8, 30)))
+	require.Equal(t, []string{"morning"}, names(GetActiveAutoProvSchedules(&policy, &zone1, ny, at(13, 30))))
+
+	// active zones use each zone's own time zone
+	getLoc := func(zoneKey *edgeproto.ZoneKey) *time.Location {
+		if zoneKey.Matches(&zone1) {
+			return ny
+		}
+		return time.UTC
+	}
+	morning := &policy.Schedules[0]
+	require.Equal(t, []edgeproto.ZoneKey{zone2}, GetAutoProvScheduleActiveZones(&policy, morning, at(8, 30), getLoc))
+	require.Equal(t, []edgeproto.ZoneKey{zone1}, GetAutoProvScheduleActiveZones(&policy, morning, at(13, 30), getLoc))
+	require.Empty(t, GetAutoProvScheduleActiveZones(&policy, morning, at(16, 0), getLoc))
+}
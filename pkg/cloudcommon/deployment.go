@@ -455,6 +455,38 @@ func GetTimeout(cLen int) time.Duration {
 	return 15 * time.Minute
 }
 
+// DefaultRolloutHealthTimeout is the time allowed for a new App version
+// to become healthy if the App's RolloutPolicy does not specify one.
+var DefaultRolloutHealthTimeout = 5 * time.Minute
+
+// GetUpdateStrategy returns the strategy used to update the App's
+// instances to a new revision.
+func GetUpdateStrategy(app *edgeproto.App) edgeproto.UpdateStrategy {
+	if app.RolloutPolicy == nil {
+		return edgeproto.UpdateStrategy_UPDATE_IN_PLACE
+	}
+	return app.RolloutPolicy.Strategy
+}
+
+func GetRolloutHealthTimeout(app *edgeproto.App) time.Duration {
+	if app.RolloutPolicy == nil || app.RolloutPolicy.HealthTimeout == 0 {
+		return DefaultRolloutHealthTimeout
+	}
+	return app.RolloutPolicy.HealthTimeout.TimeDuration()
+}
+
+// DefaultRolloutObservationTime is the time a new App version must stay
+// healthy while serving traffic before it is promoted, if the App's
+// RolloutPolicy does not specify one.
+var DefaultRolloutObservationTime = time.Minute
+
+func GetRolloutObservationTime(app *edgeproto.App) time.Duration {
+	if app.RolloutPolicy == nil || app.RolloutPolicy.ObservationTime == 0 {
+		return DefaultRolloutObservationTime
+	}
+	return app.RolloutPolicy.ObservationTime.TimeDuration()
+}
+
 func DownloadFile(ctx context.Context, authApi RegistryAuthApi, fileUrlPath, urlCreds, filePath string, content *string) (reterr error) {
 	var reqConfig *RequestConfig
 
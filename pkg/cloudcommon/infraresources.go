@@ -211,6 +211,40 @@ func KuberentesResourcesGPUCount(kr *edgeproto.KubernetesResources) uint64 {
 	return GetGPUCount(kr.GpuPool.TotalOptRes)
 }
 
+// GetReservedKubernetesResources gets the Kubernetes resources
+// reserved in the cluster for the AppInst. For horizontally scaled
+// AppInsts the specified resources are for a single replica, so
+// resources for the maximum number of replicas are reserved.
+func GetReservedKubernetesResources(appInst *edgeproto.AppInst) (*edgeproto.KubernetesResources, error) {
+	kr := appInst.KubernetesResources
+	if kr == nil || appInst.HorizontalScalePolicy == nil || appInst.HorizontalScalePolicy.MaxReplicas <= 1 {
+		return kr, nil
+	}
+	replicas := appInst.HorizontalScalePolicy.MaxReplicas
+	reserved := kr.Clone()
+	for _, pool := range []*edgeproto.NodePoolResources{reserved.CpuPool, reserved.GpuPool} {
+		if pool == nil {
+			continue
+		}
+		pool.TotalVcpus.Mult(replicas)
+		pool.TotalMemory *= uint64(replicas)
+		pool.TotalDisk *= uint64(replicas)
+		for resName, val := range pool.TotalOptRes {
+			typ, alias, count, err := ParseOptResVal(val)
+			if err != nil {
+				return nil, err
+			}
+			parts := []string{typ}
+			if alias != "" {
+				parts = append(parts, alias)
+			}
+			parts = append(parts, strconv.Itoa(count*int(replicas)))
+			pool.TotalOptRes[resName] = strings.Join(parts, ":")
+		}
+	}
+	return reserved, nil
+}
+
 func NodeResourcesGPUCount(nr *edgeproto.NodeResources) uint64 {
 	if nr == nil {
 		return 0
@@ -0,0 +1,60 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcommon
+
+import (
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReservedKubernetesResources(t *testing.T) {
+	ai := edgeproto.AppInst{}
+	ai.KubernetesResources = &edgeproto.KubernetesResources{
+		GpuPool: &edgeproto.NodePoolResources{
+			TotalVcpus:  *edgeproto.NewUdec64(1, 500*edgeproto.DecMillis),
+			TotalMemory: 1024,
+			TotalDisk:   10,
+			TotalOptRes: map[string]string{
+				"gpu": "vgpu:nvidia-63:1",
+			},
+			Topology: edgeproto.NodePoolTopology{
+				MinNodeVcpus: 2,
+			},
+		},
+	}
+	// no scale policy, resources are unchanged
+	kr, err := GetReservedKubernetesResources(&ai)
+	require.Nil(t, err)
+	require.Equal(t, ai.KubernetesResources, kr)
+
+	// resources are reserved for max replicas
+	ai.HorizontalScalePolicy = &edgeproto.HorizontalScalePolicy{
+		MinReplicas: 1,
+		MaxReplicas: 3,
+		TargetCpu:   50,
+	}
+	kr, err = GetReservedKubernetesResources(&ai)
+	require.Nil(t, err)
+	require.Equal(t, "4.5", kr.GpuPool.TotalVcpus.DecString())
+	require.Equal(t, uint64(3072), kr.GpuPool.TotalMemory)
+	require.Equal(t, uint64(30), kr.GpuPool.TotalDisk)
+	require.Equal(t, "vgpu:nvidia-63:3", kr.GpuPool.TotalOptRes["gpu"])
+	require.Equal(t, uint64(2), kr.GpuPool.Topology.MinNodeVcpus)
+	// original is not modified
+	require.Equal(t, uint64(1024), ai.KubernetesResources.GpuPool.TotalMemory)
+	require.Equal(t, "vgpu:nvidia-63:1", ai.KubernetesResources.GpuPool.TotalOptRes["gpu"])
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	yaml "github.com/mobiledgex/yaml/v2"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -89,6 +90,13 @@ func EncodeK8SYaml(objs []runtime.Object) (string, error) {
 				// field, we need to remove it
 				file = strings.TrimSuffix(file, "status: {}\n")
 			}
+			if _, ok := o.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+				// the status has non-omitempty fields which would
+				// otherwise be written out for a new object.
+				if ii := strings.Index(file, "\nstatus:\n"); ii >= 0 {
+					file = file[:ii+1]
+				}
+			}
 			files = append(files, file)
 		}
 	}
@@ -45,6 +45,7 @@ var NodeTypeMC = "mc"
 var NodeTypeAutoProv = "autoprov"
 var NodeTypeFRM = "frm"
 var NodeTypeAddonMgr = "addonmgr"
+var NodeTypeDmeLocGroup = "dme-locgroup"
 
 // Node tracks all the nodes connected via notify, and handles common
 // requests over all nodes.
@@ -14,6 +14,14 @@
 
 package node
 
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
 // Three intermediate certificates are used to issue certificates to services.
 // The global intermediate certificate is used for global services.
 // The regional intermediate certificate is used for regional services like
@@ -64,3 +72,31 @@ func SameRegionalCloudletMatchCA() MatchCA {
 		RequireRegionMatch: true,
 	}
 }
+
+// GetPeerCertIssuer gets the issuer and common name of the verified
+// client certificate of the grpc peer.
+func GetPeerCertIssuer(ctx context.Context) (string, string, error) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", "", fmt.Errorf("no grpc peer context")
+	}
+	tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return "", "", fmt.Errorf("connection is not using TLS")
+	}
+	for _, chain := range tlsInfo.State.VerifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		for _, cert := range chain[1:] {
+			if !cert.IsCA || len(cert.DNSNames) == 0 {
+				continue
+			}
+			switch cert.DNSNames[0] {
+			case CertIssuerGlobal, CertIssuerRegional, CertIssuerRegionalCloudlet:
+				return cert.DNSNames[0], chain[0].Subject.CommonName, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("no verified client certificate from an internal issuer")
+}
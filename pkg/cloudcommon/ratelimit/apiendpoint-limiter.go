@@ -40,6 +40,8 @@ type apiEndpointLimiter struct {
 	// Maximum number of Ips and/or Users allowed in hashmaps
 	maxNumIps   int
 	maxNumUsers int
+	// If set, limiters keep their state in redis
+	redisStore *RedisLimiterStore
 }
 
 // Rate Limit Settings for an API endpoint
@@ -50,11 +52,12 @@ type apiEndpointRateLimitSettings struct {
 }
 
 // Create an ApiEndpointLimiter
-func newApiEndpointLimiter(apiName string, apiEndpointRateLimitSettings *apiEndpointRateLimitSettings, maxNumIps int, maxNumUsers int) *apiEndpointLimiter {
+func newApiEndpointLimiter(apiName string, apiEndpointRateLimitSettings *apiEndpointRateLimitSettings, maxNumIps int, maxNumUsers int, redisStore *RedisLimiterStore) *apiEndpointLimiter {
 	a := &apiEndpointLimiter{}
 	a.apiName = apiName
 	a.apiEndpointRateLimitSettings = apiEndpointRateLimitSettings
-	limiters := getLimitersFromRateLimitSettings(apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+	a.redisStore = redisStore
+	limiters := a.getLimiters(apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
 	a.limitAllRequests = NewCompositeLimiter(limiters...)
 	a.limitsPerIp = make(map[string]*CompositeLimiter)
 	a.limitsPerUser = make(map[string]*CompositeLimiter)
@@ -70,21 +73,33 @@ func (a *apiEndpointLimiter) Limit(ctx context.Context, info *CallerInfo) error
 		log.SpanLog(ctx, log.DebugLevelInfo, "nil CallerInfo - skipping rate limit")
 		return fmt.Errorf("nil CallerInfo - skipping rate limit")
 	}
+	return a.getCallerLimiters(info).Limit(ctx, info)
+}
+
+// Limiters that apply to a caller
+type callerLimiters struct {
+	perIp       *CompositeLimiter
+	perUser     *CompositeLimiter
+	allRequests *CompositeLimiter
+}
 
+// Get the limiters for the caller, adding limiters for new ips
+// and users. This modifies the apiEndpointLimiter so must be
+// called under the RateLimitManager lock, but the limiters that
+// are returned can be run without the lock.
+func (a *apiEndpointLimiter) getCallerLimiters(info *CallerInfo) *callerLimiters {
+	c := &callerLimiters{}
 	if a.doesLimitByIp() && info.Ip != "" {
 		// limit per ip
 		limiter, ok := a.limitsPerIp[info.Ip]
 		if !ok {
 			a.removeExcessIps()
 			// add ip
-			limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.PerIpRateLimitSettings)
+			limiters := a.getLimiters(a.apiEndpointRateLimitSettings.PerIpRateLimitSettings, info.Ip)
 			limiter = NewCompositeLimiter(limiters...)
 			a.limitsPerIp[info.Ip] = limiter
 		}
-		err := limiter.Limit(ctx, info)
-		if err != nil {
-			return fmt.Errorf("Client exceeded api rate limit per ip. %s", err)
-		}
+		c.perIp = limiter
 	}
 	if a.doesLimitByUser() && info.User != "" {
 		// limit per user
@@ -92,18 +107,34 @@ func (a *apiEndpointLimiter) Limit(ctx context.Context, info *CallerInfo) error
 		if !ok {
 			a.removeExcessUsers()
 			// add user
-			limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.PerUserRateLimitSettings)
+			limiters := a.getLimiters(a.apiEndpointRateLimitSettings.PerUserRateLimitSettings, info.User)
 			limiter = NewCompositeLimiter(limiters...)
 			a.limitsPerUser[info.User] = limiter
 		}
-		err := limiter.Limit(ctx, info)
+		c.perUser = limiter
+	}
+	if a.doesLimitByAllRequests() {
+		// limit for the entire endpoint
+		c.allRequests = a.limitAllRequests
+	}
+	return c
+}
+
+func (c *callerLimiters) Limit(ctx context.Context, info *CallerInfo) error {
+	if c.perIp != nil {
+		err := c.perIp.Limit(ctx, info)
+		if err != nil {
+			return fmt.Errorf("Client exceeded api rate limit per ip. %s", err)
+		}
+	}
+	if c.perUser != nil {
+		err := c.perUser.Limit(ctx, info)
 		if err != nil {
 			return fmt.Errorf("user \"%s\" exceeded api rate limit per user. %s", info.User, err)
 		}
 	}
-	if a.doesLimitByAllRequests() {
-		// limit for the entire endpoint
-		return a.limitAllRequests.Limit(ctx, info)
+	if c.allRequests != nil {
+		return c.allRequests.Limit(ctx, info)
 	}
 	return nil
 }
@@ -126,7 +157,7 @@ func (a *apiEndpointLimiter) updateFlowRateLimitSettings(flowRateLimitSettings *
 			}
 		}
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.UpdateFlowSettings(flowRateLimitSettings)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		if a.apiEndpointRateLimitSettings.PerIpRateLimitSettings == nil {
@@ -153,7 +184,7 @@ func (a *apiEndpointLimiter) removeFlowRateLimitSettings(target edgeproto.RateLi
 	switch target {
 	case edgeproto.RateLimitTarget_ALL_REQUESTS:
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveFlowSettings(name)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		a.apiEndpointRateLimitSettings.PerIpRateLimitSettings.RemoveFlowSettings(name)
@@ -178,7 +209,7 @@ func (a *apiEndpointLimiter) updateMaxReqsRateLimitSettings(maxReqsRateLimitSett
 			}
 		}
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.UpdateMaxReqsSettings(maxReqsRateLimitSettings)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		if a.apiEndpointRateLimitSettings.PerIpRateLimitSettings == nil {
@@ -205,7 +236,7 @@ func (a *apiEndpointLimiter) removeMaxReqsRateLimitSettings(target edgeproto.Rat
 	switch target {
 	case edgeproto.RateLimitTarget_ALL_REQUESTS:
 		a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveMaxReqsSettings(name)
-		limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+		limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
 		a.limitAllRequests = NewCompositeLimiter(limiters...)
 	case edgeproto.RateLimitTarget_PER_IP:
 		a.apiEndpointRateLimitSettings.PerIpRateLimitSettings.RemoveMaxReqsSettings(name)
@@ -226,7 +257,7 @@ func (a *apiEndpointLimiter) pruneFlowRateLimitSettings(keys map[edgeproto.FlowR
 			}
 			if _, ok := keys[key]; !ok {
 				a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveFlowSettings(name)
-				limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+				limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
 				a.limitAllRequests = NewCompositeLimiter(limiters...)
 			}
 		}
@@ -269,7 +300,7 @@ func (a *apiEndpointLimiter) pruneMaxReqsRateLimitSettings(keys map[edgeproto.Ma
 			}
 			if _, ok := keys[key]; !ok {
 				a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings.RemoveMaxReqsSettings(name)
-				limiters := getLimitersFromRateLimitSettings(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings)
+				limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
 				a.limitAllRequests = NewCompositeLimiter(limiters...)
 			}
 		}
@@ -333,6 +364,24 @@ func (a *apiEndpointLimiter) updateMaxNumUsers(max int) {
 	a.maxNumUsers = max
 }
 
+func (a *apiEndpointLimiter) updateRedisStore(redisStore *RedisLimiterStore) {
+	a.redisStore = redisStore
+	limiters := a.getLimiters(a.apiEndpointRateLimitSettings.AllRequestsRateLimitSettings, "")
+	a.limitAllRequests = NewCompositeLimiter(limiters...)
+	a.limitsPerIp = make(map[string]*CompositeLimiter)
+	a.limitsPerUser = make(map[string]*CompositeLimiter)
+}
+
+// getLimiters gets the limiters for the settings. The caller is the
+// ip or user for per-ip or per-user settings, and is used to keep
+// separate redis state per caller.
+func (a *apiEndpointLimiter) getLimiters(settings *edgeproto.RateLimitSettings, caller string) []Limiter {
+	if a.redisStore == nil {
+		return getLimitersFromRateLimitSettings(settings)
+	}
+	return getRedisLimitersFromRateLimitSettings(a.redisStore, settings, caller)
+}
+
 // Helper function that checks if the mgr should rate limit per user
 func (a *apiEndpointLimiter) doesLimitByUser() bool {
 	return a.apiEndpointRateLimitSettings.PerUserRateLimitSettings != nil
@@ -398,3 +447,40 @@ func getLimitersFromRateLimitSettings(settings *edgeproto.RateLimitSettings) []L
 	}
 	return limiters
 }
+
+func getRedisLimitersFromRateLimitSettings(store *RedisLimiterStore, settings *edgeproto.RateLimitSettings, caller string) []Limiter {
+	limiters := make([]Limiter, 0)
+	if settings == nil {
+		return limiters
+	}
+	getKey := func(settingsType, name string) string {
+		key := fmt.Sprintf("%s/%s/%s/%s/%s", settings.Key.ApiEndpointType.String(), settings.Key.ApiName, settings.Key.RateLimitTarget.String(), settingsType, name)
+		if caller != "" {
+			key += "/" + caller
+		}
+		return key
+	}
+
+	// Generate Flow Limiters
+	for name, fsettings := range settings.FlowSettings {
+		key := getKey("flow", name)
+		switch fsettings.FlowAlgorithm {
+		case edgeproto.FlowRateLimitAlgorithm_TOKEN_BUCKET_ALGORITHM:
+			limiters = append(limiters, NewRedisTokenBucketLimiter(store, key, fsettings.ReqsPerSecond, int(fsettings.BurstSize)))
+		case edgeproto.FlowRateLimitAlgorithm_LEAKY_BUCKET_ALGORITHM:
+			limiters = append(limiters, NewRedisLeakyBucketLimiter(store, key, fsettings.ReqsPerSecond))
+		default:
+		}
+	}
+
+	// Generate MaxReqs Limiters
+	for name, msettings := range settings.MaxReqsSettings {
+		key := getKey("maxreqs", name)
+		switch msettings.MaxReqsAlgorithm {
+		case edgeproto.MaxReqsRateLimitAlgorithm_FIXED_WINDOW_ALGORITHM:
+			limiters = append(limiters, NewRedisIntervalLimiter(store, key, int(msettings.MaxRequests), time.Duration(msettings.Interval)))
+		default:
+		}
+	}
+	return limiters
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/go-redis/redis/v8"
 )
 
 /*
@@ -34,6 +35,7 @@ type RateLimitManager struct {
 	disableRateLimit bool
 	maxTrackedIps    int
 	maxTrackedUsers  int
+	redisStore       *RedisLimiterStore
 }
 
 // Create a RateLimitManager
@@ -57,7 +59,7 @@ func (r *RateLimitManager) CreateApiEndpointLimiter(api string, allRequestsRateL
 		PerUserRateLimitSettings:     perUserRateLimitSettings,
 	}
 	// Map API to ApiEndpointLimiter for easy lookup
-	r.limitsPerApi[api] = newApiEndpointLimiter(api, apiEndpointRateLimitSettings, r.maxTrackedIps, r.maxTrackedUsers)
+	r.limitsPerApi[api] = newApiEndpointLimiter(api, apiEndpointRateLimitSettings, r.maxTrackedIps, r.maxTrackedUsers, r.redisStore)
 }
 
 // Update the flow rate limit settings for API that use the rate limit settings associated with the specified RateLimitSettingsKey
@@ -68,7 +70,7 @@ func (r *RateLimitManager) UpdateFlowRateLimitSettings(flowRateLimitSettings *ed
 	api := flowRateLimitSettings.Key.RateLimitKey.ApiName
 	limiter, ok := r.limitsPerApi[api]
 	if !ok || limiter == nil {
-		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers)
+		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers, r.redisStore)
 	}
 	// Update ApiEndpointLimiter with new RateLimitSettings
 	limiter.updateFlowRateLimitSettings(flowRateLimitSettings)
@@ -101,7 +103,7 @@ func (r *RateLimitManager) UpdateMaxReqsRateLimitSettings(maxReqsRateLimitSettin
 	api := maxReqsRateLimitSettings.Key.RateLimitKey.ApiName
 	limiter, ok := r.limitsPerApi[api]
 	if !ok || limiter == nil {
-		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers)
+		limiter = newApiEndpointLimiter(api, &apiEndpointRateLimitSettings{}, r.maxTrackedIps, r.maxTrackedUsers, r.redisStore)
 	}
 	// Update ApiEndpointLimiter with new RateLimitSettings
 	limiter.updateMaxReqsRateLimitSettings(maxReqsRateLimitSettings)
@@ -173,18 +175,47 @@ func (r *RateLimitManager) UpdateMaxTrackedUsers(max int) {
 	}
 }
 
+/*
+ * Keep rate limit state in redis so that limits are shared by all
+ * nodes using the same redis, rather than enforced by each node
+ * independently. If redis is unreachable, local limiters are used.
+ * A nil client reverts to local limiters only.
+ */
+func (r *RateLimitManager) SetRedisClient(client *redis.Client) {
+	r.Lock()
+	defer r.Unlock()
+	if client == nil {
+		r.redisStore = nil
+	} else {
+		r.redisStore = NewRedisLimiterStore(client)
+	}
+	for _, limiter := range r.limitsPerApi {
+		limiter.updateRedisStore(r.redisStore)
+	}
+}
+
 // Implements the Limiter interface
 func (r *RateLimitManager) Limit(ctx context.Context, info *CallerInfo) error {
+	// Only look up the limiters under the lock, as running them
+	// may block, e.g. for leaky bucket waits or redis round trips.
+	limiter, err := r.getCallerLimiters(ctx, info)
+	if limiter == nil || err != nil {
+		return err
+	}
+	return limiter.Limit(ctx, info)
+}
+
+func (r *RateLimitManager) getCallerLimiters(ctx context.Context, info *CallerInfo) (*callerLimiters, error) {
 	r.Lock()
 	defer r.Unlock()
 	// Skip rest of function if rate limiting is not enabled
 	if r.disableRateLimit {
-		return nil
+		return nil, nil
 	}
 	// Check for CallerInfo which provides essential information about the api, ip, user, and org
 	if info == nil {
 		log.DebugLog(log.DebugLevelInfo, "nil CallerInfo")
-		return fmt.Errorf("nil CallerInfo - skipping rate limit")
+		return nil, fmt.Errorf("nil CallerInfo - skipping rate limit")
 	}
 	// Check that api exists
 	api := info.Api
@@ -194,15 +225,15 @@ func (r *RateLimitManager) Limit(ctx context.Context, info *CallerInfo) error {
 		limiter, ok = r.limitsPerApi[edgeproto.GlobalApiName]
 		if !ok {
 			log.SpanLog(ctx, log.DebugLevelInfo, "Unable to find limiter for api or global limiter in ApiEndpointLimiter", "api", api)
-			return nil
+			return nil, nil
 		}
 	}
 	// Check that ApiEndpointLimiter is non nil (ie. does rate limiting)
 	if limiter == nil {
 		log.SpanLog(ctx, log.DebugLevelInfo, "No rate limiting on api", "api", api)
-		return nil
+		return nil, nil
 	}
-	return limiter.Limit(ctx, info)
+	return limiter.getCallerLimiters(info), nil
 }
 
 func (r *RateLimitManager) Type() string {
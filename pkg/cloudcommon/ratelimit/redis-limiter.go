@@ -0,0 +1,289 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisLimiterTimeout is the maximum time a rate limit check waits on
+// redis before falling back to the local limiter.
+var RedisLimiterTimeout = 500 * time.Millisecond
+
+// RedisLimiterRetryInterval is how long the local fallback limiters are
+// used after redis is found to be unreachable, before trying redis again.
+var RedisLimiterRetryInterval = 10 * time.Second
+
+// Keys expire once they have been idle for longer than the time needed
+// to reset their state, plus this margin.
+var redisLimiterExpireMargin = time.Second
+
+const redisLimiterKeyPrefix = "ratelimit/"
+
+/*
+ * RedisLimiterStore keeps rate limit counters in redis, so that limits are
+ * enforced across all replicas of a service (ie. region-wide for DMEs)
+ * instead of per process.
+ * If redis is unreachable, limiters fall back to their local in-process
+ * equivalent until redis is reachable again.
+ */
+type RedisLimiterStore struct {
+	client           *redis.Client
+	mux              sync.Mutex
+	unavailableUntil time.Time
+}
+
+func NewRedisLimiterStore(client *redis.Client) *RedisLimiterStore {
+	return &RedisLimiterStore{
+		client: client,
+	}
+}
+
+func (s *RedisLimiterStore) available() bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return time.Now().After(s.unavailableUntil)
+}
+
+func (s *RedisLimiterStore) setUnavailable(ctx context.Context, err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.unavailableUntil = time.Now().Add(RedisLimiterRetryInterval)
+	log.SpanLog(ctx, log.DebugLevelInfo, "redis rate limit store unavailable, using local limiters", "err", err, "retry-in", RedisLimiterRetryInterval.String())
+}
+
+// run runs the script and returns its integer results. An error means
+// the rate limit could not be determined from redis.
+func (s *RedisLimiterStore) run(ctx context.Context, script *redis.Script, key string, args ...interface{}) ([]int64, error) {
+	if !s.available() {
+		return nil, fmt.Errorf("redis rate limit store unavailable")
+	}
+	rctx, cancel := context.WithTimeout(ctx, RedisLimiterTimeout)
+	defer cancel()
+	res, err := script.Run(rctx, s.client, []string{redisLimiterKeyPrefix + key}, args...).Int64Slice()
+	if err != nil {
+		if ctx.Err() == nil {
+			s.setUnavailable(ctx, err)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func redisLimiterExpire(resetTime time.Duration) int64 {
+	return (resetTime + redisLimiterExpireMargin).Milliseconds()
+}
+
+// Scripts use the redis server time so that all replicas share the
+// same clock. Times are in microseconds.
+var redisTokenBucketScript = redis.NewScript(`
+redis.replicate_commands()
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+local data = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(data[1])
+local ts = tonumber(data[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+local elapsed = math.max(0, now - ts)
+tokens = math.min(burst, tokens + elapsed * rate / 1000000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', string.format('%.6f', tokens), 'ts', string.format('%.0f', now))
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return {allowed}
+`)
+
+var redisLeakyBucketScript = redis.NewScript(`
+redis.replicate_commands()
+local interval = tonumber(ARGV[1])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+local nextFree = tonumber(redis.call('GET', KEYS[1]) or '0')
+if nextFree < now then
+	nextFree = now
+end
+local expire = math.ceil((nextFree + interval - now) / 1000) + tonumber(ARGV[2])
+redis.call('SET', KEYS[1], string.format('%.0f', nextFree + interval), 'PX', expire)
+return {nextFree - now}
+`)
+
+var redisIntervalScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local count = tonumber(redis.call('GET', KEYS[1]) or '0')
+if count >= limit then
+	return {0, redis.call('PTTL', KEYS[1])}
+end
+count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return {1, redis.call('PTTL', KEYS[1])}
+`)
+
+/*
+ * Redis backed equivalent of TokenBucketLimiter.
+ * The bucket is shared by all limiters using the same key.
+ * FlowRateLimitAlgorithm
+ */
+type RedisTokenBucketLimiter struct {
+	store           *RedisLimiterStore
+	key             string
+	tokensPerSecond float64
+	bucketSize      int
+	local           *TokenBucketLimiter
+}
+
+func NewRedisTokenBucketLimiter(store *RedisLimiterStore, key string, tokensPerSecond float64, bucketSize int) *RedisTokenBucketLimiter {
+	return &RedisTokenBucketLimiter{
+		store:           store,
+		key:             key,
+		tokensPerSecond: tokensPerSecond,
+		bucketSize:      bucketSize,
+		local:           NewTokenBucketLimiter(tokensPerSecond, bucketSize),
+	}
+}
+
+func (t *RedisTokenBucketLimiter) Limit(ctx context.Context, info *CallerInfo) error {
+	// time to refill the bucket from empty
+	refill := time.Hour
+	if t.tokensPerSecond > 0 {
+		fill := time.Duration(float64(t.bucketSize) / t.tokensPerSecond * float64(time.Second))
+		if fill < refill {
+			refill = fill
+		}
+	}
+	res, err := t.store.run(ctx, redisTokenBucketScript, t.key, t.tokensPerSecond, t.bucketSize, redisLimiterExpire(refill))
+	if err != nil || len(res) != 1 {
+		return t.local.Limit(ctx, info)
+	}
+	if res[0] == 0 {
+		return fmt.Errorf("Exceeded rate of %f requests per second", t.tokensPerSecond)
+	}
+	return nil
+}
+
+func (t *RedisTokenBucketLimiter) Type() string {
+	return "RedisTokenBucketLimiter"
+}
+
+/*
+ * Redis backed equivalent of LeakyBucketLimiter.
+ * Requests from all limiters using the same key are queued and
+ * leaked out at reqsPerSecond.
+ * FlowRateLimitAlgorithm
+ */
+type RedisLeakyBucketLimiter struct {
+	store         *RedisLimiterStore
+	key           string
+	reqsPerSecond float64
+	local         *LeakyBucketLimiter
+}
+
+func NewRedisLeakyBucketLimiter(store *RedisLimiterStore, key string, reqsPerSecond float64) *RedisLeakyBucketLimiter {
+	return &RedisLeakyBucketLimiter{
+		store:         store,
+		key:           key,
+		reqsPerSecond: reqsPerSecond,
+		local:         NewLeakyBucketLimiter(reqsPerSecond),
+	}
+}
+
+func (l *RedisLeakyBucketLimiter) Limit(ctx context.Context, info *CallerInfo) error {
+	if l.reqsPerSecond <= 0 {
+		return l.local.Limit(ctx, info)
+	}
+	intervalUsec := int64(1000000 / l.reqsPerSecond)
+	res, err := l.store.run(ctx, redisLeakyBucketScript, l.key, intervalUsec, redisLimiterExpireMargin.Milliseconds())
+	if err != nil || len(res) != 1 {
+		return l.local.Limit(ctx, info)
+	}
+	wait := time.Duration(res[0]) * time.Microsecond
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		log.SpanLog(ctx, log.DebugLevelInfo, "Error during redis leakybucket rate limiting", "error", ctx.Err())
+		return fmt.Errorf("error during leakybucket rate limiting: %s", ctx.Err())
+	case <-time.After(wait):
+	}
+	return nil
+}
+
+func (l *RedisLeakyBucketLimiter) Type() string {
+	return "RedisLeakyBucketLimiter"
+}
+
+/*
+ * Redis backed equivalent of IntervalLimiter.
+ * The request count for the interval is shared by all limiters using
+ * the same key.
+ * MaxReqsRateLimitAlgorithm
+ */
+type RedisIntervalLimiter struct {
+	store        *RedisLimiterStore
+	key          string
+	requestLimit int
+	interval     time.Duration
+	local        *IntervalLimiter
+}
+
+func NewRedisIntervalLimiter(store *RedisLimiterStore, key string, reqLimit int, interval time.Duration) *RedisIntervalLimiter {
+	return &RedisIntervalLimiter{
+		store:        store,
+		key:          key,
+		requestLimit: reqLimit,
+		interval:     interval,
+		local:        NewIntervalLimiter(reqLimit, interval),
+	}
+}
+
+func (i *RedisIntervalLimiter) Limit(ctx context.Context, info *CallerInfo) error {
+	if i.requestLimit == 0 {
+		// no limit
+		return nil
+	}
+	intervalMs := i.interval.Milliseconds()
+	if intervalMs <= 0 {
+		return i.local.Limit(ctx, info)
+	}
+	res, err := i.store.run(ctx, redisIntervalScript, i.key, i.requestLimit, intervalMs)
+	if err != nil || len(res) != 2 {
+		return i.local.Limit(ctx, info)
+	}
+	if res[0] == 0 {
+		waitTime := time.Duration(res[1]) * time.Millisecond
+		return fmt.Errorf("Exceeded limit of %d, retry again in %v", i.requestLimit, waitTime)
+	}
+	return nil
+}
+
+func (i *RedisIntervalLimiter) Type() string {
+	return "RedisIntervalLimiter"
+}
@@ -0,0 +1,214 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/rediscache"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisLimiters(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisSrv, err := rediscache.NewMockRedisServer()
+	require.Nil(t, err)
+	defer redisSrv.Close()
+
+	// two stores simulate two replicas sharing the same redis
+	client1 := redis.NewClient(&redis.Options{Addr: redisSrv.GetStandaloneAddr()})
+	defer client1.Close()
+	client2 := redis.NewClient(&redis.Options{Addr: redisSrv.GetStandaloneAddr()})
+	defer client2.Close()
+	store1 := NewRedisLimiterStore(client1)
+	store2 := NewRedisLimiterStore(client2)
+
+	// token bucket is shared between replicas
+	tb1 := NewRedisTokenBucketLimiter(store1, "tb", 1, 2)
+	tb2 := NewRedisTokenBucketLimiter(store2, "tb", 1, 2)
+	require.Nil(t, tb1.Limit(ctx, nil))
+	require.Nil(t, tb2.Limit(ctx, nil))
+	err = tb1.Limit(ctx, nil)
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "Exceeded rate"))
+	err = tb2.Limit(ctx, nil)
+	require.NotNil(t, err)
+	// different key is a different bucket
+	tbOther := NewRedisTokenBucketLimiter(store2, "tbOther", 1, 2)
+	require.Nil(t, tbOther.Limit(ctx, nil))
+	// bucket refills
+	time.Sleep(1100 * time.Millisecond)
+	require.Nil(t, tb2.Limit(ctx, nil))
+
+	// interval limit is shared between replicas
+	il1 := NewRedisIntervalLimiter(store1, "il", 3, time.Minute)
+	il2 := NewRedisIntervalLimiter(store2, "il", 3, time.Minute)
+	require.Nil(t, il1.Limit(ctx, nil))
+	require.Nil(t, il2.Limit(ctx, nil))
+	require.Nil(t, il1.Limit(ctx, nil))
+	err = il2.Limit(ctx, nil)
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "Exceeded limit"))
+	// interval resets
+	redisSrv.FastForward(time.Minute)
+	require.Nil(t, il2.Limit(ctx, nil))
+
+	// leaky bucket queues requests across replicas
+	reqsPerSecond := 4.0
+	lb1 := NewRedisLeakyBucketLimiter(store1, "lb", reqsPerSecond)
+	lb2 := NewRedisLeakyBucketLimiter(store2, "lb", reqsPerSecond)
+	numRequests := 4
+	start := time.Now()
+	done := make(chan error, numRequests)
+	for ii := 0; ii < numRequests; ii++ {
+		lb := lb1
+		if ii%2 == 1 {
+			lb = lb2
+		}
+		go func() {
+			done <- lb.Limit(ctx, nil)
+		}()
+	}
+	for ii := 0; ii < numRequests; ii++ {
+		require.Nil(t, <-done)
+	}
+	expectedTime := time.Duration(float64(numRequests-1) / reqsPerSecond * float64(time.Second))
+	require.True(t, time.Since(start) >= expectedTime)
+
+	// fall back to local limiters if redis is unreachable
+	redisSrv.Close()
+	il1 = NewRedisIntervalLimiter(store1, "il2", 1, time.Minute)
+	require.Nil(t, il1.Limit(ctx, nil))
+	require.False(t, store1.available())
+	err = il1.Limit(ctx, nil)
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "Exceeded limit"))
+}
+
+func TestRedisRateLimitMgr(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisSrv, err := rediscache.NewMockRedisServer()
+	require.Nil(t, err)
+	defer redisSrv.Close()
+
+	api := "api1"
+	maxReqs := &edgeproto.MaxReqsRateLimitSettings{
+		Key: edgeproto.MaxReqsRateLimitSettingsKey{
+			MaxReqsSettingsName: "maxreqs1",
+			RateLimitKey: edgeproto.RateLimitSettingsKey{
+				ApiName:         api,
+				ApiEndpointType: edgeproto.ApiEndpointType_DME,
+				RateLimitTarget: edgeproto.RateLimitTarget_PER_IP,
+			},
+		},
+		Settings: edgeproto.MaxReqsSettings{
+			MaxReqsAlgorithm: edgeproto.MaxReqsRateLimitAlgorithm_FIXED_WINDOW_ALGORITHM,
+			MaxRequests:      2,
+			Interval:         edgeproto.Duration(time.Minute),
+		},
+	}
+
+	// two managers simulate two DMEs in the region
+	mgrs := []*RateLimitManager{}
+	for ii := 0; ii < 2; ii++ {
+		client := redis.NewClient(&redis.Options{Addr: redisSrv.GetStandaloneAddr()})
+		defer client.Close()
+		mgr := NewRateLimitManager(false, 100, 100)
+		mgr.UpdateMaxReqsRateLimitSettings(maxReqs)
+		mgr.SetRedisClient(client)
+		mgrs = append(mgrs, mgr)
+	}
+
+	info1 := &CallerInfo{Api: api, Ip: "10.0.0.1"}
+	info2 := &CallerInfo{Api: api, Ip: "10.0.0.2"}
+	require.Nil(t, mgrs[0].Limit(ctx, info1))
+	require.Nil(t, mgrs[1].Limit(ctx, info1))
+	// limit is enforced across both managers
+	require.NotNil(t, mgrs[0].Limit(ctx, info1))
+	require.NotNil(t, mgrs[1].Limit(ctx, info1))
+	// limit is per ip
+	require.Nil(t, mgrs[1].Limit(ctx, info2))
+
+	// without redis, limits are per manager
+	mgrs[0].SetRedisClient(nil)
+	require.Nil(t, mgrs[0].Limit(ctx, info1))
+	require.Nil(t, mgrs[0].Limit(ctx, info1))
+	require.NotNil(t, mgrs[0].Limit(ctx, info1))
+}
+
+func TestRedisRateLimitMgrNoBlock(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	redisSrv, err := rediscache.NewMockRedisServer()
+	require.Nil(t, err)
+	defer redisSrv.Close()
+
+	getFlow := func(api string, reqsPerSecond float64) *edgeproto.FlowRateLimitSettings {
+		return &edgeproto.FlowRateLimitSettings{
+			Key: edgeproto.FlowRateLimitSettingsKey{
+				FlowSettingsName: "flow1",
+				RateLimitKey: edgeproto.RateLimitSettingsKey{
+					ApiName:         api,
+					ApiEndpointType: edgeproto.ApiEndpointType_DME,
+					RateLimitTarget: edgeproto.RateLimitTarget_ALL_REQUESTS,
+				},
+			},
+			Settings: edgeproto.FlowSettings{
+				FlowAlgorithm: edgeproto.FlowRateLimitAlgorithm_LEAKY_BUCKET_ALGORITHM,
+				ReqsPerSecond: reqsPerSecond,
+			},
+		}
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: redisSrv.GetStandaloneAddr()})
+	defer client.Close()
+	mgr := NewRateLimitManager(false, 100, 100)
+	mgr.UpdateFlowRateLimitSettings(getFlow("slowapi", 0.5))
+	mgr.UpdateFlowRateLimitSettings(getFlow("fastapi", 1000))
+	mgr.SetRedisClient(client)
+
+	slowInfo := &CallerInfo{Api: "slowapi"}
+	fastInfo := &CallerInfo{Api: "fastapi"}
+	require.Nil(t, mgr.Limit(ctx, slowInfo))
+
+	// second call to the slow api waits in the leaky bucket,
+	// which must not block calls to other apis.
+	done := make(chan error, 1)
+	go func() {
+		done <- mgr.Limit(ctx, slowInfo)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	start := time.Now()
+	require.Nil(t, mgr.Limit(ctx, fastInfo))
+	require.Less(t, time.Since(start), time.Second)
+	require.Nil(t, <-done)
+}
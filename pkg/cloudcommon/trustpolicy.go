@@ -0,0 +1,155 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcommon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+)
+
+// LookupHostFunc resolves a host name to its IP addresses.
+type LookupHostFunc func(ctx context.Context, host string) ([]string, error)
+
+// TrustPolicyFqdnResolver resolves the remote FQDNs of trust policy
+// security rules to CIDRs that can be applied by the platforms.
+// The last successful resolution of each FQDN is kept, so that a
+// transient DNS failure does not remove the rules for the FQDN.
+type TrustPolicyFqdnResolver struct {
+	lookup   LookupHostFunc
+	mux      sync.Mutex
+	resolved map[string][]string
+}
+
+// TrustPolicyFqdns is the resolver used by the CRM and platforms.
+var TrustPolicyFqdns = NewTrustPolicyFqdnResolver(net.DefaultResolver.LookupHost)
+
+func NewTrustPolicyFqdnResolver(lookup LookupHostFunc) *TrustPolicyFqdnResolver {
+	return &TrustPolicyFqdnResolver{
+		lookup:   lookup,
+		resolved: make(map[string][]string),
+	}
+}
+
+// HasRemoteFqdns returns true if any of the rules specify a remote FQDN.
+func HasRemoteFqdns(rules []edgeproto.SecurityRule) bool {
+	for _, r := range rules {
+		if r.RemoteFqdn != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveTrustPolicy returns a copy of the trust policy where each
+// outbound rule with a remote FQDN is replaced by a rule for each of
+// the FQDN's addresses. FQDNs that have already been resolved are not
+// looked up again, use Refresh to update them.
+func (s *TrustPolicyFqdnResolver) ResolveTrustPolicy(ctx context.Context, policy *edgeproto.TrustPolicy) (*edgeproto.TrustPolicy, error) {
+	resolved := policy.Clone()
+	if !HasRemoteFqdns(policy.OutboundSecurityRules) {
+		return resolved, nil
+	}
+	rules := []edgeproto.SecurityRule{}
+	for _, r := range policy.OutboundSecurityRules {
+		if r.RemoteFqdn == "" {
+			rules = append(rules, r)
+			continue
+		}
+		addrs, _, err := s.resolve(ctx, r.RemoteFqdn, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			rule := r
+			rule.RemoteFqdn = ""
+			rule.RemoteCidr = addr
+			rules = append(rules, rule)
+		}
+	}
+	resolved.OutboundSecurityRules = rules
+	log.SpanLog(ctx, log.DebugLevelInfra, "resolved trust policy fqdns", "policy", policy.Key, "rules", rules)
+	return resolved, nil
+}
+
+// Refresh looks up the remote FQDNs of the trust policy again, and
+// returns true if the addresses of any of them changed.
+func (s *TrustPolicyFqdnResolver) Refresh(ctx context.Context, policy *edgeproto.TrustPolicy) (bool, error) {
+	changed := false
+	var lastErr error
+	for _, r := range policy.OutboundSecurityRules {
+		if r.RemoteFqdn == "" {
+			continue
+		}
+		_, updated, err := s.resolve(ctx, r.RemoteFqdn, true)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if updated {
+			changed = true
+		}
+	}
+	return changed, lastErr
+}
+
+// resolve gets the addresses of the FQDN as CIDRs. If refresh is
+// false the previous resolution is used if present.
+func (s *TrustPolicyFqdnResolver) resolve(ctx context.Context, fqdn string, refresh bool) ([]string, bool, error) {
+	s.mux.Lock()
+	prev, found := s.resolved[fqdn]
+	s.mux.Unlock()
+	if found && !refresh {
+		return prev, false, nil
+	}
+
+	addrs, err := s.lookup(ctx, fqdn)
+	if err == nil && len(addrs) == 0 {
+		err = fmt.Errorf("no addresses found")
+	}
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "failed to resolve trust policy fqdn", "fqdn", fqdn, "prev", prev, "err", err)
+		if found {
+			// keep using the previous addresses
+			return prev, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to resolve trust policy remote FQDN %s, %s", fqdn, err)
+	}
+	cidrs := []string{}
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			continue
+		}
+		if ip.To4() != nil {
+			cidrs = append(cidrs, ip.String()+"/32")
+		} else {
+			cidrs = append(cidrs, ip.String()+"/128")
+		}
+	}
+	sort.Strings(cidrs)
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	changed := !slices.Equal(s.resolved[fqdn], cidrs)
+	s.resolved[fqdn] = cidrs
+	return cidrs, changed, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcommon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustPolicyFqdnResolver(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfra)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	hosts := map[string][]string{
+		"api.example.com": {"10.1.1.2", "10.1.1.1"},
+		"db.example.com":  {"2001:db8::1"},
+	}
+	lookups := 0
+	lookup := func(ctx context.Context, host string) ([]string, error) {
+		lookups++
+		addrs, ok := hosts[host]
+		if !ok {
+			return nil, fmt.Errorf("no such host")
+		}
+		return addrs, nil
+	}
+	resolver := NewTrustPolicyFqdnResolver(lookup)
+
+	policy := &edgeproto.TrustPolicy{
+		Key: edgeproto.PolicyKey{
+			Name:         "policy",
+			Organization: "operorg",
+		},
+		OutboundSecurityRules: []edgeproto.SecurityRule{{
+			Protocol:     "TCP",
+			PortRangeMin: 443,
+			PortRangeMax: 443,
+			RemoteFqdn:   "api.example.com",
+		}, {
+			Protocol:     "UDP",
+			PortRangeMin: 53,
+			PortRangeMax: 53,
+			RemoteCidr:   "8.8.8.8/32",
+		}, {
+			Protocol:     "TCP",
+			PortRangeMin: 5432,
+			PortRangeMax: 5432,
+			RemoteFqdn:   "db.example.com",
+		}},
+	}
+	expRules := func(apiCidrs ...string) []edgeproto.SecurityRule {
+		rules := []edgeproto.SecurityRule{}
+		for _, cidr := range apiCidrs {
+			rules = append(rules, edgeproto.SecurityRule{
+				Protocol:     "TCP",
+				PortRangeMin: 443,
+				PortRangeMax: 443,
+				RemoteCidr:   cidr,
+			})
+		}
+		rules = append(rules, policy.OutboundSecurityRules[1])
+		rules = append(rules, edgeproto.SecurityRule{
+			Protocol:     "TCP",
+			PortRangeMin: 5432,
+			PortRangeMax: 5432,
+			RemoteCidr:   "2001:db8::1/128",
+		})
+		return rules
+	}
+
+	resolved, err := resolver.ResolveTrustPolicy(ctx, policy)
+	require.Nil(t, err)
+	require.Equal(t, expRules("10.1.1.1/32", "10.1.1.2/32"), resolved.OutboundSecurityRules)
+	require.Equal(t, "api.example.com", policy.OutboundSecurityRules[0].RemoteFqdn, "original policy is unchanged")
+	require.Equal(t, 2, lookups)
+
+	// previous resolutions are reused
+	_, err = resolver.ResolveTrustPolicy(ctx, policy)
+	require.Nil(t, err)
+	require.Equal(t, 2, lookups)
+
+	// refresh with no changes
+	changed, err := resolver.Refresh(ctx, policy)
+	require.Nil(t, err)
+	require.False(t, changed)
+	require.Equal(t, 4, lookups)
+
+	// refresh with changes
+	hosts["api.example.com"] = []string{"10.1.1.3"}
+	changed, err = resolver.Refresh(ctx, policy)
+	require.Nil(t, err)
+	require.True(t, changed)
+	resolved, err = resolver.ResolveTrustPolicy(ctx, policy)
+	require.Nil(t, err)
+	require.Equal(t, expRules("10.1.1.3/32"), resolved.OutboundSecurityRules)
+
+	// lookup failures keep the previous addresses
+	delete(hosts, "api.example.com")
+	changed, err = resolver.Refresh(ctx, policy)
+	require.Nil(t, err)
+	require.False(t, changed)
+	resolved, err = resolver.ResolveTrustPolicy(ctx, policy)
+	require.Nil(t, err)
+	require.Equal(t, expRules("10.1.1.3/32"), resolved.OutboundSecurityRules)
+
+	// lookup failures without previous addresses fail
+	policy.OutboundSecurityRules[0].RemoteFqdn = "unknown.example.com"
+	_, err = resolver.ResolveTrustPolicy(ctx, policy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "failed to resolve trust policy remote FQDN unknown.example.com")
+	_, err = resolver.Refresh(ctx, policy)
+	require.NotNil(t, err)
+
+	// policies without FQDNs are not changed
+	policy.OutboundSecurityRules = policy.OutboundSecurityRules[1:2]
+	resolved, err = resolver.ResolveTrustPolicy(ctx, policy)
+	require.Nil(t, err)
+	require.Equal(t, policy, resolved)
+}
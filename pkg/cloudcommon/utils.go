@@ -24,30 +24,99 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/kballard/go-shellquote"
 )
 
 type SessionInfo struct {
 	Token string
+	// Err is set if the EdgeTurn server rejected the session
+	Err string `json:",omitempty"`
 }
 
 type ExecReqInfo struct {
-	Type    ExecReqType
-	InitURL *url.URL
-	Cookies []*http.Cookie
+	Type        ExecReqType
+	InitURL     *url.URL
+	Cookies     []*http.Cookie
+	PortForward *PortForwardInfo `json:",omitempty"`
+	// CopyFile is set for copy file sessions. For downloads,
+	// FileSize is the size of the file that will be sent.
+	CopyFile *edgeproto.CopyFile `json:",omitempty"`
 }
 
+// PortForwardInfo describes a port forward session for the
+// EdgeTurn server to enforce session limits and audit.
+type PortForwardInfo struct {
+	AppInstKey edgeproto.AppInstKey
+	Port       int32
+	User       string
+	TTL        time.Duration
+}
+
+// CallerUser is the grpc metadata key set by MC with the name of
+// the user it authenticated. It is only trusted from connections
+// with a globally issued client cert. It must be lower-case.
+const CallerUser = "caller-user"
+
 type ExecReqType int
 
 const (
-	ExecReqConsole ExecReqType = 0
-	ExecReqShell   ExecReqType = 1
+	ExecReqConsole     ExecReqType = 0
+	ExecReqShell       ExecReqType = 1
+	ExecReqCopyFile    ExecReqType = 2
+	ExecReqPortForward ExecReqType = 3
 )
 
+// ExecCopyFileMaxSize is the maximum size of a file that can be
+// uploaded to or downloaded from a container via the ExecApi.
+const ExecCopyFileMaxSize int64 = 10 * 1024 * 1024
+
+// GetCopyFileCommand returns the arguments to run in the container
+// to upload or download a file. Upload reads the file contents from
+// stdin. Download writes the file size on the first line of stdout,
+// followed by the file contents, unless the file exceeds the max size.
+// The path is passed as a positional argument to avoid shell
+// interpretation.
+func GetCopyFileCommand(copyFile *edgeproto.CopyFile) []string {
+	if copyFile.Upload {
+		return []string{"sh", "-c", `cat > "$1"`, "sh", copyFile.Path}
+	}
+	script := `size=$(wc -c < "$1") || exit 1; echo $size; if [ $size -le $2 ]; then exec head -c $size "$1"; fi`
+	return []string{"sh", "-c", script, "sh", copyFile.Path, strconv.FormatInt(ExecCopyFileMaxSize, 10)}
+}
+
+// PortForwardRelayScript relays stdin/stdout to the TCP address
+// passed as arguments. It is used to connect port forward streams
+// to AppInst ports that are only reachable from the cluster.
+const PortForwardRelayScript = `import os, socket, sys, threading
+s = socket.create_connection((sys.argv[1], int(sys.argv[2])))
+def up():
+    while True:
+        d = os.read(0, 65536)
+        if not d:
+            break
+        s.sendall(d)
+    s.shutdown(socket.SHUT_WR)
+threading.Thread(target=up, daemon=True).start()
+while True:
+    d = s.recv(65536)
+    if not d:
+        break
+    os.write(1, d)
+`
+
+// GetPortForwardCommand returns the command to relay a port forward
+// stream to the given host and port. The host must not be user input.
+func GetPortForwardCommand(host string, port int32) string {
+	return shellquote.Join("python3", "-c", PortForwardRelayScript, host, strconv.Itoa(int(port)))
+}
+
 func GetFileNameWithExt(fileUrlPath string) (string, error) {
 	log.DebugLog(log.DebugLevelInfra, "get file name with extension from url", "file-url", fileUrlPath)
 	fileUrl, err := url.Parse(fileUrlPath)
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/alerts"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
@@ -63,6 +64,9 @@ func (a *AlertPolicyApi) CreateAlertPolicy(ctx context.Context, in *edgeproto.Al
 		return &edgeproto.Result{},
 			fmt.Errorf("Invalid severity. Valid severities: %s", cloudcommon.GetValidAlertSeverityString())
 	}
+	if err := alerts.ValidateAlertPolicyConditions(in.Conditions); err != nil {
+		return &edgeproto.Result{}, err
+	}
 
 	err = a.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
 		if a.store.STMGet(stm, &in.Key, nil) {
@@ -148,6 +152,9 @@ func (a *AlertPolicyApi) UpdateAlertPolicy(ctx context.Context, in *edgeproto.Al
 		if !cloudcommon.IsAlertSeverityValid(cur.Severity) {
 			return fmt.Errorf("Invalid severity. Valid severities: %s", cloudcommon.GetValidAlertSeverityString())
 		}
+		if err := alerts.ValidateAlertPolicyConditions(cur.Conditions); err != nil {
+			return err
+		}
 		a.store.STMPut(stm, &cur)
 		return nil
 	})
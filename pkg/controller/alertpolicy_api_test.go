@@ -106,6 +106,32 @@ func TestAlertPolicyApi(t *testing.T) {
 	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
 	require.NotNil(t, err, "Trigger Time cannot exceed 3 days")
 
+	// Invalid condition expression
+	userAlert = testutil.AlertPolicyData()[0]
+	userAlert.Conditions = []edgeproto.AlertPolicyCondition{{
+		Expr:      `sum(rate(http_requests_total[$window]))`,
+		Threshold: 10,
+		Window:    edgeproto.Duration(5 * time.Minute),
+	}}
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
+	require.NotNil(t, err, "Aggregation must keep the pod label")
+	require.Contains(t, err.Error(), "Invalid condition 1 expression")
+
+	// Condition window required
+	userAlert.Conditions[0].Expr = `sum(rate(http_requests_total[$window]))by(pod)`
+	userAlert.Conditions[0].Window = 0
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
+	require.NotNil(t, err, "Window must be set to use $window")
+
+	// Conditions cannot be mixed with active connections
+	userAlert = testutil.AlertPolicyData()[1]
+	userAlert.Conditions = []edgeproto.AlertPolicyCondition{{
+		Expr:      `http_requests_in_flight`,
+		Threshold: 10,
+	}}
+	_, err = apis.alertPolicyApi.CreateAlertPolicy(ctx, &userAlert)
+	require.NotNil(t, err, "Active connection alert cannot have conditions")
+
 	// Delete non-existent user alert
 	userAlert = testutil.AlertPolicyData()[0]
 	_, err = apis.alertPolicyApi.DeleteAlertPolicy(ctx, &userAlert)
@@ -23,6 +23,7 @@ import (
 	"net"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
@@ -45,6 +46,8 @@ type AppApi struct {
 	store         edgeproto.AppStore
 	cache         edgeproto.AppCache
 	globalIdStore edgeproto.AppGlobalIdStore
+	rolloutsMux   sync.Mutex
+	rollouts      map[edgeproto.AppKey]struct{}
 }
 
 func NewAppApi(sync *regiondata.Sync, all *AllApis) *AppApi {
@@ -54,6 +57,7 @@ func NewAppApi(sync *regiondata.Sync, all *AllApis) *AppApi {
 	appApi.store = edgeproto.NewAppStore(sync.GetKVStore())
 	edgeproto.InitAppCacheWithStore(&appApi.cache, appApi.store)
 	sync.RegisterCache(&appApi.cache)
+	appApi.rollouts = make(map[edgeproto.AppKey]struct{})
 	return &appApi
 }
 
@@ -123,6 +127,11 @@ func (s *AppApi) CheckAppCompatibleWithTrustPolicy(ctx context.Context, ckey *ed
 			if strings.ToLower(r.Protocol) != strings.ToLower(outboundRule.Protocol) {
 				continue
 			}
+			if outboundRule.RemoteFqdn != "" {
+				// FQDN addresses are only known to the CRM, they
+				// cannot be verified to cover the required CIDR.
+				continue
+			}
 			_, trustPolNet, err := net.ParseCIDR(outboundRule.RemoteCidr)
 			if err != nil {
 				return fmt.Errorf("Invalid remote CIDR in policy: %s - %v", outboundRule.RemoteCidr, err)
@@ -222,6 +231,56 @@ func (s *AppApi) AndroidPackageConflicts(a *edgeproto.App) bool {
 	return false
 }
 
+func validateFindCloudletRanking(app *edgeproto.App) error {
+	weights := app.FindCloudletRankingWeights
+	if app.FindCloudletRanking != edgeproto.FindCloudletRanking_RANK_BY_WEIGHTED {
+		if weights != nil {
+			return fmt.Errorf("FindCloudletRankingWeights can only be specified for FindCloudletRanking Weighted")
+		}
+		return nil
+	}
+	if weights == nil {
+		return fmt.Errorf("FindCloudletRankingWeights must be specified for FindCloudletRanking Weighted")
+	}
+	if weights.Distance < 0 || weights.Latency < 0 || weights.Load < 0 {
+		return fmt.Errorf("FindCloudletRankingWeights cannot be negative")
+	}
+	if weights.Distance == 0 && weights.Latency == 0 && weights.Load == 0 {
+		return fmt.Errorf("At least one FindCloudletRankingWeights weight must be greater than 0")
+	}
+	return nil
+}
+
+func validateRolloutPolicy(app *edgeproto.App) error {
+	policy := app.RolloutPolicy
+	if policy == nil {
+		return nil
+	}
+	if policy.HealthTimeout < 0 {
+		return fmt.Errorf("RolloutPolicy HealthTimeout cannot be negative")
+	}
+	if policy.ObservationTime < 0 {
+		return fmt.Errorf("RolloutPolicy ObservationTime cannot be negative")
+	}
+	if policy.Strategy == edgeproto.UpdateStrategy_UPDATE_CANARY {
+		if policy.CanaryPercent < 1 || policy.CanaryPercent > 99 {
+			return fmt.Errorf("RolloutPolicy CanaryPercent must be between 1 and 99 for the canary strategy")
+		}
+	} else if policy.CanaryPercent != 0 {
+		return fmt.Errorf("RolloutPolicy CanaryPercent can only be specified for the canary strategy")
+	}
+	if policy.Strategy == edgeproto.UpdateStrategy_UPDATE_IN_PLACE {
+		return nil
+	}
+	// Docker AppInsts use the same host ports for each version so
+	// the new version cannot run alongside the old.
+	if app.Deployment != cloudcommon.DeploymentTypeKubernetes {
+		strategy := edgeproto.UpdateStrategy_CamelName[int32(policy.Strategy)]
+		return fmt.Errorf("RolloutPolicy Strategy %s is not supported for %s deployments", strategy, app.Deployment)
+	}
+	return nil
+}
+
 func validateSkipHcPorts(app *edgeproto.App) error {
 	if app.SkipHcPorts == "" {
 		return nil
@@ -381,7 +440,7 @@ func (s *AppApi) configureApp(ctx context.Context, stm concurrency.STM, in *edge
 	if !cloudcommon.IsValidDeploymentForImage(in.ImageType, in.Deployment) {
 		return fmt.Errorf("Deployment is not valid for image type")
 	}
-	if err := validateAppConfigsForDeployment(ctx, in.Configs, in.Deployment); err != nil {
+	if err := validateAppConfigsForDeployment(ctx, in.Configs, in.Deployment, in.DeploymentGenerator); err != nil {
 		return err
 	}
 	if len(in.EnvVars) > 0 || len(in.SecretEnvVars) > 0 {
@@ -452,6 +511,9 @@ func (s *AppApi) configureApp(ctx context.Context, stm concurrency.STM, in *edge
 	if in.ScaleWithCluster && in.Deployment != cloudcommon.DeploymentTypeKubernetes {
 		return fmt.Errorf("app scaling is only supported for Kubernetes deployments")
 	}
+	if in.DeploymentGenerator == deploygen.DockerComposeBasic && in.Deployment != cloudcommon.DeploymentTypeDocker {
+		return fmt.Errorf("Deployment generator %s is only supported for docker deployments", deploygen.DockerComposeBasic)
+	}
 	if in.VmAppOsType != edgeproto.VmAppOsType_VM_APP_OS_UNKNOWN && in.Deployment != cloudcommon.DeploymentTypeVM {
 		return fmt.Errorf("VM App OS Type is only supported for VM deployments")
 	}
@@ -520,6 +582,14 @@ func (s *AppApi) configureApp(ctx context.Context, stm concurrency.STM, in *edge
 		return fmt.Errorf("DaemonSet required in manifest when ScaleWithCluster set")
 	}
 
+	if in.HorizontalScalePolicy != nil {
+		if err := validateHorizontalScalePolicy(in, in.HorizontalScalePolicy); err != nil {
+			return err
+		}
+		if !manifestContainsScalableWorkload(deploymf) {
+			return errors.New("Deployment or StatefulSet required in manifest when horizontal scale policy set")
+		}
+	}
 	if in.ServerlessConfig != nil {
 		return errors.New("serverless config is deprecated, please replace with KubernetesResources")
 	}
@@ -545,11 +615,17 @@ func (s *AppApi) configureApp(ctx context.Context, stm concurrency.STM, in *edge
 	if in.QosSessionProfile == edgeproto.QosSessionProfile_QOS_NO_PRIORITY && in.QosSessionDuration > 0 {
 		return fmt.Errorf("QosSessionDuration cannot be specified without setting QosSessionProfile")
 	}
+	if err := validateFindCloudletRanking(in); err != nil {
+		return err
+	}
 
 	// Save manifest to app in case it was a remote target.
 	// Manifest is required on app delete and we'll be in trouble
 	// if remote target is unreachable or changed at that time.
 	in.DeploymentManifest = deploymf
+	if err := validateRolloutPolicy(in); err != nil {
+		return err
+	}
 
 	log.DebugLog(log.DebugLevelApi, "setting App revision", "revision", revision)
 	in.Revision = revision
@@ -760,6 +836,13 @@ func (s *AppApi) UpdateApp(ctx context.Context, in *edgeproto.App) (*edgeproto.R
 			cur.QosSessionDuration = 0
 		}
 
+		// Weights only apply to the weighted ranking strategy
+		if fmap.Has(edgeproto.AppFieldFindCloudletRanking) &&
+			in.FindCloudletRanking != edgeproto.FindCloudletRanking_RANK_BY_WEIGHTED &&
+			!fmap.HasOrHasChild(edgeproto.AppFieldFindCloudletRankingWeights) {
+			cur.FindCloudletRankingWeights = nil
+		}
+
 		cur.CopyInFields(in)
 		// for any changes that can affect trust policy, verify the app is still valid for all
 		// cloudlets onto which it is deployed.
@@ -967,7 +1050,7 @@ func (s *AppApi) RemoveAppAutoProvPolicy(ctx context.Context, in *edgeproto.AppA
 	return &edgeproto.Result{}, err
 }
 
-func validateAppConfigsForDeployment(ctx context.Context, configs []*edgeproto.ConfigFile, deployment string) error {
+func validateAppConfigsForDeployment(ctx context.Context, configs []*edgeproto.ConfigFile, deployment, generator string) error {
 	log.SpanLog(ctx, log.DebugLevelApi, "validateAppConfigsForDeployment")
 	for _, cfg := range configs {
 		invalid := false
@@ -983,7 +1066,8 @@ func validateAppConfigsForDeployment(ctx context.Context, configs []*edgeproto.C
 				return err
 			}
 		case edgeproto.AppConfigEnvYaml:
-			if deployment != cloudcommon.DeploymentTypeKubernetes {
+			// docker compose generator renders them into the environment
+			if deployment != cloudcommon.DeploymentTypeKubernetes && !(deployment == cloudcommon.DeploymentTypeDocker && generator == deploygen.DockerComposeBasic) {
 				invalid = true
 			}
 		}
@@ -1053,6 +1137,37 @@ func manifestContainsDaemonSet(manifest string) bool {
 	return false
 }
 
+// manifestContainsScalableWorkload checks if the manifest has any
+// objects that can be targeted by a horizontal pod autoscaler.
+func manifestContainsScalableWorkload(manifest string) bool {
+	objs, _, err := cloudcommon.DecodeK8SYaml(manifest)
+	if err != nil {
+		return false
+	}
+	for ii, _ := range objs {
+		switch objs[ii].(type) {
+		case *appsv1.Deployment, *appsv1.StatefulSet:
+			return true
+		}
+	}
+	return false
+}
+
+// validateHorizontalScalePolicy checks that the policy is valid and
+// can be applied to instances of the App.
+func validateHorizontalScalePolicy(app *edgeproto.App, policy *edgeproto.HorizontalScalePolicy) error {
+	if app.Deployment != cloudcommon.DeploymentTypeKubernetes {
+		return fmt.Errorf("horizontal scale policy only supported for deployment type %s", cloudcommon.DeploymentTypeKubernetes)
+	}
+	if app.ScaleWithCluster {
+		return errors.New("horizontal scale policy not supported with scale with cluster")
+	}
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("invalid horizontal scale policy, %s", err)
+	}
+	return nil
+}
+
 func (s *AppApi) AddAppAlertPolicy(ctx context.Context, in *edgeproto.AppAlertPolicy) (*edgeproto.Result, error) {
 	cur := edgeproto.App{}
 	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
@@ -17,10 +17,12 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
+	"github.com/edgexr/edge-cloud-platform/pkg/deploygen"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
@@ -323,6 +325,103 @@ func TestAppApi(t *testing.T) {
 	_, err = apis.appApi.CreateApp(ctx, &qosApp)
 	require.Nil(t, err, "Create app with proper QOS Priority Sessions config")
 
+	// Verify FindCloudlet ranking weights
+	rankApp := testutil.AppData()[15]
+	rankApp.Key.Name = "docker ranking"
+	rankApp.FindCloudletRankingWeights = &edgeproto.FindCloudletRankingWeights{
+		Latency: 1,
+	}
+	_, err = apis.appApi.CreateApp(ctx, &rankApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "FindCloudletRankingWeights can only be specified for FindCloudletRanking Weighted")
+	rankApp.FindCloudletRanking = edgeproto.FindCloudletRanking_RANK_BY_WEIGHTED
+	rankApp.FindCloudletRankingWeights.Load = -1
+	_, err = apis.appApi.CreateApp(ctx, &rankApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "FindCloudletRankingWeights cannot be negative")
+	rankApp.FindCloudletRankingWeights.Load = 10
+	_, err = apis.appApi.CreateApp(ctx, &rankApp)
+	require.Nil(t, err)
+	// changing the strategy clears the weights
+	upapp = rankApp
+	upapp.FindCloudletRanking = edgeproto.FindCloudletRanking_RANK_BY_LOAD
+	upapp.FindCloudletRankingWeights = nil
+	upapp.Fields = []string{edgeproto.AppFieldFindCloudletRanking}
+	_, err = apis.appApi.UpdateApp(ctx, &upapp)
+	require.Nil(t, err)
+	found = apis.appApi.Get(rankApp.GetKey(), &storedApp)
+	require.True(t, found)
+	require.Equal(t, edgeproto.FindCloudletRanking_RANK_BY_LOAD, storedApp.FindCloudletRanking)
+	require.Nil(t, storedApp.FindCloudletRankingWeights)
+	_, err = apis.appApi.DeleteApp(ctx, &rankApp)
+	require.Nil(t, err)
+
+	// Verify rollout policy
+	rolloutApp := testutil.AppData()[15]
+	rolloutApp.Key.Name = "docker rollout"
+	rolloutApp.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy:      edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN,
+		CanaryPercent: 10,
+	}
+	_, err = apis.appApi.CreateApp(ctx, &rolloutApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "RolloutPolicy CanaryPercent can only be specified for the canary strategy")
+	rolloutApp.RolloutPolicy.Strategy = edgeproto.UpdateStrategy_UPDATE_CANARY
+	rolloutApp.RolloutPolicy.CanaryPercent = 100
+	_, err = apis.appApi.CreateApp(ctx, &rolloutApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "RolloutPolicy CanaryPercent must be between 1 and 99")
+	rolloutApp.RolloutPolicy.CanaryPercent = 25
+	rolloutApp.RolloutPolicy.HealthTimeout = -1
+	_, err = apis.appApi.CreateApp(ctx, &rolloutApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "RolloutPolicy HealthTimeout cannot be negative")
+	rolloutApp.RolloutPolicy.HealthTimeout = edgeproto.Duration(time.Minute)
+	rolloutApp.RolloutPolicy.ObservationTime = -1
+	_, err = apis.appApi.CreateApp(ctx, &rolloutApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "RolloutPolicy ObservationTime cannot be negative")
+	rolloutApp.RolloutPolicy.ObservationTime = edgeproto.Duration(time.Minute)
+	_, err = apis.appApi.CreateApp(ctx, &rolloutApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "RolloutPolicy Strategy UpdateCanary is not supported for docker deployments")
+	rolloutApp.RolloutPolicy.Strategy = edgeproto.UpdateStrategy_UPDATE_IN_PLACE
+	rolloutApp.RolloutPolicy.CanaryPercent = 0
+	_, err = apis.appApi.CreateApp(ctx, &rolloutApp)
+	require.Nil(t, err)
+	_, err = apis.appApi.DeleteApp(ctx, &rolloutApp)
+	require.Nil(t, err)
+	var vmRolloutApp edgeproto.App
+	for _, app := range testutil.AppData() {
+		if app.Deployment == cloudcommon.DeploymentTypeVM {
+			vmRolloutApp = app
+			break
+		}
+	}
+	vmRolloutApp.Key.Name = "vm rollout"
+	vmRolloutApp.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy: edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN,
+	}
+	_, err = apis.appApi.CreateApp(ctx, &vmRolloutApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "RolloutPolicy Strategy UpdateBlueGreen is not supported for vm deployments")
+
+	// Verify docker compose generator
+	composeApp := testutil.AppData()[15]
+	composeApp.Key.Name = "docker compose"
+	composeApp.DeploymentGenerator = deploygen.DockerComposeBasic
+	_, err = apis.appApi.CreateApp(ctx, &composeApp)
+	require.Nil(t, err)
+	found = apis.appApi.Get(composeApp.GetKey(), &storedApp)
+	require.True(t, found)
+	require.Contains(t, storedApp.DeploymentManifest, "mexDeployGen: docker-compose-basic")
+	_, err = apis.appApi.DeleteApp(ctx, &composeApp)
+	require.Nil(t, err)
+	composeApp.Deployment = cloudcommon.DeploymentTypeKubernetes
+	_, err = apis.appApi.CreateApp(ctx, &composeApp)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Deployment generator docker-compose-basic is only supported for docker deployments")
+
 	// test updating app with a list of alertpolicies
 	alertPolicyApp := testutil.AppData()[1]
 	alertPolicyApp.Deployment = cloudcommon.DeploymentTypeKubernetes
@@ -522,7 +621,7 @@ func TestValidateAppConfigs(t *testing.T) {
 			Config: testValidYmlHelmCfg,
 		},
 	}
-	err := validateAppConfigsForDeployment(ctx, configs, cloudcommon.DeploymentTypeHelm)
+	err := validateAppConfigsForDeployment(ctx, configs, cloudcommon.DeploymentTypeHelm, "")
 	require.Nil(t, err)
 
 	// invalid url
@@ -532,8 +631,23 @@ func TestValidateAppConfigs(t *testing.T) {
 			Config: testInvalidUrlHelmCfg,
 		},
 	}
-	err = validateAppConfigsForDeployment(ctx, configs, cloudcommon.DeploymentTypeHelm)
+	err = validateAppConfigsForDeployment(ctx, configs, cloudcommon.DeploymentTypeHelm, "")
+	require.NotNil(t, err)
+
+	// env vars only for kubernetes, or docker compose generator
+	configs = []*edgeproto.ConfigFile{
+		&edgeproto.ConfigFile{
+			Kind:   edgeproto.AppConfigEnvYaml,
+			Config: "- name: MODE\n  value: prod\n",
+		},
+	}
+	err = validateAppConfigsForDeployment(ctx, configs, cloudcommon.DeploymentTypeKubernetes, "")
+	require.Nil(t, err)
+	err = validateAppConfigsForDeployment(ctx, configs, cloudcommon.DeploymentTypeDocker, deploygen.DockerComposeBasic)
+	require.Nil(t, err)
+	err = validateAppConfigsForDeployment(ctx, configs, cloudcommon.DeploymentTypeDocker, "")
 	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid Config Kind")
 }
 
 var testVmManifest = `#cloud-config vmManifest`
@@ -0,0 +1,564 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// A staged rollout updates the AppInsts of an App in batches, waiting
+// for each batch to become healthy before starting the next. The
+// rollout state is stored on the App so that any controller can pause,
+// resume, or abort it. The batch and progress of each AppInst are
+// stored in the App's AppInstRefs, which are not sent to the CRMs, and
+// are shown by ShowAppInst. A pause or abort takes effect once the
+// current batch is done.
+//
+// The App also records the controller running the rollout. If that
+// controller restarts or goes away, or the caller cancels the
+// RefreshAppInst call, the rollout is left in place and can be resumed
+// from its current batch or aborted by any controller.
+
+// RolloutPollInterval is how often the App's rollout state is checked
+// while a rollout is paused.
+var RolloutPollInterval = 5 * time.Second
+
+func useStagedRollout(app *edgeproto.App) bool {
+	return app.RolloutPolicy != nil && app.RolloutPolicy.BatchSize > 0
+}
+
+func rolloutInProgress(status *edgeproto.RolloutStatus) bool {
+	if status == nil {
+		return false
+	}
+	return status.State == edgeproto.RolloutState_ROLLOUT_IN_PROGRESS || status.State == edgeproto.RolloutState_ROLLOUT_PAUSED
+}
+
+// getRolloutBatches groups the AppInsts by Cloudlet or Zone, and splits
+// the groups into batches of the policy's batch size. For canary, the
+// first batch is the canary percentage of the AppInsts.
+func getRolloutBatches(insts []edgeproto.AppInst, app *edgeproto.App) [][]edgeproto.AppInstKey {
+	policy := app.RolloutPolicy
+	if policy == nil {
+		policy = &edgeproto.RolloutPolicy{}
+	}
+	groupKey := func(inst *edgeproto.AppInst) string {
+		if policy.BatchBy == edgeproto.RolloutBatchBy_BATCH_BY_ZONE {
+			return inst.ZoneKey.GetKeyString()
+		}
+		return inst.CloudletKey.GetKeyString()
+	}
+	sort.Slice(insts, func(i, j int) bool {
+		gi, gj := groupKey(&insts[i]), groupKey(&insts[j])
+		if gi != gj {
+			return gi < gj
+		}
+		return insts[i].Key.GetKeyString() < insts[j].Key.GetKeyString()
+	})
+
+	batches := [][]edgeproto.AppInstKey{}
+	if policy.Strategy == edgeproto.UpdateStrategy_UPDATE_CANARY && len(insts) > 0 {
+		num := (len(insts)*int(policy.CanaryPercent) + 99) / 100
+		if num < 1 {
+			num = 1
+		}
+		canary := []edgeproto.AppInstKey{}
+		for ii := 0; ii < num; ii++ {
+			canary = append(canary, insts[ii].Key)
+		}
+		batches = append(batches, canary)
+		insts = insts[num:]
+	}
+
+	batchSize := int(policy.BatchSize)
+	if batchSize == 0 {
+		batchSize = 1
+	}
+	var batch []edgeproto.AppInstKey
+	numGroups := 0
+	lastGroup := ""
+	for ii := range insts {
+		group := groupKey(&insts[ii])
+		if ii == 0 || group != lastGroup {
+			if numGroups == batchSize {
+				batches = append(batches, batch)
+				batch = nil
+				numGroups = 0
+			}
+			numGroups++
+			lastGroup = group
+		}
+		batch = append(batch, insts[ii].Key)
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// stagedRolloutAppInsts runs a staged rollout of the App to the
+// AppInsts.
+func (s *AppInstApi) stagedRolloutAppInsts(ctx context.Context, app *edgeproto.App, instances map[edgeproto.AppInstKey]struct{}, forceUpdate bool, cb edgeproto.AppInstApi_RefreshAppInstServer) error {
+	insts := []edgeproto.AppInst{}
+	for k := range instances {
+		inst := edgeproto.AppInst{}
+		if s.cache.Get(&k, &inst) {
+			insts = append(insts, inst)
+		}
+	}
+	batches := getRolloutBatches(insts, app)
+	if err := s.all.appApi.startRollout(ctx, &app.Key, batches, forceUpdate); err != nil {
+		return err
+	}
+	strategy := edgeproto.UpdateStrategy_CamelName[int32(app.RolloutPolicy.Strategy)]
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Updating: %d AppInsts in %d batches using %s", len(insts), len(batches), strategy)})
+	nodeMgr.Event(ctx, "App rollout started", app.Key.Organization, app.Key.GetTags(), nil, "strategy", strategy, "appinsts", strconv.Itoa(len(insts)), "batches", strconv.Itoa(len(batches)))
+	return s.runRollout(ctx, app, batches, 0, forceUpdate, cb)
+}
+
+// resumeRollout continues a rollout taken over from another
+// controller from the App's current batch. AppInsts that were
+// already updated are not updated again.
+func (s *AppInstApi) resumeRollout(app *edgeproto.App) {
+	span := log.StartSpan(log.DebugLevelApi, "resume App rollout")
+	log.SetTags(span, app.Key.GetTags())
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+
+	status := app.RolloutStatus
+	batches := make([][]edgeproto.AppInstKey, status.NumBatches)
+	refs := edgeproto.AppInstRefs{}
+	s.all.appInstRefsApi.cache.Get(&app.Key, &refs)
+	for keyStr, instStatus := range refs.RolloutInsts {
+		if instStatus.Batch == 0 || instStatus.Batch > status.NumBatches || instStatus.State == edgeproto.RolloutState_ROLLOUT_DONE {
+			continue
+		}
+		key := edgeproto.AppInstKey{}
+		edgeproto.AppInstKeyStringParse(keyStr, &key)
+		batches[instStatus.Batch-1] = append(batches[instStatus.Batch-1], key)
+	}
+	for _, batch := range batches {
+		sort.Slice(batch, func(i, j int) bool {
+			return batch[i].GetKeyString() < batch[j].GetKeyString()
+		})
+	}
+	startBatch := 0
+	if status.Batch > 0 {
+		startBatch = int(status.Batch) - 1
+	}
+	cb := &streamoutAppInst{
+		ctx:      ctx,
+		debugLvl: log.DebugLevelApi,
+	}
+	err := s.runRollout(ctx, app, batches, startBatch, status.ForceUpdate, cb)
+	log.SpanLog(ctx, log.DebugLevelApi, "resumed App rollout done", "app", app.Key, "err", err)
+}
+
+// runRollout updates the AppInsts batch by batch, starting from the
+// given batch. AppInsts in a batch are updated in parallel, and must
+// report a healthy HealthCheck state before the next batch is started,
+// otherwise the rollout is halted. AppInsts that fail to update are
+// rolled back by the platform according to the App's update strategy.
+// If ctx is cancelled the rollout is left paused.
+func (s *AppInstApi) runRollout(ctx context.Context, app *edgeproto.App, batches [][]edgeproto.AppInstKey, startBatch int, forceUpdate bool, cb edgeproto.AppInstApi_RefreshAppInstServer) (reterr error) {
+	defer s.all.appApi.rolloutStopped(&app.Key)
+
+	// rollout progress must be recorded even if ctx is cancelled
+	sctx := log.ContextWithSpan(context.Background(), log.SpanFromContext(ctx))
+	numBatches := uint32(len(batches))
+	timeout := cloudcommon.GetRolloutHealthTimeout(app)
+	start := time.Now()
+	finalState := edgeproto.RolloutState_ROLLOUT_DONE
+	curBatch := startBatch
+	defer func() {
+		if ctx.Err() != nil {
+			finalState = edgeproto.RolloutState_ROLLOUT_PAUSED
+		} else if reterr != nil && finalState == edgeproto.RolloutState_ROLLOUT_DONE {
+			finalState = edgeproto.RolloutState_ROLLOUT_FAILED
+		}
+		finalState = s.all.appApi.finishRollout(sctx, &app.Key, finalState)
+		if finalState != edgeproto.RolloutState_ROLLOUT_PAUSED {
+			// AppInsts in later batches will not be updated
+			remaining := []edgeproto.AppInstKey{}
+			for ii := curBatch + 1; ii < len(batches); ii++ {
+				remaining = append(remaining, batches[ii]...)
+			}
+			s.all.appInstRefsApi.setRolloutInsts(sctx, &app.Key, remaining, edgeproto.RolloutState_ROLLOUT_ABORTED)
+		}
+		eventName := "App rollout completed"
+		switch finalState {
+		case edgeproto.RolloutState_ROLLOUT_PAUSED:
+			eventName = "App rollout interrupted"
+		case edgeproto.RolloutState_ROLLOUT_ABORTED:
+			eventName = "App rollout aborted"
+		case edgeproto.RolloutState_ROLLOUT_FAILED:
+			eventName = "App rollout failed"
+		}
+		nodeMgr.TimedEvent(sctx, eventName, app.Key.Organization, node.EventType, app.Key.GetTags(), reterr, start, time.Now(), "batch", strconv.Itoa(curBatch+1), "batches", strconv.Itoa(len(batches)))
+	}()
+
+	type updateResult struct {
+		key     edgeproto.AppInstKey
+		updated bool
+		err     error
+	}
+	numUpdated := 0
+	numSkipped := 0
+	for ii := startBatch; ii < len(batches); ii++ {
+		batch := batches[ii]
+		curBatch = ii
+		state, err := s.all.appApi.waitRolloutBatch(ctx, &app.Key, uint32(ii+1), cb)
+		if err != nil {
+			return err
+		}
+		if state == edgeproto.RolloutState_ROLLOUT_ABORTED {
+			finalState = state
+			// this batch was not started
+			curBatch = ii - 1
+			return fmt.Errorf("Rollout aborted before batch %d of %d.  Updated: %d Skipped: %d", ii+1, numBatches, numUpdated, numSkipped)
+		}
+		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Batch %d of %d: updating %d AppInsts", ii+1, numBatches, len(batch))})
+		s.all.appInstRefsApi.setRolloutInsts(sctx, &app.Key, batch, edgeproto.RolloutState_ROLLOUT_IN_PROGRESS)
+		results := make(chan updateResult, len(batch))
+		for _, k := range batch {
+			go func(k edgeproto.AppInstKey) {
+				log.SpanLog(ctx, log.DebugLevelApi, "rollout updating AppInst", "key", k)
+				updated, err := s.refreshAppInstInternal(DefCallContext(), k, app.Key, cb, forceUpdate, false, nil)
+				results <- updateResult{key: k, updated: updated, err: err}
+			}(k)
+		}
+		updatedKeys := []edgeproto.AppInstKey{}
+		doneKeys := []edgeproto.AppInstKey{}
+		failedKeys := []edgeproto.AppInstKey{}
+		for range batch {
+			result := <-results
+			if result.err != nil {
+				failedKeys = append(failedKeys, result.key)
+				cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Failed for AppInst %s[%s]: %s", result.key.Name, result.key.Organization, result.err)})
+			} else if result.updated {
+				numUpdated++
+				updatedKeys = append(updatedKeys, result.key)
+			} else {
+				numSkipped++
+				doneKeys = append(doneKeys, result.key)
+			}
+		}
+		for _, k := range updatedKeys {
+			if err := s.waitAppInstHealthy(ctx, &k, timeout); err != nil {
+				failedKeys = append(failedKeys, k)
+				cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Failed health check for AppInst %s[%s]: %s", k.Name, k.Organization, err)})
+			} else {
+				doneKeys = append(doneKeys, k)
+			}
+		}
+		s.all.appInstRefsApi.setRolloutInsts(sctx, &app.Key, doneKeys, edgeproto.RolloutState_ROLLOUT_DONE)
+		s.all.appInstRefsApi.setRolloutInsts(sctx, &app.Key, failedKeys, edgeproto.RolloutState_ROLLOUT_FAILED)
+		if len(failedKeys) > 0 {
+			return fmt.Errorf("Rollout halted at batch %d of %d, %d AppInsts failed.  Updated: %d Skipped: %d", ii+1, numBatches, len(failedKeys), numUpdated, numSkipped)
+		}
+		nodeMgr.Event(sctx, "App rollout batch done", app.Key.Organization, app.Key.GetTags(), nil, "batch", strconv.Itoa(ii+1), "batches", strconv.Itoa(len(batches)))
+	}
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Completed: %d batches.  Updated: %d Skipped: %d Failed: 0", numBatches, numUpdated, numSkipped)})
+	return nil
+}
+
+// startRollout records the start of a rollout on the App and the
+// batch of each AppInst in the App's AppInstRefs.
+func (s *AppApi) startRollout(ctx context.Context, key *edgeproto.AppKey, batches [][]edgeproto.AppInstKey, forceUpdate bool) error {
+	if !s.reserveRollout(key) {
+		return fmt.Errorf("Rollout already in progress for App, abort it first to start a new rollout")
+	}
+	numBatches := uint32(len(batches))
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		app := edgeproto.App{}
+		if !s.store.STMGet(stm, key, &app) {
+			return key.NotFoundError()
+		}
+		if rolloutInProgress(app.RolloutStatus) {
+			return fmt.Errorf("Rollout already in progress for App, abort it first to start a new rollout")
+		}
+		app.RolloutStatus = &edgeproto.RolloutStatus{
+			State:       edgeproto.RolloutState_ROLLOUT_IN_PROGRESS,
+			NumBatches:  numBatches,
+			Controller:  *externalApiAddr,
+			ForceUpdate: forceUpdate,
+		}
+		s.store.STMPut(stm, &app)
+
+		refs := edgeproto.AppInstRefs{}
+		if !s.all.appInstRefsApi.store.STMGet(stm, key, &refs) {
+			refs.Key = *key
+			refs.Insts = make(map[string]uint32)
+			refs.DeleteRequestedInsts = make(map[string]uint32)
+		}
+		refs.RolloutInsts = make(map[string]edgeproto.RolloutStatus)
+		for ii, batch := range batches {
+			for _, k := range batch {
+				refs.RolloutInsts[k.GetKeyString()] = edgeproto.RolloutStatus{
+					State:      edgeproto.RolloutState_ROLLOUT_PENDING,
+					Batch:      uint32(ii + 1),
+					NumBatches: numBatches,
+				}
+			}
+		}
+		s.all.appInstRefsApi.store.STMPut(stm, &refs)
+		return nil
+	})
+	if err != nil {
+		s.rolloutStopped(key)
+	}
+	return err
+}
+
+// reserveRollout marks the App's rollout as running on this
+// controller. It returns false if it is already running.
+func (s *AppApi) reserveRollout(key *edgeproto.AppKey) bool {
+	s.rolloutsMux.Lock()
+	defer s.rolloutsMux.Unlock()
+	if _, found := s.rollouts[*key]; found {
+		return false
+	}
+	s.rollouts[*key] = struct{}{}
+	return true
+}
+
+func (s *AppApi) rolloutStopped(key *edgeproto.AppKey) {
+	s.rolloutsMux.Lock()
+	defer s.rolloutsMux.Unlock()
+	delete(s.rollouts, *key)
+}
+
+// claimOrphanedRollout takes over an in-progress rollout that is not
+// running on this controller, and whose controller is not this one
+// and is no longer online. It returns the App if the rollout was
+// claimed, and the caller must then call rolloutStopped once done.
+func (s *AppApi) claimOrphanedRollout(ctx context.Context, key *edgeproto.AppKey, state edgeproto.RolloutState) (*edgeproto.App, error) {
+	if !s.reserveRollout(key) {
+		return nil, nil
+	}
+	var claimed *edgeproto.App
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		claimed = nil
+		app := edgeproto.App{}
+		if !s.store.STMGet(stm, key, &app) {
+			return key.NotFoundError()
+		}
+		if !rolloutInProgress(app.RolloutStatus) {
+			return nil
+		}
+		owner := app.RolloutStatus.Controller
+		if owner != *externalApiAddr && s.all.controllerApi.cache.HasKey(&edgeproto.ControllerKey{Addr: owner}) {
+			// still running on another controller
+			return nil
+		}
+		app.RolloutStatus.State = state
+		app.RolloutStatus.Controller = *externalApiAddr
+		s.store.STMPut(stm, &app)
+		if state == edgeproto.RolloutState_ROLLOUT_ABORTED {
+			s.all.appInstRefsApi.abortRolloutInsts(stm, key)
+		}
+		claimed = &app
+		return nil
+	})
+	if err != nil || claimed == nil {
+		s.rolloutStopped(key)
+	}
+	return claimed, err
+}
+
+// pauseOrphanedRollouts pauses the rollouts that were run by this
+// controller before it restarted, so they can be resumed or aborted.
+func (s *AppApi) pauseOrphanedRollouts(ctx context.Context) {
+	keys := []edgeproto.AppKey{}
+	s.cache.Mux.Lock()
+	for key, data := range s.cache.Objs {
+		status := data.Obj.RolloutStatus
+		if status != nil && status.State == edgeproto.RolloutState_ROLLOUT_IN_PROGRESS && status.Controller == *externalApiAddr {
+			keys = append(keys, key)
+		}
+	}
+	s.cache.Mux.Unlock()
+	for _, key := range keys {
+		if _, err := s.setRolloutState(ctx, &key, edgeproto.RolloutState_ROLLOUT_PAUSED, "App rollout interrupted", edgeproto.RolloutState_ROLLOUT_IN_PROGRESS); err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to pause orphaned App rollout", "key", key, "err", err)
+		}
+	}
+}
+
+// waitRolloutBatch marks the batch as the current batch of the rollout.
+// If the rollout is paused it waits until it is resumed or aborted, or
+// ctx is done. It returns the rollout state.
+func (s *AppApi) waitRolloutBatch(ctx context.Context, key *edgeproto.AppKey, batch uint32, cb edgeproto.AppInstApi_RefreshAppInstServer) (edgeproto.RolloutState, error) {
+	pauseReported := false
+	for {
+		if err := ctx.Err(); err != nil {
+			return edgeproto.RolloutState_ROLLOUT_NONE, err
+		}
+		app := edgeproto.App{}
+		if !s.cache.Get(key, &app) {
+			return edgeproto.RolloutState_ROLLOUT_NONE, key.NotFoundError()
+		}
+		if app.RolloutStatus != nil && app.RolloutStatus.State == edgeproto.RolloutState_ROLLOUT_PAUSED {
+			if !pauseReported {
+				cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Rollout paused before batch %d of %d", batch, app.RolloutStatus.NumBatches)})
+				pauseReported = true
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(RolloutPollInterval):
+			}
+			continue
+		}
+		var state edgeproto.RolloutState
+		err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+			if !s.store.STMGet(stm, key, &app) {
+				return key.NotFoundError()
+			}
+			if app.RolloutStatus == nil {
+				app.RolloutStatus = &edgeproto.RolloutStatus{}
+			}
+			state = app.RolloutStatus.State
+			if state != edgeproto.RolloutState_ROLLOUT_IN_PROGRESS {
+				return nil
+			}
+			app.RolloutStatus.Batch = batch
+			s.store.STMPut(stm, &app)
+			return nil
+		})
+		if err != nil {
+			return state, err
+		}
+		if state == edgeproto.RolloutState_ROLLOUT_PAUSED {
+			// paused after the cache check
+			continue
+		}
+		if pauseReported && state == edgeproto.RolloutState_ROLLOUT_IN_PROGRESS {
+			cb.Send(&edgeproto.Result{Message: "Rollout resumed"})
+		}
+		return state, nil
+	}
+}
+
+// finishRollout sets the final state of the rollout and returns it.
+// A rollout that was aborted while its last batch was running stays
+// aborted.
+func (s *AppApi) finishRollout(ctx context.Context, key *edgeproto.AppKey, state edgeproto.RolloutState) edgeproto.RolloutState {
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		app := edgeproto.App{}
+		if !s.store.STMGet(stm, key, &app) {
+			return nil
+		}
+		if app.RolloutStatus == nil {
+			app.RolloutStatus = &edgeproto.RolloutStatus{}
+		}
+		if app.RolloutStatus.State == edgeproto.RolloutState_ROLLOUT_ABORTED {
+			state = edgeproto.RolloutState_ROLLOUT_ABORTED
+		}
+		app.RolloutStatus.State = state
+		s.store.STMPut(stm, &app)
+		return nil
+	})
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to set App rollout state", "key", key, "state", state, "err", err)
+	}
+	return state
+}
+
+func (s *AppApi) PauseAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	return s.setRolloutState(ctx, &in.AppKey, edgeproto.RolloutState_ROLLOUT_PAUSED, "App rollout paused", edgeproto.RolloutState_ROLLOUT_IN_PROGRESS)
+}
+
+func (s *AppApi) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	if err := in.AppKey.ValidateKey(); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	// A rollout whose controller has gone away is continued
+	// by this controller.
+	app, err := s.claimOrphanedRollout(ctx, &in.AppKey, edgeproto.RolloutState_ROLLOUT_IN_PROGRESS)
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	if app != nil {
+		nodeMgr.Event(ctx, "App rollout resumed", in.AppKey.Organization, in.AppKey.GetTags(), nil, "batch", strconv.Itoa(int(app.RolloutStatus.Batch)), "batches", strconv.Itoa(int(app.RolloutStatus.NumBatches)))
+		go s.all.appInstApi.resumeRollout(app)
+		return &edgeproto.Result{Message: "Rollout resumed by controller " + *externalApiAddr}, nil
+	}
+	return s.setRolloutState(ctx, &in.AppKey, edgeproto.RolloutState_ROLLOUT_IN_PROGRESS, "App rollout resumed", edgeproto.RolloutState_ROLLOUT_PAUSED)
+}
+
+func (s *AppApi) AbortAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	if err := in.AppKey.ValidateKey(); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	// A rollout whose controller has gone away is aborted
+	// immediately so that a new rollout can be started.
+	app, err := s.claimOrphanedRollout(ctx, &in.AppKey, edgeproto.RolloutState_ROLLOUT_ABORTED)
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	if app != nil {
+		s.rolloutStopped(&in.AppKey)
+		nodeMgr.Event(ctx, "App rollout aborted", in.AppKey.Organization, in.AppKey.GetTags(), nil, "batch", strconv.Itoa(int(app.RolloutStatus.Batch)), "batches", strconv.Itoa(int(app.RolloutStatus.NumBatches)))
+		return &edgeproto.Result{}, nil
+	}
+	return s.setRolloutState(ctx, &in.AppKey, edgeproto.RolloutState_ROLLOUT_ABORTED, "App rollout abort requested", edgeproto.RolloutState_ROLLOUT_IN_PROGRESS, edgeproto.RolloutState_ROLLOUT_PAUSED)
+}
+
+func (s *AppApi) setRolloutState(ctx context.Context, key *edgeproto.AppKey, state edgeproto.RolloutState, eventName string, fromStates ...edgeproto.RolloutState) (*edgeproto.Result, error) {
+	if err := key.ValidateKey(); err != nil {
+		return &edgeproto.Result{}, err
+	}
+	var batch, numBatches uint32
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		app := edgeproto.App{}
+		if !s.store.STMGet(stm, key, &app) {
+			return key.NotFoundError()
+		}
+		cur := edgeproto.RolloutState_ROLLOUT_NONE
+		if app.RolloutStatus != nil {
+			cur = app.RolloutStatus.State
+		}
+		allowed := false
+		for _, from := range fromStates {
+			if cur == from {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("Cannot change rollout state to %s, rollout is %s", edgeproto.RolloutState_CamelName[int32(state)], edgeproto.RolloutState_CamelName[int32(cur)])
+		}
+		app.RolloutStatus.State = state
+		batch = app.RolloutStatus.Batch
+		numBatches = app.RolloutStatus.NumBatches
+		s.store.STMPut(stm, &app)
+		return nil
+	})
+	if err != nil {
+		return &edgeproto.Result{}, err
+	}
+	nodeMgr.Event(ctx, eventName, key.Organization, key.GetTags(), nil, "batch", strconv.Itoa(int(batch)), "batches", strconv.Itoa(int(numBatches)))
+	return &edgeproto.Result{}, nil
+}
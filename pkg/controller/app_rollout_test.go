@@ -0,0 +1,274 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloutBatches(t *testing.T) {
+	insts := []edgeproto.AppInst{}
+	for ii := 0; ii < 10; ii++ {
+		inst := edgeproto.AppInst{}
+		inst.Key.Name = fmt.Sprintf("inst%d", ii)
+		inst.Key.Organization = "devorg"
+		// two AppInsts per cloudlet, five cloudlets in two zones
+		inst.CloudletKey.Name = fmt.Sprintf("cloudlet%d", ii/2)
+		inst.CloudletKey.Organization = "operorg"
+		inst.ZoneKey.Name = fmt.Sprintf("zone%d", ii%2)
+		inst.ZoneKey.Organization = "operorg"
+		insts = append(insts, inst)
+	}
+	batchLens := func(batches [][]edgeproto.AppInstKey) []int {
+		lens := []int{}
+		for _, b := range batches {
+			lens = append(lens, len(b))
+		}
+		return lens
+	}
+
+	// one cloudlet per batch by default
+	app := &edgeproto.App{
+		RolloutPolicy: &edgeproto.RolloutPolicy{
+			Strategy: edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN,
+		},
+	}
+	batches := getRolloutBatches(insts, app)
+	require.Equal(t, []int{2, 2, 2, 2, 2}, batchLens(batches))
+	require.Equal(t, "inst0", batches[0][0].Name)
+	require.Equal(t, "inst1", batches[0][1].Name)
+
+	app.RolloutPolicy.BatchSize = 2
+	batches = getRolloutBatches(insts, app)
+	require.Equal(t, []int{4, 4, 2}, batchLens(batches))
+
+	app.RolloutPolicy.BatchBy = edgeproto.RolloutBatchBy_BATCH_BY_ZONE
+	app.RolloutPolicy.BatchSize = 1
+	batches = getRolloutBatches(insts, app)
+	require.Equal(t, []int{5, 5}, batchLens(batches))
+	for _, key := range batches[0] {
+		require.Contains(t, []string{"inst0", "inst2", "inst4", "inst6", "inst8"}, key.Name)
+	}
+
+	// canary batch first, then the rest by cloudlet
+	app.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy:      edgeproto.UpdateStrategy_UPDATE_CANARY,
+		CanaryPercent: 25,
+		BatchSize:     2,
+	}
+	batches = getRolloutBatches(insts, app)
+	require.Equal(t, []int{3, 3, 4}, batchLens(batches))
+	batches = getRolloutBatches(insts[:1], app)
+	require.Equal(t, []int{1}, batchLens(batches))
+	batches = getRolloutBatches(nil, app)
+	require.Equal(t, 0, len(batches))
+}
+
+func TestAppRolloutApi(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	testSvcs := testinit(ctx, t)
+	defer testfinish(testSvcs)
+
+	dummy := regiondata.InMemoryStore{}
+	dummy.Start()
+
+	sync := regiondata.InitSync(&dummy)
+	apis := NewAllApis(sync)
+	sync.Start()
+	defer sync.Done()
+
+	defer func(interval time.Duration) {
+		RolloutPollInterval = interval
+	}(RolloutPollInterval)
+	RolloutPollInterval = 5 * time.Millisecond
+
+	testutil.InternalFlavorCreate(t, apis.flavorApi, testutil.FlavorData())
+	app := testutil.AppData()[0]
+	testutil.InternalAppCreate(t, apis.appApi, []edgeproto.App{app})
+	req := &edgeproto.AppRolloutRequest{AppKey: app.Key}
+
+	keys := []edgeproto.AppInstKey{}
+	batches := [][]edgeproto.AppInstKey{}
+	for ii := 0; ii < 3; ii++ {
+		key := edgeproto.AppInstKey{
+			Name:         fmt.Sprintf("rolloutinst%d", ii),
+			Organization: app.Key.Organization,
+		}
+		keys = append(keys, key)
+		batches = append(batches, []edgeproto.AppInstKey{key})
+	}
+
+	getStatus := func() edgeproto.RolloutStatus {
+		cur := edgeproto.App{}
+		require.True(t, apis.appApi.cache.Get(&app.Key, &cur))
+		require.NotNil(t, cur.RolloutStatus)
+		return *cur.RolloutStatus
+	}
+	getInstStatus := func(key edgeproto.AppInstKey) edgeproto.RolloutStatus {
+		refs := edgeproto.AppInstRefs{}
+		require.True(t, apis.appInstRefsApi.cache.Get(&app.Key, &refs))
+		status, found := refs.RolloutInsts[key.GetKeyString()]
+		require.True(t, found)
+		return status
+	}
+
+	// no rollout
+	_, err := apis.appApi.PauseAppRollout(ctx, req)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Cannot change rollout state to RolloutPaused, rollout is RolloutNone")
+
+	err = apis.appApi.startRollout(ctx, &app.Key, batches, false)
+	require.Nil(t, err)
+	err = apis.appApi.startRollout(ctx, &app.Key, batches, false)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Rollout already in progress")
+	require.Equal(t, *externalApiAddr, getStatus().Controller)
+	for ii, key := range keys {
+		status := getInstStatus(key)
+		require.Equal(t, edgeproto.RolloutState_ROLLOUT_PENDING, status.State)
+		require.Equal(t, uint32(ii+1), status.Batch)
+		require.Equal(t, uint32(3), status.NumBatches)
+	}
+
+	cb := testutil.NewCudStreamoutAppInst(ctx)
+	state, err := apis.appApi.waitRolloutBatch(ctx, &app.Key, 1, cb)
+	require.Nil(t, err)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_IN_PROGRESS, state)
+	require.Equal(t, uint32(1), getStatus().Batch)
+
+	// paused rollout waits for resume
+	_, err = apis.appApi.ResumeAppRollout(ctx, req)
+	require.NotNil(t, err)
+	_, err = apis.appApi.PauseAppRollout(ctx, req)
+	require.Nil(t, err)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_PAUSED, getStatus().State)
+	done := make(chan edgeproto.RolloutState)
+	go func() {
+		state, err := apis.appApi.waitRolloutBatch(ctx, &app.Key, 2, cb)
+		require.Nil(t, err)
+		done <- state
+	}()
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, uint32(1), getStatus().Batch)
+	_, err = apis.appApi.ResumeAppRollout(ctx, req)
+	require.Nil(t, err)
+	select {
+	case state = <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for resume")
+	}
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_IN_PROGRESS, state)
+	require.Equal(t, uint32(2), getStatus().Batch)
+
+	// paused rollout stops waiting if cancelled
+	_, err = apis.appApi.PauseAppRollout(ctx, req)
+	require.Nil(t, err)
+	cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	_, err = apis.appApi.waitRolloutBatch(cctx, &app.Key, 3, cb)
+	cancel()
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Equal(t, uint32(2), getStatus().Batch)
+	_, err = apis.appApi.ResumeAppRollout(ctx, req)
+	require.Nil(t, err)
+
+	// abort
+	_, err = apis.appApi.AbortAppRollout(ctx, req)
+	require.Nil(t, err)
+	state, err = apis.appApi.waitRolloutBatch(ctx, &app.Key, 3, cb)
+	require.Nil(t, err)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_ABORTED, state)
+	require.Equal(t, uint32(2), getStatus().Batch)
+	state = apis.appApi.finishRollout(ctx, &app.Key, edgeproto.RolloutState_ROLLOUT_DONE)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_ABORTED, state)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_ABORTED, getStatus().State)
+	apis.appApi.rolloutStopped(&app.Key)
+	_, err = apis.appApi.AbortAppRollout(ctx, req)
+	require.NotNil(t, err)
+
+	// a new rollout can start after the last one is done
+	err = apis.appApi.startRollout(ctx, &app.Key, batches, true)
+	require.Nil(t, err)
+	require.True(t, getStatus().ForceUpdate)
+	apis.appApi.finishRollout(ctx, &app.Key, edgeproto.RolloutState_ROLLOUT_DONE)
+	apis.appApi.rolloutStopped(&app.Key)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_DONE, getStatus().State)
+
+	// rollout interrupted by a controller restart is paused on
+	// startup and can be aborted
+	err = apis.appApi.startRollout(ctx, &app.Key, batches, false)
+	require.Nil(t, err)
+	apis.appApi.rolloutStopped(&app.Key)
+	apis.appApi.pauseOrphanedRollouts(ctx)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_PAUSED, getStatus().State)
+	_, err = apis.appApi.AbortAppRollout(ctx, req)
+	require.Nil(t, err)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_ABORTED, getStatus().State)
+	for _, key := range keys {
+		require.Equal(t, edgeproto.RolloutState_ROLLOUT_ABORTED, getInstStatus(key).State)
+	}
+
+	// rollout interrupted by a controller restart is resumed from
+	// the current batch
+	err = apis.appApi.startRollout(ctx, &app.Key, batches, false)
+	require.Nil(t, err)
+	state, err = apis.appApi.waitRolloutBatch(ctx, &app.Key, 2, cb)
+	require.Nil(t, err)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_IN_PROGRESS, state)
+	apis.appInstRefsApi.setRolloutInsts(ctx, &app.Key, keys[:1], edgeproto.RolloutState_ROLLOUT_DONE)
+	apis.appApi.rolloutStopped(&app.Key)
+	res, err := apis.appApi.ResumeAppRollout(ctx, req)
+	require.Nil(t, err)
+	require.Contains(t, res.Message, "Rollout resumed by controller")
+	// the AppInsts do not exist so the second batch fails
+	require.Eventually(t, func() bool {
+		return getStatus().State == edgeproto.RolloutState_ROLLOUT_FAILED
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_DONE, getInstStatus(keys[0]).State)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_FAILED, getInstStatus(keys[1]).State)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_ABORTED, getInstStatus(keys[2]).State)
+
+	// rollout progress is shown by ShowAppInst
+	inst := edgeproto.AppInst{
+		Key:    keys[0],
+		AppKey: app.Key,
+	}
+	apis.appInstApi.cache.Update(ctx, &inst, 0)
+	show := testutil.ShowAppInst{}
+	show.Init()
+	show.Ctx = ctx
+	err = apis.appInstApi.ShowAppInst(&edgeproto.AppInst{Key: keys[0]}, &show)
+	require.Nil(t, err)
+	shown, found := show.Data[keys[0].GetKeyString()]
+	require.True(t, found)
+	require.NotNil(t, shown.RolloutStatus)
+	require.Equal(t, edgeproto.RolloutState_ROLLOUT_DONE, shown.RolloutStatus.State)
+	require.Equal(t, uint32(1), shown.RolloutStatus.Batch)
+	cached := edgeproto.AppInst{}
+	require.True(t, apis.appInstApi.cache.Get(&keys[0], &cached))
+	require.Nil(t, cached.RolloutStatus)
+}
@@ -461,6 +461,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	var err error
 	ctx := inCb.Context()
 	cctx.SetOverride(&in.CrmOverride)
+	cctx.SetDryRun(&in.DryRun)
 
 	// If the ClusterKey is left blank and a cluster is required,
 	// then a cluster will automatically be chosen or created.
@@ -480,6 +481,9 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	appInstKey := in.Key
 	// create stream once AppInstKey is formed correctly
 	streamCb, cb := s.all.streamObjApi.newStream(ctx, cctx, appInstKey.StreamKey(), inCb)
+	defer func() {
+		sendDryRunFailure(cctx, cb, reterr)
+	}()
 
 	if err := in.Key.ValidateKey(); err != nil {
 		return err
@@ -507,7 +511,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	in.CompatibilityVersion = cloudcommon.GetAppInstCompatibilityVersion()
 
 	defer func() {
-		if reterr != nil {
+		if reterr != nil || cctx.DryRun {
 			return
 		}
 		s.RecordAppInstEvent(ctx, in, cloudcommon.CREATED, cloudcommon.InstanceUp)
@@ -536,6 +540,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 		app = edgeproto.App{}
 		scaleSpec = nil
 		sendMsgs = nil
+		cctx.Placement.reset()
 
 		// lookup App so we can get flavor for reservable ClusterInst
 		if !s.all.appApi.store.STMGet(stm, &in.AppKey, &app) {
@@ -585,6 +590,15 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 				in.KubernetesResources.MinKubernetesVersion = app.KubernetesResources.MinKubernetesVersion
 			}
 		}
+		// if no scale policy specified, inherit from app
+		if in.HorizontalScalePolicy == nil && app.HorizontalScalePolicy != nil {
+			in.HorizontalScalePolicy = app.HorizontalScalePolicy.Clone()
+		}
+		if in.HorizontalScalePolicy != nil {
+			if err := validateHorizontalScalePolicy(&app, in.HorizontalScalePolicy); err != nil {
+				return err
+			}
+		}
 		sidecarApp = cloudcommon.IsSideCarApp(&app)
 		if sidecarApp && (in.ClusterKey.Name == "" || in.ClusterKey.Organization == "") {
 			return fmt.Errorf("Sidecar AppInst (AutoDelete App) must specify the Cluster name and organization to deploy to")
@@ -660,6 +674,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 				}
 				if err != nil {
 					log.SpanLog(ctx, log.DebugLevelApi, "failed to use potential cluster, will try another", "cluster", pc.existingCluster, "cloudlet", pc.cloudletKey, "err", err)
+					cctx.Placement.skip(&pc.cloudletKey, &pc.existingCluster, ClusterUnavailable, err)
 					continue
 				}
 				if pc.scaleSpec != nil {
@@ -676,6 +691,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 					}
 					if err != nil {
 						log.SpanLog(ctx, log.DebugLevelApi, "failed to confirm potential cluster resources, will try another", "cluster", pc.existingCluster, "cloudlet", pc.cloudletKey, "err", err)
+						cctx.Placement.skip(&pc.cloudletKey, &pc.existingCluster, ClusterNoResources, err)
 						continue
 					}
 				}
@@ -693,6 +709,7 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 					autoClusterType = NoAutoCluster
 				}
 				sendMsgs = append(sendMsgs, msg)
+				cctx.Placement.choose(&pc.cloudletKey, &pc.existingCluster, msg)
 				in.ClusterKey = pc.existingCluster
 				in.CloudletKey = pc.cloudletKey
 				in.EnableIpv6 = clusterInst.EnableIpv6
@@ -733,21 +750,25 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 					autoCi, err := s.buildAutocluster(ctx, ciKey, pc.cloudlet.Key, pc.features, &app, in)
 					if err != nil {
 						log.SpanLog(ctx, log.DebugLevelApi, "failed to build auto cluster for potential cloudlet check, skipping", "err", err)
+						cctx.Placement.skip(&pc.cloudlet.Key, &edgeproto.ClusterKey{}, SiteUnavailable, err)
 						continue
 					}
 					_, err = pc.resCalc.CloudletFitsCluster(ctx, autoCi, nil)
 					if err != nil {
 						log.SpanLog(ctx, log.DebugLevelApi, "skip potential cloudlet for reservable clusterinst", "cloudlet", pc.cloudlet.Key, "err", err)
+						cctx.Placement.skip(&pc.cloudlet.Key, &edgeproto.ClusterKey{}, SiteNoResources, err)
 						continue
 					}
 				} else {
 					_, err := pc.resCalc.CloudletFitsVMApp(ctx, &app, in)
 					if err != nil {
 						log.SpanLog(ctx, log.DebugLevelApi, "skip potential cloudlet for VMApp", "cloudlet", pc.cloudlet.Key, "err", err)
+						cctx.Placement.skip(&pc.cloudlet.Key, &edgeproto.ClusterKey{}, SiteNoResources, err)
 						continue
 					}
 				}
 				found = true
+				cctx.Placement.choose(&pc.cloudlet.Key, &edgeproto.ClusterKey{}, "cloudlet has enough resources for deployment")
 				in.CloudletKey = pc.cloudlet.Key
 				log.SpanLog(ctx, log.DebugLevelApi, "chose cloudlet for deployment", "appinst", in.Key, "cloudlet", in.CloudletKey)
 				break
@@ -902,6 +923,10 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 				}
 			}
 		}
+		if cctx.DryRun {
+			// all checks passed, abort the transaction
+			return errDryRun
+		}
 		// Set new state to show autocluster clusterinst progress as part of
 		// appinst progress
 		in.State = edgeproto.TrackedState_CREATING_DEPENDENCIES
@@ -920,6 +945,16 @@ func (s *AppInstApi) createAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 	for _, msg := range sendMsgs {
 		cb.Send(&edgeproto.Result{Message: msg})
 	}
+	if err == errDryRun {
+		msg := fmt.Sprintf("Dry run passed, AppInst would be deployed to cloudlet %s", in.CloudletKey.Name)
+		if createCluster {
+			msg += fmt.Sprintf(" on new auto-cluster %s", in.ClusterKey.Name)
+		} else if in.ClusterKey.Name != "" {
+			msg += fmt.Sprintf(" on cluster %s", in.ClusterKey.Name)
+		}
+		cb.Send(cctx.Placement.getResult(msg))
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -1616,6 +1651,16 @@ func (s *AppInstApi) RefreshAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstA
 	}
 
 	if !singleAppInst {
+		app := edgeproto.App{}
+		if !s.all.appApi.cache.Get(&appKey, &app) {
+			return appKey.NotFoundError()
+		}
+		if useStagedRollout(&app) {
+			return s.stagedRolloutAppInsts(ctx, &app, instances, in.ForceUpdate, cb)
+		}
+		if cloudcommon.GetUpdateStrategy(&app) != edgeproto.UpdateStrategy_UPDATE_IN_PLACE {
+			return s.rolloutAppInsts(ctx, &app, instances, in.ForceUpdate, cb)
+		}
 		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Updating: %d AppInsts", len(instances))})
 	}
 
@@ -1671,6 +1716,135 @@ func (s *AppInstApi) RefreshAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstA
 	return nil
 }
 
+// RolloutHealthCheckInterval is how often AppInst health is checked
+// while waiting on a rollout wave.
+var RolloutHealthCheckInterval = 5 * time.Second
+
+// RolloutHealthySettleTime is how long an updated AppInst must report
+// a healthy state before the rollout moves on to the next wave.
+var RolloutHealthySettleTime = 30 * time.Second
+
+// getRolloutWaves splits the AppInsts into the waves that are updated
+// together. Canary updates a percentage of the AppInsts first, then the
+// rest. Blue/green updates one AppInst at a time.
+func getRolloutWaves(keys []edgeproto.AppInstKey, app *edgeproto.App) [][]edgeproto.AppInstKey {
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].GetKeyString() < keys[j].GetKeyString()
+	})
+	waves := [][]edgeproto.AppInstKey{}
+	switch cloudcommon.GetUpdateStrategy(app) {
+	case edgeproto.UpdateStrategy_UPDATE_CANARY:
+		num := (len(keys)*int(app.RolloutPolicy.CanaryPercent) + 99) / 100
+		if num < 1 {
+			num = 1
+		}
+		waves = append(waves, keys[:num])
+		if num < len(keys) {
+			waves = append(waves, keys[num:])
+		}
+	case edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN:
+		for ii := range keys {
+			waves = append(waves, keys[ii:ii+1])
+		}
+	default:
+		waves = append(waves, keys)
+	}
+	return waves
+}
+
+// rolloutAppInsts updates the AppInsts in waves according to the App's
+// RolloutPolicy. Each AppInst in a wave must report a healthy
+// HealthCheck state before the next wave is started, otherwise the
+// rollout is halted. AppInsts that fail to update are rolled back by
+// the platform.
+func (s *AppInstApi) rolloutAppInsts(ctx context.Context, app *edgeproto.App, instances map[edgeproto.AppInstKey]struct{}, forceUpdate bool, cb edgeproto.AppInstApi_RefreshAppInstServer) error {
+	keys := []edgeproto.AppInstKey{}
+	for k := range instances {
+		keys = append(keys, k)
+	}
+	waves := getRolloutWaves(keys, app)
+	timeout := cloudcommon.GetRolloutHealthTimeout(app)
+	strategy := edgeproto.UpdateStrategy_CamelName[int32(app.RolloutPolicy.Strategy)]
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Updating: %d AppInsts in %d waves using %s", len(keys), len(waves), strategy)})
+
+	type updateResult struct {
+		key     edgeproto.AppInstKey
+		updated bool
+		err     error
+	}
+	numUpdated := 0
+	numSkipped := 0
+	for ii, wave := range waves {
+		cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Wave %d of %d: updating %d AppInsts", ii+1, len(waves), len(wave))})
+		results := make(chan updateResult, len(wave))
+		for _, k := range wave {
+			go func(k edgeproto.AppInstKey) {
+				log.SpanLog(ctx, log.DebugLevelApi, "rollout updating AppInst", "key", k)
+				updated, err := s.refreshAppInstInternal(DefCallContext(), k, app.Key, cb, forceUpdate, false, nil)
+				results <- updateResult{key: k, updated: updated, err: err}
+			}(k)
+		}
+		updatedKeys := []edgeproto.AppInstKey{}
+		numFailed := 0
+		for range wave {
+			result := <-results
+			if result.err != nil {
+				numFailed++
+				cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Failed for AppInst %s[%s]: %s", result.key.Name, result.key.Organization, result.err)})
+			} else if result.updated {
+				numUpdated++
+				updatedKeys = append(updatedKeys, result.key)
+			} else {
+				numSkipped++
+			}
+		}
+		for _, k := range updatedKeys {
+			if err := s.waitAppInstHealthy(ctx, &k, timeout); err != nil {
+				numFailed++
+				cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Failed health check for AppInst %s[%s]: %s", k.Name, k.Organization, err)})
+			}
+		}
+		if numFailed > 0 {
+			return fmt.Errorf("Rollout halted at wave %d of %d, %d AppInsts failed.  Updated: %d Skipped: %d", ii+1, len(waves), numFailed, numUpdated, numSkipped)
+		}
+	}
+	cb.Send(&edgeproto.Result{Message: fmt.Sprintf("Completed: %d of %d AppInsts.  Updated: %d Skipped: %d Failed: 0", len(keys), len(keys), numUpdated, numSkipped)})
+	return nil
+}
+
+// waitAppInstHealthy waits for the AppInst to report a healthy
+// HealthCheck state for the settle time.
+func (s *AppInstApi) waitAppInstHealthy(ctx context.Context, key *edgeproto.AppInstKey, timeout time.Duration) error {
+	settle := RolloutHealthySettleTime
+	if settle > timeout/2 {
+		settle = timeout / 2
+	}
+	start := time.Now()
+	var healthySince time.Time
+	for {
+		inst := edgeproto.AppInst{}
+		if !s.cache.Get(key, &inst) {
+			return key.NotFoundError()
+		}
+		now := time.Now()
+		if inst.HealthCheck == dme.HealthCheck_HEALTH_CHECK_OK {
+			if healthySince.IsZero() {
+				healthySince = now
+			}
+			if now.Sub(healthySince) >= settle {
+				return nil
+			}
+		} else {
+			healthySince = time.Time{}
+		}
+		if now.Sub(start) >= timeout {
+			return fmt.Errorf("AppInst not healthy after %s, health check is %s", timeout, dme.HealthCheck_CamelName[int32(inst.HealthCheck)])
+		}
+		log.SpanLog(ctx, log.DebugLevelApi, "rollout waiting for AppInst health", "key", key, "healthCheck", inst.HealthCheck)
+		time.Sleep(RolloutHealthCheckInterval)
+	}
+}
+
 func (s *AppInstApi) UpdateAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstApi_UpdateAppInstServer) error {
 	ctx := cb.Context()
 	err := in.ValidateUpdateFields()
@@ -1705,6 +1879,11 @@ func (s *AppInstApi) UpdateAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstAp
 	if fmap.HasOrHasChild(edgeproto.AppInstFieldFlavor) || fmap.HasOrHasChild(edgeproto.AppInstFieldKubernetesResources) || fmap.HasOrHasChild(edgeproto.AppFieldKubernetesResources) {
 		resChange = true
 	}
+	scalePolicyChange := fmap.HasOrHasChild(edgeproto.AppInstFieldHorizontalScalePolicy)
+	if scalePolicyChange {
+		// max replicas determines the reserved resources
+		resChange = true
+	}
 
 	cctx := DefCallContext()
 	cctx.SetOverride(&in.CrmOverride)
@@ -1756,6 +1935,18 @@ func (s *AppInstApi) UpdateAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstAp
 			// nothing changed
 			return nil
 		}
+		if scalePolicyChange {
+			// if the scale policy is removed, inherit from app,
+			// same as for create
+			if cur.HorizontalScalePolicy == nil && app.HorizontalScalePolicy != nil {
+				cur.HorizontalScalePolicy = app.HorizontalScalePolicy.Clone()
+			}
+			if cur.HorizontalScalePolicy != nil {
+				if err := validateHorizontalScalePolicy(&app, cur.HorizontalScalePolicy); err != nil {
+					return err
+				}
+			}
+		}
 		if resChange {
 			if !cloudcommon.IsClusterInstReqd(&app) {
 				return errors.New("cannot modify resources allocated to VM deployments")
@@ -2072,7 +2263,15 @@ func (s *AppInstApi) deleteAppInstInternal(cctx *CallContext, in *edgeproto.AppI
 }
 
 func (s *AppInstApi) ShowAppInst(in *edgeproto.AppInst, cb edgeproto.AppInstApi_ShowAppInstServer) error {
+	rolloutInsts := s.all.appInstRefsApi.getRolloutInsts()
 	err := s.cache.Show(in, func(obj *edgeproto.AppInst) error {
+		if status, found := rolloutInsts[obj.Key.GetKeyString()]; found {
+			// rollout progress is not stored on the AppInst to
+			// avoid sending updates to the CRMs
+			out := *obj
+			out.RolloutStatus = &status
+			obj = &out
+		}
 		err := cb.Send(obj)
 		return err
 	})
@@ -2443,16 +2642,23 @@ func setPortFQDNPrefix(port *edgeproto.InstPort, objs []runtime.Object) {
 	}
 }
 
-func (s *AppInstApi) sumRequestedAppInstResources(appInst *edgeproto.AppInst) resspec.ResValMap {
+func (s *AppInstApi) sumRequestedAppInstResources(ctx context.Context, appInst *edgeproto.AppInst) resspec.ResValMap {
 	// Note this calculates the resource values as requested
 	// by the user, not the actual resources deployed in the
 	// infrastructure due to flavor quantization or additional
 	// platform specific requirements like load balancers.
+	// Horizontally scaled AppInsts request resources for their
+	// maximum replicas.
 	resVals := resspec.ResValMap{}
 	if appInst.KubernetesResources != nil {
+		kr, err := cloudcommon.GetReservedKubernetesResources(appInst)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to get reserved kubernetes resources", "appInst", appInst.Key, "err", err)
+			kr = appInst.KubernetesResources
+		}
 		for _, pool := range []*edgeproto.NodePoolResources{
-			appInst.KubernetesResources.CpuPool,
-			appInst.KubernetesResources.GpuPool,
+			kr.CpuPool,
+			kr.GpuPool,
 		} {
 			resVals.AddNodePoolResources(pool)
 		}
@@ -2491,7 +2697,7 @@ func (s *AppInstApi) RecordAppInstEvent(ctx context.Context, appInst *edgeproto.
 	}
 	metric.AddStringVal(cloudcommon.MetricTagDeployment, app.Deployment)
 
-	resVals := s.sumRequestedAppInstResources(appInst)
+	resVals := s.sumRequestedAppInstResources(ctx, appInst)
 	metric.AddIntVal(cloudcommon.MetricTagRAM, resVals.GetInt(cloudcommon.ResourceRamMb))
 	metric.AddIntVal(cloudcommon.MetricTagVCPU, resVals.GetInt(cloudcommon.ResourceVcpus))
 	services.events.AddMetric(&metric)
@@ -196,6 +196,8 @@ func TestAppInstApi(t *testing.T) {
 	require.Equal(t, clusterInstCnt, len(apis.clusterInstApi.cache.Objs))
 	testutil.InternalCloudletRefsTest(t, "show", apis.cloudletRefsApi, testutil.CloudletRefsData())
 
+	testAppInstDryRun(t, ctx, apis)
+
 	testutil.InternalAppInstTest(t, "cud", apis.appInstApi, testutil.AppInstData(), testutil.WithCreatedAppInstTestData(testutil.CreatedAppInstData()))
 	InternalAppInstCachedFieldsTest(t, ctx, apis)
 	// check cluster insts created (includes explicit and auto)
@@ -1861,3 +1863,142 @@ func TestNBIUseCase(t *testing.T) {
 	_, err = deployApp("scenario5.1", appIDs[2], zoneA.ObjId)
 	require.Nil(t, err)
 }
+
+func testAppInstDryRun(t *testing.T, ctx context.Context, apis *AllApis) {
+	clusterInstCount := apis.clusterInstApi.cache.GetCount()
+	for ii, data := range testutil.AppInstData() {
+		obj := data
+		if testutil.IsAutoClusterAutoDeleteApp(&obj) {
+			continue
+		}
+		obj.DryRun = true
+		stream := NewStreamoutMsg(ctx)
+		err := apis.appInstApi.CreateAppInst(&obj, stream)
+		require.Nil(t, err, "dry run AppInst[%d]", ii)
+		require.Greater(t, len(stream.Msgs), 0)
+		res := stream.Msgs[len(stream.Msgs)-1]
+		require.Contains(t, res.Message, "Dry run passed", "AppInst[%d]", ii)
+		require.Contains(t, res.Message, obj.CloudletKey.Name)
+		chosen := 0
+		for _, pc := range res.PlacementCandidates {
+			if pc.Chosen {
+				chosen++
+				require.Equal(t, obj.CloudletKey, pc.CloudletKey)
+				require.Empty(t, pc.SkipReason)
+			}
+		}
+		require.Equal(t, 1, chosen, "AppInst[%d] %v", ii, res.PlacementCandidates)
+		// nothing should have been created
+		require.Equal(t, 0, apis.appInstApi.cache.GetCount())
+		require.Equal(t, clusterInstCount, apis.clusterInstApi.cache.GetCount())
+	}
+	testutil.InternalCloudletRefsTest(t, "show", apis.cloudletRefsApi, testutil.CloudletRefsData())
+
+	// dry run failures still report the placement decisions
+	obj := testutil.AppInstData()[0]
+	obj.DryRun = true
+	obj.ClusterKey = edgeproto.ClusterKey{}
+	obj.CloudletKey = edgeproto.CloudletKey{}
+	obj.ZoneKey = edgeproto.ZoneKey{Name: "nosuchzone", Organization: "nosuchorg"}
+	stream := NewStreamoutMsg(ctx)
+	err := apis.appInstApi.CreateAppInst(&obj, stream)
+	require.NotNil(t, err)
+	require.Equal(t, 0, apis.appInstApi.cache.GetCount())
+}
+
+func TestRolloutWaves(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	keys := []edgeproto.AppInstKey{}
+	for ii := 0; ii < 10; ii++ {
+		keys = append(keys, edgeproto.AppInstKey{
+			Name:         fmt.Sprintf("inst%d", ii),
+			Organization: "devorg",
+		})
+	}
+	app := &edgeproto.App{}
+	waves := getRolloutWaves(keys, app)
+	require.Equal(t, 1, len(waves))
+	require.Equal(t, 10, len(waves[0]))
+
+	app.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy:      edgeproto.UpdateStrategy_UPDATE_CANARY,
+		CanaryPercent: 25,
+	}
+	waves = getRolloutWaves(keys, app)
+	require.Equal(t, 2, len(waves))
+	require.Equal(t, 3, len(waves[0]))
+	require.Equal(t, 7, len(waves[1]))
+	waves = getRolloutWaves(keys[:1], app)
+	require.Equal(t, 1, len(waves))
+
+	app.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy: edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN,
+	}
+	waves = getRolloutWaves(keys, app)
+	require.Equal(t, 10, len(waves))
+	for _, wave := range waves {
+		require.Equal(t, 1, len(wave))
+	}
+
+	// health gate
+	defer func(interval, settle time.Duration) {
+		RolloutHealthCheckInterval = interval
+		RolloutHealthySettleTime = settle
+	}(RolloutHealthCheckInterval, RolloutHealthySettleTime)
+	RolloutHealthCheckInterval = 5 * time.Millisecond
+	RolloutHealthySettleTime = 20 * time.Millisecond
+
+	api := &AppInstApi{}
+	edgeproto.InitAppInstCache(&api.cache)
+	inst := edgeproto.AppInst{
+		Key:         keys[0],
+		HealthCheck: dme.HealthCheck_HEALTH_CHECK_OK,
+	}
+	api.cache.Update(ctx, &inst, 0)
+	err := api.waitAppInstHealthy(ctx, &inst.Key, time.Second)
+	require.Nil(t, err)
+
+	inst.HealthCheck = dme.HealthCheck_HEALTH_CHECK_SERVER_FAIL
+	api.cache.Update(ctx, &inst, 0)
+	err = api.waitAppInstHealthy(ctx, &inst.Key, 50*time.Millisecond)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "health check is HealthCheckServerFail")
+
+	err = api.waitAppInstHealthy(ctx, &keys[1], time.Second)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+}
+
+func TestSumRequestedAppInstResources(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	api := &AppInstApi{}
+	appInst := edgeproto.AppInst{
+		KubernetesResources: &edgeproto.KubernetesResources{
+			CpuPool: &edgeproto.NodePoolResources{
+				TotalVcpus:  *edgeproto.NewUdec64(2, 0),
+				TotalMemory: 1024,
+			},
+		},
+	}
+	resVals := api.sumRequestedAppInstResources(ctx, &appInst)
+	require.Equal(t, uint64(2), resVals.GetInt(cloudcommon.ResourceVcpus))
+	require.Equal(t, uint64(1024), resVals.GetInt(cloudcommon.ResourceRamMb))
+
+	// resources are requested for the max replicas
+	appInst.HorizontalScalePolicy = &edgeproto.HorizontalScalePolicy{
+		MinReplicas: 1,
+		MaxReplicas: 3,
+		TargetCpu:   50,
+	}
+	resVals = api.sumRequestedAppInstResources(ctx, &appInst)
+	require.Equal(t, uint64(6), resVals.GetInt(cloudcommon.ResourceVcpus))
+	require.Equal(t, uint64(3072), resVals.GetInt(cloudcommon.ResourceRamMb))
+}
@@ -105,9 +105,13 @@ func (s *AppInstApi) getPotentialCloudlets(ctx context.Context, cctx *CallContex
 			}
 			log.SpanLog(ctx, log.DebugLevelApi, "skipping potential cloudlet from AppInst create", "cloudlet", ckey, "err", err)
 			skipReasons.add(skipReason)
+			if skipReason != NoSkipReason {
+				cctx.Placement.skip(&ckey, &edgeproto.ClusterKey{}, skipReason, err)
+			}
 			continue
 		}
 		log.SpanLog(ctx, log.DebugLevelApi, "adding potential cloudlet for AppInst create", "appInst", in.Key, "cloudlet", ckey)
+		cctx.Placement.usable(&ckey, &edgeproto.ClusterKey{}, pc.resourceScore)
 		potentialCloudlets = append(potentialCloudlets, pc)
 	}
 	if len(potentialCloudlets) == 0 {
@@ -223,6 +227,7 @@ func (s *AppInstApi) getPotentialClusters(ctx context.Context, cctx *CallContext
 			// return error if we can't use the cluster specified
 			return nil, nil, err
 		}
+		cctx.Placement.usable(&pc.cloudlet.Key, &in.ClusterKey, clust.resourceScore)
 		potentialClusters = append(potentialClusters, clust)
 		return potentialClusters, SkipReasons{}, nil
 	}
@@ -263,9 +268,11 @@ func (s *AppInstApi) getPotentialCloudletClusters(ctx context.Context, cctx *Cal
 				// mismatched deployment types or cluster owned by
 				// a different tenant should not be shown.
 				skipReasons.add(skipReason)
+				cctx.Placement.skip(&pc.cloudlet.Key, &key, skipReason, err)
 			}
 			continue
 		}
+		cctx.Placement.usable(&pc.cloudlet.Key, &key, clust.resourceScore)
 		potentialClusters = append(potentialClusters, clust)
 	}
 	return potentialClusters, skipReasons
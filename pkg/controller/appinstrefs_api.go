@@ -15,7 +15,10 @@
 package controller
 
 import (
+	"context"
+
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
 	"go.etcd.io/etcd/client/v3/concurrency"
 )
@@ -75,6 +78,7 @@ func (s *AppInstRefsApi) removeRef(stm concurrency.STM, appKey *edgeproto.AppKey
 	}
 	delete(refs.Insts, key.GetKeyString())
 	delete(refs.DeleteRequestedInsts, key.GetKeyString())
+	delete(refs.RolloutInsts, key.GetKeyString())
 	s.store.STMPut(stm, &refs)
 }
 
@@ -97,3 +101,60 @@ func (s *AppInstRefsApi) removeDeleteRequestedRef(stm concurrency.STM, appKey *e
 	delete(refs.DeleteRequestedInsts, key.GetKeyString())
 	s.store.STMPut(stm, &refs)
 }
+
+// getRolloutInsts gets the staged rollout progress of all AppInsts,
+// keyed by the JSON of the AppInst key.
+func (s *AppInstRefsApi) getRolloutInsts() map[string]edgeproto.RolloutStatus {
+	rolloutInsts := map[string]edgeproto.RolloutStatus{}
+	s.cache.Mux.Lock()
+	defer s.cache.Mux.Unlock()
+	for _, data := range s.cache.Objs {
+		for k, status := range data.Obj.RolloutInsts {
+			rolloutInsts[k] = status
+		}
+	}
+	return rolloutInsts
+}
+
+// setRolloutInsts sets the staged rollout state of the AppInsts.
+func (s *AppInstRefsApi) setRolloutInsts(ctx context.Context, appKey *edgeproto.AppKey, keys []edgeproto.AppInstKey, state edgeproto.RolloutState) {
+	if len(keys) == 0 {
+		return
+	}
+	err := s.sync.ApplySTMWait(ctx, func(stm concurrency.STM) error {
+		refs := edgeproto.AppInstRefs{}
+		if !s.store.STMGet(stm, appKey, &refs) {
+			return nil
+		}
+		for _, key := range keys {
+			keyStr := key.GetKeyString()
+			status, found := refs.RolloutInsts[keyStr]
+			if !found {
+				continue
+			}
+			status.State = state
+			refs.RolloutInsts[keyStr] = status
+		}
+		s.store.STMPut(stm, &refs)
+		return nil
+	})
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to set AppInst rollout state", "app", appKey, "state", state, "err", err)
+	}
+}
+
+// abortRolloutInsts marks the AppInsts that have not finished the
+// staged rollout as aborted.
+func (s *AppInstRefsApi) abortRolloutInsts(stm concurrency.STM, appKey *edgeproto.AppKey) {
+	refs := edgeproto.AppInstRefs{}
+	if !s.store.STMGet(stm, appKey, &refs) {
+		return
+	}
+	for keyStr, status := range refs.RolloutInsts {
+		if status.State == edgeproto.RolloutState_ROLLOUT_PENDING || status.State == edgeproto.RolloutState_ROLLOUT_IN_PROGRESS {
+			status.State = edgeproto.RolloutState_ROLLOUT_ABORTED
+			refs.RolloutInsts[keyStr] = status
+		}
+	}
+	s.store.STMPut(stm, &refs)
+}
@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	influxq "github.com/edgexr/edge-cloud-platform/pkg/influxq_client"
@@ -180,6 +182,13 @@ func (s *AutoProvPolicyApi) RemoveAutoProvPolicyZone(ctx context.Context, in *ed
 		if !s.store.STMGet(stm, &in.Key, &cur) {
 			return in.Key.NotFoundError()
 		}
+		for _, sched := range cur.Schedules {
+			for _, zoneKey := range sched.Zones {
+				if zoneKey.Matches(&in.ZoneKey) {
+					return fmt.Errorf("zone in use by schedule %s", sched.Name)
+				}
+			}
+		}
 		changed := false
 		for ii, zoneKey := range cur.Zones {
 			if zoneKey.Matches(&in.ZoneKey) {
@@ -388,6 +397,8 @@ func (s *AutoProvPolicyApi) appInstCheck(ctx context.Context, stm concurrency.ST
 		err = s.checkMinMax(ctx, stm, action, app, inst, &refs, policyName)
 	case cloudcommon.AutoProvReasonOrphaned:
 		err = s.checkOrphaned(ctx, stm, action, app, inst)
+	case cloudcommon.AutoProvReasonSchedule:
+		err = s.checkSchedule(ctx, stm, action, app, inst, &refs, policyName)
 	default:
 		log.SpanLog(ctx, log.DebugLevelApi, "unsupported reason", "reason", reason)
 		return nil
@@ -455,6 +466,17 @@ func (s *AutoProvPolicyApi) checkDemand(ctx context.Context, stm concurrency.STM
 				return fmt.Errorf("Delete would violate min active instances %d of policy %s", policy.MinActiveInstances, policy.Key.Name)
 			}
 		}
+		if action == cloudcommon.Delete && policy.MinInstanceLifetime > 0 {
+			created := dme.TimestampToTime(inst.CreatedAt)
+			if age := time.Since(created); age < policy.MinInstanceLifetime.TimeDuration() {
+				return fmt.Errorf("Delete would violate min instance lifetime %s of policy %s, instance age is %s", policy.MinInstanceLifetime.TimeDuration(), policy.Key.Name, age.Round(time.Second))
+			}
+		}
+		if action == cloudcommon.Delete {
+			if err := s.checkActiveScheduleMins(ctx, stm, &policy, inst, countsByZone); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -500,6 +522,141 @@ func (s *AutoProvPolicyApi) checkMinMax(ctx context.Context, stm concurrency.STM
 	return nil
 }
 
+// Intent is to satisfy the min constraint of a schedule during its
+// window for create, and to remove instances deployed for a schedule
+// after its window has passed for delete. Reject any calls that are
+// outside of the window, or that would overshoot the constraints.
+func (s *AutoProvPolicyApi) checkSchedule(ctx context.Context, stm concurrency.STM, action cloudcommon.Action, app *edgeproto.App, inst *edgeproto.AppInst, refs *edgeproto.AppInstRefs, policyName string) error {
+	policy := edgeproto.AutoProvPolicy{}
+	policyKey := edgeproto.PolicyKey{
+		Name:         policyName,
+		Organization: app.Key.Organization,
+	}
+	if !s.store.STMGet(stm, &policyKey, &policy) {
+		return policyKey.NotFoundError()
+	}
+	now := time.Now()
+	getLoc := func(zoneKey *edgeproto.ZoneKey) *time.Location {
+		return s.getZoneLocation(ctx, stm, zoneKey)
+	}
+
+	if action == cloudcommon.Create {
+		schedName := inst.Annotations[cloudcommon.AnnotationAutoProvSchedule]
+		var sched *edgeproto.AutoProvSchedule
+		for ii := range policy.Schedules {
+			if policy.Schedules[ii].Name == schedName {
+				sched = &policy.Schedules[ii]
+				break
+			}
+		}
+		if sched == nil {
+			return fmt.Errorf("Schedule %q not found on policy %s", schedName, policyName)
+		}
+		activeZones := cloudcommon.GetAutoProvScheduleActiveZones(&policy, sched, now, getLoc)
+		withinSchedule := false
+		for _, zoneKey := range activeZones {
+			if zoneKey.Matches(&inst.ZoneKey) {
+				withinSchedule = true
+				break
+			}
+		}
+		if !withinSchedule {
+			return fmt.Errorf("Schedule %s not active for Zone %s, ignoring", sched.Name, inst.ZoneKey.GetKeyString())
+		}
+		onlineCounts, err := s.getAppInstCountsForAutoProv(ctx, stm, refs, true)
+		if err != nil {
+			return err
+		}
+		count := 0
+		for _, zoneKey := range activeZones {
+			count += onlineCounts[zoneKey]
+		}
+		log.SpanLog(ctx, log.DebugLevelApi, "autoprov check schedule stats", "action", action.String(), "inst", inst.Key, "policy", policyName, "schedule", sched.Name, "count", count, "min", sched.MinActiveInstances, "max", policy.MaxInstances)
+		if count >= int(sched.MinActiveInstances) {
+			return cloudcommon.AutoProvMinAlreadyMetError
+		}
+		if policy.MaxInstances > 0 {
+			allCounts, err := s.getAppInstCountsForAutoProv(ctx, stm, refs, false)
+			if err != nil {
+				return err
+			}
+			total := 0
+			for _, zoneKey := range policy.Zones {
+				total += allCounts[*zoneKey]
+			}
+			if total >= int(policy.MaxInstances) {
+				return fmt.Errorf("Create would exceed max instances %d of policy %s", policy.MaxInstances, policy.Key.Name)
+			}
+		}
+		return nil
+	}
+	if action == cloudcommon.Delete {
+		active := cloudcommon.GetActiveAutoProvSchedules(&policy, &inst.ZoneKey, getLoc(&inst.ZoneKey), now)
+		if len(active) > 0 {
+			return fmt.Errorf("Schedule %s still active for Zone %s, ignoring", active[0].Name, inst.ZoneKey.GetKeyString())
+		}
+		// If the AppInst we are deleting is not online, then it will
+		// not affect the min active count so we can safely delete it.
+		online, err := s.autoProvAppInstOnline(ctx, stm, &inst.Key)
+		if err != nil {
+			return err
+		}
+		if !online || policy.MinActiveInstances == 0 {
+			return nil
+		}
+		onlineCounts, err := s.getAppInstCountsForAutoProv(ctx, stm, refs, true)
+		if err != nil {
+			return err
+		}
+		count := 0
+		for _, zoneKey := range policy.Zones {
+			count += onlineCounts[*zoneKey]
+		}
+		if count <= int(policy.MinActiveInstances) {
+			return fmt.Errorf("Delete would violate min active instances %d of policy %s", policy.MinActiveInstances, policy.Key.Name)
+		}
+	}
+	return nil
+}
+
+// checkActiveScheduleMins checks that deleting the online AppInst
+// would not violate the min constraint of any of the policy's
+// schedules that are active for the AppInst's Zone.
+func (s *AutoProvPolicyApi) checkActiveScheduleMins(ctx context.Context, stm concurrency.STM, policy *edgeproto.AutoProvPolicy, inst *edgeproto.AppInst, onlineCounts map[edgeproto.ZoneKey]int) error {
+	if len(policy.Schedules) == 0 {
+		return nil
+	}
+	now := time.Now()
+	getLoc := func(zoneKey *edgeproto.ZoneKey) *time.Location {
+		return s.getZoneLocation(ctx, stm, zoneKey)
+	}
+	for _, sched := range cloudcommon.GetActiveAutoProvSchedules(policy, &inst.ZoneKey, getLoc(&inst.ZoneKey), now) {
+		count := 0
+		for _, zoneKey := range cloudcommon.GetAutoProvScheduleActiveZones(policy, sched, now, getLoc) {
+			count += onlineCounts[zoneKey]
+		}
+		if count <= int(sched.MinActiveInstances) {
+			return fmt.Errorf("Delete would violate min active instances %d of schedule %s of policy %s", sched.MinActiveInstances, sched.Name, policy.Key.Name)
+		}
+	}
+	return nil
+}
+
+// getZoneLocation gets the time zone used to evaluate schedules
+// for the Zone.
+func (s *AutoProvPolicyApi) getZoneLocation(ctx context.Context, stm concurrency.STM, key *edgeproto.ZoneKey) *time.Location {
+	zone := edgeproto.Zone{}
+	if !s.all.zoneApi.store.STMGet(stm, key, &zone) {
+		return time.UTC
+	}
+	loc, err := zone.GetLocation()
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to get zone location, using UTC", "zone", key, "err", err)
+		return time.UTC
+	}
+	return loc
+}
+
 // Intent is to remove any auto-provisioned AppInsts that no longer
 // belong to any policies on the App. This can happen if the user removes
 // a policy from the App, for example.
@@ -25,6 +25,8 @@ type CallContext struct {
 	AutoCluster            bool
 	SkipCloudletReadyCheck bool
 	StreamObjs             map[string]*streamSend
+	DryRun                 bool
+	Placement              *placementTracker
 }
 
 func DefCallContext() *CallContext {
@@ -86,6 +88,18 @@ func (c *CallContext) SetOverride(o *edgeproto.CRMOverride) {
 	*o = edgeproto.CRMOverride_NO_OVERRIDE
 }
 
+// SetDryRun takes the dry run flag specified from the user,
+// and removes it from the input object. Like the override, it is
+// only a switch to the current operation.
+func (c *CallContext) SetDryRun(dryRun *bool) {
+	if !*dryRun {
+		return
+	}
+	c.DryRun = true
+	c.Placement = &placementTracker{}
+	*dryRun = false
+}
+
 func (c *CallContext) Clone() *CallContext {
 	clone := *c
 	if c.StreamObjs != nil {
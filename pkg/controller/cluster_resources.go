@@ -47,7 +47,10 @@ func (s *ClusterInstApi) calcKubernetesClusterUsedResources(refs *edgeproto.Clus
 			// when being sized for an App.
 			return nil
 		}
-		kr := appInst.KubernetesResources
+		kr, err := cloudcommon.GetReservedKubernetesResources(appInst)
+		if err != nil {
+			return err
+		}
 		if err := cpuPoolVals.AddNodePoolResources(kr.CpuPool); err != nil {
 			return err
 		}
@@ -588,6 +588,7 @@ func (s *ClusterInstApi) resolveResourcesSpec(ctx context.Context, stm *edgeprot
 // bypassing static assignment. It is also used to create auto-cluster insts.
 func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgeproto.ClusterInst, inCb edgeproto.ClusterInstApi_CreateClusterInstServer) (reterr error) {
 	cctx.SetOverride(&in.CrmOverride)
+	cctx.SetDryRun(&in.DryRun)
 	if err := in.Key.ValidateKey(); err != nil {
 		return err
 	}
@@ -597,6 +598,9 @@ func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgepr
 	clusterKey := in.Key
 	var err error
 	streamCb, cb := s.all.streamObjApi.newStream(ctx, cctx, clusterKey.StreamKey(), inCb)
+	defer func() {
+		sendDryRunFailure(cctx, cb, reterr)
+	}()
 
 	if in.Key.Organization == "" {
 		return fmt.Errorf("ClusterInst Organization cannot be empty")
@@ -904,6 +908,10 @@ func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgepr
 		in.CreatedAt = dme.TimeToTimestamp(time.Now())
 		in.ObjId = ulid.Make().String()
 
+		if cctx.DryRun {
+			// all checks passed, abort the transaction
+			return errDryRun
+		}
 		if ignoreCRM(cctx) {
 			in.State = edgeproto.TrackedState_READY
 		} else {
@@ -927,9 +935,15 @@ func (s *ClusterInstApi) createClusterInstInternal(cctx *CallContext, in *edgepr
 		crmOnEdge = false
 		resourceFailure = false
 		modRev, err = s.sync.ApplySTMWaitRev(ctx, applyCreateReq)
+		if err == errDryRun {
+			cctx.Placement.choose(&pc.cloudlet.Key, &edgeproto.ClusterKey{}, "cloudlet has enough resources for the cluster")
+			cb.Send(cctx.Placement.getResult(fmt.Sprintf("Dry run passed, ClusterInst would be created on cloudlet %s", pc.cloudlet.Key.Name)))
+			return nil
+		}
 		if err != nil {
 			if resourceFailure {
 				log.SpanLog(ctx, log.DebugLevelApi, "createCluster failed with resource error, will try next potential cloudlet", "targetCloudlet", pc.cloudlet.Key.GetKeyString(), "err", err)
+				cctx.Placement.skip(&pc.cloudlet.Key, &edgeproto.ClusterKey{}, SiteNoResources, err)
 				continue // try the next cloudlet
 			}
 			return err
@@ -1036,7 +1050,12 @@ func (s *ClusterInstApi) updateClusterInstInternal(cctx *CallContext, in *edgepr
 	}
 
 	cctx.SetOverride(&in.CrmOverride)
+	cctx.SetDryRun(&in.DryRun)
 	fmap := edgeproto.MakeFieldMap(in.Fields)
+	if fmap.Has(edgeproto.ClusterInstFieldDryRun) {
+		fmap.Clear(edgeproto.ClusterInstFieldDryRun)
+		in.Fields = fmap.Fields()
+	}
 
 	if fmap.Has(edgeproto.ClusterInstFieldEnableIpv6) && !in.EnableIpv6 {
 		err := s.checkDisableDisableIPV6(ctx, &in.Key)
@@ -1047,6 +1066,9 @@ func (s *ClusterInstApi) updateClusterInstInternal(cctx *CallContext, in *edgepr
 
 	clusterKey := in.Key
 	streamCb, cb := s.all.streamObjApi.newStream(ctx, cctx, clusterKey.StreamKey(), inCb)
+	defer func() {
+		sendDryRunFailure(cctx, cb, reterr)
+	}()
 
 	var inbuf edgeproto.ClusterInst
 	var changeCount int
@@ -1145,6 +1167,7 @@ func (s *ClusterInstApi) updateClusterInstInternal(cctx *CallContext, in *edgepr
 			return nil
 		}
 
+		cctx.Placement.reset()
 		if resChange {
 			ostm := edgeproto.NewOptionalSTM(stm)
 			err = s.resolveResourcesSpec(ctx, ostm, &inbuf, fmap)
@@ -1156,6 +1179,7 @@ func (s *ClusterInstApi) updateClusterInstInternal(cctx *CallContext, in *edgepr
 			resCalc.deps.cloudlet = &cloudlet
 			warnings, err := resCalc.CloudletFitsCluster(ctx, &inbuf, oldClusterInst)
 			if err != nil {
+				cctx.Placement.skip(&cloudlet.Key, &inbuf.Key, SiteNoResources, err)
 				return err
 			}
 			s.handleResourceUsageAlerts(ctx, stm, &cloudlet.Key, warnings)
@@ -1164,6 +1188,11 @@ func (s *ClusterInstApi) updateClusterInstInternal(cctx *CallContext, in *edgepr
 		if err := s.validateClusterInstUpdates(ctx, stm, &inbuf); err != nil {
 			return err
 		}
+		if cctx.DryRun {
+			// all checks passed, abort the transaction
+			cctx.Placement.choose(&cloudlet.Key, &inbuf.Key, "cloudlet has enough resources for the updated cluster")
+			return errDryRun
+		}
 
 		if !ignoreCRM(cctx) {
 			inbuf.State = edgeproto.TrackedState_UPDATE_REQUESTED
@@ -1173,10 +1202,17 @@ func (s *ClusterInstApi) updateClusterInstInternal(cctx *CallContext, in *edgepr
 		diffFields = old.GetDiffFields(&inbuf)
 		return nil
 	})
+	if err == errDryRun {
+		cb.Send(cctx.Placement.getResult(fmt.Sprintf("Dry run passed, ClusterInst would be updated on cloudlet %s", inbuf.CloudletKey.Name)))
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 	if changeCount == 0 && !retry {
+		if cctx.DryRun {
+			cb.Send(&edgeproto.Result{Message: "Dry run passed, no changes to ClusterInst"})
+		}
 		return nil
 	}
 
@@ -2158,7 +2194,11 @@ func (s *ClusterInstApi) setInfraFlavor(ctx context.Context, stm *edgeproto.Opti
 func setClusterResourcesForReqs(ctx context.Context, ci *edgeproto.ClusterInst, app *edgeproto.App, ai *edgeproto.AppInst) error {
 	log.SpanLog(ctx, log.DebugLevelApi, "set cluster resources", "ci", ci.Key, "app", app.Key, "ai", ai.Key)
 	if cloudcommon.AppDeploysToKubernetes(app.Deployment) {
-		nodePools, err := GetNodePoolsFromReqs(ctx, ai.KubernetesResources)
+		kr, err := cloudcommon.GetReservedKubernetesResources(ai)
+		if err != nil {
+			return err
+		}
+		nodePools, err := GetNodePoolsFromReqs(ctx, kr)
 		if err != nil {
 			return err
 		}
@@ -2214,7 +2254,11 @@ func (s *ClusterInstApi) fitsAppResources(ctx context.Context, ci *edgeproto.Clu
 		if err != nil {
 			return nil, noFreeRes, err
 		}
-		return resspec.KubernetesResourcesFits(ctx, ci, appInst.KubernetesResources, cpuUsed, gpuUsed, flavorLookup)
+		kr, err := cloudcommon.GetReservedKubernetesResources(appInst)
+		if err != nil {
+			return nil, noFreeRes, err
+		}
+		return resspec.KubernetesResourcesFits(ctx, ci, kr, cpuUsed, gpuUsed, flavorLookup)
 	} else {
 		used, err := s.calcVMClusterUsedResources(refs, appInst)
 		if err != nil {
@@ -96,10 +96,14 @@ func TestClusterInstApi(t *testing.T) {
 	// 1 clusterInst is always present because of singlefakecloudlet, cloudletData[4]
 	require.Equal(t, 1, len(apis.clusterInstApi.cache.Objs))
 
+	testClusterInstCreateDryRun(t, ctx, apis)
+
 	testutil.InternalClusterInstTest(t, "cud", apis.clusterInstApi, testutil.ClusterInstData(), testutil.WithCreatedClusterInstTestData(testutil.CreatedClusterInstData()))
 	// after cluster insts create, check that cloudlet refs data is correct.
 	testutil.InternalCloudletRefsTest(t, "show", apis.cloudletRefsApi, testutil.CloudletRefsData())
 
+	testClusterInstUpdateDryRun(t, ctx, apis)
+
 	commonApi := testutil.NewInternalClusterInstApi(apis.clusterInstApi)
 
 	// Set responder to fail delete.
@@ -1610,3 +1614,59 @@ func testClusterResourceUsage(t *testing.T, ctx context.Context, apis *AllApis)
 		}
 	}
 }
+
+func testClusterInstCreateDryRun(t *testing.T, ctx context.Context, apis *AllApis) {
+	count := apis.clusterInstApi.cache.GetCount()
+	for ii, data := range testutil.ClusterInstData() {
+		obj := data
+		obj.DryRun = true
+		stream := NewStreamoutMsg(ctx)
+		err := apis.clusterInstApi.CreateClusterInst(&obj, stream)
+		require.Nil(t, err, "dry run ClusterInst[%d]", ii)
+		require.Greater(t, len(stream.Msgs), 0)
+		res := stream.Msgs[len(stream.Msgs)-1]
+		require.Contains(t, res.Message, "Dry run passed", "ClusterInst[%d]", ii)
+		chosen := []edgeproto.PlacementCandidate{}
+		for _, pc := range res.PlacementCandidates {
+			if pc.Chosen {
+				chosen = append(chosen, pc)
+			}
+		}
+		require.Equal(t, 1, len(chosen), "ClusterInst[%d] %v", ii, res.PlacementCandidates)
+		require.Contains(t, res.Message, chosen[0].CloudletKey.Name)
+		require.Equal(t, count, apis.clusterInstApi.cache.GetCount())
+	}
+}
+
+func testClusterInstUpdateDryRun(t *testing.T, ctx context.Context, apis *AllApis) {
+	obj := testutil.ClusterInstData()[0]
+	cur := edgeproto.ClusterInst{}
+	require.True(t, apis.clusterInstApi.cache.Get(&obj.Key, &cur))
+
+	update := edgeproto.ClusterInst{
+		Key:                            obj.Key,
+		DisableDynamicAppinstPlacement: !cur.DisableDynamicAppinstPlacement,
+		DryRun:                         true,
+	}
+	update.Fields = []string{
+		edgeproto.ClusterInstFieldDisableDynamicAppinstPlacement,
+		edgeproto.ClusterInstFieldDryRun,
+	}
+	stream := NewStreamoutMsg(ctx)
+	err := apis.clusterInstApi.UpdateClusterInst(&update, stream)
+	require.Nil(t, err)
+	require.Greater(t, len(stream.Msgs), 0)
+	res := stream.Msgs[len(stream.Msgs)-1]
+	require.Contains(t, res.Message, "Dry run passed")
+	require.Equal(t, 1, len(res.PlacementCandidates))
+	require.True(t, res.PlacementCandidates[0].Chosen)
+	require.Equal(t, cur.CloudletKey, res.PlacementCandidates[0].CloudletKey)
+	require.Equal(t, obj.Key, res.PlacementCandidates[0].ClusterKey)
+
+	// update was not applied
+	check := edgeproto.ClusterInst{}
+	require.True(t, apis.clusterInstApi.cache.Get(&obj.Key, &check))
+	require.Equal(t, cur.DisableDynamicAppinstPlacement, check.DisableDynamicAppinstPlacement)
+	require.False(t, check.DryRun)
+	require.Equal(t, cur.UpdatedAt, check.UpdatedAt)
+}
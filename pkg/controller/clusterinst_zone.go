@@ -64,8 +64,10 @@ func (s *ClusterInstApi) getPotentialCloudlets(ctx context.Context, cctx *CallCo
 			}
 			log.SpanLog(ctx, log.DebugLevelApi, "skipping potential cloudlet from ClusterInst create", "cloudlet", ckey, "err", err)
 			skipReasons.add(skipReason)
+			cctx.Placement.skip(&ckey, &edgeproto.ClusterKey{}, skipReason, err)
 			continue
 		}
+		cctx.Placement.usable(&ckey, &edgeproto.ClusterKey{}, pc.resourceScore)
 		potentialCloudlets = append(potentialCloudlets, pc)
 	}
 	if len(potentialCloudlets) == 0 {
@@ -38,6 +38,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
 	influxq "github.com/edgexr/edge-cloud-platform/pkg/influxq_client"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/metricsexport"
 	"github.com/edgexr/edge-cloud-platform/pkg/notify"
 	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
 	"github.com/edgexr/edge-cloud-platform/pkg/process"
@@ -77,6 +78,9 @@ var edgeTurnProxyAddr = flag.String("edgeTurnProxyAddr", "127.0.0.1:8443", "Addr
 var debugLevels = flag.String("d", "", fmt.Sprintf("comma separated list of %v", log.DebugLevelStrings))
 var shortTimeouts = flag.Bool("shortTimeouts", false, "set timeouts short for simulated cloudlet testing")
 var influxAddr = flag.String("influxAddr", "http://127.0.0.1:8086", "InfluxDB listener address")
+var metricsExportType = flag.String("metricsExportType", "", fmt.Sprintf("optionally export received metrics to an external receiver, one of %v", metricsexport.Types))
+var metricsExportAddr = flag.String("metricsExportAddr", "", "metrics export receiver URL, i.e. http://prometheus:9090/api/v1/write or http://otel-collector:4318/v1/metrics")
+var metricsExportOnly = flag.Bool("metricsExportOnly", false, "send received metrics only to the metrics export receiver instead of InfluxDB; features that query InfluxDB, such as auto-provisioning, will not see them")
 var registryFQDN = flag.String("registryFQDN", "", "default docker image registry FQDN")
 var artifactoryFQDN = flag.String("artifactoryFQDN", "", "default VM image registry (artifactory) FQDN")
 var versionTag = flag.String("versionTag", "", "edge-cloud image tag indicating controller version")
@@ -136,6 +140,7 @@ type Services struct {
 	edgeEventsInfluxQ           *influxq.InfluxQ
 	cloudletResourcesInfluxQ    *influxq.InfluxQ
 	downsampledMetricsInfluxQ   *influxq.InfluxQ
+	metricsExporter             *metricsexport.Exporter
 	notifyServerMgr             bool
 	grpcServer                  *grpc.Server
 	httpServer                  *http.Server
@@ -157,6 +162,10 @@ type Services struct {
 type UpgradeSupport struct {
 	region      string
 	vaultConfig *vault.Config
+	// staging is set when upgrading backup data in a staging
+	// store before it is restored. There is no vault config, and
+	// upgrades must not change any state outside of the store.
+	staging bool
 }
 
 func Run() {
@@ -285,6 +294,7 @@ func startServices() error {
 	allApis.cloudletApi.accessKeyServer.SetRequireTlsAccessKey(*requireNotifyAccessKey)
 
 	allApis.Start(ctx)
+	allApis.appApi.pauseOrphanedRollouts(ctx)
 
 	initDebug(ctx, &nodeMgr, allApis)
 
@@ -366,12 +376,27 @@ func startServices() error {
 	}
 	services.cloudletResourcesInfluxQ = cloudletResourcesInfluxQ
 
+	// optional export of metrics to prometheus or opentelemetry
+	if *metricsExportType != "" {
+		metricsExporter, err := metricsexport.NewExporter(*metricsExportType, *metricsExportAddr)
+		if err != nil {
+			return err
+		}
+		metricsExporter.Start()
+		services.metricsExporter = metricsExporter
+	} else if *metricsExportOnly {
+		return fmt.Errorf("metricsExportOnly requires metricsExportType to be set")
+	}
+
 	// create continuous queries for edgeevents metrics
 	services.stopInitCC = make(chan bool)
 	services.waitGroup.Add(1)
 	go initContinuousQueries(allApis)
 
-	InitNotify(influxQ, edgeEventsInfluxQ, allApis.appInstClientApi, allApis)
+	InitNotify(influxQ, edgeEventsInfluxQ, services.metricsExporter, allApis.appInstClientApi, allApis)
+	// Changes to the synced caches are tracked from the current
+	// revision on, allowing reconnecting clients to sync incrementally.
+	notify.ServerMgrOne.SetSyncHistoryStartRev(sync.GetRev(), sync.GetCacheTypes())
 	if *notifyParentAddrs != "" {
 		addrs := strings.Split(*notifyParentAddrs, ",")
 		tlsConfig, err := nodeMgr.InternalPki.GetClientTlsConfig(ctx,
@@ -644,6 +669,9 @@ func stopServices() {
 	if services.downsampledMetricsInfluxQ != nil {
 		services.downsampledMetricsInfluxQ.Stop()
 	}
+	if services.metricsExporter != nil {
+		services.metricsExporter.Stop()
+	}
 	if services.allApis != nil {
 		services.allApis.Stop()
 	}
@@ -747,6 +775,7 @@ type AllApis struct {
 	alertPolicyApi              *AlertPolicyApi
 	networkApi                  *NetworkApi
 	platformFeaturesApi         *PlatformFeaturesApi
+	nbiSubscriptionApi          *NBISubscriptionApi
 	syncLeaseData               *SyncLeaseData
 }
 
@@ -791,6 +820,7 @@ func NewAllApis(sync *regiondata.Sync) *AllApis {
 	all.alertPolicyApi = NewAlertPolicyApi(sync, all)
 	all.networkApi = NewNetworkApi(sync, all)
 	all.platformFeaturesApi = NewPlatformFeaturesApi(sync, all)
+	all.nbiSubscriptionApi = NewNBISubscriptionApi(all)
 	all.syncLeaseData = NewSyncLeaseData(sync, all)
 	return all
 }
@@ -805,7 +835,7 @@ func (s *AllApis) Stop() {
 	}
 }
 
-func InitNotify(metricsInflux *influxq.InfluxQ, edgeEventsInflux *influxq.InfluxQ, clientQ notify.RecvAppInstClientHandler, allApis *AllApis) {
+func InitNotify(metricsInflux *influxq.InfluxQ, edgeEventsInflux *influxq.InfluxQ, metricsExporter *metricsexport.Exporter, clientQ notify.RecvAppInstClientHandler, allApis *AllApis) {
 	notify.ServerMgrOne.RegisterSendSettingsCache(&allApis.settingsApi.cache)
 	notify.ServerMgrOne.RegisterSendFlowRateLimitSettingsCache(&allApis.flowRateLimitSettingsApi.cache)
 	notify.ServerMgrOne.RegisterSendMaxReqsRateLimitSettingsCache(&allApis.maxReqsRateLimitSettingsApi.cache)
@@ -853,23 +883,33 @@ func InitNotify(metricsInflux *influxq.InfluxQ, edgeEventsInflux *influxq.Influx
 	notify.ServerMgrOne.RegisterRecv(notify.NewAppInstClientRecvMany(clientQ))
 	notify.ServerMgrOne.RegisterRecv(notify.NewDeviceRecvMany(allApis.deviceApi))
 	notify.ServerMgrOne.RegisterRecv(notify.NewAutoProvInfoRecvMany(allApis.autoProvInfoApi))
-	notify.ServerMgrOne.RegisterRecv(notify.NewMetricRecvMany(NewControllerMetricsReceiver(metricsInflux, edgeEventsInflux)))
+	notify.ServerMgrOne.RegisterRecv(notify.NewMetricRecvMany(NewControllerMetricsReceiver(metricsInflux, edgeEventsInflux, metricsExporter, *metricsExportOnly)))
 }
 
 type ControllerMetricsReceiver struct {
 	metricsInflux    *influxq.InfluxQ
 	edgeEventsInflux *influxq.InfluxQ
+	metricsExporter  *metricsexport.Exporter
+	exportOnly       bool
 }
 
-func NewControllerMetricsReceiver(metricsInflux *influxq.InfluxQ, edgeEventsInflux *influxq.InfluxQ) *ControllerMetricsReceiver {
+func NewControllerMetricsReceiver(metricsInflux *influxq.InfluxQ, edgeEventsInflux *influxq.InfluxQ, metricsExporter *metricsexport.Exporter, exportOnly bool) *ControllerMetricsReceiver {
 	c := new(ControllerMetricsReceiver)
 	c.metricsInflux = metricsInflux
 	c.edgeEventsInflux = edgeEventsInflux
+	c.metricsExporter = metricsExporter
+	c.exportOnly = exportOnly && metricsExporter != nil
 	return c
 }
 
-// Send metric to correct influxdb
+// Send metric to the metrics exporter, if any, and to the correct influxdb
 func (c *ControllerMetricsReceiver) RecvMetric(ctx context.Context, metric *edgeproto.Metric) {
+	if c.metricsExporter != nil {
+		c.metricsExporter.AddMetric(metric)
+	}
+	if c.exportOnly {
+		return
+	}
 	if _, ok := cloudcommon.EdgeEventsMetrics[metric.Name]; ok {
 		c.edgeEventsInflux.AddMetric(metric)
 	} else {
@@ -0,0 +1,446 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/passhash"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Backups are taken from a single prefix read of the region's data,
+// so all objects in the snapshot are consistent as of one revision.
+// The snapshot is encrypted with AES-GCM using a key derived from
+// the user supplied passphrase.
+
+var (
+	backupKeyIter   = 100000
+	backupSaltBytes = 16
+	// limit on key iterations read from the backup data, so that a
+	// crafted backup cannot tie up the controller deriving the key
+	backupKeyIterMax = 10 * backupKeyIter
+	// objects per restore transaction, below etcd's max-txn-ops
+	restoreBatchSize = 100
+)
+
+// restoreMarkerPrefix is the key of the marker written while a
+// restore is in progress.
+const restoreMarkerPrefix = "ControllerRestore"
+
+type restoreMarker struct {
+	Region   string
+	Revision int64
+}
+
+// Data tied to a live controller lease, which is recreated by the
+// running services and so is not backed up.
+var backupSkipTypes = map[string]struct{}{
+	"Controller":        {},
+	"Alert":             {},
+	restoreMarkerPrefix: {},
+}
+
+// Data that is created by the controller and services when a new
+// region starts up. It does not prevent a restore, and is
+// overwritten by the backup data.
+var restoreOverwriteTypes = map[string]struct{}{
+	DataModelVersion0Prefix: {},
+	DataModelVersion2Prefix: {},
+	"Settings":              {},
+	"PlatformFeatures":      {},
+}
+
+// Secret data stored in etcd, as json field names by object type,
+// removed from backups unless secrets are explicitly included.
+var backupSecretFields = map[string][]string{
+	"Cloudlet":     {"crm_access_private_key", "secondary_crm_access_private_key", "kafka_password"},
+	"CloudletNode": {"password_hash", "salt", "iter"},
+}
+
+type controllerSnapshot struct {
+	Region           string
+	Revision         int64
+	DataModelVersion edgeproto.DataModelVersion
+	IncludeSecrets   bool
+	// Objs are keyed by the db key without the region prefix
+	Objs map[string]string
+}
+
+type controllerBackupEnvelope struct {
+	Salt       []byte
+	Iter       int
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+func (s *ControllerApi) BackupController(ctx context.Context, in *edgeproto.ControllerBackupRequest) (*edgeproto.ControllerBackup, error) {
+	if in.Passphrase == "" {
+		return nil, fmt.Errorf("passphrase is required to encrypt the backup")
+	}
+	objStore := s.sync.GetKVStore()
+	vers, err := getDataVersion(ctx, objStore, edgeproto.GetDataModelVersion())
+	if err != nil {
+		return nil, err
+	}
+	snap, err := getControllerSnapshot(ctx, objStore, in.IncludeSecrets)
+	if err != nil {
+		return nil, err
+	}
+	snap.Region = *region
+	snap.DataModelVersion = *vers
+	data, err := encryptControllerSnapshot(snap, in.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	backup := &edgeproto.ControllerBackup{
+		Region:               snap.Region,
+		Revision:             snap.Revision,
+		DataModelVersionHash: snap.DataModelVersion.Hash,
+		DataModelVersionId:   snap.DataModelVersion.ID,
+		NumObjects:           int32(len(snap.Objs)),
+		IncludeSecrets:       snap.IncludeSecrets,
+		Data:                 data,
+	}
+	nodeMgr.Event(ctx, "Controller data backup", "", nil, nil, "revision", fmt.Sprintf("%d", snap.Revision), "objects", fmt.Sprintf("%d", len(snap.Objs)), "secrets", fmt.Sprintf("%t", snap.IncludeSecrets))
+	return backup, nil
+}
+
+func (s *ControllerApi) RestoreController(ctx context.Context, in *edgeproto.ControllerRestoreRequest) (*edgeproto.Result, error) {
+	if in.Passphrase == "" {
+		return nil, fmt.Errorf("passphrase is required to decrypt the backup")
+	}
+	snap, err := decryptControllerSnapshot(in.Data, in.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	upgradeSupport := &UpgradeSupport{
+		region: *region,
+	}
+	err = restoreControllerSnapshot(ctx, s.sync.GetKVStore(), s.all, upgradeSupport, snap, edgeproto.GetDataModelVersion(), VersionHash_UpgradeFuncs)
+	nodeMgr.Event(ctx, "Controller data restore", "", nil, err, "revision", fmt.Sprintf("%d", snap.Revision), "objects", fmt.Sprintf("%d", len(snap.Objs)), "fromregion", snap.Region)
+	if err != nil {
+		return nil, err
+	}
+	msg := fmt.Sprintf("Restored %d objects from backup at revision %d", len(snap.Objs), snap.Revision)
+	return &edgeproto.Result{Message: msg}, nil
+}
+
+// getControllerSnapshot reads all of the region's data
+func getControllerSnapshot(ctx context.Context, objStore objstore.KVStore, includeSecrets bool) (*controllerSnapshot, error) {
+	snap := &controllerSnapshot{
+		IncludeSecrets: includeSecrets,
+		Objs:           make(map[string]string),
+	}
+	prefix := objstore.DbKeyPrefixString("")
+	var listErr error
+	err := objStore.List(prefix, func(key, val []byte, rev, modRev int64) error {
+		snap.Revision = rev
+		_, typ, _, err := objstore.DbKeyPrefixParse(string(key))
+		if err != nil {
+			listErr = err
+			return err
+		}
+		if _, found := backupSkipTypes[typ]; found {
+			return nil
+		}
+		if typ == DataModelVersion0Prefix || typ == DataModelVersion2Prefix {
+			// version is tracked separately in the snapshot
+			return nil
+		}
+		value := string(val)
+		if fields, found := backupSecretFields[typ]; found && !includeSecrets {
+			value, err = removeJsonFields(value, fields)
+			if err != nil {
+				listErr = fmt.Errorf("failed to remove secrets from %s, %s", objstore.DbKeyPrefixRemove(string(key)), err)
+				return listErr
+			}
+		}
+		snap.Objs[strings.TrimPrefix(string(key), prefix)] = value
+		return nil
+	})
+	if err == nil {
+		err = listErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "got controller snapshot", "revision", snap.Revision, "numObjs", len(snap.Objs))
+	return snap, nil
+}
+
+func removeJsonFields(val string, fields []string) (string, error) {
+	obj := make(map[string]interface{})
+	dec := json.NewDecoder(strings.NewReader(val))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return "", err
+	}
+	for _, field := range fields {
+		delete(obj, field)
+	}
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// restoreControllerSnapshot writes the snapshot into an empty region.
+// If the snapshot is from an older data model, it is upgraded in a
+// staging store first, so the upgrade functions never run against the
+// live region. The objects are written in batched transactions, with a
+// marker recording the restore in progress, so that a restore that
+// fails part way through can be retried with the same backup.
+func restoreControllerSnapshot(ctx context.Context, objStore objstore.KVStore, allApis *AllApis, upgradeSupport *UpgradeSupport, snap *controllerSnapshot, latestVers *edgeproto.DataModelVersion, upgradeFuncs []VersionUpgrade) error {
+	if snap.DataModelVersion.ID > latestVers.ID {
+		return fmt.Errorf("backup data model version %d is newer than the controller's data model version %d", snap.DataModelVersion.ID, latestVers.ID)
+	}
+	prefix := objstore.DbKeyPrefixString("")
+	marker := restoreMarker{
+		Region:   snap.Region,
+		Revision: snap.Revision,
+	}
+	markerKey := objstore.DbKeyPrefixString(restoreMarkerPrefix)
+	resume := false
+	if val, _, _, err := objStore.Get(markerKey); err == nil {
+		prev := restoreMarker{}
+		if err := json.Unmarshal(val, &prev); err != nil {
+			return fmt.Errorf("failed to unmarshal restore marker, %s", err)
+		}
+		if prev != marker {
+			return fmt.Errorf("cannot restore backup, a restore of the backup from region %s at revision %d did not complete, retry with that backup", prev.Region, prev.Revision)
+		}
+		resume = true
+	}
+	if !resume {
+		existing := []string{}
+		err := objStore.List(prefix, func(key, val []byte, rev, modRev int64) error {
+			_, typ, _, err := objstore.DbKeyPrefixParse(string(key))
+			if err != nil {
+				return err
+			}
+			if _, found := backupSkipTypes[typ]; found {
+				return nil
+			}
+			if _, found := restoreOverwriteTypes[typ]; found {
+				return nil
+			}
+			existing = append(existing, objstore.DbKeyPrefixRemove(string(key)))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			sort.Strings(existing)
+			return fmt.Errorf("cannot restore backup, region is not empty, found %d objects including %s", len(existing), existing[0])
+		}
+	}
+
+	objs := snap.Objs
+	vers := snap.DataModelVersion
+	if vers.Hash != latestVers.Hash {
+		log.SpanLog(ctx, log.DebugLevelApi, "upgrading backup data", "from", vers, "to", latestVers)
+		upgraded, err := upgradeControllerSnapshot(ctx, allApis, upgradeSupport, snap, upgradeFuncs)
+		if err != nil {
+			return fmt.Errorf("failed to upgrade backup data model: %s", err)
+		}
+		objs = upgraded
+		vers = *latestVers
+	}
+
+	log.SpanLog(ctx, log.DebugLevelApi, "restoring controller snapshot", "revision", snap.Revision, "numObjs", len(objs), "version", vers, "resume", resume)
+	markerVal, err := json.Marshal(&marker)
+	if err != nil {
+		return err
+	}
+	if _, err := objStore.Put(ctx, markerKey, string(markerVal)); err != nil {
+		return fmt.Errorf("failed to write restore marker, %s", err)
+	}
+	keys := make([]string, 0, len(objs))
+	for key := range objs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for len(keys) > 0 {
+		num := restoreBatchSize
+		if num > len(keys) {
+			num = len(keys)
+		}
+		batch := keys[:num]
+		keys = keys[num:]
+		_, err := objStore.ApplySTM(ctx, func(stm concurrency.STM) error {
+			for _, key := range batch {
+				stm.Put(prefix+key, objs[key])
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to restore objects %s to %s, %s", batch[0], batch[len(batch)-1], err)
+		}
+	}
+	versVal, err := json.Marshal(&vers)
+	if err != nil {
+		return err
+	}
+	_, err = objStore.ApplySTM(ctx, func(stm concurrency.STM) error {
+		stm.Put(objstore.DbKeyPrefixString(DataModelVersion2Prefix), string(versVal))
+		stm.Del(markerKey)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write restored data model version, %s", err)
+	}
+	return nil
+}
+
+// upgradeControllerSnapshot runs the upgrade functions against a
+// staging copy of the snapshot, and returns the upgraded objects.
+// Nothing outside of the staging store may change before the
+// restore commits, so the upgrades get no vault access, and
+// upgrades that change external state are rejected.
+func upgradeControllerSnapshot(ctx context.Context, allApis *AllApis, upgradeSupport *UpgradeSupport, snap *controllerSnapshot, upgradeFuncs []VersionUpgrade) (map[string]string, error) {
+	for _, upgrade := range upgradeFuncs {
+		if upgrade.id <= snap.DataModelVersion.ID || upgrade.upgradeFunc == nil {
+			continue
+		}
+		if _, found := upgradeExternalChanges[upgrade.name]; found {
+			return nil, fmt.Errorf("upgrade %s changes state outside of the region data and cannot be run before the restore", upgrade.name)
+		}
+	}
+	stagingSupport := &UpgradeSupport{
+		staging: true,
+	}
+	if upgradeSupport != nil {
+		stagingSupport.region = upgradeSupport.region
+	}
+
+	staging := &regiondata.InMemoryStore{}
+	if err := staging.Start(); err != nil {
+		return nil, err
+	}
+	defer staging.Stop()
+
+	prefix := objstore.DbKeyPrefixString("")
+	for key, val := range snap.Objs {
+		if _, err := staging.Put(ctx, prefix+key, val); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeDataModelVersionV2(ctx, staging, &snap.DataModelVersion); err != nil {
+		return nil, err
+	}
+	if err := upgradeToLatest(&snap.DataModelVersion, staging, allApis, stagingSupport, upgradeFuncs); err != nil {
+		return nil, err
+	}
+	objs := make(map[string]string)
+	err := staging.List(prefix, func(key, val []byte, rev, modRev int64) error {
+		_, typ, _, err := objstore.DbKeyPrefixParse(string(key))
+		if err != nil {
+			return err
+		}
+		if typ == DataModelVersion0Prefix || typ == DataModelVersion2Prefix {
+			return nil
+		}
+		objs[strings.TrimPrefix(string(key), prefix)] = string(val)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func getBackupKey(passphrase string, salt []byte, iter int) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(passhash.Passhash([]byte(passphrase), salt, iter))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encryptControllerSnapshot(snap *controllerSnapshot, passphrase string) (string, error) {
+	plaintext, err := json.Marshal(snap)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal snapshot, %s", err)
+	}
+	env := controllerBackupEnvelope{
+		Salt: make([]byte, backupSaltBytes),
+		Iter: backupKeyIter,
+	}
+	if _, err := rand.Read(env.Salt); err != nil {
+		return "", err
+	}
+	gcm, err := getBackupKey(passphrase, env.Salt, env.Iter)
+	if err != nil {
+		return "", err
+	}
+	env.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(env.Nonce); err != nil {
+		return "", err
+	}
+	env.Ciphertext = gcm.Seal(nil, env.Nonce, plaintext, nil)
+	out, err := json.Marshal(&env)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+func decryptControllerSnapshot(data, passphrase string) (*controllerSnapshot, error) {
+	out, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode backup data, %s", err)
+	}
+	env := controllerBackupEnvelope{}
+	dec := json.NewDecoder(bytes.NewReader(out))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&env); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backup data, %s", err)
+	}
+	if env.Iter <= 0 || env.Iter > backupKeyIterMax {
+		return nil, fmt.Errorf("invalid backup data, bad key iterations %d", env.Iter)
+	}
+	gcm, err := getBackupKey(passphrase, env.Salt, env.Iter)
+	if err != nil {
+		return nil, err
+	}
+	if len(env.Nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid backup data, bad nonce size")
+	}
+	plaintext, err := gcm.Open(nil, env.Nonce, env.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt backup, invalid passphrase or corrupt data")
+	}
+	snap := &controllerSnapshot{}
+	if err := json.Unmarshal(plaintext, snap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backup snapshot, %s", err)
+	}
+	return snap, nil
+}
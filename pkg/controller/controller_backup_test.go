@@ -0,0 +1,222 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/pkg/vault"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestControllerBackup(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelUpgrade | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	kvstore := &regiondata.InMemoryStore{}
+	kvstore.Start()
+	defer kvstore.Stop()
+
+	flavorStore := edgeproto.NewFlavorStore(kvstore)
+	for _, flavor := range testutil.FlavorData() {
+		_, err := flavorStore.Put(ctx, &flavor, nil)
+		require.Nil(t, err)
+	}
+	appStore := edgeproto.NewAppStore(kvstore)
+	for _, app := range testutil.AppData() {
+		_, err := appStore.Put(ctx, &app, nil)
+		require.Nil(t, err)
+	}
+	cloudletNode := edgeproto.CloudletNode{
+		Key: edgeproto.CloudletNodeKey{
+			Name:        "node1",
+			CloudletKey: testutil.CloudletData()[0].Key,
+		},
+		NodeType:     "platformvm",
+		PasswordHash: "hash",
+		Salt:         "salt",
+		Iter:         10000,
+	}
+	cloudletNodeStore := edgeproto.NewCloudletNodeStore(kvstore)
+	_, err := cloudletNodeStore.Put(ctx, &cloudletNode, nil)
+	require.Nil(t, err)
+	ctrl := edgeproto.Controller{
+		Key: edgeproto.ControllerKey{Addr: "127.0.0.1:55001"},
+	}
+	_, err = edgeproto.NewControllerStore(kvstore).Put(ctx, &ctrl, nil)
+	require.Nil(t, err)
+	oldVers := &edgeproto.DataModelVersion{
+		Hash: "10",
+		ID:   10,
+	}
+	err = writeDataModelVersionV2(ctx, kvstore, oldVers)
+	require.Nil(t, err)
+	numObjs := len(testutil.FlavorData()) + len(testutil.AppData()) + 1
+
+	// snapshot excludes secrets, controllers, and the version
+	snap, err := getControllerSnapshot(ctx, kvstore, false)
+	require.Nil(t, err)
+	require.Equal(t, numObjs, len(snap.Objs))
+	require.NotZero(t, snap.Revision)
+	nodeKey := objstore.DbKeyString("CloudletNode", &cloudletNode.Key)
+	require.NotContains(t, snap.Objs[nodeKey[len(objstore.DbKeyPrefixString("")):]], "password_hash")
+	snap.DataModelVersion = *oldVers
+
+	secretSnap, err := getControllerSnapshot(ctx, kvstore, true)
+	require.Nil(t, err)
+	require.Equal(t, numObjs, len(secretSnap.Objs))
+	require.Contains(t, secretSnap.Objs[nodeKey[len(objstore.DbKeyPrefixString("")):]], "password_hash")
+
+	// encryption round trip
+	data, err := encryptControllerSnapshot(snap, "secret passphrase")
+	require.Nil(t, err)
+	_, err = decryptControllerSnapshot(data, "wrong passphrase")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid passphrase")
+	restoreSnap, err := decryptControllerSnapshot(data, "secret passphrase")
+	require.Nil(t, err)
+	require.Equal(t, snap, restoreSnap)
+
+	// bad key iterations are rejected before deriving the key
+	env := controllerBackupEnvelope{}
+	envData, err := base64.StdEncoding.DecodeString(data)
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(envData, &env))
+	env.Iter = backupKeyIterMax + 1
+	envData, err = json.Marshal(&env)
+	require.Nil(t, err)
+	_, err = decryptControllerSnapshot(base64.StdEncoding.EncodeToString(envData), "secret passphrase")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "bad key iterations")
+
+	upgradesDone := []int32{}
+	upgradeStores := []objstore.KVStore{}
+	upgradeSupports := []*UpgradeSupport{}
+	upgradedKey := objstore.DbKeyPrefixString("Upgraded")
+	runUpgrade := func(ctx context.Context, objStore objstore.KVStore, allApis *AllApis, upgradeSupport *UpgradeSupport, id int32) error {
+		upgradesDone = append(upgradesDone, id)
+		upgradeStores = append(upgradeStores, objStore)
+		upgradeSupports = append(upgradeSupports, upgradeSupport)
+		_, err := objStore.Put(ctx, upgradedKey, fmt.Sprintf("%d", id))
+		return err
+	}
+	upgradeFuncs := []VersionUpgrade{
+		{0, "0", nil, ""},
+		{10, "10", runUpgrade, "10"},
+		{11, "11", runUpgrade, "11"},
+		{12, "12", runUpgrade, "12"},
+	}
+	latestVers := &edgeproto.DataModelVersion{
+		Hash: "12",
+		ID:   12,
+	}
+
+	// cannot restore into a region with data
+	err = restoreControllerSnapshot(ctx, kvstore, nil, nil, restoreSnap, latestVers, upgradeFuncs)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "region is not empty")
+
+	// restore into an empty region, with default settings present
+	newstore := &regiondata.InMemoryStore{}
+	newstore.Start()
+	defer newstore.Stop()
+	_, err = edgeproto.NewSettingsStore(newstore).Put(ctx, edgeproto.GetDefaultSettings(), nil)
+	require.Nil(t, err)
+	err = writeDataModelVersionV2(ctx, newstore, latestVers)
+	require.Nil(t, err)
+
+	// cannot restore a newer version
+	newerSnap := *restoreSnap
+	newerSnap.DataModelVersion = edgeproto.DataModelVersion{Hash: "13", ID: 13}
+	err = restoreControllerSnapshot(ctx, newstore, nil, nil, &newerSnap, latestVers, upgradeFuncs)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "is newer than")
+
+	// a restore interrupted part way through blocks restoring any
+	// other backup, but can be retried with the same backup
+	restoreBatchSize = 2
+	defer func() { restoreBatchSize = 100 }()
+	marker, err := json.Marshal(&restoreMarker{
+		Region:   restoreSnap.Region,
+		Revision: restoreSnap.Revision,
+	})
+	require.Nil(t, err)
+	_, err = newstore.Put(ctx, objstore.DbKeyPrefixString(restoreMarkerPrefix), string(marker))
+	require.Nil(t, err)
+	_, err = edgeproto.NewAppStore(newstore).Put(ctx, &testutil.AppData()[0], nil)
+	require.Nil(t, err)
+	otherSnap := *restoreSnap
+	otherSnap.Revision++
+	err = restoreControllerSnapshot(ctx, newstore, nil, nil, &otherSnap, latestVers, upgradeFuncs)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "did not complete")
+
+	// upgrades that change external state cannot be run before
+	// the restore commits
+	upgradeExternalChanges["12"] = struct{}{}
+	err = restoreControllerSnapshot(ctx, newstore, nil, &UpgradeSupport{region: "local"}, restoreSnap, latestVers, upgradeFuncs)
+	delete(upgradeExternalChanges, "12")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "upgrade 12 changes state outside of the region data")
+	require.Equal(t, 0, len(upgradesDone))
+
+	err = restoreControllerSnapshot(ctx, newstore, nil, &UpgradeSupport{region: "local", vaultConfig: &vault.Config{}}, restoreSnap, latestVers, upgradeFuncs)
+	require.Nil(t, err)
+	require.Equal(t, []int32{11, 12}, upgradesDone)
+	// upgrades ran against a staging store, not the live region,
+	// without access to vault
+	require.Equal(t, 2, len(upgradeStores))
+	for _, store := range upgradeStores {
+		require.NotEqual(t, newstore, store)
+	}
+	for _, sup := range upgradeSupports {
+		require.True(t, sup.staging)
+		require.Nil(t, sup.vaultConfig)
+		require.Equal(t, "local", sup.region)
+	}
+	val, _, _, err := newstore.Get(upgradedKey)
+	require.Nil(t, err)
+	require.Equal(t, "12", string(val))
+	_, _, _, err = newstore.Get(objstore.DbKeyPrefixString(restoreMarkerPrefix))
+	require.NotNil(t, err)
+	vers, err := getDataVersion(ctx, newstore, latestVers)
+	require.Nil(t, err)
+	require.Equal(t, latestVers, vers)
+
+	for _, app := range testutil.AppData() {
+		buf := edgeproto.App{}
+		require.True(t, appStore.Get(ctx, &app.Key, &buf))
+		restored := edgeproto.App{}
+		require.True(t, edgeproto.NewAppStore(newstore).Get(ctx, &app.Key, &restored))
+		require.Equal(t, buf, restored)
+	}
+	restoredNode := edgeproto.CloudletNode{}
+	require.True(t, edgeproto.NewCloudletNodeStore(newstore).Get(ctx, &cloudletNode.Key, &restoredNode))
+	require.Equal(t, "platformvm", restoredNode.NodeType)
+	require.Empty(t, restoredNode.PasswordHash)
+	_, _, _, err = newstore.Get(objstore.DbKeyString("Controller", &ctrl.Key))
+	require.NotNil(t, err)
+}
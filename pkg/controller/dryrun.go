@@ -0,0 +1,110 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"errors"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+)
+
+// errDryRun is returned from the STM func of a dry run request
+// after all checks have passed, so that no changes are committed.
+var errDryRun = errors.New("dry run, changes not applied")
+
+// placementTracker records the placement decisions made during a
+// dry run. All functions are no-ops on a nil tracker so callers
+// do not need to check if the request is a dry run.
+type placementTracker struct {
+	candidates []edgeproto.PlacementCandidate
+}
+
+// reset clears any decisions, as the STM func may be rerun.
+func (s *placementTracker) reset() {
+	if s == nil {
+		return
+	}
+	s.candidates = nil
+}
+
+func (s *placementTracker) find(cloudletKey *edgeproto.CloudletKey, clusterKey *edgeproto.ClusterKey) *edgeproto.PlacementCandidate {
+	for ii := range s.candidates {
+		pc := &s.candidates[ii]
+		if pc.CloudletKey.Matches(cloudletKey) && pc.ClusterKey.Matches(clusterKey) {
+			return pc
+		}
+	}
+	pc := edgeproto.PlacementCandidate{
+		CloudletKey: *cloudletKey,
+		ClusterKey:  *clusterKey,
+	}
+	s.candidates = append(s.candidates, pc)
+	return &s.candidates[len(s.candidates)-1]
+}
+
+// usable records a candidate that passed validation.
+func (s *placementTracker) usable(cloudletKey *edgeproto.CloudletKey, clusterKey *edgeproto.ClusterKey, resourceScore uint64) {
+	if s == nil {
+		return
+	}
+	pc := s.find(cloudletKey, clusterKey)
+	pc.ResourceScore = resourceScore
+}
+
+// skip records a candidate that cannot be used.
+func (s *placementTracker) skip(cloudletKey *edgeproto.CloudletKey, clusterKey *edgeproto.ClusterKey, reason SkipReason, err error) {
+	if s == nil {
+		return
+	}
+	pc := s.find(cloudletKey, clusterKey)
+	pc.Chosen = false
+	pc.SkipReason = string(reason)
+	if err != nil {
+		pc.Details = err.Error()
+	}
+}
+
+// choose records the candidate chosen for deployment.
+func (s *placementTracker) choose(cloudletKey *edgeproto.CloudletKey, clusterKey *edgeproto.ClusterKey, details string) {
+	if s == nil {
+		return
+	}
+	for ii := range s.candidates {
+		s.candidates[ii].Chosen = false
+	}
+	pc := s.find(cloudletKey, clusterKey)
+	pc.Chosen = true
+	pc.SkipReason = ""
+	pc.Details = details
+}
+
+func (s *placementTracker) getResult(msg string) *edgeproto.Result {
+	res := &edgeproto.Result{
+		Message: msg,
+	}
+	if s != nil {
+		res.PlacementCandidates = s.candidates
+	}
+	return res
+}
+
+// sendDryRunFailure sends any placement decisions that were made
+// before a dry run request failed, to explain the failure.
+func sendDryRunFailure(cctx *CallContext, cb GenericCb, err error) {
+	if err == nil || !cctx.DryRun || cctx.Placement == nil || len(cctx.Placement.candidates) == 0 {
+		return
+	}
+	cb.Send(cctx.Placement.getResult("Dry run failed, " + err.Error()))
+}
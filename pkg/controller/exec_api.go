@@ -17,15 +17,20 @@ package controller
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/notify"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
 	"github.com/segmentio/ksuid"
+	"google.golang.org/grpc/metadata"
 )
 
 type ExecApi struct {
@@ -44,6 +49,8 @@ const (
 	ShortTimeout = edgeproto.Duration(6 * time.Second)
 	// For VM based Apps
 	LongTimeout = edgeproto.Duration(60 * time.Second)
+	// Max length of ShowLogs filter
+	MaxLogFilterLen = 256
 )
 
 var execRequestSendMany *notify.ExecRequestSendMany
@@ -115,6 +122,20 @@ func (s *ExecApi) ShowLogs(ctx context.Context, req *edgeproto.ExecRequest) (*ed
 			return nil, fmt.Errorf("Unable to parse Since field as duration or RFC3339 formatted time")
 		}
 	}
+	if req.Log.AllContainers && req.ContainerId != "" {
+		return nil, fmt.Errorf("Cannot specify container id with all containers")
+	}
+	if len(req.Log.Filter) > MaxLogFilterLen {
+		return nil, fmt.Errorf("Filter cannot be longer than %d characters", MaxLogFilterLen)
+	}
+	if req.Log.FilterRegex {
+		if req.Log.Filter == "" {
+			return nil, fmt.Errorf("Filter regex requires a filter")
+		}
+		if _, err := regexp.Compile(req.Log.Filter); err != nil {
+			return nil, fmt.Errorf("Invalid filter regex, %v", err)
+		}
+	}
 	return s.doExchange(ctx, &cloudlet, req)
 }
 
@@ -177,6 +198,140 @@ func (s *ExecApi) RunConsole(ctx context.Context, req *edgeproto.ExecRequest) (*
 	return s.doExchange(ctx, &cloudlet, req)
 }
 
+func (s *ExecApi) RunCopyFile(ctx context.Context, req *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
+	copyFile := req.CopyFile
+	if copyFile == nil {
+		return nil, fmt.Errorf("No copy file specified")
+	}
+	req.Cmd = nil
+	req.Log = nil
+	req.Console = nil
+	req.PortForward = nil
+	// Be very careful about validating string input. These arguments
+	// will be passed to the command line in the VM, which user should
+	// not have access to.
+	if err := ValidateCopyFile(copyFile); err != nil {
+		return nil, err
+	}
+	app := edgeproto.App{}
+	cloudlet := edgeproto.Cloudlet{}
+	if err := s.getApp(ctx, req, &app, &cloudlet); err != nil {
+		return nil, err
+	}
+	if app.Deployment == cloudcommon.DeploymentTypeVM {
+		return nil, fmt.Errorf("RunCopyFile not available for VM deployments")
+	}
+	if err := ValidateContainerName(app.Deployment, req.ContainerId); err != nil {
+		return nil, err
+	}
+	req.Timeout = ShortTimeout
+	return s.doExchange(ctx, &cloudlet, req)
+}
+
+func (s *ExecApi) RunPortForward(ctx context.Context, req *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
+	portForward := req.PortForward
+	if portForward == nil {
+		return nil, fmt.Errorf("No port forward specified")
+	}
+	req.Cmd = nil
+	req.Log = nil
+	req.Console = nil
+	req.CopyFile = nil
+	if portForward.Ttl < 0 {
+		return nil, fmt.Errorf("invalid ttl %s", portForward.Ttl.TimeDuration())
+	}
+	// The user is always taken from the authenticated caller,
+	// never from the request.
+	user, err := getPortForwardUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine user for port forward session, %s", err)
+	}
+	portForward.User = user
+	app := edgeproto.App{}
+	cloudlet := edgeproto.Cloudlet{}
+	if err := s.getApp(ctx, req, &app, &cloudlet); err != nil {
+		return nil, err
+	}
+	if app.Deployment != cloudcommon.DeploymentTypeDocker && app.Deployment != cloudcommon.DeploymentTypeKubernetes {
+		return nil, fmt.Errorf("RunPortForward not supported for %s deployments", app.Deployment)
+	}
+	appInst := edgeproto.AppInst{}
+	if !s.all.appInstApi.Get(&req.AppInstKey, &appInst) {
+		return nil, req.AppInstKey.NotFoundError()
+	}
+	if err := ValidatePortForwardPort(&appInst, portForward.Port); err != nil {
+		return nil, err
+	}
+	req.Timeout = ShortTimeout
+	return s.doExchange(ctx, &cloudlet, req)
+}
+
+// getPortForwardUser gets the user from the authenticated connection.
+// MC authenticates users and forwards the user name in the metadata,
+// which is only trusted from globally issued certs. Other clients
+// are identified by their client cert.
+func getPortForwardUser(ctx context.Context) (string, error) {
+	issuer, commonName, err := node.GetPeerCertIssuer(ctx)
+	if err != nil {
+		return "", err
+	}
+	if issuer != node.CertIssuerGlobal {
+		if commonName == "" {
+			return "", fmt.Errorf("client certificate has no common name")
+		}
+		return commonName, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	users := md.Get(cloudcommon.CallerUser)
+	if len(users) == 0 || users[0] == "" {
+		return "", fmt.Errorf("%s not specified by %s", cloudcommon.CallerUser, commonName)
+	}
+	return users[0], nil
+}
+
+// ValidatePortForwardPort checks that the port is one of the
+// AppInst's TCP ports.
+func ValidatePortForwardPort(appInst *edgeproto.AppInst, port int32) error {
+	if port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid port %d", port)
+	}
+	for _, p := range appInst.MappedPorts {
+		if p.Proto != dme.LProto_L_PROTO_TCP {
+			continue
+		}
+		endPort := p.EndPort
+		if endPort == 0 {
+			endPort = p.InternalPort
+		}
+		if port >= p.InternalPort && port <= endPort {
+			return nil
+		}
+	}
+	return fmt.Errorf("port %d is not a TCP port of AppInst %s", port, appInst.Key.GetKeyString())
+}
+
+func ValidateCopyFile(copyFile *edgeproto.CopyFile) error {
+	if copyFile.Path == "" {
+		return fmt.Errorf("path argument required")
+	}
+	if !strings.HasPrefix(copyFile.Path, "/") {
+		return fmt.Errorf("path must be an absolute path")
+	}
+	if strings.ContainsAny(copyFile.Path, "\x00\n\r") {
+		return fmt.Errorf("path contains invalid characters")
+	}
+	if copyFile.FileSize < 0 {
+		return fmt.Errorf("invalid file size %d", copyFile.FileSize)
+	}
+	if copyFile.FileSize > cloudcommon.ExecCopyFileMaxSize {
+		return fmt.Errorf("file size %d exceeds max allowed size of %d bytes", copyFile.FileSize, cloudcommon.ExecCopyFileMaxSize)
+	}
+	if !copyFile.Upload && copyFile.FileSize != 0 {
+		return fmt.Errorf("file size only valid for upload")
+	}
+	return nil
+}
+
 func (s *ExecApi) doExchange(ctx context.Context, cloudlet *edgeproto.Cloudlet, req *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
 	// Make sure EdgeTurn Server Address is present
 	if *edgeTurnAddr == "" {
@@ -0,0 +1,79 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGetPortForwardUser(t *testing.T) {
+	ctx := context.Background()
+
+	peerCtx := func(issuer, commonName string) context.Context {
+		state := tls.ConnectionState{}
+		if issuer != "" {
+			leaf := &x509.Certificate{
+				Subject: pkix.Name{CommonName: commonName},
+			}
+			ca := &x509.Certificate{
+				IsCA:     true,
+				DNSNames: []string{issuer},
+			}
+			state.VerifiedChains = [][]*x509.Certificate{{leaf, ca}}
+		}
+		return peer.NewContext(ctx, &peer.Peer{
+			Addr:     &net.TCPAddr{},
+			AuthInfo: credentials.TLSInfo{State: state},
+		})
+	}
+	withUser := func(ctx context.Context, user string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(cloudcommon.CallerUser, user))
+	}
+
+	// no TLS
+	_, err := getPortForwardUser(withUser(ctx, "user1"))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "no grpc peer context")
+
+	// no verified client cert
+	_, err = getPortForwardUser(withUser(peerCtx("", ""), "user1"))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "no verified client certificate")
+
+	// MC forwards the user it authenticated
+	user, err := getPortForwardUser(withUser(peerCtx(node.CertIssuerGlobal, "mc"), "user1"))
+	require.Nil(t, err)
+	require.Equal(t, "user1", user)
+	_, err = getPortForwardUser(peerCtx(node.CertIssuerGlobal, "mc"))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not specified by mc")
+
+	// other clients cannot pick the user, the cert identifies them
+	user, err = getPortForwardUser(withUser(peerCtx(node.CertIssuerRegional, "edgectl"), "user1"))
+	require.Nil(t, err)
+	require.Equal(t, "edgectl", user)
+}
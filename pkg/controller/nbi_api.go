@@ -102,6 +102,43 @@ func (s *NBIAPI) GetApp(ctx context.Context, request nbi.GetAppRequestObject) (n
 	return resp, nil
 }
 
+func (s *NBIAPI) UpdateApp(ctx context.Context, request nbi.UpdateAppRequestObject) (nbi.UpdateAppResponseObject, error) {
+	cur, err := s.allApis.appApi.getAppByID(ctx, request.AppId)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	if cur == nil {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "app not found")
+	}
+	if request.Body.AppId != nil && *request.Body.AppId != request.AppId {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, "app ID in body does not match app ID in path")
+	}
+	if request.Body.Name != cur.Key.Name || request.Body.AppProvider != cur.Key.Organization || request.Body.Version != cur.Key.Version {
+		return nil, nbi.NewErrorInfo(http.StatusConflict, "cannot change app name, provider, or version")
+	}
+	ecApp, err := ProtoAppUpdate(cur, request.Body)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	if len(ecApp.Fields) > 0 {
+		_, err = s.allApis.appApi.UpdateApp(ctx, ecApp)
+		if err != nil {
+			return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+		}
+	}
+	updated := edgeproto.App{}
+	if !s.allApis.appApi.cache.Get(&cur.Key, &updated) {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "app not found")
+	}
+	nbiApp, err := NBIApp(&updated)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	resp := nbi.UpdateApp200JSONResponse{}
+	resp.Body = *nbiApp
+	return resp, nil
+}
+
 func (s *NBIAPI) GetAppInstance(ctx context.Context, request nbi.GetAppInstanceRequestObject) (nbi.GetAppInstanceResponseObject, error) {
 	filter := edgeproto.AppInst{}
 	if request.Params.AppId != nil {
@@ -198,6 +235,51 @@ func (s *NBIAPI) DeleteAppInstance(ctx context.Context, request nbi.DeleteAppIns
 	return resp, nil
 }
 
+func (s *NBIAPI) UpdateAppInstance(ctx context.Context, request nbi.UpdateAppInstanceRequestObject) (nbi.UpdateAppInstanceResponseObject, error) {
+	appInst, err := s.allApis.appInstApi.getAppInstByID(ctx, request.AppInstanceId)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	if appInst == nil {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "app instance not found")
+	}
+	in, err := ProtoAppInstUpdate(&appInst.Key, request.Body)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	if len(in.Fields) > 0 {
+		err = s.allApis.appInstApi.UpdateAppInst(in, NewStreamoutAppInst(ctx))
+		if err != nil {
+			return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+		}
+	}
+	// bring the instance up to date with any App updates
+	app := edgeproto.App{}
+	if s.allApis.appApi.cache.Get(&appInst.AppKey, &app) {
+		cur := edgeproto.AppInst{}
+		if s.allApis.appInstApi.cache.Get(&appInst.Key, &cur) && cur.Revision != app.Revision {
+			refresh := edgeproto.AppInst{
+				Key: appInst.Key,
+			}
+			err = s.allApis.appInstApi.RefreshAppInst(&refresh, NewStreamoutAppInst(ctx))
+			if err != nil {
+				return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+			}
+		}
+	}
+	updated := edgeproto.AppInst{}
+	if !s.allApis.appInstApi.cache.Get(&appInst.Key, &updated) {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "app instance not found")
+	}
+	instOut, err := s.NBIAppInst(&updated)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	resp := nbi.UpdateAppInstance202JSONResponse{}
+	resp.Body = *instOut
+	return resp, nil
+}
+
 type StreamoutAppInst struct {
 	grpc.ServerStream
 	ctx context.Context
@@ -250,3 +332,43 @@ func (s *NBIAPI) GetEdgeCloudZones(ctx context.Context, request nbi.GetEdgeCloud
 	slices.SortStableFunc(resp.Body, NBIZoneSort)
 	return nbi.GetEdgeCloudZonesResponseObject(resp), nil
 }
+
+func (s *NBIAPI) GetSubscriptions(ctx context.Context, request nbi.GetSubscriptionsRequestObject) (nbi.GetSubscriptionsResponseObject, error) {
+	if request.Params.AppProvider == "" {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, "missing app provider")
+	}
+	subs, err := s.allApis.nbiSubscriptionApi.showSubscriptions(ctx, redisClient, request.Params.AppProvider)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	resp := nbi.GetSubscriptions200JSONResponse{}
+	resp.Body = subs
+	return resp, nil
+}
+
+func (s *NBIAPI) CreateSubscription(ctx context.Context, request nbi.CreateSubscriptionRequestObject) (nbi.CreateSubscriptionResponseObject, error) {
+	if request.Body == nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, "missing request body")
+	}
+	sub, err := s.allApis.nbiSubscriptionApi.createSubscription(ctx, request.Body)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, err.Error())
+	}
+	resp := nbi.CreateSubscription201JSONResponse{}
+	resp.Body = *sub
+	return resp, nil
+}
+
+func (s *NBIAPI) DeleteSubscription(ctx context.Context, request nbi.DeleteSubscriptionRequestObject) (nbi.DeleteSubscriptionResponseObject, error) {
+	if request.Params.AppProvider == "" {
+		return nil, nbi.NewErrorInfo(http.StatusBadRequest, "missing app provider")
+	}
+	found, err := s.allApis.nbiSubscriptionApi.deleteSubscription(ctx, request.SubscriptionId, request.Params.AppProvider)
+	if err != nil {
+		return nil, nbi.NewErrorInfo(http.StatusInternalServerError, err.Error())
+	}
+	if !found {
+		return nil, nbi.NewErrorInfo(http.StatusNotFound, "subscription not found")
+	}
+	return nbi.DeleteSubscription204Response{}, nil
+}
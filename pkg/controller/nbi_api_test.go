@@ -90,6 +90,30 @@ func TestNBIAPI(t *testing.T) {
 		appData.NBI.AppId = &appID
 		require.Equal(t, appData.NBI, getResp200.Body.AppManifest)
 
+		// update app with no changes
+		updReq := nbi.UpdateAppRequestObject{
+			AppId: appID,
+			Body:  appData.NBI,
+		}
+		updResp, err := nbiApis.UpdateApp(ctx, updReq)
+		require.Nil(t, err)
+		updResp200, ok := updResp.(nbi.UpdateApp200JSONResponse)
+		require.True(t, ok, "expect 200 but got %T", updResp)
+		require.Equal(t, *appData.NBI, updResp200.Body)
+
+		// update app key not allowed
+		badApp := *appData.NBI
+		badApp.Version = "bad-version"
+		updReq.Body = &badApp
+		_, err = nbiApis.UpdateApp(ctx, updReq)
+		requireErrRespCode(t, http.StatusConflict, err)
+
+		// update unknown app
+		updReq.AppId = "unknown"
+		updReq.Body = appData.NBI
+		_, err = nbiApis.UpdateApp(ctx, updReq)
+		requireErrRespCode(t, http.StatusNotFound, err)
+
 		// instance app across given zones
 		zones := []*edgeproto.Zone{}
 		err = apis.zoneApi.cache.Show(&edgeproto.Zone{}, func(obj *edgeproto.Zone) error {
@@ -159,6 +183,22 @@ func TestNBIAPI(t *testing.T) {
 			appInstOut.KubernetesClusterRef = nil
 			require.Equal(t, *expInst, appInstOut)
 
+			// update the AppInst with no changes
+			updReq := nbi.UpdateAppInstanceRequestObject{
+				AppInstanceId: appInstID,
+				Body:          &nbi.UpdateAppInstanceJSONRequestBody{},
+			}
+			updResp, err := nbiApis.UpdateAppInstance(ctx, updReq)
+			require.Nil(t, err)
+			updResp202, ok := updResp.(nbi.UpdateAppInstance202JSONResponse)
+			require.True(t, ok, "expect 202 but got %T", updResp)
+			require.Equal(t, appInstID, updResp202.Body.AppInstanceId)
+
+			// update unknown AppInst
+			updReq.AppInstanceId = "unknown"
+			_, err = nbiApis.UpdateAppInstance(ctx, updReq)
+			requireErrRespCode(t, http.StatusNotFound, err)
+
 			// delete the AppInst
 			delReq := nbi.DeleteAppInstanceRequestObject{}
 			delReq.AppInstanceId = appInstID
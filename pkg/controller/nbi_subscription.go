@@ -0,0 +1,531 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/oklog/ulid/v2"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// NBI subscriptions allow NBI clients to register a callback URL
+// (sink) to be notified of AppInst status changes instead of polling.
+// Subscriptions are stored in redis so that they are shared by all
+// controllers, and expire via the redis key TTL. Notifications are
+// sent by the controller that receives the AppInstInfo state change
+// from the CRM, when it is published to the redis stream.
+// Subscriptions are owned by an AppProvider, and only receive events
+// for that AppProvider's AppInsts. Each AppProvider has a redis set
+// indexing its subscription IDs, so that only its subscriptions are
+// read on an AppInst status change. IDs of expired subscriptions are
+// removed from the index when they are found to be missing.
+// Notifications are sent by a fixed number of workers so that slow
+// sinks do not block the AppInstInfo updates from the CRM. Failed
+// sends are retried from timers, so that dead sinks do not hold up
+// the workers. Sinks may not be on private networks, to avoid the
+// controller being used to reach internal services.
+
+const (
+	NBISubscriptionKeyPrefix   = "nbisubscription/"
+	NBISubscriptionIndexPrefix = "nbisubscriptionindex/"
+	NBIAppInstStatusEventType  = "org.edgexr.edge-application-management.app-instance-status-changed"
+	NBIAppInstStatusDeleted    = "deleted"
+	cloudEventsSpecVersion     = "1.0"
+)
+
+var (
+	nbiNotifyMaxAttempts   = 5
+	nbiNotifyRetryInterval = 2 * time.Second
+	nbiNotifyTimeout       = 10 * time.Second
+	nbiNotifyWorkers       = 10
+	// Notifications are never dropped, but a warning is logged
+	// if the queue grows larger than this.
+	nbiNotifyQueueWarnSize = 1000
+	// nbiSinkCheckIP verifies a sink IP is allowed, unit-tests
+	// may replace it to allow for local sinks.
+	nbiSinkCheckIP = checkPublicIP
+)
+
+type NBISubscriptionApi struct {
+	all         *AllApis
+	mux         sync.Mutex
+	lastStatus  map[edgeproto.AppInstKey]nbiAppInstStatus
+	client      *http.Client
+	queueMux    sync.Mutex
+	queueCond   *sync.Cond
+	queue       []*nbiNotifyWork
+	queueWarned bool
+	startWorker sync.Once
+}
+
+// nbiNotifyWork is work for the notify workers. Each is run in its
+// own span, as the span that queued it has usually finished.
+type nbiNotifyWork struct {
+	name   string
+	parent opentracing.SpanContext
+	run    func(ctx context.Context)
+}
+
+// nbiEventSend tracks the attempts to send an event to a sink.
+type nbiEventSend struct {
+	client        *redis.Client
+	sub           nbi.Subscription
+	event         *nbi.AppInstanceStatusEvent
+	data          []byte
+	attempt       int
+	retryInterval time.Duration
+}
+
+// nbiAppInstStatus tracks the last status notified for an AppInst.
+// The IDs are kept so that the deleted status can be sent after
+// the AppInst has been removed.
+type nbiAppInstStatus struct {
+	appInstID string
+	appID     string
+	org       string
+	status    string
+}
+
+func NewNBISubscriptionApi(all *AllApis) *NBISubscriptionApi {
+	// The dialer checks the resolved address of every connection,
+	// including redirects, so a sink cannot be switched to a private
+	// address after the subscription was created.
+	dialer := &net.Dialer{
+		Timeout: nbiNotifyTimeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			return nbiSinkCheckIP(net.ParseIP(host))
+		},
+	}
+	s := &NBISubscriptionApi{
+		all:        all,
+		lastStatus: make(map[edgeproto.AppInstKey]nbiAppInstStatus),
+		client: &http.Client{
+			Timeout: nbiNotifyTimeout,
+			Transport: &http.Transport{
+				DialContext: dialer.DialContext,
+			},
+		},
+	}
+	s.queueCond = sync.NewCond(&s.queueMux)
+	return s
+}
+
+// checkPublicIP returns an error if the IP is not a public address.
+func checkPublicIP(ip net.IP) error {
+	if ip == nil {
+		return fmt.Errorf("invalid IP address")
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return fmt.Errorf("address %s is not a public address", ip.String())
+	}
+	return nil
+}
+
+// checkSinkHost verifies that all addresses of the sink host are
+// allowed.
+func checkSinkHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		return nbiSinkCheckIP(ip)
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve host %s, %s", host, err)
+	}
+	for _, addr := range addrs {
+		if err := nbiSinkCheckIP(addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getNBISubscriptionKey(id string) string {
+	return NBISubscriptionKeyPrefix + id
+}
+
+func getNBISubscriptionIndexKey(appProvider string) string {
+	return NBISubscriptionIndexPrefix + appProvider
+}
+
+func (s *NBISubscriptionApi) createSubscription(ctx context.Context, in *nbi.SubscriptionRequest) (*nbi.Subscription, error) {
+	sinkURL, err := url.Parse(in.Sink)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sink %q, %s", in.Sink, err)
+	}
+	if sinkURL.Scheme != "http" && sinkURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid sink %q, scheme must be http or https", in.Sink)
+	}
+	if sinkURL.Hostname() == "" {
+		return nil, fmt.Errorf("invalid sink %q, missing host", in.Sink)
+	}
+	if err := checkSinkHost(ctx, sinkURL.Hostname()); err != nil {
+		return nil, fmt.Errorf("invalid sink %q, %s", in.Sink, err)
+	}
+	if in.AppProvider == "" {
+		return nil, fmt.Errorf("missing app provider")
+	}
+	if in.AppId != nil {
+		app, err := s.all.appApi.getAppByID(ctx, *in.AppId)
+		if err != nil {
+			return nil, err
+		}
+		if app == nil || app.Key.Organization != in.AppProvider {
+			return nil, fmt.Errorf("app ID %s not found", *in.AppId)
+		}
+	}
+	if in.AppInstanceId != nil {
+		appInst, err := s.all.appInstApi.getAppInstByID(ctx, *in.AppInstanceId)
+		if err != nil {
+			return nil, err
+		}
+		if appInst == nil || appInst.Key.Organization != in.AppProvider {
+			return nil, fmt.Errorf("app instance ID %s not found", *in.AppInstanceId)
+		}
+	}
+	now := time.Now()
+	var ttl time.Duration
+	if in.SubscriptionExpireTime != nil {
+		ttl = in.SubscriptionExpireTime.Sub(now)
+		if ttl <= 0 {
+			return nil, fmt.Errorf("subscription expire time must be in the future")
+		}
+	}
+	sub := nbi.Subscription{
+		SubscriptionId: ulid.Make().String(),
+		AppProvider:    in.AppProvider,
+		Sink:           in.Sink,
+		AppId:          in.AppId,
+		AppInstanceId:  in.AppInstanceId,
+		StartsAt:       now,
+		ExpiresAt:      in.SubscriptionExpireTime,
+	}
+	data, err := json.Marshal(&sub)
+	if err != nil {
+		return nil, err
+	}
+	_, err = redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, getNBISubscriptionKey(sub.SubscriptionId), string(data), ttl)
+		pipe.SAdd(ctx, getNBISubscriptionIndexKey(sub.AppProvider), sub.SubscriptionId)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store subscription, %s", err)
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "created nbi subscription", "id", sub.SubscriptionId, "sink", sub.Sink)
+	return &sub, nil
+}
+
+// showSubscriptions returns the subscriptions of the AppProvider.
+func (s *NBISubscriptionApi) showSubscriptions(ctx context.Context, client *redis.Client, appProvider string) ([]nbi.Subscription, error) {
+	subs := []nbi.Subscription{}
+	indexKey := getNBISubscriptionIndexKey(appProvider)
+	ids, err := client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return subs, nil
+	}
+	keys := []string{}
+	for _, id := range ids {
+		keys = append(keys, getNBISubscriptionKey(id))
+	}
+	vals, err := client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	stale := []interface{}{}
+	for ii, val := range vals {
+		str, ok := val.(string)
+		if !ok {
+			// expired or deleted
+			stale = append(stale, ids[ii])
+			continue
+		}
+		sub := nbi.Subscription{}
+		if err := json.Unmarshal([]byte(str), &sub); err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to unmarshal nbi subscription", "key", keys[ii], "err", err)
+			continue
+		}
+		if sub.ExpiresAt != nil && time.Now().After(*sub.ExpiresAt) {
+			continue
+		}
+		if sub.AppProvider != appProvider {
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	if len(stale) > 0 {
+		if err := client.SRem(ctx, indexKey, stale...).Err(); err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to remove expired nbi subscriptions from index", "appProvider", appProvider, "err", err)
+		}
+	}
+	slices.SortStableFunc(subs, func(a, b nbi.Subscription) int {
+		return strings.Compare(a.SubscriptionId, b.SubscriptionId)
+	})
+	return subs, nil
+}
+
+func (s *NBISubscriptionApi) deleteSubscription(ctx context.Context, id, appProvider string) (bool, error) {
+	key := getNBISubscriptionKey(id)
+	val, err := redisClient.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	sub := nbi.Subscription{}
+	if err := json.Unmarshal([]byte(val), &sub); err != nil {
+		return false, fmt.Errorf("failed to unmarshal subscription %s, %s", id, err)
+	}
+	if sub.AppProvider != appProvider {
+		// do not reveal subscriptions of other AppProviders
+		return false, nil
+	}
+	var del *redis.IntCmd
+	_, err = redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		del = pipe.Del(ctx, key)
+		pipe.SRem(ctx, getNBISubscriptionIndexKey(appProvider), id)
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return del.Val() > 0, nil
+}
+
+// appInstStatusChanged queues notifications to subscribers if the
+// NBI status of the AppInst changed. It does not block on sending
+// the notifications.
+func (s *NBISubscriptionApi) appInstStatusChanged(ctx context.Context, info *edgeproto.AppInstInfo) {
+	status := string(NBIAppInstStatus(info.State))
+	if info.State == edgeproto.TrackedState_DELETE_DONE || info.State == edgeproto.TrackedState_NOT_PRESENT {
+		status = NBIAppInstStatusDeleted
+	}
+
+	s.mux.Lock()
+	last, found := s.lastStatus[info.Key]
+	if !found {
+		appInst := edgeproto.AppInst{}
+		if !s.all.appInstApi.cache.Get(&info.Key, &appInst) {
+			s.mux.Unlock()
+			return
+		}
+		last.appInstID = appInst.ObjId
+		last.org = appInst.Key.Organization
+		app := edgeproto.App{}
+		if s.all.appApi.cache.Get(&appInst.AppKey, &app) {
+			last.appID = app.ObjId
+		}
+	}
+	if last.status == status {
+		s.mux.Unlock()
+		return
+	}
+	last.status = status
+	if status == NBIAppInstStatusDeleted {
+		delete(s.lastStatus, info.Key)
+	} else {
+		s.lastStatus[info.Key] = last
+	}
+	s.mux.Unlock()
+
+	var message *string
+	if len(info.Errors) > 0 {
+		msg := strings.Join(info.Errors, ", ")
+		message = &msg
+	}
+	now := time.Now()
+	// notifications are sent asynchronously and may outlive the
+	// controller services, so they use the current redis client
+	client := redisClient
+	if client == nil {
+		return
+	}
+	s.enqueue(ctx, "nbi notify subscribers", func(ctx context.Context) {
+		s.notifySubscribers(ctx, client, last, message, now)
+	})
+}
+
+// enqueue adds work for the notify workers. It never blocks the
+// caller and never drops the work.
+func (s *NBISubscriptionApi) enqueue(ctx context.Context, name string, run func(ctx context.Context)) {
+	s.startWorker.Do(func() {
+		for ii := 0; ii < nbiNotifyWorkers; ii++ {
+			go s.runWorker()
+		}
+	})
+	work := &nbiNotifyWork{
+		name: name,
+		run:  run,
+	}
+	if span := log.SpanFromContext(ctx); span != nil {
+		work.parent = span.Context()
+	}
+	s.queueMux.Lock()
+	defer s.queueMux.Unlock()
+	s.queue = append(s.queue, work)
+	if len(s.queue) > nbiNotifyQueueWarnSize {
+		if !s.queueWarned {
+			log.SpanLog(ctx, log.DebugLevelApi, "nbi subscription notify queue backlog", "len", len(s.queue))
+			s.queueWarned = true
+		}
+	} else {
+		s.queueWarned = false
+	}
+	s.queueCond.Signal()
+}
+
+func (s *NBISubscriptionApi) runWorker() {
+	for {
+		s.queueMux.Lock()
+		for len(s.queue) == 0 {
+			s.queueCond.Wait()
+		}
+		work := s.queue[0]
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+		s.queueMux.Unlock()
+
+		opts := []opentracing.StartSpanOption{}
+		if work.parent != nil {
+			opts = append(opts, opentracing.FollowsFrom(work.parent))
+		}
+		span := log.StartSpan(log.DebugLevelApi, work.name, opts...)
+		ctx := log.ContextWithSpan(context.Background(), span)
+		work.run(ctx)
+		span.Finish()
+	}
+}
+
+func (s *NBISubscriptionApi) notifySubscribers(ctx context.Context, client *redis.Client, last nbiAppInstStatus, message *string, now time.Time) {
+	subs, err := s.showSubscriptions(ctx, client, last.org)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "failed to get nbi subscriptions", "err", err)
+		return
+	}
+	for _, sub := range subs {
+		if sub.AppInstanceId != nil && *sub.AppInstanceId != last.appInstID {
+			continue
+		}
+		if sub.AppId != nil && *sub.AppId != last.appID {
+			continue
+		}
+		event := nbi.AppInstanceStatusEvent{
+			Id:          ulid.Make().String(),
+			Source:      cloudcommon.NBIRootPath + "/appinstances/" + last.appInstID,
+			Type:        NBIAppInstStatusEventType,
+			Specversion: cloudEventsSpecVersion,
+			Time:        now,
+			Data: nbi.AppInstanceStatusEventData{
+				SubscriptionId: sub.SubscriptionId,
+				AppInstanceId:  last.appInstID,
+				AppId:          last.appID,
+				Status:         last.status,
+				Message:        message,
+			},
+		}
+		data, err := json.Marshal(&event)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelApi, "failed to marshal nbi subscription event", "event", event, "err", err)
+			continue
+		}
+		send := &nbiEventSend{
+			client:        client,
+			sub:           sub,
+			event:         &event,
+			data:          data,
+			retryInterval: nbiNotifyRetryInterval,
+		}
+		s.enqueue(ctx, "nbi send event", func(ctx context.Context) {
+			s.sendEvent(ctx, send)
+		})
+	}
+}
+
+// sendEvent posts the event to the subscription sink. On failure it
+// schedules a retry with backoff, until the sink accepts it, the
+// subscription is removed, or the max number of attempts is reached.
+func (s *NBISubscriptionApi) sendEvent(ctx context.Context, send *nbiEventSend) {
+	sub := send.sub
+	if send.attempt > 0 {
+		// stop if the subscription was deleted or expired
+		if sub.ExpiresAt != nil && time.Now().After(*sub.ExpiresAt) {
+			return
+		}
+		exists, err := send.client.Exists(ctx, getNBISubscriptionKey(sub.SubscriptionId)).Result()
+		if err == nil && exists == 0 {
+			log.SpanLog(ctx, log.DebugLevelApi, "nbi subscription removed, abort sending event", "subscription", sub.SubscriptionId)
+			return
+		}
+	}
+	send.attempt++
+	err := s.postEvent(ctx, sub.Sink, send.data)
+	if err == nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "sent nbi subscription event", "subscription", sub.SubscriptionId, "appInst", send.event.Data.AppInstanceId, "status", send.event.Data.Status)
+		return
+	}
+	log.SpanLog(ctx, log.DebugLevelApi, "failed to send nbi subscription event", "subscription", sub.SubscriptionId, "attempt", send.attempt, "err", err)
+	if send.attempt >= nbiNotifyMaxAttempts {
+		return
+	}
+	// retry from a timer rather than holding up the worker
+	retryCtx := log.ContextWithSpan(context.Background(), log.SpanFromContext(ctx))
+	time.AfterFunc(send.retryInterval, func() {
+		s.enqueue(retryCtx, "nbi send event retry", func(ctx context.Context) {
+			s.sendEvent(ctx, send)
+		})
+	})
+	send.retryInterval *= 2
+}
+
+func (s *NBISubscriptionApi) postEvent(ctx context.Context, sink string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sink returned status %d", resp.StatusCode)
+	}
+	return nil
+}
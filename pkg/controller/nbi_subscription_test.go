@@ -0,0 +1,430 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/nbi"
+	"github.com/edgexr/edge-cloud-platform/pkg/ccrmdummy"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/regiondata"
+	"github.com/edgexr/edge-cloud-platform/test/nbitest"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// testEventSink records events posted by subscriptions.
+type testEventSink struct {
+	mux      sync.Mutex
+	events   map[string][]nbi.AppInstanceStatusEvent
+	failNext int
+}
+
+func (s *testEventSink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.failNext > 0 {
+		s.failNext--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	event := nbi.AppInstanceStatusEvent{}
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	subID := event.Data.SubscriptionId
+	s.events[subID] = append(s.events[subID], event)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *testEventSink) getStatuses(subID string) map[string]bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	statuses := map[string]bool{}
+	for _, ev := range s.events[subID] {
+		statuses[ev.Data.Status] = true
+	}
+	return statuses
+}
+
+func TestNBISubscriptions(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+	testSvcs := testinit(ctx, t)
+	defer testfinish(testSvcs)
+
+	dummy := regiondata.InMemoryStore{}
+	dummy.Start()
+	defer dummy.Stop()
+
+	sync := regiondata.InitSync(&dummy)
+	apis := NewAllApis(sync)
+	sync.Start()
+	defer sync.Done()
+	responder := DefaultDummyInfoResponder(apis)
+	responder.InitDummyInfoResponder()
+	ccrm := ccrmdummy.StartDummyCCRM(ctx, testSvcs.DummyVault.Config, &dummy)
+	registerDummyCCRMConn(t, ccrm)
+	defer ccrm.Stop()
+
+	reduceInfoTimeouts(t, ctx, apis)
+	defer func(interval time.Duration) {
+		nbiNotifyRetryInterval = interval
+	}(nbiNotifyRetryInterval)
+	nbiNotifyRetryInterval = 10 * time.Millisecond
+	// allow for the local test sink
+	defer func(checkIP func(net.IP) error) {
+		nbiSinkCheckIP = checkIP
+	}(nbiSinkCheckIP)
+
+	addTestPlatformFeatures(t, ctx, apis, testutil.PlatformFeaturesData())
+	testutil.InternalFlavorCreate(t, apis.flavorApi, testutil.FlavorData())
+	testutil.InternalGPUDriverCreate(t, apis.gpuDriverApi, testutil.GPUDriverData())
+	testutil.InternalResTagTableCreate(t, apis.resTagTableApi, testutil.ResTagTableData())
+	testutil.InternalZoneCreate(t, apis.zoneApi, testutil.ZoneData())
+	testutil.InternalCloudletCreate(t, apis.cloudletApi, testutil.CloudletData())
+	insertCloudletInfo(ctx, apis, testutil.CloudletInfoData())
+
+	nbiApis := NewNBIAPI(apis)
+
+	sink := &testEventSink{
+		events: map[string][]nbi.AppInstanceStatusEvent{},
+	}
+	server := httptest.NewServer(sink)
+	defer server.Close()
+
+	appData := nbitest.AppData()[0]
+	appResp, err := nbiApis.SubmitApp(ctx, nbi.SubmitAppRequestObject{
+		Body: appData.NBI,
+	})
+	require.Nil(t, err)
+	appID := *appResp.(nbi.SubmitApp201JSONResponse).Body.AppId
+	org := appData.NBI.AppProvider
+	otherOrg := "otherorg"
+
+	createSub := func(in nbi.SubscriptionRequest) (nbi.Subscription, error) {
+		resp, err := nbiApis.CreateSubscription(ctx, nbi.CreateSubscriptionRequestObject{
+			Body: &in,
+		})
+		if err != nil {
+			return nbi.Subscription{}, err
+		}
+		resp201, ok := resp.(nbi.CreateSubscription201JSONResponse)
+		require.True(t, ok, "expect 201 but got %T", resp)
+		return resp201.Body, nil
+	}
+
+	// validation failures
+	badID := "unknown"
+	past := time.Now().Add(-time.Minute)
+	for _, in := range []nbi.SubscriptionRequest{{
+		AppProvider: org,
+		Sink:        "ftp://example.com/events",
+	}, {
+		AppProvider: org,
+		Sink:        "http:///events",
+	}, {
+		AppProvider: org,
+		Sink:        server.URL, // loopback
+	}, {
+		AppProvider: org,
+		Sink:        "http://10.1.1.1:8080/events",
+	}, {
+		AppProvider: org,
+		Sink:        "http://169.254.169.254/latest/meta-data",
+	}, {
+		AppProvider: org,
+		Sink:        "http://[::1]/events",
+	}, {
+		AppProvider: org,
+		Sink:        "http://localhost/events",
+	}} {
+		_, err := createSub(in)
+		requireErrRespCode(t, http.StatusBadRequest, err)
+	}
+	nbiSinkCheckIP = func(ip net.IP) error {
+		if ip.IsLoopback() {
+			return nil
+		}
+		return checkPublicIP(ip)
+	}
+	for _, in := range []nbi.SubscriptionRequest{{
+		Sink: server.URL,
+	}, {
+		AppProvider: org,
+		Sink:        server.URL,
+		AppId:       &badID,
+	}, {
+		AppProvider:   org,
+		Sink:          server.URL,
+		AppInstanceId: &badID,
+	}, {
+		AppProvider: otherOrg,
+		Sink:        server.URL,
+		AppId:       &appID,
+	}, {
+		AppProvider:            org,
+		Sink:                   server.URL,
+		SubscriptionExpireTime: &past,
+	}} {
+		_, err := createSub(in)
+		requireErrRespCode(t, http.StatusBadRequest, err)
+	}
+
+	// subscription for all events
+	allSub, err := createSub(nbi.SubscriptionRequest{
+		AppProvider: org,
+		Sink:        server.URL,
+	})
+	require.Nil(t, err)
+	// subscription for the app
+	appSub, err := createSub(nbi.SubscriptionRequest{
+		AppProvider: org,
+		Sink:        server.URL,
+		AppId:       &appID,
+	})
+	require.Nil(t, err)
+	// subscription for a different app never gets events
+	otherAppID := "other"
+	otherSub := nbi.Subscription{
+		SubscriptionId: "other-sub",
+		AppProvider:    org,
+		Sink:           server.URL,
+		AppId:          &otherAppID,
+	}
+	data, err := json.Marshal(&otherSub)
+	require.Nil(t, err)
+	err = redisClient.Set(ctx, getNBISubscriptionKey(otherSub.SubscriptionId), string(data), 0).Err()
+	require.Nil(t, err)
+	err = redisClient.SAdd(ctx, getNBISubscriptionIndexKey(org), otherSub.SubscriptionId).Err()
+	require.Nil(t, err)
+	// subscription for a different AppProvider never gets events
+	otherOrgSub, err := createSub(nbi.SubscriptionRequest{
+		AppProvider: otherOrg,
+		Sink:        server.URL,
+	})
+	require.Nil(t, err)
+	// expiring subscription
+	expireTime := time.Now().Add(time.Hour)
+	expSub, err := createSub(nbi.SubscriptionRequest{
+		AppProvider:            org,
+		Sink:                   server.URL,
+		SubscriptionExpireTime: &expireTime,
+	})
+	require.Nil(t, err)
+
+	showSubs := func(appProvider string) []nbi.Subscription {
+		showResp, err := nbiApis.GetSubscriptions(ctx, nbi.GetSubscriptionsRequestObject{
+			Params: nbi.GetSubscriptionsParams{
+				AppProvider: appProvider,
+			},
+		})
+		require.Nil(t, err)
+		return showResp.(nbi.GetSubscriptions200JSONResponse).Body
+	}
+	_, err = nbiApis.GetSubscriptions(ctx, nbi.GetSubscriptionsRequestObject{})
+	requireErrRespCode(t, http.StatusBadRequest, err)
+	require.Equal(t, 4, len(showSubs(org)))
+	otherOrgSubs := showSubs(otherOrg)
+	require.Equal(t, 1, len(otherOrgSubs))
+	require.Equal(t, otherOrgSub.SubscriptionId, otherOrgSubs[0].SubscriptionId)
+
+	// expire the subscription, it is removed from the index
+	testSvcs.DummyRedisSrv.FastForward(2 * time.Hour)
+	subs := showSubs(org)
+	require.Equal(t, 3, len(subs))
+	for _, sub := range subs {
+		require.NotEqual(t, expSub.SubscriptionId, sub.SubscriptionId)
+	}
+	indexed, err := redisClient.SIsMember(ctx, getNBISubscriptionIndexKey(org), expSub.SubscriptionId).Result()
+	require.Nil(t, err)
+	require.False(t, indexed)
+
+	// first send attempt fails and is retried
+	sink.mux.Lock()
+	sink.failNext = 1
+	sink.mux.Unlock()
+
+	// create the AppInst, events are triggered by state changes
+	zone := testutil.ZoneData()[0]
+	body := nbi.CreateAppInstanceJSONBody{
+		Name:            appData.NBI.Name + "-sub",
+		AppId:           appID,
+		EdgeCloudZoneId: apis.zoneApi.cache.Objs[zone.Key].Obj.ObjId,
+	}
+	req := nbi.CreateAppInstanceRequestObject{}
+	req.Body = (*nbi.CreateAppInstanceJSONRequestBody)(&body)
+	resp, err := nbiApis.CreateAppInstance(ctx, req)
+	require.Nil(t, err)
+	appInstID := resp.(nbi.CreateAppInstance202JSONResponse).Body.AppInstanceId
+
+	// subscription for the AppInst
+	instSub, err := createSub(nbi.SubscriptionRequest{
+		AppProvider:   org,
+		Sink:          server.URL,
+		AppInstanceId: &appInstID,
+	})
+	require.Nil(t, err)
+
+	expStatuses := func(statuses ...string) map[string]bool {
+		exp := map[string]bool{}
+		for _, status := range statuses {
+			exp[status] = true
+		}
+		return exp
+	}
+	for _, subID := range []string{allSub.SubscriptionId, appSub.SubscriptionId} {
+		require.Eventually(t, func() bool {
+			return len(sink.getStatuses(subID)) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, expStatuses("instantiating", "ready"), sink.getStatuses(subID))
+	}
+	sink.mux.Lock()
+	ev := sink.events[allSub.SubscriptionId][0]
+	sink.mux.Unlock()
+	require.Equal(t, NBIAppInstStatusEventType, ev.Type)
+	require.Equal(t, cloudcommon.NBIRootPath+"/appinstances/"+appInstID, ev.Source)
+	require.Equal(t, appInstID, ev.Data.AppInstanceId)
+	require.Equal(t, appID, ev.Data.AppId)
+
+	// delete the AppInst subscription before deleting the AppInst
+	deleteSub := func(subID, appProvider string) error {
+		_, err := nbiApis.DeleteSubscription(ctx, nbi.DeleteSubscriptionRequestObject{
+			SubscriptionId: subID,
+			Params: nbi.DeleteSubscriptionParams{
+				AppProvider: appProvider,
+			},
+		})
+		return err
+	}
+	// other AppProviders cannot delete the subscription
+	err = deleteSub(appSub.SubscriptionId, otherOrg)
+	requireErrRespCode(t, http.StatusNotFound, err)
+	err = deleteSub(appSub.SubscriptionId, "")
+	requireErrRespCode(t, http.StatusBadRequest, err)
+	err = deleteSub(appSub.SubscriptionId, org)
+	require.Nil(t, err)
+	indexed, err = redisClient.SIsMember(ctx, getNBISubscriptionIndexKey(org), appSub.SubscriptionId).Result()
+	require.Nil(t, err)
+	require.False(t, indexed)
+	err = deleteSub(appSub.SubscriptionId, org)
+	requireErrRespCode(t, http.StatusNotFound, err)
+
+	delReq := nbi.DeleteAppInstanceRequestObject{
+		AppInstanceId: appInstID,
+	}
+	_, err = nbiApis.DeleteAppInstance(ctx, delReq)
+	require.Nil(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(sink.getStatuses(allSub.SubscriptionId)) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, expStatuses("instantiating", "ready", "terminating", "deleted"), sink.getStatuses(allSub.SubscriptionId))
+	require.Eventually(t, func() bool {
+		return len(sink.getStatuses(instSub.SubscriptionId)) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, expStatuses("terminating", "deleted"), sink.getStatuses(instSub.SubscriptionId))
+	require.Equal(t, expStatuses("instantiating", "ready"), sink.getStatuses(appSub.SubscriptionId))
+	require.Equal(t, 0, len(sink.getStatuses(otherSub.SubscriptionId)))
+	require.Equal(t, 0, len(sink.getStatuses(otherOrgSub.SubscriptionId)))
+	require.Equal(t, 0, len(sink.getStatuses(expSub.SubscriptionId)))
+	// deleted AppInsts are no longer tracked
+	require.Equal(t, 0, len(apis.nbiSubscriptionApi.lastStatus))
+}
+
+func TestNBINotifyQueue(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	defer func(workers, warnSize int, interval time.Duration, checkIP func(net.IP) error) {
+		nbiNotifyWorkers = workers
+		nbiNotifyQueueWarnSize = warnSize
+		nbiNotifyRetryInterval = interval
+		nbiSinkCheckIP = checkIP
+	}(nbiNotifyWorkers, nbiNotifyQueueWarnSize, nbiNotifyRetryInterval, nbiSinkCheckIP)
+	nbiNotifyWorkers = 1
+	nbiNotifyQueueWarnSize = 2
+	nbiNotifyRetryInterval = time.Hour
+	nbiSinkCheckIP = func(ip net.IP) error { return nil }
+
+	api := NewNBISubscriptionApi(nil)
+
+	// work beyond the warn size is not dropped, and each runs
+	// in its own span
+	parent := log.SpanFromContext(ctx)
+	release := make(chan struct{})
+	api.enqueue(ctx, "block", func(ctx context.Context) {
+		<-release
+	})
+	numWork := 10
+	done := make(chan bool, numWork)
+	for ii := 0; ii < numWork; ii++ {
+		api.enqueue(ctx, "work", func(ctx context.Context) {
+			done <- log.SpanFromContext(ctx) != parent
+		})
+	}
+	close(release)
+	for ii := 0; ii < numWork; ii++ {
+		select {
+		case newSpan := <-done:
+			require.True(t, newSpan)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "work did not run")
+		}
+	}
+
+	// a failing sink does not hold up the worker while waiting
+	// to retry
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	send := &nbiEventSend{
+		sub: nbi.Subscription{
+			SubscriptionId: "sub1",
+			Sink:           server.URL,
+		},
+		event:         &nbi.AppInstanceStatusEvent{},
+		data:          []byte("{}"),
+		retryInterval: nbiNotifyRetryInterval,
+	}
+	api.enqueue(ctx, "send", func(ctx context.Context) {
+		api.sendEvent(ctx, send)
+	})
+	ran := make(chan struct{})
+	api.enqueue(ctx, "next", func(ctx context.Context) {
+		close(ran)
+	})
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "worker blocked by retry")
+	}
+	require.Equal(t, 1, send.attempt)
+}
@@ -275,6 +275,46 @@ func NBIApp(in *edgeproto.App) (*nbi.AppManifest, error) {
 	return &am, nil
 }
 
+// nbiAppFields are the App fields that are managed by the NBI
+// AppManifest, see ProtoApp.
+var nbiAppFields = edgeproto.NewFieldMap(map[string]struct{}{
+	edgeproto.AppFieldImagePath:           {},
+	edgeproto.AppFieldImageType:           {},
+	edgeproto.AppFieldAccessPorts:         {},
+	edgeproto.AppFieldDeployment:          {},
+	edgeproto.AppFieldAllowServerless:     {},
+	edgeproto.AppFieldIsStandalone:        {},
+	edgeproto.AppFieldAppAnnotations:      {},
+	edgeproto.AppFieldKubernetesResources: {},
+})
+
+// ProtoAppUpdate converts an NBI AppManifest into an update for the
+// current App. Only fields managed by the NBI that changed are set in
+// the returned App's Fields. Annotations not managed by the NBI
+// are preserved.
+func ProtoAppUpdate(cur *edgeproto.App, in *nbi.AppManifest) (*edgeproto.App, error) {
+	app, err := ProtoApp(in)
+	if err != nil {
+		return nil, err
+	}
+	if !app.Key.Matches(&cur.Key) {
+		return nil, fmt.Errorf("cannot change app name, provider, or version")
+	}
+	for k, v := range cur.AppAnnotations {
+		if !strings.HasPrefix(k, "NBIApp") {
+			app.AppAnnotations[k] = v
+		}
+	}
+	app.ObjId = cur.ObjId
+	diffFields := cur.GetDiffFields(app)
+	for _, field := range diffFields.Fields() {
+		if nbiAppFields.Has(field) {
+			app.Fields = append(app.Fields, field)
+		}
+	}
+	return app, nil
+}
+
 func nbiKubernetesResources(in *edgeproto.KubernetesResources, standalone bool) *nbi.KubernetesResources {
 	kr := &nbi.KubernetesResources{
 		IsStandalone: standalone,
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/test/nbitest"
 	"github.com/test-go/testify/require"
 )
@@ -37,3 +38,30 @@ func TestConvertApp(t *testing.T) {
 		require.Equal(t, pair.NBI, outNBI, desc)
 	}
 }
+
+func TestConvertAppUpdate(t *testing.T) {
+	pair := nbitest.AppData()[0]
+	cur := pair.Edgeproto.Clone()
+	cur.ObjId = "app-id"
+	cur.AppAnnotations["other"] = "value"
+	cur.Revision = "rev1"
+
+	// no changes
+	upd, err := ProtoAppUpdate(cur, pair.NBI)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(upd.Fields))
+	require.Equal(t, "app-id", upd.ObjId)
+	require.Equal(t, "value", upd.AppAnnotations["other"])
+
+	// only NBI managed fields are updated
+	pair.NBI.AppRepo.ImagePath = "ghcr.io/edgexr/http-echo:1.0.1"
+	upd, err = ProtoAppUpdate(cur, pair.NBI)
+	require.Nil(t, err)
+	require.Equal(t, []string{edgeproto.AppFieldImagePath}, upd.Fields)
+
+	// key cannot change
+	pair.NBI.Version = "2.0.0"
+	_, err = ProtoAppUpdate(cur, pair.NBI)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "cannot change app name")
+}
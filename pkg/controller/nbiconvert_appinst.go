@@ -58,26 +58,48 @@ func (s *NBIAPI) NBIAppInst(in *edgeproto.AppInst) (*nbi.AppInstanceInfo, error)
 			ai.KubernetesClusterRef = &cluster.ObjId
 		}
 	}
-	if in.State == edgeproto.TrackedState_CREATE_REQUESTED ||
-		in.State == edgeproto.TrackedState_CREATING_DEPENDENCIES ||
-		in.State == edgeproto.TrackedState_CREATING ||
-		in.State == edgeproto.TrackedState_UPDATE_REQUESTED ||
-		in.State == edgeproto.TrackedState_UPDATING {
-		ai.Status = toPtr(nbi.AppInstanceInfoStatusInstantiating)
-	} else if in.State == edgeproto.TrackedState_DELETE_REQUESTED ||
-		in.State == edgeproto.TrackedState_DELETE_PREPARE ||
-		in.State == edgeproto.TrackedState_DELETING {
-		ai.Status = toPtr(nbi.AppInstanceInfoStatusTerminating)
-	} else if in.State == edgeproto.TrackedState_READY {
-		ai.Status = toPtr(nbi.AppInstanceInfoStatusReady)
-	} else if in.State == edgeproto.TrackedState_CREATE_ERROR ||
-		in.State == edgeproto.TrackedState_UPDATE_ERROR ||
-		in.State == edgeproto.TrackedState_DELETE_ERROR {
-		ai.Status = toPtr(nbi.AppInstanceInfoStatusFailed)
+	ai.Status = toPtr(NBIAppInstStatus(in.State))
+	return &ai, nil
+}
+
+// ProtoAppInstUpdate converts an NBI app instance update into an
+// AppInst update for the given instance.
+func ProtoAppInstUpdate(key *edgeproto.AppInstKey, in *nbi.UpdateAppInstanceJSONRequestBody) (*edgeproto.AppInst, error) {
+	appInst := edgeproto.AppInst{
+		Key: *key,
+	}
+	if in.KubernetesResources != nil {
+		kr, err := protoKubernetesResources(in.KubernetesResources)
+		if err != nil {
+			return nil, err
+		}
+		appInst.KubernetesResources = kr
+		appInst.Fields = append(appInst.Fields, edgeproto.AppInstFieldKubernetesResources)
+	}
+	return &appInst, nil
+}
+
+// NBIAppInstStatus converts the AppInst state to the NBI status.
+func NBIAppInstStatus(state edgeproto.TrackedState) nbi.AppInstanceInfoStatus {
+	if state == edgeproto.TrackedState_CREATE_REQUESTED ||
+		state == edgeproto.TrackedState_CREATING_DEPENDENCIES ||
+		state == edgeproto.TrackedState_CREATING ||
+		state == edgeproto.TrackedState_UPDATE_REQUESTED ||
+		state == edgeproto.TrackedState_UPDATING {
+		return nbi.AppInstanceInfoStatusInstantiating
+	} else if state == edgeproto.TrackedState_DELETE_REQUESTED ||
+		state == edgeproto.TrackedState_DELETE_PREPARE ||
+		state == edgeproto.TrackedState_DELETING {
+		return nbi.AppInstanceInfoStatusTerminating
+	} else if state == edgeproto.TrackedState_READY {
+		return nbi.AppInstanceInfoStatusReady
+	} else if state == edgeproto.TrackedState_CREATE_ERROR ||
+		state == edgeproto.TrackedState_UPDATE_ERROR ||
+		state == edgeproto.TrackedState_DELETE_ERROR {
+		return nbi.AppInstanceInfoStatusFailed
 	} else {
-		ai.Status = toPtr(nbi.AppInstanceInfoStatusUnknown)
+		return nbi.AppInstanceInfoStatusUnknown
 	}
-	return &ai, nil
 }
 
 func toPtr[T any](v T) *T {
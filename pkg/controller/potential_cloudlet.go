@@ -65,6 +65,7 @@ const (
 	NoSupportDedicatedIPAccess             = "site does not support dedicated IP access"
 	MTClusterOrgInvalid                    = "invalid organization for multi-tenant cluster"
 	NoSupportMultipleNodePools             = "site does not support multiple node pools"
+	SiteNoResources                        = "site does not have enough resources"
 )
 
 // cluster skip reasons
@@ -82,6 +83,7 @@ const (
 	StandaloneConflict                     = "standalone App conflict"
 	ClusterNoResources                     = "not enough resources"
 	AppManagesOwnNamespace                 = "cluster is multi-tenant but App manages its own namespaces"
+	ClusterUnavailable                     = "cluster unavailable"
 )
 
 type SkipReasons map[SkipReason]struct{}
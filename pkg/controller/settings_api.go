@@ -162,6 +162,10 @@ func (s *SettingsApi) initDefaults(ctx context.Context) error {
 			cur.CcrmApiTimeout = edgeproto.GetDefaultSettings().CcrmApiTimeout
 			modified = true
 		}
+		if cur.TrustPolicyFqdnRefreshInterval == 0 {
+			cur.TrustPolicyFqdnRefreshInterval = edgeproto.GetDefaultSettings().TrustPolicyFqdnRefreshInterval
+			modified = true
+		}
 		if modified {
 			s.store.STMPut(stm, cur)
 		}
@@ -603,6 +603,9 @@ func (s *StreamObjApi) UpdateStatus(ctx context.Context, obj interface{}, state
 	if err != nil {
 		log.SpanLog(ctx, log.DebugLevelApi, "Failed to publish message on redis channel", "key", streamKey, "err", err)
 	}
+	if info, ok := obj.(*edgeproto.AppInstInfo); ok && state != nil {
+		s.all.nbiSubscriptionApi.appInstStatusChanged(ctx, info)
+	}
 	infoDone := false
 	if state != nil {
 		if *state == edgeproto.TrackedState_READY ||
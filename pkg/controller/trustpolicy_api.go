@@ -23,6 +23,10 @@ import (
 	"go.etcd.io/etcd/client/v3/concurrency"
 )
 
+// Each remote FQDN is resolved by the CRMs for every refresh,
+// so limit how many may be specified.
+var MaxTrustPolicyRemoteFqdns = 50
+
 type TrustPolicyApi struct {
 	all   *AllApis
 	sync  *regiondata.Sync
@@ -48,6 +52,9 @@ func (s *TrustPolicyApi) CreateTrustPolicy(in *edgeproto.TrustPolicy, cb edgepro
 	if err := in.Validate(nil); err != nil {
 		return err
 	}
+	if err := validateTrustPolicyRules(in); err != nil {
+		return err
+	}
 	_, err := s.store.Create(ctx, in, s.sync.SyncWait)
 	return err
 
@@ -68,6 +75,9 @@ func (s *TrustPolicyApi) UpdateTrustPolicy(in *edgeproto.TrustPolicy, cb edgepro
 		if err := cur.Validate(nil); err != nil {
 			return err
 		}
+		if err := validateTrustPolicyRules(&cur); err != nil {
+			return err
+		}
 		if err := s.all.cloudletApi.ValidateCloudletsUsingTrustPolicy(ctx, &cur); err != nil {
 			return err
 		}
@@ -146,3 +156,39 @@ func (s *TrustPolicyApi) GetTrustPolicies(policies map[edgeproto.PolicyKey]*edge
 		policies[pol.Key] = copy
 	}
 }
+
+// validateTrustPolicyRules checks the rules of the policy against each
+// other. Individual rules are checked by the policy's Validate.
+func validateTrustPolicyRules(policy *edgeproto.TrustPolicy) error {
+	if err := checkDuplicateSecurityRules("outbound", policy.OutboundSecurityRules); err != nil {
+		return err
+	}
+	if err := checkDuplicateSecurityRules("inbound", policy.InboundSecurityRules); err != nil {
+		return err
+	}
+	numFqdns := 0
+	for _, r := range policy.OutboundSecurityRules {
+		if r.RemoteFqdn != "" {
+			numFqdns++
+		}
+	}
+	if numFqdns > MaxTrustPolicyRemoteFqdns {
+		return fmt.Errorf("Too many outbound security rules with remote FQDNs, %d specified but only %d allowed", numFqdns, MaxTrustPolicyRemoteFqdns)
+	}
+	return nil
+}
+
+func checkDuplicateSecurityRules(direction string, rules []edgeproto.SecurityRule) error {
+	seen := map[edgeproto.SecurityRule]struct{}{}
+	for _, r := range rules {
+		if _, found := seen[r]; found {
+			remote := r.RemoteCidr
+			if r.RemoteFqdn != "" {
+				remote = r.RemoteFqdn
+			}
+			return fmt.Errorf("Duplicate %s security rule for protocol %s ports %d-%d remote %s", direction, r.Protocol, r.PortRangeMin, r.PortRangeMax, remote)
+		}
+		seen[r] = struct{}{}
+	}
+	return nil
+}
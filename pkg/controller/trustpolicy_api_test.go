@@ -16,6 +16,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
@@ -47,6 +48,71 @@ func TestTrustPolicyApi(t *testing.T) {
 	expectCreatePolicyError(t, ctx, apis, &testutil.TrustPolicyErrorData()[1], "invalid CIDR")
 	expectCreatePolicyError(t, ctx, apis, &testutil.TrustPolicyErrorData()[2], "Invalid min port: 0")
 
+	// inbound rules and remote FQDNs
+	policy := edgeproto.TrustPolicy{
+		Key: edgeproto.PolicyKey{
+			Name:         "fqdn-policy",
+			Organization: "operorg",
+		},
+		OutboundSecurityRules: []edgeproto.SecurityRule{{
+			Protocol:     "tcp",
+			PortRangeMin: 443,
+			RemoteFqdn:   "API.example.com.",
+		}},
+		InboundSecurityRules: []edgeproto.SecurityRule{{
+			Protocol:     "tcp",
+			PortRangeMin: 80,
+			PortRangeMax: 443,
+			RemoteCidr:   "10.0.0.0/8",
+		}},
+	}
+	policyCopy := policy
+	policyCopy.OutboundSecurityRules = []edgeproto.SecurityRule{policy.OutboundSecurityRules[0]}
+	policyCopy.OutboundSecurityRules[0].RemoteCidr = "10.0.0.0/8"
+	expectCreatePolicyError(t, ctx, apis, &policyCopy, "Only one of remote CIDR or remote FQDN may be specified")
+	policyCopy.OutboundSecurityRules[0].RemoteCidr = ""
+	policyCopy.OutboundSecurityRules[0].RemoteFqdn = "api_server.example.com"
+	expectCreatePolicyError(t, ctx, apis, &policyCopy, "invalid FQDN")
+	policyCopy.OutboundSecurityRules[0].RemoteFqdn = "localhost"
+	expectCreatePolicyError(t, ctx, apis, &policyCopy, "must have at least two labels")
+	policyCopy = policy
+	policyCopy.InboundSecurityRules = []edgeproto.SecurityRule{{
+		Protocol:     "tcp",
+		PortRangeMin: 443,
+		RemoteFqdn:   "client.example.com",
+	}}
+	expectCreatePolicyError(t, ctx, apis, &policyCopy, "Invalid inbound security rule, Remote FQDN not allowed")
+	policyCopy.InboundSecurityRules = []edgeproto.SecurityRule{policy.InboundSecurityRules[0], policy.InboundSecurityRules[0]}
+	expectCreatePolicyError(t, ctx, apis, &policyCopy, "Duplicate inbound security rule for protocol TCP ports 80-443 remote 10.0.0.0/8")
+	policyCopy = policy
+	policyCopy.OutboundSecurityRules = nil
+	for ii := 0; ii <= MaxTrustPolicyRemoteFqdns; ii++ {
+		policyCopy.OutboundSecurityRules = append(policyCopy.OutboundSecurityRules, edgeproto.SecurityRule{
+			Protocol:   "icmp",
+			RemoteFqdn: fmt.Sprintf("host%d.example.com", ii),
+		})
+	}
+	expectCreatePolicyError(t, ctx, apis, &policyCopy, "Too many outbound security rules with remote FQDNs")
+
+	err := apis.trustPolicyApi.CreateTrustPolicy(&policy, testutil.NewCudStreamoutTrustPolicy(ctx))
+	require.Nil(t, err)
+	check := edgeproto.TrustPolicy{}
+	require.True(t, apis.trustPolicyApi.cache.Get(&policy.Key, &check))
+	require.Equal(t, "api.example.com", check.OutboundSecurityRules[0].RemoteFqdn)
+	require.Equal(t, uint32(443), check.OutboundSecurityRules[0].PortRangeMax)
+	require.Equal(t, "TCP", check.InboundSecurityRules[0].Protocol)
+
+	// update adds a duplicate outbound rule
+	update := check
+	update.OutboundSecurityRules = append(update.OutboundSecurityRules, update.OutboundSecurityRules[0])
+	update.Fields = []string{edgeproto.TrustPolicyFieldOutboundSecurityRules}
+	err = apis.trustPolicyApi.UpdateTrustPolicy(&update, testutil.NewCudStreamoutTrustPolicy(ctx))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Duplicate outbound security rule for protocol TCP ports 443-443 remote api.example.com")
+
+	err = apis.trustPolicyApi.DeleteTrustPolicy(&policy, testutil.NewCudStreamoutTrustPolicy(ctx))
+	require.Nil(t, err)
+
 	dummy.Stop()
 }
 
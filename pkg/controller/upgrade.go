@@ -27,6 +27,12 @@ import (
 
 var testDataKeyPrefix = "_testdatakey"
 
+// upgradeExternalChanges are the names of upgrade functions that
+// change state outside of the KVStore, i.e. in vault. They cannot
+// be run against a staging store, so restoring a backup that
+// needs them is rejected.
+var upgradeExternalChanges = map[string]struct{}{}
+
 // Prototype for the upgrade function - takes an objectstore and stm to ensure
 // automicity of each upgrade function
 type VersionUpgradeFunc func(context.Context, objstore.KVStore, *AllApis, *UpgradeSupport, int32) error
@@ -148,6 +148,9 @@ func (s *ZoneApi) UpdateZone(ctx context.Context, in *edgeproto.Zone) (*edgeprot
 		if changed == 0 {
 			return nil
 		}
+		if _, err := zone.GetLocation(); err != nil {
+			return err
+		}
 		s.store.STMPut(stm, zone)
 		return nil
 	})
@@ -80,6 +80,7 @@ var notifyServer *notify.ServerMgr
 var platform pf.Platform
 var finishInfraResourceThread bool
 var finishUpdateCloudletInfoHAThread bool
+var finishTrustPolicyFqdnThread bool
 
 const ControllerTimeout = 1 * time.Minute
 
@@ -405,6 +406,9 @@ func Start(builders map[string]pf.PlatformBuilder) error {
 	crmdata.StartInfraResourceRefreshThread()
 	finishInfraResourceThread = true
 
+	crmdata.StartTrustPolicyFqdnRefreshThread()
+	finishTrustPolicyFqdnThread = true
+
 	if haEnabled {
 		crmdata.StartUpdateCloudletInfoHAThread(ctx)
 		finishUpdateCloudletInfoHAThread = true
@@ -422,6 +426,10 @@ func Stop() {
 		crmdata.FinishUpdateCloudletInfoHAThread()
 		finishUpdateCloudletInfoHAThread = false
 	}
+	if finishTrustPolicyFqdnThread {
+		crmdata.FinishTrustPolicyFqdnRefreshThread()
+		finishTrustPolicyFqdnThread = false
+	}
 	if notifyServer != nil {
 		notifyServer.Stop()
 		notifyServer = nil
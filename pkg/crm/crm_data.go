@@ -54,6 +54,7 @@ type CRMData struct {
 	vmResourceSnapshotWorker         tasks.KeyWorkers
 	vmResourceSnapshotPeriodicTask   *tasks.PeriodicTask
 	updateCloudletInfoHAPeriodicTask *tasks.PeriodicTask
+	trustPolicyFqdnPeriodicTask      *tasks.PeriodicTask
 }
 
 const CloudletInfoCacheKey = "cloudletInfo"
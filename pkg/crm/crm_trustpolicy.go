@@ -0,0 +1,97 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crm
+
+import (
+	"context"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/util/tasks"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// Remote FQDNs in the trust policy's outbound rules are periodically
+// resolved again, and if their addresses changed the trust policy
+// is re-applied to the platform.
+
+func (s *CRMData) StartTrustPolicyFqdnRefreshThread() {
+	s.trustPolicyFqdnPeriodicTask = tasks.NewPeriodicTask(&trustPolicyFqdnTaskable{s})
+	s.trustPolicyFqdnPeriodicTask.Start()
+}
+
+func (s *CRMData) FinishTrustPolicyFqdnRefreshThread() {
+	if s.trustPolicyFqdnPeriodicTask != nil {
+		s.trustPolicyFqdnPeriodicTask.Stop()
+	}
+}
+
+func (s *CRMData) refreshTrustPolicyFqdns(ctx context.Context) {
+	if !s.highAvailabilityManager.PlatformInstanceActive || !s.PlatformCommonInitDone {
+		return
+	}
+	cloudlet := edgeproto.Cloudlet{}
+	if !s.CloudletCache.Get(s.cloudletKey, &cloudlet) {
+		return
+	}
+	if cloudlet.TrustPolicy == "" || cloudlet.State != edgeproto.TrackedState_READY {
+		return
+	}
+	policy, err := edgeproto.GetCloudletTrustPolicy(ctx, cloudlet.TrustPolicy, cloudlet.Key.Organization, &s.TrustPolicyCache)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "failed to get trust policy for fqdn refresh", "err", err)
+		return
+	}
+	if !cloudcommon.HasRemoteFqdns(policy.OutboundSecurityRules) {
+		return
+	}
+	changed, err := cloudcommon.TrustPolicyFqdns.Refresh(ctx, policy)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "failed to refresh trust policy fqdns", "policy", policy.Key, "err", err)
+	}
+	if !changed {
+		return
+	}
+	log.SpanLog(ctx, log.DebugLevelInfra, "trust policy fqdn addresses changed, updating trust policy", "policy", policy.Key)
+	sender := edgeproto.NewCloudletInfoCacheUpdater(ctx, &s.CloudletInfoCache, cloudlet.Key)
+	err = s.CRMHandler.UpdateTrustPolicy(ctx, &cloudlet, s.platform, sender)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "failed to update trust policy for refreshed fqdns", "policy", policy.Key, "err", err)
+	}
+}
+
+// configuration for the periodic trust policy fqdn refresh thread
+type trustPolicyFqdnTaskable struct {
+	cd *CRMData
+}
+
+func (s *trustPolicyFqdnTaskable) Run(ctx context.Context) {
+	s.cd.refreshTrustPolicyFqdns(ctx)
+}
+
+func (s *trustPolicyFqdnTaskable) GetInterval() time.Duration {
+	interval := s.cd.Settings.TrustPolicyFqdnRefreshInterval
+	if interval == 0 {
+		// settings from an older controller
+		interval = edgeproto.GetDefaultSettings().TrustPolicyFqdnRefreshInterval
+	}
+	return interval.TimeDuration()
+}
+
+func (s *trustPolicyFqdnTaskable) StartSpan() opentracing.Span {
+	return log.StartSpan(log.DebugLevelApi, "TrustPolicyFqdnRefresh thread", log.WithNoLogStartFinish{})
+}
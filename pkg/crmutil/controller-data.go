@@ -810,7 +810,11 @@ func (cd *CRMHandler) UpdateTrustPolicy(ctx context.Context, cloudlet *edgeproto
 		}
 	}
 	log.SpanLog(ctx, log.DebugLevelInfra, "UpdateTrustPolicy", "cloudlet.TrustPolicy", cloudlet.TrustPolicy, "TrustPolicyCache TrustPolicy", TrustPolicy)
-	err := pf.UpdateTrustPolicy(ctx, &TrustPolicy)
+	// platforms only deal with CIDRs, so resolve any remote FQDNs
+	resolved, err := cloudcommon.TrustPolicyFqdns.ResolveTrustPolicy(ctx, &TrustPolicy)
+	if err == nil {
+		err = pf.UpdateTrustPolicy(ctx, resolved)
+	}
 	log.SpanLog(ctx, log.DebugLevelInfra, "Update Privacy Done", "err", err)
 	newState := edgeproto.TrackedState_NOT_PRESENT
 	if err != nil {
@@ -15,6 +15,8 @@
 package crmutil
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
@@ -22,11 +24,14 @@ import (
 	"io"
 	"net"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
+	"github.com/edgexr/edge-cloud-platform/pkg/k8smgmt"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
@@ -60,6 +65,8 @@ func (cd *CRMHandler) ProcessExecReq(ctx context.Context, pf platform.Platform,
 
 	var execReqType cloudcommon.ExecReqType
 	var initURL *url.URL
+	var portForwardInfo *cloudcommon.PortForwardInfo
+	var copyFileInfo *edgeproto.CopyFile
 	if req.Console != nil {
 		req.Console.Url, err = pf.GetConsoleUrl(ctx, &app, &appInst)
 		if err != nil {
@@ -124,25 +131,84 @@ func (cd *CRMHandler) ProcessExecReq(ctx context.Context, pf platform.Platform,
 			return err
 		}
 		execReqType = cloudcommon.ExecReqShell
-	} else {
-		execReqType = cloudcommon.ExecReqShell
+	} else if req.PortForward != nil {
 		clusterInst := edgeproto.ClusterInst{}
 		found := cd.ClusterInstCache.Get(appInst.GetClusterKey(), &clusterInst)
 		if !found {
 			return fmt.Errorf("cluster inst %s not found",
 				appInst.GetClusterKey().GetKeyString())
 		}
-
-		run.contcmd, err = pf.GetContainerCommand(ctx, &clusterInst, &app, &appInst, req)
+		clientType := cloudcommon.GetAppClientType(&app)
+		run.client, err = pf.GetClusterPlatformClient(ctx, &clusterInst, clientType)
+		if err != nil {
+			return err
+		}
+		host, err := getPortForwardHost(ctx, run.client, &clusterInst, &app, &appInst, req.PortForward.Port)
 		if err != nil {
 			return err
 		}
+		run.contcmd = cloudcommon.GetPortForwardCommand(host, req.PortForward.Port)
+		// Each forwarded connection runs its own relay command,
+		// so needs its own client.
+		run.newClient = func() (ssh.Client, error) {
+			return pf.GetClusterPlatformClient(ctx, &clusterInst, clientType)
+		}
+		execReqType = cloudcommon.ExecReqPortForward
+		portForwardInfo = &cloudcommon.PortForwardInfo{
+			AppInstKey: req.AppInstKey,
+			Port:       req.PortForward.Port,
+			User:       req.PortForward.User,
+			TTL:        req.PortForward.Ttl.TimeDuration(),
+		}
+	} else {
+		execReqType = cloudcommon.ExecReqShell
+		clusterInst := edgeproto.ClusterInst{}
+		found := cd.ClusterInstCache.Get(appInst.GetClusterKey(), &clusterInst)
+		if !found {
+			return fmt.Errorf("cluster inst %s not found",
+				appInst.GetClusterKey().GetKeyString())
+		}
 
 		clientType := cloudcommon.GetAppClientType(&app)
+		if isAggregateLogs(req) {
+			run.logSources, err = getLogSources(ctx, pf, &clusterInst, &app, &appInst, req)
+			if err != nil {
+				return err
+			}
+			// Each log source runs its own command, so needs its own client.
+			run.newClient = func() (ssh.Client, error) {
+				return pf.GetClusterPlatformClient(ctx, &clusterInst, clientType)
+			}
+		} else {
+			run.contcmd, err = pf.GetContainerCommand(ctx, &clusterInst, &app, &appInst, req)
+			if err != nil {
+				return err
+			}
+		}
+
 		run.client, err = pf.GetClusterPlatformClient(ctx, &clusterInst, clientType)
 		if err != nil {
 			return err
 		}
+		if req.CopyFile != nil {
+			execReqType = cloudcommon.ExecReqCopyFile
+			copyFileInfo = &edgeproto.CopyFile{
+				Path:   req.CopyFile.Path,
+				Upload: req.CopyFile.Upload,
+			}
+			if !req.CopyFile.Upload {
+				// Start the download to get the file size before
+				// replying so any failure can be returned in the
+				// ExecRequest reply. The contents are streamed
+				// once the client connects.
+				run.download, err = run.startDownload(ctx)
+				if err != nil {
+					return err
+				}
+				defer run.download.wait()
+				copyFileInfo.FileSize = run.download.size
+			}
+		}
 	}
 
 	// Connect to EdgeTurn server
@@ -168,8 +234,10 @@ func (cd *CRMHandler) ProcessExecReq(ctx context.Context, pf platform.Platform,
 
 	// Send ExecReqInfo to EdgeTurn server
 	execReqInfo := cloudcommon.ExecReqInfo{
-		Type:    execReqType,
-		InitURL: initURL,
+		Type:        execReqType,
+		InitURL:     initURL,
+		PortForward: portForwardInfo,
+		CopyFile:    copyFileInfo,
 	}
 	out, err := json.Marshal(&execReqInfo)
 	if err != nil {
@@ -186,6 +254,9 @@ func (cd *CRMHandler) ProcessExecReq(ctx context.Context, pf platform.Platform,
 		return fmt.Errorf("failed to decode session info: %v", err)
 	}
 	log.SpanLog(ctx, log.DebugLevelApi, "received session info from edgeturn server", "info", sessInfo)
+	if sessInfo.Err != "" {
+		return fmt.Errorf("%s", sessInfo.Err)
+	}
 
 	replySent := false
 	// if ExecRequest reply is already sent, we can't send any error back to the
@@ -281,6 +352,59 @@ func (cd *CRMHandler) ProcessExecReq(ctx context.Context, pf platform.Platform,
 				server.Close()
 			}(server, stream)
 		}
+	} else if req.PortForward != nil {
+		sess, err := smux.Server(turnConn, nil)
+		if err != nil {
+			return fmt.Errorf("failed to setup smux server, %v", err)
+		}
+		defer sess.Close()
+		proxyAddr := "wss://" + req.EdgeTurnProxyAddr + "/edgeforward?edgetoken=" + sessInfo.Token
+		req.AccessUrl = proxyAddr
+		sendReply(req)
+		replySent = true
+		// EdgeTurn server closes the session once the TTL expires
+		for {
+			stream, err := sess.AcceptStream()
+			if err != nil {
+				if err.Error() != io.ErrClosedPipe.Error() {
+					log.SpanLog(ctx, log.DebugLevelApi, "port forward session closed", "err", err)
+				}
+				return nil
+			}
+			go run.forwardStream(ctx, stream)
+		}
+	} else if req.CopyFile != nil {
+		proxyAddr := "https://" + req.EdgeTurnProxyAddr + "/edgecopy?edgetoken=" + sessInfo.Token
+		req.AccessUrl = proxyAddr
+		sendReply(req)
+		replySent = true
+		if req.CopyFile.Upload {
+			// any error is written back to the turn connection,
+			// and returned to the client by the EdgeTurn server
+			return run.uploadFile(ctx, turnConn)
+		}
+		err = run.download.copyTo(turnConn)
+		if err != nil {
+			// The EdgeTurn server sends the file size as the content
+			// length, so the client will detect the short download.
+			// Writing the error to the turn connection would corrupt
+			// the file contents.
+			log.SpanLog(ctx, log.DebugLevelApi, "download failed", "err", err)
+		}
+		return nil
+	} else if run.logSources != nil {
+		proxyAddr := "wss://" + req.EdgeTurnProxyAddr + "/edgeshell?edgetoken=" + sessInfo.Token
+		req.AccessUrl = proxyAddr
+		sendReply(req)
+		replySent = true
+		// stop the log commands if the client disconnects
+		logCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			io.Copy(io.Discard, turnConn)
+			cancel()
+		}()
+		return run.aggregateLogs(logCtx, turnConn)
 	} else {
 		proxyAddr := "wss://" + req.EdgeTurnProxyAddr + "/edgeshell?edgetoken=" + sessInfo.Token
 		req.AccessUrl = proxyAddr
@@ -296,9 +420,12 @@ func (cd *CRMHandler) ProcessExecReq(ctx context.Context, pf platform.Platform,
 }
 
 type RunExec struct {
-	req     *edgeproto.ExecRequest
-	client  ssh.Client
-	contcmd string
+	req        *edgeproto.ExecRequest
+	client     ssh.Client
+	contcmd    string
+	download   *fileDownload
+	newClient  func() (ssh.Client, error)
+	logSources []logSource
 }
 
 func (s *RunExec) proxyRawConn(turnConn net.Conn) error {
@@ -316,3 +443,152 @@ func (s *RunExec) proxyRawConn(turnConn net.Conn) error {
 	}
 	return err
 }
+
+// fileDownload streams a file from the container. The download
+// command writes the file size on the first line, followed by the
+// file contents.
+type fileDownload struct {
+	path     string
+	size     int64
+	pr       *io.PipeReader
+	rd       *bufio.Reader
+	serr     bytes.Buffer
+	done     chan error
+	waitOnce sync.Once
+	err      error
+}
+
+func (s *RunExec) startDownload(ctx context.Context) (*fileDownload, error) {
+	args, err := shellquote.Split(strings.TrimSpace(s.contcmd))
+	if err != nil {
+		return nil, fmt.Errorf("bad command %s: %s", s.contcmd, err)
+	}
+	pr, pw := io.Pipe()
+	dl := &fileDownload{
+		path: s.req.CopyFile.Path,
+		pr:   pr,
+		rd:   bufio.NewReader(pr),
+		done: make(chan error, 1),
+	}
+	go func() {
+		err := pc.RunSafeStream(ctx, s.client, nil, pw, &dl.serr, args[0], args[1:])
+		pw.CloseWithError(err)
+		dl.done <- err
+	}()
+	line, err := dl.rd.ReadString('\n')
+	if err != nil {
+		if cmdErr := dl.wait(); cmdErr != nil {
+			err = cmdErr
+		}
+		return nil, fmt.Errorf("failed to download file %s: %s, %v", dl.path, strings.TrimSpace(dl.serr.String()), err)
+	}
+	dl.size, err = strconv.ParseInt(strings.TrimSpace(line), 10, 64)
+	if err != nil {
+		dl.wait()
+		return nil, fmt.Errorf("failed to download file %s: invalid file size %q", dl.path, strings.TrimSpace(line))
+	}
+	if dl.size > cloudcommon.ExecCopyFileMaxSize {
+		dl.wait()
+		return nil, fmt.Errorf("file %s is larger than the max allowed size of %d bytes", dl.path, cloudcommon.ExecCopyFileMaxSize)
+	}
+	return dl, nil
+}
+
+// copyTo writes the file contents to the writer.
+func (s *fileDownload) copyTo(w io.Writer) error {
+	_, err := io.CopyN(w, s.rd, s.size)
+	cmdErr := s.wait()
+	if err != nil {
+		return fmt.Errorf("failed to download file %s: %v", s.path, err)
+	}
+	if cmdErr != nil {
+		return fmt.Errorf("failed to download file %s: %s, %v", s.path, strings.TrimSpace(s.serr.String()), cmdErr)
+	}
+	return nil
+}
+
+// wait stops reading the output and waits for the download command
+// to finish. The command output is bounded by the file size so this
+// does not block for long.
+func (s *fileDownload) wait() error {
+	s.waitOnce.Do(func() {
+		s.pr.Close()
+		s.err = <-s.done
+	})
+	return s.err
+}
+
+func (s *RunExec) uploadFile(ctx context.Context, turnConn net.Conn) error {
+	args, err := shellquote.Split(strings.TrimSpace(s.contcmd))
+	if err != nil {
+		return fmt.Errorf("bad command %s: %s", s.contcmd, err)
+	}
+	sin := &countReader{
+		rd: io.LimitReader(turnConn, s.req.CopyFile.FileSize),
+	}
+	serr := bytes.Buffer{}
+	err = pc.RunSafeStream(ctx, s.client, sin, io.Discard, &serr, args[0], args[1:])
+	if err != nil {
+		return fmt.Errorf("failed to upload file %s: %s, %v", s.req.CopyFile.Path, strings.TrimSpace(serr.String()), err)
+	}
+	if sin.count != s.req.CopyFile.FileSize {
+		return fmt.Errorf("incomplete upload of file %s, received %d of %d bytes", s.req.CopyFile.Path, sin.count, s.req.CopyFile.FileSize)
+	}
+	return nil
+}
+
+type countReader struct {
+	rd    io.Reader
+	count int64
+}
+
+func (s *countReader) Read(p []byte) (int, error) {
+	n, err := s.rd.Read(p)
+	s.count += int64(n)
+	return n, err
+}
+
+// forwardStream relays a port forward stream to the AppInst port.
+func (s *RunExec) forwardStream(ctx context.Context, stream *smux.Stream) {
+	defer stream.Close()
+	client, err := s.newClient()
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "port forward failed to get client", "err", err)
+		return
+	}
+	serr := bytes.Buffer{}
+	err = pc.RunSafeStream(ctx, client, stream, stream, &serr, s.contcmd, nil)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "port forward stream failed", "stderr", serr.String(), "err", err)
+	}
+}
+
+// getPortForwardHost gets the host address from which the relay
+// command can reach the AppInst port.
+func getPortForwardHost(ctx context.Context, client ssh.Client, clusterInst *edgeproto.ClusterInst, app *edgeproto.App, appInst *edgeproto.AppInst, port int32) (string, error) {
+	switch app.Deployment {
+	case cloudcommon.DeploymentTypeDocker:
+		return "127.0.0.1", nil
+	case cloudcommon.DeploymentTypeKubernetes:
+		names, err := k8smgmt.GetKubeNames(clusterInst, app, appInst)
+		if err != nil {
+			return "", err
+		}
+		svcs, err := k8smgmt.GetServices(ctx, client, names)
+		if err != nil {
+			return "", err
+		}
+		for _, svc := range svcs {
+			if svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == "None" {
+				continue
+			}
+			for _, p := range svc.Spec.Ports {
+				if p.Port == port && (p.Protocol == "" || p.Protocol == "TCP") {
+					return svc.Spec.ClusterIP, nil
+				}
+			}
+		}
+		return "", fmt.Errorf("no kubernetes service found for port %d", port)
+	}
+	return "", fmt.Errorf("port forward not supported for %s deployments", app.Deployment)
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crmutil
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
+	"github.com/kballard/go-shellquote"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadFile(t *testing.T) {
+	ctx := context.Background()
+	workingDir := t.TempDir()
+	smallFile := filepath.Join(workingDir, "small")
+	err := os.WriteFile(smallFile, []byte("file\ncontents\n"), 0644)
+	require.Nil(t, err)
+	largeFile := filepath.Join(workingDir, "large")
+	err = os.WriteFile(largeFile, nil, 0644)
+	require.Nil(t, err)
+	err = os.Truncate(largeFile, cloudcommon.ExecCopyFileMaxSize+1)
+	require.Nil(t, err)
+
+	getRun := func(path string) *RunExec {
+		copyFile := &edgeproto.CopyFile{
+			Path: path,
+		}
+		return &RunExec{
+			req: &edgeproto.ExecRequest{
+				CopyFile: copyFile,
+			},
+			client:  &pc.LocalClient{WorkingDir: workingDir},
+			contcmd: shellquote.Join(cloudcommon.GetCopyFileCommand(copyFile)...),
+		}
+	}
+
+	// file contents are streamed after the size
+	dl, err := getRun(smallFile).startDownload(ctx)
+	require.Nil(t, err)
+	require.Equal(t, int64(14), dl.size)
+	out := &bytes.Buffer{}
+	err = dl.copyTo(out)
+	require.Nil(t, err)
+	require.Equal(t, "file\ncontents\n", out.String())
+
+	// missing file fails before the reply
+	_, err = getRun(filepath.Join(workingDir, "missing")).startDownload(ctx)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "failed to download file")
+
+	// file too large fails before the reply
+	_, err = getRun(largeFile).startDownload(ctx)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "larger than the max allowed size")
+}
@@ -0,0 +1,302 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crmutil
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
+	"github.com/kballard/go-shellquote"
+)
+
+var (
+	// LogMergeWindow is how long log lines are held when following
+	// logs, to allow lines from different containers to be ordered.
+	LogMergeWindow = time.Second
+	// LogMergeFlushInterval is how often held log lines are checked
+	LogMergeFlushInterval = 200 * time.Millisecond
+	// maximum length of a single log line
+	logMaxLineLen = 1024 * 1024
+	// LogMergeMaxPending is the maximum size in bytes of held log
+	// lines. If exceeded, held lines are written out even if lines
+	// from slower sources may still need to be ordered before them.
+	LogMergeMaxPending = 10 * 1024 * 1024
+)
+
+// logSource is a log command for a single container
+type logSource struct {
+	prefix string
+	cmd    string
+}
+
+type logLine struct {
+	ts      time.Time
+	arrival time.Time
+	seq     uint64
+	text    string
+}
+
+// isAggregateLogs returns true if the log request needs to be
+// handled by the CRM rather than streaming a single log command.
+func isAggregateLogs(req *edgeproto.ExecRequest) bool {
+	return req.Log != nil && (req.Log.AllContainers || req.Log.Filter != "")
+}
+
+// getLogSources gets the log commands for the requested containers.
+// Timestamps are always enabled so that lines can be merged in order.
+func getLogSources(ctx context.Context, pf platform.Platform, clusterInst *edgeproto.ClusterInst, app *edgeproto.App, appInst *edgeproto.AppInst, req *edgeproto.ExecRequest) ([]logSource, error) {
+	containerIds := []string{req.ContainerId}
+	if req.Log.AllContainers {
+		// The containers in the AppInst's runtime info may be stale
+		// if pods have been restarted or scaled, so look them up now.
+		rt, err := pf.GetAppInstRuntime(ctx, clusterInst, app, appInst)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get containers, %v", err)
+		}
+		containerIds = rt.ContainerIds
+		if len(containerIds) == 0 {
+			return nil, fmt.Errorf("no containers to show logs for")
+		}
+	}
+	sources := []logSource{}
+	for _, id := range containerIds {
+		srcReq := &edgeproto.ExecRequest{
+			AppInstKey:  req.AppInstKey,
+			ContainerId: id,
+			Log: &edgeproto.ShowLog{
+				Since:      req.Log.Since,
+				Tail:       req.Log.Tail,
+				Timestamps: true,
+				Follow:     req.Log.Follow,
+			},
+		}
+		cmd, err := pf.GetContainerCommand(ctx, clusterInst, app, appInst, srcReq)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, logSource{
+			// platform may fill in the default container id
+			prefix: srcReq.ContainerId,
+			cmd:    cmd,
+		})
+	}
+	return sources, nil
+}
+
+func getLogFilter(showLog *edgeproto.ShowLog) (func(string) bool, error) {
+	if showLog.Filter == "" {
+		return func(string) bool { return true }, nil
+	}
+	if showLog.FilterRegex {
+		re, err := regexp.Compile(showLog.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter regex, %v", err)
+		}
+		return re.MatchString, nil
+	}
+	return func(line string) bool {
+		return strings.Contains(line, showLog.Filter)
+	}, nil
+}
+
+// parseLogLine splits the timestamp added by --timestamps from the
+// log message. If there is no valid timestamp, the arrival time is used.
+func parseLogLine(raw string, arrival time.Time) (time.Time, string, string) {
+	if idx := strings.IndexByte(raw, ' '); idx > 0 {
+		if ts, err := time.Parse(time.RFC3339Nano, raw[:idx]); err == nil {
+			return ts, raw[:idx], raw[idx+1:]
+		}
+	}
+	return arrival, "", raw
+}
+
+// logMerger orders log lines by timestamp. Lines are held for the
+// window duration after arrival so that lines from slower sources
+// can be ordered before them.
+type logMerger struct {
+	out          io.Writer
+	window       time.Duration
+	maxPending   int
+	pending      []logLine
+	pendingBytes int
+}
+
+// add holds the line until it is flushed. If the held lines exceed
+// the max pending size, all held lines are written out.
+func (s *logMerger) add(line logLine) error {
+	s.pending = append(s.pending, line)
+	s.pendingBytes += len(line.text)
+	if s.maxPending > 0 && s.pendingBytes > s.maxPending {
+		return s.flush(time.Now(), true)
+	}
+	return nil
+}
+
+// flush writes out pending lines in timestamp order that arrived
+// before the window. If all is set, all pending lines are written.
+func (s *logMerger) flush(now time.Time, all bool) error {
+	sort.SliceStable(s.pending, func(i, j int) bool {
+		if s.pending[i].ts.Equal(s.pending[j].ts) {
+			return s.pending[i].seq < s.pending[j].seq
+		}
+		return s.pending[i].ts.Before(s.pending[j].ts)
+	})
+	cutoff := now.Add(-s.window)
+	ii := 0
+	for ; ii < len(s.pending); ii++ {
+		if !all && s.pending[ii].arrival.After(cutoff) {
+			break
+		}
+		if _, err := io.WriteString(s.out, s.pending[ii].text); err != nil {
+			s.pending = s.pending[ii:]
+			return err
+		}
+		s.pendingBytes -= len(s.pending[ii].text)
+	}
+	s.pending = s.pending[ii:]
+	return nil
+}
+
+// aggregateLogs runs the log commands for all sources and writes the
+// merged, filtered output. The log commands are stopped when ctx is
+// cancelled or the output cannot be written.
+func (s *RunExec) aggregateLogs(ctx context.Context, out io.Writer) error {
+	filter, err := getLogFilter(s.req.Log)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	showTimestamps := s.req.Log.Timestamps
+	lines := make(chan logLine, 100)
+	seqMux := sync.Mutex{}
+	var seq uint64
+
+	wg := sync.WaitGroup{}
+	for _, src := range s.logSources {
+		wg.Add(1)
+		go func(src logSource) {
+			defer wg.Done()
+			send := func(text string) {
+				now := time.Now()
+				ts, tsStr, msg := parseLogLine(text, now)
+				if !filter(msg) {
+					return
+				}
+				formatted := "[" + src.prefix + "] "
+				if showTimestamps && tsStr != "" {
+					formatted += tsStr + " "
+				}
+				formatted += msg + "\n"
+				seqMux.Lock()
+				seq++
+				line := logLine{
+					ts:      ts,
+					arrival: now,
+					seq:     seq,
+					text:    formatted,
+				}
+				seqMux.Unlock()
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+				}
+			}
+			err := s.streamLogSource(ctx, src, send)
+			if err != nil && ctx.Err() == nil {
+				send(err.Error())
+			}
+		}(src)
+	}
+	go func() {
+		wg.Wait()
+		close(lines)
+	}()
+
+	merger := logMerger{
+		out:        out,
+		window:     LogMergeWindow,
+		maxPending: LogMergeMaxPending,
+	}
+	ticker := time.NewTicker(LogMergeFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				return merger.flush(time.Now(), true)
+			}
+			if err := merger.add(line); err != nil {
+				// client went away
+				return err
+			}
+		case <-ticker.C:
+			if !s.req.Log.Follow {
+				// not following, order all lines once done
+				continue
+			}
+			if err := merger.flush(time.Now(), false); err != nil {
+				// client went away
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *RunExec) streamLogSource(ctx context.Context, src logSource, send func(string)) error {
+	args, err := shellquote.Split(strings.TrimSpace(src.cmd))
+	if err != nil {
+		return fmt.Errorf("bad command %s: %s", src.cmd, err)
+	}
+	client, err := s.newClient()
+	if err != nil {
+		return err
+	}
+	pr, pw := io.Pipe()
+	serr := bytes.Buffer{}
+	go func() {
+		err := pc.RunSafeStream(ctx, client, nil, pw, &serr, args[0], args[1:])
+		if err != nil && serr.Len() > 0 {
+			err = fmt.Errorf("%s, %v", strings.TrimSpace(serr.String()), err)
+		}
+		pw.CloseWithError(err)
+	}()
+	scanner := bufio.NewScanner(pr)
+	scanner.Buffer(make([]byte, 64*1024), logMaxLineLen)
+	for scanner.Scan() {
+		send(scanner.Text())
+	}
+	err = scanner.Err()
+	pr.Close()
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelApi, "log source failed", "container", src.prefix, "err", err)
+		return fmt.Errorf("failed to get logs, %v", err)
+	}
+	return nil
+}
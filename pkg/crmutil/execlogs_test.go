@@ -0,0 +1,163 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crmutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
+	ssh "github.com/edgexr/golang-ssh"
+	"github.com/test-go/testify/require"
+)
+
+func TestParseLogLine(t *testing.T) {
+	now := time.Now()
+	ts, tsStr, msg := parseLogLine("2024-01-02T03:04:05.123456789Z hello world", now)
+	require.Equal(t, "2024-01-02T03:04:05.123456789Z", tsStr)
+	require.Equal(t, "hello world", msg)
+	require.Equal(t, 123456789, ts.Nanosecond())
+
+	ts, tsStr, msg = parseLogLine("no timestamp here", now)
+	require.Equal(t, now, ts)
+	require.Equal(t, "", tsStr)
+	require.Equal(t, "no timestamp here", msg)
+}
+
+func TestLogMerger(t *testing.T) {
+	out := &bytes.Buffer{}
+	merger := logMerger{
+		out:    out,
+		window: time.Second,
+	}
+	start := time.Now()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	add := func(sec int, arrival time.Time, text string) {
+		err := merger.add(logLine{
+			ts:      base.Add(time.Duration(sec) * time.Second),
+			arrival: arrival,
+			seq:     uint64(len(merger.pending)),
+			text:    text,
+		})
+		require.Nil(t, err)
+	}
+	add(3, start, "c\n")
+	add(1, start, "a\n")
+	add(2, start.Add(2*time.Second), "b\n")
+	// b arrived recently, so must be held along with any later lines
+	err := merger.flush(start.Add(1500*time.Millisecond), false)
+	require.Nil(t, err)
+	require.Equal(t, "a\n", out.String())
+	err = merger.flush(start.Add(1500*time.Millisecond), true)
+	require.Nil(t, err)
+	require.Equal(t, "a\nb\nc\n", out.String())
+	require.Equal(t, 0, len(merger.pending))
+	require.Equal(t, 0, merger.pendingBytes)
+
+	// held lines are written out once the max pending size is exceeded
+	out.Reset()
+	merger.maxPending = 4
+	add(2, start, "b\n")
+	add(1, start, "a\n")
+	require.Equal(t, "", out.String())
+	add(3, start, "c\n")
+	require.Equal(t, "a\nb\nc\n", out.String())
+	require.Equal(t, 0, len(merger.pending))
+}
+
+func TestAggregateLogs(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	workingDir := t.TempDir()
+	sources := []logSource{{
+		prefix: "pod1/c1",
+		cmd:    `printf '2024-01-01T00:00:01Z one GET\n2024-01-01T00:00:03Z three POST\n'`,
+	}, {
+		prefix: "pod2/c1",
+		cmd:    `printf '2024-01-01T00:00:02Z two GET\n2024-01-01T00:00:04Z four GET\n'`,
+	}}
+
+	var tests = []struct {
+		desc   string
+		log    edgeproto.ShowLog
+		expOut string
+	}{{
+		"merged",
+		edgeproto.ShowLog{AllContainers: true},
+		"[pod1/c1] one GET\n[pod2/c1] two GET\n[pod1/c1] three POST\n[pod2/c1] four GET\n",
+	}, {
+		"filter",
+		edgeproto.ShowLog{AllContainers: true, Filter: "GET"},
+		"[pod1/c1] one GET\n[pod2/c1] two GET\n[pod2/c1] four GET\n",
+	}, {
+		"filter regex with timestamps",
+		edgeproto.ShowLog{AllContainers: true, Filter: "^t.*", FilterRegex: true, Timestamps: true},
+		"[pod2/c1] 2024-01-01T00:00:02Z two GET\n[pod1/c1] 2024-01-01T00:00:03Z three POST\n",
+	}, {
+		"follow",
+		edgeproto.ShowLog{AllContainers: true, Follow: true, Filter: "o"},
+		"[pod1/c1] one GET\n[pod2/c1] two GET\n[pod2/c1] four GET\n",
+	}}
+	for _, test := range tests {
+		run := &RunExec{
+			req: &edgeproto.ExecRequest{
+				Log: &test.log,
+			},
+			logSources: sources,
+			newClient: func() (ssh.Client, error) {
+				return &pc.LocalClient{WorkingDir: workingDir}, nil
+			},
+		}
+		out := &bytes.Buffer{}
+		err := run.aggregateLogs(ctx, out)
+		require.Nil(t, err, test.desc)
+		require.Equal(t, test.expOut, out.String(), test.desc)
+	}
+
+	// following logs stops when the context is cancelled
+	run := &RunExec{
+		req: &edgeproto.ExecRequest{
+			Log: &edgeproto.ShowLog{AllContainers: true, Follow: true},
+		},
+		logSources: []logSource{{
+			prefix: "pod1/c1",
+			cmd:    `sh -c 'while true; do echo running; sleep 0.1; done'`,
+		}},
+		newClient: func() (ssh.Client, error) {
+			return &pc.LocalClient{WorkingDir: workingDir}, nil
+		},
+	}
+	cctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	out := &bytes.Buffer{}
+	done := make(chan error, 1)
+	go func() {
+		done <- run.aggregateLogs(cctx, out)
+	}()
+	select {
+	case err := <-done:
+		require.Equal(t, context.DeadlineExceeded, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "aggregate logs did not stop on cancel")
+	}
+	require.Contains(t, out.String(), "[pod1/c1] running\n")
+}
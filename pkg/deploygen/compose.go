@@ -0,0 +1,161 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploygen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var composeT *template.Template
+
+func init() {
+	composeT = template.Must(template.New("compose").Funcs(template.FuncMap{
+		"quote": composeQuote,
+	}).Parse(composeTemplate))
+}
+
+type composeData struct {
+	Name         string
+	ImagePath    string
+	Ports        []string
+	Command      []string
+	EnvVarNames  []string
+	EnvVars      []v1.EnvVar
+	MexDeployGen string
+}
+
+// dockerComposeBasic generates a docker compose file equivalent
+// to how a docker deployment is run without a manifest.
+func dockerComposeBasic(app *AppSpec) (string, error) {
+	data := composeData{
+		Name:         util.DNSSanitize(appID(app)),
+		ImagePath:    app.ImagePath,
+		EnvVarNames:  app.EnvVarNames,
+		MexDeployGen: MexDeployGenLabel,
+	}
+	envVars, err := getComposeEnvVars(app)
+	if err != nil {
+		return "", err
+	}
+	data.EnvVars = envVars
+	for _, port := range app.Ports {
+		proto := strings.ToLower(port.Proto)
+		if proto == "http" {
+			proto = "tcp"
+		}
+		portStr := port.Port
+		if port.EndPort != "" && port.EndPort != "0" {
+			portStr += "-" + port.EndPort
+		}
+		data.Ports = append(data.Ports, portStr+"/"+proto)
+	}
+	// Like docker run, the command and args replace the
+	// image's default command.
+	args := append(splitCommand(app.Command), app.Args...)
+	for _, arg := range args {
+		arg = strings.TrimSpace(arg)
+		if str, err := strconv.Unquote(arg); err == nil {
+			arg = str
+		}
+		if arg != "" {
+			data.Command = append(data.Command, arg)
+		}
+	}
+	buf := bytes.Buffer{}
+	err = composeT.Execute(&buf, &data)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// getComposeEnvVars gets the environment variables from the
+// envVarsYaml configs. Variables also in EnvVarNames are skipped,
+// as their values are supplied at deployment time.
+func getComposeEnvVars(app *AppSpec) ([]v1.EnvVar, error) {
+	names := make(map[string]struct{})
+	for _, name := range app.EnvVarNames {
+		names[name] = struct{}{}
+	}
+	envVars := []v1.EnvVar{}
+	for _, cfg := range app.EnvVarsYaml {
+		if strings.HasPrefix(cfg, "http://") || strings.HasPrefix(cfg, "https://") {
+			return nil, fmt.Errorf("%s generator requires inline envVarsYaml configs, not %s", DockerComposeBasic, cfg)
+		}
+		var curVars []v1.EnvVar
+		if err := yaml.Unmarshal([]byte(cfg), &curVars); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal env vars: %s - %v", cfg, err)
+		}
+		for _, envVar := range curVars {
+			if envVar.ValueFrom != nil {
+				return nil, fmt.Errorf("%s generator does not support valueFrom for env var %s", DockerComposeBasic, envVar.Name)
+			}
+			if _, found := names[envVar.Name]; found {
+				continue
+			}
+			names[envVar.Name] = struct{}{}
+			envVars = append(envVars, envVar)
+		}
+	}
+	return envVars, nil
+}
+
+// composeQuote quotes the string for yaml, and escapes "$" to
+// prevent docker compose from interpolating it.
+func composeQuote(str string) string {
+	return strconv.Quote(strings.ReplaceAll(str, "$", "$$"))
+}
+
+// Host networking is used to match docker run, so ports are
+// exposed rather than published. Environment variable values are
+// interpolated from the env file passed to docker compose, while
+// values from envVarsYaml configs are set directly.
+var composeTemplate = `services:
+  {{.Name}}:
+    image: {{.ImagePath}}
+    network_mode: host
+    restart: unless-stopped
+    labels:
+      {{.MexDeployGen}}: docker-compose-basic
+{{- if .Ports}}
+    expose:
+{{- range .Ports}}
+    - {{quote .}}
+{{- end}}
+{{- end}}
+{{- if .Command}}
+    command:
+{{- range .Command}}
+    - {{quote .}}
+{{- end}}
+{{- end}}
+{{- if or .EnvVarNames .EnvVars}}
+    environment:
+{{- range .EnvVarNames}}
+      {{.}}: ${{"{"}}{{.}}{{"}"}}
+{{- end}}
+{{- range .EnvVars}}
+      {{.Name}}: {{quote .Value}}
+{{- end}}
+{{- end}}
+`
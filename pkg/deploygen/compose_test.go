@@ -0,0 +1,151 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploygen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploygenDockerCompose(t *testing.T) {
+	appSpec := AppSpec{
+		Name:      "myapp",
+		OrgName:   "myorg",
+		Version:   "1.0",
+		ImagePath: "docker.io/company/imagename:latest",
+		ImageType: "docker",
+		ImageHost: "docker.io",
+		Command:   `bash -c "echo $HOME"`,
+		Args:      []string{"--flag", " value "},
+		Ports: []util.PortSpec{{
+			Proto: "tcp",
+			Port:  "443",
+			Tls:   true,
+		}, {
+			Proto: "http",
+			Port:  "8080",
+		}, {
+			Proto:   "udp",
+			Port:    "8001",
+			EndPort: "8003",
+		}},
+		EnvVarNames: []string{"DB_PASSWORD", "LOG_LEVEL"},
+	}
+	manifest, err := dockerComposeBasic(&appSpec)
+	require.Nil(t, err)
+	if manifest != composeManifest {
+		fmt.Println(manifest)
+	}
+	require.Equal(t, composeManifest, manifest)
+
+	// envVarsYaml configs are set in the environment, app env
+	// vars take precedence
+	configSpec := appSpec
+	configSpec.EnvVarsYaml = []string{`
+- name: LOG_LEVEL
+  value: info
+- name: GREETING
+  value: hello $USER
+`}
+	manifest, err = dockerComposeBasic(&configSpec)
+	require.Nil(t, err)
+	require.Contains(t, manifest, `
+    environment:
+      DB_PASSWORD: ${DB_PASSWORD}
+      LOG_LEVEL: ${LOG_LEVEL}
+      GREETING: "hello $$USER"
+`)
+
+	// only inline values are supported
+	configSpec.EnvVarsYaml = []string{"http://example.com/envvars.yaml"}
+	_, err = dockerComposeBasic(&configSpec)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "requires inline envVarsYaml configs")
+	configSpec.EnvVarsYaml = []string{`
+- name: SECRET
+  valueFrom:
+    secretKeyRef:
+      name: mysecret
+      key: password
+`}
+	_, err = dockerComposeBasic(&configSpec)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "does not support valueFrom")
+
+	// minimal app
+	app := &edgeproto.App{
+		Key: edgeproto.AppKey{
+			Name:         "myapp",
+			Organization: "myorg",
+			Version:      "1.0",
+		},
+		ImagePath: "docker.io/company/imagename:latest",
+		EnvVars: map[string]string{
+			"LOG_LEVEL": "debug",
+		},
+		SecretEnvVars: map[string]string{
+			"DB_PASSWORD": "***",
+		},
+		Configs: []*edgeproto.ConfigFile{{
+			Kind:   edgeproto.AppConfigEnvYaml,
+			Config: "- name: MODE\n  value: prod\n",
+		}},
+	}
+	manifest, err = RunGen(DockerComposeBasic, app)
+	require.Nil(t, err)
+	if manifest != composeMinimalManifest {
+		fmt.Println(manifest)
+	}
+	require.Equal(t, composeMinimalManifest, manifest)
+}
+
+var composeManifest = `services:
+  myapp10:
+    image: docker.io/company/imagename:latest
+    network_mode: host
+    restart: unless-stopped
+    labels:
+      mexDeployGen: docker-compose-basic
+    expose:
+    - "443/tcp"
+    - "8080/tcp"
+    - "8001-8003/udp"
+    command:
+    - "bash"
+    - "-c"
+    - "echo $$HOME"
+    - "--flag"
+    - "value"
+    environment:
+      DB_PASSWORD: ${DB_PASSWORD}
+      LOG_LEVEL: ${LOG_LEVEL}
+`
+
+var composeMinimalManifest = `services:
+  myapp10:
+    image: docker.io/company/imagename:latest
+    network_mode: host
+    restart: unless-stopped
+    labels:
+      mexDeployGen: docker-compose-basic
+    environment:
+      DB_PASSWORD: ${DB_PASSWORD}
+      LOG_LEVEL: ${LOG_LEVEL}
+      MODE: "prod"
+`
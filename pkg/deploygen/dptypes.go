@@ -15,15 +15,19 @@
 package deploygen
 
 import (
+	"sort"
+
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"github.com/edgexr/edge-cloud-platform/pkg/util"
 )
 
 var KubernetesBasic = "kubernetes-basic"
+var DockerComposeBasic = "docker-compose-basic"
 var MexDeployGenLabel = "mexDeployGen"
 
 var Generators = map[string]func(app *AppSpec) (string, error){
-	KubernetesBasic: kubeBasic,
+	KubernetesBasic:    kubeBasic,
+	DockerComposeBasic: dockerComposeBasic,
 }
 
 type AppSpec struct {
@@ -39,6 +43,11 @@ type AppSpec struct {
 	Ports            []util.PortSpec `json:"ports"`
 	ScaleWithCluster bool            `json:"scalewithcluster"`
 	ImageHost        string          `json:"imagehost"`
+	// Names of environment variables, values are supplied
+	// at deployment time so that secrets are not in the manifest.
+	EnvVarNames []string `json:"envvarnames"`
+	// Inline envVarsYaml configs, lists of kubernetes EnvVars
+	EnvVarsYaml []string `json:"envvarsyaml"`
 }
 
 func NewAppSpec(app *edgeproto.App) (*AppSpec, error) {
@@ -59,6 +68,18 @@ func NewAppSpec(app *edgeproto.App) (*AppSpec, error) {
 		return nil, err
 	}
 	out.ImageHost = urlObj.Host
+	for name := range app.EnvVars {
+		out.EnvVarNames = append(out.EnvVarNames, name)
+	}
+	for name := range app.SecretEnvVars {
+		out.EnvVarNames = append(out.EnvVarNames, name)
+	}
+	sort.Strings(out.EnvVarNames)
+	for _, cfg := range app.Configs {
+		if cfg.Kind == edgeproto.AppConfigEnvYaml {
+			out.EnvVarsYaml = append(out.EnvVarsYaml, cfg.Config)
+		}
+	}
 
 	if app.AccessPorts == "" {
 		return out, nil
@@ -200,19 +200,7 @@ func (g *kubeBasicGen) kubeApp() {
 	if g.err != nil {
 		return
 	}
-	var cs []string
-	if g.app.Command != "" {
-		quoted := false
-		cs = strings.FieldsFunc(g.app.Command, func(rn rune) bool {
-			if rn == '"' {
-				quoted = !quoted
-			}
-			return !quoted && rn == ' '
-		})
-		for ii, _ := range cs {
-			cs[ii] = strings.TrimSpace(cs[ii])
-		}
-	}
+	cs := splitCommand(g.app.Command)
 	if len(g.app.Args) > 0 {
 		for ii, arg := range g.app.Args {
 			g.app.Args[ii] = strings.TrimSpace(arg)
@@ -249,6 +237,25 @@ func (g *kubeBasicGen) kubeApp() {
 	g.files = append(g.files, buf.String())
 }
 
+// splitCommand splits the command on spaces, except for
+// spaces within double quotes.
+func splitCommand(command string) []string {
+	if command == "" {
+		return nil
+	}
+	quoted := false
+	cs := strings.FieldsFunc(command, func(rn rune) bool {
+		if rn == '"' {
+			quoted = !quoted
+		}
+		return !quoted && rn == ' '
+	})
+	for ii, _ := range cs {
+		cs[ii] = strings.TrimSpace(cs[ii])
+	}
+	return cs
+}
+
 type appData struct {
 	Name           string
 	DNSName        string
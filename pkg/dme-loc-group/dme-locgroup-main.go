@@ -15,13 +15,22 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
-	"log"
 	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
-	"golang.org/x/net/context"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon/node"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/notify"
+	"github.com/edgexr/edge-cloud-platform/pkg/tls"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -30,41 +39,125 @@ import (
 var rootDir = flag.String("r", "", "root directory for testing")
 var notifyAddrs = flag.String("notifyAddrs", "127.0.0.1:50001", "Comma separated list of controller notify listener addresses")
 var apiAddr = flag.String("apiAddr", "0.0.0.0:50058", "API listener address")
+var tlsApiCertFile = flag.String("tlsApiCertFile", "", "TLS cert file for serving APIs")
+var tlsApiKeyFile = flag.String("tlsApiKeyFile", "", "TLS key file for serving APIs")
+var debugLevels = flag.String("d", "", fmt.Sprintf("comma separated list of %v", log.DebugLevelStrings))
+var region = flag.String("region", "local", "region name")
+var hostname = flag.String("hostname", "", "Unique hostname")
 
-// server is used to implement helloworld.GreeterServer.
-type server struct{}
+var nodeMgr node.NodeMgr
+var appInstCache edgeproto.AppInstCache
 
-func (s *server) SendToGroup(ctx context.Context, req *dme.DlgMessage) (*dme.DlgReply,
-	error) {
-
-	var mreq *dme.DlgReply
+// server implements the DynamicLocGroupApi.
+type server struct {
+	groupMgr *GroupMgr
+}
 
-	fmt.Printf("SendToGroup: To Group %d\n", req.LgId)
-	mreq = new(dme.DlgReply)
-	mreq.AckId = req.MessageId
-	return mreq, nil
+func (s *server) SendToGroup(ctx context.Context, req *dme.DlgMessage) (*dme.DlgReply, error) {
+	span := log.NewSpanFromGrpc(ctx, log.DebugLevelDmereq, "SendToGroup")
+	defer span.Finish()
+	ctx = log.ContextWithSpan(ctx, span)
+	return s.groupMgr.SendToGroup(ctx, req)
 }
 
 func (s *server) AddUserToGroup(ctx context.Context, req *dme.DynamicLocGroupRequest) (*dme.DynamicLocGroupReply, error) {
-	return nil, fmt.Errorf("AddUserToGroup API is unsupported")
+	span := log.NewSpanFromGrpc(ctx, log.DebugLevelDmereq, "AddUserToGroup")
+	defer span.Finish()
+	ctx = log.ContextWithSpan(ctx, span)
+	return s.groupMgr.AddUserToGroup(ctx, req)
 }
 
-// The Group is created as an AppInst from the Controller
-// The AddUser call comes from Client to DME and somehow needs to be communicated
-// to this guy
+// ReceiveFromGroup implements the DynamicLocGroupStreamApi.
+// The Group is created as an AppInst from the Controller. Clients
+// register with the DME, add themselves to the group with their
+// session cookie, and then stream group messages.
+func (s *server) ReceiveFromGroup(req *dme.DynamicLocGroupStreamRequest, cb dme.DynamicLocGroupStreamApi_ReceiveFromGroupServer) error {
+	ctx := cb.Context()
+	span := log.NewSpanFromGrpc(ctx, log.DebugLevelDmereq, "ReceiveFromGroup")
+	defer span.Finish()
+	ctx = log.ContextWithSpan(ctx, span)
+	err := s.groupMgr.ReceiveFromGroup(ctx, req, func(msg *dme.DlgMessage) error {
+		return cb.Send(msg)
+	})
+	log.SpanLog(ctx, log.DebugLevelDmereq, "ReceiveFromGroup done", "lgId", req.LgId, "err", err)
+	return err
+}
 
 func main() {
+	nodeMgr.InitFlags()
 	flag.Parse()
+	log.SetDebugLevelStrs(*debugLevels)
+	done := make(chan struct{})
+	defer close(done)
+
+	ctx, span, err := nodeMgr.Init(node.NodeTypeDmeLocGroup, node.CertIssuerRegional, node.WithName(*hostname), node.WithRegion(*region))
+	if err != nil {
+		log.FatalLog("Failed init node", "err", err)
+	}
+	defer nodeMgr.Finish()
+
+	// session cookies are issued by the DME
+	err = uaemcommon.InitVault(nodeMgr.VaultAddr, *region, done)
+	if err != nil {
+		span.Finish()
+		log.FatalLog("Failed to init vault", "err", err)
+	}
+
+	groupMgr := NewGroupMgr()
+	serv := &server{
+		groupMgr: groupMgr,
+	}
+
+	// Track AppInsts from the Controller
+	clientTlsConfig, err := nodeMgr.InternalPki.GetClientTlsConfig(ctx,
+		nodeMgr.CommonNamePrefix(),
+		node.CertIssuerRegional,
+		[]node.MatchCA{node.SameRegionalMatchCA()})
+	if err != nil {
+		span.Finish()
+		log.FatalLog("Failed to get internal pki tls config", "err", err)
+	}
+	edgeproto.InitAppInstCache(&appInstCache)
+	appInstCache.SetUpdatedCb(groupMgr.appInstUpdated)
+	appInstCache.SetDeletedCb(groupMgr.appInstDeleted)
+	notifyClient := notify.NewClient(nodeMgr.Name(), strings.Split(*notifyAddrs, ","), tls.GetGrpcDialOption(clientTlsConfig))
+	notifyClient.RegisterRecvAppInstCache(&appInstCache)
+	nodeMgr.RegisterClient(notifyClient)
+	notifyClient.Start()
+	defer notifyClient.Stop()
 
 	lis, err := net.Listen("tcp", *apiAddr)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		span.Finish()
+		log.FatalLog("Failed to listen", "addr", *apiAddr, "err", err)
+	}
+	creds, err := tls.ServerAuthServerCreds(*tlsApiCertFile, *tlsApiKeyFile)
+	if err != nil {
+		span.Finish()
+		log.FatalLog("Failed to get TLS credentials", "err", err)
+	}
+	grpcOpts := []grpc.ServerOption{}
+	if creds != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(creds))
 	}
-	s := grpc.NewServer()
-	dme.RegisterDynamicLocGroupApiServer(s, &server{})
+	s := grpc.NewServer(grpcOpts...)
+	dme.RegisterDynamicLocGroupApiServer(s, serv)
+	dme.RegisterDynamicLocGroupStreamApiServer(s, serv)
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
-	}
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.FatalLog("Failed to serve grpc", "err", err)
+		}
+	}()
+	defer s.Stop()
+
+	log.SpanLog(ctx, log.DebugLevelInfo, "dynamic location group service ready", "apiAddr", *apiAddr)
+	span.Finish()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	// wait until killed/interrupted
+	sig := <-sigChan
+	fmt.Println(sig)
 }
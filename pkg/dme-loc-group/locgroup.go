@@ -0,0 +1,419 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
+	"google.golang.org/grpc/metadata"
+)
+
+// Tags used in DynamicLocGroupRequest and DynamicLocGroupReply
+const (
+	// Identifies the AppInst whose group to join, if lg_id is not set
+	TagAppInstName = "appinst"
+	TagAppInstOrg  = "appinstorg"
+	// Group id returned to the client
+	TagLgId = "lg_id"
+)
+
+// SendToGroup callers identify themselves by their session cookie
+// in the grpc metadata under this key, as the DlgMessage has no
+// session cookie field. Only group members may send to the group.
+const SessionCookieMDKey = "session-cookie"
+
+// Number of messages queued per member before it is considered
+// too slow and further messages are dropped for it.
+var MemberQueueSize = 100
+
+// For DLG_ASY_EVERY_N_MESSAGE, every Nth message to a group is acked.
+var AckEveryN uint64 = 10
+
+// For DLG_ACK_EACH_MESSAGE, maximum time to wait for all members
+// to receive the message.
+var AckTimeout = 5 * time.Second
+
+// Members that are not receiving from the group are removed if they
+// have not been added again or sent to the group within this time.
+var MemberIdleTimeout = 5 * time.Minute
+
+var timeNow = time.Now
+
+// Each AppInst created by the controller is a dynamic location group.
+// Clients of the AppInst's App can join the group, and messages sent
+// to the group are fanned out to all members.
+type GroupMgr struct {
+	mux    sync.Mutex
+	groups map[uint64]*group
+	// verify the session cookie and return its key
+	verifyCookie func(ctx context.Context, cookie string) (*uaemcommon.CookieKey, error)
+}
+
+type group struct {
+	id          uint64
+	appInstKey  edgeproto.AppInstKey
+	appKey      edgeproto.AppKey
+	cookie      string
+	secure      bool
+	members     map[string]*member
+	numMessages uint64
+}
+
+type member struct {
+	sessionCookie string
+	msgs          chan *queuedMsg
+	// set while a receive stream is attached
+	receiving bool
+	// last time the member was added or sent to the group
+	lastActive time.Time
+	// closed when the member is removed
+	done   chan struct{}
+	closed bool
+}
+
+type queuedMsg struct {
+	msg       *dme.DlgMessage
+	delivered *sync.WaitGroup
+}
+
+func NewGroupMgr() *GroupMgr {
+	return &GroupMgr{
+		groups:       make(map[uint64]*group),
+		verifyCookie: uaemcommon.VerifyCookie,
+	}
+}
+
+// GetGroupId gets the dynamic location group id for the AppInst.
+func GetGroupId(key *edgeproto.AppInstKey) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key.GetKeyString()))
+	return h.Sum64()
+}
+
+func newGroupCookie() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *GroupMgr) appInstUpdated(ctx context.Context, old *edgeproto.AppInst, new *edgeproto.AppInst) {
+	if new == nil {
+		return
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	id := GetGroupId(&new.Key)
+	if _, found := s.groups[id]; found {
+		return
+	}
+	s.groups[id] = &group{
+		id:         id,
+		appInstKey: new.Key,
+		appKey:     new.AppKey,
+		cookie:     newGroupCookie(),
+		members:    make(map[string]*member),
+	}
+	log.SpanLog(ctx, log.DebugLevelDmereq, "created dynamic location group", "appInst", new.Key, "lgId", id)
+}
+
+func (s *GroupMgr) appInstDeleted(ctx context.Context, old *edgeproto.AppInst) {
+	if old == nil {
+		return
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	id := GetGroupId(&old.Key)
+	grp, found := s.groups[id]
+	if !found {
+		return
+	}
+	for _, mem := range grp.members {
+		mem.close()
+	}
+	delete(s.groups, id)
+	log.SpanLog(ctx, log.DebugLevelDmereq, "deleted dynamic location group", "appInst", old.Key, "lgId", id)
+}
+
+// expireIdleMembers removes members that are not receiving
+// and have been idle too long. Caller must hold lock.
+func (s *GroupMgr) expireIdleMembers(ctx context.Context, grp *group) {
+	now := timeNow()
+	for cookie, mem := range grp.members {
+		if mem.receiving || now.Sub(mem.lastActive) < MemberIdleTimeout {
+			continue
+		}
+		// nothing is queued for members that are not receiving
+		mem.close()
+		delete(grp.members, cookie)
+		log.SpanLog(ctx, log.DebugLevelDmereq, "expired idle group member", "lgId", grp.id, "lastActive", mem.lastActive)
+	}
+}
+
+func (s *member) close() {
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+}
+
+// getGroup gets the group for the request, and checks that the client
+// of the session cookie is allowed to be a member. Caller must hold lock.
+func (s *GroupMgr) getGroup(ctx context.Context, sessionCookie string, lgId uint64, tags map[string]string) (*group, error) {
+	ckey, err := s.verifyCookie(ctx, sessionCookie)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session cookie, %s", err)
+	}
+	if lgId == 0 {
+		appInstKey := edgeproto.AppInstKey{
+			Name:         tags[TagAppInstName],
+			Organization: tags[TagAppInstOrg],
+		}
+		if appInstKey.Name == "" || appInstKey.Organization == "" {
+			return nil, fmt.Errorf("lg_id or %s and %s tags must be specified", TagAppInstName, TagAppInstOrg)
+		}
+		lgId = GetGroupId(&appInstKey)
+	}
+	grp, found := s.groups[lgId]
+	if !found {
+		return nil, fmt.Errorf("dynamic location group %d not found", lgId)
+	}
+	if grp.appKey.Organization != ckey.OrgName || grp.appKey.Name != ckey.AppName || grp.appKey.Version != ckey.AppVers {
+		return nil, fmt.Errorf("session is not for the App of dynamic location group %d", lgId)
+	}
+	return grp, nil
+}
+
+func (s *GroupMgr) AddUserToGroup(ctx context.Context, req *dme.DynamicLocGroupRequest) (*dme.DynamicLocGroupReply, error) {
+	reply := &dme.DynamicLocGroupReply{}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	grp, err := s.getGroup(ctx, req.SessionCookie, req.LgId, req.Tags)
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelDmereq, "AddUserToGroup failed", "lgId", req.LgId, "err", err)
+		reply.Status = dme.ReplyStatus_RS_FAIL
+		return reply, err
+	}
+	s.expireIdleMembers(ctx, grp)
+	mem, found := grp.members[req.SessionCookie]
+	if !found {
+		mem = &member{
+			sessionCookie: req.SessionCookie,
+			msgs:          make(chan *queuedMsg, MemberQueueSize),
+			done:          make(chan struct{}),
+		}
+		grp.members[req.SessionCookie] = mem
+	}
+	mem.lastActive = timeNow()
+	if req.CommType == dme.DynamicLocGroupRequest_DLG_SECURE {
+		// once anyone requires secure communication, senders
+		// must provide the group cookie.
+		grp.secure = true
+		reply.GroupCookie = grp.cookie
+	}
+	reply.Status = dme.ReplyStatus_RS_SUCCESS
+	reply.Tags = map[string]string{
+		TagLgId: strconv.FormatUint(grp.id, 10),
+	}
+	log.SpanLog(ctx, log.DebugLevelDmereq, "added user to group", "lgId", grp.id, "numMembers", len(grp.members))
+	return reply, nil
+}
+
+// attachMember gets the group member for the session and marks it
+// as receiving, so that messages are queued for it.
+func (s *GroupMgr) attachMember(ctx context.Context, sessionCookie string, lgId uint64, tags map[string]string) (*group, *member, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	grp, err := s.getGroup(ctx, sessionCookie, lgId, tags)
+	if err != nil {
+		return nil, nil, err
+	}
+	mem, found := grp.members[sessionCookie]
+	if !found {
+		return nil, nil, fmt.Errorf("not a member of dynamic location group %d, please add user to group first", grp.id)
+	}
+	if mem.receiving {
+		return nil, nil, fmt.Errorf("already receiving from dynamic location group %d", grp.id)
+	}
+	mem.receiving = true
+	return grp, mem, nil
+}
+
+// removeMember removes the member from the group once its receive
+// stream has ended, so messages are no longer queued for it.
+func (s *GroupMgr) removeMember(ctx context.Context, grp *group, mem *member) {
+	s.mux.Lock()
+	if cur, found := grp.members[mem.sessionCookie]; found && cur == mem {
+		delete(grp.members, mem.sessionCookie)
+	}
+	mem.close()
+	numMembers := len(grp.members)
+	s.mux.Unlock()
+	// Messages are only queued under lock to members in the group,
+	// so nothing more will be queued. Release any senders waiting
+	// on acks for the remaining messages.
+	for {
+		select {
+		case qmsg := <-mem.msgs:
+			if qmsg.delivered != nil {
+				qmsg.delivered.Done()
+			}
+		default:
+			log.SpanLog(ctx, log.DebugLevelDmereq, "removed user from group", "lgId", grp.id, "numMembers", numMembers)
+			return
+		}
+	}
+}
+
+// ReceiveFromGroup sends messages for the member to the callback
+// until the context is done, the callback fails, or the group is deleted.
+// The member is removed from the group when it returns.
+func (s *GroupMgr) ReceiveFromGroup(ctx context.Context, req *dme.DynamicLocGroupStreamRequest, cb func(msg *dme.DlgMessage) error) error {
+	grp, mem, err := s.attachMember(ctx, req.SessionCookie, req.LgId, req.Tags)
+	if err != nil {
+		return err
+	}
+	defer s.removeMember(ctx, grp, mem)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-mem.done:
+			return fmt.Errorf("dynamic location group %d deleted", grp.id)
+		case qmsg := <-mem.msgs:
+			err := cb(&dme.DlgMessage{
+				Ver:       qmsg.msg.Ver,
+				LgId:      qmsg.msg.LgId,
+				MessageId: qmsg.msg.MessageId,
+				AckType:   qmsg.msg.AckType,
+				Message:   qmsg.msg.Message,
+			})
+			if qmsg.delivered != nil {
+				qmsg.delivered.Done()
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func getSenderSessionCookie(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get(SessionCookieMDKey)
+	if len(vals) == 0 || vals[0] == "" {
+		return "", fmt.Errorf("%s not found in metadata", SessionCookieMDKey)
+	}
+	return vals[0], nil
+}
+
+func (s *GroupMgr) SendToGroup(ctx context.Context, req *dme.DlgMessage) (*dme.DlgReply, error) {
+	reply := &dme.DlgReply{
+		Ver: req.Ver,
+	}
+	sessionCookie, err := getSenderSessionCookie(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mux.Lock()
+	grp, err := s.getGroup(ctx, sessionCookie, req.LgId, nil)
+	if err != nil {
+		s.mux.Unlock()
+		return nil, err
+	}
+	sender, found := grp.members[sessionCookie]
+	if !found {
+		s.mux.Unlock()
+		return nil, fmt.Errorf("not a member of dynamic location group %d, please add user to group first", grp.id)
+	}
+	if grp.secure && req.GroupCookie != grp.cookie {
+		s.mux.Unlock()
+		return nil, fmt.Errorf("invalid group cookie for dynamic location group %d", req.LgId)
+	}
+	sender.lastActive = timeNow()
+	s.expireIdleMembers(ctx, grp)
+	grp.numMessages++
+	numMessages := grp.numMessages
+	if grp.secure {
+		reply.GroupCookie = grp.cookie
+	}
+
+	var delivered *sync.WaitGroup
+	if req.AckType == dme.DlgMessage_DLG_ACK_EACH_MESSAGE {
+		delivered = &sync.WaitGroup{}
+	}
+	dropped := 0
+	numMembers := 0
+	for _, mem := range grp.members {
+		if !mem.receiving {
+			// only members with a receive stream get messages
+			continue
+		}
+		numMembers++
+		qmsg := &queuedMsg{
+			msg:       req,
+			delivered: delivered,
+		}
+		if delivered != nil {
+			delivered.Add(1)
+		}
+		select {
+		case mem.msgs <- qmsg:
+		default:
+			// don't let one slow member block the group
+			dropped++
+			if delivered != nil {
+				delivered.Done()
+			}
+		}
+	}
+	s.mux.Unlock()
+	log.SpanLog(ctx, log.DebugLevelDmereq, "sent to group", "lgId", req.LgId, "messageId", req.MessageId, "numMembers", numMembers, "dropped", dropped)
+
+	switch req.AckType {
+	case dme.DlgMessage_DLG_ACK_EACH_MESSAGE:
+		if dropped > 0 {
+			return nil, fmt.Errorf("message %d not delivered to %d of %d members of dynamic location group %d", req.MessageId, dropped, numMembers, req.LgId)
+		}
+		waitDone := make(chan struct{})
+		go func() {
+			delivered.Wait()
+			close(waitDone)
+		}()
+		select {
+		case <-waitDone:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(AckTimeout):
+			return nil, fmt.Errorf("timed out waiting for delivery of message %d to dynamic location group %d", req.MessageId, req.LgId)
+		}
+		reply.AckId = req.MessageId
+	case dme.DlgMessage_DLG_ASY_EVERY_N_MESSAGE:
+		if AckEveryN > 0 && numMessages%AckEveryN == 0 {
+			reply.AckId = req.MessageId
+		}
+	}
+	return reply, nil
+}
@@ -0,0 +1,457 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	uaemcommon "github.com/edgexr/edge-cloud-platform/pkg/uaem-common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// test cookies are "org/app/vers/id"
+func testVerifyCookie(ctx context.Context, cookie string) (*uaemcommon.CookieKey, error) {
+	parts := strings.Split(cookie, "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("bad cookie")
+	}
+	return &uaemcommon.CookieKey{
+		OrgName:  parts[0],
+		AppName:  parts[1],
+		AppVers:  parts[2],
+		UniqueId: parts[3],
+	}, nil
+}
+
+func TestGroupMgr(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	defer func(ackEveryN uint64, queueSize int, idleTimeout time.Duration) {
+		AckEveryN = ackEveryN
+		MemberQueueSize = queueSize
+		MemberIdleTimeout = idleTimeout
+		timeNow = time.Now
+	}(AckEveryN, MemberQueueSize, MemberIdleTimeout)
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+
+	mgr := NewGroupMgr()
+	mgr.verifyCookie = testVerifyCookie
+
+	appInst := edgeproto.AppInst{
+		Key: edgeproto.AppInstKey{
+			Name:         "game1",
+			Organization: "devorg",
+		},
+		AppKey: edgeproto.AppKey{
+			Organization: "devorg",
+			Name:         "game",
+			Version:      "1.0",
+		},
+	}
+	lgId := GetGroupId(&appInst.Key)
+
+	// group does not exist until AppInst is created
+	user1 := "devorg/game/1.0/user1"
+	user2 := "devorg/game/1.0/user2"
+	_, err := mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+
+	mgr.appInstUpdated(ctx, nil, &appInst)
+
+	// add users, by id and by AppInst tags
+	reply, err := mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+	})
+	require.Nil(t, err)
+	require.Equal(t, dme.ReplyStatus_RS_SUCCESS, reply.Status)
+	require.Equal(t, strconv.FormatUint(lgId, 10), reply.Tags[TagLgId])
+	require.Equal(t, "", reply.GroupCookie)
+	reply, err = mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user2,
+		Tags: map[string]string{
+			TagAppInstName: appInst.Key.Name,
+			TagAppInstOrg:  appInst.Key.Organization,
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, strconv.FormatUint(lgId, 10), reply.Tags[TagLgId])
+
+	// clients of other apps cannot join
+	reply, err = mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: "devorg/otherapp/1.0/user3",
+		LgId:          lgId,
+	})
+	require.NotNil(t, err)
+	require.Equal(t, dme.ReplyStatus_RS_FAIL, reply.Status)
+	// non-members cannot receive or send
+	user3 := "devorg/game/1.0/user3"
+	err = mgr.ReceiveFromGroup(ctx, &dme.DynamicLocGroupStreamRequest{
+		SessionCookie: user3,
+		LgId:          lgId,
+	}, func(msg *dme.DlgMessage) error { return nil })
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not a member")
+	_, err = mgr.SendToGroup(ctx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 1,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found in metadata")
+	_, err = mgr.SendToGroup(senderCtx(ctx, user3), &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 1,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not a member")
+	sendCtx := senderCtx(ctx, user1)
+
+	// members without a receive stream do not get messages,
+	// so they cannot block acks
+	dreply, err := mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 0,
+		AckType:   dme.DlgMessage_DLG_ACK_EACH_MESSAGE,
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), dreply.AckId)
+
+	// start receivers
+	type recvResult struct {
+		user string
+		msgs []*dme.DlgMessage
+		err  error
+	}
+	recvCtx, recvCancel := context.WithCancel(ctx)
+	defer recvCancel()
+	recvDone := make(chan recvResult, 2)
+	for _, user := range []string{user1, user2} {
+		go func(user string) {
+			res := recvResult{user: user}
+			res.err = mgr.ReceiveFromGroup(recvCtx, &dme.DynamicLocGroupStreamRequest{
+				SessionCookie: user,
+				LgId:          lgId,
+			}, func(msg *dme.DlgMessage) error {
+				res.msgs = append(res.msgs, msg)
+				return nil
+			})
+			recvDone <- res
+		}(user)
+	}
+	waitReceiving(t, mgr, lgId, user1, user2)
+	// a session can only have one receive stream
+	err = mgr.ReceiveFromGroup(ctx, &dme.DynamicLocGroupStreamRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+	}, func(msg *dme.DlgMessage) error { return nil })
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "already receiving")
+
+	// message acked once delivered to all members
+	dreply, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 1,
+		AckType:   dme.DlgMessage_DLG_ACK_EACH_MESSAGE,
+		Message:   "hello",
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), dreply.AckId)
+
+	// no ack requested
+	dreply, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 2,
+		AckType:   dme.DlgMessage_DLG_NO_ACK,
+		Message:   "noack",
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), dreply.AckId)
+
+	// ack every N messages
+	AckEveryN = 5
+	for ii := uint64(3); ii <= 4; ii++ {
+		dreply, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+			LgId:      lgId,
+			MessageId: ii,
+			AckType:   dme.DlgMessage_DLG_ASY_EVERY_N_MESSAGE,
+		})
+		require.Nil(t, err)
+		if ii == 4 {
+			require.Equal(t, ii, dreply.AckId)
+		} else {
+			require.Equal(t, uint64(0), dreply.AckId)
+		}
+	}
+
+	// secure group requires group cookie to send
+	reply, err = mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+		CommType:      dme.DynamicLocGroupRequest_DLG_SECURE,
+	})
+	require.Nil(t, err)
+	require.NotEqual(t, "", reply.GroupCookie)
+	_, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 5,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid group cookie")
+	dreply, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:        lgId,
+		MessageId:   5,
+		GroupCookie: reply.GroupCookie,
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), dreply.AckId)
+
+	// deleting the AppInst ends the receive streams
+	mgr.appInstDeleted(ctx, &appInst)
+	for ii := 0; ii < 2; ii++ {
+		select {
+		case res := <-recvDone:
+			require.NotNil(t, res.err)
+			require.Contains(t, res.err.Error(), "deleted")
+			require.Equal(t, 5, len(res.msgs), res.user)
+			require.Equal(t, "hello", res.msgs[0].Message)
+		case <-time.After(time.Second):
+			require.Fail(t, "receive did not finish")
+		}
+	}
+	_, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 6,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+
+	// slow members do not block the group, but prevent the ack
+	MemberQueueSize = 1
+	mgr.appInstUpdated(ctx, nil, &appInst)
+	_, err = mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+	})
+	require.Nil(t, err)
+	release := make(chan struct{})
+	recvCtx2, recvCancel2 := context.WithCancel(ctx)
+	recvDone2 := make(chan error, 1)
+	go func() {
+		recvDone2 <- mgr.ReceiveFromGroup(recvCtx2, &dme.DynamicLocGroupStreamRequest{
+			SessionCookie: user1,
+			LgId:          lgId,
+		}, func(msg *dme.DlgMessage) error {
+			<-release
+			return nil
+		})
+	}()
+	waitReceiving(t, mgr, lgId, user1)
+	for ii := uint64(1); ii <= 2; ii++ {
+		_, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+			LgId:      lgId,
+			MessageId: ii,
+			AckType:   dme.DlgMessage_DLG_NO_ACK,
+		})
+		require.Nil(t, err)
+	}
+	_, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 3,
+		AckType:   dme.DlgMessage_DLG_ACK_EACH_MESSAGE,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not delivered to 1 of 1 members")
+
+	// member is removed once its receive stream ends, releasing
+	// any queued messages
+	recvCancel2()
+	close(release)
+	select {
+	case err = <-recvDone2:
+		require.Nil(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "receive did not finish")
+	}
+	_, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 4,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not a member")
+
+	// idle members that never receive are expired
+	_, err = mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+	})
+	require.Nil(t, err)
+	_, err = mgr.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user2,
+		LgId:          lgId,
+	})
+	require.Nil(t, err)
+	now = now.Add(MemberIdleTimeout / 2)
+	// sending keeps the sender active
+	_, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 5,
+		AckType:   dme.DlgMessage_DLG_NO_ACK,
+	})
+	require.Nil(t, err)
+	now = now.Add(MemberIdleTimeout/2 + time.Second)
+	_, err = mgr.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 6,
+		AckType:   dme.DlgMessage_DLG_NO_ACK,
+	})
+	require.Nil(t, err)
+	_, err = mgr.SendToGroup(senderCtx(ctx, user2), &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 7,
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not a member")
+}
+
+// waitReceiving waits for the members to attach their receive streams.
+func waitReceiving(t *testing.T, mgr *GroupMgr, lgId uint64, users ...string) {
+	require.Eventually(t, func() bool {
+		mgr.mux.Lock()
+		defer mgr.mux.Unlock()
+		grp, found := mgr.groups[lgId]
+		if !found {
+			return false
+		}
+		for _, user := range users {
+			mem, found := grp.members[user]
+			if !found || !mem.receiving {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func senderCtx(ctx context.Context, sessionCookie string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(SessionCookieMDKey, sessionCookie))
+}
+
+func TestReceiveFromGroupStream(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	mgr := NewGroupMgr()
+	mgr.verifyCookie = testVerifyCookie
+	serv := &server{groupMgr: mgr}
+
+	appInst := edgeproto.AppInst{
+		Key: edgeproto.AppInstKey{
+			Name:         "game1",
+			Organization: "devorg",
+		},
+		AppKey: edgeproto.AppKey{
+			Organization: "devorg",
+			Name:         "game",
+			Version:      "1.0",
+		},
+	}
+	lgId := GetGroupId(&appInst.Key)
+	mgr.appInstUpdated(ctx, nil, &appInst)
+	user1 := "devorg/game/1.0/user1"
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	grpcServer := grpc.NewServer()
+	dme.RegisterDynamicLocGroupApiServer(grpcServer, serv)
+	dme.RegisterDynamicLocGroupStreamApiServer(grpcServer, serv)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	defer conn.Close()
+	dlgClient := dme.NewDynamicLocGroupApiClient(conn)
+	streamClient := dme.NewDynamicLocGroupStreamApiClient(conn)
+
+	// not a member yet
+	streamReq := &dme.DynamicLocGroupStreamRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+	}
+	stream, err := streamClient.ReceiveFromGroup(ctx, streamReq)
+	require.Nil(t, err)
+	_, err = stream.Recv()
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not a member")
+
+	_, err = dlgClient.AddUserToGroup(ctx, &dme.DynamicLocGroupRequest{
+		SessionCookie: user1,
+		LgId:          lgId,
+	})
+	require.Nil(t, err)
+	streamCtx, streamCancel := context.WithCancel(ctx)
+	defer streamCancel()
+	stream, err = streamClient.ReceiveFromGroup(streamCtx, streamReq)
+	require.Nil(t, err)
+	waitReceiving(t, mgr, lgId, user1)
+
+	sendCtx := metadata.AppendToOutgoingContext(ctx, SessionCookieMDKey, user1)
+	dreply, err := dlgClient.SendToGroup(sendCtx, &dme.DlgMessage{
+		LgId:      lgId,
+		MessageId: 10,
+		AckType:   dme.DlgMessage_DLG_ACK_EACH_MESSAGE,
+		Message:   "hello",
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), dreply.AckId)
+
+	msg, err := stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, lgId, msg.LgId)
+	require.Equal(t, uint64(10), msg.MessageId)
+	require.Equal(t, "hello", msg.Message)
+
+	// closing the stream removes the member
+	streamCancel()
+	require.Eventually(t, func() bool {
+		_, err := dlgClient.SendToGroup(sendCtx, &dme.DlgMessage{
+			LgId:      lgId,
+			MessageId: 11,
+		})
+		return err != nil && strings.Contains(err.Error(), "not a member")
+	}, 5*time.Second, 10*time.Millisecond)
+}
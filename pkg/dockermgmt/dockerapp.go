@@ -672,7 +672,16 @@ func GetContainerCommand(clusterInst *edgeproto.ClusterInst, app *edgeproto.App,
 		cmdStr += req.ContainerId
 		return cmdStr, nil
 	}
-	return "", fmt.Errorf("no command or log specified with exec request")
+	if req.CopyFile != nil {
+		// no tty so binary data is passed through unmodified
+		cmdStr := "docker exec "
+		if req.CopyFile.Upload {
+			cmdStr += "-i "
+		}
+		cmdStr += req.ContainerId + " " + shellquote.Join(cloudcommon.GetCopyFileCommand(req.CopyFile)...)
+		return cmdStr, nil
+	}
+	return "", fmt.Errorf("no command, log, or copy file specified with exec request")
 }
 
 // SingleOpts are docker run options that take no option value.
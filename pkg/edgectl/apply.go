@@ -0,0 +1,675 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/edgexr/edge-cloud-platform/pkg/gencmd"
+	"github.com/spf13/pflag"
+)
+
+var applyPlanOnly bool
+var applyPrune bool
+var applyPruneOrgs []string
+
+var applyCmd = &cli.Command{
+	Use:          "Apply",
+	DataFlagOnly: true,
+	ReqData:      &edgeproto.AllData{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runApply,
+	AddFlagsFunc: addApplyFlags,
+}
+
+func addApplyFlags(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&applyPlanOnly, "plan", false, "only show the plan, do not apply any changes")
+	flagSet.BoolVar(&applyPrune, "prune", false, "delete objects in owned organizations that are not in the input data")
+	flagSet.StringSliceVar(&applyPruneOrgs, "prune-orgs", nil, "organizations owned by the input data for pruning, required with prune")
+}
+
+type applyActionType string
+
+const (
+	applyCreate   applyActionType = "create"
+	applyUpdate   applyActionType = "update"
+	applyDelete   applyActionType = "delete"
+	applyConflict applyActionType = "conflict"
+)
+
+var applyActionSymbols = map[applyActionType]string{
+	applyCreate:   "+",
+	applyUpdate:   "~",
+	applyDelete:   "-",
+	applyConflict: "!",
+}
+
+var applyActionVerbs = map[applyActionType]string{
+	applyCreate: "Create",
+	applyUpdate: "Update",
+	applyDelete: "Delete",
+}
+
+// applyFieldDiff describes a changed field for an update.
+type applyFieldDiff struct {
+	name string
+	old  string
+	new  string
+}
+
+// applyAction is a single step of the plan.
+type applyAction struct {
+	action   applyActionType
+	typeName string
+	key      string
+	diffs    []applyFieldDiff
+	reason   string
+	run      func(c *cli.Command) error
+}
+
+// applyHandler describes how to read, diff, and converge one
+// object type of AllData.
+type applyHandler[T any] struct {
+	typeName string
+	// dataField is the AllData field name for the object list
+	dataField     string
+	allFields     map[string]string
+	updateFields  *edgeproto.FieldMap
+	getKey        func(obj *T) string
+	getOrg        func(obj *T) string
+	getDiffFields func(obj, cur *T) *edgeproto.FieldMap
+	isKeyField    func(field string) bool
+	show          func(ctx context.Context) ([]T, error)
+	create        func(c *cli.Command, obj *T) error
+	update        func(c *cli.Command, obj *T) error
+	delete        func(c *cli.Command, obj *T) error
+	// skipPrune skips objects that are managed by the system
+	skipPrune func(obj *T) bool
+}
+
+// applyPlanner is the type-independent interface of applyHandler.
+type applyPlanner interface {
+	name() string
+	plan(ctx context.Context, data *edgeproto.AllData, mapped *cli.MapData) ([]applyAction, error)
+	prune(ctx context.Context, data *edgeproto.AllData, owned map[string]struct{}) ([]applyAction, error)
+}
+
+func (s *applyHandler[T]) name() string {
+	return s.typeName
+}
+
+func (s *applyHandler[T]) getObjs(data *edgeproto.AllData) []T {
+	val := reflect.ValueOf(data).Elem().FieldByName(s.dataField)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil
+		}
+		return []T{*val.Interface().(*T)}
+	}
+	return val.Interface().([]T)
+}
+
+// getSpecifiedFields gets the fields that were specified in the
+// input data for the object at the given index.
+func (s *applyHandler[T]) getSpecifiedFields(mapped *cli.MapData, ii int, obj *T) ([]string, error) {
+	if mapped == nil {
+		return nil, nil
+	}
+	allDataType := reflect.TypeOf(edgeproto.AllData{})
+	for name, val := range mapped.Data {
+		sf, ok := cli.FindField(allDataType, name, mapped.Namespace)
+		if !ok || sf.Name != s.dataField {
+			continue
+		}
+		var objMap map[string]interface{}
+		var err error
+		if sf.Type.Kind() == reflect.Slice {
+			objMap, err = cli.GetGenericObjFromList(val, ii)
+		} else {
+			objMap, err = cli.GetGenericObj(val)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid data map for %s: %v", s.typeName, err)
+		}
+		objMapData := &cli.MapData{
+			Namespace: mapped.Namespace,
+			Data:      objMap,
+		}
+		return cli.GetSpecifiedFields(objMapData, obj), nil
+	}
+	return nil, nil
+}
+
+func (s *applyHandler[T]) plan(ctx context.Context, data *edgeproto.AllData, mapped *cli.MapData) ([]applyAction, error) {
+	objs := s.getObjs(data)
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	curObjs, err := s.show(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cur := make(map[string]*T)
+	for ii := range curObjs {
+		cur[s.getKey(&curObjs[ii])] = &curObjs[ii]
+	}
+
+	actions := []applyAction{}
+	for ii := range objs {
+		obj := &objs[ii]
+		key := s.getKey(obj)
+		curObj, found := cur[key]
+		if !found {
+			if s.create == nil {
+				return nil, fmt.Errorf("%s %s not found", s.typeName, key)
+			}
+			actions = append(actions, applyAction{
+				action:   applyCreate,
+				typeName: s.typeName,
+				key:      key,
+				run: func(c *cli.Command) error {
+					return s.create(c, obj)
+				},
+			})
+			continue
+		}
+		specified, err := s.getSpecifiedFields(mapped, ii, obj)
+		if err != nil {
+			return nil, err
+		}
+		diffFields := s.getDiffFields(obj, curObj)
+		changed := []string{}
+		immutable := []string{}
+		for _, field := range specified {
+			if s.isKeyField(field) || !diffFields.Has(field) {
+				continue
+			}
+			if _, ok := s.allFields[field]; !ok {
+				// not a user-settable field
+				continue
+			}
+			changed = append(changed, field)
+			if s.update == nil || !s.updateFields.Has(field) {
+				immutable = append(immutable, s.allFields[field])
+			}
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		sort.Strings(changed)
+		diffs := []applyFieldDiff{}
+		for _, field := range changed {
+			diffs = append(diffs, applyFieldDiff{
+				name: s.allFields[field],
+				old:  getFieldValueString(curObj, field),
+				new:  getFieldValueString(obj, field),
+			})
+		}
+		if len(immutable) > 0 {
+			sort.Strings(immutable)
+			actions = append(actions, applyAction{
+				action:   applyConflict,
+				typeName: s.typeName,
+				key:      key,
+				diffs:    diffs,
+				reason:   fmt.Sprintf("field(s) %s cannot be modified", strings.Join(immutable, ",")),
+			})
+			continue
+		}
+		fields := changed
+		actions = append(actions, applyAction{
+			action:   applyUpdate,
+			typeName: s.typeName,
+			key:      key,
+			diffs:    diffs,
+			run: func(c *cli.Command) error {
+				reflect.ValueOf(obj).Elem().FieldByName("Fields").Set(reflect.ValueOf(fields))
+				return s.update(c, obj)
+			},
+		})
+	}
+	return actions, nil
+}
+
+func (s *applyHandler[T]) prune(ctx context.Context, data *edgeproto.AllData, owned map[string]struct{}) ([]applyAction, error) {
+	if s.delete == nil || s.getOrg == nil {
+		return nil, nil
+	}
+	want := make(map[string]struct{})
+	objs := s.getObjs(data)
+	for ii := range objs {
+		want[s.getKey(&objs[ii])] = struct{}{}
+	}
+	curObjs, err := s.show(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actions := []applyAction{}
+	for ii := range curObjs {
+		obj := &curObjs[ii]
+		if _, ok := owned[s.getOrg(obj)]; !ok {
+			continue
+		}
+		if s.skipPrune != nil && s.skipPrune(obj) {
+			continue
+		}
+		key := s.getKey(obj)
+		if _, ok := want[key]; ok {
+			continue
+		}
+		actions = append(actions, applyAction{
+			action:   applyDelete,
+			typeName: s.typeName,
+			key:      key,
+			run: func(c *cli.Command) error {
+				return s.delete(c, obj)
+			},
+		})
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].key < actions[j].key
+	})
+	return actions, nil
+}
+
+// getFieldValueString gets the value of the field, specified by
+// its protobuf field id path, as a json string.
+func getFieldValueString(obj interface{}, field string) string {
+	mapData, err := cli.GetStructMap(obj, cli.WithStructMapFieldFlags([]string{field}))
+	if err != nil {
+		return "?"
+	}
+	var val interface{} = mapData.Data
+	for range strings.Split(field, ".") {
+		m, ok := val.(map[string]interface{})
+		if !ok || len(m) != 1 {
+			break
+		}
+		for _, v := range m {
+			val = v
+		}
+	}
+	out, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprintf("%v", val)
+	}
+	return string(out)
+}
+
+// recvAll reads all objects from a Show stream.
+func recvAll[T any](stream interface{ Recv() (*T, error) }, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	objs := []T{}
+	for {
+		obj, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, *obj)
+	}
+	return objs, nil
+}
+
+func keyString[T interface{ GetKeyString() string }](key T) string {
+	return key.GetKeyString()
+}
+
+// applyHandlers are in create order.
+var applyHandlers = []applyPlanner{
+	&applyHandler[edgeproto.Flavor]{
+		typeName:      "Flavor",
+		dataField:     "Flavors",
+		allFields:     edgeproto.FlavorAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateFlavorFieldsMap,
+		getKey:        func(obj *edgeproto.Flavor) string { return keyString(&obj.Key) },
+		getDiffFields: func(obj, cur *edgeproto.Flavor) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.Flavor{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.Flavor, error) {
+			return recvAll[edgeproto.Flavor](gencmd.FlavorApiCmd.ShowFlavor(ctx, &edgeproto.Flavor{}))
+		},
+		create: gencmd.CreateFlavor,
+		update: gencmd.UpdateFlavor,
+		delete: gencmd.DeleteFlavor,
+	},
+	&applyHandler[edgeproto.Settings]{
+		typeName:      "Settings",
+		dataField:     "Settings",
+		allFields:     edgeproto.SettingsAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateSettingsFieldsMap,
+		getKey:        func(obj *edgeproto.Settings) string { return "" },
+		getDiffFields: func(obj, cur *edgeproto.Settings) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    func(field string) bool { return false },
+		show: func(ctx context.Context) ([]edgeproto.Settings, error) {
+			settings, err := gencmd.SettingsApiCmd.ShowSettings(ctx, &edgeproto.Settings{})
+			if err != nil {
+				return nil, err
+			}
+			return []edgeproto.Settings{*settings}, nil
+		},
+		update: gencmd.UpdateSettings,
+	},
+	&applyHandler[edgeproto.OperatorCode]{
+		typeName:  "OperatorCode",
+		dataField: "OperatorCodes",
+		getKey:    func(obj *edgeproto.OperatorCode) string { return obj.Organization + "/" + obj.Code },
+		getOrg:    func(obj *edgeproto.OperatorCode) string { return obj.Organization },
+		getDiffFields: func(obj, cur *edgeproto.OperatorCode) *edgeproto.FieldMap {
+			// the whole object is the key
+			return edgeproto.MakeFieldMap(nil)
+		},
+		isKeyField: func(field string) bool { return true },
+		show: func(ctx context.Context) ([]edgeproto.OperatorCode, error) {
+			return recvAll[edgeproto.OperatorCode](gencmd.OperatorCodeApiCmd.ShowOperatorCode(ctx, &edgeproto.OperatorCode{}))
+		},
+		create: gencmd.CreateOperatorCode,
+		delete: gencmd.DeleteOperatorCode,
+	},
+	&applyHandler[edgeproto.Cloudlet]{
+		typeName:      "Cloudlet",
+		dataField:     "Cloudlets",
+		allFields:     edgeproto.CloudletAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateCloudletFieldsMap,
+		getKey:        func(obj *edgeproto.Cloudlet) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.Cloudlet) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.Cloudlet) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.Cloudlet{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.Cloudlet, error) {
+			return recvAll[edgeproto.Cloudlet](gencmd.CloudletApiCmd.ShowCloudlet(ctx, &edgeproto.Cloudlet{}))
+		},
+		create: gencmd.CreateCloudlet,
+		update: gencmd.UpdateCloudlet,
+		delete: gencmd.DeleteCloudlet,
+	},
+	&applyHandler[edgeproto.AutoScalePolicy]{
+		typeName:      "AutoScalePolicy",
+		dataField:     "AutoScalePolicies",
+		allFields:     edgeproto.AutoScalePolicyAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateAutoScalePolicyFieldsMap,
+		getKey:        func(obj *edgeproto.AutoScalePolicy) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.AutoScalePolicy) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.AutoScalePolicy) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.AutoScalePolicy{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.AutoScalePolicy, error) {
+			return recvAll[edgeproto.AutoScalePolicy](gencmd.AutoScalePolicyApiCmd.ShowAutoScalePolicy(ctx, &edgeproto.AutoScalePolicy{}))
+		},
+		create: gencmd.CreateAutoScalePolicy,
+		update: gencmd.UpdateAutoScalePolicy,
+		delete: gencmd.DeleteAutoScalePolicy,
+	},
+	&applyHandler[edgeproto.AutoProvPolicy]{
+		typeName:      "AutoProvPolicy",
+		dataField:     "AutoProvPolicies",
+		allFields:     edgeproto.AutoProvPolicyAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateAutoProvPolicyFieldsMap,
+		getKey:        func(obj *edgeproto.AutoProvPolicy) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.AutoProvPolicy) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.AutoProvPolicy) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.AutoProvPolicy{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.AutoProvPolicy, error) {
+			return recvAll[edgeproto.AutoProvPolicy](gencmd.AutoProvPolicyApiCmd.ShowAutoProvPolicy(ctx, &edgeproto.AutoProvPolicy{}))
+		},
+		create: gencmd.CreateAutoProvPolicy,
+		update: gencmd.UpdateAutoProvPolicy,
+		delete: gencmd.DeleteAutoProvPolicy,
+	},
+	&applyHandler[edgeproto.App]{
+		typeName:      "App",
+		dataField:     "Apps",
+		allFields:     edgeproto.AppAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateAppFieldsMap,
+		getKey:        func(obj *edgeproto.App) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.App) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.App) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.App{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.App, error) {
+			return recvAll[edgeproto.App](gencmd.AppApiCmd.ShowApp(ctx, &edgeproto.App{}))
+		},
+		create: gencmd.CreateApp,
+		update: gencmd.UpdateApp,
+		delete: gencmd.DeleteApp,
+	},
+	&applyHandler[edgeproto.TrustPolicy]{
+		typeName:      "TrustPolicy",
+		dataField:     "TrustPolicies",
+		allFields:     edgeproto.TrustPolicyAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateTrustPolicyFieldsMap,
+		getKey:        func(obj *edgeproto.TrustPolicy) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.TrustPolicy) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.TrustPolicy) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.TrustPolicy{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.TrustPolicy, error) {
+			return recvAll[edgeproto.TrustPolicy](gencmd.TrustPolicyApiCmd.ShowTrustPolicy(ctx, &edgeproto.TrustPolicy{}))
+		},
+		create: gencmd.CreateTrustPolicy,
+		update: gencmd.UpdateTrustPolicy,
+		delete: gencmd.DeleteTrustPolicy,
+	},
+	&applyHandler[edgeproto.TrustPolicyException]{
+		typeName:      "TrustPolicyException",
+		dataField:     "TrustPolicyExceptions",
+		allFields:     edgeproto.TrustPolicyExceptionAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateTrustPolicyExceptionFieldsMap,
+		getKey:        func(obj *edgeproto.TrustPolicyException) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.TrustPolicyException) string { return obj.Key.AppKey.Organization },
+		getDiffFields: func(obj, cur *edgeproto.TrustPolicyException) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.TrustPolicyException{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.TrustPolicyException, error) {
+			return recvAll[edgeproto.TrustPolicyException](gencmd.TrustPolicyExceptionApiCmd.ShowTrustPolicyException(ctx, &edgeproto.TrustPolicyException{}))
+		},
+		create: gencmd.CreateTrustPolicyException,
+		update: gencmd.UpdateTrustPolicyException,
+		delete: gencmd.DeleteTrustPolicyException,
+	},
+	&applyHandler[edgeproto.Network]{
+		typeName:      "Network",
+		dataField:     "Networks",
+		allFields:     edgeproto.NetworkAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateNetworkFieldsMap,
+		getKey:        func(obj *edgeproto.Network) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.Network) string { return obj.Key.CloudletKey.Organization },
+		getDiffFields: func(obj, cur *edgeproto.Network) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.Network{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.Network, error) {
+			return recvAll[edgeproto.Network](gencmd.NetworkApiCmd.ShowNetwork(ctx, &edgeproto.Network{}))
+		},
+		create: gencmd.CreateNetwork,
+		update: gencmd.UpdateNetwork,
+		delete: gencmd.DeleteNetwork,
+	},
+	&applyHandler[edgeproto.ClusterInst]{
+		typeName:      "ClusterInst",
+		dataField:     "ClusterInsts",
+		allFields:     edgeproto.ClusterInstAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateClusterInstFieldsMap,
+		getKey:        func(obj *edgeproto.ClusterInst) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.ClusterInst) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.ClusterInst) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.ClusterInst{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.ClusterInst, error) {
+			return recvAll[edgeproto.ClusterInst](gencmd.ClusterInstApiCmd.ShowClusterInst(ctx, &edgeproto.ClusterInst{}))
+		},
+		create: gencmd.CreateClusterInst,
+		update: gencmd.UpdateClusterInst,
+		delete: gencmd.DeleteClusterInst,
+		// reservable clusters are created on demand by the system
+		skipPrune: func(obj *edgeproto.ClusterInst) bool { return obj.Reservable },
+	},
+	&applyHandler[edgeproto.AppInst]{
+		typeName:      "AppInst",
+		dataField:     "AppInstances",
+		allFields:     edgeproto.AppInstAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateAppInstFieldsMap,
+		getKey:        func(obj *edgeproto.AppInst) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.AppInst) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.AppInst) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.AppInst{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.AppInst, error) {
+			return recvAll[edgeproto.AppInst](gencmd.AppInstApiCmd.ShowAppInst(ctx, &edgeproto.AppInst{}))
+		},
+		create: gencmd.CreateAppInst,
+		update: gencmd.UpdateAppInst,
+		delete: gencmd.DeleteAppInst,
+		// auto-provisioned and internal AppInsts are created by the system
+		skipPrune: func(obj *edgeproto.AppInst) bool {
+			return obj.Liveness == edgeproto.Liveness_LIVENESS_AUTOPROV || obj.Liveness == edgeproto.Liveness_LIVENESS_DYNAMIC
+		},
+	},
+	&applyHandler[edgeproto.AlertPolicy]{
+		typeName:      "AlertPolicy",
+		dataField:     "AlertPolicies",
+		allFields:     edgeproto.AlertPolicyAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateAlertPolicyFieldsMap,
+		getKey:        func(obj *edgeproto.AlertPolicy) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.AlertPolicy) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.AlertPolicy) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.AlertPolicy{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.AlertPolicy, error) {
+			return recvAll[edgeproto.AlertPolicy](gencmd.AlertPolicyApiCmd.ShowAlertPolicy(ctx, &edgeproto.AlertPolicy{}))
+		},
+		create: gencmd.CreateAlertPolicy,
+		update: gencmd.UpdateAlertPolicy,
+		delete: gencmd.DeleteAlertPolicy,
+	},
+}
+
+// applyDeleteOrder matches the order used by the Delete command.
+var applyDeleteOrder = []string{
+	"AppInst",
+	"ClusterInst",
+	"TrustPolicy",
+	"TrustPolicyException",
+	"Network",
+	"App",
+	"AutoProvPolicy",
+	"AutoScalePolicy",
+	"Cloudlet",
+	"OperatorCode",
+	"Flavor",
+	"AlertPolicy",
+}
+
+func runApply(c *cli.Command, args []string) error {
+	mapped, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	data := c.ReqData.(*edgeproto.AllData)
+	ctx := context.Background()
+
+	var pruneOrgs []string
+	if applyPrune {
+		if len(applyPruneOrgs) == 0 {
+			return fmt.Errorf("prune-orgs must be specified with prune")
+		}
+		pruneOrgs = applyPruneOrgs
+	}
+	actions, err := getApplyPlan(ctx, applyHandlers, data, mapped, pruneOrgs)
+	if err != nil {
+		return err
+	}
+	out := c.CobraCmd.OutOrStdout()
+	writeApplyPlan(out, actions)
+
+	conflicts := 0
+	for _, action := range actions {
+		if action.action == applyConflict {
+			conflicts++
+		}
+	}
+	if conflicts > 0 {
+		return fmt.Errorf("plan has %d conflict(s), no changes applied", conflicts)
+	}
+	if applyPlanOnly {
+		return nil
+	}
+	for _, action := range actions {
+		fmt.Fprintf(out, "%s %s %s\n", applyActionVerbs[action.action], action.typeName, action.key)
+		if err := action.run(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getApplyPlan gets the actions to converge the region to the input
+// data. If pruneOrgs are specified, objects in those organizations
+// that are not in the input data are deleted.
+func getApplyPlan(ctx context.Context, planners []applyPlanner, data *edgeproto.AllData, mapped *cli.MapData, pruneOrgs []string) ([]applyAction, error) {
+	actions := []applyAction{}
+	for _, handler := range planners {
+		handlerActions, err := handler.plan(ctx, data, mapped)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan %s: %s", handler.name(), err)
+		}
+		actions = append(actions, handlerActions...)
+	}
+	if len(pruneOrgs) == 0 {
+		return actions, nil
+	}
+
+	owned := make(map[string]struct{})
+	for _, org := range pruneOrgs {
+		owned[org] = struct{}{}
+	}
+	handlers := make(map[string]applyPlanner)
+	for _, handler := range planners {
+		handlers[handler.name()] = handler
+	}
+	for _, name := range applyDeleteOrder {
+		handler, ok := handlers[name]
+		if !ok {
+			continue
+		}
+		handlerActions, err := handler.prune(ctx, data, owned)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan prune of %s: %s", name, err)
+		}
+		actions = append(actions, handlerActions...)
+	}
+	return actions, nil
+}
+
+func writeApplyPlan(out io.Writer, actions []applyAction) {
+	counts := make(map[applyActionType]int)
+	fmt.Fprintf(out, "Plan:\n")
+	for _, action := range actions {
+		counts[action.action]++
+		fmt.Fprintf(out, "  %s %s %s\n", applyActionSymbols[action.action], action.typeName, action.key)
+		for _, diff := range action.diffs {
+			fmt.Fprintf(out, "      %s: %s -> %s\n", diff.name, diff.old, diff.new)
+		}
+		if action.reason != "" {
+			fmt.Fprintf(out, "      %s\n", action.reason)
+		}
+	}
+	fmt.Fprintf(out, "%d to create, %d to update, %d to delete", counts[applyCreate], counts[applyUpdate], counts[applyDelete])
+	if counts[applyConflict] > 0 {
+		fmt.Fprintf(out, ", %d conflicts", counts[applyConflict])
+	}
+	fmt.Fprintf(out, "\n")
+}
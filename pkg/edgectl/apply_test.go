@@ -0,0 +1,246 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAppHandler(cur []edgeproto.App, ran *[]string) *applyHandler[edgeproto.App] {
+	record := func(action string) func(c *cli.Command, obj *edgeproto.App) error {
+		return func(c *cli.Command, obj *edgeproto.App) error {
+			*ran = append(*ran, action+" "+obj.Key.GetKeyString())
+			return nil
+		}
+	}
+	return &applyHandler[edgeproto.App]{
+		typeName:      "App",
+		dataField:     "Apps",
+		allFields:     edgeproto.AppAllFieldsStringMap,
+		updateFields:  edgeproto.UpdateAppFieldsMap,
+		getKey:        func(obj *edgeproto.App) string { return keyString(&obj.Key) },
+		getOrg:        func(obj *edgeproto.App) string { return obj.Key.Organization },
+		getDiffFields: func(obj, cur *edgeproto.App) *edgeproto.FieldMap { return obj.GetDiffFields(cur) },
+		isKeyField:    (&edgeproto.App{}).IsKeyField,
+		show: func(ctx context.Context) ([]edgeproto.App, error) {
+			return cur, nil
+		},
+		create: record("create"),
+		update: record("update"),
+		delete: record("delete"),
+	}
+}
+
+func testApp(org, name, image string) edgeproto.App {
+	return edgeproto.App{
+		Key: edgeproto.AppKey{
+			Organization: org,
+			Name:         name,
+			Version:      "1.0",
+		},
+		ImagePath:  image,
+		Deployment: "kubernetes",
+	}
+}
+
+type testApplyAction struct {
+	action applyActionType
+	key    string
+	diffs  []applyFieldDiff
+}
+
+func TestApplyPlan(t *testing.T) {
+	ctx := context.Background()
+	cur := []edgeproto.App{
+		testApp("devorg", "app1", "image1"),
+		testApp("devorg", "app2", "image2"),
+	}
+	app1Key := keyString(&cur[0].Key)
+	app3 := testApp("devorg", "app3", "image3")
+	app3Key := keyString(&app3.Key)
+
+	var tests = []struct {
+		desc     string
+		input    string
+		expected []testApplyAction
+		ran      []string
+	}{{
+		desc:  "no changes",
+		input: `{"apps":[{"key":{"organization":"devorg","name":"app1","version":"1.0"},"image_path":"image1"}]}`,
+	}, {
+		desc:  "unspecified fields are not changed",
+		input: `{"apps":[{"key":{"organization":"devorg","name":"app1","version":"1.0"}}]}`,
+	}, {
+		desc:  "create",
+		input: `{"apps":[{"key":{"organization":"devorg","name":"app3","version":"1.0"},"image_path":"image3"}]}`,
+		expected: []testApplyAction{
+			{action: applyCreate, key: app3Key},
+		},
+		ran: []string{"create " + app3Key},
+	}, {
+		desc:  "update",
+		input: `{"apps":[{"key":{"organization":"devorg","name":"app1","version":"1.0"},"image_path":"image1b"}]}`,
+		expected: []testApplyAction{{
+			action: applyUpdate,
+			key:    app1Key,
+			diffs: []applyFieldDiff{
+				{name: "Image Path", old: `"image1"`, new: `"image1b"`},
+			},
+		}},
+		ran: []string{"update " + app1Key},
+	}, {
+		desc:  "immutable field conflict",
+		input: `{"apps":[{"key":{"organization":"devorg","name":"app1","version":"1.0"},"del_opt":"AUTO_DELETE"}]}`,
+		expected: []testApplyAction{{
+			action: applyConflict,
+			key:    app1Key,
+			diffs: []applyFieldDiff{
+				{name: "Del Opt", old: `"NoAutoDelete"`, new: `"AutoDelete"`},
+			},
+		}},
+	}}
+	for _, test := range tests {
+		data := &edgeproto.AllData{}
+		require.Nil(t, json.Unmarshal([]byte(test.input), data), test.desc)
+		mapped := &cli.MapData{
+			Namespace: cli.JsonNamespace,
+			Data:      map[string]interface{}{},
+		}
+		require.Nil(t, json.Unmarshal([]byte(test.input), &mapped.Data), test.desc)
+
+		ran := []string{}
+		planners := []applyPlanner{newTestAppHandler(cur, &ran)}
+		actions, err := getApplyPlan(ctx, planners, data, mapped, nil)
+		require.Nil(t, err, test.desc)
+		got := []testApplyAction{}
+		for _, action := range actions {
+			require.Equal(t, "App", action.typeName, test.desc)
+			got = append(got, testApplyAction{
+				action: action.action,
+				key:    action.key,
+				diffs:  action.diffs,
+			})
+			if action.run != nil {
+				require.Nil(t, action.run(nil), test.desc)
+			}
+		}
+		if test.expected == nil {
+			test.expected = []testApplyAction{}
+		}
+		require.Equal(t, test.expected, got, test.desc)
+		if test.ran == nil {
+			test.ran = []string{}
+		}
+		require.Equal(t, test.ran, ran, test.desc)
+	}
+}
+
+func TestApplyPrune(t *testing.T) {
+	ctx := context.Background()
+	cur := []edgeproto.App{
+		testApp("devorg", "app1", "image1"),
+		testApp("devorg", "app3", "image3"),
+		testApp("devorg", "app2", "image2"),
+		testApp("otherorg", "app1", "image1"),
+	}
+	keys := []string{}
+	for ii := range cur {
+		keys = append(keys, keyString(&cur[ii].Key))
+	}
+	input := &edgeproto.AllData{
+		Apps: []edgeproto.App{cur[0]},
+	}
+
+	var tests = []struct {
+		desc      string
+		pruneOrgs []string
+		deleted   []string
+	}{{
+		desc: "no prune orgs does not delete",
+	}, {
+		desc:      "delete missing objects in owned org",
+		pruneOrgs: []string{"devorg"},
+		deleted:   []string{keys[2], keys[1]},
+	}, {
+		desc:      "delete all missing objects in owned orgs",
+		pruneOrgs: []string{"devorg", "otherorg"},
+		deleted:   []string{keys[2], keys[1], keys[3]},
+	}, {
+		desc:      "org not in input data",
+		pruneOrgs: []string{"otherorg"},
+		deleted:   []string{keys[3]},
+	}, {
+		desc:      "org with no objects",
+		pruneOrgs: []string{"emptyorg"},
+	}}
+	for _, test := range tests {
+		ran := []string{}
+		planners := []applyPlanner{newTestAppHandler(cur, &ran)}
+		actions, err := getApplyPlan(ctx, planners, input, nil, test.pruneOrgs)
+		require.Nil(t, err, test.desc)
+		deleted := []string{}
+		for _, action := range actions {
+			require.Equal(t, applyDelete, action.action, test.desc)
+			deleted = append(deleted, action.key)
+			require.Nil(t, action.run(nil), test.desc)
+		}
+		if test.deleted == nil {
+			test.deleted = []string{}
+		}
+		require.Equal(t, test.deleted, deleted, test.desc)
+		require.Equal(t, len(test.deleted), len(ran), test.desc)
+	}
+}
+
+func TestApplyPruneSkipsSystemAppInsts(t *testing.T) {
+	ctx := context.Background()
+	var handler *applyHandler[edgeproto.AppInst]
+	for _, planner := range applyHandlers {
+		if h, ok := planner.(*applyHandler[edgeproto.AppInst]); ok {
+			handler = h
+		}
+	}
+	require.NotNil(t, handler)
+
+	testAppInst := func(name string, liveness edgeproto.Liveness) edgeproto.AppInst {
+		return edgeproto.AppInst{
+			Key: edgeproto.AppInstKey{
+				Name:         name,
+				Organization: "devorg",
+			},
+			Liveness: liveness,
+		}
+	}
+	cur := []edgeproto.AppInst{
+		testAppInst("static", edgeproto.Liveness_LIVENESS_STATIC),
+		testAppInst("autoprov", edgeproto.Liveness_LIVENESS_AUTOPROV),
+		testAppInst("dynamic", edgeproto.Liveness_LIVENESS_DYNAMIC),
+	}
+	h := *handler
+	h.show = func(ctx context.Context) ([]edgeproto.AppInst, error) {
+		return cur, nil
+	}
+	actions, err := getApplyPlan(ctx, []applyPlanner{&h}, &edgeproto.AllData{}, nil, []string{"devorg"})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, applyDelete, actions[0].action)
+	require.Equal(t, keyString(&cur[0].Key), actions[0].key)
+}
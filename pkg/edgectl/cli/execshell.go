@@ -15,17 +15,22 @@
 package cli
 
 import (
+	"bytes"
 	"crypto/tls"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/edgeturnclient"
+	"github.com/xtaci/smux"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -70,6 +75,10 @@ type WSStreamPayload struct {
 type ExecOptions struct {
 	Stdin bool
 	Tty   bool
+	// File data to upload for CopyFile requests
+	CopyFileData []byte
+	// Local address to listen on for PortForward requests
+	LocalAddr string
 }
 
 func RunEdgeTurn(req *edgeproto.ExecRequest, options *ExecOptions, exchangeFunc func() (*edgeproto.ExecRequest, error)) error {
@@ -87,6 +96,24 @@ func RunEdgeTurn(req *edgeproto.ExecRequest, options *ExecOptions, exchangeFunc
 
 	if reply.Console != nil {
 		fmt.Println(reply.AccessUrl)
+	} else if reply.PortForward != nil {
+		lis, err := net.Listen("tcp", options.LocalAddr)
+		if err != nil {
+			return err
+		}
+		defer lis.Close()
+		fmt.Printf("Forwarding %s -> port %d\n", lis.Addr().String(), reply.PortForward.Port)
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- RunEdgeForward(reply.AccessUrl, lis)
+		}()
+		select {
+		case <-signalChan:
+		case err = <-errChan:
+			return err
+		}
+	} else if reply.CopyFile != nil {
+		return RunEdgeCopy(reply.AccessUrl, reply.CopyFile.Upload, options.CopyFileData, os.Stdout)
 	} else {
 		d := websocket.Dialer{
 			Proxy:            http.ProxyFromEnvironment,
@@ -181,3 +208,87 @@ func RunEdgeTurn(req *edgeproto.ExecRequest, options *ExecOptions, exchangeFunc
 
 	return nil
 }
+
+// RunEdgeCopy uploads data to or downloads data from the EdgeTurn
+// copy file access URL. Downloaded data is written to out.
+func RunEdgeCopy(accessUrl string, upload bool, data []byte, out io.Writer) error {
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	method := http.MethodGet
+	var body io.Reader
+	if upload {
+		method = http.MethodPut
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, accessUrl, body)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("copy file failed, %s: %s", http.StatusText(resp.StatusCode), strings.TrimSpace(string(msg)))
+	}
+	if upload {
+		return nil
+	}
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+// RunEdgeForward forwards connections accepted on the listener to the
+// EdgeTurn port forward access URL, until the listener is closed.
+func RunEdgeForward(accessUrl string, lis net.Listener) error {
+	d := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 45 * time.Second,
+		TLSClientConfig:  &tls.Config{InsecureSkipVerify: true},
+	}
+	// The access token can only be used once, so all local
+	// connections are multiplexed over one websocket connection.
+	ws, _, err := d.Dial(accessUrl, nil)
+	if err != nil {
+		return err
+	}
+	sess, err := smux.Client(edgeturnclient.NewWebsocketConn(ws), nil)
+	if err != nil {
+		ws.Close()
+		return err
+	}
+	defer sess.Close()
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return err
+		}
+		stream, err := sess.OpenStream()
+		if err != nil {
+			conn.Close()
+			return fmt.Errorf("port forward session closed, %v", err)
+		}
+		go forwardConn(conn, stream)
+	}
+}
+
+func forwardConn(conn net.Conn, stream *smux.Stream) {
+	defer conn.Close()
+	defer stream.Close()
+	done := make(chan bool, 2)
+	go func() {
+		io.Copy(stream, conn)
+		done <- true
+	}()
+	go func() {
+		io.Copy(conn, stream)
+		done <- true
+	}()
+	<-done
+}
@@ -17,8 +17,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/edgexr/edge-cloud-platform/pkg/cli"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
 	edgecli "github.com/edgexr/edge-cloud-platform/pkg/edgectl/cli"
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
 	"google.golang.org/grpc"
@@ -40,6 +43,16 @@ func runShowLogs(c *cli.Command, args []string) error {
 	return runExecRequest(c, args, execApiCmd.ShowLogs)
 }
 
+func runRunCopyFile(c *cli.Command, args []string) error {
+	return runExecRequest(c, args, execApiCmd.RunCopyFile)
+}
+
+// The port forward user is determined by the Controller from
+// the client certificate.
+func runRunPortForward(c *cli.Command, args []string) error {
+	return runExecRequest(c, args, execApiCmd.RunPortForward)
+}
+
 func runAccessCloudlet(c *cli.Command, args []string) error {
 	return runExecRequest(c, args, execApiCmd.AccessCloudlet)
 }
@@ -66,8 +79,21 @@ func runExecRequest(c *cli.Command, args []string, apiFunc execFunc) error {
 		return reply, nil
 	}
 	options := &edgecli.ExecOptions{
-		Stdin: cli.Interactive,
-		Tty:   cli.Tty,
+		Stdin:     cli.Interactive,
+		Tty:       cli.Tty,
+		LocalAddr: cli.LocalAddr,
+	}
+	if req.CopyFile != nil && req.CopyFile.Upload {
+		// upload data is read from stdin
+		data, err := io.ReadAll(io.LimitReader(os.Stdin, cloudcommon.ExecCopyFileMaxSize+1))
+		if err != nil {
+			return err
+		}
+		if int64(len(data)) > cloudcommon.ExecCopyFileMaxSize {
+			return fmt.Errorf("file exceeds max allowed size of %d bytes", cloudcommon.ExecCopyFileMaxSize)
+		}
+		req.CopyFile.FileSize = int64(len(data))
+		options.CopyFileData = data
 	}
 	return edgecli.RunEdgeTurn(req, options, exchangeFunc)
 }
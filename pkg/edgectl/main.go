@@ -173,13 +173,17 @@ func main() {
 	controllerCmd.AddCommand(gencmd.RateLimitSettingsApiCmds...)
 	controllerCmd.AddCommand(createCmd.GenCmd())
 	controllerCmd.AddCommand(deleteCmd.GenCmd())
+	controllerCmd.AddCommand(applyCmd.GenCmd())
 	gencmd.RunCommandCmd.Run = runRunCommand
 	gencmd.RunCommandCmd.AddFlagsFunc = cli.AddTtyFlags
 	gencmd.ShowLogsCmd.Run = runShowLogs
 	gencmd.RunConsoleCmd.Run = runRunConsole
 	gencmd.AccessCloudletCmd.Run = runAccessCloudlet
+	gencmd.RunCopyFileCmd.Run = runRunCopyFile
+	gencmd.RunPortForwardCmd.Run = runRunPortForward
+	gencmd.RunPortForwardCmd.AddFlagsFunc = cli.AddPortForwardFlags
 	gencmd.AccessCloudletCmd.AddFlagsFunc = cli.AddTtyFlags
-	controllerCmd.AddCommand(gencmd.RunCommandCmd.GenCmd(), gencmd.RunConsoleCmd.GenCmd(), gencmd.ShowLogsCmd.GenCmd(), gencmd.AccessCloudletCmd.GenCmd())
+	controllerCmd.AddCommand(gencmd.RunCommandCmd.GenCmd(), gencmd.RunConsoleCmd.GenCmd(), gencmd.ShowLogsCmd.GenCmd(), gencmd.RunCopyFileCmd.GenCmd(), gencmd.RunPortForwardCmd.GenCmd(), gencmd.AccessCloudletCmd.GenCmd())
 
 	dmeCmd.AddCommand(gencmd.MatchEngineApiCmds...)
 	dmeCmd.AddCommand(gencmd.DebugApiCmds...)
@@ -0,0 +1,81 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edgeturnclient
+
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// WebsocketConn adapts a websocket connection to a net.Conn, so that
+// stream protocols like smux can be run over the websocket. Data is
+// sent as binary messages, and message boundaries are ignored when
+// reading.
+type WebsocketConn struct {
+	*websocket.Conn
+	rd   io.Reader
+	wmux sync.Mutex
+}
+
+var _ net.Conn = (*WebsocketConn)(nil)
+
+func NewWebsocketConn(ws *websocket.Conn) *WebsocketConn {
+	return &WebsocketConn{
+		Conn: ws,
+	}
+}
+
+func (s *WebsocketConn) Read(p []byte) (int, error) {
+	for {
+		if s.rd == nil {
+			_, rd, err := s.Conn.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			s.rd = rd
+		}
+		n, err := s.rd.Read(p)
+		if err == io.EOF {
+			// end of message, continue with the next one
+			s.rd = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (s *WebsocketConn) Write(p []byte) (int, error) {
+	// websocket allows only one concurrent writer
+	s.wmux.Lock()
+	defer s.wmux.Unlock()
+	if err := s.Conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *WebsocketConn) SetDeadline(t time.Time) error {
+	if err := s.Conn.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return s.Conn.SetWriteDeadline(t)
+}
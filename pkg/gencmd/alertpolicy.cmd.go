@@ -36,6 +36,8 @@ func AlertPolicyHideTags(in *edgeproto.AlertPolicy) {
 	if _, found := tags["nocmp"]; found {
 		in.DeletePrepare = false
 	}
+	for i0 := 0; i0 < len(in.Conditions); i0++ {
+	}
 }
 
 var AlertPolicyApiCmd edgeproto.AlertPolicyApiClient
@@ -307,6 +309,21 @@ var AlertPolicyKeyComments = map[string]string{
 	"name":         "Alert Policy name",
 }
 var AlertPolicyKeySpecialArgs = map[string]string{}
+var AlertPolicyConditionRequiredArgs = []string{}
+var AlertPolicyConditionOptionalArgs = []string{
+	"expr",
+	"comparison",
+	"threshold",
+	"window",
+}
+var AlertPolicyConditionAliasArgs = []string{}
+var AlertPolicyConditionComments = map[string]string{
+	"expr":       "Prometheus expression over the metrics exposed by the App, for example sum(rate(http_requests_total{code=~5..}[$window]))by(pod). Aggregations must keep the pod label. $window is replaced by the condition window",
+	"comparison": "Comparison of the expression value against the threshold, one of Above, Below, one of Above, Below",
+	"threshold":  "Threshold that triggers the condition",
+	"window":     "Window used for range vectors specified as [$window] in the expression",
+}
+var AlertPolicyConditionSpecialArgs = map[string]string{}
 var AlertPolicyRequiredArgs = []string{
 	"alertorg",
 	"name",
@@ -321,6 +338,11 @@ var AlertPolicyOptionalArgs = []string{
 	"labels",
 	"annotations",
 	"description",
+	"conditions:empty",
+	"conditions:#.expr",
+	"conditions:#.comparison",
+	"conditions:#.threshold",
+	"conditions:#.window",
 }
 var AlertPolicyAliasArgs = []string{
 	"alertorg=key.organization",
@@ -332,18 +354,23 @@ var AlertPolicyAliasArgs = []string{
 	"triggertime=triggertime",
 }
 var AlertPolicyComments = map[string]string{
-	"alertorg":          "Name of the organization for the app that this alert can be applied to",
-	"name":              "Alert Policy name",
-	"cpuutilization":    "Container or pod CPU utilization rate(percentage) across all nodes. Valid values 1-100",
-	"memutilization":    "Container or pod memory utilization rate(percentage) across all nodes. Valid values 1-100",
-	"diskutilization":   "Container or pod disk utilization rate(percentage) across all nodes. Valid values 1-100",
-	"activeconnections": "Active Connections alert threshold. Valid values 1-4294967295",
-	"severity":          "Alert severity level - one of info, warning, error",
-	"triggertime":       "Duration for which alert interval is active (max 72 hours)",
-	"labels":            "Additional Labels, specify labels:empty=true to clear",
-	"annotations":       "Additional Annotations for extra information about the alert, specify annotations:empty=true to clear",
-	"description":       "Description of the alert policy",
-	"deleteprepare":     "Preparing to be deleted",
+	"alertorg":                "Name of the organization for the app that this alert can be applied to",
+	"name":                    "Alert Policy name",
+	"cpuutilization":          "Container or pod CPU utilization rate(percentage) across all nodes. Valid values 1-100",
+	"memutilization":          "Container or pod memory utilization rate(percentage) across all nodes. Valid values 1-100",
+	"diskutilization":         "Container or pod disk utilization rate(percentage) across all nodes. Valid values 1-100",
+	"activeconnections":       "Active Connections alert threshold. Valid values 1-4294967295",
+	"severity":                "Alert severity level - one of info, warning, error",
+	"triggertime":             "Duration for which alert interval is active (max 72 hours)",
+	"labels":                  "Additional Labels, specify labels:empty=true to clear",
+	"annotations":             "Additional Annotations for extra information about the alert, specify annotations:empty=true to clear",
+	"description":             "Description of the alert policy",
+	"deleteprepare":           "Preparing to be deleted",
+	"conditions:empty":        "Conditions on App metric expressions, all of which must be met to trigger the alert, specify conditions:empty=true to clear",
+	"conditions:#.expr":       "Prometheus expression over the metrics exposed by the App, for example sum(rate(http_requests_total{code=~5..}[$window]))by(pod). Aggregations must keep the pod label. $window is replaced by the condition window",
+	"conditions:#.comparison": "Comparison of the expression value against the threshold, one of Above, Below, one of Above, Below",
+	"conditions:#.threshold":  "Threshold that triggers the condition",
+	"conditions:#.window":     "Window used for range vectors specified as [$window] in the expression",
 }
 var AlertPolicySpecialArgs = map[string]string{
 	"annotations": "StringToString",
@@ -364,4 +391,8 @@ var CreateAlertPolicyOptionalArgs = []string{
 	"labels",
 	"annotations",
 	"description",
+	"conditions:#.expr",
+	"conditions:#.comparison",
+	"conditions:#.threshold",
+	"conditions:#.window",
 }
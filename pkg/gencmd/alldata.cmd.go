@@ -41,6 +41,8 @@ func AllDataHideTags(in *edgeproto.AllData) {
 	for i0 := 0; i0 < len(in.TrustPolicies); i0++ {
 		for i1 := 0; i1 < len(in.TrustPolicies[i0].OutboundSecurityRules); i1++ {
 		}
+		for i1 := 0; i1 < len(in.TrustPolicies[i0].InboundSecurityRules); i1++ {
+		}
 	}
 	for i0 := 0; i0 < len(in.GpuDrivers); i0++ {
 		for i1 := 0; i1 < len(in.GpuDrivers[i0].Builds); i1++ {
@@ -172,6 +174,10 @@ func AllDataHideTags(in *edgeproto.AllData) {
 	for i0 := 0; i0 < len(in.AutoProvPolicies); i0++ {
 		for i1 := 0; i1 < len(in.AutoProvPolicies[i0].Zones); i1++ {
 		}
+		for i1 := 0; i1 < len(in.AutoProvPolicies[i0].Schedules); i1++ {
+			for i2 := 0; i2 < len(in.AutoProvPolicies[i0].Schedules[i1].Zones); i2++ {
+			}
+		}
 	}
 	for i0 := 0; i0 < len(in.AutoProvPolicyZones); i0++ {
 	}
@@ -210,6 +216,9 @@ func AllDataHideTags(in *edgeproto.AllData) {
 		}
 		for i1 := 0; i1 < len(in.ClusterInsts[i0].NodePools); i1++ {
 		}
+		if _, found := tags["nocmp"]; found {
+			in.ClusterInsts[i0].DryRun = false
+		}
 	}
 	for i0 := 0; i0 < len(in.Apps); i0++ {
 		if _, found := tags["nocmp"]; found {
@@ -234,6 +243,9 @@ func AllDataHideTags(in *edgeproto.AllData) {
 		if _, found := tags["nocmp"]; found {
 			in.Apps[i0].CompatibilityVersion = 0
 		}
+		if _, found := tags["nocmp"]; found {
+			in.Apps[i0].RolloutStatus = nil
+		}
 	}
 	for i0 := 0; i0 < len(in.AppInstances); i0++ {
 		for i1 := 0; i1 < len(in.AppInstances[i0].MappedPorts); i1++ {
@@ -267,6 +279,12 @@ func AllDataHideTags(in *edgeproto.AllData) {
 		if _, found := tags["nocmp"]; found {
 			in.AppInstances[i0].DbModelId = 0
 		}
+		if _, found := tags["nocmp"]; found {
+			in.AppInstances[i0].RolloutStatus = nil
+		}
+		if _, found := tags["nocmp"]; found {
+			in.AppInstances[i0].DryRun = false
+		}
 	}
 	for i0 := 0; i0 < len(in.AppInstRefs); i0++ {
 	}
@@ -288,6 +306,8 @@ func AllDataHideTags(in *edgeproto.AllData) {
 		if _, found := tags["nocmp"]; found {
 			in.AlertPolicies[i0].DeletePrepare = false
 		}
+		for i1 := 0; i1 < len(in.AlertPolicies[i0].Conditions); i1++ {
+		}
 	}
 	for i0 := 0; i0 < len(in.FlowRateLimitSettings); i0++ {
 	}
@@ -350,6 +370,7 @@ var AllDataOptionalArgs = []string{
 	"settings.platformhainstancepollinterval",
 	"settings.platformhainstanceactiveexpiretime",
 	"settings.ccrmapitimeout",
+	"settings.trustpolicyfqdnrefreshinterval",
 	"operatorcodes:#.code",
 	"operatorcodes:#.organization",
 	"restagtables:#.fields",
@@ -365,7 +386,13 @@ var AllDataOptionalArgs = []string{
 	"trustpolicies:#.outboundsecurityrules:#.portrangemin",
 	"trustpolicies:#.outboundsecurityrules:#.portrangemax",
 	"trustpolicies:#.outboundsecurityrules:#.remotecidr",
+	"trustpolicies:#.outboundsecurityrules:#.remotefqdn",
 	"trustpolicies:#.deleteprepare",
+	"trustpolicies:#.inboundsecurityrules:#.protocol",
+	"trustpolicies:#.inboundsecurityrules:#.portrangemin",
+	"trustpolicies:#.inboundsecurityrules:#.portrangemax",
+	"trustpolicies:#.inboundsecurityrules:#.remotecidr",
+	"trustpolicies:#.inboundsecurityrules:#.remotefqdn",
 	"gpudrivers:#.fields",
 	"gpudrivers:#.key.name",
 	"gpudrivers:#.key.organization",
@@ -441,6 +468,7 @@ var AllDataOptionalArgs = []string{
 	"zones:#.location.course",
 	"zones:#.location.speed",
 	"zones:#.location.timestamp",
+	"zones:#.timezone",
 	"zones:#.objid",
 	"zones:#.deleteprepare",
 	"zones:#.createdat",
@@ -657,6 +685,18 @@ var AllDataOptionalArgs = []string{
 	"autoprovpolicies:#.undeployclientcount",
 	"autoprovpolicies:#.undeployintervalcount",
 	"autoprovpolicies:#.deleteprepare",
+	"autoprovpolicies:#.schedules:#.name",
+	"autoprovpolicies:#.schedules:#.cron",
+	"autoprovpolicies:#.schedules:#.duration",
+	"autoprovpolicies:#.schedules:#.zones:#.organization",
+	"autoprovpolicies:#.schedules:#.zones:#.name",
+	"autoprovpolicies:#.schedules:#.zones:#.federatedorganization",
+	"autoprovpolicies:#.schedules:#.minactiveinstances",
+	"autoprovpolicies:#.scheduleleadtime",
+	"autoprovpolicies:#.mininstancelifetime",
+	"autoprovpolicies:#.redeploycooldown",
+	"autoprovpolicies:#.maxflaps",
+	"autoprovpolicies:#.flapwindow",
 	"autoprovpolicyzones:#.key.organization",
 	"autoprovpolicyzones:#.key.name",
 	"autoprovpolicyzones:#.zonekey.organization",
@@ -750,6 +790,7 @@ var AllDataOptionalArgs = []string{
 	"clusterinsts:#.infraannotations",
 	"clusterinsts:#.kubernetesversion",
 	"clusterinsts:#.disabledynamicappinstplacement",
+	"clusterinsts:#.dryrun",
 	"clusterinsts:#.tags",
 	"apps:#.fields",
 	"apps:#.key.organization",
@@ -788,6 +829,7 @@ var AllDataOptionalArgs = []string{
 	"apps:#.requiredoutboundconnections:#.portrangemin",
 	"apps:#.requiredoutboundconnections:#.portrangemax",
 	"apps:#.requiredoutboundconnections:#.remotecidr",
+	"apps:#.requiredoutboundconnections:#.remotefqdn",
 	"apps:#.allowserverless",
 	"apps:#.serverlessconfig.vcpus",
 	"apps:#.serverlessconfig.ram",
@@ -834,6 +876,27 @@ var AllDataOptionalArgs = []string{
 	"apps:#.isstandalone",
 	"apps:#.managesownnamespaces",
 	"apps:#.compatibilityversion",
+	"apps:#.findcloudletranking",
+	"apps:#.findcloudletrankingweights.distance",
+	"apps:#.findcloudletrankingweights.latency",
+	"apps:#.findcloudletrankingweights.load",
+	"apps:#.rolloutpolicy.strategy",
+	"apps:#.rolloutpolicy.canarypercent",
+	"apps:#.rolloutpolicy.healthtimeout",
+	"apps:#.rolloutpolicy.observationtime",
+	"apps:#.rolloutpolicy.batchby",
+	"apps:#.rolloutpolicy.batchsize",
+	"apps:#.rolloutstatus.state",
+	"apps:#.rolloutstatus.batch",
+	"apps:#.rolloutstatus.numbatches",
+	"apps:#.rolloutstatus.controller",
+	"apps:#.rolloutstatus.forceupdate",
+	"apps:#.horizontalscalepolicy.minreplicas",
+	"apps:#.horizontalscalepolicy.maxreplicas",
+	"apps:#.horizontalscalepolicy.targetcpu",
+	"apps:#.horizontalscalepolicy.targetmem",
+	"apps:#.horizontalscalepolicy.targetactiveconnections",
+	"apps:#.horizontalscalepolicy.stabilizationwindowsec",
 	"apps:#.tags",
 	"appinstances:#.fields",
 	"appinstances:#.key.name",
@@ -878,6 +941,8 @@ var AllDataOptionalArgs = []string{
 	"appinstances:#.errors",
 	"appinstances:#.crmoverride",
 	"appinstances:#.runtimeinfo.containerids",
+	"appinstances:#.runtimeinfo.replicas",
+	"appinstances:#.runtimeinfo.desiredreplicas",
 	"appinstances:#.createdat",
 	"appinstances:#.autoclusteripaccess",
 	"appinstances:#.revision",
@@ -932,6 +997,18 @@ var AllDataOptionalArgs = []string{
 	"appinstances:#.noderesources.infranodeflavor",
 	"appinstances:#.noderesources.externalvolumesize",
 	"appinstances:#.isstandalone",
+	"appinstances:#.rolloutstatus.state",
+	"appinstances:#.rolloutstatus.batch",
+	"appinstances:#.rolloutstatus.numbatches",
+	"appinstances:#.rolloutstatus.controller",
+	"appinstances:#.rolloutstatus.forceupdate",
+	"appinstances:#.dryrun",
+	"appinstances:#.horizontalscalepolicy.minreplicas",
+	"appinstances:#.horizontalscalepolicy.maxreplicas",
+	"appinstances:#.horizontalscalepolicy.targetcpu",
+	"appinstances:#.horizontalscalepolicy.targetmem",
+	"appinstances:#.horizontalscalepolicy.targetactiveconnections",
+	"appinstances:#.horizontalscalepolicy.stabilizationwindowsec",
 	"appinstances:#.tags",
 	"appinstrefs:#.key.organization",
 	"appinstrefs:#.key.name",
@@ -973,6 +1050,10 @@ var AllDataOptionalArgs = []string{
 	"alertpolicies:#.annotations",
 	"alertpolicies:#.description",
 	"alertpolicies:#.deleteprepare",
+	"alertpolicies:#.conditions:#.expr",
+	"alertpolicies:#.conditions:#.comparison",
+	"alertpolicies:#.conditions:#.threshold",
+	"alertpolicies:#.conditions:#.window",
 	"flowratelimitsettings:#.fields",
 	"flowratelimitsettings:#.key.flowsettingsname",
 	"flowratelimitsettings:#.key.ratelimitkey.apiname",
@@ -1001,6 +1082,7 @@ var AllDataOptionalArgs = []string{
 	"trustpolicyexceptions:#.outboundsecurityrules:#.portrangemin",
 	"trustpolicyexceptions:#.outboundsecurityrules:#.portrangemax",
 	"trustpolicyexceptions:#.outboundsecurityrules:#.remotecidr",
+	"trustpolicyexceptions:#.outboundsecurityrules:#.remotefqdn",
 }
 var AllDataAliasArgs = []string{}
 var AllDataComments = map[string]string{
@@ -1053,6 +1135,7 @@ var AllDataComments = map[string]string{
 	"settings.platformhainstancepollinterval":                                    "Platform HA instance poll interval",
 	"settings.platformhainstanceactiveexpiretime":                                "Platform HA instance active time",
 	"settings.ccrmapitimeout":                                                    "Timeout for controller platform-specific API calls to CCRM",
+	"settings.trustpolicyfqdnrefreshinterval":                                    "Trust policy remote FQDN resolution refresh interval",
 	"operatorcodes:#.code":                                                       "MCC plus MNC code, or custom carrier code designation.",
 	"operatorcodes:#.organization":                                               "Operator Organization name",
 	"restagtables:#.key.name":                                                    "Resource Table Name",
@@ -1067,7 +1150,13 @@ var AllDataComments = map[string]string{
 	"trustpolicies:#.outboundsecurityrules:#.portrangemin":                       "TCP or UDP port range start",
 	"trustpolicies:#.outboundsecurityrules:#.portrangemax":                       "TCP or UDP port range end",
 	"trustpolicies:#.outboundsecurityrules:#.remotecidr":                         "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"trustpolicies:#.outboundsecurityrules:#.remotefqdn":                         "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 	"trustpolicies:#.deleteprepare":                                              "Preparing to be deleted",
+	"trustpolicies:#.inboundsecurityrules:#.protocol":                            "TCP, UDP, ICMP",
+	"trustpolicies:#.inboundsecurityrules:#.portrangemin":                        "TCP or UDP port range start",
+	"trustpolicies:#.inboundsecurityrules:#.portrangemax":                        "TCP or UDP port range end",
+	"trustpolicies:#.inboundsecurityrules:#.remotecidr":                          "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"trustpolicies:#.inboundsecurityrules:#.remotefqdn":                          "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 	"gpudrivers:#.fields":                                                        "Fields are used for the Update API to specify which fields to apply",
 	"gpudrivers:#.key.name":                                                      "Name of the driver",
 	"gpudrivers:#.key.organization":                                              "Organization to which the driver belongs to",
@@ -1143,6 +1232,7 @@ var AllDataComments = map[string]string{
 	"zones:#.location.course":                                                    "Course (IOS) / bearing (Android) (degrees east relative to true north)",
 	"zones:#.location.speed":                                                     "Speed (IOS) / velocity (Android) (meters/sec)",
 	"zones:#.location.timestamp":                                                 "Timestamp",
+	"zones:#.timezone":                                                           "IANA time zone name (e.g. America/Los_Angeles) used to evaluate time-of-day schedules, defaults to UTC",
 	"zones:#.objid":                                                              "Universally unique object ID",
 	"zones:#.deleteprepare":                                                      "Preparing to be deleted",
 	"zones:#.createdat":                                                          "Created at time",
@@ -1359,6 +1449,18 @@ var AllDataComments = map[string]string{
 	"autoprovpolicies:#.undeployclientcount":                                     "Number of active clients for the undeploy interval below which trigers undeployment, 0 (default) disables auto undeploy",
 	"autoprovpolicies:#.undeployintervalcount":                                   "Number of intervals to check before triggering undeployment",
 	"autoprovpolicies:#.deleteprepare":                                           "Preparing to be deleted",
+	"autoprovpolicies:#.schedules:#.name":                                        "Schedule name, unique within the policy",
+	"autoprovpolicies:#.schedules:#.cron":                                        "Window start time in 5-field cron format (minute hour day-of-month month day-of-week), evaluated in each zones time zone",
+	"autoprovpolicies:#.schedules:#.duration":                                    "Length of the window, i.e. 2h, 30m",
+	"autoprovpolicies:#.schedules:#.zones:#.organization":                        "Organization owner of the Zone",
+	"autoprovpolicies:#.schedules:#.zones:#.name":                                "Name of the Zone",
+	"autoprovpolicies:#.schedules:#.zones:#.federatedorganization":               "Federated operator organization who shared this Zone",
+	"autoprovpolicies:#.schedules:#.minactiveinstances":                          "Minimum number of active instances in total across the schedules zones whose window is active, not per zone",
+	"autoprovpolicies:#.scheduleleadtime":                                        "How far ahead of a scheduled window to deploy instances, i.e. 10m, 1h",
+	"autoprovpolicies:#.mininstancelifetime":                                     "Minimum time an auto-provisioned instance is kept before it can be undeployed due to low demand, i.e. 30m",
+	"autoprovpolicies:#.redeploycooldown":                                        "Time after undeploying from a zone before deploying to it again due to demand, i.e. 15m",
+	"autoprovpolicies:#.maxflaps":                                                "Number of demand-driven undeploys of an App within the flap window above which demand-driven deploys of the App are suspended, undeploys are still allowed, 0 (default) disables",
+	"autoprovpolicies:#.flapwindow":                                              "Window over which undeploys are counted against max flaps, also the time for which demand-driven deploys are suspended, i.e. 1h",
 	"autoprovpolicyzones:#.key.organization":                                     "Name of the organization for the cluster that this policy will apply to",
 	"autoprovpolicyzones:#.key.name":                                             "Policy name",
 	"autoprovpolicyzones:#.zonekey.organization":                                 "Organization owner of the Zone",
@@ -1452,6 +1554,7 @@ var AllDataComments = map[string]string{
 	"clusterinsts:#.infraannotations":                                            "Annotations added by the implementing infrastructure",
 	"clusterinsts:#.kubernetesversion":                                           "Kubernetes version of cluster if applicable",
 	"clusterinsts:#.disabledynamicappinstplacement":                              "Disables dynamic placement of AppInsts on this cluster",
+	"clusterinsts:#.dryrun":                                                      "Run validation and resource checks without creating or updating the instance, and show the placement decisions",
 	"clusterinsts:#.tags":                                                        "Vendor-specific data",
 	"apps:#.fields":                                                              "Fields are used for the Update API to specify which fields to apply",
 	"apps:#.key.organization":                                                    "App developer organization",
@@ -1490,6 +1593,7 @@ var AllDataComments = map[string]string{
 	"apps:#.requiredoutboundconnections:#.portrangemin":                          "TCP or UDP port range start",
 	"apps:#.requiredoutboundconnections:#.portrangemax":                          "TCP or UDP port range end",
 	"apps:#.requiredoutboundconnections:#.remotecidr":                            "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"apps:#.requiredoutboundconnections:#.remotefqdn":                            "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 	"apps:#.allowserverless":                                                     "App is allowed to deploy as serverless containers",
 	"apps:#.serverlessconfig.vcpus":                                              "Virtual CPUs allocation per container when serverless, may be decimal in increments of 0.001",
 	"apps:#.serverlessconfig.ram":                                                "RAM allocation in megabytes per container when serverless",
@@ -1536,172 +1640,212 @@ var AllDataComments = map[string]string{
 	"apps:#.isstandalone":                                                        "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
 	"apps:#.managesownnamespaces":                                                "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
 	"apps:#.compatibilityversion":                                                "Internal compatibility version",
-	"apps:#.tags":                                                                "Vendor-specific data",
-	"appinstances:#.fields":                                                      "Fields are used for the Update API to specify which fields to apply",
-	"appinstances:#.key.name":                                                    "App Instance name",
-	"appinstances:#.key.organization":                                            "App Instance organization",
-	"appinstances:#.appkey.organization":                                         "App developer organization",
-	"appinstances:#.appkey.name":                                                 "App name",
-	"appinstances:#.appkey.version":                                              "App version",
-	"appinstances:#.clusterkey.name":                                             "Cluster name",
-	"appinstances:#.clusterkey.organization":                                     "Name of the organization that this cluster belongs to",
-	"appinstances:#.cloudletkey.organization":                                    "Organization of the cloudlet site",
-	"appinstances:#.cloudletkey.name":                                            "Name of the cloudlet",
-	"appinstances:#.cloudletkey.federatedorganization":                           "Federated operator organization who shared this cloudlet",
-	"appinstances:#.zonekey.organization":                                        "Organization owner of the Zone",
-	"appinstances:#.zonekey.name":                                                "Name of the Zone",
-	"appinstances:#.zonekey.federatedorganization":                               "Federated operator organization who shared this Zone",
-	"appinstances:#.cloudletloc.latitude":                                        "Latitude in WGS 84 coordinates",
-	"appinstances:#.cloudletloc.longitude":                                       "Longitude in WGS 84 coordinates",
-	"appinstances:#.cloudletloc.horizontalaccuracy":                              "Horizontal accuracy (radius in meters)",
-	"appinstances:#.cloudletloc.verticalaccuracy":                                "Vertical accuracy (meters)",
-	"appinstances:#.cloudletloc.altitude":                                        "On android only lat and long are guaranteed to be supplied Altitude in meters",
-	"appinstances:#.cloudletloc.course":                                          "Course (IOS) / bearing (Android) (degrees east relative to true north)",
-	"appinstances:#.cloudletloc.speed":                                           "Speed (IOS) / velocity (Android) (meters/sec)",
-	"appinstances:#.cloudletloc.timestamp":                                       "Timestamp",
-	"appinstances:#.uri":                                                         "Base FQDN (not really URI) for the App. See Service FQDN for endpoint access.",
-	"appinstances:#.staticuri":                                                   "Static startup FQDN gets set when the object is created and cannot be changed",
-	"appinstances:#.liveness":                                                    "Liveness of instance (see Liveness), one of Unknown, Static, Dynamic, Autoprov",
-	"appinstances:#.mappedports:#.proto":                                         "TCP (L4) or UDP (L4) protocol, one of Unknown, Tcp, Udp, Http",
-	"appinstances:#.mappedports:#.internalport":                                  "Container port",
-	"appinstances:#.mappedports:#.publicport":                                    "Public facing port for TCP/UDP (may be mapped on shared LB reverse proxy)",
-	"appinstances:#.mappedports:#.pathprefix":                                    "PathPrefix for HTTP ports in Kubernetes ingress",
-	"appinstances:#.mappedports:#.fqdnprefix":                                    "FQDN prefix to append to base FQDN in FindCloudlet response. May be empty.",
-	"appinstances:#.mappedports:#.endport":                                       "A non-zero end port indicates a port range from internal port to end port, inclusive.",
-	"appinstances:#.mappedports:#.tls":                                           "TLS termination for this port",
-	"appinstances:#.mappedports:#.nginx":                                         "Use nginx proxy for this port if you really need a transparent proxy (udp only)",
-	"appinstances:#.mappedports:#.maxpktsize":                                    "Maximum datagram size (udp only)",
-	"appinstances:#.mappedports:#.internalvisonly":                               "Internal visibility only",
-	"appinstances:#.mappedports:#.id":                                            "Port ID for NBI compatibility",
-	"appinstances:#.mappedports:#.servicename":                                   "Service name for Kubernetes port, use with a custom manifest or Helm chart that uses same port number on different services in the app.",
-	"appinstances:#.flavor.name":                                                 "Flavor name",
-	"appinstances:#.cloudletflavor":                                              "(_deprecated_) Cloudlet-specific flavor instead of regional flavor, replaced by NodeResources.InfraNodeFlavor.",
-	"appinstances:#.state":                                                       "Current state of the AppInst on the Cloudlet, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
-	"appinstances:#.errors":                                                      "Any errors trying to create, update, or delete the AppInst on the Cloudlet",
-	"appinstances:#.crmoverride":                                                 "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
-	"appinstances:#.runtimeinfo.containerids":                                    "List of container names",
-	"appinstances:#.createdat":                                                   "Created at time",
-	"appinstances:#.autoclusteripaccess":                                         "(Deprecated) IpAccess for auto-clusters. Ignored otherwise., one of Unknown, Dedicated, Shared",
-	"appinstances:#.revision":                                                    "Revision changes each time the App is updated.  Refreshing the App Instance will sync the revision with that of the App",
-	"appinstances:#.forceupdate":                                                 "Force Appinst refresh even if revision number matches App revision number.",
-	"appinstances:#.updatemultiple":                                              "Allow multiple instances to be updated at once",
-	"appinstances:#.configs:#.kind":                                              "Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml",
-	"appinstances:#.configs:#.config":                                            "Config file contents or URI reference",
-	"appinstances:#.healthcheck":                                                 "Health Check status, one of Unknown, RootlbOffline, ServerFail, Ok, CloudletOffline",
-	"appinstances:#.powerstate":                                                  "Power State of the AppInst, one of PowerOn, PowerOff, Reboot",
-	"appinstances:#.externalvolumesize":                                          "Size of external volume to be attached to nodes.  This is for the root partition",
-	"appinstances:#.availabilityzone":                                            "Optional Availability Zone if any",
-	"appinstances:#.vmflavor":                                                    "(_deprecated_) Replaced by NodeResources.InfraNodeFlavor; OS node flavor to use",
-	"appinstances:#.optres":                                                      "(_deprecated_) Optional Resources required by OS flavor if any",
-	"appinstances:#.updatedat":                                                   "Updated at time",
-	"appinstances:#.realclustername":                                             "(_deprecated_) Real ClusterInst name",
-	"appinstances:#.internalporttolbip":                                          "mapping of ports to load balancer IPs",
-	"appinstances:#.dedicatedip":                                                 "Dedicated IP assigns an IP for this AppInst but requires platform support",
-	"appinstances:#.uniqueid":                                                    "A unique id for the AppInst within the region to be used by platforms",
-	"appinstances:#.dnslabel":                                                    "DNS label that is unique within the cloudlet and among other AppInsts/ClusterInsts",
-	"appinstances:#.fedkey.federationname":                                       "Federation name",
-	"appinstances:#.fedkey.appinstid":                                            "Federated AppInst ID",
-	"appinstances:#.compatibilityversion":                                        "Internal compatibility version",
-	"appinstances:#.virtualclusterkey.name":                                      "Cluster name",
-	"appinstances:#.virtualclusterkey.organization":                              "Name of the organization that this cluster belongs to",
-	"appinstances:#.enableipv6":                                                  "Enable IPv6 addressing, requires platform and cloudlet support, defaults to platform setting for VM Apps and auto-clusters, otherwise defaults to target cluster instance setting.",
-	"appinstances:#.objid":                                                       "Universally unique object ID",
-	"appinstances:#.annotations":                                                 "Annotations",
-	"appinstances:#.dbmodelid":                                                   "database version model ID",
-	"appinstances:#.kubernetesresources.cpupool.totalvcpus":                      "Total Vcpus to be allocated in the pool, in increments of 0.001",
-	"appinstances:#.kubernetesresources.cpupool.totalmemory":                     "Total RAM in megabytes to be allocated in the pool",
-	"appinstances:#.kubernetesresources.cpupool.totaldisk":                       "Total Disk in gigabytes to be allocated in the pool",
-	"appinstances:#.kubernetesresources.cpupool.totaloptres":                     "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
-	"appinstances:#.kubernetesresources.cpupool.topology.minnodevcpus":           "Minimum number of vcpus per node",
-	"appinstances:#.kubernetesresources.cpupool.topology.minnodememory":          "Minimum amount of RAM in megabytes per node",
-	"appinstances:#.kubernetesresources.cpupool.topology.minnodedisk":            "Minimum amount of root partition disk space in gigabytes per node",
-	"appinstances:#.kubernetesresources.cpupool.topology.minnodeoptres":          "Minimum number of optional resources per node",
-	"appinstances:#.kubernetesresources.cpupool.topology.minnumberofnodes":       "Minimum number of nodes in pool, to satisfy HA/replication requirements",
-	"appinstances:#.kubernetesresources.gpupool.totalvcpus":                      "Total Vcpus to be allocated in the pool, in increments of 0.001",
-	"appinstances:#.kubernetesresources.gpupool.totalmemory":                     "Total RAM in megabytes to be allocated in the pool",
-	"appinstances:#.kubernetesresources.gpupool.totaldisk":                       "Total Disk in gigabytes to be allocated in the pool",
-	"appinstances:#.kubernetesresources.gpupool.totaloptres":                     "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
-	"appinstances:#.kubernetesresources.gpupool.topology.minnodevcpus":           "Minimum number of vcpus per node",
-	"appinstances:#.kubernetesresources.gpupool.topology.minnodememory":          "Minimum amount of RAM in megabytes per node",
-	"appinstances:#.kubernetesresources.gpupool.topology.minnodedisk":            "Minimum amount of root partition disk space in gigabytes per node",
-	"appinstances:#.kubernetesresources.gpupool.topology.minnodeoptres":          "Minimum number of optional resources per node",
-	"appinstances:#.kubernetesresources.gpupool.topology.minnumberofnodes":       "Minimum number of nodes in pool, to satisfy HA/replication requirements",
-	"appinstances:#.kubernetesresources.minkubernetesversion":                    "Minimum Kubernetes version",
-	"appinstances:#.noderesources.vcpus":                                         "Vcpus to be allocated to the VM, must be either 1 or an even number",
-	"appinstances:#.noderesources.ram":                                           "Total RAM in megabytes to be allocated to the VM",
-	"appinstances:#.noderesources.disk":                                          "Total disk space in gigabytes to be allocated to the VMs root partition",
-	"appinstances:#.noderesources.optresmap":                                     "Optional resources request, key = gpu form: $resource=$kind:[$alias]$count ex: optresmap=gpu=vgpu:nvidia-63:1",
-	"appinstances:#.noderesources.infranodeflavor":                               "Infrastructure specific node flavor",
-	"appinstances:#.noderesources.externalvolumesize":                            "Size of external volume to be attached to nodes. This is for the root partition",
-	"appinstances:#.isstandalone":                                                "A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster",
-	"appinstances:#.tags":                                                        "Vendor-specific data",
-	"appinstrefs:#.key.organization":                                             "App developer organization",
-	"appinstrefs:#.key.name":                                                     "App name",
-	"appinstrefs:#.key.version":                                                  "App version",
-	"clusterrefs:#.key.name":                                                     "Cluster name",
-	"clusterrefs:#.key.organization":                                             "Name of the organization that this cluster belongs to",
-	"clusterrefs:#.apps:#.name":                                                  "App Instance name",
-	"clusterrefs:#.apps:#.organization":                                          "App Instance organization",
-	"vmpools:#.fields":                                                           "Fields are used for the Update API to specify which fields to apply",
-	"vmpools:#.key.organization":                                                 "Organization of the vmpool",
-	"vmpools:#.key.name":                                                         "Name of the vmpool",
-	"vmpools:#.vms:#.name":                                                       "VM Name",
-	"vmpools:#.vms:#.netinfo.externalip":                                         "External IP",
-	"vmpools:#.vms:#.netinfo.internalip":                                         "Internal IP",
-	"vmpools:#.vms:#.groupname":                                                  "VM Group Name",
-	"vmpools:#.vms:#.state":                                                      "VM State, one of ForceFree",
-	"vmpools:#.vms:#.updatedat.seconds":                                          "Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.",
-	"vmpools:#.vms:#.updatedat.nanos":                                            "Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.",
-	"vmpools:#.vms:#.internalname":                                               "VM Internal Name",
-	"vmpools:#.vms:#.flavor.name":                                                "Name of the flavor on the Cloudlet",
-	"vmpools:#.vms:#.flavor.vcpus":                                               "Number of VCPU cores on the Cloudlet",
-	"vmpools:#.vms:#.flavor.ram":                                                 "Ram in MB on the Cloudlet",
-	"vmpools:#.vms:#.flavor.disk":                                                "Amount of disk in GB on the Cloudlet",
-	"vmpools:#.vms:#.flavor.propmap":                                             "OS Flavor Properties, if any",
-	"vmpools:#.state":                                                            "Current state of the VM pool, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
-	"vmpools:#.errors":                                                           "Any errors trying to add/remove VM to/from VM Pool",
-	"vmpools:#.crmoverride":                                                      "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
-	"vmpools:#.deleteprepare":                                                    "Preparing to be deleted",
-	"alertpolicies:#.key.organization":                                           "Name of the organization for the app that this alert can be applied to",
-	"alertpolicies:#.key.name":                                                   "Alert Policy name",
-	"alertpolicies:#.cpuutilizationlimit":                                        "Container or pod CPU utilization rate(percentage) across all nodes. Valid values 1-100",
-	"alertpolicies:#.memutilizationlimit":                                        "Container or pod memory utilization rate(percentage) across all nodes. Valid values 1-100",
-	"alertpolicies:#.diskutilizationlimit":                                       "Container or pod disk utilization rate(percentage) across all nodes. Valid values 1-100",
-	"alertpolicies:#.activeconnlimit":                                            "Active Connections alert threshold. Valid values 1-4294967295",
-	"alertpolicies:#.severity":                                                   "Alert severity level - one of info, warning, error",
-	"alertpolicies:#.triggertime":                                                "Duration for which alert interval is active (max 72 hours)",
-	"alertpolicies:#.labels":                                                     "Additional Labels",
-	"alertpolicies:#.annotations":                                                "Additional Annotations for extra information about the alert",
-	"alertpolicies:#.description":                                                "Description of the alert policy",
-	"alertpolicies:#.deleteprepare":                                              "Preparing to be deleted",
-	"flowratelimitsettings:#.fields":                                             "Fields are used for the Update API to specify which fields to apply",
-	"flowratelimitsettings:#.key.flowsettingsname":                               "Unique name for FlowRateLimitSettings (there can be multiple FlowSettings per RateLimitSettingsKey)",
-	"flowratelimitsettings:#.key.ratelimitkey.apiname":                           "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"flowratelimitsettings:#.key.ratelimitkey.apiendpointtype":                   "API Endpoint type, one of UnknownApiEndpointType, Dme",
-	"flowratelimitsettings:#.key.ratelimitkey.ratelimittarget":                   "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
-	"flowratelimitsettings:#.settings.flowalgorithm":                             "Flow Rate Limit algorithm, one of UnknownFlowAlgorithm, TokenBucketAlgorithm, LeakyBucketAlgorithm",
-	"flowratelimitsettings:#.settings.reqspersecond":                             "Requests per second for flow rate limiting",
-	"flowratelimitsettings:#.settings.burstsize":                                 "Burst size for flow rate limiting (required for TokenBucketAlgorithm)",
-	"maxreqsratelimitsettings:#.fields":                                          "Fields are used for the Update API to specify which fields to apply",
-	"maxreqsratelimitsettings:#.key.maxreqssettingsname":                         "Unique name for MaxReqsRateLimitSettings (there can be multiple MaxReqsSettings per RateLimitSettingsKey)",
-	"maxreqsratelimitsettings:#.key.ratelimitkey.apiname":                        "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
-	"maxreqsratelimitsettings:#.key.ratelimitkey.apiendpointtype":                "API Endpoint type, one of UnknownApiEndpointType, Dme",
-	"maxreqsratelimitsettings:#.key.ratelimitkey.ratelimittarget":                "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
-	"maxreqsratelimitsettings:#.settings.maxreqsalgorithm":                       "MaxReqs Rate Limit Algorithm, one of UnknownMaxReqsAlgorithm, FixedWindowAlgorithm",
-	"maxreqsratelimitsettings:#.settings.maxrequests":                            "Maximum number of requests for the given Interval",
-	"maxreqsratelimitsettings:#.settings.interval":                               "Time interval",
-	"trustpolicyexceptions:#.fields":                                             "Fields are used for the Update API to specify which fields to apply",
-	"trustpolicyexceptions:#.key.appkey.organization":                            "App developer organization",
-	"trustpolicyexceptions:#.key.appkey.name":                                    "App name",
-	"trustpolicyexceptions:#.key.appkey.version":                                 "App version",
-	"trustpolicyexceptions:#.key.zonepoolkey.organization":                       "Name of the organization this pool belongs to",
-	"trustpolicyexceptions:#.key.zonepoolkey.name":                               "ZonePool Name",
-	"trustpolicyexceptions:#.key.name":                                           "TrustPolicyExceptionKey name",
-	"trustpolicyexceptions:#.state":                                              "State of the exception within the approval process, one of Unknown, ApprovalRequested, Active, Rejected",
-	"trustpolicyexceptions:#.outboundsecurityrules:#.protocol":                   "TCP, UDP, ICMP",
-	"trustpolicyexceptions:#.outboundsecurityrules:#.portrangemin":               "TCP or UDP port range start",
-	"trustpolicyexceptions:#.outboundsecurityrules:#.portrangemax":               "TCP or UDP port range end",
-	"trustpolicyexceptions:#.outboundsecurityrules:#.remotecidr":                 "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"apps:#.findcloudletranking":                                                 "Strategy used by FindCloudlet to rank AppInsts, one of Distance, Latency, Load, Weighted",
+	"apps:#.findcloudletrankingweights.distance":                                 "Weight per km of distance to the client",
+	"apps:#.findcloudletrankingweights.latency":                                  "Weight per ms of average latency",
+	"apps:#.findcloudletrankingweights.load":                                     "Weight per active client connection",
+	"apps:#.rolloutpolicy.strategy":                                              "Update strategy, blue/green and canary fall back to in place on platforms that cannot switch traffic between versions, one of InPlace, BlueGreen, Canary",
+	"apps:#.rolloutpolicy.canarypercent":                                         "Percentage of instance replicas and of AppInsts updated first for the canary strategy",
+	"apps:#.rolloutpolicy.healthtimeout":                                         "Time to wait for the new version to become healthy before rolling back, defaults to 5m",
+	"apps:#.rolloutpolicy.observationtime":                                       "Time the new version must stay healthy while serving traffic before it is promoted, defaults to 1m",
+	"apps:#.rolloutpolicy.batchby":                                               "How AppInsts are grouped into batches for a staged rollout, one of Cloudlet, Zone",
+	"apps:#.rolloutpolicy.batchsize":                                             "Number of Cloudlets or Zones updated per batch, enables a staged rollout if set",
+	"apps:#.rolloutstatus.state":                                                 "Rollout state, one of None, Pending, InProgress, Paused, Done, Failed, Aborted",
+	"apps:#.rolloutstatus.batch":                                                 "Current batch of the App rollout, or the batch the AppInst is updated in",
+	"apps:#.rolloutstatus.numbatches":                                            "Total number of batches",
+	"apps:#.rolloutstatus.controller":                                            "Address of the Controller running the App rollout",
+	"apps:#.rolloutstatus.forceupdate":                                           "App rollout updates AppInsts even if they are already at the Apps revision",
+	"apps:#.horizontalscalepolicy.minreplicas":                                   "Minimum number of replicas",
+	"apps:#.horizontalscalepolicy.maxreplicas":                                   "Maximum number of replicas",
+	"apps:#.horizontalscalepolicy.targetcpu":                                     "Target average cpu utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"apps:#.horizontalscalepolicy.targetmem":                                     "Target average memory utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"apps:#.horizontalscalepolicy.targetactiveconnections":                       "Target average number of active connections per pod, 0 means disabled. This is an external metric, and requires an external metrics adapter in the cluster that serves the load balancers envoy_cluster_upstream_cx_active metric, which is not deployed by the platform. Without the adapter, the autoscaler cannot scale on this target.",
+	"apps:#.horizontalscalepolicy.stabilizationwindowsec":                        "Scale down stabilization window in seconds, 0 uses the Kubernetes default",
+	"apps:#.tags":                                                          "Vendor-specific data",
+	"appinstances:#.fields":                                                "Fields are used for the Update API to specify which fields to apply",
+	"appinstances:#.key.name":                                              "App Instance name",
+	"appinstances:#.key.organization":                                      "App Instance organization",
+	"appinstances:#.appkey.organization":                                   "App developer organization",
+	"appinstances:#.appkey.name":                                           "App name",
+	"appinstances:#.appkey.version":                                        "App version",
+	"appinstances:#.clusterkey.name":                                       "Cluster name",
+	"appinstances:#.clusterkey.organization":                               "Name of the organization that this cluster belongs to",
+	"appinstances:#.cloudletkey.organization":                              "Organization of the cloudlet site",
+	"appinstances:#.cloudletkey.name":                                      "Name of the cloudlet",
+	"appinstances:#.cloudletkey.federatedorganization":                     "Federated operator organization who shared this cloudlet",
+	"appinstances:#.zonekey.organization":                                  "Organization owner of the Zone",
+	"appinstances:#.zonekey.name":                                          "Name of the Zone",
+	"appinstances:#.zonekey.federatedorganization":                         "Federated operator organization who shared this Zone",
+	"appinstances:#.cloudletloc.latitude":                                  "Latitude in WGS 84 coordinates",
+	"appinstances:#.cloudletloc.longitude":                                 "Longitude in WGS 84 coordinates",
+	"appinstances:#.cloudletloc.horizontalaccuracy":                        "Horizontal accuracy (radius in meters)",
+	"appinstances:#.cloudletloc.verticalaccuracy":                          "Vertical accuracy (meters)",
+	"appinstances:#.cloudletloc.altitude":                                  "On android only lat and long are guaranteed to be supplied Altitude in meters",
+	"appinstances:#.cloudletloc.course":                                    "Course (IOS) / bearing (Android) (degrees east relative to true north)",
+	"appinstances:#.cloudletloc.speed":                                     "Speed (IOS) / velocity (Android) (meters/sec)",
+	"appinstances:#.cloudletloc.timestamp":                                 "Timestamp",
+	"appinstances:#.uri":                                                   "Base FQDN (not really URI) for the App. See Service FQDN for endpoint access.",
+	"appinstances:#.staticuri":                                             "Static startup FQDN gets set when the object is created and cannot be changed",
+	"appinstances:#.liveness":                                              "Liveness of instance (see Liveness), one of Unknown, Static, Dynamic, Autoprov",
+	"appinstances:#.mappedports:#.proto":                                   "TCP (L4) or UDP (L4) protocol, one of Unknown, Tcp, Udp, Http",
+	"appinstances:#.mappedports:#.internalport":                            "Container port",
+	"appinstances:#.mappedports:#.publicport":                              "Public facing port for TCP/UDP (may be mapped on shared LB reverse proxy)",
+	"appinstances:#.mappedports:#.pathprefix":                              "PathPrefix for HTTP ports in Kubernetes ingress",
+	"appinstances:#.mappedports:#.fqdnprefix":                              "FQDN prefix to append to base FQDN in FindCloudlet response. May be empty.",
+	"appinstances:#.mappedports:#.endport":                                 "A non-zero end port indicates a port range from internal port to end port, inclusive.",
+	"appinstances:#.mappedports:#.tls":                                     "TLS termination for this port",
+	"appinstances:#.mappedports:#.nginx":                                   "Use nginx proxy for this port if you really need a transparent proxy (udp only)",
+	"appinstances:#.mappedports:#.maxpktsize":                              "Maximum datagram size (udp only)",
+	"appinstances:#.mappedports:#.internalvisonly":                         "Internal visibility only",
+	"appinstances:#.mappedports:#.id":                                      "Port ID for NBI compatibility",
+	"appinstances:#.mappedports:#.servicename":                             "Service name for Kubernetes port, use with a custom manifest or Helm chart that uses same port number on different services in the app.",
+	"appinstances:#.flavor.name":                                           "Flavor name",
+	"appinstances:#.cloudletflavor":                                        "(_deprecated_) Cloudlet-specific flavor instead of regional flavor, replaced by NodeResources.InfraNodeFlavor.",
+	"appinstances:#.state":                                                 "Current state of the AppInst on the Cloudlet, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"appinstances:#.errors":                                                "Any errors trying to create, update, or delete the AppInst on the Cloudlet",
+	"appinstances:#.crmoverride":                                           "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
+	"appinstances:#.runtimeinfo.containerids":                              "List of container names",
+	"appinstances:#.runtimeinfo.replicas":                                  "Current number of replicas, for horizontally scaled instances",
+	"appinstances:#.runtimeinfo.desiredreplicas":                           "Number of replicas desired by the horizontal pod autoscaler",
+	"appinstances:#.createdat":                                             "Created at time",
+	"appinstances:#.autoclusteripaccess":                                   "(Deprecated) IpAccess for auto-clusters. Ignored otherwise., one of Unknown, Dedicated, Shared",
+	"appinstances:#.revision":                                              "Revision changes each time the App is updated.  Refreshing the App Instance will sync the revision with that of the App",
+	"appinstances:#.forceupdate":                                           "Force Appinst refresh even if revision number matches App revision number.",
+	"appinstances:#.updatemultiple":                                        "Allow multiple instances to be updated at once",
+	"appinstances:#.configs:#.kind":                                        "Kind (type) of config, i.e. envVarsYaml, helmCustomizationYaml",
+	"appinstances:#.configs:#.config":                                      "Config file contents or URI reference",
+	"appinstances:#.healthcheck":                                           "Health Check status, one of Unknown, RootlbOffline, ServerFail, Ok, CloudletOffline",
+	"appinstances:#.powerstate":                                            "Power State of the AppInst, one of PowerOn, PowerOff, Reboot",
+	"appinstances:#.externalvolumesize":                                    "Size of external volume to be attached to nodes.  This is for the root partition",
+	"appinstances:#.availabilityzone":                                      "Optional Availability Zone if any",
+	"appinstances:#.vmflavor":                                              "(_deprecated_) Replaced by NodeResources.InfraNodeFlavor; OS node flavor to use",
+	"appinstances:#.optres":                                                "(_deprecated_) Optional Resources required by OS flavor if any",
+	"appinstances:#.updatedat":                                             "Updated at time",
+	"appinstances:#.realclustername":                                       "(_deprecated_) Real ClusterInst name",
+	"appinstances:#.internalporttolbip":                                    "mapping of ports to load balancer IPs",
+	"appinstances:#.dedicatedip":                                           "Dedicated IP assigns an IP for this AppInst but requires platform support",
+	"appinstances:#.uniqueid":                                              "A unique id for the AppInst within the region to be used by platforms",
+	"appinstances:#.dnslabel":                                              "DNS label that is unique within the cloudlet and among other AppInsts/ClusterInsts",
+	"appinstances:#.fedkey.federationname":                                 "Federation name",
+	"appinstances:#.fedkey.appinstid":                                      "Federated AppInst ID",
+	"appinstances:#.compatibilityversion":                                  "Internal compatibility version",
+	"appinstances:#.virtualclusterkey.name":                                "Cluster name",
+	"appinstances:#.virtualclusterkey.organization":                        "Name of the organization that this cluster belongs to",
+	"appinstances:#.enableipv6":                                            "Enable IPv6 addressing, requires platform and cloudlet support, defaults to platform setting for VM Apps and auto-clusters, otherwise defaults to target cluster instance setting.",
+	"appinstances:#.objid":                                                 "Universally unique object ID",
+	"appinstances:#.annotations":                                           "Annotations",
+	"appinstances:#.dbmodelid":                                             "database version model ID",
+	"appinstances:#.kubernetesresources.cpupool.totalvcpus":                "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"appinstances:#.kubernetesresources.cpupool.totalmemory":               "Total RAM in megabytes to be allocated in the pool",
+	"appinstances:#.kubernetesresources.cpupool.totaldisk":                 "Total Disk in gigabytes to be allocated in the pool",
+	"appinstances:#.kubernetesresources.cpupool.totaloptres":               "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"appinstances:#.kubernetesresources.cpupool.topology.minnodevcpus":     "Minimum number of vcpus per node",
+	"appinstances:#.kubernetesresources.cpupool.topology.minnodememory":    "Minimum amount of RAM in megabytes per node",
+	"appinstances:#.kubernetesresources.cpupool.topology.minnodedisk":      "Minimum amount of root partition disk space in gigabytes per node",
+	"appinstances:#.kubernetesresources.cpupool.topology.minnodeoptres":    "Minimum number of optional resources per node",
+	"appinstances:#.kubernetesresources.cpupool.topology.minnumberofnodes": "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"appinstances:#.kubernetesresources.gpupool.totalvcpus":                "Total Vcpus to be allocated in the pool, in increments of 0.001",
+	"appinstances:#.kubernetesresources.gpupool.totalmemory":               "Total RAM in megabytes to be allocated in the pool",
+	"appinstances:#.kubernetesresources.gpupool.totaldisk":                 "Total Disk in gigabytes to be allocated in the pool",
+	"appinstances:#.kubernetesresources.gpupool.totaloptres":               "Total optional resources to be allocated in the pool, follows the NodeResources.OptResMap format.",
+	"appinstances:#.kubernetesresources.gpupool.topology.minnodevcpus":     "Minimum number of vcpus per node",
+	"appinstances:#.kubernetesresources.gpupool.topology.minnodememory":    "Minimum amount of RAM in megabytes per node",
+	"appinstances:#.kubernetesresources.gpupool.topology.minnodedisk":      "Minimum amount of root partition disk space in gigabytes per node",
+	"appinstances:#.kubernetesresources.gpupool.topology.minnodeoptres":    "Minimum number of optional resources per node",
+	"appinstances:#.kubernetesresources.gpupool.topology.minnumberofnodes": "Minimum number of nodes in pool, to satisfy HA/replication requirements",
+	"appinstances:#.kubernetesresources.minkubernetesversion":              "Minimum Kubernetes version",
+	"appinstances:#.noderesources.vcpus":                                   "Vcpus to be allocated to the VM, must be either 1 or an even number",
+	"appinstances:#.noderesources.ram":                                     "Total RAM in megabytes to be allocated to the VM",
+	"appinstances:#.noderesources.disk":                                    "Total disk space in gigabytes to be allocated to the VMs root partition",
+	"appinstances:#.noderesources.optresmap":                               "Optional resources request, key = gpu form: $resource=$kind:[$alias]$count ex: optresmap=gpu=vgpu:nvidia-63:1",
+	"appinstances:#.noderesources.infranodeflavor":                         "Infrastructure specific node flavor",
+	"appinstances:#.noderesources.externalvolumesize":                      "Size of external volume to be attached to nodes. This is for the root partition",
+	"appinstances:#.isstandalone":                                          "A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster",
+	"appinstances:#.rolloutstatus.state":                                   "Rollout state, one of None, Pending, InProgress, Paused, Done, Failed, Aborted",
+	"appinstances:#.rolloutstatus.batch":                                   "Current batch of the App rollout, or the batch the AppInst is updated in",
+	"appinstances:#.rolloutstatus.numbatches":                              "Total number of batches",
+	"appinstances:#.rolloutstatus.controller":                              "Address of the Controller running the App rollout",
+	"appinstances:#.rolloutstatus.forceupdate":                             "App rollout updates AppInsts even if they are already at the Apps revision",
+	"appinstances:#.dryrun":                                                "Run validation and placement without creating the instance, and show the placement decisions",
+	"appinstances:#.horizontalscalepolicy.minreplicas":                     "Minimum number of replicas",
+	"appinstances:#.horizontalscalepolicy.maxreplicas":                     "Maximum number of replicas",
+	"appinstances:#.horizontalscalepolicy.targetcpu":                       "Target average cpu utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"appinstances:#.horizontalscalepolicy.targetmem":                       "Target average memory utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"appinstances:#.horizontalscalepolicy.targetactiveconnections":         "Target average number of active connections per pod, 0 means disabled. This is an external metric, and requires an external metrics adapter in the cluster that serves the load balancers envoy_cluster_upstream_cx_active metric, which is not deployed by the platform. Without the adapter, the autoscaler cannot scale on this target.",
+	"appinstances:#.horizontalscalepolicy.stabilizationwindowsec":          "Scale down stabilization window in seconds, 0 uses the Kubernetes default",
+	"appinstances:#.tags":                                                  "Vendor-specific data",
+	"appinstrefs:#.key.organization":                                       "App developer organization",
+	"appinstrefs:#.key.name":                                               "App name",
+	"appinstrefs:#.key.version":                                            "App version",
+	"clusterrefs:#.key.name":                                               "Cluster name",
+	"clusterrefs:#.key.organization":                                       "Name of the organization that this cluster belongs to",
+	"clusterrefs:#.apps:#.name":                                            "App Instance name",
+	"clusterrefs:#.apps:#.organization":                                    "App Instance organization",
+	"vmpools:#.fields":                                                     "Fields are used for the Update API to specify which fields to apply",
+	"vmpools:#.key.organization":                                           "Organization of the vmpool",
+	"vmpools:#.key.name":                                                   "Name of the vmpool",
+	"vmpools:#.vms:#.name":                                                 "VM Name",
+	"vmpools:#.vms:#.netinfo.externalip":                                   "External IP",
+	"vmpools:#.vms:#.netinfo.internalip":                                   "Internal IP",
+	"vmpools:#.vms:#.groupname":                                            "VM Group Name",
+	"vmpools:#.vms:#.state":                                                "VM State, one of ForceFree",
+	"vmpools:#.vms:#.updatedat.seconds":                                    "Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.",
+	"vmpools:#.vms:#.updatedat.nanos":                                      "Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.",
+	"vmpools:#.vms:#.internalname":                                         "VM Internal Name",
+	"vmpools:#.vms:#.flavor.name":                                          "Name of the flavor on the Cloudlet",
+	"vmpools:#.vms:#.flavor.vcpus":                                         "Number of VCPU cores on the Cloudlet",
+	"vmpools:#.vms:#.flavor.ram":                                           "Ram in MB on the Cloudlet",
+	"vmpools:#.vms:#.flavor.disk":                                          "Amount of disk in GB on the Cloudlet",
+	"vmpools:#.vms:#.flavor.propmap":                                       "OS Flavor Properties, if any",
+	"vmpools:#.state":                                                      "Current state of the VM pool, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"vmpools:#.errors":                                                     "Any errors trying to add/remove VM to/from VM Pool",
+	"vmpools:#.crmoverride":                                                "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
+	"vmpools:#.deleteprepare":                                              "Preparing to be deleted",
+	"alertpolicies:#.key.organization":                                     "Name of the organization for the app that this alert can be applied to",
+	"alertpolicies:#.key.name":                                             "Alert Policy name",
+	"alertpolicies:#.cpuutilizationlimit":                                  "Container or pod CPU utilization rate(percentage) across all nodes. Valid values 1-100",
+	"alertpolicies:#.memutilizationlimit":                                  "Container or pod memory utilization rate(percentage) across all nodes. Valid values 1-100",
+	"alertpolicies:#.diskutilizationlimit":                                 "Container or pod disk utilization rate(percentage) across all nodes. Valid values 1-100",
+	"alertpolicies:#.activeconnlimit":                                      "Active Connections alert threshold. Valid values 1-4294967295",
+	"alertpolicies:#.severity":                                             "Alert severity level - one of info, warning, error",
+	"alertpolicies:#.triggertime":                                          "Duration for which alert interval is active (max 72 hours)",
+	"alertpolicies:#.labels":                                               "Additional Labels",
+	"alertpolicies:#.annotations":                                          "Additional Annotations for extra information about the alert",
+	"alertpolicies:#.description":                                          "Description of the alert policy",
+	"alertpolicies:#.deleteprepare":                                        "Preparing to be deleted",
+	"alertpolicies:#.conditions:#.expr":                                    "Prometheus expression over the metrics exposed by the App, for example sum(rate(http_requests_total{code=~5..}[$window]))by(pod). Aggregations must keep the pod label. $window is replaced by the condition window",
+	"alertpolicies:#.conditions:#.comparison":                              "Comparison of the expression value against the threshold, one of Above, Below, one of Above, Below",
+	"alertpolicies:#.conditions:#.threshold":                               "Threshold that triggers the condition",
+	"alertpolicies:#.conditions:#.window":                                  "Window used for range vectors specified as [$window] in the expression",
+	"flowratelimitsettings:#.fields":                                       "Fields are used for the Update API to specify which fields to apply",
+	"flowratelimitsettings:#.key.flowsettingsname":                         "Unique name for FlowRateLimitSettings (there can be multiple FlowSettings per RateLimitSettingsKey)",
+	"flowratelimitsettings:#.key.ratelimitkey.apiname":                     "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
+	"flowratelimitsettings:#.key.ratelimitkey.apiendpointtype":             "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"flowratelimitsettings:#.key.ratelimitkey.ratelimittarget":             "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
+	"flowratelimitsettings:#.settings.flowalgorithm":                       "Flow Rate Limit algorithm, one of UnknownFlowAlgorithm, TokenBucketAlgorithm, LeakyBucketAlgorithm",
+	"flowratelimitsettings:#.settings.reqspersecond":                       "Requests per second for flow rate limiting",
+	"flowratelimitsettings:#.settings.burstsize":                           "Burst size for flow rate limiting (required for TokenBucketAlgorithm)",
+	"maxreqsratelimitsettings:#.fields":                                    "Fields are used for the Update API to specify which fields to apply",
+	"maxreqsratelimitsettings:#.key.maxreqssettingsname":                   "Unique name for MaxReqsRateLimitSettings (there can be multiple MaxReqsSettings per RateLimitSettingsKey)",
+	"maxreqsratelimitsettings:#.key.ratelimitkey.apiname":                  "Name of API (eg. CreateApp or RegisterClient) (Use Global if not a specific API)",
+	"maxreqsratelimitsettings:#.key.ratelimitkey.apiendpointtype":          "API Endpoint type, one of UnknownApiEndpointType, Dme",
+	"maxreqsratelimitsettings:#.key.ratelimitkey.ratelimittarget":          "Target to rate limit, one of UnknownTarget, AllRequests, PerIp, PerUser",
+	"maxreqsratelimitsettings:#.settings.maxreqsalgorithm":                 "MaxReqs Rate Limit Algorithm, one of UnknownMaxReqsAlgorithm, FixedWindowAlgorithm",
+	"maxreqsratelimitsettings:#.settings.maxrequests":                      "Maximum number of requests for the given Interval",
+	"maxreqsratelimitsettings:#.settings.interval":                         "Time interval",
+	"trustpolicyexceptions:#.fields":                                       "Fields are used for the Update API to specify which fields to apply",
+	"trustpolicyexceptions:#.key.appkey.organization":                      "App developer organization",
+	"trustpolicyexceptions:#.key.appkey.name":                              "App name",
+	"trustpolicyexceptions:#.key.appkey.version":                           "App version",
+	"trustpolicyexceptions:#.key.zonepoolkey.organization":                 "Name of the organization this pool belongs to",
+	"trustpolicyexceptions:#.key.zonepoolkey.name":                         "ZonePool Name",
+	"trustpolicyexceptions:#.key.name":                                     "TrustPolicyExceptionKey name",
+	"trustpolicyexceptions:#.state":                                        "State of the exception within the approval process, one of Unknown, ApprovalRequested, Active, Rejected",
+	"trustpolicyexceptions:#.outboundsecurityrules:#.protocol":             "TCP, UDP, ICMP",
+	"trustpolicyexceptions:#.outboundsecurityrules:#.portrangemin":         "TCP or UDP port range start",
+	"trustpolicyexceptions:#.outboundsecurityrules:#.portrangemax":         "TCP or UDP port range end",
+	"trustpolicyexceptions:#.outboundsecurityrules:#.remotecidr":           "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"trustpolicyexceptions:#.outboundsecurityrules:#.remotefqdn":           "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 }
 var AllDataSpecialArgs = map[string]string{
 	"alertpolicies:#.annotations":       "StringToString",
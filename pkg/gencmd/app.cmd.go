@@ -57,6 +57,9 @@ func AppHideTags(in *edgeproto.App) {
 	if _, found := tags["nocmp"]; found {
 		in.CompatibilityVersion = 0
 	}
+	if _, found := tags["nocmp"]; found {
+		in.RolloutStatus = nil
+	}
 }
 
 func DeploymentZoneRequestHideTags(in *edgeproto.DeploymentZoneRequest) {
@@ -89,6 +92,9 @@ func DeploymentZoneRequestHideTags(in *edgeproto.DeploymentZoneRequest) {
 	if _, found := tags["nocmp"]; found {
 		in.App.CompatibilityVersion = 0
 	}
+	if _, found := tags["nocmp"]; found {
+		in.App.RolloutStatus = nil
+	}
 }
 
 var AppApiCmd edgeproto.AppApiClient
@@ -570,6 +576,177 @@ func RemoveAppAlertPolicys(c *cli.Command, data []edgeproto.AppAlertPolicy, err
 	}
 }
 
+var PauseAppRolloutCmd = &cli.Command{
+	Use:          "PauseAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequestRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutRequestOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutRequestAliasArgs, " "),
+	SpecialArgs:  &AppRolloutRequestSpecialArgs,
+	Comments:     AppRolloutRequestComments,
+	ReqData:      &edgeproto.AppRolloutRequest{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runPauseAppRollout,
+}
+
+func runPauseAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRolloutRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return PauseAppRollout(c, obj)
+}
+
+func PauseAppRollout(c *cli.Command, in *edgeproto.AppRolloutRequest) error {
+	if AppApiCmd == nil {
+		return fmt.Errorf("AppApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppApiCmd.PauseAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("PauseAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func PauseAppRollouts(c *cli.Command, data []edgeproto.AppRolloutRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("PauseAppRollout %v\n", data[ii])
+		myerr := PauseAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var ResumeAppRolloutCmd = &cli.Command{
+	Use:          "ResumeAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequestRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutRequestOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutRequestAliasArgs, " "),
+	SpecialArgs:  &AppRolloutRequestSpecialArgs,
+	Comments:     AppRolloutRequestComments,
+	ReqData:      &edgeproto.AppRolloutRequest{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runResumeAppRollout,
+}
+
+func runResumeAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRolloutRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return ResumeAppRollout(c, obj)
+}
+
+func ResumeAppRollout(c *cli.Command, in *edgeproto.AppRolloutRequest) error {
+	if AppApiCmd == nil {
+		return fmt.Errorf("AppApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppApiCmd.ResumeAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("ResumeAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func ResumeAppRollouts(c *cli.Command, data []edgeproto.AppRolloutRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("ResumeAppRollout %v\n", data[ii])
+		myerr := ResumeAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var AbortAppRolloutCmd = &cli.Command{
+	Use:          "AbortAppRollout",
+	RequiredArgs: strings.Join(AppRolloutRequestRequiredArgs, " "),
+	OptionalArgs: strings.Join(AppRolloutRequestOptionalArgs, " "),
+	AliasArgs:    strings.Join(AppRolloutRequestAliasArgs, " "),
+	SpecialArgs:  &AppRolloutRequestSpecialArgs,
+	Comments:     AppRolloutRequestComments,
+	ReqData:      &edgeproto.AppRolloutRequest{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runAbortAppRollout,
+}
+
+func runAbortAppRollout(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.AppRolloutRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return AbortAppRollout(c, obj)
+}
+
+func AbortAppRollout(c *cli.Command, in *edgeproto.AppRolloutRequest) error {
+	if AppApiCmd == nil {
+		return fmt.Errorf("AppApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := AppApiCmd.AbortAppRollout(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("AbortAppRollout failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func AbortAppRollouts(c *cli.Command, data []edgeproto.AppRolloutRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("AbortAppRollout %v\n", data[ii])
+		myerr := AbortAppRollout(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var ShowZonesForAppDeploymentCmd = &cli.Command{
 	Use:          "ShowZonesForAppDeployment",
 	OptionalArgs: strings.Join(append(DeploymentZoneRequestRequiredArgs, DeploymentZoneRequestOptionalArgs...), " "),
@@ -659,6 +836,9 @@ var AppApiCmds = []*cobra.Command{
 	RemoveAppAutoProvPolicyCmd.GenCmd(),
 	AddAppAlertPolicyCmd.GenCmd(),
 	RemoveAppAlertPolicyCmd.GenCmd(),
+	PauseAppRolloutCmd.GenCmd(),
+	ResumeAppRolloutCmd.GenCmd(),
+	AbortAppRolloutCmd.GenCmd(),
 	ShowZonesForAppDeploymentCmd.GenCmd(),
 }
 
@@ -675,6 +855,55 @@ var AppKeyComments = map[string]string{
 	"version":      "App version",
 }
 var AppKeySpecialArgs = map[string]string{}
+var FindCloudletRankingWeightsRequiredArgs = []string{}
+var FindCloudletRankingWeightsOptionalArgs = []string{
+	"distance",
+	"latency",
+	"load",
+}
+var FindCloudletRankingWeightsAliasArgs = []string{}
+var FindCloudletRankingWeightsComments = map[string]string{
+	"distance": "Weight per km of distance to the client",
+	"latency":  "Weight per ms of average latency",
+	"load":     "Weight per active client connection",
+}
+var FindCloudletRankingWeightsSpecialArgs = map[string]string{}
+var RolloutPolicyRequiredArgs = []string{}
+var RolloutPolicyOptionalArgs = []string{
+	"strategy",
+	"canarypercent",
+	"healthtimeout",
+	"observationtime",
+	"batchby",
+	"batchsize",
+}
+var RolloutPolicyAliasArgs = []string{}
+var RolloutPolicyComments = map[string]string{
+	"strategy":        "Update strategy, blue/green and canary fall back to in place on platforms that cannot switch traffic between versions, one of InPlace, BlueGreen, Canary",
+	"canarypercent":   "Percentage of instance replicas and of AppInsts updated first for the canary strategy",
+	"healthtimeout":   "Time to wait for the new version to become healthy before rolling back, defaults to 5m",
+	"observationtime": "Time the new version must stay healthy while serving traffic before it is promoted, defaults to 1m",
+	"batchby":         "How AppInsts are grouped into batches for a staged rollout, one of Cloudlet, Zone",
+	"batchsize":       "Number of Cloudlets or Zones updated per batch, enables a staged rollout if set",
+}
+var RolloutPolicySpecialArgs = map[string]string{}
+var RolloutStatusRequiredArgs = []string{}
+var RolloutStatusOptionalArgs = []string{
+	"state",
+	"batch",
+	"numbatches",
+	"controller",
+	"forceupdate",
+}
+var RolloutStatusAliasArgs = []string{}
+var RolloutStatusComments = map[string]string{
+	"state":       "Rollout state, one of None, Pending, InProgress, Paused, Done, Failed, Aborted",
+	"batch":       "Current batch of the App rollout, or the batch the AppInst is updated in",
+	"numbatches":  "Total number of batches",
+	"controller":  "Address of the Controller running the App rollout",
+	"forceupdate": "App rollout updates AppInsts even if they are already at the Apps revision",
+}
+var RolloutStatusSpecialArgs = map[string]string{}
 var ConfigFileRequiredArgs = []string{}
 var ConfigFileOptionalArgs = []string{
 	"kind",
@@ -722,6 +951,7 @@ var AppOptionalArgs = []string{
 	"requiredoutboundconnections:#.portrangemin",
 	"requiredoutboundconnections:#.portrangemax",
 	"requiredoutboundconnections:#.remotecidr",
+	"requiredoutboundconnections:#.remotefqdn",
 	"allowserverless",
 	"serverlessconfig.vcpus",
 	"serverlessconfig.ram",
@@ -767,6 +997,22 @@ var AppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletranking",
+	"findcloudletrankingweights.distance",
+	"findcloudletrankingweights.latency",
+	"findcloudletrankingweights.load",
+	"rolloutpolicy.strategy",
+	"rolloutpolicy.canarypercent",
+	"rolloutpolicy.healthtimeout",
+	"rolloutpolicy.observationtime",
+	"rolloutpolicy.batchby",
+	"rolloutpolicy.batchsize",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var AppAliasArgs = []string{
@@ -815,6 +1061,7 @@ var AppComments = map[string]string{
 	"requiredoutboundconnections:#.portrangemin": "TCP or UDP port range start",
 	"requiredoutboundconnections:#.portrangemax": "TCP or UDP port range end",
 	"requiredoutboundconnections:#.remotecidr":   "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"requiredoutboundconnections:#.remotefqdn":   "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 	"allowserverless":                                       "App is allowed to deploy as serverless containers",
 	"serverlessconfig.vcpus":                                "Virtual CPUs allocation per container when serverless, may be decimal in increments of 0.001",
 	"serverlessconfig.ram":                                  "RAM allocation in megabytes per container when serverless",
@@ -861,7 +1108,28 @@ var AppComments = map[string]string{
 	"isstandalone":                                          "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
 	"managesownnamespaces":                                  "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
 	"compatibilityversion":                                  "Internal compatibility version",
-	"tags":                                                  "Vendor-specific data, specify tags:empty=true to clear",
+	"findcloudletranking":                                   "Strategy used by FindCloudlet to rank AppInsts, one of Distance, Latency, Load, Weighted",
+	"findcloudletrankingweights.distance":                   "Weight per km of distance to the client",
+	"findcloudletrankingweights.latency":                    "Weight per ms of average latency",
+	"findcloudletrankingweights.load":                       "Weight per active client connection",
+	"rolloutpolicy.strategy":                                "Update strategy, blue/green and canary fall back to in place on platforms that cannot switch traffic between versions, one of InPlace, BlueGreen, Canary",
+	"rolloutpolicy.canarypercent":                           "Percentage of instance replicas and of AppInsts updated first for the canary strategy",
+	"rolloutpolicy.healthtimeout":                           "Time to wait for the new version to become healthy before rolling back, defaults to 5m",
+	"rolloutpolicy.observationtime":                         "Time the new version must stay healthy while serving traffic before it is promoted, defaults to 1m",
+	"rolloutpolicy.batchby":                                 "How AppInsts are grouped into batches for a staged rollout, one of Cloudlet, Zone",
+	"rolloutpolicy.batchsize":                               "Number of Cloudlets or Zones updated per batch, enables a staged rollout if set",
+	"rolloutstatus.state":                                   "Rollout state, one of None, Pending, InProgress, Paused, Done, Failed, Aborted",
+	"rolloutstatus.batch":                                   "Current batch of the App rollout, or the batch the AppInst is updated in",
+	"rolloutstatus.numbatches":                              "Total number of batches",
+	"rolloutstatus.controller":                              "Address of the Controller running the App rollout",
+	"rolloutstatus.forceupdate":                             "App rollout updates AppInsts even if they are already at the Apps revision",
+	"horizontalscalepolicy.minreplicas":                     "Minimum number of replicas",
+	"horizontalscalepolicy.maxreplicas":                     "Maximum number of replicas",
+	"horizontalscalepolicy.targetcpu":                       "Target average cpu utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"horizontalscalepolicy.targetmem":                       "Target average memory utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"horizontalscalepolicy.targetactiveconnections":         "Target average number of active connections per pod, 0 means disabled. This is an external metric, and requires an external metrics adapter in the cluster that serves the load balancers envoy_cluster_upstream_cx_active metric, which is not deployed by the platform. Without the adapter, the autoscaler cannot scale on this target.",
+	"horizontalscalepolicy.stabilizationwindowsec":          "Scale down stabilization window in seconds, 0 uses the Kubernetes default",
+	"tags": "Vendor-specific data, specify tags:empty=true to clear",
 }
 var AppSpecialArgs = map[string]string{
 	"alertpolicies":    "StringArray",
@@ -953,6 +1221,23 @@ var AppAlertPolicyComments = map[string]string{
 	"alertpolicyname": "Alert name",
 }
 var AppAlertPolicySpecialArgs = map[string]string{}
+var AppRolloutRequestRequiredArgs = []string{
+	"apporg",
+	"appname",
+	"appvers",
+}
+var AppRolloutRequestOptionalArgs = []string{}
+var AppRolloutRequestAliasArgs = []string{
+	"apporg=appkey.organization",
+	"appname=appkey.name",
+	"appvers=appkey.version",
+}
+var AppRolloutRequestComments = map[string]string{
+	"apporg":  "App developer organization",
+	"appname": "App name",
+	"appvers": "App version",
+}
+var AppRolloutRequestSpecialArgs = map[string]string{}
 var DeploymentZoneRequestRequiredArgs = []string{}
 var DeploymentZoneRequestOptionalArgs = []string{
 	"app.fields",
@@ -987,6 +1272,7 @@ var DeploymentZoneRequestOptionalArgs = []string{
 	"app.requiredoutboundconnections:#.portrangemin",
 	"app.requiredoutboundconnections:#.portrangemax",
 	"app.requiredoutboundconnections:#.remotecidr",
+	"app.requiredoutboundconnections:#.remotefqdn",
 	"app.allowserverless",
 	"app.serverlessconfig.vcpus",
 	"app.serverlessconfig.ram",
@@ -1033,6 +1319,22 @@ var DeploymentZoneRequestOptionalArgs = []string{
 	"app.isstandalone",
 	"app.managesownnamespaces",
 	"app.compatibilityversion",
+	"app.findcloudletranking",
+	"app.findcloudletrankingweights.distance",
+	"app.findcloudletrankingweights.latency",
+	"app.findcloudletrankingweights.load",
+	"app.rolloutpolicy.strategy",
+	"app.rolloutpolicy.canarypercent",
+	"app.rolloutpolicy.healthtimeout",
+	"app.rolloutpolicy.observationtime",
+	"app.rolloutpolicy.batchby",
+	"app.rolloutpolicy.batchsize",
+	"app.horizontalscalepolicy.minreplicas",
+	"app.horizontalscalepolicy.maxreplicas",
+	"app.horizontalscalepolicy.targetcpu",
+	"app.horizontalscalepolicy.targetmem",
+	"app.horizontalscalepolicy.targetactiveconnections",
+	"app.horizontalscalepolicy.stabilizationwindowsec",
 	"app.tags",
 	"dryrundeploy",
 	"numnodes",
@@ -1079,6 +1381,7 @@ var DeploymentZoneRequestComments = map[string]string{
 	"app.requiredoutboundconnections:#.portrangemin":            "TCP or UDP port range start",
 	"app.requiredoutboundconnections:#.portrangemax":            "TCP or UDP port range end",
 	"app.requiredoutboundconnections:#.remotecidr":              "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"app.requiredoutboundconnections:#.remotefqdn":              "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 	"app.allowserverless":                                       "App is allowed to deploy as serverless containers",
 	"app.serverlessconfig.vcpus":                                "Virtual CPUs allocation per container when serverless, may be decimal in increments of 0.001",
 	"app.serverlessconfig.ram":                                  "RAM allocation in megabytes per container when serverless",
@@ -1125,9 +1428,30 @@ var DeploymentZoneRequestComments = map[string]string{
 	"app.isstandalone":                                          "A standalone App will not share a cluster with another App unless explicitly targeted to the same cluster",
 	"app.managesownnamespaces":                                  "Specifies if the kubernetes application manages creating and deleting its own namespaces. If true, it is disallowed from deployment to multi-tenant clusters, and it is up to the application developer to manage namespace conflicts if they deploy multiple applications to the same cluster. If false, each application instance is deployed to its own namespace set by the platform.",
 	"app.compatibilityversion":                                  "Internal compatibility version",
-	"app.tags":                                                  "Vendor-specific data",
-	"dryrundeploy":                                              "Attempt to qualify zones resources for deployment",
-	"numnodes":                                                  "Optional number of worker VMs in dry run K8s Cluster, default = 2",
+	"app.findcloudletranking":                                   "Strategy used by FindCloudlet to rank AppInsts, one of Distance, Latency, Load, Weighted",
+	"app.findcloudletrankingweights.distance":                   "Weight per km of distance to the client",
+	"app.findcloudletrankingweights.latency":                    "Weight per ms of average latency",
+	"app.findcloudletrankingweights.load":                       "Weight per active client connection",
+	"app.rolloutpolicy.strategy":                                "Update strategy, blue/green and canary fall back to in place on platforms that cannot switch traffic between versions, one of InPlace, BlueGreen, Canary",
+	"app.rolloutpolicy.canarypercent":                           "Percentage of instance replicas and of AppInsts updated first for the canary strategy",
+	"app.rolloutpolicy.healthtimeout":                           "Time to wait for the new version to become healthy before rolling back, defaults to 5m",
+	"app.rolloutpolicy.observationtime":                         "Time the new version must stay healthy while serving traffic before it is promoted, defaults to 1m",
+	"app.rolloutpolicy.batchby":                                 "How AppInsts are grouped into batches for a staged rollout, one of Cloudlet, Zone",
+	"app.rolloutpolicy.batchsize":                               "Number of Cloudlets or Zones updated per batch, enables a staged rollout if set",
+	"app.rolloutstatus.state":                                   "Rollout state, one of None, Pending, InProgress, Paused, Done, Failed, Aborted",
+	"app.rolloutstatus.batch":                                   "Current batch of the App rollout, or the batch the AppInst is updated in",
+	"app.rolloutstatus.numbatches":                              "Total number of batches",
+	"app.rolloutstatus.controller":                              "Address of the Controller running the App rollout",
+	"app.rolloutstatus.forceupdate":                             "App rollout updates AppInsts even if they are already at the Apps revision",
+	"app.horizontalscalepolicy.minreplicas":                     "Minimum number of replicas",
+	"app.horizontalscalepolicy.maxreplicas":                     "Maximum number of replicas",
+	"app.horizontalscalepolicy.targetcpu":                       "Target average cpu utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"app.horizontalscalepolicy.targetmem":                       "Target average memory utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"app.horizontalscalepolicy.targetactiveconnections":         "Target average number of active connections per pod, 0 means disabled. This is an external metric, and requires an external metrics adapter in the cluster that serves the load balancers envoy_cluster_upstream_cx_active metric, which is not deployed by the platform. Without the adapter, the autoscaler cannot scale on this target.",
+	"app.horizontalscalepolicy.stabilizationwindowsec":          "Scale down stabilization window in seconds, 0 uses the Kubernetes default",
+	"app.tags":     "Vendor-specific data",
+	"dryrundeploy": "Attempt to qualify zones resources for deployment",
+	"numnodes":     "Optional number of worker VMs in dry run K8s Cluster, default = 2",
 }
 var DeploymentZoneRequestSpecialArgs = map[string]string{
 	"app.alertpolicies":    "StringArray",
@@ -1178,6 +1502,7 @@ var CreateAppOptionalArgs = []string{
 	"requiredoutboundconnections:#.portrangemin",
 	"requiredoutboundconnections:#.portrangemax",
 	"requiredoutboundconnections:#.remotecidr",
+	"requiredoutboundconnections:#.remotefqdn",
 	"allowserverless",
 	"serverlessconfig.vcpus",
 	"serverlessconfig.ram",
@@ -1222,6 +1547,22 @@ var CreateAppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletranking",
+	"findcloudletrankingweights.distance",
+	"findcloudletrankingweights.latency",
+	"findcloudletrankingweights.load",
+	"rolloutpolicy.strategy",
+	"rolloutpolicy.canarypercent",
+	"rolloutpolicy.healthtimeout",
+	"rolloutpolicy.observationtime",
+	"rolloutpolicy.batchby",
+	"rolloutpolicy.batchsize",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var DeleteAppRequiredArgs = []string{
@@ -1258,6 +1599,7 @@ var DeleteAppOptionalArgs = []string{
 	"requiredoutboundconnections:#.portrangemin",
 	"requiredoutboundconnections:#.portrangemax",
 	"requiredoutboundconnections:#.remotecidr",
+	"requiredoutboundconnections:#.remotefqdn",
 	"allowserverless",
 	"serverlessconfig.vcpus",
 	"serverlessconfig.ram",
@@ -1302,6 +1644,22 @@ var DeleteAppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletranking",
+	"findcloudletrankingweights.distance",
+	"findcloudletrankingweights.latency",
+	"findcloudletrankingweights.load",
+	"rolloutpolicy.strategy",
+	"rolloutpolicy.canarypercent",
+	"rolloutpolicy.healthtimeout",
+	"rolloutpolicy.observationtime",
+	"rolloutpolicy.batchby",
+	"rolloutpolicy.batchsize",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var ShowAppRequiredArgs = []string{
@@ -1338,6 +1696,7 @@ var ShowAppOptionalArgs = []string{
 	"requiredoutboundconnections:#.portrangemin",
 	"requiredoutboundconnections:#.portrangemax",
 	"requiredoutboundconnections:#.remotecidr",
+	"requiredoutboundconnections:#.remotefqdn",
 	"allowserverless",
 	"serverlessconfig.vcpus",
 	"serverlessconfig.ram",
@@ -1382,5 +1741,21 @@ var ShowAppOptionalArgs = []string{
 	"appannotations",
 	"isstandalone",
 	"managesownnamespaces",
+	"findcloudletranking",
+	"findcloudletrankingweights.distance",
+	"findcloudletrankingweights.latency",
+	"findcloudletrankingweights.load",
+	"rolloutpolicy.strategy",
+	"rolloutpolicy.canarypercent",
+	"rolloutpolicy.healthtimeout",
+	"rolloutpolicy.observationtime",
+	"rolloutpolicy.batchby",
+	"rolloutpolicy.batchsize",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
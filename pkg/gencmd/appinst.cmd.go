@@ -66,6 +66,12 @@ func AppInstHideTags(in *edgeproto.AppInst) {
 	if _, found := tags["nocmp"]; found {
 		in.DbModelId = 0
 	}
+	if _, found := tags["nocmp"]; found {
+		in.RolloutStatus = nil
+	}
+	if _, found := tags["nocmp"]; found {
+		in.DryRun = false
+	}
 }
 
 func AppInstInfoHideTags(in *edgeproto.AppInstInfo) {
@@ -903,6 +909,13 @@ var AppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"dryrun",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var AppInstAliasArgs = []string{
@@ -966,6 +979,8 @@ var AppInstComments = map[string]string{
 	"errors":                                 "Any errors trying to create, update, or delete the AppInst on the Cloudlet, specify errors:empty=true to clear",
 	"crmoverride":                            "Override actions to CRM, one of NoOverride, IgnoreCrmErrors, IgnoreCrm, IgnoreTransientState, IgnoreCrmAndTransientState",
 	"runtimeinfo.containerids":               "List of container names, specify runtimeinfo.containerids:empty=true to clear",
+	"runtimeinfo.replicas":                   "Current number of replicas, for horizontally scaled instances",
+	"runtimeinfo.desiredreplicas":            "Number of replicas desired by the horizontal pod autoscaler",
 	"createdat":                              "Created at time",
 	"autoclusteripaccess":                    "(Deprecated) IpAccess for auto-clusters. Ignored otherwise., one of Unknown, Dedicated, Shared",
 	"revision":                               "Revision changes each time the App is updated.  Refreshing the App Instance will sync the revision with that of the App",
@@ -1021,7 +1036,19 @@ var AppInstComments = map[string]string{
 	"noderesources.infranodeflavor":                         "Infrastructure specific node flavor",
 	"noderesources.externalvolumesize":                      "Size of external volume to be attached to nodes. This is for the root partition",
 	"isstandalone":                                          "A standalone AppInst will not share a cluster with another AppInst unless explicitly targeted to the same cluster",
-	"tags":                                                  "Vendor-specific data, specify tags:empty=true to clear",
+	"rolloutstatus.state":                                   "Rollout state, one of None, Pending, InProgress, Paused, Done, Failed, Aborted",
+	"rolloutstatus.batch":                                   "Current batch of the App rollout, or the batch the AppInst is updated in",
+	"rolloutstatus.numbatches":                              "Total number of batches",
+	"rolloutstatus.controller":                              "Address of the Controller running the App rollout",
+	"rolloutstatus.forceupdate":                             "App rollout updates AppInsts even if they are already at the Apps revision",
+	"dryrun":                                                "Run validation and placement without creating the instance, and show the placement decisions",
+	"horizontalscalepolicy.minreplicas":                     "Minimum number of replicas",
+	"horizontalscalepolicy.maxreplicas":                     "Maximum number of replicas",
+	"horizontalscalepolicy.targetcpu":                       "Target average cpu utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"horizontalscalepolicy.targetmem":                       "Target average memory utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"horizontalscalepolicy.targetactiveconnections":         "Target average number of active connections per pod, 0 means disabled. This is an external metric, and requires an external metrics adapter in the cluster that serves the load balancers envoy_cluster_upstream_cx_active metric, which is not deployed by the platform. Without the adapter, the autoscaler cannot scale on this target.",
+	"horizontalscalepolicy.stabilizationwindowsec":          "Scale down stabilization window in seconds, 0 uses the Kubernetes default",
+	"tags": "Vendor-specific data, specify tags:empty=true to clear",
 }
 var AppInstSpecialArgs = map[string]string{
 	"annotations":        "StringToString",
@@ -1039,10 +1066,14 @@ var AppInstSpecialArgs = map[string]string{
 var AppInstRuntimeRequiredArgs = []string{}
 var AppInstRuntimeOptionalArgs = []string{
 	"containerids",
+	"replicas",
+	"desiredreplicas",
 }
 var AppInstRuntimeAliasArgs = []string{}
 var AppInstRuntimeComments = map[string]string{
-	"containerids": "List of container names",
+	"containerids":    "List of container names",
+	"replicas":        "Current number of replicas, for horizontally scaled instances",
+	"desiredreplicas": "Number of replicas desired by the horizontal pod autoscaler",
 }
 var AppInstRuntimeSpecialArgs = map[string]string{
 	"containerids": "StringArray",
@@ -1087,6 +1118,8 @@ var AppInstInfoOptionalArgs = []string{
 	"state",
 	"errors",
 	"runtimeinfo.containerids",
+	"runtimeinfo.replicas",
+	"runtimeinfo.desiredreplicas",
 	"status.tasknumber",
 	"status.maxtasks",
 	"status.taskname",
@@ -1112,35 +1145,37 @@ var AppInstInfoOptionalArgs = []string{
 }
 var AppInstInfoAliasArgs = []string{}
 var AppInstInfoComments = map[string]string{
-	"fields":                     "Fields are used for the Update API to specify which fields to apply",
-	"key.name":                   "App Instance name",
-	"key.organization":           "App Instance organization",
-	"notifyid":                   "Id of client assigned by server (internal use only)",
-	"state":                      "Current state of the AppInst on the Cloudlet, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
-	"errors":                     "Any errors trying to create, update, or delete the AppInst on the Cloudlet",
-	"runtimeinfo.containerids":   "List of container names",
-	"status.tasknumber":          "Task number",
-	"status.maxtasks":            "Max tasks",
-	"status.taskname":            "Task name",
-	"status.stepname":            "Step name",
-	"status.msgcount":            "Message count",
-	"status.msgs":                "Messages",
-	"powerstate":                 "Power State of the AppInst, one of PowerOn, PowerOff, Reboot",
-	"uri":                        "Base FQDN for the App based on the cloudlet platform",
-	"fedkey.federationname":      "Federation name",
-	"fedkey.appinstid":           "Federated AppInst ID",
-	"fedports:#.proto":           "TCP (L4) or UDP (L4) protocol, one of Unknown, Tcp, Udp, Http",
-	"fedports:#.internalport":    "Container port",
-	"fedports:#.publicport":      "Public facing port for TCP/UDP (may be mapped on shared LB reverse proxy)",
-	"fedports:#.pathprefix":      "PathPrefix for HTTP ports in Kubernetes ingress",
-	"fedports:#.fqdnprefix":      "FQDN prefix to append to base FQDN in FindCloudlet response. May be empty.",
-	"fedports:#.endport":         "A non-zero end port indicates a port range from internal port to end port, inclusive.",
-	"fedports:#.tls":             "TLS termination for this port",
-	"fedports:#.nginx":           "Use nginx proxy for this port if you really need a transparent proxy (udp only)",
-	"fedports:#.maxpktsize":      "Maximum datagram size (udp only)",
-	"fedports:#.internalvisonly": "Internal visibility only",
-	"fedports:#.id":              "Port ID for NBI compatibility",
-	"fedports:#.servicename":     "Service name for Kubernetes port, use with a custom manifest or Helm chart that uses same port number on different services in the app.",
+	"fields":                      "Fields are used for the Update API to specify which fields to apply",
+	"key.name":                    "App Instance name",
+	"key.organization":            "App Instance organization",
+	"notifyid":                    "Id of client assigned by server (internal use only)",
+	"state":                       "Current state of the AppInst on the Cloudlet, one of TrackedStateUnknown, NotPresent, CreateRequested, Creating, CreateError, Ready, UpdateRequested, Updating, UpdateError, DeleteRequested, Deleting, DeleteError, DeletePrepare, CrmInitok, CreatingDependencies, DeleteDone",
+	"errors":                      "Any errors trying to create, update, or delete the AppInst on the Cloudlet",
+	"runtimeinfo.containerids":    "List of container names",
+	"runtimeinfo.replicas":        "Current number of replicas, for horizontally scaled instances",
+	"runtimeinfo.desiredreplicas": "Number of replicas desired by the horizontal pod autoscaler",
+	"status.tasknumber":           "Task number",
+	"status.maxtasks":             "Max tasks",
+	"status.taskname":             "Task name",
+	"status.stepname":             "Step name",
+	"status.msgcount":             "Message count",
+	"status.msgs":                 "Messages",
+	"powerstate":                  "Power State of the AppInst, one of PowerOn, PowerOff, Reboot",
+	"uri":                         "Base FQDN for the App based on the cloudlet platform",
+	"fedkey.federationname":       "Federation name",
+	"fedkey.appinstid":            "Federated AppInst ID",
+	"fedports:#.proto":            "TCP (L4) or UDP (L4) protocol, one of Unknown, Tcp, Udp, Http",
+	"fedports:#.internalport":     "Container port",
+	"fedports:#.publicport":       "Public facing port for TCP/UDP (may be mapped on shared LB reverse proxy)",
+	"fedports:#.pathprefix":       "PathPrefix for HTTP ports in Kubernetes ingress",
+	"fedports:#.fqdnprefix":       "FQDN prefix to append to base FQDN in FindCloudlet response. May be empty.",
+	"fedports:#.endport":          "A non-zero end port indicates a port range from internal port to end port, inclusive.",
+	"fedports:#.tls":              "TLS termination for this port",
+	"fedports:#.nginx":            "Use nginx proxy for this port if you really need a transparent proxy (udp only)",
+	"fedports:#.maxpktsize":       "Maximum datagram size (udp only)",
+	"fedports:#.internalvisonly":  "Internal visibility only",
+	"fedports:#.id":               "Port ID for NBI compatibility",
+	"fedports:#.servicename":      "Service name for Kubernetes port, use with a custom manifest or Helm chart that uses same port number on different services in the app.",
 }
 var AppInstInfoSpecialArgs = map[string]string{
 	"errors":                   "StringArray",
@@ -1324,6 +1359,13 @@ var CreateAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"dryrun",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var DeleteAppInstRequiredArgs = []string{
@@ -1378,6 +1420,12 @@ var DeleteAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var RefreshAppInstRequiredArgs = []string{
@@ -1428,6 +1476,12 @@ var RefreshAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
 var UpdateAppInstRequiredArgs = []string{
@@ -1477,5 +1531,11 @@ var UpdateAppInstOptionalArgs = []string{
 	"noderesources.infranodeflavor",
 	"noderesources.externalvolumesize",
 	"isstandalone",
+	"horizontalscalepolicy.minreplicas",
+	"horizontalscalepolicy.maxreplicas",
+	"horizontalscalepolicy.targetcpu",
+	"horizontalscalepolicy.targetmem",
+	"horizontalscalepolicy.targetactiveconnections",
+	"horizontalscalepolicy.stabilizationwindowsec",
 	"tags",
 }
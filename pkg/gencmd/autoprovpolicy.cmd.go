@@ -428,30 +428,79 @@ var AutoProvPolicyOptionalArgs = []string{
 	"maxinstances",
 	"undeployclientcount",
 	"undeployintervalcount",
+	"schedules:empty",
+	"schedules:#.name",
+	"schedules:#.cron",
+	"schedules:#.duration",
+	"schedules:#.zones:empty",
+	"schedules:#.zones:#.organization",
+	"schedules:#.zones:#.name",
+	"schedules:#.zones:#.federatedorganization",
+	"schedules:#.minactiveinstances",
+	"scheduleleadtime",
+	"mininstancelifetime",
+	"redeploycooldown",
+	"maxflaps",
+	"flapwindow",
 }
 var AutoProvPolicyAliasArgs = []string{
 	"apporg=key.organization",
 	"name=key.name",
 }
 var AutoProvPolicyComments = map[string]string{
-	"fields":                        "Fields are used for the Update API to specify which fields to apply",
-	"apporg":                        "Name of the organization for the cluster that this policy will apply to",
-	"name":                          "Policy name",
-	"deployclientcount":             "Minimum number of clients within the auto deploy interval to trigger deployment",
-	"deployintervalcount":           "Number of intervals to check before triggering deployment",
-	"zones:empty":                   "Allowed deployment locations, specify zones:empty=true to clear",
-	"zones:#.organization":          "Organization owner of the Zone",
-	"zones:#.name":                  "Name of the Zone",
-	"zones:#.federatedorganization": "Federated operator organization who shared this Zone",
-	"minactiveinstances":            "Minimum number of active instances for High-Availability",
-	"maxinstances":                  "Maximum number of instances (active or not)",
-	"undeployclientcount":           "Number of active clients for the undeploy interval below which trigers undeployment, 0 (default) disables auto undeploy",
-	"undeployintervalcount":         "Number of intervals to check before triggering undeployment",
-	"deleteprepare":                 "Preparing to be deleted",
+	"fields":                                    "Fields are used for the Update API to specify which fields to apply",
+	"apporg":                                    "Name of the organization for the cluster that this policy will apply to",
+	"name":                                      "Policy name",
+	"deployclientcount":                         "Minimum number of clients within the auto deploy interval to trigger deployment",
+	"deployintervalcount":                       "Number of intervals to check before triggering deployment",
+	"zones:empty":                               "Allowed deployment locations, specify zones:empty=true to clear",
+	"zones:#.organization":                      "Organization owner of the Zone",
+	"zones:#.name":                              "Name of the Zone",
+	"zones:#.federatedorganization":             "Federated operator organization who shared this Zone",
+	"minactiveinstances":                        "Minimum number of active instances for High-Availability",
+	"maxinstances":                              "Maximum number of instances (active or not)",
+	"undeployclientcount":                       "Number of active clients for the undeploy interval below which trigers undeployment, 0 (default) disables auto undeploy",
+	"undeployintervalcount":                     "Number of intervals to check before triggering undeployment",
+	"deleteprepare":                             "Preparing to be deleted",
+	"schedules:empty":                           "Time-of-day schedules for pre-provisioning instances ahead of expected demand, specify schedules:empty=true to clear",
+	"schedules:#.name":                          "Schedule name, unique within the policy",
+	"schedules:#.cron":                          "Window start time in 5-field cron format (minute hour day-of-month month day-of-week), evaluated in each zones time zone",
+	"schedules:#.duration":                      "Length of the window, i.e. 2h, 30m",
+	"schedules:#.zones:empty":                   "Zones the schedule applies to, defaults to all zones in the policy, specify schedules:#.zones:empty=true to clear",
+	"schedules:#.zones:#.organization":          "Organization owner of the Zone",
+	"schedules:#.zones:#.name":                  "Name of the Zone",
+	"schedules:#.zones:#.federatedorganization": "Federated operator organization who shared this Zone",
+	"schedules:#.minactiveinstances":            "Minimum number of active instances in total across the schedules zones whose window is active, not per zone",
+	"scheduleleadtime":                          "How far ahead of a scheduled window to deploy instances, i.e. 10m, 1h",
+	"mininstancelifetime":                       "Minimum time an auto-provisioned instance is kept before it can be undeployed due to low demand, i.e. 30m",
+	"redeploycooldown":                          "Time after undeploying from a zone before deploying to it again due to demand, i.e. 15m",
+	"maxflaps":                                  "Number of demand-driven undeploys of an App within the flap window above which demand-driven deploys of the App are suspended, undeploys are still allowed, 0 (default) disables",
+	"flapwindow":                                "Window over which undeploys are counted against max flaps, also the time for which demand-driven deploys are suspended, i.e. 1h",
 }
 var AutoProvPolicySpecialArgs = map[string]string{
 	"fields": "StringArray",
 }
+var AutoProvScheduleRequiredArgs = []string{}
+var AutoProvScheduleOptionalArgs = []string{
+	"name",
+	"cron",
+	"duration",
+	"zones:#.organization",
+	"zones:#.name",
+	"zones:#.federatedorganization",
+	"minactiveinstances",
+}
+var AutoProvScheduleAliasArgs = []string{}
+var AutoProvScheduleComments = map[string]string{
+	"name":                          "Schedule name, unique within the policy",
+	"cron":                          "Window start time in 5-field cron format (minute hour day-of-month month day-of-week), evaluated in each zones time zone",
+	"duration":                      "Length of the window, i.e. 2h, 30m",
+	"zones:#.organization":          "Organization owner of the Zone",
+	"zones:#.name":                  "Name of the Zone",
+	"zones:#.federatedorganization": "Federated operator organization who shared this Zone",
+	"minactiveinstances":            "Minimum number of active instances in total across the schedules zones whose window is active, not per zone",
+}
+var AutoProvScheduleSpecialArgs = map[string]string{}
 var AutoProvCountRequiredArgs = []string{}
 var AutoProvCountOptionalArgs = []string{
 	"appkey.organization",
@@ -569,4 +618,16 @@ var CreateAutoProvPolicyOptionalArgs = []string{
 	"maxinstances",
 	"undeployclientcount",
 	"undeployintervalcount",
+	"schedules:#.name",
+	"schedules:#.cron",
+	"schedules:#.duration",
+	"schedules:#.zones:#.organization",
+	"schedules:#.zones:#.name",
+	"schedules:#.zones:#.federatedorganization",
+	"schedules:#.minactiveinstances",
+	"scheduleleadtime",
+	"mininstancelifetime",
+	"redeploycooldown",
+	"maxflaps",
+	"flapwindow",
 }
@@ -147,7 +147,16 @@ var CloudletExecReqOptionalArgs = []string{
 	"execreq.log.tail",
 	"execreq.log.timestamps",
 	"execreq.log.follow",
+	"execreq.log.allcontainers",
+	"execreq.log.filter",
+	"execreq.log.filterregex",
 	"execreq.console.url",
+	"execreq.copyfile.path",
+	"execreq.copyfile.upload",
+	"execreq.copyfile.filesize",
+	"execreq.portforward.port",
+	"execreq.portforward.ttl",
+	"execreq.portforward.user",
 	"execreq.timeout",
 	"execreq.accessurl",
 	"execreq.edgeturnaddr",
@@ -174,7 +183,16 @@ var CloudletExecReqComments = map[string]string{
 	"execreq.log.tail":                          "Show only a recent number of lines",
 	"execreq.log.timestamps":                    "Show timestamps",
 	"execreq.log.follow":                        "Stream data",
+	"execreq.log.allcontainers":                 "Show logs from all containers of the AppInst, merged in timestamp order and prefixed by container",
+	"execreq.log.filter":                        "Only show log lines containing the filter string",
+	"execreq.log.filterregex":                   "Treat the filter as a regular expression",
 	"execreq.console.url":                       "VM Console URL",
+	"execreq.copyfile.path":                     "Absolute path of the file in the container",
+	"execreq.copyfile.upload":                   "Upload the file to the container, otherwise download the file from the container",
+	"execreq.copyfile.filesize":                 "Size in bytes of the file to upload",
+	"execreq.portforward.port":                  "AppInst TCP port to forward to",
+	"execreq.portforward.ttl":                   "Session time to live, limited by the EdgeTurn server maximum",
+	"execreq.portforward.user":                  "User requesting the session, for session limits and auditing. Set by the Controller from the authenticated caller.",
 	"execreq.timeout":                           "Timeout",
 	"execreq.accessurl":                         "Access URL",
 	"execreq.edgeturnaddr":                      "EdgeTurn Server Address",
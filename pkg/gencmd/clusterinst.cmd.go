@@ -67,6 +67,9 @@ func ClusterInstHideTags(in *edgeproto.ClusterInst) {
 	}
 	for i0 := 0; i0 < len(in.NodePools); i0++ {
 	}
+	if _, found := tags["nocmp"]; found {
+		in.DryRun = false
+	}
 }
 
 func ClusterInstInfoHideTags(in *edgeproto.ClusterInstInfo) {
@@ -173,8 +176,8 @@ func CreateClusterInsts(c *cli.Command, data []edgeproto.ClusterInst, err *error
 
 var DeleteClusterInstCmd = &cli.Command{
 	Use:          "DeleteClusterInst",
-	RequiredArgs: strings.Join(ClusterInstRequiredArgs, " "),
-	OptionalArgs: strings.Join(ClusterInstOptionalArgs, " "),
+	RequiredArgs: strings.Join(DeleteClusterInstRequiredArgs, " "),
+	OptionalArgs: strings.Join(DeleteClusterInstOptionalArgs, " "),
 	AliasArgs:    strings.Join(ClusterInstAliasArgs, " "),
 	SpecialArgs:  &ClusterInstSpecialArgs,
 	Comments:     ClusterInstComments,
@@ -712,6 +715,7 @@ var ClusterInstOptionalArgs = []string{
 	"infraannotations",
 	"kubernetesversion",
 	"disabledynamicappinstplacement",
+	"dryrun",
 	"tags",
 }
 var ClusterInstAliasArgs = []string{
@@ -803,6 +807,7 @@ var ClusterInstComments = map[string]string{
 	"infraannotations":                             "Annotations added by the implementing infrastructure, specify infraannotations:empty=true to clear",
 	"kubernetesversion":                            "Kubernetes version of cluster if applicable",
 	"disabledynamicappinstplacement":               "Disables dynamic placement of AppInsts on this cluster",
+	"dryrun":                                       "Run validation and resource checks without creating or updating the instance, and show the placement decisions",
 	"tags":                                         "Vendor-specific data, specify tags:empty=true to clear",
 }
 var ClusterInstSpecialArgs = map[string]string{
@@ -961,6 +966,51 @@ var ClusterInstInfoSpecialArgs = map[string]string{
 	"infraannotations": "StringToString",
 	"status.msgs":      "StringArray",
 }
+var DeleteClusterInstRequiredArgs = []string{
+	"cluster",
+	"clusterorg",
+}
+var DeleteClusterInstOptionalArgs = []string{
+	"zoneorg",
+	"zone",
+	"zonekey.federatedorganization",
+	"flavor",
+	"crmoverride",
+	"ipaccess",
+	"deployment",
+	"nummasters",
+	"numnodes",
+	"autoscalepolicy",
+	"imagename",
+	"reservable",
+	"sharedvolumesize",
+	"skipcrmcleanuponfailure",
+	"multitenant",
+	"networks",
+	"enableipv6",
+	"objid",
+	"annotations",
+	"dbmodelid",
+	"noderesources.vcpus",
+	"noderesources.ram",
+	"noderesources.disk",
+	"noderesources.optresmap",
+	"noderesources.infranodeflavor",
+	"noderesources.externalvolumesize",
+	"nodepools:#.name",
+	"nodepools:#.numnodes",
+	"nodepools:#.noderesources.vcpus",
+	"nodepools:#.noderesources.ram",
+	"nodepools:#.noderesources.disk",
+	"nodepools:#.noderesources.optresmap",
+	"nodepools:#.noderesources.infranodeflavor",
+	"nodepools:#.noderesources.externalvolumesize",
+	"nodepools:#.scalable",
+	"infraannotations",
+	"kubernetesversion",
+	"disabledynamicappinstplacement",
+	"tags",
+}
 var UpdateClusterInstRequiredArgs = []string{
 	"cluster",
 	"clusterorg",
@@ -982,6 +1032,7 @@ var UpdateClusterInstOptionalArgs = []string{
 	"nodepools:#.scalable",
 	"infraannotations",
 	"disabledynamicappinstplacement",
+	"dryrun",
 	"tags",
 }
 var ShowClusterResourceUsageRequiredArgs = []string{}
@@ -1026,5 +1077,6 @@ var ShowClusterResourceUsageOptionalArgs = []string{
 	"infraannotations",
 	"kubernetesversion",
 	"disabledynamicappinstplacement",
+	"dryrun",
 	"tags",
 }
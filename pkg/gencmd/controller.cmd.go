@@ -126,8 +126,124 @@ func ShowControllers(c *cli.Command, data []edgeproto.Controller, err *error) {
 	}
 }
 
+var BackupControllerCmd = &cli.Command{
+	Use:          "BackupController",
+	RequiredArgs: strings.Join(BackupControllerRequiredArgs, " "),
+	OptionalArgs: strings.Join(BackupControllerOptionalArgs, " "),
+	AliasArgs:    strings.Join(ControllerBackupRequestAliasArgs, " "),
+	SpecialArgs:  &ControllerBackupRequestSpecialArgs,
+	Comments:     ControllerBackupRequestComments,
+	ReqData:      &edgeproto.ControllerBackupRequest{},
+	ReplyData:    &edgeproto.ControllerBackup{},
+	Run:          runBackupController,
+}
+
+func runBackupController(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.ControllerBackupRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return BackupController(c, obj)
+}
+
+func BackupController(c *cli.Command, in *edgeproto.ControllerBackupRequest) error {
+	if ControllerApiCmd == nil {
+		return fmt.Errorf("ControllerApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := ControllerApiCmd.BackupController(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("BackupController failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func BackupControllers(c *cli.Command, data []edgeproto.ControllerBackupRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("BackupController %v\n", data[ii])
+		myerr := BackupController(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var RestoreControllerCmd = &cli.Command{
+	Use:          "RestoreController",
+	RequiredArgs: strings.Join(RestoreControllerRequiredArgs, " "),
+	OptionalArgs: strings.Join(RestoreControllerOptionalArgs, " "),
+	AliasArgs:    strings.Join(ControllerRestoreRequestAliasArgs, " "),
+	SpecialArgs:  &ControllerRestoreRequestSpecialArgs,
+	Comments:     ControllerRestoreRequestComments,
+	ReqData:      &edgeproto.ControllerRestoreRequest{},
+	ReplyData:    &edgeproto.Result{},
+	Run:          runRestoreController,
+}
+
+func runRestoreController(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.ControllerRestoreRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return RestoreController(c, obj)
+}
+
+func RestoreController(c *cli.Command, in *edgeproto.ControllerRestoreRequest) error {
+	if ControllerApiCmd == nil {
+		return fmt.Errorf("ControllerApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := ControllerApiCmd.RestoreController(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("RestoreController failed: %s", errstr)
+	}
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func RestoreControllers(c *cli.Command, data []edgeproto.ControllerRestoreRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("RestoreController %v\n", data[ii])
+		myerr := RestoreController(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var ControllerApiCmds = []*cobra.Command{
 	ShowControllerCmd.GenCmd(),
+	BackupControllerCmd.GenCmd(),
+	RestoreControllerCmd.GenCmd(),
 }
 
 var ControllerKeyRequiredArgs = []string{}
@@ -160,3 +276,57 @@ var ControllerComments = map[string]string{
 var ControllerSpecialArgs = map[string]string{
 	"fields": "StringArray",
 }
+var ControllerBackupRequestRequiredArgs = []string{}
+var ControllerBackupRequestOptionalArgs = []string{
+	"passphrase",
+	"includesecrets",
+}
+var ControllerBackupRequestAliasArgs = []string{}
+var ControllerBackupRequestComments = map[string]string{
+	"passphrase":     "Passphrase used to encrypt the backup, required to restore it",
+	"includesecrets": "Include secret data such as cloudlet node password hashes in the backup",
+}
+var ControllerBackupRequestSpecialArgs = map[string]string{}
+var ControllerBackupRequiredArgs = []string{}
+var ControllerBackupOptionalArgs = []string{
+	"region",
+	"revision",
+	"datamodelversionhash",
+	"datamodelversionid",
+	"numobjects",
+	"includesecrets",
+	"data",
+}
+var ControllerBackupAliasArgs = []string{}
+var ControllerBackupComments = map[string]string{
+	"region":               "Region the backup was taken from",
+	"revision":             "Etcd revision at which the snapshot was taken",
+	"datamodelversionhash": "Data model version hash of the snapshot",
+	"datamodelversionid":   "Data model version ID of the snapshot",
+	"numobjects":           "Number of objects in the snapshot",
+	"includesecrets":       "Whether secret data is included in the snapshot",
+	"data":                 "Encrypted snapshot data, base64 encoded",
+}
+var ControllerBackupSpecialArgs = map[string]string{}
+var ControllerRestoreRequestRequiredArgs = []string{}
+var ControllerRestoreRequestOptionalArgs = []string{
+	"passphrase",
+	"data",
+}
+var ControllerRestoreRequestAliasArgs = []string{}
+var ControllerRestoreRequestComments = map[string]string{
+	"passphrase": "Passphrase used to encrypt the backup",
+	"data":       "Encrypted snapshot data from ControllerBackup",
+}
+var ControllerRestoreRequestSpecialArgs = map[string]string{}
+var BackupControllerRequiredArgs = []string{
+	"passphrase",
+}
+var BackupControllerOptionalArgs = []string{
+	"includesecrets",
+}
+var RestoreControllerRequiredArgs = []string{
+	"passphrase",
+	"data",
+}
+var RestoreControllerOptionalArgs = []string{}
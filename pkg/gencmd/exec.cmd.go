@@ -231,6 +231,122 @@ func ShowLogss(c *cli.Command, data []edgeproto.ExecRequest, err *error) {
 	}
 }
 
+var RunCopyFileCmd = &cli.Command{
+	Use:          "RunCopyFile",
+	RequiredArgs: strings.Join(RunCopyFileRequiredArgs, " "),
+	OptionalArgs: strings.Join(RunCopyFileOptionalArgs, " "),
+	AliasArgs:    strings.Join(ExecRequestAliasArgs, " "),
+	SpecialArgs:  &ExecRequestSpecialArgs,
+	Comments:     ExecRequestComments,
+	ReqData:      &edgeproto.ExecRequest{},
+	ReplyData:    &edgeproto.ExecRequest{},
+	Run:          runRunCopyFile,
+}
+
+func runRunCopyFile(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.ExecRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return RunCopyFile(c, obj)
+}
+
+func RunCopyFile(c *cli.Command, in *edgeproto.ExecRequest) error {
+	if ExecApiCmd == nil {
+		return fmt.Errorf("ExecApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := ExecApiCmd.RunCopyFile(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("RunCopyFile failed: %s", errstr)
+	}
+	ExecRequestHideTags(obj)
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func RunCopyFiles(c *cli.Command, data []edgeproto.ExecRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("RunCopyFile %v\n", data[ii])
+		myerr := RunCopyFile(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
+var RunPortForwardCmd = &cli.Command{
+	Use:          "RunPortForward",
+	RequiredArgs: strings.Join(RunPortForwardRequiredArgs, " "),
+	OptionalArgs: strings.Join(RunPortForwardOptionalArgs, " "),
+	AliasArgs:    strings.Join(ExecRequestAliasArgs, " "),
+	SpecialArgs:  &ExecRequestSpecialArgs,
+	Comments:     ExecRequestComments,
+	ReqData:      &edgeproto.ExecRequest{},
+	ReplyData:    &edgeproto.ExecRequest{},
+	Run:          runRunPortForward,
+}
+
+func runRunPortForward(c *cli.Command, args []string) error {
+	if cli.SilenceUsage {
+		c.CobraCmd.SilenceUsage = true
+	}
+	obj := c.ReqData.(*edgeproto.ExecRequest)
+	_, err := c.ParseInput(args)
+	if err != nil {
+		return err
+	}
+	return RunPortForward(c, obj)
+}
+
+func RunPortForward(c *cli.Command, in *edgeproto.ExecRequest) error {
+	if ExecApiCmd == nil {
+		return fmt.Errorf("ExecApi client not initialized")
+	}
+	ctx := context.Background()
+	obj, err := ExecApiCmd.RunPortForward(ctx, in)
+	if err != nil {
+		errstr := err.Error()
+		st, ok := status.FromError(err)
+		if ok {
+			errstr = st.Message()
+		}
+		return fmt.Errorf("RunPortForward failed: %s", errstr)
+	}
+	ExecRequestHideTags(obj)
+	c.WriteOutput(c.CobraCmd.OutOrStdout(), obj, cli.OutputFormat)
+	return nil
+}
+
+// this supports "Create" and "Delete" commands on ApplicationData
+func RunPortForwards(c *cli.Command, data []edgeproto.ExecRequest, err *error) {
+	if *err != nil {
+		return
+	}
+	for ii, _ := range data {
+		fmt.Printf("RunPortForward %v\n", data[ii])
+		myerr := RunPortForward(c, &data[ii])
+		if myerr != nil {
+			*err = myerr
+			break
+		}
+	}
+}
+
 var AccessCloudletCmd = &cli.Command{
 	Use:          "AccessCloudlet",
 	RequiredArgs: strings.Join(AccessCloudletRequiredArgs, " "),
@@ -351,6 +467,8 @@ var ExecApiCmds = []*cobra.Command{
 	RunCommandCmd.GenCmd(),
 	RunConsoleCmd.GenCmd(),
 	ShowLogsCmd.GenCmd(),
+	RunCopyFileCmd.GenCmd(),
+	RunPortForwardCmd.GenCmd(),
 	AccessCloudletCmd.GenCmd(),
 	SendLocalRequestCmd.GenCmd(),
 }
@@ -394,15 +512,47 @@ var ShowLogOptionalArgs = []string{
 	"tail",
 	"timestamps",
 	"follow",
+	"allcontainers",
+	"filter",
+	"filterregex",
 }
 var ShowLogAliasArgs = []string{}
 var ShowLogComments = map[string]string{
-	"since":      "Show logs since either a duration ago (5s, 2m, 3h) or a timestamp (RFC3339)",
-	"tail":       "Show only a recent number of lines",
-	"timestamps": "Show timestamps",
-	"follow":     "Stream data",
+	"since":         "Show logs since either a duration ago (5s, 2m, 3h) or a timestamp (RFC3339)",
+	"tail":          "Show only a recent number of lines",
+	"timestamps":    "Show timestamps",
+	"follow":        "Stream data",
+	"allcontainers": "Show logs from all containers of the AppInst, merged in timestamp order and prefixed by container",
+	"filter":        "Only show log lines containing the filter string",
+	"filterregex":   "Treat the filter as a regular expression",
 }
 var ShowLogSpecialArgs = map[string]string{}
+var CopyFileRequiredArgs = []string{}
+var CopyFileOptionalArgs = []string{
+	"path",
+	"upload",
+	"filesize",
+}
+var CopyFileAliasArgs = []string{}
+var CopyFileComments = map[string]string{
+	"path":     "Absolute path of the file in the container",
+	"upload":   "Upload the file to the container, otherwise download the file from the container",
+	"filesize": "Size in bytes of the file to upload",
+}
+var CopyFileSpecialArgs = map[string]string{}
+var PortForwardRequiredArgs = []string{}
+var PortForwardOptionalArgs = []string{
+	"port",
+	"ttl",
+	"user",
+}
+var PortForwardAliasArgs = []string{}
+var PortForwardComments = map[string]string{
+	"port": "AppInst TCP port to forward to",
+	"ttl":  "Session time to live, limited by the EdgeTurn server maximum",
+	"user": "User requesting the session, for session limits and auditing. Set by the Controller from the authenticated caller.",
+}
+var PortForwardSpecialArgs = map[string]string{}
 var ExecRequestRequiredArgs = []string{
 	"appinstname",
 	"appinstorg",
@@ -416,6 +566,15 @@ var ExecRequestOptionalArgs = []string{
 	"tail",
 	"timestamps",
 	"follow",
+	"allcontainers",
+	"filter",
+	"filterregex",
+	"path",
+	"upload",
+	"size",
+	"port",
+	"ttl",
+	"portforward.user",
 	"edgeturnproxyaddr",
 	"cloudletorg",
 	"cloudlet",
@@ -431,6 +590,14 @@ var ExecRequestAliasArgs = []string{
 	"tail=log.tail",
 	"timestamps=log.timestamps",
 	"follow=log.follow",
+	"allcontainers=log.allcontainers",
+	"filter=log.filter",
+	"filterregex=log.filterregex",
+	"path=copyfile.path",
+	"upload=copyfile.upload",
+	"size=copyfile.filesize",
+	"port=portforward.port",
+	"ttl=portforward.ttl",
 	"cloudletorg=cloudletkey.organization",
 	"cloudlet=cloudletkey.name",
 	"federatedorg=cloudletkey.federatedorganization",
@@ -449,7 +616,16 @@ var ExecRequestComments = map[string]string{
 	"tail":              "Show only a recent number of lines",
 	"timestamps":        "Show timestamps",
 	"follow":            "Stream data",
+	"allcontainers":     "Show logs from all containers of the AppInst, merged in timestamp order and prefixed by container",
+	"filter":            "Only show log lines containing the filter string",
+	"filterregex":       "Treat the filter as a regular expression",
 	"console.url":       "VM Console URL",
+	"path":              "Absolute path of the file in the container",
+	"upload":            "Upload the file to the container, otherwise download the file from the container",
+	"size":              "Size in bytes of the file to upload",
+	"port":              "AppInst TCP port to forward to",
+	"ttl":               "Session time to live, limited by the EdgeTurn server maximum",
+	"portforward.user":  "User requesting the session, for session limits and auditing. Set by the Controller from the authenticated caller.",
 	"timeout":           "Timeout",
 	"accessurl":         "Access URL",
 	"edgeturnaddr":      "EdgeTurn Server Address",
@@ -485,6 +661,30 @@ var ShowLogsOptionalArgs = []string{
 	"tail",
 	"timestamps",
 	"follow",
+	"allcontainers",
+	"filter",
+	"filterregex",
+	"edgeturnproxyaddr",
+}
+var RunCopyFileRequiredArgs = []string{
+	"appinstname",
+	"appinstorg",
+	"path",
+}
+var RunCopyFileOptionalArgs = []string{
+	"containerid",
+	"upload",
+	"size",
+	"edgeturnproxyaddr",
+}
+var RunPortForwardRequiredArgs = []string{
+	"appinstname",
+	"appinstorg",
+	"port",
+}
+var RunPortForwardOptionalArgs = []string{
+	"containerid",
+	"ttl",
 	"edgeturnproxyaddr",
 }
 var AccessCloudletRequiredArgs = []string{
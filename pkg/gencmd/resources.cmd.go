@@ -88,6 +88,25 @@ var KubernetesResourcesSpecialArgs = map[string]string{
 	"gpupool.topology.minnodeoptres": "StringToString",
 	"gpupool.totaloptres":            "StringToString",
 }
+var HorizontalScalePolicyRequiredArgs = []string{}
+var HorizontalScalePolicyOptionalArgs = []string{
+	"minreplicas",
+	"maxreplicas",
+	"targetcpu",
+	"targetmem",
+	"targetactiveconnections",
+	"stabilizationwindowsec",
+}
+var HorizontalScalePolicyAliasArgs = []string{}
+var HorizontalScalePolicyComments = map[string]string{
+	"minreplicas":             "Minimum number of replicas",
+	"maxreplicas":             "Maximum number of replicas",
+	"targetcpu":               "Target average cpu utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"targetmem":               "Target average memory utilization of pod requests (percentage 1 to 100), 0 means disabled",
+	"targetactiveconnections": "Target average number of active connections per pod, 0 means disabled. This is an external metric, and requires an external metrics adapter in the cluster that serves the load balancers envoy_cluster_upstream_cx_active metric, which is not deployed by the platform. Without the adapter, the autoscaler cannot scale on this target.",
+	"stabilizationwindowsec":  "Scale down stabilization window in seconds, 0 uses the Kubernetes default",
+}
+var HorizontalScalePolicySpecialArgs = map[string]string{}
 var NodePoolResourcesRequiredArgs = []string{}
 var NodePoolResourcesOptionalArgs = []string{
 	"totalvcpus",
@@ -5,6 +5,7 @@ package gencmd
 
 import (
 	fmt "fmt"
+	_ "github.com/edgexr/edge-cloud-platform/tools/protogen"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	math "math"
@@ -20,10 +21,53 @@ var ResultRequiredArgs = []string{}
 var ResultOptionalArgs = []string{
 	"message",
 	"code",
+	"placementcandidates:#.cloudletkey.organization",
+	"placementcandidates:#.cloudletkey.name",
+	"placementcandidates:#.cloudletkey.federatedorganization",
+	"placementcandidates:#.clusterkey.name",
+	"placementcandidates:#.clusterkey.organization",
+	"placementcandidates:#.chosen",
+	"placementcandidates:#.skipreason",
+	"placementcandidates:#.details",
+	"placementcandidates:#.resourcescore",
 }
 var ResultAliasArgs = []string{}
 var ResultComments = map[string]string{
 	"message": "Message, may be success or failure message",
 	"code":    "Error code, 0 indicates success, non-zero indicates failure (not implemented)",
+	"placementcandidates:#.cloudletkey.organization":          "Organization of the cloudlet site",
+	"placementcandidates:#.cloudletkey.name":                  "Name of the cloudlet",
+	"placementcandidates:#.cloudletkey.federatedorganization": "Federated operator organization who shared this cloudlet",
+	"placementcandidates:#.clusterkey.name":                   "Cluster name",
+	"placementcandidates:#.clusterkey.organization":           "Name of the organization that this cluster belongs to",
+	"placementcandidates:#.chosen":                            "Candidate was chosen for deployment",
+	"placementcandidates:#.skipreason":                        "Reason the candidate was skipped, blank if the candidate is usable",
+	"placementcandidates:#.details":                           "Details of the placement decision",
+	"placementcandidates:#.resourcescore":                     "Resource score, higher score means more available resources",
 }
 var ResultSpecialArgs = map[string]string{}
+var PlacementCandidateRequiredArgs = []string{}
+var PlacementCandidateOptionalArgs = []string{
+	"cloudletkey.organization",
+	"cloudletkey.name",
+	"cloudletkey.federatedorganization",
+	"clusterkey.name",
+	"clusterkey.organization",
+	"chosen",
+	"skipreason",
+	"details",
+	"resourcescore",
+}
+var PlacementCandidateAliasArgs = []string{}
+var PlacementCandidateComments = map[string]string{
+	"cloudletkey.organization":          "Organization of the cloudlet site",
+	"cloudletkey.name":                  "Name of the cloudlet",
+	"cloudletkey.federatedorganization": "Federated operator organization who shared this cloudlet",
+	"clusterkey.name":                   "Cluster name",
+	"clusterkey.organization":           "Name of the organization that this cluster belongs to",
+	"chosen":                            "Candidate was chosen for deployment",
+	"skipreason":                        "Reason the candidate was skipped, blank if the candidate is usable",
+	"details":                           "Details of the placement decision",
+	"resourcescore":                     "Resource score, higher score means more available resources",
+}
+var PlacementCandidateSpecialArgs = map[string]string{}
@@ -238,6 +238,7 @@ var SettingsOptionalArgs = []string{
 	"platformhainstancepollinterval",
 	"platformhainstanceactiveexpiretime",
 	"ccrmapitimeout",
+	"trustpolicyfqdnrefreshinterval",
 }
 var SettingsAliasArgs = []string{}
 var SettingsComments = map[string]string{
@@ -284,6 +285,7 @@ var SettingsComments = map[string]string{
 	"platformhainstancepollinterval":                                    "Platform HA instance poll interval",
 	"platformhainstanceactiveexpiretime":                                "Platform HA instance active time",
 	"ccrmapitimeout":                                                    "Timeout for controller platform-specific API calls to CCRM",
+	"trustpolicyfqdnrefreshinterval":                                    "Trust policy remote FQDN resolution refresh interval",
 }
 var SettingsSpecialArgs = map[string]string{
 	"fields": "StringArray",
@@ -360,6 +360,7 @@ var SecurityRuleOptionalArgs = []string{
 	"portrangemin",
 	"portrangemax",
 	"remotecidr",
+	"remotefqdn",
 }
 var SecurityRuleAliasArgs = []string{}
 var SecurityRuleComments = map[string]string{
@@ -367,6 +368,7 @@ var SecurityRuleComments = map[string]string{
 	"portrangemin": "TCP or UDP port range start",
 	"portrangemax": "TCP or UDP port range end",
 	"remotecidr":   "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"remotefqdn":   "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 }
 var SecurityRuleSpecialArgs = map[string]string{}
 var TrustPolicyRequiredArgs = []string{
@@ -379,6 +381,13 @@ var TrustPolicyOptionalArgs = []string{
 	"outboundsecurityrules:#.portrangemin",
 	"outboundsecurityrules:#.portrangemax",
 	"outboundsecurityrules:#.remotecidr",
+	"outboundsecurityrules:#.remotefqdn",
+	"inboundsecurityrules:empty",
+	"inboundsecurityrules:#.protocol",
+	"inboundsecurityrules:#.portrangemin",
+	"inboundsecurityrules:#.portrangemax",
+	"inboundsecurityrules:#.remotecidr",
+	"inboundsecurityrules:#.remotefqdn",
 }
 var TrustPolicyAliasArgs = []string{
 	"cloudletorg=key.organization",
@@ -393,7 +402,14 @@ var TrustPolicyComments = map[string]string{
 	"outboundsecurityrules:#.portrangemin": "TCP or UDP port range start",
 	"outboundsecurityrules:#.portrangemax": "TCP or UDP port range end",
 	"outboundsecurityrules:#.remotecidr":   "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"outboundsecurityrules:#.remotefqdn":   "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 	"deleteprepare":                        "Preparing to be deleted",
+	"inboundsecurityrules:empty":           "List of inbound security rules for whitelisting traffic to application instances, specify inboundsecurityrules:empty=true to clear",
+	"inboundsecurityrules:#.protocol":      "TCP, UDP, ICMP",
+	"inboundsecurityrules:#.portrangemin":  "TCP or UDP port range start",
+	"inboundsecurityrules:#.portrangemax":  "TCP or UDP port range end",
+	"inboundsecurityrules:#.remotecidr":    "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"inboundsecurityrules:#.remotefqdn":    "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 }
 var TrustPolicySpecialArgs = map[string]string{
 	"fields": "StringArray",
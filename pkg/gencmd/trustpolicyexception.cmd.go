@@ -317,6 +317,7 @@ var TrustPolicyExceptionOptionalArgs = []string{
 	"outboundsecurityrules:#.portrangemin",
 	"outboundsecurityrules:#.portrangemax",
 	"outboundsecurityrules:#.remotecidr",
+	"outboundsecurityrules:#.remotefqdn",
 }
 var TrustPolicyExceptionAliasArgs = []string{
 	"apporg=key.appkey.organization",
@@ -340,6 +341,7 @@ var TrustPolicyExceptionComments = map[string]string{
 	"outboundsecurityrules:#.portrangemin": "TCP or UDP port range start",
 	"outboundsecurityrules:#.portrangemax": "TCP or UDP port range end",
 	"outboundsecurityrules:#.remotecidr":   "Remote CIDR X.X.X.X/X for IPv4 or e.g. XXXX:XXXX::XXXX/XX for IPv6",
+	"outboundsecurityrules:#.remotefqdn":   "Remote fully qualified domain name, used instead of remote CIDR for outbound trust policy rules. The name is resolved and periodically refreshed by the CRM.",
 }
 var TrustPolicyExceptionSpecialArgs = map[string]string{
 	"fields": "StringArray",
@@ -431,6 +433,7 @@ var CreateTrustPolicyExceptionOptionalArgs = []string{
 	"outboundsecurityrules:#.portrangemin",
 	"outboundsecurityrules:#.portrangemax",
 	"outboundsecurityrules:#.remotecidr",
+	"outboundsecurityrules:#.remotefqdn",
 }
 var DeleteTrustPolicyExceptionRequiredArgs = []string{
 	"apporg",
@@ -445,4 +448,5 @@ var DeleteTrustPolicyExceptionOptionalArgs = []string{
 	"outboundsecurityrules:#.portrangemin",
 	"outboundsecurityrules:#.portrangemax",
 	"outboundsecurityrules:#.remotecidr",
+	"outboundsecurityrules:#.remotefqdn",
 }
@@ -318,6 +318,7 @@ var ZoneOptionalArgs = []string{
 	"infraflavors:#.ram",
 	"infraflavors:#.disk",
 	"infraflavors:#.propmap",
+	"timezone",
 	"objid",
 }
 var ZoneAliasArgs = []string{
@@ -344,6 +345,7 @@ var ZoneComments = map[string]string{
 	"location.course":             "Course (IOS) / bearing (Android) (degrees east relative to true north)",
 	"location.speed":              "Speed (IOS) / velocity (Android) (meters/sec)",
 	"location.timestamp":          "Timestamp",
+	"timezone":                    "IANA time zone name (e.g. America/Los_Angeles) used to evaluate time-of-day schedules, defaults to UTC",
 	"objid":                       "Universally unique object ID",
 	"deleteprepare":               "Preparing to be deleted",
 	"createdat":                   "Created at time",
@@ -467,7 +467,7 @@ func FindHierField(g *generator.Generator, message *descriptor.DescriptorProto,
 		name := names[0]
 		field := FindField(message, name)
 		if field == nil {
-			return message, field, fmt.Errorf("No field %s on %s", *field.Name, *message.Name)
+			return message, field, fmt.Errorf("No field %s on %s", name, *message.Name)
 		}
 		if len(names) == 1 {
 			return message, field, nil
@@ -28,6 +28,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/platform"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
 	ssh "github.com/edgexr/golang-ssh"
+	"github.com/kballard/go-shellquote"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 )
@@ -60,6 +61,8 @@ type AppInstOptions struct {
 	Wait            bool
 	WM              WorkloadMgr
 	NamespaceLabels map[string]string
+	TrustPolicy     *edgeproto.TrustPolicy
+	RolloutHooks    *RolloutHooks
 }
 
 type AppInstOp func(*AppInstOptions)
@@ -93,6 +96,15 @@ func WithNamespaceLabels(labels map[string]string) AppInstOp {
 	}
 }
 
+// WithTrustPolicy applies the cloudlet's trust policy to the
+// AppInst's network policy. Remote FQDNs in the policy's rules must
+// already be resolved.
+func WithTrustPolicy(policy *edgeproto.TrustPolicy) AppInstOp {
+	return func(opts *AppInstOptions) {
+		opts.TrustPolicy = policy
+	}
+}
+
 func GetAppInstOptions(ops []AppInstOp) *AppInstOptions {
 	opts := &AppInstOptions{
 		Wait: true, // by default, we wait for pods
@@ -440,21 +452,35 @@ func CreateAllNamespaces(ctx context.Context, client ssh.Client, names *KubeName
 	return nil
 }
 
-func GenerateAppInstPolicyManifest(ctx context.Context, names *KubeNames, app *edgeproto.App, appInst *edgeproto.AppInst) (string, error) {
+func GenerateAppInstPolicyManifest(ctx context.Context, names *KubeNames, app *edgeproto.App, appInst *edgeproto.AppInst, ops ...AppInstOp) (string, error) {
+	opts := GetAppInstOptions(ops)
+	trustPolicy := opts.TrustPolicy
+	if trustPolicy != nil && trustPolicy.Key.Name == "" {
+		trustPolicy = nil
+	}
 	mf := ""
-	if names.MultiTenantRestricted {
-		// Mulit-tenant cluster, add network policy
-		np, err := GetNetworkPolicy(ctx, app, appInst, names)
+	if names.MultiTenantRestricted || trustPolicy != nil {
+		// Mulit-tenant cluster or trust policy, add network policy
+		np, err := GetNetworkPolicy(ctx, app, appInst, names, trustPolicy)
 		if err != nil {
 			return "", err
 		}
 		mf = AddManifest(mf, np)
+	}
+	if names.MultiTenantRestricted {
 		// For now, ResourceQuota is only for multi-tenancy.
 		// It is pretty strict, in that any deployment that
 		// does not define resource limits will not be allowed
 		// to be deployed. We can evaluate later if it should
 		// also be applied in a non-multi-tenant context.
-		rq, err := GetResourceQuota(ctx, names, appInst.KubernetesResources)
+		// The quota covers the maximum replicas for horizontally
+		// scaled AppInsts, matching the resources reserved by
+		// the controller.
+		kr, err := cloudcommon.GetReservedKubernetesResources(appInst)
+		if err != nil {
+			return "", err
+		}
+		rq, err := GetResourceQuota(ctx, names, kr)
 		if err != nil {
 			return "", err
 		}
@@ -488,8 +514,8 @@ func WriteDeploymentManifestToFile(ctx context.Context, accessApi platform.Acces
 // policies like the NetworkPolicy and ResourceQuota. To be able
 // apply ResourceQuota restrictions to the AppInst, it must be
 // applied before the AppInst is deployed.
-func ApplyAppInstPolicy(ctx context.Context, client ssh.Client, names *KubeNames, app *edgeproto.App, appInst *edgeproto.AppInst, action cloudcommon.Action) error {
-	policyManifest, err := GenerateAppInstPolicyManifest(ctx, names, app, appInst)
+func ApplyAppInstPolicy(ctx context.Context, client ssh.Client, names *KubeNames, app *edgeproto.App, appInst *edgeproto.AppInst, action cloudcommon.Action, ops ...AppInstOp) error {
+	policyManifest, err := GenerateAppInstPolicyManifest(ctx, names, app, appInst, ops...)
 	if err != nil {
 		return err
 	}
@@ -515,6 +541,30 @@ func ApplyAppInstPolicy(ctx context.Context, client ssh.Client, names *KubeNames
 	return nil
 }
 
+// UpdateAppInstTrustPolicy updates the AppInst's policies after the
+// cloudlet's trust policy changed. The trust policy may be empty if
+// it was removed from the cloudlet.
+func UpdateAppInstTrustPolicy(ctx context.Context, client ssh.Client, names *KubeNames, app *edgeproto.App, appInst *edgeproto.AppInst, trustPolicy *edgeproto.TrustPolicy) error {
+	policyManifest, err := GenerateAppInstPolicyManifest(ctx, names, app, appInst, WithTrustPolicy(trustPolicy))
+	if err != nil {
+		return err
+	}
+	if policyManifest != "" {
+		return ApplyAppInstPolicy(ctx, client, names, app, appInst, cloudcommon.Create, WithTrustPolicy(trustPolicy))
+	}
+	// no policies apply anymore, remove the network policy that
+	// was added for a previous trust policy.
+	for _, ns := range GetNetworkPolicyNamespaces(names) {
+		cmd := fmt.Sprintf("kubectl %s delete networkpolicy %s -n %s --ignore-not-found=true", names.GetTenantKconfArg(), GetNetworkPolicyName(names), ns)
+		log.SpanLog(ctx, log.DebugLevelInfra, "removing trust policy network policy", "cmd", cmd)
+		out, err := client.Output(cmd)
+		if err != nil {
+			return fmt.Errorf("failed to remove network policy %q: %s, %s", cmd, out, err)
+		}
+	}
+	return CleanupManifest(ctx, client, names, appInst, PolicyManifestSuffix)
+}
+
 func createOrUpdateAppInst(ctx context.Context, accessApi platform.AccessApi, client ssh.Client, names *KubeNames, clusterInst *edgeproto.ClusterInst, app *edgeproto.App, appInst *edgeproto.AppInst, action string, ops ...AppInstOp) (reterr error) {
 	opts := GetAppInstOptions(ops)
 	if action == createManifest && names.InstanceNamespace != "" {
@@ -523,7 +573,7 @@ func createOrUpdateAppInst(ctx context.Context, accessApi platform.AccessApi, cl
 			return err
 		}
 	}
-	if err := ApplyAppInstPolicy(ctx, client, names, app, appInst, cloudcommon.Create); err != nil {
+	if err := ApplyAppInstPolicy(ctx, client, names, app, appInst, cloudcommon.Create, ops...); err != nil {
 		return err
 	}
 
@@ -538,6 +588,9 @@ func CreateAppInst(ctx context.Context, accessApi platform.AccessApi, client ssh
 }
 
 func UpdateAppInst(ctx context.Context, accessApi platform.AccessApi, client ssh.Client, names *KubeNames, clusterInst *edgeproto.ClusterInst, app *edgeproto.App, appInst *edgeproto.AppInst, ops ...AppInstOp) error {
+	if cloudcommon.GetUpdateStrategy(app) != edgeproto.UpdateStrategy_UPDATE_IN_PLACE {
+		return RolloutAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, ops...)
+	}
 	return createOrUpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, applyManifest, ops...)
 }
 
@@ -547,7 +600,7 @@ func DeleteAppInst(ctx context.Context, accessApi platform.AccessApi, client ssh
 	if err != nil {
 		return err
 	}
-	err = ApplyAppInstPolicy(ctx, client, names, app, appInst, cloudcommon.Delete)
+	err = ApplyAppInstPolicy(ctx, client, names, app, appInst, cloudcommon.Delete, ops...)
 	if err != nil {
 		return err
 	}
@@ -579,20 +632,27 @@ func GetAppInstRuntime(ctx context.Context, client ssh.Client, names *KubeNames,
 		return nil, err
 	}
 	kconfArg := names.GetTenantKconfArg()
+	var hpaTargets map[hpaTarget]struct{}
+	if appInst.HorizontalScalePolicy != nil {
+		hpaTargets, _ = getHPATargets(objs)
+	}
 	var name string
 	for ii, _ := range objs {
 		name = ""
 		namespace := ""
+		var target hpaTarget
 		switch obj := objs[ii].(type) {
 		case *appsv1.Deployment:
 			name = obj.ObjectMeta.Name
 			namespace = obj.ObjectMeta.Namespace
+			target = hpaTarget{kind: "Deployment", name: name}
 		case *appsv1.DaemonSet:
 			name = obj.ObjectMeta.Name
 			namespace = obj.ObjectMeta.Namespace
 		case *appsv1.StatefulSet:
 			name = obj.ObjectMeta.Name
 			namespace = obj.ObjectMeta.Namespace
+			target = hpaTarget{kind: "StatefulSet", name: name}
 		}
 		if name == "" {
 			continue
@@ -638,6 +698,17 @@ func GetAppInstRuntime(ctx context.Context, client ssh.Client, names *KubeNames,
 				rt.ContainerIds = append(rt.ContainerIds, namespace+"/"+podName+"/"+contName)
 			}
 		}
+		if _, found := hpaTargets[target]; found {
+			// replica counts are informational, so failing to
+			// read them should not fail the runtime info
+			cur, desired, err := getHPAReplicas(ctx, client, kconfArg, namespace, target)
+			if err != nil {
+				log.SpanLog(ctx, log.DebugLevelInfra, "failed to get hpa replicas", "target", target.name, "err", err)
+				continue
+			}
+			rt.Replicas += uint32(cur)
+			rt.DesiredReplicas += uint32(desired)
+		}
 	}
 
 	return rt, nil
@@ -715,5 +786,17 @@ func GetContainerCommand(ctx context.Context, clusterInst *edgeproto.ClusterInst
 		}
 		return cmdStr, nil
 	}
-	return "", fmt.Errorf("no command or log specified with the exec request")
+	if req.CopyFile != nil {
+		// no tty so binary data is passed through unmodified
+		cmdStr := fmt.Sprintf("kubectl %s exec -n %s ", kconfArg, namespace)
+		if req.CopyFile.Upload {
+			cmdStr += "-i "
+		}
+		if containerName != "" {
+			cmdStr += fmt.Sprintf("-c %s ", containerName)
+		}
+		cmdStr += podName + " -- " + shellquote.Join(cloudcommon.GetCopyFileCommand(req.CopyFile)...)
+		return cmdStr, nil
+	}
+	return "", fmt.Errorf("no command, log, or copy file specified with the exec request")
 }
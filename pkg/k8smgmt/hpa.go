@@ -0,0 +1,205 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8smgmt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/promutils"
+	ssh "github.com/edgexr/golang-ssh"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The active connections metric is an External metric. It requires
+// an external metrics adapter (i.e. prometheus-adapter) in the
+// cluster that serves the load balancer's active connections
+// metric, labeled by the AppKey tags. The platform does not deploy
+// the adapter, so it must be installed in the cluster by the
+// operator. Without it, the autoscaler reports the metric as
+// unavailable and only scales on the cpu and memory targets.
+const HPAActiveConnectionsMetric = promutils.PromQConnections
+
+// hpaTarget identifies a scalable object targeted by an HPA
+type hpaTarget struct {
+	kind string
+	name string
+}
+
+// getHPATargets finds the objects in the manifest that will be
+// managed by a horizontal pod autoscaler. Objects that are already
+// targeted by an autoscaler in the manifest are skipped, as they
+// are managed by the developer.
+func getHPATargets(objs []runtime.Object) (map[hpaTarget]struct{}, []hpaTarget) {
+	existing := map[hpaTarget]struct{}{}
+	for _, obj := range objs {
+		switch hpa := obj.(type) {
+		case *autoscalingv1.HorizontalPodAutoscaler:
+			ref := hpa.Spec.ScaleTargetRef
+			existing[hpaTarget{kind: ref.Kind, name: ref.Name}] = struct{}{}
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			ref := hpa.Spec.ScaleTargetRef
+			existing[hpaTarget{kind: ref.Kind, name: ref.Name}] = struct{}{}
+		}
+	}
+	targetSet := map[hpaTarget]struct{}{}
+	targets := []hpaTarget{}
+	for _, obj := range objs {
+		var target hpaTarget
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			target = hpaTarget{kind: "Deployment", name: o.ObjectMeta.Name}
+		case *appsv1.StatefulSet:
+			target = hpaTarget{kind: "StatefulSet", name: o.ObjectMeta.Name}
+		default:
+			continue
+		}
+		if _, found := existing[target]; found {
+			continue
+		}
+		targetSet[target] = struct{}{}
+		targets = append(targets, target)
+	}
+	return targetSet, targets
+}
+
+// getHPAName gets the name of the autoscaler for the target object
+func getHPAName(target hpaTarget) string {
+	return target.name
+}
+
+// newHorizontalPodAutoscaler builds the autoscaler for the target
+// object from the AppInst's horizontal scale policy.
+func newHorizontalPodAutoscaler(appInst *edgeproto.AppInst, target hpaTarget) *autoscalingv2.HorizontalPodAutoscaler {
+	policy := appInst.HorizontalScalePolicy
+	minReplicas := int32(policy.MinReplicas)
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "autoscaling/v2",
+			Kind:       "HorizontalPodAutoscaler",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: getHPAName(target),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       target.kind,
+				Name:       target.name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: int32(policy.MaxReplicas),
+		},
+	}
+	if policy.TargetCpu > 0 {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, getHPAResourceMetric(v1.ResourceCPU, policy.TargetCpu))
+	}
+	if policy.TargetMem > 0 {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, getHPAResourceMetric(v1.ResourceMemory, policy.TargetMem))
+	}
+	if policy.TargetActiveConnections > 0 {
+		avg := resource.NewQuantity(int64(policy.TargetActiveConnections), resource.DecimalSI)
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: HPAActiveConnectionsMetric,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							edgeproto.AppKeyTagName:         appInst.AppKey.Name,
+							edgeproto.AppKeyTagVersion:      appInst.AppKey.Version,
+							edgeproto.AppKeyTagOrganization: appInst.AppKey.Organization,
+						},
+					},
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: avg,
+				},
+			},
+		})
+	}
+	if policy.StabilizationWindowSec > 0 {
+		window := int32(policy.StabilizationWindowSec)
+		hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: &window,
+			},
+		}
+	}
+	return hpa
+}
+
+func getHPAResourceMetric(name v1.ResourceName, utilization uint32) autoscalingv2.MetricSpec {
+	avg := int32(utilization)
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &avg,
+			},
+		},
+	}
+}
+
+// addHPARequests sets resource requests on the pod containers from
+// the per-replica Kubernetes resources, if not already set, as
+// utilization targets are calculated against the requests.
+func addHPARequests(template *v1.PodTemplateSpec, kr *edgeproto.KubernetesResources) error {
+	res, err := getKubernetesResourceQuants(kr)
+	if err != nil {
+		return err
+	}
+	for j, _ := range template.Spec.Containers {
+		resources := &template.Spec.Containers[j].Resources
+		if resources.Requests == nil {
+			resources.Requests = v1.ResourceList{}
+		}
+		if _, found := resources.Requests[v1.ResourceCPU]; !found {
+			resources.Requests[v1.ResourceCPU] = res.VCPUs
+		}
+		if _, found := resources.Requests[v1.ResourceMemory]; !found {
+			resources.Requests[v1.ResourceMemory] = res.Memory
+		}
+	}
+	return nil
+}
+
+// getHPAReplicas gets the current and desired replicas from
+// the autoscaler for the target object.
+func getHPAReplicas(ctx context.Context, client ssh.Client, kconfArg, namespace string, target hpaTarget) (int32, int32, error) {
+	cmd := fmt.Sprintf("kubectl %s get hpa %s -n %s -o json", kconfArg, getHPAName(target), namespace)
+	out, err := client.Output(cmd)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting horizontal pod autoscaler, %s, %s, %s", cmd, out, err)
+	}
+	hpa := autoscalingv2.HorizontalPodAutoscaler{}
+	if err := json.Unmarshal([]byte(out), &hpa); err != nil {
+		return 0, 0, fmt.Errorf("failed to unmarshal horizontal pod autoscaler json, %s", err)
+	}
+	log.SpanLog(ctx, log.DebugLevelInfra, "hpa replicas", "target", target.name, "current", hpa.Status.CurrentReplicas, "desired", hpa.Status.DesiredReplicas)
+	return hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas, nil
+}
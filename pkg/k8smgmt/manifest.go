@@ -331,6 +331,16 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 		objs = append(objs, appSecretVars)
 	}
 
+	// add autoscalers before walking the objects so they get labeled
+	var hpaTargets map[hpaTarget]struct{}
+	if appInst.HorizontalScalePolicy != nil {
+		var targets []hpaTarget
+		hpaTargets, targets = getHPATargets(objs)
+		for _, target := range targets {
+			objs = append(objs, newHorizontalPodAutoscaler(appInst, target))
+		}
+	}
+
 	//walk the objects
 	var template *v1.PodTemplateSpec
 	var name string
@@ -354,18 +364,30 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 
 		template = nil
 		name = ""
+		autoscaled := false
 		switch obj := objs[i].(type) {
 		case *appsv1.Deployment:
 			template = &obj.Spec.Template
 			name = obj.ObjectMeta.Name
-			obj.Spec.Replicas = getDefaultReplicas(app, names, *obj.Spec.Replicas)
+			_, autoscaled = hpaTargets[hpaTarget{kind: "Deployment", name: name}]
+			if autoscaled {
+				// replicas are managed by the autoscaler
+				obj.Spec.Replicas = nil
+			} else {
+				obj.Spec.Replicas = getDefaultReplicas(app, names, obj.Spec.Replicas)
+			}
 		case *appsv1.DaemonSet:
 			template = &obj.Spec.Template
 			name = obj.ObjectMeta.Name
 		case *appsv1.StatefulSet:
 			template = &obj.Spec.Template
 			name = obj.ObjectMeta.Name
-			obj.Spec.Replicas = getDefaultReplicas(app, names, *obj.Spec.Replicas)
+			_, autoscaled = hpaTargets[hpaTarget{kind: "StatefulSet", name: name}]
+			if autoscaled {
+				obj.Spec.Replicas = nil
+			} else {
+				obj.Spec.Replicas = getDefaultReplicas(app, names, obj.Spec.Replicas)
+			}
 		}
 		if template == nil {
 			continue
@@ -378,10 +400,22 @@ func MergeEnvVars(ctx context.Context, accessApi platform.AccessApi, app *edgepr
 			addImagePullSecret(ctx, template, imagePullSecrets)
 		}
 		if names.MultiTenantRestricted && appInst.KubernetesResources != nil {
+			// limits are per replica, the namespace resource quota
+			// allows for the maximum number of replicas
 			if err := addResourceLimits(template, appInst.KubernetesResources); err != nil {
 				return "", err
 			}
 		}
+		if autoscaled && !names.MultiTenantRestricted && kr != nil {
+			// utilization targets require requests. Just set them for
+			// manifests generated by our deployment generator, custom
+			// manifests must specify their own requests.
+			if _, ok := template.ObjectMeta.Labels[deploygen.MexDeployGenLabel]; ok {
+				if err := addHPARequests(template, kr); err != nil {
+					return "", err
+				}
+			}
+		}
 		gpuCount := cloudcommon.KuberentesResourcesGPUCount(kr)
 		if gpuCount > 0 {
 			// just set GPU resource limit for manifest generated by our
@@ -424,10 +458,10 @@ func AddManifest(mf, addmf string) string {
 	return mf + "---\n" + addmf
 }
 
-func getDefaultReplicas(app *edgeproto.App, names *KubeNames, curReplica int32) *int32 {
+func getDefaultReplicas(app *edgeproto.App, names *KubeNames, curReplica *int32) *int32 {
 	val := int32(1)
-	if curReplica != 0 {
-		val = curReplica
+	if curReplica != nil && *curReplica != 0 {
+		val = *curReplica
 	}
 	if names.InstanceNamespace != "" && app.ServerlessConfig != nil {
 		val = int32(app.ServerlessConfig.MinReplicas)
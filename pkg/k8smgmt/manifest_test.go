@@ -25,6 +25,8 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 	"github.com/edgexr/edge-cloud-platform/test/testutil"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 )
 
 func TestGenerateAppInstManifest(t *testing.T) {
@@ -80,6 +82,17 @@ func TestGenerateAppInstManifest(t *testing.T) {
 		fmt.Println(mf)
 	}
 	require.Equal(t, expectedPolicyManifest, mf)
+
+	// resource quota covers the maximum replicas
+	appInst.HorizontalScalePolicy = &edgeproto.HorizontalScalePolicy{
+		MinReplicas: 1,
+		MaxReplicas: 3,
+		TargetCpu:   50,
+	}
+	mf, err = GenerateAppInstPolicyManifest(ctx, names, app, appInst)
+	require.Nil(t, err)
+	require.Contains(t, mf, "limits.cpu: \"3\"\n    limits.memory: 3Gi\n")
+	require.Contains(t, expectedPolicyManifest, "limits.cpu: \"1\"\n    limits.memory: 1Gi\n")
 }
 
 var expectedFullManifest = `apiVersion: v1
@@ -549,3 +562,89 @@ func TestNames(t *testing.T) {
 	_, err := GetKubeNames(&edgeproto.ClusterInst{}, &edgeproto.App{}, &edgeproto.AppInst{})
 	require.Nil(t, err)
 }
+
+func TestHorizontalScalePolicy(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd | log.DebugLevelApi | log.DebugLevelNotify)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	appInst := &testutil.AppInstData()[0]
+	app := &testutil.AppData()[0]
+	app.Deployment = cloudcommon.DeploymentTypeKubernetes
+	baseMf, err := cloudcommon.GetAppDeploymentManifest(ctx, nil, app)
+	require.Nil(t, err)
+	app.DeploymentManifest = baseMf
+
+	kr := &edgeproto.KubernetesResources{
+		CpuPool: &edgeproto.NodePoolResources{
+			TotalVcpus:  *edgeproto.NewUdec64(0, 500*edgeproto.DecMillis),
+			TotalMemory: 512,
+		},
+	}
+	appInst.KubernetesResources = kr
+	appInst.HorizontalScalePolicy = &edgeproto.HorizontalScalePolicy{
+		MinReplicas:             2,
+		MaxReplicas:             5,
+		TargetCpu:               70,
+		TargetActiveConnections: 100,
+		StabilizationWindowSec:  300,
+	}
+	names, err := GetKubeNames(&edgeproto.ClusterInst{}, app, appInst)
+	require.Nil(t, err)
+
+	mf, err := MergeEnvVars(ctx, nil, app, appInst, baseMf, nil, names, kr)
+	require.Nil(t, err)
+	require.NotContains(t, mf, "status:\n  currentMetrics")
+	objs, _, err := cloudcommon.DecodeK8SYaml(mf)
+	require.Nil(t, err)
+
+	var hpas []*autoscalingv2.HorizontalPodAutoscaler
+	var deployment *appsv1.Deployment
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			hpas = append(hpas, o)
+		case *appsv1.Deployment:
+			deployment = o
+		}
+	}
+	require.NotNil(t, deployment)
+	require.Equal(t, 1, len(hpas))
+	hpa := hpas[0]
+
+	// replicas are managed by the autoscaler
+	require.Nil(t, deployment.Spec.Replicas)
+	// utilization targets require requests
+	requests := deployment.Spec.Template.Spec.Containers[0].Resources.Requests
+	require.Equal(t, "500m", requests.Cpu().String())
+	require.Equal(t, "512Mi", requests.Memory().String())
+
+	require.Equal(t, getConfigLabel(names), hpa.ObjectMeta.Labels[ConfigLabel])
+	require.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	require.Equal(t, deployment.ObjectMeta.Name, hpa.Spec.ScaleTargetRef.Name)
+	require.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	require.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	require.Equal(t, 2, len(hpa.Spec.Metrics))
+	require.Equal(t, autoscalingv2.ResourceMetricSourceType, hpa.Spec.Metrics[0].Type)
+	require.Equal(t, int32(70), *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	require.Equal(t, autoscalingv2.ExternalMetricSourceType, hpa.Spec.Metrics[1].Type)
+	external := hpa.Spec.Metrics[1].External
+	require.Equal(t, HPAActiveConnectionsMetric, external.Metric.Name)
+	require.Equal(t, app.Key.Name, external.Metric.Selector.MatchLabels[edgeproto.AppKeyTagName])
+	require.Equal(t, int64(100), external.Target.AverageValue.Value())
+	require.Equal(t, int32(300), *hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+
+	// developer-defined autoscalers are left alone
+	mf, err = MergeEnvVars(ctx, nil, app, appInst, mf, nil, names, kr)
+	require.Nil(t, err)
+	objs, _, err = cloudcommon.DecodeK8SYaml(mf)
+	require.Nil(t, err)
+	numHPAs := 0
+	for _, obj := range objs {
+		if _, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			numHPAs++
+		}
+	}
+	require.Equal(t, 1, numHPAs)
+}
@@ -18,31 +18,57 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"text/template"
 
 	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
 )
 
 type networkPolicyPort struct {
 	Protocol string
 	Port     int32
+	EndPort  int32
+}
+
+type networkPolicyIngress struct {
+	Cidrs []string
+	Ports []networkPolicyPort
+}
+
+type networkPolicyEgress struct {
+	Cidr  string
+	Ports []networkPolicyPort
 }
 
 type networkPolicyArgs struct {
-	Labels         map[string]string
-	Namespace      string
-	ConfigLabelKey string
-	ConfigLabelVal string
-	Ports          []networkPolicyPort
+	Labels           map[string]string
+	Name             string
+	Namespace        string
+	PodLabels        map[string]string
+	ClusterPeers     bool
+	ConfigLabelKey   string
+	ConfigLabelVal   string
+	Ingress          []networkPolicyIngress
+	EgressRestricted bool
+	Egress           []networkPolicyEgress
 }
 
 // This network policy blocks all ingress for the matching pods.
-// The matching pods are all pods within the namespace, due to the
-// empty podSelector.
+// For namespaced instances, the matching pods are all pods within
+// the namespace, due to the empty podSelector. Otherwise the
+// matching pods are the AppInst's pods, selected by their labels.
 // Ingress is then allowed by the "from" rules. The first "from"
-// rule allows access to any port from any pods in the same namespace.
-// The second "from" rule allows access to public ports from any source.
+// rule allows access to any port from any pods in the same namespace,
+// or for non-namespaced instances, from any pods in the cluster.
+// The remaining "from" rules allow access to public ports from any
+// source, or from the sources allowed by the trust policy's inbound
+// rules.
+// If a trust policy applies, egress is also blocked, and then allowed
+// to the same pods as ingress, to the cluster DNS, and to the
+// destinations allowed by the trust policy's outbound rules.
 var k8sNetworkPolicyTemplate = template.Must(template.New("networkpolicy").Parse(`apiVersion: networking.k8s.io/v1
 kind: NetworkPolicy
 metadata:
@@ -52,36 +78,122 @@ metadata:
     {{ $key }}: {{ $value }}
 {{- end }}
 {{- end }}
-  name: networkpolicy-{{.Namespace}}
+  name: {{.Name}}
   namespace: {{.Namespace}}
 spec:
+{{- if .PodLabels}}
+  podSelector:
+    matchLabels:
+{{- range $key, $value := .PodLabels}}
+      {{ $key }}: {{ $value }}
+{{- end}}
+{{- end}}
   ingress:
   - from:
+{{- if .ClusterPeers}}
+    - namespaceSelector: {}
+{{- else}}
     - namespaceSelector:
         matchLabels:
           name: {{.Namespace}}
-{{- if .Ports}}
+{{- end}}
+{{- range .Ingress}}
   - from:
+{{- range .Cidrs}}
+    - ipBlock:
+        cidr: {{.}}
+{{- end}}
+    ports:
+{{- range .Ports}}
+    - port: {{.Port}}
+      protocol: {{.Protocol}}
+{{- end}}
+{{- end}}
+{{- if .EgressRestricted}}
+  egress:
+  - to:
+{{- if .ClusterPeers}}
+    - namespaceSelector: {}
+{{- else}}
+    - namespaceSelector:
+        matchLabels:
+          name: {{.Namespace}}
+{{- end}}
+  - to:
+    - namespaceSelector: {}
+      podSelector:
+        matchLabels:
+          k8s-app: kube-dns
+    ports:
+    - port: 53
+      protocol: UDP
+    - port: 53
+      protocol: TCP
+{{- range .Egress}}
+  - to:
     - ipBlock:
-        cidr: 0.0.0.0/0
+        cidr: {{.Cidr}}
     ports:
 {{- range .Ports}}
     - port: {{.Port}}
+{{- if .EndPort}}
+      endPort: {{.EndPort}}
+{{- end}}
       protocol: {{.Protocol}}
 {{- end}}
+{{- end}}
+  policyTypes:
+  - Ingress
+  - Egress
 {{- end}}
 `))
 
-func GetNetworkPolicy(ctx context.Context, app *edgeproto.App, appInst *edgeproto.AppInst, names *KubeNames) (string, error) {
-	if names.InstanceNamespace == "" {
-		return "", fmt.Errorf("NetworkPolicy only valid for namespaced instances")
+// GetNetworkPolicyName gets the name of the AppInst's network policy.
+func GetNetworkPolicyName(names *KubeNames) string {
+	if names.InstanceNamespace != "" {
+		return "networkpolicy-" + names.InstanceNamespace
+	}
+	return "networkpolicy-" + names.AppInstName + "-" + names.AppInstOrg
+}
+
+// GetNetworkPolicyNamespaces gets the namespaces the AppInst's network
+// policy is applied to. Non-namespaced instances are deployed to the
+// default namespace and any namespaces defined in the manifest.
+func GetNetworkPolicyNamespaces(names *KubeNames) []string {
+	if names.InstanceNamespace != "" {
+		return []string{names.InstanceNamespace}
+	}
+	namespaces := []string{DefaultNamespace}
+	for _, ns := range names.DeveloperDefinedNamespaces {
+		if ns != DefaultNamespace {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// GetNetworkPolicy gets the network policy for the AppInst. If the
+// trust policy is specified, its security rules must have any remote
+// FQDNs already resolved to CIDRs. Non-namespaced instances only
+// need a network policy to apply a trust policy.
+func GetNetworkPolicy(ctx context.Context, app *edgeproto.App, appInst *edgeproto.AppInst, names *KubeNames, trustPolicy *edgeproto.TrustPolicy) (string, error) {
+	if names.InstanceNamespace == "" && trustPolicy == nil {
+		return "", fmt.Errorf("NetworkPolicy only valid for namespaced instances or to apply a trust policy")
 	}
 	args := networkPolicyArgs{}
-	args.Namespace = names.InstanceNamespace
+	args.Name = GetNetworkPolicyName(names)
+	if names.InstanceNamespace == "" {
+		// other AppInsts may share the namespace, so only
+		// select this AppInst's pods.
+		labels := cloudcommon.GetAppInstLabels(appInst)
+		args.PodLabels = labels.Map()
+		args.ClusterPeers = true
+	}
 	args.Labels = map[string]string{
 		ConfigLabel: getConfigLabel(names),
 	}
 
+	ports := []networkPolicyPort{}
 	for _, port := range appInst.MappedPorts {
 		npp := networkPolicyPort{}
 		if port.Proto == dme.LProto_L_PROTO_TCP || port.Proto == dme.LProto_L_PROTO_HTTP {
@@ -98,13 +210,85 @@ func GetNetworkPolicy(ctx context.Context, app *edgeproto.App, appInst *edgeprot
 		}
 		for p := port.InternalPort; p <= endport; p++ {
 			npp.Port = p
-			args.Ports = append(args.Ports, npp)
+			ports = append(ports, npp)
+		}
+	}
+	if trustPolicy == nil || len(trustPolicy.InboundSecurityRules) == 0 {
+		if len(ports) > 0 {
+			args.Ingress = append(args.Ingress, networkPolicyIngress{
+				Cidrs: []string{"0.0.0.0/0"},
+				Ports: ports,
+			})
+		}
+	} else {
+		// only allow public ports from the inbound rule sources
+		for _, rule := range trustPolicy.InboundSecurityRules {
+			if err := checkNetworkPolicyProtocol(trustPolicy, "inbound", &rule); err != nil {
+				return "", err
+			}
+			ingress := networkPolicyIngress{
+				Cidrs: []string{rule.RemoteCidr},
+			}
+			for _, npp := range ports {
+				if npp.Protocol != strings.ToUpper(rule.Protocol) {
+					continue
+				}
+				if npp.Port < int32(rule.PortRangeMin) || npp.Port > int32(rule.PortRangeMax) {
+					continue
+				}
+				ingress.Ports = append(ingress.Ports, npp)
+			}
+			if len(ingress.Ports) == 0 {
+				log.SpanLog(ctx, log.DebugLevelInfra, "no AppInst ports match inbound security rule", "rule", rule)
+				continue
+			}
+			args.Ingress = append(args.Ingress, ingress)
+		}
+	}
+	if trustPolicy != nil && trustPolicy.Key.Name != "" {
+		args.EgressRestricted = true
+		for _, rule := range trustPolicy.OutboundSecurityRules {
+			if rule.RemoteCidr == "" {
+				return "", fmt.Errorf("trust policy %s outbound rule for %s not resolved", trustPolicy.Key.Name, rule.RemoteFqdn)
+			}
+			if err := checkNetworkPolicyProtocol(trustPolicy, "outbound", &rule); err != nil {
+				return "", err
+			}
+			protocol := strings.ToUpper(rule.Protocol)
+			npp := networkPolicyPort{
+				Protocol: protocol,
+				Port:     int32(rule.PortRangeMin),
+			}
+			if rule.PortRangeMax > rule.PortRangeMin {
+				npp.EndPort = int32(rule.PortRangeMax)
+			}
+			args.Egress = append(args.Egress, networkPolicyEgress{
+				Cidr:  rule.RemoteCidr,
+				Ports: []networkPolicyPort{npp},
+			})
+		}
+	}
+	mf := ""
+	for _, ns := range GetNetworkPolicyNamespaces(names) {
+		args.Namespace = ns
+		buf := bytes.Buffer{}
+		err := k8sNetworkPolicyTemplate.Execute(&buf, &args)
+		if err != nil {
+			return "", err
 		}
+		mf = AddManifest(mf, buf.String())
 	}
-	buf := bytes.Buffer{}
-	err := k8sNetworkPolicyTemplate.Execute(&buf, &args)
-	if err != nil {
-		return "", err
+	return mf, nil
+}
+
+// checkNetworkPolicyProtocol checks that the security rule can be
+// applied by a network policy. Network policies only support TCP and
+// UDP, so rather than silently dropping ICMP rules, which would block
+// ICMP that the trust policy allows, the trust policy is rejected.
+func checkNetworkPolicyProtocol(trustPolicy *edgeproto.TrustPolicy, direction string, rule *edgeproto.SecurityRule) error {
+	protocol := strings.ToUpper(rule.Protocol)
+	if protocol == "TCP" || protocol == "UDP" {
+		return nil
 	}
-	return buf.String(), nil
+	return fmt.Errorf("trust policy %s %s rule protocol %s is not supported for Kubernetes applications, only TCP and UDP are supported", trustPolicy.Key.Name, direction, rule.Protocol)
 }
@@ -16,6 +16,7 @@ package k8smgmt
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
@@ -186,12 +187,208 @@ spec:
     - port: 51009
       protocol: TCP
 `)
+
+	// trust policy restricts ingress to inbound rule sources,
+	// and egress to outbound rule destinations
+	appInst.MappedPorts = appInst.MappedPorts[:3]
+	trustPolicy := &edgeproto.TrustPolicy{
+		Key: edgeproto.PolicyKey{
+			Name:         "trust",
+			Organization: "operorg",
+		},
+		InboundSecurityRules: []edgeproto.SecurityRule{{
+			Protocol:     "TCP",
+			PortRangeMin: 1,
+			PortRangeMax: 500,
+			RemoteCidr:   "10.10.0.0/16",
+		}, {
+			Protocol:     "UDP",
+			PortRangeMin: 10000,
+			PortRangeMax: 11000,
+			RemoteCidr:   "192.168.1.0/24",
+		}, {
+			// no matching ports
+			Protocol:     "UDP",
+			PortRangeMin: 1,
+			PortRangeMax: 100,
+			RemoteCidr:   "192.168.2.0/24",
+		}},
+		OutboundSecurityRules: []edgeproto.SecurityRule{{
+			Protocol:     "TCP",
+			PortRangeMin: 443,
+			PortRangeMax: 443,
+			RemoteCidr:   "35.1.1.1/32",
+		}, {
+			Protocol:     "UDP",
+			PortRangeMin: 5000,
+			PortRangeMax: 5100,
+			RemoteCidr:   "35.2.0.0/16",
+		}},
+	}
+	names, err := GetKubeNames(&ci, &app, &appInst)
+	require.Nil(t, err)
+	mf, err := GetNetworkPolicy(ctx, &app, &appInst, names, trustPolicy)
+	require.Nil(t, err)
+	require.Equal(t, `apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  labels:
+    config: appinst1-devorg
+  name: networkpolicy-appinst1-devorg
+  namespace: appinst1-devorg
+spec:
+  ingress:
+  - from:
+    - namespaceSelector:
+        matchLabels:
+          name: appinst1-devorg
+  - from:
+    - ipBlock:
+        cidr: 10.10.0.0/16
+    ports:
+    - port: 443
+      protocol: TCP
+  - from:
+    - ipBlock:
+        cidr: 192.168.1.0/24
+    ports:
+    - port: 10101
+      protocol: UDP
+  egress:
+  - to:
+    - namespaceSelector:
+        matchLabels:
+          name: appinst1-devorg
+  - to:
+    - namespaceSelector: {}
+      podSelector:
+        matchLabels:
+          k8s-app: kube-dns
+    ports:
+    - port: 53
+      protocol: UDP
+    - port: 53
+      protocol: TCP
+  - to:
+    - ipBlock:
+        cidr: 35.1.1.1/32
+    ports:
+    - port: 443
+      protocol: TCP
+  - to:
+    - ipBlock:
+        cidr: 35.2.0.0/16
+    ports:
+    - port: 5000
+      endPort: 5100
+      protocol: UDP
+  policyTypes:
+  - Ingress
+  - Egress
+`, mf)
+
+	// icmp is not supported by network policies
+	icmpRule := edgeproto.SecurityRule{
+		Protocol:   "ICMP",
+		RemoteCidr: "0.0.0.0/0",
+	}
+	trustPolicy.OutboundSecurityRules = append(trustPolicy.OutboundSecurityRules, icmpRule)
+	_, err = GetNetworkPolicy(ctx, &app, &appInst, names, trustPolicy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "trust policy trust outbound rule protocol ICMP is not supported")
+	trustPolicy.OutboundSecurityRules = trustPolicy.OutboundSecurityRules[:2]
+	trustPolicy.InboundSecurityRules = append(trustPolicy.InboundSecurityRules, icmpRule)
+	_, err = GetNetworkPolicy(ctx, &app, &appInst, names, trustPolicy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "trust policy trust inbound rule protocol ICMP is not supported")
+	trustPolicy.InboundSecurityRules = trustPolicy.InboundSecurityRules[:3]
+
+	// unresolved FQDNs are an error
+	trustPolicy.OutboundSecurityRules[0].RemoteCidr = ""
+	trustPolicy.OutboundSecurityRules[0].RemoteFqdn = "api.example.com"
+	_, err = GetNetworkPolicy(ctx, &app, &appInst, names, trustPolicy)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "outbound rule for api.example.com not resolved")
+
+	// trust policy applies to namespaced instances in dedicated clusters
+	ci.MultiTenant = false
+	app.CompatibilityVersion = cloudcommon.GetAppCompatibilityVersion()
+	names, err = GetKubeNames(&ci, &app, &appInst)
+	require.Nil(t, err)
+	mf, err = GenerateAppInstPolicyManifest(ctx, names, &app, &appInst)
+	require.Nil(t, err)
+	require.Equal(t, "", mf)
+	trustPolicy.OutboundSecurityRules = trustPolicy.OutboundSecurityRules[1:]
+	mf, err = GenerateAppInstPolicyManifest(ctx, names, &app, &appInst, WithTrustPolicy(trustPolicy))
+	require.Nil(t, err)
+	require.Contains(t, mf, "cidr: 35.2.0.0/16")
+
+	// trust policy applies to non-namespaced instances in dedicated
+	// clusters by selecting the AppInst's pods
+	app.ManagesOwnNamespaces = true
+	names, err = GetKubeNames(&ci, &app, &appInst)
+	require.Nil(t, err)
+	require.Equal(t, "", names.InstanceNamespace)
+	names.DeveloperDefinedNamespaces = []string{"ns1"}
+	mf, err = GenerateAppInstPolicyManifest(ctx, names, &app, &appInst)
+	require.Nil(t, err)
+	require.Equal(t, "", mf)
+	trustPolicy.InboundSecurityRules = trustPolicy.InboundSecurityRules[:1]
+	trustPolicy.OutboundSecurityRules[0].RemoteCidr = "35.2.0.0/16"
+	mf, err = GenerateAppInstPolicyManifest(ctx, names, &app, &appInst, WithTrustPolicy(trustPolicy))
+	require.Nil(t, err)
+	npDefault := `apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  labels:
+    config: defaultmtclust-d53d1223edgecloudorg
+  name: networkpolicy-appinst1-devorg
+  namespace: default
+spec:
+  podSelector:
+    matchLabels:
+      mexAppInstName: appInst1
+      mexAppInstOrg: devorg
+  ingress:
+  - from:
+    - namespaceSelector: {}
+  - from:
+    - ipBlock:
+        cidr: 10.10.0.0/16
+    ports:
+    - port: 443
+      protocol: TCP
+  egress:
+  - to:
+    - namespaceSelector: {}
+  - to:
+    - namespaceSelector: {}
+      podSelector:
+        matchLabels:
+          k8s-app: kube-dns
+    ports:
+    - port: 53
+      protocol: UDP
+    - port: 53
+      protocol: TCP
+  - to:
+    - ipBlock:
+        cidr: 35.2.0.0/16
+    ports:
+    - port: 5000
+      endPort: 5100
+      protocol: UDP
+  policyTypes:
+  - Ingress
+  - Egress
+`
+	require.Equal(t, npDefault+"---\n"+strings.ReplaceAll(npDefault, "namespace: default", "namespace: ns1"), mf)
 }
 
 func testGetNetworkPolicy(t *testing.T, ctx context.Context, app *edgeproto.App, clusterInst *edgeproto.ClusterInst, appInst *edgeproto.AppInst, expectedErr string, expectedMF string) {
 	names, err := GetKubeNames(clusterInst, app, appInst)
 	require.Nil(t, err)
-	mf, err := GetNetworkPolicy(ctx, app, appInst, names)
+	mf, err := GetNetworkPolicy(ctx, app, appInst, names, nil)
 	if expectedErr != "" {
 		require.NotNil(t, err)
 		require.Contains(t, err.Error(), expectedErr)
@@ -0,0 +1,491 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8smgmt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
+	ssh "github.com/edgexr/golang-ssh"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RolloutSuffix is appended to the names of the candidate Deployments
+// that run the new version alongside the old during a rollout. For
+// blue/green it is also appended to the names of the candidate
+// services, and to the candidate's selector label values so that the
+// existing services do not select the candidate.
+const RolloutSuffix = "-rollout"
+
+// RolloutLabel marks candidate objects created during a rollout.
+const RolloutLabel = "rollout"
+const RolloutLabelCandidate = "candidate"
+
+const rolloutCandidateManifestSuffix = "-candidate"
+const rolloutPromoteManifestSuffix = "-promote"
+
+// RolloutHooks are provided by the platform to switch traffic on the
+// rootLB proxy and to check the AppInst's health during a rollout.
+type RolloutHooks struct {
+	// SetProxyBackends reconfigures the rootLB proxy for the AppInst
+	// to forward to the given load balancer IPs, keyed by internal
+	// port string as in AppInst.InternalPortToLbIp.
+	SetProxyBackends func(ctx context.Context, lbIPs map[string]string) error
+	// GetHealthCheck gets the AppInst's current HealthCheck state.
+	GetHealthCheck func(ctx context.Context) (dme.HealthCheck, error)
+}
+
+// WithRolloutHooks sets the hooks required for blue/green and
+// canary rollouts.
+func WithRolloutHooks(hooks *RolloutHooks) AppInstOp {
+	return func(opts *AppInstOptions) {
+		opts.RolloutHooks = hooks
+	}
+}
+
+// RolloutHealthCheckInterval is how often the AppInst's HealthCheck
+// state is checked during a rollout.
+var RolloutHealthCheckInterval = 5 * time.Second
+
+// rolloutCandidate tracks a Deployment being rolled out and the
+// candidate copy that runs the new version.
+type rolloutCandidate struct {
+	orig      *appsv1.Deployment
+	candidate *appsv1.Deployment
+}
+
+// getRolloutCandidates builds the candidate Deployments for the
+// Deployments in the new manifest. For canary, the candidate keeps the
+// pod labels so that the services split traffic between the old and new
+// versions, and runs a percentage of the replicas. For blue/green, the
+// candidate runs the full set of replicas but its selector labels are
+// changed so the existing services do not select it. Traffic is sent
+// to it via candidate services instead, see getRolloutServices.
+func getRolloutCandidates(objs []runtime.Object, app *edgeproto.App) ([]*rolloutCandidate, error) {
+	strategy := cloudcommon.GetUpdateStrategy(app)
+	candidates := []*rolloutCandidate{}
+	for _, obj := range objs {
+		dep, ok := obj.(*appsv1.Deployment)
+		if !ok {
+			continue
+		}
+		rc := &rolloutCandidate{
+			orig:      dep,
+			candidate: dep.DeepCopy(),
+		}
+		cand := rc.candidate
+		cand.Name = dep.Name + RolloutSuffix
+		if cand.Labels == nil {
+			cand.Labels = map[string]string{}
+		}
+		// candidate is not part of the config dir, it must
+		// not be pruned or it will be removed by the promote.
+		delete(cand.Labels, ConfigLabel)
+		cand.Labels[RolloutLabel] = RolloutLabelCandidate
+
+		replicas := int32(1)
+		if dep.Spec.Replicas != nil {
+			replicas = *dep.Spec.Replicas
+		}
+		switch strategy {
+		case edgeproto.UpdateStrategy_UPDATE_CANARY:
+			percent := int32(app.RolloutPolicy.CanaryPercent)
+			replicas = (replicas*percent + 99) / 100
+			if replicas < 1 {
+				replicas = 1
+			}
+			// Services select the candidate's pods as well as the
+			// original's, but the candidate's selector must not
+			// match the original deployment's pods.
+			if cand.Spec.Selector == nil {
+				return nil, fmt.Errorf("deployment %s has no selector, cannot use canary update", dep.Name)
+			}
+			if cand.Spec.Selector.MatchLabels == nil {
+				cand.Spec.Selector.MatchLabels = map[string]string{}
+			}
+			if cand.Spec.Template.Labels == nil {
+				cand.Spec.Template.Labels = map[string]string{}
+			}
+			cand.Spec.Selector.MatchLabels[RolloutLabel] = RolloutLabelCandidate
+			cand.Spec.Template.Labels[RolloutLabel] = RolloutLabelCandidate
+		case edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN:
+			if dep.Spec.Selector == nil || len(dep.Spec.Selector.MatchLabels) == 0 {
+				return nil, fmt.Errorf("deployment %s has no selector match labels, cannot use blue/green update", dep.Name)
+			}
+			cand.Spec.Selector.MatchLabels = map[string]string{}
+			if cand.Spec.Template.Labels == nil {
+				cand.Spec.Template.Labels = map[string]string{}
+			}
+			for k, v := range dep.Spec.Selector.MatchLabels {
+				cand.Spec.Selector.MatchLabels[k] = v + RolloutSuffix
+				cand.Spec.Template.Labels[k] = v + RolloutSuffix
+			}
+		}
+		cand.Spec.Replicas = &replicas
+		candidates = append(candidates, rc)
+	}
+	return candidates, nil
+}
+
+// getRolloutServices builds the candidate services for blue/green.
+// Each load balancer service that selects an original Deployment's
+// pods is copied to a candidate service that selects the candidate's
+// pods instead. The candidate services get their own load balancer
+// IPs, which the rootLB proxy is switched to.
+func getRolloutServices(objs []runtime.Object, candidates []*rolloutCandidate) []*v1.Service {
+	services := []*v1.Service{}
+	for _, obj := range objs {
+		svc, ok := obj.(*v1.Service)
+		if !ok || svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+			continue
+		}
+		for _, rc := range candidates {
+			if !selectsPods(svc.Spec.Selector, rc.orig.Spec.Template.Labels) {
+				continue
+			}
+			cand := svc.DeepCopy()
+			cand.Name = svc.Name + RolloutSuffix
+			if cand.Labels == nil {
+				cand.Labels = map[string]string{}
+			}
+			delete(cand.Labels, ConfigLabel)
+			cand.Labels[RolloutLabel] = RolloutLabelCandidate
+			cand.Spec.Selector = map[string]string{}
+			for k := range svc.Spec.Selector {
+				cand.Spec.Selector[k] = rc.candidate.Spec.Template.Labels[k]
+			}
+			// load balancer IPs are assigned to the candidate
+			cand.Spec.LoadBalancerIP = ""
+			cand.Spec.ExternalIPs = nil
+			services = append(services, cand)
+			break
+		}
+	}
+	return services
+}
+
+func selectsPods(selector, podLabels map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for k, v := range selector {
+		if podLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// getRolloutServiceIPs waits for the candidate services to be assigned
+// load balancer IPs, and returns the proxy backends for the AppInst's
+// ports that point to them.
+func getRolloutServiceIPs(ctx context.Context, client ssh.Client, names *KubeNames, appInst *edgeproto.AppInst, services []*v1.Service, timeout time.Duration) (map[string]string, error) {
+	start := time.Now()
+	for {
+		lbIPs := map[string]string{}
+		for portString, ip := range appInst.InternalPortToLbIp {
+			lbIPs[portString] = ip
+		}
+		missing := ""
+		for _, cand := range services {
+			svc := v1.Service{}
+			err := GetObject(ctx, client, names.GetKConfNames(), "svc", cand.Name, &svc, WithObjectNamespace(cand.Namespace))
+			if err != nil {
+				return nil, err
+			}
+			lbip := ""
+			for _, ing := range svc.Status.LoadBalancer.Ingress {
+				if ing.IP != "" {
+					lbip = ing.IP
+					break
+				}
+			}
+			if lbip == "" {
+				missing = svc.Name
+				break
+			}
+			for _, p := range svc.Spec.Ports {
+				lbIPs[LbServicePortToString(&p)] = lbip
+			}
+		}
+		if missing == "" {
+			return lbIPs, nil
+		}
+		if time.Since(start) >= timeout {
+			return nil, fmt.Errorf("timed out waiting for load balancer IP for service %s", missing)
+		}
+		log.SpanLog(ctx, log.DebugLevelInfra, "waiting for rollout service load balancer IP", "service", missing)
+		time.Sleep(time.Second)
+	}
+}
+
+// waitRolloutHealthy waits for the AppInst to report a healthy
+// HealthCheck state, and then requires it to stay healthy for the
+// observation time.
+func waitRolloutHealthy(ctx context.Context, hooks *RolloutHooks, timeout, observe time.Duration) error {
+	start := time.Now()
+	var healthySince time.Time
+	for {
+		hc, err := hooks.GetHealthCheck(ctx)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if hc == dme.HealthCheck_HEALTH_CHECK_OK {
+			if healthySince.IsZero() {
+				healthySince = now
+			}
+			if now.Sub(healthySince) >= observe {
+				return nil
+			}
+		} else if !healthySince.IsZero() {
+			return fmt.Errorf("health check failed after %s, health check is %s", now.Sub(healthySince).Round(time.Second), dme.HealthCheck_CamelName[int32(hc)])
+		} else if now.Sub(start) >= timeout {
+			return fmt.Errorf("not healthy after %s, health check is %s", timeout, dme.HealthCheck_CamelName[int32(hc)])
+		}
+		log.SpanLog(ctx, log.DebugLevelInfra, "rollout waiting for AppInst health", "healthCheck", hc, "healthySince", healthySince)
+		time.Sleep(RolloutHealthCheckInterval)
+	}
+}
+
+func getRolloutDirName(names *KubeNames) string {
+	// Must not be the config dir, otherwise the candidates would be
+	// applied and pruned along with the rest of the AppInsts.
+	return GetConfigDirName(names) + RolloutSuffix
+}
+
+func writeRolloutManifest(ctx context.Context, client ssh.Client, names *KubeNames, appInst *edgeproto.AppInst, suffix string, objs []runtime.Object) (string, error) {
+	contents, err := cloudcommon.EncodeK8SYaml(objs)
+	if err != nil {
+		return "", err
+	}
+	dir := getRolloutDirName(names)
+	err = pc.CreateDir(ctx, client, dir, pc.NoOverwrite, pc.NoSudo)
+	if err != nil {
+		return "", err
+	}
+	file := dir + "/" + names.AppInstName + names.AppInstOrg + suffix + ".yaml"
+	log.SpanLog(ctx, log.DebugLevelInfra, "writing rollout manifest file", "file", file, "contents", contents)
+	err = pc.WriteFile(client, file, contents, "rollout manifest", pc.NoSudo)
+	if err != nil {
+		return "", err
+	}
+	return file, nil
+}
+
+func cleanupRolloutManifests(ctx context.Context, client ssh.Client, files ...string) {
+	for _, file := range files {
+		if err := pc.DeleteFile(client, file, pc.NoSudo); err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfra, "failed to delete rollout manifest", "file", file, "err", err)
+		}
+	}
+}
+
+func getNamespaceArg(namespace string) string {
+	if namespace == "" {
+		return ""
+	}
+	return " -n " + namespace
+}
+
+func runRolloutCmd(ctx context.Context, client ssh.Client, cmd string) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "running rollout command", "cmd", cmd)
+	out, err := client.Output(cmd)
+	if err != nil {
+		return fmt.Errorf("error running %q: %s, %v", cmd, out, err)
+	}
+	return nil
+}
+
+func waitDeploymentRollout(ctx context.Context, client ssh.Client, names *KubeNames, dep *appsv1.Deployment, timeout time.Duration) error {
+	cmd := fmt.Sprintf("kubectl %s rollout status deployment/%s%s --timeout=%ds", names.GetTenantKconfArg(), dep.Name, getNamespaceArg(dep.Namespace), int(timeout.Seconds()))
+	return runRolloutCmd(ctx, client, cmd)
+}
+
+// RolloutAppInst updates the AppInst by bringing up the new version
+// alongside the old one and sending traffic to it. For blue/green,
+// traffic is switched to the new version by pointing the rootLB proxy
+// at candidate services. For canary, the existing services split
+// traffic between both versions. The new version is promoted to
+// replace the old version once the AppInst's HealthCheck has stayed
+// healthy for the observation time. If the new version fails at any
+// point the old version is restored. Only Deployments are rolled out
+// this way, other objects are updated in place during the promote.
+// Platforms that do not provide RolloutHooks update in place.
+func RolloutAppInst(ctx context.Context, accessApi platform.AccessApi, client ssh.Client, names *KubeNames, clusterInst *edgeproto.ClusterInst, app *edgeproto.App, appInst *edgeproto.AppInst, ops ...AppInstOp) (reterr error) {
+	opts := GetAppInstOptions(ops)
+	strategy := cloudcommon.GetUpdateStrategy(app)
+	if _, ok := opts.WM.(*K8SWorkloadMgr); !ok {
+		log.SpanLog(ctx, log.DebugLevelInfra, "rollout not supported by workload manager, updating in place", "strategy", strategy)
+		return createOrUpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, applyManifest, ops...)
+	}
+	strategyName := edgeproto.UpdateStrategy_CamelName[int32(strategy)]
+	hooks := opts.RolloutHooks
+	if hooks == nil || hooks.GetHealthCheck == nil || hooks.SetProxyBackends == nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "rollout not supported by platform, updating in place", "strategy", strategy)
+		return createOrUpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, applyManifest, ops...)
+	}
+	if strategy == edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN && len(appInst.InternalPortToLbIp) == 0 {
+		return fmt.Errorf("rollout strategy %s requires load balancer IPs for the AppInst's services", strategyName)
+	}
+	mf, err := GenerateAppInstManifest(ctx, accessApi, names, app, appInst)
+	if err != nil {
+		return err
+	}
+	objs, _, err := cloudcommon.DecodeK8SYaml(mf)
+	if err != nil {
+		return err
+	}
+	candidates, err := getRolloutCandidates(objs, app)
+	if err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		log.SpanLog(ctx, log.DebugLevelInfra, "no deployments to roll out, updating in place", "strategy", strategy)
+		return createOrUpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, applyManifest, ops...)
+	}
+	candServices := []*v1.Service{}
+	if strategy == edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN {
+		candServices = getRolloutServices(objs, candidates)
+		if len(candServices) == 0 {
+			return fmt.Errorf("rollout strategy %s requires load balancer services", strategyName)
+		}
+	}
+	if err := ApplyAppInstPolicy(ctx, client, names, app, appInst, cloudcommon.Create, ops...); err != nil {
+		return err
+	}
+
+	timeout := cloudcommon.GetRolloutHealthTimeout(app)
+	observe := cloudcommon.GetRolloutObservationTime(app)
+	kconfArg := names.GetTenantKconfArg()
+	candObjs := []runtime.Object{}
+	promoteObjs := []runtime.Object{}
+	for _, rc := range candidates {
+		candObjs = append(candObjs, rc.candidate)
+		promoteObjs = append(promoteObjs, rc.orig)
+	}
+	for _, svc := range candServices {
+		candObjs = append(candObjs, svc)
+	}
+	candFile, err := writeRolloutManifest(ctx, client, names, appInst, rolloutCandidateManifestSuffix, candObjs)
+	if err != nil {
+		return err
+	}
+	promoteFile, err := writeRolloutManifest(ctx, client, names, appInst, rolloutPromoteManifestSuffix, promoteObjs)
+	if err != nil {
+		return err
+	}
+
+	switched := false
+	promoted := false
+	defer func() {
+		if reterr == nil {
+			return
+		}
+		log.SpanLog(ctx, log.DebugLevelInfra, "rolling back failed rollout", "strategy", strategy, "switched", switched, "promoted", promoted, "err", reterr)
+		if switched {
+			if err := hooks.SetProxyBackends(ctx, appInst.InternalPortToLbIp); err != nil {
+				log.SpanLog(ctx, log.DebugLevelInfra, "rollback failed to switch proxy back", "err", err)
+			}
+		}
+		if promoted {
+			for _, rc := range candidates {
+				cmd := fmt.Sprintf("kubectl %s rollout undo deployment/%s%s", kconfArg, rc.orig.Name, getNamespaceArg(rc.orig.Namespace))
+				if err := runRolloutCmd(ctx, client, cmd); err != nil {
+					log.SpanLog(ctx, log.DebugLevelInfra, "rollback failed to undo deployment", "err", err)
+				}
+			}
+		}
+		cmd := fmt.Sprintf("kubectl %s delete -f %s --ignore-not-found", kconfArg, candFile)
+		if err := runRolloutCmd(ctx, client, cmd); err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfra, "rollback failed to delete candidate", "err", err)
+		}
+		cleanupRolloutManifests(ctx, client, candFile, promoteFile)
+		reterr = fmt.Errorf("rollout failed and was rolled back, %v", reterr)
+	}()
+
+	log.SpanLog(ctx, log.DebugLevelInfra, "starting rollout", "strategy", strategy, "timeout", timeout, "observe", observe)
+	cmd := fmt.Sprintf("kubectl %s apply -f %s", kconfArg, candFile)
+	if err := runRolloutCmd(ctx, client, cmd); err != nil {
+		return err
+	}
+	// pods must be ready before they are sent traffic
+	for _, rc := range candidates {
+		if err := waitDeploymentRollout(ctx, client, names, rc.candidate, timeout); err != nil {
+			return fmt.Errorf("new version did not become ready, %v", err)
+		}
+	}
+	if strategy == edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN {
+		candIPs, err := getRolloutServiceIPs(ctx, client, names, appInst, candServices, timeout)
+		if err != nil {
+			return err
+		}
+		switched = true
+		if err := hooks.SetProxyBackends(ctx, candIPs); err != nil {
+			return fmt.Errorf("failed to switch traffic to new version, %v", err)
+		}
+	}
+	// the new version is now serving traffic, it must stay
+	// healthy for the observation time before it is promoted
+	if err := waitRolloutHealthy(ctx, hooks, timeout, observe); err != nil {
+		return fmt.Errorf("new version %v", err)
+	}
+
+	// Promote the new version by updating the original deployments.
+	// For blue/green, traffic is still served by the candidates while
+	// this happens.
+	cmd = fmt.Sprintf("kubectl %s apply -f %s", kconfArg, promoteFile)
+	if err := runRolloutCmd(ctx, client, cmd); err != nil {
+		return err
+	}
+	promoted = true
+	for _, rc := range candidates {
+		if err := waitDeploymentRollout(ctx, client, names, rc.orig, timeout); err != nil {
+			return fmt.Errorf("promoted version did not become ready, %v", err)
+		}
+	}
+	if switched {
+		if err := hooks.SetProxyBackends(ctx, appInst.InternalPortToLbIp); err != nil {
+			return fmt.Errorf("failed to switch traffic to promoted version, %v", err)
+		}
+		switched = false
+		if err := waitRolloutHealthy(ctx, hooks, timeout, 0); err != nil {
+			return fmt.Errorf("promoted version %v", err)
+		}
+	}
+	// Apply the full workload. Undo is handled here rather than by
+	// deleting the workload.
+	err = opts.WM.ApplyAppInstWorkload(ctx, accessApi, client, names, clusterInst, app, appInst, append(ops, WithAppInstUndo())...)
+	if err != nil {
+		return err
+	}
+
+	cmd = fmt.Sprintf("kubectl %s delete -f %s --ignore-not-found", kconfArg, candFile)
+	if err := runRolloutCmd(ctx, client, cmd); err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "failed to delete rollout candidate", "err", err)
+	}
+	cleanupRolloutManifests(ctx, client, candFile, promoteFile)
+	log.SpanLog(ctx, log.DebugLevelInfra, "rollout done", "strategy", strategy)
+	return nil
+}
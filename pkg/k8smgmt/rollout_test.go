@@ -0,0 +1,299 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8smgmt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/accessapi"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/pc"
+	"github.com/edgexr/edge-cloud-platform/test/testutil"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+func getRolloutTestApp(t *testing.T, ctx context.Context) (*edgeproto.App, *edgeproto.AppInst, *KubeNames) {
+	appInst := testutil.AppInstData()[0]
+	app := testutil.AppData()[0]
+	app.Deployment = cloudcommon.DeploymentTypeKubernetes
+	app.DeploymentGenerator = ""
+	baseMf, err := cloudcommon.GetAppDeploymentManifest(ctx, nil, &app)
+	require.Nil(t, err)
+	app.DeploymentManifest = baseMf
+	ports, err := edgeproto.ParseAppPorts(app.AccessPorts)
+	require.Nil(t, err)
+	appInst.MappedPorts = ports
+	names, err := GetKubeNames(&edgeproto.ClusterInst{}, &app, &appInst)
+	require.Nil(t, err)
+	return &app, &appInst, names
+}
+
+func TestRolloutCandidates(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfra)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	app, appInst, names := getRolloutTestApp(t, ctx)
+	mf, err := GenerateAppInstManifest(ctx, &accessapi.TestHandler{}, names, app, appInst)
+	require.Nil(t, err)
+	objs, _, err := cloudcommon.DecodeK8SYaml(mf)
+	require.Nil(t, err)
+	var dep *appsv1.Deployment
+	for _, obj := range objs {
+		if d, ok := obj.(*appsv1.Deployment); ok {
+			dep = d
+		}
+	}
+	require.NotNil(t, dep)
+	replicas := int32(4)
+	dep.Spec.Replicas = &replicas
+
+	// canary
+	app.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy:      edgeproto.UpdateStrategy_UPDATE_CANARY,
+		CanaryPercent: 30,
+	}
+	candidates, err := getRolloutCandidates(objs, app)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(candidates))
+	cand := candidates[0].candidate
+	require.Equal(t, dep.Name+RolloutSuffix, cand.Name)
+	require.Equal(t, int32(2), *cand.Spec.Replicas)
+	require.Equal(t, int32(4), *dep.Spec.Replicas)
+	require.Equal(t, "", cand.Labels[ConfigLabel])
+	require.Equal(t, RolloutLabelCandidate, cand.Labels[RolloutLabel])
+	require.Equal(t, RolloutLabelCandidate, cand.Spec.Selector.MatchLabels[RolloutLabel])
+	require.Equal(t, "", dep.Spec.Selector.MatchLabels[RolloutLabel])
+	for k, v := range dep.Spec.Template.Labels {
+		// services still select the canary pods
+		require.Equal(t, v, cand.Spec.Template.Labels[k])
+	}
+
+	// blue/green
+	app.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy: edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN,
+	}
+	candidates, err = getRolloutCandidates(objs, app)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(candidates))
+	cand = candidates[0].candidate
+	require.Equal(t, int32(4), *cand.Spec.Replicas)
+	for k, v := range dep.Spec.Selector.MatchLabels {
+		require.Equal(t, v+RolloutSuffix, cand.Spec.Selector.MatchLabels[k])
+		require.Equal(t, v+RolloutSuffix, cand.Spec.Template.Labels[k])
+	}
+	services := getRolloutServices(objs, candidates)
+	require.Equal(t, 2, len(services))
+	for _, svc := range services {
+		require.True(t, strings.HasSuffix(svc.Name, RolloutSuffix))
+		require.Equal(t, "", svc.Labels[ConfigLabel])
+		require.Equal(t, RolloutLabelCandidate, svc.Labels[RolloutLabel])
+		require.Equal(t, map[string]string{"run": "pillimogo1.0.0-rollout"}, svc.Spec.Selector)
+	}
+}
+
+func TestRolloutAppInst(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfra)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	app, appInst, names := getRolloutTestApp(t, ctx)
+	app.RolloutPolicy = &edgeproto.RolloutPolicy{
+		Strategy:        edgeproto.UpdateStrategy_UPDATE_BLUE_GREEN,
+		HealthTimeout:   edgeproto.Duration(time.Second),
+		ObservationTime: edgeproto.Duration(10 * time.Millisecond),
+	}
+	accessApi := &accessapi.TestHandler{}
+	clusterInst := &edgeproto.ClusterInst{}
+
+	origInterval := RolloutHealthCheckInterval
+	RolloutHealthCheckInterval = 5 * time.Millisecond
+	defer func() {
+		RolloutHealthCheckInterval = origInterval
+	}()
+
+	// candidate services are assigned their own load balancer IPs
+	mf, err := GenerateAppInstManifest(ctx, accessApi, names, app, appInst)
+	require.Nil(t, err)
+	objs, _, err := cloudcommon.DecodeK8SYaml(mf)
+	require.Nil(t, err)
+	candidates, err := getRolloutCandidates(objs, app)
+	require.Nil(t, err)
+	candSvcOut := map[string]string{}
+	origIPs := map[string]string{}
+	candIPs := map[string]string{}
+	for _, svc := range getRolloutServices(objs, candidates) {
+		for _, p := range svc.Spec.Ports {
+			origIPs[LbServicePortToString(&p)] = "10.0.0.1"
+			candIPs[LbServicePortToString(&p)] = "10.0.0.2"
+		}
+		svc.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{IP: "10.0.0.2"}}
+		out, err := json.Marshal(svc)
+		require.Nil(t, err)
+		candSvcOut[svc.Name] = string(out)
+	}
+	appInst.InternalPortToLbIp = origIPs
+	respondSvc := func(cmd string) (string, bool) {
+		for name, out := range candSvcOut {
+			if strings.Contains(cmd, "get svc "+name+" ") {
+				return out, true
+			}
+		}
+		return "", false
+	}
+
+	type testHooks struct {
+		backends []map[string]string
+		health   []dme.HealthCheck
+	}
+	newHooks := func(health ...dme.HealthCheck) (*testHooks, *RolloutHooks) {
+		th := &testHooks{health: health}
+		return th, &RolloutHooks{
+			SetProxyBackends: func(ctx context.Context, lbIPs map[string]string) error {
+				th.backends = append(th.backends, lbIPs)
+				return nil
+			},
+			GetHealthCheck: func(ctx context.Context) (dme.HealthCheck, error) {
+				hc := th.health[0]
+				if len(th.health) > 1 {
+					th.health = th.health[1:]
+				}
+				return hc, nil
+			},
+		}
+	}
+
+	countCmds := func(cmds []string, substr string) int {
+		count := 0
+		for _, cmd := range cmds {
+			if strings.Contains(cmd, substr) {
+				count++
+			}
+		}
+		return count
+	}
+	depName := "pillimogo100-deployment"
+	candStatus := fmt.Sprintf("rollout status deployment/%s%s", depName, RolloutSuffix)
+
+	// platforms without hooks update in place
+	client := &pc.TestClient{}
+	err = UpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, WithAppInstNoWait())
+	require.Nil(t, err)
+	require.Equal(t, 0, countCmds(client.Cmds, RolloutSuffix))
+	require.Equal(t, 1, countCmds(client.Cmds, "--prune"))
+
+	// successful rollout switches traffic to the candidate and
+	// back to the promoted version, and removes the candidate
+	client = &pc.TestClient{
+		OutputResponder: func(cmd string) (string, error) {
+			out, _ := respondSvc(cmd)
+			return out, nil
+		},
+	}
+	th, hooks := newHooks(dme.HealthCheck_HEALTH_CHECK_OK)
+	err = UpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, WithAppInstNoWait(), WithRolloutHooks(hooks))
+	require.Nil(t, err)
+	require.Equal(t, 1, countCmds(client.Cmds, candStatus))
+	require.Equal(t, []map[string]string{candIPs, origIPs}, th.backends)
+	require.Equal(t, 1, countCmds(client.Cmds, "--prune"))
+	require.Equal(t, 1, countCmds(client.Cmds, "candidate.yaml --ignore-not-found"))
+	require.Equal(t, 0, countCmds(client.Cmds, "rollout undo"))
+
+	// candidate fails to become ready, traffic is never switched
+	client = &pc.TestClient{
+		OutputResponder: func(cmd string) (string, error) {
+			if strings.Contains(cmd, candStatus) {
+				return "timed out waiting for the condition", fmt.Errorf("exit status 1")
+			}
+			out, _ := respondSvc(cmd)
+			return out, nil
+		},
+	}
+	th, hooks = newHooks(dme.HealthCheck_HEALTH_CHECK_OK)
+	err = UpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, WithAppInstNoWait(), WithRolloutHooks(hooks))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "rolled back")
+	require.Equal(t, 0, len(th.backends))
+	require.Equal(t, 0, countCmds(client.Cmds, "--prune"))
+	require.Equal(t, 0, countCmds(client.Cmds, "rollout undo"))
+	require.Equal(t, 1, countCmds(client.Cmds, "candidate.yaml --ignore-not-found"))
+
+	// candidate becomes unhealthy while serving traffic, traffic is
+	// switched back and the original deployment is never modified
+	client = &pc.TestClient{
+		OutputResponder: func(cmd string) (string, error) {
+			out, _ := respondSvc(cmd)
+			return out, nil
+		},
+	}
+	th, hooks = newHooks(dme.HealthCheck_HEALTH_CHECK_OK, dme.HealthCheck_HEALTH_CHECK_SERVER_FAIL)
+	app.RolloutPolicy.ObservationTime = edgeproto.Duration(time.Minute)
+	err = UpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, WithAppInstNoWait(), WithRolloutHooks(hooks))
+	app.RolloutPolicy.ObservationTime = edgeproto.Duration(10 * time.Millisecond)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "health check failed")
+	require.Equal(t, []map[string]string{candIPs, origIPs}, th.backends)
+	require.Equal(t, 0, countCmds(client.Cmds, "apply -f "+getRolloutDirName(names)+"/"+names.AppInstName+names.AppInstOrg+rolloutPromoteManifestSuffix))
+	require.Equal(t, 0, countCmds(client.Cmds, "rollout undo"))
+	require.Equal(t, 1, countCmds(client.Cmds, "candidate.yaml --ignore-not-found"))
+
+	// promoted version fails, traffic is switched back and the
+	// original deployment is rolled back
+	client = &pc.TestClient{
+		OutputResponder: func(cmd string) (string, error) {
+			if strings.Contains(cmd, "rollout status deployment/"+depName+" ") {
+				return "timed out waiting for the condition", fmt.Errorf("exit status 1")
+			}
+			out, _ := respondSvc(cmd)
+			return out, nil
+		},
+	}
+	th, hooks = newHooks(dme.HealthCheck_HEALTH_CHECK_OK)
+	err = UpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, WithAppInstNoWait(), WithRolloutHooks(hooks))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "rolled back")
+	require.Equal(t, []map[string]string{candIPs, origIPs}, th.backends)
+	require.Equal(t, 1, countCmds(client.Cmds, "rollout undo deployment/"+depName))
+	require.Equal(t, 1, countCmds(client.Cmds, "candidate.yaml --ignore-not-found"))
+
+	// promote apply fails, the original deployment is not rolled back
+	client = &pc.TestClient{
+		OutputResponder: func(cmd string) (string, error) {
+			if strings.Contains(cmd, "apply -f") && strings.Contains(cmd, "promote.yaml") {
+				return "error", fmt.Errorf("exit status 1")
+			}
+			out, _ := respondSvc(cmd)
+			return out, nil
+		},
+	}
+	th, hooks = newHooks(dme.HealthCheck_HEALTH_CHECK_OK)
+	err = UpdateAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, WithAppInstNoWait(), WithRolloutHooks(hooks))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "rolled back")
+	require.Equal(t, []map[string]string{candIPs, origIPs}, th.backends)
+	require.Equal(t, 0, countCmds(client.Cmds, "rollout undo"))
+}
@@ -0,0 +1,249 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metricsexport sends edgeproto.Metric data to Prometheus
+// remote-write or OpenTelemetry (OTLP) receivers.
+package metricsexport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+)
+
+// Metrics are buffered and sent in batches, either after a certain
+// period of time (interval) or number of buffered metrics (count
+// trigger), the same as for the InfluxDB queue. If a batch fails to
+// send, it is put back on the queue and retried after the retry delay.
+
+var PushInterval time.Duration = time.Second
+var PushCountTrigger = 50
+var PushCountMax = 5000
+var RetryDelay time.Duration = 10 * time.Second
+var SendTimeout time.Duration = 30 * time.Second
+
+const (
+	TypePrometheusRemoteWrite = "prometheus"
+	TypeOTLP                  = "otlp"
+)
+
+var Types = []string{TypePrometheusRemoteWrite, TypeOTLP}
+
+// sender converts metrics to the receiver's format and sends them.
+type sender interface {
+	send(ctx context.Context, client *http.Client, url string, data []*edgeproto.Metric) (int, error)
+}
+
+type Exporter struct {
+	exportType string
+	url        string
+	client     *http.Client
+	sender     sender
+	data       []*edgeproto.Metric
+	done       bool
+	doPush     chan bool
+	mux        sync.Mutex
+	wg         sync.WaitGroup
+	ErrBatch   uint64
+	ErrPoint   uint64
+	Qfull      uint64
+	QWrites    uint64
+	DatWrites  uint64
+}
+
+// NewExporter creates a new exporter of the given type which sends
+// metrics to the given URL.
+func NewExporter(exportType, url string) (*Exporter, error) {
+	e := Exporter{}
+	switch exportType {
+	case TypePrometheusRemoteWrite:
+		e.sender = &remoteWriteSender{}
+	case TypeOTLP:
+		e.sender = &otlpSender{}
+	default:
+		return nil, fmt.Errorf("invalid metrics export type %q, must be one of %v", exportType, Types)
+	}
+	if url == "" {
+		return nil, fmt.Errorf("metrics export URL not specified")
+	}
+	e.exportType = exportType
+	e.url = url
+	e.client = &http.Client{
+		Timeout: SendTimeout,
+	}
+	e.data = make([]*edgeproto.Metric, 0)
+	e.doPush = make(chan bool, 1)
+	return &e, nil
+}
+
+func (e *Exporter) Start() {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	e.done = false
+	e.wg.Add(1)
+	go e.RunPush()
+}
+
+func (e *Exporter) Stop() {
+	e.mux.Lock()
+	e.done = true
+	e.mux.Unlock()
+	e.DoPush() // wake up thread
+	e.wg.Wait()
+}
+
+func (e *Exporter) isDone() bool {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	return e.done
+}
+
+func (e *Exporter) RunPush() {
+	for !e.isDone() {
+		select {
+		case <-e.doPush:
+		case <-time.After(PushInterval):
+		}
+		if e.isDone() {
+			break
+		}
+		e.mux.Lock()
+		if len(e.data) == 0 {
+			e.mux.Unlock()
+			continue
+		}
+		data := e.data
+		e.data = make([]*edgeproto.Metric, 0)
+		e.mux.Unlock()
+
+		span := log.StartSpan(log.DebugLevelMetrics, "metrics export")
+		ctx := log.ContextWithSpan(context.Background(), span)
+		errPoints, err := e.sender.send(ctx, e.client, e.url, data)
+		atomic.AddUint64(&e.ErrPoint, uint64(errPoints))
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelMetrics, "send metrics failed",
+				"type", e.exportType, "url", e.url, "err", err)
+			atomic.AddUint64(&e.ErrBatch, 1)
+			span.Finish()
+			if !isRetryable(err) {
+				// receiver rejected the data, retrying will not help
+				continue
+			}
+			e.requeue(data)
+			select {
+			case <-e.doPush:
+			case <-time.After(RetryDelay):
+			}
+			continue
+		}
+		span.Finish()
+		atomic.AddUint64(&e.QWrites, 1)
+		atomic.AddUint64(&e.DatWrites, uint64(len(data)))
+	}
+	e.wg.Done()
+}
+
+// requeue puts back a failed batch ahead of any metrics received
+// since, dropping the oldest metrics if the queue would overflow.
+func (e *Exporter) requeue(data []*edgeproto.Metric) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	data = append(data, e.data...)
+	if len(data) > PushCountMax {
+		e.Qfull += uint64(len(data) - PushCountMax)
+		data = data[len(data)-PushCountMax:]
+	}
+	e.data = data
+}
+
+func (e *Exporter) RecvMetric(ctx context.Context, metric *edgeproto.Metric) {
+	e.AddMetric(metric)
+}
+
+func (e *Exporter) AddMetric(metrics ...*edgeproto.Metric) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if len(e.data) > PushCountMax {
+		// limit len to prevent out of memory if
+		// receiver is not reachable
+		e.Qfull++
+		return
+	}
+	e.data = append(e.data, metrics...)
+	if len(e.data) > PushCountTrigger {
+		e.DoPush()
+	}
+}
+
+func (e *Exporter) DoPush() {
+	select {
+	case e.doPush <- true:
+	default:
+		// already triggered
+	}
+}
+
+func postData(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &statusError{
+			code: resp.StatusCode,
+			msg:  fmt.Sprintf("%s: %s", resp.Status, string(msg)),
+		}
+	}
+	return nil
+}
+
+type statusError struct {
+	code int
+	msg  string
+}
+
+func (s *statusError) Error() string {
+	return s.msg
+}
+
+// isRetryable returns false if the receiver rejected the request
+// as invalid. Connection failures, server errors and throttling
+// are retried.
+func isRetryable(err error) bool {
+	serr, ok := err.(*statusError)
+	if !ok {
+		return true
+	}
+	if serr.code == http.StatusTooManyRequests {
+		return true
+	}
+	return serr.code/100 != 4
+}
@@ -0,0 +1,305 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsexport
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/snappy"
+	"github.com/test-go/testify/require"
+)
+
+type testReceiver struct {
+	mux      sync.Mutex
+	statuses []int
+	headers  []http.Header
+	bodies   [][]byte
+}
+
+func (s *testReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	body, _ := io.ReadAll(r.Body)
+	status := http.StatusOK
+	if len(s.statuses) > 0 {
+		status = s.statuses[0]
+		s.statuses = s.statuses[1:]
+	}
+	if status == http.StatusOK {
+		s.headers = append(s.headers, r.Header)
+		s.bodies = append(s.bodies, body)
+	}
+	w.WriteHeader(status)
+}
+
+func (s *testReceiver) waitBodies(t *testing.T, count int) [][]byte {
+	for ii := 0; ii < 100; ii++ {
+		s.mux.Lock()
+		if len(s.bodies) >= count {
+			bodies := s.bodies
+			s.mux.Unlock()
+			return bodies
+		}
+		s.mux.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Fail(t, "timed out waiting for metrics")
+	return nil
+}
+
+func waitCount(t *testing.T, counter *uint64, count uint64) {
+	for ii := 0; ii < 100; ii++ {
+		if atomic.LoadUint64(counter) >= count {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, count, atomic.LoadUint64(counter))
+}
+
+func getTestMetric() *edgeproto.Metric {
+	ts, _ := types.TimestampProto(time.Unix(1700000000, 500000000))
+	metric := &edgeproto.Metric{
+		Name:      "appinst-cpu",
+		Timestamp: *ts,
+	}
+	metric.AddTag("app", "myapp")
+	metric.AddTag("cloudlet-org", "operorg")
+	metric.AddDoubleVal("cpu", 12.5)
+	metric.AddIntVal("restarts", 3)
+	metric.AddBoolVal("healthy", true)
+	metric.AddStringVal("version", "1.0")
+	return metric
+}
+
+func setTestIntervals(t *testing.T) {
+	oldInterval := PushInterval
+	oldRetry := RetryDelay
+	PushInterval = 10 * time.Millisecond
+	RetryDelay = 10 * time.Millisecond
+	t.Cleanup(func() {
+		PushInterval = oldInterval
+		RetryDelay = oldRetry
+	})
+}
+
+type decodedSeries struct {
+	labels    map[string]string
+	value     float64
+	timestamp int64
+}
+
+func decodeWriteRequest(t *testing.T, data []byte) []decodedSeries {
+	list := []decodedSeries{}
+	buf := proto.NewBuffer(data)
+	for {
+		key, err := buf.DecodeVarint()
+		if err == io.ErrUnexpectedEOF {
+			break
+		}
+		require.Nil(t, err)
+		require.Equal(t, uint64(1<<3|wireBytes), key)
+		tsData, err := buf.DecodeRawBytes(false)
+		require.Nil(t, err)
+		s := decodedSeries{
+			labels: map[string]string{},
+		}
+		tsBuf := proto.NewBuffer(tsData)
+		for {
+			key, err := tsBuf.DecodeVarint()
+			if err == io.ErrUnexpectedEOF {
+				break
+			}
+			require.Nil(t, err)
+			msg, err := tsBuf.DecodeRawBytes(false)
+			require.Nil(t, err)
+			mbuf := proto.NewBuffer(msg)
+			switch key {
+			case 1<<3 | wireBytes:
+				_, err = mbuf.DecodeVarint()
+				require.Nil(t, err)
+				name, err := mbuf.DecodeStringBytes()
+				require.Nil(t, err)
+				_, err = mbuf.DecodeVarint()
+				require.Nil(t, err)
+				val, err := mbuf.DecodeStringBytes()
+				require.Nil(t, err)
+				s.labels[name] = val
+			case 2<<3 | wireBytes:
+				_, err = mbuf.DecodeVarint()
+				require.Nil(t, err)
+				bits, err := mbuf.DecodeFixed64()
+				require.Nil(t, err)
+				s.value = math.Float64frombits(bits)
+				_, err = mbuf.DecodeVarint()
+				require.Nil(t, err)
+				ts, err := mbuf.DecodeVarint()
+				require.Nil(t, err)
+				s.timestamp = int64(ts)
+			default:
+				require.Fail(t, "unexpected field", key)
+			}
+		}
+		list = append(list, s)
+	}
+	return list
+}
+
+func TestRemoteWrite(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelMetrics)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	setTestIntervals(t)
+	recv := &testReceiver{}
+	server := httptest.NewServer(recv)
+	defer server.Close()
+
+	_, err := NewExporter("influx", server.URL)
+	require.NotNil(t, err)
+
+	e, err := NewExporter(TypePrometheusRemoteWrite, server.URL)
+	require.Nil(t, err)
+	e.Start()
+	defer e.Stop()
+
+	e.AddMetric(getTestMetric())
+	bodies := recv.waitBodies(t, 1)
+	require.Equal(t, "snappy", recv.headers[0].Get("Content-Encoding"))
+	require.Equal(t, "application/x-protobuf", recv.headers[0].Get("Content-Type"))
+	require.Equal(t, "0.1.0", recv.headers[0].Get("X-Prometheus-Remote-Write-Version"))
+
+	data, err := snappy.Decode(nil, bodies[0])
+	require.Nil(t, err)
+	labels := func(name string) map[string]string {
+		return map[string]string{
+			"__name__":     name,
+			"app":          "myapp",
+			"cloudlet_org": "operorg",
+			"version":      "1.0",
+		}
+	}
+	ts := int64(1700000000500)
+	expected := []decodedSeries{
+		{labels("appinst_cpu_cpu"), 12.5, ts},
+		{labels("appinst_cpu_restarts"), 3, ts},
+		{labels("appinst_cpu_healthy"), 1, ts},
+	}
+	require.Equal(t, expected, decodeWriteRequest(t, data))
+	waitCount(t, &e.QWrites, 1)
+	waitCount(t, &e.DatWrites, 1)
+}
+
+func TestOTLP(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelMetrics)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	setTestIntervals(t)
+	recv := &testReceiver{}
+	server := httptest.NewServer(recv)
+	defer server.Close()
+
+	e, err := NewExporter(TypeOTLP, server.URL)
+	require.Nil(t, err)
+	e.Start()
+	defer e.Stop()
+
+	metric2 := getTestMetric()
+	metric2.Vals = metric2.Vals[:1]
+	metric2.Tags[0].Val = "otherapp"
+	e.AddMetric(getTestMetric(), metric2)
+	bodies := recv.waitBodies(t, 1)
+	require.Equal(t, "application/json", recv.headers[0].Get("Content-Type"))
+
+	req := otlpRequest{}
+	err = json.Unmarshal(bodies[0], &req)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(req.ResourceMetrics))
+	require.Equal(t, 1, len(req.ResourceMetrics[0].ScopeMetrics))
+	metrics := req.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	require.Equal(t, 3, len(metrics))
+	require.Equal(t, "appinst_cpu_cpu", metrics[0].Name)
+	require.Equal(t, 2, len(metrics[0].Gauge.DataPoints))
+	dp := metrics[0].Gauge.DataPoints[1]
+	require.Equal(t, "1700000000500000000", dp.TimeUnixNano)
+	require.Equal(t, 12.5, *dp.AsDouble)
+	require.Equal(t, otlpKeyValue{"app", otlpAnyValue{"otherapp"}}, dp.Attributes[0])
+	require.Equal(t, "appinst_cpu_restarts", metrics[1].Name)
+	require.Equal(t, "3", metrics[1].Gauge.DataPoints[0].AsInt)
+	require.Nil(t, metrics[1].Gauge.DataPoints[0].AsDouble)
+}
+
+func TestRetry(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelMetrics)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	setTestIntervals(t)
+	recv := &testReceiver{
+		statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+	}
+	server := httptest.NewServer(recv)
+	defer server.Close()
+
+	e, err := NewExporter(TypePrometheusRemoteWrite, server.URL)
+	require.Nil(t, err)
+	e.Start()
+	defer e.Stop()
+
+	// failed batches are retried until they succeed
+	e.AddMetric(getTestMetric())
+	recv.waitBodies(t, 1)
+	waitCount(t, &e.DatWrites, 1)
+	require.Equal(t, uint64(2), atomic.LoadUint64(&e.ErrBatch))
+
+	// rejected batches are dropped
+	recv.mux.Lock()
+	recv.statuses = []int{http.StatusBadRequest}
+	recv.mux.Unlock()
+	e.AddMetric(getTestMetric())
+	waitCount(t, &e.ErrBatch, 3)
+	e.AddMetric(getTestMetric())
+	recv.waitBodies(t, 2)
+	waitCount(t, &e.DatWrites, 2)
+	require.Equal(t, uint64(3), atomic.LoadUint64(&e.ErrBatch))
+}
+
+func TestRequeue(t *testing.T) {
+	oldMax := PushCountMax
+	PushCountMax = 3
+	defer func() {
+		PushCountMax = oldMax
+	}()
+	e, err := NewExporter(TypeOTLP, "http://127.0.0.1:1")
+	require.Nil(t, err)
+	m := []*edgeproto.Metric{}
+	for ii := 0; ii < 4; ii++ {
+		m = append(m, &edgeproto.Metric{Name: string(rune('a' + ii))})
+	}
+	e.AddMetric(m[3])
+	e.requeue(m[:3])
+	require.Equal(t, []*edgeproto.Metric{m[1], m[2], m[3]}, e.data)
+	require.Equal(t, uint64(1), e.Qfull)
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsexport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+)
+
+// OTLP metrics are sent using the OTLP/HTTP JSON encoding of
+// ExportMetricsServiceRequest. All metrics are sent as gauges.
+
+const otlpScopeName = "edge-cloud-platform"
+
+type otlpRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpMetric struct {
+	Name  string    `json:"name"`
+	Gauge otlpGauge `json:"gauge"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpDataPoint `json:"dataPoints"`
+}
+
+// Per the protobuf JSON mapping, 64-bit integers are strings.
+type otlpDataPoint struct {
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+	TimeUnixNano string         `json:"timeUnixNano"`
+	AsDouble     *float64       `json:"asDouble,omitempty"`
+	AsInt        string         `json:"asInt,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpSender struct{}
+
+func (s *otlpSender) send(ctx context.Context, client *http.Client, url string, data []*edgeproto.Metric) (int, error) {
+	all, errPoints := getAllSeries(data)
+	if len(all) == 0 {
+		return errPoints, nil
+	}
+	body, err := json.Marshal(getOTLPRequest(all))
+	if err != nil {
+		return errPoints, err
+	}
+	headers := map[string]string{
+		"Content-Type": "application/json",
+		"User-Agent":   "edge-cloud-platform",
+	}
+	return errPoints, postData(ctx, client, url, body, headers)
+}
+
+func getOTLPRequest(all []series) *otlpRequest {
+	// group data points by metric name, preserving order
+	metrics := []otlpMetric{}
+	metricIdx := map[string]int{}
+	for _, s := range all {
+		dp := otlpDataPoint{
+			TimeUnixNano: strconv.FormatInt(s.timestamp.UnixNano(), 10),
+		}
+		keys := []string{}
+		for k := range s.labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			dp.Attributes = append(dp.Attributes, otlpKeyValue{
+				Key: k,
+				Value: otlpAnyValue{
+					StringValue: s.labels[k],
+				},
+			})
+		}
+		if s.isInt {
+			dp.AsInt = strconv.FormatInt(s.ival, 10)
+		} else {
+			dval := s.dval
+			dp.AsDouble = &dval
+		}
+		idx, ok := metricIdx[s.name]
+		if !ok {
+			idx = len(metrics)
+			metricIdx[s.name] = idx
+			metrics = append(metrics, otlpMetric{Name: s.name})
+		}
+		metrics[idx].Gauge.DataPoints = append(metrics[idx].Gauge.DataPoints, dp)
+	}
+	return &otlpRequest{
+		ResourceMetrics: []otlpResourceMetrics{{
+			ScopeMetrics: []otlpScopeMetrics{{
+				Scope: otlpScope{
+					Name: otlpScopeName,
+				},
+				Metrics: metrics,
+			}},
+		}},
+	}
+}
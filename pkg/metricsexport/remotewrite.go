@@ -0,0 +1,97 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsexport
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+)
+
+// Prometheus remote-write 1.0 sends a snappy compressed protobuf
+// encoded WriteRequest. To avoid pulling in the Prometheus server
+// code for the message definitions, the messages are encoded
+// directly:
+//
+//	message WriteRequest { repeated TimeSeries timeseries = 1; }
+//	message TimeSeries { repeated Label labels = 1; repeated Sample samples = 2; }
+//	message Label { string name = 1; string value = 2; }
+//	message Sample { double value = 1; int64 timestamp = 2; }
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+)
+
+type remoteWriteSender struct{}
+
+func (s *remoteWriteSender) send(ctx context.Context, client *http.Client, url string, data []*edgeproto.Metric) (int, error) {
+	all, errPoints := getAllSeries(data)
+	if len(all) == 0 {
+		return errPoints, nil
+	}
+	body := snappy.Encode(nil, encodeWriteRequest(all))
+	headers := map[string]string{
+		"Content-Encoding":                  "snappy",
+		"Content-Type":                      "application/x-protobuf",
+		"User-Agent":                        "edge-cloud-platform",
+		"X-Prometheus-Remote-Write-Version": "0.1.0",
+	}
+	return errPoints, postData(ctx, client, url, body, headers)
+}
+
+func encodeWriteRequest(all []series) []byte {
+	buf := proto.NewBuffer(nil)
+	for ii := range all {
+		buf.EncodeVarint(1<<3 | wireBytes)
+		buf.EncodeRawBytes(encodeTimeSeries(&all[ii]))
+	}
+	return buf.Bytes()
+}
+
+func encodeTimeSeries(s *series) []byte {
+	// labels must be sorted by name, including the metric name
+	labels := [][2]string{{"__name__", s.name}}
+	for k, v := range s.labels {
+		labels = append(labels, [2]string{k, v})
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i][0] < labels[j][0]
+	})
+	buf := proto.NewBuffer(nil)
+	for _, label := range labels {
+		lbuf := proto.NewBuffer(nil)
+		lbuf.EncodeVarint(1<<3 | wireBytes)
+		lbuf.EncodeStringBytes(label[0])
+		lbuf.EncodeVarint(2<<3 | wireBytes)
+		lbuf.EncodeStringBytes(label[1])
+		buf.EncodeVarint(1<<3 | wireBytes)
+		buf.EncodeRawBytes(lbuf.Bytes())
+	}
+	sbuf := proto.NewBuffer(nil)
+	sbuf.EncodeVarint(1<<3 | wireFixed64)
+	sbuf.EncodeFixed64(math.Float64bits(s.floatValue()))
+	sbuf.EncodeVarint(2<<3 | wireVarint)
+	sbuf.EncodeVarint(uint64(s.timestamp.UnixMilli()))
+	buf.EncodeVarint(2<<3 | wireBytes)
+	buf.EncodeRawBytes(sbuf.Bytes())
+	return buf.Bytes()
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsexport
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/gogo/protobuf/types"
+)
+
+// series is a single numeric sample derived from an edgeproto.Metric.
+// Each numeric value of the metric becomes its own series named
+// <metric>_<value>, labeled by the metric's tags. String values
+// cannot be stored as samples, so they are added as labels.
+type series struct {
+	name      string
+	labels    map[string]string
+	dval      float64
+	ival      int64
+	isInt     bool
+	timestamp time.Time
+}
+
+func (s *series) floatValue() float64 {
+	if s.isInt {
+		return float64(s.ival)
+	}
+	return s.dval
+}
+
+func getSeries(metric *edgeproto.Metric) ([]series, error) {
+	ts, err := types.TimestampFromProto(&metric.Timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp for metric %s, %s", metric.Name, err)
+	}
+	labels := make(map[string]string)
+	for _, mtag := range metric.Tags {
+		labels[sanitizeLabelName(mtag.Name)] = mtag.Val
+	}
+	for _, mval := range metric.Vals {
+		if sval, ok := mval.Value.(*edgeproto.MetricVal_Sval); ok {
+			labels[sanitizeLabelName(mval.Name)] = sval.Sval
+		}
+	}
+	list := []series{}
+	for _, mval := range metric.Vals {
+		s := series{
+			name:      sanitizeMetricName(metric.Name + "_" + mval.Name),
+			labels:    labels,
+			timestamp: ts,
+		}
+		switch v := mval.Value.(type) {
+		case *edgeproto.MetricVal_Dval:
+			s.dval = v.Dval
+		case *edgeproto.MetricVal_Ival:
+			s.ival = int64(v.Ival)
+			s.isInt = true
+		case *edgeproto.MetricVal_Bval:
+			if v.Bval {
+				s.ival = 1
+			}
+			s.isInt = true
+		default:
+			continue
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+// getAllSeries converts the metrics to series, and returns the number
+// of metrics that could not be converted.
+func getAllSeries(data []*edgeproto.Metric) ([]series, int) {
+	all := []series{}
+	errPoints := 0
+	for _, metric := range data {
+		list, err := getSeries(metric)
+		if err != nil {
+			errPoints++
+			continue
+		}
+		all = append(all, list...)
+	}
+	return all, errPoints
+}
+
+// sanitizeMetricName converts the name to a valid Prometheus metric
+// name, [a-zA-Z_:][a-zA-Z0-9_:]*
+func sanitizeMetricName(name string) string {
+	return sanitizeName(name, true)
+}
+
+// sanitizeLabelName converts the name to a valid Prometheus label
+// name, [a-zA-Z_][a-zA-Z0-9_]*
+func sanitizeLabelName(name string) string {
+	return sanitizeName(name, false)
+}
+
+func sanitizeName(name string, allowColon bool) string {
+	var sb strings.Builder
+	for ii, ch := range name {
+		if (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_' ||
+			(ch >= '0' && ch <= '9' && ii > 0) || (ch == ':' && allowColon) {
+			sb.WriteRune(ch)
+		} else {
+			sb.WriteRune('_')
+		}
+	}
+	return sb.String()
+}
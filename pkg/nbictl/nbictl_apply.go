@@ -24,8 +24,27 @@ import (
 func ApplyAll(ctx context.Context, client *nbi.ClientWithResponses, data *ApplyData) []APIErr {
 	errs := []APIErr{}
 
-	// apply Apps
+	// apply Apps, updating any that already exist
+	var appsByKey AppsByKey
+	if len(data.Apps) > 0 {
+		var apiErr *APIErr
+		appsByKey, apiErr = getAppsByKey(ctx, client)
+		if apiErr != nil {
+			errs = append(errs, *apiErr)
+		}
+	}
 	for _, app := range data.Apps {
+		id, err := appsByKey.getIDFromName(app.Name, app.AppProvider, app.Version)
+		if err == nil {
+			desc := "update app " + app.Name
+			params := nbi.UpdateAppParams{}
+			resp, err := client.UpdateAppWithResponse(ctx, id, &params, app)
+			apiErr := checkForAPIErr(desc, resp, err, http.StatusOK)
+			if apiErr != nil {
+				errs = append(errs, *apiErr)
+			}
+			continue
+		}
 		desc := "submit app " + app.Name
 		params := nbi.SubmitAppParams{}
 		resp, err := client.SubmitAppWithResponse(ctx, &params, app)
@@ -48,13 +67,30 @@ func ApplyAll(ctx context.Context, client *nbi.ClientWithResponses, data *ApplyD
 		if apiErr != nil {
 			errs = append(errs, *apiErr)
 		}
-		// apply AppInsts
+		instsByKey, apiErr := getAppInstsByKey(ctx, client)
+		if apiErr != nil {
+			errs = append(errs, *apiErr)
+		}
+		// apply AppInsts, updating any that already exist
 		for _, appinst := range data.AppInsts {
 			apiErr = ensureCreateAppInstIDs(appsByKey, zonesByKey, clustersByKey, &appinst)
 			if apiErr != nil {
 				errs = append(errs, *apiErr)
 				continue
 			}
+			if id, err := instsByKey.getIDFromName(appinst.Name, appinst.AppProvider); err == nil {
+				desc := "update app instance " + appinst.Name
+				params := &nbi.UpdateAppInstanceParams{}
+				req := nbi.UpdateAppInstanceJSONRequestBody{
+					KubernetesResources: appinst.KubernetesResources,
+				}
+				resp, err := client.UpdateAppInstanceWithResponse(ctx, id, params, req)
+				apiErr := checkForAPIErr(desc, resp, err, http.StatusAccepted)
+				if apiErr != nil {
+					errs = append(errs, *apiErr)
+				}
+				continue
+			}
 			desc := "create app instance " + appinst.Name
 			params := &nbi.CreateAppInstanceParams{}
 			req := nbi.CreateAppInstanceJSONRequestBody(appinst.CreateAppInstanceJSONBody)
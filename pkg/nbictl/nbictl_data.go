@@ -31,6 +31,9 @@ type CreateAppInst struct {
 	EdgeCloudProvider string `json:"edgecloudprovider,omitempty"`
 	ClusterName       string `json:"clustername,omitempty"`
 	ClusterProvider   string `json:"clusterprovider,omitempty"`
+	// KubernetesResources are applied as an update if the
+	// instance already exists.
+	KubernetesResources *nbi.KubernetesResources `json:"kubernetesresources,omitempty"`
 }
 
 // DeleteAppInst adds additional fields to the NBI data to allow for
@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendAlertHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Alert, modRev int64))
 	GetWithRev(key *edgeproto.AlertKey, buf *edgeproto.Alert, modRev *int64) bool
+	HasKey(key *edgeproto.AlertKey) bool
 }
 
 type RecvAlertHandler interface {
@@ -50,6 +51,7 @@ type AlertSend struct {
 	handler     SendAlertHandler
 	Keys        map[edgeproto.AlertKey]AlertSendContext
 	keysToSend  map[edgeproto.AlertKey]AlertSendContext
+	history     *SyncHistory[edgeproto.AlertKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Alert
@@ -105,6 +107,36 @@ func (s *AlertSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AlertSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AlertSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Alert, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = AlertSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.AlertKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AlertSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AlertSend) Update(ctx context.Context, obj *edgeproto.Alert, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -138,8 +170,23 @@ func (s *AlertSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer str
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -192,6 +239,7 @@ func (s *AlertSend) PrepData() bool {
 // peers to send to.
 type AlertSendMany struct {
 	handler SendAlertHandler
+	history SyncHistory[edgeproto.AlertKey]
 	Mux     sync.Mutex
 	sends   map[string]*AlertSend
 }
@@ -205,6 +253,7 @@ func NewAlertSendMany(handler SendAlertHandler) *AlertSendMany {
 
 func (s *AlertSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAlertSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -226,6 +275,13 @@ func (s *AlertSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AlertSendMany) Update(ctx context.Context, obj *edgeproto.Alert, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -237,6 +293,18 @@ func (s *AlertSendMany) GetTypeString() string {
 	return "Alert"
 }
 
+func (s *AlertSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Alert)(nil))
+}
+
+func (s *AlertSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AlertSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AlertRecv struct {
 	Name        string
 	MessageName string
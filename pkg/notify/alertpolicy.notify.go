@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendAlertPolicyHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.AlertPolicy, modRev int64))
 	GetWithRev(key *edgeproto.AlertPolicyKey, buf *edgeproto.AlertPolicy, modRev *int64) bool
+	HasKey(key *edgeproto.AlertPolicyKey) bool
 }
 
 type RecvAlertPolicyHandler interface {
@@ -49,6 +50,7 @@ type AlertPolicySend struct {
 	handler     SendAlertPolicyHandler
 	Keys        map[edgeproto.AlertPolicyKey]AlertPolicySendContext
 	keysToSend  map[edgeproto.AlertPolicyKey]AlertPolicySendContext
+	history     *SyncHistory[edgeproto.AlertPolicyKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AlertPolicy
@@ -104,6 +106,36 @@ func (s *AlertPolicySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AlertPolicySend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AlertPolicySend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AlertPolicy, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = AlertPolicySendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.AlertPolicyKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AlertPolicySendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AlertPolicySend) Update(ctx context.Context, obj *edgeproto.AlertPolicy, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *AlertPolicySend) Send(stream StreamNotify, notice *edgeproto.Notice, pe
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *AlertPolicySend) PrepData() bool {
 // peers to send to.
 type AlertPolicySendMany struct {
 	handler SendAlertPolicyHandler
+	history SyncHistory[edgeproto.AlertPolicyKey]
 	Mux     sync.Mutex
 	sends   map[string]*AlertPolicySend
 }
@@ -204,6 +252,7 @@ func NewAlertPolicySendMany(handler SendAlertPolicyHandler) *AlertPolicySendMany
 
 func (s *AlertPolicySendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAlertPolicySend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *AlertPolicySendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AlertPolicySendMany) Update(ctx context.Context, obj *edgeproto.AlertPolicy, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *AlertPolicySendMany) GetTypeString() string {
 	return "AlertPolicy"
 }
 
+func (s *AlertPolicySendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.AlertPolicy)(nil))
+}
+
+func (s *AlertPolicySendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AlertPolicySendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AlertPolicyRecv struct {
 	Name        string
 	MessageName string
@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendAppHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.App, modRev int64))
 	GetWithRev(key *edgeproto.AppKey, buf *edgeproto.App, modRev *int64) bool
+	HasKey(key *edgeproto.AppKey) bool
 }
 
 type RecvAppHandler interface {
@@ -50,6 +51,7 @@ type AppSend struct {
 	handler     SendAppHandler
 	Keys        map[edgeproto.AppKey]AppSendContext
 	keysToSend  map[edgeproto.AppKey]AppSendContext
+	history     *SyncHistory[edgeproto.AppKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.App
@@ -108,6 +110,39 @@ func (s *AppSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AppSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.App, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = AppSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.AppKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AppSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AppSend) Update(ctx context.Context, obj *edgeproto.App, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -144,8 +179,23 @@ func (s *AppSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer strin
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -198,6 +248,7 @@ func (s *AppSend) PrepData() bool {
 // peers to send to.
 type AppSendMany struct {
 	handler SendAppHandler
+	history SyncHistory[edgeproto.AppKey]
 	Mux     sync.Mutex
 	sends   map[string]*AppSend
 }
@@ -211,6 +262,7 @@ func NewAppSendMany(handler SendAppHandler) *AppSendMany {
 
 func (s *AppSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAppSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -232,6 +284,13 @@ func (s *AppSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AppSendMany) Update(ctx context.Context, obj *edgeproto.App, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -243,6 +302,18 @@ func (s *AppSendMany) GetTypeString() string {
 	return "App"
 }
 
+func (s *AppSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.App)(nil))
+}
+
+func (s *AppSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AppSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AppRecv struct {
 	Name        string
 	MessageName string
@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendAppInstHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.AppInst, modRev int64))
 	GetWithRev(key *edgeproto.AppInstKey, buf *edgeproto.AppInst, modRev *int64) bool
+	HasKey(key *edgeproto.AppInstKey) bool
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *edgeproto.AppInstCacheData))
 }
 
@@ -51,6 +52,7 @@ type AppInstSend struct {
 	handler     SendAppInstHandler
 	Keys        map[edgeproto.AppInstKey]AppInstSendContext
 	keysToSend  map[edgeproto.AppInstKey]AppInstSendContext
+	history     *SyncHistory[edgeproto.AppInstKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInst
@@ -109,6 +111,39 @@ func (s *AppInstSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AppInstSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AppInst, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = AppInstSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.AppInstKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AppInstSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AppInstSend) Update(ctx context.Context, obj *edgeproto.AppInst, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -159,8 +194,23 @@ func (s *AppInstSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer s
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -213,6 +263,7 @@ func (s *AppInstSend) PrepData() bool {
 // peers to send to.
 type AppInstSendMany struct {
 	handler SendAppInstHandler
+	history SyncHistory[edgeproto.AppInstKey]
 	Mux     sync.Mutex
 	sends   map[string]*AppInstSend
 }
@@ -226,6 +277,7 @@ func NewAppInstSendMany(handler SendAppInstHandler) *AppInstSendMany {
 
 func (s *AppInstSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAppInstSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -247,6 +299,13 @@ func (s *AppInstSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AppInstSendMany) Update(ctx context.Context, obj *edgeproto.AppInst, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -258,6 +317,18 @@ func (s *AppInstSendMany) GetTypeString() string {
 	return "AppInst"
 }
 
+func (s *AppInstSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.AppInst)(nil))
+}
+
+func (s *AppInstSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AppInstSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AppInstRecv struct {
 	Name        string
 	MessageName string
@@ -394,6 +465,7 @@ func (s *Client) RegisterRecvAppInstCache(cache AppInstCacheHandler) {
 type SendAppInstInfoHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.AppInstInfo, modRev int64))
 	GetWithRev(key *edgeproto.AppInstKey, buf *edgeproto.AppInstInfo, modRev *int64) bool
+	HasKey(key *edgeproto.AppInstKey) bool
 }
 
 type RecvAppInstInfoHandler interface {
@@ -415,6 +487,7 @@ type AppInstInfoSend struct {
 	handler     SendAppInstInfoHandler
 	Keys        map[edgeproto.AppInstKey]AppInstInfoSendContext
 	keysToSend  map[edgeproto.AppInstKey]AppInstInfoSendContext
+	history     *SyncHistory[edgeproto.AppInstKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInstInfo
@@ -470,6 +543,36 @@ func (s *AppInstInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstInfoSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AppInstInfoSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AppInstInfo, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = AppInstInfoSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.AppInstKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AppInstInfoSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AppInstInfoSend) Update(ctx context.Context, obj *edgeproto.AppInstInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -503,8 +606,23 @@ func (s *AppInstInfoSend) Send(stream StreamNotify, notice *edgeproto.Notice, pe
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -557,6 +675,7 @@ func (s *AppInstInfoSend) PrepData() bool {
 // peers to send to.
 type AppInstInfoSendMany struct {
 	handler SendAppInstInfoHandler
+	history SyncHistory[edgeproto.AppInstKey]
 	Mux     sync.Mutex
 	sends   map[string]*AppInstInfoSend
 }
@@ -570,6 +689,7 @@ func NewAppInstInfoSendMany(handler SendAppInstInfoHandler) *AppInstInfoSendMany
 
 func (s *AppInstInfoSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAppInstInfoSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -591,6 +711,13 @@ func (s *AppInstInfoSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AppInstInfoSendMany) Update(ctx context.Context, obj *edgeproto.AppInstInfo, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -602,6 +729,18 @@ func (s *AppInstInfoSendMany) GetTypeString() string {
 	return "AppInstInfo"
 }
 
+func (s *AppInstInfoSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.AppInstInfo)(nil))
+}
+
+func (s *AppInstInfoSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AppInstInfoSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AppInstInfoRecv struct {
 	Name        string
 	MessageName string
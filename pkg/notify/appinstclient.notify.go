@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendAppInstClientKeyHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.AppInstClientKey, modRev int64))
 	GetWithRev(key *edgeproto.AppInstClientKey, buf *edgeproto.AppInstClientKey, modRev *int64) bool
+	HasKey(key *edgeproto.AppInstClientKey) bool
 }
 
 type RecvAppInstClientKeyHandler interface {
@@ -50,6 +51,7 @@ type AppInstClientKeySend struct {
 	handler     SendAppInstClientKeyHandler
 	Keys        map[edgeproto.AppInstClientKey]AppInstClientKeySendContext
 	keysToSend  map[edgeproto.AppInstClientKey]AppInstClientKeySendContext
+	history     *SyncHistory[edgeproto.AppInstClientKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInstClientKey
@@ -105,6 +107,36 @@ func (s *AppInstClientKeySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstClientKeySend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AppInstClientKeySend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AppInstClientKey, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = AppInstClientKeySendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.AppInstClientKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AppInstClientKeySendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AppInstClientKeySend) Update(ctx context.Context, obj *edgeproto.AppInstClientKey, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -138,8 +170,23 @@ func (s *AppInstClientKeySend) Send(stream StreamNotify, notice *edgeproto.Notic
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -192,6 +239,7 @@ func (s *AppInstClientKeySend) PrepData() bool {
 // peers to send to.
 type AppInstClientKeySendMany struct {
 	handler SendAppInstClientKeyHandler
+	history SyncHistory[edgeproto.AppInstClientKey]
 	Mux     sync.Mutex
 	sends   map[string]*AppInstClientKeySend
 }
@@ -205,6 +253,7 @@ func NewAppInstClientKeySendMany(handler SendAppInstClientKeyHandler) *AppInstCl
 
 func (s *AppInstClientKeySendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAppInstClientKeySend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -226,6 +275,13 @@ func (s *AppInstClientKeySendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AppInstClientKeySendMany) Update(ctx context.Context, obj *edgeproto.AppInstClientKey, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -237,6 +293,18 @@ func (s *AppInstClientKeySendMany) GetTypeString() string {
 	return "AppInstClientKey"
 }
 
+func (s *AppInstClientKeySendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.AppInstClientKey)(nil))
+}
+
+func (s *AppInstClientKeySendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AppInstClientKeySendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AppInstClientKeyRecv struct {
 	Name        string
 	MessageName string
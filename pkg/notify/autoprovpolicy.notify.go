@@ -30,6 +30,7 @@ var _ = math.Inf
 type SendAutoProvPolicyHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.AutoProvPolicy, modRev int64))
 	GetWithRev(key *edgeproto.PolicyKey, buf *edgeproto.AutoProvPolicy, modRev *int64) bool
+	HasKey(key *edgeproto.PolicyKey) bool
 }
 
 type RecvAutoProvPolicyHandler interface {
@@ -51,6 +52,7 @@ type AutoProvPolicySend struct {
 	handler     SendAutoProvPolicyHandler
 	Keys        map[edgeproto.PolicyKey]AutoProvPolicySendContext
 	keysToSend  map[edgeproto.PolicyKey]AutoProvPolicySendContext
+	history     *SyncHistory[edgeproto.PolicyKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AutoProvPolicy
@@ -106,6 +108,36 @@ func (s *AutoProvPolicySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AutoProvPolicySend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AutoProvPolicySend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AutoProvPolicy, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = AutoProvPolicySendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.PolicyKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AutoProvPolicySendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AutoProvPolicySend) Update(ctx context.Context, obj *edgeproto.AutoProvPolicy, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -139,8 +171,23 @@ func (s *AutoProvPolicySend) Send(stream StreamNotify, notice *edgeproto.Notice,
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -193,6 +240,7 @@ func (s *AutoProvPolicySend) PrepData() bool {
 // peers to send to.
 type AutoProvPolicySendMany struct {
 	handler SendAutoProvPolicyHandler
+	history SyncHistory[edgeproto.PolicyKey]
 	Mux     sync.Mutex
 	sends   map[string]*AutoProvPolicySend
 }
@@ -206,6 +254,7 @@ func NewAutoProvPolicySendMany(handler SendAutoProvPolicyHandler) *AutoProvPolic
 
 func (s *AutoProvPolicySendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAutoProvPolicySend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -227,6 +276,13 @@ func (s *AutoProvPolicySendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AutoProvPolicySendMany) Update(ctx context.Context, obj *edgeproto.AutoProvPolicy, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -238,6 +294,18 @@ func (s *AutoProvPolicySendMany) GetTypeString() string {
 	return "AutoProvPolicy"
 }
 
+func (s *AutoProvPolicySendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.AutoProvPolicy)(nil))
+}
+
+func (s *AutoProvPolicySendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AutoProvPolicySendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AutoProvPolicyRecv struct {
 	Name        string
 	MessageName string
@@ -622,6 +690,7 @@ func (s *AutoProvCountsRecvMany) Flush(ctx context.Context, notifyId int64) {
 type SendAutoProvInfoHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.AutoProvInfo, modRev int64))
 	GetWithRev(key *edgeproto.CloudletKey, buf *edgeproto.AutoProvInfo, modRev *int64) bool
+	HasKey(key *edgeproto.CloudletKey) bool
 }
 
 type RecvAutoProvInfoHandler interface {
@@ -643,6 +712,7 @@ type AutoProvInfoSend struct {
 	handler     SendAutoProvInfoHandler
 	Keys        map[edgeproto.CloudletKey]AutoProvInfoSendContext
 	keysToSend  map[edgeproto.CloudletKey]AutoProvInfoSendContext
+	history     *SyncHistory[edgeproto.CloudletKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AutoProvInfo
@@ -698,6 +768,36 @@ func (s *AutoProvInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AutoProvInfoSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AutoProvInfoSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AutoProvInfo, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = AutoProvInfoSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.CloudletKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AutoProvInfoSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AutoProvInfoSend) Update(ctx context.Context, obj *edgeproto.AutoProvInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -731,8 +831,23 @@ func (s *AutoProvInfoSend) Send(stream StreamNotify, notice *edgeproto.Notice, p
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -785,6 +900,7 @@ func (s *AutoProvInfoSend) PrepData() bool {
 // peers to send to.
 type AutoProvInfoSendMany struct {
 	handler SendAutoProvInfoHandler
+	history SyncHistory[edgeproto.CloudletKey]
 	Mux     sync.Mutex
 	sends   map[string]*AutoProvInfoSend
 }
@@ -798,6 +914,7 @@ func NewAutoProvInfoSendMany(handler SendAutoProvInfoHandler) *AutoProvInfoSendM
 
 func (s *AutoProvInfoSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAutoProvInfoSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -819,6 +936,13 @@ func (s *AutoProvInfoSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AutoProvInfoSendMany) Update(ctx context.Context, obj *edgeproto.AutoProvInfo, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -830,6 +954,18 @@ func (s *AutoProvInfoSendMany) GetTypeString() string {
 	return "AutoProvInfo"
 }
 
+func (s *AutoProvInfoSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.AutoProvInfo)(nil))
+}
+
+func (s *AutoProvInfoSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AutoProvInfoSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AutoProvInfoRecv struct {
 	Name        string
 	MessageName string
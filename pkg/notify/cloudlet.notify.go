@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendCloudletInternalHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.CloudletInternal, modRev int64))
 	GetWithRev(key *edgeproto.CloudletKey, buf *edgeproto.CloudletInternal, modRev *int64) bool
+	HasKey(key *edgeproto.CloudletKey) bool
 }
 
 type RecvCloudletInternalHandler interface {
@@ -50,6 +51,7 @@ type CloudletInternalSend struct {
 	handler     SendCloudletInternalHandler
 	Keys        map[edgeproto.CloudletKey]CloudletInternalSendContext
 	keysToSend  map[edgeproto.CloudletKey]CloudletInternalSendContext
+	history     *SyncHistory[edgeproto.CloudletKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.CloudletInternal
@@ -105,6 +107,36 @@ func (s *CloudletInternalSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletInternalSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *CloudletInternalSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.CloudletInternal, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = CloudletInternalSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.CloudletKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = CloudletInternalSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *CloudletInternalSend) Update(ctx context.Context, obj *edgeproto.CloudletInternal, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -138,8 +170,23 @@ func (s *CloudletInternalSend) Send(stream StreamNotify, notice *edgeproto.Notic
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -192,6 +239,7 @@ func (s *CloudletInternalSend) PrepData() bool {
 // peers to send to.
 type CloudletInternalSendMany struct {
 	handler SendCloudletInternalHandler
+	history SyncHistory[edgeproto.CloudletKey]
 	Mux     sync.Mutex
 	sends   map[string]*CloudletInternalSend
 }
@@ -205,6 +253,7 @@ func NewCloudletInternalSendMany(handler SendCloudletInternalHandler) *CloudletI
 
 func (s *CloudletInternalSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewCloudletInternalSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -226,6 +275,13 @@ func (s *CloudletInternalSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *CloudletInternalSendMany) Update(ctx context.Context, obj *edgeproto.CloudletInternal, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -237,6 +293,18 @@ func (s *CloudletInternalSendMany) GetTypeString() string {
 	return "CloudletInternal"
 }
 
+func (s *CloudletInternalSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.CloudletInternal)(nil))
+}
+
+func (s *CloudletInternalSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *CloudletInternalSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type CloudletInternalRecv struct {
 	Name        string
 	MessageName string
@@ -373,6 +441,7 @@ func (s *Client) RegisterRecvCloudletInternalCache(cache CloudletInternalCacheHa
 type SendPlatformFeaturesHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.PlatformFeatures, modRev int64))
 	GetWithRev(key *edgeproto.PlatformFeaturesKey, buf *edgeproto.PlatformFeatures, modRev *int64) bool
+	HasKey(key *edgeproto.PlatformFeaturesKey) bool
 }
 
 type RecvPlatformFeaturesHandler interface {
@@ -394,6 +463,7 @@ type PlatformFeaturesSend struct {
 	handler     SendPlatformFeaturesHandler
 	Keys        map[edgeproto.PlatformFeaturesKey]PlatformFeaturesSendContext
 	keysToSend  map[edgeproto.PlatformFeaturesKey]PlatformFeaturesSendContext
+	history     *SyncHistory[edgeproto.PlatformFeaturesKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.PlatformFeatures
@@ -449,6 +519,36 @@ func (s *PlatformFeaturesSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *PlatformFeaturesSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *PlatformFeaturesSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.PlatformFeatures, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = PlatformFeaturesSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.PlatformFeaturesKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = PlatformFeaturesSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *PlatformFeaturesSend) Update(ctx context.Context, obj *edgeproto.PlatformFeatures, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -482,8 +582,23 @@ func (s *PlatformFeaturesSend) Send(stream StreamNotify, notice *edgeproto.Notic
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -536,6 +651,7 @@ func (s *PlatformFeaturesSend) PrepData() bool {
 // peers to send to.
 type PlatformFeaturesSendMany struct {
 	handler SendPlatformFeaturesHandler
+	history SyncHistory[edgeproto.PlatformFeaturesKey]
 	Mux     sync.Mutex
 	sends   map[string]*PlatformFeaturesSend
 }
@@ -549,6 +665,7 @@ func NewPlatformFeaturesSendMany(handler SendPlatformFeaturesHandler) *PlatformF
 
 func (s *PlatformFeaturesSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewPlatformFeaturesSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -570,6 +687,13 @@ func (s *PlatformFeaturesSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *PlatformFeaturesSendMany) Update(ctx context.Context, obj *edgeproto.PlatformFeatures, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -581,6 +705,18 @@ func (s *PlatformFeaturesSendMany) GetTypeString() string {
 	return "PlatformFeatures"
 }
 
+func (s *PlatformFeaturesSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.PlatformFeatures)(nil))
+}
+
+func (s *PlatformFeaturesSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *PlatformFeaturesSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type PlatformFeaturesRecv struct {
 	Name        string
 	MessageName string
@@ -717,6 +853,7 @@ func (s *Client) RegisterRecvPlatformFeaturesCache(cache PlatformFeaturesCacheHa
 type SendGPUDriverHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.GPUDriver, modRev int64))
 	GetWithRev(key *edgeproto.GPUDriverKey, buf *edgeproto.GPUDriver, modRev *int64) bool
+	HasKey(key *edgeproto.GPUDriverKey) bool
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *edgeproto.GPUDriverCacheData))
 }
 
@@ -739,6 +876,7 @@ type GPUDriverSend struct {
 	handler     SendGPUDriverHandler
 	Keys        map[edgeproto.GPUDriverKey]GPUDriverSendContext
 	keysToSend  map[edgeproto.GPUDriverKey]GPUDriverSendContext
+	history     *SyncHistory[edgeproto.GPUDriverKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.GPUDriver
@@ -797,6 +935,39 @@ func (s *GPUDriverSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *GPUDriverSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *GPUDriverSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.GPUDriver, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = GPUDriverSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.GPUDriverKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = GPUDriverSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *GPUDriverSend) Update(ctx context.Context, obj *edgeproto.GPUDriver, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -847,8 +1018,23 @@ func (s *GPUDriverSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -901,6 +1087,7 @@ func (s *GPUDriverSend) PrepData() bool {
 // peers to send to.
 type GPUDriverSendMany struct {
 	handler SendGPUDriverHandler
+	history SyncHistory[edgeproto.GPUDriverKey]
 	Mux     sync.Mutex
 	sends   map[string]*GPUDriverSend
 }
@@ -914,6 +1101,7 @@ func NewGPUDriverSendMany(handler SendGPUDriverHandler) *GPUDriverSendMany {
 
 func (s *GPUDriverSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewGPUDriverSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -935,6 +1123,13 @@ func (s *GPUDriverSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *GPUDriverSendMany) Update(ctx context.Context, obj *edgeproto.GPUDriver, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -946,6 +1141,18 @@ func (s *GPUDriverSendMany) GetTypeString() string {
 	return "GPUDriver"
 }
 
+func (s *GPUDriverSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.GPUDriver)(nil))
+}
+
+func (s *GPUDriverSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *GPUDriverSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type GPUDriverRecv struct {
 	Name        string
 	MessageName string
@@ -1082,6 +1289,7 @@ func (s *Client) RegisterRecvGPUDriverCache(cache GPUDriverCacheHandler) {
 type SendCloudletHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Cloudlet, modRev int64))
 	GetWithRev(key *edgeproto.CloudletKey, buf *edgeproto.Cloudlet, modRev *int64) bool
+	HasKey(key *edgeproto.CloudletKey) bool
 }
 
 type RecvCloudletHandler interface {
@@ -1103,6 +1311,7 @@ type CloudletSend struct {
 	handler     SendCloudletHandler
 	Keys        map[edgeproto.CloudletKey]CloudletSendContext
 	keysToSend  map[edgeproto.CloudletKey]CloudletSendContext
+	history     *SyncHistory[edgeproto.CloudletKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Cloudlet
@@ -1161,6 +1370,39 @@ func (s *CloudletSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *CloudletSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Cloudlet, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = CloudletSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.CloudletKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = CloudletSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *CloudletSend) Update(ctx context.Context, obj *edgeproto.Cloudlet, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -1197,8 +1439,23 @@ func (s *CloudletSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -1251,6 +1508,7 @@ func (s *CloudletSend) PrepData() bool {
 // peers to send to.
 type CloudletSendMany struct {
 	handler SendCloudletHandler
+	history SyncHistory[edgeproto.CloudletKey]
 	Mux     sync.Mutex
 	sends   map[string]*CloudletSend
 }
@@ -1264,6 +1522,7 @@ func NewCloudletSendMany(handler SendCloudletHandler) *CloudletSendMany {
 
 func (s *CloudletSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewCloudletSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -1285,6 +1544,13 @@ func (s *CloudletSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *CloudletSendMany) Update(ctx context.Context, obj *edgeproto.Cloudlet, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -1296,6 +1562,18 @@ func (s *CloudletSendMany) GetTypeString() string {
 	return "Cloudlet"
 }
 
+func (s *CloudletSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Cloudlet)(nil))
+}
+
+func (s *CloudletSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *CloudletSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type CloudletRecv struct {
 	Name        string
 	MessageName string
@@ -1433,6 +1711,7 @@ func (s *Client) RegisterRecvCloudletCache(cache CloudletCacheHandler) {
 type SendCloudletInfoHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.CloudletInfo, modRev int64))
 	GetWithRev(key *edgeproto.CloudletKey, buf *edgeproto.CloudletInfo, modRev *int64) bool
+	HasKey(key *edgeproto.CloudletKey) bool
 }
 
 type RecvCloudletInfoHandler interface {
@@ -1454,6 +1733,7 @@ type CloudletInfoSend struct {
 	handler     SendCloudletInfoHandler
 	Keys        map[edgeproto.CloudletKey]CloudletInfoSendContext
 	keysToSend  map[edgeproto.CloudletKey]CloudletInfoSendContext
+	history     *SyncHistory[edgeproto.CloudletKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.CloudletInfo
@@ -1509,6 +1789,36 @@ func (s *CloudletInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletInfoSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *CloudletInfoSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.CloudletInfo, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = CloudletInfoSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.CloudletKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = CloudletInfoSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *CloudletInfoSend) Update(ctx context.Context, obj *edgeproto.CloudletInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -1542,8 +1852,23 @@ func (s *CloudletInfoSend) Send(stream StreamNotify, notice *edgeproto.Notice, p
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -1596,6 +1921,7 @@ func (s *CloudletInfoSend) PrepData() bool {
 // peers to send to.
 type CloudletInfoSendMany struct {
 	handler SendCloudletInfoHandler
+	history SyncHistory[edgeproto.CloudletKey]
 	Mux     sync.Mutex
 	sends   map[string]*CloudletInfoSend
 }
@@ -1609,6 +1935,7 @@ func NewCloudletInfoSendMany(handler SendCloudletInfoHandler) *CloudletInfoSendM
 
 func (s *CloudletInfoSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewCloudletInfoSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -1630,6 +1957,13 @@ func (s *CloudletInfoSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *CloudletInfoSendMany) Update(ctx context.Context, obj *edgeproto.CloudletInfo, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -1641,6 +1975,18 @@ func (s *CloudletInfoSendMany) GetTypeString() string {
 	return "CloudletInfo"
 }
 
+func (s *CloudletInfoSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.CloudletInfo)(nil))
+}
+
+func (s *CloudletInfoSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *CloudletInfoSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type CloudletInfoRecv struct {
 	Name        string
 	MessageName string
@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendCloudletNodeHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.CloudletNode, modRev int64))
 	GetWithRev(key *edgeproto.CloudletNodeKey, buf *edgeproto.CloudletNode, modRev *int64) bool
+	HasKey(key *edgeproto.CloudletNodeKey) bool
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *edgeproto.CloudletNodeCacheData))
 }
 
@@ -50,6 +51,7 @@ type CloudletNodeSend struct {
 	handler     SendCloudletNodeHandler
 	Keys        map[edgeproto.CloudletNodeKey]CloudletNodeSendContext
 	keysToSend  map[edgeproto.CloudletNodeKey]CloudletNodeSendContext
+	history     *SyncHistory[edgeproto.CloudletNodeKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.CloudletNode
@@ -105,6 +107,36 @@ func (s *CloudletNodeSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *CloudletNodeSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *CloudletNodeSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.CloudletNode, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = CloudletNodeSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.CloudletNodeKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = CloudletNodeSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *CloudletNodeSend) Update(ctx context.Context, obj *edgeproto.CloudletNode, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -152,8 +184,23 @@ func (s *CloudletNodeSend) Send(stream StreamNotify, notice *edgeproto.Notice, p
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -206,6 +253,7 @@ func (s *CloudletNodeSend) PrepData() bool {
 // peers to send to.
 type CloudletNodeSendMany struct {
 	handler SendCloudletNodeHandler
+	history SyncHistory[edgeproto.CloudletNodeKey]
 	Mux     sync.Mutex
 	sends   map[string]*CloudletNodeSend
 }
@@ -219,6 +267,7 @@ func NewCloudletNodeSendMany(handler SendCloudletNodeHandler) *CloudletNodeSendM
 
 func (s *CloudletNodeSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewCloudletNodeSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -240,6 +289,13 @@ func (s *CloudletNodeSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *CloudletNodeSendMany) Update(ctx context.Context, obj *edgeproto.CloudletNode, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -251,6 +307,18 @@ func (s *CloudletNodeSendMany) GetTypeString() string {
 	return "CloudletNode"
 }
 
+func (s *CloudletNodeSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.CloudletNode)(nil))
+}
+
+func (s *CloudletNodeSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *CloudletNodeSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type CloudletNodeRecv struct {
 	Name        string
 	MessageName string
@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendClusterInstHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.ClusterInst, modRev int64))
 	GetWithRev(key *edgeproto.ClusterKey, buf *edgeproto.ClusterInst, modRev *int64) bool
+	HasKey(key *edgeproto.ClusterKey) bool
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *edgeproto.ClusterInstCacheData))
 }
 
@@ -51,6 +52,7 @@ type ClusterInstSend struct {
 	handler     SendClusterInstHandler
 	Keys        map[edgeproto.ClusterKey]ClusterInstSendContext
 	keysToSend  map[edgeproto.ClusterKey]ClusterInstSendContext
+	history     *SyncHistory[edgeproto.ClusterKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ClusterInst
@@ -109,6 +111,39 @@ func (s *ClusterInstSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ClusterInstSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *ClusterInstSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ClusterInst, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = ClusterInstSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.ClusterKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = ClusterInstSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *ClusterInstSend) Update(ctx context.Context, obj *edgeproto.ClusterInst, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -159,8 +194,23 @@ func (s *ClusterInstSend) Send(stream StreamNotify, notice *edgeproto.Notice, pe
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -213,6 +263,7 @@ func (s *ClusterInstSend) PrepData() bool {
 // peers to send to.
 type ClusterInstSendMany struct {
 	handler SendClusterInstHandler
+	history SyncHistory[edgeproto.ClusterKey]
 	Mux     sync.Mutex
 	sends   map[string]*ClusterInstSend
 }
@@ -226,6 +277,7 @@ func NewClusterInstSendMany(handler SendClusterInstHandler) *ClusterInstSendMany
 
 func (s *ClusterInstSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewClusterInstSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -247,6 +299,13 @@ func (s *ClusterInstSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *ClusterInstSendMany) Update(ctx context.Context, obj *edgeproto.ClusterInst, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -258,6 +317,18 @@ func (s *ClusterInstSendMany) GetTypeString() string {
 	return "ClusterInst"
 }
 
+func (s *ClusterInstSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.ClusterInst)(nil))
+}
+
+func (s *ClusterInstSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *ClusterInstSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type ClusterInstRecv struct {
 	Name        string
 	MessageName string
@@ -394,6 +465,7 @@ func (s *Client) RegisterRecvClusterInstCache(cache ClusterInstCacheHandler) {
 type SendClusterInstInfoHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.ClusterInstInfo, modRev int64))
 	GetWithRev(key *edgeproto.ClusterKey, buf *edgeproto.ClusterInstInfo, modRev *int64) bool
+	HasKey(key *edgeproto.ClusterKey) bool
 }
 
 type RecvClusterInstInfoHandler interface {
@@ -415,6 +487,7 @@ type ClusterInstInfoSend struct {
 	handler     SendClusterInstInfoHandler
 	Keys        map[edgeproto.ClusterKey]ClusterInstInfoSendContext
 	keysToSend  map[edgeproto.ClusterKey]ClusterInstInfoSendContext
+	history     *SyncHistory[edgeproto.ClusterKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ClusterInstInfo
@@ -470,6 +543,36 @@ func (s *ClusterInstInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ClusterInstInfoSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *ClusterInstInfoSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ClusterInstInfo, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = ClusterInstInfoSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.ClusterKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = ClusterInstInfoSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *ClusterInstInfoSend) Update(ctx context.Context, obj *edgeproto.ClusterInstInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -503,8 +606,23 @@ func (s *ClusterInstInfoSend) Send(stream StreamNotify, notice *edgeproto.Notice
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -557,6 +675,7 @@ func (s *ClusterInstInfoSend) PrepData() bool {
 // peers to send to.
 type ClusterInstInfoSendMany struct {
 	handler SendClusterInstInfoHandler
+	history SyncHistory[edgeproto.ClusterKey]
 	Mux     sync.Mutex
 	sends   map[string]*ClusterInstInfoSend
 }
@@ -570,6 +689,7 @@ func NewClusterInstInfoSendMany(handler SendClusterInstInfoHandler) *ClusterInst
 
 func (s *ClusterInstInfoSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewClusterInstInfoSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -591,6 +711,13 @@ func (s *ClusterInstInfoSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *ClusterInstInfoSendMany) Update(ctx context.Context, obj *edgeproto.ClusterInstInfo, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -602,6 +729,18 @@ func (s *ClusterInstInfoSendMany) GetTypeString() string {
 	return "ClusterInstInfo"
 }
 
+func (s *ClusterInstInfoSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.ClusterInstInfo)(nil))
+}
+
+func (s *ClusterInstInfoSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *ClusterInstInfoSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type ClusterInstInfoRecv struct {
 	Name        string
 	MessageName string
@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendDeviceHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Device, modRev int64))
 	GetWithRev(key *edgeproto.DeviceKey, buf *edgeproto.Device, modRev *int64) bool
+	HasKey(key *edgeproto.DeviceKey) bool
 }
 
 type RecvDeviceHandler interface {
@@ -50,6 +51,7 @@ type DeviceSend struct {
 	handler     SendDeviceHandler
 	Keys        map[edgeproto.DeviceKey]DeviceSendContext
 	keysToSend  map[edgeproto.DeviceKey]DeviceSendContext
+	history     *SyncHistory[edgeproto.DeviceKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Device
@@ -105,6 +107,36 @@ func (s *DeviceSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *DeviceSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *DeviceSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Device, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = DeviceSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.DeviceKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = DeviceSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *DeviceSend) Update(ctx context.Context, obj *edgeproto.Device, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -138,8 +170,23 @@ func (s *DeviceSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer st
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -192,6 +239,7 @@ func (s *DeviceSend) PrepData() bool {
 // peers to send to.
 type DeviceSendMany struct {
 	handler SendDeviceHandler
+	history SyncHistory[edgeproto.DeviceKey]
 	Mux     sync.Mutex
 	sends   map[string]*DeviceSend
 }
@@ -205,6 +253,7 @@ func NewDeviceSendMany(handler SendDeviceHandler) *DeviceSendMany {
 
 func (s *DeviceSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewDeviceSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -226,6 +275,13 @@ func (s *DeviceSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *DeviceSendMany) Update(ctx context.Context, obj *edgeproto.Device, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -237,6 +293,18 @@ func (s *DeviceSendMany) GetTypeString() string {
 	return "Device"
 }
 
+func (s *DeviceSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Device)(nil))
+}
+
+func (s *DeviceSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *DeviceSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type DeviceRecv struct {
 	Name        string
 	MessageName string
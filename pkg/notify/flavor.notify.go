@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendFlavorHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Flavor, modRev int64))
 	GetWithRev(key *edgeproto.FlavorKey, buf *edgeproto.Flavor, modRev *int64) bool
+	HasKey(key *edgeproto.FlavorKey) bool
 }
 
 type RecvFlavorHandler interface {
@@ -49,6 +50,7 @@ type FlavorSend struct {
 	handler     SendFlavorHandler
 	Keys        map[edgeproto.FlavorKey]FlavorSendContext
 	keysToSend  map[edgeproto.FlavorKey]FlavorSendContext
+	history     *SyncHistory[edgeproto.FlavorKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Flavor
@@ -104,6 +106,36 @@ func (s *FlavorSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *FlavorSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *FlavorSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Flavor, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = FlavorSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.FlavorKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = FlavorSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *FlavorSend) Update(ctx context.Context, obj *edgeproto.Flavor, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *FlavorSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer st
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *FlavorSend) PrepData() bool {
 // peers to send to.
 type FlavorSendMany struct {
 	handler SendFlavorHandler
+	history SyncHistory[edgeproto.FlavorKey]
 	Mux     sync.Mutex
 	sends   map[string]*FlavorSend
 }
@@ -204,6 +252,7 @@ func NewFlavorSendMany(handler SendFlavorHandler) *FlavorSendMany {
 
 func (s *FlavorSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewFlavorSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *FlavorSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *FlavorSendMany) Update(ctx context.Context, obj *edgeproto.Flavor, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *FlavorSendMany) GetTypeString() string {
 	return "Flavor"
 }
 
+func (s *FlavorSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Flavor)(nil))
+}
+
+func (s *FlavorSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *FlavorSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type FlavorRecv struct {
 	Name        string
 	MessageName string
@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendNetworkHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Network, modRev int64))
 	GetWithRev(key *edgeproto.NetworkKey, buf *edgeproto.Network, modRev *int64) bool
+	HasKey(key *edgeproto.NetworkKey) bool
 }
 
 type RecvNetworkHandler interface {
@@ -49,6 +50,7 @@ type NetworkSend struct {
 	handler     SendNetworkHandler
 	Keys        map[edgeproto.NetworkKey]NetworkSendContext
 	keysToSend  map[edgeproto.NetworkKey]NetworkSendContext
+	history     *SyncHistory[edgeproto.NetworkKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Network
@@ -104,6 +106,36 @@ func (s *NetworkSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *NetworkSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *NetworkSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Network, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = NetworkSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.NetworkKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = NetworkSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *NetworkSend) Update(ctx context.Context, obj *edgeproto.Network, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *NetworkSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer s
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *NetworkSend) PrepData() bool {
 // peers to send to.
 type NetworkSendMany struct {
 	handler SendNetworkHandler
+	history SyncHistory[edgeproto.NetworkKey]
 	Mux     sync.Mutex
 	sends   map[string]*NetworkSend
 }
@@ -204,6 +252,7 @@ func NewNetworkSendMany(handler SendNetworkHandler) *NetworkSendMany {
 
 func (s *NetworkSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewNetworkSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *NetworkSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *NetworkSendMany) Update(ctx context.Context, obj *edgeproto.Network, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *NetworkSendMany) GetTypeString() string {
 	return "Network"
 }
 
+func (s *NetworkSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Network)(nil))
+}
+
+func (s *NetworkSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *NetworkSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type NetworkRecv struct {
 	Name        string
 	MessageName string
@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendNodeHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Node, modRev int64))
 	GetWithRev(key *edgeproto.NodeKey, buf *edgeproto.Node, modRev *int64) bool
+	HasKey(key *edgeproto.NodeKey) bool
 }
 
 type RecvNodeHandler interface {
@@ -49,6 +50,7 @@ type NodeSend struct {
 	handler     SendNodeHandler
 	Keys        map[edgeproto.NodeKey]NodeSendContext
 	keysToSend  map[edgeproto.NodeKey]NodeSendContext
+	history     *SyncHistory[edgeproto.NodeKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Node
@@ -104,6 +106,36 @@ func (s *NodeSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *NodeSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *NodeSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Node, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = NodeSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.NodeKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = NodeSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *NodeSend) Update(ctx context.Context, obj *edgeproto.Node, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *NodeSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer stri
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *NodeSend) PrepData() bool {
 // peers to send to.
 type NodeSendMany struct {
 	handler SendNodeHandler
+	history SyncHistory[edgeproto.NodeKey]
 	Mux     sync.Mutex
 	sends   map[string]*NodeSend
 }
@@ -204,6 +252,7 @@ func NewNodeSendMany(handler SendNodeHandler) *NodeSendMany {
 
 func (s *NodeSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewNodeSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *NodeSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *NodeSendMany) Update(ctx context.Context, obj *edgeproto.Node, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *NodeSendMany) GetTypeString() string {
 	return "Node"
 }
 
+func (s *NodeSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Node)(nil))
+}
+
+func (s *NodeSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *NodeSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type NodeRecv struct {
 	Name        string
 	MessageName string
@@ -220,6 +220,7 @@ func (s *Client) negotiate(stream StreamNotify) error {
 	request.WantObjs = s.sendrecv.localWanted
 	request.FilterCloudletKey = s.sendrecv.filterCloudletKeys
 	request.FilterFederatedCloudlet = s.sendrecv.filterFederatedCloudlet
+	request.SyncRevs = s.sendrecv.getSyncRevs()
 	request.Tags = map[string]string{
 		"name": s.name,
 	}
@@ -239,6 +240,13 @@ func (s *Client) negotiate(stream StreamNotify) error {
 		s.version = request.Version
 	}
 	s.sendrecv.setRemoteWanted(reply.WantObjs)
+	incrementalRevs := make(map[string]int64)
+	for _, name := range reply.IncrementalObjs {
+		if rev, found := request.SyncRevs[name]; found {
+			incrementalRevs[name] = rev
+		}
+	}
+	s.sendrecv.setIncrementalRevs(incrementalRevs)
 	if reply.Tags != nil {
 		if peer, found := reply.Tags["name"]; found {
 			s.sendrecv.peer = peer
@@ -255,6 +263,7 @@ func (s *Client) negotiate(stream StreamNotify) error {
 		"remoteWanted", s.sendrecv.remoteWanted,
 		"filterCloudletKey", s.sendrecv.filterCloudletKeys,
 		"filterFederatedCloudlet", s.sendrecv.filterFederatedCloudlet,
+		"incrementalRevs", incrementalRevs,
 		"tries", s.sendrecv.stats.Tries,
 		"connects", s.sendrecv.stats.Connects)
 	return nil
@@ -258,6 +258,23 @@ func (s *TPEInstanceStateSend) UpdateAllOkLocked(tpe *edgeproto.TPEInstanceState
 	return !s.sendrecv.filterCloudletKeys
 }
 
+// Objects filtered by Cloudlet in UpdateAllOkLocked cannot be
+// synced incrementally to filtered clients.
+
+func (s *AppInstSendMany) IsCloudletFiltered() bool { return true }
+
+func (s *CloudletSendMany) IsCloudletFiltered() bool { return true }
+
+func (s *ClusterInstSendMany) IsCloudletFiltered() bool { return true }
+
+func (s *VMPoolSendMany) IsCloudletFiltered() bool { return true }
+
+func (s *GPUDriverSendMany) IsCloudletFiltered() bool { return true }
+
+func (s *TrustPolicyExceptionSendMany) IsCloudletFiltered() bool { return true }
+
+func (s *TPEInstanceStateSendMany) IsCloudletFiltered() bool { return true }
+
 func (s *CloudletInfoRecv) RecvHook(ctx context.Context, notice *edgeproto.Notice, buf *edgeproto.CloudletInfo, peerAddr string) {
 	log.SpanLog(ctx, log.DebugLevelNotify, "CloudletInfo RecvHook", "key", buf.Key, "state", buf.State)
 
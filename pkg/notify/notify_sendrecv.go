@@ -55,6 +55,16 @@ type NotifySend interface {
 	SendForCloudlet(ctx context.Context, action edgeproto.NoticeAction, cloudlet *edgeproto.Cloudlet)
 }
 
+// NotifySendIncremental is implemented by auto-generated code for
+// cached objects. It allows for sending only the changes since a
+// database revision, instead of all cached data.
+type NotifySendIncremental interface {
+	// Check if all changes since the revision are known
+	HasSyncHistory(rev int64) bool
+	// Queue cached data changed since the revision for send
+	UpdateSince(ctx context.Context, rev int64)
+}
+
 // NotifyRecv is implemented by auto-generated code. The same
 // comment as for NotifySend applies here as well.
 type NotifyRecv interface {
@@ -88,19 +98,21 @@ type StreamNotify interface {
 }
 
 type Stats struct {
-	Tries           uint64
-	Connects        uint64
-	NegotiateErrors uint64
-	SendAll         uint64
-	SendAllEnd      uint64
-	Send            uint64
-	Recv            uint64
-	RecvErrors      uint64
-	SendErrors      uint64
-	MarshalErrors   uint64
-	UnmarshalErrors uint64
-	ObjSend         map[string]uint64
-	ObjRecv         map[string]uint64
+	Tries            uint64
+	Connects         uint64
+	NegotiateErrors  uint64
+	SendAll          uint64
+	SendAllEnd       uint64
+	Send             uint64
+	Recv             uint64
+	RecvErrors       uint64
+	SendErrors       uint64
+	MarshalErrors    uint64
+	UnmarshalErrors  uint64
+	FullSyncs        uint64
+	IncrementalSyncs uint64
+	ObjSend          map[string]uint64
+	ObjRecv          map[string]uint64
 }
 
 // There are two ways to clean up stale cache entries.
@@ -114,11 +126,14 @@ type Stats struct {
 // but Prune stale entries after reconnect and receiving
 // the SendAllEnd message. All entries not sent by the
 // server are pruned (deleted).
+// Objects that are sent incrementally are not pruned, as only
+// the changes since the last sync are sent.
 type Cleanup int
 
 const (
 	CleanupPrune Cleanup = iota
 	CleanupFlush
+	CleanupNone
 )
 
 type SendRecv struct {
@@ -148,6 +163,11 @@ type SendRecv struct {
 	sendAllEnd              bool
 	manualSendAllEnd        bool
 	sendAllRecvHandler      SendAllRecv
+	// database revisions synced per object type
+	syncRevs        map[string]int64
+	pendingSyncRevs map[string]int64
+	// object types being synced incrementally, by revision
+	incrementalRevs map[string]int64
 }
 
 func (s *SendRecv) init(name, cliserv string) {
@@ -159,6 +179,8 @@ func (s *SendRecv) init(name, cliserv string) {
 	s.remoteWanted = make(map[string]struct{})
 	s.cloudletKeys = make(map[edgeproto.CloudletKey]struct{})
 	s.signal = make(chan bool, 1)
+	s.syncRevs = make(map[string]int64)
+	s.incrementalRevs = make(map[string]int64)
 }
 
 func (s *SendRecv) registerSend(send NotifySend) {
@@ -216,6 +238,72 @@ func (s *SendRecv) triggerSendAllEnd() {
 	}
 }
 
+func (s *SendRecv) getSyncRevs() map[string]int64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	revs := make(map[string]int64)
+	for name, rev := range s.syncRevs {
+		revs[name] = rev
+	}
+	return revs
+}
+
+func (s *SendRecv) setIncrementalRevs(revs map[string]int64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.incrementalRevs = revs
+	if len(revs) > 0 {
+		s.stats.IncrementalSyncs++
+	} else {
+		s.stats.FullSyncs++
+	}
+}
+
+func (s *SendRecv) getIncrementalRev(name string) (int64, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	rev, found := s.incrementalRevs[name]
+	return rev, found
+}
+
+// updateSyncRev tracks the revision up to which all changes have
+// been received for the object type. Revisions received during
+// the initial send all are not valid until the send all is done.
+func (s *SendRecv) updateSyncRev(name string, rev int64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.pendingSyncRevs != nil {
+		if rev > s.pendingSyncRevs[name] {
+			s.pendingSyncRevs[name] = rev
+		}
+	} else if rev > s.syncRevs[name] {
+		s.syncRevs[name] = rev
+	}
+}
+
+func (s *SendRecv) startSyncRevs() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.pendingSyncRevs = make(map[string]int64)
+}
+
+func (s *SendRecv) commitSyncRevs() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for name := range s.recvmap {
+		if _, found := s.incrementalRevs[name]; !found {
+			// full sync, revision from a previous sync is stale
+			delete(s.syncRevs, name)
+		}
+	}
+	for name, rev := range s.pendingSyncRevs {
+		if rev > s.syncRevs[name] {
+			s.syncRevs[name] = rev
+		}
+	}
+	s.pendingSyncRevs = nil
+}
+
 func (s *SendRecv) isRemoteWanted(name string) bool {
 	s.mux.Lock()
 	defer s.mux.Unlock()
@@ -264,7 +352,13 @@ func (s *SendRecv) send(stream StreamNotify) {
 		// it depended on.
 		for ii := len(s.sendlist) - 1; ii >= 0; ii-- {
 			if sendAll {
-				s.sendlist[ii].UpdateAll(sendAllCtx)
+				send := s.sendlist[ii]
+				rev, found := s.getIncrementalRev(send.GetMessageName())
+				if incSend, ok := send.(NotifySendIncremental); ok && found {
+					incSend.UpdateSince(sendAllCtx, rev)
+				} else {
+					send.UpdateAll(sendAllCtx)
+				}
 			}
 			if s.sendlist[ii].PrepData() {
 				hasData = true
@@ -285,6 +379,7 @@ func (s *SendRecv) send(stream StreamNotify) {
 		// caller to make sure CacheSend objects are registered
 		// in the desired send order.
 		for _, send := range s.sendlist {
+			notice.SyncRev = 0
 			err = send.Send(stream, &notice, s.peerAddr)
 			if err != nil {
 				break
@@ -298,6 +393,7 @@ func (s *SendRecv) send(stream StreamNotify) {
 			log.SpanLog(sendAllCtx, log.DebugLevelNotify, fmt.Sprintf("%s send all end", s.cliserv), "peer", s.peer, "local", s.name)
 			notice.Action = edgeproto.NoticeAction_SENDALL_END
 			notice.Any = types.Any{}
+			notice.SyncRev = 0
 			notice.Span = log.SpanToString(sendAllCtx)
 			err = stream.Send(&notice)
 			if err != nil {
@@ -334,6 +430,7 @@ func (s *SendRecv) recv(stream StreamNotify, notifyId int64, cleanup Cleanup) {
 	for _, recv := range s.recvmap {
 		recv.RecvAllStart()
 	}
+	s.startSyncRevs()
 	for !s.done {
 		notice, err := stream.Recv()
 		if s.done {
@@ -363,9 +460,14 @@ func (s *SendRecv) recv(stream StreamNotify, notifyId int64, cleanup Cleanup) {
 			}
 			if recvAll && notice.Action == edgeproto.NoticeAction_SENDALL_END {
 				log.SpanLog(ctx, log.DebugLevelNotify, fmt.Sprintf("%s recv sendall end", s.cliserv), "peer", s.peer, "local", s.name)
-				for _, recv := range s.recvmap {
-					recv.RecvAllEnd(ctx, cleanup)
+				for name, recv := range s.recvmap {
+					if _, found := s.getIncrementalRev(name); found && cleanup == CleanupPrune {
+						recv.RecvAllEnd(ctx, CleanupNone)
+					} else {
+						recv.RecvAllEnd(ctx, cleanup)
+					}
 				}
+				s.commitSyncRevs()
 				sendAllRecv := s.sendAllRecvHandler
 				if sendAllRecv != nil {
 					sendAllRecv.RecvAllEnd(ctx)
@@ -377,6 +479,9 @@ func (s *SendRecv) recv(stream StreamNotify, notifyId int64, cleanup Cleanup) {
 			recv := s.recvmap[name]
 			if recv != nil {
 				recv.Recv(ctx, notice, notifyId, s.peerAddr)
+				if notice.SyncRev > 0 {
+					s.updateSyncRev(name, notice.SyncRev)
+				}
 			} else {
 				log.DebugLog(log.DebugLevelNotify,
 					fmt.Sprintf("%s recv unhandled", s.cliserv),
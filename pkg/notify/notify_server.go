@@ -77,6 +77,28 @@ type ServerMgr struct {
 	regServ  func(s *grpc.Server)
 }
 
+// NotifySendManyHistory is implemented by auto-generated code for
+// cached objects. It tracks the history needed to send only the
+// changes since a client's last synced revision.
+type NotifySendManyHistory interface {
+	// Get the proto message name
+	GetMessageName() string
+	// Set the revision after which history is complete
+	SetSyncHistoryStartRev(rev int64)
+	// Check if all changes since the revision are known
+	HasSyncHistory(rev int64) bool
+}
+
+// NotifySendManyFiltered is implemented by hand for cached objects
+// that are filtered by Cloudlet for filtered clients. Which of
+// these objects a filtered client gets depends on the Cloudlets
+// the client reports after it connects, and deleted objects are
+// not tracked per Cloudlet, so these objects are always sent in
+// full to filtered clients.
+type NotifySendManyFiltered interface {
+	IsCloudletFiltered() bool
+}
+
 // NotifySendMany and NotifyRecvMany are implemented by auto-generated code.
 // They are simply thin layers which can create new NotifySend/NotifyRecv
 // implementations which are object-specific. These are required by the
@@ -208,7 +230,7 @@ func (mgr *ServerMgr) StreamNotice(stream edgeproto.NotifyApi_StreamNoticeServer
 	// do initial version exchange before registering sends,
 	// otherwise sends could start before cloudlet/federation
 	// filters specified during negotiate are applied.
-	err := server.negotiate(spctx, stream, mgr.name)
+	err := server.negotiate(spctx, stream, mgr)
 	if err != nil {
 		server.logDisconnect(spctx, err)
 		close(server.running)
@@ -285,6 +307,51 @@ func (mgr *ServerMgr) GetStats(peerAddr string) *Stats {
 	return stats
 }
 
+// SetSyncHistoryStartRev enables incremental sync for the given
+// cached object types. It must only be used for objects backed by
+// the database, whose modRevs are database revisions. The rev is
+// the database revision the caches were synced to, and all changes
+// after it must be notified to the ServerMgr.
+func (mgr *ServerMgr) SetSyncHistoryStartRev(rev int64, typeStrings []string) {
+	mgr.mux.Lock()
+	defer mgr.mux.Unlock()
+	types := make(map[string]struct{})
+	for _, typ := range typeStrings {
+		types[typ] = struct{}{}
+	}
+	for _, send := range mgr.sends {
+		if _, found := types[send.GetTypeString()]; !found {
+			continue
+		}
+		if hist, ok := send.(NotifySendManyHistory); ok {
+			hist.SetSyncHistoryStartRev(rev)
+		}
+	}
+}
+
+// getIncrementalRevs determines which of the client's synced
+// revisions allow for an incremental sync.
+func (mgr *ServerMgr) getIncrementalRevs(syncRevs map[string]int64, filtered bool) map[string]int64 {
+	mgr.mux.Lock()
+	defer mgr.mux.Unlock()
+	revs := make(map[string]int64)
+	for _, send := range mgr.sends {
+		hist, ok := send.(NotifySendManyHistory)
+		if !ok {
+			continue
+		}
+		if f, ok := send.(NotifySendManyFiltered); ok && filtered && f.IsCloudletFiltered() {
+			continue
+		}
+		name := hist.GetMessageName()
+		rev, found := syncRevs[name]
+		if found && hist.HasSyncHistory(rev) {
+			revs[name] = rev
+		}
+	}
+	return revs
+}
+
 // Get order of sends based on SendAll type
 func (s *ServerMgr) GetSendOrder() map[string]int {
 	order := make(map[string]int)
@@ -294,7 +361,7 @@ func (s *ServerMgr) GetSendOrder() map[string]int {
 	return order
 }
 
-func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_StreamNoticeServer, name string) error {
+func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_StreamNoticeServer, mgr *ServerMgr) error {
 	var notice edgeproto.Notice
 	// initial connection is version exchange
 	// this also sets the connection Id so we can ignore spurious old
@@ -318,6 +385,11 @@ func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_Strea
 	} else {
 		s.sendrecv.sendAllEnd = true
 	}
+	// For filtered clients like CRMs, objects filtered by Cloudlet
+	// always get a full sync, other objects may be incremental.
+	filtered := s.sendrecv.filterCloudletKeys || s.sendrecv.filterFederatedCloudlet
+	incrementalRevs := mgr.getIncrementalRevs(req.SyncRevs, filtered)
+	s.sendrecv.setIncrementalRevs(incrementalRevs)
 	// use lowest common version
 	if req.Version > NotifyVersion {
 		s.version = req.Version
@@ -334,8 +406,11 @@ func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_Strea
 	notice.Action = edgeproto.NoticeAction_VERSION
 	notice.Version = s.version
 	notice.WantObjs = s.sendrecv.localWanted
+	for name := range incrementalRevs {
+		notice.IncrementalObjs = append(notice.IncrementalObjs, name)
+	}
 	notice.Tags = map[string]string{
-		"name": name,
+		"name": mgr.name,
 	}
 	err = stream.Send(&notice)
 	if err != nil {
@@ -349,7 +424,8 @@ func (s *Server) negotiate(ctx context.Context, stream edgeproto.NotifyApi_Strea
 		"notifyid", s.notifyId,
 		"remoteWanted", s.sendrecv.remoteWanted,
 		"filterCloudletKey", s.sendrecv.filterCloudletKeys,
-		"filterFederatedCloudlet", s.sendrecv.filterFederatedCloudlet)
+		"filterFederatedCloudlet", s.sendrecv.filterFederatedCloudlet,
+		"incrementalRevs", incrementalRevs)
 	return nil
 }
 
@@ -296,3 +296,155 @@ func TestNotifyBasic(t *testing.T) {
 	clientDME.Stop()
 	clientCRM.Stop()
 }
+
+func TestNotifyIncrementalSync(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	// override retry time
+	NotifyRetryTime = 10 * time.Millisecond
+
+	addr := "127.0.0.1:61235"
+	serverAddrs := []string{addr}
+	appInstName := "edgeproto.AppInst"
+
+	// Set up server, with history for the database-backed caches
+	serverHandler := NewDummyHandler()
+	serverMgr := ServerMgr{}
+	serverHandler.RegisterServer(&serverMgr)
+	serverMgr.SetSyncHistoryStartRev(1, []string{"App", "AppInst"})
+	serverMgr.Start("ctrl", addr, nil)
+	defer serverMgr.Stop()
+
+	appInsts := testutil.CreatedAppInstData()
+	serverHandler.AppInstCache.Update(ctx, &appInsts[0], 2)
+	serverHandler.AppInstCache.Update(ctx, &appInsts[1], 3)
+	serverHandler.AppInstCache.Update(ctx, &appInsts[2], 4)
+
+	// Set up client DME
+	dmeHandler := NewDummyHandler()
+	clientDME := NewClient("dme", serverAddrs, grpc.WithInsecure())
+	dmeHandler.RegisterDMEClient(clientDME)
+	clientDME.Start()
+	defer clientDME.Stop()
+
+	// Initial connect requires a full sync
+	require.Nil(t, clientDME.WaitForConnect(1))
+	require.Nil(t, clientDME.WaitForSendAllEnd(1))
+	require.Nil(t, dmeHandler.WaitForAppInsts(3))
+	stats := Stats{}
+	clientDME.GetStats(&stats)
+	require.Equal(t, uint64(1), stats.FullSyncs)
+	require.Equal(t, uint64(0), stats.IncrementalSyncs)
+	require.Equal(t, int64(4), clientDME.sendrecv.getSyncRevs()[appInstName])
+
+	// Changes while disconnected are sent incrementally on reconnect
+	serverMgr.Stop()
+	serverHandler.AppInstCache.Delete(ctx, &appInsts[0], 5)
+	serverHandler.AppInstCache.Update(ctx, &appInsts[1], 6)
+	serverMgr.Start("ctrl", addr, nil)
+	require.Nil(t, clientDME.WaitForConnect(2))
+	require.Nil(t, clientDME.WaitForSendAllEnd(2))
+	require.Nil(t, dmeHandler.WaitForAppInsts(2))
+	clientDME.GetStats(&stats)
+	require.Equal(t, uint64(1), stats.FullSyncs)
+	require.Equal(t, uint64(1), stats.IncrementalSyncs)
+	require.Equal(t, int64(6), clientDME.sendrecv.getSyncRevs()[appInstName])
+	serverStats := serverMgr.GetStats(clientDME.GetLocalAddr())
+	require.Equal(t, uint64(2), serverStats.ObjSend["AppInst"])
+	require.Equal(t, uint64(1), serverStats.IncrementalSyncs)
+
+	// If deletes have been dropped from the history, fall back
+	// to a full sync, which prunes the deleted objects.
+	maxDeletes := SyncHistoryMaxDeletes
+	SyncHistoryMaxDeletes = 1
+	defer func() {
+		SyncHistoryMaxDeletes = maxDeletes
+	}()
+	serverMgr.Stop()
+	serverHandler.AppInstCache.Delete(ctx, &appInsts[1], 7)
+	serverHandler.AppInstCache.Delete(ctx, &appInsts[2], 8)
+	serverHandler.AppInstCache.Update(ctx, &appInsts[3], 9)
+	serverMgr.Start("ctrl", addr, nil)
+	require.Nil(t, clientDME.WaitForConnect(3))
+	require.Nil(t, clientDME.WaitForSendAllEnd(3))
+	require.Nil(t, dmeHandler.WaitForAppInsts(1))
+	clientDME.GetStats(&stats)
+	require.Equal(t, uint64(2), stats.FullSyncs)
+	require.Equal(t, uint64(1), stats.IncrementalSyncs)
+	require.Equal(t, int64(9), clientDME.sendrecv.getSyncRevs()[appInstName])
+	appInstBuf := edgeproto.AppInst{}
+	require.True(t, dmeHandler.AppInstCache.Get(&appInsts[3].Key, &appInstBuf))
+}
+
+func TestNotifyIncrementalSyncCRM(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelNotify)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	// override retry time
+	NotifyRetryTime = 10 * time.Millisecond
+
+	addr := "127.0.0.1:61236"
+	serverAddrs := []string{addr}
+	appName := "edgeproto.App"
+	appInstName := "edgeproto.AppInst"
+
+	// Set up server, with history for the database-backed caches
+	serverHandler := NewDummyHandler()
+	serverMgr := ServerMgr{}
+	serverHandler.RegisterServer(&serverMgr)
+	serverMgr.SetSyncHistoryStartRev(1, []string{"App", "AppInst", "Cloudlet"})
+	serverMgr.Start("ctrl", addr, nil)
+	defer serverMgr.Stop()
+
+	apps := testutil.AppData()
+	appInsts := testutil.CreatedAppInstData()
+	serverHandler.CloudletCache.Update(ctx, &testutil.CloudletData()[0], 2)
+	serverHandler.AppCache.Update(ctx, &apps[0], 3)
+	serverHandler.AppCache.Update(ctx, &apps[1], 4)
+	serverHandler.AppCache.Update(ctx, &apps[2], 5)
+	serverHandler.AppInstCache.Update(ctx, &appInsts[0], 6)
+	serverHandler.AppInstCache.Update(ctx, &appInsts[1], 7)
+
+	// Set up client CRM
+	crmHandler := NewDummyHandler()
+	clientCRM := NewClient("crm", serverAddrs, grpc.WithInsecure())
+	crmHandler.RegisterCRMClient(clientCRM)
+	clientCRM.Start()
+	defer clientCRM.Stop()
+
+	// Initial connect requires a full sync
+	require.Nil(t, clientCRM.WaitForConnect(1))
+	crmHandler.CloudletInfoCache.Update(ctx, &testutil.CloudletInfoData()[0], 0)
+	require.Nil(t, clientCRM.WaitForSendAllEnd(1))
+	require.Nil(t, crmHandler.WaitForApps(3))
+	require.Nil(t, crmHandler.WaitForAppInsts(2))
+	stats := Stats{}
+	clientCRM.GetStats(&stats)
+	require.Equal(t, uint64(1), stats.FullSyncs)
+	require.Equal(t, uint64(0), stats.IncrementalSyncs)
+
+	// On reconnect, Apps are synced incrementally, while the
+	// AppInsts filtered by Cloudlet are sent in full.
+	serverMgr.Stop()
+	serverHandler.AppCache.Delete(ctx, &apps[2], 8)
+	serverHandler.AppInstCache.Delete(ctx, &appInsts[1], 9)
+	serverMgr.Start("ctrl", addr, nil)
+	require.Nil(t, clientCRM.WaitForConnect(2))
+	require.Nil(t, clientCRM.WaitForSendAllEnd(2))
+	require.Nil(t, crmHandler.WaitForApps(2))
+	require.Nil(t, crmHandler.WaitForAppInsts(1))
+	clientCRM.GetStats(&stats)
+	require.Equal(t, uint64(1), stats.FullSyncs)
+	require.Equal(t, uint64(1), stats.IncrementalSyncs)
+	_, found := clientCRM.sendrecv.getIncrementalRev(appName)
+	require.True(t, found)
+	_, found = clientCRM.sendrecv.getIncrementalRev(appInstName)
+	require.False(t, found)
+	appInstBuf := edgeproto.AppInst{}
+	require.True(t, crmHandler.AppInstCache.Get(&appInsts[0].Key, &appInstBuf))
+}
@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendOperatorCodeHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.OperatorCode, modRev int64))
 	GetWithRev(key *edgeproto.OperatorCodeKey, buf *edgeproto.OperatorCode, modRev *int64) bool
+	HasKey(key *edgeproto.OperatorCodeKey) bool
 }
 
 type RecvOperatorCodeHandler interface {
@@ -49,6 +50,7 @@ type OperatorCodeSend struct {
 	handler     SendOperatorCodeHandler
 	Keys        map[edgeproto.OperatorCodeKey]OperatorCodeSendContext
 	keysToSend  map[edgeproto.OperatorCodeKey]OperatorCodeSendContext
+	history     *SyncHistory[edgeproto.OperatorCodeKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.OperatorCode
@@ -104,6 +106,36 @@ func (s *OperatorCodeSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *OperatorCodeSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *OperatorCodeSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.OperatorCode, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = OperatorCodeSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.OperatorCodeKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = OperatorCodeSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *OperatorCodeSend) Update(ctx context.Context, obj *edgeproto.OperatorCode, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *OperatorCodeSend) Send(stream StreamNotify, notice *edgeproto.Notice, p
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *OperatorCodeSend) PrepData() bool {
 // peers to send to.
 type OperatorCodeSendMany struct {
 	handler SendOperatorCodeHandler
+	history SyncHistory[edgeproto.OperatorCodeKey]
 	Mux     sync.Mutex
 	sends   map[string]*OperatorCodeSend
 }
@@ -204,6 +252,7 @@ func NewOperatorCodeSendMany(handler SendOperatorCodeHandler) *OperatorCodeSendM
 
 func (s *OperatorCodeSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewOperatorCodeSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *OperatorCodeSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *OperatorCodeSendMany) Update(ctx context.Context, obj *edgeproto.OperatorCode, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *OperatorCodeSendMany) GetTypeString() string {
 	return "OperatorCode"
 }
 
+func (s *OperatorCodeSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.OperatorCode)(nil))
+}
+
+func (s *OperatorCodeSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *OperatorCodeSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type OperatorCodeRecv struct {
 	Name        string
 	MessageName string
@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendFlowRateLimitSettingsHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.FlowRateLimitSettings, modRev int64))
 	GetWithRev(key *edgeproto.FlowRateLimitSettingsKey, buf *edgeproto.FlowRateLimitSettings, modRev *int64) bool
+	HasKey(key *edgeproto.FlowRateLimitSettingsKey) bool
 }
 
 type RecvFlowRateLimitSettingsHandler interface {
@@ -49,6 +50,7 @@ type FlowRateLimitSettingsSend struct {
 	handler     SendFlowRateLimitSettingsHandler
 	Keys        map[edgeproto.FlowRateLimitSettingsKey]FlowRateLimitSettingsSendContext
 	keysToSend  map[edgeproto.FlowRateLimitSettingsKey]FlowRateLimitSettingsSendContext
+	history     *SyncHistory[edgeproto.FlowRateLimitSettingsKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.FlowRateLimitSettings
@@ -104,6 +106,36 @@ func (s *FlowRateLimitSettingsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *FlowRateLimitSettingsSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *FlowRateLimitSettingsSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.FlowRateLimitSettings, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = FlowRateLimitSettingsSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.FlowRateLimitSettingsKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = FlowRateLimitSettingsSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *FlowRateLimitSettingsSend) Update(ctx context.Context, obj *edgeproto.FlowRateLimitSettings, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *FlowRateLimitSettingsSend) Send(stream StreamNotify, notice *edgeproto.
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *FlowRateLimitSettingsSend) PrepData() bool {
 // peers to send to.
 type FlowRateLimitSettingsSendMany struct {
 	handler SendFlowRateLimitSettingsHandler
+	history SyncHistory[edgeproto.FlowRateLimitSettingsKey]
 	Mux     sync.Mutex
 	sends   map[string]*FlowRateLimitSettingsSend
 }
@@ -204,6 +252,7 @@ func NewFlowRateLimitSettingsSendMany(handler SendFlowRateLimitSettingsHandler)
 
 func (s *FlowRateLimitSettingsSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewFlowRateLimitSettingsSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *FlowRateLimitSettingsSendMany) DoneSend(peerAddr string, send NotifySen
 	s.Mux.Unlock()
 }
 func (s *FlowRateLimitSettingsSendMany) Update(ctx context.Context, obj *edgeproto.FlowRateLimitSettings, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *FlowRateLimitSettingsSendMany) GetTypeString() string {
 	return "FlowRateLimitSettings"
 }
 
+func (s *FlowRateLimitSettingsSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.FlowRateLimitSettings)(nil))
+}
+
+func (s *FlowRateLimitSettingsSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *FlowRateLimitSettingsSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type FlowRateLimitSettingsRecv struct {
 	Name        string
 	MessageName string
@@ -372,6 +440,7 @@ func (s *Client) RegisterRecvFlowRateLimitSettingsCache(cache FlowRateLimitSetti
 type SendMaxReqsRateLimitSettingsHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.MaxReqsRateLimitSettings, modRev int64))
 	GetWithRev(key *edgeproto.MaxReqsRateLimitSettingsKey, buf *edgeproto.MaxReqsRateLimitSettings, modRev *int64) bool
+	HasKey(key *edgeproto.MaxReqsRateLimitSettingsKey) bool
 }
 
 type RecvMaxReqsRateLimitSettingsHandler interface {
@@ -393,6 +462,7 @@ type MaxReqsRateLimitSettingsSend struct {
 	handler     SendMaxReqsRateLimitSettingsHandler
 	Keys        map[edgeproto.MaxReqsRateLimitSettingsKey]MaxReqsRateLimitSettingsSendContext
 	keysToSend  map[edgeproto.MaxReqsRateLimitSettingsKey]MaxReqsRateLimitSettingsSendContext
+	history     *SyncHistory[edgeproto.MaxReqsRateLimitSettingsKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.MaxReqsRateLimitSettings
@@ -448,6 +518,36 @@ func (s *MaxReqsRateLimitSettingsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *MaxReqsRateLimitSettingsSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *MaxReqsRateLimitSettingsSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.MaxReqsRateLimitSettings, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = MaxReqsRateLimitSettingsSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.MaxReqsRateLimitSettingsKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = MaxReqsRateLimitSettingsSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *MaxReqsRateLimitSettingsSend) Update(ctx context.Context, obj *edgeproto.MaxReqsRateLimitSettings, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -481,8 +581,23 @@ func (s *MaxReqsRateLimitSettingsSend) Send(stream StreamNotify, notice *edgepro
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -535,6 +650,7 @@ func (s *MaxReqsRateLimitSettingsSend) PrepData() bool {
 // peers to send to.
 type MaxReqsRateLimitSettingsSendMany struct {
 	handler SendMaxReqsRateLimitSettingsHandler
+	history SyncHistory[edgeproto.MaxReqsRateLimitSettingsKey]
 	Mux     sync.Mutex
 	sends   map[string]*MaxReqsRateLimitSettingsSend
 }
@@ -548,6 +664,7 @@ func NewMaxReqsRateLimitSettingsSendMany(handler SendMaxReqsRateLimitSettingsHan
 
 func (s *MaxReqsRateLimitSettingsSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewMaxReqsRateLimitSettingsSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -569,6 +686,13 @@ func (s *MaxReqsRateLimitSettingsSendMany) DoneSend(peerAddr string, send Notify
 	s.Mux.Unlock()
 }
 func (s *MaxReqsRateLimitSettingsSendMany) Update(ctx context.Context, obj *edgeproto.MaxReqsRateLimitSettings, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -580,6 +704,18 @@ func (s *MaxReqsRateLimitSettingsSendMany) GetTypeString() string {
 	return "MaxReqsRateLimitSettings"
 }
 
+func (s *MaxReqsRateLimitSettingsSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.MaxReqsRateLimitSettings)(nil))
+}
+
+func (s *MaxReqsRateLimitSettingsSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *MaxReqsRateLimitSettingsSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type MaxReqsRateLimitSettingsRecv struct {
 	Name        string
 	MessageName string
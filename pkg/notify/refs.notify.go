@@ -27,6 +27,7 @@ var _ = math.Inf
 type SendClusterRefsHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.ClusterRefs, modRev int64))
 	GetWithRev(key *edgeproto.ClusterKey, buf *edgeproto.ClusterRefs, modRev *int64) bool
+	HasKey(key *edgeproto.ClusterKey) bool
 }
 
 type RecvClusterRefsHandler interface {
@@ -48,6 +49,7 @@ type ClusterRefsSend struct {
 	handler     SendClusterRefsHandler
 	Keys        map[edgeproto.ClusterKey]ClusterRefsSendContext
 	keysToSend  map[edgeproto.ClusterKey]ClusterRefsSendContext
+	history     *SyncHistory[edgeproto.ClusterKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ClusterRefs
@@ -103,6 +105,36 @@ func (s *ClusterRefsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ClusterRefsSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *ClusterRefsSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ClusterRefs, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = ClusterRefsSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.ClusterKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = ClusterRefsSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *ClusterRefsSend) Update(ctx context.Context, obj *edgeproto.ClusterRefs, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -136,8 +168,23 @@ func (s *ClusterRefsSend) Send(stream StreamNotify, notice *edgeproto.Notice, pe
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -190,6 +237,7 @@ func (s *ClusterRefsSend) PrepData() bool {
 // peers to send to.
 type ClusterRefsSendMany struct {
 	handler SendClusterRefsHandler
+	history SyncHistory[edgeproto.ClusterKey]
 	Mux     sync.Mutex
 	sends   map[string]*ClusterRefsSend
 }
@@ -203,6 +251,7 @@ func NewClusterRefsSendMany(handler SendClusterRefsHandler) *ClusterRefsSendMany
 
 func (s *ClusterRefsSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewClusterRefsSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -224,6 +273,13 @@ func (s *ClusterRefsSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *ClusterRefsSendMany) Update(ctx context.Context, obj *edgeproto.ClusterRefs, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -235,6 +291,18 @@ func (s *ClusterRefsSendMany) GetTypeString() string {
 	return "ClusterRefs"
 }
 
+func (s *ClusterRefsSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.ClusterRefs)(nil))
+}
+
+func (s *ClusterRefsSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *ClusterRefsSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type ClusterRefsRecv struct {
 	Name        string
 	MessageName string
@@ -371,6 +439,7 @@ func (s *Client) RegisterRecvClusterRefsCache(cache ClusterRefsCacheHandler) {
 type SendAppInstRefsHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.AppInstRefs, modRev int64))
 	GetWithRev(key *edgeproto.AppKey, buf *edgeproto.AppInstRefs, modRev *int64) bool
+	HasKey(key *edgeproto.AppKey) bool
 }
 
 type RecvAppInstRefsHandler interface {
@@ -392,6 +461,7 @@ type AppInstRefsSend struct {
 	handler     SendAppInstRefsHandler
 	Keys        map[edgeproto.AppKey]AppInstRefsSendContext
 	keysToSend  map[edgeproto.AppKey]AppInstRefsSendContext
+	history     *SyncHistory[edgeproto.AppKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.AppInstRefs
@@ -447,6 +517,36 @@ func (s *AppInstRefsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *AppInstRefsSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *AppInstRefsSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.AppInstRefs, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = AppInstRefsSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.AppKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = AppInstRefsSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *AppInstRefsSend) Update(ctx context.Context, obj *edgeproto.AppInstRefs, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -480,8 +580,23 @@ func (s *AppInstRefsSend) Send(stream StreamNotify, notice *edgeproto.Notice, pe
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -534,6 +649,7 @@ func (s *AppInstRefsSend) PrepData() bool {
 // peers to send to.
 type AppInstRefsSendMany struct {
 	handler SendAppInstRefsHandler
+	history SyncHistory[edgeproto.AppKey]
 	Mux     sync.Mutex
 	sends   map[string]*AppInstRefsSend
 }
@@ -547,6 +663,7 @@ func NewAppInstRefsSendMany(handler SendAppInstRefsHandler) *AppInstRefsSendMany
 
 func (s *AppInstRefsSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewAppInstRefsSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -568,6 +685,13 @@ func (s *AppInstRefsSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *AppInstRefsSendMany) Update(ctx context.Context, obj *edgeproto.AppInstRefs, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -579,6 +703,18 @@ func (s *AppInstRefsSendMany) GetTypeString() string {
 	return "AppInstRefs"
 }
 
+func (s *AppInstRefsSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.AppInstRefs)(nil))
+}
+
+func (s *AppInstRefsSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *AppInstRefsSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type AppInstRefsRecv struct {
 	Name        string
 	MessageName string
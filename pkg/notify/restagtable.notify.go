@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendResTagTableHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.ResTagTable, modRev int64))
 	GetWithRev(key *edgeproto.ResTagTableKey, buf *edgeproto.ResTagTable, modRev *int64) bool
+	HasKey(key *edgeproto.ResTagTableKey) bool
 }
 
 type RecvResTagTableHandler interface {
@@ -49,6 +50,7 @@ type ResTagTableSend struct {
 	handler     SendResTagTableHandler
 	Keys        map[edgeproto.ResTagTableKey]ResTagTableSendContext
 	keysToSend  map[edgeproto.ResTagTableKey]ResTagTableSendContext
+	history     *SyncHistory[edgeproto.ResTagTableKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ResTagTable
@@ -104,6 +106,36 @@ func (s *ResTagTableSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ResTagTableSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *ResTagTableSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ResTagTable, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = ResTagTableSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.ResTagTableKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = ResTagTableSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *ResTagTableSend) Update(ctx context.Context, obj *edgeproto.ResTagTable, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *ResTagTableSend) Send(stream StreamNotify, notice *edgeproto.Notice, pe
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *ResTagTableSend) PrepData() bool {
 // peers to send to.
 type ResTagTableSendMany struct {
 	handler SendResTagTableHandler
+	history SyncHistory[edgeproto.ResTagTableKey]
 	Mux     sync.Mutex
 	sends   map[string]*ResTagTableSend
 }
@@ -204,6 +252,7 @@ func NewResTagTableSendMany(handler SendResTagTableHandler) *ResTagTableSendMany
 
 func (s *ResTagTableSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewResTagTableSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *ResTagTableSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *ResTagTableSendMany) Update(ctx context.Context, obj *edgeproto.ResTagTable, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *ResTagTableSendMany) GetTypeString() string {
 	return "ResTagTable"
 }
 
+func (s *ResTagTableSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.ResTagTable)(nil))
+}
+
+func (s *ResTagTableSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *ResTagTableSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type ResTagTableRecv struct {
 	Name        string
 	MessageName string
@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendSettingsHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Settings, modRev int64))
 	GetWithRev(key *edgeproto.SettingsKey, buf *edgeproto.Settings, modRev *int64) bool
+	HasKey(key *edgeproto.SettingsKey) bool
 }
 
 type RecvSettingsHandler interface {
@@ -49,6 +50,7 @@ type SettingsSend struct {
 	handler     SendSettingsHandler
 	Keys        map[edgeproto.SettingsKey]SettingsSendContext
 	keysToSend  map[edgeproto.SettingsKey]SettingsSendContext
+	history     *SyncHistory[edgeproto.SettingsKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Settings
@@ -104,6 +106,36 @@ func (s *SettingsSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *SettingsSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *SettingsSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Settings, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = SettingsSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.SettingsKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = SettingsSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *SettingsSend) Update(ctx context.Context, obj *edgeproto.Settings, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *SettingsSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *SettingsSend) PrepData() bool {
 // peers to send to.
 type SettingsSendMany struct {
 	handler SendSettingsHandler
+	history SyncHistory[edgeproto.SettingsKey]
 	Mux     sync.Mutex
 	sends   map[string]*SettingsSend
 }
@@ -204,6 +252,7 @@ func NewSettingsSendMany(handler SendSettingsHandler) *SettingsSendMany {
 
 func (s *SettingsSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewSettingsSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *SettingsSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *SettingsSendMany) Update(ctx context.Context, obj *edgeproto.Settings, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *SettingsSendMany) GetTypeString() string {
 	return "Settings"
 }
 
+func (s *SettingsSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Settings)(nil))
+}
+
+func (s *SettingsSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *SettingsSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type SettingsRecv struct {
 	Name        string
 	MessageName string
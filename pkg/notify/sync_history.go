@@ -0,0 +1,125 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"math"
+	"sync"
+)
+
+// SyncHistoryMaxDeletes is the maximum number of deletes tracked
+// per object type. Once exceeded, the oldest deletes are discarded
+// and clients which last synced before them need a full sync.
+var SyncHistoryMaxDeletes = 10000
+
+// SyncHistory tracks the database revisions of deleted objects of
+// a cached object type. Updated objects already carry their
+// revision in the cache, so together they allow the server to
+// determine all changes since a given revision. This lets a
+// reconnecting client be sent only the changes since its last
+// synced revision, instead of all objects.
+// History is only recorded once enabled by setting the start
+// revision, which is only done by processes that serve
+// incremental syncs.
+type SyncHistory[K comparable] struct {
+	mux     sync.Mutex
+	enabled bool
+	// startRev is the revision after which history is complete.
+	startRev int64
+	deleted  map[K]int64
+	order    []syncHistoryDelete[K]
+}
+
+type syncHistoryDelete[K comparable] struct {
+	key K
+	rev int64
+}
+
+func (s *SyncHistory[K]) init() {
+	if s.deleted == nil {
+		s.deleted = make(map[K]int64)
+		s.startRev = math.MaxInt64
+	}
+}
+
+// SetStartRev marks the history as complete for all changes after
+// the given revision. Until set, no history is available.
+func (s *SyncHistory[K]) SetStartRev(rev int64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.init()
+	s.enabled = true
+	s.startRev = rev
+}
+
+// IsEnabled checks if history is being recorded.
+func (s *SyncHistory[K]) IsEnabled() bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.enabled
+}
+
+// HasHistory checks if all changes since the revision are known.
+func (s *SyncHistory[K]) HasHistory(rev int64) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.init()
+	return rev > 0 && rev >= s.startRev
+}
+
+// Deleted records that the object was deleted at the revision.
+func (s *SyncHistory[K]) Deleted(key K, rev int64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if !s.enabled {
+		return
+	}
+	s.deleted[key] = rev
+	s.order = append(s.order, syncHistoryDelete[K]{
+		key: key,
+		rev: rev,
+	})
+	for len(s.order) > SyncHistoryMaxDeletes {
+		oldest := s.order[0]
+		s.order = s.order[1:]
+		if rev, found := s.deleted[oldest.key]; found && rev == oldest.rev {
+			delete(s.deleted, oldest.key)
+		}
+		if oldest.rev > s.startRev {
+			s.startRev = oldest.rev
+		}
+	}
+}
+
+// Updated clears any delete recorded for a re-created object.
+func (s *SyncHistory[K]) Updated(key K) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.deleted != nil {
+		delete(s.deleted, key)
+	}
+}
+
+// GetDeletedSince calls the callback for all objects deleted after
+// the given revision.
+func (s *SyncHistory[K]) GetDeletedSince(rev int64, cb func(key K, delRev int64)) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for key, delRev := range s.deleted {
+		if delRev > rev {
+			cb(key, delRev)
+		}
+	}
+}
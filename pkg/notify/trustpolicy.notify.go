@@ -28,6 +28,7 @@ var _ = math.Inf
 type SendTrustPolicyHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.TrustPolicy, modRev int64))
 	GetWithRev(key *edgeproto.PolicyKey, buf *edgeproto.TrustPolicy, modRev *int64) bool
+	HasKey(key *edgeproto.PolicyKey) bool
 }
 
 type RecvTrustPolicyHandler interface {
@@ -49,6 +50,7 @@ type TrustPolicySend struct {
 	handler     SendTrustPolicyHandler
 	Keys        map[edgeproto.PolicyKey]TrustPolicySendContext
 	keysToSend  map[edgeproto.PolicyKey]TrustPolicySendContext
+	history     *SyncHistory[edgeproto.PolicyKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.TrustPolicy
@@ -104,6 +106,36 @@ func (s *TrustPolicySend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *TrustPolicySend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *TrustPolicySend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.TrustPolicy, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = TrustPolicySendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.PolicyKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = TrustPolicySendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *TrustPolicySend) Update(ctx context.Context, obj *edgeproto.TrustPolicy, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -137,8 +169,23 @@ func (s *TrustPolicySend) Send(stream StreamNotify, notice *edgeproto.Notice, pe
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -191,6 +238,7 @@ func (s *TrustPolicySend) PrepData() bool {
 // peers to send to.
 type TrustPolicySendMany struct {
 	handler SendTrustPolicyHandler
+	history SyncHistory[edgeproto.PolicyKey]
 	Mux     sync.Mutex
 	sends   map[string]*TrustPolicySend
 }
@@ -204,6 +252,7 @@ func NewTrustPolicySendMany(handler SendTrustPolicyHandler) *TrustPolicySendMany
 
 func (s *TrustPolicySendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewTrustPolicySend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -225,6 +274,13 @@ func (s *TrustPolicySendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *TrustPolicySendMany) Update(ctx context.Context, obj *edgeproto.TrustPolicy, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -236,6 +292,18 @@ func (s *TrustPolicySendMany) GetTypeString() string {
 	return "TrustPolicy"
 }
 
+func (s *TrustPolicySendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.TrustPolicy)(nil))
+}
+
+func (s *TrustPolicySendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *TrustPolicySendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type TrustPolicyRecv struct {
 	Name        string
 	MessageName string
@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendTrustPolicyExceptionHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.TrustPolicyException, modRev int64))
 	GetWithRev(key *edgeproto.TrustPolicyExceptionKey, buf *edgeproto.TrustPolicyException, modRev *int64) bool
+	HasKey(key *edgeproto.TrustPolicyExceptionKey) bool
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *edgeproto.TrustPolicyExceptionCacheData))
 }
 
@@ -51,6 +52,7 @@ type TrustPolicyExceptionSend struct {
 	handler     SendTrustPolicyExceptionHandler
 	Keys        map[edgeproto.TrustPolicyExceptionKey]TrustPolicyExceptionSendContext
 	keysToSend  map[edgeproto.TrustPolicyExceptionKey]TrustPolicyExceptionSendContext
+	history     *SyncHistory[edgeproto.TrustPolicyExceptionKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.TrustPolicyException
@@ -109,6 +111,39 @@ func (s *TrustPolicyExceptionSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *TrustPolicyExceptionSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *TrustPolicyExceptionSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.TrustPolicyException, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = TrustPolicyExceptionSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.TrustPolicyExceptionKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = TrustPolicyExceptionSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *TrustPolicyExceptionSend) Update(ctx context.Context, obj *edgeproto.TrustPolicyException, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -159,8 +194,23 @@ func (s *TrustPolicyExceptionSend) Send(stream StreamNotify, notice *edgeproto.N
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -213,6 +263,7 @@ func (s *TrustPolicyExceptionSend) PrepData() bool {
 // peers to send to.
 type TrustPolicyExceptionSendMany struct {
 	handler SendTrustPolicyExceptionHandler
+	history SyncHistory[edgeproto.TrustPolicyExceptionKey]
 	Mux     sync.Mutex
 	sends   map[string]*TrustPolicyExceptionSend
 }
@@ -226,6 +277,7 @@ func NewTrustPolicyExceptionSendMany(handler SendTrustPolicyExceptionHandler) *T
 
 func (s *TrustPolicyExceptionSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewTrustPolicyExceptionSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -247,6 +299,13 @@ func (s *TrustPolicyExceptionSendMany) DoneSend(peerAddr string, send NotifySend
 	s.Mux.Unlock()
 }
 func (s *TrustPolicyExceptionSendMany) Update(ctx context.Context, obj *edgeproto.TrustPolicyException, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -258,6 +317,18 @@ func (s *TrustPolicyExceptionSendMany) GetTypeString() string {
 	return "TrustPolicyException"
 }
 
+func (s *TrustPolicyExceptionSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.TrustPolicyException)(nil))
+}
+
+func (s *TrustPolicyExceptionSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *TrustPolicyExceptionSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type TrustPolicyExceptionRecv struct {
 	Name        string
 	MessageName string
@@ -394,6 +465,7 @@ func (s *Client) RegisterRecvTrustPolicyExceptionCache(cache TrustPolicyExceptio
 type SendTPEInstanceStateHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.TPEInstanceState, modRev int64))
 	GetWithRev(key *edgeproto.TPEInstanceKey, buf *edgeproto.TPEInstanceState, modRev *int64) bool
+	HasKey(key *edgeproto.TPEInstanceKey) bool
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *edgeproto.TPEInstanceStateCacheData))
 }
 
@@ -416,6 +488,7 @@ type TPEInstanceStateSend struct {
 	handler     SendTPEInstanceStateHandler
 	Keys        map[edgeproto.TPEInstanceKey]TPEInstanceStateSendContext
 	keysToSend  map[edgeproto.TPEInstanceKey]TPEInstanceStateSendContext
+	history     *SyncHistory[edgeproto.TPEInstanceKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.TPEInstanceState
@@ -474,6 +547,39 @@ func (s *TPEInstanceStateSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *TPEInstanceStateSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *TPEInstanceStateSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.TPEInstanceState, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = TPEInstanceStateSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.TPEInstanceKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = TPEInstanceStateSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *TPEInstanceStateSend) Update(ctx context.Context, obj *edgeproto.TPEInstanceState, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -524,8 +630,23 @@ func (s *TPEInstanceStateSend) Send(stream StreamNotify, notice *edgeproto.Notic
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -578,6 +699,7 @@ func (s *TPEInstanceStateSend) PrepData() bool {
 // peers to send to.
 type TPEInstanceStateSendMany struct {
 	handler SendTPEInstanceStateHandler
+	history SyncHistory[edgeproto.TPEInstanceKey]
 	Mux     sync.Mutex
 	sends   map[string]*TPEInstanceStateSend
 }
@@ -591,6 +713,7 @@ func NewTPEInstanceStateSendMany(handler SendTPEInstanceStateHandler) *TPEInstan
 
 func (s *TPEInstanceStateSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewTPEInstanceStateSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -612,6 +735,13 @@ func (s *TPEInstanceStateSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *TPEInstanceStateSendMany) Update(ctx context.Context, obj *edgeproto.TPEInstanceState, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -623,6 +753,18 @@ func (s *TPEInstanceStateSendMany) GetTypeString() string {
 	return "TPEInstanceState"
 }
 
+func (s *TPEInstanceStateSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.TPEInstanceState)(nil))
+}
+
+func (s *TPEInstanceStateSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *TPEInstanceStateSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type TPEInstanceStateRecv struct {
 	Name        string
 	MessageName string
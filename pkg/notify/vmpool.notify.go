@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendVMPoolHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.VMPool, modRev int64))
 	GetWithRev(key *edgeproto.VMPoolKey, buf *edgeproto.VMPool, modRev *int64) bool
+	HasKey(key *edgeproto.VMPoolKey) bool
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *edgeproto.VMPoolCacheData))
 }
 
@@ -51,6 +52,7 @@ type VMPoolSend struct {
 	handler     SendVMPoolHandler
 	Keys        map[edgeproto.VMPoolKey]VMPoolSendContext
 	keysToSend  map[edgeproto.VMPoolKey]VMPoolSendContext
+	history     *SyncHistory[edgeproto.VMPoolKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.VMPool
@@ -109,6 +111,39 @@ func (s *VMPoolSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *VMPoolSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *VMPoolSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.VMPool, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+		s.Keys[*obj.GetKey()] = VMPoolSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.VMPoolKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = VMPoolSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *VMPoolSend) Update(ctx context.Context, obj *edgeproto.VMPool, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -159,8 +194,23 @@ func (s *VMPoolSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer st
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -213,6 +263,7 @@ func (s *VMPoolSend) PrepData() bool {
 // peers to send to.
 type VMPoolSendMany struct {
 	handler SendVMPoolHandler
+	history SyncHistory[edgeproto.VMPoolKey]
 	Mux     sync.Mutex
 	sends   map[string]*VMPoolSend
 }
@@ -226,6 +277,7 @@ func NewVMPoolSendMany(handler SendVMPoolHandler) *VMPoolSendMany {
 
 func (s *VMPoolSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewVMPoolSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -247,6 +299,13 @@ func (s *VMPoolSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *VMPoolSendMany) Update(ctx context.Context, obj *edgeproto.VMPool, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -258,6 +317,18 @@ func (s *VMPoolSendMany) GetTypeString() string {
 	return "VMPool"
 }
 
+func (s *VMPoolSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.VMPool)(nil))
+}
+
+func (s *VMPoolSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *VMPoolSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type VMPoolRecv struct {
 	Name        string
 	MessageName string
@@ -394,6 +465,7 @@ func (s *Client) RegisterRecvVMPoolCache(cache VMPoolCacheHandler) {
 type SendVMPoolInfoHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.VMPoolInfo, modRev int64))
 	GetWithRev(key *edgeproto.VMPoolKey, buf *edgeproto.VMPoolInfo, modRev *int64) bool
+	HasKey(key *edgeproto.VMPoolKey) bool
 }
 
 type RecvVMPoolInfoHandler interface {
@@ -415,6 +487,7 @@ type VMPoolInfoSend struct {
 	handler     SendVMPoolInfoHandler
 	Keys        map[edgeproto.VMPoolKey]VMPoolInfoSendContext
 	keysToSend  map[edgeproto.VMPoolKey]VMPoolInfoSendContext
+	history     *SyncHistory[edgeproto.VMPoolKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.VMPoolInfo
@@ -470,6 +543,36 @@ func (s *VMPoolInfoSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *VMPoolInfoSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *VMPoolInfoSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.VMPoolInfo, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = VMPoolInfoSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.VMPoolKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = VMPoolInfoSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *VMPoolInfoSend) Update(ctx context.Context, obj *edgeproto.VMPoolInfo, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -503,8 +606,23 @@ func (s *VMPoolInfoSend) Send(stream StreamNotify, notice *edgeproto.Notice, pee
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -557,6 +675,7 @@ func (s *VMPoolInfoSend) PrepData() bool {
 // peers to send to.
 type VMPoolInfoSendMany struct {
 	handler SendVMPoolInfoHandler
+	history SyncHistory[edgeproto.VMPoolKey]
 	Mux     sync.Mutex
 	sends   map[string]*VMPoolInfoSend
 }
@@ -570,6 +689,7 @@ func NewVMPoolInfoSendMany(handler SendVMPoolInfoHandler) *VMPoolInfoSendMany {
 
 func (s *VMPoolInfoSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewVMPoolInfoSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -591,6 +711,13 @@ func (s *VMPoolInfoSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *VMPoolInfoSendMany) Update(ctx context.Context, obj *edgeproto.VMPoolInfo, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -602,6 +729,18 @@ func (s *VMPoolInfoSendMany) GetTypeString() string {
 	return "VMPoolInfo"
 }
 
+func (s *VMPoolInfoSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.VMPoolInfo)(nil))
+}
+
+func (s *VMPoolInfoSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *VMPoolInfoSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type VMPoolInfoRecv struct {
 	Name        string
 	MessageName string
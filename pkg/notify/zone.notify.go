@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendZoneHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.Zone, modRev int64))
 	GetWithRev(key *edgeproto.ZoneKey, buf *edgeproto.Zone, modRev *int64) bool
+	HasKey(key *edgeproto.ZoneKey) bool
 }
 
 type RecvZoneHandler interface {
@@ -50,6 +51,7 @@ type ZoneSend struct {
 	handler     SendZoneHandler
 	Keys        map[edgeproto.ZoneKey]ZoneSendContext
 	keysToSend  map[edgeproto.ZoneKey]ZoneSendContext
+	history     *SyncHistory[edgeproto.ZoneKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.Zone
@@ -105,6 +107,36 @@ func (s *ZoneSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ZoneSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *ZoneSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.Zone, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = ZoneSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.ZoneKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = ZoneSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *ZoneSend) Update(ctx context.Context, obj *edgeproto.Zone, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -138,8 +170,23 @@ func (s *ZoneSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer stri
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -192,6 +239,7 @@ func (s *ZoneSend) PrepData() bool {
 // peers to send to.
 type ZoneSendMany struct {
 	handler SendZoneHandler
+	history SyncHistory[edgeproto.ZoneKey]
 	Mux     sync.Mutex
 	sends   map[string]*ZoneSend
 }
@@ -205,6 +253,7 @@ func NewZoneSendMany(handler SendZoneHandler) *ZoneSendMany {
 
 func (s *ZoneSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewZoneSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -226,6 +275,13 @@ func (s *ZoneSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *ZoneSendMany) Update(ctx context.Context, obj *edgeproto.Zone, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -237,6 +293,18 @@ func (s *ZoneSendMany) GetTypeString() string {
 	return "Zone"
 }
 
+func (s *ZoneSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.Zone)(nil))
+}
+
+func (s *ZoneSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *ZoneSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type ZoneRecv struct {
 	Name        string
 	MessageName string
@@ -29,6 +29,7 @@ var _ = math.Inf
 type SendZonePoolHandler interface {
 	GetAllLocked(ctx context.Context, cb func(key *edgeproto.ZonePool, modRev int64))
 	GetWithRev(key *edgeproto.ZonePoolKey, buf *edgeproto.ZonePool, modRev *int64) bool
+	HasKey(key *edgeproto.ZonePoolKey) bool
 }
 
 type RecvZonePoolHandler interface {
@@ -50,6 +51,7 @@ type ZonePoolSend struct {
 	handler     SendZonePoolHandler
 	Keys        map[edgeproto.ZonePoolKey]ZonePoolSendContext
 	keysToSend  map[edgeproto.ZonePoolKey]ZonePoolSendContext
+	history     *SyncHistory[edgeproto.ZonePoolKey]
 	notifyId    int64
 	Mux         sync.Mutex
 	buf         edgeproto.ZonePool
@@ -105,6 +107,36 @@ func (s *ZonePoolSend) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *ZonePoolSend) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *ZonePoolSend) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *edgeproto.ZonePool, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+		s.Keys[*obj.GetKey()] = ZonePoolSendContext{
+			ctx:    ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key edgeproto.ZonePoolKey, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = ZonePoolSendContext{
+			ctx:    ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *ZonePoolSend) Update(ctx context.Context, obj *edgeproto.ZonePool, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -138,8 +170,23 @@ func (s *ZonePoolSend) Send(stream StreamNotify, notice *edgeproto.Notice, peer
 	keys := s.keysToSend
 	s.keysToSend = nil
 	s.Mux.Unlock()
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -192,6 +239,7 @@ func (s *ZonePoolSend) PrepData() bool {
 // peers to send to.
 type ZonePoolSendMany struct {
 	handler SendZonePoolHandler
+	history SyncHistory[edgeproto.ZonePoolKey]
 	Mux     sync.Mutex
 	sends   map[string]*ZonePoolSend
 }
@@ -205,6 +253,7 @@ func NewZonePoolSendMany(handler SendZonePoolHandler) *ZonePoolSendMany {
 
 func (s *ZonePoolSendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 	send := NewZonePoolSend(s.handler)
+	send.history = &s.history
 	send.notifyId = notifyId
 	s.Mux.Lock()
 	s.sends[peerAddr] = send
@@ -226,6 +275,13 @@ func (s *ZonePoolSendMany) DoneSend(peerAddr string, send NotifySend) {
 	s.Mux.Unlock()
 }
 func (s *ZonePoolSendMany) Update(ctx context.Context, obj *edgeproto.ZonePool, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -237,6 +293,18 @@ func (s *ZonePoolSendMany) GetTypeString() string {
 	return "ZonePool"
 }
 
+func (s *ZonePoolSendMany) GetMessageName() string {
+	return proto.MessageName((*edgeproto.ZonePool)(nil))
+}
+
+func (s *ZonePoolSendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *ZonePoolSendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+
 type ZonePoolRecv struct {
 	Name        string
 	MessageName string
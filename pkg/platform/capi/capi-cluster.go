@@ -0,0 +1,316 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capi
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/k8smgmt"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+	yaml "github.com/mobiledgex/yaml/v2"
+)
+
+const (
+	clusterAPIVersion      = "cluster.x-k8s.io/v1beta1"
+	controlPlaneAPIVersion = "controlplane.cluster.x-k8s.io/v1beta1"
+	bootstrapAPIVersion    = "bootstrap.cluster.x-k8s.io/v1beta1"
+	clusterNameLabel       = "cluster.x-k8s.io/cluster-name"
+	kubeconfigSecretSuffix = "-kubeconfig"
+	kubeconfigSecretKey    = "value"
+)
+
+type object = map[string]interface{}
+
+func getControlPlaneName(clusterName string) string {
+	return clusterName + "-control-plane"
+}
+
+func getMachineDeploymentName(clusterName string, pool *edgeproto.NodePool) string {
+	return clusterName + "-" + util.DNSSanitize(pool.Name)
+}
+
+func getRef(apiVersion, kind, name string) object {
+	return object{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"name":       name,
+	}
+}
+
+// getClusterObjects maps the ClusterInst onto the Cluster API objects
+// that define it: the Cluster, its infrastructure cluster, a kubeadm
+// control plane, and one MachineDeployment per node pool.
+func (c *CAPIPlatform) getClusterObjects(clusterName string, clusterInst *edgeproto.ClusterInst) ([]object, error) {
+	if len(clusterInst.NodePools) == 0 {
+		return nil, fmt.Errorf("no node pools specified for cluster")
+	}
+	version, err := c.resolveKubernetesVersion(clusterInst.KubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+	namespace := c.getNamespace()
+	infraAPIVersion := c.getProp(CAPI_INFRA_API_VERSION)
+	infraClusterKind := c.getProp(CAPI_INFRA_CLUSTER_KIND)
+	machineTemplateKind := c.getProp(CAPI_INFRA_MACHINE_TEMPLATE_KIND)
+	bootstrapTemplate := c.getProp(CAPI_BOOTSTRAP_CONFIG_TEMPLATE)
+	for _, prop := range []string{CAPI_INFRA_CLUSTER_KIND, CAPI_INFRA_MACHINE_TEMPLATE_KIND, CAPI_BOOTSTRAP_CONFIG_TEMPLATE} {
+		if c.getProp(prop) == "" {
+			return nil, fmt.Errorf("missing required property %s", prop)
+		}
+	}
+	controlPlaneTemplate := clusterInst.MasterNodeFlavor
+	if controlPlaneTemplate == "" {
+		controlPlaneTemplate = c.getProp(CAPI_CONTROL_PLANE_MACHINE_TEMPLATE)
+	}
+	if controlPlaneTemplate == "" {
+		return nil, fmt.Errorf("no master node flavor specified and %s is not set", CAPI_CONTROL_PLANE_MACHINE_TEMPLATE)
+	}
+	infraClusterSpec := object{}
+	if specJSON := c.getProp(CAPI_INFRA_CLUSTER_SPEC); specJSON != "" {
+		if err := json.Unmarshal([]byte(specJSON), &infraClusterSpec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %s, %s", CAPI_INFRA_CLUSTER_SPEC, specJSON, err)
+		}
+	}
+	numMasters := clusterInst.NumMasters
+	if numMasters == 0 {
+		numMasters = 1
+	}
+	metadata := func(name string) object {
+		return object{
+			"name":      name,
+			"namespace": namespace,
+			"labels": object{
+				clusterNameLabel: clusterName,
+			},
+		}
+	}
+
+	objs := []object{{
+		"apiVersion": clusterAPIVersion,
+		"kind":       "Cluster",
+		"metadata":   metadata(clusterName),
+		"spec": object{
+			"clusterNetwork": object{
+				"pods": object{
+					"cidrBlocks": []string{c.getProp(CAPI_POD_CIDR)},
+				},
+			},
+			"controlPlaneRef":   getRef(controlPlaneAPIVersion, "KubeadmControlPlane", getControlPlaneName(clusterName)),
+			"infrastructureRef": getRef(infraAPIVersion, infraClusterKind, clusterName),
+		},
+	}, {
+		"apiVersion": infraAPIVersion,
+		"kind":       infraClusterKind,
+		"metadata":   metadata(clusterName),
+		"spec":       infraClusterSpec,
+	}, {
+		"apiVersion": controlPlaneAPIVersion,
+		"kind":       "KubeadmControlPlane",
+		"metadata":   metadata(getControlPlaneName(clusterName)),
+		"spec": object{
+			"replicas": numMasters,
+			"version":  version,
+			"machineTemplate": object{
+				"infrastructureRef": getRef(infraAPIVersion, machineTemplateKind, controlPlaneTemplate),
+			},
+			"kubeadmConfigSpec": object{},
+		},
+	}}
+	for _, pool := range clusterInst.NodePools {
+		if pool.NodeResources == nil || pool.NodeResources.InfraNodeFlavor == "" {
+			return nil, fmt.Errorf("node pool %s has no infra flavor", pool.Name)
+		}
+		objs = append(objs, object{
+			"apiVersion": clusterAPIVersion,
+			"kind":       "MachineDeployment",
+			"metadata":   metadata(getMachineDeploymentName(clusterName, pool)),
+			"spec": object{
+				"clusterName": clusterName,
+				"replicas":    pool.NumNodes,
+				"selector": object{
+					"matchLabels": object{},
+				},
+				"template": object{
+					"spec": object{
+						"clusterName": clusterName,
+						"version":     version,
+						"bootstrap": object{
+							"configRef": getRef(bootstrapAPIVersion, "KubeadmConfigTemplate", bootstrapTemplate),
+						},
+						"infrastructureRef": getRef(infraAPIVersion, machineTemplateKind, pool.NodeResources.InfraNodeFlavor),
+					},
+				},
+			},
+		})
+	}
+	return objs, nil
+}
+
+func getManifest(objs []object) ([]byte, error) {
+	docs := []string{}
+	for _, obj := range objs {
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, string(out))
+	}
+	return []byte(strings.Join(docs, "---\n")), nil
+}
+
+// CreateClusterPrerequisites currently does nothing
+func (c *CAPIPlatform) CreateClusterPrerequisites(ctx context.Context, clusterName string) error {
+	return nil
+}
+
+// RunClusterCreateCommand creates the cluster by applying Cluster API
+// objects to the management cluster
+func (c *CAPIPlatform) RunClusterCreateCommand(ctx context.Context, clusterName string, clusterInst *edgeproto.ClusterInst) (map[string]string, error) {
+	return c.applyCluster(ctx, clusterName, clusterInst, "create")
+}
+
+// RunClusterUpdateCommand updates node pool sizes and the kubernetes
+// version by re-applying the Cluster API objects
+func (c *CAPIPlatform) RunClusterUpdateCommand(ctx context.Context, clusterName string, clusterInst *edgeproto.ClusterInst) (map[string]string, error) {
+	return c.applyCluster(ctx, clusterName, clusterInst, "update")
+}
+
+func (c *CAPIPlatform) applyCluster(ctx context.Context, clusterName string, clusterInst *edgeproto.ClusterInst, action string) (map[string]string, error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "apply cluster", "action", action, "clusterName", clusterName)
+	objs, err := c.getClusterObjects(clusterName, clusterInst)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := getManifest(objs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cluster manifest, %s", err)
+	}
+	timeout, err := c.getReadyTimeout()
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.kubectl(ctx, manifest, "apply", "-f", "-")
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "Error in cluster "+action, "out", string(out), "err", err)
+		return nil, fmt.Errorf("Error in cluster %s: %s - %v", action, string(out), err)
+	}
+	if action == "update" {
+		if err := c.deleteStaleMachineDeployments(ctx, clusterName, clusterInst); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.waitClusterReady(ctx, clusterName, timeout.String()); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// deleteStaleMachineDeployments removes MachineDeployments for node
+// pools that are no longer part of the cluster
+func (c *CAPIPlatform) deleteStaleMachineDeployments(ctx context.Context, clusterName string, clusterInst *edgeproto.ClusterInst) error {
+	out, err := c.kubectl(ctx, nil, "get", "machinedeployments", "-n", c.getNamespace(), "-l", clusterNameLabel+"="+clusterName, "-o", "jsonpath={.items[*].metadata.name}")
+	if err != nil {
+		return fmt.Errorf("failed to list machine deployments: %s - %v", string(out), err)
+	}
+	want := map[string]struct{}{}
+	for _, pool := range clusterInst.NodePools {
+		want[getMachineDeploymentName(clusterName, pool)] = struct{}{}
+	}
+	for _, name := range strings.Fields(string(out)) {
+		if _, found := want[name]; found {
+			continue
+		}
+		log.SpanLog(ctx, log.DebugLevelInfra, "deleting stale machine deployment", "name", name)
+		out, err := c.kubectl(ctx, nil, "delete", "machinedeployment", name, "-n", c.getNamespace(), "--ignore-not-found")
+		if err != nil {
+			return fmt.Errorf("failed to delete machine deployment %s: %s - %v", name, string(out), err)
+		}
+	}
+	return nil
+}
+
+func (c *CAPIPlatform) waitClusterReady(ctx context.Context, clusterName, timeout string) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "waiting for cluster ready", "clusterName", clusterName, "timeout", timeout)
+	namespace := c.getNamespace()
+	out, err := c.kubectl(ctx, nil, "wait", "--for=condition=Ready", "cluster/"+clusterName, "-n", namespace, "--timeout="+timeout)
+	if err != nil {
+		return fmt.Errorf("cluster %s not ready: %s - %v", clusterName, string(out), err)
+	}
+	out, err = c.kubectl(ctx, nil, "wait", "--for=condition=Ready", "machinedeployments", "-l", clusterNameLabel+"="+clusterName, "-n", namespace, "--timeout="+timeout)
+	if err != nil {
+		return fmt.Errorf("cluster %s machine deployments not ready: %s - %v", clusterName, string(out), err)
+	}
+	return nil
+}
+
+// RunClusterDeleteCommand deletes the Cluster object, which cascades
+// to all objects owned by the cluster
+func (c *CAPIPlatform) RunClusterDeleteCommand(ctx context.Context, clusterName string, clusterInst *edgeproto.ClusterInst) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "RunClusterDeleteCommand", "clusterName", clusterName)
+	timeout, err := c.getReadyTimeout()
+	if err != nil {
+		return err
+	}
+	out, err := c.kubectl(ctx, nil, "delete", "cluster", clusterName, "-n", c.getNamespace(), "--ignore-not-found", "--wait=true", "--timeout="+timeout.String())
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "Error in cluster delete", "out", string(out), "err", err)
+		return fmt.Errorf("Error in cluster delete: %s - %v", string(out), err)
+	}
+	return nil
+}
+
+// GetCredentials retrieves the admin kubeconfig from the secret
+// generated by Cluster API for the cluster
+func (c *CAPIPlatform) GetCredentials(ctx context.Context, clusterName string, clusterInst *edgeproto.ClusterInst) ([]byte, error) {
+	secretName := clusterName + kubeconfigSecretSuffix
+	out, err := c.kubectl(ctx, nil, "get", "secret", secretName, "-n", c.getNamespace(), "-o", "jsonpath={.data."+kubeconfigSecretKey+"}")
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "Error in GetCredentials", "out", string(out), "err", err)
+		return nil, fmt.Errorf("get credential failed: %s - %v", string(out), err)
+	}
+	data, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(out)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode kubeconfig secret %s, %s", secretName, err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("kubeconfig secret %s is empty", secretName)
+	}
+	return data, nil
+}
+
+func (c *CAPIPlatform) GetClusterAddonInfo(ctx context.Context, clusterName string, clusterInst *edgeproto.ClusterInst) (*k8smgmt.ClusterAddonInfo, error) {
+	info := k8smgmt.ClusterAddonInfo{}
+	return &info, nil
+}
+
+func (c *CAPIPlatform) GetCloudletInfraResourcesInfo(ctx context.Context) ([]edgeproto.InfraResource, error) {
+	return []edgeproto.InfraResource{}, nil
+}
+
+// called by controller, make sure it doesn't make any calls to infra API
+func (c *CAPIPlatform) GetClusterAdditionalResources(ctx context.Context, cloudlet *edgeproto.Cloudlet, vmResources []edgeproto.VMResource) map[string]edgeproto.InfraResource {
+	return nil
+}
+
+func (c *CAPIPlatform) GetClusterAdditionalResourceMetric(ctx context.Context, cloudlet *edgeproto.Cloudlet, resMetric *edgeproto.Metric, resources []edgeproto.VMResource) error {
+	return nil
+}
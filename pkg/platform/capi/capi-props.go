@@ -0,0 +1,154 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+)
+
+const (
+	CAPI_MANAGEMENT_KUBECONFIG = "CAPI_MANAGEMENT_KUBECONFIG"
+
+	CAPI_NAMESPACE                      = "CAPI_NAMESPACE"
+	CAPI_INFRA_API_VERSION              = "CAPI_INFRA_API_VERSION"
+	CAPI_INFRA_CLUSTER_KIND             = "CAPI_INFRA_CLUSTER_KIND"
+	CAPI_INFRA_CLUSTER_SPEC             = "CAPI_INFRA_CLUSTER_SPEC"
+	CAPI_INFRA_MACHINE_TEMPLATE_KIND    = "CAPI_INFRA_MACHINE_TEMPLATE_KIND"
+	CAPI_CONTROL_PLANE_MACHINE_TEMPLATE = "CAPI_CONTROL_PLANE_MACHINE_TEMPLATE"
+	CAPI_BOOTSTRAP_CONFIG_TEMPLATE      = "CAPI_BOOTSTRAP_CONFIG_TEMPLATE"
+	CAPI_POD_CIDR                       = "CAPI_POD_CIDR"
+	CAPI_KUBERNETES_VERSIONS            = "CAPI_KUBERNETES_VERSIONS"
+	CAPI_CLUSTER_READY_TIMEOUT          = "CAPI_CLUSTER_READY_TIMEOUT"
+	CAPI_FLAVORS                        = "CAPI_FLAVORS"
+)
+
+var AccessVarProps = map[string]*edgeproto.PropertyInfo{
+	CAPI_MANAGEMENT_KUBECONFIG: {
+		Name:        "Management cluster config file data",
+		Description: "Contents of the Kubernetes config file used with kubectl to access the Cluster API management cluster",
+		Mandatory:   true,
+	},
+}
+
+var Props = map[string]*edgeproto.PropertyInfo{
+	CAPI_NAMESPACE: {
+		Name:        "Management cluster namespace",
+		Description: "Namespace in the management cluster in which Cluster API objects are created",
+		Value:       "default",
+	},
+	CAPI_INFRA_API_VERSION: {
+		Name:        "Infrastructure provider API version",
+		Description: "API version of the Cluster API infrastructure provider resources, e.g. infrastructure.cluster.x-k8s.io/v1beta1",
+		Value:       "infrastructure.cluster.x-k8s.io/v1beta1",
+	},
+	CAPI_INFRA_CLUSTER_KIND: {
+		Name:        "Infrastructure cluster kind",
+		Description: "Kind of the infrastructure provider cluster object created for each cluster, e.g. OpenStackCluster",
+		Mandatory:   true,
+	},
+	CAPI_INFRA_CLUSTER_SPEC: {
+		Name:        "Infrastructure cluster spec",
+		Description: `JSON formatted spec for the infrastructure provider cluster object, i.e. {"identityRef":{"name":"cloud-config","cloudName":"openstack"}}`,
+	},
+	CAPI_INFRA_MACHINE_TEMPLATE_KIND: {
+		Name:        "Infrastructure machine template kind",
+		Description: "Kind of the infrastructure provider machine templates, e.g. OpenStackMachineTemplate. A machine template must exist in the management cluster namespace for each flavor, named by the flavor name",
+		Mandatory:   true,
+	},
+	CAPI_CONTROL_PLANE_MACHINE_TEMPLATE: {
+		Name:        "Control plane machine template",
+		Description: "Name of the infrastructure machine template used for control plane nodes if the cluster does not specify a master node flavor",
+		Mandatory:   true,
+	},
+	CAPI_BOOTSTRAP_CONFIG_TEMPLATE: {
+		Name:        "Worker bootstrap config template",
+		Description: "Name of the KubeadmConfigTemplate in the management cluster namespace used to bootstrap worker nodes",
+		Mandatory:   true,
+	},
+	CAPI_POD_CIDR: {
+		Name:        "Pod CIDR",
+		Description: "CIDR block used for pod networking in created clusters",
+		Value:       "192.168.0.0/16",
+	},
+	CAPI_KUBERNETES_VERSIONS: {
+		Name:        "Supported Kubernetes versions",
+		Description: "Comma separated list of full Kubernetes versions supported by the machine images, i.e. v1.29.2,v1.30.1. The first entry is the default. If not set, the cluster's version is passed through as is",
+	},
+	CAPI_CLUSTER_READY_TIMEOUT: {
+		Name:        "Cluster ready timeout",
+		Description: "Maximum time to wait for a cluster to become ready after create or update",
+		Value:       "30m",
+	},
+	CAPI_FLAVORS: {
+		Name:        "List of flavors in JSON format",
+		Description: `JSON formatted list of edgeproto.FlavorInfo, one per infrastructure machine template, i.e. [{"name":"m1.medium","vcpus":2,"ram":4096,"disk":40}]`,
+	},
+}
+
+func (c *CAPIPlatform) getNamespace() string {
+	val, _ := c.properties.GetValue(CAPI_NAMESPACE)
+	return val
+}
+
+func (c *CAPIPlatform) getProp(name string) string {
+	val, _ := c.properties.GetValue(name)
+	return val
+}
+
+func (c *CAPIPlatform) getReadyTimeout() (time.Duration, error) {
+	val := c.getProp(CAPI_CLUSTER_READY_TIMEOUT)
+	if val == "" {
+		return 30 * time.Minute, nil
+	}
+	timeout, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q, %s", CAPI_CLUSTER_READY_TIMEOUT, val, err)
+	}
+	return timeout, nil
+}
+
+// resolveKubernetesVersion maps the requested cluster version, which
+// may be just major.minor, onto a full version supported by the
+// machine images.
+func (c *CAPIPlatform) resolveKubernetesVersion(requested string) (string, error) {
+	requested = strings.TrimPrefix(requested, "v")
+	supported := []string{}
+	for _, v := range strings.Split(c.getProp(CAPI_KUBERNETES_VERSIONS), ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			supported = append(supported, "v"+strings.TrimPrefix(v, "v"))
+		}
+	}
+	if len(supported) == 0 {
+		if requested == "" {
+			return "", fmt.Errorf("no kubernetes version specified and %s is not set", CAPI_KUBERNETES_VERSIONS)
+		}
+		return "v" + requested, nil
+	}
+	if requested == "" {
+		return supported[0], nil
+	}
+	for _, v := range supported {
+		trimmed := strings.TrimPrefix(v, "v")
+		if trimmed == requested || strings.HasPrefix(trimmed, requested+".") {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("kubernetes version %s not supported, must be one of %s", requested, strings.Join(supported, ", "))
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capi provides a managed kubernetes platform that creates
+// clusters by applying Kubernetes Cluster API objects to a
+// pre-existing management cluster.
+package capi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/managedk8s"
+	"github.com/edgexr/edge-cloud-platform/pkg/util"
+)
+
+// Cluster API resource names are used as prefixes for generated
+// machine and node names, so keep them reasonably short.
+const CAPIMaxClusterNameLen int = 40
+
+type CAPIPlatform struct {
+	properties     *infracommon.InfraProperties
+	accessVars     map[string]string
+	mgmtKubeconfig string
+	// kubectl runs kubectl against the management cluster,
+	// replaced in unit tests.
+	kubectl func(ctx context.Context, stdin []byte, args ...string) ([]byte, error)
+}
+
+func NewPlatform() platform.Platform {
+	return &managedk8s.ManagedK8sPlatform{
+		Provider: &CAPIPlatform{},
+	}
+}
+
+func (c *CAPIPlatform) Init(accessVars map[string]string, properties *infracommon.InfraProperties) error {
+	// copy the access vars so the caller's map is not modified
+	c.accessVars = make(map[string]string, len(accessVars))
+	for k, v := range accessVars {
+		c.accessVars[k] = v
+	}
+	c.properties = properties
+	if kubeconfig, ok := c.accessVars[CAPI_MANAGEMENT_KUBECONFIG]; ok {
+		c.mgmtKubeconfig = kubeconfig
+		delete(c.accessVars, CAPI_MANAGEMENT_KUBECONFIG)
+	}
+	if c.kubectl == nil {
+		c.kubectl = c.runKubectl
+	}
+	return nil
+}
+
+func (c *CAPIPlatform) GetFeatures() *edgeproto.PlatformFeatures {
+	return &edgeproto.PlatformFeatures{
+		PlatformType:                  platform.PlatformTypeCAPI,
+		SupportsMultiTenantCluster:    true,
+		SupportsKubernetesOnly:        true,
+		KubernetesRequiresWorkerNodes: true,
+		IpAllocatedPerService:         true,
+		RequiresCrmOffEdge:            true,
+		AccessVars:                    AccessVarProps,
+		Properties:                    Props,
+		ResourceQuotaProperties:       cloudcommon.CommonResourceQuotaProps,
+	}
+}
+
+func (c *CAPIPlatform) GatherCloudletInfo(ctx context.Context, info *edgeproto.CloudletInfo) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "GatherCloudletInfo")
+	// Cluster API has no generic way to list infra flavors
+	flavorsJSON, ok := c.properties.GetValue(CAPI_FLAVORS)
+	if ok && flavorsJSON != "" {
+		flavors := []*edgeproto.FlavorInfo{}
+		if err := json.Unmarshal([]byte(flavorsJSON), &flavors); err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %s, %s", CAPI_FLAVORS, flavorsJSON, err)
+		}
+		info.Flavors = flavors
+	}
+	return nil
+}
+
+// Login verifies access to the management cluster
+func (c *CAPIPlatform) Login(ctx context.Context) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "CAPI Login")
+	if c.mgmtKubeconfig == "" {
+		return fmt.Errorf("missing %s access var", CAPI_MANAGEMENT_KUBECONFIG)
+	}
+	out, err := c.kubectl(ctx, nil, "get", "namespace", c.getNamespace())
+	if err != nil {
+		return fmt.Errorf("unable to access management cluster: %s - %v", string(out), err)
+	}
+	return nil
+}
+
+func (c *CAPIPlatform) NameSanitize(clusterName string) string {
+	clusterName = util.DNSSanitize(clusterName)
+	if len(clusterName) > CAPIMaxClusterNameLen {
+		clusterName = strings.TrimSuffix(clusterName[:CAPIMaxClusterNameLen], "-")
+	}
+	return clusterName
+}
+
+// runKubectl runs kubectl against the management cluster. The
+// kubeconfig is written to a temporary file for the duration of
+// the command so concurrent commands do not interfere.
+func (c *CAPIPlatform) runKubectl(ctx context.Context, stdin []byte, args ...string) ([]byte, error) {
+	f, err := os.CreateTemp("", "capi-mgmt-*.kubeconfig")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create management kubeconfig file, %s", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(c.mgmtKubeconfig)
+	if err == nil {
+		err = f.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write management kubeconfig file, %s", err)
+	}
+	cmdArgs := []interface{}{"--kubeconfig", f.Name()}
+	for _, arg := range args {
+		cmdArgs = append(cmdArgs, arg)
+	}
+	log.SpanLog(ctx, log.DebugLevelInfra, "run kubectl", "args", args)
+	sess := infracommon.Sh(c.accessVars)
+	if stdin != nil {
+		sess.SetStdin(bytes.NewReader(stdin))
+	}
+	return sess.Command("kubectl", cmdArgs...).CombinedOutput()
+}
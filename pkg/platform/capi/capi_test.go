@@ -0,0 +1,275 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capi
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/test-go/testify/require"
+)
+
+type fakeKubectl struct {
+	cmds   []string
+	stdins []string
+	out    map[string]string
+}
+
+func (s *fakeKubectl) run(ctx context.Context, stdin []byte, args ...string) ([]byte, error) {
+	cmd := strings.Join(args, " ")
+	s.cmds = append(s.cmds, cmd)
+	if stdin != nil {
+		s.stdins = append(s.stdins, string(stdin))
+	}
+	for prefix, out := range s.out {
+		if strings.HasPrefix(cmd, prefix) {
+			return []byte(out), nil
+		}
+	}
+	return []byte{}, nil
+}
+
+func getTestPlatform(t *testing.T) (*CAPIPlatform, *fakeKubectl) {
+	kubectl := &fakeKubectl{
+		out: map[string]string{},
+	}
+	c := &CAPIPlatform{
+		kubectl: kubectl.run,
+	}
+	properties := &infracommon.InfraProperties{
+		Properties: make(map[string]*edgeproto.PropertyInfo),
+	}
+	properties.SetProperties(Props)
+	properties.SetValue(CAPI_NAMESPACE, "clusters")
+	properties.SetValue(CAPI_INFRA_CLUSTER_KIND, "OpenStackCluster")
+	properties.SetValue(CAPI_INFRA_CLUSTER_SPEC, `{"identityRef":{"name":"cloud-config"}}`)
+	properties.SetValue(CAPI_INFRA_MACHINE_TEMPLATE_KIND, "OpenStackMachineTemplate")
+	properties.SetValue(CAPI_CONTROL_PLANE_MACHINE_TEMPLATE, "control-plane")
+	properties.SetValue(CAPI_BOOTSTRAP_CONFIG_TEMPLATE, "workers")
+	properties.SetValue(CAPI_KUBERNETES_VERSIONS, "v1.29.2, 1.30.1")
+	accessVars := map[string]string{
+		CAPI_MANAGEMENT_KUBECONFIG: "kubeconfig-data",
+	}
+	err := c.Init(accessVars, properties)
+	require.Nil(t, err)
+	require.Equal(t, "kubeconfig-data", c.mgmtKubeconfig)
+	_, found := c.accessVars[CAPI_MANAGEMENT_KUBECONFIG]
+	require.False(t, found)
+	// caller's access vars are not modified
+	require.Equal(t, "kubeconfig-data", accessVars[CAPI_MANAGEMENT_KUBECONFIG])
+	return c, kubectl
+}
+
+func getTestClusterInst() *edgeproto.ClusterInst {
+	return &edgeproto.ClusterInst{
+		Key: edgeproto.ClusterKey{
+			Name:         "testclust",
+			Organization: "testorg",
+		},
+		NodePools: []*edgeproto.NodePool{{
+			Name:     "cpupool",
+			NumNodes: 2,
+			NodeResources: &edgeproto.NodeResources{
+				InfraNodeFlavor: "m1.medium",
+			},
+		}},
+		KubernetesVersion: "1.30",
+	}
+}
+
+func TestClusterManifest(t *testing.T) {
+	c, _ := getTestPlatform(t)
+	clusterInst := getTestClusterInst()
+
+	objs, err := c.getClusterObjects("testclust", clusterInst)
+	require.Nil(t, err)
+	manifest, err := getManifest(objs)
+	require.Nil(t, err)
+	expected := `apiVersion: cluster.x-k8s.io/v1beta1
+kind: Cluster
+metadata:
+  labels:
+    cluster.x-k8s.io/cluster-name: testclust
+  name: testclust
+  namespace: clusters
+spec:
+  clusterNetwork:
+    pods:
+      cidrBlocks:
+      - 192.168.0.0/16
+  controlPlaneRef:
+    apiVersion: controlplane.cluster.x-k8s.io/v1beta1
+    kind: KubeadmControlPlane
+    name: testclust-control-plane
+  infrastructureRef:
+    apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+    kind: OpenStackCluster
+    name: testclust
+---
+apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+kind: OpenStackCluster
+metadata:
+  labels:
+    cluster.x-k8s.io/cluster-name: testclust
+  name: testclust
+  namespace: clusters
+spec:
+  identityRef:
+    name: cloud-config
+---
+apiVersion: controlplane.cluster.x-k8s.io/v1beta1
+kind: KubeadmControlPlane
+metadata:
+  labels:
+    cluster.x-k8s.io/cluster-name: testclust
+  name: testclust-control-plane
+  namespace: clusters
+spec:
+  kubeadmConfigSpec: {}
+  machineTemplate:
+    infrastructureRef:
+      apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+      kind: OpenStackMachineTemplate
+      name: control-plane
+  replicas: 1
+  version: v1.30.1
+---
+apiVersion: cluster.x-k8s.io/v1beta1
+kind: MachineDeployment
+metadata:
+  labels:
+    cluster.x-k8s.io/cluster-name: testclust
+  name: testclust-cpupool
+  namespace: clusters
+spec:
+  clusterName: testclust
+  replicas: 2
+  selector:
+    matchLabels: {}
+  template:
+    spec:
+      bootstrap:
+        configRef:
+          apiVersion: bootstrap.cluster.x-k8s.io/v1beta1
+          kind: KubeadmConfigTemplate
+          name: workers
+      clusterName: testclust
+      infrastructureRef:
+        apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+        kind: OpenStackMachineTemplate
+        name: m1.medium
+      version: v1.30.1
+`
+	require.Equal(t, expected, string(manifest))
+
+	// master flavor overrides control plane template
+	clusterInst.MasterNodeFlavor = "m1.large"
+	clusterInst.NumMasters = 3
+	objs, err = c.getClusterObjects("testclust", clusterInst)
+	require.Nil(t, err)
+	cpSpec := objs[2]["spec"].(object)
+	require.Equal(t, uint32(3), cpSpec["replicas"])
+	require.Equal(t, "m1.large", cpSpec["machineTemplate"].(object)["infrastructureRef"].(object)["name"])
+
+	// unsupported version
+	clusterInst.KubernetesVersion = "1.28"
+	_, err = c.getClusterObjects("testclust", clusterInst)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "kubernetes version 1.28 not supported")
+}
+
+func TestResolveKubernetesVersion(t *testing.T) {
+	c, _ := getTestPlatform(t)
+
+	tests := []struct {
+		requested string
+		expected  string
+		err       string
+	}{
+		{"", "v1.29.2", ""},
+		{"1.29", "v1.29.2", ""},
+		{"v1.30", "v1.30.1", ""},
+		{"1.30.1", "v1.30.1", ""},
+		{"1.3", "", "not supported"},
+	}
+	for _, test := range tests {
+		version, err := c.resolveKubernetesVersion(test.requested)
+		if test.err != "" {
+			require.NotNil(t, err, test.requested)
+			require.Contains(t, err.Error(), test.err, test.requested)
+		} else {
+			require.Nil(t, err, test.requested)
+			require.Equal(t, test.expected, version, test.requested)
+		}
+	}
+
+	// without a supported list, the version is passed through
+	c.properties.SetValue(CAPI_KUBERNETES_VERSIONS, "")
+	version, err := c.resolveKubernetesVersion("1.31.0")
+	require.Nil(t, err)
+	require.Equal(t, "v1.31.0", version)
+	_, err = c.resolveKubernetesVersion("")
+	require.NotNil(t, err)
+}
+
+func TestClusterLifecycle(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfra | log.DebugLevelApi)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	c, kubectl := getTestPlatform(t)
+	clusterInst := getTestClusterInst()
+	clusterName := c.NameSanitize("Test_Clust.org")
+	require.Equal(t, "test-clustorg", clusterName)
+
+	_, err := c.RunClusterCreateCommand(ctx, clusterName, clusterInst)
+	require.Nil(t, err)
+	require.Equal(t, []string{
+		"apply -f -",
+		"wait --for=condition=Ready cluster/test-clustorg -n clusters --timeout=30m0s",
+		"wait --for=condition=Ready machinedeployments -l cluster.x-k8s.io/cluster-name=test-clustorg -n clusters --timeout=30m0s",
+	}, kubectl.cmds)
+	require.Equal(t, 1, len(kubectl.stdins))
+	require.Contains(t, kubectl.stdins[0], "name: test-clustorg-cpupool")
+
+	// replace node pool, old machine deployment should be removed
+	kubectl.cmds = nil
+	kubectl.out["get machinedeployments"] = "test-clustorg-cpupool test-clustorg-gpupool"
+	clusterInst.NodePools[0].Name = "gpupool"
+	clusterInst.NodePools[0].NumNodes = 3
+	_, err = c.RunClusterUpdateCommand(ctx, clusterName, clusterInst)
+	require.Nil(t, err)
+	require.Equal(t, "delete machinedeployment test-clustorg-cpupool -n clusters --ignore-not-found", kubectl.cmds[2])
+	require.Equal(t, 5, len(kubectl.cmds))
+
+	kconf := "apiVersion: v1\nkind: Config\n"
+	kubectl.out["get secret test-clustorg-kubeconfig"] = base64.StdEncoding.EncodeToString([]byte(kconf))
+	data, err := c.GetCredentials(ctx, clusterName, clusterInst)
+	require.Nil(t, err)
+	require.Equal(t, kconf, string(data))
+
+	kubectl.cmds = nil
+	err = c.RunClusterDeleteCommand(ctx, clusterName, clusterInst)
+	require.Nil(t, err)
+	require.Equal(t, []string{
+		"delete cluster test-clustorg -n clusters --ignore-not-found --wait=true --timeout=30m0s",
+	}, kubectl.cmds)
+}
@@ -139,6 +139,31 @@ func (c *CommonPlatform) AddProxySecurityRulesAndPatchDNS(ctx context.Context, c
 	return nil
 }
 
+// GetRolloutHooks gets the hooks used for blue/green and canary
+// rollouts of kubernetes AppInsts. Traffic is switched by updating the
+// AppInst's rootLB proxy to forward to the given backend IPs. Health
+// is taken from the AppInst's HealthCheck state, which reflects the
+// health checks done against the rootLB proxy.
+func (c *CommonPlatform) GetRolloutHooks(client ssh.Client, appInstCache *edgeproto.AppInstCache, app *edgeproto.App, appInst *edgeproto.AppInst, proxyName string, proxyConfig *proxy.ProxyConfig, proxyops ...proxy.Op) *k8smgmt.RolloutHooks {
+	return &k8smgmt.RolloutHooks{
+		SetProxyBackends: func(ctx context.Context, lbIPs map[string]string) error {
+			log.SpanLog(ctx, log.DebugLevelInfra, "rollout set proxy backends", "proxyName", proxyName, "lbIPs", lbIPs)
+			inst := *appInst
+			inst.InternalPortToLbIp = lbIPs
+			config := *proxyConfig
+			config.SkipHCPorts = app.SkipHcPorts
+			return proxy.CreateNginxProxy(ctx, client, proxyName, c.PlatformConfig.EnvoyWithCurlImage, c.PlatformConfig.NginxWithCurlImage, &config, &inst, c.PlatformConfig.AccessApi, proxyops...)
+		},
+		GetHealthCheck: func(ctx context.Context) (dme.HealthCheck, error) {
+			inst := edgeproto.AppInst{}
+			if appInstCache == nil || !appInstCache.Get(&appInst.Key, &inst) {
+				return dme.HealthCheck_HEALTH_CHECK_UNKNOWN, appInst.Key.NotFoundError()
+			}
+			return inst.HealthCheck, nil
+		},
+	}
+}
+
 func (c *CommonPlatform) DeleteProxySecurityGroupRules(ctx context.Context, client ssh.Client, proxyName string, whiteListDel WhiteListFunc, wlParams *WhiteListParams) error {
 	log.SpanLog(ctx, log.DebugLevelInfra, "DeleteProxySecurityGroupRules", "proxyName", proxyName, "wlParams", wlParams)
 
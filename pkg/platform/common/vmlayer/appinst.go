@@ -487,7 +487,11 @@ func (v *VMPlatform) CreateAppInst(ctx context.Context, clusterInst *edgeproto.C
 
 		if deployment == cloudcommon.DeploymentTypeKubernetes {
 			updateCallback(edgeproto.UpdateTask, "Creating Kubernetes App")
-			err = k8smgmt.CreateAppInst(ctx, v.VMProperties.CommonPf.PlatformConfig.AccessApi, client, names, clusterInst, app, appInst, k8smgmt.WithAppInstNoWait())
+			trustPolicy, err := v.getAppInstTrustPolicy(ctx, &app.Key)
+			if err != nil {
+				return err
+			}
+			err = k8smgmt.CreateAppInst(ctx, v.VMProperties.CommonPf.PlatformConfig.AccessApi, client, names, clusterInst, app, appInst, k8smgmt.WithAppInstNoWait(), k8smgmt.WithTrustPolicy(trustPolicy))
 		} else {
 			updateCallback(edgeproto.UpdateTask, "Creating Helm App")
 
@@ -712,7 +716,8 @@ func (v *VMPlatform) cleanupAppInstInternal(ctx context.Context, clusterInst *ed
 		}
 		accessApi := v.VMProperties.CommonPf.PlatformConfig.AccessApi
 		if deployment == cloudcommon.DeploymentTypeKubernetes {
-			return k8smgmt.DeleteAppInst(ctx, accessApi, client, names, clusterInst, app, appInst)
+			trustPolicy := v.getAppInstDeleteTrustPolicy(ctx)
+			return k8smgmt.DeleteAppInst(ctx, accessApi, client, names, clusterInst, app, appInst, k8smgmt.WithTrustPolicy(trustPolicy))
 		} else {
 			return k8smgmt.DeleteHelmAppInst(ctx, client, names, clusterInst)
 		}
@@ -904,7 +909,18 @@ func (v *VMPlatform) UpdateAppInst(ctx context.Context, clusterInst *edgeproto.C
 
 	switch deployment := app.Deployment; deployment {
 	case cloudcommon.DeploymentTypeKubernetes:
-		return k8smgmt.UpdateAppInst(ctx, v.VMProperties.CommonPf.PlatformConfig.AccessApi, client, names, clusterInst, app, appInst)
+		trustPolicy, err := v.getAppInstTrustPolicy(ctx, &app.Key)
+		if err != nil {
+			return err
+		}
+		// rollouts switch traffic via the same proxy set up by setupDnsForAppInst
+		var appInstCache *edgeproto.AppInstCache
+		if v.Caches != nil {
+			appInstCache = v.Caches.AppInstCache
+		}
+		proxyConfig := NewProxyConfig(ListenAllIPs, masterIPs, appInst.EnableIpv6)
+		rolloutHooks := v.VMProperties.CommonPf.GetRolloutHooks(client, appInstCache, app, appInst, dockermgmt.GetContainerName(appInst), proxyConfig, proxy.WithDockerNetwork("host"), proxy.WithMetricEndpoint(infracommon.GetUniqueLoopbackIp(ctx, appInst.MappedPorts)))
+		return k8smgmt.UpdateAppInst(ctx, v.VMProperties.CommonPf.PlatformConfig.AccessApi, client, names, clusterInst, app, appInst, k8smgmt.WithTrustPolicy(trustPolicy), k8smgmt.WithRolloutHooks(rolloutHooks))
 	case cloudcommon.DeploymentTypeHelm:
 		return k8smgmt.UpdateHelmAppInst(ctx, client, names, app, appInst)
 
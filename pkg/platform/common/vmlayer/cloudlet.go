@@ -372,7 +372,11 @@ func (v *VMPlatform) UpdateTrustPolicy(ctx context.Context, TrustPolicy *edgepro
 	if err != nil {
 		return fmt.Errorf("Unable to get rootlb clients - %v", err)
 	}
-	return v.VMProvider.ConfigureCloudletSecurityRules(ctx, egressRestricted, TrustPolicy, rootlbClients, ActionUpdate, edgeproto.DummyUpdateCallback)
+	err = v.VMProvider.ConfigureCloudletSecurityRules(ctx, egressRestricted, TrustPolicy, rootlbClients, ActionUpdate, edgeproto.DummyUpdateCallback)
+	if err != nil {
+		return err
+	}
+	return v.updateAppInstsTrustPolicy(ctx, TrustPolicy, nil, nil, false)
 }
 
 func (v *VMPlatform) UpdateTrustPolicyException(ctx context.Context, TrustPolicyException *edgeproto.TrustPolicyException, clusterKey *edgeproto.ClusterKey) error {
@@ -383,7 +387,21 @@ func (v *VMPlatform) UpdateTrustPolicyException(ctx context.Context, TrustPolicy
 		return fmt.Errorf("Unable to get rootlb clients - %v", err)
 	}
 	// Only create supported, update not allowed.
-	return v.VMProvider.ConfigureTrustPolicyExceptionSecurityRules(ctx, TrustPolicyException, rootlbClients, ActionCreate, edgeproto.DummyUpdateCallback)
+	err = v.VMProvider.ConfigureTrustPolicyExceptionSecurityRules(ctx, TrustPolicyException, rootlbClients, ActionCreate, edgeproto.DummyUpdateCallback)
+	if err != nil {
+		return err
+	}
+	return v.updateAppInstsTrustPolicyException(ctx, &TrustPolicyException.Key, clusterKey, false)
+}
+
+// updateAppInstsTrustPolicyException updates the network policies of
+// the exception's App's AppInsts in the cluster.
+func (v *VMPlatform) updateAppInstsTrustPolicyException(ctx context.Context, tpeKey *edgeproto.TrustPolicyExceptionKey, clusterKey *edgeproto.ClusterKey, removed bool) error {
+	trustPolicy, err := v.getCloudletTrustPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	return v.updateAppInstsTrustPolicy(ctx, trustPolicy, clusterKey, tpeKey, removed)
 }
 
 func (v *VMPlatform) DeleteTrustPolicyException(ctx context.Context, TrustPolicyExceptionKey *edgeproto.TrustPolicyExceptionKey, clusterKey *edgeproto.ClusterKey) error {
@@ -397,7 +415,11 @@ func (v *VMPlatform) DeleteTrustPolicyException(ctx context.Context, TrustPolicy
 	TrustPolicyException := edgeproto.TrustPolicyException{
 		Key: *TrustPolicyExceptionKey,
 	}
-	return v.VMProvider.ConfigureTrustPolicyExceptionSecurityRules(ctx, &TrustPolicyException, rootlbClients, ActionDelete, edgeproto.DummyUpdateCallback)
+	err = v.VMProvider.ConfigureTrustPolicyExceptionSecurityRules(ctx, &TrustPolicyException, rootlbClients, ActionDelete, edgeproto.DummyUpdateCallback)
+	if err != nil {
+		return err
+	}
+	return v.updateAppInstsTrustPolicyException(ctx, TrustPolicyExceptionKey, clusterKey, true)
 }
 
 func (v *VMPlatform) DeleteCloudlet(ctx context.Context, cloudlet *edgeproto.Cloudlet, pfConfig *edgeproto.PlatformConfig, pfInitConfig *pf.PlatformInitConfig, caches *pf.Caches, updateCallback edgeproto.CacheUpdateCallback) error {
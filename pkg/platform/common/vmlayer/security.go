@@ -19,6 +19,8 @@ import (
 	"fmt"
 
 	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/k8smgmt"
 	"github.com/edgexr/edge-cloud-platform/pkg/log"
 )
 
@@ -34,6 +36,10 @@ func (v *VMPlatform) ConfigureCloudletSecurityRules(ctx context.Context, action
 		if err != nil {
 			return err
 		}
+		privPol, err = cloudcommon.TrustPolicyFqdns.ResolveTrustPolicy(ctx, privPol)
+		if err != nil {
+			return err
+		}
 		egressRestricted = true
 	} else {
 		// use an empty policy
@@ -45,3 +51,144 @@ func (v *VMPlatform) ConfigureCloudletSecurityRules(ctx context.Context, action
 	}
 	return v.VMProvider.ConfigureCloudletSecurityRules(ctx, egressRestricted, privPol, rootlbClients, action, edgeproto.DummyUpdateCallback)
 }
+
+// getCloudletTrustPolicy gets the cloudlet's trust policy, or an
+// empty policy if the cloudlet has no trust policy.
+func (v *VMPlatform) getCloudletTrustPolicy(ctx context.Context) (*edgeproto.TrustPolicy, error) {
+	cloudletKey := v.VMProperties.CommonPf.PlatformConfig.CloudletKey
+	policyName := v.getCloudletTrustPolicyName()
+	if policyName == "" {
+		return &edgeproto.TrustPolicy{}, nil
+	}
+	if v.Caches == nil || v.Caches.TrustPolicyCache == nil {
+		return nil, fmt.Errorf("cannot get trust policy %s, trust policy cache not initialized", policyName)
+	}
+	return edgeproto.GetCloudletTrustPolicy(ctx, policyName, cloudletKey.Organization, v.Caches.TrustPolicyCache)
+}
+
+func (v *VMPlatform) getCloudletTrustPolicyName() string {
+	cloudletKey := v.VMProperties.CommonPf.PlatformConfig.CloudletKey
+	policyName := v.VMProperties.CommonPf.PlatformConfig.TrustPolicy
+	cloudlet := edgeproto.Cloudlet{}
+	if v.Caches != nil && v.Caches.CloudletCache != nil && v.Caches.CloudletCache.Get(cloudletKey, &cloudlet) {
+		policyName = cloudlet.TrustPolicy
+	}
+	return policyName
+}
+
+// getAppInstTrustPolicy gets the cloudlet's trust policy to apply to
+// the App's AppInst network policies, see resolveAppInstTrustPolicy.
+func (v *VMPlatform) getAppInstTrustPolicy(ctx context.Context, appKey *edgeproto.AppKey) (*edgeproto.TrustPolicy, error) {
+	policy, err := v.getCloudletTrustPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return v.resolveAppInstTrustPolicy(ctx, policy, appKey, nil)
+}
+
+// getAppInstDeleteTrustPolicy gets the trust policy for deleting the
+// AppInst's network policy. Only the presence of a trust policy
+// matters for the delete, so the policy's rules are left out rather
+// than resolving remote FQDNs, which could fail and block the delete.
+func (v *VMPlatform) getAppInstDeleteTrustPolicy(ctx context.Context) *edgeproto.TrustPolicy {
+	policyName := v.getCloudletTrustPolicyName()
+	if policyName == "" {
+		return nil
+	}
+	return &edgeproto.TrustPolicy{
+		Key: edgeproto.PolicyKey{
+			Name:         policyName,
+			Organization: v.VMProperties.CommonPf.PlatformConfig.CloudletKey.Organization,
+		},
+	}
+}
+
+// resolveAppInstTrustPolicy returns a copy of the trust policy with the
+// outbound rules of the App's active trust policy exceptions added, as
+// the AppInst's network policy egress must allow them as well, and with
+// remote FQDNs resolved. The exception for the exclude key is left out,
+// as it is being removed.
+func (v *VMPlatform) resolveAppInstTrustPolicy(ctx context.Context, policy *edgeproto.TrustPolicy, appKey *edgeproto.AppKey, exclude *edgeproto.TrustPolicyExceptionKey) (*edgeproto.TrustPolicy, error) {
+	if policy.Key.Name == "" {
+		// egress is not restricted
+		return policy, nil
+	}
+	policy = policy.Clone()
+	if v.Caches != nil && v.Caches.TrustPolicyExceptionCache != nil {
+		v.Caches.TrustPolicyExceptionCache.Show(&edgeproto.TrustPolicyException{}, func(tpe *edgeproto.TrustPolicyException) error {
+			if !tpe.Key.AppKey.Matches(appKey) || tpe.State != edgeproto.TrustPolicyExceptionState_TRUST_POLICY_EXCEPTION_STATE_ACTIVE {
+				return nil
+			}
+			if exclude != nil && tpe.Key.Matches(exclude) {
+				return nil
+			}
+			policy.OutboundSecurityRules = append(policy.OutboundSecurityRules, tpe.OutboundSecurityRules...)
+			return nil
+		})
+	}
+	return cloudcommon.TrustPolicyFqdns.ResolveTrustPolicy(ctx, policy)
+}
+
+// updateAppInstsTrustPolicy updates the network policies of the
+// Kubernetes AppInsts on the cloudlet for the updated trust policy.
+// If the cluster key is specified, only the AppInsts of the App in
+// that cluster are updated, for a changed trust policy exception.
+func (v *VMPlatform) updateAppInstsTrustPolicy(ctx context.Context, trustPolicy *edgeproto.TrustPolicy, clusterKey *edgeproto.ClusterKey, tpeKey *edgeproto.TrustPolicyExceptionKey, tpeRemoved bool) error {
+	if v.Caches == nil || v.Caches.AppInstCache == nil {
+		return nil
+	}
+	var exclude *edgeproto.TrustPolicyExceptionKey
+	if tpeRemoved {
+		exclude = tpeKey
+	}
+	appInsts := []edgeproto.AppInst{}
+	v.Caches.AppInstCache.Show(&edgeproto.AppInst{}, func(appInst *edgeproto.AppInst) error {
+		if !appInst.CloudletKey.Matches(v.VMProperties.CommonPf.PlatformConfig.CloudletKey) {
+			return nil
+		}
+		if clusterKey != nil && !appInst.GetClusterKey().Matches(clusterKey) {
+			return nil
+		}
+		if tpeKey != nil && !appInst.AppKey.Matches(&tpeKey.AppKey) {
+			return nil
+		}
+		appInsts = append(appInsts, *appInst)
+		return nil
+	})
+	var lastErr error
+	for ii := range appInsts {
+		appInst := &appInsts[ii]
+		app := edgeproto.App{}
+		if !v.Caches.AppCache.Get(&appInst.AppKey, &app) {
+			continue
+		}
+		if app.Deployment != cloudcommon.DeploymentTypeKubernetes {
+			continue
+		}
+		clusterInst := edgeproto.ClusterInst{}
+		if !v.Caches.ClusterInstCache.Get(appInst.GetClusterKey(), &clusterInst) {
+			continue
+		}
+		appTrustPolicy, err := v.resolveAppInstTrustPolicy(ctx, trustPolicy, &app.Key, exclude)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		names, err := k8smgmt.GetKubeNames(&clusterInst, &app, appInst)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		client, err := v.GetClusterPlatformClient(ctx, &clusterInst, cloudcommon.ClientTypeRootLB)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		err = k8smgmt.UpdateAppInstTrustPolicy(ctx, client, names, &app, appInst, appTrustPolicy)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfra, "failed to update AppInst trust policy", "appInst", appInst.Key, "err", err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
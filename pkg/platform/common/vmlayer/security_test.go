@@ -0,0 +1,115 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmlayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppInstTrustPolicy(t *testing.T) {
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	v := VMPlatform{}
+	v.VMProperties.CommonPf.PlatformConfig = &platform.PlatformConfig{
+		CloudletKey: &edgeproto.CloudletKey{
+			Name:         "cloudlet",
+			Organization: "operorg",
+		},
+	}
+	appKey := edgeproto.AppKey{
+		Name:         "app",
+		Organization: "devorg",
+		Version:      "1.0",
+	}
+
+	// no trust policy and no caches
+	policy, err := v.getAppInstTrustPolicy(ctx, &appKey)
+	require.Nil(t, err)
+	require.Equal(t, "", policy.Key.Name)
+	require.Nil(t, v.getAppInstDeleteTrustPolicy(ctx))
+
+	// trust policy without caches
+	v.VMProperties.CommonPf.PlatformConfig.TrustPolicy = "trust"
+	_, err = v.getAppInstTrustPolicy(ctx, &appKey)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "trust policy cache not initialized")
+	// delete does not need the policy's rules, and the remote
+	// FQDN does not need to be resolved.
+	policy = v.getAppInstDeleteTrustPolicy(ctx)
+	require.Equal(t, "trust", policy.Key.Name)
+	require.Equal(t, 0, len(policy.OutboundSecurityRules))
+
+	// active trust policy exceptions for the App are added
+	v.Caches = &platform.Caches{
+		TrustPolicyCache:          edgeproto.NewTrustPolicyCache(),
+		TrustPolicyExceptionCache: edgeproto.NewTrustPolicyExceptionCache(),
+	}
+	v.Caches.TrustPolicyCache.Update(ctx, &edgeproto.TrustPolicy{
+		Key: edgeproto.PolicyKey{
+			Name:         "trust",
+			Organization: "operorg",
+		},
+		OutboundSecurityRules: []edgeproto.SecurityRule{{
+			Protocol:     "TCP",
+			PortRangeMin: 443,
+			PortRangeMax: 443,
+			RemoteCidr:   "35.1.1.1/32",
+		}},
+	}, 0)
+	tpeRule := edgeproto.SecurityRule{
+		Protocol:     "TCP",
+		PortRangeMin: 8443,
+		PortRangeMax: 8443,
+		RemoteCidr:   "35.3.3.3/32",
+	}
+	tpe := edgeproto.TrustPolicyException{
+		Key: edgeproto.TrustPolicyExceptionKey{
+			AppKey: appKey,
+			Name:   "tpe",
+		},
+		State:                 edgeproto.TrustPolicyExceptionState_TRUST_POLICY_EXCEPTION_STATE_ACTIVE,
+		OutboundSecurityRules: []edgeproto.SecurityRule{tpeRule},
+	}
+	v.Caches.TrustPolicyExceptionCache.Update(ctx, &tpe, 0)
+	otherTpe := tpe
+	otherTpe.Key.AppKey.Name = "otherapp"
+	v.Caches.TrustPolicyExceptionCache.Update(ctx, &otherTpe, 0)
+	pendingTpe := tpe
+	pendingTpe.Key.Name = "pending"
+	pendingTpe.State = edgeproto.TrustPolicyExceptionState_TRUST_POLICY_EXCEPTION_STATE_APPROVAL_REQUESTED
+	v.Caches.TrustPolicyExceptionCache.Update(ctx, &pendingTpe, 0)
+
+	policy, err = v.getAppInstTrustPolicy(ctx, &appKey)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(policy.OutboundSecurityRules))
+	require.Equal(t, tpeRule, policy.OutboundSecurityRules[1])
+
+	// removed exception is excluded
+	cloudletPolicy, err := v.getCloudletTrustPolicy(ctx)
+	require.Nil(t, err)
+	policy, err = v.resolveAppInstTrustPolicy(ctx, cloudletPolicy, &appKey, &tpe.Key)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(policy.OutboundSecurityRules))
+	// cached policy is not modified
+	require.Equal(t, 1, len(cloudletPolicy.OutboundSecurityRules))
+}
@@ -267,7 +267,26 @@ func (k *K8sBareMetalPlatform) UpdateAppInst(ctx context.Context, clusterInst *e
 	if err != nil {
 		return err
 	}
-	return k8smgmt.UpdateAppInst(ctx, k.commonPf.PlatformConfig.AccessApi, client, names, clusterInst, app, appInst)
+	ops := []k8smgmt.AppInstOp{}
+	if app.Deployment == cloudcommon.DeploymentTypeKubernetes && cloudcommon.GetUpdateStrategy(app) != edgeproto.UpdateStrategy_UPDATE_IN_PLACE {
+		// rollouts switch traffic via the same proxy set up by CreateAppInst
+		ipaddr, err := infracommon.GetIPAddressFromNetplan(ctx, client, k.GetLbName(ctx, appInst))
+		if err != nil {
+			return err
+		}
+		var appInstCache *edgeproto.AppInstCache
+		if k.caches != nil {
+			appInstCache = k.caches.AppInstCache
+		}
+		proxyConfig := &proxy.ProxyConfig{
+			ListenIP:   ipaddr.IPV4(),
+			ListenIPV6: ipaddr.IPV6(),
+		}
+		proxyName := k8smgmt.GetKconfName(clusterInst) + "-" + dockermgmt.GetContainerName(appInst)
+		rolloutHooks := k.commonPf.GetRolloutHooks(client, appInstCache, app, appInst, proxyName, proxyConfig, proxy.WithDockerNetwork("host"), proxy.WithDockerUser(DockerUser), proxy.WithMetricEndpoint(cloudcommon.ProxyMetricsListenUDS))
+		ops = append(ops, k8smgmt.WithRolloutHooks(rolloutHooks))
+	}
+	return k8smgmt.UpdateAppInst(ctx, k.commonPf.PlatformConfig.AccessApi, client, names, clusterInst, app, appInst, ops...)
 }
 
 func (k *K8sBareMetalPlatform) GetAppInstRuntime(ctx context.Context, clusterInst *edgeproto.ClusterInst, app *edgeproto.App, appInst *edgeproto.AppInst) (*edgeproto.AppInstRuntime, error) {
@@ -0,0 +1,495 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+)
+
+// ErrNotFound is returned by the client when the requested
+// domain, network, or volume does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Domain states as reported by libvirt
+const (
+	DomainStateRunning  = "running"
+	DomainStateShutoff  = "shut off"
+	DomainStatePaused   = "paused"
+	DomainStateCrashed  = "crashed"
+	DomainStateShutdown = "in shutdown"
+)
+
+// LibvirtClient is the set of libvirt operations used by the platform.
+// XML is passed as strings so that the client is a thin layer over
+// libvirt, and can be replaced by a fake for testing.
+type LibvirtClient interface {
+	GetNodeInfo(ctx context.Context) (*NodeInfo, error)
+	ListDomains(ctx context.Context) ([]string, error)
+	// GetDomainXML gets the persistent configuration of the domain
+	GetDomainXML(ctx context.Context, name string) (string, error)
+	GetDomainState(ctx context.Context, name string) (string, error)
+	DefineDomain(ctx context.Context, domainXML string) error
+	UndefineDomain(ctx context.Context, name string) error
+	StartDomain(ctx context.Context, name string) error
+	DestroyDomain(ctx context.Context, name string) error
+	RebootDomain(ctx context.Context, name string) error
+	// SetDomainMetadata sets the platform metadata in MetadataNamespace
+	SetDomainMetadata(ctx context.Context, name, metadataXML string) error
+	AttachInterface(ctx context.Context, name, interfaceXML string) error
+	DetachInterface(ctx context.Context, name, ifaceType, mac string) error
+	GetDomainStats(ctx context.Context, name string) (*DomainStats, error)
+	ListNetworks(ctx context.Context) ([]string, error)
+	// CreateNetwork defines, starts and autostarts the network
+	CreateNetwork(ctx context.Context, networkXML string) error
+	DeleteNetwork(ctx context.Context, name string) error
+	GetPoolInfo(ctx context.Context, pool string) (*PoolInfo, error)
+	ListVolumes(ctx context.Context, pool string) ([]string, error)
+	// CreateVolume creates a qcow2 volume, optionally backed by
+	// another qcow2 volume in the same pool.
+	CreateVolume(ctx context.Context, pool, name string, sizeGB uint64, backingVol string) error
+	// UploadVolume creates a volume from a local qcow2 file
+	UploadVolume(ctx context.Context, pool, name, file string) error
+	// CreateSeedVolume creates a cloud-init NoCloud seed ISO volume
+	// from the map of file names to contents.
+	CreateSeedVolume(ctx context.Context, pool, name string, files map[string]string) error
+	DeleteVolume(ctx context.Context, pool, name string) error
+}
+
+type NodeInfo struct {
+	CPUs      uint64
+	MemoryKiB uint64
+}
+
+type PoolInfo struct {
+	CapacityBytes   uint64
+	AllocationBytes uint64
+	AvailableBytes  uint64
+}
+
+type DomainStats struct {
+	Timestamp time.Time
+	// CPUTime is the total cpu time in nanoseconds
+	CPUTime uint64
+	VCPUs   uint64
+	// MemUsed is memory used in bytes
+	MemUsed        uint64
+	NetRxBytes     uint64
+	NetTxBytes     uint64
+	DiskAllocation uint64
+}
+
+// NewLibvirtClient gets a client for the given libvirt URI.
+func NewLibvirtClient(uri string) LibvirtClient {
+	return &virshClient{
+		uri: uri,
+	}
+}
+
+// virshClient implements LibvirtClient using the virsh command,
+// which allows for remote connections via ssh without needing
+// the libvirt client libraries.
+type virshClient struct {
+	uri string
+}
+
+func (s *virshClient) timedVirshCommand(ctx context.Context, args ...string) (string, error) {
+	args = append([]string{"-c", s.uri}, args...)
+	parmstr := strings.Join(args, " ")
+	start := time.Now()
+
+	log.SpanLog(ctx, log.DebugLevelInfra, "Virsh Command Start", "parms", parmstr)
+	newSh := infracommon.Sh(nil)
+	out, err := newSh.Command("virsh", args).CombinedOutput()
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "Virsh command returned error", "parms", parmstr, "out", string(out), "err", err, "elapsed time", time.Since(start))
+		if isVirshNotFound(string(out)) {
+			return string(out), fmt.Errorf("%s, %w", strings.TrimSpace(string(out)), ErrNotFound)
+		}
+		return string(out), fmt.Errorf("virsh %s failed, %s, %v", args[2], strings.TrimSpace(string(out)), err)
+	}
+	log.SpanLog(ctx, log.DebugLevelInfra, "Virsh Command Done", "parmstr", parmstr, "elapsed time", time.Since(start))
+	return string(out), nil
+}
+
+func isVirshNotFound(out string) bool {
+	for _, msg := range []string{
+		"Domain not found",
+		"failed to get domain",
+		"Network not found",
+		"failed to get network",
+		"Storage volume not found",
+		"failed to get vol",
+	} {
+		if strings.Contains(out, msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// virshWithFile writes the data to a temp file and runs the virsh
+// command with the file name appended to the args.
+func (s *virshClient) virshWithFile(ctx context.Context, data string, args ...string) error {
+	f, err := os.CreateTemp("", "virsh-*.xml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(data)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	args = append(args, f.Name())
+	_, err = s.timedVirshCommand(ctx, args...)
+	return err
+}
+
+// parseColonValues parses virsh output of the form "key: value"
+func parseColonValues(out string) map[string]string {
+	vals := make(map[string]string)
+	for _, line := range strings.Split(out, "\n") {
+		key, val, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		vals[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	}
+	return vals
+}
+
+func parseNames(out string) []string {
+	names := []string{}
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			names = append(names, line)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func parseUintField(vals map[string]string, key string) (uint64, error) {
+	str, ok := vals[key]
+	if !ok {
+		return 0, fmt.Errorf("missing %s", key)
+	}
+	// values may have units, i.e. "16310576 KiB"
+	str = strings.Fields(str)[0]
+	val, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s value %q, %v", key, str, err)
+	}
+	return val, nil
+}
+
+func (s *virshClient) GetNodeInfo(ctx context.Context) (*NodeInfo, error) {
+	out, err := s.timedVirshCommand(ctx, "nodeinfo")
+	if err != nil {
+		return nil, err
+	}
+	vals := parseColonValues(out)
+	info := NodeInfo{}
+	info.CPUs, err = parseUintField(vals, "CPU(s)")
+	if err != nil {
+		return nil, err
+	}
+	info.MemoryKiB, err = parseUintField(vals, "Memory size")
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (s *virshClient) ListDomains(ctx context.Context) ([]string, error) {
+	out, err := s.timedVirshCommand(ctx, "list", "--all", "--name")
+	if err != nil {
+		return nil, err
+	}
+	return parseNames(out), nil
+}
+
+func (s *virshClient) GetDomainXML(ctx context.Context, name string) (string, error) {
+	return s.timedVirshCommand(ctx, "dumpxml", "--inactive", name)
+}
+
+func (s *virshClient) GetDomainState(ctx context.Context, name string) (string, error) {
+	out, err := s.timedVirshCommand(ctx, "domstate", name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+func (s *virshClient) DefineDomain(ctx context.Context, domainXML string) error {
+	return s.virshWithFile(ctx, domainXML, "define")
+}
+
+func (s *virshClient) UndefineDomain(ctx context.Context, name string) error {
+	_, err := s.timedVirshCommand(ctx, "undefine", name)
+	return err
+}
+
+func (s *virshClient) StartDomain(ctx context.Context, name string) error {
+	_, err := s.timedVirshCommand(ctx, "start", name)
+	return err
+}
+
+func (s *virshClient) DestroyDomain(ctx context.Context, name string) error {
+	_, err := s.timedVirshCommand(ctx, "destroy", name)
+	return err
+}
+
+func (s *virshClient) RebootDomain(ctx context.Context, name string) error {
+	_, err := s.timedVirshCommand(ctx, "reboot", name)
+	return err
+}
+
+// liveFlags adds the live flag to apply changes to a running domain
+// in addition to the persistent config.
+func (s *virshClient) liveFlags(ctx context.Context, name string) ([]string, error) {
+	state, err := s.GetDomainState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if state == DomainStateRunning || state == DomainStatePaused {
+		return []string{"--config", "--live"}, nil
+	}
+	return []string{"--config"}, nil
+}
+
+func (s *virshClient) SetDomainMetadata(ctx context.Context, name, metadataXML string) error {
+	flags, err := s.liveFlags(ctx, name)
+	if err != nil {
+		return err
+	}
+	args := []string{"metadata", name, "--uri", MetadataNamespace, "--key", MetadataKey, "--set", metadataXML}
+	_, err = s.timedVirshCommand(ctx, append(args, flags...)...)
+	return err
+}
+
+func (s *virshClient) AttachInterface(ctx context.Context, name, interfaceXML string) error {
+	flags, err := s.liveFlags(ctx, name)
+	if err != nil {
+		return err
+	}
+	args := append([]string{"attach-device", name}, flags...)
+	return s.virshWithFile(ctx, interfaceXML, append(args, "--file")...)
+}
+
+func (s *virshClient) DetachInterface(ctx context.Context, name, ifaceType, mac string) error {
+	flags, err := s.liveFlags(ctx, name)
+	if err != nil {
+		return err
+	}
+	args := []string{"detach-interface", name, "--type", ifaceType, "--mac", mac}
+	_, err = s.timedVirshCommand(ctx, append(args, flags...)...)
+	return err
+}
+
+func (s *virshClient) GetDomainStats(ctx context.Context, name string) (*DomainStats, error) {
+	out, err := s.timedVirshCommand(ctx, "domstats", "--cpu-total", "--balloon", "--vcpu", "--interface", "--block", name)
+	if err != nil {
+		return nil, err
+	}
+	return parseDomainStats(out), nil
+}
+
+// parseDomainStats parses the key=value output of virsh domstats.
+func parseDomainStats(out string) *DomainStats {
+	vals := make(map[string]uint64)
+	for _, line := range strings.Split(out, "\n") {
+		key, val, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+		ival, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			continue
+		}
+		vals[key] = ival
+	}
+	stats := DomainStats{
+		Timestamp: time.Now(),
+		CPUTime:   vals["cpu.time"],
+		VCPUs:     vals["vcpu.current"],
+	}
+	// memory stats are in KiB. Unused memory is only reported
+	// if the guest has the balloon driver stats enabled.
+	if unused, ok := vals["balloon.unused"]; ok && vals["balloon.current"] >= unused {
+		stats.MemUsed = (vals["balloon.current"] - unused) * 1024
+	} else {
+		stats.MemUsed = vals["balloon.rss"] * 1024
+	}
+	for ii := uint64(0); ii < vals["net.count"]; ii++ {
+		stats.NetRxBytes += vals[fmt.Sprintf("net.%d.rx.bytes", ii)]
+		stats.NetTxBytes += vals[fmt.Sprintf("net.%d.tx.bytes", ii)]
+	}
+	for ii := uint64(0); ii < vals["block.count"]; ii++ {
+		stats.DiskAllocation += vals[fmt.Sprintf("block.%d.allocation", ii)]
+	}
+	return &stats
+}
+
+func (s *virshClient) ListNetworks(ctx context.Context) ([]string, error) {
+	out, err := s.timedVirshCommand(ctx, "net-list", "--all", "--name")
+	if err != nil {
+		return nil, err
+	}
+	return parseNames(out), nil
+}
+
+func (s *virshClient) CreateNetwork(ctx context.Context, networkXML string) error {
+	network, err := ParseNetworkXML(networkXML)
+	if err != nil {
+		return err
+	}
+	err = s.virshWithFile(ctx, networkXML, "net-define")
+	if err != nil {
+		return err
+	}
+	if _, err := s.timedVirshCommand(ctx, "net-start", network.Name); err != nil {
+		return err
+	}
+	_, err = s.timedVirshCommand(ctx, "net-autostart", network.Name)
+	return err
+}
+
+func (s *virshClient) DeleteNetwork(ctx context.Context, name string) error {
+	out, err := s.timedVirshCommand(ctx, "net-destroy", name)
+	if err != nil && !strings.Contains(out, "is not active") {
+		return err
+	}
+	_, err = s.timedVirshCommand(ctx, "net-undefine", name)
+	return err
+}
+
+func (s *virshClient) GetPoolInfo(ctx context.Context, pool string) (*PoolInfo, error) {
+	out, err := s.timedVirshCommand(ctx, "pool-info", "--bytes", pool)
+	if err != nil {
+		return nil, err
+	}
+	vals := parseColonValues(out)
+	info := PoolInfo{}
+	info.CapacityBytes, err = parseUintField(vals, "Capacity")
+	if err != nil {
+		return nil, err
+	}
+	info.AllocationBytes, err = parseUintField(vals, "Allocation")
+	if err != nil {
+		return nil, err
+	}
+	info.AvailableBytes, err = parseUintField(vals, "Available")
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (s *virshClient) ListVolumes(ctx context.Context, pool string) ([]string, error) {
+	out, err := s.timedVirshCommand(ctx, "vol-list", "--pool", pool)
+	if err != nil {
+		return nil, err
+	}
+	// output is a table with a two line header
+	names := []string{}
+	lines := strings.Split(out, "\n")
+	for ii, line := range lines {
+		fields := strings.Fields(line)
+		if ii < 2 || len(fields) == 0 {
+			continue
+		}
+		names = append(names, fields[0])
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *virshClient) CreateVolume(ctx context.Context, pool, name string, sizeGB uint64, backingVol string) error {
+	args := []string{"vol-create-as", pool, name, fmt.Sprintf("%dG", sizeGB), "--format", "qcow2"}
+	if backingVol != "" {
+		args = append(args, "--backing-vol", backingVol, "--backing-vol-format", "qcow2")
+	}
+	_, err := s.timedVirshCommand(ctx, args...)
+	return err
+}
+
+func (s *virshClient) uploadVolume(ctx context.Context, pool, name, file, format string) error {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	_, err = s.timedVirshCommand(ctx, "vol-create-as", pool, name, fmt.Sprintf("%d", fi.Size()), "--format", format)
+	if err != nil {
+		return err
+	}
+	_, err = s.timedVirshCommand(ctx, "vol-upload", "--pool", pool, name, file)
+	if err != nil {
+		if _, delerr := s.timedVirshCommand(ctx, "vol-delete", "--pool", pool, name); delerr != nil {
+			log.SpanLog(ctx, log.DebugLevelInfra, "failed to clean up volume after upload failure", "name", name, "err", delerr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *virshClient) UploadVolume(ctx context.Context, pool, name, file string) error {
+	return s.uploadVolume(ctx, pool, name, file, "qcow2")
+}
+
+func (s *virshClient) CreateSeedVolume(ctx context.Context, pool, name string, files map[string]string) error {
+	dir, err := os.MkdirTemp("", "seed-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{"-output", filepath.Join(dir, name), "-volid", "cidata", "-joliet", "-rock"}
+	fileNames := []string{}
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	for _, fileName := range fileNames {
+		filePath := filepath.Join(dir, fileName)
+		if err := os.WriteFile(filePath, []byte(files[fileName]), 0600); err != nil {
+			return err
+		}
+		args = append(args, filePath)
+	}
+	out, err := infracommon.Sh(nil).Command("genisoimage", args).CombinedOutput()
+	if err != nil {
+		log.SpanLog(ctx, log.DebugLevelInfra, "genisoimage failed", "out", string(out), "err", err)
+		return fmt.Errorf("failed to create seed iso %s, %s, %v", name, string(out), err)
+	}
+	return s.uploadVolume(ctx, pool, name, filepath.Join(dir, name), "raw")
+}
+
+func (s *virshClient) DeleteVolume(ctx context.Context, pool, name string) error {
+	_, err := s.timedVirshCommand(ctx, "vol-delete", "--pool", pool, name)
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
+)
+
+func (l *LibvirtPlatform) GetConsoleUrl(ctx context.Context, serverName string) (string, error) {
+	return "", fmt.Errorf("console not supported for Libvirt")
+}
+
+func (l *LibvirtPlatform) GetApiEndpointAddr(ctx context.Context) (string, error) {
+	uri := l.GetLibvirtURI()
+	log.SpanLog(ctx, log.DebugLevelInfra, "GetApiEndpointAddr", "uri", uri)
+	if uri == "" {
+		return "", fmt.Errorf("unable to find %s", LIBVIRT_URI)
+	}
+	return uri, nil
+}
+
+// GetCloudletManifest gives the steps to create the platform VM by hand
+// on the KVM host, using the domain definitions the platform would use.
+func (l *LibvirtPlatform) GetCloudletManifest(ctx context.Context, name string, cloudletImagePath string, vmgp *vmlayer.VMGroupOrchestrationParams) (string, error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "GetCloudletManifest", "name", name)
+
+	var manifest infracommon.CloudletManifest
+	err := l.populateOrchestrationParams(ctx, vmgp, vmlayer.ActionCreate)
+	if err != nil {
+		return "", fmt.Errorf("unable to populate orchestration params: %v", err)
+	}
+	pool := l.GetStoragePool()
+	manifest.AddItem("Download the platform image", infracommon.ManifestTypeURL, infracommon.ManifestSubTypeNone, cloudletImagePath)
+	for _, vm := range vmgp.VMs {
+		domXML, err := l.getDomainXML(vmgp.GroupName, &vm)
+		if err != nil {
+			return "", err
+		}
+		manifest.AddItem(fmt.Sprintf("Create the volumes for VM %s in storage pool %s", vm.Name, pool), infracommon.ManifestTypeNone, infracommon.ManifestSubTypeNone, "")
+		for _, vol := range vm.Volumes {
+			manifest.AddSubItem(fmt.Sprintf("%s, %dGB, backed by %s", getVMVolumeName(vm.Name, vol.Name), vol.Size, getImageVolumeName(vol.ImageName)), infracommon.ManifestTypeNone, infracommon.ManifestSubTypeNone, "")
+		}
+		manifest.AddSubItem(fmt.Sprintf("%s, cloud-init NoCloud seed ISO", getSeedVolumeName(vm.Name)), infracommon.ManifestTypeNone, infracommon.ManifestSubTypeNone, "")
+		manifest.AddItem(fmt.Sprintf("Define and start VM %s with virsh define and virsh start", vm.Name), infracommon.ManifestTypeCode, infracommon.ManifestSubTypeNone, domXML)
+	}
+	return manifest.ToString()
+}
+
+func (l *LibvirtPlatform) VerifyVMs(ctx context.Context, vms []edgeproto.VM) error {
+	return nil
+}
+
+func (l *LibvirtPlatform) GetCloudletInfraResourcesInfo(ctx context.Context) ([]edgeproto.InfraResource, error) {
+	return []edgeproto.InfraResource{}, nil
+}
+
+func (l *LibvirtPlatform) GetClusterAdditionalResources(ctx context.Context, cloudlet *edgeproto.Cloudlet, vmResources []edgeproto.VMResource) map[string]edgeproto.InfraResource {
+	resInfo := make(map[string]edgeproto.InfraResource)
+	return resInfo
+}
+
+func (l *LibvirtPlatform) GetClusterAdditionalResourceMetric(ctx context.Context, cloudlet *edgeproto.Cloudlet, resMetric *edgeproto.Metric, resources []edgeproto.VMResource) error {
+	return nil
+}
+
+func (l *LibvirtPlatform) InternalCloudletUpdatedCallback(ctx context.Context, old *edgeproto.CloudletInternal, new *edgeproto.CloudletInternal) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "InternalCloudletUpdatedCallback")
+}
+
+func (l *LibvirtPlatform) GetGPUSetupStage(ctx context.Context) vmlayer.GPUSetupStage {
+	return vmlayer.ClusterInstStage
+}
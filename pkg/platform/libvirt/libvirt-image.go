@@ -0,0 +1,90 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
+)
+
+// AddImageIfNotPresent uploads the image as a volume in the storage
+// pool. VM disks are created as overlays backed by the image volume.
+func (l *LibvirtPlatform) AddImageIfNotPresent(ctx context.Context, imageInfo *infracommon.ImageInfo, updateCallback edgeproto.CacheUpdateCallback) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "AddImageIfNotPresent", "imageInfo", imageInfo)
+
+	pool := l.GetStoragePool()
+	volName := getImageVolumeName(imageInfo.LocalImageName)
+	vols, err := l.client.ListVolumes(ctx, pool)
+	if err != nil {
+		return err
+	}
+	for _, vol := range vols {
+		if vol == volName {
+			log.SpanLog(ctx, log.DebugLevelInfra, "image volume already present", "volName", volName)
+			return nil
+		}
+	}
+	if imageInfo.ImageType != edgeproto.ImageType_IMAGE_TYPE_QCOW {
+		return fmt.Errorf("unsupported image type %s, only QCOW images are supported", imageInfo.ImageType.String())
+	}
+
+	updateCallback(edgeproto.UpdateTask, "Downloading VM Image")
+	filePath, err := vmlayer.DownloadVMImage(ctx, l.vmProperties.CommonPf.PlatformConfig.AccessApi, imageInfo.LocalImageName, imageInfo.ImagePath, imageInfo.Md5sum)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if delerr := cloudcommon.DeleteFile(filePath); delerr != nil {
+			if !os.IsNotExist(delerr) {
+				log.SpanLog(ctx, log.DebugLevelInfra, "delete file failed", "filePath", filePath, "error", delerr)
+			}
+		}
+	}()
+
+	updateCallback(edgeproto.UpdateTask, "Uploading VM Image")
+	err = l.client.UploadVolume(ctx, pool, volName, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to upload image %s: %v", volName, err)
+	}
+	return nil
+}
+
+func (l *LibvirtPlatform) DeleteImage(ctx context.Context, folder, image string) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "DeleteImage", "image", image)
+	err := l.client.DeleteVolume(ctx, l.GetStoragePool(), getImageVolumeName(image))
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (l *LibvirtPlatform) GetCloudletImageSuffix(ctx context.Context) string {
+	return imageSuffix
+}
+
+func (l *LibvirtPlatform) GetFlavorList(ctx context.Context) ([]*edgeproto.FlavorInfo, error) {
+	var flavors []*edgeproto.FlavorInfo
+	// by returning no flavors, we signal to the controller this platform supports no native flavors
+	log.SpanLog(ctx, log.DebugLevelInfra, "GetFlavorList return empty", "len", len(flavors))
+	return flavors, nil
+}
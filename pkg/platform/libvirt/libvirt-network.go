@@ -0,0 +1,78 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
+)
+
+func (l *LibvirtPlatform) GetExternalIpRanges() ([]string, error) {
+	extIPs, _ := l.vmProperties.CommonPf.Properties.GetValue("MEX_EXTERNAL_IP_RANGES")
+	if extIPs == "" {
+		return nil, fmt.Errorf("MEX_EXTERNAL_IP_RANGES not defined")
+	}
+	return infracommon.ParseIpRanges(extIPs)
+}
+
+// getFreeExternalIP gets the first IP in the external ranges that
+// is not in the used set.
+func (l *LibvirtPlatform) getFreeExternalIP(ctx context.Context, usedIPs map[string]string) (string, error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "getFreeExternalIP")
+	ips, err := l.GetExternalIpRanges()
+	if err != nil {
+		return "", err
+	}
+	for _, ip := range ips {
+		if _, used := usedIPs[ip]; !used {
+			return ip, nil
+		}
+	}
+	return "", fmt.Errorf("No available IPs")
+}
+
+// GetExternalIPCounts returns Total, Used
+func (l *LibvirtPlatform) GetExternalIPCounts(ctx context.Context) (uint64, uint64, error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "GetExternalIPCounts")
+	ips, err := l.GetExternalIpRanges()
+	if err != nil {
+		return 0, 0, err
+	}
+	_, usedIPs, err := l.getUsedAddresses(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint64(len(ips)), uint64(len(usedIPs)), nil
+}
+
+func (l *LibvirtPlatform) GetRouterDetail(ctx context.Context, routerName string) (*vmlayer.RouterDetail, error) {
+	return nil, fmt.Errorf("Router not supported for Libvirt")
+}
+
+func (l *LibvirtPlatform) GetInternalPortPolicy() vmlayer.InternalPortAttachPolicy {
+	return vmlayer.AttachPortDuringCreate
+}
+
+func (l *LibvirtPlatform) GetNetworkList(ctx context.Context) ([]string, error) {
+	return []string{l.vmProperties.GetCloudletExternalNetwork()}, nil
+}
+
+func (l *LibvirtPlatform) ValidateAdditionalNetworks(ctx context.Context, additionalNets map[string]vmlayer.NetworkType) error {
+	return fmt.Errorf("Additional networks not supported in Libvirt cloudlets")
+}
@@ -0,0 +1,1013 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
+	yaml "github.com/mobiledgex/yaml/v2"
+)
+
+// orchVmLock serializes the allocation of subnets and external IPs,
+// which are tracked in the domain metadata.
+var orchVmLock sync.Mutex
+
+const imageSuffix = ".qcow2"
+
+// cloud-init data is written as-is to the seed volume
+func libvirtConfigDataFormatter(instring string) string {
+	return instring
+}
+
+func getImageVolumeName(imageName string) string {
+	if strings.HasSuffix(imageName, imageSuffix) {
+		return imageName
+	}
+	return imageName + imageSuffix
+}
+
+func getVMVolumeName(vmName, volName string) string {
+	return vmName + "-" + volName + imageSuffix
+}
+
+func getSeedVolumeName(vmName string) string {
+	return vmName + "-seed.iso"
+}
+
+// getNetworkName gets the libvirt network name for an internal subnet
+func (l *LibvirtPlatform) getNetworkName(subnetName string) string {
+	return l.IdSanitize(subnetName)
+}
+
+// getMacAddress generates a stable locally administered MAC
+// in the KVM range for the VM's interface on the network.
+func getMacAddress(vmName, network string) string {
+	h := fnv.New32a()
+	h.Write([]byte(vmName + "/" + network))
+	sum := h.Sum32()
+	return fmt.Sprintf("52:54:00:%02x:%02x:%02x", byte(sum>>16), byte(sum>>8), byte(sum))
+}
+
+func getCIDR(addr, mask string) string {
+	_, ipnet, err := net.ParseCIDR(addr + "/" + mask)
+	if err != nil {
+		return ""
+	}
+	return ipnet.String()
+}
+
+func (l *LibvirtPlatform) getDomain(ctx context.Context, name string) (*Domain, error) {
+	out, err := l.client.GetDomainXML(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf(vmlayer.ServerDoesNotExistError)
+		}
+		return nil, err
+	}
+	return ParseDomainXML(out)
+}
+
+// getDomains gets all domains on the host
+func (l *LibvirtPlatform) getDomains(ctx context.Context) ([]*Domain, error) {
+	names, err := l.client.ListDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	domains := []*Domain{}
+	for _, name := range names {
+		out, err := l.client.GetDomainXML(ctx, name)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				// deleted since listed
+				continue
+			}
+			return nil, err
+		}
+		dom, err := ParseDomainXML(out)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, dom)
+	}
+	return domains, nil
+}
+
+func (l *LibvirtPlatform) getGroupDomains(ctx context.Context, groupName string) ([]*Domain, error) {
+	domains, err := l.getDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groupDomains := []*Domain{}
+	for _, dom := range domains {
+		md := dom.GetInstanceMetadata()
+		if md != nil && md.Group == groupName {
+			groupDomains = append(groupDomains, dom)
+		}
+	}
+	return groupDomains, nil
+}
+
+// getUsedAddresses returns the internal subnet CIDRs in use mapped to
+// their subnet name, and the external IPs in use mapped to their VM name.
+func (l *LibvirtPlatform) getUsedAddresses(ctx context.Context) (map[string]string, map[string]string, error) {
+	usedCidrs := make(map[string]string)
+	usedIPs := make(map[string]string)
+	domains, err := l.getDomains(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	extNet := l.vmProperties.GetCloudletExternalNetwork()
+	for _, dom := range domains {
+		md := dom.GetInstanceMetadata()
+		if md == nil {
+			continue
+		}
+		for _, ip := range md.IPs {
+			if ip.Network == extNet {
+				usedIPs[ip.Address] = dom.Name
+			} else if ip.CIDR != "" {
+				usedCidrs[ip.CIDR] = ip.Network
+			}
+		}
+	}
+	return usedCidrs, usedIPs, nil
+}
+
+func (l *LibvirtPlatform) populateOrchestrationParams(ctx context.Context, vmgp *vmlayer.VMGroupOrchestrationParams, action vmlayer.ActionType) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "populateOrchestrationParams", "SkipInfraSpecificCheck", vmgp.SkipInfraSpecificCheck)
+
+	masterIP := ""
+	flavors, err := l.vmProperties.GetFlavorListInternal(ctx, l.caches)
+	if err != nil {
+		return err
+	}
+	usedCidrs := make(map[string]string)
+	usedIPs := make(map[string]string)
+	if !vmgp.SkipInfraSpecificCheck {
+		usedCidrs, usedIPs, err = l.getUsedAddresses(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	currentIPs := make(map[string]string)
+	for ip, vmName := range usedIPs {
+		currentIPs[vmName] = ip
+	}
+
+	// find an available subnet or the current subnet for update
+	for i, s := range vmgp.Subnets {
+		if s.CIDR != vmlayer.NextAvailableResource || vmgp.SkipInfraSpecificCheck {
+			// no need to compute the CIDR
+			continue
+		}
+		if s.IPVersion == infracommon.IPV6 {
+			log.SpanLog(ctx, log.DebugLevelInfra, "ipv6 subnets not supported", "subnet", s)
+			continue
+		}
+		found := false
+		for octet := 0; octet <= 255; octet++ {
+			subnet := fmt.Sprintf("%s.%s.%d.%d/%s", vmgp.Netspec.Octets[0], vmgp.Netspec.Octets[1], octet, 0, vmgp.Netspec.NetmaskBits)
+			// either look for an unused one (create) or the current one (update)
+			newSubnet := action == vmlayer.ActionCreate
+			if (newSubnet && usedCidrs[subnet] == "") || (!newSubnet && usedCidrs[subnet] == s.Name) {
+				found = true
+				usedCidrs[subnet] = s.Name
+				vmgp.Subnets[i].CIDR = subnet
+				vmgp.Subnets[i].GatewayIP = fmt.Sprintf("%s.%s.%d.%d", vmgp.Netspec.Octets[0], vmgp.Netspec.Octets[1], octet, 1)
+				vmgp.Subnets[i].NodeIPPrefix = fmt.Sprintf("%s.%s.%d", vmgp.Netspec.Octets[0], vmgp.Netspec.Octets[1], octet)
+				masterIP = fmt.Sprintf("%s.%s.%d.%d", vmgp.Netspec.Octets[0], vmgp.Netspec.Octets[1], octet, 10)
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("cannot find subnet cidr")
+		}
+	}
+
+	extNet := l.vmProperties.GetCloudletExternalNetwork()
+	for vmidx, vm := range vmgp.VMs {
+		vmHasExternalIp := false
+		vmgp.VMs[vmidx].MetaData = vmlayer.GetVMMetaData(vm.Role, masterIP, "", libvirtConfigDataFormatter)
+		userdata, err := vmlayer.GetVMUserData(vm.Name, vm.SharedVolume, vm.DeploymentManifest, vm.Command, &vm.CloudConfigParams, libvirtConfigDataFormatter)
+		if err != nil {
+			return err
+		}
+		vmgp.VMs[vmidx].UserData = userdata
+		flavormatch := false
+		for _, f := range flavors {
+			if f.Name == vm.FlavorName {
+				vmgp.VMs[vmidx].Vcpus = f.Vcpus
+				vmgp.VMs[vmidx].Disk = f.Disk
+				vmgp.VMs[vmidx].Ram = f.Ram
+				flavormatch = true
+				break
+			}
+		}
+		if !flavormatch {
+			return fmt.Errorf("No match in flavor cache for flavor name: %s", vm.FlavorName)
+		}
+
+		// The root disk is an overlay on the image volume. An external
+		// volume (VM apps) replaces the root disk, other volumes
+		// are attached as additional disks.
+		rootVol := vmlayer.VolumeOrchestrationParams{
+			Name:      "disk0",
+			ImageName: vm.ImageName,
+			Size:      vmgp.VMs[vmidx].Disk,
+		}
+		volumes := []vmlayer.VolumeOrchestrationParams{}
+		for _, vol := range vm.Volumes {
+			if vol.UnitNumber == 0 {
+				rootVol.ImageName = vol.ImageName
+				if vol.Size > rootVol.Size {
+					rootVol.Size = vol.Size
+				}
+				continue
+			}
+			vol.Name = fmt.Sprintf("disk%d", len(volumes)+1)
+			volumes = append(volumes, vol)
+		}
+		if rootVol.Size < vmlayer.MINIMUM_DISK_SIZE {
+			rootVol.Size = vmlayer.MINIMUM_DISK_SIZE
+		}
+		vmgp.VMs[vmidx].Volumes = append([]vmlayer.VolumeOrchestrationParams{rootVol}, volumes...)
+
+		if !vmgp.SkipInfraSpecificCheck {
+			// populate external ips
+			for _, portref := range vm.Ports {
+				if portref.NetworkId != l.IdSanitize(extNet) {
+					continue
+				}
+				vmHasExternalIp = true
+				eip, ok := currentIPs[vm.Name]
+				if ok && action == vmlayer.ActionUpdate {
+					log.SpanLog(ctx, log.DebugLevelInfra, "using current ip for action", "eip", eip, "action", action, "server", vm.Name)
+				} else {
+					eip, err = l.getFreeExternalIP(ctx, usedIPs)
+					if err != nil {
+						return err
+					}
+					usedIPs[eip] = vm.Name
+				}
+				gw, err := l.GetExternalGateway(ctx, extNet)
+				if err != nil {
+					return err
+				}
+				fip := vmlayer.FixedIPOrchestrationParams{
+					Subnet:    vmlayer.NewResourceReference(extNet, portref.NetworkId, false),
+					Mask:      l.GetExternalNetmask(),
+					Address:   eip,
+					Gateway:   gw,
+					IPVersion: infracommon.IPV4,
+				}
+				vmgp.VMs[vmidx].FixedIPs = append(vmgp.VMs[vmidx].FixedIPs, fip)
+			}
+
+			// update fixedips from subnet found
+			for fipidx, fip := range vmgp.VMs[vmidx].FixedIPs {
+				if fip.Address != vmlayer.NextAvailableResource {
+					continue
+				}
+				found := false
+				for _, s := range vmgp.Subnets {
+					if s.Name == fip.Subnet.Name {
+						found = true
+						vmgp.VMs[vmidx].FixedIPs[fipidx].Address = fmt.Sprintf("%s.%d", s.NodeIPPrefix, fip.LastIPOctet)
+						vmgp.VMs[vmidx].FixedIPs[fipidx].Mask = l.GetInternalNetmask()
+						if !vmHasExternalIp {
+							vmgp.VMs[vmidx].FixedIPs[fipidx].Gateway = s.GatewayIP
+						}
+						log.SpanLog(ctx, log.DebugLevelInfra, "updating address for VM", "vmname", vm.Name, "address", vmgp.VMs[vmidx].FixedIPs[fipidx].Address)
+						break
+					}
+				}
+				if !found {
+					return fmt.Errorf("subnet for vm %s not found", vm.Name)
+				}
+			}
+		}
+
+		// put the fip with the GW and the external port first
+		fips := vmgp.VMs[vmidx].FixedIPs
+		sort.SliceStable(fips, func(i, j int) bool {
+			return fips[i].Gateway != "" && fips[j].Gateway == ""
+		})
+		ports := vmgp.VMs[vmidx].Ports
+		sort.SliceStable(ports, func(i, j int) bool {
+			return ports[i].NetworkId == l.IdSanitize(extNet) && ports[j].NetworkId != l.IdSanitize(extNet)
+		})
+		log.SpanLog(ctx, log.DebugLevelInfra, "Interfaces after sorting", "vmname", vm.Name, "FixedIPs", fips, "Ports", ports)
+	}
+	return nil
+}
+
+// getPortNetwork gets the sanitized network name for the port, which
+// is either the external network or an internal subnet.
+func (l *LibvirtPlatform) getPortNetwork(port *vmlayer.PortResourceReference) string {
+	if port.NetworkId == l.IdSanitize(l.vmProperties.GetCloudletExternalNetwork()) {
+		return port.NetworkId
+	}
+	return l.getNetworkName(port.SubnetId)
+}
+
+// getInterface gets the domain interface for the given network
+func (l *LibvirtPlatform) getInterface(vmName, network string) DomainInterface {
+	iface := DomainInterface{
+		MAC: &DomainInterfaceMAC{
+			Address: getMacAddress(vmName, network),
+		},
+		Model: &DomainInterfaceModel{
+			Type: "virtio",
+		},
+	}
+	if network == l.IdSanitize(l.vmProperties.GetCloudletExternalNetwork()) {
+		iface.Type = InterfaceTypeBridge
+		iface.Source.Bridge = l.GetExternalBridge()
+	} else {
+		iface.Type = InterfaceTypeNetwork
+		iface.Source.Network = network
+	}
+	return iface
+}
+
+func (l *LibvirtPlatform) getInstanceIP(vmName string, fip *vmlayer.FixedIPOrchestrationParams) InstanceIP {
+	return InstanceIP{
+		Network: fip.Subnet.Name,
+		Address: fip.Address,
+		CIDR:    getCIDR(fip.Address, fip.Mask),
+		MAC:     getMacAddress(vmName, l.IdSanitize(fip.Subnet.Name)),
+	}
+}
+
+type netplanConfig struct {
+	Version   int                        `yaml:"version"`
+	Ethernets map[string]netplanEthernet `yaml:"ethernets"`
+}
+
+type netplanEthernet struct {
+	Match       netplanMatch        `yaml:"match"`
+	Addresses   []string            `yaml:"addresses,omitempty"`
+	Routes      []netplanRoute      `yaml:"routes,omitempty"`
+	Nameservers *netplanNameservers `yaml:"nameservers,omitempty"`
+}
+
+type netplanMatch struct {
+	MacAddress string `yaml:"macaddress"`
+}
+
+type netplanRoute struct {
+	To  string `yaml:"to"`
+	Via string `yaml:"via"`
+}
+
+type netplanNameservers struct {
+	Addresses []string `yaml:"addresses"`
+}
+
+// getNetworkConfig generates the cloud-init network config. There is
+// no DHCP on the networks, so addresses are configured statically.
+func (l *LibvirtPlatform) getNetworkConfig(vm *vmlayer.VMOrchestrationParams) (string, error) {
+	config := netplanConfig{
+		Version:   2,
+		Ethernets: make(map[string]netplanEthernet),
+	}
+	for ii, fip := range vm.FixedIPs {
+		if fip.IPVersion == infracommon.IPV6 {
+			continue
+		}
+		ip := l.getInstanceIP(vm.Name, &fip)
+		eth := netplanEthernet{
+			Match: netplanMatch{
+				MacAddress: ip.MAC,
+			},
+			Addresses: []string{fip.Address + "/" + fip.Mask},
+		}
+		if fip.Gateway != "" {
+			eth.Routes = []netplanRoute{{
+				To:  "0.0.0.0/0",
+				Via: fip.Gateway,
+			}}
+			dns := []string{}
+			for _, server := range []string{vm.CloudConfigParams.PrimaryDNS, vm.CloudConfigParams.FallbackDNS} {
+				dns = append(dns, strings.Fields(server)...)
+			}
+			if len(dns) > 0 {
+				eth.Nameservers = &netplanNameservers{
+					Addresses: dns,
+				}
+			}
+		}
+		config.Ethernets[fmt.Sprintf("eth%d", ii)] = eth
+	}
+	out, err := yaml.Marshal(&config)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func (l *LibvirtPlatform) getSeedFiles(vm *vmlayer.VMOrchestrationParams) (map[string]string, error) {
+	networkConfig, err := l.getNetworkConfig(vm)
+	if err != nil {
+		return nil, err
+	}
+	hostName := vm.HostName
+	if hostName == "" {
+		hostName = vm.Name
+	}
+	metaData := "instance-id: " + vm.Name + "\nlocal-hostname: " + hostName + "\n"
+	if vm.MetaData != "" {
+		metaData += vm.MetaData + "\n"
+	}
+	userData := vm.UserData
+	if userData == "" {
+		userData = "#cloud-config\n"
+	}
+	return map[string]string{
+		"meta-data":      metaData,
+		"user-data":      userData,
+		"network-config": networkConfig,
+	}, nil
+}
+
+// getDomainXML generates the domain definition for the VM
+func (l *LibvirtPlatform) getDomainXML(groupName string, vm *vmlayer.VMOrchestrationParams) (string, error) {
+	pool := l.GetStoragePool()
+	dom := Domain{
+		Type: "kvm",
+		Name: vm.Name,
+		Memory: DomainMemory{
+			Unit:  "MiB",
+			Value: vm.Ram,
+		},
+		VCPU: vm.Vcpus,
+		OS: DomainOS{
+			Type: DomainOSType{
+				Arch:  "x86_64",
+				Value: "hvm",
+			},
+			Boot: []DomainBoot{{Dev: "hd"}},
+		},
+		Features: &DomainFeatures{
+			ACPI: &struct{}{},
+			APIC: &struct{}{},
+		},
+		CPU: &DomainCPU{
+			Mode: "host-passthrough",
+		},
+	}
+	for ii, vol := range vm.Volumes {
+		dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+			Type:   "volume",
+			Device: DiskDeviceDisk,
+			Driver: DomainDiskDriver{Name: "qemu", Type: "qcow2"},
+			Source: DomainDiskSource{Pool: pool, Volume: getVMVolumeName(vm.Name, vol.Name)},
+			Target: DomainDiskTarget{Dev: diskTargetDev("vd", ii), Bus: "virtio"},
+		})
+	}
+	dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+		Type:     "volume",
+		Device:   DiskDeviceCdrom,
+		Driver:   DomainDiskDriver{Name: "qemu", Type: "raw"},
+		Source:   DomainDiskSource{Pool: pool, Volume: getSeedVolumeName(vm.Name)},
+		Target:   DomainDiskTarget{Dev: "sda", Bus: "sata"},
+		ReadOnly: &struct{}{},
+	})
+	for _, port := range vm.Ports {
+		network := l.getPortNetwork(&port)
+		dom.Devices.Interfaces = append(dom.Devices.Interfaces, l.getInterface(vm.Name, network))
+	}
+	dom.Devices.Serials = []DomainChardev{{Type: "pty"}}
+	dom.Devices.Consoles = []DomainChardev{{Type: "pty"}}
+
+	md := InstanceMetadata{
+		Group:  groupName,
+		Role:   string(vm.Role),
+		Flavor: vm.FlavorName,
+		Domain: string(l.vmProperties.Domain),
+	}
+	for _, fip := range vm.FixedIPs {
+		if fip.IPVersion == infracommon.IPV6 {
+			continue
+		}
+		md.IPs = append(md.IPs, l.getInstanceIP(vm.Name, &fip))
+	}
+	dom.Metadata = &DomainMetadata{Instance: &md}
+	return dom.ToXML()
+}
+
+// defineVM creates the volumes and defines the domain for the VM.
+// The domain is not started.
+func (l *LibvirtPlatform) defineVM(ctx context.Context, groupName string, vm *vmlayer.VMOrchestrationParams) (reterr error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "defineVM", "vmName", vm.Name)
+	pool := l.GetStoragePool()
+
+	createdVols := []string{}
+	defer func() {
+		if reterr == nil {
+			return
+		}
+		for _, vol := range createdVols {
+			if err := l.client.DeleteVolume(ctx, pool, vol); err != nil {
+				log.SpanLog(ctx, log.DebugLevelInfra, "failed to clean up volume", "vol", vol, "err", err)
+			}
+		}
+	}()
+
+	for _, vol := range vm.Volumes {
+		volName := getVMVolumeName(vm.Name, vol.Name)
+		backingVol := ""
+		if vol.ImageName != "" {
+			backingVol = getImageVolumeName(vol.ImageName)
+		}
+		err := l.client.CreateVolume(ctx, pool, volName, vol.Size, backingVol)
+		if err != nil {
+			return fmt.Errorf("failed to create volume %s for VM %s: %v", volName, vm.Name, err)
+		}
+		createdVols = append(createdVols, volName)
+	}
+	seedFiles, err := l.getSeedFiles(vm)
+	if err != nil {
+		return err
+	}
+	seedVol := getSeedVolumeName(vm.Name)
+	err = l.client.CreateSeedVolume(ctx, pool, seedVol, seedFiles)
+	if err != nil {
+		return fmt.Errorf("failed to create cloud-init volume for VM %s: %v", vm.Name, err)
+	}
+	createdVols = append(createdVols, seedVol)
+
+	domXML, err := l.getDomainXML(groupName, vm)
+	if err != nil {
+		return err
+	}
+	err = l.client.DefineDomain(ctx, domXML)
+	if err != nil {
+		return fmt.Errorf("failed to define VM %s: %v", vm.Name, err)
+	}
+	return nil
+}
+
+// createNetworks creates the isolated libvirt networks for the subnets
+func (l *LibvirtPlatform) createNetworks(ctx context.Context, vmgp *vmlayer.VMGroupOrchestrationParams) error {
+	existing, err := l.client.ListNetworks(ctx)
+	if err != nil {
+		return err
+	}
+	networks := make(map[string]struct{})
+	for _, name := range existing {
+		networks[name] = struct{}{}
+	}
+	for _, s := range vmgp.Subnets {
+		if s.IPVersion == infracommon.IPV6 {
+			continue
+		}
+		name := l.getNetworkName(s.Name)
+		if _, found := networks[name]; found {
+			continue
+		}
+		log.SpanLog(ctx, log.DebugLevelInfra, "creating network", "name", name, "cidr", s.CIDR)
+		network := Network{Name: name}
+		netXML, err := network.ToXML()
+		if err != nil {
+			return err
+		}
+		if err := l.client.CreateNetwork(ctx, netXML); err != nil {
+			return fmt.Errorf("failed to create network %s: %v", name, err)
+		}
+		networks[name] = struct{}{}
+	}
+	return nil
+}
+
+// startVMs starts the VMs in parallel
+func (l *LibvirtPlatform) startVMs(ctx context.Context, vmNames []string) error {
+	results := make(chan error, len(vmNames))
+	for _, name := range vmNames {
+		go func(vmName string) {
+			results <- l.client.StartDomain(ctx, vmName)
+		}(name)
+	}
+	errs := []string{}
+	for range vmNames {
+		if err := <-results; err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func (l *LibvirtPlatform) defineVMs(ctx context.Context, vmgp *vmlayer.VMGroupOrchestrationParams) error {
+	orchVmLock.Lock()
+	defer orchVmLock.Unlock()
+
+	err := l.populateOrchestrationParams(ctx, vmgp, vmlayer.ActionCreate)
+	if err != nil {
+		return err
+	}
+	if err := l.createNetworks(ctx, vmgp); err != nil {
+		return err
+	}
+	// domains must be defined under the lock as they hold the
+	// address allocations
+	for ii := range vmgp.VMs {
+		if err := l.defineVM(ctx, vmgp.GroupName, &vmgp.VMs[ii]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *LibvirtPlatform) CreateVMs(ctx context.Context, vmgp *vmlayer.VMGroupOrchestrationParams, updateCallback edgeproto.CacheUpdateCallback) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "CreateVMs", "groupName", vmgp.GroupName)
+
+	updateCallback(edgeproto.UpdateTask, "Creating VMs")
+	err := l.defineVMs(ctx, vmgp)
+	if err == nil {
+		vmNames := []string{}
+		for _, vm := range vmgp.VMs {
+			vmNames = append(vmNames, vm.Name)
+		}
+		updateCallback(edgeproto.UpdateTask, "Starting VMs")
+		err = l.startVMs(ctx, vmNames)
+	}
+	if err != nil {
+		if !vmgp.SkipCleanupOnFailure {
+			updateCallback(edgeproto.UpdateTask, "Cleaning up after failure")
+			delerr := l.DeleteResourcesForGroup(ctx, vmgp.GroupName)
+			if delerr != nil {
+				log.SpanLog(ctx, log.DebugLevelInfra, "cleanup failed", "err", delerr)
+			}
+		}
+		return fmt.Errorf("CreateVMs failed: %v", err)
+	}
+	log.SpanLog(ctx, log.DebugLevelInfra, "CreateVMs complete")
+	return nil
+}
+
+func (l *LibvirtPlatform) getVMListsForUpdate(ctx context.Context, vmgp *vmlayer.VMGroupOrchestrationParams, vmLists *vmlayer.VMUpdateList) error {
+	orchVmLock.Lock()
+	defer orchVmLock.Unlock()
+
+	err := l.populateOrchestrationParams(ctx, vmgp, vmlayer.ActionUpdate)
+	if err != nil {
+		return err
+	}
+	domains, err := l.getGroupDomains(ctx, vmgp.GroupName)
+	if err != nil {
+		return err
+	}
+	for _, dom := range domains {
+		vmLists.CurrentVMs[dom.Name] = dom.Name
+	}
+	for i := range vmgp.VMs {
+		vmLists.NewVMs[vmgp.VMs[i].Name] = &vmgp.VMs[i]
+	}
+	for vmName, vmOrch := range vmLists.NewVMs {
+		if _, exists := vmLists.CurrentVMs[vmName]; !exists {
+			vmLists.VmsToCreate[vmName] = vmOrch
+		}
+	}
+	for oldVM := range vmLists.CurrentVMs {
+		if _, exists := vmLists.NewVMs[oldVM]; !exists {
+			vmLists.VmsToDelete[oldVM] = oldVM
+		}
+	}
+	log.SpanLog(ctx, log.DebugLevelInfra, "getVMListsForUpdate", "num VMs to create", len(vmLists.VmsToCreate), "num VMs to delete", len(vmLists.VmsToDelete))
+
+	// delete before defining, so that resources can be reused
+	for _, dom := range domains {
+		if _, ok := vmLists.VmsToDelete[dom.Name]; !ok {
+			continue
+		}
+		if err := l.deleteDomain(ctx, dom); err != nil {
+			return err
+		}
+	}
+	if len(vmLists.VmsToCreate) > 0 {
+		if err := l.createNetworks(ctx, vmgp); err != nil {
+			return err
+		}
+	}
+	for _, vmOrch := range vmLists.VmsToCreate {
+		if err := l.defineVM(ctx, vmgp.GroupName, vmOrch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateVMs calculates which VMs need to be added or removed from
+// the given group and then does so.
+func (l *LibvirtPlatform) UpdateVMs(ctx context.Context, vmgp *vmlayer.VMGroupOrchestrationParams, updateCallback edgeproto.CacheUpdateCallback) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "UpdateVMs", "groupName", vmgp.GroupName)
+
+	var vmLists vmlayer.VMUpdateList
+	vmLists.CurrentVMs = make(map[string]string)
+	vmLists.NewVMs = make(map[string]*vmlayer.VMOrchestrationParams)
+	vmLists.VmsToCreate = make(map[string]*vmlayer.VMOrchestrationParams)
+	vmLists.VmsToDelete = make(map[string]string)
+
+	updateCallback(edgeproto.UpdateTask, "Updating VMs")
+	err := l.getVMListsForUpdate(ctx, vmgp, &vmLists)
+	if err != nil {
+		return err
+	}
+	if len(vmLists.VmsToCreate) == 0 {
+		return nil
+	}
+	vmNames := []string{}
+	for vmName := range vmLists.VmsToCreate {
+		vmNames = append(vmNames, vmName)
+	}
+	sort.Strings(vmNames)
+	updateCallback(edgeproto.UpdateTask, "Starting VMs")
+	if err := l.startVMs(ctx, vmNames); err != nil {
+		return fmt.Errorf("Error in starting VMs for update: %v", err)
+	}
+	return nil
+}
+
+// deleteDomain stops and undefines the domain, and deletes its volumes
+func (l *LibvirtPlatform) deleteDomain(ctx context.Context, dom *Domain) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "deleteDomain", "name", dom.Name)
+	state, err := l.client.GetDomainState(ctx, dom.Name)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if state != DomainStateShutoff {
+		if err := l.client.DestroyDomain(ctx, dom.Name); err != nil {
+			return fmt.Errorf("failed to stop VM %s: %v", dom.Name, err)
+		}
+	}
+	if err := l.client.UndefineDomain(ctx, dom.Name); err != nil {
+		return fmt.Errorf("failed to delete VM %s: %v", dom.Name, err)
+	}
+	for _, disk := range dom.Devices.Disks {
+		if disk.Source.Volume == "" {
+			continue
+		}
+		err := l.client.DeleteVolume(ctx, disk.Source.Pool, disk.Source.Volume)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("failed to delete volume %s for VM %s: %v", disk.Source.Volume, dom.Name, err)
+		}
+	}
+	return nil
+}
+
+// deleteUnusedNetworks deletes the given networks if they exist and
+// are no longer used by any domain.
+func (l *LibvirtPlatform) deleteUnusedNetworks(ctx context.Context, candidates map[string]struct{}) error {
+	domains, err := l.getDomains(ctx)
+	if err != nil {
+		return err
+	}
+	for _, dom := range domains {
+		for _, iface := range dom.Devices.Interfaces {
+			if iface.Type == InterfaceTypeNetwork {
+				delete(candidates, iface.Source.Network)
+			}
+		}
+	}
+	networks, err := l.client.ListNetworks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range networks {
+		if _, ok := candidates[name]; !ok {
+			continue
+		}
+		log.SpanLog(ctx, log.DebugLevelInfra, "deleting network", "name", name)
+		if err := l.client.DeleteNetwork(ctx, name); err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("failed to delete network %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// DeleteResourcesForGroup deletes all VMs, volumes and networks for the group
+func (l *LibvirtPlatform) DeleteResourcesForGroup(ctx context.Context, groupName string) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "DeleteResourcesForGroup", "groupName", groupName)
+
+	orchVmLock.Lock()
+	defer orchVmLock.Unlock()
+
+	domains, err := l.getGroupDomains(ctx, groupName)
+	if err != nil {
+		return err
+	}
+	networks := map[string]struct{}{
+		l.getNetworkName(vmlayer.MexSubnetPrefix + groupName): {},
+	}
+	for _, dom := range domains {
+		for _, iface := range dom.Devices.Interfaces {
+			if iface.Type == InterfaceTypeNetwork {
+				networks[iface.Source.Network] = struct{}{}
+			}
+		}
+		if err := l.deleteDomain(ctx, dom); err != nil {
+			return err
+		}
+	}
+	return l.deleteUnusedNetworks(ctx, networks)
+}
+
+func (l *LibvirtPlatform) DeleteVMs(ctx context.Context, vmGroupName, ownerID string) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "DeleteVMs", "vmGroupName", vmGroupName)
+	return l.DeleteResourcesForGroup(ctx, vmGroupName)
+}
+
+func (l *LibvirtPlatform) GetServerDetail(ctx context.Context, serverName string) (*vmlayer.ServerDetail, error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "GetServerDetail", "serverName", serverName)
+	dom, err := l.getDomain(ctx, serverName)
+	if err != nil {
+		return nil, err
+	}
+	state, err := l.client.GetDomainState(ctx, serverName)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf(vmlayer.ServerDoesNotExistError)
+		}
+		return nil, err
+	}
+	sd := vmlayer.ServerDetail{
+		Name: dom.Name,
+		ID:   dom.UUID,
+	}
+	switch state {
+	case DomainStateRunning:
+		sd.Status = vmlayer.ServerActive
+	case DomainStateShutoff:
+		sd.Status = vmlayer.ServerShutoff
+	default:
+		log.SpanLog(ctx, log.DebugLevelInfra, "unexpected domain state", "state", state)
+		sd.Status = state
+	}
+	md := dom.GetInstanceMetadata()
+	if md == nil {
+		return &sd, nil
+	}
+	for _, ip := range md.IPs {
+		sip := vmlayer.ServerIP{
+			InternalAddr: ip.Address,
+			ExternalAddr: ip.Address,
+			Network:      ip.Network,
+			PortName:     vmlayer.GetPortName(serverName, ip.Network),
+			IPVersion:    infracommon.IPV4,
+		}
+		// only report the MAC if the interface is attached
+		for _, iface := range dom.Devices.Interfaces {
+			if iface.MAC != nil && iface.MAC.Address == ip.MAC {
+				sip.MacAddress = ip.MAC
+			}
+		}
+		sd.Addresses = append(sd.Addresses, sip)
+	}
+	return &sd, nil
+}
+
+func (l *LibvirtPlatform) SetPowerState(ctx context.Context, serverName, serverAction string) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "SetPowerState", "serverName", serverName, "serverAction", serverAction)
+	switch serverAction {
+	case vmlayer.ActionStart:
+		return l.client.StartDomain(ctx, serverName)
+	case vmlayer.ActionStop:
+		return l.client.DestroyDomain(ctx, serverName)
+	case vmlayer.ActionReboot:
+		return l.client.RebootDomain(ctx, serverName)
+	}
+	return fmt.Errorf("unsupported server action: %s", serverAction)
+}
+
+func (l *LibvirtPlatform) GetServerGroupResources(ctx context.Context, name string) (*edgeproto.InfraResources, error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "GetServerGroupResources", "name", name)
+	var resources edgeproto.InfraResources
+	domains, err := l.getGroupDomains(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, dom := range domains {
+		md := dom.GetInstanceMetadata()
+		vminfo := edgeproto.VmInfo{
+			Name:        dom.Name,
+			InfraFlavor: md.Flavor,
+			Type:        l.vmProperties.GetNodeTypeForVmNameAndRole(dom.Name, md.Role).String(),
+		}
+		for _, ip := range md.IPs {
+			vminfo.Ipaddresses = append(vminfo.Ipaddresses, edgeproto.IpAddr{
+				ExternalIp: ip.Address,
+			})
+		}
+		resources.Vms = append(resources.Vms, vminfo)
+	}
+	return &resources, nil
+}
+
+// AttachPortToServer attaches an interface on the subnet's network
+// and records the IP in the domain metadata.
+func (l *LibvirtPlatform) AttachPortToServer(ctx context.Context, serverName string, subnetNames vmlayer.SubnetNames, portName string, ips infracommon.IPs, action vmlayer.ActionType) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "AttachPortToServer", "serverName", serverName, "subnetNames", subnetNames, "ips", ips)
+
+	orchVmLock.Lock()
+	defer orchVmLock.Unlock()
+
+	dom, err := l.getDomain(ctx, serverName)
+	if err != nil {
+		return err
+	}
+	md := dom.GetInstanceMetadata()
+	if md == nil {
+		return fmt.Errorf("no metadata found for server %s", serverName)
+	}
+	subnetName := subnetNames.IPV4()
+	network := l.getNetworkName(subnetName)
+	if dom.GetInterfaceForNetwork(InterfaceTypeNetwork, network) == nil {
+		iface := l.getInterface(serverName, network)
+		ifaceXML, err := iface.ToXML()
+		if err != nil {
+			return err
+		}
+		if err := l.client.AttachInterface(ctx, serverName, ifaceXML); err != nil {
+			return fmt.Errorf("AttachPortToServer failed: %v", err)
+		}
+	} else {
+		log.SpanLog(ctx, log.DebugLevelInfra, "AttachPortToServer port already attached")
+	}
+	md.SetIP(InstanceIP{
+		Network: subnetName,
+		Address: ips.IPV4(),
+		CIDR:    getCIDR(ips.IPV4(), l.GetInternalNetmask()),
+		MAC:     getMacAddress(serverName, network),
+	})
+	mdXML, err := md.ToXML()
+	if err != nil {
+		return err
+	}
+	return l.client.SetDomainMetadata(ctx, serverName, mdXML)
+}
+
+// DetachPortFromServer detaches the interface on the subnet's network
+// and removes the IP from the domain metadata. The network is deleted
+// if it is no longer in use.
+func (l *LibvirtPlatform) DetachPortFromServer(ctx context.Context, serverName string, subnetNames vmlayer.SubnetNames, portName string) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "DetachPortFromServer", "serverName", serverName, "subnetNames", subnetNames, "portName", portName)
+
+	orchVmLock.Lock()
+	defer orchVmLock.Unlock()
+
+	dom, err := l.getDomain(ctx, serverName)
+	if err != nil {
+		return err
+	}
+	subnetName := subnetNames.IPV4()
+	network := l.getNetworkName(subnetName)
+	iface := dom.GetInterfaceForNetwork(InterfaceTypeNetwork, network)
+	if iface != nil && iface.MAC != nil {
+		err := l.client.DetachInterface(ctx, serverName, InterfaceTypeNetwork, iface.MAC.Address)
+		if err != nil {
+			return fmt.Errorf("DetachPortFromServer failed: %v", err)
+		}
+	}
+	md := dom.GetInstanceMetadata()
+	if md != nil && md.RemoveIP(subnetName) != nil {
+		mdXML, err := md.ToXML()
+		if err != nil {
+			return err
+		}
+		if err := l.client.SetDomainMetadata(ctx, serverName, mdXML); err != nil {
+			return err
+		}
+	}
+	// the group's VMs may already be deleted, in which case
+	// the network is no longer needed
+	return l.deleteUnusedNetworks(ctx, map[string]struct{}{network: {}})
+}
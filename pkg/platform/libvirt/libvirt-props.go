@@ -0,0 +1,118 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+)
+
+const (
+	LIBVIRT_URI = "LIBVIRT_URI"
+)
+
+var AccessVarProps = map[string]*edgeproto.PropertyInfo{
+	LIBVIRT_URI: {
+		Name:        "Libvirt connection URI",
+		Description: "Libvirt connection URI for the KVM host, e.g. qemu+ssh://user@host/system",
+		Mandatory:   true,
+	},
+}
+
+var LibvirtProps = map[string]*edgeproto.PropertyInfo{
+	"MEX_EXTERNAL_IP_RANGES": {
+		Name:        "External IP Ranges",
+		Description: "Range of external IP addresses, Format: StartCIDR-EndCIDR",
+		Mandatory:   true,
+	},
+	"MEX_EXTERNAL_NETWORK_GATEWAY": {
+		Name:        "External Network Gateway",
+		Description: "External Network Gateway",
+		Mandatory:   true,
+	},
+	"MEX_EXTERNAL_NETWORK_MASK": {
+		Name:        "External Network Mask",
+		Description: "External Network Mask in bits, e.g. 24",
+		Mandatory:   true,
+	},
+	"MEX_INTERNAL_NETWORK_MASK": {
+		Name:        "Internal Network Mask",
+		Description: "Internal Network Mask in bits, e.g. 24",
+		Value:       "24",
+	},
+	"LIBVIRT_EXTERNAL_BRIDGE": {
+		Name:        "Libvirt External Bridge Name",
+		Description: "Name of the bridge on the KVM host which is connected to the external network",
+		Mandatory:   true,
+	},
+	"LIBVIRT_STORAGE_POOL": {
+		Name:        "Libvirt Storage Pool Name",
+		Description: "Name of the libvirt storage pool for images and VM disks",
+		Value:       "default",
+	},
+}
+
+func (l *LibvirtPlatform) InitApiAccessProperties(ctx context.Context, accessApi platform.AccessApi, vars map[string]string) error {
+	accessVars, err := accessApi.GetCloudletAccessVars(ctx)
+	if err != nil {
+		return err
+	}
+	l.accessVars = accessVars
+	if l.client == nil {
+		uri := l.GetLibvirtURI()
+		if uri == "" {
+			return fmt.Errorf(LIBVIRT_URI + " not set")
+		}
+		log.SpanLog(ctx, log.DebugLevelInfra, "using libvirt uri", "uri", uri)
+		l.client = NewLibvirtClient(uri)
+	}
+	return nil
+}
+
+func (l *LibvirtPlatform) GetLibvirtURI() string {
+	return l.accessVars[LIBVIRT_URI]
+}
+
+func (l *LibvirtPlatform) GetExternalBridge() string {
+	val, _ := l.vmProperties.CommonPf.Properties.GetValue("LIBVIRT_EXTERNAL_BRIDGE")
+	return val
+}
+
+func (l *LibvirtPlatform) GetStoragePool() string {
+	val, _ := l.vmProperties.CommonPf.Properties.GetValue("LIBVIRT_STORAGE_POOL")
+	return val
+}
+
+func (l *LibvirtPlatform) GetExternalNetmask() string {
+	val, _ := l.vmProperties.CommonPf.Properties.GetValue("MEX_EXTERNAL_NETWORK_MASK")
+	return val
+}
+
+func (l *LibvirtPlatform) GetInternalNetmask() string {
+	val, _ := l.vmProperties.CommonPf.Properties.GetValue("MEX_INTERNAL_NETWORK_MASK")
+	return val
+}
+
+func (l *LibvirtPlatform) GetExternalGateway(ctx context.Context, extNetName string) (string, error) {
+	val, _ := l.vmProperties.CommonPf.Properties.GetValue("MEX_EXTERNAL_NETWORK_GATEWAY")
+	if val == "" {
+		return "", fmt.Errorf("Unable to find MEX_EXTERNAL_NETWORK_GATEWAY")
+	}
+	return val, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
+	ssh "github.com/edgexr/golang-ssh"
+)
+
+func (l *LibvirtPlatform) WhitelistSecurityRules(ctx context.Context, client ssh.Client, wlParams *infracommon.WhiteListParams) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "WhitelistSecurityRules", "wlParams", wlParams)
+	// this can be called during LB init so we need to ensure we can reach the server before trying iptables commands
+	err := vmlayer.WaitServerReady(ctx, l, client, wlParams.ServerName, vmlayer.MaxRootLBWait)
+	if err != nil {
+		return err
+	}
+	return infracommon.AddIngressIptablesRules(ctx, client, wlParams.Label, wlParams.AllowedCIDR, wlParams.DestIP, wlParams.Ports)
+}
+
+func (l *LibvirtPlatform) RemoveWhitelistSecurityRules(ctx context.Context, client ssh.Client, wlParams *infracommon.WhiteListParams) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "RemoveWhitelistSecurityRules", "wlParams", wlParams)
+	return infracommon.RemoveIngressIptablesRules(ctx, client, wlParams.Label, wlParams.AllowedCIDR, wlParams.DestIP, wlParams.Ports)
+}
+
+func (l *LibvirtPlatform) PrepareRootLB(ctx context.Context, client ssh.Client, rootLBName string, secGrpName string, TrustPolicy *edgeproto.TrustPolicy, updateCallback edgeproto.CacheUpdateCallback) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "PrepareRootLB", "rootLBName", rootLBName)
+	// there is no infra firewall, so security is iptables based
+	sshCidrsAllowed := []string{infracommon.RemoteCidrAll, infracommon.RemoteCidrAllIPV6}
+	egressRestricted := false
+
+	var rules []edgeproto.SecurityRule
+	if TrustPolicy != nil {
+		rules = TrustPolicy.OutboundSecurityRules
+		egressRestricted = true
+	}
+	enableIPV6 := true
+	return l.vmProperties.SetupIptablesRulesForRootLB(ctx, client, sshCidrsAllowed, egressRestricted, infracommon.TrustPolicySecGrpNameLabel, rules, false, enableIPV6)
+}
+
+func (l *LibvirtPlatform) ConfigureCloudletSecurityRules(ctx context.Context, egressRestricted bool, TrustPolicy *edgeproto.TrustPolicy, rootlbClients map[string]platform.RootLBClient, action vmlayer.ActionType, updateCallback edgeproto.CacheUpdateCallback) error {
+	var rules []edgeproto.SecurityRule
+	if TrustPolicy != nil {
+		rules = TrustPolicy.OutboundSecurityRules
+	}
+	secGrpName := infracommon.TrustPolicySecGrpNameLabel
+	log.SpanLog(ctx, log.DebugLevelInfra, "ConfigureCloudletSecurityRules", "egressRestricted", egressRestricted, "TrustPolicy", TrustPolicy, "action", action)
+	updateCallback(edgeproto.UpdateTask, "Configuring Cloudlet Security Rules")
+
+	sshCidrsAllowed := []string{infracommon.RemoteCidrAll, infracommon.RemoteCidrAllIPV6}
+	failedLbs := []string{}
+	for clientName, rootLBClient := range rootlbClients {
+		sshClient := rootLBClient.Client
+		if sshClient == nil {
+			// in error conditions GetRootLbClients will populate with a nil client
+			log.SpanLog(ctx, log.DebugLevelInfra, "nil ssh client for rootlb", "clientName", clientName)
+			if action != vmlayer.ActionDelete {
+				failedLbs = append(failedLbs, clientName)
+			}
+			continue
+		}
+		var err error
+		if action == vmlayer.ActionDelete {
+			err = infracommon.RemoveRulesForLabel(ctx, sshClient, secGrpName)
+		} else {
+			enableIPV6 := true
+			err = l.vmProperties.SetupIptablesRulesForRootLB(ctx, sshClient, sshCidrsAllowed, egressRestricted, secGrpName, rules, clientName == l.vmProperties.PlatformSecgrpName, enableIPV6)
+		}
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfra, "ConfigureCloudletSecurityRules failed", "clientName", clientName, "error", err)
+			failedLbs = append(failedLbs, clientName)
+		}
+	}
+	if len(failedLbs) > 0 {
+		sort.Strings(failedLbs)
+		return fmt.Errorf("Failure in ConfigureCloudletSecurityRules for rootLBs: %s", strings.Join(failedLbs, ","))
+	}
+	return nil
+}
+
+func (l *LibvirtPlatform) ConfigureTrustPolicyExceptionSecurityRules(ctx context.Context, TrustPolicyException *edgeproto.TrustPolicyException, rootLbClients map[string]platform.RootLBClient, action vmlayer.ActionType, updateCallback edgeproto.CacheUpdateCallback) error {
+	return fmt.Errorf("Platform not supported for TrustPolicyException SecurityRules")
+}
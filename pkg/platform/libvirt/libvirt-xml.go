@@ -0,0 +1,305 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+// MetadataNamespace is the XML namespace of the metadata stored in
+// each domain. The metadata takes the place of the tags used by other
+// platforms to track the group, role and IPs of each VM.
+const MetadataNamespace = "https://edgexr.org/xmlns/libvirt/instance/1.0"
+const MetadataKey = "edgexr"
+
+const (
+	DiskDeviceDisk  = "disk"
+	DiskDeviceCdrom = "cdrom"
+
+	InterfaceTypeBridge  = "bridge"
+	InterfaceTypeNetwork = "network"
+)
+
+// Domain is the subset of the libvirt domain XML used by the platform.
+type Domain struct {
+	XMLName  xml.Name        `xml:"domain"`
+	Type     string          `xml:"type,attr"`
+	Name     string          `xml:"name"`
+	UUID     string          `xml:"uuid,omitempty"`
+	Metadata *DomainMetadata `xml:"metadata"`
+	Memory   DomainMemory    `xml:"memory"`
+	VCPU     uint64          `xml:"vcpu"`
+	OS       DomainOS        `xml:"os"`
+	Features *DomainFeatures `xml:"features"`
+	CPU      *DomainCPU      `xml:"cpu"`
+	Devices  DomainDevices   `xml:"devices"`
+}
+
+type DomainMetadata struct {
+	Instance *InstanceMetadata `xml:"https://edgexr.org/xmlns/libvirt/instance/1.0 instance"`
+}
+
+// InstanceMetadata is stored in the domain metadata.
+type InstanceMetadata struct {
+	Group  string       `xml:"group"`
+	Role   string       `xml:"role"`
+	Flavor string       `xml:"flavor"`
+	Domain string       `xml:"domain"`
+	IPs    []InstanceIP `xml:"ip"`
+}
+
+type InstanceIP struct {
+	Network string `xml:"network,attr"`
+	Address string `xml:"address,attr"`
+	CIDR    string `xml:"cidr,attr,omitempty"`
+	MAC     string `xml:"mac,attr,omitempty"`
+}
+
+type DomainMemory struct {
+	Unit  string `xml:"unit,attr,omitempty"`
+	Value uint64 `xml:",chardata"`
+}
+
+type DomainOS struct {
+	Type DomainOSType `xml:"type"`
+	Boot []DomainBoot `xml:"boot"`
+}
+
+type DomainOSType struct {
+	Arch  string `xml:"arch,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type DomainBoot struct {
+	Dev string `xml:"dev,attr"`
+}
+
+type DomainFeatures struct {
+	ACPI *struct{} `xml:"acpi"`
+	APIC *struct{} `xml:"apic"`
+}
+
+type DomainCPU struct {
+	Mode string `xml:"mode,attr"`
+}
+
+type DomainDevices struct {
+	Disks      []DomainDisk      `xml:"disk"`
+	Interfaces []DomainInterface `xml:"interface"`
+	Serials    []DomainChardev   `xml:"serial"`
+	Consoles   []DomainChardev   `xml:"console"`
+}
+
+type DomainDisk struct {
+	Type     string           `xml:"type,attr"`
+	Device   string           `xml:"device,attr"`
+	Driver   DomainDiskDriver `xml:"driver"`
+	Source   DomainDiskSource `xml:"source"`
+	Target   DomainDiskTarget `xml:"target"`
+	ReadOnly *struct{}        `xml:"readonly"`
+}
+
+type DomainDiskDriver struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type DomainDiskSource struct {
+	Pool   string `xml:"pool,attr,omitempty"`
+	Volume string `xml:"volume,attr,omitempty"`
+}
+
+type DomainDiskTarget struct {
+	Dev string `xml:"dev,attr"`
+	Bus string `xml:"bus,attr"`
+}
+
+type DomainInterface struct {
+	XMLName xml.Name              `xml:"interface"`
+	Type    string                `xml:"type,attr"`
+	MAC     *DomainInterfaceMAC   `xml:"mac"`
+	Source  DomainInterfaceSource `xml:"source"`
+	Model   *DomainInterfaceModel `xml:"model"`
+}
+
+type DomainInterfaceMAC struct {
+	Address string `xml:"address,attr"`
+}
+
+type DomainInterfaceSource struct {
+	Bridge  string `xml:"bridge,attr,omitempty"`
+	Network string `xml:"network,attr,omitempty"`
+}
+
+type DomainInterfaceModel struct {
+	Type string `xml:"type,attr"`
+}
+
+type DomainChardev struct {
+	Type string `xml:"type,attr"`
+}
+
+// Network is the subset of the libvirt network XML used by the platform.
+// Networks are only used for internal subnets, and are isolated
+// networks without any host IP or DHCP. The rootLB acts as the gateway.
+type Network struct {
+	XMLName xml.Name `xml:"network"`
+	Name    string   `xml:"name"`
+}
+
+func ParseDomainXML(data string) (*Domain, error) {
+	dom := Domain{}
+	err := xml.Unmarshal([]byte(data), &dom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse domain XML, %v", err)
+	}
+	return &dom, nil
+}
+
+func (s *Domain) ToXML() (string, error) {
+	out, err := xml.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// GetInstanceMetadata returns the platform metadata, which may be nil
+// for domains not created by the platform.
+func (s *Domain) GetInstanceMetadata() *InstanceMetadata {
+	if s.Metadata == nil {
+		return nil
+	}
+	return s.Metadata.Instance
+}
+
+// GetMemoryMB gets the domain memory in MB.
+func (s *Domain) GetMemoryMB() uint64 {
+	switch s.Memory.Unit {
+	case "b", "bytes":
+		return s.Memory.Value / (1024 * 1024)
+	case "", "k", "KiB":
+		return s.Memory.Value / 1024
+	case "M", "MiB":
+		return s.Memory.Value
+	case "G", "GiB":
+		return s.Memory.Value * 1024
+	}
+	return 0
+}
+
+// GetInterfaceForNetwork finds the interface connected to the given
+// libvirt network or host bridge.
+func (s *Domain) GetInterfaceForNetwork(ifaceType, source string) *DomainInterface {
+	for ii, iface := range s.Devices.Interfaces {
+		if iface.Type != ifaceType {
+			continue
+		}
+		if (ifaceType == InterfaceTypeBridge && iface.Source.Bridge == source) ||
+			(ifaceType == InterfaceTypeNetwork && iface.Source.Network == source) {
+			return &s.Devices.Interfaces[ii]
+		}
+	}
+	return nil
+}
+
+func ParseInstanceMetadataXML(data string) (*InstanceMetadata, error) {
+	md := InstanceMetadata{}
+	err := xml.Unmarshal([]byte(data), &md)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse instance metadata XML, %v", err)
+	}
+	return &md, nil
+}
+
+// ToXML marshals the metadata without a namespace, which is how
+// it is passed to libvirt to be set in the domain.
+func (s *InstanceMetadata) ToXML() (string, error) {
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	err := enc.EncodeElement(s, xml.StartElement{
+		Name: xml.Name{Local: "instance"},
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// SetIP adds or replaces the IP for the network.
+func (s *InstanceMetadata) SetIP(ip InstanceIP) {
+	for ii := range s.IPs {
+		if s.IPs[ii].Network == ip.Network {
+			s.IPs[ii] = ip
+			return
+		}
+	}
+	s.IPs = append(s.IPs, ip)
+}
+
+// RemoveIP removes the IP for the network, returning the removed IP.
+func (s *InstanceMetadata) RemoveIP(network string) *InstanceIP {
+	for ii := range s.IPs {
+		if s.IPs[ii].Network == network {
+			ip := s.IPs[ii]
+			s.IPs = append(s.IPs[:ii], s.IPs[ii+1:]...)
+			return &ip
+		}
+	}
+	return nil
+}
+
+func (s *DomainInterface) ToXML() (string, error) {
+	out, err := xml.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func ParseInterfaceXML(data string) (*DomainInterface, error) {
+	iface := DomainInterface{}
+	err := xml.Unmarshal([]byte(data), &iface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse interface XML, %v", err)
+	}
+	return &iface, nil
+}
+
+func ParseNetworkXML(data string) (*Network, error) {
+	network := Network{}
+	err := xml.Unmarshal([]byte(data), &network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse network XML, %v", err)
+	}
+	return &network, nil
+}
+
+func (s *Network) ToXML() (string, error) {
+	out, err := xml.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// diskTargetDev gets the target device name for the disk index,
+// i.e. vda, vdb, etc.
+func diskTargetDev(prefix string, index int) string {
+	return prefix + string(rune('a'+index))
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package libvirt implements a VM provider for a single KVM host
+// managed by libvirt, for small on-prem cloudlets without an
+// IaaS layer.
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
+	ssh "github.com/edgexr/golang-ssh"
+	"github.com/gogo/protobuf/types"
+)
+
+// interval between the two stats samples used to calculate rates
+var vmStatsInterval = 2 * time.Second
+
+type LibvirtPlatform struct {
+	vmProperties *vmlayer.VMProperties
+	caches       *platform.Caches
+	accessVars   map[string]string
+	client       LibvirtClient
+	TestMode     bool
+}
+
+func NewPlatform() platform.Platform {
+	return &vmlayer.VMPlatform{
+		VMProvider: &LibvirtPlatform{},
+	}
+}
+
+func (l *LibvirtPlatform) GetFeatures() *edgeproto.PlatformFeatures {
+	return &edgeproto.PlatformFeatures{
+		PlatformType:               platform.PlatformTypeLibvirt,
+		SupportsMultiTenantCluster: true,
+		SupportsSharedVolume:       true,
+		RequiresCrmOnEdge:          true, // IP and subnet allocation is serialized by a process-local mutex
+		AccessVars:                 AccessVarProps,
+		Properties:                 LibvirtProps,
+		ResourceQuotaProperties:    cloudcommon.CommonResourceQuotaProps,
+	}
+}
+
+func (l *LibvirtPlatform) SetVMProperties(vmProperties *vmlayer.VMProperties) {
+	l.vmProperties = vmProperties
+	vmProperties.IptablesBasedFirewall = true
+	vmProperties.RunLbDhcpServerForVmApps = true
+}
+
+func (l *LibvirtPlatform) InitData(ctx context.Context, caches *platform.Caches) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "InitData")
+	l.caches = caches
+}
+
+func (l *LibvirtPlatform) InitProvider(ctx context.Context, caches *platform.Caches, stage vmlayer.ProviderInitStage, updateCallback edgeproto.CacheUpdateCallback) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "InitProvider for Libvirt", "stage", stage)
+	l.InitData(ctx, caches)
+	if stage == vmlayer.ProviderInitDeleteCloudlet {
+		return nil
+	}
+	// verify connectivity and the storage pool
+	if _, err := l.client.GetNodeInfo(ctx); err != nil {
+		return fmt.Errorf("failed to connect to libvirt: %v", err)
+	}
+	if _, err := l.client.GetPoolInfo(ctx, l.GetStoragePool()); err != nil {
+		return fmt.Errorf("failed to get libvirt storage pool %s: %v", l.GetStoragePool(), err)
+	}
+	return nil
+}
+
+func (l *LibvirtPlatform) InitOperationContext(ctx context.Context, operationStage vmlayer.OperationInitStage) (context.Context, vmlayer.OperationInitResult, error) {
+	return ctx, vmlayer.OperationNewlyInitialized, nil
+}
+
+func (l *LibvirtPlatform) GatherCloudletInfo(ctx context.Context, info *edgeproto.CloudletInfo) error {
+	log.SpanLog(ctx, log.DebugLevelInfra, "GatherCloudletInfo")
+	var err error
+	info.Flavors, err = l.GetFlavorList(ctx)
+	return err
+}
+
+// NameSanitize restricts names to those allowed for libvirt domains,
+// networks and volumes: alphanumeric plus -_. with an alpha first char.
+func (l *LibvirtPlatform) NameSanitize(name string) string {
+	r := strings.NewReplacer(
+		" ", "",
+		"&", "",
+		",", "_",
+		"/", "_",
+		"!", "")
+	str := r.Replace(name)
+	if str == "" {
+		return str
+	}
+	if !unicode.IsLetter(rune(str[0])) {
+		// first character must be alpha
+		str = "a" + str
+	}
+	if len(str) > 255 {
+		str = str[:254]
+	}
+	return str
+}
+
+// IdSanitize is NameSanitize plus removing "."
+func (l *LibvirtPlatform) IdSanitize(name string) string {
+	str := l.NameSanitize(name)
+	str = strings.ReplaceAll(str, ".", "-")
+	str = strings.ReplaceAll(str, "=", "-")
+	return str
+}
+
+func (l *LibvirtPlatform) GetResourceID(ctx context.Context, resourceType vmlayer.ResourceType, resourceName string) (string, error) {
+	if l.TestMode {
+		return resourceName + "-testingID", nil
+	}
+	switch resourceType {
+	case vmlayer.ResourceTypeSecurityGroup:
+		// security groups are implemented via iptables on the rootLB
+		return resourceName + "-id", nil
+	}
+	return "", fmt.Errorf("GetResourceID not implemented for resource type: %s ", resourceType)
+}
+
+func (l *LibvirtPlatform) VmAppChangedCallback(ctx context.Context, appInst *edgeproto.AppInst, newState edgeproto.TrackedState) {
+}
+
+func (l *LibvirtPlatform) GetVMStats(ctx context.Context, appInst *edgeproto.AppInst) (*vmlayer.VMMetrics, error) {
+	log.DebugLog(log.DebugLevelSampled, "GetVMStats")
+	vmMetrics := vmlayer.VMMetrics{}
+	vmName := appInst.UniqueId
+
+	// rates are calculated from the difference between two samples
+	start, err := l.client.GetDomainStats(ctx, vmName)
+	if err != nil {
+		return &vmMetrics, err
+	}
+	time.Sleep(vmStatsInterval)
+	end, err := l.client.GetDomainStats(ctx, vmName)
+	if err != nil {
+		return &vmMetrics, err
+	}
+	ts, err := types.TimestampProto(end.Timestamp)
+	if err != nil {
+		return &vmMetrics, err
+	}
+	elapsed := end.Timestamp.Sub(start.Timestamp)
+	if elapsed > 0 {
+		if end.VCPUs > 0 && end.CPUTime >= start.CPUTime {
+			cpuTime := float64(end.CPUTime - start.CPUTime)
+			vmMetrics.Cpu = 100 * cpuTime / float64(elapsed.Nanoseconds()) / float64(end.VCPUs)
+		}
+		if end.NetRxBytes >= start.NetRxBytes {
+			vmMetrics.NetRecv = uint64(float64(end.NetRxBytes-start.NetRxBytes) / elapsed.Seconds())
+		}
+		if end.NetTxBytes >= start.NetTxBytes {
+			vmMetrics.NetSent = uint64(float64(end.NetTxBytes-start.NetTxBytes) / elapsed.Seconds())
+		}
+	}
+	vmMetrics.CpuTS = ts
+	vmMetrics.NetRecvTS = ts
+	vmMetrics.NetSentTS = ts
+	vmMetrics.Mem = end.MemUsed
+	vmMetrics.MemTS = ts
+	vmMetrics.Disk = end.DiskAllocation
+	vmMetrics.DiskTS = ts
+	return &vmMetrics, nil
+}
+
+func (l *LibvirtPlatform) GetPlatformResourceInfo(ctx context.Context) (*vmlayer.PlatformResources, error) {
+	log.SpanLog(ctx, log.DebugLevelInfra, "GetPlatformResourceInfo")
+	platformRes := vmlayer.PlatformResources{}
+	platformRes.CollectTime, _ = types.TimestampProto(time.Now())
+
+	nodeInfo, err := l.client.GetNodeInfo(ctx)
+	if err != nil {
+		return &platformRes, err
+	}
+	platformRes.VCpuMax = nodeInfo.CPUs
+	// convert to MB
+	platformRes.MemMax = nodeInfo.MemoryKiB / 1024
+
+	domains, err := l.getDomains(ctx)
+	if err != nil {
+		return &platformRes, err
+	}
+	var netRecv, netSent uint64
+	for _, dom := range domains {
+		platformRes.VCpuUsed += dom.VCPU
+		platformRes.MemUsed += dom.GetMemoryMB()
+		stats, err := l.client.GetDomainStats(ctx, dom.Name)
+		if err != nil {
+			log.SpanLog(ctx, log.DebugLevelInfra, "failed to get domain stats", "domain", dom.Name, "err", err)
+			continue
+		}
+		netRecv += stats.NetRxBytes
+		netSent += stats.NetTxBytes
+	}
+	// convert to KB
+	platformRes.NetRecv = netRecv / 1024
+	platformRes.NetSent = netSent / 1024
+
+	poolInfo, err := l.client.GetPoolInfo(ctx, l.GetStoragePool())
+	if err != nil {
+		return &platformRes, err
+	}
+	// convert to GB
+	platformRes.DiskMax = poolInfo.CapacityBytes / (1024 * 1024 * 1024)
+	platformRes.DiskUsed = poolInfo.AllocationBytes / (1024 * 1024 * 1024)
+
+	ipMax, ipUsed, err := l.GetExternalIPCounts(ctx)
+	if err != nil {
+		return &platformRes, err
+	}
+	platformRes.Ipv4Max = ipMax
+	platformRes.Ipv4Used = ipUsed
+	return &platformRes, nil
+}
+
+func (l *LibvirtPlatform) CheckServerReady(ctx context.Context, client ssh.Client, serverName string) error {
+	// no special checks needed, the server is ready once reachable
+	return nil
+}
+
+func (l *LibvirtPlatform) ActiveChanged(ctx context.Context, platformActive bool) error {
+	return nil
+}
@@ -0,0 +1,483 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FakeLibvirtClient is an in-memory LibvirtClient for testing.
+// It validates references between domains, networks and volumes
+// in the same way libvirt does.
+type FakeLibvirtClient struct {
+	mux       sync.Mutex
+	NodeInfo  NodeInfo
+	domains   map[string]*fakeDomain
+	networks  map[string]string
+	pools     map[string]*fakePool
+	seedFiles map[string]map[string]string
+}
+
+type fakeDomain struct {
+	dom     *Domain
+	state   string
+	cpuTime uint64
+}
+
+type fakePool struct {
+	capacityBytes uint64
+	volumes       map[string]uint64
+}
+
+const (
+	FakeDefaultPool       = "default"
+	fakePoolCapacityBytes = 1024 * 1024 * 1024 * 1024
+	fakeGiB               = 1024 * 1024 * 1024
+)
+
+func NewFakeLibvirtClient() *FakeLibvirtClient {
+	s := &FakeLibvirtClient{
+		NodeInfo: NodeInfo{
+			CPUs:      32,
+			MemoryKiB: 128 * 1024 * 1024,
+		},
+		domains:   make(map[string]*fakeDomain),
+		networks:  make(map[string]string),
+		pools:     make(map[string]*fakePool),
+		seedFiles: make(map[string]map[string]string),
+	}
+	s.AddPool(FakeDefaultPool, fakePoolCapacityBytes)
+	return s
+}
+
+func (s *FakeLibvirtClient) AddPool(name string, capacityBytes uint64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.pools[name] = &fakePool{
+		capacityBytes: capacityBytes,
+		volumes:       make(map[string]uint64),
+	}
+}
+
+// AddVolume adds a volume to the pool, i.e. to simulate an image
+// that is already present.
+func (s *FakeLibvirtClient) AddVolume(pool, name string, sizeBytes uint64) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	p, ok := s.pools[pool]
+	if !ok {
+		return fmt.Errorf("pool %s, %w", pool, ErrNotFound)
+	}
+	p.volumes[name] = sizeBytes
+	return nil
+}
+
+func (s *FakeLibvirtClient) getDomain(name string) (*fakeDomain, error) {
+	fd, ok := s.domains[name]
+	if !ok {
+		return nil, fmt.Errorf("domain %s, %w", name, ErrNotFound)
+	}
+	return fd, nil
+}
+
+func (s *FakeLibvirtClient) getPool(name string) (*fakePool, error) {
+	p, ok := s.pools[name]
+	if !ok {
+		return nil, fmt.Errorf("pool %s, %w", name, ErrNotFound)
+	}
+	return p, nil
+}
+
+func (s *FakeLibvirtClient) GetNodeInfo(ctx context.Context) (*NodeInfo, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	info := s.NodeInfo
+	return &info, nil
+}
+
+func (s *FakeLibvirtClient) ListDomains(ctx context.Context) ([]string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	names := []string{}
+	for name := range s.domains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *FakeLibvirtClient) GetDomainXML(ctx context.Context, name string) (string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return "", err
+	}
+	return fd.dom.ToXML()
+}
+
+func (s *FakeLibvirtClient) GetDomainState(ctx context.Context, name string) (string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return "", err
+	}
+	return fd.state, nil
+}
+
+func (s *FakeLibvirtClient) DefineDomain(ctx context.Context, domainXML string) error {
+	dom, err := ParseDomainXML(domainXML)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for _, disk := range dom.Devices.Disks {
+		p, err := s.getPool(disk.Source.Pool)
+		if err != nil {
+			return err
+		}
+		if _, ok := p.volumes[disk.Source.Volume]; !ok {
+			return fmt.Errorf("volume %s for domain %s, %w", disk.Source.Volume, dom.Name, ErrNotFound)
+		}
+	}
+	for _, iface := range dom.Devices.Interfaces {
+		if err := s.checkInterface(&iface); err != nil {
+			return err
+		}
+	}
+	state := DomainStateShutoff
+	if fd, ok := s.domains[dom.Name]; ok {
+		state = fd.state
+	}
+	s.domains[dom.Name] = &fakeDomain{
+		dom:   dom,
+		state: state,
+	}
+	return nil
+}
+
+func (s *FakeLibvirtClient) checkInterface(iface *DomainInterface) error {
+	if iface.Type == InterfaceTypeNetwork {
+		if _, ok := s.networks[iface.Source.Network]; !ok {
+			return fmt.Errorf("network %s, %w", iface.Source.Network, ErrNotFound)
+		}
+	} else if iface.Type == InterfaceTypeBridge {
+		if iface.Source.Bridge == "" {
+			return fmt.Errorf("missing bridge name for bridge interface")
+		}
+	} else {
+		return fmt.Errorf("unsupported interface type %s", iface.Type)
+	}
+	if iface.MAC == nil || iface.MAC.Address == "" {
+		return fmt.Errorf("missing interface mac address")
+	}
+	return nil
+}
+
+func (s *FakeLibvirtClient) UndefineDomain(ctx context.Context, name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return err
+	}
+	if fd.state != DomainStateShutoff {
+		return fmt.Errorf("cannot undefine domain %s in state %s", name, fd.state)
+	}
+	delete(s.domains, name)
+	return nil
+}
+
+func (s *FakeLibvirtClient) StartDomain(ctx context.Context, name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return err
+	}
+	if fd.state == DomainStateRunning {
+		return fmt.Errorf("domain %s is already active", name)
+	}
+	fd.state = DomainStateRunning
+	return nil
+}
+
+func (s *FakeLibvirtClient) DestroyDomain(ctx context.Context, name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return err
+	}
+	if fd.state == DomainStateShutoff {
+		return fmt.Errorf("domain %s is not running", name)
+	}
+	fd.state = DomainStateShutoff
+	return nil
+}
+
+func (s *FakeLibvirtClient) RebootDomain(ctx context.Context, name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return err
+	}
+	if fd.state != DomainStateRunning {
+		return fmt.Errorf("domain %s is not running", name)
+	}
+	return nil
+}
+
+func (s *FakeLibvirtClient) SetDomainMetadata(ctx context.Context, name, metadataXML string) error {
+	md, err := ParseInstanceMetadataXML(metadataXML)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return err
+	}
+	fd.dom.Metadata = &DomainMetadata{
+		Instance: md,
+	}
+	return nil
+}
+
+func (s *FakeLibvirtClient) AttachInterface(ctx context.Context, name, interfaceXML string) error {
+	iface, err := ParseInterfaceXML(interfaceXML)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return err
+	}
+	if err := s.checkInterface(iface); err != nil {
+		return err
+	}
+	for _, cur := range fd.dom.Devices.Interfaces {
+		if cur.MAC != nil && cur.MAC.Address == iface.MAC.Address {
+			return fmt.Errorf("interface with mac %s already attached to domain %s", iface.MAC.Address, name)
+		}
+	}
+	fd.dom.Devices.Interfaces = append(fd.dom.Devices.Interfaces, *iface)
+	return nil
+}
+
+func (s *FakeLibvirtClient) DetachInterface(ctx context.Context, name, ifaceType, mac string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return err
+	}
+	for ii, iface := range fd.dom.Devices.Interfaces {
+		if iface.Type == ifaceType && iface.MAC != nil && iface.MAC.Address == mac {
+			fd.dom.Devices.Interfaces = append(fd.dom.Devices.Interfaces[:ii], fd.dom.Devices.Interfaces[ii+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("interface with mac %s on domain %s, %w", mac, name, ErrNotFound)
+}
+
+// GetDomainStats returns stats for a running domain that increase
+// on every call, simulating a domain using half its vcpus.
+func (s *FakeLibvirtClient) GetDomainStats(ctx context.Context, name string) (*DomainStats, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	fd, err := s.getDomain(name)
+	if err != nil {
+		return nil, err
+	}
+	stats := DomainStats{
+		Timestamp: time.Now(),
+	}
+	if fd.state != DomainStateRunning {
+		return &stats, nil
+	}
+	fd.cpuTime += uint64(time.Second) * fd.dom.VCPU / 2
+	stats.CPUTime = fd.cpuTime
+	stats.VCPUs = fd.dom.VCPU
+	stats.MemUsed = fd.dom.GetMemoryMB() * 1024 * 1024 / 2
+	stats.NetRxBytes = fd.cpuTime / 1000
+	stats.NetTxBytes = fd.cpuTime / 2000
+	for _, disk := range fd.dom.Devices.Disks {
+		if p, ok := s.pools[disk.Source.Pool]; ok {
+			stats.DiskAllocation += p.volumes[disk.Source.Volume]
+		}
+	}
+	return &stats, nil
+}
+
+func (s *FakeLibvirtClient) ListNetworks(ctx context.Context) ([]string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	names := []string{}
+	for name := range s.networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *FakeLibvirtClient) CreateNetwork(ctx context.Context, networkXML string) error {
+	network, err := ParseNetworkXML(networkXML)
+	if err != nil {
+		return err
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if _, ok := s.networks[network.Name]; ok {
+		return fmt.Errorf("network %s already exists", network.Name)
+	}
+	s.networks[network.Name] = networkXML
+	return nil
+}
+
+func (s *FakeLibvirtClient) DeleteNetwork(ctx context.Context, name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if _, ok := s.networks[name]; !ok {
+		return fmt.Errorf("network %s, %w", name, ErrNotFound)
+	}
+	for _, fd := range s.domains {
+		if fd.state != DomainStateRunning {
+			continue
+		}
+		if fd.dom.GetInterfaceForNetwork(InterfaceTypeNetwork, name) != nil {
+			return fmt.Errorf("network %s in use by domain %s", name, fd.dom.Name)
+		}
+	}
+	delete(s.networks, name)
+	return nil
+}
+
+func (s *FakeLibvirtClient) GetPoolInfo(ctx context.Context, pool string) (*PoolInfo, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	p, err := s.getPool(pool)
+	if err != nil {
+		return nil, err
+	}
+	info := PoolInfo{
+		CapacityBytes: p.capacityBytes,
+	}
+	for _, size := range p.volumes {
+		info.AllocationBytes += size
+	}
+	if info.AllocationBytes < info.CapacityBytes {
+		info.AvailableBytes = info.CapacityBytes - info.AllocationBytes
+	}
+	return &info, nil
+}
+
+func (s *FakeLibvirtClient) ListVolumes(ctx context.Context, pool string) ([]string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	p, err := s.getPool(pool)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for name := range p.volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *FakeLibvirtClient) createVolume(pool, name string, sizeBytes uint64) error {
+	p, err := s.getPool(pool)
+	if err != nil {
+		return err
+	}
+	if _, ok := p.volumes[name]; ok {
+		return fmt.Errorf("volume %s already exists in pool %s", name, pool)
+	}
+	p.volumes[name] = sizeBytes
+	return nil
+}
+
+func (s *FakeLibvirtClient) CreateVolume(ctx context.Context, pool, name string, sizeGB uint64, backingVol string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if backingVol != "" {
+		p, err := s.getPool(pool)
+		if err != nil {
+			return err
+		}
+		if _, ok := p.volumes[backingVol]; !ok {
+			return fmt.Errorf("backing volume %s, %w", backingVol, ErrNotFound)
+		}
+	}
+	return s.createVolume(pool, name, sizeGB*fakeGiB)
+}
+
+func (s *FakeLibvirtClient) UploadVolume(ctx context.Context, pool, name, file string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.createVolume(pool, name, fakeGiB)
+}
+
+func (s *FakeLibvirtClient) CreateSeedVolume(ctx context.Context, pool, name string, files map[string]string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err := s.createVolume(pool, name, 1024*1024)
+	if err != nil {
+		return err
+	}
+	s.seedFiles[name] = files
+	return nil
+}
+
+func (s *FakeLibvirtClient) DeleteVolume(ctx context.Context, pool, name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	p, err := s.getPool(pool)
+	if err != nil {
+		return err
+	}
+	if _, ok := p.volumes[name]; !ok {
+		return fmt.Errorf("volume %s, %w", name, ErrNotFound)
+	}
+	for _, fd := range s.domains {
+		for _, disk := range fd.dom.Devices.Disks {
+			if disk.Source.Pool == pool && disk.Source.Volume == name {
+				return fmt.Errorf("volume %s in use by domain %s", name, fd.dom.Name)
+			}
+		}
+	}
+	delete(p.volumes, name)
+	delete(s.seedFiles, name)
+	return nil
+}
+
+// GetSeedFiles gets the cloud-init files for the seed volume.
+func (s *FakeLibvirtClient) GetSeedFiles(name string) map[string]string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.seedFiles[name]
+}
@@ -0,0 +1,315 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/infracommon"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/common/vmlayer"
+	"github.com/edgexr/edge-cloud-platform/pkg/syncdata"
+	"github.com/stretchr/testify/require"
+)
+
+const testImage = "edgecloud-v9.9.9"
+
+func getTestVMSpecs(prefix string, subnetNames vmlayer.SubnetNames, numNodes int) []*vmlayer.VMRequestSpec {
+	vms := []*vmlayer.VMRequestSpec{{
+		Name:                 prefix + "-rootlb",
+		Type:                 cloudcommon.NodeTypeDedicatedRootLB,
+		FlavorName:           "m1.medium",
+		ImageName:            testImage,
+		ConnectToExternalNet: true,
+		ConnectToSubnets:     subnetNames,
+	}, {
+		Name:             prefix + "-master",
+		Type:             cloudcommon.NodeTypeK8sClusterMaster,
+		FlavorName:       "m1.medium",
+		ImageName:        testImage,
+		ConnectToSubnets: subnetNames,
+		SharedVolumeSize: 50,
+	}}
+	for ii := 1; ii <= numNodes; ii++ {
+		vms = append(vms, &vmlayer.VMRequestSpec{
+			Name:             prefix + "-node" + string(rune('0'+ii)),
+			Type:             cloudcommon.NodeTypeK8sClusterNode,
+			FlavorName:       "m1.medium",
+			ImageName:        testImage,
+			ConnectToSubnets: subnetNames,
+		})
+	}
+	return vms
+}
+
+func setupTestPlatform(t *testing.T, ctx context.Context) (*vmlayer.VMPlatform, *LibvirtPlatform, *FakeLibvirtClient) {
+	infracommon.SetTestMode(true)
+	ckey := edgeproto.CloudletKey{
+		Organization: "edgecloud",
+		Name:         "unit-test",
+	}
+	pc := platform.PlatformConfig{}
+	pc.CloudletKey = &ckey
+	pc.SyncFactory = syncdata.NewMutexSyncFactory()
+	client := NewFakeLibvirtClient()
+	lp := LibvirtPlatform{
+		client: client,
+	}
+	vmp := vmlayer.VMPlatform{
+		Type:         platform.PlatformTypeLibvirt,
+		VMProvider:   &lp,
+		VMProperties: vmlayer.VMProperties{},
+	}
+	err := vmp.InitProps(ctx, &pc)
+	require.Nil(t, err)
+	vmp.VMProperties.UseTestCACert = true
+	props := &lp.vmProperties.CommonPf.Properties
+	props.SetValue("MEX_EXTERNAL_IP_RANGES", "10.10.10.10/24-10.10.10.12/24")
+	props.SetValue("MEX_EXTERNAL_NETWORK_GATEWAY", "10.10.10.1")
+	props.SetValue("MEX_EXTERNAL_NETWORK_MASK", "24")
+	props.SetValue("LIBVIRT_EXTERNAL_BRIDGE", "br0")
+
+	caches := platform.BuildCaches()
+	flavor := edgeproto.Flavor{
+		Key: edgeproto.FlavorKey{
+			Name: "m1.medium",
+		},
+		Vcpus: 2,
+		Ram:   4096,
+		Disk:  40,
+	}
+	caches.FlavorCache.Update(ctx, &flavor, 0)
+	err = lp.InitProvider(ctx, caches, vmlayer.ProviderInitPlatformStartCrmCommon, edgeproto.DummyUpdateCallback)
+	require.Nil(t, err)
+
+	err = client.AddVolume(FakeDefaultPool, getImageVolumeName(testImage), 1024*1024*1024)
+	require.Nil(t, err)
+	return &vmp, &lp, client
+}
+
+func getTestVMGroupParams(t *testing.T, ctx context.Context, vmp *vmlayer.VMPlatform, groupName string, numNodes int) *vmlayer.VMGroupOrchestrationParams {
+	subnetNames := vmlayer.SubnetNames{vmlayer.MexSubnetPrefix + groupName, ""}
+	vmgp, err := vmp.GetVMGroupOrchestrationParamsFromVMSpec(ctx,
+		groupName, groupName,
+		getTestVMSpecs(groupName, subnetNames, numNodes),
+		vmlayer.WithNewSecurityGroup(groupName+"-sg"),
+		vmlayer.WithNewSubnet(subnetNames),
+	)
+	require.Nil(t, err)
+	return vmgp
+}
+
+func getAddr(sd *vmlayer.ServerDetail, network string) *vmlayer.ServerIP {
+	for ii := range sd.Addresses {
+		if sd.Addresses[ii].Network == network {
+			return &sd.Addresses[ii]
+		}
+	}
+	return nil
+}
+
+func TestLibvirtVMs(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfra)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	vmp, lp, client := setupTestPlatform(t, ctx)
+	extNet := lp.vmProperties.GetCloudletExternalNetwork()
+
+	// create first cluster
+	vmgp1 := getTestVMGroupParams(t, ctx, vmp, "cluster1", 2)
+	err := lp.CreateVMs(ctx, vmgp1, edgeproto.DummyUpdateCallback)
+	require.Nil(t, err)
+
+	subnet1 := vmlayer.MexSubnetPrefix + "cluster1"
+	sd, err := lp.GetServerDetail(ctx, "cluster1-rootlb")
+	require.Nil(t, err)
+	require.Equal(t, vmlayer.ServerActive, sd.Status)
+	require.Equal(t, 2, len(sd.Addresses))
+	extAddr := getAddr(sd, extNet)
+	require.NotNil(t, extAddr)
+	require.Equal(t, "10.10.10.10", extAddr.ExternalAddr)
+	require.NotEmpty(t, extAddr.MacAddress)
+	intAddr := getAddr(sd, subnet1)
+	require.NotNil(t, intAddr)
+	require.Equal(t, "10.101.0.1", intAddr.InternalAddr)
+	require.NotEqual(t, extAddr.MacAddress, intAddr.MacAddress)
+
+	sd, err = lp.GetServerDetail(ctx, "cluster1-master")
+	require.Nil(t, err)
+	require.Equal(t, 1, len(sd.Addresses))
+	require.Equal(t, "10.101.0.10", sd.Addresses[0].InternalAddr)
+
+	// master has no external IP so routes via the rootLB,
+	// and has a shared volume in addition to the root disk
+	seedFiles := client.GetSeedFiles(getSeedVolumeName("cluster1-master"))
+	require.Contains(t, seedFiles["network-config"], "via: 10.101.0.1")
+	require.Contains(t, seedFiles["meta-data"], "k8smaster: 10.101.0.10")
+	dom, err := lp.getDomain(ctx, "cluster1-master")
+	require.Nil(t, err)
+	require.Equal(t, 3, len(dom.Devices.Disks))
+	require.Equal(t, uint64(4096), dom.GetMemoryMB())
+	require.Equal(t, uint64(2), dom.VCPU)
+
+	_, err = lp.GetServerDetail(ctx, "cluster1-notfound")
+	require.NotNil(t, err)
+	require.Equal(t, vmlayer.ServerDoesNotExistError, err.Error())
+
+	// second cluster gets the next subnet and external IP
+	vmgp2 := getTestVMGroupParams(t, ctx, vmp, "cluster2", 1)
+	err = lp.CreateVMs(ctx, vmgp2, edgeproto.DummyUpdateCallback)
+	require.Nil(t, err)
+	sd, err = lp.GetServerDetail(ctx, "cluster2-rootlb")
+	require.Nil(t, err)
+	require.Equal(t, "10.10.10.11", getAddr(sd, extNet).ExternalAddr)
+	require.Equal(t, "10.101.1.1", getAddr(sd, vmlayer.MexSubnetPrefix+"cluster2").InternalAddr)
+
+	res, err := lp.GetPlatformResourceInfo(ctx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), res.Ipv4Max)
+	require.Equal(t, uint64(2), res.Ipv4Used)
+	require.Equal(t, uint64(14), res.VCpuUsed)
+
+	resources, err := lp.GetServerGroupResources(ctx, "cluster1")
+	require.Nil(t, err)
+	require.Equal(t, 4, len(resources.Vms))
+
+	// attach the second rootLB to the first cluster's subnet
+	err = lp.AttachPortToServer(ctx, "cluster2-rootlb", vmlayer.SubnetNames{subnet1, ""}, "port", infracommon.IPs{"10.101.0.2", ""}, vmlayer.ActionCreate)
+	require.Nil(t, err)
+	sd, err = lp.GetServerDetail(ctx, "cluster2-rootlb")
+	require.Nil(t, err)
+	require.Equal(t, 3, len(sd.Addresses))
+	require.Equal(t, "10.101.0.2", getAddr(sd, subnet1).InternalAddr)
+	require.NotEmpty(t, getAddr(sd, subnet1).MacAddress)
+
+	// update first cluster to remove a node and keep its IPs
+	vmgp1 = getTestVMGroupParams(t, ctx, vmp, "cluster1", 1)
+	err = lp.UpdateVMs(ctx, vmgp1, edgeproto.DummyUpdateCallback)
+	require.Nil(t, err)
+	_, err = lp.GetServerDetail(ctx, "cluster1-node2")
+	require.NotNil(t, err)
+	sd, err = lp.GetServerDetail(ctx, "cluster1-rootlb")
+	require.Nil(t, err)
+	require.Equal(t, "10.10.10.10", getAddr(sd, extNet).ExternalAddr)
+
+	// update to add the node back
+	vmgp1 = getTestVMGroupParams(t, ctx, vmp, "cluster1", 2)
+	err = lp.UpdateVMs(ctx, vmgp1, edgeproto.DummyUpdateCallback)
+	require.Nil(t, err)
+	sd, err = lp.GetServerDetail(ctx, "cluster1-node2")
+	require.Nil(t, err)
+	require.Equal(t, vmlayer.ServerActive, sd.Status)
+
+	// power state
+	err = lp.SetPowerState(ctx, "cluster1-node2", vmlayer.ActionStop)
+	require.Nil(t, err)
+	sd, err = lp.GetServerDetail(ctx, "cluster1-node2")
+	require.Nil(t, err)
+	require.Equal(t, vmlayer.ServerShutoff, sd.Status)
+	err = lp.SetPowerState(ctx, "cluster1-node2", vmlayer.ActionStart)
+	require.Nil(t, err)
+
+	// the network is still used by the second rootLB, so is not deleted
+	err = lp.DeleteVMs(ctx, "cluster1", "cluster1")
+	require.Nil(t, err)
+	_, err = lp.GetServerDetail(ctx, "cluster1-rootlb")
+	require.NotNil(t, err)
+	networks, err := client.ListNetworks(ctx)
+	require.Nil(t, err)
+	require.Contains(t, networks, lp.getNetworkName(subnet1))
+
+	err = lp.DetachPortFromServer(ctx, "cluster2-rootlb", vmlayer.SubnetNames{subnet1, ""}, "port")
+	require.Nil(t, err)
+	sd, err = lp.GetServerDetail(ctx, "cluster2-rootlb")
+	require.Nil(t, err)
+	require.Equal(t, 2, len(sd.Addresses))
+
+	err = lp.DeleteVMs(ctx, "cluster2", "cluster2")
+	require.Nil(t, err)
+	networks, err = client.ListNetworks(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(networks))
+	vols, err := client.ListVolumes(ctx, FakeDefaultPool)
+	require.Nil(t, err)
+	require.Equal(t, []string{getImageVolumeName(testImage)}, vols)
+}
+
+func TestLibvirtCreateFailure(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfra)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	vmp, lp, client := setupTestPlatform(t, ctx)
+
+	// missing image, everything should be cleaned up
+	err := client.DeleteVolume(ctx, FakeDefaultPool, getImageVolumeName(testImage))
+	require.Nil(t, err)
+	vmgp := getTestVMGroupParams(t, ctx, vmp, "cluster1", 1)
+	err = lp.CreateVMs(ctx, vmgp, edgeproto.DummyUpdateCallback)
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "backing volume"), err.Error())
+	names, err := client.ListDomains(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(names))
+	networks, err := client.ListNetworks(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(networks))
+	vols, err := client.ListVolumes(ctx, FakeDefaultPool)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(vols))
+}
+
+func TestLibvirtStats(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelInfra)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	vmp, lp, _ := setupTestPlatform(t, ctx)
+	vmgp := getTestVMGroupParams(t, ctx, vmp, "cluster1", 0)
+	err := lp.CreateVMs(ctx, vmgp, edgeproto.DummyUpdateCallback)
+	require.Nil(t, err)
+
+	saved := vmStatsInterval
+	vmStatsInterval = 10 * time.Millisecond
+	defer func() {
+		vmStatsInterval = saved
+	}()
+	appInst := edgeproto.AppInst{
+		UniqueId: "cluster1-master",
+	}
+	metrics, err := lp.GetVMStats(ctx, &appInst)
+	require.Nil(t, err)
+	require.Greater(t, metrics.Cpu, float64(0))
+	require.Equal(t, uint64(2048*1024*1024), metrics.Mem)
+	require.NotNil(t, metrics.CpuTS)
+	require.Greater(t, metrics.Disk, uint64(0))
+
+	// image already present, so no download is needed
+	err = lp.AddImageIfNotPresent(ctx, &infracommon.ImageInfo{
+		LocalImageName: testImage,
+		ImageType:      edgeproto.ImageType_IMAGE_TYPE_QCOW,
+	}, edgeproto.DummyUpdateCallback)
+	require.Nil(t, err)
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/edgexr/edge-cloud-platform/pkg/cloudcommon"
@@ -285,6 +286,115 @@ func RunSafeShell(client ssh.Client, sin io.Reader, sout, serr io.Writer, cmd st
 	return client.Shell(sin, sout, serr, "python3 "+cmdFile)
 }
 
+// RunSafeStream behaves like RunSafeShell(), but runs the command
+// without a terminal so that binary data on stdin and stdout is passed
+// through unmodified. Stdin is closed once sin is exhausted.
+// If ctx is cancelled, the command is stopped.
+func RunSafeStream(ctx context.Context, client ssh.Client, sin io.Reader, sout, serr io.Writer, cmd string, args []string) error {
+	command := cmd
+	if len(args) > 0 {
+		cmdFile, err := writeSafeScript(client, cmd, args)
+		if err != nil {
+			return err
+		}
+		defer DeleteFile(client, cmdFile, NoSudo)
+		command = "python3 " + cmdFile
+	}
+	stdout, stderr, stdin, err := client.Start(command)
+	if err != nil {
+		return err
+	}
+	// stop the command if the context is cancelled before it finishes
+	stop := getStreamStop(client, stdout, stderr)
+	stopMux := sync.Mutex{}
+	finished := false
+	stopped := false
+	needWait := true
+	stopDone := make(chan struct{})
+	defer close(stopDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stopDone:
+			return
+		}
+		stopMux.Lock()
+		defer stopMux.Unlock()
+		if finished || stop == nil {
+			return
+		}
+		stopped = true
+		needWait = stop()
+	}()
+
+	var soutErr error
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, soutErr = io.Copy(sout, stdout)
+		if soutErr != nil {
+			// keep draining so the command does not block
+			io.Copy(io.Discard, stdout)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(serr, stderr)
+	}()
+	if sin != nil {
+		io.Copy(stdin, sin)
+	}
+	stdin.Close()
+	wg.Wait()
+
+	stopMux.Lock()
+	finished = true
+	stopMux.Unlock()
+	if needWait {
+		err = client.Wait()
+	}
+	if stopped {
+		return ctx.Err()
+	}
+	if soutErr != nil {
+		return soutErr
+	}
+	return err
+}
+
+// getStreamStop gets a function to stop the command started by
+// client.Start. The function returns true if client.Wait still needs
+// to be called to release resources. It returns nil if the client
+// does not support stopping the command.
+func getStreamStop(client ssh.Client, stdout, stderr io.Closer) func() bool {
+	switch c := client.(type) {
+	case *ssh.NativeClient:
+		// closing the session stops the remote command
+		sessionInfo := c.SessionInfo
+		if sessionInfo == nil {
+			return nil
+		}
+		return func() bool {
+			sessionInfo.CloseAll()
+			return false
+		}
+	case *LocalClient:
+		cmd := c.cmd
+		if cmd == nil || cmd.Process == nil {
+			return nil
+		}
+		return func() bool {
+			cmd.Process.Kill()
+			// child processes may still hold the pipes open
+			stdout.Close()
+			stderr.Close()
+			return true
+		}
+	}
+	return nil
+}
+
 // RunSafeOutput behaves like client.Output(), but assumes args may
 // come from user-input and may be malicious. See RunSafeShell().
 func RunSafeOutput(client ssh.Client, cmd string, args []string) (string, error) {
@@ -25,6 +25,7 @@ const (
 	PlatformTypeAWSEC2            = "awsec2"
 	PlatformTypeAWSEKS            = "awseks"
 	PlatformTypeAzure             = "azure"
+	PlatformTypeCAPI              = "capi" // kubernetes cluster api
 	PlatformTypeDind              = "dind" // docker in docker
 	PlatformTypeEdgebox           = "edgebox"
 	PlatformTypeLocalhost         = "localhost"
@@ -40,6 +41,7 @@ const (
 	PlatformTypeK8SSite           = "k8ssite"
 	PlatformTypeKind              = "kind" // kubernetes in docker
 	PlatformTypeKindInfra         = "kindinfra"
+	PlatformTypeLibvirt           = "libvirt"
 	PlatformTypeMock              = "mock"
 	PlatformTypeMockManagedK8S    = "mockmanagedk8s"
 	PlatformTypeOpenstack         = "openstack"
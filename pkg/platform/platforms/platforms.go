@@ -20,6 +20,7 @@ import (
 	awsec2 "github.com/edgexr/edge-cloud-platform/pkg/platform/aws/aws-ec2"
 	awseks "github.com/edgexr/edge-cloud-platform/pkg/platform/aws/aws-eks"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/azure"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/capi"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/dind"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/fake"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/fakeinfra"
@@ -28,6 +29,7 @@ import (
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/k8ssite"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/kind"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/kindinfra"
+	"github.com/edgexr/edge-cloud-platform/pkg/platform/libvirt"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/localhost"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/mock"
 	"github.com/edgexr/edge-cloud-platform/pkg/platform/mockmanagedk8s"
@@ -64,6 +66,8 @@ var builders = []platform.PlatformBuilder{
 	mockmanagedk8s.NewPlatform,
 	localhost.NewPlatform,
 	osmk8s.NewPlatform,
+	libvirt.NewPlatform,
+	capi.NewPlatform,
 }
 
 type PlatformsData struct {
@@ -119,5 +119,5 @@ func (v *VcdPlatform) GetCloudletTrustPolicy(ctx context.Context) (*edgeproto.Tr
 		return nil, err
 	}
 	log.SpanLog(ctx, log.DebugLevelInfra, "vcd:GetCloudletTrustPolicy fetched", "TrustPolicy", tpol.Key.Name, "cloudlet", cldlet.Key.Name)
-	return tpol, nil
+	return cloudcommon.TrustPolicyFqdns.ResolveTrustPolicy(ctx, tpol)
 }
@@ -42,6 +42,9 @@ type Controller struct {
 	NotifyParentAddrs    string
 	EdgeTurnAddr         string
 	InfluxAddr           string
+	MetricsExportType    string
+	MetricsExportAddr    string
+	MetricsExportOnly    bool
 	Region               string
 	cmd                  *exec.Cmd
 	TestMode             bool
@@ -75,6 +78,13 @@ func (p *Controller) StartLocal(logfile string, opts ...StartOp) error {
 		args = append(args, "--influxAddr")
 		args = append(args, p.InfluxAddr)
 	}
+	if p.MetricsExportType != "" {
+		args = append(args, "--metricsExportType", p.MetricsExportType)
+		args = append(args, "--metricsExportAddr", p.MetricsExportAddr)
+	}
+	if p.MetricsExportOnly {
+		args = append(args, "--metricsExportOnly")
+	}
 	if p.RegistryFQDN != "" {
 		args = append(args, "--registryFQDN")
 		args = append(args, p.RegistryFQDN)
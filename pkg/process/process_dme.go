@@ -29,25 +29,27 @@ import (
 )
 
 type Dme struct {
-	Common      `yaml:",inline"`
-	NodeCommon  `yaml:",inline"`
-	ApiAddr     string
-	HttpAddr    string
-	NotifyAddrs string
-	LocVerUrl   string
-	TokSrvUrl   string
-	QosPosUrl   string
-	QosSesAddr  string
-	Carrier     string
-	CloudletKey string
-	CookieExpr  string
-	Region      string
-	cmd         *exec.Cmd
+	Common            `yaml:",inline"`
+	NodeCommon        `yaml:",inline"`
+	RedisClientCommon `yaml:",inline"`
+	ApiAddr           string
+	HttpAddr          string
+	NotifyAddrs       string
+	LocVerUrl         string
+	TokSrvUrl         string
+	QosPosUrl         string
+	QosSesAddr        string
+	Carrier           string
+	CloudletKey       string
+	CookieExpr        string
+	Region            string
+	cmd               *exec.Cmd
 }
 
 func (p *Dme) StartLocal(logfile string, opts ...StartOp) error {
 	args := []string{"--notifyAddrs", p.NotifyAddrs}
 	args = append(args, p.GetNodeMgrArgs()...)
+	args = append(args, p.GetRedisClientArgs()...)
 	if p.ApiAddr != "" {
 		args = append(args, "--apiAddr")
 		args = append(args, p.ApiAddr)
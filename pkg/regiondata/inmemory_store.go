@@ -38,19 +38,34 @@ type inMemData struct {
 	val    string
 	vers   int64
 	modRev int64
+	lease  int64
+}
+
+// inMemLease emulates an etcd lease. Keys attached to the lease
+// are deleted when the lease expires or is revoked.
+type inMemLease struct {
+	id      int64
+	ttl     time.Duration
+	expires time.Time
+	keys    map[string]struct{}
+	timer   *time.Timer
+	done    chan struct{}
 }
 
 type InMemoryStore struct {
-	db       map[string]*inMemData
-	watchers map[string][]*watcher
-	rev      int64
-	syncCb   objstore.SyncCb
-	mux      util.Mutex
+	db          map[string]*inMemData
+	watchers    map[string][]*watcher
+	leases      map[int64]*inMemLease
+	nextLeaseID int64
+	rev         int64
+	syncCb      objstore.SyncCb
+	mux         util.Mutex
 }
 
 func (e *InMemoryStore) Start() error {
 	e.db = make(map[string]*inMemData)
 	e.watchers = make(map[string][]*watcher)
+	e.leases = make(map[int64]*inMemLease)
 	e.rev = 1
 	return nil
 }
@@ -59,6 +74,11 @@ func (e *InMemoryStore) Stop() {
 	e.mux.Lock()
 	defer e.mux.Unlock()
 	e.db = nil
+	for _, lease := range e.leases {
+		lease.timer.Stop()
+		close(lease.done)
+	}
+	e.leases = nil
 }
 
 func (e *InMemoryStore) Create(ctx context.Context, key, val string) (int64, error) {
@@ -96,6 +116,7 @@ func (e *InMemoryStore) Update(ctx context.Context, key, val string, version int
 		return 0, errors.New("Invalid version")
 	}
 
+	e.setLease(key, data, 0)
 	e.rev++
 	data.val = val
 	data.vers++
@@ -111,11 +132,18 @@ func (e *InMemoryStore) Put(ctx context.Context, key, val string, ops ...objstor
 	if e.db == nil {
 		return 0, objstore.ErrKVStoreNotInitialized
 	}
+	kvopts := objstore.GetKVOptions(ops)
+	if kvopts.LeaseID != 0 {
+		if _, found := e.leases[kvopts.LeaseID]; !found {
+			return 0, leaseNotFoundError(kvopts.LeaseID)
+		}
+	}
 	data, ok := e.db[key]
 	if !ok {
 		data = &inMemData{}
 		e.db[key] = data
 	}
+	e.setLease(key, data, kvopts.LeaseID)
 	e.rev++
 	data.val = val
 	data.vers++
@@ -131,6 +159,9 @@ func (e *InMemoryStore) Delete(ctx context.Context, key string) (int64, error) {
 	if e.db == nil {
 		return 0, objstore.ErrKVStoreNotInitialized
 	}
+	if data, ok := e.db[key]; ok {
+		e.setLease(key, data, 0)
+	}
 	delete(e.db, key)
 	e.rev++
 	log.DebugLog(log.DebugLevelEtcd, "Delete", "key", key, "rev", e.rev)
@@ -254,16 +285,135 @@ func (e *InMemoryStore) triggerWatcher(ctx context.Context, action objstore.Sync
 	}
 }
 
+func leaseNotFoundError(leaseID int64) error {
+	return fmt.Errorf("requested lease %d not found", leaseID)
+}
+
+// setLease moves the key's lease association to the given lease.
+// A leaseID of 0 detaches the key from any lease, which matches
+// etcd behavior of a put without a lease. Caller must hold the lock.
+func (e *InMemoryStore) setLease(key string, data *inMemData, leaseID int64) {
+	if data.lease == leaseID {
+		return
+	}
+	if lease, ok := e.leases[data.lease]; ok {
+		delete(lease.keys, key)
+	}
+	data.lease = leaseID
+	if lease, ok := e.leases[leaseID]; ok {
+		lease.keys[key] = struct{}{}
+	}
+}
+
 func (e *InMemoryStore) Grant(ctx context.Context, ttl int64) (int64, error) {
-	return 0, errors.New("dummy etcd grant unsupported")
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if e.db == nil {
+		return 0, objstore.ErrKVStoreNotInitialized
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("invalid lease ttl %d", ttl)
+	}
+	e.nextLeaseID++
+	lease := &inMemLease{
+		id:   e.nextLeaseID,
+		ttl:  time.Duration(ttl) * time.Second,
+		keys: make(map[string]struct{}),
+		done: make(chan struct{}),
+	}
+	lease.expires = time.Now().Add(lease.ttl)
+	lease.timer = time.AfterFunc(lease.ttl, func() {
+		e.expireLease(lease)
+	})
+	e.leases[lease.id] = lease
+	log.SpanLog(ctx, log.DebugLevelEtcd, "Grant", "lease", lease.id, "ttl", ttl)
+	return lease.id, nil
 }
 
-func (e *InMemoryStore) Revoke(ctx context.Context, lease int64) error {
-	return errors.New("dummy etcd revoke unsupported")
+func (e *InMemoryStore) Revoke(ctx context.Context, leaseID int64) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if e.db == nil {
+		return objstore.ErrKVStoreNotInitialized
+	}
+	lease, ok := e.leases[leaseID]
+	if !ok {
+		return leaseNotFoundError(leaseID)
+	}
+	log.SpanLog(ctx, log.DebugLevelEtcd, "Revoke", "lease", leaseID)
+	e.removeLease(ctx, lease)
+	return nil
 }
 
+// KeepAlive refreshes the lease until the context is cancelled.
+// It returns an error if the lease is revoked or expires.
 func (e *InMemoryStore) KeepAlive(ctx context.Context, leaseID int64) error {
-	return errors.New("dummy etcd keepalive unsupported")
+	e.mux.Lock()
+	lease, ok := e.leases[leaseID]
+	e.mux.Unlock()
+	if !ok {
+		return leaseNotFoundError(leaseID)
+	}
+	interval := lease.ttl / 3
+	for {
+		if !e.refreshLease(lease) {
+			return fmt.Errorf("keep alive finished unexpectedly")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-lease.done:
+			return fmt.Errorf("keep alive finished unexpectedly")
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (e *InMemoryStore) refreshLease(lease *inMemLease) bool {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if e.leases[lease.id] != lease {
+		return false
+	}
+	lease.expires = time.Now().Add(lease.ttl)
+	lease.timer.Reset(lease.ttl)
+	return true
+}
+
+func (e *InMemoryStore) expireLease(lease *inMemLease) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if e.leases[lease.id] != lease {
+		// already revoked
+		return
+	}
+	if wait := time.Until(lease.expires); wait > 0 {
+		// refreshed by keepalive after timer fired
+		lease.timer.Reset(wait)
+		return
+	}
+	span := log.StartSpan(log.DebugLevelEtcd, "lease expired")
+	defer span.Finish()
+	ctx := log.ContextWithSpan(context.Background(), span)
+	log.SpanLog(ctx, log.DebugLevelEtcd, "Lease expired", "lease", lease.id)
+	e.removeLease(ctx, lease)
+}
+
+// removeLease deletes the lease and all keys attached to it,
+// in a single revision like etcd. Caller must hold the lock.
+func (e *InMemoryStore) removeLease(ctx context.Context, lease *inMemLease) {
+	lease.timer.Stop()
+	delete(e.leases, lease.id)
+	close(lease.done)
+	if len(lease.keys) == 0 {
+		return
+	}
+	e.rev++
+	for key := range lease.keys {
+		delete(e.db, key)
+		log.DebugLog(log.DebugLevelEtcd, "Delete", "key", key, "lease", lease.id, "rev", e.rev)
+		e.triggerWatcher(ctx, objstore.SyncDelete, key, "", e.rev)
+	}
 }
 
 // Based on clientv3/concurrency/stm.go
@@ -341,6 +491,9 @@ func (e *InMemoryStore) commit(ctx context.Context, stm *inMemorySTM) (int64, er
 
 		if val == "" {
 			// delete
+			if dd, ok := e.db[key]; ok {
+				e.setLease(key, dd, 0)
+			}
 			delete(e.db, key)
 			log.DebugLog(log.DebugLevelEtcd, "Delete",
 				"key", key, "rev", e.rev)
@@ -351,6 +504,10 @@ func (e *InMemoryStore) commit(ctx context.Context, stm *inMemorySTM) (int64, er
 				dd = &inMemData{}
 				e.db[key] = dd
 			}
+			// Lease options for STM puts are not visible here,
+			// so like an etcd put without a lease, the key is
+			// detached from any lease.
+			e.setLease(key, dd, 0)
 			dd.val = val
 			dd.vers++
 			dd.modRev = e.rev
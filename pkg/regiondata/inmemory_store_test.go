@@ -0,0 +1,124 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regiondata
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/edgexr/edge-cloud-platform/pkg/objstore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryStoreLease(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelEtcd)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	objStore := &InMemoryStore{}
+	err := objStore.Start()
+	require.Nil(t, err)
+	defer objStore.Stop()
+
+	// track deletes seen by watchers
+	var mux sync.Mutex
+	deleted := map[string]bool{}
+	syncCtx, syncCancel := context.WithCancel(ctx)
+	defer syncCancel()
+	go objStore.Sync(syncCtx, "key", func(ctx context.Context, data *objstore.SyncCbData) {
+		if data.Action == objstore.SyncDelete {
+			mux.Lock()
+			deleted[string(data.Key)] = true
+			mux.Unlock()
+		}
+	})
+	isDeleted := func(key string) bool {
+		mux.Lock()
+		defer mux.Unlock()
+		return deleted[key]
+	}
+
+	// put with unknown lease fails
+	_, err = objStore.Put(ctx, "key0", "val0", objstore.WithLease(99))
+	require.NotNil(t, err)
+
+	lease, err := objStore.Grant(ctx, 1)
+	require.Nil(t, err)
+
+	// put keys with lease
+	_, err = objStore.Put(ctx, "key1", "val1", objstore.WithLease(lease))
+	require.Nil(t, err)
+	_, err = objStore.Put(ctx, "key2", "val2", objstore.WithLease(lease))
+	require.Nil(t, err)
+	// key3 is put without lease, so it should not expire
+	_, err = objStore.Put(ctx, "key3", "val3")
+	require.Nil(t, err)
+	// key2 is updated without lease, so it is detached
+	_, err = objStore.Update(ctx, "key2", "val2", objstore.ObjStoreUpdateVersionAny)
+	require.Nil(t, err)
+
+	// keepalive keeps keys alive past the ttl
+	kaCtx, kaCancel := context.WithCancel(ctx)
+	kaErr := make(chan error, 1)
+	go func() {
+		kaErr <- objStore.KeepAlive(kaCtx, lease)
+	}()
+	time.Sleep(2 * time.Second)
+	val, _, _, err := objStore.Get("key1")
+	require.Nil(t, err)
+	require.Equal(t, "val1", string(val))
+
+	// cancel keepalive, key1 should expire
+	kaCancel()
+	require.Nil(t, <-kaErr)
+	time.Sleep(1500 * time.Millisecond)
+	_, _, _, err = objStore.Get("key1")
+	require.Equal(t, objstore.NotFoundError("key1"), err)
+	require.True(t, isDeleted("key1"))
+	for _, key := range []string{"key2", "key3"} {
+		_, _, _, err = objStore.Get(key)
+		require.Nil(t, err, key)
+		require.False(t, isDeleted(key), key)
+	}
+	// expired lease cannot be kept alive or revoked
+	err = objStore.KeepAlive(ctx, lease)
+	require.NotNil(t, err)
+	err = objStore.Revoke(ctx, lease)
+	require.NotNil(t, err)
+
+	// revoke deletes keys immediately, and ends keepalive
+	lease, err = objStore.Grant(ctx, 10)
+	require.Nil(t, err)
+	_, err = objStore.Put(ctx, "key4", "val4", objstore.WithLease(lease))
+	require.Nil(t, err)
+	go func() {
+		kaErr <- objStore.KeepAlive(ctx, lease)
+	}()
+	err = objStore.Revoke(ctx, lease)
+	require.Nil(t, err)
+	_, _, _, err = objStore.Get("key4")
+	require.Equal(t, objstore.NotFoundError("key4"), err)
+	require.True(t, isDeleted("key4"))
+	select {
+	case err = <-kaErr:
+		require.NotNil(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "keepalive did not return after revoke")
+	}
+}
@@ -177,6 +177,23 @@ func (s *Sync) SyncWait(rev int64) {
 	}
 }
 
+// GetRev gets the database revision the caches are synced to
+func (s *Sync) GetRev() int64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.rev
+}
+
+// GetCacheTypes gets the type strings of all synced caches
+func (s *Sync) GetCacheTypes() []string {
+	types := []string{}
+	for typ := range s.caches {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
 func (s *Sync) ApplySTMWait(ctx context.Context, apply func(concurrency.STM) error) error {
 	rev, err := s.store.ApplySTM(ctx, apply)
 	if err == nil {
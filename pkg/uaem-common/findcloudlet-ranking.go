@@ -0,0 +1,225 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"sync"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+)
+
+// Latencies within 1 ms are considered equivalent
+const VeryCloseLatencyMs = 1
+
+// Weighted scores within 1 are considered equivalent
+const VeryCloseWeightedScore = 1
+
+// Round trip latency estimate per km of distance, used for AppInsts
+// that have no latency samples yet. This is roughly the speed of
+// light in fiber, plus some overhead for routing.
+var EstimatedLatencyMsPerKm = 0.02
+
+// Weight of the latest average latency reported by a client in the
+// moving average of an AppInst's latency.
+var LatencyMovingAvgWeight = 0.3
+
+// FindCloudletRanker scores AppInsts for FindCloudlet.
+// Lower scores are ranked first.
+type FindCloudletRanker interface {
+	Score(found *foundAppInst) float64
+	// Scores that differ by less than this are considered equivalent
+	VeryCloseScore() float64
+}
+
+// GetFindCloudletRanker gets the ranker for the App's ranking strategy.
+func GetFindCloudletRanker(app *DmeApp) FindCloudletRanker {
+	switch app.FindCloudletRanking {
+	case edgeproto.FindCloudletRanking_RANK_BY_LATENCY:
+		return &latencyRanker{stats: RankStats}
+	case edgeproto.FindCloudletRanking_RANK_BY_LOAD:
+		return &loadRanker{stats: RankStats}
+	case edgeproto.FindCloudletRanking_RANK_BY_WEIGHTED:
+		if app.FindCloudletRankingWeights == nil {
+			break
+		}
+		return &weightedRanker{
+			weights: *app.FindCloudletRankingWeights,
+			stats:   RankStats,
+		}
+	}
+	return &distanceRanker{}
+}
+
+type distanceRanker struct{}
+
+func (s *distanceRanker) Score(found *foundAppInst) float64 {
+	return found.distance
+}
+
+func (s *distanceRanker) VeryCloseScore() float64 {
+	return VeryCloseDistanceKm
+}
+
+type latencyRanker struct {
+	stats *AppInstRankStats
+}
+
+func (s *latencyRanker) Score(found *foundAppInst) float64 {
+	return s.stats.getLatencyMs(found)
+}
+
+func (s *latencyRanker) VeryCloseScore() float64 {
+	return VeryCloseLatencyMs
+}
+
+type loadRanker struct {
+	stats *AppInstRankStats
+}
+
+func (s *loadRanker) Score(found *foundAppInst) float64 {
+	return float64(s.stats.getActiveConns(found))
+}
+
+func (s *loadRanker) VeryCloseScore() float64 {
+	// only equal loads are equivalent, and those are
+	// then ranked by distance.
+	return 0
+}
+
+type weightedRanker struct {
+	weights edgeproto.FindCloudletRankingWeights
+	stats   *AppInstRankStats
+}
+
+func (s *weightedRanker) Score(found *foundAppInst) float64 {
+	score := s.weights.Distance * found.distance
+	if s.weights.Latency != 0 {
+		score += s.weights.Latency * s.stats.getLatencyMs(found)
+	}
+	if s.weights.Load != 0 {
+		score += s.weights.Load * float64(s.stats.getActiveConns(found))
+	}
+	return score
+}
+
+func (s *weightedRanker) VeryCloseScore() float64 {
+	return VeryCloseWeightedScore
+}
+
+// AppInstRankStats tracks the per-AppInst metrics used to rank
+// AppInsts, as seen by this DME.
+type AppInstRankStats struct {
+	mux   sync.Mutex
+	stats map[edgeproto.AppInstKey]*appInstRankStat
+}
+
+type appInstRankStat struct {
+	// moving average of the average latency reported by clients
+	latencyMs    float64
+	latencyValid bool
+	// number of clients with an edge events connection
+	activeConns int
+}
+
+var RankStats = NewAppInstRankStats()
+
+func NewAppInstRankStats() *AppInstRankStats {
+	return &AppInstRankStats{
+		stats: make(map[edgeproto.AppInstKey]*appInstRankStat),
+	}
+}
+
+func (s *AppInstRankStats) get(key edgeproto.AppInstKey) *appInstRankStat {
+	stat, found := s.stats[key]
+	if !found {
+		stat = &appInstRankStat{}
+		s.stats[key] = stat
+	}
+	return stat
+}
+
+func (s *AppInstRankStats) cleanup(key edgeproto.AppInstKey, stat *appInstRankStat) {
+	if stat.activeConns <= 0 && !stat.latencyValid {
+		delete(s.stats, key)
+	}
+}
+
+// UpdateLatency adds the statistics computed from latency samples
+// reported by a client to the AppInst's latency.
+func (s *AppInstRankStats) UpdateLatency(key edgeproto.AppInstKey, latency *dme.Statistics) {
+	if latency == nil || latency.NumSamples == 0 {
+		return
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	stat := s.get(key)
+	if stat.latencyValid {
+		stat.latencyMs = LatencyMovingAvgWeight*latency.Avg + (1-LatencyMovingAvgWeight)*stat.latencyMs
+	} else {
+		stat.latencyMs = latency.Avg
+		stat.latencyValid = true
+	}
+}
+
+// ClearLatency clears the AppInst's latency, i.e. when it is deleted.
+func (s *AppInstRankStats) ClearLatency(key edgeproto.AppInstKey) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	stat, found := s.stats[key]
+	if !found {
+		return
+	}
+	stat.latencyMs = 0
+	stat.latencyValid = false
+	s.cleanup(key, stat)
+}
+
+// AddConn tracks a new active client connection to the AppInst.
+func (s *AppInstRankStats) AddConn(key edgeproto.AppInstKey) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.get(key).activeConns++
+}
+
+// RemoveConn removes an active client connection to the AppInst.
+func (s *AppInstRankStats) RemoveConn(key edgeproto.AppInstKey) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	stat, found := s.stats[key]
+	if !found {
+		return
+	}
+	stat.activeConns--
+	s.cleanup(key, stat)
+}
+
+func (s *AppInstRankStats) getLatencyMs(found *foundAppInst) float64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if stat, ok := s.stats[found.AppInst.key]; ok && stat.latencyValid {
+		return stat.latencyMs
+	}
+	return found.distance * EstimatedLatencyMsPerKm
+}
+
+func (s *AppInstRankStats) getActiveConns(found *foundAppInst) int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if stat, ok := s.stats[found.AppInst.key]; ok && stat.activeConns > 0 {
+		return stat.activeConns
+	}
+	return 0
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmecommon
+
+import (
+	"context"
+	"testing"
+
+	dme "github.com/edgexr/edge-cloud-platform/api/distributed_match_engine"
+	"github.com/edgexr/edge-cloud-platform/api/edgeproto"
+	"github.com/edgexr/edge-cloud-platform/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCloudletRanking(t *testing.T) {
+	log.SetDebugLevel(log.DebugLevelDmereq)
+	log.InitTracer(nil)
+	defer log.FinishTracer()
+	ctx := log.StartTestSpan(context.Background())
+
+	defer func(randomize bool, stats *AppInstRankStats, apStats *AutoProvStats) {
+		OptionFindCloudletRandomizeVeryClose = randomize
+		RankStats = stats
+		autoProvStats = apStats
+	}(OptionFindCloudletRandomizeVeryClose, RankStats, autoProvStats)
+	OptionFindCloudletRandomizeVeryClose = false
+	RankStats = NewAppInstRankStats()
+
+	// AppInsts roughly 11km, 111km, and 555km from the client
+	insts := []*DmeAppInst{}
+	for ii, lat := range []float64{0.1, 1, 5} {
+		insts = append(insts, &DmeAppInst{
+			key: edgeproto.AppInstKey{
+				Name:         "inst" + string(rune('1'+ii)),
+				Organization: "devorg",
+			},
+			Location: dme.Loc{
+				Latitude: lat,
+			},
+			TrackedState:  edgeproto.TrackedState_READY,
+			CloudletState: dme.CloudletState_CLOUDLET_STATE_READY,
+		})
+	}
+	app := &DmeApp{
+		AppKey: edgeproto.AppKey{
+			Name:         "app",
+			Organization: "devorg",
+			Version:      "1.0",
+		},
+		Carriers: map[string]*DmeAppInsts{
+			"oper": &DmeAppInsts{
+				Insts: map[edgeproto.AppInstKey]*DmeAppInst{},
+			},
+		},
+	}
+	for _, inst := range insts {
+		app.Carriers["oper"].Insts[inst.key] = inst
+	}
+	loc := &dme.Loc{}
+
+	expectOrder := func(order ...int) {
+		t.Helper()
+		results := SearchAppInsts(ctx, "", app, loc, app.Carriers, len(insts), nil)
+		names := []string{}
+		for _, found := range results {
+			names = append(names, found.AppInst.key.Name)
+		}
+		expNames := []string{}
+		for _, idx := range order {
+			expNames = append(expNames, insts[idx].key.Name)
+		}
+		require.Equal(t, expNames, names)
+	}
+
+	// default is by distance
+	expectOrder(0, 1, 2)
+
+	// by latency, AppInsts without samples are estimated by distance
+	app.FindCloudletRanking = edgeproto.FindCloudletRanking_RANK_BY_LATENCY
+	expectOrder(0, 1, 2)
+	RankStats.UpdateLatency(insts[0].key, &dme.Statistics{Avg: 20, NumSamples: 5})
+	RankStats.UpdateLatency(insts[2].key, &dme.Statistics{Avg: 5, NumSamples: 5})
+	expectOrder(1, 2, 0)
+	// latency is a moving average
+	RankStats.UpdateLatency(insts[0].key, &dme.Statistics{Avg: 1, NumSamples: 5})
+	expectOrder(1, 2, 0)
+	for ii := 0; ii < 10; ii++ {
+		RankStats.UpdateLatency(insts[0].key, &dme.Statistics{Avg: 1, NumSamples: 5})
+	}
+	expectOrder(0, 1, 2)
+
+	// by load, ties go to the closest
+	app.FindCloudletRanking = edgeproto.FindCloudletRanking_RANK_BY_LOAD
+	expectOrder(0, 1, 2)
+	RankStats.AddConn(insts[0].key)
+	RankStats.AddConn(insts[0].key)
+	RankStats.AddConn(insts[1].key)
+	expectOrder(2, 1, 0)
+
+	// autoprov only counts cloudlets closer than the closest
+	// AppInst, even when the first result is not the closest
+	SetupMatchEngine(&EmptyEdgeEventsHandler{})
+	InitAutoProvStats(500, 0, 1, &edgeproto.NodeKey{}, nil)
+	zkey := edgeproto.ZoneKey{
+		Name:         "zone",
+		Organization: "oper",
+	}
+	app.AutoProvPolicies = map[string]*AutoProvPolicy{
+		"policy": &AutoProvPolicy{
+			Name:              "policy",
+			DeployClientCount: 1,
+			Zones: map[string][]*edgeproto.ZoneKey{
+				"oper": []*edgeproto.ZoneKey{&zkey},
+			},
+		},
+	}
+	// potential cloudlet roughly 333km from the client
+	cloudletLocs := CloudletLocsByZone{
+		zkey: {
+			edgeproto.CloudletKey{Name: "cloudlet", Organization: "oper"}: &dme.Loc{Latitude: 3},
+		},
+	}
+	results := SearchAppInsts(ctx, "", app, loc, app.Carriers, len(insts), cloudletLocs)
+	require.Equal(t, insts[2].key, results[0].AppInst.key)
+	for ii := range autoProvStats.shards {
+		require.Equal(t, 0, len(autoProvStats.shards[ii].appCloudletCounts))
+	}
+	app.AutoProvPolicies = nil
+
+	// weighted combination of distance and load
+	app.FindCloudletRanking = edgeproto.FindCloudletRanking_RANK_BY_WEIGHTED
+	app.FindCloudletRankingWeights = &edgeproto.FindCloudletRankingWeights{
+		Distance: 0.1,
+		Load:     1,
+	}
+	expectOrder(0, 1, 2)
+	app.FindCloudletRankingWeights.Load = 100
+	expectOrder(2, 1, 0)
+
+	// stats are removed once the AppInst has no connections or latency
+	RankStats.RemoveConn(insts[0].key)
+	RankStats.RemoveConn(insts[0].key)
+	RankStats.RemoveConn(insts[1].key)
+	RankStats.ClearLatency(insts[0].key)
+	RankStats.ClearLatency(insts[2].key)
+	require.Equal(t, 0, len(RankStats.stats))
+	expectOrder(0, 1, 2)
+}
@@ -92,6 +92,9 @@ type DmeApp struct {
 	NodeResources       *edgeproto.NodeResources
 	QosSessionProfile   string
 	QosSessionDuration  time.Duration
+	// How FindCloudlet ranks AppInsts
+	FindCloudletRanking        edgeproto.FindCloudletRanking
+	FindCloudletRankingWeights *edgeproto.FindCloudletRankingWeights
 	// Non mapped AppPorts from App definition (used for AppOfficialFqdnReply)
 	Ports []edgeproto.InstPort
 }
@@ -234,6 +237,8 @@ func AddApp(ctx context.Context, in *edgeproto.App) {
 	app.QosSessionProfile = in.QosSessionProfile.String()
 	app.QosSessionDuration = in.QosSessionDuration.TimeDuration()
 	log.SpanLog(ctx, log.DebugLevelDmedb, "QOS Priority Session values", "QosSessionProfile", app.QosSessionProfile, "QosSessionDuration", app.QosSessionDuration)
+	app.FindCloudletRanking = in.FindCloudletRanking
+	app.FindCloudletRankingWeights = in.FindCloudletRankingWeights
 	clearAutoProvStats := []string{}
 	inAP := make(map[string]struct{})
 	if in.AutoProvPolicy != "" {
@@ -458,6 +463,7 @@ func RemoveAppInst(ctx context.Context, appInst *edgeproto.AppInst) {
 			}(appinstState)
 
 			delete(app.Carriers[carrierName].Insts, appInst.Key)
+			RankStats.ClearLatency(appInst.Key)
 			log.SpanLog(ctx, log.DebugLevelDmedb, "Removing app inst",
 				"appName", appkey.Name,
 				"appVersion", appkey.Version,
@@ -876,10 +882,12 @@ type searchAppInst struct {
 	appNodeResources       *edgeproto.NodeResources
 	resultDist             float64
 	resultLimit            int
+	ranker                 FindCloudletRanker
 }
 
 type foundAppInst struct {
 	distance       float64
+	score          float64
 	AppInst        *DmeAppInst
 	appInstCarrier string
 }
@@ -917,8 +925,6 @@ func findBestForCarrier(ctx context.Context, carrierName string, key *edgeproto.
 // given the carrier, update the reply if we find a cloudlet closer
 // than the max distance.  Return the distance and whether or not response was updated
 func SearchAppInsts(ctx context.Context, carrierName string, app *DmeApp, loc *dme.Loc, carrierData map[string]*DmeAppInsts, resultLimit int, cloudletLocsByZone CloudletLocsByZone) []*foundAppInst {
-	// Eventually when we have FindCloudlet policies, we should look it
-	// up here and apply it to the search config.
 	search := searchAppInst{
 		loc:                    loc,
 		reqCarrier:             carrierName,
@@ -926,6 +932,7 @@ func SearchAppInsts(ctx context.Context, carrierName string, app *DmeApp, loc *d
 		appKubernetesResources: app.KubernetesResources,
 		appNodeResources:       app.NodeResources,
 		resultLimit:            resultLimit,
+		ranker:                 GetFindCloudletRanker(app),
 	}
 
 	for cname, carrierData := range app.Carriers {
@@ -947,15 +954,20 @@ func SearchAppInsts(ctx context.Context, carrierName string, app *DmeApp, loc *d
 			"latitude", found.AppInst.Location.Latitude,
 			"longitude", found.AppInst.Location.Longitude,
 			"distance", found.distance,
+			"score", found.score,
 			"uri", found.AppInst.Uri,
 			"IP", ipaddr.String())
 	}
 
 	if len(app.AutoProvPolicies) > 0 && cloudletLocsByZone != nil {
 		log.SpanLog(ctx, log.DebugLevelDmereq, "search AutoProvPolicy", "found", len(search.results))
+		// Results may be ranked by something other than distance,
+		// so only consider cloudlets closer than the closest result.
 		search.resultDist = InfiniteDistance
-		if len(search.results) > 0 {
-			search.resultDist = search.results[0].distance
+		for _, found := range search.results {
+			if found.distance < search.resultDist {
+				search.resultDist = found.distance
+			}
 		}
 
 		policySearches := []*policySearch{}
@@ -1045,6 +1057,9 @@ func (s *searchAppInst) searchAppInsts(ctx context.Context, carrier string, appI
 			AppInst:        i,
 			appInstCarrier: carrier,
 		}
+		if s.ranker != nil {
+			found.score = s.ranker.Score(found)
+		}
 		s.insertResult(found)
 	}
 }
@@ -1084,8 +1099,10 @@ func (s *searchAppInst) insertResult(found *foundAppInst) bool {
 }
 
 func (s *searchAppInst) less(f1, f2 *foundAppInst) bool {
-	// For now we sort by distance, but in the future
-	// we may sort by latency or some other metric.
+	// Sort by the ranking strategy's score, then by distance.
+	if f1.score != f2.score {
+		return f1.score < f2.score
+	}
 	if f1.distance == f2.distance {
 		// same cloudlet, go by name
 		return f1.AppInst.key.GetKeyString() < f2.AppInst.key.GetKeyString()
@@ -1094,10 +1111,16 @@ func (s *searchAppInst) less(f1, f2 *foundAppInst) bool {
 }
 
 func (s *searchAppInst) veryClose(f1, f2 *foundAppInst) bool {
-	if f1.distance > f2.distance {
-		return f1.distance-f2.distance < VeryCloseDistanceKm
+	v1, v2 := f1.distance, f2.distance
+	threshold := float64(VeryCloseDistanceKm)
+	if s.ranker != nil {
+		v1, v2 = f1.score, f2.score
+		threshold = s.ranker.VeryCloseScore()
+	}
+	if v1 > v2 {
+		return v1-v2 < threshold
 	}
-	return f2.distance-f1.distance < VeryCloseDistanceKm
+	return v2-v1 < threshold
 }
 
 func (s *searchAppInst) searchPolicyZones(ctx context.Context, key *edgeproto.AppKey, potential *policySearch, carrier string, list []*edgeproto.ZoneKey, cloudletLocsByZone CloudletLocsByZone) {
@@ -1453,6 +1476,9 @@ func StreamEdgeEvent(ctx context.Context, svr dme.MatchEngineApi_StreamEdgeEvent
 		EEHandler.AddClient(ctx, appInst.Key, *sessionCookieKey, *lastLocation, lastCarrier, sendFunc)
 		// Remove Client from edgeevents plugin when StreamEdgeEvent exits
 		defer EEHandler.RemoveClient(ctx, appInst.Key, *sessionCookieKey)
+		// Track active connections for load based ranking
+		RankStats.AddConn(appInst.Key)
+		defer RankStats.RemoveConn(appInst.Key)
 		// Send successful init response
 		initServerEdgeEvent := new(dme.ServerEdgeEvent)
 		initServerEdgeEvent.EventType = dme.ServerEdgeEvent_EVENT_INIT_CONNECTION
@@ -1513,12 +1539,14 @@ loop:
 				continue
 			}
 			// Process latency samples and send results to client
-			_, err = EEHandler.ProcessLatencySamples(ctx, appInst.Key, *sessionCookieKey, cupdate.Samples)
+			latencyStats, err := EEHandler.ProcessLatencySamples(ctx, appInst.Key, *sessionCookieKey, cupdate.Samples)
 			if err != nil {
 				log.SpanLog(ctx, log.DebugLevelDmereq, "ClientEdgeEvent latency unable to process latency samples", "err", err)
 				sendErrorEventToClient(ctx, fmt.Sprintf("ClientEdgeEvent latency unable to process latency samples, error is: %s", err), &appInst, *sessionCookieKey)
 				continue
 			}
+			// Latency for latency based ranking
+			RankStats.UpdateLatency(appInst.Key, latencyStats)
 			// Latency stats update
 			deviceInfoDynamic := cupdate.DeviceInfoDynamic
 			deviceInfo := &DeviceInfo{
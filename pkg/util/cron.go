@@ -0,0 +1,168 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSpec is a parsed standard 5-field cron expression:
+// minute hour day-of-month month day-of-week.
+// Each field supports "*", single values, ranges "a-b",
+// steps "*/n" or "a-b/n", and comma separated lists.
+// Day-of-week accepts 0-7 where both 0 and 7 are Sunday.
+type CronSpec struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// per cron convention, if both day-of-month and day-of-week
+	// are restricted, a time matches if either matches.
+	domStar bool
+	dowStar bool
+}
+
+type cronField struct {
+	name string
+	min  int
+	max  int
+}
+
+var cronFields = []cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day-of-month", 1, 31},
+	{"month", 1, 12},
+	{"day-of-week", 0, 7},
+}
+
+// ParseCron parses a 5-field cron expression.
+func ParseCron(spec string) (*CronSpec, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("cron expression %q must have %d fields (minute hour day-of-month month day-of-week), but has %d", spec, len(cronFields), len(parts))
+	}
+	bits := make([]uint64, len(cronFields))
+	for ii, part := range parts {
+		b, err := parseCronField(part, cronFields[ii])
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %v", spec, err)
+		}
+		bits[ii] = b
+	}
+	cs := &CronSpec{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: parts[2] == "*",
+		dowStar: parts[4] == "*",
+	}
+	// 7 is an alias for Sunday
+	if cs.dow&(1<<7) != 0 {
+		cs.dow |= 1
+	}
+	return cs, nil
+}
+
+func parseCronField(field string, cf cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangeStr, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepStr, cf.name)
+			}
+		}
+		start, end := cf.min, cf.max
+		if rangeStr != "*" {
+			startStr, endStr, isRange := strings.Cut(rangeStr, "-")
+			var err error
+			start, err = strconv.Atoi(startStr)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q in %s field", startStr, cf.name)
+			}
+			end = start
+			if isRange {
+				end, err = strconv.Atoi(endStr)
+				if err != nil {
+					return 0, fmt.Errorf("invalid value %q in %s field", endStr, cf.name)
+				}
+			} else if hasStep {
+				// "a/n" means starting at a through max
+				end = cf.max
+			}
+		}
+		if start < cf.min || end > cf.max {
+			return 0, fmt.Errorf("%s field value %q out of range %d-%d", cf.name, item, cf.min, cf.max)
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangeStr, cf.name)
+		}
+		for ii := start; ii <= end; ii += step {
+			bits |= 1 << uint(ii)
+		}
+	}
+	return bits, nil
+}
+
+// Matches returns true if the minute of the given time matches
+// the cron expression. Matching is done in the time's location.
+func (s *CronSpec) Matches(t time.Time) bool {
+	if s.minute&(1<<uint(t.Minute())) == 0 {
+		return false
+	}
+	if s.hour&(1<<uint(t.Hour())) == 0 {
+		return false
+	}
+	if s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// MatchesWithin returns true if any minute in the half-open
+// interval (start, end] matches the cron expression, with
+// matching done in the given location.
+func (s *CronSpec) MatchesWithin(start, end time.Time, loc *time.Location) bool {
+	t := start.In(loc).Truncate(time.Minute)
+	if !t.After(start) {
+		t = t.Add(time.Minute)
+	}
+	for ; !t.After(end); t = t.Add(time.Minute) {
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			// skip to the last minute of the hour
+			t = t.Add(time.Duration(59-t.Minute()) * time.Minute)
+			continue
+		}
+		if s.Matches(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 EdgeXR, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	badSpecs := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	}
+	for _, spec := range badSpecs {
+		_, err := ParseCron(spec)
+		require.NotNil(t, err, spec)
+	}
+
+	// Wednesday Jan 15 2025, 08:30 UTC
+	base := time.Date(2025, time.January, 15, 8, 30, 0, 0, time.UTC)
+	tests := []struct {
+		spec    string
+		t       time.Time
+		matches bool
+	}{
+		{"* * * * *", base, true},
+		{"30 8 * * *", base, true},
+		{"31 8 * * *", base, false},
+		{"0,15,30,45 * * * *", base, true},
+		{"*/15 * * * *", base, true},
+		{"*/20 * * * *", base, false},
+		{"10/20 * * * *", base, true},
+		{"30 6-9 * * *", base, true},
+		{"30 9-17 * * *", base, false},
+		{"30 8 * * 1-5", base, true},
+		{"30 8 * * 0,6", base, false},
+		{"30 8 * 2 *", base, false},
+		// dom or dow when both are restricted
+		{"30 8 1 * 3", base, true},
+		{"30 8 15 * 0", base, true},
+		{"30 8 1 * 0", base, false},
+		// 7 is Sunday
+		{"* * * * 7", base.AddDate(0, 0, 4), true},
+		{"* * * * 0", base.AddDate(0, 0, 4), true},
+	}
+	for _, test := range tests {
+		cs, err := ParseCron(test.spec)
+		require.Nil(t, err, test.spec)
+		require.Equal(t, test.matches, cs.Matches(test.t), test.spec)
+	}
+}
+
+func TestCronMatchesWithin(t *testing.T) {
+	cs, err := ParseCron("0 9 * * 1-5")
+	require.Nil(t, err)
+
+	// Wednesday Jan 15 2025
+	day := time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)
+	at := func(hour, min int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+	require.True(t, cs.MatchesWithin(at(8, 0), at(9, 0), time.UTC))
+	require.True(t, cs.MatchesWithin(at(6, 0), at(10, 0), time.UTC))
+	// start is exclusive
+	require.False(t, cs.MatchesWithin(at(9, 0), at(10, 0), time.UTC))
+	require.False(t, cs.MatchesWithin(at(8, 0), at(8, 59), time.UTC))
+	// Saturday
+	require.False(t, cs.MatchesWithin(at(8, 0).AddDate(0, 0, 3), at(10, 0).AddDate(0, 0, 3), time.UTC))
+
+	// evaluated in the given location, 9am Tokyo is 0:00 UTC
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.Nil(t, err)
+	require.True(t, cs.MatchesWithin(at(-1, 0), at(0, 0), tokyo))
+	require.False(t, cs.MatchesWithin(at(8, 0), at(9, 0), tokyo))
+	// half hour offset zone, 9am Kolkata is 3:30 UTC
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.Nil(t, err)
+	require.True(t, cs.MatchesWithin(at(3, 0), at(3, 30), kolkata))
+	require.False(t, cs.MatchesWithin(at(3, 0), at(3, 29), kolkata))
+}
@@ -148,6 +148,31 @@ func ValidDNSName(name string) error {
 	return nil
 }
 
+// ValidFQDN checks that the name is a fully qualified domain name
+// made up of lower case RFC1123 labels.
+func ValidFQDN(name string) error {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return fmt.Errorf("invalid FQDN, cannot be empty")
+	}
+	if len(name) > 253 {
+		return fmt.Errorf("invalid FQDN %q, cannot be longer than 253 characters", name)
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("invalid FQDN %q, must have at least two labels", name)
+	}
+	for _, label := range labels {
+		if label == "" {
+			return fmt.Errorf("invalid FQDN %q, cannot have empty labels", name)
+		}
+		if err := ValidDNSName(label); err != nil {
+			return fmt.Errorf("invalid FQDN %q, %s", name, err)
+		}
+	}
+	return nil
+}
+
 // HostnameSanitize makes a valid hostname, for which the rules
 // are the same as DNSSanitize, but it cannot end in '-' and cannot
 // be > 63 digits
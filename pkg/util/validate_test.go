@@ -242,6 +242,33 @@ func TestDNSSanitize(t *testing.T) {
 	}
 }
 
+func TestValidFQDN(t *testing.T) {
+	tests := []struct {
+		in  string
+		err string
+	}{
+		{"example.com", ""},
+		{"api.example.com.", ""},
+		{"a-1.b-2.c3", ""},
+		{"", "cannot be empty"},
+		{"localhost", "must have at least two labels"},
+		{"foo..com", "cannot have empty labels"},
+		{"Example.com", "does not allow upper case"},
+		{"foo_bar.com", "does not allow '_'"},
+		{"-foo.com", "cannot start or end with '-'"},
+		{"10.0.0.0/8", "does not allow '/'"},
+	}
+	for ii, test := range tests {
+		err := ValidFQDN(test.in)
+		if test.err == "" {
+			require.Nil(t, err, "[%d] valid test of %s", ii, test.in)
+		} else {
+			require.NotNil(t, err, "[%d] invalid test of %s", ii, test.in)
+			require.Contains(t, err.Error(), test.err, "[%d] invalid test of %s", ii, test.in)
+		}
+	}
+}
+
 func TestK8SLabelValueSanitize(t *testing.T) {
 	tests := []struct {
 		in  string
@@ -38,6 +38,18 @@ func (s *DummyServer) RemoveAppAlertPolicy(ctx context.Context, alert *edgeproto
 	return &edgeproto.Result{}, nil
 }
 
+func (s *DummyServer) PauseAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	return &edgeproto.Result{}, nil
+}
+
+func (s *DummyServer) AbortAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	return &edgeproto.Result{}, nil
+}
+
 func (s *DummyServer) ShowZonesForAppDeployment(*edgeproto.DeploymentZoneRequest, edgeproto.AppApi_ShowZonesForAppDeploymentServer) error {
 	return nil
 }
@@ -512,6 +512,48 @@ func (r *Run) AppApi_AppAutoProvPolicy(data *[]edgeproto.AppAutoProvPolicy, data
 	}
 }
 
+func (r *Run) AppApi_AppRolloutRequest(data *[]edgeproto.AppRolloutRequest, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for AppRolloutRequest", "mode", r.Mode)
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "pauseapprollout":
+			out, err := r.client.PauseAppRollout(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AppApi_AppRolloutRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppApi_AppRolloutRequest expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "resumeapprollout":
+			out, err := r.client.ResumeAppRollout(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AppApi_AppRolloutRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppApi_AppRolloutRequest expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "abortapprollout":
+			out, err := r.client.AbortAppRollout(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("AppApi_AppRolloutRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunAppApi_AppRolloutRequest expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		}
+	}
+}
+
 func (r *Run) AppApi_DeploymentZoneRequest(data *[]edgeproto.DeploymentZoneRequest, dataMap interface{}, dataOut interface{}) {
 	log.DebugLog(log.DebugLevelApi, "API for DeploymentZoneRequest", "mode", r.Mode)
 	if r.Mode == "show" {
@@ -713,6 +755,42 @@ func (s *CliClient) RemoveAppAlertPolicy(ctx context.Context, in *edgeproto.AppA
 	return &out, err
 }
 
+func (s *ApiClient) PauseAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppApiClient(s.Conn)
+	return api.PauseAppRollout(ctx, in)
+}
+
+func (s *CliClient) PauseAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "PauseAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppApiClient(s.Conn)
+	return api.ResumeAppRollout(ctx, in)
+}
+
+func (s *CliClient) ResumeAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "ResumeAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) AbortAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	api := edgeproto.NewAppApiClient(s.Conn)
+	return api.AbortAppRollout(ctx, in)
+}
+
+func (s *CliClient) AbortAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "AbortAppRollout")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
 type ZoneKeyStream interface {
 	Recv() (*edgeproto.ZoneKey, error)
 }
@@ -757,5 +835,8 @@ type AppApiClient interface {
 	RemoveAppAutoProvPolicy(ctx context.Context, in *edgeproto.AppAutoProvPolicy) (*edgeproto.Result, error)
 	AddAppAlertPolicy(ctx context.Context, in *edgeproto.AppAlertPolicy) (*edgeproto.Result, error)
 	RemoveAppAlertPolicy(ctx context.Context, in *edgeproto.AppAlertPolicy) (*edgeproto.Result, error)
+	PauseAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error)
+	ResumeAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error)
+	AbortAppRollout(ctx context.Context, in *edgeproto.AppRolloutRequest) (*edgeproto.Result, error)
 	ShowZonesForAppDeployment(ctx context.Context, in *edgeproto.DeploymentZoneRequest) ([]edgeproto.ZoneKey, error)
 }
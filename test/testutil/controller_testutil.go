@@ -59,6 +59,46 @@ func (r *Run) ControllerApi(data *[]edgeproto.Controller, dataMap interface{}, d
 	}
 }
 
+func (r *Run) ControllerApi_ControllerBackupRequest(data *[]edgeproto.ControllerBackupRequest, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for ControllerBackupRequest", "mode", r.Mode)
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "backupcontroller":
+			out, err := r.client.BackupController(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("ControllerApi_ControllerBackupRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.ControllerBackup)
+				if !ok {
+					panic(fmt.Sprintf("RunControllerApi_ControllerBackupRequest expected dataOut type *[]edgeproto.ControllerBackup, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		}
+	}
+}
+
+func (r *Run) ControllerApi_ControllerRestoreRequest(data *[]edgeproto.ControllerRestoreRequest, dataMap interface{}, dataOut interface{}) {
+	log.DebugLog(log.DebugLevelApi, "API for ControllerRestoreRequest", "mode", r.Mode)
+	for ii, objD := range *data {
+		obj := &objD
+		switch r.Mode {
+		case "restorecontroller":
+			out, err := r.client.RestoreController(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("ControllerApi_ControllerRestoreRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.Result)
+				if !ok {
+					panic(fmt.Sprintf("RunControllerApi_ControllerRestoreRequest expected dataOut type *[]edgeproto.Result, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		}
+	}
+}
+
 func (s *DummyServer) ShowController(in *edgeproto.Controller, server edgeproto.ControllerApi_ShowControllerServer) error {
 	var err error
 	obj := &edgeproto.Controller{}
@@ -118,6 +158,32 @@ func (s *CliClient) ShowController(ctx context.Context, in *edgeproto.Controller
 	return output, err
 }
 
+func (s *ApiClient) BackupController(ctx context.Context, in *edgeproto.ControllerBackupRequest) (*edgeproto.ControllerBackup, error) {
+	api := edgeproto.NewControllerApiClient(s.Conn)
+	return api.BackupController(ctx, in)
+}
+
+func (s *CliClient) BackupController(ctx context.Context, in *edgeproto.ControllerBackupRequest) (*edgeproto.ControllerBackup, error) {
+	out := edgeproto.ControllerBackup{}
+	args := append(s.BaseArgs, "controller", "BackupController")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) RestoreController(ctx context.Context, in *edgeproto.ControllerRestoreRequest) (*edgeproto.Result, error) {
+	api := edgeproto.NewControllerApiClient(s.Conn)
+	return api.RestoreController(ctx, in)
+}
+
+func (s *CliClient) RestoreController(ctx context.Context, in *edgeproto.ControllerRestoreRequest) (*edgeproto.Result, error) {
+	out := edgeproto.Result{}
+	args := append(s.BaseArgs, "controller", "RestoreController")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
 type ControllerApiClient interface {
 	ShowController(ctx context.Context, in *edgeproto.Controller) ([]edgeproto.Controller, error)
+	BackupController(ctx context.Context, in *edgeproto.ControllerBackupRequest) (*edgeproto.ControllerBackup, error)
+	RestoreController(ctx context.Context, in *edgeproto.ControllerRestoreRequest) (*edgeproto.Result, error)
 }
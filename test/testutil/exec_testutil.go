@@ -60,6 +60,28 @@ func (r *Run) ExecApi_ExecRequest(data *[]edgeproto.ExecRequest, dataMap interfa
 				}
 				*outp = append(*outp, *out)
 			}
+		case "runcopyfile":
+			out, err := r.client.RunCopyFile(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("ExecApi_ExecRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.ExecRequest)
+				if !ok {
+					panic(fmt.Sprintf("RunExecApi_ExecRequest expected dataOut type *[]edgeproto.ExecRequest, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
+		case "runportforward":
+			out, err := r.client.RunPortForward(r.ctx, obj)
+			if err != nil {
+				r.logErr(fmt.Sprintf("ExecApi_ExecRequest[%d]", ii), err)
+			} else {
+				outp, ok := dataOut.(*[]edgeproto.ExecRequest)
+				if !ok {
+					panic(fmt.Sprintf("RunExecApi_ExecRequest expected dataOut type *[]edgeproto.ExecRequest, but was %T", dataOut))
+				}
+				*outp = append(*outp, *out)
+			}
 		case "accesscloudlet":
 			out, err := r.client.AccessCloudlet(r.ctx, obj)
 			if err != nil {
@@ -122,6 +144,30 @@ func (s *CliClient) ShowLogs(ctx context.Context, in *edgeproto.ExecRequest) (*e
 	return &out, err
 }
 
+func (s *ApiClient) RunCopyFile(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
+	api := edgeproto.NewExecApiClient(s.Conn)
+	return api.RunCopyFile(ctx, in)
+}
+
+func (s *CliClient) RunCopyFile(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
+	out := edgeproto.ExecRequest{}
+	args := append(s.BaseArgs, "controller", "RunCopyFile")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
+func (s *ApiClient) RunPortForward(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
+	api := edgeproto.NewExecApiClient(s.Conn)
+	return api.RunPortForward(ctx, in)
+}
+
+func (s *CliClient) RunPortForward(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
+	out := edgeproto.ExecRequest{}
+	args := append(s.BaseArgs, "controller", "RunPortForward")
+	err := wrapper.RunEdgectlObjs(args, in, &out, s.RunOps...)
+	return &out, err
+}
+
 func (s *ApiClient) AccessCloudlet(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error) {
 	api := edgeproto.NewExecApiClient(s.Conn)
 	return api.AccessCloudlet(ctx, in)
@@ -150,6 +196,8 @@ type ExecApiClient interface {
 	RunCommand(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error)
 	RunConsole(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error)
 	ShowLogs(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error)
+	RunCopyFile(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error)
+	RunPortForward(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error)
 	AccessCloudlet(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error)
 	SendLocalRequest(ctx context.Context, in *edgeproto.ExecRequest) (*edgeproto.ExecRequest, error)
 }
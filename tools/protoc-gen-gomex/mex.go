@@ -799,6 +799,31 @@ func (m *mex) generateFields(names, nums []string, desc *generator.Descriptor) {
 	}
 }
 
+func (m *mex) generateTableColumns(desc *generator.Descriptor) {
+	message := desc.DescriptorProto
+	columns := GetTableColumns(message)
+	if columns == "" {
+		return
+	}
+	cols := []string{}
+	for _, col := range strings.Split(columns, ",") {
+		col = strings.TrimSpace(col)
+		names := []string{}
+		for _, name := range strings.Split(col, ".") {
+			names = append(names, generator.CamelCase(name))
+		}
+		if _, _, err := gensupport.FindHierField(m.gen, message, strings.Join(names, ".")); err != nil {
+			m.gen.Fail("invalid table column", col, "for", *message.Name, err.Error())
+		}
+		cols = append(cols, fmt.Sprintf("%q", col))
+	}
+	m.P("// TableColumns are the default columns for table output")
+	m.P("func (m *", message.Name, ") TableColumns() []string {")
+	m.P("return []string{", strings.Join(cols, ", "), "}")
+	m.P("}")
+	m.P("")
+}
+
 func (m *mex) markDiff(names []string, name string) {
 	// set field and all parent fields
 	names = append(names, name)
@@ -2816,6 +2841,8 @@ func (m *mex) generateMessage(file *generator.FileDescriptor, desc *generator.De
 		}
 	}
 
+	m.generateTableColumns(desc)
+
 	if desc.GetOptions().GetMapEntry() {
 		return
 	}
@@ -3820,6 +3847,10 @@ func GetSingularData(message *descriptor.DescriptorProto) bool {
 	return proto.GetBoolExtension(message.Options, protogen.E_SingularData, false)
 }
 
+func GetTableColumns(message *descriptor.DescriptorProto) string {
+	return gensupport.GetStringExtension(message.Options, protogen.E_TableColumns, "")
+}
+
 func GetBackend(field *descriptor.FieldDescriptorProto) bool {
 	return proto.GetBoolExtension(field.Options, protogen.E_Backend, false)
 }
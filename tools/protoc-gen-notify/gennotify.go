@@ -146,6 +146,7 @@ var tmpl = `
 type Send{{.Name}}Handler interface {
 	GetAllLocked(ctx context.Context, cb func(key *{{.NameType}}, modRev int64))
 	GetWithRev(key *{{.KeyType}}, buf *{{.NameType}}, modRev *int64) bool
+	HasKey(key *{{.KeyType}}) bool
 {{- if .FilterCloudletKey}}
 	GetForCloudlet(cloudlet *edgeproto.Cloudlet, cb func(data *{{.NameType}}CacheData))
 {{- end}}
@@ -177,6 +178,7 @@ type {{.Name}}Send struct {
 	handler Send{{.Name}}Handler
 	Keys map[{{.KeyType}}]{{.Name}}SendContext
 	keysToSend map[{{.KeyType}}]{{.Name}}SendContext
+	history *SyncHistory[{{.KeyType}}]
 {{- else}}
 	Data []*{{.NameType}}
 	dataToSend []*{{.NameType}}
@@ -253,6 +255,41 @@ func (s *{{.Name}}Send) UpdateAll(ctx context.Context) {
 	s.Mux.Unlock()
 }
 
+func (s *{{.Name}}Send) HasSyncHistory(rev int64) bool {
+	return s.history != nil && s.history.HasHistory(rev)
+}
+
+func (s *{{.Name}}Send) UpdateSince(ctx context.Context, rev int64) {
+	if !s.sendrecv.isRemoteWanted(s.MessageName) {
+		return
+	}
+	s.Mux.Lock()
+	s.handler.GetAllLocked(ctx, func(obj *{{.NameType}}, modRev int64) {
+		if rev >= modRev {
+			return
+		}
+{{- if .CustomUpdate}}
+		if !s.UpdateAllOkLocked(obj) { // to be implemented by hand
+			return
+		}
+{{- end}}
+		s.Keys[*obj.GetKey()] = {{.Name}}SendContext{
+			ctx: ctx,
+			modRev: modRev,
+		}
+	})
+	s.history.GetDeletedSince(rev, func(key {{.KeyType}}, delRev int64) {
+		if _, found := s.Keys[key]; found {
+			return
+		}
+		s.Keys[key] = {{.Name}}SendContext{
+			ctx: ctx,
+			modRev: delRev,
+		}
+	})
+	s.Mux.Unlock()
+}
+
 func (s *{{.Name}}Send) Update(ctx context.Context, obj *{{.NameType}}, modRev int64) {
 	if !s.sendrecv.isRemoteWanted(s.MessageName) {
 		return
@@ -339,8 +376,23 @@ func (s *{{.Name}}Send) Send(stream StreamNotify, notice *edgeproto.Notice, peer
 	s.Mux.Unlock()
 
 {{- if .Cache}}
+	// All changes up to the highest queued revision are being sent,
+	// which the client can use to request an incremental sync.
+	var syncRev int64
+	if s.history != nil {
+		for _, sendContext := range keys {
+			if sendContext.modRev > syncRev {
+				syncRev = sendContext.modRev
+			}
+		}
+	}
+	sent := 0
 	for key, sendContext := range keys {
 		ctx := sendContext.ctx
+		sent++
+		if sent == len(keys) {
+			notice.SyncRev = syncRev
+		}
 		found := s.handler.GetWithRev(&key, &s.buf, &notice.ModRev)
 		if found && !sendContext.forceDelete {
 			notice.Action = edgeproto.NoticeAction_UPDATE
@@ -415,6 +467,7 @@ func (s *{{.Name}}Send) PrepData() bool {
 type {{.Name}}SendMany struct {
 {{- if .Cache}}
 	handler Send{{.Name}}Handler
+	history SyncHistory[{{.KeyType}}]
 {{- end}}
 	Mux sync.Mutex
 	sends map[string]*{{.Name}}Send
@@ -436,6 +489,7 @@ func New{{.Name}}SendMany() *{{.Name}}SendMany {
 func (s *{{.Name}}SendMany) NewSend(peerAddr string, notifyId int64) NotifySend {
 {{- if .Cache}}
 	send := New{{.Name}}Send(s.handler)
+	send.history = &s.history
 {{- else}}
 	send := New{{.Name}}Send()
 {{- end}}
@@ -462,6 +516,13 @@ func (s *{{.Name}}SendMany) DoneSend(peerAddr string, send NotifySend) {
 
 {{- if .Cache}}
 func (s *{{.Name}}SendMany) Update(ctx context.Context, obj *{{.NameType}}, modRev int64) {
+	if s.history.IsEnabled() {
+		if s.handler.HasKey(obj.GetKey()) {
+			s.history.Updated(*obj.GetKey())
+		} else {
+			s.history.Deleted(*obj.GetKey(), modRev)
+		}
+	}
 	s.Mux.Lock()
 	defer s.Mux.Unlock()
 	for _, send := range s.sends {
@@ -501,6 +562,21 @@ func (s *{{.Name}}SendMany) GetTypeString() string {
 	return "{{.Name}}"
 }
 
+{{- if .Cache}}
+
+func (s *{{.Name}}SendMany) GetMessageName() string {
+	return proto.MessageName((*{{.NameType}})(nil))
+}
+
+func (s *{{.Name}}SendMany) SetSyncHistoryStartRev(rev int64) {
+	s.history.SetStartRev(rev)
+}
+
+func (s *{{.Name}}SendMany) HasSyncHistory(rev int64) bool {
+	return s.history.HasHistory(rev)
+}
+{{- end}}
+
 type {{.Name}}Recv struct {
 	Name string
 	MessageName string
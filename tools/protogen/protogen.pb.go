@@ -363,6 +363,15 @@ var E_CreateOverwritesDups = &proto.ExtensionDesc{
 	Filename:      "protogen.proto",
 }
 
+var E_TableColumns = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         51068,
+	Name:          "protogen.table_columns",
+	Tag:           "bytes,51068,opt,name=table_columns",
+	Filename:      "protogen.proto",
+}
+
 var E_VersionHash = &proto.ExtensionDesc{
 	ExtendedType:  (*descriptor.EnumOptions)(nil),
 	ExtensionType: (*bool)(nil),
@@ -654,6 +663,7 @@ func init() {
 	proto.RegisterExtension(E_ParentObjName)
 	proto.RegisterExtension(E_StringKeyField)
 	proto.RegisterExtension(E_CreateOverwritesDups)
+	proto.RegisterExtension(E_TableColumns)
 	proto.RegisterExtension(E_VersionHash)
 	proto.RegisterExtension(E_VersionHashSalt)
 	proto.RegisterExtension(E_CommonPrefix)
@@ -687,103 +697,104 @@ func init() {
 func init() { proto.RegisterFile("protogen.proto", fileDescriptor_f3d59d67231a6957) }
 
 var fileDescriptor_f3d59d67231a6957 = []byte{
-	// 1561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x49, 0x6f, 0x1c, 0xb9,
-	0x15, 0x80, 0x21, 0x04, 0xb1, 0x64, 0x6a, 0xb3, 0x5a, 0xf2, 0x12, 0x27, 0x51, 0x9c, 0x5b, 0x4e,
-	0x32, 0xa0, 0x1c, 0x0c, 0x33, 0x8e, 0x91, 0xb6, 0x64, 0xc5, 0xb2, 0x6c, 0xc9, 0x56, 0xcb, 0x76,
-	0xe0, 0x04, 0x61, 0xd8, 0x2c, 0x76, 0x37, 0xd5, 0x55, 0x64, 0x85, 0x64, 0x49, 0x29, 0xff, 0x88,
-	0x1c, 0xf3, 0x03, 0xf2, 0x3f, 0xb2, 0xce, 0xe6, 0xd9, 0x3d, 0xfb, 0x78, 0x56, 0x43, 0xb3, 0x7b,
-	0x30, 0xfb, 0x82, 0x59, 0x2e, 0x03, 0xb2, 0xc8, 0xea, 0xd6, 0x02, 0xb0, 0x74, 0x53, 0x4b, 0xf5,
-	0x7d, 0x5d, 0xef, 0xf1, 0xf1, 0xf1, 0x51, 0x60, 0x2c, 0x95, 0x42, 0x8b, 0x36, 0xe5, 0x33, 0xf6,
-	0x87, 0xda, 0x90, 0xff, 0x7c, 0xfc, 0x44, 0x5b, 0x88, 0x76, 0x4c, 0x4f, 0xda, 0x5f, 0x34, 0xb3,
-	0xd6, 0xc9, 0x88, 0x2a, 0x22, 0x59, 0xaa, 0x85, 0x2c, 0x9e, 0x85, 0x97, 0xc0, 0xa1, 0x36, 0xe5,
-	0x54, 0x62, 0x4d, 0x51, 0x82, 0x35, 0xe9, 0x50, 0x55, 0xfb, 0xc5, 0x4c, 0x81, 0xcd, 0x78, 0x6c,
-	0xe6, 0x32, 0x55, 0x0a, 0xb7, 0xe9, 0x4a, 0xaa, 0x99, 0xe0, 0xea, 0xd8, 0xbf, 0xfe, 0xfe, 0xa3,
-	0x13, 0x03, 0xbf, 0x1a, 0x5a, 0x1d, 0xf7, 0xe8, 0xe5, 0x82, 0x84, 0xf3, 0x60, 0xa4, 0xb4, 0x91,
-	0x2c, 0x0a, 0x9b, 0xfe, 0xed, 0x4c, 0xc3, 0x1e, 0x9b, 0xcb, 0x22, 0x08, 0xc1, 0xa0, 0x68, 0xae,
-	0xa3, 0x2e, 0xcd, 0xc3, 0x82, 0xff, 0x38, 0xc1, 0x01, 0xd1, 0x5c, 0x5f, 0xa2, 0x39, 0xbc, 0x00,
-	0xc6, 0x7a, 0x6f, 0x80, 0x49, 0x87, 0x86, 0x15, 0xff, 0x75, 0x8a, 0xd1, 0xf2, 0x1d, 0x0c, 0x67,
-	0x62, 0xe1, 0x42, 0xb3, 0x56, 0x5e, 0xd5, 0xf3, 0x7f, 0x1f, 0x4b, 0x81, 0x15, 0x96, 0xcb, 0x60,
-	0xa2, 0x3f, 0x23, 0x48, 0x53, 0xa5, 0xc3, 0xaa, 0x07, 0x76, 0x26, 0x78, 0x2e, 0x8b, 0xd6, 0xa8,
-	0xd2, 0x70, 0x05, 0xd4, 0x4a, 0x9d, 0xea, 0x88, 0xcd, 0x8a, 0xbe, 0x07, 0x9d, 0xaf, 0x5c, 0xeb,
-	0x46, 0x47, 0x6c, 0x5a, 0xe1, 0x0d, 0x70, 0x64, 0xdb, 0xfb, 0x29, 0x2d, 0x29, 0x4e, 0x44, 0x56,
-	0x41, 0xfa, 0xb0, 0x93, 0x4e, 0xf5, 0xbd, 0x64, 0xc3, 0xe3, 0xf0, 0x0f, 0xe0, 0x68, 0x29, 0xde,
-	0xc4, 0x4c, 0xa3, 0x96, 0x90, 0x48, 0x69, 0xac, 0x2b, 0x64, 0xf2, 0x11, 0x6b, 0x3e, 0xd8, 0x33,
-	0xdf, 0xc0, 0x4c, 0x2f, 0x08, 0xd9, 0x30, 0xb8, 0x59, 0x62, 0xb7, 0x30, 0x49, 0x81, 0x85, 0x85,
-	0xb7, 0xfd, 0x12, 0x17, 0xa0, 0xfb, 0x2b, 0x6c, 0x80, 0x29, 0xbf, 0xc4, 0x99, 0xd2, 0x22, 0x41,
-	0x59, 0x1a, 0x55, 0x7a, 0xc1, 0x47, 0x9d, 0xaf, 0xe6, 0x96, 0xda, 0xd2, 0xd7, 0x2c, 0x0c, 0x97,
-	0xc0, 0x21, 0x27, 0x95, 0x94, 0x6c, 0xa0, 0x8e, 0x10, 0xdd, 0xb0, 0xf0, 0x31, 0x27, 0x74, 0x91,
-	0xad, 0x52, 0xb2, 0x71, 0x41, 0x88, 0x2e, 0xfc, 0x33, 0x38, 0xee, 0x64, 0x2d, 0x16, 0x6b, 0x2a,
-	0x11, 0x89, 0x45, 0x16, 0xc5, 0x54, 0x57, 0xdb, 0x1d, 0x8f, 0x3b, 0xed, 0xd1, 0x42, 0xb2, 0x60,
-	0x1d, 0x73, 0x4e, 0x61, 0xb6, 0x4b, 0xaf, 0xc8, 0x5b, 0x71, 0xa6, 0x3a, 0x61, 0xe3, 0x13, 0xdb,
-	0x8b, 0x7c, 0xc1, 0x50, 0xf0, 0x3a, 0x38, 0xe2, 0x2c, 0xa9, 0x64, 0x5c, 0x23, 0x45, 0x79, 0x64,
-	0xa3, 0x0f, 0xfb, 0x9e, 0x72, 0xbe, 0xc9, 0x42, 0x70, 0xc5, 0xf0, 0x0d, 0xca, 0x23, 0x93, 0x01,
-	0xf8, 0x5b, 0x30, 0xc4, 0x05, 0x11, 0xbc, 0xc5, 0xda, 0x61, 0xd3, 0xb3, 0xae, 0x68, 0x4a, 0x04,
-	0x9e, 0x02, 0x3f, 0xc6, 0x31, 0xc3, 0x15, 0x1a, 0xda, 0x73, 0x8e, 0x2d, 0x9e, 0x77, 0x59, 0x41,
-	0x92, 0xfe, 0x35, 0x63, 0x92, 0x56, 0x68, 0x63, 0xcf, 0x3b, 0xde, 0x64, 0x65, 0xd5, 0x51, 0xf0,
-	0x26, 0x38, 0xb6, 0x6b, 0xeb, 0x57, 0xae, 0xb0, 0x17, 0x5d, 0x5e, 0x0e, 0xef, 0xe8, 0x00, 0xae,
-	0xc8, 0xae, 0x82, 0xc9, 0xd2, 0x8d, 0xa3, 0x48, 0x26, 0x15, 0x1b, 0xc1, 0x4b, 0x4e, 0x5b, 0x36,
-	0xa5, 0xba, 0x81, 0x6d, 0x27, 0xa8, 0x83, 0x61, 0xd3, 0x75, 0x31, 0x8f, 0xaa, 0xd5, 0xd6, 0xcb,
-	0x4e, 0x75, 0x50, 0x34, 0xd7, 0xeb, 0x3c, 0x32, 0xd5, 0xb4, 0x00, 0x46, 0x71, 0xac, 0xc4, 0x3e,
-	0x12, 0x77, 0xd7, 0x25, 0x6e, 0xc4, 0x70, 0x65, 0xe6, 0x16, 0xc1, 0x78, 0x42, 0x66, 0x91, 0xc6,
-	0xb2, 0x4d, 0x35, 0xba, 0x25, 0x78, 0x85, 0x84, 0xbd, 0xea, 0x4c, 0xa3, 0x09, 0x99, 0x5d, 0xb3,
-	0xe0, 0x4d, 0xc1, 0xa9, 0x51, 0xb9, 0xbd, 0xdd, 0xa5, 0x39, 0xd2, 0x79, 0x5a, 0x41, 0xf5, 0x86,
-	0x57, 0x15, 0xe4, 0x12, 0xcd, 0xd7, 0xf2, 0x94, 0x9a, 0xe8, 0x14, 0xe3, 0xed, 0x2c, 0xc6, 0x12,
-	0x45, 0x58, 0xe3, 0xb0, 0xe8, 0x4d, 0x97, 0xa2, 0x11, 0xcf, 0xcd, 0x63, 0x8d, 0xe1, 0x6f, 0xc0,
-	0x20, 0x9d, 0xa5, 0xd5, 0x0c, 0x6f, 0x39, 0x83, 0x27, 0xb6, 0xb5, 0x55, 0x22, 0xd2, 0x1c, 0x31,
-	0x8e, 0x5a, 0x8c, 0xc6, 0x51, 0x85, 0x2a, 0x7f, 0x7b, 0x57, 0xc3, 0x16, 0x69, 0xbe, 0xc8, 0x17,
-	0x2c, 0x0e, 0xcf, 0x80, 0xa1, 0x4c, 0x51, 0x85, 0x84, 0xac, 0xb0, 0xd9, 0xde, 0x75, 0x29, 0x1a,
-	0x34, 0xc8, 0x8a, 0x6c, 0x9b, 0x46, 0x55, 0xbe, 0x57, 0x2c, 0x44, 0x37, 0x4b, 0x51, 0x33, 0x47,
-	0x2a, 0x6b, 0xc6, 0xac, 0x4a, 0x5d, 0xbe, 0xef, 0x7c, 0x65, 0x70, 0x97, 0xac, 0xe3, 0x5c, 0xde,
-	0x28, 0x0c, 0xf0, 0x2f, 0xe0, 0xa7, 0x7b, 0xfb, 0x6d, 0xf0, 0xe1, 0x2f, 0xf8, 0xc0, 0x7d, 0xc1,
-	0xb1, 0x3d, 0xbe, 0xc0, 0x2a, 0x4c, 0xdf, 0x66, 0x6d, 0x2e, 0x24, 0x45, 0x92, 0xb6, 0xa8, 0x54,
-	0x48, 0x8b, 0xb0, 0xf6, 0x63, 0xdf, 0xb7, 0x0b, 0x74, 0xd5, 0x92, 0x6b, 0xc2, 0xc8, 0xb4, 0xc4,
-	0xa4, 0xab, 0xf6, 0x23, 0xfb, 0xc4, 0xcb, 0x0a, 0xb4, 0x94, 0x5d, 0x03, 0x87, 0x89, 0xe0, 0x5a,
-	0x8a, 0x38, 0xa6, 0x12, 0xe1, 0x94, 0x99, 0x53, 0x3a, 0x23, 0x15, 0xd2, 0xfa, 0xa9, 0x8b, 0x7a,
-	0xb2, 0xc7, 0xd7, 0x53, 0xd6, 0xb0, 0x34, 0x5c, 0x06, 0x35, 0x5f, 0x41, 0x38, 0x8e, 0x2b, 0x57,
-	0xd1, 0xe7, 0x7e, 0x36, 0x21, 0xb6, 0x7a, 0xea, 0x71, 0xec, 0x0a, 0xa8, 0xbf, 0x27, 0x15, 0x63,
-	0x44, 0xb5, 0x46, 0xf2, 0xe5, 0xce, 0x9e, 0x54, 0x0c, 0x11, 0xa6, 0xa1, 0x2c, 0x82, 0xf1, 0x14,
-	0x4b, 0xca, 0x35, 0x32, 0xad, 0x89, 0xe3, 0xa4, 0xc2, 0xee, 0xfd, 0xda, 0xef, 0xde, 0x82, 0x5c,
-	0x69, 0xae, 0x2f, 0xe3, 0xc4, 0x1e, 0xcb, 0x4a, 0x4b, 0xc6, 0xdb, 0xb6, 0x11, 0x54, 0xac, 0x9a,
-	0x6f, 0x9c, 0x6b, 0xac, 0x40, 0x97, 0x68, 0x6e, 0x63, 0x35, 0x53, 0x13, 0x91, 0xd4, 0x04, 0x2a,
-	0x36, 0xa8, 0xdc, 0x94, 0x4c, 0x53, 0x85, 0xa2, 0x2c, 0xad, 0x90, 0xbe, 0xef, 0xfc, 0x26, 0x2c,
-	0x04, 0x2b, 0x25, 0x3f, 0x9f, 0xa5, 0x0a, 0xd6, 0xc1, 0xc8, 0x06, 0x95, 0x8a, 0x09, 0x8e, 0x3a,
-	0x58, 0x75, 0x6a, 0x3f, 0xdb, 0xa5, 0x3b, 0xcf, 0xb3, 0xc4, 0xbb, 0x9e, 0xf6, 0x87, 0xb1, 0x63,
-	0x2e, 0x60, 0xd5, 0x81, 0x17, 0xc1, 0x44, 0xbf, 0x02, 0x29, 0x1c, 0xeb, 0x80, 0xe7, 0x8e, 0x0b,
-	0x73, 0xbc, 0xcf, 0xd3, 0xc0, 0xb1, 0x86, 0x73, 0x60, 0x94, 0x88, 0x24, 0x11, 0x1c, 0xa5, 0x92,
-	0xb6, 0xd8, 0xdf, 0x02, 0x9e, 0x0f, 0x7d, 0x37, 0x2f, 0xa0, 0x2b, 0x96, 0x81, 0x0b, 0x60, 0x24,
-	0x4b, 0xdb, 0x12, 0x47, 0x14, 0xb5, 0x32, 0x4e, 0x6a, 0xbf, 0xdc, 0xd3, 0x71, 0x1d, 0xc7, 0x59,
-	0x99, 0xa4, 0x67, 0xfc, 0x79, 0xea, 0xc0, 0x85, 0x8c, 0x13, 0xf8, 0x3b, 0x30, 0xdc, 0x7f, 0x84,
-	0xfe, 0x7c, 0x97, 0xc6, 0xae, 0x8d, 0x57, 0xfc, 0xcf, 0xe5, 0x06, 0xe8, 0xde, 0xa9, 0x79, 0x1a,
-	0x0c, 0x36, 0x31, 0xe9, 0x52, 0x1e, 0x85, 0xe8, 0x87, 0x7c, 0xdf, 0x75, 0xcf, 0x1b, 0xb4, 0xc3,
-	0x22, 0xaa, 0x71, 0x3b, 0x84, 0xfe, 0xf3, 0x1f, 0xae, 0x35, 0xba, 0xe7, 0xe1, 0x29, 0x70, 0xa0,
-	0x4b, 0xf3, 0x0a, 0xe4, 0x7b, 0x2e, 0x6a, 0xf7, 0x38, 0xfc, 0x13, 0x38, 0xae, 0xba, 0x2c, 0x45,
-	0xc5, 0x47, 0x64, 0xa6, 0x9a, 0x98, 0x11, 0x8d, 0x48, 0x87, 0x92, 0x6e, 0x48, 0x76, 0xdf, 0x8f,
-	0x7e, 0x46, 0xb1, 0x64, 0x0d, 0x73, 0x4e, 0x30, 0x67, 0x78, 0x78, 0x06, 0x1c, 0xec, 0xf5, 0xa6,
-	0x80, 0xec, 0x23, 0x3f, 0x5b, 0x49, 0xdf, 0x93, 0xce, 0x83, 0xb1, 0x5e, 0x83, 0x53, 0xa8, 0x99,
-	0x87, 0x14, 0x9f, 0xf9, 0xda, 0x28, 0x9b, 0x9b, 0x3a, 0x97, 0xc3, 0xb3, 0x00, 0x48, 0x1a, 0x31,
-	0x85, 0x04, 0x8f, 0x83, 0x8a, 0x2f, 0xfc, 0xc4, 0x61, 0x91, 0x15, 0x1e, 0xe7, 0x66, 0x59, 0xcc,
-	0xa4, 0x80, 0x53, 0x56, 0x9b, 0xde, 0x63, 0xe7, 0xe9, 0x8e, 0x28, 0xe9, 0x27, 0x7d, 0x76, 0x13,
-	0x32, 0x5b, 0x4f, 0x99, 0x19, 0x5a, 0x5d, 0x97, 0x12, 0x99, 0x46, 0x8c, 0x13, 0x49, 0x13, 0xca,
-	0x35, 0x8e, 0x83, 0xa6, 0x17, 0xfc, 0x16, 0x2e, 0xf8, 0x95, 0x4c, 0x2f, 0xf6, 0x68, 0xf8, 0x7b,
-	0x30, 0xc6, 0x78, 0x9a, 0xf5, 0x8d, 0x8f, 0x21, 0xdf, 0x5d, 0x7f, 0x3b, 0xb1, 0x5c, 0x39, 0x05,
-	0x5d, 0x04, 0x87, 0x4c, 0x6c, 0x6e, 0x7c, 0xc1, 0x99, 0xee, 0xdc, 0x0a, 0xaa, 0x5e, 0xf1, 0x47,
-	0x48, 0x42, 0x66, 0x8b, 0x5b, 0x49, 0xdd, 0x70, 0x76, 0xa2, 0xb2, 0x0f, 0xa2, 0x72, 0xa0, 0x0e,
-	0xa9, 0xfc, 0x40, 0x35, 0x56, 0x80, 0xcb, 0x7e, 0xaa, 0x5e, 0x05, 0x53, 0x4e, 0xb5, 0x7d, 0xd6,
-	0x0b, 0xf9, 0x5e, 0x73, 0xbe, 0x5a, 0x41, 0xd7, 0xfb, 0x07, 0xbe, 0x2b, 0x60, 0xb2, 0x7c, 0xbd,
-	0x7d, 0x24, 0xee, 0x75, 0xa7, 0x9c, 0xf0, 0xaf, 0xd8, 0x4b, 0xde, 0x25, 0x30, 0xc1, 0x05, 0x37,
-	0x17, 0x4e, 0x1e, 0x61, 0x19, 0xd9, 0xcb, 0x72, 0xd0, 0x77, 0xcf, 0x1f, 0x6d, 0x5c, 0xf0, 0x86,
-	0x23, 0xcd, 0x4d, 0xd9, 0x1c, 0x95, 0xae, 0xcc, 0x50, 0x71, 0x4f, 0x91, 0x42, 0xe8, 0xa0, 0x6e,
-	0xcb, 0xdf, 0xba, 0x8b, 0x8a, 0x5b, 0x2e, 0x49, 0x78, 0x15, 0x4c, 0x79, 0x9f, 0x0b, 0xb6, 0x98,
-	0xbb, 0x42, 0xc6, 0x77, 0xca, 0x80, 0xad, 0xd1, 0x45, 0x6b, 0x07, 0xb0, 0xd3, 0x60, 0x90, 0xc4,
-	0x0c, 0x91, 0x24, 0x9c, 0xb6, 0xfb, 0x7e, 0x27, 0x90, 0x98, 0xcd, 0x25, 0x11, 0xfc, 0x23, 0xf8,
-	0x49, 0x5f, 0xa1, 0x6d, 0xe0, 0x98, 0x99, 0x6e, 0x89, 0x6c, 0x35, 0x06, 0x65, 0x5f, 0xb9, 0x20,
-	0x8f, 0x94, 0x15, 0x77, 0xdd, 0x09, 0x16, 0x0d, 0x0f, 0x1b, 0xe0, 0xb0, 0x91, 0xdb, 0x7f, 0x56,
-	0xec, 0xab, 0x94, 0xbf, 0xf7, 0x77, 0xec, 0x84, 0xcc, 0x9a, 0x45, 0xe8, 0x2f, 0xe7, 0x79, 0x30,
-	0x12, 0x65, 0x49, 0x92, 0x23, 0x45, 0xe5, 0x06, 0x95, 0x7b, 0x9c, 0xba, 0x0d, 0x2a, 0x37, 0x18,
-	0xd9, 0x71, 0x39, 0x1c, 0x5a, 0x1d, 0xb6, 0x58, 0xc3, 0x52, 0xc6, 0xc2, 0xb8, 0xa6, 0x92, 0xe3,
-	0xd8, 0x76, 0x90, 0xa0, 0xe5, 0x5b, 0x6f, 0xf1, 0x98, 0xe9, 0x23, 0x67, 0x41, 0xd1, 0x8f, 0xaa,
-	0x29, 0xfc, 0xf1, 0x3f, 0x64, 0x99, 0x7a, 0xca, 0xce, 0x8d, 0xdc, 0xde, 0x9a, 0x1e, 0xb8, 0xb3,
-	0x35, 0x3d, 0x70, 0x6f, 0x6b, 0x7a, 0xa0, 0x79, 0xc0, 0x82, 0xbf, 0xfe, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0xbd, 0xe3, 0xf6, 0x9b, 0xd4, 0x13, 0x00, 0x00,
+	// 1580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x59, 0x73, 0x14, 0xb7,
+	0x16, 0x80, 0xcb, 0x75, 0xeb, 0x62, 0x23, 0x6f, 0x78, 0x6c, 0x96, 0xcb, 0xbd, 0xd7, 0x97, 0xfb,
+	0x96, 0x27, 0x53, 0xe5, 0x3c, 0x50, 0x74, 0x08, 0x95, 0x61, 0x8c, 0x83, 0x31, 0xd8, 0xe0, 0x31,
+	0x90, 0x22, 0xa9, 0x28, 0x1a, 0xb5, 0x66, 0x46, 0x9e, 0x6e, 0xa9, 0x23, 0xa9, 0xed, 0x34, 0x3f,
+	0x22, 0x8f, 0xf9, 0x01, 0xf9, 0x1f, 0xd9, 0x37, 0xb2, 0x93, 0x3d, 0x64, 0xa5, 0x9c, 0x9d, 0x54,
+	0xf6, 0xa5, 0xb2, 0x3d, 0xa4, 0xa4, 0x96, 0x7a, 0xc6, 0x4b, 0x95, 0xda, 0x6f, 0x18, 0xfa, 0xfb,
+	0xac, 0x73, 0x74, 0x74, 0x74, 0x04, 0x18, 0x49, 0x04, 0x57, 0xbc, 0x45, 0xd8, 0x94, 0xf9, 0x43,
+	0x65, 0xc0, 0xfd, 0x7c, 0xf0, 0x50, 0x8b, 0xf3, 0x56, 0x44, 0x0e, 0x9b, 0xbf, 0x68, 0xa4, 0xcd,
+	0xc3, 0x21, 0x91, 0x58, 0xd0, 0x44, 0x71, 0x91, 0x7f, 0x1b, 0x9c, 0x01, 0x7b, 0x5a, 0x84, 0x11,
+	0x81, 0x14, 0x81, 0x31, 0x52, 0xb8, 0x4d, 0x64, 0xe5, 0x7f, 0x53, 0x39, 0x36, 0xe5, 0xb0, 0xa9,
+	0xb3, 0x44, 0x4a, 0xd4, 0x22, 0x8b, 0x89, 0xa2, 0x9c, 0xc9, 0x03, 0x8f, 0x3c, 0xf8, 0x8f, 0x43,
+	0x7d, 0xb7, 0x0c, 0x2c, 0x8d, 0x3a, 0xf4, 0x6c, 0x4e, 0x06, 0x33, 0x60, 0xa8, 0xb0, 0xe1, 0x34,
+	0xf4, 0x9b, 0x1e, 0xb5, 0xa6, 0x41, 0x87, 0xd5, 0xd2, 0x30, 0x08, 0x40, 0x3f, 0x6f, 0xac, 0xc0,
+	0x0e, 0xc9, 0xfc, 0x82, 0xc7, 0xac, 0x60, 0x17, 0x6f, 0xac, 0xcc, 0x93, 0x2c, 0x38, 0x05, 0x46,
+	0xba, 0x2b, 0x40, 0xb8, 0x4d, 0xfc, 0x8a, 0xc7, 0xad, 0x62, 0xb8, 0x58, 0x83, 0xe6, 0x74, 0x2c,
+	0x8c, 0x2b, 0xda, 0xcc, 0xca, 0x7a, 0x9e, 0x74, 0xb1, 0xe4, 0x58, 0x6e, 0x39, 0x0b, 0xc6, 0x7a,
+	0x33, 0x02, 0x15, 0x91, 0xca, 0xaf, 0x7a, 0x6a, 0x73, 0x82, 0x6b, 0x69, 0xb8, 0x4c, 0xa4, 0x0a,
+	0x16, 0x41, 0xa5, 0xd0, 0xc9, 0x36, 0x5f, 0x2b, 0xe9, 0x7b, 0xda, 0xfa, 0x8a, 0xbd, 0xae, 0xb7,
+	0xf9, 0x9a, 0x11, 0x5e, 0x02, 0xfb, 0x36, 0xac, 0x4f, 0x2a, 0x41, 0x50, 0xcc, 0xd3, 0x12, 0xd2,
+	0x67, 0xad, 0x74, 0xa2, 0x67, 0x91, 0x75, 0x87, 0x07, 0x77, 0x81, 0xfd, 0x85, 0x78, 0x0d, 0x51,
+	0x05, 0x9b, 0x5c, 0x40, 0xa9, 0x90, 0x2a, 0x91, 0xc9, 0xe7, 0x8c, 0x79, 0x77, 0xd7, 0x7c, 0x09,
+	0x51, 0x35, 0xcb, 0x45, 0x5d, 0xe3, 0x7a, 0x8b, 0xed, 0xc6, 0xc4, 0x39, 0xe6, 0x17, 0x5e, 0x75,
+	0x5b, 0x9c, 0x83, 0xf6, 0x5f, 0x83, 0x3a, 0x98, 0x70, 0x5b, 0x9c, 0x4a, 0xc5, 0x63, 0x98, 0x26,
+	0x61, 0xa9, 0x05, 0x3e, 0x6f, 0x7d, 0x15, 0xbb, 0xd5, 0x86, 0xbe, 0x60, 0xe0, 0x60, 0x1e, 0xec,
+	0xb1, 0x52, 0x41, 0xf0, 0x2a, 0x6c, 0x73, 0xde, 0xf1, 0x0b, 0x5f, 0xb0, 0x42, 0x1b, 0xd9, 0x12,
+	0xc1, 0xab, 0xa7, 0x38, 0xef, 0x04, 0xf7, 0x82, 0x83, 0x56, 0xd6, 0xa4, 0x91, 0x22, 0x02, 0xe2,
+	0x88, 0xa7, 0x61, 0x44, 0x54, 0xb9, 0xd3, 0xf1, 0xa2, 0xd5, 0xee, 0xcf, 0x25, 0xb3, 0xc6, 0x51,
+	0xb3, 0x0a, 0x7d, 0x5c, 0xba, 0x45, 0xde, 0x8c, 0x52, 0xd9, 0xf6, 0x1b, 0x5f, 0xda, 0x58, 0xe4,
+	0xb3, 0x9a, 0x0a, 0x2e, 0x82, 0x7d, 0xd6, 0x92, 0x08, 0xca, 0x14, 0x94, 0x84, 0x85, 0x26, 0x7a,
+	0xbf, 0xef, 0x15, 0xeb, 0x1b, 0xcf, 0x05, 0xe7, 0x34, 0x5f, 0x27, 0x2c, 0xd4, 0x19, 0x08, 0x6e,
+	0x07, 0x03, 0x8c, 0x63, 0xce, 0x9a, 0xb4, 0xe5, 0x37, 0xbd, 0x6e, 0x8b, 0xa6, 0x40, 0x82, 0x23,
+	0xe0, 0x9f, 0x28, 0xa2, 0xa8, 0x44, 0x43, 0x7b, 0xc3, 0xb2, 0xf9, 0xf7, 0x36, 0x2b, 0x50, 0x90,
+	0xfb, 0x53, 0x2a, 0x48, 0x89, 0x36, 0xf6, 0xa6, 0xe5, 0x75, 0x56, 0x96, 0x2c, 0x15, 0x5c, 0x06,
+	0x07, 0xb6, 0x1c, 0xfd, 0xd2, 0x15, 0xf6, 0xb6, 0xcd, 0xcb, 0xde, 0x4d, 0x1d, 0xc0, 0x16, 0xd9,
+	0x79, 0x30, 0x5e, 0xb8, 0x51, 0x18, 0x8a, 0xb8, 0x64, 0x23, 0x78, 0xc7, 0x6a, 0x8b, 0xa6, 0x54,
+	0xd5, 0xb0, 0xe9, 0x04, 0x55, 0x30, 0xa8, 0xbb, 0x2e, 0x62, 0x61, 0xb9, 0xda, 0x7a, 0xd7, 0xaa,
+	0x76, 0xf3, 0xc6, 0x4a, 0x95, 0x85, 0xba, 0x9a, 0x66, 0xc1, 0x30, 0x8a, 0x24, 0xdf, 0x41, 0xe2,
+	0xae, 0xdb, 0xc4, 0x0d, 0x69, 0xae, 0xc8, 0xdc, 0x1c, 0x18, 0x8d, 0xf1, 0x34, 0x54, 0x48, 0xb4,
+	0x88, 0x82, 0x57, 0x38, 0x2b, 0x91, 0xb0, 0xf7, 0xad, 0x69, 0x38, 0xc6, 0xd3, 0xcb, 0x06, 0xbc,
+	0xcc, 0x19, 0xd1, 0x2a, 0x7b, 0xb6, 0x3b, 0x24, 0x83, 0x2a, 0x4b, 0x4a, 0xa8, 0x3e, 0x72, 0xaa,
+	0x9c, 0x9c, 0x27, 0xd9, 0x72, 0x96, 0x10, 0x1d, 0x9d, 0xa4, 0xac, 0x95, 0x46, 0x48, 0xc0, 0x10,
+	0x29, 0xe4, 0x17, 0x7d, 0x6c, 0x53, 0x34, 0xe4, 0xb8, 0x19, 0xa4, 0x50, 0x70, 0x1b, 0xe8, 0x27,
+	0xd3, 0xa4, 0x9c, 0xe1, 0x13, 0x6b, 0x70, 0xc4, 0x86, 0xb6, 0x8a, 0x79, 0x92, 0x41, 0xca, 0x60,
+	0x93, 0x92, 0x28, 0x2c, 0x51, 0xe5, 0x9f, 0x6e, 0x69, 0xd8, 0x3c, 0xc9, 0xe6, 0xd8, 0xac, 0xc1,
+	0x83, 0x63, 0x60, 0x20, 0x95, 0x44, 0x42, 0x2e, 0x4a, 0x1c, 0xb6, 0xcf, 0x6d, 0x8a, 0xfa, 0x35,
+	0xb2, 0x28, 0x5a, 0xba, 0x51, 0x15, 0xeb, 0x8a, 0x38, 0xef, 0xa4, 0x09, 0x6c, 0x64, 0x50, 0xa6,
+	0x8d, 0x88, 0x96, 0xa9, 0xcb, 0x2f, 0xad, 0xaf, 0x08, 0xee, 0x8c, 0x71, 0x9c, 0xc8, 0xea, 0xb9,
+	0x21, 0xb8, 0x0f, 0xfc, 0x7b, 0x7b, 0xbf, 0x09, 0xde, 0xff, 0x0b, 0xbe, 0xb2, 0xbf, 0xe0, 0xc0,
+	0x36, 0xbf, 0xc0, 0x28, 0x74, 0xdf, 0xa6, 0x2d, 0xc6, 0x05, 0x81, 0x82, 0x34, 0x89, 0x90, 0x50,
+	0x71, 0xbf, 0xf6, 0x5b, 0xd7, 0xb7, 0x73, 0x74, 0xc9, 0x90, 0xcb, 0x5c, 0xcb, 0x94, 0x40, 0xb8,
+	0x23, 0x77, 0x22, 0xfb, 0xce, 0xc9, 0x72, 0xb4, 0x90, 0x5d, 0x00, 0x7b, 0x31, 0x67, 0x4a, 0xf0,
+	0x28, 0x22, 0x02, 0xa2, 0x84, 0xea, 0x5b, 0x3a, 0xc5, 0x25, 0xd2, 0xfa, 0xbd, 0x8d, 0x7a, 0xbc,
+	0xcb, 0x57, 0x13, 0x5a, 0x37, 0x74, 0xb0, 0x00, 0x2a, 0xae, 0x82, 0x50, 0x14, 0x95, 0xae, 0xa2,
+	0x1f, 0xdd, 0x6c, 0x82, 0x4d, 0xf5, 0x54, 0xa3, 0xc8, 0x16, 0x50, 0x6f, 0x4f, 0xca, 0xc7, 0x88,
+	0x72, 0x8d, 0xe4, 0xe7, 0xcd, 0x3d, 0x29, 0x1f, 0x22, 0x74, 0x43, 0x99, 0x03, 0xa3, 0x09, 0x12,
+	0x84, 0x29, 0xa8, 0x5b, 0x13, 0x43, 0x71, 0x89, 0xd3, 0xfb, 0xab, 0x3b, 0xbd, 0x39, 0xb9, 0xd8,
+	0x58, 0x59, 0x40, 0xb1, 0xb9, 0x96, 0xa5, 0x12, 0x94, 0xb5, 0x4c, 0x23, 0x28, 0x59, 0x35, 0xbf,
+	0x59, 0xd7, 0x48, 0x8e, 0xce, 0x93, 0xcc, 0xc4, 0xaa, 0xa7, 0x26, 0x2c, 0x88, 0x0e, 0x94, 0xaf,
+	0x12, 0xb1, 0x26, 0xa8, 0x22, 0x12, 0x86, 0x69, 0x52, 0x22, 0x7d, 0x7f, 0xb8, 0x43, 0x98, 0x0b,
+	0x16, 0x0b, 0x7e, 0x26, 0x4d, 0xa4, 0xee, 0x31, 0x0a, 0x35, 0x22, 0x7d, 0xb6, 0xa3, 0x34, 0x66,
+	0x25, 0x7c, 0x7f, 0xb9, 0x0e, 0x6a, 0xb8, 0x5a, 0x8e, 0x05, 0x55, 0x30, 0xb4, 0x4a, 0x84, 0xa4,
+	0x9c, 0xc1, 0x36, 0x92, 0xed, 0xca, 0x7f, 0xb6, 0x68, 0x4e, 0xb2, 0x34, 0x76, 0x8e, 0x57, 0xdd,
+	0xa5, 0x6e, 0x99, 0x53, 0x48, 0xb6, 0x83, 0xd3, 0x60, 0xac, 0x57, 0x01, 0x25, 0x8a, 0x94, 0xc7,
+	0x73, 0xcd, 0xae, 0x65, 0xb4, 0xc7, 0x53, 0x47, 0x91, 0x0a, 0x6a, 0x60, 0x18, 0xf3, 0x38, 0xe6,
+	0x0c, 0x26, 0x82, 0x34, 0xe9, 0x03, 0x1e, 0xcf, 0xd7, 0x2e, 0xa6, 0x1c, 0x3a, 0x67, 0x98, 0x60,
+	0x16, 0x0c, 0xa5, 0x49, 0x4b, 0xa0, 0x90, 0xc0, 0x66, 0xca, 0x70, 0xe5, 0xff, 0xdb, 0x3a, 0x2e,
+	0xa2, 0x28, 0x2d, 0x92, 0xf3, 0x9a, 0xbb, 0x97, 0x2d, 0x38, 0x9b, 0x32, 0x1c, 0xdc, 0x01, 0x06,
+	0x7b, 0xaf, 0xe2, 0xff, 0x6e, 0xd1, 0x98, 0x3d, 0x76, 0x8a, 0x27, 0x6c, 0x6e, 0x80, 0xea, 0xde,
+	0xbe, 0x47, 0x41, 0x7f, 0x03, 0xe1, 0x0e, 0x61, 0xa1, 0x8f, 0x7e, 0xc6, 0xf5, 0x6f, 0xfb, 0xbd,
+	0x46, 0xdb, 0x34, 0x24, 0x0a, 0xb5, 0x7c, 0xe8, 0xc3, 0x0f, 0xd9, 0x16, 0x6b, 0xbf, 0x0f, 0x8e,
+	0x80, 0x5d, 0x1d, 0x92, 0x95, 0x20, 0xbf, 0xb0, 0x51, 0xdb, 0xcf, 0x83, 0x7b, 0xc0, 0x41, 0xd9,
+	0xa1, 0x09, 0xcc, 0x7f, 0x84, 0x7a, 0x3a, 0x8a, 0x28, 0x56, 0x10, 0xb7, 0x09, 0xee, 0xf8, 0x64,
+	0x37, 0xdd, 0x08, 0xa9, 0x15, 0xf3, 0xc6, 0x50, 0xb3, 0x82, 0x9a, 0xe6, 0x83, 0x63, 0x60, 0x77,
+	0xb7, 0xc7, 0x79, 0x64, 0xdf, 0xb8, 0x19, 0x4d, 0xb8, 0xde, 0x76, 0x12, 0x8c, 0x74, 0x1b, 0xa5,
+	0x84, 0x8d, 0xcc, 0xa7, 0xf8, 0xa1, 0xa8, 0x77, 0xd7, 0x24, 0xe5, 0x89, 0x2c, 0x38, 0x0e, 0x80,
+	0x20, 0x21, 0x95, 0x90, 0xb3, 0xc8, 0xab, 0xf8, 0xc9, 0x4d, 0x2e, 0x06, 0x59, 0x64, 0x51, 0xa6,
+	0xb7, 0x45, 0x4f, 0x1c, 0x28, 0xa1, 0x95, 0xc9, 0x6d, 0x4e, 0x9c, 0x6a, 0xf3, 0x82, 0x7e, 0xd9,
+	0x65, 0x37, 0xc6, 0xd3, 0xd5, 0x84, 0xea, 0xe1, 0xd7, 0x76, 0x3b, 0x9e, 0x2a, 0x48, 0x19, 0x16,
+	0x24, 0x26, 0x4c, 0xa1, 0xc8, 0x6b, 0x7a, 0xcb, 0xb5, 0x82, 0x9c, 0x5f, 0x4c, 0xd5, 0x5c, 0x97,
+	0x0e, 0xee, 0x04, 0x23, 0x94, 0x25, 0x69, 0xcf, 0x18, 0xea, 0xf3, 0x5d, 0x77, 0xaf, 0x1c, 0xc3,
+	0x15, 0xd3, 0xd4, 0x69, 0xb0, 0x47, 0xc7, 0x66, 0xc7, 0x20, 0x94, 0xaa, 0xf6, 0x15, 0xaf, 0xea,
+	0x3d, 0x77, 0x15, 0xc5, 0x78, 0x3a, 0x7f, 0xdd, 0x54, 0x35, 0x67, 0x26, 0x33, 0xf3, 0x21, 0x2c,
+	0x06, 0x73, 0x9f, 0xca, 0x0d, 0x66, 0x23, 0x39, 0xb8, 0xe0, 0xa6, 0xf3, 0x25, 0x30, 0x61, 0x55,
+	0x1b, 0x67, 0x46, 0x9f, 0xef, 0x03, 0xeb, 0xab, 0xe4, 0x74, 0xb5, 0x77, 0x70, 0x3c, 0x07, 0xc6,
+	0x8b, 0xe5, 0xed, 0x20, 0x71, 0x1f, 0x5a, 0xe5, 0x98, 0x5b, 0x62, 0x37, 0x79, 0x67, 0xc0, 0x18,
+	0xe3, 0x4c, 0x3f, 0x5c, 0x59, 0x88, 0x44, 0x68, 0x1e, 0xdd, 0x5e, 0xdf, 0x0d, 0x77, 0x45, 0x32,
+	0xce, 0xea, 0x96, 0xd4, 0x2f, 0x6e, 0x7d, 0xe5, 0xda, 0x32, 0x83, 0xf9, 0x7b, 0x47, 0x70, 0xae,
+	0xbc, 0xba, 0x75, 0xf7, 0x7a, 0xcf, 0x2b, 0x6e, 0xa1, 0x20, 0x83, 0xf3, 0x60, 0xc2, 0xf9, 0x6c,
+	0xb0, 0xf9, 0xfc, 0xe6, 0x33, 0x7e, 0x56, 0x04, 0x6c, 0x8c, 0x36, 0x5a, 0x33, 0xc8, 0x1d, 0x05,
+	0xfd, 0x38, 0xa2, 0x10, 0xc7, 0xfe, 0xb4, 0xdd, 0x74, 0x27, 0x01, 0x47, 0xb4, 0x16, 0x87, 0xc1,
+	0xdd, 0xe0, 0x5f, 0x3d, 0x85, 0xb6, 0x8a, 0x22, 0xaa, 0xbb, 0x25, 0x34, 0xd5, 0xe8, 0x95, 0xfd,
+	0x62, 0x83, 0xdc, 0x57, 0x54, 0xdc, 0x45, 0x2b, 0x98, 0xd3, 0x7c, 0x50, 0x07, 0x7b, 0xb5, 0xdc,
+	0xfc, 0xa7, 0xc7, 0x8e, 0x4a, 0xf9, 0x4f, 0xf7, 0x56, 0x8f, 0xf1, 0xb4, 0xde, 0x84, 0xde, 0x72,
+	0x9e, 0x01, 0x43, 0x61, 0x1a, 0xc7, 0x19, 0x94, 0x44, 0xac, 0x12, 0xb1, 0xcd, 0x6d, 0x5b, 0x27,
+	0x62, 0x95, 0xe2, 0x4d, 0x8f, 0xcc, 0x81, 0xa5, 0x41, 0x83, 0xd5, 0x0d, 0xa5, 0x2d, 0x94, 0x29,
+	0x22, 0x18, 0x8a, 0x4c, 0x07, 0xf1, 0x5a, 0x7e, 0x77, 0x16, 0x87, 0xe9, 0x3e, 0x72, 0x1c, 0xe4,
+	0xfd, 0xa8, 0x9c, 0xc2, 0x8d, 0x11, 0x03, 0x86, 0xa9, 0x26, 0xf4, 0xc4, 0xd0, 0xd5, 0xf5, 0xc9,
+	0xbe, 0x6b, 0xeb, 0x93, 0x7d, 0x37, 0xd6, 0x27, 0xfb, 0x1a, 0xbb, 0x0c, 0x78, 0xeb, 0xdf, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0xda, 0xf9, 0xf6, 0x1a, 0x1c, 0x14, 0x00, 0x00,
 }